@@ -0,0 +1,22 @@
+package ag
+
+// Access entry states, comparing the actual and expected permission of an access entry.
+const (
+	AccessOK         = "ok"
+	AccessUnexpected = "unexpected" // the team or user has access, but should not
+	AccessMissing    = "missing"    // the team or user should have access, but does not
+	AccessMismatch   = "mismatch"   // the team or user has a different permission than expected
+)
+
+// State returns the state of the access entry, comparing the actual and expected permission.
+func (e *AccessEntry) State() string {
+	switch {
+	case e.GetPermission() == e.GetExpectedPermission():
+		return AccessOK
+	case e.GetExpectedPermission() == "":
+		return AccessUnexpected
+	case e.GetPermission() == "":
+		return AccessMissing
+	}
+	return AccessMismatch
+}
@@ -0,0 +1,27 @@
+package ag
+
+import "time"
+
+// HasCourse returns true if the access token is valid for the given course.
+func (t *AccessToken) HasCourse(courseID uint64) bool {
+	for _, course := range t.GetCourses() {
+		if course.GetCourseID() == courseID {
+			return true
+		}
+	}
+	return false
+}
+
+// IsExpired returns true if the access token has expired at the given time.
+// Tokens without an expiration time never expire.
+func (t *AccessToken) IsExpired(now time.Time) bool {
+	if t.GetExpires() == "" {
+		return false
+	}
+	expires, err := time.ParseInLocation(TimeLayout, t.GetExpires(), now.Location())
+	if err != nil {
+		// tokens with invalid expiration times are considered expired
+		return true
+	}
+	return now.After(expires)
+}
@@ -27,6 +27,7 @@ type Group_GroupStatus int32
 const (
 	Group_PENDING  Group_GroupStatus = 0
 	Group_APPROVED Group_GroupStatus = 1
+	Group_INVITING Group_GroupStatus = 2 // invitations to join the group are pending; the group is not yet submitted for approval
 )
 
 // Enum value maps for Group_GroupStatus.
@@ -34,10 +35,12 @@ var (
 	Group_GroupStatus_name = map[int32]string{
 		0: "PENDING",
 		1: "APPROVED",
+		2: "INVITING",
 	}
 	Group_GroupStatus_value = map[string]int32{
 		"PENDING":  0,
 		"APPROVED": 1,
+		"INVITING": 2,
 	}
 )
 
@@ -65,7 +68,372 @@ func (x Group_GroupStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Group_GroupStatus.Descriptor instead.
 func (Group_GroupStatus) EnumDescriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{3, 0}
+	return file_ag_ag_proto_rawDescGZIP(), []int{7, 0}
+}
+
+type GroupInvitation_Status int32
+
+const (
+	GroupInvitation_PENDING  GroupInvitation_Status = 0
+	GroupInvitation_ACCEPTED GroupInvitation_Status = 1
+	GroupInvitation_DECLINED GroupInvitation_Status = 2
+	GroupInvitation_EXPIRED  GroupInvitation_Status = 3
+	GroupInvitation_CANCELED GroupInvitation_Status = 4 // the group was approved or deleted before the invitation was answered
+)
+
+// Enum value maps for GroupInvitation_Status.
+var (
+	GroupInvitation_Status_name = map[int32]string{
+		0: "PENDING",
+		1: "ACCEPTED",
+		2: "DECLINED",
+		3: "EXPIRED",
+		4: "CANCELED",
+	}
+	GroupInvitation_Status_value = map[string]int32{
+		"PENDING":  0,
+		"ACCEPTED": 1,
+		"DECLINED": 2,
+		"EXPIRED":  3,
+		"CANCELED": 4,
+	}
+)
+
+func (x GroupInvitation_Status) Enum() *GroupInvitation_Status {
+	p := new(GroupInvitation_Status)
+	*p = x
+	return p
+}
+
+func (x GroupInvitation_Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GroupInvitation_Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_ag_ag_proto_enumTypes[1].Descriptor()
+}
+
+func (GroupInvitation_Status) Type() protoreflect.EnumType {
+	return &file_ag_ag_proto_enumTypes[1]
+}
+
+func (x GroupInvitation_Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GroupInvitation_Status.Descriptor instead.
+func (GroupInvitation_Status) EnumDescriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{9, 0}
+}
+
+// SetupStep is a step in creating the course's resources on the SCM provider.
+type Course_SetupStep int32
+
+const (
+	Course_COMPLETE           Course_SetupStep = 0 // all course resources are created
+	Course_ORGANIZATION       Course_SetupStep = 1 // organization permissions and webhook
+	Course_REPOSITORIES       Course_SetupStep = 2 // info, assignments and tests repositories
+	Course_TEAMS              Course_SetupStep = 3 // teachers and students teams
+	Course_CREATOR_REPOSITORY Course_SetupStep = 4 // the course creator's student repository
+)
+
+// Enum value maps for Course_SetupStep.
+var (
+	Course_SetupStep_name = map[int32]string{
+		0: "COMPLETE",
+		1: "ORGANIZATION",
+		2: "REPOSITORIES",
+		3: "TEAMS",
+		4: "CREATOR_REPOSITORY",
+	}
+	Course_SetupStep_value = map[string]int32{
+		"COMPLETE":           0,
+		"ORGANIZATION":       1,
+		"REPOSITORIES":       2,
+		"TEAMS":              3,
+		"CREATOR_REPOSITORY": 4,
+	}
+)
+
+func (x Course_SetupStep) Enum() *Course_SetupStep {
+	p := new(Course_SetupStep)
+	*p = x
+	return p
+}
+
+func (x Course_SetupStep) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Course_SetupStep) Descriptor() protoreflect.EnumDescriptor {
+	return file_ag_ag_proto_enumTypes[2].Descriptor()
+}
+
+func (Course_SetupStep) Type() protoreflect.EnumType {
+	return &file_ag_ag_proto_enumTypes[2]
+}
+
+func (x Course_SetupStep) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Course_SetupStep.Descriptor instead.
+func (Course_SetupStep) EnumDescriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{16, 0}
+}
+
+// AssistantPermission is what teaching assistants can do in the course, besides viewing its
+// enrollments, groups, submissions and results. Teaching assistants can never change the
+// course's settings, enrollments or groups.
+type Course_AssistantPermission int32
+
+const (
+	Course_REVIEW_AND_APPROVE Course_AssistantPermission = 0 // review submissions, and approve, reject and grade them
+	Course_REVIEW_ONLY        Course_AssistantPermission = 1 // review submissions, but not change their status
+	Course_VIEW_ONLY          Course_AssistantPermission = 2 // neither review submissions nor change their status
+)
+
+// Enum value maps for Course_AssistantPermission.
+var (
+	Course_AssistantPermission_name = map[int32]string{
+		0: "REVIEW_AND_APPROVE",
+		1: "REVIEW_ONLY",
+		2: "VIEW_ONLY",
+	}
+	Course_AssistantPermission_value = map[string]int32{
+		"REVIEW_AND_APPROVE": 0,
+		"REVIEW_ONLY":        1,
+		"VIEW_ONLY":          2,
+	}
+)
+
+func (x Course_AssistantPermission) Enum() *Course_AssistantPermission {
+	p := new(Course_AssistantPermission)
+	*p = x
+	return p
+}
+
+func (x Course_AssistantPermission) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Course_AssistantPermission) Descriptor() protoreflect.EnumDescriptor {
+	return file_ag_ag_proto_enumTypes[3].Descriptor()
+}
+
+func (Course_AssistantPermission) Type() protoreflect.EnumType {
+	return &file_ag_ag_proto_enumTypes[3]
+}
+
+func (x Course_AssistantPermission) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Course_AssistantPermission.Descriptor instead.
+func (Course_AssistantPermission) EnumDescriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{16, 1}
+}
+
+type CourseCleanupRequest_RepositoryAction int32
+
+const (
+	CourseCleanupRequest_KEEP    CourseCleanupRequest_RepositoryAction = 0
+	CourseCleanupRequest_ARCHIVE CourseCleanupRequest_RepositoryAction = 1
+	CourseCleanupRequest_DELETE  CourseCleanupRequest_RepositoryAction = 2
+)
+
+// Enum value maps for CourseCleanupRequest_RepositoryAction.
+var (
+	CourseCleanupRequest_RepositoryAction_name = map[int32]string{
+		0: "KEEP",
+		1: "ARCHIVE",
+		2: "DELETE",
+	}
+	CourseCleanupRequest_RepositoryAction_value = map[string]int32{
+		"KEEP":    0,
+		"ARCHIVE": 1,
+		"DELETE":  2,
+	}
+)
+
+func (x CourseCleanupRequest_RepositoryAction) Enum() *CourseCleanupRequest_RepositoryAction {
+	p := new(CourseCleanupRequest_RepositoryAction)
+	*p = x
+	return p
+}
+
+func (x CourseCleanupRequest_RepositoryAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CourseCleanupRequest_RepositoryAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_ag_ag_proto_enumTypes[4].Descriptor()
+}
+
+func (CourseCleanupRequest_RepositoryAction) Type() protoreflect.EnumType {
+	return &file_ag_ag_proto_enumTypes[4]
+}
+
+func (x CourseCleanupRequest_RepositoryAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CourseCleanupRequest_RepositoryAction.Descriptor instead.
+func (CourseCleanupRequest_RepositoryAction) EnumDescriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{17, 0}
+}
+
+type SCMOperation_Kind int32
+
+const (
+	SCMOperation_UPDATE_ORGANIZATION      SCMOperation_Kind = 0 // set the organization's default repository permission
+	SCMOperation_CREATE_HOOK              SCMOperation_Kind = 1 // create the organization's push hook
+	SCMOperation_CREATE_REPOSITORY        SCMOperation_Kind = 2 // create a repository
+	SCMOperation_CREATE_TEAM              SCMOperation_Kind = 3 // create a team
+	SCMOperation_ADD_TEAM_REPOSITORY      SCMOperation_Kind = 4 // grant a team access to a repository
+	SCMOperation_UPDATE_REPOSITORY_ACCESS SCMOperation_Kind = 5 // grant a user access to a repository
+)
+
+// Enum value maps for SCMOperation_Kind.
+var (
+	SCMOperation_Kind_name = map[int32]string{
+		0: "UPDATE_ORGANIZATION",
+		1: "CREATE_HOOK",
+		2: "CREATE_REPOSITORY",
+		3: "CREATE_TEAM",
+		4: "ADD_TEAM_REPOSITORY",
+		5: "UPDATE_REPOSITORY_ACCESS",
+	}
+	SCMOperation_Kind_value = map[string]int32{
+		"UPDATE_ORGANIZATION":      0,
+		"CREATE_HOOK":              1,
+		"CREATE_REPOSITORY":        2,
+		"CREATE_TEAM":              3,
+		"ADD_TEAM_REPOSITORY":      4,
+		"UPDATE_REPOSITORY_ACCESS": 5,
+	}
+)
+
+func (x SCMOperation_Kind) Enum() *SCMOperation_Kind {
+	p := new(SCMOperation_Kind)
+	*p = x
+	return p
+}
+
+func (x SCMOperation_Kind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SCMOperation_Kind) Descriptor() protoreflect.EnumDescriptor {
+	return file_ag_ag_proto_enumTypes[5].Descriptor()
+}
+
+func (SCMOperation_Kind) Type() protoreflect.EnumType {
+	return &file_ag_ag_proto_enumTypes[5]
+}
+
+func (x SCMOperation_Kind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SCMOperation_Kind.Descriptor instead.
+func (SCMOperation_Kind) EnumDescriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{20, 0}
+}
+
+type ScoreCurve_Method int32
+
+const (
+	ScoreCurve_NONE       ScoreCurve_Method = 0 // no curve; removes the assignment's curve
+	ScoreCurve_LINEAR     ScoreCurve_Method = 1
+	ScoreCurve_PERCENTILE ScoreCurve_Method = 2
+)
+
+// Enum value maps for ScoreCurve_Method.
+var (
+	ScoreCurve_Method_name = map[int32]string{
+		0: "NONE",
+		1: "LINEAR",
+		2: "PERCENTILE",
+	}
+	ScoreCurve_Method_value = map[string]int32{
+		"NONE":       0,
+		"LINEAR":     1,
+		"PERCENTILE": 2,
+	}
+)
+
+func (x ScoreCurve_Method) Enum() *ScoreCurve_Method {
+	p := new(ScoreCurve_Method)
+	*p = x
+	return p
+}
+
+func (x ScoreCurve_Method) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ScoreCurve_Method) Descriptor() protoreflect.EnumDescriptor {
+	return file_ag_ag_proto_enumTypes[6].Descriptor()
+}
+
+func (ScoreCurve_Method) Type() protoreflect.EnumType {
+	return &file_ag_ag_proto_enumTypes[6]
+}
+
+func (x ScoreCurve_Method) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ScoreCurve_Method.Descriptor instead.
+func (ScoreCurve_Method) EnumDescriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{25, 0}
+}
+
+type ResultsExportRequest_Format int32
+
+const (
+	ResultsExportRequest_CSV  ResultsExportRequest_Format = 0
+	ResultsExportRequest_XLSX ResultsExportRequest_Format = 1
+)
+
+// Enum value maps for ResultsExportRequest_Format.
+var (
+	ResultsExportRequest_Format_name = map[int32]string{
+		0: "CSV",
+		1: "XLSX",
+	}
+	ResultsExportRequest_Format_value = map[string]int32{
+		"CSV":  0,
+		"XLSX": 1,
+	}
+)
+
+func (x ResultsExportRequest_Format) Enum() *ResultsExportRequest_Format {
+	p := new(ResultsExportRequest_Format)
+	*p = x
+	return p
+}
+
+func (x ResultsExportRequest_Format) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ResultsExportRequest_Format) Descriptor() protoreflect.EnumDescriptor {
+	return file_ag_ag_proto_enumTypes[7].Descriptor()
+}
+
+func (ResultsExportRequest_Format) Type() protoreflect.EnumType {
+	return &file_ag_ag_proto_enumTypes[7]
+}
+
+func (x ResultsExportRequest_Format) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ResultsExportRequest_Format.Descriptor instead.
+func (ResultsExportRequest_Format) EnumDescriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{29, 0}
 }
 
 type Repository_Type int32
@@ -110,11 +478,11 @@ func (x Repository_Type) String() string {
 }
 
 func (Repository_Type) Descriptor() protoreflect.EnumDescriptor {
-	return file_ag_ag_proto_enumTypes[1].Descriptor()
+	return file_ag_ag_proto_enumTypes[8].Descriptor()
 }
 
 func (Repository_Type) Type() protoreflect.EnumType {
-	return &file_ag_ag_proto_enumTypes[1]
+	return &file_ag_ag_proto_enumTypes[8]
 }
 
 func (x Repository_Type) Number() protoreflect.EnumNumber {
@@ -123,7 +491,7 @@ func (x Repository_Type) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Repository_Type.Descriptor instead.
 func (Repository_Type) EnumDescriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{7, 0}
+	return file_ag_ag_proto_rawDescGZIP(), []int{42, 0}
 }
 
 type Enrollment_UserStatus int32
@@ -133,6 +501,7 @@ const (
 	Enrollment_PENDING Enrollment_UserStatus = 1
 	Enrollment_STUDENT Enrollment_UserStatus = 2
 	Enrollment_TEACHER Enrollment_UserStatus = 3
+	Enrollment_TA      Enrollment_UserStatus = 4 // teaching assistant; what TAs can do is given by the course's assistantPermission
 )
 
 // Enum value maps for Enrollment_UserStatus.
@@ -142,12 +511,14 @@ var (
 		1: "PENDING",
 		2: "STUDENT",
 		3: "TEACHER",
+		4: "TA",
 	}
 	Enrollment_UserStatus_value = map[string]int32{
 		"NONE":    0,
 		"PENDING": 1,
 		"STUDENT": 2,
 		"TEACHER": 3,
+		"TA":      4,
 	}
 )
 
@@ -162,11 +533,11 @@ func (x Enrollment_UserStatus) String() string {
 }
 
 func (Enrollment_UserStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_ag_ag_proto_enumTypes[2].Descriptor()
+	return file_ag_ag_proto_enumTypes[9].Descriptor()
 }
 
 func (Enrollment_UserStatus) Type() protoreflect.EnumType {
-	return &file_ag_ag_proto_enumTypes[2]
+	return &file_ag_ag_proto_enumTypes[9]
 }
 
 func (x Enrollment_UserStatus) Number() protoreflect.EnumNumber {
@@ -175,7 +546,7 @@ func (x Enrollment_UserStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Enrollment_UserStatus.Descriptor instead.
 func (Enrollment_UserStatus) EnumDescriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{8, 0}
+	return file_ag_ag_proto_rawDescGZIP(), []int{43, 0}
 }
 
 type Enrollment_DisplayState int32
@@ -214,11 +585,11 @@ func (x Enrollment_DisplayState) String() string {
 }
 
 func (Enrollment_DisplayState) Descriptor() protoreflect.EnumDescriptor {
-	return file_ag_ag_proto_enumTypes[3].Descriptor()
+	return file_ag_ag_proto_enumTypes[10].Descriptor()
 }
 
 func (Enrollment_DisplayState) Type() protoreflect.EnumType {
-	return &file_ag_ag_proto_enumTypes[3]
+	return &file_ag_ag_proto_enumTypes[10]
 }
 
 func (x Enrollment_DisplayState) Number() protoreflect.EnumNumber {
@@ -227,16 +598,17 @@ func (x Enrollment_DisplayState) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Enrollment_DisplayState.Descriptor instead.
 func (Enrollment_DisplayState) EnumDescriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{8, 1}
+	return file_ag_ag_proto_rawDescGZIP(), []int{43, 1}
 }
 
 type Submission_Status int32
 
 const (
-	Submission_NONE     Submission_Status = 0
-	Submission_APPROVED Submission_Status = 1
-	Submission_REJECTED Submission_Status = 2
-	Submission_REVISION Submission_Status = 3
+	Submission_NONE      Submission_Status = 0 // not graded
+	Submission_APPROVED  Submission_Status = 1
+	Submission_REJECTED  Submission_Status = 2
+	Submission_REVISION  Submission_Status = 3 // changes requested
+	Submission_IN_REVIEW Submission_Status = 4 // being reviewed, or approved by fewer reviewers than the assignment requires
 )
 
 // Enum value maps for Submission_Status.
@@ -246,12 +618,14 @@ var (
 		1: "APPROVED",
 		2: "REJECTED",
 		3: "REVISION",
+		4: "IN_REVIEW",
 	}
 	Submission_Status_value = map[string]int32{
-		"NONE":     0,
-		"APPROVED": 1,
-		"REJECTED": 2,
-		"REVISION": 3,
+		"NONE":      0,
+		"APPROVED":  1,
+		"REJECTED":  2,
+		"REVISION":  3,
+		"IN_REVIEW": 4,
 	}
 )
 
@@ -266,11 +640,11 @@ func (x Submission_Status) String() string {
 }
 
 func (Submission_Status) Descriptor() protoreflect.EnumDescriptor {
-	return file_ag_ag_proto_enumTypes[4].Descriptor()
+	return file_ag_ag_proto_enumTypes[11].Descriptor()
 }
 
 func (Submission_Status) Type() protoreflect.EnumType {
-	return &file_ag_ag_proto_enumTypes[4]
+	return &file_ag_ag_proto_enumTypes[11]
 }
 
 func (x Submission_Status) Number() protoreflect.EnumNumber {
@@ -279,525 +653,692 @@ func (x Submission_Status) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Submission_Status.Descriptor instead.
 func (Submission_Status) EnumDescriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{16, 0}
+	return file_ag_ag_proto_rawDescGZIP(), []int{61, 0}
 }
 
-type GradingCriterion_Grade int32
+// ExceededLimit is the resource limit that stopped the tests of a submission, if any.
+type Submission_ExceededLimit int32
 
 const (
-	GradingCriterion_NONE   GradingCriterion_Grade = 0
-	GradingCriterion_FAILED GradingCriterion_Grade = 1
-	GradingCriterion_PASSED GradingCriterion_Grade = 2
+	Submission_NO_LIMIT     Submission_ExceededLimit = 0
+	Submission_TIME_LIMIT   Submission_ExceededLimit = 1
+	Submission_MEMORY_LIMIT Submission_ExceededLimit = 2
 )
 
-// Enum value maps for GradingCriterion_Grade.
+// Enum value maps for Submission_ExceededLimit.
 var (
-	GradingCriterion_Grade_name = map[int32]string{
-		0: "NONE",
-		1: "FAILED",
-		2: "PASSED",
+	Submission_ExceededLimit_name = map[int32]string{
+		0: "NO_LIMIT",
+		1: "TIME_LIMIT",
+		2: "MEMORY_LIMIT",
 	}
-	GradingCriterion_Grade_value = map[string]int32{
-		"NONE":   0,
-		"FAILED": 1,
-		"PASSED": 2,
+	Submission_ExceededLimit_value = map[string]int32{
+		"NO_LIMIT":     0,
+		"TIME_LIMIT":   1,
+		"MEMORY_LIMIT": 2,
 	}
 )
 
-func (x GradingCriterion_Grade) Enum() *GradingCriterion_Grade {
-	p := new(GradingCriterion_Grade)
+func (x Submission_ExceededLimit) Enum() *Submission_ExceededLimit {
+	p := new(Submission_ExceededLimit)
 	*p = x
 	return p
 }
 
-func (x GradingCriterion_Grade) String() string {
+func (x Submission_ExceededLimit) String() string {
 	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (GradingCriterion_Grade) Descriptor() protoreflect.EnumDescriptor {
-	return file_ag_ag_proto_enumTypes[5].Descriptor()
+func (Submission_ExceededLimit) Descriptor() protoreflect.EnumDescriptor {
+	return file_ag_ag_proto_enumTypes[12].Descriptor()
 }
 
-func (GradingCriterion_Grade) Type() protoreflect.EnumType {
-	return &file_ag_ag_proto_enumTypes[5]
+func (Submission_ExceededLimit) Type() protoreflect.EnumType {
+	return &file_ag_ag_proto_enumTypes[12]
 }
 
-func (x GradingCriterion_Grade) Number() protoreflect.EnumNumber {
+func (x Submission_ExceededLimit) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Use GradingCriterion_Grade.Descriptor instead.
-func (GradingCriterion_Grade) EnumDescriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{20, 0}
+// Deprecated: Use Submission_ExceededLimit.Descriptor instead.
+func (Submission_ExceededLimit) EnumDescriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{61, 1}
 }
 
-type SubmissionsForCourseRequest_Type int32
+type PolicyViolation_Rule int32
 
 const (
-	SubmissionsForCourseRequest_ALL        SubmissionsForCourseRequest_Type = 0
-	SubmissionsForCourseRequest_INDIVIDUAL SubmissionsForCourseRequest_Type = 1
-	SubmissionsForCourseRequest_GROUP      SubmissionsForCourseRequest_Type = 2
+	PolicyViolation_SECRET            PolicyViolation_Rule = 0 // a committed secret, such as an access key or a private key
+	PolicyViolation_FORBIDDEN_FILE    PolicyViolation_Rule = 1 // a file matching one of the assignment's forbidden file patterns
+	PolicyViolation_LARGE_FILE        PolicyViolation_Rule = 2 // a file larger than the assignment's maximum file size
+	PolicyViolation_DISALLOWED_IMPORT PolicyViolation_Rule = 3 // an import of one of the assignment's disallowed import paths
 )
 
-// Enum value maps for SubmissionsForCourseRequest_Type.
+// Enum value maps for PolicyViolation_Rule.
 var (
-	SubmissionsForCourseRequest_Type_name = map[int32]string{
-		0: "ALL",
-		1: "INDIVIDUAL",
-		2: "GROUP",
-	}
-	SubmissionsForCourseRequest_Type_value = map[string]int32{
-		"ALL":        0,
-		"INDIVIDUAL": 1,
-		"GROUP":      2,
+	PolicyViolation_Rule_name = map[int32]string{
+		0: "SECRET",
+		1: "FORBIDDEN_FILE",
+		2: "LARGE_FILE",
+		3: "DISALLOWED_IMPORT",
+	}
+	PolicyViolation_Rule_value = map[string]int32{
+		"SECRET":            0,
+		"FORBIDDEN_FILE":    1,
+		"LARGE_FILE":        2,
+		"DISALLOWED_IMPORT": 3,
 	}
 )
 
-func (x SubmissionsForCourseRequest_Type) Enum() *SubmissionsForCourseRequest_Type {
-	p := new(SubmissionsForCourseRequest_Type)
+func (x PolicyViolation_Rule) Enum() *PolicyViolation_Rule {
+	p := new(PolicyViolation_Rule)
 	*p = x
 	return p
 }
 
-func (x SubmissionsForCourseRequest_Type) String() string {
+func (x PolicyViolation_Rule) String() string {
 	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (SubmissionsForCourseRequest_Type) Descriptor() protoreflect.EnumDescriptor {
-	return file_ag_ag_proto_enumTypes[6].Descriptor()
+func (PolicyViolation_Rule) Descriptor() protoreflect.EnumDescriptor {
+	return file_ag_ag_proto_enumTypes[13].Descriptor()
 }
 
-func (SubmissionsForCourseRequest_Type) Type() protoreflect.EnumType {
-	return &file_ag_ag_proto_enumTypes[6]
+func (PolicyViolation_Rule) Type() protoreflect.EnumType {
+	return &file_ag_ag_proto_enumTypes[13]
 }
 
-func (x SubmissionsForCourseRequest_Type) Number() protoreflect.EnumNumber {
+func (x PolicyViolation_Rule) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Use SubmissionsForCourseRequest_Type.Descriptor instead.
-func (SubmissionsForCourseRequest_Type) EnumDescriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{44, 0}
+// Deprecated: Use PolicyViolation_Rule.Descriptor instead.
+func (PolicyViolation_Rule) EnumDescriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{66, 0}
 }
 
-type User struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type TestResult_Status int32
 
-	ID               uint64            `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	IsAdmin          bool              `protobuf:"varint,2,opt,name=isAdmin,proto3" json:"isAdmin,omitempty"`
-	Name             string            `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	StudentID        string            `protobuf:"bytes,4,opt,name=studentID,proto3" json:"studentID,omitempty"`
-	Email            string            `protobuf:"bytes,5,opt,name=email,proto3" json:"email,omitempty"`
-	AvatarURL        string            `protobuf:"bytes,6,opt,name=avatarURL,proto3" json:"avatarURL,omitempty"`
-	Login            string            `protobuf:"bytes,7,opt,name=login,proto3" json:"login,omitempty"`
-	RemoteIdentities []*RemoteIdentity `protobuf:"bytes,8,rep,name=remoteIdentities,proto3" json:"remoteIdentities,omitempty"`
-	Enrollments      []*Enrollment     `protobuf:"bytes,9,rep,name=enrollments,proto3" json:"enrollments,omitempty"`
-}
+const (
+	TestResult_UNKNOWN TestResult_Status = 0
+	TestResult_PASSED  TestResult_Status = 1
+	TestResult_FAILED  TestResult_Status = 2
+	TestResult_SKIPPED TestResult_Status = 3
+)
 
-func (x *User) Reset() {
-	*x = User{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[0]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Enum value maps for TestResult_Status.
+var (
+	TestResult_Status_name = map[int32]string{
+		0: "UNKNOWN",
+		1: "PASSED",
+		2: "FAILED",
+		3: "SKIPPED",
+	}
+	TestResult_Status_value = map[string]int32{
+		"UNKNOWN": 0,
+		"PASSED":  1,
+		"FAILED":  2,
+		"SKIPPED": 3,
 	}
+)
+
+func (x TestResult_Status) Enum() *TestResult_Status {
+	p := new(TestResult_Status)
+	*p = x
+	return p
 }
 
-func (x *User) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x TestResult_Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (*User) ProtoMessage() {}
+func (TestResult_Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_ag_ag_proto_enumTypes[14].Descriptor()
+}
 
-func (x *User) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[0]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (TestResult_Status) Type() protoreflect.EnumType {
+	return &file_ag_ag_proto_enumTypes[14]
 }
 
-// Deprecated: Use User.ProtoReflect.Descriptor instead.
-func (*User) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{0}
+func (x TestResult_Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *User) GetID() uint64 {
-	if x != nil {
-		return x.ID
-	}
-	return 0
+// Deprecated: Use TestResult_Status.Descriptor instead.
+func (TestResult_Status) EnumDescriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{70, 0}
 }
 
-func (x *User) GetIsAdmin() bool {
-	if x != nil {
-		return x.IsAdmin
+type Appeal_Status int32
+
+const (
+	Appeal_OPEN     Appeal_Status = 0
+	Appeal_GRANTED  Appeal_Status = 1
+	Appeal_REJECTED Appeal_Status = 2
+)
+
+// Enum value maps for Appeal_Status.
+var (
+	Appeal_Status_name = map[int32]string{
+		0: "OPEN",
+		1: "GRANTED",
+		2: "REJECTED",
 	}
-	return false
+	Appeal_Status_value = map[string]int32{
+		"OPEN":     0,
+		"GRANTED":  1,
+		"REJECTED": 2,
+	}
+)
+
+func (x Appeal_Status) Enum() *Appeal_Status {
+	p := new(Appeal_Status)
+	*p = x
+	return p
 }
 
-func (x *User) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+func (x Appeal_Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *User) GetStudentID() string {
-	if x != nil {
-		return x.StudentID
-	}
-	return ""
+func (Appeal_Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_ag_ag_proto_enumTypes[15].Descriptor()
 }
 
-func (x *User) GetEmail() string {
-	if x != nil {
-		return x.Email
-	}
-	return ""
+func (Appeal_Status) Type() protoreflect.EnumType {
+	return &file_ag_ag_proto_enumTypes[15]
 }
 
-func (x *User) GetAvatarURL() string {
-	if x != nil {
-		return x.AvatarURL
-	}
-	return ""
+func (x Appeal_Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *User) GetLogin() string {
-	if x != nil {
-		return x.Login
-	}
-	return ""
+// Deprecated: Use Appeal_Status.Descriptor instead.
+func (Appeal_Status) EnumDescriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{79, 0}
 }
 
-func (x *User) GetRemoteIdentities() []*RemoteIdentity {
-	if x != nil {
-		return x.RemoteIdentities
+type TestJob_Status int32
+
+const (
+	TestJob_QUEUED  TestJob_Status = 0
+	TestJob_RUNNING TestJob_Status = 1
+)
+
+// Enum value maps for TestJob_Status.
+var (
+	TestJob_Status_name = map[int32]string{
+		0: "QUEUED",
+		1: "RUNNING",
 	}
-	return nil
+	TestJob_Status_value = map[string]int32{
+		"QUEUED":  0,
+		"RUNNING": 1,
+	}
+)
+
+func (x TestJob_Status) Enum() *TestJob_Status {
+	p := new(TestJob_Status)
+	*p = x
+	return p
 }
 
-func (x *User) GetEnrollments() []*Enrollment {
-	if x != nil {
-		return x.Enrollments
-	}
-	return nil
+func (x TestJob_Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-type Users struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (TestJob_Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_ag_ag_proto_enumTypes[16].Descriptor()
+}
 
-	Users []*User `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+func (TestJob_Status) Type() protoreflect.EnumType {
+	return &file_ag_ag_proto_enumTypes[16]
 }
 
-func (x *Users) Reset() {
-	*x = Users{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[1]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x TestJob_Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *Users) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Deprecated: Use TestJob_Status.Descriptor instead.
+func (TestJob_Status) EnumDescriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{85, 0}
 }
 
-func (*Users) ProtoMessage() {}
+// Priority determines the order in which queued jobs run; HIGH before NORMAL before LOW.
+type TestJob_Priority int32
 
-func (x *Users) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[1]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+const (
+	TestJob_NORMAL TestJob_Priority = 0 // tests of pushed submissions and rebuilds requested by students
+	TestJob_HIGH   TestJob_Priority = 1 // rebuilds of single submissions requested by teaching staff
+	TestJob_LOW    TestJob_Priority = 2 // rebuilds of all submissions for an assignment
+)
+
+// Enum value maps for TestJob_Priority.
+var (
+	TestJob_Priority_name = map[int32]string{
+		0: "NORMAL",
+		1: "HIGH",
+		2: "LOW",
 	}
-	return mi.MessageOf(x)
-}
+	TestJob_Priority_value = map[string]int32{
+		"NORMAL": 0,
+		"HIGH":   1,
+		"LOW":    2,
+	}
+)
 
-// Deprecated: Use Users.ProtoReflect.Descriptor instead.
-func (*Users) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{1}
+func (x TestJob_Priority) Enum() *TestJob_Priority {
+	p := new(TestJob_Priority)
+	*p = x
+	return p
 }
 
-func (x *Users) GetUsers() []*User {
-	if x != nil {
-		return x.Users
-	}
-	return nil
+func (x TestJob_Priority) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-type RemoteIdentity struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (TestJob_Priority) Descriptor() protoreflect.EnumDescriptor {
+	return file_ag_ag_proto_enumTypes[17].Descriptor()
+}
 
-	ID          uint64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	Provider    string `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty" gorm:"uniqueIndex:uid_provider_remote_id"`
-	RemoteID    uint64 `protobuf:"varint,3,opt,name=remoteID,proto3" json:"remoteID,omitempty" gorm:"uniqueIndex:uid_provider_remote_id"`
-	AccessToken string `protobuf:"bytes,4,opt,name=accessToken,proto3" json:"accessToken,omitempty"`
-	UserID      uint64 `protobuf:"varint,5,opt,name=userID,proto3" json:"userID,omitempty"`
+func (TestJob_Priority) Type() protoreflect.EnumType {
+	return &file_ag_ag_proto_enumTypes[17]
 }
 
-func (x *RemoteIdentity) Reset() {
-	*x = RemoteIdentity{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[2]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x TestJob_Priority) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *RemoteIdentity) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Deprecated: Use TestJob_Priority.Descriptor instead.
+func (TestJob_Priority) EnumDescriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{85, 1}
 }
 
-func (*RemoteIdentity) ProtoMessage() {}
+type WebhookDelivery_Status int32
 
-func (x *RemoteIdentity) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[2]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+const (
+	WebhookDelivery_PROCESSING WebhookDelivery_Status = 0
+	WebhookDelivery_PROCESSED  WebhookDelivery_Status = 1
+	WebhookDelivery_FAILED     WebhookDelivery_Status = 2
+)
+
+// Enum value maps for WebhookDelivery_Status.
+var (
+	WebhookDelivery_Status_name = map[int32]string{
+		0: "PROCESSING",
+		1: "PROCESSED",
+		2: "FAILED",
 	}
-	return mi.MessageOf(x)
+	WebhookDelivery_Status_value = map[string]int32{
+		"PROCESSING": 0,
+		"PROCESSED":  1,
+		"FAILED":     2,
+	}
+)
+
+func (x WebhookDelivery_Status) Enum() *WebhookDelivery_Status {
+	p := new(WebhookDelivery_Status)
+	*p = x
+	return p
 }
 
-// Deprecated: Use RemoteIdentity.ProtoReflect.Descriptor instead.
-func (*RemoteIdentity) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{2}
+func (x WebhookDelivery_Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *RemoteIdentity) GetID() uint64 {
-	if x != nil {
-		return x.ID
-	}
-	return 0
+func (WebhookDelivery_Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_ag_ag_proto_enumTypes[18].Descriptor()
 }
 
-func (x *RemoteIdentity) GetProvider() string {
-	if x != nil {
-		return x.Provider
-	}
-	return ""
+func (WebhookDelivery_Status) Type() protoreflect.EnumType {
+	return &file_ag_ag_proto_enumTypes[18]
 }
 
-func (x *RemoteIdentity) GetRemoteID() uint64 {
-	if x != nil {
-		return x.RemoteID
-	}
-	return 0
+func (x WebhookDelivery_Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *RemoteIdentity) GetAccessToken() string {
-	if x != nil {
-		return x.AccessToken
-	}
-	return ""
+// Deprecated: Use WebhookDelivery_Status.Descriptor instead.
+func (WebhookDelivery_Status) EnumDescriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{88, 0}
 }
 
-func (x *RemoteIdentity) GetUserID() uint64 {
-	if x != nil {
-		return x.UserID
+type PendingAction_Action int32
+
+const (
+	PendingAction_DELETE_COURSE  PendingAction_Action = 0
+	PendingAction_DELETE_GROUP   PendingAction_Action = 1 // group with submissions
+	PendingAction_DEMOTE_TEACHER PendingAction_Action = 2 // last teacher of the course
+)
+
+// Enum value maps for PendingAction_Action.
+var (
+	PendingAction_Action_name = map[int32]string{
+		0: "DELETE_COURSE",
+		1: "DELETE_GROUP",
+		2: "DEMOTE_TEACHER",
 	}
-	return 0
+	PendingAction_Action_value = map[string]int32{
+		"DELETE_COURSE":  0,
+		"DELETE_GROUP":   1,
+		"DEMOTE_TEACHER": 2,
+	}
+)
+
+func (x PendingAction_Action) Enum() *PendingAction_Action {
+	p := new(PendingAction_Action)
+	*p = x
+	return p
 }
 
-type Group struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x PendingAction_Action) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
 
-	ID          uint64            `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	Name        string            `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty" gorm:"uniqueIndex:idx_unique_group_name"`
-	CourseID    uint64            `protobuf:"varint,3,opt,name=courseID,proto3" json:"courseID,omitempty" gorm:"uniqueIndex:idx_unique_group_name"`
-	TeamID      uint64            `protobuf:"varint,4,opt,name=teamID,proto3" json:"teamID,omitempty"`
-	Status      Group_GroupStatus `protobuf:"varint,5,opt,name=status,proto3,enum=ag.Group_GroupStatus" json:"status,omitempty"`
-	Users       []*User           `protobuf:"bytes,6,rep,name=users,proto3" json:"users,omitempty" gorm:"many2many:group_users;"`
-	Enrollments []*Enrollment     `protobuf:"bytes,7,rep,name=enrollments,proto3" json:"enrollments,omitempty"`
+func (PendingAction_Action) Descriptor() protoreflect.EnumDescriptor {
+	return file_ag_ag_proto_enumTypes[19].Descriptor()
 }
 
-func (x *Group) Reset() {
-	*x = Group{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[3]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (PendingAction_Action) Type() protoreflect.EnumType {
+	return &file_ag_ag_proto_enumTypes[19]
 }
 
-func (x *Group) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x PendingAction_Action) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (*Group) ProtoMessage() {}
+// Deprecated: Use PendingAction_Action.Descriptor instead.
+func (PendingAction_Action) EnumDescriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{101, 0}
+}
 
-func (x *Group) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[3]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+type GradingCriterion_Grade int32
+
+const (
+	GradingCriterion_NONE   GradingCriterion_Grade = 0
+	GradingCriterion_FAILED GradingCriterion_Grade = 1
+	GradingCriterion_PASSED GradingCriterion_Grade = 2
+)
+
+// Enum value maps for GradingCriterion_Grade.
+var (
+	GradingCriterion_Grade_name = map[int32]string{
+		0: "NONE",
+		1: "FAILED",
+		2: "PASSED",
 	}
-	return mi.MessageOf(x)
+	GradingCriterion_Grade_value = map[string]int32{
+		"NONE":   0,
+		"FAILED": 1,
+		"PASSED": 2,
+	}
+)
+
+func (x GradingCriterion_Grade) Enum() *GradingCriterion_Grade {
+	p := new(GradingCriterion_Grade)
+	*p = x
+	return p
 }
 
-// Deprecated: Use Group.ProtoReflect.Descriptor instead.
-func (*Group) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{3}
+func (x GradingCriterion_Grade) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *Group) GetID() uint64 {
-	if x != nil {
-		return x.ID
-	}
-	return 0
+func (GradingCriterion_Grade) Descriptor() protoreflect.EnumDescriptor {
+	return file_ag_ag_proto_enumTypes[20].Descriptor()
 }
 
-func (x *Group) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+func (GradingCriterion_Grade) Type() protoreflect.EnumType {
+	return &file_ag_ag_proto_enumTypes[20]
 }
 
-func (x *Group) GetCourseID() uint64 {
-	if x != nil {
-		return x.CourseID
-	}
-	return 0
+func (x GradingCriterion_Grade) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *Group) GetTeamID() uint64 {
-	if x != nil {
-		return x.TeamID
-	}
-	return 0
+// Deprecated: Use GradingCriterion_Grade.Descriptor instead.
+func (GradingCriterion_Grade) EnumDescriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{108, 0}
 }
 
-func (x *Group) GetStatus() Group_GroupStatus {
-	if x != nil {
-		return x.Status
+type CourseEvent_Type int32
+
+const (
+	CourseEvent_NONE                CourseEvent_Type = 0
+	CourseEvent_ASSIGNMENT_RELEASED CourseEvent_Type = 1
+	CourseEvent_DEADLINE_CHANGED    CourseEvent_Type = 2
+)
+
+// Enum value maps for CourseEvent_Type.
+var (
+	CourseEvent_Type_name = map[int32]string{
+		0: "NONE",
+		1: "ASSIGNMENT_RELEASED",
+		2: "DEADLINE_CHANGED",
 	}
-	return Group_PENDING
+	CourseEvent_Type_value = map[string]int32{
+		"NONE":                0,
+		"ASSIGNMENT_RELEASED": 1,
+		"DEADLINE_CHANGED":    2,
+	}
+)
+
+func (x CourseEvent_Type) Enum() *CourseEvent_Type {
+	p := new(CourseEvent_Type)
+	*p = x
+	return p
 }
 
-func (x *Group) GetUsers() []*User {
-	if x != nil {
-		return x.Users
-	}
-	return nil
+func (x CourseEvent_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *Group) GetEnrollments() []*Enrollment {
-	if x != nil {
-		return x.Enrollments
-	}
-	return nil
+func (CourseEvent_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_ag_ag_proto_enumTypes[21].Descriptor()
 }
 
-type Groups struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (CourseEvent_Type) Type() protoreflect.EnumType {
+	return &file_ag_ag_proto_enumTypes[21]
+}
 
-	Groups []*Group `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+func (x CourseEvent_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *Groups) Reset() {
-	*x = Groups{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[4]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Deprecated: Use CourseEvent_Type.Descriptor instead.
+func (CourseEvent_Type) EnumDescriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{119, 0}
+}
+
+type Event_Type int32
+
+const (
+	Event_NONE                Event_Type = 0
+	Event_SUBMISSION_GRADED   Event_Type = 1 // the tests of the user's or the user's group's submission have finished
+	Event_ENROLLMENT_ACCEPTED Event_Type = 2 // the user's pending enrollment has been accepted
+	Event_GROUP_APPROVED      Event_Type = 3 // the user's group has been approved
+	Event_REVIEW_PUBLISHED    Event_Type = 4 // the review of the user's or the user's group's submission has been released
+)
+
+// Enum value maps for Event_Type.
+var (
+	Event_Type_name = map[int32]string{
+		0: "NONE",
+		1: "SUBMISSION_GRADED",
+		2: "ENROLLMENT_ACCEPTED",
+		3: "GROUP_APPROVED",
+		4: "REVIEW_PUBLISHED",
+	}
+	Event_Type_value = map[string]int32{
+		"NONE":                0,
+		"SUBMISSION_GRADED":   1,
+		"ENROLLMENT_ACCEPTED": 2,
+		"GROUP_APPROVED":      3,
+		"REVIEW_PUBLISHED":    4,
 	}
+)
+
+func (x Event_Type) Enum() *Event_Type {
+	p := new(Event_Type)
+	*p = x
+	return p
 }
 
-func (x *Groups) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x Event_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (*Groups) ProtoMessage() {}
+func (Event_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_ag_ag_proto_enumTypes[22].Descriptor()
+}
 
-func (x *Groups) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[4]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (Event_Type) Type() protoreflect.EnumType {
+	return &file_ag_ag_proto_enumTypes[22]
+}
+
+func (x Event_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Event_Type.Descriptor instead.
+func (Event_Type) EnumDescriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{144, 0}
+}
+
+type SubmissionStatus_State int32
+
+const (
+	SubmissionStatus_NONE    SubmissionStatus_State = 0 // no tests queued or running for the submission
+	SubmissionStatus_QUEUED  SubmissionStatus_State = 1
+	SubmissionStatus_RUNNING SubmissionStatus_State = 2
+)
+
+// Enum value maps for SubmissionStatus_State.
+var (
+	SubmissionStatus_State_name = map[int32]string{
+		0: "NONE",
+		1: "QUEUED",
+		2: "RUNNING",
 	}
-	return mi.MessageOf(x)
+	SubmissionStatus_State_value = map[string]int32{
+		"NONE":    0,
+		"QUEUED":  1,
+		"RUNNING": 2,
+	}
+)
+
+func (x SubmissionStatus_State) Enum() *SubmissionStatus_State {
+	p := new(SubmissionStatus_State)
+	*p = x
+	return p
 }
 
-// Deprecated: Use Groups.ProtoReflect.Descriptor instead.
-func (*Groups) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{4}
+func (x SubmissionStatus_State) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *Groups) GetGroups() []*Group {
-	if x != nil {
-		return x.Groups
+func (SubmissionStatus_State) Descriptor() protoreflect.EnumDescriptor {
+	return file_ag_ag_proto_enumTypes[23].Descriptor()
+}
+
+func (SubmissionStatus_State) Type() protoreflect.EnumType {
+	return &file_ag_ag_proto_enumTypes[23]
+}
+
+func (x SubmissionStatus_State) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SubmissionStatus_State.Descriptor instead.
+func (SubmissionStatus_State) EnumDescriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{146, 0}
+}
+
+type SubmissionsForCourseRequest_Type int32
+
+const (
+	SubmissionsForCourseRequest_ALL        SubmissionsForCourseRequest_Type = 0
+	SubmissionsForCourseRequest_INDIVIDUAL SubmissionsForCourseRequest_Type = 1
+	SubmissionsForCourseRequest_GROUP      SubmissionsForCourseRequest_Type = 2
+)
+
+// Enum value maps for SubmissionsForCourseRequest_Type.
+var (
+	SubmissionsForCourseRequest_Type_name = map[int32]string{
+		0: "ALL",
+		1: "INDIVIDUAL",
+		2: "GROUP",
 	}
-	return nil
+	SubmissionsForCourseRequest_Type_value = map[string]int32{
+		"ALL":        0,
+		"INDIVIDUAL": 1,
+		"GROUP":      2,
+	}
+)
+
+func (x SubmissionsForCourseRequest_Type) Enum() *SubmissionsForCourseRequest_Type {
+	p := new(SubmissionsForCourseRequest_Type)
+	*p = x
+	return p
 }
 
-type Course struct {
+func (x SubmissionsForCourseRequest_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SubmissionsForCourseRequest_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_ag_ag_proto_enumTypes[24].Descriptor()
+}
+
+func (SubmissionsForCourseRequest_Type) Type() protoreflect.EnumType {
+	return &file_ag_ag_proto_enumTypes[24]
+}
+
+func (x SubmissionsForCourseRequest_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SubmissionsForCourseRequest_Type.Descriptor instead.
+func (SubmissionsForCourseRequest_Type) EnumDescriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{170, 0}
+}
+
+type User struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID               uint64                `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	CourseCreatorID  uint64                `protobuf:"varint,2,opt,name=courseCreatorID,proto3" json:"courseCreatorID,omitempty"`
-	Name             string                `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Code             string                `protobuf:"bytes,4,opt,name=code,proto3" json:"code,omitempty"`
-	Year             uint32                `protobuf:"varint,5,opt,name=year,proto3" json:"year,omitempty"`
-	Tag              string                `protobuf:"bytes,6,opt,name=tag,proto3" json:"tag,omitempty"`
-	Provider         string                `protobuf:"bytes,7,opt,name=provider,proto3" json:"provider,omitempty"`
-	OrganizationID   uint64                `protobuf:"varint,8,opt,name=organizationID,proto3" json:"organizationID,omitempty"`
-	OrganizationPath string                `protobuf:"bytes,9,opt,name=organizationPath,proto3" json:"organizationPath,omitempty"` // The organization's SCM name, e.g. uis-dat520-2020.
-	SlipDays         uint32                `protobuf:"varint,10,opt,name=slipDays,proto3" json:"slipDays,omitempty"`
-	Dockerfile       string                `protobuf:"bytes,11,opt,name=dockerfile,proto3" json:"dockerfile,omitempty"`
-	Enrolled         Enrollment_UserStatus `protobuf:"varint,12,opt,name=enrolled,proto3,enum=ag.Enrollment_UserStatus" json:"enrolled,omitempty" gorm:"-"`
-	Enrollments      []*Enrollment         `protobuf:"bytes,13,rep,name=enrollments,proto3" json:"enrollments,omitempty"`
-	Assignments      []*Assignment         `protobuf:"bytes,14,rep,name=assignments,proto3" json:"assignments,omitempty"`
-	Groups           []*Group              `protobuf:"bytes,15,rep,name=groups,proto3" json:"groups,omitempty"`
+	ID               uint64            `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	IsAdmin          bool              `protobuf:"varint,2,opt,name=isAdmin,proto3" json:"isAdmin,omitempty"`
+	Name             string            `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	StudentID        string            `protobuf:"bytes,4,opt,name=studentID,proto3" json:"studentID,omitempty"`
+	Email            string            `protobuf:"bytes,5,opt,name=email,proto3" json:"email,omitempty"`
+	AvatarURL        string            `protobuf:"bytes,6,opt,name=avatarURL,proto3" json:"avatarURL,omitempty"`
+	Login            string            `protobuf:"bytes,7,opt,name=login,proto3" json:"login,omitempty"`
+	RemoteIdentities []*RemoteIdentity `protobuf:"bytes,8,rep,name=remoteIdentities,proto3" json:"remoteIdentities,omitempty"`
+	Enrollments      []*Enrollment     `protobuf:"bytes,9,rep,name=enrollments,proto3" json:"enrollments,omitempty"`
+	DeletedAt        DeletedAt         `protobuf:"varint,10,opt,name=deletedAt,proto3" json:"deletedAt,omitempty" gorm:"not null;default:0"` // deletion time; zero if not deleted
+	TenantID         uint64            `protobuf:"varint,11,opt,name=tenantID,proto3" json:"tenantID,omitempty" gorm:"index"`                // 0 => the user does not belong to a tenant
 }
 
-func (x *Course) Reset() {
-	*x = Course{}
+func (x *User) Reset() {
+	*x = User{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[5]
+		mi := &file_ag_ag_proto_msgTypes[0]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Course) String() string {
+func (x *User) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Course) ProtoMessage() {}
+func (*User) ProtoMessage() {}
 
-func (x *Course) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[5]
+func (x *User) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[0]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -808,141 +1349,114 @@ func (x *Course) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Course.ProtoReflect.Descriptor instead.
-func (*Course) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use User.ProtoReflect.Descriptor instead.
+func (*User) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{0}
 }
 
-func (x *Course) GetID() uint64 {
+func (x *User) GetID() uint64 {
 	if x != nil {
 		return x.ID
 	}
 	return 0
 }
 
-func (x *Course) GetCourseCreatorID() uint64 {
+func (x *User) GetIsAdmin() bool {
 	if x != nil {
-		return x.CourseCreatorID
+		return x.IsAdmin
 	}
-	return 0
+	return false
 }
 
-func (x *Course) GetName() string {
+func (x *User) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *Course) GetCode() string {
+func (x *User) GetStudentID() string {
 	if x != nil {
-		return x.Code
+		return x.StudentID
 	}
 	return ""
 }
 
-func (x *Course) GetYear() uint32 {
+func (x *User) GetEmail() string {
 	if x != nil {
-		return x.Year
-	}
-	return 0
-}
-
-func (x *Course) GetTag() string {
-	if x != nil {
-		return x.Tag
-	}
-	return ""
-}
-
-func (x *Course) GetProvider() string {
-	if x != nil {
-		return x.Provider
+		return x.Email
 	}
 	return ""
 }
 
-func (x *Course) GetOrganizationID() uint64 {
-	if x != nil {
-		return x.OrganizationID
-	}
-	return 0
-}
-
-func (x *Course) GetOrganizationPath() string {
+func (x *User) GetAvatarURL() string {
 	if x != nil {
-		return x.OrganizationPath
+		return x.AvatarURL
 	}
 	return ""
 }
 
-func (x *Course) GetSlipDays() uint32 {
-	if x != nil {
-		return x.SlipDays
-	}
-	return 0
-}
-
-func (x *Course) GetDockerfile() string {
+func (x *User) GetLogin() string {
 	if x != nil {
-		return x.Dockerfile
+		return x.Login
 	}
 	return ""
 }
 
-func (x *Course) GetEnrolled() Enrollment_UserStatus {
+func (x *User) GetRemoteIdentities() []*RemoteIdentity {
 	if x != nil {
-		return x.Enrolled
+		return x.RemoteIdentities
 	}
-	return Enrollment_NONE
+	return nil
 }
 
-func (x *Course) GetEnrollments() []*Enrollment {
+func (x *User) GetEnrollments() []*Enrollment {
 	if x != nil {
 		return x.Enrollments
 	}
 	return nil
 }
 
-func (x *Course) GetAssignments() []*Assignment {
+func (x *User) GetDeletedAt() DeletedAt {
 	if x != nil {
-		return x.Assignments
+		return x.DeletedAt
 	}
-	return nil
+	return 0
 }
 
-func (x *Course) GetGroups() []*Group {
+func (x *User) GetTenantID() uint64 {
 	if x != nil {
-		return x.Groups
+		return x.TenantID
 	}
-	return nil
+	return 0
 }
 
-type Courses struct {
+type Users struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Courses []*Course `protobuf:"bytes,1,rep,name=courses,proto3" json:"courses,omitempty"`
+	Users         []*User `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	NextPageToken string  `protobuf:"bytes,2,opt,name=nextPageToken,proto3" json:"nextPageToken,omitempty"` // token for the next page; empty if this is the last page
 }
 
-func (x *Courses) Reset() {
-	*x = Courses{}
+func (x *Users) Reset() {
+	*x = Users{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[6]
+		mi := &file_ag_ag_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Courses) String() string {
+func (x *Users) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Courses) ProtoMessage() {}
+func (*Users) ProtoMessage() {}
 
-func (x *Courses) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[6]
+func (x *Users) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -953,49 +1467,53 @@ func (x *Courses) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Courses.ProtoReflect.Descriptor instead.
-func (*Courses) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use Users.ProtoReflect.Descriptor instead.
+func (*Users) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *Courses) GetCourses() []*Course {
+func (x *Users) GetUsers() []*User {
 	if x != nil {
-		return x.Courses
+		return x.Users
 	}
 	return nil
 }
 
-type Repository struct {
+func (x *Users) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// UsersRequest is a request for all users. If pageSize is set, at most
+// pageSize users are returned, starting at the page given by pageToken.
+type UsersRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID             uint64          `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	OrganizationID uint64          `protobuf:"varint,2,opt,name=organizationID,proto3" json:"organizationID,omitempty" gorm:"uniqueIndex:uid_gid_org_type"`
-	RepositoryID   uint64          `protobuf:"varint,3,opt,name=repositoryID,proto3" json:"repositoryID,omitempty"` // ID of a github repository
-	UserID         uint64          `protobuf:"varint,4,opt,name=userID,proto3" json:"userID,omitempty" gorm:"uniqueIndex:uid_gid_org_type"`
-	GroupID        uint64          `protobuf:"varint,5,opt,name=groupID,proto3" json:"groupID,omitempty" gorm:"uniqueIndex:uid_gid_org_type"`
-	HTMLURL        string          `protobuf:"bytes,6,opt,name=HTMLURL,proto3" json:"HTMLURL,omitempty"`
-	RepoType       Repository_Type `protobuf:"varint,7,opt,name=repoType,proto3,enum=ag.Repository_Type" json:"repoType,omitempty" gorm:"uniqueIndex:uid_gid_org_type"`
+	PageSize  uint32 `protobuf:"varint,1,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	PageToken string `protobuf:"bytes,2,opt,name=pageToken,proto3" json:"pageToken,omitempty"`
 }
 
-func (x *Repository) Reset() {
-	*x = Repository{}
+func (x *UsersRequest) Reset() {
+	*x = UsersRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[7]
+		mi := &file_ag_ag_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Repository) String() string {
+func (x *UsersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Repository) ProtoMessage() {}
+func (*UsersRequest) ProtoMessage() {}
 
-func (x *Repository) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[7]
+func (x *UsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1006,98 +1524,65 @@ func (x *Repository) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Repository.ProtoReflect.Descriptor instead.
-func (*Repository) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{7}
-}
-
-func (x *Repository) GetID() uint64 {
-	if x != nil {
-		return x.ID
-	}
-	return 0
-}
-
-func (x *Repository) GetOrganizationID() uint64 {
-	if x != nil {
-		return x.OrganizationID
-	}
-	return 0
-}
-
-func (x *Repository) GetRepositoryID() uint64 {
-	if x != nil {
-		return x.RepositoryID
-	}
-	return 0
-}
-
-func (x *Repository) GetUserID() uint64 {
-	if x != nil {
-		return x.UserID
-	}
-	return 0
+// Deprecated: Use UsersRequest.ProtoReflect.Descriptor instead.
+func (*UsersRequest) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *Repository) GetGroupID() uint64 {
+func (x *UsersRequest) GetPageSize() uint32 {
 	if x != nil {
-		return x.GroupID
+		return x.PageSize
 	}
 	return 0
 }
 
-func (x *Repository) GetHTMLURL() string {
+func (x *UsersRequest) GetPageToken() string {
 	if x != nil {
-		return x.HTMLURL
+		return x.PageToken
 	}
 	return ""
 }
 
-func (x *Repository) GetRepoType() Repository_Type {
-	if x != nil {
-		return x.RepoType
-	}
-	return Repository_NONE
-}
-
-type Enrollment struct {
+// UserData holds the data stored about a user, exported on request by the user or an admin.
+type UserData struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID                uint64                  `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	CourseID          uint64                  `protobuf:"varint,2,opt,name=courseID,proto3" json:"courseID,omitempty" gorm:"uniqueIndex:idx_unique_enrollment"`
-	UserID            uint64                  `protobuf:"varint,3,opt,name=userID,proto3" json:"userID,omitempty" gorm:"uniqueIndex:idx_unique_enrollment"`
-	GroupID           uint64                  `protobuf:"varint,4,opt,name=groupID,proto3" json:"groupID,omitempty"`
-	HasTeacherScopes  bool                    `protobuf:"varint,5,opt,name=hasTeacherScopes,proto3" json:"hasTeacherScopes,omitempty"`
-	User              *User                   `protobuf:"bytes,6,opt,name=user,proto3" json:"user,omitempty"`
-	Course            *Course                 `protobuf:"bytes,7,opt,name=course,proto3" json:"course,omitempty"`
-	Group             *Group                  `protobuf:"bytes,8,opt,name=group,proto3" json:"group,omitempty"`
-	Status            Enrollment_UserStatus   `protobuf:"varint,9,opt,name=status,proto3,enum=ag.Enrollment_UserStatus" json:"status,omitempty"`
-	State             Enrollment_DisplayState `protobuf:"varint,10,opt,name=state,proto3,enum=ag.Enrollment_DisplayState" json:"state,omitempty"`
-	SlipDaysRemaining uint32                  `protobuf:"varint,11,opt,name=slipDaysRemaining,proto3" json:"slipDaysRemaining,omitempty" gorm:"-"`
-	LastActivityDate  string                  `protobuf:"bytes,12,opt,name=lastActivityDate,proto3" json:"lastActivityDate,omitempty"`
-	TotalApproved     uint64                  `protobuf:"varint,13,opt,name=totalApproved,proto3" json:"totalApproved,omitempty"`
-	UsedSlipDays      []*UsedSlipDays         `protobuf:"bytes,14,rep,name=usedSlipDays,proto3" json:"usedSlipDays,omitempty"`
-}
-
-func (x *Enrollment) Reset() {
-	*x = Enrollment{}
+	User           *User            `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`               // with remote identities, without access tokens
+	Enrollments    []*Enrollment    `protobuf:"bytes,2,rep,name=enrollments,proto3" json:"enrollments,omitempty"` // with used slip days
+	Groups         []*Group         `protobuf:"bytes,3,rep,name=groups,proto3" json:"groups,omitempty"`
+	Submissions    []*Submission    `protobuf:"bytes,4,rep,name=submissions,proto3" json:"submissions,omitempty"` // submissions by the user and the user's groups
+	PeerReviews    []*PeerReview    `protobuf:"bytes,5,rep,name=peerReviews,proto3" json:"peerReviews,omitempty"` // peer reviews written by the user
+	Appeals        []*Appeal        `protobuf:"bytes,6,rep,name=appeals,proto3" json:"appeals,omitempty"`
+	Extensions     []*Extension     `protobuf:"bytes,7,rep,name=extensions,proto3" json:"extensions,omitempty"` // extensions granted to the user
+	Questions      []*Question      `protobuf:"bytes,8,rep,name=questions,proto3" json:"questions,omitempty"`   // questions asked by the user
+	Answers        []*Answer        `protobuf:"bytes,9,rep,name=answers,proto3" json:"answers,omitempty"`       // answers written by the user
+	Certificates   []*Certificate   `protobuf:"bytes,10,rep,name=certificates,proto3" json:"certificates,omitempty"`
+	Notifications  []*Notification  `protobuf:"bytes,11,rep,name=notifications,proto3" json:"notifications,omitempty"`
+	AccessTokens   []*AccessToken   `protobuf:"bytes,12,rep,name=accessTokens,proto3" json:"accessTokens,omitempty"` // without token hashes
+	PreEnrollments []*PreEnrollment `protobuf:"bytes,13,rep,name=preEnrollments,proto3" json:"preEnrollments,omitempty"`
+	AuditEntries   []*AuditEntry    `protobuf:"bytes,14,rep,name=auditEntries,proto3" json:"auditEntries,omitempty"` // actions performed by the user
+	Exported       string           `protobuf:"bytes,15,opt,name=exported,proto3" json:"exported,omitempty"`
+}
+
+func (x *UserData) Reset() {
+	*x = UserData{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[8]
+		mi := &file_ag_ag_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Enrollment) String() string {
+func (x *UserData) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Enrollment) ProtoMessage() {}
+func (*UserData) ProtoMessage() {}
 
-func (x *Enrollment) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[8]
+func (x *UserData) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1108,137 +1593,146 @@ func (x *Enrollment) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Enrollment.ProtoReflect.Descriptor instead.
-func (*Enrollment) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use UserData.ProtoReflect.Descriptor instead.
+func (*UserData) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *Enrollment) GetID() uint64 {
+func (x *UserData) GetUser() *User {
 	if x != nil {
-		return x.ID
+		return x.User
 	}
-	return 0
+	return nil
 }
 
-func (x *Enrollment) GetCourseID() uint64 {
+func (x *UserData) GetEnrollments() []*Enrollment {
 	if x != nil {
-		return x.CourseID
+		return x.Enrollments
 	}
-	return 0
+	return nil
 }
 
-func (x *Enrollment) GetUserID() uint64 {
+func (x *UserData) GetGroups() []*Group {
 	if x != nil {
-		return x.UserID
+		return x.Groups
 	}
-	return 0
+	return nil
 }
 
-func (x *Enrollment) GetGroupID() uint64 {
+func (x *UserData) GetSubmissions() []*Submission {
 	if x != nil {
-		return x.GroupID
+		return x.Submissions
 	}
-	return 0
+	return nil
 }
 
-func (x *Enrollment) GetHasTeacherScopes() bool {
+func (x *UserData) GetPeerReviews() []*PeerReview {
 	if x != nil {
-		return x.HasTeacherScopes
+		return x.PeerReviews
 	}
-	return false
+	return nil
 }
 
-func (x *Enrollment) GetUser() *User {
+func (x *UserData) GetAppeals() []*Appeal {
 	if x != nil {
-		return x.User
+		return x.Appeals
 	}
 	return nil
 }
 
-func (x *Enrollment) GetCourse() *Course {
+func (x *UserData) GetExtensions() []*Extension {
 	if x != nil {
-		return x.Course
+		return x.Extensions
 	}
 	return nil
 }
 
-func (x *Enrollment) GetGroup() *Group {
+func (x *UserData) GetQuestions() []*Question {
 	if x != nil {
-		return x.Group
+		return x.Questions
 	}
 	return nil
 }
 
-func (x *Enrollment) GetStatus() Enrollment_UserStatus {
+func (x *UserData) GetAnswers() []*Answer {
 	if x != nil {
-		return x.Status
+		return x.Answers
 	}
-	return Enrollment_NONE
+	return nil
 }
 
-func (x *Enrollment) GetState() Enrollment_DisplayState {
+func (x *UserData) GetCertificates() []*Certificate {
 	if x != nil {
-		return x.State
+		return x.Certificates
 	}
-	return Enrollment_UNSET
+	return nil
 }
 
-func (x *Enrollment) GetSlipDaysRemaining() uint32 {
+func (x *UserData) GetNotifications() []*Notification {
 	if x != nil {
-		return x.SlipDaysRemaining
+		return x.Notifications
 	}
-	return 0
+	return nil
 }
 
-func (x *Enrollment) GetLastActivityDate() string {
+func (x *UserData) GetAccessTokens() []*AccessToken {
 	if x != nil {
-		return x.LastActivityDate
+		return x.AccessTokens
 	}
-	return ""
+	return nil
 }
 
-func (x *Enrollment) GetTotalApproved() uint64 {
+func (x *UserData) GetPreEnrollments() []*PreEnrollment {
 	if x != nil {
-		return x.TotalApproved
+		return x.PreEnrollments
 	}
-	return 0
+	return nil
 }
 
-func (x *Enrollment) GetUsedSlipDays() []*UsedSlipDays {
+func (x *UserData) GetAuditEntries() []*AuditEntry {
 	if x != nil {
-		return x.UsedSlipDays
+		return x.AuditEntries
 	}
 	return nil
 }
 
-type UsedSlipDays struct {
+func (x *UserData) GetExported() string {
+	if x != nil {
+		return x.Exported
+	}
+	return ""
+}
+
+type RemoteIdentity struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID           uint64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	EnrollmentID uint64 `protobuf:"varint,2,opt,name=enrollmentID,proto3" json:"enrollmentID,omitempty"`
-	AssignmentID uint64 `protobuf:"varint,3,opt,name=assignmentID,proto3" json:"assignmentID,omitempty"`
-	UsedSlipDays uint32 `protobuf:"varint,4,opt,name=usedSlipDays,proto3" json:"usedSlipDays,omitempty"`
+	ID          uint64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Provider    string `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty" gorm:"uniqueIndex:uid_provider_remote_id;size:255"`
+	RemoteID    uint64 `protobuf:"varint,3,opt,name=remoteID,proto3" json:"remoteID,omitempty" gorm:"uniqueIndex:uid_provider_remote_id"`
+	AccessToken string `protobuf:"bytes,4,opt,name=accessToken,proto3" json:"accessToken,omitempty"`
+	UserID      uint64 `protobuf:"varint,5,opt,name=userID,proto3" json:"userID,omitempty"`
+	Login       string `protobuf:"bytes,6,opt,name=login,proto3" json:"login,omitempty"` // the user's login on the provider; if empty, the user's login is used
 }
 
-func (x *UsedSlipDays) Reset() {
-	*x = UsedSlipDays{}
+func (x *RemoteIdentity) Reset() {
+	*x = RemoteIdentity{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[9]
+		mi := &file_ag_ag_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UsedSlipDays) String() string {
+func (x *RemoteIdentity) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UsedSlipDays) ProtoMessage() {}
+func (*RemoteIdentity) ProtoMessage() {}
 
-func (x *UsedSlipDays) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[9]
+func (x *RemoteIdentity) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1249,64 +1743,78 @@ func (x *UsedSlipDays) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UsedSlipDays.ProtoReflect.Descriptor instead.
-func (*UsedSlipDays) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use RemoteIdentity.ProtoReflect.Descriptor instead.
+func (*RemoteIdentity) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *UsedSlipDays) GetID() uint64 {
+func (x *RemoteIdentity) GetID() uint64 {
 	if x != nil {
 		return x.ID
 	}
 	return 0
 }
 
-func (x *UsedSlipDays) GetEnrollmentID() uint64 {
+func (x *RemoteIdentity) GetProvider() string {
 	if x != nil {
-		return x.EnrollmentID
+		return x.Provider
 	}
-	return 0
+	return ""
 }
 
-func (x *UsedSlipDays) GetAssignmentID() uint64 {
+func (x *RemoteIdentity) GetRemoteID() uint64 {
 	if x != nil {
-		return x.AssignmentID
+		return x.RemoteID
 	}
 	return 0
 }
 
-func (x *UsedSlipDays) GetUsedSlipDays() uint32 {
+func (x *RemoteIdentity) GetAccessToken() string {
 	if x != nil {
-		return x.UsedSlipDays
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *RemoteIdentity) GetUserID() uint64 {
+	if x != nil {
+		return x.UserID
 	}
 	return 0
 }
 
-type Enrollments struct {
+func (x *RemoteIdentity) GetLogin() string {
+	if x != nil {
+		return x.Login
+	}
+	return ""
+}
+
+type RemoteIdentities struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Enrollments []*Enrollment `protobuf:"bytes,1,rep,name=enrollments,proto3" json:"enrollments,omitempty"`
+	Identities []*RemoteIdentity `protobuf:"bytes,1,rep,name=identities,proto3" json:"identities,omitempty"`
 }
 
-func (x *Enrollments) Reset() {
-	*x = Enrollments{}
+func (x *RemoteIdentities) Reset() {
+	*x = RemoteIdentities{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[10]
+		mi := &file_ag_ag_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Enrollments) String() string {
+func (x *RemoteIdentities) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Enrollments) ProtoMessage() {}
+func (*RemoteIdentities) ProtoMessage() {}
 
-func (x *Enrollments) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[10]
+func (x *RemoteIdentities) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1317,44 +1825,44 @@ func (x *Enrollments) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Enrollments.ProtoReflect.Descriptor instead.
-func (*Enrollments) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use RemoteIdentities.ProtoReflect.Descriptor instead.
+func (*RemoteIdentities) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *Enrollments) GetEnrollments() []*Enrollment {
+func (x *RemoteIdentities) GetIdentities() []*RemoteIdentity {
 	if x != nil {
-		return x.Enrollments
+		return x.Identities
 	}
 	return nil
 }
 
-type SubmissionLink struct {
+// UnlinkIdentityRequest is a request to remove the current user's remote identity for the given provider.
+type UnlinkIdentityRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Assignment *Assignment `protobuf:"bytes,1,opt,name=assignment,proto3" json:"assignment,omitempty"`
-	Submission *Submission `protobuf:"bytes,2,opt,name=submission,proto3" json:"submission,omitempty"`
+	Provider string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
 }
 
-func (x *SubmissionLink) Reset() {
-	*x = SubmissionLink{}
+func (x *UnlinkIdentityRequest) Reset() {
+	*x = UnlinkIdentityRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[11]
+		mi := &file_ag_ag_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SubmissionLink) String() string {
+func (x *UnlinkIdentityRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubmissionLink) ProtoMessage() {}
+func (*UnlinkIdentityRequest) ProtoMessage() {}
 
-func (x *SubmissionLink) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[11]
+func (x *UnlinkIdentityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1365,51 +1873,50 @@ func (x *SubmissionLink) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubmissionLink.ProtoReflect.Descriptor instead.
-func (*SubmissionLink) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{11}
-}
-
-func (x *SubmissionLink) GetAssignment() *Assignment {
-	if x != nil {
-		return x.Assignment
-	}
-	return nil
+// Deprecated: Use UnlinkIdentityRequest.ProtoReflect.Descriptor instead.
+func (*UnlinkIdentityRequest) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *SubmissionLink) GetSubmission() *Submission {
+func (x *UnlinkIdentityRequest) GetProvider() string {
 	if x != nil {
-		return x.Submission
+		return x.Provider
 	}
-	return nil
+	return ""
 }
 
-type EnrollmentLink struct {
+type Group struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Enrollment  *Enrollment       `protobuf:"bytes,2,opt,name=enrollment,proto3" json:"enrollment,omitempty"` // must have course and user/group preloaded
-	Submissions []*SubmissionLink `protobuf:"bytes,3,rep,name=submissions,proto3" json:"submissions,omitempty"`
+	ID          uint64            `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Name        string            `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty" gorm:"uniqueIndex:idx_unique_group_name;size:255"`
+	CourseID    uint64            `protobuf:"varint,3,opt,name=courseID,proto3" json:"courseID,omitempty" gorm:"uniqueIndex:idx_unique_group_name"`
+	TeamID      uint64            `protobuf:"varint,4,opt,name=teamID,proto3" json:"teamID,omitempty"`
+	Status      Group_GroupStatus `protobuf:"varint,5,opt,name=status,proto3,enum=ag.Group_GroupStatus" json:"status,omitempty"`
+	Users       []*User           `protobuf:"bytes,6,rep,name=users,proto3" json:"users,omitempty" gorm:"many2many:group_users;"`
+	Enrollments []*Enrollment     `protobuf:"bytes,7,rep,name=enrollments,proto3" json:"enrollments,omitempty"`
+	DeletedAt   DeletedAt         `protobuf:"varint,8,opt,name=deletedAt,proto3" json:"deletedAt,omitempty" gorm:"not null;default:0"` // deletion time; zero if not deleted
 }
 
-func (x *EnrollmentLink) Reset() {
-	*x = EnrollmentLink{}
+func (x *Group) Reset() {
+	*x = Group{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[12]
+		mi := &file_ag_ag_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EnrollmentLink) String() string {
+func (x *Group) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EnrollmentLink) ProtoMessage() {}
+func (*Group) ProtoMessage() {}
 
-func (x *EnrollmentLink) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[12]
+func (x *Group) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1420,51 +1927,92 @@ func (x *EnrollmentLink) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EnrollmentLink.ProtoReflect.Descriptor instead.
-func (*EnrollmentLink) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use Group.ProtoReflect.Descriptor instead.
+func (*Group) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *EnrollmentLink) GetEnrollment() *Enrollment {
+func (x *Group) GetID() uint64 {
 	if x != nil {
-		return x.Enrollment
+		return x.ID
+	}
+	return 0
+}
+
+func (x *Group) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Group) GetCourseID() uint64 {
+	if x != nil {
+		return x.CourseID
+	}
+	return 0
+}
+
+func (x *Group) GetTeamID() uint64 {
+	if x != nil {
+		return x.TeamID
+	}
+	return 0
+}
+
+func (x *Group) GetStatus() Group_GroupStatus {
+	if x != nil {
+		return x.Status
+	}
+	return Group_PENDING
+}
+
+func (x *Group) GetUsers() []*User {
+	if x != nil {
+		return x.Users
 	}
 	return nil
 }
 
-func (x *EnrollmentLink) GetSubmissions() []*SubmissionLink {
+func (x *Group) GetEnrollments() []*Enrollment {
 	if x != nil {
-		return x.Submissions
+		return x.Enrollments
 	}
 	return nil
 }
 
-type CourseSubmissions struct {
+func (x *Group) GetDeletedAt() DeletedAt {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return 0
+}
+
+type Groups struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Course *Course           `protobuf:"bytes,1,opt,name=course,proto3" json:"course,omitempty"` // preloaded assignments
-	Links  []*EnrollmentLink `protobuf:"bytes,2,rep,name=links,proto3" json:"links,omitempty"`
+	Groups []*Group `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
 }
 
-func (x *CourseSubmissions) Reset() {
-	*x = CourseSubmissions{}
+func (x *Groups) Reset() {
+	*x = Groups{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[13]
+		mi := &file_ag_ag_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CourseSubmissions) String() string {
+func (x *Groups) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CourseSubmissions) ProtoMessage() {}
+func (*Groups) ProtoMessage() {}
 
-func (x *CourseSubmissions) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[13]
+func (x *Groups) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1475,62 +2023,53 @@ func (x *CourseSubmissions) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CourseSubmissions.ProtoReflect.Descriptor instead.
-func (*CourseSubmissions) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{13}
-}
-
-func (x *CourseSubmissions) GetCourse() *Course {
-	if x != nil {
-		return x.Course
-	}
-	return nil
+// Deprecated: Use Groups.ProtoReflect.Descriptor instead.
+func (*Groups) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *CourseSubmissions) GetLinks() []*EnrollmentLink {
+func (x *Groups) GetGroups() []*Group {
 	if x != nil {
-		return x.Links
+		return x.Groups
 	}
 	return nil
 }
 
-type Assignment struct {
+// GroupInvitation invites a student to join a group created by another student.
+// The group is submitted for approval when none of its invitations are pending.
+type GroupInvitation struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID                uint64              `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	CourseID          uint64              `protobuf:"varint,2,opt,name=CourseID,proto3" json:"CourseID,omitempty"` // foreign key
-	Name              string              `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	ScriptFile        string              `protobuf:"bytes,4,opt,name=scriptFile,proto3" json:"scriptFile,omitempty"`
-	Deadline          string              `protobuf:"bytes,5,opt,name=deadline,proto3" json:"deadline,omitempty"`
-	AutoApprove       bool                `protobuf:"varint,6,opt,name=autoApprove,proto3" json:"autoApprove,omitempty"`
-	Order             uint32              `protobuf:"varint,7,opt,name=order,proto3" json:"order,omitempty"`
-	IsGroupLab        bool                `protobuf:"varint,8,opt,name=isGroupLab,proto3" json:"isGroupLab,omitempty"`
-	ScoreLimit        uint32              `protobuf:"varint,9,opt,name=scoreLimit,proto3" json:"scoreLimit,omitempty"`               // minimal score limit for auto approval
-	Reviewers         uint32              `protobuf:"varint,10,opt,name=reviewers,proto3" json:"reviewers,omitempty"`                // number of reviewers that will review submissions for this assignment
-	Submissions       []*Submission       `protobuf:"bytes,11,rep,name=submissions,proto3" json:"submissions,omitempty"`             // submissions produced for this assignment
-	GradingBenchmarks []*GradingBenchmark `protobuf:"bytes,12,rep,name=gradingBenchmarks,proto3" json:"gradingBenchmarks,omitempty"` // grading benchmarks for this assignment
-	ContainerTimeout  uint32              `protobuf:"varint,13,opt,name=containerTimeout,proto3" json:"containerTimeout,omitempty"`  // TODO(meling) Do we need this?
+	ID        uint64                 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	CourseID  uint64                 `protobuf:"varint,2,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	GroupID   uint64                 `protobuf:"varint,3,opt,name=groupID,proto3" json:"groupID,omitempty" gorm:"index"`
+	UserID    uint64                 `protobuf:"varint,4,opt,name=userID,proto3" json:"userID,omitempty"`       // the invited student
+	InviterID uint64                 `protobuf:"varint,5,opt,name=inviterID,proto3" json:"inviterID,omitempty"` // the group member sending the invitation
+	Status    GroupInvitation_Status `protobuf:"varint,6,opt,name=status,proto3,enum=ag.GroupInvitation_Status" json:"status,omitempty"`
+	Created   string                 `protobuf:"bytes,7,opt,name=created,proto3" json:"created,omitempty"`
+	Expires   string                 `protobuf:"bytes,8,opt,name=expires,proto3" json:"expires,omitempty"`              // time when the invitation expires if it is still pending
+	GroupName string                 `protobuf:"bytes,9,opt,name=groupName,proto3" json:"groupName,omitempty" gorm:"-"` // name of the group
 }
 
-func (x *Assignment) Reset() {
-	*x = Assignment{}
+func (x *GroupInvitation) Reset() {
+	*x = GroupInvitation{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[14]
+		mi := &file_ag_ag_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Assignment) String() string {
+func (x *GroupInvitation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Assignment) ProtoMessage() {}
+func (*GroupInvitation) ProtoMessage() {}
 
-func (x *Assignment) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[14]
+func (x *GroupInvitation) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1541,127 +2080,99 @@ func (x *Assignment) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Assignment.ProtoReflect.Descriptor instead.
-func (*Assignment) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use GroupInvitation.ProtoReflect.Descriptor instead.
+func (*GroupInvitation) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *Assignment) GetID() uint64 {
+func (x *GroupInvitation) GetID() uint64 {
 	if x != nil {
 		return x.ID
 	}
 	return 0
 }
 
-func (x *Assignment) GetCourseID() uint64 {
+func (x *GroupInvitation) GetCourseID() uint64 {
 	if x != nil {
 		return x.CourseID
 	}
 	return 0
 }
 
-func (x *Assignment) GetName() string {
+func (x *GroupInvitation) GetGroupID() uint64 {
 	if x != nil {
-		return x.Name
+		return x.GroupID
 	}
-	return ""
+	return 0
 }
 
-func (x *Assignment) GetScriptFile() string {
+func (x *GroupInvitation) GetUserID() uint64 {
 	if x != nil {
-		return x.ScriptFile
+		return x.UserID
 	}
-	return ""
+	return 0
 }
 
-func (x *Assignment) GetDeadline() string {
+func (x *GroupInvitation) GetInviterID() uint64 {
 	if x != nil {
-		return x.Deadline
+		return x.InviterID
 	}
-	return ""
+	return 0
 }
 
-func (x *Assignment) GetAutoApprove() bool {
+func (x *GroupInvitation) GetStatus() GroupInvitation_Status {
 	if x != nil {
-		return x.AutoApprove
+		return x.Status
 	}
-	return false
+	return GroupInvitation_PENDING
 }
 
-func (x *Assignment) GetOrder() uint32 {
+func (x *GroupInvitation) GetCreated() string {
 	if x != nil {
-		return x.Order
+		return x.Created
 	}
-	return 0
+	return ""
 }
 
-func (x *Assignment) GetIsGroupLab() bool {
+func (x *GroupInvitation) GetExpires() string {
 	if x != nil {
-		return x.IsGroupLab
+		return x.Expires
 	}
-	return false
+	return ""
 }
 
-func (x *Assignment) GetScoreLimit() uint32 {
+func (x *GroupInvitation) GetGroupName() string {
 	if x != nil {
-		return x.ScoreLimit
+		return x.GroupName
 	}
-	return 0
+	return ""
 }
 
-func (x *Assignment) GetReviewers() uint32 {
-	if x != nil {
-		return x.Reviewers
-	}
-	return 0
+type GroupInvitations struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Invitations []*GroupInvitation `protobuf:"bytes,1,rep,name=invitations,proto3" json:"invitations,omitempty"`
 }
 
-func (x *Assignment) GetSubmissions() []*Submission {
-	if x != nil {
-		return x.Submissions
+func (x *GroupInvitations) Reset() {
+	*x = GroupInvitations{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *Assignment) GetGradingBenchmarks() []*GradingBenchmark {
-	if x != nil {
-		return x.GradingBenchmarks
-	}
-	return nil
-}
-
-func (x *Assignment) GetContainerTimeout() uint32 {
-	if x != nil {
-		return x.ContainerTimeout
-	}
-	return 0
-}
-
-type Assignments struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Assignments []*Assignment `protobuf:"bytes,1,rep,name=assignments,proto3" json:"assignments,omitempty"`
-}
-
-func (x *Assignments) Reset() {
-	*x = Assignments{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[15]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *Assignments) String() string {
+func (x *GroupInvitations) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Assignments) ProtoMessage() {}
+func (*GroupInvitations) ProtoMessage() {}
 
-func (x *Assignments) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[15]
+func (x *GroupInvitations) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1672,54 +2183,48 @@ func (x *Assignments) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Assignments.ProtoReflect.Descriptor instead.
-func (*Assignments) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use GroupInvitations.ProtoReflect.Descriptor instead.
+func (*GroupInvitations) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *Assignments) GetAssignments() []*Assignment {
+func (x *GroupInvitations) GetInvitations() []*GroupInvitation {
 	if x != nil {
-		return x.Assignments
+		return x.Invitations
 	}
 	return nil
 }
 
-type Submission struct {
+// GenerateGroupsRequest is a request to partition the course's accepted students
+// that are not in a group into approved groups of at most the given size.
+type GenerateGroupsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID           uint64            `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	AssignmentID uint64            `protobuf:"varint,2,opt,name=AssignmentID,proto3" json:"AssignmentID,omitempty"` // foreign key
-	UserID       uint64            `protobuf:"varint,3,opt,name=userID,proto3" json:"userID,omitempty"`
-	GroupID      uint64            `protobuf:"varint,4,opt,name=groupID,proto3" json:"groupID,omitempty"`
-	Score        uint32            `protobuf:"varint,5,opt,name=score,proto3" json:"score,omitempty"`
-	CommitHash   string            `protobuf:"bytes,6,opt,name=commitHash,proto3" json:"commitHash,omitempty"`
-	Released     bool              `protobuf:"varint,7,opt,name=released,proto3" json:"released,omitempty"` // true => feedback is visible to the student or group members
-	Status       Submission_Status `protobuf:"varint,8,opt,name=status,proto3,enum=ag.Submission_Status" json:"status,omitempty"`
-	ApprovedDate string            `protobuf:"bytes,9,opt,name=approvedDate,proto3" json:"approvedDate,omitempty"`
-	Reviews      []*Review         `protobuf:"bytes,10,rep,name=reviews,proto3" json:"reviews,omitempty"`     // reviews produced for this submission
-	BuildInfo    *score.BuildInfo  `protobuf:"bytes,11,opt,name=BuildInfo,proto3" json:"BuildInfo,omitempty"` // build info for tests
-	Scores       []*score.Score    `protobuf:"bytes,12,rep,name=Scores,proto3" json:"Scores,omitempty"`       // list of scores for different tests
+	CourseID   uint64 `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	GroupSize  uint32 `protobuf:"varint,2,opt,name=groupSize,proto3" json:"groupSize,omitempty"`
+	Balanced   bool   `protobuf:"varint,3,opt,name=balanced,proto3" json:"balanced,omitempty"`    // true => balance the groups by the students' scores so far; false => random groups
+	NamePrefix string `protobuf:"bytes,4,opt,name=namePrefix,proto3" json:"namePrefix,omitempty"` // groups are named <namePrefix>-<number>; default is "group"
 }
 
-func (x *Submission) Reset() {
-	*x = Submission{}
+func (x *GenerateGroupsRequest) Reset() {
+	*x = GenerateGroupsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[16]
+		mi := &file_ag_ag_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Submission) String() string {
+func (x *GenerateGroupsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Submission) ProtoMessage() {}
+func (*GenerateGroupsRequest) ProtoMessage() {}
 
-func (x *Submission) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[16]
+func (x *GenerateGroupsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1730,120 +2235,66 @@ func (x *Submission) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Submission.ProtoReflect.Descriptor instead.
-func (*Submission) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{16}
-}
-
-func (x *Submission) GetID() uint64 {
-	if x != nil {
-		return x.ID
-	}
-	return 0
-}
-
-func (x *Submission) GetAssignmentID() uint64 {
-	if x != nil {
-		return x.AssignmentID
-	}
-	return 0
-}
-
-func (x *Submission) GetUserID() uint64 {
-	if x != nil {
-		return x.UserID
-	}
-	return 0
+// Deprecated: Use GenerateGroupsRequest.ProtoReflect.Descriptor instead.
+func (*GenerateGroupsRequest) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *Submission) GetGroupID() uint64 {
+func (x *GenerateGroupsRequest) GetCourseID() uint64 {
 	if x != nil {
-		return x.GroupID
+		return x.CourseID
 	}
 	return 0
 }
 
-func (x *Submission) GetScore() uint32 {
+func (x *GenerateGroupsRequest) GetGroupSize() uint32 {
 	if x != nil {
-		return x.Score
+		return x.GroupSize
 	}
 	return 0
 }
 
-func (x *Submission) GetCommitHash() string {
-	if x != nil {
-		return x.CommitHash
-	}
-	return ""
-}
-
-func (x *Submission) GetReleased() bool {
+func (x *GenerateGroupsRequest) GetBalanced() bool {
 	if x != nil {
-		return x.Released
+		return x.Balanced
 	}
 	return false
 }
 
-func (x *Submission) GetStatus() Submission_Status {
-	if x != nil {
-		return x.Status
-	}
-	return Submission_NONE
-}
-
-func (x *Submission) GetApprovedDate() string {
+func (x *GenerateGroupsRequest) GetNamePrefix() string {
 	if x != nil {
-		return x.ApprovedDate
+		return x.NamePrefix
 	}
 	return ""
 }
 
-func (x *Submission) GetReviews() []*Review {
-	if x != nil {
-		return x.Reviews
-	}
-	return nil
-}
-
-func (x *Submission) GetBuildInfo() *score.BuildInfo {
-	if x != nil {
-		return x.BuildInfo
-	}
-	return nil
-}
-
-func (x *Submission) GetScores() []*score.Score {
-	if x != nil {
-		return x.Scores
-	}
-	return nil
-}
-
-type Submissions struct {
+// GenerateGroupsReport reports the outcome of generating groups.
+type GenerateGroupsReport struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Submissions []*Submission `protobuf:"bytes,1,rep,name=submissions,proto3" json:"submissions,omitempty"`
+	Groups []*Group `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"` // groups created and approved
+	Errors []string `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"` // groups that could not be created or approved
 }
 
-func (x *Submissions) Reset() {
-	*x = Submissions{}
+func (x *GenerateGroupsReport) Reset() {
+	*x = GenerateGroupsReport{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[17]
+		mi := &file_ag_ag_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Submissions) String() string {
+func (x *GenerateGroupsReport) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Submissions) ProtoMessage() {}
+func (*GenerateGroupsReport) ProtoMessage() {}
 
-func (x *Submissions) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[17]
+func (x *GenerateGroupsReport) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1854,48 +2305,56 @@ func (x *Submissions) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Submissions.ProtoReflect.Descriptor instead.
-func (*Submissions) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use GenerateGroupsReport.ProtoReflect.Descriptor instead.
+func (*GenerateGroupsReport) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *Submissions) GetSubmissions() []*Submission {
+func (x *GenerateGroupsReport) GetGroups() []*Group {
 	if x != nil {
-		return x.Submissions
+		return x.Groups
 	}
 	return nil
 }
 
-type GradingBenchmark struct {
+func (x *GenerateGroupsReport) GetErrors() []string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+// Tenant is an institution or department served by the QuickFeed server. The courses and users
+// of a tenant are only visible to the tenant's users, and the tenant's admins only administer them.
+// Admins that do not belong to a tenant administer the server and all its tenants.
+type Tenant struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID           uint64              `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	AssignmentID uint64              `protobuf:"varint,2,opt,name=AssignmentID,proto3" json:"AssignmentID,omitempty"` // foreign key
-	ReviewID     uint64              `protobuf:"varint,3,opt,name=ReviewID,proto3" json:"ReviewID,omitempty"`         // foreign key
-	Heading      string              `protobuf:"bytes,4,opt,name=heading,proto3" json:"heading,omitempty"`
-	Comment      string              `protobuf:"bytes,5,opt,name=comment,proto3" json:"comment,omitempty"`
-	Criteria     []*GradingCriterion `protobuf:"bytes,6,rep,name=criteria,proto3" json:"criteria,omitempty" gorm:"foreignKey:BenchmarkID"`
+	ID          uint64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Name        string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty" gorm:"uniqueIndex"`
+	Provider    string `protobuf:"bytes,3,opt,name=provider,proto3" json:"provider,omitempty"`       // SCM provider of the tenant's courses; empty => any enabled provider
+	ProviderURL string `protobuf:"bytes,4,opt,name=providerURL,proto3" json:"providerURL,omitempty"` // base URL of the tenant's gitea or bitbucket server; empty => the server's default
 }
 
-func (x *GradingBenchmark) Reset() {
-	*x = GradingBenchmark{}
+func (x *Tenant) Reset() {
+	*x = Tenant{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[18]
+		mi := &file_ag_ag_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GradingBenchmark) String() string {
+func (x *Tenant) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GradingBenchmark) ProtoMessage() {}
+func (*Tenant) ProtoMessage() {}
 
-func (x *GradingBenchmark) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[18]
+func (x *Tenant) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1906,78 +2365,64 @@ func (x *GradingBenchmark) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GradingBenchmark.ProtoReflect.Descriptor instead.
-func (*GradingBenchmark) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use Tenant.ProtoReflect.Descriptor instead.
+func (*Tenant) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *GradingBenchmark) GetID() uint64 {
+func (x *Tenant) GetID() uint64 {
 	if x != nil {
 		return x.ID
 	}
 	return 0
 }
 
-func (x *GradingBenchmark) GetAssignmentID() uint64 {
-	if x != nil {
-		return x.AssignmentID
-	}
-	return 0
-}
-
-func (x *GradingBenchmark) GetReviewID() uint64 {
-	if x != nil {
-		return x.ReviewID
-	}
-	return 0
-}
-
-func (x *GradingBenchmark) GetHeading() string {
+func (x *Tenant) GetName() string {
 	if x != nil {
-		return x.Heading
+		return x.Name
 	}
 	return ""
 }
 
-func (x *GradingBenchmark) GetComment() string {
+func (x *Tenant) GetProvider() string {
 	if x != nil {
-		return x.Comment
+		return x.Provider
 	}
 	return ""
 }
 
-func (x *GradingBenchmark) GetCriteria() []*GradingCriterion {
+func (x *Tenant) GetProviderURL() string {
 	if x != nil {
-		return x.Criteria
+		return x.ProviderURL
 	}
-	return nil
+	return ""
 }
 
-type Benchmarks struct {
+type Tenants struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Benchmarks []*GradingBenchmark `protobuf:"bytes,1,rep,name=benchmarks,proto3" json:"benchmarks,omitempty"`
+	Tenants []*Tenant `protobuf:"bytes,1,rep,name=tenants,proto3" json:"tenants,omitempty"`
 }
 
-func (x *Benchmarks) Reset() {
-	*x = Benchmarks{}
+func (x *Tenants) Reset() {
+	*x = Tenants{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[19]
+		mi := &file_ag_ag_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Benchmarks) String() string {
+func (x *Tenants) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Benchmarks) ProtoMessage() {}
+func (*Tenants) ProtoMessage() {}
 
-func (x *Benchmarks) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[19]
+func (x *Tenants) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1988,48 +2433,45 @@ func (x *Benchmarks) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Benchmarks.ProtoReflect.Descriptor instead.
-func (*Benchmarks) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use Tenants.ProtoReflect.Descriptor instead.
+func (*Tenants) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *Benchmarks) GetBenchmarks() []*GradingBenchmark {
+func (x *Tenants) GetTenants() []*Tenant {
 	if x != nil {
-		return x.Benchmarks
+		return x.Tenants
 	}
 	return nil
 }
 
-type GradingCriterion struct {
+// TenantUserRequest is a request to move a user to a tenant; tenantID 0 => no tenant.
+type TenantUserRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID          uint64                 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	BenchmarkID uint64                 `protobuf:"varint,2,opt,name=BenchmarkID,proto3" json:"BenchmarkID,omitempty"` // foreign key
-	Points      uint64                 `protobuf:"varint,3,opt,name=points,proto3" json:"points,omitempty"`
-	Description string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	Grade       GradingCriterion_Grade `protobuf:"varint,5,opt,name=grade,proto3,enum=ag.GradingCriterion_Grade" json:"grade,omitempty"`
-	Comment     string                 `protobuf:"bytes,6,opt,name=comment,proto3" json:"comment,omitempty"`
+	TenantID uint64 `protobuf:"varint,1,opt,name=tenantID,proto3" json:"tenantID,omitempty"`
+	UserID   uint64 `protobuf:"varint,2,opt,name=userID,proto3" json:"userID,omitempty"`
 }
 
-func (x *GradingCriterion) Reset() {
-	*x = GradingCriterion{}
+func (x *TenantUserRequest) Reset() {
+	*x = TenantUserRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[20]
+		mi := &file_ag_ag_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GradingCriterion) String() string {
+func (x *TenantUserRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GradingCriterion) ProtoMessage() {}
+func (*TenantUserRequest) ProtoMessage() {}
 
-func (x *GradingCriterion) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[20]
+func (x *TenantUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2040,85 +2482,86 @@ func (x *GradingCriterion) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GradingCriterion.ProtoReflect.Descriptor instead.
-func (*GradingCriterion) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use TenantUserRequest.ProtoReflect.Descriptor instead.
+func (*TenantUserRequest) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *GradingCriterion) GetID() uint64 {
+func (x *TenantUserRequest) GetTenantID() uint64 {
 	if x != nil {
-		return x.ID
+		return x.TenantID
 	}
 	return 0
 }
 
-func (x *GradingCriterion) GetBenchmarkID() uint64 {
+func (x *TenantUserRequest) GetUserID() uint64 {
 	if x != nil {
-		return x.BenchmarkID
+		return x.UserID
 	}
 	return 0
 }
 
-func (x *GradingCriterion) GetPoints() uint64 {
-	if x != nil {
-		return x.Points
-	}
-	return 0
+type Course struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID                  uint64                     `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	CourseCreatorID     uint64                     `protobuf:"varint,2,opt,name=courseCreatorID,proto3" json:"courseCreatorID,omitempty"`
+	Name                string                     `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Code                string                     `protobuf:"bytes,4,opt,name=code,proto3" json:"code,omitempty"`
+	Year                uint32                     `protobuf:"varint,5,opt,name=year,proto3" json:"year,omitempty"`
+	Tag                 string                     `protobuf:"bytes,6,opt,name=tag,proto3" json:"tag,omitempty"`
+	Provider            string                     `protobuf:"bytes,7,opt,name=provider,proto3" json:"provider,omitempty"`
+	OrganizationID      uint64                     `protobuf:"varint,8,opt,name=organizationID,proto3" json:"organizationID,omitempty"`
+	OrganizationPath    string                     `protobuf:"bytes,9,opt,name=organizationPath,proto3" json:"organizationPath,omitempty"` // The organization's SCM name, e.g. uis-dat520-2020.
+	SlipDays            uint32                     `protobuf:"varint,10,opt,name=slipDays,proto3" json:"slipDays,omitempty"`
+	Dockerfile          string                     `protobuf:"bytes,11,opt,name=dockerfile,proto3" json:"dockerfile,omitempty"`
+	Enrolled            Enrollment_UserStatus      `protobuf:"varint,12,opt,name=enrolled,proto3,enum=ag.Enrollment_UserStatus" json:"enrolled,omitempty" gorm:"-"`
+	Enrollments         []*Enrollment              `protobuf:"bytes,13,rep,name=enrollments,proto3" json:"enrollments,omitempty"`
+	Assignments         []*Assignment              `protobuf:"bytes,14,rep,name=assignments,proto3" json:"assignments,omitempty"`
+	Groups              []*Group                   `protobuf:"bytes,15,rep,name=groups,proto3" json:"groups,omitempty"`
+	DropLowest          uint32                     `protobuf:"varint,16,opt,name=dropLowest,proto3" json:"dropLowest,omitempty"`                              // number of lowest non-mandatory assignment scores not counted in the final grade
+	FinalGradesReleased string                     `protobuf:"bytes,17,opt,name=finalGradesReleased,proto3" json:"finalGradesReleased,omitempty"`             // time when the final grades were released to students
+	AppealWindow        uint32                     `protobuf:"varint,18,opt,name=appealWindow,proto3" json:"appealWindow,omitempty"`                          // number of days after a grade is released that students can appeal the grade
+	AppealResponseDays  uint32                     `protobuf:"varint,19,opt,name=appealResponseDays,proto3" json:"appealResponseDays,omitempty"`              // number of days teachers have to decide an appeal
+	Certificates        bool                       `protobuf:"varint,20,opt,name=certificates,proto3" json:"certificates,omitempty"`                          // true => students passing the course can get a certificate of completion
+	RunnerPool          string                     `protobuf:"bytes,21,opt,name=runnerPool,proto3" json:"runnerPool,omitempty"`                               // runner pool for the course's tests; empty uses the default pool
+	Archived            bool                       `protobuf:"varint,22,opt,name=archived,proto3" json:"archived,omitempty"`                                  // true => the course is read-only
+	PendingSetup        Course_SetupStep           `protobuf:"varint,23,opt,name=pendingSetup,proto3,enum=ag.Course_SetupStep" json:"pendingSetup,omitempty"` // next course creation step to perform; COMPLETE once the course is created
+	AssistantPermission Course_AssistantPermission `protobuf:"varint,24,opt,name=assistantPermission,proto3,enum=ag.Course_AssistantPermission" json:"assistantPermission,omitempty"`
+	AutoApproveDomains  string                     `protobuf:"bytes,25,opt,name=autoApproveDomains,proto3" json:"autoApproveDomains,omitempty"`    // comma-separated email domains of students whose enrollments are accepted automatically
+	AutoApproveRoster   bool                       `protobuf:"varint,26,opt,name=autoApproveRoster,proto3" json:"autoApproveRoster,omitempty"`     // true => enrollments of students in the course's imported roster are accepted automatically
+	MinGroupSize        uint32                     `protobuf:"varint,27,opt,name=minGroupSize,proto3" json:"minGroupSize,omitempty"`               // minimum number of members of an approved group; 0 => no minimum
+	MaxGroupSize        uint32                     `protobuf:"varint,28,opt,name=maxGroupSize,proto3" json:"maxGroupSize,omitempty"`               // maximum number of members of a group; 0 => no maximum
+	LastWebhook         string                     `protobuf:"bytes,29,opt,name=lastWebhook,proto3" json:"lastWebhook,omitempty"`                  // time when the last push event for the course's repositories was received
+	AssignmentsTemplate bool                       `protobuf:"varint,30,opt,name=assignmentsTemplate,proto3" json:"assignmentsTemplate,omitempty"` // true => student and group repositories are created from the assignments repository
+	InfoRepo            string                     `protobuf:"bytes,31,opt,name=infoRepo,proto3" json:"infoRepo,omitempty"`                        // name of the course's info repository; empty => "info"
+	AssignmentsRepo     string                     `protobuf:"bytes,32,opt,name=assignmentsRepo,proto3" json:"assignmentsRepo,omitempty"`          // name of the course's assignments repository; empty => "assignments"
+	TestsRepo           string                     `protobuf:"bytes,33,opt,name=testsRepo,proto3" json:"testsRepo,omitempty"`                      // name of the course's tests repository; empty => "tests"
+	AssignmentsDir      string                     `protobuf:"bytes,34,opt,name=assignmentsDir,proto3" json:"assignmentsDir,omitempty"`            // folder in the tests repository holding the assignment folders; empty => the repository root
+	Timezone            string                     `protobuf:"bytes,35,opt,name=timezone,proto3" json:"timezone,omitempty"`                        // IANA time zone of the course's deadlines, e.g. "Europe/Oslo"; empty => the server's time zone
+	MinApproved         uint32                     `protobuf:"varint,36,opt,name=minApproved,proto3" json:"minApproved,omitempty"`                 // number of assignments that must be approved to pass the course; 0 => only the mandatory assignments
+	TenantID            uint64                     `protobuf:"varint,37,opt,name=tenantID,proto3" json:"tenantID,omitempty" gorm:"index"`          // 0 => the course does not belong to a tenant
 }
 
-func (x *GradingCriterion) GetDescription() string {
-	if x != nil {
-		return x.Description
+func (x *Course) Reset() {
+	*x = Course{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *GradingCriterion) GetGrade() GradingCriterion_Grade {
-	if x != nil {
-		return x.Grade
-	}
-	return GradingCriterion_NONE
-}
-
-func (x *GradingCriterion) GetComment() string {
-	if x != nil {
-		return x.Comment
-	}
-	return ""
-}
-
-type Review struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	ID                uint64              `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	SubmissionID      uint64              `protobuf:"varint,2,opt,name=SubmissionID,proto3" json:"SubmissionID,omitempty"` // foreign key
-	ReviewerID        uint64              `protobuf:"varint,3,opt,name=ReviewerID,proto3" json:"ReviewerID,omitempty"`     // UserID of the reviewer
-	Feedback          string              `protobuf:"bytes,4,opt,name=feedback,proto3" json:"feedback,omitempty"`
-	Ready             bool                `protobuf:"varint,5,opt,name=ready,proto3" json:"ready,omitempty"`
-	Score             uint32              `protobuf:"varint,6,opt,name=score,proto3" json:"score,omitempty"`
-	GradingBenchmarks []*GradingBenchmark `protobuf:"bytes,7,rep,name=gradingBenchmarks,proto3" json:"gradingBenchmarks,omitempty" gorm:"foreignKey:ReviewID"`
-	Edited            string              `protobuf:"bytes,8,opt,name=edited,proto3" json:"edited,omitempty"`
-}
-
-func (x *Review) Reset() {
-	*x = Review{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[21]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *Review) String() string {
+func (x *Course) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Review) ProtoMessage() {}
+func (*Course) ProtoMessage() {}
 
-func (x *Review) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[21]
+func (x *Course) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2129,288 +2572,298 @@ func (x *Review) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Review.ProtoReflect.Descriptor instead.
-func (*Review) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use Course.ProtoReflect.Descriptor instead.
+func (*Course) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *Review) GetID() uint64 {
+func (x *Course) GetID() uint64 {
 	if x != nil {
 		return x.ID
 	}
 	return 0
 }
 
-func (x *Review) GetSubmissionID() uint64 {
-	if x != nil {
-		return x.SubmissionID
-	}
-	return 0
-}
-
-func (x *Review) GetReviewerID() uint64 {
+func (x *Course) GetCourseCreatorID() uint64 {
 	if x != nil {
-		return x.ReviewerID
+		return x.CourseCreatorID
 	}
 	return 0
 }
 
-func (x *Review) GetFeedback() string {
+func (x *Course) GetName() string {
 	if x != nil {
-		return x.Feedback
+		return x.Name
 	}
 	return ""
 }
 
-func (x *Review) GetReady() bool {
+func (x *Course) GetCode() string {
 	if x != nil {
-		return x.Ready
+		return x.Code
 	}
-	return false
+	return ""
 }
 
-func (x *Review) GetScore() uint32 {
+func (x *Course) GetYear() uint32 {
 	if x != nil {
-		return x.Score
+		return x.Year
 	}
 	return 0
 }
 
-func (x *Review) GetGradingBenchmarks() []*GradingBenchmark {
+func (x *Course) GetTag() string {
 	if x != nil {
-		return x.GradingBenchmarks
+		return x.Tag
 	}
-	return nil
+	return ""
 }
 
-func (x *Review) GetEdited() string {
+func (x *Course) GetProvider() string {
 	if x != nil {
-		return x.Edited
+		return x.Provider
 	}
 	return ""
 }
 
-type Reviewers struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Reviewers []*User `protobuf:"bytes,1,rep,name=reviewers,proto3" json:"reviewers,omitempty"`
+func (x *Course) GetOrganizationID() uint64 {
+	if x != nil {
+		return x.OrganizationID
+	}
+	return 0
 }
 
-func (x *Reviewers) Reset() {
-	*x = Reviewers{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[22]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Course) GetOrganizationPath() string {
+	if x != nil {
+		return x.OrganizationPath
 	}
+	return ""
 }
 
-func (x *Reviewers) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Course) GetSlipDays() uint32 {
+	if x != nil {
+		return x.SlipDays
+	}
+	return 0
 }
 
-func (*Reviewers) ProtoMessage() {}
-
-func (x *Reviewers) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[22]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Course) GetDockerfile() string {
+	if x != nil {
+		return x.Dockerfile
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use Reviewers.ProtoReflect.Descriptor instead.
-func (*Reviewers) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{22}
+func (x *Course) GetEnrolled() Enrollment_UserStatus {
+	if x != nil {
+		return x.Enrolled
+	}
+	return Enrollment_NONE
 }
 
-func (x *Reviewers) GetReviewers() []*User {
+func (x *Course) GetEnrollments() []*Enrollment {
 	if x != nil {
-		return x.Reviewers
+		return x.Enrollments
 	}
 	return nil
 }
 
-type ReviewRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	CourseID uint64  `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
-	Review   *Review `protobuf:"bytes,2,opt,name=review,proto3" json:"review,omitempty"`
+func (x *Course) GetAssignments() []*Assignment {
+	if x != nil {
+		return x.Assignments
+	}
+	return nil
 }
 
-func (x *ReviewRequest) Reset() {
-	*x = ReviewRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[23]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Course) GetGroups() []*Group {
+	if x != nil {
+		return x.Groups
 	}
+	return nil
 }
 
-func (x *ReviewRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Course) GetDropLowest() uint32 {
+	if x != nil {
+		return x.DropLowest
+	}
+	return 0
 }
 
-func (*ReviewRequest) ProtoMessage() {}
-
-func (x *ReviewRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[23]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Course) GetFinalGradesReleased() string {
+	if x != nil {
+		return x.FinalGradesReleased
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use ReviewRequest.ProtoReflect.Descriptor instead.
-func (*ReviewRequest) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{23}
+func (x *Course) GetAppealWindow() uint32 {
+	if x != nil {
+		return x.AppealWindow
+	}
+	return 0
 }
 
-func (x *ReviewRequest) GetCourseID() uint64 {
+func (x *Course) GetAppealResponseDays() uint32 {
 	if x != nil {
-		return x.CourseID
+		return x.AppealResponseDays
 	}
 	return 0
 }
 
-func (x *ReviewRequest) GetReview() *Review {
+func (x *Course) GetCertificates() bool {
 	if x != nil {
-		return x.Review
+		return x.Certificates
 	}
-	return nil
+	return false
 }
 
-type CourseRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	CourseID uint64 `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
+func (x *Course) GetRunnerPool() string {
+	if x != nil {
+		return x.RunnerPool
+	}
+	return ""
 }
 
-func (x *CourseRequest) Reset() {
-	*x = CourseRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[24]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Course) GetArchived() bool {
+	if x != nil {
+		return x.Archived
 	}
+	return false
 }
 
-func (x *CourseRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Course) GetPendingSetup() Course_SetupStep {
+	if x != nil {
+		return x.PendingSetup
+	}
+	return Course_COMPLETE
 }
 
-func (*CourseRequest) ProtoMessage() {}
+func (x *Course) GetAssistantPermission() Course_AssistantPermission {
+	if x != nil {
+		return x.AssistantPermission
+	}
+	return Course_REVIEW_AND_APPROVE
+}
 
-func (x *CourseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[24]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Course) GetAutoApproveDomains() string {
+	if x != nil {
+		return x.AutoApproveDomains
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use CourseRequest.ProtoReflect.Descriptor instead.
-func (*CourseRequest) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{24}
+func (x *Course) GetAutoApproveRoster() bool {
+	if x != nil {
+		return x.AutoApproveRoster
+	}
+	return false
 }
 
-func (x *CourseRequest) GetCourseID() uint64 {
+func (x *Course) GetMinGroupSize() uint32 {
 	if x != nil {
-		return x.CourseID
+		return x.MinGroupSize
 	}
 	return 0
 }
 
-type UserRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *Course) GetMaxGroupSize() uint32 {
+	if x != nil {
+		return x.MaxGroupSize
+	}
+	return 0
+}
 
-	UserID uint64 `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+func (x *Course) GetLastWebhook() string {
+	if x != nil {
+		return x.LastWebhook
+	}
+	return ""
 }
 
-func (x *UserRequest) Reset() {
-	*x = UserRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[25]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Course) GetAssignmentsTemplate() bool {
+	if x != nil {
+		return x.AssignmentsTemplate
 	}
+	return false
 }
 
-func (x *UserRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Course) GetInfoRepo() string {
+	if x != nil {
+		return x.InfoRepo
+	}
+	return ""
 }
 
-func (*UserRequest) ProtoMessage() {}
+func (x *Course) GetAssignmentsRepo() string {
+	if x != nil {
+		return x.AssignmentsRepo
+	}
+	return ""
+}
 
-func (x *UserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[25]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Course) GetTestsRepo() string {
+	if x != nil {
+		return x.TestsRepo
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use UserRequest.ProtoReflect.Descriptor instead.
-func (*UserRequest) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{25}
+func (x *Course) GetAssignmentsDir() string {
+	if x != nil {
+		return x.AssignmentsDir
+	}
+	return ""
 }
 
-func (x *UserRequest) GetUserID() uint64 {
+func (x *Course) GetTimezone() string {
 	if x != nil {
-		return x.UserID
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *Course) GetMinApproved() uint32 {
+	if x != nil {
+		return x.MinApproved
 	}
 	return 0
 }
 
-type GetGroupRequest struct {
+func (x *Course) GetTenantID() uint64 {
+	if x != nil {
+		return x.TenantID
+	}
+	return 0
+}
+
+// CourseCleanupRequest is a request to archive or delete a course. The course's
+// repositories on the SCM provider are kept, archived or deleted as requested.
+type CourseCleanupRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	GroupID uint64 `protobuf:"varint,1,opt,name=groupID,proto3" json:"groupID,omitempty"`
+	CourseID     uint64                                `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	Repositories CourseCleanupRequest_RepositoryAction `protobuf:"varint,2,opt,name=repositories,proto3,enum=ag.CourseCleanupRequest_RepositoryAction" json:"repositories,omitempty"`
 }
 
-func (x *GetGroupRequest) Reset() {
-	*x = GetGroupRequest{}
+func (x *CourseCleanupRequest) Reset() {
+	*x = CourseCleanupRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[26]
+		mi := &file_ag_ag_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetGroupRequest) String() string {
+func (x *CourseCleanupRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGroupRequest) ProtoMessage() {}
+func (*CourseCleanupRequest) ProtoMessage() {}
 
-func (x *GetGroupRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[26]
+func (x *CourseCleanupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2421,45 +2874,54 @@ func (x *GetGroupRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGroupRequest.ProtoReflect.Descriptor instead.
-func (*GetGroupRequest) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use CourseCleanupRequest.ProtoReflect.Descriptor instead.
+func (*CourseCleanupRequest) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *GetGroupRequest) GetGroupID() uint64 {
+func (x *CourseCleanupRequest) GetCourseID() uint64 {
 	if x != nil {
-		return x.GroupID
+		return x.CourseID
 	}
 	return 0
 }
 
-type GroupRequest struct {
+func (x *CourseCleanupRequest) GetRepositories() CourseCleanupRequest_RepositoryAction {
+	if x != nil {
+		return x.Repositories
+	}
+	return CourseCleanupRequest_KEEP
+}
+
+// CloneCourseRequest is a request to create a new course from a previous instance of a course.
+// The new course gets the previous course's assignments, grading benchmarks and criteria, grading
+// scale and settings, and the contents of its assignments and tests repositories.
+type CloneCourseRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserID   uint64 `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
-	GroupID  uint64 `protobuf:"varint,2,opt,name=groupID,proto3" json:"groupID,omitempty"`
-	CourseID uint64 `protobuf:"varint,3,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	CourseID uint64  `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"` // the course to clone
+	Course   *Course `protobuf:"bytes,2,opt,name=course,proto3" json:"course,omitempty"`      // the new course, e.g., with the new semester's year and organization
 }
 
-func (x *GroupRequest) Reset() {
-	*x = GroupRequest{}
+func (x *CloneCourseRequest) Reset() {
+	*x = CloneCourseRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[27]
+		mi := &file_ag_ag_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GroupRequest) String() string {
+func (x *CloneCourseRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GroupRequest) ProtoMessage() {}
+func (*CloneCourseRequest) ProtoMessage() {}
 
-func (x *GroupRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[27]
+func (x *CloneCourseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2470,57 +2932,54 @@ func (x *GroupRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GroupRequest.ProtoReflect.Descriptor instead.
-func (*GroupRequest) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{27}
-}
-
-func (x *GroupRequest) GetUserID() uint64 {
-	if x != nil {
-		return x.UserID
-	}
-	return 0
+// Deprecated: Use CloneCourseRequest.ProtoReflect.Descriptor instead.
+func (*CloneCourseRequest) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *GroupRequest) GetGroupID() uint64 {
+func (x *CloneCourseRequest) GetCourseID() uint64 {
 	if x != nil {
-		return x.GroupID
+		return x.CourseID
 	}
 	return 0
 }
 
-func (x *GroupRequest) GetCourseID() uint64 {
+func (x *CloneCourseRequest) GetCourse() *Course {
 	if x != nil {
-		return x.CourseID
+		return x.Course
 	}
-	return 0
+	return nil
 }
 
-type Provider struct {
+// CourseCreationPlan lists the operations on the SCM provider that creating a course would
+// perform, so that they can be verified before the course is created.
+type CourseCreationPlan struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Provider string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Operations []*SCMOperation `protobuf:"bytes,1,rep,name=operations,proto3" json:"operations,omitempty"`
+	Resume     bool            `protobuf:"varint,2,opt,name=resume,proto3" json:"resume,omitempty"`  // true => the creation of a partially created course would be resumed
+	Problem    string          `protobuf:"bytes,3,opt,name=problem,proto3" json:"problem,omitempty"` // reason the course cannot be created; empty if the course can be created
 }
 
-func (x *Provider) Reset() {
-	*x = Provider{}
+func (x *CourseCreationPlan) Reset() {
+	*x = CourseCreationPlan{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[28]
+		mi := &file_ag_ag_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Provider) String() string {
+func (x *CourseCreationPlan) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Provider) ProtoMessage() {}
+func (*CourseCreationPlan) ProtoMessage() {}
 
-func (x *Provider) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[28]
+func (x *CourseCreationPlan) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2531,43 +2990,62 @@ func (x *Provider) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Provider.ProtoReflect.Descriptor instead.
-func (*Provider) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use CourseCreationPlan.ProtoReflect.Descriptor instead.
+func (*CourseCreationPlan) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *Provider) GetProvider() string {
+func (x *CourseCreationPlan) GetOperations() []*SCMOperation {
 	if x != nil {
-		return x.Provider
+		return x.Operations
+	}
+	return nil
+}
+
+func (x *CourseCreationPlan) GetResume() bool {
+	if x != nil {
+		return x.Resume
+	}
+	return false
+}
+
+func (x *CourseCreationPlan) GetProblem() string {
+	if x != nil {
+		return x.Problem
 	}
 	return ""
 }
 
-type OrgRequest struct {
+// SCMOperation is an operation on the SCM provider performed when creating a course.
+type SCMOperation struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	OrgName string `protobuf:"bytes,1,opt,name=orgName,proto3" json:"orgName,omitempty"`
+	Step        Course_SetupStep  `protobuf:"varint,1,opt,name=step,proto3,enum=ag.Course_SetupStep" json:"step,omitempty"` // the course creation step performing the operation
+	Kind        SCMOperation_Kind `protobuf:"varint,2,opt,name=kind,proto3,enum=ag.SCMOperation_Kind" json:"kind,omitempty"`
+	Resource    string            `protobuf:"bytes,3,opt,name=resource,proto3" json:"resource,omitempty"` // the organization, repository or team, or the hook's URL
+	Description string            `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Skipped     bool              `protobuf:"varint,5,opt,name=skipped,proto3" json:"skipped,omitempty"` // true => the resource already exists and is reused
 }
 
-func (x *OrgRequest) Reset() {
-	*x = OrgRequest{}
+func (x *SCMOperation) Reset() {
+	*x = SCMOperation{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[29]
+		mi := &file_ag_ag_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *OrgRequest) String() string {
+func (x *SCMOperation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OrgRequest) ProtoMessage() {}
+func (*SCMOperation) ProtoMessage() {}
 
-func (x *OrgRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[29]
+func (x *SCMOperation) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2578,46 +3056,81 @@ func (x *OrgRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OrgRequest.ProtoReflect.Descriptor instead.
-func (*OrgRequest) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use SCMOperation.ProtoReflect.Descriptor instead.
+func (*SCMOperation) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *OrgRequest) GetOrgName() string {
+func (x *SCMOperation) GetStep() Course_SetupStep {
 	if x != nil {
-		return x.OrgName
+		return x.Step
+	}
+	return Course_COMPLETE
+}
+
+func (x *SCMOperation) GetKind() SCMOperation_Kind {
+	if x != nil {
+		return x.Kind
+	}
+	return SCMOperation_UPDATE_ORGANIZATION
+}
+
+func (x *SCMOperation) GetResource() string {
+	if x != nil {
+		return x.Resource
 	}
 	return ""
 }
 
-type Organization struct {
+func (x *SCMOperation) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *SCMOperation) GetSkipped() bool {
+	if x != nil {
+		return x.Skipped
+	}
+	return false
+}
+
+// Certificate is a certificate of completion issued to a student passing a course.
+type Certificate struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	ID          uint64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	Path        string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
-	Avatar      string `protobuf:"bytes,3,opt,name=avatar,proto3" json:"avatar,omitempty"`
-	PaymentPlan string `protobuf:"bytes,4,opt,name=paymentPlan,proto3" json:"paymentPlan,omitempty"`
-}
-
-func (x *Organization) Reset() {
-	*x = Organization{}
+	CourseID    uint64 `protobuf:"varint,2,opt,name=courseID,proto3" json:"courseID,omitempty" gorm:"uniqueIndex:idx_unique_certificate"`
+	UserID      uint64 `protobuf:"varint,3,opt,name=userID,proto3" json:"userID,omitempty" gorm:"uniqueIndex:idx_unique_certificate"`
+	StudentName string `protobuf:"bytes,4,opt,name=studentName,proto3" json:"studentName,omitempty"`
+	CourseName  string `protobuf:"bytes,5,opt,name=courseName,proto3" json:"courseName,omitempty"`
+	Grade       string `protobuf:"bytes,6,opt,name=grade,proto3" json:"grade,omitempty"`
+	Issued      string `protobuf:"bytes,7,opt,name=issued,proto3" json:"issued,omitempty"`
+	Code        string `protobuf:"bytes,8,opt,name=code,proto3" json:"code,omitempty" gorm:"uniqueIndex;size:255"` // verification code
+	Signature   string `protobuf:"bytes,9,opt,name=signature,proto3" json:"signature,omitempty"`                   // signature of the certificate's content
+	URL         string `protobuf:"bytes,10,opt,name=URL,proto3" json:"URL,omitempty" gorm:"-"`                     // public verification URL
+}
+
+func (x *Certificate) Reset() {
+	*x = Certificate{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[30]
+		mi := &file_ag_ag_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Organization) String() string {
+func (x *Certificate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Organization) ProtoMessage() {}
+func (*Certificate) ProtoMessage() {}
 
-func (x *Organization) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[30]
+func (x *Certificate) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2628,117 +3141,112 @@ func (x *Organization) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Organization.ProtoReflect.Descriptor instead.
-func (*Organization) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use Certificate.ProtoReflect.Descriptor instead.
+func (*Certificate) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *Organization) GetID() uint64 {
+func (x *Certificate) GetID() uint64 {
 	if x != nil {
 		return x.ID
 	}
 	return 0
 }
 
-func (x *Organization) GetPath() string {
+func (x *Certificate) GetCourseID() uint64 {
 	if x != nil {
-		return x.Path
+		return x.CourseID
 	}
-	return ""
+	return 0
 }
 
-func (x *Organization) GetAvatar() string {
+func (x *Certificate) GetUserID() uint64 {
 	if x != nil {
-		return x.Avatar
+		return x.UserID
 	}
-	return ""
+	return 0
 }
 
-func (x *Organization) GetPaymentPlan() string {
+func (x *Certificate) GetStudentName() string {
 	if x != nil {
-		return x.PaymentPlan
+		return x.StudentName
 	}
 	return ""
 }
 
-type Organizations struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Organizations []*Organization `protobuf:"bytes,1,rep,name=organizations,proto3" json:"organizations,omitempty"`
+func (x *Certificate) GetCourseName() string {
+	if x != nil {
+		return x.CourseName
+	}
+	return ""
 }
 
-func (x *Organizations) Reset() {
-	*x = Organizations{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[31]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Certificate) GetGrade() string {
+	if x != nil {
+		return x.Grade
 	}
+	return ""
 }
 
-func (x *Organizations) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Certificate) GetIssued() string {
+	if x != nil {
+		return x.Issued
+	}
+	return ""
 }
 
-func (*Organizations) ProtoMessage() {}
-
-func (x *Organizations) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[31]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Certificate) GetCode() string {
+	if x != nil {
+		return x.Code
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use Organizations.ProtoReflect.Descriptor instead.
-func (*Organizations) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{31}
+func (x *Certificate) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
 }
 
-func (x *Organizations) GetOrganizations() []*Organization {
+func (x *Certificate) GetURL() string {
 	if x != nil {
-		return x.Organizations
+		return x.URL
 	}
-	return nil
+	return ""
 }
 
-// EnrollmentRequest is a request for enrolled users of a given course,
-// whose enrollment status match those provided in the request. To ignore group members
-// that otherwise match the enrollment request, set ignoreGroupMembers to true.
-type EnrollmentRequest struct {
+// GradeThreshold maps scores equal to or above minScore to the given letter grade
+// in the course's grading scale.
+type GradeThreshold struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CourseID           uint64                  `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
-	IgnoreGroupMembers bool                    `protobuf:"varint,2,opt,name=ignoreGroupMembers,proto3" json:"ignoreGroupMembers,omitempty"`
-	WithActivity       bool                    `protobuf:"varint,3,opt,name=withActivity,proto3" json:"withActivity,omitempty"`
-	Statuses           []Enrollment_UserStatus `protobuf:"varint,4,rep,packed,name=statuses,proto3,enum=ag.Enrollment_UserStatus" json:"statuses,omitempty"`
+	ID       uint64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	CourseID uint64 `protobuf:"varint,2,opt,name=courseID,proto3" json:"courseID,omitempty"` // foreign key
+	Grade    string `protobuf:"bytes,3,opt,name=grade,proto3" json:"grade,omitempty"`        // letter grade, e.g., A
+	MinScore uint32 `protobuf:"varint,4,opt,name=minScore,proto3" json:"minScore,omitempty"` // minimum percentage score for the grade
+	Passing  bool   `protobuf:"varint,5,opt,name=passing,proto3" json:"passing,omitempty"`   // true => the grade is a passing grade
 }
 
-func (x *EnrollmentRequest) Reset() {
-	*x = EnrollmentRequest{}
+func (x *GradeThreshold) Reset() {
+	*x = GradeThreshold{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[32]
+		mi := &file_ag_ag_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EnrollmentRequest) String() string {
+func (x *GradeThreshold) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EnrollmentRequest) ProtoMessage() {}
+func (*GradeThreshold) ProtoMessage() {}
 
-func (x *EnrollmentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[32]
+func (x *GradeThreshold) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2749,66 +3257,72 @@ func (x *EnrollmentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EnrollmentRequest.ProtoReflect.Descriptor instead.
-func (*EnrollmentRequest) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use GradeThreshold.ProtoReflect.Descriptor instead.
+func (*GradeThreshold) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *EnrollmentRequest) GetCourseID() uint64 {
+func (x *GradeThreshold) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *GradeThreshold) GetCourseID() uint64 {
 	if x != nil {
 		return x.CourseID
 	}
 	return 0
 }
 
-func (x *EnrollmentRequest) GetIgnoreGroupMembers() bool {
+func (x *GradeThreshold) GetGrade() string {
 	if x != nil {
-		return x.IgnoreGroupMembers
+		return x.Grade
 	}
-	return false
+	return ""
 }
 
-func (x *EnrollmentRequest) GetWithActivity() bool {
+func (x *GradeThreshold) GetMinScore() uint32 {
 	if x != nil {
-		return x.WithActivity
+		return x.MinScore
 	}
-	return false
+	return 0
 }
 
-func (x *EnrollmentRequest) GetStatuses() []Enrollment_UserStatus {
+func (x *GradeThreshold) GetPassing() bool {
 	if x != nil {
-		return x.Statuses
+		return x.Passing
 	}
-	return nil
+	return false
 }
 
-// EnrollmentStatusRequest is a request for a given user, with a specific enrollment status.
-type EnrollmentStatusRequest struct {
+type GradingScale struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserID   uint64                  `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
-	Statuses []Enrollment_UserStatus `protobuf:"varint,2,rep,packed,name=statuses,proto3,enum=ag.Enrollment_UserStatus" json:"statuses,omitempty"`
+	CourseID   uint64            `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	Thresholds []*GradeThreshold `protobuf:"bytes,2,rep,name=thresholds,proto3" json:"thresholds,omitempty"`
 }
 
-func (x *EnrollmentStatusRequest) Reset() {
-	*x = EnrollmentStatusRequest{}
+func (x *GradingScale) Reset() {
+	*x = GradingScale{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[33]
+		mi := &file_ag_ag_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EnrollmentStatusRequest) String() string {
+func (x *GradingScale) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EnrollmentStatusRequest) ProtoMessage() {}
+func (*GradingScale) ProtoMessage() {}
 
-func (x *EnrollmentStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[33]
+func (x *GradingScale) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2819,52 +3333,57 @@ func (x *EnrollmentStatusRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EnrollmentStatusRequest.ProtoReflect.Descriptor instead.
-func (*EnrollmentStatusRequest) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use GradingScale.ProtoReflect.Descriptor instead.
+func (*GradingScale) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *EnrollmentStatusRequest) GetUserID() uint64 {
+func (x *GradingScale) GetCourseID() uint64 {
 	if x != nil {
-		return x.UserID
+		return x.CourseID
 	}
 	return 0
 }
 
-func (x *EnrollmentStatusRequest) GetStatuses() []Enrollment_UserStatus {
+func (x *GradingScale) GetThresholds() []*GradeThreshold {
 	if x != nil {
-		return x.Statuses
+		return x.Thresholds
 	}
 	return nil
 }
 
-type SubmissionRequest struct {
+// FinalGrade is a student's computed final grade for a course.
+type FinalGrade struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserID   uint64 `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
-	GroupID  uint64 `protobuf:"varint,2,opt,name=groupID,proto3" json:"groupID,omitempty"`
-	CourseID uint64 `protobuf:"varint,3,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	UserID             uint64   `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	User               *User    `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	Score              uint32   `protobuf:"varint,3,opt,name=score,proto3" json:"score,omitempty"`                                  // weighted percentage score of the counted assignments
+	Grade              string   `protobuf:"bytes,4,opt,name=grade,proto3" json:"grade,omitempty"`                                   // letter grade according to the course's grading scale
+	Passed             bool     `protobuf:"varint,5,opt,name=passed,proto3" json:"passed,omitempty"`                                // true => passing grade and the course's pass requirement met
+	DroppedAssignments []uint64 `protobuf:"varint,6,rep,packed,name=droppedAssignments,proto3" json:"droppedAssignments,omitempty"` // IDs of assignments not counted due to the drop-lowest rule
+	MissingMandatory   []uint64 `protobuf:"varint,7,rep,packed,name=missingMandatory,proto3" json:"missingMandatory,omitempty"`     // IDs of mandatory assignments not approved
 }
 
-func (x *SubmissionRequest) Reset() {
-	*x = SubmissionRequest{}
+func (x *FinalGrade) Reset() {
+	*x = FinalGrade{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[34]
+		mi := &file_ag_ag_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SubmissionRequest) String() string {
+func (x *FinalGrade) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubmissionRequest) ProtoMessage() {}
+func (*FinalGrade) ProtoMessage() {}
 
-func (x *SubmissionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[34]
+func (x *FinalGrade) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2875,61 +3394,97 @@ func (x *SubmissionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubmissionRequest.ProtoReflect.Descriptor instead.
-func (*SubmissionRequest) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use FinalGrade.ProtoReflect.Descriptor instead.
+func (*FinalGrade) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *SubmissionRequest) GetUserID() uint64 {
+func (x *FinalGrade) GetUserID() uint64 {
 	if x != nil {
 		return x.UserID
 	}
 	return 0
 }
 
-func (x *SubmissionRequest) GetGroupID() uint64 {
+func (x *FinalGrade) GetUser() *User {
 	if x != nil {
-		return x.GroupID
+		return x.User
 	}
-	return 0
+	return nil
 }
 
-func (x *SubmissionRequest) GetCourseID() uint64 {
+func (x *FinalGrade) GetScore() uint32 {
 	if x != nil {
-		return x.CourseID
+		return x.Score
 	}
 	return 0
 }
 
-type UpdateSubmissionRequest struct {
+func (x *FinalGrade) GetGrade() string {
+	if x != nil {
+		return x.Grade
+	}
+	return ""
+}
+
+func (x *FinalGrade) GetPassed() bool {
+	if x != nil {
+		return x.Passed
+	}
+	return false
+}
+
+func (x *FinalGrade) GetDroppedAssignments() []uint64 {
+	if x != nil {
+		return x.DroppedAssignments
+	}
+	return nil
+}
+
+func (x *FinalGrade) GetMissingMandatory() []uint64 {
+	if x != nil {
+		return x.MissingMandatory
+	}
+	return nil
+}
+
+// ScoreCurve adjusts the scores of an assignment's submissions for final grading, without changing
+// the submissions' raw scores. The linear method maps a score x to slope*x + offset, and the percentile
+// method maps the percentile rank of a score among the assignment's submissions to the range from
+// minScore to maxScore. Adjusted scores are clamped to the range from 0 to 100.
+type ScoreCurve struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SubmissionID uint64            `protobuf:"varint,1,opt,name=submissionID,proto3" json:"submissionID,omitempty"`
+	ID           uint64            `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
 	CourseID     uint64            `protobuf:"varint,2,opt,name=courseID,proto3" json:"courseID,omitempty"`
-	Score        uint32            `protobuf:"varint,3,opt,name=score,proto3" json:"score,omitempty"`
-	Released     bool              `protobuf:"varint,4,opt,name=released,proto3" json:"released,omitempty"`
-	Status       Submission_Status `protobuf:"varint,5,opt,name=status,proto3,enum=ag.Submission_Status" json:"status,omitempty"`
+	AssignmentID uint64            `protobuf:"varint,3,opt,name=assignmentID,proto3" json:"assignmentID,omitempty" gorm:"uniqueIndex"`
+	Method       ScoreCurve_Method `protobuf:"varint,4,opt,name=method,proto3,enum=ag.ScoreCurve_Method" json:"method,omitempty"`
+	Slope        float64           `protobuf:"fixed64,5,opt,name=slope,proto3" json:"slope,omitempty"`      // linear method: factor applied to the raw score
+	Offset       float64           `protobuf:"fixed64,6,opt,name=offset,proto3" json:"offset,omitempty"`    // linear method: percentage points added to the scaled score
+	MinScore     uint32            `protobuf:"varint,7,opt,name=minScore,proto3" json:"minScore,omitempty"` // percentile method: adjusted score of the lowest percentile rank
+	MaxScore     uint32            `protobuf:"varint,8,opt,name=maxScore,proto3" json:"maxScore,omitempty"` // percentile method: adjusted score of the highest percentile rank
+	Applied      string            `protobuf:"bytes,9,opt,name=applied,proto3" json:"applied,omitempty"`    // time the curve was last applied
 }
 
-func (x *UpdateSubmissionRequest) Reset() {
-	*x = UpdateSubmissionRequest{}
+func (x *ScoreCurve) Reset() {
+	*x = ScoreCurve{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[35]
+		mi := &file_ag_ag_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpdateSubmissionRequest) String() string {
+func (x *ScoreCurve) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateSubmissionRequest) ProtoMessage() {}
+func (*ScoreCurve) ProtoMessage() {}
 
-func (x *UpdateSubmissionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[35]
+func (x *ScoreCurve) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2940,75 +3495,101 @@ func (x *UpdateSubmissionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateSubmissionRequest.ProtoReflect.Descriptor instead.
-func (*UpdateSubmissionRequest) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use ScoreCurve.ProtoReflect.Descriptor instead.
+func (*ScoreCurve) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *UpdateSubmissionRequest) GetSubmissionID() uint64 {
+func (x *ScoreCurve) GetID() uint64 {
 	if x != nil {
-		return x.SubmissionID
+		return x.ID
 	}
 	return 0
 }
 
-func (x *UpdateSubmissionRequest) GetCourseID() uint64 {
+func (x *ScoreCurve) GetCourseID() uint64 {
 	if x != nil {
 		return x.CourseID
 	}
 	return 0
 }
 
-func (x *UpdateSubmissionRequest) GetScore() uint32 {
+func (x *ScoreCurve) GetAssignmentID() uint64 {
 	if x != nil {
-		return x.Score
+		return x.AssignmentID
 	}
 	return 0
 }
 
-func (x *UpdateSubmissionRequest) GetReleased() bool {
+func (x *ScoreCurve) GetMethod() ScoreCurve_Method {
 	if x != nil {
-		return x.Released
+		return x.Method
 	}
-	return false
+	return ScoreCurve_NONE
 }
 
-func (x *UpdateSubmissionRequest) GetStatus() Submission_Status {
+func (x *ScoreCurve) GetSlope() float64 {
 	if x != nil {
-		return x.Status
+		return x.Slope
 	}
-	return Submission_NONE
+	return 0
 }
 
-type UpdateSubmissionsRequest struct {
+func (x *ScoreCurve) GetOffset() float64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ScoreCurve) GetMinScore() uint32 {
+	if x != nil {
+		return x.MinScore
+	}
+	return 0
+}
+
+func (x *ScoreCurve) GetMaxScore() uint32 {
+	if x != nil {
+		return x.MaxScore
+	}
+	return 0
+}
+
+func (x *ScoreCurve) GetApplied() string {
+	if x != nil {
+		return x.Applied
+	}
+	return ""
+}
+
+type FinalGrades struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CourseID     uint64 `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
-	AssignmentID uint64 `protobuf:"varint,2,opt,name=assignmentID,proto3" json:"assignmentID,omitempty"`
-	ScoreLimit   uint32 `protobuf:"varint,3,opt,name=scoreLimit,proto3" json:"scoreLimit,omitempty"`
-	Release      bool   `protobuf:"varint,4,opt,name=release,proto3" json:"release,omitempty"`
-	Approve      bool   `protobuf:"varint,5,opt,name=approve,proto3" json:"approve,omitempty"`
+	CourseID   uint64        `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	Grades     []*FinalGrade `protobuf:"bytes,2,rep,name=grades,proto3" json:"grades,omitempty"`
+	ComputedAt string        `protobuf:"bytes,3,opt,name=computedAt,proto3" json:"computedAt,omitempty"`
 }
 
-func (x *UpdateSubmissionsRequest) Reset() {
-	*x = UpdateSubmissionsRequest{}
+func (x *FinalGrades) Reset() {
+	*x = FinalGrades{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[36]
+		mi := &file_ag_ag_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpdateSubmissionsRequest) String() string {
+func (x *FinalGrades) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateSubmissionsRequest) ProtoMessage() {}
+func (*FinalGrades) ProtoMessage() {}
 
-func (x *UpdateSubmissionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[36]
+func (x *FinalGrades) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3019,72 +3600,62 @@ func (x *UpdateSubmissionsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateSubmissionsRequest.ProtoReflect.Descriptor instead.
-func (*UpdateSubmissionsRequest) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{36}
+// Deprecated: Use FinalGrades.ProtoReflect.Descriptor instead.
+func (*FinalGrades) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *UpdateSubmissionsRequest) GetCourseID() uint64 {
+func (x *FinalGrades) GetCourseID() uint64 {
 	if x != nil {
 		return x.CourseID
 	}
 	return 0
 }
 
-func (x *UpdateSubmissionsRequest) GetAssignmentID() uint64 {
-	if x != nil {
-		return x.AssignmentID
-	}
-	return 0
-}
-
-func (x *UpdateSubmissionsRequest) GetScoreLimit() uint32 {
-	if x != nil {
-		return x.ScoreLimit
-	}
-	return 0
-}
-
-func (x *UpdateSubmissionsRequest) GetRelease() bool {
+func (x *FinalGrades) GetGrades() []*FinalGrade {
 	if x != nil {
-		return x.Release
+		return x.Grades
 	}
-	return false
+	return nil
 }
 
-func (x *UpdateSubmissionsRequest) GetApprove() bool {
+func (x *FinalGrades) GetComputedAt() string {
 	if x != nil {
-		return x.Approve
+		return x.ComputedAt
 	}
-	return false
+	return ""
 }
 
-type SubmissionReviewersRequest struct {
+// CourseCompletion is the completion status of the students in a course, according to the course's
+// pass requirement: at least minApproved assignments approved, including all mandatory assignments.
+type CourseCompletion struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SubmissionID uint64 `protobuf:"varint,1,opt,name=submissionID,proto3" json:"submissionID,omitempty"`
-	CourseID     uint64 `protobuf:"varint,2,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	CourseID    uint64               `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	MinApproved uint32               `protobuf:"varint,2,opt,name=minApproved,proto3" json:"minApproved,omitempty"`
+	Mandatory   []uint64             `protobuf:"varint,3,rep,packed,name=mandatory,proto3" json:"mandatory,omitempty"` // IDs of the course's mandatory assignments
+	Students    []*StudentCompletion `protobuf:"bytes,4,rep,name=students,proto3" json:"students,omitempty"`
 }
 
-func (x *SubmissionReviewersRequest) Reset() {
-	*x = SubmissionReviewersRequest{}
+func (x *CourseCompletion) Reset() {
+	*x = CourseCompletion{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[37]
+		mi := &file_ag_ag_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SubmissionReviewersRequest) String() string {
+func (x *CourseCompletion) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubmissionReviewersRequest) ProtoMessage() {}
+func (*CourseCompletion) ProtoMessage() {}
 
-func (x *SubmissionReviewersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[37]
+func (x *CourseCompletion) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3095,50 +3666,67 @@ func (x *SubmissionReviewersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubmissionReviewersRequest.ProtoReflect.Descriptor instead.
-func (*SubmissionReviewersRequest) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{37}
+// Deprecated: Use CourseCompletion.ProtoReflect.Descriptor instead.
+func (*CourseCompletion) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *SubmissionReviewersRequest) GetSubmissionID() uint64 {
+func (x *CourseCompletion) GetCourseID() uint64 {
 	if x != nil {
-		return x.SubmissionID
+		return x.CourseID
 	}
 	return 0
 }
 
-func (x *SubmissionReviewersRequest) GetCourseID() uint64 {
+func (x *CourseCompletion) GetMinApproved() uint32 {
 	if x != nil {
-		return x.CourseID
+		return x.MinApproved
 	}
 	return 0
 }
 
-type Providers struct {
+func (x *CourseCompletion) GetMandatory() []uint64 {
+	if x != nil {
+		return x.Mandatory
+	}
+	return nil
+}
+
+func (x *CourseCompletion) GetStudents() []*StudentCompletion {
+	if x != nil {
+		return x.Students
+	}
+	return nil
+}
+
+type StudentCompletion struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Providers []string `protobuf:"bytes,1,rep,name=providers,proto3" json:"providers,omitempty"`
+	Enrollment       *Enrollment `protobuf:"bytes,1,opt,name=enrollment,proto3" json:"enrollment,omitempty"`
+	Approved         uint32      `protobuf:"varint,2,opt,name=approved,proto3" json:"approved,omitempty"`                        // number of approved assignments
+	MissingMandatory []uint64    `protobuf:"varint,3,rep,packed,name=missingMandatory,proto3" json:"missingMandatory,omitempty"` // IDs of mandatory assignments not approved
+	Completed        bool        `protobuf:"varint,4,opt,name=completed,proto3" json:"completed,omitempty"`                      // true => the course's pass requirement is met
 }
 
-func (x *Providers) Reset() {
-	*x = Providers{}
+func (x *StudentCompletion) Reset() {
+	*x = StudentCompletion{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[38]
+		mi := &file_ag_ag_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Providers) String() string {
+func (x *StudentCompletion) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Providers) ProtoMessage() {}
+func (*StudentCompletion) ProtoMessage() {}
 
-func (x *Providers) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[38]
+func (x *StudentCompletion) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3149,44 +3737,67 @@ func (x *Providers) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Providers.ProtoReflect.Descriptor instead.
-func (*Providers) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{38}
+// Deprecated: Use StudentCompletion.ProtoReflect.Descriptor instead.
+func (*StudentCompletion) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *Providers) GetProviders() []string {
+func (x *StudentCompletion) GetEnrollment() *Enrollment {
 	if x != nil {
-		return x.Providers
+		return x.Enrollment
 	}
 	return nil
 }
 
-type URLRequest struct {
+func (x *StudentCompletion) GetApproved() uint32 {
+	if x != nil {
+		return x.Approved
+	}
+	return 0
+}
+
+func (x *StudentCompletion) GetMissingMandatory() []uint64 {
+	if x != nil {
+		return x.MissingMandatory
+	}
+	return nil
+}
+
+func (x *StudentCompletion) GetCompleted() bool {
+	if x != nil {
+		return x.Completed
+	}
+	return false
+}
+
+// ResultsExportRequest selects the course whose results are exported, and the file format.
+type ResultsExportRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CourseID  uint64            `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
-	RepoTypes []Repository_Type `protobuf:"varint,2,rep,packed,name=repoTypes,proto3,enum=ag.Repository_Type" json:"repoTypes,omitempty"`
+	CourseID      uint64                      `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	Format        ResultsExportRequest_Format `protobuf:"varint,2,opt,name=format,proto3,enum=ag.ResultsExportRequest_Format" json:"format,omitempty"`
+	IncludeGroups bool                        `protobuf:"varint,3,opt,name=includeGroups,proto3" json:"includeGroups,omitempty"` // true => also export one row of group assignment results per group
 }
 
-func (x *URLRequest) Reset() {
-	*x = URLRequest{}
+func (x *ResultsExportRequest) Reset() {
+	*x = ResultsExportRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[39]
+		mi := &file_ag_ag_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *URLRequest) String() string {
+func (x *ResultsExportRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*URLRequest) ProtoMessage() {}
+func (*ResultsExportRequest) ProtoMessage() {}
 
-func (x *URLRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[39]
+func (x *ResultsExportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3197,53 +3808,60 @@ func (x *URLRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use URLRequest.ProtoReflect.Descriptor instead.
-func (*URLRequest) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{39}
+// Deprecated: Use ResultsExportRequest.ProtoReflect.Descriptor instead.
+func (*ResultsExportRequest) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *URLRequest) GetCourseID() uint64 {
+func (x *ResultsExportRequest) GetCourseID() uint64 {
 	if x != nil {
 		return x.CourseID
 	}
 	return 0
 }
 
-func (x *URLRequest) GetRepoTypes() []Repository_Type {
+func (x *ResultsExportRequest) GetFormat() ResultsExportRequest_Format {
 	if x != nil {
-		return x.RepoTypes
+		return x.Format
 	}
-	return nil
+	return ResultsExportRequest_CSV
 }
 
-// used to check whether student/group submission repo is empty
-type RepositoryRequest struct {
+func (x *ResultsExportRequest) GetIncludeGroups() bool {
+	if x != nil {
+		return x.IncludeGroups
+	}
+	return false
+}
+
+// ResultsExport is a file with one row per student and a score and status column per assignment.
+type ResultsExport struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserID   uint64 `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
-	GroupID  uint64 `protobuf:"varint,2,opt,name=groupID,proto3" json:"groupID,omitempty"`
-	CourseID uint64 `protobuf:"varint,3,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	FileName    string `protobuf:"bytes,1,opt,name=fileName,proto3" json:"fileName,omitempty"`
+	ContentType string `protobuf:"bytes,2,opt,name=contentType,proto3" json:"contentType,omitempty"`
+	Content     []byte `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
 }
 
-func (x *RepositoryRequest) Reset() {
-	*x = RepositoryRequest{}
+func (x *ResultsExport) Reset() {
+	*x = ResultsExport{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[40]
+		mi := &file_ag_ag_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RepositoryRequest) String() string {
+func (x *ResultsExport) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RepositoryRequest) ProtoMessage() {}
+func (*ResultsExport) ProtoMessage() {}
 
-func (x *RepositoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[40]
+func (x *ResultsExport) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3254,57 +3872,61 @@ func (x *RepositoryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RepositoryRequest.ProtoReflect.Descriptor instead.
-func (*RepositoryRequest) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{40}
+// Deprecated: Use ResultsExport.ProtoReflect.Descriptor instead.
+func (*ResultsExport) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *RepositoryRequest) GetUserID() uint64 {
+func (x *ResultsExport) GetFileName() string {
 	if x != nil {
-		return x.UserID
+		return x.FileName
 	}
-	return 0
+	return ""
 }
 
-func (x *RepositoryRequest) GetGroupID() uint64 {
+func (x *ResultsExport) GetContentType() string {
 	if x != nil {
-		return x.GroupID
+		return x.ContentType
 	}
-	return 0
+	return ""
 }
 
-func (x *RepositoryRequest) GetCourseID() uint64 {
+func (x *ResultsExport) GetContent() []byte {
 	if x != nil {
-		return x.CourseID
+		return x.Content
 	}
-	return 0
+	return nil
 }
 
-type Repositories struct {
+// CourseResults is a course's results matrix, holding the latest submission
+// of each student and group to each of the course's assignments.
+type CourseResults struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	URLs map[string]string `protobuf:"bytes,1,rep,name=URLs,proto3" json:"URLs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	CourseID    uint64        `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	Assignments []*Assignment `protobuf:"bytes,2,rep,name=assignments,proto3" json:"assignments,omitempty"` // course assignments in order, without submissions
+	Rows        []*ResultsRow `protobuf:"bytes,3,rep,name=rows,proto3" json:"rows,omitempty"`               // one row per student, followed by one row per group
 }
 
-func (x *Repositories) Reset() {
-	*x = Repositories{}
+func (x *CourseResults) Reset() {
+	*x = CourseResults{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[41]
+		mi := &file_ag_ag_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Repositories) String() string {
+func (x *CourseResults) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Repositories) ProtoMessage() {}
+func (*CourseResults) ProtoMessage() {}
 
-func (x *Repositories) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[41]
+func (x *CourseResults) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3315,43 +3937,63 @@ func (x *Repositories) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Repositories.ProtoReflect.Descriptor instead.
-func (*Repositories) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{41}
+// Deprecated: Use CourseResults.ProtoReflect.Descriptor instead.
+func (*CourseResults) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *Repositories) GetURLs() map[string]string {
+func (x *CourseResults) GetCourseID() uint64 {
 	if x != nil {
-		return x.URLs
+		return x.CourseID
+	}
+	return 0
+}
+
+func (x *CourseResults) GetAssignments() []*Assignment {
+	if x != nil {
+		return x.Assignments
 	}
 	return nil
 }
 
-type AuthorizationResponse struct {
+func (x *CourseResults) GetRows() []*ResultsRow {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+// ResultsRow holds the latest submissions of a student or group, one per assignment in the
+// order of the matrix' assignments. Assignments without a submission have an empty submission.
+// Student rows hold the submissions of the student's group to group assignments,
+// while group rows only hold submissions to group assignments.
+type ResultsRow struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	IsAuthorized bool `protobuf:"varint,1,opt,name=IsAuthorized,proto3" json:"IsAuthorized,omitempty"`
+	Enrollment  *Enrollment   `protobuf:"bytes,1,opt,name=enrollment,proto3" json:"enrollment,omitempty"` // the student's enrollment, with user and group preloaded; nil for group rows
+	Group       *Group        `protobuf:"bytes,2,opt,name=group,proto3" json:"group,omitempty"`           // nil for student rows
+	Submissions []*Submission `protobuf:"bytes,3,rep,name=submissions,proto3" json:"submissions,omitempty"`
 }
 
-func (x *AuthorizationResponse) Reset() {
-	*x = AuthorizationResponse{}
+func (x *ResultsRow) Reset() {
+	*x = ResultsRow{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[42]
+		mi := &file_ag_ag_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AuthorizationResponse) String() string {
+func (x *ResultsRow) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AuthorizationResponse) ProtoMessage() {}
+func (*ResultsRow) ProtoMessage() {}
 
-func (x *AuthorizationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[42]
+func (x *ResultsRow) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3362,44 +4004,60 @@ func (x *AuthorizationResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AuthorizationResponse.ProtoReflect.Descriptor instead.
-func (*AuthorizationResponse) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{42}
+// Deprecated: Use ResultsRow.ProtoReflect.Descriptor instead.
+func (*ResultsRow) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *AuthorizationResponse) GetIsAuthorized() bool {
+func (x *ResultsRow) GetEnrollment() *Enrollment {
 	if x != nil {
-		return x.IsAuthorized
+		return x.Enrollment
 	}
-	return false
+	return nil
 }
 
-type Status struct {
+func (x *ResultsRow) GetGroup() *Group {
+	if x != nil {
+		return x.Group
+	}
+	return nil
+}
+
+func (x *ResultsRow) GetSubmissions() []*Submission {
+	if x != nil {
+		return x.Submissions
+	}
+	return nil
+}
+
+// CourseStatistics holds aggregates of the submissions to each of a course's assignments,
+// for showing the course's health on the teacher dashboard.
+type CourseStatistics struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Code  uint64 `protobuf:"varint,1,opt,name=Code,proto3" json:"Code,omitempty"`
-	Error string `protobuf:"bytes,2,opt,name=Error,proto3" json:"Error,omitempty"`
+	CourseID    uint64                  `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	Assignments []*AssignmentStatistics `protobuf:"bytes,2,rep,name=assignments,proto3" json:"assignments,omitempty"` // in assignment order
 }
 
-func (x *Status) Reset() {
-	*x = Status{}
+func (x *CourseStatistics) Reset() {
+	*x = CourseStatistics{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[43]
+		mi := &file_ag_ag_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Status) String() string {
+func (x *CourseStatistics) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Status) ProtoMessage() {}
+func (*CourseStatistics) ProtoMessage() {}
 
-func (x *Status) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[43]
+func (x *CourseStatistics) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3410,52 +4068,59 @@ func (x *Status) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Status.ProtoReflect.Descriptor instead.
-func (*Status) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{43}
+// Deprecated: Use CourseStatistics.ProtoReflect.Descriptor instead.
+func (*CourseStatistics) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *Status) GetCode() uint64 {
+func (x *CourseStatistics) GetCourseID() uint64 {
 	if x != nil {
-		return x.Code
+		return x.CourseID
 	}
 	return 0
 }
 
-func (x *Status) GetError() string {
+func (x *CourseStatistics) GetAssignments() []*AssignmentStatistics {
 	if x != nil {
-		return x.Error
+		return x.Assignments
 	}
-	return ""
+	return nil
 }
 
-type SubmissionsForCourseRequest struct {
+// AssignmentStatistics holds aggregates of the latest submission of each student or group
+// to an assignment, and the number of submissions built on each day.
+type AssignmentStatistics struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CourseID      uint64                           `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
-	Type          SubmissionsForCourseRequest_Type `protobuf:"varint,2,opt,name=type,proto3,enum=ag.SubmissionsForCourseRequest_Type" json:"type,omitempty"`
-	WithBuildInfo bool                             `protobuf:"varint,3,opt,name=withBuildInfo,proto3" json:"withBuildInfo,omitempty"`
+	AssignmentID      uint64              `protobuf:"varint,1,opt,name=assignmentID,proto3" json:"assignmentID,omitempty"`
+	Name              string              `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Submissions       uint32              `protobuf:"varint,3,opt,name=submissions,proto3" json:"submissions,omitempty"` // number of students and groups with a submission
+	Approved          uint32              `protobuf:"varint,4,opt,name=approved,proto3" json:"approved,omitempty"`       // number of approved submissions
+	PassRate          float64             `protobuf:"fixed64,5,opt,name=passRate,proto3" json:"passRate,omitempty"`      // percentage of submissions approved
+	AverageScore      float64             `protobuf:"fixed64,6,opt,name=averageScore,proto3" json:"averageScore,omitempty"`
+	ScoreDistribution []uint32            `protobuf:"varint,7,rep,packed,name=scoreDistribution,proto3" json:"scoreDistribution,omitempty"` // number of submissions with scores 0-9, 10-19, ..., 90-100
+	SubmissionsPerDay []*DailySubmissions `protobuf:"bytes,8,rep,name=submissionsPerDay,proto3" json:"submissionsPerDay,omitempty"`         // in date order; days without submissions are omitted
 }
 
-func (x *SubmissionsForCourseRequest) Reset() {
-	*x = SubmissionsForCourseRequest{}
+func (x *AssignmentStatistics) Reset() {
+	*x = AssignmentStatistics{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[44]
+		mi := &file_ag_ag_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SubmissionsForCourseRequest) String() string {
+func (x *AssignmentStatistics) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubmissionsForCourseRequest) ProtoMessage() {}
+func (*AssignmentStatistics) ProtoMessage() {}
 
-func (x *SubmissionsForCourseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[44]
+func (x *AssignmentStatistics) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3466,58 +4131,96 @@ func (x *SubmissionsForCourseRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubmissionsForCourseRequest.ProtoReflect.Descriptor instead.
-func (*SubmissionsForCourseRequest) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{44}
+// Deprecated: Use AssignmentStatistics.ProtoReflect.Descriptor instead.
+func (*AssignmentStatistics) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *SubmissionsForCourseRequest) GetCourseID() uint64 {
+func (x *AssignmentStatistics) GetAssignmentID() uint64 {
 	if x != nil {
-		return x.CourseID
+		return x.AssignmentID
 	}
 	return 0
 }
 
-func (x *SubmissionsForCourseRequest) GetType() SubmissionsForCourseRequest_Type {
+func (x *AssignmentStatistics) GetName() string {
 	if x != nil {
-		return x.Type
+		return x.Name
 	}
-	return SubmissionsForCourseRequest_ALL
+	return ""
 }
 
-func (x *SubmissionsForCourseRequest) GetWithBuildInfo() bool {
+func (x *AssignmentStatistics) GetSubmissions() uint32 {
 	if x != nil {
-		return x.WithBuildInfo
+		return x.Submissions
 	}
-	return false
+	return 0
 }
 
-type RebuildRequest struct {
+func (x *AssignmentStatistics) GetApproved() uint32 {
+	if x != nil {
+		return x.Approved
+	}
+	return 0
+}
+
+func (x *AssignmentStatistics) GetPassRate() float64 {
+	if x != nil {
+		return x.PassRate
+	}
+	return 0
+}
+
+func (x *AssignmentStatistics) GetAverageScore() float64 {
+	if x != nil {
+		return x.AverageScore
+	}
+	return 0
+}
+
+func (x *AssignmentStatistics) GetScoreDistribution() []uint32 {
+	if x != nil {
+		return x.ScoreDistribution
+	}
+	return nil
+}
+
+func (x *AssignmentStatistics) GetSubmissionsPerDay() []*DailySubmissions {
+	if x != nil {
+		return x.SubmissionsPerDay
+	}
+	return nil
+}
+
+// TestFailureStats holds the number of times each test of an assignment passed and failed
+// in the latest test run of each student's and group's submission to the assignment.
+type TestFailureStats struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SubmissionID uint64 `protobuf:"varint,1,opt,name=submissionID,proto3" json:"submissionID,omitempty"`
-	AssignmentID uint64 `protobuf:"varint,2,opt,name=assignmentID,proto3" json:"assignmentID,omitempty"`
+	AssignmentID uint64            `protobuf:"varint,1,opt,name=assignmentID,proto3" json:"assignmentID,omitempty"`
+	Submissions  uint32            `protobuf:"varint,2,opt,name=submissions,proto3" json:"submissions,omitempty"` // number of latest submissions with test results
+	Tests        []*TestStatistics `protobuf:"bytes,3,rep,name=tests,proto3" json:"tests,omitempty"`              // most often failed first
 }
 
-func (x *RebuildRequest) Reset() {
-	*x = RebuildRequest{}
+func (x *TestFailureStats) Reset() {
+	*x = TestFailureStats{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[45]
+		mi := &file_ag_ag_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RebuildRequest) String() string {
+func (x *TestFailureStats) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RebuildRequest) ProtoMessage() {}
+func (*TestFailureStats) ProtoMessage() {}
 
-func (x *RebuildRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[45]
+func (x *TestFailureStats) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3528,52 +4231,61 @@ func (x *RebuildRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RebuildRequest.ProtoReflect.Descriptor instead.
-func (*RebuildRequest) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{45}
+// Deprecated: Use TestFailureStats.ProtoReflect.Descriptor instead.
+func (*TestFailureStats) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *RebuildRequest) GetSubmissionID() uint64 {
+func (x *TestFailureStats) GetAssignmentID() uint64 {
 	if x != nil {
-		return x.SubmissionID
+		return x.AssignmentID
 	}
 	return 0
 }
 
-func (x *RebuildRequest) GetAssignmentID() uint64 {
+func (x *TestFailureStats) GetSubmissions() uint32 {
 	if x != nil {
-		return x.AssignmentID
+		return x.Submissions
 	}
 	return 0
 }
 
-type CourseUserRequest struct {
+func (x *TestFailureStats) GetTests() []*TestStatistics {
+	if x != nil {
+		return x.Tests
+	}
+	return nil
+}
+
+type TestStatistics struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CourseCode string `protobuf:"bytes,1,opt,name=courseCode,proto3" json:"courseCode,omitempty"`
-	CourseYear uint32 `protobuf:"varint,2,opt,name=courseYear,proto3" json:"courseYear,omitempty"`
-	UserLogin  string `protobuf:"bytes,3,opt,name=userLogin,proto3" json:"userLogin,omitempty"`
+	Name        string  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Passed      uint32  `protobuf:"varint,2,opt,name=passed,proto3" json:"passed,omitempty"`
+	Failed      uint32  `protobuf:"varint,3,opt,name=failed,proto3" json:"failed,omitempty"`
+	Skipped     uint32  `protobuf:"varint,4,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	FailureRate float64 `protobuf:"fixed64,5,opt,name=failureRate,proto3" json:"failureRate,omitempty"` // percentage of the test's passed and failed runs that failed
 }
 
-func (x *CourseUserRequest) Reset() {
-	*x = CourseUserRequest{}
+func (x *TestStatistics) Reset() {
+	*x = TestStatistics{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[46]
+		mi := &file_ag_ag_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CourseUserRequest) String() string {
+func (x *TestStatistics) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CourseUserRequest) ProtoMessage() {}
+func (*TestStatistics) ProtoMessage() {}
 
-func (x *CourseUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[46]
+func (x *TestStatistics) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3584,58 +4296,72 @@ func (x *CourseUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CourseUserRequest.ProtoReflect.Descriptor instead.
-func (*CourseUserRequest) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{46}
+// Deprecated: Use TestStatistics.ProtoReflect.Descriptor instead.
+func (*TestStatistics) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *CourseUserRequest) GetCourseCode() string {
+func (x *TestStatistics) GetName() string {
 	if x != nil {
-		return x.CourseCode
+		return x.Name
 	}
 	return ""
 }
 
-func (x *CourseUserRequest) GetCourseYear() uint32 {
+func (x *TestStatistics) GetPassed() uint32 {
 	if x != nil {
-		return x.CourseYear
+		return x.Passed
 	}
 	return 0
 }
 
-func (x *CourseUserRequest) GetUserLogin() string {
+func (x *TestStatistics) GetFailed() uint32 {
 	if x != nil {
-		return x.UserLogin
+		return x.Failed
 	}
-	return ""
+	return 0
 }
 
-type AssignmentRequest struct {
+func (x *TestStatistics) GetSkipped() uint32 {
+	if x != nil {
+		return x.Skipped
+	}
+	return 0
+}
+
+func (x *TestStatistics) GetFailureRate() float64 {
+	if x != nil {
+		return x.FailureRate
+	}
+	return 0
+}
+
+type DailySubmissions struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CourseID     uint64 `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
-	AssignmentID uint64 `protobuf:"varint,2,opt,name=assignmentID,proto3" json:"assignmentID,omitempty"`
+	Date  string `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"` // YYYY-MM-DD
+	Count uint32 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
 }
 
-func (x *AssignmentRequest) Reset() {
-	*x = AssignmentRequest{}
+func (x *DailySubmissions) Reset() {
+	*x = DailySubmissions{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[47]
+		mi := &file_ag_ag_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AssignmentRequest) String() string {
+func (x *DailySubmissions) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AssignmentRequest) ProtoMessage() {}
+func (*DailySubmissions) ProtoMessage() {}
 
-func (x *AssignmentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[47]
+func (x *DailySubmissions) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3646,51 +4372,51 @@ func (x *AssignmentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AssignmentRequest.ProtoReflect.Descriptor instead.
-func (*AssignmentRequest) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{47}
+// Deprecated: Use DailySubmissions.ProtoReflect.Descriptor instead.
+func (*DailySubmissions) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *AssignmentRequest) GetCourseID() uint64 {
+func (x *DailySubmissions) GetDate() string {
 	if x != nil {
-		return x.CourseID
+		return x.Date
 	}
-	return 0
+	return ""
 }
 
-func (x *AssignmentRequest) GetAssignmentID() uint64 {
+func (x *DailySubmissions) GetCount() uint32 {
 	if x != nil {
-		return x.AssignmentID
+		return x.Count
 	}
 	return 0
 }
 
-// Void contains no fields. A server response with a Void still contains a gRPC status code,
-// which can be checked for success or failure. Status code 0 indicates that the requested action was successful,
-// whereas any other status code indicates some failure. As such, the status code can be used as a boolean result from the server.
-type Void struct {
+// UserProgress holds the user's progress in each course the user is enrolled in as a student.
+type UserProgress struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Courses []*CourseProgress `protobuf:"bytes,1,rep,name=courses,proto3" json:"courses,omitempty"`
 }
 
-func (x *Void) Reset() {
-	*x = Void{}
+func (x *UserProgress) Reset() {
+	*x = UserProgress{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ag_ag_proto_msgTypes[48]
+		mi := &file_ag_ag_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Void) String() string {
+func (x *UserProgress) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Void) ProtoMessage() {}
+func (*UserProgress) ProtoMessage() {}
 
-func (x *Void) ProtoReflect() protoreflect.Message {
-	mi := &file_ag_ag_proto_msgTypes[48]
+func (x *UserProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3701,875 +4427,15019 @@ func (x *Void) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Void.ProtoReflect.Descriptor instead.
-func (*Void) Descriptor() ([]byte, []int) {
-	return file_ag_ag_proto_rawDescGZIP(), []int{48}
+// Deprecated: Use UserProgress.ProtoReflect.Descriptor instead.
+func (*UserProgress) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{38}
 }
 
-var File_ag_ag_proto protoreflect.FileDescriptor
+func (x *UserProgress) GetCourses() []*CourseProgress {
+	if x != nil {
+		return x.Courses
+	}
+	return nil
+}
 
-var file_ag_ag_proto_rawDesc = []byte{
-	0x0a, 0x0b, 0x61, 0x67, 0x2f, 0x61, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x61,
-	0x67, 0x1a, 0x15, 0x6b, 0x69, 0x74, 0x2f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x73, 0x63, 0x6f,
-	0x72, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0e, 0x70, 0x61, 0x74, 0x63, 0x68, 0x2f,
-	0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x9e, 0x02, 0x0a, 0x04, 0x55, 0x73, 0x65,
-	0x72, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49,
-	0x44, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x73, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x07, 0x69, 0x73, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
-	0x1c, 0x0a, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x14, 0x0a,
-	0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d,
-	0x61, 0x69, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x55, 0x52, 0x4c,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x55, 0x52,
-	0x4c, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x3e, 0x0a, 0x10, 0x72, 0x65, 0x6d, 0x6f, 0x74,
-	0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x12, 0x2e, 0x61, 0x67, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x49, 0x64, 0x65,
-	0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x10, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x49, 0x64, 0x65,
-	0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x30, 0x0a, 0x0b, 0x65, 0x6e, 0x72, 0x6f, 0x6c,
-	0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61,
-	0x67, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x65, 0x6e,
-	0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x27, 0x0a, 0x05, 0x55, 0x73, 0x65,
-	0x72, 0x73, 0x12, 0x1e, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x75, 0x73, 0x65,
-	0x72, 0x73, 0x22, 0xf6, 0x01, 0x0a, 0x0e, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x49, 0x64, 0x65,
-	0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x4c, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
-	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xca, 0xb5, 0x03, 0x2c, 0xa2, 0x01, 0x29,
-	0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x6e, 0x64, 0x65,
-	0x78, 0x3a, 0x75, 0x69, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x72,
-	0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x22, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69,
-	0x64, 0x65, 0x72, 0x12, 0x4c, 0x0a, 0x08, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x49, 0x44, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x30, 0xca, 0xb5, 0x03, 0x2c, 0xa2, 0x01, 0x29, 0x67, 0x6f,
-	0x72, 0x6d, 0x3a, 0x22, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x3a,
-	0x75, 0x69, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x6d,
-	0x6f, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x22, 0x52, 0x08, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x49,
-	0x44, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f,
-	0x6b, 0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x92, 0x03, 0x0a, 0x05,
-	0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x43, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x2f, 0xca, 0xb5, 0x03, 0x2b, 0xa2, 0x01, 0x28, 0x67, 0x6f, 0x72, 0x6d,
-	0x3a, 0x22, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x3a, 0x69, 0x64,
-	0x78, 0x5f, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x6e,
-	0x61, 0x6d, 0x65, 0x22, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x4b, 0x0a, 0x08, 0x63, 0x6f,
-	0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2f, 0xca, 0xb5,
-	0x03, 0x2b, 0xa2, 0x01, 0x28, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x75, 0x6e, 0x69, 0x71, 0x75,
-	0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x3a, 0x69, 0x64, 0x78, 0x5f, 0x75, 0x6e, 0x69, 0x71, 0x75,
-	0x65, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x52, 0x08, 0x63,
-	0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x65, 0x61, 0x6d, 0x49,
-	0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x12,
-	0x2d, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x15, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x44,
-	0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x08, 0x2e,
-	0x61, 0x67, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x42, 0x24, 0xca, 0xb5, 0x03, 0x20, 0xa2, 0x01, 0x1d,
-	0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x6d, 0x61, 0x6e, 0x79, 0x32, 0x6d, 0x61, 0x6e, 0x79, 0x3a,
-	0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x3b, 0x22, 0x52, 0x05, 0x75,
-	0x73, 0x65, 0x72, 0x73, 0x12, 0x30, 0x0a, 0x0b, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65,
-	0x6e, 0x74, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x67, 0x2e, 0x45,
-	0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x65, 0x6e, 0x72, 0x6f, 0x6c,
-	0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x28, 0x0a, 0x0b, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47,
-	0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x41, 0x50, 0x50, 0x52, 0x4f, 0x56, 0x45, 0x44, 0x10, 0x01,
-	0x22, 0x2b, 0x0a, 0x06, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x21, 0x0a, 0x06, 0x67, 0x72,
-	0x6f, 0x75, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61, 0x67, 0x2e,
-	0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x22, 0x8b, 0x04,
-	0x0a, 0x06, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x28, 0x0a, 0x0f, 0x63, 0x6f, 0x75, 0x72,
-	0x73, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x0f, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72,
-	0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x79, 0x65,
-	0x61, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x79, 0x65, 0x61, 0x72, 0x12, 0x10,
-	0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67,
-	0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x26, 0x0a, 0x0e,
-	0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x08,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x49, 0x44, 0x12, 0x2a, 0x0a, 0x10, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x74, 0x68, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10,
-	0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x74, 0x68,
-	0x12, 0x1a, 0x0a, 0x08, 0x73, 0x6c, 0x69, 0x70, 0x44, 0x61, 0x79, 0x73, 0x18, 0x0a, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x08, 0x73, 0x6c, 0x69, 0x70, 0x44, 0x61, 0x79, 0x73, 0x12, 0x1e, 0x0a, 0x0a,
-	0x64, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x64, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x46, 0x0a, 0x08,
-	0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19,
-	0x2e, 0x61, 0x67, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x55,
-	0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x0f, 0xca, 0xb5, 0x03, 0x0b, 0xa2,
-	0x01, 0x08, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x2d, 0x22, 0x52, 0x08, 0x65, 0x6e, 0x72, 0x6f,
-	0x6c, 0x6c, 0x65, 0x64, 0x12, 0x30, 0x0a, 0x0b, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65,
-	0x6e, 0x74, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x67, 0x2e, 0x45,
-	0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x65, 0x6e, 0x72, 0x6f, 0x6c,
-	0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x30, 0x0a, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e,
-	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x67,
-	0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x61, 0x73, 0x73,
-	0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x21, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75,
-	0x70, 0x73, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72,
-	0x6f, 0x75, 0x70, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x22, 0x2f, 0x0a, 0x07, 0x43,
-	0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x07, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x61, 0x67, 0x2e, 0x43, 0x6f, 0x75,
-	0x72, 0x73, 0x65, 0x52, 0x07, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x22, 0xe8, 0x03, 0x0a,
-	0x0a, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x49,
-	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x52, 0x0a, 0x0e, 0x6f,
-	0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x04, 0x42, 0x2a, 0xca, 0xb5, 0x03, 0x26, 0xa2, 0x01, 0x23, 0x67, 0x6f, 0x72, 0x6d,
-	0x3a, 0x22, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x3a, 0x75, 0x69,
-	0x64, 0x5f, 0x67, 0x69, 0x64, 0x5f, 0x6f, 0x72, 0x67, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x22, 0x52,
-	0x0e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12,
-	0x22, 0x0a, 0x0c, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x49, 0x44, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72,
-	0x79, 0x49, 0x44, 0x12, 0x42, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x04, 0x42, 0x2a, 0xca, 0xb5, 0x03, 0x26, 0xa2, 0x01, 0x23, 0x67, 0x6f, 0x72, 0x6d,
-	0x3a, 0x22, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x3a, 0x75, 0x69,
-	0x64, 0x5f, 0x67, 0x69, 0x64, 0x5f, 0x6f, 0x72, 0x67, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x22, 0x52,
-	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x44, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70,
-	0x49, 0x44, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2a, 0xca, 0xb5, 0x03, 0x26, 0xa2, 0x01,
-	0x23, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x6e, 0x64,
-	0x65, 0x78, 0x3a, 0x75, 0x69, 0x64, 0x5f, 0x67, 0x69, 0x64, 0x5f, 0x6f, 0x72, 0x67, 0x5f, 0x74,
-	0x79, 0x70, 0x65, 0x22, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x12, 0x18, 0x0a,
-	0x07, 0x48, 0x54, 0x4d, 0x4c, 0x55, 0x52, 0x4c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x48, 0x54, 0x4d, 0x4c, 0x55, 0x52, 0x4c, 0x12, 0x5b, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x54,
-	0x79, 0x70, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x61, 0x67, 0x2e, 0x52,
-	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x42, 0x2a,
-	0xca, 0xb5, 0x03, 0x26, 0xa2, 0x01, 0x23, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x75, 0x6e, 0x69,
-	0x71, 0x75, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x3a, 0x75, 0x69, 0x64, 0x5f, 0x67, 0x69, 0x64,
-	0x5f, 0x6f, 0x72, 0x67, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x22, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f,
-	0x54, 0x79, 0x70, 0x65, 0x22, 0x51, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x08, 0x0a, 0x04,
-	0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x43, 0x4f, 0x55, 0x52, 0x53, 0x45,
-	0x49, 0x4e, 0x46, 0x4f, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x41, 0x53, 0x53, 0x49, 0x47, 0x4e,
-	0x4d, 0x45, 0x4e, 0x54, 0x53, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05, 0x54, 0x45, 0x53, 0x54, 0x53,
-	0x10, 0x03, 0x12, 0x08, 0x0a, 0x04, 0x55, 0x53, 0x45, 0x52, 0x10, 0x04, 0x12, 0x09, 0x0a, 0x05,
-	0x47, 0x52, 0x4f, 0x55, 0x50, 0x10, 0x05, 0x22, 0x89, 0x06, 0x0a, 0x0a, 0x45, 0x6e, 0x72, 0x6f,
-	0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x4b, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65,
-	0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2f, 0xca, 0xb5, 0x03, 0x2b, 0xa2, 0x01,
-	0x28, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x6e, 0x64,
-	0x65, 0x78, 0x3a, 0x69, 0x64, 0x78, 0x5f, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x65, 0x6e,
-	0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73,
-	0x65, 0x49, 0x44, 0x12, 0x47, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x04, 0x42, 0x2f, 0xca, 0xb5, 0x03, 0x2b, 0xa2, 0x01, 0x28, 0x67, 0x6f, 0x72, 0x6d,
-	0x3a, 0x22, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x3a, 0x69, 0x64,
-	0x78, 0x5f, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d,
-	0x65, 0x6e, 0x74, 0x22, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07,
-	0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x67,
-	0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x12, 0x2a, 0x0a, 0x10, 0x68, 0x61, 0x73, 0x54, 0x65, 0x61,
-	0x63, 0x68, 0x65, 0x72, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x10, 0x68, 0x61, 0x73, 0x54, 0x65, 0x61, 0x63, 0x68, 0x65, 0x72, 0x53, 0x63, 0x6f, 0x70,
-	0x65, 0x73, 0x12, 0x1c, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72,
-	0x12, 0x22, 0x0a, 0x06, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x0a, 0x2e, 0x61, 0x67, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x06, 0x63, 0x6f,
-	0x75, 0x72, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x08, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x05,
-	0x67, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x31, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
-	0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x61, 0x67, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c,
-	0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x31, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74,
-	0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x61, 0x67, 0x2e, 0x45, 0x6e, 0x72,
-	0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x53,
-	0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x3d, 0x0a, 0x11, 0x73,
-	0x6c, 0x69, 0x70, 0x44, 0x61, 0x79, 0x73, 0x52, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67,
-	0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0f, 0xca, 0xb5, 0x03, 0x0b, 0xa2, 0x01, 0x08, 0x67,
-	0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x2d, 0x22, 0x52, 0x11, 0x73, 0x6c, 0x69, 0x70, 0x44, 0x61, 0x79,
-	0x73, 0x52, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x2a, 0x0a, 0x10, 0x6c, 0x61,
-	0x73, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x44, 0x61, 0x74, 0x65, 0x18, 0x0c,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x6c, 0x61, 0x73, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69,
-	0x74, 0x79, 0x44, 0x61, 0x74, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x41,
-	0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x74,
-	0x6f, 0x74, 0x61, 0x6c, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x12, 0x34, 0x0a, 0x0c,
-	0x75, 0x73, 0x65, 0x64, 0x53, 0x6c, 0x69, 0x70, 0x44, 0x61, 0x79, 0x73, 0x18, 0x0e, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x61, 0x67, 0x2e, 0x55, 0x73, 0x65, 0x64, 0x53, 0x6c, 0x69, 0x70,
-	0x44, 0x61, 0x79, 0x73, 0x52, 0x0c, 0x75, 0x73, 0x65, 0x64, 0x53, 0x6c, 0x69, 0x70, 0x44, 0x61,
-	0x79, 0x73, 0x22, 0x3d, 0x0a, 0x0a, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x45,
-	0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x54, 0x55, 0x44, 0x45,
-	0x4e, 0x54, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x54, 0x45, 0x41, 0x43, 0x48, 0x45, 0x52, 0x10,
-	0x03, 0x22, 0x40, 0x0a, 0x0c, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x53, 0x74, 0x61, 0x74,
-	0x65, 0x12, 0x09, 0x0a, 0x05, 0x55, 0x4e, 0x53, 0x45, 0x54, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06,
-	0x48, 0x49, 0x44, 0x44, 0x45, 0x4e, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x56, 0x49, 0x53, 0x49,
-	0x42, 0x4c, 0x45, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x46, 0x41, 0x56, 0x4f, 0x52, 0x49, 0x54,
-	0x45, 0x10, 0x03, 0x22, 0x8a, 0x01, 0x0a, 0x0c, 0x55, 0x73, 0x65, 0x64, 0x53, 0x6c, 0x69, 0x70,
-	0x44, 0x61, 0x79, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x02, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65,
-	0x6e, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x65, 0x6e, 0x72, 0x6f,
-	0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x73, 0x73, 0x69,
-	0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c,
-	0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c,
-	0x75, 0x73, 0x65, 0x64, 0x53, 0x6c, 0x69, 0x70, 0x44, 0x61, 0x79, 0x73, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x0c, 0x75, 0x73, 0x65, 0x64, 0x53, 0x6c, 0x69, 0x70, 0x44, 0x61, 0x79, 0x73,
-	0x22, 0x3f, 0x0a, 0x0b, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12,
-	0x30, 0x0a, 0x0b, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x67, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c,
-	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74,
-	0x73, 0x22, 0x70, 0x0a, 0x0e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4c,
-	0x69, 0x6e, 0x6b, 0x12, 0x2e, 0x0a, 0x0a, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e,
-	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x67, 0x2e, 0x41, 0x73, 0x73,
-	0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d,
-	0x65, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x0a, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x67, 0x2e, 0x53, 0x75, 0x62,
-	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x22, 0x76, 0x0a, 0x0e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e,
-	0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x2e, 0x0a, 0x0a, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d,
-	0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x67, 0x2e, 0x45,
-	0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x65, 0x6e, 0x72, 0x6f, 0x6c,
-	0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x34, 0x0a, 0x0b, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x61, 0x67, 0x2e,
-	0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x0b,
-	0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x61, 0x0a, 0x11, 0x43,
-	0x6f, 0x75, 0x72, 0x73, 0x65, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
-	0x12, 0x22, 0x0a, 0x06, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x0a, 0x2e, 0x61, 0x67, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x06, 0x63, 0x6f,
-	0x75, 0x72, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x05, 0x6c, 0x69, 0x6e, 0x6b, 0x73, 0x18, 0x02, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x61, 0x67, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d,
-	0x65, 0x6e, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x05, 0x6c, 0x69, 0x6e, 0x6b, 0x73, 0x22, 0xc0,
-	0x03, 0x0a, 0x0a, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a,
-	0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x1a, 0x0a,
-	0x08, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x08, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a,
-	0x0a, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0a, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x1a, 0x0a,
-	0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x75, 0x74,
-	0x6f, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b,
-	0x61, 0x75, 0x74, 0x6f, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6f,
-	0x72, 0x64, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6f, 0x72, 0x64, 0x65,
-	0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4c, 0x61, 0x62, 0x18,
-	0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4c, 0x61,
-	0x62, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18,
-	0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x4c, 0x69, 0x6d, 0x69,
-	0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x73, 0x18, 0x0a,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x73, 0x12,
-	0x30, 0x0a, 0x0b, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0b,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x67, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x73, 0x12, 0x42, 0x0a, 0x11, 0x67, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x65, 0x6e, 0x63,
-	0x68, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61,
-	0x67, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61,
-	0x72, 0x6b, 0x52, 0x11, 0x67, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x65, 0x6e, 0x63, 0x68,
-	0x6d, 0x61, 0x72, 0x6b, 0x73, 0x12, 0x2a, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
-	0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x10, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75,
-	0x74, 0x22, 0x3f, 0x0a, 0x0b, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73,
-	0x12, 0x30, 0x0a, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x67, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67,
-	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e,
-	0x74, 0x73, 0x22, 0xd1, 0x03, 0x0a, 0x0a, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49,
-	0x44, 0x12, 0x22, 0x0a, 0x0c, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49,
-	0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d,
-	0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x18, 0x0a,
-	0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07,
-	0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x1e, 0x0a,
-	0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x48, 0x61, 0x73, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1a, 0x0a,
-	0x08, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x08, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x12, 0x2d, 0x0a, 0x06, 0x73, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x61, 0x67, 0x2e, 0x53,
-	0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x70, 0x70, 0x72,
-	0x6f, 0x76, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
-	0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x24, 0x0a, 0x07,
-	0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e,
-	0x61, 0x67, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x07, 0x72, 0x65, 0x76, 0x69, 0x65,
-	0x77, 0x73, 0x12, 0x2e, 0x0a, 0x09, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x18,
-	0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x42, 0x75,
-	0x69, 0x6c, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x09, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x6e,
-	0x66, 0x6f, 0x12, 0x24, 0x0a, 0x06, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x18, 0x0c, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x53, 0x63, 0x6f, 0x72, 0x65,
-	0x52, 0x06, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x22, 0x3c, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08,
-	0x41, 0x50, 0x50, 0x52, 0x4f, 0x56, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x52, 0x45,
-	0x4a, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x52, 0x45, 0x56, 0x49,
-	0x53, 0x49, 0x4f, 0x4e, 0x10, 0x03, 0x22, 0x3f, 0x0a, 0x0b, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x30, 0x0a, 0x0b, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x67, 0x2e,
-	0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x73, 0x75, 0x62, 0x6d,
-	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xee, 0x01, 0x0a, 0x10, 0x47, 0x72, 0x61, 0x64,
-	0x69, 0x6e, 0x67, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x12, 0x0e, 0x0a, 0x02,
-	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c,
-	0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x0c, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44,
-	0x12, 0x1a, 0x0a, 0x08, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x08, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07,
-	0x68, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x68,
-	0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
-	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
-	0x12, 0x56, 0x0a, 0x08, 0x63, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x18, 0x06, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x43,
-	0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x42, 0x24, 0xca, 0xb5, 0x03, 0x20, 0xa2, 0x01,
-	0x1d, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x4b, 0x65,
-	0x79, 0x3a, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x49, 0x44, 0x22, 0x52, 0x08,
-	0x63, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x22, 0x42, 0x0a, 0x0a, 0x42, 0x65, 0x6e, 0x63,
-	0x68, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x12, 0x34, 0x0a, 0x0a, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d,
-	0x61, 0x72, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x67, 0x2e,
-	0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b,
-	0x52, 0x0a, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x22, 0xf5, 0x01, 0x0a,
-	0x10, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f,
-	0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49,
-	0x44, 0x12, 0x20, 0x0a, 0x0b, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x49, 0x44,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72,
-	0x6b, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x64,
-	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x30, 0x0a,
-	0x05, 0x67, 0x72, 0x61, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x61,
-	0x67, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69,
-	0x6f, 0x6e, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x65, 0x52, 0x05, 0x67, 0x72, 0x61, 0x64, 0x65, 0x12,
-	0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x29, 0x0a, 0x05, 0x47, 0x72, 0x61,
-	0x64, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06,
-	0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x50, 0x41, 0x53, 0x53,
-	0x45, 0x44, 0x10, 0x02, 0x22, 0xa3, 0x02, 0x0a, 0x06, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12,
-	0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12,
-	0x22, 0x0a, 0x0c, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x49,
-	0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65,
-	0x72, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x12,
-	0x14, 0x0a, 0x05, 0x72, 0x65, 0x61, 0x64, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05,
-	0x72, 0x65, 0x61, 0x64, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x65, 0x0a, 0x11, 0x67,
-	0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x73,
-	0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x61, 0x64,
-	0x69, 0x6e, 0x67, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x42, 0x21, 0xca, 0xb5,
-	0x03, 0x1d, 0xa2, 0x01, 0x1a, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x66, 0x6f, 0x72, 0x65, 0x69,
-	0x67, 0x6e, 0x4b, 0x65, 0x79, 0x3a, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x49, 0x44, 0x22, 0x52,
-	0x11, 0x67, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72,
-	0x6b, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x64, 0x69, 0x74, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x65, 0x64, 0x69, 0x74, 0x65, 0x64, 0x22, 0x33, 0x0a, 0x09, 0x52, 0x65,
-	0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x73, 0x12, 0x26, 0x0a, 0x09, 0x72, 0x65, 0x76, 0x69, 0x65,
-	0x77, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x61, 0x67, 0x2e,
-	0x55, 0x73, 0x65, 0x72, 0x52, 0x09, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x73, 0x22,
-	0x4f, 0x0a, 0x0d, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x06,
-	0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x61,
-	0x67, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x06, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77,
-	0x22, 0x2b, 0x0a, 0x0d, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x22, 0x25, 0x0a,
-	0x0b, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06,
-	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73,
-	0x65, 0x72, 0x49, 0x44, 0x22, 0x2b, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70,
-	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49,
-	0x44, 0x22, 0x5c, 0x0a, 0x0c, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x6f,
-	0x75, 0x70, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75,
-	0x70, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x22,
-	0x26, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x70,
-	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70,
-	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x22, 0x26, 0x0a, 0x0a, 0x4f, 0x72, 0x67, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x72, 0x67, 0x4e, 0x61, 0x6d, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x22,
-	0x6c, 0x0a, 0x0c, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12,
-	0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70,
-	0x61, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x70,
-	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0b, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x22, 0x47, 0x0a,
-	0x0d, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x36,
-	0x0a, 0x0d, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x61, 0x67, 0x2e, 0x4f, 0x72, 0x67, 0x61, 0x6e,
-	0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xba, 0x01, 0x0a, 0x11, 0x45, 0x6e, 0x72, 0x6f, 0x6c,
-	0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08,
-	0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08,
-	0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x2e, 0x0a, 0x12, 0x69, 0x67, 0x6e, 0x6f,
-	0x72, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x47, 0x72, 0x6f, 0x75,
-	0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x77, 0x69, 0x74, 0x68,
-	0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c,
-	0x77, 0x69, 0x74, 0x68, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x12, 0x35, 0x0a, 0x08,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x19,
-	0x2e, 0x61, 0x67, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x55,
-	0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x08, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x65, 0x73, 0x22, 0x68, 0x0a, 0x17, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e,
-	0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16,
-	0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06,
-	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x35, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x61, 0x67, 0x2e, 0x45, 0x6e,
-	0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x52, 0x08, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x22, 0x61, 0x0a,
-	0x11, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72,
-	0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x67, 0x72, 0x6f,
-	0x75, 0x70, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44,
-	0x22, 0xba, 0x01, 0x0a, 0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x6d, 0x69,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0c,
-	0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44,
-	0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05,
-	0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x73, 0x63, 0x6f,
-	0x72, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x12, 0x2d,
-	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15,
-	0x2e, 0x61, 0x67, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xae, 0x01,
-	0x0a, 0x18, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f,
-	0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x6f,
-	0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e,
-	0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x61, 0x73,
-	0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x63,
-	0x6f, 0x72, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a,
-	0x73, 0x63, 0x6f, 0x72, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65,
-	0x6c, 0x65, 0x61, 0x73, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x6c,
-	0x65, 0x61, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x22, 0x5c,
-	0x0a, 0x1a, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x76, 0x69,
-	0x65, 0x77, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0c,
-	0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44,
-	0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x22, 0x29, 0x0a, 0x09,
-	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f,
-	0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72,
-	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x22, 0x5b, 0x0a, 0x0a, 0x55, 0x52, 0x4c, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49,
-	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49,
-	0x44, 0x12, 0x31, 0x0a, 0x09, 0x72, 0x65, 0x70, 0x6f, 0x54, 0x79, 0x70, 0x65, 0x73, 0x18, 0x02,
-	0x20, 0x03, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x61, 0x67, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69,
-	0x74, 0x6f, 0x72, 0x79, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x72, 0x65, 0x70, 0x6f, 0x54,
-	0x79, 0x70, 0x65, 0x73, 0x22, 0x61, 0x0a, 0x11, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f,
-	0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65,
-	0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
-	0x44, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x63,
-	0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63,
-	0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x22, 0x77, 0x0a, 0x0c, 0x52, 0x65, 0x70, 0x6f, 0x73,
-	0x69, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x2e, 0x0a, 0x04, 0x55, 0x52, 0x4c, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x67, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x73,
-	0x69, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x2e, 0x55, 0x52, 0x4c, 0x73, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x52, 0x04, 0x55, 0x52, 0x4c, 0x73, 0x1a, 0x37, 0x0a, 0x09, 0x55, 0x52, 0x4c, 0x73, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
-	0x22, 0x3b, 0x0a, 0x15, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x49, 0x73, 0x41,
-	0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x0c, 0x49, 0x73, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64, 0x22, 0x32, 0x0a,
-	0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x45,
-	0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x45, 0x72, 0x72, 0x6f,
-	0x72, 0x22, 0xc5, 0x01, 0x0a, 0x1b, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x73, 0x46, 0x6f, 0x72, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x38, 0x0a,
-	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e, 0x61, 0x67,
-	0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x46, 0x6f, 0x72, 0x43,
-	0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x54, 0x79, 0x70,
-	0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x77, 0x69, 0x74, 0x68, 0x42,
-	0x75, 0x69, 0x6c, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d,
-	0x77, 0x69, 0x74, 0x68, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x2a, 0x0a,
-	0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x4c, 0x4c, 0x10, 0x00, 0x12, 0x0e,
-	0x0a, 0x0a, 0x49, 0x4e, 0x44, 0x49, 0x56, 0x49, 0x44, 0x55, 0x41, 0x4c, 0x10, 0x01, 0x12, 0x09,
-	0x0a, 0x05, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x10, 0x02, 0x22, 0x58, 0x0a, 0x0e, 0x52, 0x65, 0x62,
-	0x75, 0x69, 0x6c, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x73,
-	0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12,
-	0x22, 0x0a, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e,
-	0x74, 0x49, 0x44, 0x22, 0x71, 0x0a, 0x11, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x55, 0x73, 0x65,
-	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x75, 0x72,
-	0x73, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f,
-	0x75, 0x72, 0x73, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x75, 0x72,
-	0x73, 0x65, 0x59, 0x65, 0x61, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x63, 0x6f,
-	0x75, 0x72, 0x73, 0x65, 0x59, 0x65, 0x61, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72,
-	0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x73, 0x65,
-	0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x22, 0x53, 0x0a, 0x11, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e,
-	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63,
-	0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63,
-	0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67,
-	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x61,
-	0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x22, 0x06, 0x0a, 0x04, 0x56,
-	0x6f, 0x69, 0x64, 0x32, 0xf4, 0x11, 0x0a, 0x11, 0x41, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64,
-	0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x07, 0x47, 0x65, 0x74,
-	0x55, 0x73, 0x65, 0x72, 0x12, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x1a, 0x08,
-	0x2e, 0x61, 0x67, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x22, 0x00, 0x12, 0x21, 0x0a, 0x08, 0x47, 0x65,
-	0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64,
-	0x1a, 0x09, 0x2e, 0x61, 0x67, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x73, 0x22, 0x00, 0x12, 0x34, 0x0a,
-	0x0f, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x42, 0x79, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65,
-	0x12, 0x15, 0x2e, 0x61, 0x67, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x55, 0x73, 0x65, 0x72,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x55, 0x73, 0x65,
-	0x72, 0x22, 0x00, 0x12, 0x22, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65,
-	0x72, 0x12, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x1a, 0x08, 0x2e, 0x61, 0x67,
-	0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x3c, 0x0a, 0x13, 0x49, 0x73, 0x41, 0x75, 0x74,
-	0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64, 0x54, 0x65, 0x61, 0x63, 0x68, 0x65, 0x72, 0x12, 0x08,
-	0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x1a, 0x19, 0x2e, 0x61, 0x67, 0x2e, 0x41, 0x75,
-	0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x2c, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75,
-	0x70, 0x12, 0x13, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x09, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x6f, 0x75,
-	0x70, 0x22, 0x00, 0x12, 0x38, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42,
-	0x79, 0x55, 0x73, 0x65, 0x72, 0x41, 0x6e, 0x64, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x12, 0x10,
-	0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x09, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x22, 0x00, 0x12, 0x34, 0x0a,
-	0x11, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x42, 0x79, 0x43, 0x6f, 0x75, 0x72,
-	0x73, 0x65, 0x12, 0x11, 0x2e, 0x61, 0x67, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0a, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70,
-	0x73, 0x22, 0x00, 0x12, 0x25, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f,
-	0x75, 0x70, 0x12, 0x09, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x1a, 0x09, 0x2e,
-	0x61, 0x67, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x22, 0x00, 0x12, 0x24, 0x0a, 0x0b, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x09, 0x2e, 0x61, 0x67, 0x2e, 0x47,
-	0x72, 0x6f, 0x75, 0x70, 0x1a, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00,
-	0x12, 0x2b, 0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12,
-	0x10, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x2c, 0x0a,
-	0x09, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x12, 0x11, 0x2e, 0x61, 0x67, 0x2e,
-	0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0a, 0x2e,
-	0x61, 0x67, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x22, 0x00, 0x12, 0x25, 0x0a, 0x0a, 0x47,
-	0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x12, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56,
-	0x6f, 0x69, 0x64, 0x1a, 0x0b, 0x2e, 0x61, 0x67, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73,
-	0x22, 0x00, 0x12, 0x3e, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73,
-	0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1b, 0x2e, 0x61, 0x67, 0x2e, 0x45, 0x6e, 0x72, 0x6f,
-	0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x0b, 0x2e, 0x61, 0x67, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73,
-	0x22, 0x00, 0x12, 0x28, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x72,
-	0x73, 0x65, 0x12, 0x0a, 0x2e, 0x61, 0x67, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x1a, 0x0a,
-	0x2e, 0x61, 0x67, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x22, 0x00, 0x12, 0x26, 0x0a, 0x0c,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x12, 0x0a, 0x2e, 0x61,
-	0x67, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x1a, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f,
-	0x69, 0x64, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f,
-	0x75, 0x72, 0x73, 0x65, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x0e,
-	0x2e, 0x61, 0x67, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x1a, 0x08,
-	0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x36, 0x0a, 0x0e, 0x47, 0x65,
-	0x74, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x11, 0x2e, 0x61,
-	0x67, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x0f, 0x2e, 0x61, 0x67, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73,
-	0x22, 0x00, 0x12, 0x32, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x73, 0x73, 0x69,
-	0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x11, 0x2e, 0x61, 0x67, 0x2e, 0x43, 0x6f, 0x75,
-	0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x08, 0x2e, 0x61, 0x67, 0x2e,
-	0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x72,
-	0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1b,
-	0x2e, 0x61, 0x67, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x61, 0x67,
-	0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x00, 0x12, 0x42,
-	0x0a, 0x16, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73,
-	0x42, 0x79, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x12, 0x15, 0x2e, 0x61, 0x67, 0x2e, 0x45, 0x6e,
-	0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x0f, 0x2e, 0x61, 0x67, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73,
-	0x22, 0x00, 0x12, 0x2e, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x72, 0x6f,
-	0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x2e, 0x61, 0x67, 0x2e, 0x45, 0x6e, 0x72, 0x6f,
-	0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x1a, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64,
-	0x22, 0x00, 0x12, 0x2e, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x72, 0x6f,
-	0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x2e, 0x61, 0x67, 0x2e, 0x45, 0x6e, 0x72, 0x6f,
-	0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x1a, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64,
-	0x22, 0x00, 0x12, 0x32, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x72, 0x6f,
-	0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x11, 0x2e, 0x61, 0x67, 0x2e, 0x43, 0x6f, 0x75,
-	0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x08, 0x2e, 0x61, 0x67, 0x2e,
-	0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62,
-	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x15, 0x2e, 0x61, 0x67, 0x2e, 0x53, 0x75,
-	0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x0f, 0x2e, 0x61, 0x67, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
-	0x22, 0x00, 0x12, 0x52, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x73, 0x42, 0x79, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x12, 0x1f, 0x2e, 0x61,
-	0x67, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x46, 0x6f, 0x72,
-	0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e,
-	0x61, 0x67, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x73, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x2e, 0x61, 0x67, 0x2e,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69,
-	0x64, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62,
-	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1c, 0x2e, 0x61, 0x67, 0x2e, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64,
-	0x22, 0x00, 0x12, 0x39, 0x0a, 0x11, 0x52, 0x65, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x53, 0x75, 0x62,
-	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x2e, 0x61, 0x67, 0x2e, 0x52, 0x65, 0x62,
-	0x75, 0x69, 0x6c, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x61, 0x67,
-	0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x12, 0x37, 0x0a,
-	0x12, 0x52, 0x65, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x73, 0x12, 0x15, 0x2e, 0x61, 0x67, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d,
-	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x08, 0x2e, 0x61, 0x67, 0x2e,
-	0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x3f, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x12, 0x14, 0x2e, 0x61, 0x67, 0x2e, 0x47,
-	0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x1a,
-	0x14, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x65, 0x6e, 0x63,
-	0x68, 0x6d, 0x61, 0x72, 0x6b, 0x22, 0x00, 0x12, 0x33, 0x0a, 0x0f, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x12, 0x14, 0x2e, 0x61, 0x67, 0x2e,
-	0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b,
-	0x1a, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x33, 0x0a, 0x0f,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x12,
-	0x14, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x65, 0x6e, 0x63,
-	0x68, 0x6d, 0x61, 0x72, 0x6b, 0x1a, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22,
-	0x00, 0x12, 0x3f, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x72, 0x69, 0x74, 0x65,
-	0x72, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e,
-	0x67, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x1a, 0x14, 0x2e, 0x61, 0x67, 0x2e,
-	0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e,
-	0x22, 0x00, 0x12, 0x33, 0x0a, 0x0f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x72, 0x69, 0x74,
-	0x65, 0x72, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x69,
-	0x6e, 0x67, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x1a, 0x08, 0x2e, 0x61, 0x67,
-	0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x33, 0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x2e, 0x61, 0x67, 0x2e,
-	0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e,
-	0x1a, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x2f, 0x0a, 0x0c,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x11, 0x2e, 0x61,
-	0x67, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x0a, 0x2e, 0x61, 0x67, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x22, 0x00, 0x12, 0x2f, 0x0a,
-	0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x11, 0x2e,
-	0x61, 0x67, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x0a, 0x2e, 0x61, 0x67, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x22, 0x00, 0x12, 0x3f,
-	0x0a, 0x0c, 0x47, 0x65, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x73, 0x12, 0x1e,
-	0x2e, 0x61, 0x67, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d,
-	0x2e, 0x61, 0x67, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x73, 0x22, 0x00, 0x12,
-	0x29, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x12,
-	0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x1a, 0x0d, 0x2e, 0x61, 0x67, 0x2e, 0x50,
-	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x22, 0x00, 0x12, 0x35, 0x0a, 0x0f, 0x47, 0x65,
-	0x74, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x2e,
-	0x61, 0x67, 0x2e, 0x4f, 0x72, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e,
-	0x61, 0x67, 0x2e, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22,
-	0x00, 0x12, 0x35, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f,
-	0x72, 0x69, 0x65, 0x73, 0x12, 0x0e, 0x2e, 0x61, 0x67, 0x2e, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x61, 0x67, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69,
-	0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x22, 0x00, 0x12, 0x30, 0x0a, 0x0b, 0x49, 0x73, 0x45, 0x6d,
-	0x70, 0x74, 0x79, 0x52, 0x65, 0x70, 0x6f, 0x12, 0x15, 0x2e, 0x61, 0x67, 0x2e, 0x52, 0x65, 0x70,
-	0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x08,
-	0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x42, 0x26, 0x5a, 0x21, 0x67, 0x69,
-	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61,
-	0x64, 0x65, 0x2f, 0x71, 0x75, 0x69, 0x63, 0x6b, 0x66, 0x65, 0x65, 0x64, 0x2f, 0x61, 0x67, 0xba,
-	0x02, 0x00, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+type CourseProgress struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CourseID            uint64              `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	Code                string              `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Name                string              `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Assignments         uint32              `protobuf:"varint,4,opt,name=assignments,proto3" json:"assignments,omitempty"` // number of published assignments
+	ApprovedAssignments uint32              `protobuf:"varint,5,opt,name=approvedAssignments,proto3" json:"approvedAssignments,omitempty"`
+	TotalScore          uint32              `protobuf:"varint,6,opt,name=totalScore,proto3" json:"totalScore,omitempty"` // sum of the scores of the latest submissions to the published assignments
+	UsedSlipDays        uint32              `protobuf:"varint,7,opt,name=usedSlipDays,proto3" json:"usedSlipDays,omitempty"`
+	RemainingSlipDays   uint32              `protobuf:"varint,8,opt,name=remainingSlipDays,proto3" json:"remainingSlipDays,omitempty"`
+	UpcomingDeadlines   []*UpcomingDeadline `protobuf:"bytes,9,rep,name=upcomingDeadlines,proto3" json:"upcomingDeadlines,omitempty"` // in deadline order
 }
 
-var (
-	file_ag_ag_proto_rawDescOnce sync.Once
-	file_ag_ag_proto_rawDescData = file_ag_ag_proto_rawDesc
-)
+func (x *CourseProgress) Reset() {
+	*x = CourseProgress{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_ag_ag_proto_rawDescGZIP() []byte {
-	file_ag_ag_proto_rawDescOnce.Do(func() {
-		file_ag_ag_proto_rawDescData = protoimpl.X.CompressGZIP(file_ag_ag_proto_rawDescData)
-	})
-	return file_ag_ag_proto_rawDescData
+func (x *CourseProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_ag_ag_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
-var file_ag_ag_proto_msgTypes = make([]protoimpl.MessageInfo, 50)
-var file_ag_ag_proto_goTypes = []interface{}{
-	(Group_GroupStatus)(0),                // 0: ag.Group.GroupStatus
-	(Repository_Type)(0),                  // 1: ag.Repository.Type
-	(Enrollment_UserStatus)(0),            // 2: ag.Enrollment.UserStatus
-	(Enrollment_DisplayState)(0),          // 3: ag.Enrollment.DisplayState
-	(Submission_Status)(0),                // 4: ag.Submission.Status
-	(GradingCriterion_Grade)(0),           // 5: ag.GradingCriterion.Grade
-	(SubmissionsForCourseRequest_Type)(0), // 6: ag.SubmissionsForCourseRequest.Type
-	(*User)(nil),                          // 7: ag.User
-	(*Users)(nil),                         // 8: ag.Users
-	(*RemoteIdentity)(nil),                // 9: ag.RemoteIdentity
-	(*Group)(nil),                         // 10: ag.Group
-	(*Groups)(nil),                        // 11: ag.Groups
-	(*Course)(nil),                        // 12: ag.Course
-	(*Courses)(nil),                       // 13: ag.Courses
-	(*Repository)(nil),                    // 14: ag.Repository
-	(*Enrollment)(nil),                    // 15: ag.Enrollment
-	(*UsedSlipDays)(nil),                  // 16: ag.UsedSlipDays
-	(*Enrollments)(nil),                   // 17: ag.Enrollments
-	(*SubmissionLink)(nil),                // 18: ag.SubmissionLink
-	(*EnrollmentLink)(nil),                // 19: ag.EnrollmentLink
-	(*CourseSubmissions)(nil),             // 20: ag.CourseSubmissions
-	(*Assignment)(nil),                    // 21: ag.Assignment
-	(*Assignments)(nil),                   // 22: ag.Assignments
-	(*Submission)(nil),                    // 23: ag.Submission
-	(*Submissions)(nil),                   // 24: ag.Submissions
-	(*GradingBenchmark)(nil),              // 25: ag.GradingBenchmark
-	(*Benchmarks)(nil),                    // 26: ag.Benchmarks
-	(*GradingCriterion)(nil),              // 27: ag.GradingCriterion
-	(*Review)(nil),                        // 28: ag.Review
-	(*Reviewers)(nil),                     // 29: ag.Reviewers
-	(*ReviewRequest)(nil),                 // 30: ag.ReviewRequest
-	(*CourseRequest)(nil),                 // 31: ag.CourseRequest
-	(*UserRequest)(nil),                   // 32: ag.UserRequest
-	(*GetGroupRequest)(nil),               // 33: ag.GetGroupRequest
-	(*GroupRequest)(nil),                  // 34: ag.GroupRequest
-	(*Provider)(nil),                      // 35: ag.Provider
-	(*OrgRequest)(nil),                    // 36: ag.OrgRequest
-	(*Organization)(nil),                  // 37: ag.Organization
-	(*Organizations)(nil),                 // 38: ag.Organizations
-	(*EnrollmentRequest)(nil),             // 39: ag.EnrollmentRequest
-	(*EnrollmentStatusRequest)(nil),       // 40: ag.EnrollmentStatusRequest
-	(*SubmissionRequest)(nil),             // 41: ag.SubmissionRequest
-	(*UpdateSubmissionRequest)(nil),       // 42: ag.UpdateSubmissionRequest
-	(*UpdateSubmissionsRequest)(nil),      // 43: ag.UpdateSubmissionsRequest
-	(*SubmissionReviewersRequest)(nil),    // 44: ag.SubmissionReviewersRequest
-	(*Providers)(nil),                     // 45: ag.Providers
-	(*URLRequest)(nil),                    // 46: ag.URLRequest
-	(*RepositoryRequest)(nil),             // 47: ag.RepositoryRequest
-	(*Repositories)(nil),                  // 48: ag.Repositories
-	(*AuthorizationResponse)(nil),         // 49: ag.AuthorizationResponse
-	(*Status)(nil),                        // 50: ag.Status
-	(*SubmissionsForCourseRequest)(nil),   // 51: ag.SubmissionsForCourseRequest
-	(*RebuildRequest)(nil),                // 52: ag.RebuildRequest
-	(*CourseUserRequest)(nil),             // 53: ag.CourseUserRequest
-	(*AssignmentRequest)(nil),             // 54: ag.AssignmentRequest
-	(*Void)(nil),                          // 55: ag.Void
-	nil,                                   // 56: ag.Repositories.URLsEntry
-	(*score.BuildInfo)(nil),               // 57: score.BuildInfo
-	(*score.Score)(nil),                   // 58: score.Score
+func (*CourseProgress) ProtoMessage() {}
+
+func (x *CourseProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_ag_ag_proto_depIdxs = []int32{
-	9,  // 0: ag.User.remoteIdentities:type_name -> ag.RemoteIdentity
-	15, // 1: ag.User.enrollments:type_name -> ag.Enrollment
-	7,  // 2: ag.Users.users:type_name -> ag.User
-	0,  // 3: ag.Group.status:type_name -> ag.Group.GroupStatus
-	7,  // 4: ag.Group.users:type_name -> ag.User
-	15, // 5: ag.Group.enrollments:type_name -> ag.Enrollment
-	10, // 6: ag.Groups.groups:type_name -> ag.Group
-	2,  // 7: ag.Course.enrolled:type_name -> ag.Enrollment.UserStatus
-	15, // 8: ag.Course.enrollments:type_name -> ag.Enrollment
-	21, // 9: ag.Course.assignments:type_name -> ag.Assignment
-	10, // 10: ag.Course.groups:type_name -> ag.Group
-	12, // 11: ag.Courses.courses:type_name -> ag.Course
-	1,  // 12: ag.Repository.repoType:type_name -> ag.Repository.Type
-	7,  // 13: ag.Enrollment.user:type_name -> ag.User
-	12, // 14: ag.Enrollment.course:type_name -> ag.Course
-	10, // 15: ag.Enrollment.group:type_name -> ag.Group
-	2,  // 16: ag.Enrollment.status:type_name -> ag.Enrollment.UserStatus
-	3,  // 17: ag.Enrollment.state:type_name -> ag.Enrollment.DisplayState
-	16, // 18: ag.Enrollment.usedSlipDays:type_name -> ag.UsedSlipDays
-	15, // 19: ag.Enrollments.enrollments:type_name -> ag.Enrollment
-	21, // 20: ag.SubmissionLink.assignment:type_name -> ag.Assignment
-	23, // 21: ag.SubmissionLink.submission:type_name -> ag.Submission
-	15, // 22: ag.EnrollmentLink.enrollment:type_name -> ag.Enrollment
-	18, // 23: ag.EnrollmentLink.submissions:type_name -> ag.SubmissionLink
-	12, // 24: ag.CourseSubmissions.course:type_name -> ag.Course
-	19, // 25: ag.CourseSubmissions.links:type_name -> ag.EnrollmentLink
-	23, // 26: ag.Assignment.submissions:type_name -> ag.Submission
-	25, // 27: ag.Assignment.gradingBenchmarks:type_name -> ag.GradingBenchmark
-	21, // 28: ag.Assignments.assignments:type_name -> ag.Assignment
-	4,  // 29: ag.Submission.status:type_name -> ag.Submission.Status
-	28, // 30: ag.Submission.reviews:type_name -> ag.Review
-	57, // 31: ag.Submission.BuildInfo:type_name -> score.BuildInfo
-	58, // 32: ag.Submission.Scores:type_name -> score.Score
-	23, // 33: ag.Submissions.submissions:type_name -> ag.Submission
-	27, // 34: ag.GradingBenchmark.criteria:type_name -> ag.GradingCriterion
-	25, // 35: ag.Benchmarks.benchmarks:type_name -> ag.GradingBenchmark
-	5,  // 36: ag.GradingCriterion.grade:type_name -> ag.GradingCriterion.Grade
-	25, // 37: ag.Review.gradingBenchmarks:type_name -> ag.GradingBenchmark
-	7,  // 38: ag.Reviewers.reviewers:type_name -> ag.User
-	28, // 39: ag.ReviewRequest.review:type_name -> ag.Review
-	37, // 40: ag.Organizations.organizations:type_name -> ag.Organization
-	2,  // 41: ag.EnrollmentRequest.statuses:type_name -> ag.Enrollment.UserStatus
-	2,  // 42: ag.EnrollmentStatusRequest.statuses:type_name -> ag.Enrollment.UserStatus
-	4,  // 43: ag.UpdateSubmissionRequest.status:type_name -> ag.Submission.Status
-	1,  // 44: ag.URLRequest.repoTypes:type_name -> ag.Repository.Type
-	56, // 45: ag.Repositories.URLs:type_name -> ag.Repositories.URLsEntry
-	6,  // 46: ag.SubmissionsForCourseRequest.type:type_name -> ag.SubmissionsForCourseRequest.Type
-	55, // 47: ag.AutograderService.GetUser:input_type -> ag.Void
-	55, // 48: ag.AutograderService.GetUsers:input_type -> ag.Void
-	53, // 49: ag.AutograderService.GetUserByCourse:input_type -> ag.CourseUserRequest
-	7,  // 50: ag.AutograderService.UpdateUser:input_type -> ag.User
-	55, // 51: ag.AutograderService.IsAuthorizedTeacher:input_type -> ag.Void
-	33, // 52: ag.AutograderService.GetGroup:input_type -> ag.GetGroupRequest
-	34, // 53: ag.AutograderService.GetGroupByUserAndCourse:input_type -> ag.GroupRequest
-	31, // 54: ag.AutograderService.GetGroupsByCourse:input_type -> ag.CourseRequest
-	10, // 55: ag.AutograderService.CreateGroup:input_type -> ag.Group
-	10, // 56: ag.AutograderService.UpdateGroup:input_type -> ag.Group
-	34, // 57: ag.AutograderService.DeleteGroup:input_type -> ag.GroupRequest
-	31, // 58: ag.AutograderService.GetCourse:input_type -> ag.CourseRequest
-	55, // 59: ag.AutograderService.GetCourses:input_type -> ag.Void
-	40, // 60: ag.AutograderService.GetCoursesByUser:input_type -> ag.EnrollmentStatusRequest
-	12, // 61: ag.AutograderService.CreateCourse:input_type -> ag.Course
-	12, // 62: ag.AutograderService.UpdateCourse:input_type -> ag.Course
-	15, // 63: ag.AutograderService.UpdateCourseVisibility:input_type -> ag.Enrollment
-	31, // 64: ag.AutograderService.GetAssignments:input_type -> ag.CourseRequest
-	31, // 65: ag.AutograderService.UpdateAssignments:input_type -> ag.CourseRequest
-	40, // 66: ag.AutograderService.GetEnrollmentsByUser:input_type -> ag.EnrollmentStatusRequest
-	39, // 67: ag.AutograderService.GetEnrollmentsByCourse:input_type -> ag.EnrollmentRequest
-	15, // 68: ag.AutograderService.CreateEnrollment:input_type -> ag.Enrollment
-	15, // 69: ag.AutograderService.UpdateEnrollment:input_type -> ag.Enrollment
-	31, // 70: ag.AutograderService.UpdateEnrollments:input_type -> ag.CourseRequest
-	41, // 71: ag.AutograderService.GetSubmissions:input_type -> ag.SubmissionRequest
-	51, // 72: ag.AutograderService.GetSubmissionsByCourse:input_type -> ag.SubmissionsForCourseRequest
-	42, // 73: ag.AutograderService.UpdateSubmission:input_type -> ag.UpdateSubmissionRequest
-	43, // 74: ag.AutograderService.UpdateSubmissions:input_type -> ag.UpdateSubmissionsRequest
-	52, // 75: ag.AutograderService.RebuildSubmission:input_type -> ag.RebuildRequest
-	54, // 76: ag.AutograderService.RebuildSubmissions:input_type -> ag.AssignmentRequest
-	25, // 77: ag.AutograderService.CreateBenchmark:input_type -> ag.GradingBenchmark
-	25, // 78: ag.AutograderService.UpdateBenchmark:input_type -> ag.GradingBenchmark
-	25, // 79: ag.AutograderService.DeleteBenchmark:input_type -> ag.GradingBenchmark
-	27, // 80: ag.AutograderService.CreateCriterion:input_type -> ag.GradingCriterion
-	27, // 81: ag.AutograderService.UpdateCriterion:input_type -> ag.GradingCriterion
-	27, // 82: ag.AutograderService.DeleteCriterion:input_type -> ag.GradingCriterion
-	30, // 83: ag.AutograderService.CreateReview:input_type -> ag.ReviewRequest
-	30, // 84: ag.AutograderService.UpdateReview:input_type -> ag.ReviewRequest
-	44, // 85: ag.AutograderService.GetReviewers:input_type -> ag.SubmissionReviewersRequest
-	55, // 86: ag.AutograderService.GetProviders:input_type -> ag.Void
-	36, // 87: ag.AutograderService.GetOrganization:input_type -> ag.OrgRequest
-	46, // 88: ag.AutograderService.GetRepositories:input_type -> ag.URLRequest
-	47, // 89: ag.AutograderService.IsEmptyRepo:input_type -> ag.RepositoryRequest
-	7,  // 90: ag.AutograderService.GetUser:output_type -> ag.User
-	8,  // 91: ag.AutograderService.GetUsers:output_type -> ag.Users
-	7,  // 92: ag.AutograderService.GetUserByCourse:output_type -> ag.User
-	55, // 93: ag.AutograderService.UpdateUser:output_type -> ag.Void
-	49, // 94: ag.AutograderService.IsAuthorizedTeacher:output_type -> ag.AuthorizationResponse
-	10, // 95: ag.AutograderService.GetGroup:output_type -> ag.Group
-	10, // 96: ag.AutograderService.GetGroupByUserAndCourse:output_type -> ag.Group
-	11, // 97: ag.AutograderService.GetGroupsByCourse:output_type -> ag.Groups
-	10, // 98: ag.AutograderService.CreateGroup:output_type -> ag.Group
-	55, // 99: ag.AutograderService.UpdateGroup:output_type -> ag.Void
-	55, // 100: ag.AutograderService.DeleteGroup:output_type -> ag.Void
-	12, // 101: ag.AutograderService.GetCourse:output_type -> ag.Course
-	13, // 102: ag.AutograderService.GetCourses:output_type -> ag.Courses
-	13, // 103: ag.AutograderService.GetCoursesByUser:output_type -> ag.Courses
-	12, // 104: ag.AutograderService.CreateCourse:output_type -> ag.Course
-	55, // 105: ag.AutograderService.UpdateCourse:output_type -> ag.Void
-	55, // 106: ag.AutograderService.UpdateCourseVisibility:output_type -> ag.Void
-	22, // 107: ag.AutograderService.GetAssignments:output_type -> ag.Assignments
-	55, // 108: ag.AutograderService.UpdateAssignments:output_type -> ag.Void
-	17, // 109: ag.AutograderService.GetEnrollmentsByUser:output_type -> ag.Enrollments
-	17, // 110: ag.AutograderService.GetEnrollmentsByCourse:output_type -> ag.Enrollments
-	55, // 111: ag.AutograderService.CreateEnrollment:output_type -> ag.Void
-	55, // 112: ag.AutograderService.UpdateEnrollment:output_type -> ag.Void
-	55, // 113: ag.AutograderService.UpdateEnrollments:output_type -> ag.Void
-	24, // 114: ag.AutograderService.GetSubmissions:output_type -> ag.Submissions
-	20, // 115: ag.AutograderService.GetSubmissionsByCourse:output_type -> ag.CourseSubmissions
-	55, // 116: ag.AutograderService.UpdateSubmission:output_type -> ag.Void
-	55, // 117: ag.AutograderService.UpdateSubmissions:output_type -> ag.Void
-	23, // 118: ag.AutograderService.RebuildSubmission:output_type -> ag.Submission
-	55, // 119: ag.AutograderService.RebuildSubmissions:output_type -> ag.Void
-	25, // 120: ag.AutograderService.CreateBenchmark:output_type -> ag.GradingBenchmark
-	55, // 121: ag.AutograderService.UpdateBenchmark:output_type -> ag.Void
-	55, // 122: ag.AutograderService.DeleteBenchmark:output_type -> ag.Void
-	27, // 123: ag.AutograderService.CreateCriterion:output_type -> ag.GradingCriterion
-	55, // 124: ag.AutograderService.UpdateCriterion:output_type -> ag.Void
-	55, // 125: ag.AutograderService.DeleteCriterion:output_type -> ag.Void
-	28, // 126: ag.AutograderService.CreateReview:output_type -> ag.Review
-	28, // 127: ag.AutograderService.UpdateReview:output_type -> ag.Review
-	29, // 128: ag.AutograderService.GetReviewers:output_type -> ag.Reviewers
-	45, // 129: ag.AutograderService.GetProviders:output_type -> ag.Providers
-	37, // 130: ag.AutograderService.GetOrganization:output_type -> ag.Organization
-	48, // 131: ag.AutograderService.GetRepositories:output_type -> ag.Repositories
-	55, // 132: ag.AutograderService.IsEmptyRepo:output_type -> ag.Void
-	90, // [90:133] is the sub-list for method output_type
-	47, // [47:90] is the sub-list for method input_type
-	47, // [47:47] is the sub-list for extension type_name
-	47, // [47:47] is the sub-list for extension extendee
-	0,  // [0:47] is the sub-list for field type_name
+
+// Deprecated: Use CourseProgress.ProtoReflect.Descriptor instead.
+func (*CourseProgress) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{39}
 }
 
-func init() { file_ag_ag_proto_init() }
-func file_ag_ag_proto_init() {
-	if File_ag_ag_proto != nil {
-		return
+func (x *CourseProgress) GetCourseID() uint64 {
+	if x != nil {
+		return x.CourseID
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_ag_ag_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*User); i {
+	return 0
+}
+
+func (x *CourseProgress) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *CourseProgress) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CourseProgress) GetAssignments() uint32 {
+	if x != nil {
+		return x.Assignments
+	}
+	return 0
+}
+
+func (x *CourseProgress) GetApprovedAssignments() uint32 {
+	if x != nil {
+		return x.ApprovedAssignments
+	}
+	return 0
+}
+
+func (x *CourseProgress) GetTotalScore() uint32 {
+	if x != nil {
+		return x.TotalScore
+	}
+	return 0
+}
+
+func (x *CourseProgress) GetUsedSlipDays() uint32 {
+	if x != nil {
+		return x.UsedSlipDays
+	}
+	return 0
+}
+
+func (x *CourseProgress) GetRemainingSlipDays() uint32 {
+	if x != nil {
+		return x.RemainingSlipDays
+	}
+	return 0
+}
+
+func (x *CourseProgress) GetUpcomingDeadlines() []*UpcomingDeadline {
+	if x != nil {
+		return x.UpcomingDeadlines
+	}
+	return nil
+}
+
+type UpcomingDeadline struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AssignmentID  uint64 `protobuf:"varint,1,opt,name=assignmentID,proto3" json:"assignmentID,omitempty"`
+	Name          string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Deadline      string `protobuf:"bytes,3,opt,name=deadline,proto3" json:"deadline,omitempty"` // the student's or group's extended deadline, if any, in UTC
+	IsGroupLab    bool   `protobuf:"varint,4,opt,name=isGroupLab,proto3" json:"isGroupLab,omitempty"`
+	Approved      bool   `protobuf:"varint,5,opt,name=approved,proto3" json:"approved,omitempty"`
+	LocalDeadline string `protobuf:"bytes,6,opt,name=localDeadline,proto3" json:"localDeadline,omitempty"` // the deadline in the course's time zone, in RFC3339 format
+}
+
+func (x *UpcomingDeadline) Reset() {
+	*x = UpcomingDeadline{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpcomingDeadline) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpcomingDeadline) ProtoMessage() {}
+
+func (x *UpcomingDeadline) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpcomingDeadline.ProtoReflect.Descriptor instead.
+func (*UpcomingDeadline) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *UpcomingDeadline) GetAssignmentID() uint64 {
+	if x != nil {
+		return x.AssignmentID
+	}
+	return 0
+}
+
+func (x *UpcomingDeadline) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpcomingDeadline) GetDeadline() string {
+	if x != nil {
+		return x.Deadline
+	}
+	return ""
+}
+
+func (x *UpcomingDeadline) GetIsGroupLab() bool {
+	if x != nil {
+		return x.IsGroupLab
+	}
+	return false
+}
+
+func (x *UpcomingDeadline) GetApproved() bool {
+	if x != nil {
+		return x.Approved
+	}
+	return false
+}
+
+func (x *UpcomingDeadline) GetLocalDeadline() string {
+	if x != nil {
+		return x.LocalDeadline
+	}
+	return ""
+}
+
+type Courses struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Courses []*Course `protobuf:"bytes,1,rep,name=courses,proto3" json:"courses,omitempty"`
+}
+
+func (x *Courses) Reset() {
+	*x = Courses{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Courses) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Courses) ProtoMessage() {}
+
+func (x *Courses) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Courses.ProtoReflect.Descriptor instead.
+func (*Courses) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *Courses) GetCourses() []*Course {
+	if x != nil {
+		return x.Courses
+	}
+	return nil
+}
+
+type Repository struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID             uint64          `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	OrganizationID uint64          `protobuf:"varint,2,opt,name=organizationID,proto3" json:"organizationID,omitempty" gorm:"uniqueIndex:uid_gid_org_type"`
+	RepositoryID   uint64          `protobuf:"varint,3,opt,name=repositoryID,proto3" json:"repositoryID,omitempty"` // ID of a github repository
+	UserID         uint64          `protobuf:"varint,4,opt,name=userID,proto3" json:"userID,omitempty" gorm:"uniqueIndex:uid_gid_org_type"`
+	GroupID        uint64          `protobuf:"varint,5,opt,name=groupID,proto3" json:"groupID,omitempty" gorm:"uniqueIndex:uid_gid_org_type"`
+	HTMLURL        string          `protobuf:"bytes,6,opt,name=HTMLURL,proto3" json:"HTMLURL,omitempty"`
+	RepoType       Repository_Type `protobuf:"varint,7,opt,name=repoType,proto3,enum=ag.Repository_Type" json:"repoType,omitempty" gorm:"uniqueIndex:uid_gid_org_type"`
+}
+
+func (x *Repository) Reset() {
+	*x = Repository{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Repository) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Repository) ProtoMessage() {}
+
+func (x *Repository) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Repository.ProtoReflect.Descriptor instead.
+func (*Repository) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *Repository) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *Repository) GetOrganizationID() uint64 {
+	if x != nil {
+		return x.OrganizationID
+	}
+	return 0
+}
+
+func (x *Repository) GetRepositoryID() uint64 {
+	if x != nil {
+		return x.RepositoryID
+	}
+	return 0
+}
+
+func (x *Repository) GetUserID() uint64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *Repository) GetGroupID() uint64 {
+	if x != nil {
+		return x.GroupID
+	}
+	return 0
+}
+
+func (x *Repository) GetHTMLURL() string {
+	if x != nil {
+		return x.HTMLURL
+	}
+	return ""
+}
+
+func (x *Repository) GetRepoType() Repository_Type {
+	if x != nil {
+		return x.RepoType
+	}
+	return Repository_NONE
+}
+
+type Enrollment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID                uint64                  `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	CourseID          uint64                  `protobuf:"varint,2,opt,name=courseID,proto3" json:"courseID,omitempty" gorm:"uniqueIndex:idx_unique_enrollment"`
+	UserID            uint64                  `protobuf:"varint,3,opt,name=userID,proto3" json:"userID,omitempty" gorm:"uniqueIndex:idx_unique_enrollment"`
+	GroupID           uint64                  `protobuf:"varint,4,opt,name=groupID,proto3" json:"groupID,omitempty"`
+	HasTeacherScopes  bool                    `protobuf:"varint,5,opt,name=hasTeacherScopes,proto3" json:"hasTeacherScopes,omitempty"`
+	User              *User                   `protobuf:"bytes,6,opt,name=user,proto3" json:"user,omitempty"`
+	Course            *Course                 `protobuf:"bytes,7,opt,name=course,proto3" json:"course,omitempty"`
+	Group             *Group                  `protobuf:"bytes,8,opt,name=group,proto3" json:"group,omitempty"`
+	Status            Enrollment_UserStatus   `protobuf:"varint,9,opt,name=status,proto3,enum=ag.Enrollment_UserStatus" json:"status,omitempty"`
+	State             Enrollment_DisplayState `protobuf:"varint,10,opt,name=state,proto3,enum=ag.Enrollment_DisplayState" json:"state,omitempty"`
+	SlipDaysRemaining uint32                  `protobuf:"varint,11,opt,name=slipDaysRemaining,proto3" json:"slipDaysRemaining,omitempty" gorm:"-"`
+	LastActivityDate  string                  `protobuf:"bytes,12,opt,name=lastActivityDate,proto3" json:"lastActivityDate,omitempty"`
+	TotalApproved     uint64                  `protobuf:"varint,13,opt,name=totalApproved,proto3" json:"totalApproved,omitempty"`
+	UsedSlipDays      []*UsedSlipDays         `protobuf:"bytes,14,rep,name=usedSlipDays,proto3" json:"usedSlipDays,omitempty"`
+	DeletedAt         DeletedAt               `protobuf:"varint,15,opt,name=deletedAt,proto3" json:"deletedAt,omitempty" gorm:"not null;default:0"` // deletion time; zero if not deleted
+}
+
+func (x *Enrollment) Reset() {
+	*x = Enrollment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Enrollment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Enrollment) ProtoMessage() {}
+
+func (x *Enrollment) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Enrollment.ProtoReflect.Descriptor instead.
+func (*Enrollment) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *Enrollment) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *Enrollment) GetCourseID() uint64 {
+	if x != nil {
+		return x.CourseID
+	}
+	return 0
+}
+
+func (x *Enrollment) GetUserID() uint64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *Enrollment) GetGroupID() uint64 {
+	if x != nil {
+		return x.GroupID
+	}
+	return 0
+}
+
+func (x *Enrollment) GetHasTeacherScopes() bool {
+	if x != nil {
+		return x.HasTeacherScopes
+	}
+	return false
+}
+
+func (x *Enrollment) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *Enrollment) GetCourse() *Course {
+	if x != nil {
+		return x.Course
+	}
+	return nil
+}
+
+func (x *Enrollment) GetGroup() *Group {
+	if x != nil {
+		return x.Group
+	}
+	return nil
+}
+
+func (x *Enrollment) GetStatus() Enrollment_UserStatus {
+	if x != nil {
+		return x.Status
+	}
+	return Enrollment_NONE
+}
+
+func (x *Enrollment) GetState() Enrollment_DisplayState {
+	if x != nil {
+		return x.State
+	}
+	return Enrollment_UNSET
+}
+
+func (x *Enrollment) GetSlipDaysRemaining() uint32 {
+	if x != nil {
+		return x.SlipDaysRemaining
+	}
+	return 0
+}
+
+func (x *Enrollment) GetLastActivityDate() string {
+	if x != nil {
+		return x.LastActivityDate
+	}
+	return ""
+}
+
+func (x *Enrollment) GetTotalApproved() uint64 {
+	if x != nil {
+		return x.TotalApproved
+	}
+	return 0
+}
+
+func (x *Enrollment) GetUsedSlipDays() []*UsedSlipDays {
+	if x != nil {
+		return x.UsedSlipDays
+	}
+	return nil
+}
+
+func (x *Enrollment) GetDeletedAt() DeletedAt {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return 0
+}
+
+type UsedSlipDays struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID           uint64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	EnrollmentID uint64 `protobuf:"varint,2,opt,name=enrollmentID,proto3" json:"enrollmentID,omitempty"`
+	AssignmentID uint64 `protobuf:"varint,3,opt,name=assignmentID,proto3" json:"assignmentID,omitempty"`
+	UsedSlipDays uint32 `protobuf:"varint,4,opt,name=usedSlipDays,proto3" json:"usedSlipDays,omitempty"`
+}
+
+func (x *UsedSlipDays) Reset() {
+	*x = UsedSlipDays{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UsedSlipDays) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UsedSlipDays) ProtoMessage() {}
+
+func (x *UsedSlipDays) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UsedSlipDays.ProtoReflect.Descriptor instead.
+func (*UsedSlipDays) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *UsedSlipDays) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *UsedSlipDays) GetEnrollmentID() uint64 {
+	if x != nil {
+		return x.EnrollmentID
+	}
+	return 0
+}
+
+func (x *UsedSlipDays) GetAssignmentID() uint64 {
+	if x != nil {
+		return x.AssignmentID
+	}
+	return 0
+}
+
+func (x *UsedSlipDays) GetUsedSlipDays() uint32 {
+	if x != nil {
+		return x.UsedSlipDays
+	}
+	return 0
+}
+
+type SlipDaysRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CourseID uint64 `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	UserID   uint64 `protobuf:"varint,2,opt,name=userID,proto3" json:"userID,omitempty"`
+}
+
+func (x *SlipDaysRequest) Reset() {
+	*x = SlipDaysRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SlipDaysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SlipDaysRequest) ProtoMessage() {}
+
+func (x *SlipDaysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SlipDaysRequest.ProtoReflect.Descriptor instead.
+func (*SlipDaysRequest) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *SlipDaysRequest) GetCourseID() uint64 {
+	if x != nil {
+		return x.CourseID
+	}
+	return 0
+}
+
+func (x *SlipDaysRequest) GetUserID() uint64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+// SlipDays holds a student's use of the course's slip-day budget.
+// Late submissions are not approved automatically once the budget is used.
+type SlipDays struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CourseID     uint64          `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	UserID       uint64          `protobuf:"varint,2,opt,name=userID,proto3" json:"userID,omitempty"`
+	Budget       uint32          `protobuf:"varint,3,opt,name=budget,proto3" json:"budget,omitempty"` // the course's slip days; 0 means the course has no slip-day budget
+	Used         uint32          `protobuf:"varint,4,opt,name=used,proto3" json:"used,omitempty"`
+	Remaining    uint32          `protobuf:"varint,5,opt,name=remaining,proto3" json:"remaining,omitempty"`
+	UsedSlipDays []*UsedSlipDays `protobuf:"bytes,6,rep,name=usedSlipDays,proto3" json:"usedSlipDays,omitempty"` // the slip days used for each assignment
+}
+
+func (x *SlipDays) Reset() {
+	*x = SlipDays{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SlipDays) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SlipDays) ProtoMessage() {}
+
+func (x *SlipDays) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SlipDays.ProtoReflect.Descriptor instead.
+func (*SlipDays) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *SlipDays) GetCourseID() uint64 {
+	if x != nil {
+		return x.CourseID
+	}
+	return 0
+}
+
+func (x *SlipDays) GetUserID() uint64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *SlipDays) GetBudget() uint32 {
+	if x != nil {
+		return x.Budget
+	}
+	return 0
+}
+
+func (x *SlipDays) GetUsed() uint32 {
+	if x != nil {
+		return x.Used
+	}
+	return 0
+}
+
+func (x *SlipDays) GetRemaining() uint32 {
+	if x != nil {
+		return x.Remaining
+	}
+	return 0
+}
+
+func (x *SlipDays) GetUsedSlipDays() []*UsedSlipDays {
+	if x != nil {
+		return x.UsedSlipDays
+	}
+	return nil
+}
+
+type Enrollments struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Enrollments   []*Enrollment `protobuf:"bytes,1,rep,name=enrollments,proto3" json:"enrollments,omitempty"`
+	NextPageToken string        `protobuf:"bytes,2,opt,name=nextPageToken,proto3" json:"nextPageToken,omitempty"` // token for the next page; empty if this is the last page
+}
+
+func (x *Enrollments) Reset() {
+	*x = Enrollments{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Enrollments) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Enrollments) ProtoMessage() {}
+
+func (x *Enrollments) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Enrollments.ProtoReflect.Descriptor instead.
+func (*Enrollments) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *Enrollments) GetEnrollments() []*Enrollment {
+	if x != nil {
+		return x.Enrollments
+	}
+	return nil
+}
+
+func (x *Enrollments) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// PreEnrollment is a student imported into a course's roster. If the student has not logged in
+// to QuickFeed, the student's enrollment is created when the student first logs in with a
+// matching login or email, and the student's user is then recorded in the pre-enrollment.
+type PreEnrollment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID        uint64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	CourseID  uint64 `protobuf:"varint,2,opt,name=courseID,proto3" json:"courseID,omitempty" gorm:"index"`
+	StudentID string `protobuf:"bytes,3,opt,name=studentID,proto3" json:"studentID,omitempty"`
+	Email     string `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
+	Login     string `protobuf:"bytes,5,opt,name=login,proto3" json:"login,omitempty"`    // GitHub username
+	UserID    uint64 `protobuf:"varint,6,opt,name=userID,proto3" json:"userID,omitempty"` // the matched user; 0 until the student has logged in
+}
+
+func (x *PreEnrollment) Reset() {
+	*x = PreEnrollment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PreEnrollment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreEnrollment) ProtoMessage() {}
+
+func (x *PreEnrollment) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreEnrollment.ProtoReflect.Descriptor instead.
+func (*PreEnrollment) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *PreEnrollment) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *PreEnrollment) GetCourseID() uint64 {
+	if x != nil {
+		return x.CourseID
+	}
+	return 0
+}
+
+func (x *PreEnrollment) GetStudentID() string {
+	if x != nil {
+		return x.StudentID
+	}
+	return ""
+}
+
+func (x *PreEnrollment) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *PreEnrollment) GetLogin() string {
+	if x != nil {
+		return x.Login
+	}
+	return ""
+}
+
+func (x *PreEnrollment) GetUserID() uint64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+type SubmissionLink struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Assignment *Assignment `protobuf:"bytes,1,opt,name=assignment,proto3" json:"assignment,omitempty"`
+	Submission *Submission `protobuf:"bytes,2,opt,name=submission,proto3" json:"submission,omitempty"`
+}
+
+func (x *SubmissionLink) Reset() {
+	*x = SubmissionLink{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubmissionLink) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmissionLink) ProtoMessage() {}
+
+func (x *SubmissionLink) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmissionLink.ProtoReflect.Descriptor instead.
+func (*SubmissionLink) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *SubmissionLink) GetAssignment() *Assignment {
+	if x != nil {
+		return x.Assignment
+	}
+	return nil
+}
+
+func (x *SubmissionLink) GetSubmission() *Submission {
+	if x != nil {
+		return x.Submission
+	}
+	return nil
+}
+
+type EnrollmentLink struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Enrollment  *Enrollment       `protobuf:"bytes,2,opt,name=enrollment,proto3" json:"enrollment,omitempty"` // must have course and user/group preloaded
+	Submissions []*SubmissionLink `protobuf:"bytes,3,rep,name=submissions,proto3" json:"submissions,omitempty"`
+}
+
+func (x *EnrollmentLink) Reset() {
+	*x = EnrollmentLink{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EnrollmentLink) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnrollmentLink) ProtoMessage() {}
+
+func (x *EnrollmentLink) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnrollmentLink.ProtoReflect.Descriptor instead.
+func (*EnrollmentLink) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *EnrollmentLink) GetEnrollment() *Enrollment {
+	if x != nil {
+		return x.Enrollment
+	}
+	return nil
+}
+
+func (x *EnrollmentLink) GetSubmissions() []*SubmissionLink {
+	if x != nil {
+		return x.Submissions
+	}
+	return nil
+}
+
+type CourseSubmissions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Course *Course           `protobuf:"bytes,1,opt,name=course,proto3" json:"course,omitempty"` // preloaded assignments
+	Links  []*EnrollmentLink `protobuf:"bytes,2,rep,name=links,proto3" json:"links,omitempty"`
+}
+
+func (x *CourseSubmissions) Reset() {
+	*x = CourseSubmissions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CourseSubmissions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CourseSubmissions) ProtoMessage() {}
+
+func (x *CourseSubmissions) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CourseSubmissions.ProtoReflect.Descriptor instead.
+func (*CourseSubmissions) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *CourseSubmissions) GetCourse() *Course {
+	if x != nil {
+		return x.Course
+	}
+	return nil
+}
+
+func (x *CourseSubmissions) GetLinks() []*EnrollmentLink {
+	if x != nil {
+		return x.Links
+	}
+	return nil
+}
+
+type Assignment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID                    uint64              `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	CourseID              uint64              `protobuf:"varint,2,opt,name=CourseID,proto3" json:"CourseID,omitempty"` // foreign key
+	Name                  string              `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	ScriptFile            string              `protobuf:"bytes,4,opt,name=scriptFile,proto3" json:"scriptFile,omitempty"`
+	Deadline              string              `protobuf:"bytes,5,opt,name=deadline,proto3" json:"deadline,omitempty"` // deadline in UTC, in the TimeLayout format
+	AutoApprove           bool                `protobuf:"varint,6,opt,name=autoApprove,proto3" json:"autoApprove,omitempty"`
+	Order                 uint32              `protobuf:"varint,7,opt,name=order,proto3" json:"order,omitempty"`
+	IsGroupLab            bool                `protobuf:"varint,8,opt,name=isGroupLab,proto3" json:"isGroupLab,omitempty"`
+	ScoreLimit            uint32              `protobuf:"varint,9,opt,name=scoreLimit,proto3" json:"scoreLimit,omitempty"`                        // minimal score limit for auto approval
+	Reviewers             uint32              `protobuf:"varint,10,opt,name=reviewers,proto3" json:"reviewers,omitempty"`                         // number of reviewers that will review submissions for this assignment
+	Submissions           []*Submission       `protobuf:"bytes,11,rep,name=submissions,proto3" json:"submissions,omitempty"`                      // submissions produced for this assignment
+	GradingBenchmarks     []*GradingBenchmark `protobuf:"bytes,12,rep,name=gradingBenchmarks,proto3" json:"gradingBenchmarks,omitempty"`          // grading benchmarks for this assignment
+	ContainerTimeout      uint32              `protobuf:"varint,13,opt,name=containerTimeout,proto3" json:"containerTimeout,omitempty"`           // TODO(meling) Do we need this?
+	TestScoreWeight       uint32              `protobuf:"varint,14,opt,name=testScoreWeight,proto3" json:"testScoreWeight,omitempty"`             // percentage of a manually graded submission's score given by the tests; the remainder is given by manual review
+	GradeWeight           uint32              `protobuf:"varint,15,opt,name=gradeWeight,proto3" json:"gradeWeight,omitempty"`                     // relative weight of the assignment in the final grade
+	Mandatory             bool                `protobuf:"varint,16,opt,name=mandatory,proto3" json:"mandatory,omitempty"`                         // true => the assignment must be approved to pass the course
+	RunnerPool            string              `protobuf:"bytes,17,opt,name=runnerPool,proto3" json:"runnerPool,omitempty"`                        // runner pool the assignment's tests must run on; overrides the course's runner pool
+	LateGraceHours        uint32              `protobuf:"varint,18,opt,name=lateGraceHours,proto3" json:"lateGraceHours,omitempty"`               // hours after the deadline before late submissions are penalized
+	LatePenalty           uint32              `protobuf:"varint,19,opt,name=latePenalty,proto3" json:"latePenalty,omitempty"`                     // percentage deducted from the score of late submissions per day late
+	LateCutoffDays        uint32              `protobuf:"varint,20,opt,name=lateCutoffDays,proto3" json:"lateCutoffDays,omitempty"`               // days after the deadline when late submissions get no score; 0 => no cutoff
+	PeerReviews           uint32              `protobuf:"varint,21,opt,name=peerReviews,proto3" json:"peerReviews,omitempty"`                     // number of anonymous peer reviews of each submission; 0 => no peer review
+	ContainerImage        string              `protobuf:"bytes,22,opt,name=containerImage,proto3" json:"containerImage,omitempty"`                // docker image to run the assignment's tests; overrides the image in the script file
+	Dockerfile            string              `protobuf:"bytes,23,opt,name=dockerfile,proto3" json:"dockerfile,omitempty"`                        // contents of the assignment's Dockerfile in the tests repository, used to build containerImage
+	CpuLimit              uint32              `protobuf:"varint,24,opt,name=cpuLimit,proto3" json:"cpuLimit,omitempty"`                           // CPU limit of the tests' container in millicores (1000 => one CPU); 0 => no limit
+	MemoryLimit           uint32              `protobuf:"varint,25,opt,name=memoryLimit,proto3" json:"memoryLimit,omitempty"`                     // memory limit of the tests' container in megabytes; 0 => no limit
+	PidsLimit             uint32              `protobuf:"varint,26,opt,name=pidsLimit,proto3" json:"pidsLimit,omitempty"`                         // maximum number of processes in the tests' container; 0 => the runner's default
+	ScanSecrets           bool                `protobuf:"varint,27,opt,name=scanSecrets,proto3" json:"scanSecrets,omitempty"`                     // true => pushed code is scanned for committed secrets before grading
+	ForbiddenFiles        string              `protobuf:"bytes,28,opt,name=forbiddenFiles,proto3" json:"forbiddenFiles,omitempty"`                // comma-separated file name patterns not allowed in pushed code, e.g. ".env,*.exe"
+	MaxFileSize           uint32              `protobuf:"varint,29,opt,name=maxFileSize,proto3" json:"maxFileSize,omitempty"`                     // size in megabytes of the largest file allowed in pushed code; 0 => no limit
+	DisallowedImports     string              `protobuf:"bytes,30,opt,name=disallowedImports,proto3" json:"disallowedImports,omitempty"`          // comma-separated Go import paths not allowed in pushed code
+	BlockPolicyViolations bool                `protobuf:"varint,31,opt,name=blockPolicyViolations,proto3" json:"blockPolicyViolations,omitempty"` // true => the tests are not run for pushed code violating the assignment's policy
+	Release               string              `protobuf:"bytes,32,opt,name=release,proto3" json:"release,omitempty"`                              // time in UTC to publish the assignment's content to the students; empty => published by the teacher
+	Released              bool                `protobuf:"varint,33,opt,name=released,proto3" json:"released,omitempty"`                           // true => the assignment's content has been published at its release time
+	MinCoverage           uint32              `protobuf:"varint,34,opt,name=minCoverage,proto3" json:"minCoverage,omitempty"`                     // minimal test coverage percentage reported in commit statuses; not used for auto approval
+	RunConfig             string              `protobuf:"bytes,35,opt,name=runConfig,proto3" json:"runConfig,omitempty"`                          // contents of the assignment's run.json in the tests repository; used instead of scriptFile
+	Tasks                 []*Task             `protobuf:"bytes,36,rep,name=tasks,proto3" json:"tasks,omitempty"`                                  // tasks of a multi-part assignment, each graded by a subset of the tests
+	FeedbackIssue         bool                `protobuf:"varint,37,opt,name=feedbackIssue,proto3" json:"feedbackIssue,omitempty"`                 // true => released reviews of approved or rejected submissions are published as issues in the repository
+	SubmissionBranch      string              `protobuf:"bytes,38,opt,name=submissionBranch,proto3" json:"submissionBranch,omitempty"`            // branch that submissions are made to by pull request; empty => pushes to the default branch are graded
+	Exam                  bool                `protobuf:"varint,39,opt,name=exam,proto3" json:"exam,omitempty"`                                   // true => the repositories only accept pushes during the exam window, from examStart to the deadline
+	ExamStart             string              `protobuf:"bytes,40,opt,name=examStart,proto3" json:"examStart,omitempty"`                          // start of the exam window in UTC; extensions extend the window's end
+	LocalDeadline         string              `protobuf:"bytes,41,opt,name=localDeadline,proto3" json:"localDeadline,omitempty" gorm:"-"`         // deadline in the course's time zone, in RFC3339 format; the deadline field is in UTC
+}
+
+func (x *Assignment) Reset() {
+	*x = Assignment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Assignment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Assignment) ProtoMessage() {}
+
+func (x *Assignment) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Assignment.ProtoReflect.Descriptor instead.
+func (*Assignment) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *Assignment) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *Assignment) GetCourseID() uint64 {
+	if x != nil {
+		return x.CourseID
+	}
+	return 0
+}
+
+func (x *Assignment) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Assignment) GetScriptFile() string {
+	if x != nil {
+		return x.ScriptFile
+	}
+	return ""
+}
+
+func (x *Assignment) GetDeadline() string {
+	if x != nil {
+		return x.Deadline
+	}
+	return ""
+}
+
+func (x *Assignment) GetAutoApprove() bool {
+	if x != nil {
+		return x.AutoApprove
+	}
+	return false
+}
+
+func (x *Assignment) GetOrder() uint32 {
+	if x != nil {
+		return x.Order
+	}
+	return 0
+}
+
+func (x *Assignment) GetIsGroupLab() bool {
+	if x != nil {
+		return x.IsGroupLab
+	}
+	return false
+}
+
+func (x *Assignment) GetScoreLimit() uint32 {
+	if x != nil {
+		return x.ScoreLimit
+	}
+	return 0
+}
+
+func (x *Assignment) GetReviewers() uint32 {
+	if x != nil {
+		return x.Reviewers
+	}
+	return 0
+}
+
+func (x *Assignment) GetSubmissions() []*Submission {
+	if x != nil {
+		return x.Submissions
+	}
+	return nil
+}
+
+func (x *Assignment) GetGradingBenchmarks() []*GradingBenchmark {
+	if x != nil {
+		return x.GradingBenchmarks
+	}
+	return nil
+}
+
+func (x *Assignment) GetContainerTimeout() uint32 {
+	if x != nil {
+		return x.ContainerTimeout
+	}
+	return 0
+}
+
+func (x *Assignment) GetTestScoreWeight() uint32 {
+	if x != nil {
+		return x.TestScoreWeight
+	}
+	return 0
+}
+
+func (x *Assignment) GetGradeWeight() uint32 {
+	if x != nil {
+		return x.GradeWeight
+	}
+	return 0
+}
+
+func (x *Assignment) GetMandatory() bool {
+	if x != nil {
+		return x.Mandatory
+	}
+	return false
+}
+
+func (x *Assignment) GetRunnerPool() string {
+	if x != nil {
+		return x.RunnerPool
+	}
+	return ""
+}
+
+func (x *Assignment) GetLateGraceHours() uint32 {
+	if x != nil {
+		return x.LateGraceHours
+	}
+	return 0
+}
+
+func (x *Assignment) GetLatePenalty() uint32 {
+	if x != nil {
+		return x.LatePenalty
+	}
+	return 0
+}
+
+func (x *Assignment) GetLateCutoffDays() uint32 {
+	if x != nil {
+		return x.LateCutoffDays
+	}
+	return 0
+}
+
+func (x *Assignment) GetPeerReviews() uint32 {
+	if x != nil {
+		return x.PeerReviews
+	}
+	return 0
+}
+
+func (x *Assignment) GetContainerImage() string {
+	if x != nil {
+		return x.ContainerImage
+	}
+	return ""
+}
+
+func (x *Assignment) GetDockerfile() string {
+	if x != nil {
+		return x.Dockerfile
+	}
+	return ""
+}
+
+func (x *Assignment) GetCpuLimit() uint32 {
+	if x != nil {
+		return x.CpuLimit
+	}
+	return 0
+}
+
+func (x *Assignment) GetMemoryLimit() uint32 {
+	if x != nil {
+		return x.MemoryLimit
+	}
+	return 0
+}
+
+func (x *Assignment) GetPidsLimit() uint32 {
+	if x != nil {
+		return x.PidsLimit
+	}
+	return 0
+}
+
+func (x *Assignment) GetScanSecrets() bool {
+	if x != nil {
+		return x.ScanSecrets
+	}
+	return false
+}
+
+func (x *Assignment) GetForbiddenFiles() string {
+	if x != nil {
+		return x.ForbiddenFiles
+	}
+	return ""
+}
+
+func (x *Assignment) GetMaxFileSize() uint32 {
+	if x != nil {
+		return x.MaxFileSize
+	}
+	return 0
+}
+
+func (x *Assignment) GetDisallowedImports() string {
+	if x != nil {
+		return x.DisallowedImports
+	}
+	return ""
+}
+
+func (x *Assignment) GetBlockPolicyViolations() bool {
+	if x != nil {
+		return x.BlockPolicyViolations
+	}
+	return false
+}
+
+func (x *Assignment) GetRelease() string {
+	if x != nil {
+		return x.Release
+	}
+	return ""
+}
+
+func (x *Assignment) GetReleased() bool {
+	if x != nil {
+		return x.Released
+	}
+	return false
+}
+
+func (x *Assignment) GetMinCoverage() uint32 {
+	if x != nil {
+		return x.MinCoverage
+	}
+	return 0
+}
+
+func (x *Assignment) GetRunConfig() string {
+	if x != nil {
+		return x.RunConfig
+	}
+	return ""
+}
+
+func (x *Assignment) GetTasks() []*Task {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+func (x *Assignment) GetFeedbackIssue() bool {
+	if x != nil {
+		return x.FeedbackIssue
+	}
+	return false
+}
+
+func (x *Assignment) GetSubmissionBranch() string {
+	if x != nil {
+		return x.SubmissionBranch
+	}
+	return ""
+}
+
+func (x *Assignment) GetExam() bool {
+	if x != nil {
+		return x.Exam
+	}
+	return false
+}
+
+func (x *Assignment) GetExamStart() string {
+	if x != nil {
+		return x.ExamStart
+	}
+	return ""
+}
+
+func (x *Assignment) GetLocalDeadline() string {
+	if x != nil {
+		return x.LocalDeadline
+	}
+	return ""
+}
+
+type Assignments struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Assignments []*Assignment `protobuf:"bytes,1,rep,name=assignments,proto3" json:"assignments,omitempty"`
+}
+
+func (x *Assignments) Reset() {
+	*x = Assignments{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Assignments) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Assignments) ProtoMessage() {}
+
+func (x *Assignments) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Assignments.ProtoReflect.Descriptor instead.
+func (*Assignments) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *Assignments) GetAssignments() []*Assignment {
+	if x != nil {
+		return x.Assignments
+	}
+	return nil
+}
+
+// AssignmentFile is the contents of a file describing an assignment: an assignment.yml,
+// assignment.yaml or assignment.json file, or a README.md file with YAML front-matter.
+type AssignmentFile struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CourseID uint64 `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	Path     string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"` // path of the file in the course's assignments folder, e.g., lab1/assignment.yml
+	Contents string `protobuf:"bytes,3,opt,name=contents,proto3" json:"contents,omitempty"`
+}
+
+func (x *AssignmentFile) Reset() {
+	*x = AssignmentFile{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AssignmentFile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignmentFile) ProtoMessage() {}
+
+func (x *AssignmentFile) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignmentFile.ProtoReflect.Descriptor instead.
+func (*AssignmentFile) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *AssignmentFile) GetCourseID() uint64 {
+	if x != nil {
+		return x.CourseID
+	}
+	return 0
+}
+
+func (x *AssignmentFile) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *AssignmentFile) GetContents() string {
+	if x != nil {
+		return x.Contents
+	}
+	return ""
+}
+
+// AssignmentFileError is a problem with a field of an assignment file.
+type AssignmentFileError struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	File    string `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
+	Field   string `protobuf:"bytes,2,opt,name=field,proto3" json:"field,omitempty"` // e.g., latepolicy.penalty or tasks[1].name; empty if the problem concerns the whole file
+	Reason  string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	Warning bool   `protobuf:"varint,4,opt,name=warning,proto3" json:"warning,omitempty"` // true => the problem does not prevent updating the assignments, but may not be intended
+}
+
+func (x *AssignmentFileError) Reset() {
+	*x = AssignmentFileError{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AssignmentFileError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignmentFileError) ProtoMessage() {}
+
+func (x *AssignmentFileError) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignmentFileError.ProtoReflect.Descriptor instead.
+func (*AssignmentFileError) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *AssignmentFileError) GetFile() string {
+	if x != nil {
+		return x.File
+	}
+	return ""
+}
+
+func (x *AssignmentFileError) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *AssignmentFileError) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *AssignmentFileError) GetWarning() bool {
+	if x != nil {
+		return x.Warning
+	}
+	return false
+}
+
+type AssignmentFileErrors struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Errors []*AssignmentFileError `protobuf:"bytes,1,rep,name=errors,proto3" json:"errors,omitempty"`
+}
+
+func (x *AssignmentFileErrors) Reset() {
+	*x = AssignmentFileErrors{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AssignmentFileErrors) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignmentFileErrors) ProtoMessage() {}
+
+func (x *AssignmentFileErrors) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignmentFileErrors.ProtoReflect.Descriptor instead.
+func (*AssignmentFileErrors) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *AssignmentFileErrors) GetErrors() []*AssignmentFileError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+// AssignmentValidation is the result of checking the assignments in a course's tests repository
+// without updating the course's assignments.
+type AssignmentValidation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CourseID    uint64                 `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	Assignments []*Assignment          `protobuf:"bytes,2,rep,name=assignments,proto3" json:"assignments,omitempty"` // assignments found; empty if there are errors
+	Problems    []*AssignmentFileError `protobuf:"bytes,3,rep,name=problems,proto3" json:"problems,omitempty"`       // errors preventing the update, and warnings
+}
+
+func (x *AssignmentValidation) Reset() {
+	*x = AssignmentValidation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AssignmentValidation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignmentValidation) ProtoMessage() {}
+
+func (x *AssignmentValidation) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignmentValidation.ProtoReflect.Descriptor instead.
+func (*AssignmentValidation) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *AssignmentValidation) GetCourseID() uint64 {
+	if x != nil {
+		return x.CourseID
+	}
+	return 0
+}
+
+func (x *AssignmentValidation) GetAssignments() []*Assignment {
+	if x != nil {
+		return x.Assignments
+	}
+	return nil
+}
+
+func (x *AssignmentValidation) GetProblems() []*AssignmentFileError {
+	if x != nil {
+		return x.Problems
+	}
+	return nil
+}
+
+// Task is a part of a multi-part assignment, graded by the tests whose names start with one of its test prefixes.
+type Task struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID           uint64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	AssignmentID uint64 `protobuf:"varint,2,opt,name=assignmentID,proto3" json:"assignmentID,omitempty" gorm:"index"`
+	Name         string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Title        string `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	Description  string `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"` // contents of the task's task-<name>.md file in the tests repository, if any
+	Tests        string `protobuf:"bytes,6,opt,name=tests,proto3" json:"tests,omitempty"`             // comma-separated prefixes of the names of the task's tests
+	Order        uint32 `protobuf:"varint,7,opt,name=order,proto3" json:"order,omitempty"`            // position of the task in the assignment
+}
+
+func (x *Task) Reset() {
+	*x = Task{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Task) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Task) ProtoMessage() {}
+
+func (x *Task) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Task.ProtoReflect.Descriptor instead.
+func (*Task) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *Task) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *Task) GetAssignmentID() uint64 {
+	if x != nil {
+		return x.AssignmentID
+	}
+	return 0
+}
+
+func (x *Task) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Task) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Task) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Task) GetTests() string {
+	if x != nil {
+		return x.Tests
+	}
+	return ""
+}
+
+func (x *Task) GetOrder() uint32 {
+	if x != nil {
+		return x.Order
+	}
+	return 0
+}
+
+// TaskScore is the score of a submission on one of the tasks of its assignment.
+type TaskScore struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID           uint64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	SubmissionID uint64 `protobuf:"varint,2,opt,name=submissionID,proto3" json:"submissionID,omitempty" gorm:"index"`
+	TaskName     string `protobuf:"bytes,3,opt,name=taskName,proto3" json:"taskName,omitempty"`
+	TaskTitle    string `protobuf:"bytes,4,opt,name=taskTitle,proto3" json:"taskTitle,omitempty"`
+	Score        uint32 `protobuf:"varint,5,opt,name=score,proto3" json:"score,omitempty"`             // score on the task's tests, in percent
+	PassedTests  uint32 `protobuf:"varint,6,opt,name=passedTests,proto3" json:"passedTests,omitempty"` // number of the task's tests passed
+	TotalTests   uint32 `protobuf:"varint,7,opt,name=totalTests,proto3" json:"totalTests,omitempty"`   // number of the task's tests
+}
+
+func (x *TaskScore) Reset() {
+	*x = TaskScore{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TaskScore) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskScore) ProtoMessage() {}
+
+func (x *TaskScore) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskScore.ProtoReflect.Descriptor instead.
+func (*TaskScore) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *TaskScore) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *TaskScore) GetSubmissionID() uint64 {
+	if x != nil {
+		return x.SubmissionID
+	}
+	return 0
+}
+
+func (x *TaskScore) GetTaskName() string {
+	if x != nil {
+		return x.TaskName
+	}
+	return ""
+}
+
+func (x *TaskScore) GetTaskTitle() string {
+	if x != nil {
+		return x.TaskTitle
+	}
+	return ""
+}
+
+func (x *TaskScore) GetScore() uint32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *TaskScore) GetPassedTests() uint32 {
+	if x != nil {
+		return x.PassedTests
+	}
+	return 0
+}
+
+func (x *TaskScore) GetTotalTests() uint32 {
+	if x != nil {
+		return x.TotalTests
+	}
+	return 0
+}
+
+type TaskScores struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TaskScores []*TaskScore `protobuf:"bytes,1,rep,name=taskScores,proto3" json:"taskScores,omitempty"`
+}
+
+func (x *TaskScores) Reset() {
+	*x = TaskScores{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TaskScores) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskScores) ProtoMessage() {}
+
+func (x *TaskScores) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskScores.ProtoReflect.Descriptor instead.
+func (*TaskScores) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *TaskScores) GetTaskScores() []*TaskScore {
+	if x != nil {
+		return x.TaskScores
+	}
+	return nil
+}
+
+type Submission struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID               uint64                   `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	AssignmentID     uint64                   `protobuf:"varint,2,opt,name=AssignmentID,proto3" json:"AssignmentID,omitempty"` // foreign key
+	UserID           uint64                   `protobuf:"varint,3,opt,name=userID,proto3" json:"userID,omitempty"`
+	GroupID          uint64                   `protobuf:"varint,4,opt,name=groupID,proto3" json:"groupID,omitempty"`
+	Score            uint32                   `protobuf:"varint,5,opt,name=score,proto3" json:"score,omitempty"`
+	CommitHash       string                   `protobuf:"bytes,6,opt,name=commitHash,proto3" json:"commitHash,omitempty"`
+	Released         bool                     `protobuf:"varint,7,opt,name=released,proto3" json:"released,omitempty"` // true => feedback is visible to the student or group members
+	Status           Submission_Status        `protobuf:"varint,8,opt,name=status,proto3,enum=ag.Submission_Status" json:"status,omitempty"`
+	ApprovedDate     string                   `protobuf:"bytes,9,opt,name=approvedDate,proto3" json:"approvedDate,omitempty"`
+	Reviews          []*Review                `protobuf:"bytes,10,rep,name=reviews,proto3" json:"reviews,omitempty"`                                               // reviews produced for this submission
+	BuildInfo        *score.BuildInfo         `protobuf:"bytes,11,opt,name=BuildInfo,proto3" json:"BuildInfo,omitempty"`                                           // build info for tests
+	Scores           []*score.Score           `protobuf:"bytes,12,rep,name=Scores,proto3" json:"Scores,omitempty"`                                                 // list of scores for different tests
+	Grade            string                   `protobuf:"bytes,13,opt,name=grade,proto3" json:"grade,omitempty" gorm:"-"`                                          // letter grade according to the course's grading scale
+	ReleasedDate     string                   `protobuf:"bytes,14,opt,name=releasedDate,proto3" json:"releasedDate,omitempty"`                                     // time when the submission's feedback was released
+	LatePenalty      uint32                   `protobuf:"varint,15,opt,name=latePenalty,proto3" json:"latePenalty,omitempty"`                                      // percentage deducted from the score for late delivery
+	ReviewScore      uint32                   `protobuf:"varint,16,opt,name=reviewScore,proto3" json:"reviewScore,omitempty"`                                      // rubric score of the released manual review; combined with the test score in score
+	ExceededLimit    Submission_ExceededLimit `protobuf:"varint,17,opt,name=exceededLimit,proto3,enum=ag.Submission_ExceededLimit" json:"exceededLimit,omitempty"` // resource limit exceeded by the tests; the tests' results are incomplete if set
+	PolicyViolations []*PolicyViolation       `protobuf:"bytes,18,rep,name=policyViolations,proto3" json:"policyViolations,omitempty"`                             // violations of the assignment's policy found in the pushed code
+	PolicyBlocked    bool                     `protobuf:"varint,19,opt,name=policyBlocked,proto3" json:"policyBlocked,omitempty"`                                  // true => the tests were not run because of policy violations
+	Coverage         float64                  `protobuf:"fixed64,20,opt,name=coverage,proto3" json:"coverage,omitempty"`                                           // percentage of statements or lines covered by the tests; 0 if no coverage report was found
+	FileCoverage     []*FileCoverage          `protobuf:"bytes,21,rep,name=fileCoverage,proto3" json:"fileCoverage,omitempty"`                                     // coverage of each source file in the coverage reports
+	FeedbackIssueURL string                   `protobuf:"bytes,22,opt,name=feedbackIssueURL,proto3" json:"feedbackIssueURL,omitempty"`                             // URL of the issue publishing the released review in the repository; empty if not published
+	GradedCommit     string                   `protobuf:"bytes,23,opt,name=gradedCommit,proto3" json:"gradedCommit,omitempty"`                                     // commit the tests ran on; empty if the tests ran on the head of the default branch
+	PullRequest      uint64                   `protobuf:"varint,24,opt,name=pullRequest,proto3" json:"pullRequest,omitempty"`                                      // number of the pull request the submission was made by; 0 if made by a push
+	AdjustedScore    uint32                   `protobuf:"varint,25,opt,name=adjustedScore,proto3" json:"adjustedScore,omitempty"`                                  // score after applying the assignment's score curve; only valid if curved
+	Curved           bool                     `protobuf:"varint,26,opt,name=curved,proto3" json:"curved,omitempty"`                                                // true => adjustedScore holds the curved score; cleared by new test results
+}
+
+func (x *Submission) Reset() {
+	*x = Submission{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Submission) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Submission) ProtoMessage() {}
+
+func (x *Submission) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Submission.ProtoReflect.Descriptor instead.
+func (*Submission) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *Submission) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *Submission) GetAssignmentID() uint64 {
+	if x != nil {
+		return x.AssignmentID
+	}
+	return 0
+}
+
+func (x *Submission) GetUserID() uint64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *Submission) GetGroupID() uint64 {
+	if x != nil {
+		return x.GroupID
+	}
+	return 0
+}
+
+func (x *Submission) GetScore() uint32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *Submission) GetCommitHash() string {
+	if x != nil {
+		return x.CommitHash
+	}
+	return ""
+}
+
+func (x *Submission) GetReleased() bool {
+	if x != nil {
+		return x.Released
+	}
+	return false
+}
+
+func (x *Submission) GetStatus() Submission_Status {
+	if x != nil {
+		return x.Status
+	}
+	return Submission_NONE
+}
+
+func (x *Submission) GetApprovedDate() string {
+	if x != nil {
+		return x.ApprovedDate
+	}
+	return ""
+}
+
+func (x *Submission) GetReviews() []*Review {
+	if x != nil {
+		return x.Reviews
+	}
+	return nil
+}
+
+func (x *Submission) GetBuildInfo() *score.BuildInfo {
+	if x != nil {
+		return x.BuildInfo
+	}
+	return nil
+}
+
+func (x *Submission) GetScores() []*score.Score {
+	if x != nil {
+		return x.Scores
+	}
+	return nil
+}
+
+func (x *Submission) GetGrade() string {
+	if x != nil {
+		return x.Grade
+	}
+	return ""
+}
+
+func (x *Submission) GetReleasedDate() string {
+	if x != nil {
+		return x.ReleasedDate
+	}
+	return ""
+}
+
+func (x *Submission) GetLatePenalty() uint32 {
+	if x != nil {
+		return x.LatePenalty
+	}
+	return 0
+}
+
+func (x *Submission) GetReviewScore() uint32 {
+	if x != nil {
+		return x.ReviewScore
+	}
+	return 0
+}
+
+func (x *Submission) GetExceededLimit() Submission_ExceededLimit {
+	if x != nil {
+		return x.ExceededLimit
+	}
+	return Submission_NO_LIMIT
+}
+
+func (x *Submission) GetPolicyViolations() []*PolicyViolation {
+	if x != nil {
+		return x.PolicyViolations
+	}
+	return nil
+}
+
+func (x *Submission) GetPolicyBlocked() bool {
+	if x != nil {
+		return x.PolicyBlocked
+	}
+	return false
+}
+
+func (x *Submission) GetCoverage() float64 {
+	if x != nil {
+		return x.Coverage
+	}
+	return 0
+}
+
+func (x *Submission) GetFileCoverage() []*FileCoverage {
+	if x != nil {
+		return x.FileCoverage
+	}
+	return nil
+}
+
+func (x *Submission) GetFeedbackIssueURL() string {
+	if x != nil {
+		return x.FeedbackIssueURL
+	}
+	return ""
+}
+
+func (x *Submission) GetGradedCommit() string {
+	if x != nil {
+		return x.GradedCommit
+	}
+	return ""
+}
+
+func (x *Submission) GetPullRequest() uint64 {
+	if x != nil {
+		return x.PullRequest
+	}
+	return 0
+}
+
+func (x *Submission) GetAdjustedScore() uint32 {
+	if x != nil {
+		return x.AdjustedScore
+	}
+	return 0
+}
+
+func (x *Submission) GetCurved() bool {
+	if x != nil {
+		return x.Curved
+	}
+	return false
+}
+
+// SubmissionTransition records a grader moving a submission to a new status, forming the submission's approval trail.
+// Approvals of assignments with several reviewers are sign-offs, which keep the submission in review
+// until the required number of reviewers have signed off.
+type SubmissionTransition struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID              uint64            `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	SubmissionID    uint64            `protobuf:"varint,2,opt,name=submissionID,proto3" json:"submissionID,omitempty" gorm:"index"`
+	UserID          uint64            `protobuf:"varint,3,opt,name=userID,proto3" json:"userID,omitempty"` // the teacher or teaching assistant making the transition
+	PreviousStatus  Submission_Status `protobuf:"varint,4,opt,name=previousStatus,proto3,enum=ag.Submission_Status" json:"previousStatus,omitempty"`
+	RequestedStatus Submission_Status `protobuf:"varint,5,opt,name=requestedStatus,proto3,enum=ag.Submission_Status" json:"requestedStatus,omitempty"` // the status requested by the grader
+	Status          Submission_Status `protobuf:"varint,6,opt,name=status,proto3,enum=ag.Submission_Status" json:"status,omitempty"`                   // the resulting status; in review while awaiting sign-offs
+	Comment         string            `protobuf:"bytes,7,opt,name=comment,proto3" json:"comment,omitempty"`
+	Created         string            `protobuf:"bytes,8,opt,name=created,proto3" json:"created,omitempty"`
+}
+
+func (x *SubmissionTransition) Reset() {
+	*x = SubmissionTransition{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubmissionTransition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmissionTransition) ProtoMessage() {}
+
+func (x *SubmissionTransition) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmissionTransition.ProtoReflect.Descriptor instead.
+func (*SubmissionTransition) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *SubmissionTransition) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *SubmissionTransition) GetSubmissionID() uint64 {
+	if x != nil {
+		return x.SubmissionID
+	}
+	return 0
+}
+
+func (x *SubmissionTransition) GetUserID() uint64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *SubmissionTransition) GetPreviousStatus() Submission_Status {
+	if x != nil {
+		return x.PreviousStatus
+	}
+	return Submission_NONE
+}
+
+func (x *SubmissionTransition) GetRequestedStatus() Submission_Status {
+	if x != nil {
+		return x.RequestedStatus
+	}
+	return Submission_NONE
+}
+
+func (x *SubmissionTransition) GetStatus() Submission_Status {
+	if x != nil {
+		return x.Status
+	}
+	return Submission_NONE
+}
+
+func (x *SubmissionTransition) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *SubmissionTransition) GetCreated() string {
+	if x != nil {
+		return x.Created
+	}
+	return ""
+}
+
+type SubmissionTransitions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Transitions []*SubmissionTransition `protobuf:"bytes,1,rep,name=transitions,proto3" json:"transitions,omitempty"`
+}
+
+func (x *SubmissionTransitions) Reset() {
+	*x = SubmissionTransitions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubmissionTransitions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmissionTransitions) ProtoMessage() {}
+
+func (x *SubmissionTransitions) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmissionTransitions.ProtoReflect.Descriptor instead.
+func (*SubmissionTransitions) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *SubmissionTransitions) GetTransitions() []*SubmissionTransition {
+	if x != nil {
+		return x.Transitions
+	}
+	return nil
+}
+
+type SubmissionTransitionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CourseID     uint64            `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	SubmissionID uint64            `protobuf:"varint,2,opt,name=submissionID,proto3" json:"submissionID,omitempty"`
+	Status       Submission_Status `protobuf:"varint,3,opt,name=status,proto3,enum=ag.Submission_Status" json:"status,omitempty"` // the requested status; ignored when getting transitions
+	Comment      string            `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment,omitempty"`
+}
+
+func (x *SubmissionTransitionRequest) Reset() {
+	*x = SubmissionTransitionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubmissionTransitionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmissionTransitionRequest) ProtoMessage() {}
+
+func (x *SubmissionTransitionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmissionTransitionRequest.ProtoReflect.Descriptor instead.
+func (*SubmissionTransitionRequest) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *SubmissionTransitionRequest) GetCourseID() uint64 {
+	if x != nil {
+		return x.CourseID
+	}
+	return 0
+}
+
+func (x *SubmissionTransitionRequest) GetSubmissionID() uint64 {
+	if x != nil {
+		return x.SubmissionID
+	}
+	return 0
+}
+
+func (x *SubmissionTransitionRequest) GetStatus() Submission_Status {
+	if x != nil {
+		return x.Status
+	}
+	return Submission_NONE
+}
+
+func (x *SubmissionTransitionRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+// FileCoverage is the test coverage of a source file, as found in the coverage reports of a submission's test run.
+type FileCoverage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID           uint64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	SubmissionID uint64 `protobuf:"varint,2,opt,name=submissionID,proto3" json:"submissionID,omitempty" gorm:"index"`
+	Path         string `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`        // path of the source file, as given by the coverage report
+	Covered      uint32 `protobuf:"varint,4,opt,name=covered,proto3" json:"covered,omitempty"` // number of statements or lines covered by the tests
+	Total        uint32 `protobuf:"varint,5,opt,name=total,proto3" json:"total,omitempty"`     // number of statements or lines
+}
+
+func (x *FileCoverage) Reset() {
+	*x = FileCoverage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FileCoverage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileCoverage) ProtoMessage() {}
+
+func (x *FileCoverage) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileCoverage.ProtoReflect.Descriptor instead.
+func (*FileCoverage) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *FileCoverage) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *FileCoverage) GetSubmissionID() uint64 {
+	if x != nil {
+		return x.SubmissionID
+	}
+	return 0
+}
+
+func (x *FileCoverage) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *FileCoverage) GetCovered() uint32 {
+	if x != nil {
+		return x.Covered
+	}
+	return 0
+}
+
+func (x *FileCoverage) GetTotal() uint32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// PolicyViolation is a violation of an assignment's policy found in pushed code before grading.
+type PolicyViolation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID           uint64               `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	SubmissionID uint64               `protobuf:"varint,2,opt,name=submissionID,proto3" json:"submissionID,omitempty" gorm:"index"`
+	Rule         PolicyViolation_Rule `protobuf:"varint,3,opt,name=rule,proto3,enum=ag.PolicyViolation_Rule" json:"rule,omitempty"`
+	Path         string               `protobuf:"bytes,4,opt,name=path,proto3" json:"path,omitempty"`     // path of the violating file, relative to the repository root
+	Line         uint32               `protobuf:"varint,5,opt,name=line,proto3" json:"line,omitempty"`    // line of the violation in the file; 0 if not applicable
+	Detail       string               `protobuf:"bytes,6,opt,name=detail,proto3" json:"detail,omitempty"` // description of the violation; never contains the secret itself
+}
+
+func (x *PolicyViolation) Reset() {
+	*x = PolicyViolation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PolicyViolation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PolicyViolation) ProtoMessage() {}
+
+func (x *PolicyViolation) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PolicyViolation.ProtoReflect.Descriptor instead.
+func (*PolicyViolation) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *PolicyViolation) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *PolicyViolation) GetSubmissionID() uint64 {
+	if x != nil {
+		return x.SubmissionID
+	}
+	return 0
+}
+
+func (x *PolicyViolation) GetRule() PolicyViolation_Rule {
+	if x != nil {
+		return x.Rule
+	}
+	return PolicyViolation_SECRET
+}
+
+func (x *PolicyViolation) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *PolicyViolation) GetLine() uint32 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *PolicyViolation) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+// Artifact is a file produced by the test run of a submission, such as the full build log
+// or a coverage report. The artifact's content is stored in the artifact store.
+type Artifact struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID           uint64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	SubmissionID uint64 `protobuf:"varint,2,opt,name=submissionID,proto3" json:"submissionID,omitempty" gorm:"index"`
+	Name         string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`               // file name, relative to the test run's artifacts directory
+	Size         uint64 `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`              // size in bytes
+	ContentType  string `protobuf:"bytes,5,opt,name=contentType,proto3" json:"contentType,omitempty"` // MIME type of the content
+	Created      string `protobuf:"bytes,6,opt,name=created,proto3" json:"created,omitempty"`         // time when the artifact was stored
+	Key          string `protobuf:"bytes,7,opt,name=key,proto3" json:"key,omitempty"`                 // key of the content in the artifact store
+	URL          string `protobuf:"bytes,8,opt,name=URL,proto3" json:"URL,omitempty" gorm:"-"`        // download URL
+}
+
+func (x *Artifact) Reset() {
+	*x = Artifact{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Artifact) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Artifact) ProtoMessage() {}
+
+func (x *Artifact) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Artifact.ProtoReflect.Descriptor instead.
+func (*Artifact) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *Artifact) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *Artifact) GetSubmissionID() uint64 {
+	if x != nil {
+		return x.SubmissionID
+	}
+	return 0
+}
+
+func (x *Artifact) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Artifact) GetSize() uint64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *Artifact) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *Artifact) GetCreated() string {
+	if x != nil {
+		return x.Created
+	}
+	return ""
+}
+
+func (x *Artifact) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *Artifact) GetURL() string {
+	if x != nil {
+		return x.URL
+	}
+	return ""
+}
+
+type Artifacts struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Artifacts []*Artifact `protobuf:"bytes,1,rep,name=artifacts,proto3" json:"artifacts,omitempty"`
+}
+
+func (x *Artifacts) Reset() {
+	*x = Artifacts{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Artifacts) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Artifacts) ProtoMessage() {}
+
+func (x *Artifacts) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Artifacts.ProtoReflect.Descriptor instead.
+func (*Artifacts) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *Artifacts) GetArtifacts() []*Artifact {
+	if x != nil {
+		return x.Artifacts
+	}
+	return nil
+}
+
+type ArtifactRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CourseID     uint64 `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	SubmissionID uint64 `protobuf:"varint,2,opt,name=submissionID,proto3" json:"submissionID,omitempty"`
+}
+
+func (x *ArtifactRequest) Reset() {
+	*x = ArtifactRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ArtifactRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArtifactRequest) ProtoMessage() {}
+
+func (x *ArtifactRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArtifactRequest.ProtoReflect.Descriptor instead.
+func (*ArtifactRequest) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *ArtifactRequest) GetCourseID() uint64 {
+	if x != nil {
+		return x.CourseID
+	}
+	return 0
+}
+
+func (x *ArtifactRequest) GetSubmissionID() uint64 {
+	if x != nil {
+		return x.SubmissionID
+	}
+	return 0
+}
+
+// TestResult is the result of a single scored test in the test run of a submission.
+type TestResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID           uint64            `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	SubmissionID uint64            `protobuf:"varint,2,opt,name=submissionID,proto3" json:"submissionID,omitempty" gorm:"index"`
+	Name         string            `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`            // name of the test
+	Points       int32             `protobuf:"varint,4,opt,name=points,proto3" json:"points,omitempty"`       // points obtained; -1 if the test reported several non-zero scores
+	MaxPoints    int32             `protobuf:"varint,5,opt,name=maxPoints,proto3" json:"maxPoints,omitempty"` // maximum points for the test
+	Duration     int64             `protobuf:"varint,6,opt,name=duration,proto3" json:"duration,omitempty"`   // test duration in milliseconds; 0 if not reported by the test output
+	Output       string            `protobuf:"bytes,7,opt,name=output,proto3" json:"output,omitempty"`        // output of the test and its subtests, without score strings
+	Status       TestResult_Status `protobuf:"varint,8,opt,name=status,proto3,enum=ag.TestResult_Status" json:"status,omitempty"`
+}
+
+func (x *TestResult) Reset() {
+	*x = TestResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TestResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestResult) ProtoMessage() {}
+
+func (x *TestResult) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestResult.ProtoReflect.Descriptor instead.
+func (*TestResult) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *TestResult) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *TestResult) GetSubmissionID() uint64 {
+	if x != nil {
+		return x.SubmissionID
+	}
+	return 0
+}
+
+func (x *TestResult) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TestResult) GetPoints() int32 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+func (x *TestResult) GetMaxPoints() int32 {
+	if x != nil {
+		return x.MaxPoints
+	}
+	return 0
+}
+
+func (x *TestResult) GetDuration() int64 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *TestResult) GetOutput() string {
+	if x != nil {
+		return x.Output
+	}
+	return ""
+}
+
+func (x *TestResult) GetStatus() TestResult_Status {
+	if x != nil {
+		return x.Status
+	}
+	return TestResult_UNKNOWN
+}
+
+type TestResults struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TestResults []*TestResult `protobuf:"bytes,1,rep,name=testResults,proto3" json:"testResults,omitempty"`
+}
+
+func (x *TestResults) Reset() {
+	*x = TestResults{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TestResults) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestResults) ProtoMessage() {}
+
+func (x *TestResults) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestResults.ProtoReflect.Descriptor instead.
+func (*TestResults) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *TestResults) GetTestResults() []*TestResult {
+	if x != nil {
+		return x.TestResults
+	}
+	return nil
+}
+
+type TestResultRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CourseID     uint64 `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	SubmissionID uint64 `protobuf:"varint,2,opt,name=submissionID,proto3" json:"submissionID,omitempty"`
+}
+
+func (x *TestResultRequest) Reset() {
+	*x = TestResultRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TestResultRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestResultRequest) ProtoMessage() {}
+
+func (x *TestResultRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestResultRequest.ProtoReflect.Descriptor instead.
+func (*TestResultRequest) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *TestResultRequest) GetCourseID() uint64 {
+	if x != nil {
+		return x.CourseID
+	}
+	return 0
+}
+
+func (x *TestResultRequest) GetSubmissionID() uint64 {
+	if x != nil {
+		return x.SubmissionID
+	}
+	return 0
+}
+
+type TaskScoreRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CourseID     uint64 `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	SubmissionID uint64 `protobuf:"varint,2,opt,name=submissionID,proto3" json:"submissionID,omitempty"`
+}
+
+func (x *TaskScoreRequest) Reset() {
+	*x = TaskScoreRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TaskScoreRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskScoreRequest) ProtoMessage() {}
+
+func (x *TaskScoreRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskScoreRequest.ProtoReflect.Descriptor instead.
+func (*TaskScoreRequest) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *TaskScoreRequest) GetCourseID() uint64 {
+	if x != nil {
+		return x.CourseID
+	}
+	return 0
+}
+
+func (x *TaskScoreRequest) GetSubmissionID() uint64 {
+	if x != nil {
+		return x.SubmissionID
+	}
+	return 0
+}
+
+type Submissions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Submissions   []*Submission `protobuf:"bytes,1,rep,name=submissions,proto3" json:"submissions,omitempty"`
+	NextPageToken string        `protobuf:"bytes,2,opt,name=nextPageToken,proto3" json:"nextPageToken,omitempty"` // token for the next page; empty if this is the last page
+}
+
+func (x *Submissions) Reset() {
+	*x = Submissions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Submissions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Submissions) ProtoMessage() {}
+
+func (x *Submissions) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Submissions.ProtoReflect.Descriptor instead.
+func (*Submissions) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *Submissions) GetSubmissions() []*Submission {
+	if x != nil {
+		return x.Submissions
+	}
+	return nil
+}
+
+func (x *Submissions) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// ForcePush records a force push to the default branch of a student or group repository,
+// rewriting the history of a graded submission.
+type ForcePush struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID            uint64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	CourseID      uint64 `protobuf:"varint,2,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	SubmissionID  uint64 `protobuf:"varint,3,opt,name=submissionID,proto3" json:"submissionID,omitempty"` // foreign key; the submission affected by the force push
+	BeforeCommit  string `protobuf:"bytes,4,opt,name=beforeCommit,proto3" json:"beforeCommit,omitempty"`  // head commit before the force push
+	AfterCommit   string `protobuf:"bytes,5,opt,name=afterCommit,proto3" json:"afterCommit,omitempty"`    // head commit after the force push
+	Pusher        string `protobuf:"bytes,6,opt,name=pusher,proto3" json:"pusher,omitempty"`              // login name of the user force pushing
+	Pushed        string `protobuf:"bytes,7,opt,name=pushed,proto3" json:"pushed,omitempty"`
+	AfterDeadline bool   `protobuf:"varint,8,opt,name=afterDeadline,proto3" json:"afterDeadline,omitempty"` // true => the force push happened after the assignment's deadline
+}
+
+func (x *ForcePush) Reset() {
+	*x = ForcePush{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ForcePush) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForcePush) ProtoMessage() {}
+
+func (x *ForcePush) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForcePush.ProtoReflect.Descriptor instead.
+func (*ForcePush) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *ForcePush) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *ForcePush) GetCourseID() uint64 {
+	if x != nil {
+		return x.CourseID
+	}
+	return 0
+}
+
+func (x *ForcePush) GetSubmissionID() uint64 {
+	if x != nil {
+		return x.SubmissionID
+	}
+	return 0
+}
+
+func (x *ForcePush) GetBeforeCommit() string {
+	if x != nil {
+		return x.BeforeCommit
+	}
+	return ""
+}
+
+func (x *ForcePush) GetAfterCommit() string {
+	if x != nil {
+		return x.AfterCommit
+	}
+	return ""
+}
+
+func (x *ForcePush) GetPusher() string {
+	if x != nil {
+		return x.Pusher
+	}
+	return ""
+}
+
+func (x *ForcePush) GetPushed() string {
+	if x != nil {
+		return x.Pushed
+	}
+	return ""
+}
+
+func (x *ForcePush) GetAfterDeadline() bool {
+	if x != nil {
+		return x.AfterDeadline
+	}
+	return false
+}
+
+type ForcePushes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ForcePushes []*ForcePush `protobuf:"bytes,1,rep,name=forcePushes,proto3" json:"forcePushes,omitempty"`
+}
+
+func (x *ForcePushes) Reset() {
+	*x = ForcePushes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ForcePushes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForcePushes) ProtoMessage() {}
+
+func (x *ForcePushes) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForcePushes.ProtoReflect.Descriptor instead.
+func (*ForcePushes) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *ForcePushes) GetForcePushes() []*ForcePush {
+	if x != nil {
+		return x.ForcePushes
+	}
+	return nil
+}
+
+// MissedPushesRequest is a request to process the commits pushed to the course's
+// student and group repositories since the given time, e.g., during a webhook outage.
+type MissedPushesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CourseID uint64 `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	Since    string `protobuf:"bytes,2,opt,name=since,proto3" json:"since,omitempty"` // in the pb.TimeLayout format
+}
+
+func (x *MissedPushesRequest) Reset() {
+	*x = MissedPushesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MissedPushesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MissedPushesRequest) ProtoMessage() {}
+
+func (x *MissedPushesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MissedPushesRequest.ProtoReflect.Descriptor instead.
+func (*MissedPushesRequest) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *MissedPushesRequest) GetCourseID() uint64 {
+	if x != nil {
+		return x.CourseID
+	}
+	return 0
+}
+
+func (x *MissedPushesRequest) GetSince() string {
+	if x != nil {
+		return x.Since
+	}
+	return ""
+}
+
+// MissedPushesReport reports the outcome of processing missed pushes.
+type MissedPushesReport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Repositories uint32   `protobuf:"varint,1,opt,name=repositories,proto3" json:"repositories,omitempty"` // number of student and group repositories checked
+	Pushes       uint32   `protobuf:"varint,2,opt,name=pushes,proto3" json:"pushes,omitempty"`             // number of repositories with commits since the given time
+	Commits      uint32   `protobuf:"varint,3,opt,name=commits,proto3" json:"commits,omitempty"`           // number of commits since the given time
+	Errors       []string `protobuf:"bytes,4,rep,name=errors,proto3" json:"errors,omitempty"`              // repositories that could not be checked or processed
+}
+
+func (x *MissedPushesReport) Reset() {
+	*x = MissedPushesReport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MissedPushesReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MissedPushesReport) ProtoMessage() {}
+
+func (x *MissedPushesReport) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MissedPushesReport.ProtoReflect.Descriptor instead.
+func (*MissedPushesReport) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *MissedPushesReport) GetRepositories() uint32 {
+	if x != nil {
+		return x.Repositories
+	}
+	return 0
+}
+
+func (x *MissedPushesReport) GetPushes() uint32 {
+	if x != nil {
+		return x.Pushes
+	}
+	return 0
+}
+
+func (x *MissedPushesReport) GetCommits() uint32 {
+	if x != nil {
+		return x.Commits
+	}
+	return 0
+}
+
+func (x *MissedPushesReport) GetErrors() []string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+// Appeal is a student's appeal against a released assignment grade or final grade.
+type Appeal struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID               uint64        `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	CourseID         uint64        `protobuf:"varint,2,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	UserID           uint64        `protobuf:"varint,3,opt,name=userID,proto3" json:"userID,omitempty"`             // the appealing student
+	SubmissionID     uint64        `protobuf:"varint,4,opt,name=submissionID,proto3" json:"submissionID,omitempty"` // the appealed submission; 0 => the final grade is appealed
+	Reason           string        `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	Status           Appeal_Status `protobuf:"varint,6,opt,name=status,proto3,enum=ag.Appeal_Status" json:"status,omitempty"`
+	Opened           string        `protobuf:"bytes,7,opt,name=opened,proto3" json:"opened,omitempty"`
+	ResponseDeadline string        `protobuf:"bytes,8,opt,name=responseDeadline,proto3" json:"responseDeadline,omitempty"` // time by which the appeal must be decided
+	Decided          string        `protobuf:"bytes,9,opt,name=decided,proto3" json:"decided,omitempty"`
+	DeciderID        uint64        `protobuf:"varint,10,opt,name=deciderID,proto3" json:"deciderID,omitempty"` // the teacher deciding the appeal
+	Rationale        string        `protobuf:"bytes,11,opt,name=rationale,proto3" json:"rationale,omitempty"`  // the rationale for the decision
+	NewGrade         string        `protobuf:"bytes,12,opt,name=newGrade,proto3" json:"newGrade,omitempty"`    // the grade after a granted appeal
+}
+
+func (x *Appeal) Reset() {
+	*x = Appeal{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Appeal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Appeal) ProtoMessage() {}
+
+func (x *Appeal) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Appeal.ProtoReflect.Descriptor instead.
+func (*Appeal) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *Appeal) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *Appeal) GetCourseID() uint64 {
+	if x != nil {
+		return x.CourseID
+	}
+	return 0
+}
+
+func (x *Appeal) GetUserID() uint64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *Appeal) GetSubmissionID() uint64 {
+	if x != nil {
+		return x.SubmissionID
+	}
+	return 0
+}
+
+func (x *Appeal) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *Appeal) GetStatus() Appeal_Status {
+	if x != nil {
+		return x.Status
+	}
+	return Appeal_OPEN
+}
+
+func (x *Appeal) GetOpened() string {
+	if x != nil {
+		return x.Opened
+	}
+	return ""
+}
+
+func (x *Appeal) GetResponseDeadline() string {
+	if x != nil {
+		return x.ResponseDeadline
+	}
+	return ""
+}
+
+func (x *Appeal) GetDecided() string {
+	if x != nil {
+		return x.Decided
+	}
+	return ""
+}
+
+func (x *Appeal) GetDeciderID() uint64 {
+	if x != nil {
+		return x.DeciderID
+	}
+	return 0
+}
+
+func (x *Appeal) GetRationale() string {
+	if x != nil {
+		return x.Rationale
+	}
+	return ""
+}
+
+func (x *Appeal) GetNewGrade() string {
+	if x != nil {
+		return x.NewGrade
+	}
+	return ""
+}
+
+type Appeals struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Appeals []*Appeal `protobuf:"bytes,1,rep,name=appeals,proto3" json:"appeals,omitempty"`
+}
+
+func (x *Appeals) Reset() {
+	*x = Appeals{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Appeals) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Appeals) ProtoMessage() {}
+
+func (x *Appeals) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Appeals.ProtoReflect.Descriptor instead.
+func (*Appeals) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *Appeals) GetAppeals() []*Appeal {
+	if x != nil {
+		return x.Appeals
+	}
+	return nil
+}
+
+type AppealRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CourseID uint64 `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	Overdue  bool   `protobuf:"varint,2,opt,name=overdue,proto3" json:"overdue,omitempty"` // true => only open appeals past their response deadline
+}
+
+func (x *AppealRequest) Reset() {
+	*x = AppealRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AppealRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppealRequest) ProtoMessage() {}
+
+func (x *AppealRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppealRequest.ProtoReflect.Descriptor instead.
+func (*AppealRequest) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *AppealRequest) GetCourseID() uint64 {
+	if x != nil {
+		return x.CourseID
+	}
+	return 0
+}
+
+func (x *AppealRequest) GetOverdue() bool {
+	if x != nil {
+		return x.Overdue
+	}
+	return false
+}
+
+// Extension extends the deadline of an assignment for a single student or group.
+type Extension struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID           uint64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	CourseID     uint64 `protobuf:"varint,2,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	AssignmentID uint64 `protobuf:"varint,3,opt,name=assignmentID,proto3" json:"assignmentID,omitempty" gorm:"uniqueIndex:idx_unique_extension"`
+	UserID       uint64 `protobuf:"varint,4,opt,name=userID,proto3" json:"userID,omitempty" gorm:"uniqueIndex:idx_unique_extension"`   // the student granted the extension; 0 for groups
+	GroupID      uint64 `protobuf:"varint,5,opt,name=groupID,proto3" json:"groupID,omitempty" gorm:"uniqueIndex:idx_unique_extension"` // the group granted the extension; 0 for students
+	Deadline     string `protobuf:"bytes,6,opt,name=deadline,proto3" json:"deadline,omitempty"`                                        // the extended deadline
+	Reason       string `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
+	GrantedByID  uint64 `protobuf:"varint,8,opt,name=grantedByID,proto3" json:"grantedByID,omitempty"` // the teacher granting the extension
+	Granted      string `protobuf:"bytes,9,opt,name=granted,proto3" json:"granted,omitempty"`
+}
+
+func (x *Extension) Reset() {
+	*x = Extension{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Extension) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Extension) ProtoMessage() {}
+
+func (x *Extension) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Extension.ProtoReflect.Descriptor instead.
+func (*Extension) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *Extension) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *Extension) GetCourseID() uint64 {
+	if x != nil {
+		return x.CourseID
+	}
+	return 0
+}
+
+func (x *Extension) GetAssignmentID() uint64 {
+	if x != nil {
+		return x.AssignmentID
+	}
+	return 0
+}
+
+func (x *Extension) GetUserID() uint64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *Extension) GetGroupID() uint64 {
+	if x != nil {
+		return x.GroupID
+	}
+	return 0
+}
+
+func (x *Extension) GetDeadline() string {
+	if x != nil {
+		return x.Deadline
+	}
+	return ""
+}
+
+func (x *Extension) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *Extension) GetGrantedByID() uint64 {
+	if x != nil {
+		return x.GrantedByID
+	}
+	return 0
+}
+
+func (x *Extension) GetGranted() string {
+	if x != nil {
+		return x.Granted
+	}
+	return ""
+}
+
+type Extensions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Extensions []*Extension `protobuf:"bytes,1,rep,name=extensions,proto3" json:"extensions,omitempty"`
+}
+
+func (x *Extensions) Reset() {
+	*x = Extensions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Extensions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Extensions) ProtoMessage() {}
+
+func (x *Extensions) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Extensions.ProtoReflect.Descriptor instead.
+func (*Extensions) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *Extensions) GetExtensions() []*Extension {
+	if x != nil {
+		return x.Extensions
+	}
+	return nil
+}
+
+type ExtensionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CourseID     uint64 `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	AssignmentID uint64 `protobuf:"varint,2,opt,name=assignmentID,proto3" json:"assignmentID,omitempty"` // 0 => extensions for all assignments
+}
+
+func (x *ExtensionRequest) Reset() {
+	*x = ExtensionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExtensionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExtensionRequest) ProtoMessage() {}
+
+func (x *ExtensionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExtensionRequest.ProtoReflect.Descriptor instead.
+func (*ExtensionRequest) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *ExtensionRequest) GetCourseID() uint64 {
+	if x != nil {
+		return x.CourseID
+	}
+	return 0
+}
+
+func (x *ExtensionRequest) GetAssignmentID() uint64 {
+	if x != nil {
+		return x.AssignmentID
+	}
+	return 0
+}
+
+// TestJob is a queued or running job testing a submission. Test jobs are stored
+// in the database until they complete, so that they are resumed after a restart.
+type TestJob struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID           uint64           `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	CourseID     uint64           `protobuf:"varint,2,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	AssignmentID uint64           `protobuf:"varint,3,opt,name=assignmentID,proto3" json:"assignmentID,omitempty"`
+	RepositoryID uint64           `protobuf:"varint,4,opt,name=repositoryID,proto3" json:"repositoryID,omitempty"`
+	UserID       uint64           `protobuf:"varint,5,opt,name=userID,proto3" json:"userID,omitempty"`
+	GroupID      uint64           `protobuf:"varint,6,opt,name=groupID,proto3" json:"groupID,omitempty"`
+	CommitID     string           `protobuf:"bytes,7,opt,name=commitID,proto3" json:"commitID,omitempty"`
+	JobOwner     string           `protobuf:"bytes,8,opt,name=jobOwner,proto3" json:"jobOwner,omitempty"`
+	Rebuild      bool             `protobuf:"varint,9,opt,name=rebuild,proto3" json:"rebuild,omitempty"`
+	RunnerPool   string           `protobuf:"bytes,10,opt,name=runnerPool,proto3" json:"runnerPool,omitempty"` // runner pool the job is queued on; empty for the default pool
+	Priority     TestJob_Priority `protobuf:"varint,11,opt,name=priority,proto3,enum=ag.TestJob_Priority" json:"priority,omitempty"`
+	Status       TestJob_Status   `protobuf:"varint,12,opt,name=status,proto3,enum=ag.TestJob_Status" json:"status,omitempty"`
+	Attempts     uint32           `protobuf:"varint,13,opt,name=attempts,proto3" json:"attempts,omitempty"` // number of times the job has been started
+	Enqueued     string           `protobuf:"bytes,14,opt,name=enqueued,proto3" json:"enqueued,omitempty"`
+	Started      string           `protobuf:"bytes,15,opt,name=started,proto3" json:"started,omitempty"`          // time when the job's most recent attempt started
+	LastError    string           `protobuf:"bytes,16,opt,name=lastError,proto3" json:"lastError,omitempty"`      // error of the job's most recent failed attempt, if any
+	PullRequest  uint64           `protobuf:"varint,17,opt,name=pullRequest,proto3" json:"pullRequest,omitempty"` // number of the pull request whose head commit is tested; 0 for pushes
+}
+
+func (x *TestJob) Reset() {
+	*x = TestJob{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TestJob) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestJob) ProtoMessage() {}
+
+func (x *TestJob) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestJob.ProtoReflect.Descriptor instead.
+func (*TestJob) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *TestJob) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *TestJob) GetCourseID() uint64 {
+	if x != nil {
+		return x.CourseID
+	}
+	return 0
+}
+
+func (x *TestJob) GetAssignmentID() uint64 {
+	if x != nil {
+		return x.AssignmentID
+	}
+	return 0
+}
+
+func (x *TestJob) GetRepositoryID() uint64 {
+	if x != nil {
+		return x.RepositoryID
+	}
+	return 0
+}
+
+func (x *TestJob) GetUserID() uint64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *TestJob) GetGroupID() uint64 {
+	if x != nil {
+		return x.GroupID
+	}
+	return 0
+}
+
+func (x *TestJob) GetCommitID() string {
+	if x != nil {
+		return x.CommitID
+	}
+	return ""
+}
+
+func (x *TestJob) GetJobOwner() string {
+	if x != nil {
+		return x.JobOwner
+	}
+	return ""
+}
+
+func (x *TestJob) GetRebuild() bool {
+	if x != nil {
+		return x.Rebuild
+	}
+	return false
+}
+
+func (x *TestJob) GetRunnerPool() string {
+	if x != nil {
+		return x.RunnerPool
+	}
+	return ""
+}
+
+func (x *TestJob) GetPriority() TestJob_Priority {
+	if x != nil {
+		return x.Priority
+	}
+	return TestJob_NORMAL
+}
+
+func (x *TestJob) GetStatus() TestJob_Status {
+	if x != nil {
+		return x.Status
+	}
+	return TestJob_QUEUED
+}
+
+func (x *TestJob) GetAttempts() uint32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *TestJob) GetEnqueued() string {
+	if x != nil {
+		return x.Enqueued
+	}
+	return ""
+}
+
+func (x *TestJob) GetStarted() string {
+	if x != nil {
+		return x.Started
+	}
+	return ""
+}
+
+func (x *TestJob) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *TestJob) GetPullRequest() uint64 {
+	if x != nil {
+		return x.PullRequest
+	}
+	return 0
+}
+
+type TestJobs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TestJobs []*TestJob `protobuf:"bytes,1,rep,name=testJobs,proto3" json:"testJobs,omitempty"`
+}
+
+func (x *TestJobs) Reset() {
+	*x = TestJobs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TestJobs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestJobs) ProtoMessage() {}
+
+func (x *TestJobs) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestJobs.ProtoReflect.Descriptor instead.
+func (*TestJobs) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *TestJobs) GetTestJobs() []*TestJob {
+	if x != nil {
+		return x.TestJobs
+	}
+	return nil
+}
+
+// SubmittedCommit records that a commit was submitted for an assignment by the owner of a
+// repository, such that each commit is tested only once, even if its push is delivered again.
+type SubmittedCommit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID           uint64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	AssignmentID uint64 `protobuf:"varint,2,opt,name=assignmentID,proto3" json:"assignmentID,omitempty" gorm:"uniqueIndex:idx_submitted_commit"`
+	UserID       uint64 `protobuf:"varint,3,opt,name=userID,proto3" json:"userID,omitempty" gorm:"uniqueIndex:idx_submitted_commit"`
+	GroupID      uint64 `protobuf:"varint,4,opt,name=groupID,proto3" json:"groupID,omitempty" gorm:"uniqueIndex:idx_submitted_commit"`
+	CommitID     string `protobuf:"bytes,5,opt,name=commitID,proto3" json:"commitID,omitempty" gorm:"uniqueIndex:idx_submitted_commit"`
+	Submitted    string `protobuf:"bytes,6,opt,name=submitted,proto3" json:"submitted,omitempty"`
+}
+
+func (x *SubmittedCommit) Reset() {
+	*x = SubmittedCommit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubmittedCommit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmittedCommit) ProtoMessage() {}
+
+func (x *SubmittedCommit) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmittedCommit.ProtoReflect.Descriptor instead.
+func (*SubmittedCommit) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *SubmittedCommit) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *SubmittedCommit) GetAssignmentID() uint64 {
+	if x != nil {
+		return x.AssignmentID
+	}
+	return 0
+}
+
+func (x *SubmittedCommit) GetUserID() uint64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *SubmittedCommit) GetGroupID() uint64 {
+	if x != nil {
+		return x.GroupID
+	}
+	return 0
+}
+
+func (x *SubmittedCommit) GetCommitID() string {
+	if x != nil {
+		return x.CommitID
+	}
+	return ""
+}
+
+func (x *SubmittedCommit) GetSubmitted() string {
+	if x != nil {
+		return x.Submitted
+	}
+	return ""
+}
+
+// WebhookDelivery records a webhook delivered by an SCM provider, such that redelivered
+// webhooks are processed only once, and failed deliveries can be replayed.
+type WebhookDelivery struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID         uint64                 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	DeliveryID string                 `protobuf:"bytes,2,opt,name=deliveryID,proto3" json:"deliveryID,omitempty" gorm:"uniqueIndex"` // the SCM provider's ID of the delivery
+	Event      string                 `protobuf:"bytes,3,opt,name=event,proto3" json:"event,omitempty"`                              // event type, e.g., push
+	Repository string                 `protobuf:"bytes,4,opt,name=repository,proto3" json:"repository,omitempty"`                    // full name of the repository the event is for
+	Status     WebhookDelivery_Status `protobuf:"varint,5,opt,name=status,proto3,enum=ag.WebhookDelivery_Status" json:"status,omitempty" gorm:"index"`
+	Error      string                 `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`            // error of the most recent failed attempt, if any
+	Deliveries uint32                 `protobuf:"varint,7,opt,name=deliveries,proto3" json:"deliveries,omitempty"` // number of times the webhook was delivered or replayed
+	Received   string                 `protobuf:"bytes,8,opt,name=received,proto3" json:"received,omitempty"`      // time of the first delivery
+	Processed  string                 `protobuf:"bytes,9,opt,name=processed,proto3" json:"processed,omitempty"`    // time of the most recent attempt
+	Payload    []byte                 `protobuf:"bytes,10,opt,name=payload,proto3" json:"payload,omitempty"`       // the validated payload, for replays
+}
+
+func (x *WebhookDelivery) Reset() {
+	*x = WebhookDelivery{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WebhookDelivery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookDelivery) ProtoMessage() {}
+
+func (x *WebhookDelivery) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookDelivery.ProtoReflect.Descriptor instead.
+func (*WebhookDelivery) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *WebhookDelivery) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetDeliveryID() string {
+	if x != nil {
+		return x.DeliveryID
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetEvent() string {
+	if x != nil {
+		return x.Event
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetRepository() string {
+	if x != nil {
+		return x.Repository
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetStatus() WebhookDelivery_Status {
+	if x != nil {
+		return x.Status
+	}
+	return WebhookDelivery_PROCESSING
+}
+
+func (x *WebhookDelivery) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetDeliveries() uint32 {
+	if x != nil {
+		return x.Deliveries
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetReceived() string {
+	if x != nil {
+		return x.Received
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetProcessed() string {
+	if x != nil {
+		return x.Processed
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+type WebhookDeliveries struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Deliveries []*WebhookDelivery `protobuf:"bytes,1,rep,name=deliveries,proto3" json:"deliveries,omitempty"`
+}
+
+func (x *WebhookDeliveries) Reset() {
+	*x = WebhookDeliveries{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WebhookDeliveries) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookDeliveries) ProtoMessage() {}
+
+func (x *WebhookDeliveries) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookDeliveries.ProtoReflect.Descriptor instead.
+func (*WebhookDeliveries) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *WebhookDeliveries) GetDeliveries() []*WebhookDelivery {
+	if x != nil {
+		return x.Deliveries
+	}
+	return nil
+}
+
+// WebhookDeliveryRequest selects the webhook delivery with the given delivery ID,
+// or when listing deliveries, only the failed deliveries if failed is set.
+type WebhookDeliveryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DeliveryID string `protobuf:"bytes,1,opt,name=deliveryID,proto3" json:"deliveryID,omitempty"`
+	Failed     bool   `protobuf:"varint,2,opt,name=failed,proto3" json:"failed,omitempty"`
+}
+
+func (x *WebhookDeliveryRequest) Reset() {
+	*x = WebhookDeliveryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WebhookDeliveryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookDeliveryRequest) ProtoMessage() {}
+
+func (x *WebhookDeliveryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookDeliveryRequest.ProtoReflect.Descriptor instead.
+func (*WebhookDeliveryRequest) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *WebhookDeliveryRequest) GetDeliveryID() string {
+	if x != nil {
+		return x.DeliveryID
+	}
+	return ""
+}
+
+func (x *WebhookDeliveryRequest) GetFailed() bool {
+	if x != nil {
+		return x.Failed
+	}
+	return false
+}
+
+// AuditEntry records an action performed by a teacher or admin, such as updating an enrollment.
+type AuditEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID        uint64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	CourseID  uint64 `protobuf:"varint,2,opt,name=courseID,proto3" json:"courseID,omitempty" gorm:"index"` // zero for actions outside a course
+	UserID    uint64 `protobuf:"varint,3,opt,name=userID,proto3" json:"userID,omitempty" gorm:"index"`     // user performing the action
+	Login     string `protobuf:"bytes,4,opt,name=login,proto3" json:"login,omitempty"`
+	Action    string `protobuf:"bytes,5,opt,name=action,proto3" json:"action,omitempty" gorm:"index"`
+	Details   string `protobuf:"bytes,6,opt,name=details,proto3" json:"details,omitempty"` // JSON object with details about the action
+	Before    string `protobuf:"bytes,7,opt,name=before,proto3" json:"before,omitempty"`   // JSON encoded record before the action; empty if none
+	After     string `protobuf:"bytes,8,opt,name=after,proto3" json:"after,omitempty"`     // JSON encoded record after the action; empty if none
+	Timestamp string `protobuf:"bytes,9,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (x *AuditEntry) Reset() {
+	*x = AuditEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuditEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditEntry) ProtoMessage() {}
+
+func (x *AuditEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditEntry.ProtoReflect.Descriptor instead.
+func (*AuditEntry) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *AuditEntry) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *AuditEntry) GetCourseID() uint64 {
+	if x != nil {
+		return x.CourseID
+	}
+	return 0
+}
+
+func (x *AuditEntry) GetUserID() uint64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *AuditEntry) GetLogin() string {
+	if x != nil {
+		return x.Login
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetDetails() string {
+	if x != nil {
+		return x.Details
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetBefore() string {
+	if x != nil {
+		return x.Before
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetAfter() string {
+	if x != nil {
+		return x.After
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+// AuditLogRequest selects the audit log entries of the given course, user and action;
+// zero values match all entries. If pageSize is set, at most pageSize entries are
+// returned, starting at the page given by pageToken.
+type AuditLogRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CourseID  uint64 `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	UserID    uint64 `protobuf:"varint,2,opt,name=userID,proto3" json:"userID,omitempty"`
+	Action    string `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`
+	PageSize  uint32 `protobuf:"varint,4,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	PageToken string `protobuf:"bytes,5,opt,name=pageToken,proto3" json:"pageToken,omitempty"`
+}
+
+func (x *AuditLogRequest) Reset() {
+	*x = AuditLogRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuditLogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditLogRequest) ProtoMessage() {}
+
+func (x *AuditLogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditLogRequest.ProtoReflect.Descriptor instead.
+func (*AuditLogRequest) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *AuditLogRequest) GetCourseID() uint64 {
+	if x != nil {
+		return x.CourseID
+	}
+	return 0
+}
+
+func (x *AuditLogRequest) GetUserID() uint64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *AuditLogRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *AuditLogRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *AuditLogRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// AuditLog holds audit log entries, oldest first.
+type AuditLog struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entries       []*AuditEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	NextPageToken string        `protobuf:"bytes,2,opt,name=nextPageToken,proto3" json:"nextPageToken,omitempty"` // token for the next page; empty if this is the last page
+}
+
+func (x *AuditLog) Reset() {
+	*x = AuditLog{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuditLog) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditLog) ProtoMessage() {}
+
+func (x *AuditLog) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditLog.ProtoReflect.Descriptor instead.
+func (*AuditLog) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *AuditLog) GetEntries() []*AuditEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *AuditLog) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// AccessToken is a personal access token allowing scripts and command line tools to call
+// the API on behalf of its user. Only a hash of the token is stored; the token itself
+// is only returned when it is created.
+type AccessToken struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID       uint64               `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	UserID   uint64               `protobuf:"varint,2,opt,name=userID,proto3" json:"userID,omitempty" gorm:"index"`
+	Name     string               `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Hash     string               `protobuf:"bytes,4,opt,name=hash,proto3" json:"hash,omitempty" gorm:"uniqueIndex;size:255"`           // SHA-256 hash of the token; never returned
+	Courses  []*AccessTokenCourse `protobuf:"bytes,5,rep,name=courses,proto3" json:"courses,omitempty" gorm:"foreignKey:AccessTokenID"` // courses the token is valid for
+	Created  string               `protobuf:"bytes,6,opt,name=created,proto3" json:"created,omitempty"`
+	Expires  string               `protobuf:"bytes,7,opt,name=expires,proto3" json:"expires,omitempty"`   // empty if the token does not expire
+	LastUsed string               `protobuf:"bytes,8,opt,name=lastUsed,proto3" json:"lastUsed,omitempty"` // empty if the token has not been used
+}
+
+func (x *AccessToken) Reset() {
+	*x = AccessToken{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccessToken) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccessToken) ProtoMessage() {}
+
+func (x *AccessToken) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccessToken.ProtoReflect.Descriptor instead.
+func (*AccessToken) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *AccessToken) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *AccessToken) GetUserID() uint64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *AccessToken) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AccessToken) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *AccessToken) GetCourses() []*AccessTokenCourse {
+	if x != nil {
+		return x.Courses
+	}
+	return nil
+}
+
+func (x *AccessToken) GetCreated() string {
+	if x != nil {
+		return x.Created
+	}
+	return ""
+}
+
+func (x *AccessToken) GetExpires() string {
+	if x != nil {
+		return x.Expires
+	}
+	return ""
+}
+
+func (x *AccessToken) GetLastUsed() string {
+	if x != nil {
+		return x.LastUsed
+	}
+	return ""
+}
+
+// AccessTokenCourse is a course an access token is valid for.
+type AccessTokenCourse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID            uint64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	AccessTokenID uint64 `protobuf:"varint,2,opt,name=accessTokenID,proto3" json:"accessTokenID,omitempty" gorm:"index"`
+	CourseID      uint64 `protobuf:"varint,3,opt,name=courseID,proto3" json:"courseID,omitempty"`
+}
+
+func (x *AccessTokenCourse) Reset() {
+	*x = AccessTokenCourse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccessTokenCourse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccessTokenCourse) ProtoMessage() {}
+
+func (x *AccessTokenCourse) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccessTokenCourse.ProtoReflect.Descriptor instead.
+func (*AccessTokenCourse) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *AccessTokenCourse) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *AccessTokenCourse) GetAccessTokenID() uint64 {
+	if x != nil {
+		return x.AccessTokenID
+	}
+	return 0
+}
+
+func (x *AccessTokenCourse) GetCourseID() uint64 {
+	if x != nil {
+		return x.CourseID
+	}
+	return 0
+}
+
+// AccessTokenRequest creates an access token valid for the given courses.
+// The token expires after the given number of days; zero means never.
+type AccessTokenRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name          string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	CourseIDs     []uint64 `protobuf:"varint,2,rep,packed,name=courseIDs,proto3" json:"courseIDs,omitempty"`
+	ExpiresInDays uint32   `protobuf:"varint,3,opt,name=expiresInDays,proto3" json:"expiresInDays,omitempty"`
+}
+
+func (x *AccessTokenRequest) Reset() {
+	*x = AccessTokenRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[96]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccessTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccessTokenRequest) ProtoMessage() {}
+
+func (x *AccessTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccessTokenRequest.ProtoReflect.Descriptor instead.
+func (*AccessTokenRequest) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *AccessTokenRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AccessTokenRequest) GetCourseIDs() []uint64 {
+	if x != nil {
+		return x.CourseIDs
+	}
+	return nil
+}
+
+func (x *AccessTokenRequest) GetExpiresInDays() uint32 {
+	if x != nil {
+		return x.ExpiresInDays
+	}
+	return 0
+}
+
+// NewAccessToken holds a newly created access token and its secret token.
+type NewAccessToken struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccessToken *AccessToken `protobuf:"bytes,1,opt,name=accessToken,proto3" json:"accessToken,omitempty"`
+	Token       string       `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+}
+
+func (x *NewAccessToken) Reset() {
+	*x = NewAccessToken{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[97]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NewAccessToken) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NewAccessToken) ProtoMessage() {}
+
+func (x *NewAccessToken) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[97]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NewAccessToken.ProtoReflect.Descriptor instead.
+func (*NewAccessToken) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *NewAccessToken) GetAccessToken() *AccessToken {
+	if x != nil {
+		return x.AccessToken
+	}
+	return nil
+}
+
+func (x *NewAccessToken) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type AccessTokens struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccessTokens []*AccessToken `protobuf:"bytes,1,rep,name=accessTokens,proto3" json:"accessTokens,omitempty"`
+}
+
+func (x *AccessTokens) Reset() {
+	*x = AccessTokens{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[98]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccessTokens) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccessTokens) ProtoMessage() {}
+
+func (x *AccessTokens) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[98]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccessTokens.ProtoReflect.Descriptor instead.
+func (*AccessTokens) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *AccessTokens) GetAccessTokens() []*AccessToken {
+	if x != nil {
+		return x.AccessTokens
+	}
+	return nil
+}
+
+// Session is a signed in browser session of a user. Only a hash of the session cookie is stored.
+type Session struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID        uint64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	UserID    uint64 `protobuf:"varint,2,opt,name=userID,proto3" json:"userID,omitempty" gorm:"index"`
+	Hash      string `protobuf:"bytes,3,opt,name=hash,proto3" json:"hash,omitempty" gorm:"uniqueIndex;size:255"` // SHA-256 hash of the session cookie; never returned
+	UserAgent string `protobuf:"bytes,4,opt,name=userAgent,proto3" json:"userAgent,omitempty"`                   // user agent of the browser signing in
+	IP        string `protobuf:"bytes,5,opt,name=IP,proto3" json:"IP,omitempty"`                                 // address the user signed in from
+	Created   string `protobuf:"bytes,6,opt,name=created,proto3" json:"created,omitempty"`
+	LastSeen  string `protobuf:"bytes,7,opt,name=lastSeen,proto3" json:"lastSeen,omitempty"` // time of the last request in the session, updated at most once a minute
+}
+
+func (x *Session) Reset() {
+	*x = Session{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[99]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Session) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Session) ProtoMessage() {}
+
+func (x *Session) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[99]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Session.ProtoReflect.Descriptor instead.
+func (*Session) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *Session) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *Session) GetUserID() uint64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *Session) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *Session) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+func (x *Session) GetIP() string {
+	if x != nil {
+		return x.IP
+	}
+	return ""
+}
+
+func (x *Session) GetCreated() string {
+	if x != nil {
+		return x.Created
+	}
+	return ""
+}
+
+func (x *Session) GetLastSeen() string {
+	if x != nil {
+		return x.LastSeen
+	}
+	return ""
+}
+
+type Sessions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sessions []*Session `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"`
+}
+
+func (x *Sessions) Reset() {
+	*x = Sessions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[100]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Sessions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Sessions) ProtoMessage() {}
+
+func (x *Sessions) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[100]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Sessions.ProtoReflect.Descriptor instead.
+func (*Sessions) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *Sessions) GetSessions() []*Session {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
+// PendingAction is a destructive action awaiting confirmation by a second admin.
+type PendingAction struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID             uint64                                `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Action         PendingAction_Action                  `protobuf:"varint,2,opt,name=action,proto3,enum=ag.PendingAction_Action" json:"action,omitempty"`
+	CourseID       uint64                                `protobuf:"varint,3,opt,name=courseID,proto3" json:"courseID,omitempty" gorm:"index"`
+	GroupID        uint64                                `protobuf:"varint,4,opt,name=groupID,proto3" json:"groupID,omitempty"`                                                         // group to delete
+	EnrolledUserID uint64                                `protobuf:"varint,5,opt,name=enrolledUserID,proto3" json:"enrolledUserID,omitempty"`                                           // teacher to demote
+	Status         Enrollment_UserStatus                 `protobuf:"varint,6,opt,name=status,proto3,enum=ag.Enrollment_UserStatus" json:"status,omitempty"`                             // new enrollment status of the teacher
+	Repositories   CourseCleanupRequest_RepositoryAction `protobuf:"varint,7,opt,name=repositories,proto3,enum=ag.CourseCleanupRequest_RepositoryAction" json:"repositories,omitempty"` // cleanup of the deleted course's repositories
+	RequestedByID  uint64                                `protobuf:"varint,8,opt,name=requestedByID,proto3" json:"requestedByID,omitempty"`
+	RequestedBy    string                                `protobuf:"bytes,9,opt,name=requestedBy,proto3" json:"requestedBy,omitempty"` // login of the user requesting the action
+	Requested      string                                `protobuf:"bytes,10,opt,name=requested,proto3" json:"requested,omitempty"`
+}
+
+func (x *PendingAction) Reset() {
+	*x = PendingAction{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[101]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PendingAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PendingAction) ProtoMessage() {}
+
+func (x *PendingAction) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[101]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PendingAction.ProtoReflect.Descriptor instead.
+func (*PendingAction) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *PendingAction) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *PendingAction) GetAction() PendingAction_Action {
+	if x != nil {
+		return x.Action
+	}
+	return PendingAction_DELETE_COURSE
+}
+
+func (x *PendingAction) GetCourseID() uint64 {
+	if x != nil {
+		return x.CourseID
+	}
+	return 0
+}
+
+func (x *PendingAction) GetGroupID() uint64 {
+	if x != nil {
+		return x.GroupID
+	}
+	return 0
+}
+
+func (x *PendingAction) GetEnrolledUserID() uint64 {
+	if x != nil {
+		return x.EnrolledUserID
+	}
+	return 0
+}
+
+func (x *PendingAction) GetStatus() Enrollment_UserStatus {
+	if x != nil {
+		return x.Status
+	}
+	return Enrollment_NONE
+}
+
+func (x *PendingAction) GetRepositories() CourseCleanupRequest_RepositoryAction {
+	if x != nil {
+		return x.Repositories
+	}
+	return CourseCleanupRequest_KEEP
+}
+
+func (x *PendingAction) GetRequestedByID() uint64 {
+	if x != nil {
+		return x.RequestedByID
+	}
+	return 0
+}
+
+func (x *PendingAction) GetRequestedBy() string {
+	if x != nil {
+		return x.RequestedBy
+	}
+	return ""
+}
+
+func (x *PendingAction) GetRequested() string {
+	if x != nil {
+		return x.Requested
+	}
+	return ""
+}
+
+type PendingActions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Actions []*PendingAction `protobuf:"bytes,1,rep,name=actions,proto3" json:"actions,omitempty"`
+}
+
+func (x *PendingActions) Reset() {
+	*x = PendingActions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[102]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PendingActions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PendingActions) ProtoMessage() {}
+
+func (x *PendingActions) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[102]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PendingActions.ProtoReflect.Descriptor instead.
+func (*PendingActions) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *PendingActions) GetActions() []*PendingAction {
+	if x != nil {
+		return x.Actions
+	}
+	return nil
+}
+
+// PlagiarismResult is the similarity of the code of two submissions for an assignment.
+type PlagiarismResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID                uint64  `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	CourseID          uint64  `protobuf:"varint,2,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	AssignmentID      uint64  `protobuf:"varint,3,opt,name=assignmentID,proto3" json:"assignmentID,omitempty"`
+	SubmissionID      uint64  `protobuf:"varint,4,opt,name=submissionID,proto3" json:"submissionID,omitempty"`           // the first submission of the compared pair
+	OtherSubmissionID uint64  `protobuf:"varint,5,opt,name=otherSubmissionID,proto3" json:"otherSubmissionID,omitempty"` // the second submission of the compared pair
+	Similarity        float32 `protobuf:"fixed32,6,opt,name=similarity,proto3" json:"similarity,omitempty"`              // the percentage of similar code
+	URL               string  `protobuf:"bytes,7,opt,name=URL,proto3" json:"URL,omitempty"`                              // the plagiarism checker's comparison of the submissions, if available
+	Tool              string  `protobuf:"bytes,8,opt,name=tool,proto3" json:"tool,omitempty"`                            // the plagiarism checker, e.g., moss or jplag
+	Checked           string  `protobuf:"bytes,9,opt,name=checked,proto3" json:"checked,omitempty"`
+}
+
+func (x *PlagiarismResult) Reset() {
+	*x = PlagiarismResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[103]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlagiarismResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlagiarismResult) ProtoMessage() {}
+
+func (x *PlagiarismResult) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[103]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlagiarismResult.ProtoReflect.Descriptor instead.
+func (*PlagiarismResult) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *PlagiarismResult) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *PlagiarismResult) GetCourseID() uint64 {
+	if x != nil {
+		return x.CourseID
+	}
+	return 0
+}
+
+func (x *PlagiarismResult) GetAssignmentID() uint64 {
+	if x != nil {
+		return x.AssignmentID
+	}
+	return 0
+}
+
+func (x *PlagiarismResult) GetSubmissionID() uint64 {
+	if x != nil {
+		return x.SubmissionID
+	}
+	return 0
+}
+
+func (x *PlagiarismResult) GetOtherSubmissionID() uint64 {
+	if x != nil {
+		return x.OtherSubmissionID
+	}
+	return 0
+}
+
+func (x *PlagiarismResult) GetSimilarity() float32 {
+	if x != nil {
+		return x.Similarity
+	}
+	return 0
+}
+
+func (x *PlagiarismResult) GetURL() string {
+	if x != nil {
+		return x.URL
+	}
+	return ""
+}
+
+func (x *PlagiarismResult) GetTool() string {
+	if x != nil {
+		return x.Tool
+	}
+	return ""
+}
+
+func (x *PlagiarismResult) GetChecked() string {
+	if x != nil {
+		return x.Checked
+	}
+	return ""
+}
+
+type PlagiarismResults struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Results []*PlagiarismResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *PlagiarismResults) Reset() {
+	*x = PlagiarismResults{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[104]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlagiarismResults) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlagiarismResults) ProtoMessage() {}
+
+func (x *PlagiarismResults) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[104]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlagiarismResults.ProtoReflect.Descriptor instead.
+func (*PlagiarismResults) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *PlagiarismResults) GetResults() []*PlagiarismResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type PlagiarismRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CourseID     uint64 `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	AssignmentID uint64 `protobuf:"varint,2,opt,name=assignmentID,proto3" json:"assignmentID,omitempty"`
+	Tool         string `protobuf:"bytes,3,opt,name=tool,proto3" json:"tool,omitempty"`         // the plagiarism checker to run, e.g., moss or jplag
+	Language     string `protobuf:"bytes,4,opt,name=language,proto3" json:"language,omitempty"` // the programming language of the submissions; empty for go
+}
+
+func (x *PlagiarismRequest) Reset() {
+	*x = PlagiarismRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[105]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlagiarismRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlagiarismRequest) ProtoMessage() {}
+
+func (x *PlagiarismRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[105]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlagiarismRequest.ProtoReflect.Descriptor instead.
+func (*PlagiarismRequest) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *PlagiarismRequest) GetCourseID() uint64 {
+	if x != nil {
+		return x.CourseID
+	}
+	return 0
+}
+
+func (x *PlagiarismRequest) GetAssignmentID() uint64 {
+	if x != nil {
+		return x.AssignmentID
+	}
+	return 0
+}
+
+func (x *PlagiarismRequest) GetTool() string {
+	if x != nil {
+		return x.Tool
+	}
+	return ""
+}
+
+func (x *PlagiarismRequest) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+type GradingBenchmark struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID           uint64              `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	AssignmentID uint64              `protobuf:"varint,2,opt,name=AssignmentID,proto3" json:"AssignmentID,omitempty"` // foreign key
+	ReviewID     uint64              `protobuf:"varint,3,opt,name=ReviewID,proto3" json:"ReviewID,omitempty"`         // foreign key
+	Heading      string              `protobuf:"bytes,4,opt,name=heading,proto3" json:"heading,omitempty"`
+	Comment      string              `protobuf:"bytes,5,opt,name=comment,proto3" json:"comment,omitempty"`
+	Criteria     []*GradingCriterion `protobuf:"bytes,6,rep,name=criteria,proto3" json:"criteria,omitempty" gorm:"foreignKey:BenchmarkID"`
+	Weight       uint32              `protobuf:"varint,7,opt,name=weight,proto3" json:"weight,omitempty"` // relative weight of the benchmark in the review score
+}
+
+func (x *GradingBenchmark) Reset() {
+	*x = GradingBenchmark{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[106]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GradingBenchmark) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GradingBenchmark) ProtoMessage() {}
+
+func (x *GradingBenchmark) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[106]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GradingBenchmark.ProtoReflect.Descriptor instead.
+func (*GradingBenchmark) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *GradingBenchmark) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *GradingBenchmark) GetAssignmentID() uint64 {
+	if x != nil {
+		return x.AssignmentID
+	}
+	return 0
+}
+
+func (x *GradingBenchmark) GetReviewID() uint64 {
+	if x != nil {
+		return x.ReviewID
+	}
+	return 0
+}
+
+func (x *GradingBenchmark) GetHeading() string {
+	if x != nil {
+		return x.Heading
+	}
+	return ""
+}
+
+func (x *GradingBenchmark) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *GradingBenchmark) GetCriteria() []*GradingCriterion {
+	if x != nil {
+		return x.Criteria
+	}
+	return nil
+}
+
+func (x *GradingBenchmark) GetWeight() uint32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+type Benchmarks struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Benchmarks []*GradingBenchmark `protobuf:"bytes,1,rep,name=benchmarks,proto3" json:"benchmarks,omitempty"`
+}
+
+func (x *Benchmarks) Reset() {
+	*x = Benchmarks{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[107]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Benchmarks) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Benchmarks) ProtoMessage() {}
+
+func (x *Benchmarks) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[107]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Benchmarks.ProtoReflect.Descriptor instead.
+func (*Benchmarks) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *Benchmarks) GetBenchmarks() []*GradingBenchmark {
+	if x != nil {
+		return x.Benchmarks
+	}
+	return nil
+}
+
+type GradingCriterion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID          uint64                 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	BenchmarkID uint64                 `protobuf:"varint,2,opt,name=BenchmarkID,proto3" json:"BenchmarkID,omitempty"` // foreign key
+	Points      uint64                 `protobuf:"varint,3,opt,name=points,proto3" json:"points,omitempty"`
+	Description string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Grade       GradingCriterion_Grade `protobuf:"varint,5,opt,name=grade,proto3,enum=ag.GradingCriterion_Grade" json:"grade,omitempty"`
+	Comment     string                 `protobuf:"bytes,6,opt,name=comment,proto3" json:"comment,omitempty"`
+	Weight      uint32                 `protobuf:"varint,7,opt,name=weight,proto3" json:"weight,omitempty"` // relative weight of the criterion in the review score
+}
+
+func (x *GradingCriterion) Reset() {
+	*x = GradingCriterion{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[108]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GradingCriterion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GradingCriterion) ProtoMessage() {}
+
+func (x *GradingCriterion) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[108]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GradingCriterion.ProtoReflect.Descriptor instead.
+func (*GradingCriterion) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *GradingCriterion) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *GradingCriterion) GetBenchmarkID() uint64 {
+	if x != nil {
+		return x.BenchmarkID
+	}
+	return 0
+}
+
+func (x *GradingCriterion) GetPoints() uint64 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+func (x *GradingCriterion) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *GradingCriterion) GetGrade() GradingCriterion_Grade {
+	if x != nil {
+		return x.Grade
+	}
+	return GradingCriterion_NONE
+}
+
+func (x *GradingCriterion) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *GradingCriterion) GetWeight() uint32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+type Review struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID                uint64              `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	SubmissionID      uint64              `protobuf:"varint,2,opt,name=SubmissionID,proto3" json:"SubmissionID,omitempty"` // foreign key
+	ReviewerID        uint64              `protobuf:"varint,3,opt,name=ReviewerID,proto3" json:"ReviewerID,omitempty"`     // UserID of the reviewer
+	Feedback          string              `protobuf:"bytes,4,opt,name=feedback,proto3" json:"feedback,omitempty"`
+	Ready             bool                `protobuf:"varint,5,opt,name=ready,proto3" json:"ready,omitempty"`
+	Score             uint32              `protobuf:"varint,6,opt,name=score,proto3" json:"score,omitempty"`
+	GradingBenchmarks []*GradingBenchmark `protobuf:"bytes,7,rep,name=gradingBenchmarks,proto3" json:"gradingBenchmarks,omitempty" gorm:"foreignKey:ReviewID"`
+	Edited            string              `protobuf:"bytes,8,opt,name=edited,proto3" json:"edited,omitempty"`
+	Released          bool                `protobuf:"varint,9,opt,name=released,proto3" json:"released,omitempty"` // true => the review is visible to the submission's authors; set by ReleaseReviews
+}
+
+func (x *Review) Reset() {
+	*x = Review{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[109]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Review) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Review) ProtoMessage() {}
+
+func (x *Review) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[109]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Review.ProtoReflect.Descriptor instead.
+func (*Review) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *Review) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *Review) GetSubmissionID() uint64 {
+	if x != nil {
+		return x.SubmissionID
+	}
+	return 0
+}
+
+func (x *Review) GetReviewerID() uint64 {
+	if x != nil {
+		return x.ReviewerID
+	}
+	return 0
+}
+
+func (x *Review) GetFeedback() string {
+	if x != nil {
+		return x.Feedback
+	}
+	return ""
+}
+
+func (x *Review) GetReady() bool {
+	if x != nil {
+		return x.Ready
+	}
+	return false
+}
+
+func (x *Review) GetScore() uint32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *Review) GetGradingBenchmarks() []*GradingBenchmark {
+	if x != nil {
+		return x.GradingBenchmarks
+	}
+	return nil
+}
+
+func (x *Review) GetEdited() string {
+	if x != nil {
+		return x.Edited
+	}
+	return ""
+}
+
+func (x *Review) GetReleased() bool {
+	if x != nil {
+		return x.Released
+	}
+	return false
+}
+
+type Reviewers struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reviewers []*User `protobuf:"bytes,1,rep,name=reviewers,proto3" json:"reviewers,omitempty"`
+}
+
+func (x *Reviewers) Reset() {
+	*x = Reviewers{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[110]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Reviewers) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Reviewers) ProtoMessage() {}
+
+func (x *Reviewers) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[110]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Reviewers.ProtoReflect.Descriptor instead.
+func (*Reviewers) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *Reviewers) GetReviewers() []*User {
+	if x != nil {
+		return x.Reviewers
+	}
+	return nil
+}
+
+// PeerReview is a student's anonymous review of another student's or group's submission.
+// Reviewers are not told who the submission's authors are, and authors are not told who the reviewer is.
+type PeerReview struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID           uint64      `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	CourseID     uint64      `protobuf:"varint,2,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	AssignmentID uint64      `protobuf:"varint,3,opt,name=assignmentID,proto3" json:"assignmentID,omitempty"`
+	SubmissionID uint64      `protobuf:"varint,4,opt,name=submissionID,proto3" json:"submissionID,omitempty" gorm:"uniqueIndex:idx_unique_peer_review"`
+	ReviewerID   uint64      `protobuf:"varint,5,opt,name=reviewerID,proto3" json:"reviewerID,omitempty" gorm:"uniqueIndex:idx_unique_peer_review"` // the student reviewing the submission
+	Score        uint32      `protobuf:"varint,6,opt,name=score,proto3" json:"score,omitempty"`
+	Feedback     string      `protobuf:"bytes,7,opt,name=feedback,proto3" json:"feedback,omitempty"`
+	Submitted    bool        `protobuf:"varint,8,opt,name=submitted,proto3" json:"submitted,omitempty"`            // true => the reviewer has completed the review
+	Released     bool        `protobuf:"varint,9,opt,name=released,proto3" json:"released,omitempty"`              // true => the review is visible to the submission's authors
+	ModeratorID  uint64      `protobuf:"varint,10,opt,name=moderatorID,proto3" json:"moderatorID,omitempty"`       // the teacher who last overrode the review's score or feedback; 0 if not moderated
+	Submission   *Submission `protobuf:"bytes,11,opt,name=submission,proto3" json:"submission,omitempty" gorm:"-"` // the anonymized submission; only given to the reviewer
+}
+
+func (x *PeerReview) Reset() {
+	*x = PeerReview{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[111]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PeerReview) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeerReview) ProtoMessage() {}
+
+func (x *PeerReview) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[111]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeerReview.ProtoReflect.Descriptor instead.
+func (*PeerReview) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *PeerReview) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *PeerReview) GetCourseID() uint64 {
+	if x != nil {
+		return x.CourseID
+	}
+	return 0
+}
+
+func (x *PeerReview) GetAssignmentID() uint64 {
+	if x != nil {
+		return x.AssignmentID
+	}
+	return 0
+}
+
+func (x *PeerReview) GetSubmissionID() uint64 {
+	if x != nil {
+		return x.SubmissionID
+	}
+	return 0
+}
+
+func (x *PeerReview) GetReviewerID() uint64 {
+	if x != nil {
+		return x.ReviewerID
+	}
+	return 0
+}
+
+func (x *PeerReview) GetScore() uint32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *PeerReview) GetFeedback() string {
+	if x != nil {
+		return x.Feedback
+	}
+	return ""
+}
+
+func (x *PeerReview) GetSubmitted() bool {
+	if x != nil {
+		return x.Submitted
+	}
+	return false
+}
+
+func (x *PeerReview) GetReleased() bool {
+	if x != nil {
+		return x.Released
+	}
+	return false
+}
+
+func (x *PeerReview) GetModeratorID() uint64 {
+	if x != nil {
+		return x.ModeratorID
+	}
+	return 0
+}
+
+func (x *PeerReview) GetSubmission() *Submission {
+	if x != nil {
+		return x.Submission
+	}
+	return nil
+}
+
+type PeerReviews struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PeerReviews []*PeerReview `protobuf:"bytes,1,rep,name=peerReviews,proto3" json:"peerReviews,omitempty"`
+}
+
+func (x *PeerReviews) Reset() {
+	*x = PeerReviews{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[112]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PeerReviews) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeerReviews) ProtoMessage() {}
+
+func (x *PeerReviews) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[112]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeerReviews.ProtoReflect.Descriptor instead.
+func (*PeerReviews) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *PeerReviews) GetPeerReviews() []*PeerReview {
+	if x != nil {
+		return x.PeerReviews
+	}
+	return nil
+}
+
+// SubmissionComment is a feedback comment anchored to a line of a file in the commit graded by a submission.
+type SubmissionComment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID               uint64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	CourseID         uint64 `protobuf:"varint,2,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	SubmissionID     uint64 `protobuf:"varint,3,opt,name=submissionID,proto3" json:"submissionID,omitempty" gorm:"index"`
+	AuthorID         uint64 `protobuf:"varint,4,opt,name=authorID,proto3" json:"authorID,omitempty"`    // the teacher, teaching assistant or peer reviewer writing the comment; 0 if hidden from the reader
+	CommitHash       string `protobuf:"bytes,5,opt,name=commitHash,proto3" json:"commitHash,omitempty"` // the graded commit the comment is anchored to
+	Path             string `protobuf:"bytes,6,opt,name=path,proto3" json:"path,omitempty"`             // path of the file, relative to the repository root
+	Line             uint32 `protobuf:"varint,7,opt,name=line,proto3" json:"line,omitempty"`            // line number in the file; 0 => the comment is on the whole file
+	Body             string `protobuf:"bytes,8,opt,name=body,proto3" json:"body,omitempty"`
+	Created          string `protobuf:"bytes,9,opt,name=created,proto3" json:"created,omitempty"`
+	Updated          string `protobuf:"bytes,10,opt,name=updated,proto3" json:"updated,omitempty"`
+	Peer             bool   `protobuf:"varint,11,opt,name=peer,proto3" json:"peer,omitempty"`                        // true => the comment is written by an anonymous peer reviewer
+	Mirror           bool   `protobuf:"varint,12,opt,name=mirror,proto3" json:"mirror,omitempty" gorm:"-"`           // true => the new comment is mirrored to the SCM as a commit comment
+	CommitCommentURL string `protobuf:"bytes,13,opt,name=commitCommentURL,proto3" json:"commitCommentURL,omitempty"` // URL of the comment mirrored to the SCM; empty if not mirrored
+}
+
+func (x *SubmissionComment) Reset() {
+	*x = SubmissionComment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[113]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubmissionComment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmissionComment) ProtoMessage() {}
+
+func (x *SubmissionComment) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[113]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmissionComment.ProtoReflect.Descriptor instead.
+func (*SubmissionComment) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *SubmissionComment) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *SubmissionComment) GetCourseID() uint64 {
+	if x != nil {
+		return x.CourseID
+	}
+	return 0
+}
+
+func (x *SubmissionComment) GetSubmissionID() uint64 {
+	if x != nil {
+		return x.SubmissionID
+	}
+	return 0
+}
+
+func (x *SubmissionComment) GetAuthorID() uint64 {
+	if x != nil {
+		return x.AuthorID
+	}
+	return 0
+}
+
+func (x *SubmissionComment) GetCommitHash() string {
+	if x != nil {
+		return x.CommitHash
+	}
+	return ""
+}
+
+func (x *SubmissionComment) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *SubmissionComment) GetLine() uint32 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *SubmissionComment) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *SubmissionComment) GetCreated() string {
+	if x != nil {
+		return x.Created
+	}
+	return ""
+}
+
+func (x *SubmissionComment) GetUpdated() string {
+	if x != nil {
+		return x.Updated
+	}
+	return ""
+}
+
+func (x *SubmissionComment) GetPeer() bool {
+	if x != nil {
+		return x.Peer
+	}
+	return false
+}
+
+func (x *SubmissionComment) GetMirror() bool {
+	if x != nil {
+		return x.Mirror
+	}
+	return false
+}
+
+func (x *SubmissionComment) GetCommitCommentURL() string {
+	if x != nil {
+		return x.CommitCommentURL
+	}
+	return ""
+}
+
+type SubmissionComments struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Comments []*SubmissionComment `protobuf:"bytes,1,rep,name=comments,proto3" json:"comments,omitempty"`
+}
+
+func (x *SubmissionComments) Reset() {
+	*x = SubmissionComments{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[114]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubmissionComments) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmissionComments) ProtoMessage() {}
+
+func (x *SubmissionComments) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[114]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmissionComments.ProtoReflect.Descriptor instead.
+func (*SubmissionComments) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *SubmissionComments) GetComments() []*SubmissionComment {
+	if x != nil {
+		return x.Comments
+	}
+	return nil
+}
+
+type SubmissionCommentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CourseID     uint64 `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	SubmissionID uint64 `protobuf:"varint,2,opt,name=submissionID,proto3" json:"submissionID,omitempty"`
+}
+
+func (x *SubmissionCommentRequest) Reset() {
+	*x = SubmissionCommentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[115]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubmissionCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmissionCommentRequest) ProtoMessage() {}
+
+func (x *SubmissionCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[115]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmissionCommentRequest.ProtoReflect.Descriptor instead.
+func (*SubmissionCommentRequest) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *SubmissionCommentRequest) GetCourseID() uint64 {
+	if x != nil {
+		return x.CourseID
+	}
+	return 0
+}
+
+func (x *SubmissionCommentRequest) GetSubmissionID() uint64 {
+	if x != nil {
+		return x.SubmissionID
+	}
+	return 0
+}
+
+type Announcement struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID       uint64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	CourseID uint64 `protobuf:"varint,2,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	AuthorID uint64 `protobuf:"varint,3,opt,name=authorID,proto3" json:"authorID,omitempty"` // UserID of the teacher who wrote the announcement
+	Title    string `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	Body     string `protobuf:"bytes,5,opt,name=body,proto3" json:"body,omitempty"`
+	Created  string `protobuf:"bytes,6,opt,name=created,proto3" json:"created,omitempty"`
+	Updated  string `protobuf:"bytes,7,opt,name=updated,proto3" json:"updated,omitempty"`
+	Read     bool   `protobuf:"varint,8,opt,name=read,proto3" json:"read,omitempty" gorm:"-"` // true if the current user has read the announcement
+}
+
+func (x *Announcement) Reset() {
+	*x = Announcement{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[116]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Announcement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Announcement) ProtoMessage() {}
+
+func (x *Announcement) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[116]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Announcement.ProtoReflect.Descriptor instead.
+func (*Announcement) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *Announcement) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *Announcement) GetCourseID() uint64 {
+	if x != nil {
+		return x.CourseID
+	}
+	return 0
+}
+
+func (x *Announcement) GetAuthorID() uint64 {
+	if x != nil {
+		return x.AuthorID
+	}
+	return 0
+}
+
+func (x *Announcement) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Announcement) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *Announcement) GetCreated() string {
+	if x != nil {
+		return x.Created
+	}
+	return ""
+}
+
+func (x *Announcement) GetUpdated() string {
+	if x != nil {
+		return x.Updated
+	}
+	return ""
+}
+
+func (x *Announcement) GetRead() bool {
+	if x != nil {
+		return x.Read
+	}
+	return false
+}
+
+type Announcements struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Announcements []*Announcement `protobuf:"bytes,1,rep,name=announcements,proto3" json:"announcements,omitempty"`
+}
+
+func (x *Announcements) Reset() {
+	*x = Announcements{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[117]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Announcements) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Announcements) ProtoMessage() {}
+
+func (x *Announcements) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[117]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Announcements.ProtoReflect.Descriptor instead.
+func (*Announcements) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *Announcements) GetAnnouncements() []*Announcement {
+	if x != nil {
+		return x.Announcements
+	}
+	return nil
+}
+
+// AnnouncementRead records that a user has read an announcement.
+type AnnouncementRead struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID             uint64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	AnnouncementID uint64 `protobuf:"varint,2,opt,name=announcementID,proto3" json:"announcementID,omitempty" gorm:"uniqueIndex:idx_unique_announcement_read"`
+	UserID         uint64 `protobuf:"varint,3,opt,name=userID,proto3" json:"userID,omitempty" gorm:"uniqueIndex:idx_unique_announcement_read"`
+	ReadAt         string `protobuf:"bytes,4,opt,name=readAt,proto3" json:"readAt,omitempty"`
+}
+
+func (x *AnnouncementRead) Reset() {
+	*x = AnnouncementRead{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[118]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AnnouncementRead) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnnouncementRead) ProtoMessage() {}
+
+func (x *AnnouncementRead) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[118]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnnouncementRead.ProtoReflect.Descriptor instead.
+func (*AnnouncementRead) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *AnnouncementRead) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *AnnouncementRead) GetAnnouncementID() uint64 {
+	if x != nil {
+		return x.AnnouncementID
+	}
+	return 0
+}
+
+func (x *AnnouncementRead) GetUserID() uint64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *AnnouncementRead) GetReadAt() string {
+	if x != nil {
+		return x.ReadAt
+	}
+	return ""
+}
+
+// CourseEvent records a change to a course that is of interest to course participants.
+type CourseEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID           uint64           `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	CourseID     uint64           `protobuf:"varint,2,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	AssignmentID uint64           `protobuf:"varint,3,opt,name=assignmentID,proto3" json:"assignmentID,omitempty"`
+	Type         CourseEvent_Type `protobuf:"varint,4,opt,name=type,proto3,enum=ag.CourseEvent_Type" json:"type,omitempty"`
+	Title        string           `protobuf:"bytes,5,opt,name=title,proto3" json:"title,omitempty"`
+	Description  string           `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	Created      string           `protobuf:"bytes,7,opt,name=created,proto3" json:"created,omitempty"`
+}
+
+func (x *CourseEvent) Reset() {
+	*x = CourseEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[119]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CourseEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CourseEvent) ProtoMessage() {}
+
+func (x *CourseEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[119]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CourseEvent.ProtoReflect.Descriptor instead.
+func (*CourseEvent) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *CourseEvent) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *CourseEvent) GetCourseID() uint64 {
+	if x != nil {
+		return x.CourseID
+	}
+	return 0
+}
+
+func (x *CourseEvent) GetAssignmentID() uint64 {
+	if x != nil {
+		return x.AssignmentID
+	}
+	return 0
+}
+
+func (x *CourseEvent) GetType() CourseEvent_Type {
+	if x != nil {
+		return x.Type
+	}
+	return CourseEvent_NONE
+}
+
+func (x *CourseEvent) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CourseEvent) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CourseEvent) GetCreated() string {
+	if x != nil {
+		return x.Created
+	}
+	return ""
+}
+
+// Notification is an in-app notification to a user about a course event,
+// such as an announcement, a group approval or an approaching deadline.
+type Notification struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID       uint64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	UserID   uint64 `protobuf:"varint,2,opt,name=userID,proto3" json:"userID,omitempty" gorm:"index"`
+	CourseID uint64 `protobuf:"varint,3,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	Subject  string `protobuf:"bytes,4,opt,name=subject,proto3" json:"subject,omitempty"`
+	Body     string `protobuf:"bytes,5,opt,name=body,proto3" json:"body,omitempty"`
+	Created  string `protobuf:"bytes,6,opt,name=created,proto3" json:"created,omitempty"`
+	Read     bool   `protobuf:"varint,7,opt,name=read,proto3" json:"read,omitempty"`
+}
+
+func (x *Notification) Reset() {
+	*x = Notification{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[120]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Notification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Notification) ProtoMessage() {}
+
+func (x *Notification) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[120]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Notification.ProtoReflect.Descriptor instead.
+func (*Notification) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *Notification) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *Notification) GetUserID() uint64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *Notification) GetCourseID() uint64 {
+	if x != nil {
+		return x.CourseID
+	}
+	return 0
+}
+
+func (x *Notification) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *Notification) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *Notification) GetCreated() string {
+	if x != nil {
+		return x.Created
+	}
+	return ""
+}
+
+func (x *Notification) GetRead() bool {
+	if x != nil {
+		return x.Read
+	}
+	return false
+}
+
+type Notifications struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Notifications []*Notification `protobuf:"bytes,1,rep,name=notifications,proto3" json:"notifications,omitempty"`
+}
+
+func (x *Notifications) Reset() {
+	*x = Notifications{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[121]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Notifications) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Notifications) ProtoMessage() {}
+
+func (x *Notifications) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[121]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Notifications.ProtoReflect.Descriptor instead.
+func (*Notifications) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *Notifications) GetNotifications() []*Notification {
+	if x != nil {
+		return x.Notifications
+	}
+	return nil
+}
+
+// NotificationsRequest is a request for the current user's most recent notifications.
+type NotificationsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UnreadOnly bool `protobuf:"varint,1,opt,name=unreadOnly,proto3" json:"unreadOnly,omitempty"`
+}
+
+func (x *NotificationsRequest) Reset() {
+	*x = NotificationsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[122]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NotificationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationsRequest) ProtoMessage() {}
+
+func (x *NotificationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[122]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationsRequest.ProtoReflect.Descriptor instead.
+func (*NotificationsRequest) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *NotificationsRequest) GetUnreadOnly() bool {
+	if x != nil {
+		return x.UnreadOnly
+	}
+	return false
+}
+
+// NotificationRequest identifies a notification of the current user;
+// a zero notificationID identifies all the user's notifications.
+type NotificationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NotificationID uint64 `protobuf:"varint,1,opt,name=notificationID,proto3" json:"notificationID,omitempty"`
+}
+
+func (x *NotificationRequest) Reset() {
+	*x = NotificationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[123]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NotificationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationRequest) ProtoMessage() {}
+
+func (x *NotificationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[123]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationRequest.ProtoReflect.Descriptor instead.
+func (*NotificationRequest) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *NotificationRequest) GetNotificationID() uint64 {
+	if x != nil {
+		return x.NotificationID
+	}
+	return 0
+}
+
+// Question is a question about an assignment, asked by a course participant.
+type Question struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID               uint64    `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	AssignmentID     uint64    `protobuf:"varint,2,opt,name=AssignmentID,proto3" json:"AssignmentID,omitempty"` // foreign key
+	AuthorID         uint64    `protobuf:"varint,3,opt,name=authorID,proto3" json:"authorID,omitempty"`         // UserID of the user asking the question
+	Title            string    `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	Body             string    `protobuf:"bytes,5,opt,name=body,proto3" json:"body,omitempty"`
+	AcceptedAnswerID uint64    `protobuf:"varint,6,opt,name=acceptedAnswerID,proto3" json:"acceptedAnswerID,omitempty"` // ID of the answer accepted by the author or course staff
+	Created          string    `protobuf:"bytes,7,opt,name=created,proto3" json:"created,omitempty"`
+	Updated          string    `protobuf:"bytes,8,opt,name=updated,proto3" json:"updated,omitempty"`
+	Answers          []*Answer `protobuf:"bytes,9,rep,name=answers,proto3" json:"answers,omitempty"`
+}
+
+func (x *Question) Reset() {
+	*x = Question{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[124]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Question) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Question) ProtoMessage() {}
+
+func (x *Question) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[124]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Question.ProtoReflect.Descriptor instead.
+func (*Question) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *Question) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *Question) GetAssignmentID() uint64 {
+	if x != nil {
+		return x.AssignmentID
+	}
+	return 0
+}
+
+func (x *Question) GetAuthorID() uint64 {
+	if x != nil {
+		return x.AuthorID
+	}
+	return 0
+}
+
+func (x *Question) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Question) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *Question) GetAcceptedAnswerID() uint64 {
+	if x != nil {
+		return x.AcceptedAnswerID
+	}
+	return 0
+}
+
+func (x *Question) GetCreated() string {
+	if x != nil {
+		return x.Created
+	}
+	return ""
+}
+
+func (x *Question) GetUpdated() string {
+	if x != nil {
+		return x.Updated
+	}
+	return ""
+}
+
+func (x *Question) GetAnswers() []*Answer {
+	if x != nil {
+		return x.Answers
+	}
+	return nil
+}
+
+type Questions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Questions []*Question `protobuf:"bytes,1,rep,name=questions,proto3" json:"questions,omitempty"`
+}
+
+func (x *Questions) Reset() {
+	*x = Questions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[125]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Questions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Questions) ProtoMessage() {}
+
+func (x *Questions) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[125]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Questions.ProtoReflect.Descriptor instead.
+func (*Questions) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *Questions) GetQuestions() []*Question {
+	if x != nil {
+		return x.Questions
+	}
+	return nil
+}
+
+type Answer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID         uint64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	QuestionID uint64 `protobuf:"varint,2,opt,name=QuestionID,proto3" json:"QuestionID,omitempty"` // foreign key
+	AuthorID   uint64 `protobuf:"varint,3,opt,name=authorID,proto3" json:"authorID,omitempty"`     // UserID of the user answering the question
+	Body       string `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`
+	Endorsed   bool   `protobuf:"varint,5,opt,name=endorsed,proto3" json:"endorsed,omitempty"` // true => the answer is endorsed by course staff
+	Created    string `protobuf:"bytes,6,opt,name=created,proto3" json:"created,omitempty"`
+	Updated    string `protobuf:"bytes,7,opt,name=updated,proto3" json:"updated,omitempty"`
+}
+
+func (x *Answer) Reset() {
+	*x = Answer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[126]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Answer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Answer) ProtoMessage() {}
+
+func (x *Answer) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[126]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Answer.ProtoReflect.Descriptor instead.
+func (*Answer) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *Answer) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *Answer) GetQuestionID() uint64 {
+	if x != nil {
+		return x.QuestionID
+	}
+	return 0
+}
+
+func (x *Answer) GetAuthorID() uint64 {
+	if x != nil {
+		return x.AuthorID
+	}
+	return 0
+}
+
+func (x *Answer) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *Answer) GetEndorsed() bool {
+	if x != nil {
+		return x.Endorsed
+	}
+	return false
+}
+
+func (x *Answer) GetCreated() string {
+	if x != nil {
+		return x.Created
+	}
+	return ""
+}
+
+func (x *Answer) GetUpdated() string {
+	if x != nil {
+		return x.Updated
+	}
+	return ""
+}
+
+// Discussion is a discussion thread about an assignment, hosted by the course's SCM provider.
+// Discussions are not stored in the database.
+type Discussion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Title    string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	URL      string `protobuf:"bytes,2,opt,name=URL,proto3" json:"URL,omitempty"`
+	Author   string `protobuf:"bytes,3,opt,name=author,proto3" json:"author,omitempty"` // login name of the discussion's author
+	Created  string `protobuf:"bytes,4,opt,name=created,proto3" json:"created,omitempty"`
+	Comments uint32 `protobuf:"varint,5,opt,name=comments,proto3" json:"comments,omitempty"`
+	Answered bool   `protobuf:"varint,6,opt,name=answered,proto3" json:"answered,omitempty"` // true => the discussion has an accepted answer
+}
+
+func (x *Discussion) Reset() {
+	*x = Discussion{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[127]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Discussion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Discussion) ProtoMessage() {}
+
+func (x *Discussion) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[127]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Discussion.ProtoReflect.Descriptor instead.
+func (*Discussion) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *Discussion) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Discussion) GetURL() string {
+	if x != nil {
+		return x.URL
+	}
+	return ""
+}
+
+func (x *Discussion) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *Discussion) GetCreated() string {
+	if x != nil {
+		return x.Created
+	}
+	return ""
+}
+
+func (x *Discussion) GetComments() uint32 {
+	if x != nil {
+		return x.Comments
+	}
+	return 0
+}
+
+func (x *Discussion) GetAnswered() bool {
+	if x != nil {
+		return x.Answered
+	}
+	return false
+}
+
+type Discussions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Discussions []*Discussion `protobuf:"bytes,1,rep,name=discussions,proto3" json:"discussions,omitempty"`
+}
+
+func (x *Discussions) Reset() {
+	*x = Discussions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[128]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Discussions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Discussions) ProtoMessage() {}
+
+func (x *Discussions) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[128]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Discussions.ProtoReflect.Descriptor instead.
+func (*Discussions) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *Discussions) GetDiscussions() []*Discussion {
+	if x != nil {
+		return x.Discussions
+	}
+	return nil
+}
+
+type ReviewRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CourseID uint64  `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	Review   *Review `protobuf:"bytes,2,opt,name=review,proto3" json:"review,omitempty"`
+}
+
+func (x *ReviewRequest) Reset() {
+	*x = ReviewRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[129]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewRequest) ProtoMessage() {}
+
+func (x *ReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[129]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewRequest.ProtoReflect.Descriptor instead.
+func (*ReviewRequest) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *ReviewRequest) GetCourseID() uint64 {
+	if x != nil {
+		return x.CourseID
+	}
+	return 0
+}
+
+func (x *ReviewRequest) GetReview() *Review {
+	if x != nil {
+		return x.Review
+	}
+	return nil
+}
+
+type CourseRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CourseID uint64 `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
+}
+
+func (x *CourseRequest) Reset() {
+	*x = CourseRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[130]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CourseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CourseRequest) ProtoMessage() {}
+
+func (x *CourseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[130]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CourseRequest.ProtoReflect.Descriptor instead.
+func (*CourseRequest) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{130}
+}
+
+func (x *CourseRequest) GetCourseID() uint64 {
+	if x != nil {
+		return x.CourseID
+	}
+	return 0
+}
+
+type UserRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID uint64 `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+}
+
+func (x *UserRequest) Reset() {
+	*x = UserRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[131]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserRequest) ProtoMessage() {}
+
+func (x *UserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[131]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserRequest.ProtoReflect.Descriptor instead.
+func (*UserRequest) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{131}
+}
+
+func (x *UserRequest) GetUserID() uint64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+type GetGroupRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupID uint64 `protobuf:"varint,1,opt,name=groupID,proto3" json:"groupID,omitempty"`
+}
+
+func (x *GetGroupRequest) Reset() {
+	*x = GetGroupRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[132]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupRequest) ProtoMessage() {}
+
+func (x *GetGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[132]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupRequest.ProtoReflect.Descriptor instead.
+func (*GetGroupRequest) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{132}
+}
+
+func (x *GetGroupRequest) GetGroupID() uint64 {
+	if x != nil {
+		return x.GroupID
+	}
+	return 0
+}
+
+type GroupRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID   uint64 `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	GroupID  uint64 `protobuf:"varint,2,opt,name=groupID,proto3" json:"groupID,omitempty"`
+	CourseID uint64 `protobuf:"varint,3,opt,name=courseID,proto3" json:"courseID,omitempty"`
+}
+
+func (x *GroupRequest) Reset() {
+	*x = GroupRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[133]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupRequest) ProtoMessage() {}
+
+func (x *GroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[133]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupRequest.ProtoReflect.Descriptor instead.
+func (*GroupRequest) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{133}
+}
+
+func (x *GroupRequest) GetUserID() uint64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *GroupRequest) GetGroupID() uint64 {
+	if x != nil {
+		return x.GroupID
+	}
+	return 0
+}
+
+func (x *GroupRequest) GetCourseID() uint64 {
+	if x != nil {
+		return x.CourseID
+	}
+	return 0
+}
+
+type Provider struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Provider string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+}
+
+func (x *Provider) Reset() {
+	*x = Provider{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[134]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Provider) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Provider) ProtoMessage() {}
+
+func (x *Provider) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[134]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Provider.ProtoReflect.Descriptor instead.
+func (*Provider) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{134}
+}
+
+func (x *Provider) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+type OrgRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrgName string `protobuf:"bytes,1,opt,name=orgName,proto3" json:"orgName,omitempty"`
+}
+
+func (x *OrgRequest) Reset() {
+	*x = OrgRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[135]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OrgRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrgRequest) ProtoMessage() {}
+
+func (x *OrgRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[135]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrgRequest.ProtoReflect.Descriptor instead.
+func (*OrgRequest) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{135}
+}
+
+func (x *OrgRequest) GetOrgName() string {
+	if x != nil {
+		return x.OrgName
+	}
+	return ""
+}
+
+type Organization struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID          uint64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Path        string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Avatar      string `protobuf:"bytes,3,opt,name=avatar,proto3" json:"avatar,omitempty"`
+	PaymentPlan string `protobuf:"bytes,4,opt,name=paymentPlan,proto3" json:"paymentPlan,omitempty"`
+}
+
+func (x *Organization) Reset() {
+	*x = Organization{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[136]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Organization) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Organization) ProtoMessage() {}
+
+func (x *Organization) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[136]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Organization.ProtoReflect.Descriptor instead.
+func (*Organization) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{136}
+}
+
+func (x *Organization) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *Organization) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *Organization) GetAvatar() string {
+	if x != nil {
+		return x.Avatar
+	}
+	return ""
+}
+
+func (x *Organization) GetPaymentPlan() string {
+	if x != nil {
+		return x.PaymentPlan
+	}
+	return ""
+}
+
+type Organizations struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Organizations []*Organization `protobuf:"bytes,1,rep,name=organizations,proto3" json:"organizations,omitempty"`
+}
+
+func (x *Organizations) Reset() {
+	*x = Organizations{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[137]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Organizations) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Organizations) ProtoMessage() {}
+
+func (x *Organizations) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[137]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Organizations.ProtoReflect.Descriptor instead.
+func (*Organizations) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{137}
+}
+
+func (x *Organizations) GetOrganizations() []*Organization {
+	if x != nil {
+		return x.Organizations
+	}
+	return nil
+}
+
+// EnrollmentRequest is a request for enrolled users of a given course,
+// whose enrollment status match those provided in the request. To ignore group members
+// that otherwise match the enrollment request, set ignoreGroupMembers to true.
+// If pageSize is set, at most pageSize enrollments are returned, starting at
+// the page given by pageToken; pages may be shorter when group members are ignored.
+type EnrollmentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CourseID           uint64                  `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	IgnoreGroupMembers bool                    `protobuf:"varint,2,opt,name=ignoreGroupMembers,proto3" json:"ignoreGroupMembers,omitempty"`
+	WithActivity       bool                    `protobuf:"varint,3,opt,name=withActivity,proto3" json:"withActivity,omitempty"`
+	Statuses           []Enrollment_UserStatus `protobuf:"varint,4,rep,packed,name=statuses,proto3,enum=ag.Enrollment_UserStatus" json:"statuses,omitempty"`
+	PageSize           uint32                  `protobuf:"varint,5,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	PageToken          string                  `protobuf:"bytes,6,opt,name=pageToken,proto3" json:"pageToken,omitempty"`
+}
+
+func (x *EnrollmentRequest) Reset() {
+	*x = EnrollmentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[138]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EnrollmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnrollmentRequest) ProtoMessage() {}
+
+func (x *EnrollmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[138]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnrollmentRequest.ProtoReflect.Descriptor instead.
+func (*EnrollmentRequest) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{138}
+}
+
+func (x *EnrollmentRequest) GetCourseID() uint64 {
+	if x != nil {
+		return x.CourseID
+	}
+	return 0
+}
+
+func (x *EnrollmentRequest) GetIgnoreGroupMembers() bool {
+	if x != nil {
+		return x.IgnoreGroupMembers
+	}
+	return false
+}
+
+func (x *EnrollmentRequest) GetWithActivity() bool {
+	if x != nil {
+		return x.WithActivity
+	}
+	return false
+}
+
+func (x *EnrollmentRequest) GetStatuses() []Enrollment_UserStatus {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+func (x *EnrollmentRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *EnrollmentRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// ImportEnrollmentsRequest is a request to enroll the students listed in a CSV file into a course.
+// Each row holds a student's student ID, email and GitHub username; a header row is ignored.
+type ImportEnrollmentsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CourseID uint64 `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	Csv      string `protobuf:"bytes,2,opt,name=csv,proto3" json:"csv,omitempty"`
+}
+
+func (x *ImportEnrollmentsRequest) Reset() {
+	*x = ImportEnrollmentsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[139]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportEnrollmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportEnrollmentsRequest) ProtoMessage() {}
+
+func (x *ImportEnrollmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[139]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportEnrollmentsRequest.ProtoReflect.Descriptor instead.
+func (*ImportEnrollmentsRequest) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{139}
+}
+
+func (x *ImportEnrollmentsRequest) GetCourseID() uint64 {
+	if x != nil {
+		return x.CourseID
+	}
+	return 0
+}
+
+func (x *ImportEnrollmentsRequest) GetCsv() string {
+	if x != nil {
+		return x.Csv
+	}
+	return ""
+}
+
+// EnrollmentImportReport reports the outcome of importing enrollments.
+type EnrollmentImportReport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Enrolled        uint32           `protobuf:"varint,1,opt,name=enrolled,proto3" json:"enrolled,omitempty"`               // number of existing users with new pending enrollments
+	AlreadyEnrolled uint32           `protobuf:"varint,2,opt,name=alreadyEnrolled,proto3" json:"alreadyEnrolled,omitempty"` // number of existing users already enrolled in the course
+	Unmatched       []*PreEnrollment `protobuf:"bytes,3,rep,name=unmatched,proto3" json:"unmatched,omitempty"`              // students that will be enrolled when they first log in
+	Errors          []string         `protobuf:"bytes,4,rep,name=errors,proto3" json:"errors,omitempty"`                    // rows that could not be imported
+}
+
+func (x *EnrollmentImportReport) Reset() {
+	*x = EnrollmentImportReport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[140]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EnrollmentImportReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnrollmentImportReport) ProtoMessage() {}
+
+func (x *EnrollmentImportReport) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[140]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnrollmentImportReport.ProtoReflect.Descriptor instead.
+func (*EnrollmentImportReport) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{140}
+}
+
+func (x *EnrollmentImportReport) GetEnrolled() uint32 {
+	if x != nil {
+		return x.Enrolled
+	}
+	return 0
+}
+
+func (x *EnrollmentImportReport) GetAlreadyEnrolled() uint32 {
+	if x != nil {
+		return x.AlreadyEnrolled
+	}
+	return 0
+}
+
+func (x *EnrollmentImportReport) GetUnmatched() []*PreEnrollment {
+	if x != nil {
+		return x.Unmatched
+	}
+	return nil
+}
+
+func (x *EnrollmentImportReport) GetErrors() []string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+// EnrollmentStatusRequest is a request for a given user, with a specific enrollment status.
+type EnrollmentStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID   uint64                  `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	Statuses []Enrollment_UserStatus `protobuf:"varint,2,rep,packed,name=statuses,proto3,enum=ag.Enrollment_UserStatus" json:"statuses,omitempty"`
+}
+
+func (x *EnrollmentStatusRequest) Reset() {
+	*x = EnrollmentStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[141]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EnrollmentStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnrollmentStatusRequest) ProtoMessage() {}
+
+func (x *EnrollmentStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[141]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnrollmentStatusRequest.ProtoReflect.Descriptor instead.
+func (*EnrollmentStatusRequest) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{141}
+}
+
+func (x *EnrollmentStatusRequest) GetUserID() uint64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *EnrollmentStatusRequest) GetStatuses() []Enrollment_UserStatus {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+// SubmissionRequest is a request for the latest submissions of a user or group
+// for each of the course's assignments. If pageSize is set, the submissions for
+// at most pageSize assignments are returned, starting at the page given by pageToken.
+type SubmissionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID    uint64 `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	GroupID   uint64 `protobuf:"varint,2,opt,name=groupID,proto3" json:"groupID,omitempty"`
+	CourseID  uint64 `protobuf:"varint,3,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	PageSize  uint32 `protobuf:"varint,4,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	PageToken string `protobuf:"bytes,5,opt,name=pageToken,proto3" json:"pageToken,omitempty"`
+}
+
+func (x *SubmissionRequest) Reset() {
+	*x = SubmissionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[142]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubmissionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmissionRequest) ProtoMessage() {}
+
+func (x *SubmissionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[142]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmissionRequest.ProtoReflect.Descriptor instead.
+func (*SubmissionRequest) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{142}
+}
+
+func (x *SubmissionRequest) GetUserID() uint64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *SubmissionRequest) GetGroupID() uint64 {
+	if x != nil {
+		return x.GroupID
+	}
+	return 0
+}
+
+func (x *SubmissionRequest) GetCourseID() uint64 {
+	if x != nil {
+		return x.CourseID
+	}
+	return 0
+}
+
+func (x *SubmissionRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *SubmissionRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// SubmissionStreamRequest is a request for the submission results recorded for
+// the given course while the stream is open.
+type SubmissionStreamRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CourseID uint64 `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
+}
+
+func (x *SubmissionStreamRequest) Reset() {
+	*x = SubmissionStreamRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[143]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubmissionStreamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmissionStreamRequest) ProtoMessage() {}
+
+func (x *SubmissionStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[143]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmissionStreamRequest.ProtoReflect.Descriptor instead.
+func (*SubmissionStreamRequest) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{143}
+}
+
+func (x *SubmissionStreamRequest) GetCourseID() uint64 {
+	if x != nil {
+		return x.CourseID
+	}
+	return 0
+}
+
+// Event notifies a user's browser about a change relevant to the user, such that the
+// frontend can update the affected views. Events are sent by the server's events endpoint.
+type Event struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type         Event_Type `protobuf:"varint,1,opt,name=type,proto3,enum=ag.Event_Type" json:"type,omitempty"`
+	CourseID     uint64     `protobuf:"varint,2,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	AssignmentID uint64     `protobuf:"varint,3,opt,name=assignmentID,proto3" json:"assignmentID,omitempty"`
+	SubmissionID uint64     `protobuf:"varint,4,opt,name=submissionID,proto3" json:"submissionID,omitempty"`
+	GroupID      uint64     `protobuf:"varint,5,opt,name=groupID,proto3" json:"groupID,omitempty"`
+	Time         string     `protobuf:"bytes,6,opt,name=time,proto3" json:"time,omitempty"`
+}
+
+func (x *Event) Reset() {
+	*x = Event{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[144]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Event) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event) ProtoMessage() {}
+
+func (x *Event) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[144]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event.ProtoReflect.Descriptor instead.
+func (*Event) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{144}
+}
+
+func (x *Event) GetType() Event_Type {
+	if x != nil {
+		return x.Type
+	}
+	return Event_NONE
+}
+
+func (x *Event) GetCourseID() uint64 {
+	if x != nil {
+		return x.CourseID
+	}
+	return 0
+}
+
+func (x *Event) GetAssignmentID() uint64 {
+	if x != nil {
+		return x.AssignmentID
+	}
+	return 0
+}
+
+func (x *Event) GetSubmissionID() uint64 {
+	if x != nil {
+		return x.SubmissionID
+	}
+	return 0
+}
+
+func (x *Event) GetGroupID() uint64 {
+	if x != nil {
+		return x.GroupID
+	}
+	return 0
+}
+
+func (x *Event) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+type SubmissionStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID       uint64 `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	GroupID      uint64 `protobuf:"varint,2,opt,name=groupID,proto3" json:"groupID,omitempty"`
+	CourseID     uint64 `protobuf:"varint,3,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	AssignmentID uint64 `protobuf:"varint,4,opt,name=assignmentID,proto3" json:"assignmentID,omitempty"`
+}
+
+func (x *SubmissionStatusRequest) Reset() {
+	*x = SubmissionStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[145]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubmissionStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmissionStatusRequest) ProtoMessage() {}
+
+func (x *SubmissionStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[145]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmissionStatusRequest.ProtoReflect.Descriptor instead.
+func (*SubmissionStatusRequest) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{145}
+}
+
+func (x *SubmissionStatusRequest) GetUserID() uint64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *SubmissionStatusRequest) GetGroupID() uint64 {
+	if x != nil {
+		return x.GroupID
+	}
+	return 0
+}
+
+func (x *SubmissionStatusRequest) GetCourseID() uint64 {
+	if x != nil {
+		return x.CourseID
+	}
+	return 0
+}
+
+func (x *SubmissionStatusRequest) GetAssignmentID() uint64 {
+	if x != nil {
+		return x.AssignmentID
+	}
+	return 0
+}
+
+// SubmissionStatus describes the grading status of a submission in the test queue.
+type SubmissionStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	State         SubmissionStatus_State `protobuf:"varint,1,opt,name=state,proto3,enum=ag.SubmissionStatus_State" json:"state,omitempty"`
+	Position      uint32                 `protobuf:"varint,2,opt,name=position,proto3" json:"position,omitempty"`           // number of queued submissions ahead of this submission
+	EstimatedWait uint32                 `protobuf:"varint,3,opt,name=estimatedWait,proto3" json:"estimatedWait,omitempty"` // estimated number of seconds until the results are ready
+	Enqueued      string                 `protobuf:"bytes,4,opt,name=enqueued,proto3" json:"enqueued,omitempty"`
+}
+
+func (x *SubmissionStatus) Reset() {
+	*x = SubmissionStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[146]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubmissionStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmissionStatus) ProtoMessage() {}
+
+func (x *SubmissionStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[146]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmissionStatus.ProtoReflect.Descriptor instead.
+func (*SubmissionStatus) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{146}
+}
+
+func (x *SubmissionStatus) GetState() SubmissionStatus_State {
+	if x != nil {
+		return x.State
+	}
+	return SubmissionStatus_NONE
+}
+
+func (x *SubmissionStatus) GetPosition() uint32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *SubmissionStatus) GetEstimatedWait() uint32 {
+	if x != nil {
+		return x.EstimatedWait
+	}
+	return 0
+}
+
+func (x *SubmissionStatus) GetEnqueued() string {
+	if x != nil {
+		return x.Enqueued
+	}
+	return ""
+}
+
+// ReplayRequest replays a sample of a course's most recent submissions on a staging runner pool.
+type ReplayRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CourseID     uint64 `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	AssignmentID uint64 `protobuf:"varint,2,opt,name=assignmentID,proto3" json:"assignmentID,omitempty"` // 0 => submissions for all assignments
+	Sample       uint32 `protobuf:"varint,3,opt,name=sample,proto3" json:"sample,omitempty"`             // number of recent submissions to replay
+	RunnerPool   string `protobuf:"bytes,4,opt,name=runnerPool,proto3" json:"runnerPool,omitempty"`      // staging runner pool to replay the submissions on
+}
+
+func (x *ReplayRequest) Reset() {
+	*x = ReplayRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[147]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplayRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayRequest) ProtoMessage() {}
+
+func (x *ReplayRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[147]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayRequest.ProtoReflect.Descriptor instead.
+func (*ReplayRequest) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{147}
+}
+
+func (x *ReplayRequest) GetCourseID() uint64 {
+	if x != nil {
+		return x.CourseID
+	}
+	return 0
+}
+
+func (x *ReplayRequest) GetAssignmentID() uint64 {
+	if x != nil {
+		return x.AssignmentID
+	}
+	return 0
+}
+
+func (x *ReplayRequest) GetSample() uint32 {
+	if x != nil {
+		return x.Sample
+	}
+	return 0
+}
+
+func (x *ReplayRequest) GetRunnerPool() string {
+	if x != nil {
+		return x.RunnerPool
+	}
+	return ""
+}
+
+// ReplayResult compares a submission's recorded test score with the score from replaying its tests.
+type ReplayResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SubmissionID  uint64 `protobuf:"varint,1,opt,name=submissionID,proto3" json:"submissionID,omitempty"`
+	AssignmentID  uint64 `protobuf:"varint,2,opt,name=assignmentID,proto3" json:"assignmentID,omitempty"`
+	UserID        uint64 `protobuf:"varint,3,opt,name=userID,proto3" json:"userID,omitempty"`
+	GroupID       uint64 `protobuf:"varint,4,opt,name=groupID,proto3" json:"groupID,omitempty"`
+	CommitHash    string `protobuf:"bytes,5,opt,name=commitHash,proto3" json:"commitHash,omitempty"`
+	RecordedScore uint32 `protobuf:"varint,6,opt,name=recordedScore,proto3" json:"recordedScore,omitempty"`
+	ReplayedScore uint32 `protobuf:"varint,7,opt,name=replayedScore,proto3" json:"replayedScore,omitempty"`
+	Error         string `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"` // non-empty if the replay failed
+}
+
+func (x *ReplayResult) Reset() {
+	*x = ReplayResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[148]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplayResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayResult) ProtoMessage() {}
+
+func (x *ReplayResult) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[148]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayResult.ProtoReflect.Descriptor instead.
+func (*ReplayResult) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{148}
+}
+
+func (x *ReplayResult) GetSubmissionID() uint64 {
+	if x != nil {
+		return x.SubmissionID
+	}
+	return 0
+}
+
+func (x *ReplayResult) GetAssignmentID() uint64 {
+	if x != nil {
+		return x.AssignmentID
+	}
+	return 0
+}
+
+func (x *ReplayResult) GetUserID() uint64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *ReplayResult) GetGroupID() uint64 {
+	if x != nil {
+		return x.GroupID
+	}
+	return 0
+}
+
+func (x *ReplayResult) GetCommitHash() string {
+	if x != nil {
+		return x.CommitHash
+	}
+	return ""
+}
+
+func (x *ReplayResult) GetRecordedScore() uint32 {
+	if x != nil {
+		return x.RecordedScore
+	}
+	return 0
+}
+
+func (x *ReplayResult) GetReplayedScore() uint32 {
+	if x != nil {
+		return x.ReplayedScore
+	}
+	return 0
+}
+
+func (x *ReplayResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ReplayReport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CourseID   uint64          `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	RunnerPool string          `protobuf:"bytes,2,opt,name=runnerPool,proto3" json:"runnerPool,omitempty"`
+	Results    []*ReplayResult `protobuf:"bytes,3,rep,name=results,proto3" json:"results,omitempty"`
+	Mismatches uint32          `protobuf:"varint,4,opt,name=mismatches,proto3" json:"mismatches,omitempty"` // number of replays that failed or gave a different score
+}
+
+func (x *ReplayReport) Reset() {
+	*x = ReplayReport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[149]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplayReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayReport) ProtoMessage() {}
+
+func (x *ReplayReport) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[149]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayReport.ProtoReflect.Descriptor instead.
+func (*ReplayReport) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{149}
+}
+
+func (x *ReplayReport) GetCourseID() uint64 {
+	if x != nil {
+		return x.CourseID
+	}
+	return 0
+}
+
+func (x *ReplayReport) GetRunnerPool() string {
+	if x != nil {
+		return x.RunnerPool
+	}
+	return ""
+}
+
+func (x *ReplayReport) GetResults() []*ReplayResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *ReplayReport) GetMismatches() uint32 {
+	if x != nil {
+		return x.Mismatches
+	}
+	return 0
+}
+
+type UpdateSubmissionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SubmissionID uint64            `protobuf:"varint,1,opt,name=submissionID,proto3" json:"submissionID,omitempty"`
+	CourseID     uint64            `protobuf:"varint,2,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	Score        uint32            `protobuf:"varint,3,opt,name=score,proto3" json:"score,omitempty"`
+	Released     bool              `protobuf:"varint,4,opt,name=released,proto3" json:"released,omitempty"`
+	Status       Submission_Status `protobuf:"varint,5,opt,name=status,proto3,enum=ag.Submission_Status" json:"status,omitempty"`
+}
+
+func (x *UpdateSubmissionRequest) Reset() {
+	*x = UpdateSubmissionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[150]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateSubmissionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSubmissionRequest) ProtoMessage() {}
+
+func (x *UpdateSubmissionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[150]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSubmissionRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSubmissionRequest) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{150}
+}
+
+func (x *UpdateSubmissionRequest) GetSubmissionID() uint64 {
+	if x != nil {
+		return x.SubmissionID
+	}
+	return 0
+}
+
+func (x *UpdateSubmissionRequest) GetCourseID() uint64 {
+	if x != nil {
+		return x.CourseID
+	}
+	return 0
+}
+
+func (x *UpdateSubmissionRequest) GetScore() uint32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *UpdateSubmissionRequest) GetReleased() bool {
+	if x != nil {
+		return x.Released
+	}
+	return false
+}
+
+func (x *UpdateSubmissionRequest) GetStatus() Submission_Status {
+	if x != nil {
+		return x.Status
+	}
+	return Submission_NONE
+}
+
+type UpdateSubmissionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CourseID     uint64 `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	AssignmentID uint64 `protobuf:"varint,2,opt,name=assignmentID,proto3" json:"assignmentID,omitempty"`
+	ScoreLimit   uint32 `protobuf:"varint,3,opt,name=scoreLimit,proto3" json:"scoreLimit,omitempty"`
+	Release      bool   `protobuf:"varint,4,opt,name=release,proto3" json:"release,omitempty"`
+	Approve      bool   `protobuf:"varint,5,opt,name=approve,proto3" json:"approve,omitempty"`
+}
+
+func (x *UpdateSubmissionsRequest) Reset() {
+	*x = UpdateSubmissionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[151]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateSubmissionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSubmissionsRequest) ProtoMessage() {}
+
+func (x *UpdateSubmissionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[151]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSubmissionsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSubmissionsRequest) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{151}
+}
+
+func (x *UpdateSubmissionsRequest) GetCourseID() uint64 {
+	if x != nil {
+		return x.CourseID
+	}
+	return 0
+}
+
+func (x *UpdateSubmissionsRequest) GetAssignmentID() uint64 {
+	if x != nil {
+		return x.AssignmentID
+	}
+	return 0
+}
+
+func (x *UpdateSubmissionsRequest) GetScoreLimit() uint32 {
+	if x != nil {
+		return x.ScoreLimit
+	}
+	return 0
+}
+
+func (x *UpdateSubmissionsRequest) GetRelease() bool {
+	if x != nil {
+		return x.Release
+	}
+	return false
+}
+
+func (x *UpdateSubmissionsRequest) GetApprove() bool {
+	if x != nil {
+		return x.Approve
+	}
+	return false
+}
+
+type SubmissionReviewersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SubmissionID uint64 `protobuf:"varint,1,opt,name=submissionID,proto3" json:"submissionID,omitempty"`
+	CourseID     uint64 `protobuf:"varint,2,opt,name=courseID,proto3" json:"courseID,omitempty"`
+}
+
+func (x *SubmissionReviewersRequest) Reset() {
+	*x = SubmissionReviewersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[152]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubmissionReviewersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmissionReviewersRequest) ProtoMessage() {}
+
+func (x *SubmissionReviewersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[152]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmissionReviewersRequest.ProtoReflect.Descriptor instead.
+func (*SubmissionReviewersRequest) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{152}
+}
+
+func (x *SubmissionReviewersRequest) GetSubmissionID() uint64 {
+	if x != nil {
+		return x.SubmissionID
+	}
+	return 0
+}
+
+func (x *SubmissionReviewersRequest) GetCourseID() uint64 {
+	if x != nil {
+		return x.CourseID
+	}
+	return 0
+}
+
+type Providers struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Providers []string `protobuf:"bytes,1,rep,name=providers,proto3" json:"providers,omitempty"`
+}
+
+func (x *Providers) Reset() {
+	*x = Providers{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[153]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Providers) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Providers) ProtoMessage() {}
+
+func (x *Providers) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[153]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Providers.ProtoReflect.Descriptor instead.
+func (*Providers) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{153}
+}
+
+func (x *Providers) GetProviders() []string {
+	if x != nil {
+		return x.Providers
+	}
+	return nil
+}
+
+type URLRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CourseID  uint64            `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	RepoTypes []Repository_Type `protobuf:"varint,2,rep,packed,name=repoTypes,proto3,enum=ag.Repository_Type" json:"repoTypes,omitempty"`
+}
+
+func (x *URLRequest) Reset() {
+	*x = URLRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[154]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *URLRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*URLRequest) ProtoMessage() {}
+
+func (x *URLRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[154]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use URLRequest.ProtoReflect.Descriptor instead.
+func (*URLRequest) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{154}
+}
+
+func (x *URLRequest) GetCourseID() uint64 {
+	if x != nil {
+		return x.CourseID
+	}
+	return 0
+}
+
+func (x *URLRequest) GetRepoTypes() []Repository_Type {
+	if x != nil {
+		return x.RepoTypes
+	}
+	return nil
+}
+
+// used to check whether student/group submission repo is empty
+type RepositoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID   uint64 `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	GroupID  uint64 `protobuf:"varint,2,opt,name=groupID,proto3" json:"groupID,omitempty"`
+	CourseID uint64 `protobuf:"varint,3,opt,name=courseID,proto3" json:"courseID,omitempty"`
+}
+
+func (x *RepositoryRequest) Reset() {
+	*x = RepositoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[155]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RepositoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepositoryRequest) ProtoMessage() {}
+
+func (x *RepositoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[155]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepositoryRequest.ProtoReflect.Descriptor instead.
+func (*RepositoryRequest) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{155}
+}
+
+func (x *RepositoryRequest) GetUserID() uint64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *RepositoryRequest) GetGroupID() uint64 {
+	if x != nil {
+		return x.GroupID
+	}
+	return 0
+}
+
+func (x *RepositoryRequest) GetCourseID() uint64 {
+	if x != nil {
+		return x.CourseID
+	}
+	return 0
+}
+
+type Repositories struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	URLs map[string]string `protobuf:"bytes,1,rep,name=URLs,proto3" json:"URLs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *Repositories) Reset() {
+	*x = Repositories{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[156]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Repositories) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Repositories) ProtoMessage() {}
+
+func (x *Repositories) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[156]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Repositories.ProtoReflect.Descriptor instead.
+func (*Repositories) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{156}
+}
+
+func (x *Repositories) GetURLs() map[string]string {
+	if x != nil {
+		return x.URLs
+	}
+	return nil
+}
+
+type FeedURL struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	URL string `protobuf:"bytes,1,opt,name=URL,proto3" json:"URL,omitempty"`
+}
+
+func (x *FeedURL) Reset() {
+	*x = FeedURL{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[157]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FeedURL) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeedURL) ProtoMessage() {}
+
+func (x *FeedURL) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[157]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeedURL.ProtoReflect.Descriptor instead.
+func (*FeedURL) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{157}
+}
+
+func (x *FeedURL) GetURL() string {
+	if x != nil {
+		return x.URL
+	}
+	return ""
+}
+
+// OrphanedResources lists the resources found in a course's organization
+// that have no corresponding records in QuickFeed.
+type OrphanedResources struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CourseID     uint64   `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	Repositories []string `protobuf:"bytes,2,rep,name=repositories,proto3" json:"repositories,omitempty"` // repositories with no owner
+	Teams        []string `protobuf:"bytes,3,rep,name=teams,proto3" json:"teams,omitempty"`               // teams not belonging to any group
+	Users        []string `protobuf:"bytes,4,rep,name=users,proto3" json:"users,omitempty"`               // organization members not enrolled in the course
+	CheckedAt    string   `protobuf:"bytes,5,opt,name=checkedAt,proto3" json:"checkedAt,omitempty"`
+}
+
+func (x *OrphanedResources) Reset() {
+	*x = OrphanedResources{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[158]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OrphanedResources) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrphanedResources) ProtoMessage() {}
+
+func (x *OrphanedResources) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[158]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrphanedResources.ProtoReflect.Descriptor instead.
+func (*OrphanedResources) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{158}
+}
+
+func (x *OrphanedResources) GetCourseID() uint64 {
+	if x != nil {
+		return x.CourseID
+	}
+	return 0
+}
+
+func (x *OrphanedResources) GetRepositories() []string {
+	if x != nil {
+		return x.Repositories
+	}
+	return nil
+}
+
+func (x *OrphanedResources) GetTeams() []string {
+	if x != nil {
+		return x.Teams
+	}
+	return nil
+}
+
+func (x *OrphanedResources) GetUsers() []string {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *OrphanedResources) GetCheckedAt() string {
+	if x != nil {
+		return x.CheckedAt
+	}
+	return ""
+}
+
+// AccessViolation is a team or user with access to a course repository
+// that they should not have access to, such as the tests repository.
+type AccessViolation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Repository string `protobuf:"bytes,1,opt,name=repository,proto3" json:"repository,omitempty"`
+	Team       string `protobuf:"bytes,2,opt,name=team,proto3" json:"team,omitempty"` // name of the team with access; empty for users
+	User       string `protobuf:"bytes,3,opt,name=user,proto3" json:"user,omitempty"` // login name of the user with access; empty for teams
+	Permission string `protobuf:"bytes,4,opt,name=permission,proto3" json:"permission,omitempty"`
+	Revoked    bool   `protobuf:"varint,5,opt,name=revoked,proto3" json:"revoked,omitempty"` // true => the access has been revoked
+}
+
+func (x *AccessViolation) Reset() {
+	*x = AccessViolation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[159]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccessViolation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccessViolation) ProtoMessage() {}
+
+func (x *AccessViolation) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[159]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccessViolation.ProtoReflect.Descriptor instead.
+func (*AccessViolation) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{159}
+}
+
+func (x *AccessViolation) GetRepository() string {
+	if x != nil {
+		return x.Repository
+	}
+	return ""
+}
+
+func (x *AccessViolation) GetTeam() string {
+	if x != nil {
+		return x.Team
+	}
+	return ""
+}
+
+func (x *AccessViolation) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *AccessViolation) GetPermission() string {
+	if x != nil {
+		return x.Permission
+	}
+	return ""
+}
+
+func (x *AccessViolation) GetRevoked() bool {
+	if x != nil {
+		return x.Revoked
+	}
+	return false
+}
+
+type ExposureReport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CourseID   uint64             `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	Violations []*AccessViolation `protobuf:"bytes,2,rep,name=violations,proto3" json:"violations,omitempty"`
+	CheckedAt  string             `protobuf:"bytes,3,opt,name=checkedAt,proto3" json:"checkedAt,omitempty"`
+}
+
+func (x *ExposureReport) Reset() {
+	*x = ExposureReport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[160]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExposureReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExposureReport) ProtoMessage() {}
+
+func (x *ExposureReport) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[160]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExposureReport.ProtoReflect.Descriptor instead.
+func (*ExposureReport) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{160}
+}
+
+func (x *ExposureReport) GetCourseID() uint64 {
+	if x != nil {
+		return x.CourseID
+	}
+	return 0
+}
+
+func (x *ExposureReport) GetViolations() []*AccessViolation {
+	if x != nil {
+		return x.Violations
+	}
+	return nil
+}
+
+func (x *ExposureReport) GetCheckedAt() string {
+	if x != nil {
+		return x.CheckedAt
+	}
+	return ""
+}
+
+// AccessEntry is the permission of a team or user on a course repository,
+// compared to the permission expected by QuickFeed.
+type AccessEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Repository         string `protobuf:"bytes,1,opt,name=repository,proto3" json:"repository,omitempty"`
+	Team               string `protobuf:"bytes,2,opt,name=team,proto3" json:"team,omitempty"`                             // name of the team; empty for users
+	User               string `protobuf:"bytes,3,opt,name=user,proto3" json:"user,omitempty"`                             // login name of the user; empty for teams
+	Permission         string `protobuf:"bytes,4,opt,name=permission,proto3" json:"permission,omitempty"`                 // actual permission; empty if the team or user has no access
+	ExpectedPermission string `protobuf:"bytes,5,opt,name=expectedPermission,proto3" json:"expectedPermission,omitempty"` // empty if the team or user should have no access
+}
+
+func (x *AccessEntry) Reset() {
+	*x = AccessEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[161]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccessEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccessEntry) ProtoMessage() {}
+
+func (x *AccessEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[161]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccessEntry.ProtoReflect.Descriptor instead.
+func (*AccessEntry) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{161}
+}
+
+func (x *AccessEntry) GetRepository() string {
+	if x != nil {
+		return x.Repository
+	}
+	return ""
+}
+
+func (x *AccessEntry) GetTeam() string {
+	if x != nil {
+		return x.Team
+	}
+	return ""
+}
+
+func (x *AccessEntry) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *AccessEntry) GetPermission() string {
+	if x != nil {
+		return x.Permission
+	}
+	return ""
+}
+
+func (x *AccessEntry) GetExpectedPermission() string {
+	if x != nil {
+		return x.ExpectedPermission
+	}
+	return ""
+}
+
+type AccessReport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CourseID  uint64         `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	Entries   []*AccessEntry `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries,omitempty"`
+	CheckedAt string         `protobuf:"bytes,3,opt,name=checkedAt,proto3" json:"checkedAt,omitempty"`
+}
+
+func (x *AccessReport) Reset() {
+	*x = AccessReport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[162]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccessReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccessReport) ProtoMessage() {}
+
+func (x *AccessReport) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[162]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccessReport.ProtoReflect.Descriptor instead.
+func (*AccessReport) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{162}
+}
+
+func (x *AccessReport) GetCourseID() uint64 {
+	if x != nil {
+		return x.CourseID
+	}
+	return 0
+}
+
+func (x *AccessReport) GetEntries() []*AccessEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *AccessReport) GetCheckedAt() string {
+	if x != nil {
+		return x.CheckedAt
+	}
+	return ""
+}
+
+// RepositoryAccessRequest selects the course whose student and group repositories are made
+// read-only, e.g., to freeze the course at the end of the semester, or writable again.
+type RepositoryAccessRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CourseID uint64 `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	ReadOnly bool   `protobuf:"varint,2,opt,name=readOnly,proto3" json:"readOnly,omitempty"` // true => the repositories' owners get read access; false => write access
+}
+
+func (x *RepositoryAccessRequest) Reset() {
+	*x = RepositoryAccessRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[163]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RepositoryAccessRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepositoryAccessRequest) ProtoMessage() {}
+
+func (x *RepositoryAccessRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[163]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepositoryAccessRequest.ProtoReflect.Descriptor instead.
+func (*RepositoryAccessRequest) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{163}
+}
+
+func (x *RepositoryAccessRequest) GetCourseID() uint64 {
+	if x != nil {
+		return x.CourseID
+	}
+	return 0
+}
+
+func (x *RepositoryAccessRequest) GetReadOnly() bool {
+	if x != nil {
+		return x.ReadOnly
+	}
+	return false
+}
+
+// RepositoryAccessResult is the outcome of updating the access to a student or group repository.
+type RepositoryAccessResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Repository string `protobuf:"bytes,1,opt,name=repository,proto3" json:"repository,omitempty"`
+	Permission string `protobuf:"bytes,2,opt,name=permission,proto3" json:"permission,omitempty"` // permission given to the repository's owner
+	Updated    bool   `protobuf:"varint,3,opt,name=updated,proto3" json:"updated,omitempty"`      // false if the owner already had the permission, or if the update failed
+	Error      string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`           // reason the update failed; empty on success
+}
+
+func (x *RepositoryAccessResult) Reset() {
+	*x = RepositoryAccessResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[164]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RepositoryAccessResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepositoryAccessResult) ProtoMessage() {}
+
+func (x *RepositoryAccessResult) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[164]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepositoryAccessResult.ProtoReflect.Descriptor instead.
+func (*RepositoryAccessResult) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{164}
+}
+
+func (x *RepositoryAccessResult) GetRepository() string {
+	if x != nil {
+		return x.Repository
+	}
+	return ""
+}
+
+func (x *RepositoryAccessResult) GetPermission() string {
+	if x != nil {
+		return x.Permission
+	}
+	return ""
+}
+
+func (x *RepositoryAccessResult) GetUpdated() bool {
+	if x != nil {
+		return x.Updated
+	}
+	return false
+}
+
+func (x *RepositoryAccessResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type RepositoryAccessReport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CourseID uint64                    `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	Results  []*RepositoryAccessResult `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *RepositoryAccessReport) Reset() {
+	*x = RepositoryAccessReport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[165]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RepositoryAccessReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepositoryAccessReport) ProtoMessage() {}
+
+func (x *RepositoryAccessReport) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[165]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepositoryAccessReport.ProtoReflect.Descriptor instead.
+func (*RepositoryAccessReport) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{165}
+}
+
+func (x *RepositoryAccessReport) GetCourseID() uint64 {
+	if x != nil {
+		return x.CourseID
+	}
+	return 0
+}
+
+func (x *RepositoryAccessReport) GetResults() []*RepositoryAccessResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// CourseOverview summarizes a course's activity and the health of its integrations for admins.
+type CourseOverview struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Course      *Course `protobuf:"bytes,1,opt,name=course,proto3" json:"course,omitempty"`
+	Students    uint32  `protobuf:"varint,2,opt,name=students,proto3" json:"students,omitempty"`
+	Teachers    uint32  `protobuf:"varint,3,opt,name=teachers,proto3" json:"teachers,omitempty"`      // teachers and teaching assistants
+	Pending     uint32  `protobuf:"varint,4,opt,name=pending,proto3" json:"pending,omitempty"`        // pending enrollments
+	LastWebhook string  `protobuf:"bytes,5,opt,name=lastWebhook,proto3" json:"lastWebhook,omitempty"` // time of the last push event; empty if none has been received
+	TokenValid  bool    `protobuf:"varint,6,opt,name=tokenValid,proto3" json:"tokenValid,omitempty"`  // true => the course's access token can access the course organization
+	TokenError  string  `protobuf:"bytes,7,opt,name=tokenError,proto3" json:"tokenError,omitempty"`   // error from accessing the course organization, if the token is not valid
+	QueuedJobs  uint32  `protobuf:"varint,8,opt,name=queuedJobs,proto3" json:"queuedJobs,omitempty"`  // test jobs waiting for a runner
+	RunningJobs uint32  `protobuf:"varint,9,opt,name=runningJobs,proto3" json:"runningJobs,omitempty"`
+}
+
+func (x *CourseOverview) Reset() {
+	*x = CourseOverview{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[166]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CourseOverview) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CourseOverview) ProtoMessage() {}
+
+func (x *CourseOverview) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[166]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CourseOverview.ProtoReflect.Descriptor instead.
+func (*CourseOverview) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{166}
+}
+
+func (x *CourseOverview) GetCourse() *Course {
+	if x != nil {
+		return x.Course
+	}
+	return nil
+}
+
+func (x *CourseOverview) GetStudents() uint32 {
+	if x != nil {
+		return x.Students
+	}
+	return 0
+}
+
+func (x *CourseOverview) GetTeachers() uint32 {
+	if x != nil {
+		return x.Teachers
+	}
+	return 0
+}
+
+func (x *CourseOverview) GetPending() uint32 {
+	if x != nil {
+		return x.Pending
+	}
+	return 0
+}
+
+func (x *CourseOverview) GetLastWebhook() string {
+	if x != nil {
+		return x.LastWebhook
+	}
+	return ""
+}
+
+func (x *CourseOverview) GetTokenValid() bool {
+	if x != nil {
+		return x.TokenValid
+	}
+	return false
+}
+
+func (x *CourseOverview) GetTokenError() string {
+	if x != nil {
+		return x.TokenError
+	}
+	return ""
+}
+
+func (x *CourseOverview) GetQueuedJobs() uint32 {
+	if x != nil {
+		return x.QueuedJobs
+	}
+	return 0
+}
+
+func (x *CourseOverview) GetRunningJobs() uint32 {
+	if x != nil {
+		return x.RunningJobs
+	}
+	return 0
+}
+
+type AdminOverview struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Courses   []*CourseOverview `protobuf:"bytes,1,rep,name=courses,proto3" json:"courses,omitempty"`
+	CheckedAt string            `protobuf:"bytes,2,opt,name=checkedAt,proto3" json:"checkedAt,omitempty"`
+}
+
+func (x *AdminOverview) Reset() {
+	*x = AdminOverview{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[167]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminOverview) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminOverview) ProtoMessage() {}
+
+func (x *AdminOverview) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[167]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminOverview.ProtoReflect.Descriptor instead.
+func (*AdminOverview) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{167}
+}
+
+func (x *AdminOverview) GetCourses() []*CourseOverview {
+	if x != nil {
+		return x.Courses
+	}
+	return nil
+}
+
+func (x *AdminOverview) GetCheckedAt() string {
+	if x != nil {
+		return x.CheckedAt
+	}
+	return ""
+}
+
+type AuthorizationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IsAuthorized bool `protobuf:"varint,1,opt,name=IsAuthorized,proto3" json:"IsAuthorized,omitempty"`
+}
+
+func (x *AuthorizationResponse) Reset() {
+	*x = AuthorizationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[168]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuthorizationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthorizationResponse) ProtoMessage() {}
+
+func (x *AuthorizationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[168]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthorizationResponse.ProtoReflect.Descriptor instead.
+func (*AuthorizationResponse) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{168}
+}
+
+func (x *AuthorizationResponse) GetIsAuthorized() bool {
+	if x != nil {
+		return x.IsAuthorized
+	}
+	return false
+}
+
+type Status struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code  uint64 `protobuf:"varint,1,opt,name=Code,proto3" json:"Code,omitempty"`
+	Error string `protobuf:"bytes,2,opt,name=Error,proto3" json:"Error,omitempty"`
+}
+
+func (x *Status) Reset() {
+	*x = Status{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[169]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Status) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Status) ProtoMessage() {}
+
+func (x *Status) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[169]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Status.ProtoReflect.Descriptor instead.
+func (*Status) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{169}
+}
+
+func (x *Status) GetCode() uint64 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *Status) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type SubmissionsForCourseRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CourseID      uint64                           `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	Type          SubmissionsForCourseRequest_Type `protobuf:"varint,2,opt,name=type,proto3,enum=ag.SubmissionsForCourseRequest_Type" json:"type,omitempty"`
+	WithBuildInfo bool                             `protobuf:"varint,3,opt,name=withBuildInfo,proto3" json:"withBuildInfo,omitempty"`
+}
+
+func (x *SubmissionsForCourseRequest) Reset() {
+	*x = SubmissionsForCourseRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[170]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubmissionsForCourseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmissionsForCourseRequest) ProtoMessage() {}
+
+func (x *SubmissionsForCourseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[170]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmissionsForCourseRequest.ProtoReflect.Descriptor instead.
+func (*SubmissionsForCourseRequest) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{170}
+}
+
+func (x *SubmissionsForCourseRequest) GetCourseID() uint64 {
+	if x != nil {
+		return x.CourseID
+	}
+	return 0
+}
+
+func (x *SubmissionsForCourseRequest) GetType() SubmissionsForCourseRequest_Type {
+	if x != nil {
+		return x.Type
+	}
+	return SubmissionsForCourseRequest_ALL
+}
+
+func (x *SubmissionsForCourseRequest) GetWithBuildInfo() bool {
+	if x != nil {
+		return x.WithBuildInfo
+	}
+	return false
+}
+
+type RebuildRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SubmissionID uint64 `protobuf:"varint,1,opt,name=submissionID,proto3" json:"submissionID,omitempty"`
+	AssignmentID uint64 `protobuf:"varint,2,opt,name=assignmentID,proto3" json:"assignmentID,omitempty"`
+}
+
+func (x *RebuildRequest) Reset() {
+	*x = RebuildRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[171]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RebuildRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RebuildRequest) ProtoMessage() {}
+
+func (x *RebuildRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[171]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RebuildRequest.ProtoReflect.Descriptor instead.
+func (*RebuildRequest) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{171}
+}
+
+func (x *RebuildRequest) GetSubmissionID() uint64 {
+	if x != nil {
+		return x.SubmissionID
+	}
+	return 0
+}
+
+func (x *RebuildRequest) GetAssignmentID() uint64 {
+	if x != nil {
+		return x.AssignmentID
+	}
+	return 0
+}
+
+type CourseUserRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CourseCode string `protobuf:"bytes,1,opt,name=courseCode,proto3" json:"courseCode,omitempty"`
+	CourseYear uint32 `protobuf:"varint,2,opt,name=courseYear,proto3" json:"courseYear,omitempty"`
+	UserLogin  string `protobuf:"bytes,3,opt,name=userLogin,proto3" json:"userLogin,omitempty"`
+}
+
+func (x *CourseUserRequest) Reset() {
+	*x = CourseUserRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[172]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CourseUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CourseUserRequest) ProtoMessage() {}
+
+func (x *CourseUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[172]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CourseUserRequest.ProtoReflect.Descriptor instead.
+func (*CourseUserRequest) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{172}
+}
+
+func (x *CourseUserRequest) GetCourseCode() string {
+	if x != nil {
+		return x.CourseCode
+	}
+	return ""
+}
+
+func (x *CourseUserRequest) GetCourseYear() uint32 {
+	if x != nil {
+		return x.CourseYear
+	}
+	return 0
+}
+
+func (x *CourseUserRequest) GetUserLogin() string {
+	if x != nil {
+		return x.UserLogin
+	}
+	return ""
+}
+
+type AssignmentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CourseID     uint64 `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	AssignmentID uint64 `protobuf:"varint,2,opt,name=assignmentID,proto3" json:"assignmentID,omitempty"`
+}
+
+func (x *AssignmentRequest) Reset() {
+	*x = AssignmentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[173]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AssignmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignmentRequest) ProtoMessage() {}
+
+func (x *AssignmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[173]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignmentRequest.ProtoReflect.Descriptor instead.
+func (*AssignmentRequest) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{173}
+}
+
+func (x *AssignmentRequest) GetCourseID() uint64 {
+	if x != nil {
+		return x.CourseID
+	}
+	return 0
+}
+
+func (x *AssignmentRequest) GetAssignmentID() uint64 {
+	if x != nil {
+		return x.AssignmentID
+	}
+	return 0
+}
+
+type QuestionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CourseID uint64    `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	Question *Question `protobuf:"bytes,2,opt,name=question,proto3" json:"question,omitempty"`
+}
+
+func (x *QuestionRequest) Reset() {
+	*x = QuestionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[174]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuestionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuestionRequest) ProtoMessage() {}
+
+func (x *QuestionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[174]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuestionRequest.ProtoReflect.Descriptor instead.
+func (*QuestionRequest) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{174}
+}
+
+func (x *QuestionRequest) GetCourseID() uint64 {
+	if x != nil {
+		return x.CourseID
+	}
+	return 0
+}
+
+func (x *QuestionRequest) GetQuestion() *Question {
+	if x != nil {
+		return x.Question
+	}
+	return nil
+}
+
+type AnswerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CourseID uint64  `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	Answer   *Answer `protobuf:"bytes,2,opt,name=answer,proto3" json:"answer,omitempty"`
+}
+
+func (x *AnswerRequest) Reset() {
+	*x = AnswerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[175]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AnswerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnswerRequest) ProtoMessage() {}
+
+func (x *AnswerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[175]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnswerRequest.ProtoReflect.Descriptor instead.
+func (*AnswerRequest) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{175}
+}
+
+func (x *AnswerRequest) GetCourseID() uint64 {
+	if x != nil {
+		return x.CourseID
+	}
+	return 0
+}
+
+func (x *AnswerRequest) GetAnswer() *Answer {
+	if x != nil {
+		return x.Answer
+	}
+	return nil
+}
+
+type ExposureAuditRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CourseID uint64 `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	Revoke   bool   `protobuf:"varint,2,opt,name=revoke,proto3" json:"revoke,omitempty"` // true => revoke the access of violating teams and users
+}
+
+func (x *ExposureAuditRequest) Reset() {
+	*x = ExposureAuditRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[176]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExposureAuditRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExposureAuditRequest) ProtoMessage() {}
+
+func (x *ExposureAuditRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[176]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExposureAuditRequest.ProtoReflect.Descriptor instead.
+func (*ExposureAuditRequest) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{176}
+}
+
+func (x *ExposureAuditRequest) GetCourseID() uint64 {
+	if x != nil {
+		return x.CourseID
+	}
+	return 0
+}
+
+func (x *ExposureAuditRequest) GetRevoke() bool {
+	if x != nil {
+		return x.Revoke
+	}
+	return false
+}
+
+type AnnouncementRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CourseID       uint64 `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	AnnouncementID uint64 `protobuf:"varint,2,opt,name=announcementID,proto3" json:"announcementID,omitempty"`
+}
+
+func (x *AnnouncementRequest) Reset() {
+	*x = AnnouncementRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[177]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AnnouncementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnnouncementRequest) ProtoMessage() {}
+
+func (x *AnnouncementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[177]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnnouncementRequest.ProtoReflect.Descriptor instead.
+func (*AnnouncementRequest) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{177}
+}
+
+func (x *AnnouncementRequest) GetCourseID() uint64 {
+	if x != nil {
+		return x.CourseID
+	}
+	return 0
+}
+
+func (x *AnnouncementRequest) GetAnnouncementID() uint64 {
+	if x != nil {
+		return x.AnnouncementID
+	}
+	return 0
+}
+
+// Void contains no fields. A server response with a Void still contains a gRPC status code,
+// which can be checked for success or failure. Status code 0 indicates that the requested action was successful,
+// whereas any other status code indicates some failure. As such, the status code can be used as a boolean result from the server.
+type Void struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *Void) Reset() {
+	*x = Void{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ag_ag_proto_msgTypes[178]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Void) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Void) ProtoMessage() {}
+
+func (x *Void) ProtoReflect() protoreflect.Message {
+	mi := &file_ag_ag_proto_msgTypes[178]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Void.ProtoReflect.Descriptor instead.
+func (*Void) Descriptor() ([]byte, []int) {
+	return file_ag_ag_proto_rawDescGZIP(), []int{178}
+}
+
+var File_ag_ag_proto protoreflect.FileDescriptor
+
+var file_ag_ag_proto_rawDesc = []byte{
+	0x0a, 0x0b, 0x61, 0x67, 0x2f, 0x61, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x61,
+	0x67, 0x1a, 0x15, 0x6b, 0x69, 0x74, 0x2f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x73, 0x63, 0x6f,
+	0x72, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0e, 0x70, 0x61, 0x74, 0x63, 0x68, 0x2f,
+	0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x9a, 0x03, 0x0a, 0x04, 0x55, 0x73, 0x65,
+	0x72, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49,
+	0x44, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x73, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x69, 0x73, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x1c, 0x0a, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x14, 0x0a,
+	0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d,
+	0x61, 0x69, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x55, 0x52, 0x4c,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x55, 0x52,
+	0x4c, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x3e, 0x0a, 0x10, 0x72, 0x65, 0x6d, 0x6f, 0x74,
+	0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x61, 0x67, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x49, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x10, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x49, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x30, 0x0a, 0x0b, 0x65, 0x6e, 0x72, 0x6f, 0x6c,
+	0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61,
+	0x67, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x65, 0x6e,
+	0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x49, 0x0a, 0x09, 0x64, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x42, 0x2b, 0xca, 0xb5,
+	0x03, 0x27, 0x1a, 0x09, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0xa2, 0x01, 0x19,
+	0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x6e, 0x6f, 0x74, 0x20, 0x6e, 0x75, 0x6c, 0x6c, 0x3b, 0x64,
+	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x3a, 0x30, 0x22, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x2f, 0x0a, 0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x44,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x42, 0x13, 0xca, 0xb5, 0x03, 0x0f, 0xa2, 0x01, 0x0c, 0x67,
+	0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x52, 0x08, 0x74, 0x65, 0x6e,
+	0x61, 0x6e, 0x74, 0x49, 0x44, 0x22, 0x4d, 0x0a, 0x05, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x1e,
+	0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x08, 0x2e,
+	0x61, 0x67, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x12, 0x24,
+	0x0a, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x48, 0x0a, 0x0c, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65,
+	0x12, 0x1c, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xb5,
+	0x05, 0x0a, 0x08, 0x55, 0x73, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x04, 0x75,
+	0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x55,
+	0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x30, 0x0a, 0x0b, 0x65, 0x6e, 0x72,
+	0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e,
+	0x2e, 0x61, 0x67, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b,
+	0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x21, 0x0a, 0x06, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61, 0x67,
+	0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x30,
+	0x0a, 0x0b, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x67, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x30, 0x0a, 0x0b, 0x70, 0x65, 0x65, 0x72, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x67, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x52,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x0b, 0x70, 0x65, 0x65, 0x72, 0x52, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x73, 0x12, 0x24, 0x0a, 0x07, 0x61, 0x70, 0x70, 0x65, 0x61, 0x6c, 0x73, 0x18, 0x06, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x61, 0x67, 0x2e, 0x41, 0x70, 0x70, 0x65, 0x61, 0x6c, 0x52,
+	0x07, 0x61, 0x70, 0x70, 0x65, 0x61, 0x6c, 0x73, 0x12, 0x2d, 0x0a, 0x0a, 0x65, 0x78, 0x74, 0x65,
+	0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x61,
+	0x67, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x65, 0x78, 0x74,
+	0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2a, 0x0a, 0x09, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x61, 0x67, 0x2e,
+	0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x24, 0x0a, 0x07, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x18, 0x09,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x61, 0x67, 0x2e, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72,
+	0x52, 0x07, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x12, 0x33, 0x0a, 0x0c, 0x63, 0x65, 0x72,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0f, 0x2e, 0x61, 0x67, 0x2e, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65,
+	0x52, 0x0c, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x12, 0x36,
+	0x0a, 0x0d, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x61, 0x67, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x33, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61,
+	0x67, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x0c, 0x61,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x39, 0x0a, 0x0e, 0x70,
+	0x72, 0x65, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x0d, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x61, 0x67, 0x2e, 0x50, 0x72, 0x65, 0x45, 0x6e, 0x72, 0x6f,
+	0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0e, 0x70, 0x72, 0x65, 0x45, 0x6e, 0x72, 0x6f, 0x6c,
+	0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x32, 0x0a, 0x0c, 0x61, 0x75, 0x64, 0x69, 0x74, 0x45,
+	0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61,
+	0x67, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x61, 0x75,
+	0x64, 0x69, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x22, 0x95, 0x02, 0x0a, 0x0e, 0x52, 0x65, 0x6d, 0x6f, 0x74,
+	0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x55, 0x0a, 0x08, 0x70, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x39, 0xca, 0xb5, 0x03,
+	0x35, 0xa2, 0x01, 0x32, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65,
+	0x49, 0x6e, 0x64, 0x65, 0x78, 0x3a, 0x75, 0x69, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x5f, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x3b, 0x73, 0x69, 0x7a,
+	0x65, 0x3a, 0x32, 0x35, 0x35, 0x22, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x12, 0x4c, 0x0a, 0x08, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x04, 0x42, 0x30, 0xca, 0xb5, 0x03, 0x2c, 0xa2, 0x01, 0x29, 0x67, 0x6f, 0x72, 0x6d, 0x3a,
+	0x22, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x3a, 0x75, 0x69, 0x64,
+	0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65,
+	0x5f, 0x69, 0x64, 0x22, 0x52, 0x08, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x49, 0x44, 0x12, 0x20,
+	0x0a, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6f, 0x67, 0x69,
+	0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x22, 0x46,
+	0x0a, 0x10, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69,
+	0x65, 0x73, 0x12, 0x32, 0x0a, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x61, 0x67, 0x2e, 0x52, 0x65, 0x6d, 0x6f,
+	0x74, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x0a, 0x69, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x22, 0x33, 0x0a, 0x15, 0x55, 0x6e, 0x6c, 0x69, 0x6e, 0x6b,
+	0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x22, 0xf4, 0x03, 0x0a, 0x05,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x4c, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x38, 0xca, 0xb5, 0x03, 0x34, 0xa2, 0x01, 0x31, 0x67, 0x6f, 0x72, 0x6d,
+	0x3a, 0x22, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x3a, 0x69, 0x64,
+	0x78, 0x5f, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x3b, 0x73, 0x69, 0x7a, 0x65, 0x3a, 0x32, 0x35, 0x35, 0x22, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x4b, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2f, 0xca, 0xb5, 0x03, 0x2b, 0xa2, 0x01, 0x28, 0x67, 0x6f,
+	0x72, 0x6d, 0x3a, 0x22, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x3a,
+	0x69, 0x64, 0x78, 0x5f, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44,
+	0x12, 0x16, 0x0a, 0x06, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x06, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x12, 0x2d, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x44, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73,
+	0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x55, 0x73, 0x65, 0x72,
+	0x42, 0x24, 0xca, 0xb5, 0x03, 0x20, 0xa2, 0x01, 0x1d, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x6d,
+	0x61, 0x6e, 0x79, 0x32, 0x6d, 0x61, 0x6e, 0x79, 0x3a, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x75,
+	0x73, 0x65, 0x72, 0x73, 0x3b, 0x22, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x12, 0x30, 0x0a,
+	0x0b, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x07, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x67, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x0b, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12,
+	0x49, 0x0a, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x03, 0x42, 0x2b, 0xca, 0xb5, 0x03, 0x27, 0x1a, 0x09, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0xa2, 0x01, 0x19, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x6e, 0x6f, 0x74, 0x20,
+	0x6e, 0x75, 0x6c, 0x6c, 0x3b, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x3a, 0x30, 0x22, 0x52,
+	0x09, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x36, 0x0a, 0x0b, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x45, 0x4e,
+	0x44, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x41, 0x50, 0x50, 0x52, 0x4f, 0x56,
+	0x45, 0x44, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x49, 0x4e, 0x56, 0x49, 0x54, 0x49, 0x4e, 0x47,
+	0x10, 0x02, 0x22, 0x2b, 0x0a, 0x06, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x21, 0x0a, 0x06,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61,
+	0x67, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x22,
+	0x87, 0x03, 0x0a, 0x0f, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x02, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12,
+	0x2d, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x42, 0x13, 0xca, 0xb5, 0x03, 0x0f, 0xa2, 0x01, 0x0c, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x22, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x12, 0x16,
+	0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65,
+	0x72, 0x49, 0x44, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x69, 0x6e, 0x76, 0x69, 0x74,
+	0x65, 0x72, 0x49, 0x44, 0x12, 0x32, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49,
+	0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x12, 0x2d, 0x0a, 0x09,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x0f, 0xca, 0xb5, 0x03, 0x0b, 0xa2, 0x01, 0x08, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x2d, 0x22,
+	0x52, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x4c, 0x0a, 0x06, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47,
+	0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x41, 0x43, 0x43, 0x45, 0x50, 0x54, 0x45, 0x44, 0x10, 0x01,
+	0x12, 0x0c, 0x0a, 0x08, 0x44, 0x45, 0x43, 0x4c, 0x49, 0x4e, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0b,
+	0x0a, 0x07, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44, 0x10, 0x03, 0x12, 0x0c, 0x0a, 0x08, 0x43,
+	0x41, 0x4e, 0x43, 0x45, 0x4c, 0x45, 0x44, 0x10, 0x04, 0x22, 0x49, 0x0a, 0x10, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x35, 0x0a,
+	0x0b, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x6e, 0x76,
+	0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x22, 0x8d, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
+	0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x62, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x6e, 0x61, 0x6d, 0x65, 0x50, 0x72, 0x65, 0x66,
+	0x69, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x61, 0x6d, 0x65, 0x50, 0x72,
+	0x65, 0x66, 0x69, 0x78, 0x22, 0x51, 0x0a, 0x14, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x21, 0x0a, 0x06,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61,
+	0x67, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12,
+	0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x22, 0x85, 0x01, 0x0a, 0x06, 0x54, 0x65, 0x6e, 0x61,
+	0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02,
+	0x49, 0x44, 0x12, 0x2d, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x19, 0xca, 0xb5, 0x03, 0x15, 0xa2, 0x01, 0x12, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x75,
+	0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x20, 0x0a,
+	0x0b, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x55, 0x52, 0x4c, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x55, 0x52, 0x4c, 0x22,
+	0x2f, 0x0a, 0x07, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x24, 0x0a, 0x07, 0x74, 0x65,
+	0x6e, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x61, 0x67,
+	0x2e, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x07, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x73,
+	0x22, 0x47, 0x0a, 0x11, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49,
+	0x44, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0xc3, 0x0c, 0x0a, 0x06, 0x43, 0x6f,
+	0x75, 0x72, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x02, 0x49, 0x44, 0x12, 0x28, 0x0a, 0x0f, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x63,
+	0x6f, 0x75, 0x72, 0x73, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x44, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x79, 0x65, 0x61, 0x72, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x79, 0x65, 0x61, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61,
+	0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x1a, 0x0a, 0x08,
+	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x26, 0x0a, 0x0e, 0x6f, 0x72, 0x67, 0x61,
+	0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44,
+	0x12, 0x2a, 0x0a, 0x10, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x50, 0x61, 0x74, 0x68, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x6f, 0x72, 0x67, 0x61,
+	0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x74, 0x68, 0x12, 0x1a, 0x0a, 0x08,
+	0x73, 0x6c, 0x69, 0x70, 0x44, 0x61, 0x79, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08,
+	0x73, 0x6c, 0x69, 0x70, 0x44, 0x61, 0x79, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x6f, 0x63, 0x6b,
+	0x65, 0x72, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x6f,
+	0x63, 0x6b, 0x65, 0x72, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x46, 0x0a, 0x08, 0x65, 0x6e, 0x72, 0x6f,
+	0x6c, 0x6c, 0x65, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x61, 0x67, 0x2e,
+	0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x0f, 0xca, 0xb5, 0x03, 0x0b, 0xa2, 0x01, 0x08, 0x67, 0x6f,
+	0x72, 0x6d, 0x3a, 0x22, 0x2d, 0x22, 0x52, 0x08, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x64,
+	0x12, 0x30, 0x0a, 0x0b, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18,
+	0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x67, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c,
+	0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x12, 0x30, 0x0a, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x67, 0x2e, 0x41, 0x73, 0x73,
+	0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x12, 0x21, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x0f,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52,
+	0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x72, 0x6f, 0x70, 0x4c,
+	0x6f, 0x77, 0x65, 0x73, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x64, 0x72, 0x6f,
+	0x70, 0x4c, 0x6f, 0x77, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x13, 0x66, 0x69, 0x6e, 0x61, 0x6c,
+	0x47, 0x72, 0x61, 0x64, 0x65, 0x73, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x18, 0x11,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x47, 0x72, 0x61, 0x64, 0x65,
+	0x73, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x70, 0x70,
+	0x65, 0x61, 0x6c, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0c, 0x61, 0x70, 0x70, 0x65, 0x61, 0x6c, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x2e, 0x0a,
+	0x12, 0x61, 0x70, 0x70, 0x65, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44,
+	0x61, 0x79, 0x73, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x61, 0x70, 0x70, 0x65, 0x61,
+	0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x61, 0x79, 0x73, 0x12, 0x22, 0x0a,
+	0x0c, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x18, 0x14, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0c, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65,
+	0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x50, 0x6f, 0x6f, 0x6c, 0x18,
+	0x15, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x50, 0x6f, 0x6f,
+	0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x18, 0x16, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x08, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x12, 0x38, 0x0a,
+	0x0c, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x74, 0x75, 0x70, 0x18, 0x17, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x61, 0x67, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x2e,
+	0x53, 0x65, 0x74, 0x75, 0x70, 0x53, 0x74, 0x65, 0x70, 0x52, 0x0c, 0x70, 0x65, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x53, 0x65, 0x74, 0x75, 0x70, 0x12, 0x50, 0x0a, 0x13, 0x61, 0x73, 0x73, 0x69, 0x73,
+	0x74, 0x61, 0x6e, 0x74, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x18,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x61, 0x67, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65,
+	0x2e, 0x41, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x13, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x50,
+	0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x12, 0x61, 0x75, 0x74,
+	0x6f, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x73, 0x18,
+	0x19, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x61, 0x75, 0x74, 0x6f, 0x41, 0x70, 0x70, 0x72, 0x6f,
+	0x76, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x73, 0x12, 0x2c, 0x0a, 0x11, 0x61, 0x75, 0x74,
+	0x6f, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x52, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x18, 0x1a,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x61, 0x75, 0x74, 0x6f, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76,
+	0x65, 0x52, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x12, 0x22, 0x0a, 0x0c, 0x6d, 0x69, 0x6e, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x6d,
+	0x69, 0x6e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x6d,
+	0x61, 0x78, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x1c, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x69, 0x7a, 0x65, 0x12,
+	0x20, 0x0a, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x18, 0x1d,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f,
+	0x6b, 0x12, 0x30, 0x0a, 0x13, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13,
+	0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x54, 0x65, 0x6d, 0x70, 0x6c,
+	0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x70, 0x6f, 0x18,
+	0x1f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x70, 0x6f, 0x12,
+	0x28, 0x0a, 0x0f, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65,
+	0x70, 0x6f, 0x18, 0x20, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x65, 0x73,
+	0x74, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x18, 0x21, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x65,
+	0x73, 0x74, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x12, 0x26, 0x0a, 0x0e, 0x61, 0x73, 0x73, 0x69, 0x67,
+	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x44, 0x69, 0x72, 0x18, 0x22, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0e, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x44, 0x69, 0x72, 0x12,
+	0x1a, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x23, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x6d,
+	0x69, 0x6e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x24, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x12, 0x2f, 0x0a,
+	0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x25, 0x20, 0x01, 0x28, 0x04, 0x42,
+	0x13, 0xca, 0xb5, 0x03, 0x0f, 0xa2, 0x01, 0x0c, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x22, 0x52, 0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x44, 0x22, 0x60,
+	0x0a, 0x09, 0x53, 0x65, 0x74, 0x75, 0x70, 0x53, 0x74, 0x65, 0x70, 0x12, 0x0c, 0x0a, 0x08, 0x43,
+	0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x4f, 0x52, 0x47,
+	0x41, 0x4e, 0x49, 0x5a, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x52,
+	0x45, 0x50, 0x4f, 0x53, 0x49, 0x54, 0x4f, 0x52, 0x49, 0x45, 0x53, 0x10, 0x02, 0x12, 0x09, 0x0a,
+	0x05, 0x54, 0x45, 0x41, 0x4d, 0x53, 0x10, 0x03, 0x12, 0x16, 0x0a, 0x12, 0x43, 0x52, 0x45, 0x41,
+	0x54, 0x4f, 0x52, 0x5f, 0x52, 0x45, 0x50, 0x4f, 0x53, 0x49, 0x54, 0x4f, 0x52, 0x59, 0x10, 0x04,
+	0x22, 0x4d, 0x0a, 0x13, 0x41, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x50, 0x65, 0x72,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x12, 0x52, 0x45, 0x56, 0x49, 0x45,
+	0x57, 0x5f, 0x41, 0x4e, 0x44, 0x5f, 0x41, 0x50, 0x50, 0x52, 0x4f, 0x56, 0x45, 0x10, 0x00, 0x12,
+	0x0f, 0x0a, 0x0b, 0x52, 0x45, 0x56, 0x49, 0x45, 0x57, 0x5f, 0x4f, 0x4e, 0x4c, 0x59, 0x10, 0x01,
+	0x12, 0x0d, 0x0a, 0x09, 0x56, 0x49, 0x45, 0x57, 0x5f, 0x4f, 0x4e, 0x4c, 0x59, 0x10, 0x02, 0x22,
+	0xb8, 0x01, 0x0a, 0x14, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x75,
+	0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x49, 0x44, 0x12, 0x4d, 0x0a, 0x0c, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f,
+	0x72, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x29, 0x2e, 0x61, 0x67, 0x2e,
+	0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x41,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72,
+	0x69, 0x65, 0x73, 0x22, 0x35, 0x0a, 0x10, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72,
+	0x79, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x08, 0x0a, 0x04, 0x4b, 0x45, 0x45, 0x50, 0x10,
+	0x00, 0x12, 0x0b, 0x0a, 0x07, 0x41, 0x52, 0x43, 0x48, 0x49, 0x56, 0x45, 0x10, 0x01, 0x12, 0x0a,
+	0x0a, 0x06, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x10, 0x02, 0x22, 0x54, 0x0a, 0x12, 0x43, 0x6c,
+	0x6f, 0x6e, 0x65, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x06,
+	0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x61,
+	0x67, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x06, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65,
+	0x22, 0x78, 0x0a, 0x12, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x50, 0x6c, 0x61, 0x6e, 0x12, 0x30, 0x0a, 0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x61, 0x67, 0x2e,
+	0x53, 0x43, 0x4d, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x6f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x22, 0xcd, 0x02, 0x0a, 0x0c, 0x53,
+	0x43, 0x4d, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x04, 0x73,
+	0x74, 0x65, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x61, 0x67, 0x2e, 0x43,
+	0x6f, 0x75, 0x72, 0x73, 0x65, 0x2e, 0x53, 0x65, 0x74, 0x75, 0x70, 0x53, 0x74, 0x65, 0x70, 0x52,
+	0x04, 0x73, 0x74, 0x65, 0x70, 0x12, 0x29, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x61, 0x67, 0x2e, 0x53, 0x43, 0x4d, 0x4f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64,
+	0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x20, 0x0a, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18,
+	0x0a, 0x07, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x22, 0x8f, 0x01, 0x0a, 0x04, 0x4b, 0x69, 0x6e,
+	0x64, 0x12, 0x17, 0x0a, 0x13, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x4f, 0x52, 0x47, 0x41,
+	0x4e, 0x49, 0x5a, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x43, 0x52,
+	0x45, 0x41, 0x54, 0x45, 0x5f, 0x48, 0x4f, 0x4f, 0x4b, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x43,
+	0x52, 0x45, 0x41, 0x54, 0x45, 0x5f, 0x52, 0x45, 0x50, 0x4f, 0x53, 0x49, 0x54, 0x4f, 0x52, 0x59,
+	0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x5f, 0x54, 0x45, 0x41,
+	0x4d, 0x10, 0x03, 0x12, 0x17, 0x0a, 0x13, 0x41, 0x44, 0x44, 0x5f, 0x54, 0x45, 0x41, 0x4d, 0x5f,
+	0x52, 0x45, 0x50, 0x4f, 0x53, 0x49, 0x54, 0x4f, 0x52, 0x59, 0x10, 0x04, 0x12, 0x1c, 0x0a, 0x18,
+	0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x52, 0x45, 0x50, 0x4f, 0x53, 0x49, 0x54, 0x4f, 0x52,
+	0x59, 0x5f, 0x41, 0x43, 0x43, 0x45, 0x53, 0x53, 0x10, 0x05, 0x22, 0x9e, 0x03, 0x0a, 0x0b, 0x43,
+	0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x4c, 0x0a, 0x08, 0x63, 0x6f,
+	0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x30, 0xca, 0xb5,
+	0x03, 0x2c, 0xa2, 0x01, 0x29, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x75, 0x6e, 0x69, 0x71, 0x75,
+	0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x3a, 0x69, 0x64, 0x78, 0x5f, 0x75, 0x6e, 0x69, 0x71, 0x75,
+	0x65, 0x5f, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x22, 0x52, 0x08,
+	0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x48, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x30, 0xca, 0xb5, 0x03, 0x2c, 0xa2, 0x01,
+	0x29, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x6e, 0x64,
+	0x65, 0x78, 0x3a, 0x69, 0x64, 0x78, 0x5f, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x63, 0x65,
+	0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x22, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x4e, 0x61,
+	0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x61, 0x64, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x67, 0x72, 0x61, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x73,
+	0x73, 0x75, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x73, 0x73, 0x75,
+	0x65, 0x64, 0x12, 0x36, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x22, 0xca, 0xb5, 0x03, 0x1e, 0xa2, 0x01, 0x1b, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x75,
+	0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x3b, 0x73, 0x69, 0x7a, 0x65, 0x3a,
+	0x32, 0x35, 0x35, 0x22, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69,
+	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x21, 0x0a, 0x03, 0x55, 0x52, 0x4c, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0f, 0xca, 0xb5, 0x03, 0x0b, 0xa2, 0x01, 0x08, 0x67, 0x6f,
+	0x72, 0x6d, 0x3a, 0x22, 0x2d, 0x22, 0x52, 0x03, 0x55, 0x52, 0x4c, 0x22, 0x88, 0x01, 0x0a, 0x0e,
+	0x47, 0x72, 0x61, 0x64, 0x65, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x0e,
+	0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x1a,
+	0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x72,
+	0x61, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x67, 0x72, 0x61, 0x64, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x69, 0x6e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x70, 0x61, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x70,
+	0x61, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x22, 0x5e, 0x0a, 0x0c, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e,
+	0x67, 0x53, 0x63, 0x61, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65,
+	0x49, 0x44, 0x12, 0x32, 0x0a, 0x0a, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x61, 0x64,
+	0x65, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x52, 0x0a, 0x74, 0x68, 0x72, 0x65,
+	0x73, 0x68, 0x6f, 0x6c, 0x64, 0x73, 0x22, 0xe2, 0x01, 0x0a, 0x0a, 0x46, 0x69, 0x6e, 0x61, 0x6c,
+	0x47, 0x72, 0x61, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1c, 0x0a,
+	0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x61, 0x67,
+	0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x73,
+	0x63, 0x6f, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x61, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x67, 0x72, 0x61, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x73, 0x73, 0x65,
+	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x70, 0x61, 0x73, 0x73, 0x65, 0x64, 0x12,
+	0x2e, 0x0a, 0x12, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x04, 0x52, 0x12, 0x64, 0x72, 0x6f,
+	0x70, 0x70, 0x65, 0x64, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12,
+	0x2a, 0x0a, 0x10, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x4d, 0x61, 0x6e, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x79, 0x18, 0x07, 0x20, 0x03, 0x28, 0x04, 0x52, 0x10, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6e, 0x67, 0x4d, 0x61, 0x6e, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x79, 0x22, 0xd6, 0x02, 0x0a, 0x0a,
+	0x53, 0x63, 0x6f, 0x72, 0x65, 0x43, 0x75, 0x72, 0x76, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f,
+	0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x6f,
+	0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x3d, 0x0a, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e,
+	0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x19, 0xca, 0xb5,
+	0x03, 0x15, 0xa2, 0x01, 0x12, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x75, 0x6e, 0x69, 0x71, 0x75,
+	0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d,
+	0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x2d, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x61, 0x67, 0x2e, 0x53, 0x63, 0x6f, 0x72, 0x65,
+	0x43, 0x75, 0x72, 0x76, 0x65, 0x2e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x06, 0x6d, 0x65,
+	0x74, 0x68, 0x6f, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x6c, 0x6f, 0x70, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x05, 0x73, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66,
+	0x66, 0x73, 0x65, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73,
+	0x65, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x69, 0x6e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x6d, 0x61, 0x78, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x08, 0x6d, 0x61, 0x78, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x70,
+	0x70, 0x6c, 0x69, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x70, 0x70,
+	0x6c, 0x69, 0x65, 0x64, 0x22, 0x2e, 0x0a, 0x06, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x08,
+	0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x4c, 0x49, 0x4e, 0x45,
+	0x41, 0x52, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x50, 0x45, 0x52, 0x43, 0x45, 0x4e, 0x54, 0x49,
+	0x4c, 0x45, 0x10, 0x02, 0x22, 0x71, 0x0a, 0x0b, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x47, 0x72, 0x61,
+	0x64, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12,
+	0x26, 0x0a, 0x06, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0e, 0x2e, 0x61, 0x67, 0x2e, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x47, 0x72, 0x61, 0x64, 0x65, 0x52,
+	0x06, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x75,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6d,
+	0x70, 0x75, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xa1, 0x01, 0x0a, 0x10, 0x43, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08,
+	0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08,
+	0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b, 0x6d, 0x69, 0x6e, 0x41,
+	0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6d,
+	0x69, 0x6e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x61,
+	0x6e, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x03, 0x20, 0x03, 0x28, 0x04, 0x52, 0x09, 0x6d,
+	0x61, 0x6e, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x31, 0x0a, 0x08, 0x73, 0x74, 0x75, 0x64,
+	0x65, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x61, 0x67, 0x2e,
+	0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x08, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x22, 0xa9, 0x01, 0x0a, 0x11,
+	0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x2e, 0x0a, 0x0a, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x67, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c,
+	0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e,
+	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x08, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x12, 0x2a, 0x0a,
+	0x10, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x4d, 0x61, 0x6e, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x79, 0x18, 0x03, 0x20, 0x03, 0x28, 0x04, 0x52, 0x10, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67,
+	0x4d, 0x61, 0x6e, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d,
+	0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x63, 0x6f,
+	0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x22, 0xae, 0x01, 0x0a, 0x14, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x73, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x37, 0x0a, 0x06,
+	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x61,
+	0x67, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x66,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x69, 0x6e,
+	0x63, 0x6c, 0x75, 0x64, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x22, 0x1b, 0x0a, 0x06, 0x46,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x07, 0x0a, 0x03, 0x43, 0x53, 0x56, 0x10, 0x00, 0x12, 0x08,
+	0x0a, 0x04, 0x58, 0x4c, 0x53, 0x58, 0x10, 0x01, 0x22, 0x67, 0x0a, 0x0d, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x73, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x22, 0x81, 0x01, 0x0a, 0x0d, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12,
+	0x30, 0x0a, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x67, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x12, 0x22, 0x0a, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0e, 0x2e, 0x61, 0x67, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x52, 0x6f, 0x77, 0x52,
+	0x04, 0x72, 0x6f, 0x77, 0x73, 0x22, 0x8f, 0x01, 0x0a, 0x0a, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x73, 0x52, 0x6f, 0x77, 0x12, 0x2e, 0x0a, 0x0a, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65,
+	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x67, 0x2e, 0x45, 0x6e,
+	0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c,
+	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x05,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x30, 0x0a, 0x0b, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x67, 0x2e,
+	0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x73, 0x75, 0x62, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x6a, 0x0a, 0x10, 0x43, 0x6f, 0x75, 0x72, 0x73,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x63,
+	0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63,
+	0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x3a, 0x0a, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x67,
+	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61,
+	0x67, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x52, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x22, 0xbe, 0x02, 0x0a, 0x14, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65,
+	0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x22, 0x0a, 0x0c,
+	0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x73, 0x75, 0x62, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76,
+	0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76,
+	0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x52, 0x61, 0x74, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x52, 0x61, 0x74, 0x65, 0x12, 0x22,
+	0x0a, 0x0c, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x53, 0x63, 0x6f,
+	0x72, 0x65, 0x12, 0x2c, 0x0a, 0x11, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x44, 0x69, 0x73, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x11, 0x73,
+	0x63, 0x6f, 0x72, 0x65, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x42, 0x0a, 0x11, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x50,
+	0x65, 0x72, 0x44, 0x61, 0x79, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x67,
+	0x2e, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x11, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x50, 0x65,
+	0x72, 0x44, 0x61, 0x79, 0x22, 0x82, 0x01, 0x0a, 0x10, 0x54, 0x65, 0x73, 0x74, 0x46, 0x61, 0x69,
+	0x6c, 0x75, 0x72, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x73, 0x73,
+	0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x20, 0x0a,
+	0x0b, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0b, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x28, 0x0a, 0x05, 0x74, 0x65, 0x73, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x61, 0x67, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69,
+	0x63, 0x73, 0x52, 0x05, 0x74, 0x65, 0x73, 0x74, 0x73, 0x22, 0x90, 0x01, 0x0a, 0x0e, 0x54, 0x65,
+	0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x73, 0x73, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x06, 0x70, 0x61, 0x73, 0x73, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x61, 0x69, 0x6c,
+	0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64,
+	0x12, 0x18, 0x0a, 0x07, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x07, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x66, 0x61,
+	0x69, 0x6c, 0x75, 0x72, 0x65, 0x52, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x0b, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x52, 0x61, 0x74, 0x65, 0x22, 0x3c, 0x0a, 0x10,
+	0x44, 0x61, 0x69, 0x6c, 0x79, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x64, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x3c, 0x0a, 0x0c, 0x55, 0x73,
+	0x65, 0x72, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x2c, 0x0a, 0x07, 0x63, 0x6f,
+	0x75, 0x72, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x61, 0x67,
+	0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52,
+	0x07, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x22, 0xde, 0x02, 0x0a, 0x0e, 0x43, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x63,
+	0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63,
+	0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x20, 0x0a, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x12, 0x30, 0x0a, 0x13, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x41, 0x73, 0x73,
+	0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13,
+	0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x63, 0x6f, 0x72,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x63,
+	0x6f, 0x72, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x75, 0x73, 0x65, 0x64, 0x53, 0x6c, 0x69, 0x70, 0x44,
+	0x61, 0x79, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x75, 0x73, 0x65, 0x64, 0x53,
+	0x6c, 0x69, 0x70, 0x44, 0x61, 0x79, 0x73, 0x12, 0x2c, 0x0a, 0x11, 0x72, 0x65, 0x6d, 0x61, 0x69,
+	0x6e, 0x69, 0x6e, 0x67, 0x53, 0x6c, 0x69, 0x70, 0x44, 0x61, 0x79, 0x73, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x11, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x53, 0x6c, 0x69,
+	0x70, 0x44, 0x61, 0x79, 0x73, 0x12, 0x42, 0x0a, 0x11, 0x75, 0x70, 0x63, 0x6f, 0x6d, 0x69, 0x6e,
+	0x67, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x61, 0x67, 0x2e, 0x55, 0x70, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x44, 0x65,
+	0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x11, 0x75, 0x70, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67,
+	0x44, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x22, 0xc8, 0x01, 0x0a, 0x10, 0x55, 0x70,
+	0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x22,
+	0x0a, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
+	0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69,
+	0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69,
+	0x6e, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4c, 0x61, 0x62,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4c,
+	0x61, 0x62, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x12, 0x24,
+	0x0a, 0x0d, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x44, 0x65, 0x61, 0x64,
+	0x6c, 0x69, 0x6e, 0x65, 0x22, 0x2f, 0x0a, 0x07, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x12,
+	0x24, 0x0a, 0x07, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0a, 0x2e, 0x61, 0x67, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x07, 0x63, 0x6f,
+	0x75, 0x72, 0x73, 0x65, 0x73, 0x22, 0xe8, 0x03, 0x0a, 0x0a, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x6f, 0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x02, 0x49, 0x44, 0x12, 0x52, 0x0a, 0x0e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2a, 0xca, 0xb5,
+	0x03, 0x26, 0xa2, 0x01, 0x23, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x75, 0x6e, 0x69, 0x71, 0x75,
+	0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x3a, 0x75, 0x69, 0x64, 0x5f, 0x67, 0x69, 0x64, 0x5f, 0x6f,
+	0x72, 0x67, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x22, 0x52, 0x0e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69,
+	0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c,
+	0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x49, 0x44, 0x12, 0x42, 0x0a, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2a, 0xca, 0xb5,
+	0x03, 0x26, 0xa2, 0x01, 0x23, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x75, 0x6e, 0x69, 0x71, 0x75,
+	0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x3a, 0x75, 0x69, 0x64, 0x5f, 0x67, 0x69, 0x64, 0x5f, 0x6f,
+	0x72, 0x67, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x22, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x12, 0x44, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x04, 0x42, 0x2a, 0xca, 0xb5, 0x03, 0x26, 0xa2, 0x01, 0x23, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22,
+	0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x3a, 0x75, 0x69, 0x64, 0x5f,
+	0x67, 0x69, 0x64, 0x5f, 0x6f, 0x72, 0x67, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x22, 0x52, 0x07, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x48, 0x54, 0x4d, 0x4c, 0x55, 0x52,
+	0x4c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x48, 0x54, 0x4d, 0x4c, 0x55, 0x52, 0x4c,
+	0x12, 0x5b, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x54, 0x79, 0x70, 0x65, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x13, 0x2e, 0x61, 0x67, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f,
+	0x72, 0x79, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x42, 0x2a, 0xca, 0xb5, 0x03, 0x26, 0xa2, 0x01, 0x23,
+	0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x6e, 0x64, 0x65,
+	0x78, 0x3a, 0x75, 0x69, 0x64, 0x5f, 0x67, 0x69, 0x64, 0x5f, 0x6f, 0x72, 0x67, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x22, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x54, 0x79, 0x70, 0x65, 0x22, 0x51, 0x0a,
+	0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12,
+	0x0e, 0x0a, 0x0a, 0x43, 0x4f, 0x55, 0x52, 0x53, 0x45, 0x49, 0x4e, 0x46, 0x4f, 0x10, 0x01, 0x12,
+	0x0f, 0x0a, 0x0b, 0x41, 0x53, 0x53, 0x49, 0x47, 0x4e, 0x4d, 0x45, 0x4e, 0x54, 0x53, 0x10, 0x02,
+	0x12, 0x09, 0x0a, 0x05, 0x54, 0x45, 0x53, 0x54, 0x53, 0x10, 0x03, 0x12, 0x08, 0x0a, 0x04, 0x55,
+	0x53, 0x45, 0x52, 0x10, 0x04, 0x12, 0x09, 0x0a, 0x05, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x10, 0x05,
+	0x22, 0xdc, 0x06, 0x0a, 0x0a, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12,
+	0x4b, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x42, 0x2f, 0xca, 0xb5, 0x03, 0x2b, 0xa2, 0x01, 0x28, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22,
+	0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x3a, 0x69, 0x64, 0x78, 0x5f,
+	0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e,
+	0x74, 0x22, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x47, 0x0a, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2f, 0xca, 0xb5,
+	0x03, 0x2b, 0xa2, 0x01, 0x28, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x75, 0x6e, 0x69, 0x71, 0x75,
+	0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x3a, 0x69, 0x64, 0x78, 0x5f, 0x75, 0x6e, 0x69, 0x71, 0x75,
+	0x65, 0x5f, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x12,
+	0x2a, 0x0a, 0x10, 0x68, 0x61, 0x73, 0x54, 0x65, 0x61, 0x63, 0x68, 0x65, 0x72, 0x53, 0x63, 0x6f,
+	0x70, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x68, 0x61, 0x73, 0x54, 0x65,
+	0x61, 0x63, 0x68, 0x65, 0x72, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x04, 0x75,
+	0x73, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x55,
+	0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x22, 0x0a, 0x06, 0x63, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x61, 0x67, 0x2e, 0x43,
+	0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x06, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x12, 0x1f, 0x0a,
+	0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61,
+	0x67, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x31,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19,
+	0x2e, 0x61, 0x67, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x55,
+	0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x31, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x1b, 0x2e, 0x61, 0x67, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74,
+	0x2e, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x12, 0x3d, 0x0a, 0x11, 0x73, 0x6c, 0x69, 0x70, 0x44, 0x61, 0x79, 0x73,
+	0x52, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x42,
+	0x0f, 0xca, 0xb5, 0x03, 0x0b, 0xa2, 0x01, 0x08, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x2d, 0x22,
+	0x52, 0x11, 0x73, 0x6c, 0x69, 0x70, 0x44, 0x61, 0x79, 0x73, 0x52, 0x65, 0x6d, 0x61, 0x69, 0x6e,
+	0x69, 0x6e, 0x67, 0x12, 0x2a, 0x0a, 0x10, 0x6c, 0x61, 0x73, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76,
+	0x69, 0x74, 0x79, 0x44, 0x61, 0x74, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x6c,
+	0x61, 0x73, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x44, 0x61, 0x74, 0x65, 0x12,
+	0x24, 0x0a, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x70, 0x70,
+	0x72, 0x6f, 0x76, 0x65, 0x64, 0x12, 0x34, 0x0a, 0x0c, 0x75, 0x73, 0x65, 0x64, 0x53, 0x6c, 0x69,
+	0x70, 0x44, 0x61, 0x79, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x61, 0x67,
+	0x2e, 0x55, 0x73, 0x65, 0x64, 0x53, 0x6c, 0x69, 0x70, 0x44, 0x61, 0x79, 0x73, 0x52, 0x0c, 0x75,
+	0x73, 0x65, 0x64, 0x53, 0x6c, 0x69, 0x70, 0x44, 0x61, 0x79, 0x73, 0x12, 0x49, 0x0a, 0x09, 0x64,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x03, 0x42, 0x2b,
+	0xca, 0xb5, 0x03, 0x27, 0x1a, 0x09, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0xa2,
+	0x01, 0x19, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x6e, 0x6f, 0x74, 0x20, 0x6e, 0x75, 0x6c, 0x6c,
+	0x3b, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x3a, 0x30, 0x22, 0x52, 0x09, 0x64, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x45, 0x0a, 0x0a, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x0b,
+	0x0a, 0x07, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x53,
+	0x54, 0x55, 0x44, 0x45, 0x4e, 0x54, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x54, 0x45, 0x41, 0x43,
+	0x48, 0x45, 0x52, 0x10, 0x03, 0x12, 0x06, 0x0a, 0x02, 0x54, 0x41, 0x10, 0x04, 0x22, 0x40, 0x0a,
+	0x0c, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x09, 0x0a,
+	0x05, 0x55, 0x4e, 0x53, 0x45, 0x54, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x48, 0x49, 0x44, 0x44,
+	0x45, 0x4e, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x56, 0x49, 0x53, 0x49, 0x42, 0x4c, 0x45, 0x10,
+	0x02, 0x12, 0x0c, 0x0a, 0x08, 0x46, 0x41, 0x56, 0x4f, 0x52, 0x49, 0x54, 0x45, 0x10, 0x03, 0x22,
+	0x8a, 0x01, 0x0a, 0x0c, 0x55, 0x73, 0x65, 0x64, 0x53, 0x6c, 0x69, 0x70, 0x44, 0x61, 0x79, 0x73,
+	0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44,
+	0x12, 0x22, 0x0a, 0x0c, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65,
+	0x6e, 0x74, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65,
+	0x6e, 0x74, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x69,
+	0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x75, 0x73, 0x65, 0x64,
+	0x53, 0x6c, 0x69, 0x70, 0x44, 0x61, 0x79, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c,
+	0x75, 0x73, 0x65, 0x64, 0x53, 0x6c, 0x69, 0x70, 0x44, 0x61, 0x79, 0x73, 0x22, 0x45, 0x0a, 0x0f,
+	0x53, 0x6c, 0x69, 0x70, 0x44, 0x61, 0x79, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x22, 0xbe, 0x01, 0x0a, 0x08, 0x53, 0x6c, 0x69, 0x70, 0x44, 0x61, 0x79, 0x73,
+	0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x75, 0x73, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x75, 0x73, 0x65, 0x64,
+	0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x09, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x34,
+	0x0a, 0x0c, 0x75, 0x73, 0x65, 0x64, 0x53, 0x6c, 0x69, 0x70, 0x44, 0x61, 0x79, 0x73, 0x18, 0x06,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x61, 0x67, 0x2e, 0x55, 0x73, 0x65, 0x64, 0x53, 0x6c,
+	0x69, 0x70, 0x44, 0x61, 0x79, 0x73, 0x52, 0x0c, 0x75, 0x73, 0x65, 0x64, 0x53, 0x6c, 0x69, 0x70,
+	0x44, 0x61, 0x79, 0x73, 0x22, 0x65, 0x0a, 0x0b, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x12, 0x30, 0x0a, 0x0b, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x67, 0x2e, 0x45, 0x6e,
+	0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67,
+	0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65,
+	0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xb2, 0x01, 0x0a, 0x0d,
+	0x50, 0x72, 0x65, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x2f, 0x0a,
+	0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42,
+	0x13, 0xca, 0xb5, 0x03, 0x0f, 0xa2, 0x01, 0x0c, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x22, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x1c,
+	0x0a, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05,
+	0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61,
+	0x69, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x22, 0x70, 0x0a, 0x0e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x69,
+	0x6e, 0x6b, 0x12, 0x2e, 0x0a, 0x0a, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x67, 0x2e, 0x41, 0x73, 0x73, 0x69,
+	0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65,
+	0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x0a, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x67, 0x2e, 0x53, 0x75, 0x62, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x22, 0x76, 0x0a, 0x0e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74,
+	0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x2e, 0x0a, 0x0a, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x67, 0x2e, 0x45, 0x6e,
+	0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c,
+	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x34, 0x0a, 0x0b, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x61, 0x67, 0x2e, 0x53,
+	0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x0b, 0x73,
+	0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x61, 0x0a, 0x11, 0x43, 0x6f,
+	0x75, 0x72, 0x73, 0x65, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x22, 0x0a, 0x06, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0a, 0x2e, 0x61, 0x67, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x06, 0x63, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x05, 0x6c, 0x69, 0x6e, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x61, 0x67, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65,
+	0x6e, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x05, 0x6c, 0x69, 0x6e, 0x6b, 0x73, 0x22, 0xa3, 0x0b,
+	0x0a, 0x0a, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08,
+	0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08,
+	0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x75, 0x74, 0x6f,
+	0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x61,
+	0x75, 0x74, 0x6f, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4c, 0x61, 0x62, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4c, 0x61, 0x62,
+	0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74,
+	0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x73, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x09, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x73, 0x12, 0x30,
+	0x0a, 0x0b, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0b, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x67, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x42, 0x0a, 0x11, 0x67, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x65, 0x6e, 0x63, 0x68,
+	0x6d, 0x61, 0x72, 0x6b, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x67,
+	0x2e, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72,
+	0x6b, 0x52, 0x11, 0x67, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d,
+	0x61, 0x72, 0x6b, 0x73, 0x12, 0x2a, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65,
+	0x72, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10,
+	0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
+	0x12, 0x28, 0x0a, 0x0f, 0x74, 0x65, 0x73, 0x74, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x57, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x74, 0x65, 0x73, 0x74, 0x53,
+	0x63, 0x6f, 0x72, 0x65, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x67, 0x72,
+	0x61, 0x64, 0x65, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0b, 0x67, 0x72, 0x61, 0x64, 0x65, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1c, 0x0a, 0x09,
+	0x6d, 0x61, 0x6e, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x10, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x09, 0x6d, 0x61, 0x6e, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x75,
+	0x6e, 0x6e, 0x65, 0x72, 0x50, 0x6f, 0x6f, 0x6c, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x50, 0x6f, 0x6f, 0x6c, 0x12, 0x26, 0x0a, 0x0e, 0x6c, 0x61,
+	0x74, 0x65, 0x47, 0x72, 0x61, 0x63, 0x65, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x18, 0x12, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0e, 0x6c, 0x61, 0x74, 0x65, 0x47, 0x72, 0x61, 0x63, 0x65, 0x48, 0x6f, 0x75,
+	0x72, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x6c, 0x61, 0x74, 0x65, 0x50, 0x65, 0x6e, 0x61, 0x6c, 0x74,
+	0x79, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6c, 0x61, 0x74, 0x65, 0x50, 0x65, 0x6e,
+	0x61, 0x6c, 0x74, 0x79, 0x12, 0x26, 0x0a, 0x0e, 0x6c, 0x61, 0x74, 0x65, 0x43, 0x75, 0x74, 0x6f,
+	0x66, 0x66, 0x44, 0x61, 0x79, 0x73, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x6c, 0x61,
+	0x74, 0x65, 0x43, 0x75, 0x74, 0x6f, 0x66, 0x66, 0x44, 0x61, 0x79, 0x73, 0x12, 0x20, 0x0a, 0x0b,
+	0x70, 0x65, 0x65, 0x72, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x18, 0x15, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0b, 0x70, 0x65, 0x65, 0x72, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x12, 0x26,
+	0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x6d, 0x61, 0x67, 0x65,
+	0x18, 0x16, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65,
+	0x72, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x6f, 0x63, 0x6b, 0x65, 0x72,
+	0x66, 0x69, 0x6c, 0x65, 0x18, 0x17, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x6f, 0x63, 0x6b,
+	0x65, 0x72, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x70, 0x75, 0x4c, 0x69, 0x6d,
+	0x69, 0x74, 0x18, 0x18, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x63, 0x70, 0x75, 0x4c, 0x69, 0x6d,
+	0x69, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4c, 0x69, 0x6d, 0x69,
+	0x74, 0x18, 0x19, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4c,
+	0x69, 0x6d, 0x69, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x69, 0x64, 0x73, 0x4c, 0x69, 0x6d, 0x69,
+	0x74, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x70, 0x69, 0x64, 0x73, 0x4c, 0x69, 0x6d,
+	0x69, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x63, 0x61, 0x6e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x73, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x73, 0x63, 0x61, 0x6e, 0x53, 0x65, 0x63,
+	0x72, 0x65, 0x74, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x66, 0x6f, 0x72, 0x62, 0x69, 0x64, 0x64, 0x65,
+	0x6e, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x66, 0x6f,
+	0x72, 0x62, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x20, 0x0a, 0x0b,
+	0x6d, 0x61, 0x78, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x1d, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x2c,
+	0x0a, 0x11, 0x64, 0x69, 0x73, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x49, 0x6d, 0x70, 0x6f,
+	0x72, 0x74, 0x73, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x64, 0x69, 0x73, 0x61, 0x6c,
+	0x6c, 0x6f, 0x77, 0x65, 0x64, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x34, 0x0a, 0x15,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x56, 0x69, 0x6f, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x56, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x18, 0x20, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x18, 0x21, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08,
+	0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x6d, 0x69, 0x6e, 0x43,
+	0x6f, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x18, 0x22, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6d,
+	0x69, 0x6e, 0x43, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x75,
+	0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x23, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72,
+	0x75, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1e, 0x0a, 0x05, 0x74, 0x61, 0x73, 0x6b,
+	0x73, 0x18, 0x24, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x54, 0x61, 0x73,
+	0x6b, 0x52, 0x05, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x66, 0x65, 0x65, 0x64,
+	0x62, 0x61, 0x63, 0x6b, 0x49, 0x73, 0x73, 0x75, 0x65, 0x18, 0x25, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0d, 0x66, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x49, 0x73, 0x73, 0x75, 0x65, 0x12, 0x2a,
+	0x0a, 0x10, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x72, 0x61, 0x6e,
+	0x63, 0x68, 0x18, 0x26, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x65, 0x78,
+	0x61, 0x6d, 0x18, 0x27, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x65, 0x78, 0x61, 0x6d, 0x12, 0x1c,
+	0x0a, 0x09, 0x65, 0x78, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x72, 0x74, 0x18, 0x28, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x65, 0x78, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x35, 0x0a, 0x0d,
+	0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x29, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x0f, 0xca, 0xb5, 0x03, 0x0b, 0xa2, 0x01, 0x08, 0x67, 0x6f, 0x72, 0x6d,
+	0x3a, 0x22, 0x2d, 0x22, 0x52, 0x0d, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x44, 0x65, 0x61, 0x64, 0x6c,
+	0x69, 0x6e, 0x65, 0x22, 0x3f, 0x0a, 0x0b, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x12, 0x30, 0x0a, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x67, 0x2e, 0x41, 0x73, 0x73,
+	0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x22, 0x5c, 0x0a, 0x0e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65,
+	0x6e, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65,
+	0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x73, 0x22, 0x71, 0x0a, 0x13, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
+	0x46, 0x69, 0x6c, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x69, 0x6c,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x77,
+	0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x77, 0x61,
+	0x72, 0x6e, 0x69, 0x6e, 0x67, 0x22, 0x47, 0x0a, 0x14, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d,
+	0x65, 0x6e, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x2f, 0x0a,
+	0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x61, 0x67, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x69, 0x6c,
+	0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x22, 0x99,
+	0x01, 0x0a, 0x14, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73,
+	0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73,
+	0x65, 0x49, 0x44, 0x12, 0x30, 0x0a, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x67, 0x2e, 0x41, 0x73,
+	0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x33, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x67, 0x2e, 0x41, 0x73, 0x73,
+	0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x52, 0x08, 0x70, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x73, 0x22, 0xc7, 0x01, 0x0a, 0x04, 0x54,
+	0x61, 0x73, 0x6b, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x02, 0x49, 0x44, 0x12, 0x37, 0x0a, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e,
+	0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x13, 0xca, 0xb5, 0x03, 0x0f, 0xa2,
+	0x01, 0x0c, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x52, 0x0c,
+	0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x65, 0x73, 0x74,
+	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x65, 0x73, 0x74, 0x73, 0x12, 0x14,
+	0x0a, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x22, 0xe6, 0x01, 0x0a, 0x09, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x63, 0x6f,
+	0x72, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02,
+	0x49, 0x44, 0x12, 0x37, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x13, 0xca, 0xb5, 0x03, 0x0f, 0xa2, 0x01,
+	0x0c, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x52, 0x0c, 0x73,
+	0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x74,
+	0x61, 0x73, 0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74,
+	0x61, 0x73, 0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x61, 0x73, 0x6b, 0x54,
+	0x69, 0x74, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x61, 0x73, 0x6b,
+	0x54, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x70,
+	0x61, 0x73, 0x73, 0x65, 0x64, 0x54, 0x65, 0x73, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0b, 0x70, 0x61, 0x73, 0x73, 0x65, 0x64, 0x54, 0x65, 0x73, 0x74, 0x73, 0x12, 0x1e, 0x0a,
+	0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x54, 0x65, 0x73, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x54, 0x65, 0x73, 0x74, 0x73, 0x22, 0x3b, 0x0a,
+	0x0a, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x12, 0x2d, 0x0a, 0x0a, 0x74,
+	0x61, 0x73, 0x6b, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0d, 0x2e, 0x61, 0x67, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x0a,
+	0x74, 0x61, 0x73, 0x6b, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x22, 0xdd, 0x08, 0x0a, 0x0a, 0x53,
+	0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x41, 0x73, 0x73,
+	0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0c, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x16, 0x0a,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x12,
+	0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05,
+	0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x48,
+	0x61, 0x73, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65,
+	0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65,
+	0x64, 0x12, 0x2d, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x15, 0x2e, 0x61, 0x67, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x22, 0x0a, 0x0c, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64,
+	0x44, 0x61, 0x74, 0x65, 0x12, 0x24, 0x0a, 0x07, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x18,
+	0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x61, 0x67, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x52, 0x07, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x12, 0x2e, 0x0a, 0x09, 0x42, 0x75,
+	0x69, 0x6c, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e,
+	0x73, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x09, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x24, 0x0a, 0x06, 0x53, 0x63,
+	0x6f, 0x72, 0x65, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x73, 0x63, 0x6f,
+	0x72, 0x65, 0x2e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x06, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x73,
+	0x12, 0x25, 0x0a, 0x05, 0x67, 0x72, 0x61, 0x64, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x0f, 0xca, 0xb5, 0x03, 0x0b, 0xa2, 0x01, 0x08, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x2d, 0x22,
+	0x52, 0x05, 0x67, 0x72, 0x61, 0x64, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x6c, 0x65, 0x61,
+	0x73, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72,
+	0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x6c,
+	0x61, 0x74, 0x65, 0x50, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0b, 0x6c, 0x61, 0x74, 0x65, 0x50, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x12, 0x20, 0x0a,
+	0x0b, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x10, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0b, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12,
+	0x42, 0x0a, 0x0d, 0x65, 0x78, 0x63, 0x65, 0x65, 0x64, 0x65, 0x64, 0x4c, 0x69, 0x6d, 0x69, 0x74,
+	0x18, 0x11, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x61, 0x67, 0x2e, 0x53, 0x75, 0x62, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x45, 0x78, 0x63, 0x65, 0x65, 0x64, 0x65, 0x64, 0x4c,
+	0x69, 0x6d, 0x69, 0x74, 0x52, 0x0d, 0x65, 0x78, 0x63, 0x65, 0x65, 0x64, 0x65, 0x64, 0x4c, 0x69,
+	0x6d, 0x69, 0x74, 0x12, 0x3f, 0x0a, 0x10, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x56, 0x69, 0x6f,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x12, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e,
+	0x61, 0x67, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x56, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x10, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x56, 0x69, 0x6f, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x13, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x70, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f,
+	0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x63, 0x6f,
+	0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x12, 0x34, 0x0a, 0x0c, 0x66, 0x69, 0x6c, 0x65, 0x43, 0x6f,
+	0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x18, 0x15, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x61,
+	0x67, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x52, 0x0c,
+	0x66, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x12, 0x2a, 0x0a, 0x10,
+	0x66, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x49, 0x73, 0x73, 0x75, 0x65, 0x55, 0x52, 0x4c,
+	0x18, 0x16, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x66, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b,
+	0x49, 0x73, 0x73, 0x75, 0x65, 0x55, 0x52, 0x4c, 0x12, 0x22, 0x0a, 0x0c, 0x67, 0x72, 0x61, 0x64,
+	0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x17, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x67, 0x72, 0x61, 0x64, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x20, 0x0a, 0x0b,
+	0x70, 0x75, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x18, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0b, 0x70, 0x75, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24,
+	0x0a, 0x0d, 0x61, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x65, 0x64, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x18,
+	0x19, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x61, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x65, 0x64, 0x53,
+	0x63, 0x6f, 0x72, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x76, 0x65, 0x64, 0x18, 0x1a,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x63, 0x75, 0x72, 0x76, 0x65, 0x64, 0x22, 0x4b, 0x0a, 0x06,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00,
+	0x12, 0x0c, 0x0a, 0x08, 0x41, 0x50, 0x50, 0x52, 0x4f, 0x56, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0c,
+	0x0a, 0x08, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08,
+	0x52, 0x45, 0x56, 0x49, 0x53, 0x49, 0x4f, 0x4e, 0x10, 0x03, 0x12, 0x0d, 0x0a, 0x09, 0x49, 0x4e,
+	0x5f, 0x52, 0x45, 0x56, 0x49, 0x45, 0x57, 0x10, 0x04, 0x22, 0x3f, 0x0a, 0x0d, 0x45, 0x78, 0x63,
+	0x65, 0x65, 0x64, 0x65, 0x64, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x0c, 0x0a, 0x08, 0x4e, 0x4f,
+	0x5f, 0x4c, 0x49, 0x4d, 0x49, 0x54, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x54, 0x49, 0x4d, 0x45,
+	0x5f, 0x4c, 0x49, 0x4d, 0x49, 0x54, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x4d, 0x45, 0x4d, 0x4f,
+	0x52, 0x59, 0x5f, 0x4c, 0x49, 0x4d, 0x49, 0x54, 0x10, 0x02, 0x22, 0xda, 0x02, 0x0a, 0x14, 0x53,
+	0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x02, 0x49, 0x44, 0x12, 0x37, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x13, 0xca, 0xb5, 0x03, 0x0f, 0xa2,
+	0x01, 0x0c, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x52, 0x0c,
+	0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x12, 0x3d, 0x0a, 0x0e, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x61,
+	0x67, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x0e, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x3f, 0x0a, 0x0f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x61,
+	0x67, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x0f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x2d, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x61, 0x67, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a,
+	0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x22, 0x53, 0x0a, 0x15, 0x53, 0x75, 0x62, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x3a, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61, 0x67, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xa6, 0x01, 0x0a,
+	0x1b, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08,
+	0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08,
+	0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c,
+	0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x2d, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x61,
+	0x67, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x9b, 0x01, 0x0a, 0x0c, 0x46, 0x69, 0x6c, 0x65, 0x43, 0x6f,
+	0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x37, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x13, 0xca, 0xb5,
+	0x03, 0x0f, 0xa2, 0x01, 0x0c, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x22, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12,
+	0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70,
+	0x61, 0x74, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x65, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x65, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x22, 0x97, 0x02, 0x0a, 0x0f, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x56, 0x69,
+	0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x37, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x13, 0xca,
+	0xb5, 0x03, 0x0f, 0xa2, 0x01, 0x0c, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x22, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44,
+	0x12, 0x2c, 0x0a, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18,
+	0x2e, 0x61, 0x67, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x56, 0x69, 0x6f, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61,
+	0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x04, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x22, 0x4d,
+	0x0a, 0x04, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x45, 0x43, 0x52, 0x45, 0x54,
+	0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x46, 0x4f, 0x52, 0x42, 0x49, 0x44, 0x44, 0x45, 0x4e, 0x5f,
+	0x46, 0x49, 0x4c, 0x45, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x4c, 0x41, 0x52, 0x47, 0x45, 0x5f,
+	0x46, 0x49, 0x4c, 0x45, 0x10, 0x02, 0x12, 0x15, 0x0a, 0x11, 0x44, 0x49, 0x53, 0x41, 0x4c, 0x4c,
+	0x4f, 0x57, 0x45, 0x44, 0x5f, 0x49, 0x4d, 0x50, 0x4f, 0x52, 0x54, 0x10, 0x03, 0x22, 0xec, 0x01,
+	0x0a, 0x08, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x37, 0x0a, 0x0c, 0x73, 0x75,
+	0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x42, 0x13, 0xca, 0xb5, 0x03, 0x0f, 0xa2, 0x01, 0x0c, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x22, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x21, 0x0a, 0x03, 0x55, 0x52, 0x4c,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0f, 0xca, 0xb5, 0x03, 0x0b, 0xa2, 0x01, 0x08, 0x67,
+	0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x2d, 0x22, 0x52, 0x03, 0x55, 0x52, 0x4c, 0x22, 0x37, 0x0a, 0x09,
+	0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x73, 0x12, 0x2a, 0x0a, 0x09, 0x61, 0x72, 0x74,
+	0x69, 0x66, 0x61, 0x63, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x61,
+	0x67, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x52, 0x09, 0x61, 0x72, 0x74, 0x69,
+	0x66, 0x61, 0x63, 0x74, 0x73, 0x22, 0x51, 0x0a, 0x0f, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x22, 0xbe, 0x02, 0x0a, 0x0a, 0x54, 0x65, 0x73,
+	0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x37, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x13, 0xca,
+	0xb5, 0x03, 0x0f, 0xa2, 0x01, 0x0c, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x22, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x1c, 0x0a, 0x09,
+	0x6d, 0x61, 0x78, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x09, 0x6d, 0x61, 0x78, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x64, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x2d,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15,
+	0x2e, 0x61, 0x67, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x2e, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x3a, 0x0a,
+	0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f,
+	0x57, 0x4e, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x50, 0x41, 0x53, 0x53, 0x45, 0x44, 0x10, 0x01,
+	0x12, 0x0a, 0x0a, 0x06, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07,
+	0x53, 0x4b, 0x49, 0x50, 0x50, 0x45, 0x44, 0x10, 0x03, 0x22, 0x3f, 0x0a, 0x0b, 0x54, 0x65, 0x73,
+	0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x30, 0x0a, 0x0b, 0x74, 0x65, 0x73, 0x74,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
+	0x61, 0x67, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x0b, 0x74,
+	0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x53, 0x0a, 0x11, 0x54, 0x65,
+	0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x73,
+	0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x22,
+	0x52, 0x0a, 0x10, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12,
+	0x22, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x49, 0x44, 0x22, 0x65, 0x0a, 0x0b, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x30, 0x0a, 0x0b, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x67, 0x2e, 0x53, 0x75, 0x62,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78,
+	0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xf7, 0x01, 0x0a, 0x09, 0x46,
+	0x6f, 0x72, 0x63, 0x65, 0x50, 0x75, 0x73, 0x68, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x62, 0x65, 0x66, 0x6f,
+	0x72, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x20, 0x0a, 0x0b,
+	0x61, 0x66, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x61, 0x66, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x70, 0x75, 0x73, 0x68, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x70, 0x75, 0x73, 0x68, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75, 0x73, 0x68, 0x65, 0x64,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x75, 0x73, 0x68, 0x65, 0x64, 0x12, 0x24,
+	0x0a, 0x0d, 0x61, 0x66, 0x74, 0x65, 0x72, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x61, 0x66, 0x74, 0x65, 0x72, 0x44, 0x65, 0x61, 0x64,
+	0x6c, 0x69, 0x6e, 0x65, 0x22, 0x3e, 0x0a, 0x0b, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x50, 0x75, 0x73,
+	0x68, 0x65, 0x73, 0x12, 0x2f, 0x0a, 0x0b, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x50, 0x75, 0x73, 0x68,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x61, 0x67, 0x2e, 0x46, 0x6f,
+	0x72, 0x63, 0x65, 0x50, 0x75, 0x73, 0x68, 0x52, 0x0b, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x50, 0x75,
+	0x73, 0x68, 0x65, 0x73, 0x22, 0x47, 0x0a, 0x13, 0x4d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x50, 0x75,
+	0x73, 0x68, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63,
+	0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63,
+	0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x22, 0x82, 0x01,
+	0x0a, 0x12, 0x4d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x50, 0x75, 0x73, 0x68, 0x65, 0x73, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f,
+	0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x72, 0x65, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75, 0x73, 0x68,
+	0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x70, 0x75, 0x73, 0x68, 0x65, 0x73,
+	0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x73, 0x22, 0x98, 0x03, 0x0a, 0x06, 0x41, 0x70, 0x70, 0x65, 0x61, 0x6c, 0x12, 0x0e, 0x0a,
+	0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x1a, 0x0a,
+	0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49,
+	0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x29, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e,
+	0x61, 0x67, 0x2e, 0x41, 0x70, 0x70, 0x65, 0x61, 0x6c, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x70, 0x65, 0x6e,
+	0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x70, 0x65, 0x6e, 0x65, 0x64,
+	0x12, 0x2a, 0x0a, 0x10, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x65, 0x61, 0x64,
+	0x6c, 0x69, 0x6e, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x72, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x64, 0x65, 0x63, 0x69, 0x64, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x64,
+	0x65, 0x63, 0x69, 0x64, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x65, 0x63, 0x69, 0x64, 0x65,
+	0x72, 0x49, 0x44, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x64, 0x65, 0x63, 0x69, 0x64,
+	0x65, 0x72, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c,
+	0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x61,
+	0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x65, 0x77, 0x47, 0x72, 0x61, 0x64, 0x65, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x65, 0x77, 0x47, 0x72, 0x61, 0x64, 0x65, 0x22, 0x2d,
+	0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x08, 0x0a, 0x04, 0x4f, 0x50, 0x45, 0x4e,
+	0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x47, 0x52, 0x41, 0x4e, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12,
+	0x0c, 0x0a, 0x08, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x02, 0x22, 0x2f, 0x0a,
+	0x07, 0x41, 0x70, 0x70, 0x65, 0x61, 0x6c, 0x73, 0x12, 0x24, 0x0a, 0x07, 0x61, 0x70, 0x70, 0x65,
+	0x61, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x61, 0x67, 0x2e, 0x41,
+	0x70, 0x70, 0x65, 0x61, 0x6c, 0x52, 0x07, 0x61, 0x70, 0x70, 0x65, 0x61, 0x6c, 0x73, 0x22, 0x45,
+	0x0a, 0x0d, 0x41, 0x70, 0x70, 0x65, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x6f,
+	0x76, 0x65, 0x72, 0x64, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x6f, 0x76,
+	0x65, 0x72, 0x64, 0x75, 0x65, 0x22, 0x8d, 0x03, 0x0a, 0x09, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x02, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12,
+	0x52, 0x0a, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2e, 0xca, 0xb5, 0x03, 0x2a, 0xa2, 0x01, 0x27, 0x67, 0x6f,
+	0x72, 0x6d, 0x3a, 0x22, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x3a,
+	0x69, 0x64, 0x78, 0x5f, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x6e,
+	0x73, 0x69, 0x6f, 0x6e, 0x22, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e,
+	0x74, 0x49, 0x44, 0x12, 0x46, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x04, 0x42, 0x2e, 0xca, 0xb5, 0x03, 0x2a, 0xa2, 0x01, 0x27, 0x67, 0x6f, 0x72, 0x6d,
+	0x3a, 0x22, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x3a, 0x69, 0x64,
+	0x78, 0x5f, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69,
+	0x6f, 0x6e, 0x22, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x48, 0x0a, 0x07, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2e, 0xca, 0xb5,
+	0x03, 0x2a, 0xa2, 0x01, 0x27, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x75, 0x6e, 0x69, 0x71, 0x75,
+	0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x3a, 0x69, 0x64, 0x78, 0x5f, 0x75, 0x6e, 0x69, 0x71, 0x75,
+	0x65, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x52, 0x07, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x67, 0x72, 0x61,
+	0x6e, 0x74, 0x65, 0x64, 0x42, 0x79, 0x49, 0x44, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b,
+	0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x64, 0x42, 0x79, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x67,
+	0x72, 0x61, 0x6e, 0x74, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72,
+	0x61, 0x6e, 0x74, 0x65, 0x64, 0x22, 0x3b, 0x0a, 0x0a, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x2d, 0x0a, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x61, 0x67, 0x2e, 0x45, 0x78, 0x74,
+	0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x22, 0x52, 0x0a, 0x10, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65,
+	0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
+	0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e,
+	0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x22, 0xdf, 0x04, 0x0a, 0x07, 0x54, 0x65, 0x73, 0x74, 0x4a,
+	0x6f, 0x62, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02,
+	0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x22,
+	0x0a, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
+	0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79,
+	0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x6f, 0x72, 0x79, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x18,
+	0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x49, 0x44, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x6a, 0x6f, 0x62, 0x4f, 0x77, 0x6e, 0x65, 0x72,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6a, 0x6f, 0x62, 0x4f, 0x77, 0x6e, 0x65, 0x72,
+	0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x72, 0x65, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x75,
+	0x6e, 0x6e, 0x65, 0x72, 0x50, 0x6f, 0x6f, 0x6c, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x50, 0x6f, 0x6f, 0x6c, 0x12, 0x30, 0x0a, 0x08, 0x70, 0x72,
+	0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x61,
+	0x67, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x2e, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69,
+	0x74, 0x79, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x2a, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x61,
+	0x67, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x74, 0x74, 0x65,
+	0x6d, 0x70, 0x74, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x61, 0x74, 0x74, 0x65,
+	0x6d, 0x70, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64,
+	0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64,
+	0x12, 0x18, 0x0a, 0x07, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x61,
+	0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c,
+	0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x75, 0x6c, 0x6c,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x70,
+	0x75, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x21, 0x0a, 0x06, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x0a, 0x0a, 0x06, 0x51, 0x55, 0x45, 0x55, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x0b, 0x0a, 0x07, 0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x22, 0x29, 0x0a,
+	0x08, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x0a, 0x0a, 0x06, 0x4e, 0x4f, 0x52,
+	0x4d, 0x41, 0x4c, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x48, 0x49, 0x47, 0x48, 0x10, 0x01, 0x12,
+	0x07, 0x0a, 0x03, 0x4c, 0x4f, 0x57, 0x10, 0x02, 0x22, 0x33, 0x0a, 0x08, 0x54, 0x65, 0x73, 0x74,
+	0x4a, 0x6f, 0x62, 0x73, 0x12, 0x27, 0x0a, 0x08, 0x74, 0x65, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x61, 0x67, 0x2e, 0x54, 0x65, 0x73, 0x74,
+	0x4a, 0x6f, 0x62, 0x52, 0x08, 0x74, 0x65, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x73, 0x22, 0xf1, 0x02,
+	0x0a, 0x0f, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49,
+	0x44, 0x12, 0x52, 0x0a, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49,
+	0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2e, 0xca, 0xb5, 0x03, 0x2a, 0xa2, 0x01, 0x27,
+	0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x6e, 0x64, 0x65,
+	0x78, 0x3a, 0x69, 0x64, 0x78, 0x5f, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x5f,
+	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x22, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d,
+	0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x46, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2e, 0xca, 0xb5, 0x03, 0x2a, 0xa2, 0x01, 0x27, 0x67, 0x6f,
+	0x72, 0x6d, 0x3a, 0x22, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x3a,
+	0x69, 0x64, 0x78, 0x5f, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x22, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x48, 0x0a,
+	0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2e,
+	0xca, 0xb5, 0x03, 0x2a, 0xa2, 0x01, 0x27, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x75, 0x6e, 0x69,
+	0x71, 0x75, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x3a, 0x69, 0x64, 0x78, 0x5f, 0x73, 0x75, 0x62,
+	0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x22, 0x52, 0x07,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x12, 0x4a, 0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x49, 0x44, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2e, 0xca, 0xb5, 0x03, 0x2a, 0xa2,
+	0x01, 0x27, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x6e,
+	0x64, 0x65, 0x78, 0x3a, 0x69, 0x64, 0x78, 0x5f, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65,
+	0x64, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x22, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65,
+	0x64, 0x22, 0x9a, 0x03, 0x0a, 0x0f, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c,
+	0x69, 0x76, 0x65, 0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x39, 0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72,
+	0x79, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x19, 0xca, 0xb5, 0x03, 0x15, 0xa2,
+	0x01, 0x12, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x6e,
+	0x64, 0x65, 0x78, 0x22, 0x52, 0x0a, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x49, 0x44,
+	0x12, 0x14, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x6f, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x47, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x61, 0x67, 0x2e, 0x57, 0x65, 0x62, 0x68,
+	0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x2e, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x42, 0x13, 0xca, 0xb5, 0x03, 0x0f, 0xa2, 0x01, 0x0c, 0x67, 0x6f, 0x72, 0x6d, 0x3a,
+	0x22, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72,
+	0x69, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x64, 0x65, 0x6c, 0x69, 0x76,
+	0x65, 0x72, 0x69, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65,
+	0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65,
+	0x64, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x12,
+	0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0x33, 0x0a, 0x06, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x0e, 0x0a, 0x0a, 0x50, 0x52, 0x4f, 0x43, 0x45, 0x53, 0x53, 0x49, 0x4e,
+	0x47, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x50, 0x52, 0x4f, 0x43, 0x45, 0x53, 0x53, 0x45, 0x44,
+	0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x02, 0x22, 0x48,
+	0x0a, 0x11, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72,
+	0x69, 0x65, 0x73, 0x12, 0x33, 0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x67, 0x2e, 0x57, 0x65, 0x62,
+	0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x52, 0x0a, 0x64, 0x65,
+	0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x22, 0x50, 0x0a, 0x16, 0x57, 0x65, 0x62, 0x68,
+	0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79,
+	0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x22, 0xa3, 0x02, 0x0a, 0x0a, 0x41,
+	0x75, 0x64, 0x69, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x2f, 0x0a, 0x08, 0x63, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x13, 0xca, 0xb5, 0x03,
+	0x0f, 0xa2, 0x01, 0x0c, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x22,
+	0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x2b, 0x0a, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x13, 0xca, 0xb5, 0x03, 0x0f,
+	0xa2, 0x01, 0x0c, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x2b, 0x0a,
+	0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x13, 0xca,
+	0xb5, 0x03, 0x0f, 0xa2, 0x01, 0x0c, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x22, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65,
+	0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x64, 0x65, 0x74,
+	0x61, 0x69, 0x6c, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x66, 0x74,
+	0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x22, 0x97, 0x01, 0x0a, 0x0f, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44,
+	0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1c, 0x0a, 0x09,
+	0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x5a, 0x0a, 0x08, 0x41, 0x75,
+	0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x12, 0x28, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x67, 0x2e, 0x41, 0x75, 0x64,
+	0x69, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73,
+	0x12, 0x24, 0x0a, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67,
+	0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xbf, 0x02, 0x0a, 0x0b, 0x41, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x2b, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x13, 0xca, 0xb5, 0x03, 0x0f, 0xa2, 0x01, 0x0c, 0x67,
+	0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x36, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x22, 0xca, 0xb5, 0x03, 0x1e, 0xa2, 0x01, 0x1b, 0x67, 0x6f,
+	0x72, 0x6d, 0x3a, 0x22, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x3b,
+	0x73, 0x69, 0x7a, 0x65, 0x3a, 0x32, 0x35, 0x35, 0x22, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12,
+	0x57, 0x0a, 0x07, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x15, 0x2e, 0x61, 0x67, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x42, 0x26, 0xca, 0xb5, 0x03, 0x22, 0xa2, 0x01, 0x1f,
+	0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x4b, 0x65, 0x79,
+	0x3a, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x44, 0x22, 0x52,
+	0x07, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08,
+	0x6c, 0x61, 0x73, 0x74, 0x55, 0x73, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x6c, 0x61, 0x73, 0x74, 0x55, 0x73, 0x65, 0x64, 0x22, 0x7a, 0x0a, 0x11, 0x41, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x12, 0x0e, 0x0a,
+	0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x39, 0x0a,
+	0x0d, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x44, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x42, 0x13, 0xca, 0xb5, 0x03, 0x0f, 0xa2, 0x01, 0x0c, 0x67, 0x6f, 0x72,
+	0x6d, 0x3a, 0x22, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x52, 0x0d, 0x61, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x49, 0x44, 0x22, 0x6c, 0x0a, 0x12, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x04, 0x52, 0x09, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x73, 0x12, 0x24, 0x0a, 0x0d,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x49, 0x6e, 0x44, 0x61, 0x79, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0d, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x49, 0x6e, 0x44, 0x61,
+	0x79, 0x73, 0x22, 0x59, 0x0a, 0x0e, 0x4e, 0x65, 0x77, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x31, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61, 0x67, 0x2e, 0x41,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x43, 0x0a,
+	0x0c, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x33, 0x0a,
+	0x0c, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61, 0x67, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x0c, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x73, 0x22, 0xe2, 0x01, 0x0a, 0x07, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x0e,
+	0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x2b,
+	0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x13,
+	0xca, 0xb5, 0x03, 0x0f, 0xa2, 0x01, 0x0c, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x22, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x36, 0x0a, 0x04, 0x68,
+	0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x22, 0xca, 0xb5, 0x03, 0x1e, 0xa2,
+	0x01, 0x1b, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x6e,
+	0x64, 0x65, 0x78, 0x3b, 0x73, 0x69, 0x7a, 0x65, 0x3a, 0x32, 0x35, 0x35, 0x22, 0x52, 0x04, 0x68,
+	0x61, 0x73, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x41, 0x67, 0x65, 0x6e, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x73, 0x65, 0x72, 0x41, 0x67, 0x65, 0x6e,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x50, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49,
+	0x50, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6c,
+	0x61, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c,
+	0x61, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x22, 0x33, 0x0a, 0x08, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x27, 0x0a, 0x08, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x61, 0x67, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x08, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xef, 0x03, 0x0a,
+	0x0d, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e,
+	0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x30,
+	0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18,
+	0x2e, 0x61, 0x67, 0x2e, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x41, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x2f, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x04, 0x42, 0x13, 0xca, 0xb5, 0x03, 0x0f, 0xa2, 0x01, 0x0c, 0x67, 0x6f, 0x72, 0x6d, 0x3a,
+	0x22, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49,
+	0x44, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x12, 0x26, 0x0a, 0x0e, 0x65,
+	0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0e, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x64, 0x55, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x12, 0x31, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x61, 0x67, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d,
+	0x65, 0x6e, 0x74, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x4d, 0x0a, 0x0c, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x29, 0x2e, 0x61,
+	0x67, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72,
+	0x79, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x65, 0x64, 0x42, 0x79, 0x49, 0x44, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x42, 0x79, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x42, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x1c, 0x0a,
+	0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x22, 0x41, 0x0a, 0x06, 0x41,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x11, 0x0a, 0x0d, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x5f,
+	0x43, 0x4f, 0x55, 0x52, 0x53, 0x45, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x44, 0x45, 0x4c, 0x45,
+	0x54, 0x45, 0x5f, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x44, 0x45,
+	0x4d, 0x4f, 0x54, 0x45, 0x5f, 0x54, 0x45, 0x41, 0x43, 0x48, 0x45, 0x52, 0x10, 0x02, 0x22, 0x3d,
+	0x0a, 0x0e, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x2b, 0x0a, 0x07, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x61, 0x67, 0x2e, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x41, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x94, 0x02,
+	0x0a, 0x10, 0x50, 0x6c, 0x61, 0x67, 0x69, 0x61, 0x72, 0x69, 0x73, 0x6d, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02,
+	0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x22,
+	0x0a, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
+	0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x2c, 0x0a, 0x11, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x53,
+	0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x11, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x69,
+	0x74, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0a, 0x73, 0x69, 0x6d, 0x69, 0x6c, 0x61,
+	0x72, 0x69, 0x74, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x55, 0x52, 0x4c, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x55, 0x52, 0x4c, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x6f, 0x6f, 0x6c, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x6f, 0x6f, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68,
+	0x65, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x65,
+	0x63, 0x6b, 0x65, 0x64, 0x22, 0x43, 0x0a, 0x11, 0x50, 0x6c, 0x61, 0x67, 0x69, 0x61, 0x72, 0x69,
+	0x73, 0x6d, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x2e, 0x0a, 0x07, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x67, 0x2e,
+	0x50, 0x6c, 0x61, 0x67, 0x69, 0x61, 0x72, 0x69, 0x73, 0x6d, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x83, 0x01, 0x0a, 0x11, 0x50, 0x6c,
+	0x61, 0x67, 0x69, 0x61, 0x72, 0x69, 0x73, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x61,
+	0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12,
+	0x12, 0x0a, 0x04, 0x74, 0x6f, 0x6f, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74,
+	0x6f, 0x6f, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x22,
+	0x86, 0x02, 0x0a, 0x10, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x65, 0x6e, 0x63, 0x68,
+	0x6d, 0x61, 0x72, 0x6b, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x02, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65,
+	0x6e, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x41, 0x73, 0x73, 0x69,
+	0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x52, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x52, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x18,
+	0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x56, 0x0a, 0x08, 0x63, 0x72, 0x69, 0x74,
+	0x65, 0x72, 0x69, 0x61, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x67, 0x2e,
+	0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e,
+	0x42, 0x24, 0xca, 0xb5, 0x03, 0x20, 0xa2, 0x01, 0x1d, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x66,
+	0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x4b, 0x65, 0x79, 0x3a, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d,
+	0x61, 0x72, 0x6b, 0x49, 0x44, 0x22, 0x52, 0x08, 0x63, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61,
+	0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x42, 0x0a, 0x0a, 0x42, 0x65, 0x6e, 0x63,
+	0x68, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x12, 0x34, 0x0a, 0x0a, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d,
+	0x61, 0x72, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x67, 0x2e,
+	0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b,
+	0x52, 0x0a, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x22, 0x8d, 0x02, 0x0a,
+	0x10, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f,
+	0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49,
+	0x44, 0x12, 0x20, 0x0a, 0x0b, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x49, 0x44,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72,
+	0x6b, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x30, 0x0a,
+	0x05, 0x67, 0x72, 0x61, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x61,
+	0x67, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69,
+	0x6f, 0x6e, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x65, 0x52, 0x05, 0x67, 0x72, 0x61, 0x64, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x22, 0x29, 0x0a, 0x05, 0x47, 0x72, 0x61, 0x64, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f,
+	0x4e, 0x45, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x01,
+	0x12, 0x0a, 0x0a, 0x06, 0x50, 0x41, 0x53, 0x53, 0x45, 0x44, 0x10, 0x02, 0x22, 0xbf, 0x02, 0x0a,
+	0x06, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x53, 0x75, 0x62, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x53,
+	0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x52,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0a, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x66,
+	0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66,
+	0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x65, 0x61, 0x64, 0x79,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x72, 0x65, 0x61, 0x64, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x73, 0x63,
+	0x6f, 0x72, 0x65, 0x12, 0x65, 0x0a, 0x11, 0x67, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x65,
+	0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x65, 0x6e, 0x63, 0x68,
+	0x6d, 0x61, 0x72, 0x6b, 0x42, 0x21, 0xca, 0xb5, 0x03, 0x1d, 0xa2, 0x01, 0x1a, 0x67, 0x6f, 0x72,
+	0x6d, 0x3a, 0x22, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x4b, 0x65, 0x79, 0x3a, 0x52, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x49, 0x44, 0x22, 0x52, 0x11, 0x67, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67,
+	0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x64,
+	0x69, 0x74, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x64, 0x69, 0x74,
+	0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x22, 0x33,
+	0x0a, 0x09, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x73, 0x12, 0x26, 0x0a, 0x09, 0x72,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x08,
+	0x2e, 0x61, 0x67, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x09, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x65, 0x72, 0x73, 0x22, 0xd3, 0x03, 0x0a, 0x0a, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02,
+	0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x22,
+	0x0a, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
+	0x49, 0x44, 0x12, 0x54, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x42, 0x30, 0xca, 0xb5, 0x03, 0x2c, 0xa2, 0x01,
+	0x29, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x6e, 0x64,
+	0x65, 0x78, 0x3a, 0x69, 0x64, 0x78, 0x5f, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x70, 0x65,
+	0x65, 0x72, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x22, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x50, 0x0a, 0x0a, 0x72, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x65, 0x72, 0x49, 0x44, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x42, 0x30, 0xca, 0xb5,
+	0x03, 0x2c, 0xa2, 0x01, 0x29, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x75, 0x6e, 0x69, 0x71, 0x75,
+	0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x3a, 0x69, 0x64, 0x78, 0x5f, 0x75, 0x6e, 0x69, 0x71, 0x75,
+	0x65, 0x5f, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x22, 0x52, 0x0a,
+	0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63,
+	0x6f, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x66, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x66, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x1c, 0x0a, 0x09,
+	0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x09, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65,
+	0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65,
+	0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x6d, 0x6f, 0x64, 0x65, 0x72, 0x61,
+	0x74, 0x6f, 0x72, 0x49, 0x44, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x6d, 0x6f, 0x64,
+	0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x44, 0x12, 0x3f, 0x0a, 0x0a, 0x73, 0x75, 0x62, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61,
+	0x67, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x0f, 0xca, 0xb5,
+	0x03, 0x0b, 0xa2, 0x01, 0x08, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x2d, 0x22, 0x52, 0x0a, 0x73,
+	0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x3f, 0x0a, 0x0b, 0x50, 0x65, 0x65,
+	0x72, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x12, 0x30, 0x0a, 0x0b, 0x70, 0x65, 0x65, 0x72,
+	0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
+	0x61, 0x67, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x0b, 0x70,
+	0x65, 0x65, 0x72, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x22, 0x8d, 0x03, 0x0a, 0x11, 0x53,
+	0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44,
+	0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x37, 0x0a, 0x0c,
+	0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x04, 0x42, 0x13, 0xca, 0xb5, 0x03, 0x0f, 0xa2, 0x01, 0x0c, 0x67, 0x6f, 0x72, 0x6d, 0x3a,
+	0x22, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x49,
+	0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x49,
+	0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x48, 0x61, 0x73, 0x68, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x48, 0x61, 0x73,
+	0x68, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x04, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x64,
+	0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x18, 0x0a,
+	0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x65, 0x65, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x04, 0x70, 0x65, 0x65, 0x72, 0x12, 0x27, 0x0a, 0x06, 0x6d, 0x69, 0x72, 0x72, 0x6f, 0x72, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x08, 0x42, 0x0f, 0xca, 0xb5, 0x03, 0x0b, 0xa2, 0x01, 0x08, 0x67, 0x6f,
+	0x72, 0x6d, 0x3a, 0x22, 0x2d, 0x22, 0x52, 0x06, 0x6d, 0x69, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x2a,
+	0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x55,
+	0x52, 0x4c, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x52, 0x4c, 0x22, 0x47, 0x0a, 0x12, 0x53, 0x75,
+	0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x12, 0x31, 0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x61, 0x67, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x22, 0x5a, 0x0a, 0x18, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x73,
+	0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x22,
+	0xd9, 0x01, 0x0a, 0x0c, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44,
+	0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08,
+	0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08,
+	0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x62, 0x6f,
+	0x64, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x23, 0x0a, 0x04, 0x72, 0x65, 0x61, 0x64, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x08, 0x42, 0x0f, 0xca, 0xb5, 0x03, 0x0b, 0xa2, 0x01, 0x08, 0x67, 0x6f, 0x72,
+	0x6d, 0x3a, 0x22, 0x2d, 0x22, 0x52, 0x04, 0x72, 0x65, 0x61, 0x64, 0x22, 0x47, 0x0a, 0x0d, 0x41,
+	0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x36, 0x0a, 0x0d,
+	0x61, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x61, 0x67, 0x2e, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0d, 0x61, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x22, 0xea, 0x01, 0x0a, 0x10, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x61, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x5e, 0x0a, 0x0e, 0x61, 0x6e, 0x6e,
+	0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x42, 0x36, 0xca, 0xb5, 0x03, 0x32, 0xa2, 0x01, 0x2f, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22,
+	0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x3a, 0x69, 0x64, 0x78, 0x5f,
+	0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x61, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x22, 0x52, 0x0e, 0x61, 0x6e, 0x6e, 0x6f, 0x75,
+	0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x4e, 0x0a, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x36, 0xca, 0xb5, 0x03, 0x32, 0xa2,
+	0x01, 0x2f, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x6e,
+	0x64, 0x65, 0x78, 0x3a, 0x69, 0x64, 0x78, 0x5f, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x61,
+	0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x61, 0x64,
+	0x22, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61,
+	0x64, 0x41, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x64, 0x41,
+	0x74, 0x22, 0x9a, 0x02, 0x0a, 0x0b, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49,
+	0x44, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x22, 0x0a,
+	0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49,
+	0x44, 0x12, 0x28, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x14, 0x2e, 0x61, 0x67, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x69, 0x74, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c,
+	0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x22, 0x3f, 0x0a,
+	0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12,
+	0x17, 0x0a, 0x13, 0x41, 0x53, 0x53, 0x49, 0x47, 0x4e, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x52, 0x45,
+	0x4c, 0x45, 0x41, 0x53, 0x45, 0x44, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x44, 0x45, 0x41, 0x44,
+	0x4c, 0x49, 0x4e, 0x45, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x44, 0x10, 0x02, 0x22, 0xc3,
+	0x01, 0x0a, 0x0c, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12,
+	0x2b, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42,
+	0x13, 0xca, 0xb5, 0x03, 0x0f, 0xa2, 0x01, 0x0c, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x22, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08,
+	0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08,
+	0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x72, 0x65, 0x61, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04,
+	0x72, 0x65, 0x61, 0x64, 0x22, 0x47, 0x0a, 0x0d, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x36, 0x0a, 0x0d, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x61,
+	0x67, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d,
+	0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x36, 0x0a,
+	0x14, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x75, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x4f,
+	0x6e, 0x6c, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x75, 0x6e, 0x72, 0x65, 0x61,
+	0x64, 0x4f, 0x6e, 0x6c, 0x79, 0x22, 0x3d, 0x0a, 0x13, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e,
+	0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x44, 0x22, 0x8a, 0x02, 0x0a, 0x08, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49,
+	0x44, 0x12, 0x22, 0x0a, 0x0c, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49,
+	0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d,
+	0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x49,
+	0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x49,
+	0x44, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x2a, 0x0a, 0x10, 0x61,
+	0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x49, 0x44, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x41,
+	0x6e, 0x73, 0x77, 0x65, 0x72, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x24, 0x0a, 0x07, 0x61,
+	0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x61,
+	0x67, 0x2e, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x52, 0x07, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72,
+	0x73, 0x22, 0x37, 0x0a, 0x09, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2a,
+	0x0a, 0x09, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0c, 0x2e, 0x61, 0x67, 0x2e, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x09, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xb8, 0x01, 0x0a, 0x06, 0x41,
+	0x6e, 0x73, 0x77, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x51, 0x75, 0x65, 0x73, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x49,
+	0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x49,
+	0x44, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x73, 0x65,
+	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x73, 0x65,
+	0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x22, 0x9e, 0x01, 0x0a, 0x0a, 0x44, 0x69, 0x73, 0x63, 0x75, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x55, 0x52,
+	0x4c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x55, 0x52, 0x4c, 0x12, 0x16, 0x0a, 0x06,
+	0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x1a,
+	0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x6e,
+	0x73, 0x77, 0x65, 0x72, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x61, 0x6e,
+	0x73, 0x77, 0x65, 0x72, 0x65, 0x64, 0x22, 0x3f, 0x0a, 0x0b, 0x44, 0x69, 0x73, 0x63, 0x75, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x30, 0x0a, 0x0b, 0x64, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x67, 0x2e,
+	0x44, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x63,
+	0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x4f, 0x0a, 0x0d, 0x52, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x06, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x61, 0x67, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x52, 0x06, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x22, 0x2b, 0x0a, 0x0d, 0x43, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x49, 0x44, 0x22, 0x25, 0x0a, 0x0b, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x2b, 0x0a, 0x0f,
+	0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x22, 0x5c, 0x0a, 0x0c, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x63,
+	0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63,
+	0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x22, 0x26, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x22,
+	0x26, 0x0a, 0x0a, 0x4f, 0x72, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a,
+	0x07, 0x6f, 0x72, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6f, 0x72, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x6c, 0x0a, 0x0c, 0x4f, 0x72, 0x67, 0x61, 0x6e,
+	0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x61,
+	0x76, 0x61, 0x74, 0x61, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x76, 0x61,
+	0x74, 0x61, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6c,
+	0x61, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x50, 0x6c, 0x61, 0x6e, 0x22, 0x47, 0x0a, 0x0d, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x36, 0x0a, 0x0d, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69,
+	0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e,
+	0x61, 0x67, 0x2e, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x0d, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xf4,
+	0x01, 0x0a, 0x11, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44,
+	0x12, 0x2e, 0x0a, 0x12, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x69, 0x67,
+	0x6e, 0x6f, 0x72, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73,
+	0x12, 0x22, 0x0a, 0x0c, 0x77, 0x69, 0x74, 0x68, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x77, 0x69, 0x74, 0x68, 0x41, 0x63, 0x74, 0x69,
+	0x76, 0x69, 0x74, 0x79, 0x12, 0x35, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x61, 0x67, 0x2e, 0x45, 0x6e, 0x72, 0x6f,
+	0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x08, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x70,
+	0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x70,
+	0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x48, 0x0a, 0x18, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x45,
+	0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x10, 0x0a,
+	0x03, 0x63, 0x73, 0x76, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x63, 0x73, 0x76, 0x22,
+	0xa7, 0x01, 0x0a, 0x16, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6d,
+	0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6e,
+	0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x65, 0x6e,
+	0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x64, 0x12, 0x28, 0x0a, 0x0f, 0x61, 0x6c, 0x72, 0x65, 0x61, 0x64,
+	0x79, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0f, 0x61, 0x6c, 0x72, 0x65, 0x61, 0x64, 0x79, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x64,
+	0x12, 0x2f, 0x0a, 0x09, 0x75, 0x6e, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x61, 0x67, 0x2e, 0x50, 0x72, 0x65, 0x45, 0x6e, 0x72, 0x6f,
+	0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x09, 0x75, 0x6e, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x22, 0x68, 0x0a, 0x17, 0x45, 0x6e, 0x72,
+	0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x35, 0x0a, 0x08,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x19,
+	0x2e, 0x61, 0x67, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x55,
+	0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x08, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x65, 0x73, 0x22, 0x9b, 0x01, 0x0a, 0x11, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x63,
+	0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63,
+	0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53,
+	0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53,
+	0x69, 0x7a, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x22, 0x35, 0x0a, 0x17, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08,
+	0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08,
+	0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x22, 0xa9, 0x02, 0x0a, 0x05, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x12, 0x22, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x0e, 0x2e, 0x61, 0x67, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65,
+	0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65,
+	0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
+	0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e,
+	0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x73, 0x75,
+	0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x6a, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x53, 0x55,
+	0x42, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x47, 0x52, 0x41, 0x44, 0x45, 0x44, 0x10,
+	0x01, 0x12, 0x17, 0x0a, 0x13, 0x45, 0x4e, 0x52, 0x4f, 0x4c, 0x4c, 0x4d, 0x45, 0x4e, 0x54, 0x5f,
+	0x41, 0x43, 0x43, 0x45, 0x50, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x12, 0x0a, 0x0e, 0x47, 0x52,
+	0x4f, 0x55, 0x50, 0x5f, 0x41, 0x50, 0x50, 0x52, 0x4f, 0x56, 0x45, 0x44, 0x10, 0x03, 0x12, 0x14,
+	0x0a, 0x10, 0x52, 0x45, 0x56, 0x49, 0x45, 0x57, 0x5f, 0x50, 0x55, 0x42, 0x4c, 0x49, 0x53, 0x48,
+	0x45, 0x44, 0x10, 0x04, 0x22, 0x8b, 0x01, 0x0a, 0x17, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x22,
+	0x0a, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
+	0x49, 0x44, 0x22, 0xce, 0x01, 0x0a, 0x10, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x30, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x61, 0x67, 0x2e, 0x53, 0x75, 0x62, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x0d, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74,
+	0x65, 0x64, 0x57, 0x61, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x65, 0x73,
+	0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x57, 0x61, 0x69, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x65,
+	0x6e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65,
+	0x6e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64, 0x22, 0x2a, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x51, 0x55,
+	0x45, 0x55, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e,
+	0x47, 0x10, 0x02, 0x22, 0x87, 0x01, 0x0a, 0x0d, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49,
+	0x44, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49,
+	0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d,
+	0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x12, 0x1e, 0x0a,
+	0x0a, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x50, 0x6f, 0x6f, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x50, 0x6f, 0x6f, 0x6c, 0x22, 0x8a, 0x02,
+	0x0a, 0x0c, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x22,
+	0x0a, 0x0c, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
+	0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e,
+	0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x18,
+	0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x48, 0x61, 0x73, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x24, 0x0a, 0x0d, 0x72, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x65, 0x64, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0d, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x65, 0x64, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x24,
+	0x0a, 0x0d, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x53,
+	0x63, 0x6f, 0x72, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x96, 0x01, 0x0a, 0x0c, 0x52,
+	0x65, 0x70, 0x6c, 0x61, 0x79, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63,
+	0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63,
+	0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x75, 0x6e, 0x6e, 0x65,
+	0x72, 0x50, 0x6f, 0x6f, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x75, 0x6e,
+	0x6e, 0x65, 0x72, 0x50, 0x6f, 0x6f, 0x6c, 0x12, 0x2a, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x61, 0x67, 0x2e, 0x52, 0x65,
+	0x70, 0x6c, 0x61, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x69, 0x73, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6d, 0x69, 0x73, 0x6d, 0x61, 0x74, 0x63,
+	0x68, 0x65, 0x73, 0x22, 0xba, 0x01, 0x0a, 0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x75,
+	0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x22, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12,
+	0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05,
+	0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65,
+	0x64, 0x12, 0x2d, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x15, 0x2e, 0x61, 0x67, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x22, 0xae, 0x01, 0x0a, 0x18, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a,
+	0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x73, 0x73,
+	0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x1e, 0x0a,
+	0x0a, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0a, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x18, 0x0a,
+	0x07, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x70, 0x70, 0x72, 0x6f,
+	0x76, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76,
+	0x65, 0x22, 0x5c, 0x0a, 0x1a, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x22, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x22,
+	0x29, 0x0a, 0x09, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x12, 0x1c, 0x0a, 0x09,
+	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x09, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x22, 0x5b, 0x0a, 0x0a, 0x55, 0x52,
+	0x4c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x49, 0x44, 0x12, 0x31, 0x0a, 0x09, 0x72, 0x65, 0x70, 0x6f, 0x54, 0x79, 0x70, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x61, 0x67, 0x2e, 0x52, 0x65, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x72, 0x65,
+	0x70, 0x6f, 0x54, 0x79, 0x70, 0x65, 0x73, 0x22, 0x61, 0x0a, 0x11, 0x52, 0x65, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x12, 0x1a,
+	0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x22, 0x77, 0x0a, 0x0c, 0x52, 0x65,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x2e, 0x0a, 0x04, 0x55, 0x52,
+	0x4c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x67, 0x2e, 0x52, 0x65,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x2e, 0x55, 0x52, 0x4c, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x55, 0x52, 0x4c, 0x73, 0x1a, 0x37, 0x0a, 0x09, 0x55, 0x52,
+	0x4c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x22, 0x1b, 0x0a, 0x07, 0x46, 0x65, 0x65, 0x64, 0x55, 0x52, 0x4c, 0x12, 0x10,
+	0x0a, 0x03, 0x55, 0x52, 0x4c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x55, 0x52, 0x4c,
+	0x22, 0x9d, 0x01, 0x0a, 0x11, 0x4f, 0x72, 0x70, 0x68, 0x61, 0x6e, 0x65, 0x64, 0x52, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65,
+	0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x69,
+	0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x65, 0x61, 0x6d, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x74, 0x65, 0x61, 0x6d, 0x73, 0x12, 0x14, 0x0a, 0x05,
+	0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x75, 0x73, 0x65,
+	0x72, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x41, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x41, 0x74,
+	0x22, 0x93, 0x01, 0x0a, 0x0f, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x56, 0x69, 0x6f, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f,
+	0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x6f, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x61, 0x6d, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x61, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a,
+	0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07,
+	0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72,
+	0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x22, 0x7f, 0x0a, 0x0e, 0x45, 0x78, 0x70, 0x6f, 0x73, 0x75,
+	0x72, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x49, 0x44, 0x12, 0x33, 0x0a, 0x0a, 0x76, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x67, 0x2e, 0x41, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x56, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x76,
+	0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x68, 0x65,
+	0x63, 0x6b, 0x65, 0x64, 0x41, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68,
+	0x65, 0x63, 0x6b, 0x65, 0x64, 0x41, 0x74, 0x22, 0xa5, 0x01, 0x0a, 0x0b, 0x41, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x61, 0x6d, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x61, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x75,
+	0x73, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12,
+	0x1e, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x2e, 0x0a, 0x12, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x50, 0x65, 0x72, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x65, 0x78, 0x70,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22,
+	0x73, 0x0a, 0x0c, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12,
+	0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x29, 0x0a, 0x07, 0x65,
+	0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61,
+	0x67, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65,
+	0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65,
+	0x64, 0x41, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x65, 0x63, 0x6b,
+	0x65, 0x64, 0x41, 0x74, 0x22, 0x51, 0x0a, 0x17, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f,
+	0x72, 0x79, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x72,
+	0x65, 0x61, 0x64, 0x4f, 0x6e, 0x6c, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72,
+	0x65, 0x61, 0x64, 0x4f, 0x6e, 0x6c, 0x79, 0x22, 0x88, 0x01, 0x0a, 0x16, 0x52, 0x65, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f,
+	0x72, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x22, 0x6a, 0x0a, 0x16, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79,
+	0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1a, 0x0a, 0x08,
+	0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08,
+	0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x34, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x67, 0x2e, 0x52,
+	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0xaa,
+	0x02, 0x0a, 0x0e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x4f, 0x76, 0x65, 0x72, 0x76, 0x69, 0x65,
+	0x77, 0x12, 0x22, 0x0a, 0x06, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0a, 0x2e, 0x61, 0x67, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x06, 0x63,
+	0x6f, 0x75, 0x72, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74,
+	0x73, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x65, 0x61, 0x63, 0x68, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x08, 0x74, 0x65, 0x61, 0x63, 0x68, 0x65, 0x72, 0x73, 0x12, 0x18, 0x0a,
+	0x07, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07,
+	0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x20, 0x0a, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x57,
+	0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6c, 0x61,
+	0x73, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x71, 0x75, 0x65,
+	0x75, 0x65, 0x64, 0x4a, 0x6f, 0x62, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x71,
+	0x75, 0x65, 0x75, 0x65, 0x64, 0x4a, 0x6f, 0x62, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x75, 0x6e,
+	0x6e, 0x69, 0x6e, 0x67, 0x4a, 0x6f, 0x62, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b,
+	0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x4a, 0x6f, 0x62, 0x73, 0x22, 0x5b, 0x0a, 0x0d, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x4f, 0x76, 0x65, 0x72, 0x76, 0x69, 0x65, 0x77, 0x12, 0x2c, 0x0a, 0x07,
+	0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x61, 0x67, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x4f, 0x76, 0x65, 0x72, 0x76, 0x69, 0x65,
+	0x77, 0x52, 0x07, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x68,
+	0x65, 0x63, 0x6b, 0x65, 0x64, 0x41, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63,
+	0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x41, 0x74, 0x22, 0x3b, 0x0a, 0x15, 0x41, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x22, 0x0a, 0x0c, 0x49, 0x73, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x49, 0x73, 0x41, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x69, 0x7a, 0x65, 0x64, 0x22, 0x32, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x12, 0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x43,
+	0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xc5, 0x01, 0x0a, 0x1b, 0x53, 0x75,
+	0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x46, 0x6f, 0x72, 0x43, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x38, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e, 0x61, 0x67, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x46, 0x6f, 0x72, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12,
+	0x24, 0x0a, 0x0d, 0x77, 0x69, 0x74, 0x68, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x6e, 0x66, 0x6f,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x77, 0x69, 0x74, 0x68, 0x42, 0x75, 0x69, 0x6c,
+	0x64, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x2a, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x07, 0x0a,
+	0x03, 0x41, 0x4c, 0x4c, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x49, 0x4e, 0x44, 0x49, 0x56, 0x49,
+	0x44, 0x55, 0x41, 0x4c, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x10,
+	0x02, 0x22, 0x58, 0x0a, 0x0e, 0x52, 0x65, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67,
+	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x61,
+	0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x22, 0x71, 0x0a, 0x11, 0x43,
+	0x6f, 0x75, 0x72, 0x73, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x43, 0x6f, 0x64, 0x65,
+	0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x59, 0x65, 0x61, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x59, 0x65, 0x61, 0x72,
+	0x12, 0x1c, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x73, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x22, 0x53,
+	0x0a, 0x11, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12,
+	0x22, 0x0a, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e,
+	0x74, 0x49, 0x44, 0x22, 0x57, 0x0a, 0x0f, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65,
+	0x49, 0x44, 0x12, 0x28, 0x0a, 0x08, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x61, 0x67, 0x2e, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x08, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x4f, 0x0a, 0x0d,
+	0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a,
+	0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x06, 0x61, 0x6e, 0x73,
+	0x77, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x61, 0x67, 0x2e, 0x41,
+	0x6e, 0x73, 0x77, 0x65, 0x72, 0x52, 0x06, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x22, 0x4a, 0x0a,
+	0x14, 0x45, 0x78, 0x70, 0x6f, 0x73, 0x75, 0x72, 0x65, 0x41, 0x75, 0x64, 0x69, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49,
+	0x44, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x06, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x22, 0x59, 0x0a, 0x13, 0x41, 0x6e, 0x6e,
+	0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x26, 0x0a, 0x0e,
+	0x61, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x61, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x49, 0x44, 0x22, 0x06, 0x0a, 0x04, 0x56, 0x6f, 0x69, 0x64, 0x32, 0xbc, 0x3e, 0x0a,
+	0x11, 0x41, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x12, 0x1f, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x12, 0x08, 0x2e,
+	0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x1a, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x55, 0x73, 0x65,
+	0x72, 0x22, 0x00, 0x12, 0x29, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12,
+	0x10, 0x2e, 0x61, 0x67, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x09, 0x2e, 0x61, 0x67, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x73, 0x22, 0x00, 0x12, 0x34,
+	0x0a, 0x0f, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x42, 0x79, 0x43, 0x6f, 0x75, 0x72, 0x73,
+	0x65, 0x12, 0x15, 0x2e, 0x61, 0x67, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x55, 0x73, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x55, 0x73,
+	0x65, 0x72, 0x22, 0x00, 0x12, 0x22, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73,
+	0x65, 0x72, 0x12, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x1a, 0x08, 0x2e, 0x61,
+	0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x31, 0x0a, 0x0e, 0x45, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x55, 0x73, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x12, 0x0f, 0x2e, 0x61, 0x67, 0x2e,
+	0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x61, 0x67,
+	0x2e, 0x55, 0x73, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x22, 0x00, 0x12, 0x2d, 0x0a, 0x0e, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x12, 0x0f, 0x2e,
+	0x61, 0x67, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x08,
+	0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x3c, 0x0a, 0x13, 0x49, 0x73,
+	0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64, 0x54, 0x65, 0x61, 0x63, 0x68, 0x65,
+	0x72, 0x12, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x1a, 0x19, 0x2e, 0x61, 0x67,
+	0x2e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x52,
+	0x65, 0x6d, 0x6f, 0x74, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12,
+	0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x1a, 0x14, 0x2e, 0x61, 0x67, 0x2e, 0x52,
+	0x65, 0x6d, 0x6f, 0x74, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x22,
+	0x00, 0x12, 0x3d, 0x0a, 0x14, 0x55, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x6d, 0x6f, 0x74,
+	0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x19, 0x2e, 0x61, 0x67, 0x2e, 0x55,
+	0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00,
+	0x12, 0x25, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x08,
+	0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x1a, 0x0b, 0x2e, 0x61, 0x67, 0x2e, 0x54, 0x65,
+	0x6e, 0x61, 0x6e, 0x74, 0x73, 0x22, 0x00, 0x12, 0x28, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x12, 0x0a, 0x2e, 0x61, 0x67, 0x2e, 0x54, 0x65, 0x6e,
+	0x61, 0x6e, 0x74, 0x1a, 0x0a, 0x2e, 0x61, 0x67, 0x2e, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x22,
+	0x00, 0x12, 0x26, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e,
+	0x74, 0x12, 0x0a, 0x2e, 0x61, 0x67, 0x2e, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x1a, 0x08, 0x2e,
+	0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x32, 0x0a, 0x0d, 0x53, 0x65, 0x74,
+	0x55, 0x73, 0x65, 0x72, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x12, 0x15, 0x2e, 0x61, 0x67, 0x2e,
+	0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x2c, 0x0a,
+	0x08, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x13, 0x2e, 0x61, 0x67, 0x2e, 0x47,
+	0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x09,
+	0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x22, 0x00, 0x12, 0x38, 0x0a, 0x17, 0x47,
+	0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x41, 0x6e, 0x64,
+	0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x12, 0x10, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x09, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x73, 0x42, 0x79, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x12, 0x11, 0x2e, 0x61, 0x67, 0x2e,
+	0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0a, 0x2e,
+	0x61, 0x67, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x22, 0x00, 0x12, 0x25, 0x0a, 0x0b, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x09, 0x2e, 0x61, 0x67, 0x2e,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x1a, 0x09, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x22, 0x00, 0x12, 0x24, 0x0a, 0x0b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x12, 0x09, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x1a, 0x08, 0x2e, 0x61,
+	0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x2b, 0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x10, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56,
+	0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x2d, 0x0a, 0x0c, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x10, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x09, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x0d, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x54, 0x6f,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x13, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x13, 0x2e, 0x61, 0x67, 0x2e,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+	0x00, 0x12, 0x3b, 0x0a, 0x18, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x54, 0x6f, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x13, 0x2e,
+	0x61, 0x67, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x1a, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x40,
+	0x0a, 0x13, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x11, 0x2e, 0x61, 0x67, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x00,
+	0x12, 0x47, 0x0a, 0x0e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x73, 0x12, 0x19, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e,
+	0x61, 0x67, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x73, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x00, 0x12, 0x2c, 0x0a, 0x09, 0x47, 0x65, 0x74,
+	0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x12, 0x11, 0x2e, 0x61, 0x67, 0x2e, 0x43, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0a, 0x2e, 0x61, 0x67, 0x2e, 0x43,
+	0x6f, 0x75, 0x72, 0x73, 0x65, 0x22, 0x00, 0x12, 0x25, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x43, 0x6f,
+	0x75, 0x72, 0x73, 0x65, 0x73, 0x12, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x1a,
+	0x0b, 0x2e, 0x61, 0x67, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x22, 0x00, 0x12, 0x3e,
+	0x0a, 0x10, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x42, 0x79, 0x55, 0x73,
+	0x65, 0x72, 0x12, 0x1b, 0x2e, 0x61, 0x67, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65,
+	0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x0b, 0x2e, 0x61, 0x67, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x22, 0x00, 0x12, 0x28,
+	0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x12, 0x0a,
+	0x2e, 0x61, 0x67, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x1a, 0x0a, 0x2e, 0x61, 0x67, 0x2e,
+	0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x22, 0x00, 0x12, 0x26, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x12, 0x0a, 0x2e, 0x61, 0x67, 0x2e, 0x43, 0x6f,
+	0x75, 0x72, 0x73, 0x65, 0x1a, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00,
+	0x12, 0x34, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65,
+	0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x0e, 0x2e, 0x61, 0x67, 0x2e,
+	0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x1a, 0x08, 0x2e, 0x61, 0x67, 0x2e,
+	0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x35, 0x0a, 0x0d, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76,
+	0x65, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x12, 0x18, 0x2e, 0x61, 0x67, 0x2e, 0x43, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x34, 0x0a,
+	0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x12, 0x18, 0x2e,
+	0x61, 0x67, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69,
+	0x64, 0x22, 0x00, 0x12, 0x2f, 0x0a, 0x0c, 0x52, 0x65, 0x70, 0x61, 0x69, 0x72, 0x43, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x12, 0x11, 0x2e, 0x61, 0x67, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0a, 0x2e, 0x61, 0x67, 0x2e, 0x43, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x33, 0x0a, 0x0b, 0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x43, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x12, 0x16, 0x2e, 0x61, 0x67, 0x2e, 0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x43, 0x6f,
+	0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0a, 0x2e, 0x61, 0x67,
+	0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x15, 0x50, 0x72, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x0a, 0x2e, 0x61, 0x67, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x1a, 0x16,
+	0x2e, 0x61, 0x67, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x50, 0x6c, 0x61, 0x6e, 0x22, 0x00, 0x12, 0x38, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x47,
+	0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x61, 0x6c, 0x65, 0x12, 0x11, 0x2e, 0x61, 0x67,
+	0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10,
+	0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x61, 0x6c, 0x65,
+	0x22, 0x00, 0x12, 0x32, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x61, 0x64,
+	0x69, 0x6e, 0x67, 0x53, 0x63, 0x61, 0x6c, 0x65, 0x12, 0x10, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72,
+	0x61, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x61, 0x6c, 0x65, 0x1a, 0x08, 0x2e, 0x61, 0x67, 0x2e,
+	0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x12, 0x43, 0x6f, 0x6d, 0x70, 0x75, 0x74,
+	0x65, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x47, 0x72, 0x61, 0x64, 0x65, 0x73, 0x12, 0x11, 0x2e, 0x61,
+	0x67, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x0f, 0x2e, 0x61, 0x67, 0x2e, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x47, 0x72, 0x61, 0x64, 0x65, 0x73,
+	0x22, 0x00, 0x12, 0x40, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x43,
+	0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x11, 0x2e, 0x61, 0x67, 0x2e, 0x43,
+	0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x61,
+	0x67, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69,
+	0x6f, 0x6e, 0x22, 0x00, 0x12, 0x38, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x53, 0x63, 0x6f, 0x72, 0x65,
+	0x43, 0x75, 0x72, 0x76, 0x65, 0x12, 0x15, 0x2e, 0x61, 0x67, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67,
+	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x61,
+	0x67, 0x2e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x43, 0x75, 0x72, 0x76, 0x65, 0x22, 0x00, 0x12, 0x33,
+	0x0a, 0x0f, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x43, 0x75, 0x72, 0x76,
+	0x65, 0x12, 0x0e, 0x2e, 0x61, 0x67, 0x2e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x43, 0x75, 0x72, 0x76,
+	0x65, 0x1a, 0x0e, 0x2e, 0x61, 0x67, 0x2e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x43, 0x75, 0x72, 0x76,
+	0x65, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x0d, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x73, 0x12, 0x18, 0x2e, 0x61, 0x67, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x73, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11,
+	0x2e, 0x61, 0x67, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x45, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x22, 0x00, 0x12, 0x36, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x11, 0x2e, 0x61, 0x67, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x61, 0x67, 0x2e, 0x43, 0x65,
+	0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x22, 0x00, 0x12, 0x36, 0x0a, 0x0e, 0x47,
+	0x65, 0x74, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x11, 0x2e,
+	0x61, 0x67, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x0f, 0x2e, 0x61, 0x67, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x22, 0x00, 0x12, 0x32, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x73, 0x73,
+	0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x11, 0x2e, 0x61, 0x67, 0x2e, 0x43, 0x6f,
+	0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x08, 0x2e, 0x61, 0x67,
+	0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x16, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x65, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x69, 0x6c,
+	0x65, 0x12, 0x12, 0x2e, 0x61, 0x67, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e,
+	0x74, 0x46, 0x69, 0x6c, 0x65, 0x1a, 0x18, 0x2e, 0x61, 0x67, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67,
+	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x22,
+	0x00, 0x12, 0x44, 0x0a, 0x13, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x41, 0x73, 0x73,
+	0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x11, 0x2e, 0x61, 0x67, 0x2e, 0x43, 0x6f,
+	0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x61, 0x67,
+	0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x45, 0x6e,
+	0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x12,
+	0x1b, 0x2e, 0x61, 0x67, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x61,
+	0x67, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x00, 0x12,
+	0x2f, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x1a, 0x10, 0x2e, 0x61,
+	0x67, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x22, 0x00,
+	0x12, 0x32, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x53, 0x6c, 0x69, 0x70, 0x44, 0x61, 0x79, 0x73, 0x12,
+	0x13, 0x2e, 0x61, 0x67, 0x2e, 0x53, 0x6c, 0x69, 0x70, 0x44, 0x61, 0x79, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x61, 0x67, 0x2e, 0x53, 0x6c, 0x69, 0x70, 0x44, 0x61,
+	0x79, 0x73, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x72, 0x6f, 0x6c,
+	0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x42, 0x79, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x12, 0x15,
+	0x2e, 0x61, 0x67, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x61, 0x67, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c,
+	0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x00, 0x12, 0x2e, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x2e, 0x61,
+	0x67, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x1a, 0x08, 0x2e, 0x61,
+	0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x2e, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x2e, 0x61,
+	0x67, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x1a, 0x08, 0x2e, 0x61,
+	0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x32, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x11, 0x2e,
+	0x61, 0x67, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x35, 0x0a, 0x11,
+	0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e,
+	0x74, 0x12, 0x0e, 0x2e, 0x61, 0x67, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e,
+	0x74, 0x1a, 0x0e, 0x2e, 0x61, 0x67, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e,
+	0x74, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x11, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x6e, 0x72,
+	0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1c, 0x2e, 0x61, 0x67, 0x2e, 0x49, 0x6d,
+	0x70, 0x6f, 0x72, 0x74, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x67, 0x2e, 0x45, 0x6e, 0x72, 0x6f,
+	0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x15, 0x2e, 0x61, 0x67, 0x2e, 0x53, 0x75, 0x62, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e,
+	0x61, 0x67, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x00,
+	0x12, 0x43, 0x0a, 0x10, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x12, 0x1b, 0x2e, 0x61, 0x67, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x0e, 0x2e, 0x61, 0x67, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x22, 0x00, 0x30, 0x01, 0x12, 0x52, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x79, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x12,
+	0x1f, 0x2e, 0x61, 0x67, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x46, 0x6f, 0x72, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x15, 0x2e, 0x61, 0x67, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x53, 0x75, 0x62, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x10, 0x47, 0x65, 0x74,
+	0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x11, 0x2e,
+	0x61, 0x67, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x11, 0x2e, 0x61, 0x67, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x73, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x11, 0x2e, 0x61,
+	0x67, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x14, 0x2e, 0x61, 0x67, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x53, 0x74, 0x61, 0x74, 0x69,
+	0x73, 0x74, 0x69, 0x63, 0x73, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x54, 0x65,
+	0x73, 0x74, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x15,
+	0x2e, 0x61, 0x67, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x61, 0x67, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x46,
+	0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x22, 0x00, 0x12, 0x4a, 0x0a,
+	0x13, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x1b, 0x2e, 0x61, 0x67, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x14, 0x2e, 0x61, 0x67, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x16, 0x47, 0x65, 0x74,
+	0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61,
+	0x63, 0x74, 0x73, 0x12, 0x13, 0x2e, 0x61, 0x67, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x61, 0x67, 0x2e, 0x41, 0x72,
+	0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x73, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x0e, 0x47, 0x65, 0x74,
+	0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x15, 0x2e, 0x61, 0x67,
+	0x2e, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x61, 0x67, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x73, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x54, 0x61, 0x73, 0x6b,
+	0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x12, 0x14, 0x2e, 0x61, 0x67, 0x2e, 0x54, 0x61, 0x73, 0x6b,
+	0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x61,
+	0x67, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x22, 0x00, 0x12, 0x3b,
+	0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x1b, 0x2e, 0x61, 0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x75,
+	0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x11, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x1c, 0x2e, 0x61, 0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x08,
+	0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x14, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x1f, 0x2e, 0x61, 0x67, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x61, 0x67, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x1f, 0x2e, 0x61, 0x67, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x19, 0x2e, 0x61, 0x67, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x00, 0x12,
+	0x39, 0x0a, 0x11, 0x52, 0x65, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x2e, 0x61, 0x67, 0x2e, 0x52, 0x65, 0x62, 0x75, 0x69, 0x6c,
+	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x61, 0x67, 0x2e, 0x53, 0x75,
+	0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x12, 0x52, 0x65,
+	0x62, 0x75, 0x69, 0x6c, 0x64, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x15, 0x2e, 0x61, 0x67, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69,
+	0x64, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x13, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x4d, 0x69,
+	0x73, 0x73, 0x65, 0x64, 0x50, 0x75, 0x73, 0x68, 0x65, 0x73, 0x12, 0x17, 0x2e, 0x61, 0x67, 0x2e,
+	0x4d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x50, 0x75, 0x73, 0x68, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x61, 0x67, 0x2e, 0x4d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x50,
+	0x75, 0x73, 0x68, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x00, 0x12, 0x3a, 0x0a,
+	0x11, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x11, 0x2e, 0x61, 0x67, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x61, 0x67, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x61,
+	0x79, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x00, 0x12, 0x36, 0x0a, 0x0e, 0x47, 0x65, 0x74,
+	0x46, 0x6f, 0x72, 0x63, 0x65, 0x50, 0x75, 0x73, 0x68, 0x65, 0x73, 0x12, 0x11, 0x2e, 0x61, 0x67,
+	0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f,
+	0x2e, 0x61, 0x67, 0x2e, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x50, 0x75, 0x73, 0x68, 0x65, 0x73, 0x22,
+	0x00, 0x12, 0x28, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x70, 0x70, 0x65, 0x61,
+	0x6c, 0x12, 0x0a, 0x2e, 0x61, 0x67, 0x2e, 0x41, 0x70, 0x70, 0x65, 0x61, 0x6c, 0x1a, 0x0a, 0x2e,
+	0x61, 0x67, 0x2e, 0x41, 0x70, 0x70, 0x65, 0x61, 0x6c, 0x22, 0x00, 0x12, 0x26, 0x0a, 0x0c, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x70, 0x70, 0x65, 0x61, 0x6c, 0x12, 0x0a, 0x2e, 0x61, 0x67,
+	0x2e, 0x41, 0x70, 0x70, 0x65, 0x61, 0x6c, 0x1a, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69,
+	0x64, 0x22, 0x00, 0x12, 0x2e, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x65, 0x61, 0x6c,
+	0x73, 0x12, 0x11, 0x2e, 0x61, 0x67, 0x2e, 0x41, 0x70, 0x70, 0x65, 0x61, 0x6c, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x0b, 0x2e, 0x61, 0x67, 0x2e, 0x41, 0x70, 0x70, 0x65, 0x61, 0x6c,
+	0x73, 0x22, 0x00, 0x12, 0x30, 0x0a, 0x0e, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x45, 0x78, 0x74, 0x65,
+	0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x0d, 0x2e, 0x61, 0x67, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e,
+	0x73, 0x69, 0x6f, 0x6e, 0x1a, 0x0d, 0x2e, 0x61, 0x67, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73,
+	0x69, 0x6f, 0x6e, 0x22, 0x00, 0x12, 0x38, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x74,
+	0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x14, 0x2e, 0x61, 0x67, 0x2e, 0x45, 0x78, 0x74,
+	0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e,
+	0x61, 0x67, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x00, 0x12,
+	0x44, 0x0a, 0x12, 0x52, 0x75, 0x6e, 0x50, 0x6c, 0x61, 0x67, 0x69, 0x61, 0x72, 0x69, 0x73, 0x6d,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x15, 0x2e, 0x61, 0x67, 0x2e, 0x50, 0x6c, 0x61, 0x67, 0x69,
+	0x61, 0x72, 0x69, 0x73, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x61,
+	0x67, 0x2e, 0x50, 0x6c, 0x61, 0x67, 0x69, 0x61, 0x72, 0x69, 0x73, 0x6d, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x73, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x67,
+	0x69, 0x61, 0x72, 0x69, 0x73, 0x6d, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x15, 0x2e,
+	0x61, 0x67, 0x2e, 0x50, 0x6c, 0x61, 0x67, 0x69, 0x61, 0x72, 0x69, 0x73, 0x6d, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x61, 0x67, 0x2e, 0x50, 0x6c, 0x61, 0x67, 0x69, 0x61,
+	0x72, 0x69, 0x73, 0x6d, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x00, 0x12, 0x3f, 0x0a,
+	0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b,
+	0x12, 0x14, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x65, 0x6e,
+	0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x1a, 0x14, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x61, 0x64,
+	0x69, 0x6e, 0x67, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x22, 0x00, 0x12, 0x33,
+	0x0a, 0x0f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72,
+	0x6b, 0x12, 0x14, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x65,
+	0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x1a, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69,
+	0x64, 0x22, 0x00, 0x12, 0x33, 0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x65, 0x6e,
+	0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x12, 0x14, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x61, 0x64,
+	0x69, 0x6e, 0x67, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x1a, 0x08, 0x2e, 0x61,
+	0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x3f, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x2e, 0x61, 0x67,
+	0x2e, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f,
+	0x6e, 0x1a, 0x14, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x72,
+	0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x12, 0x33, 0x0a, 0x0f, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x2e, 0x61,
+	0x67, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69,
+	0x6f, 0x6e, 0x1a, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x33,
+	0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f,
+	0x6e, 0x12, 0x14, 0x2e, 0x61, 0x67, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x72,
+	0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x1a, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69,
+	0x64, 0x22, 0x00, 0x12, 0x2f, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x12, 0x11, 0x2e, 0x61, 0x67, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0a, 0x2e, 0x61, 0x67, 0x2e, 0x52, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x22, 0x00, 0x12, 0x2f, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x12, 0x11, 0x2e, 0x61, 0x67, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0a, 0x2e, 0x61, 0x67, 0x2e, 0x52, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x22, 0x00, 0x12, 0x3f, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x52, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x65, 0x72, 0x73, 0x12, 0x1e, 0x2e, 0x61, 0x67, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x61, 0x67, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x65, 0x72, 0x73, 0x22, 0x00, 0x12, 0x33, 0x0a, 0x0e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73,
+	0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x12, 0x15, 0x2e, 0x61, 0x67, 0x2e, 0x41, 0x73,
+	0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x11, 0x41,
+	0x73, 0x73, 0x69, 0x67, 0x6e, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73,
+	0x12, 0x15, 0x2e, 0x61, 0x67, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x61, 0x67, 0x2e, 0x50, 0x65, 0x65,
+	0x72, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x12, 0x47, 0x65,
+	0x74, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x54, 0x61, 0x73, 0x6b, 0x73,
+	0x12, 0x15, 0x2e, 0x61, 0x67, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x61, 0x67, 0x2e, 0x50, 0x65, 0x65,
+	0x72, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x10, 0x53, 0x75,
+	0x62, 0x6d, 0x69, 0x74, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x0e,
+	0x2e, 0x61, 0x67, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x1a, 0x0e,
+	0x2e, 0x61, 0x67, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x22, 0x00,
+	0x12, 0x3a, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x73, 0x12, 0x15, 0x2e, 0x61, 0x67, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x61, 0x67, 0x2e, 0x50,
+	0x65, 0x65, 0x72, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x22, 0x00, 0x12, 0x36, 0x0a, 0x12,
+	0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x65, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x12, 0x0e, 0x2e, 0x61, 0x67, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x1a, 0x0e, 0x2e, 0x61, 0x67, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x12, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x50,
+	0x65, 0x65, 0x72, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x12, 0x15, 0x2e, 0x61, 0x67, 0x2e,
+	0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x49, 0x0a,
+	0x17, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x15, 0x2e, 0x61, 0x67, 0x2e, 0x53, 0x75,
+	0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x1a,
+	0x15, 0x2e, 0x61, 0x67, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x17, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x12, 0x15, 0x2e, 0x61, 0x67, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x1a, 0x15, 0x2e, 0x61, 0x67, 0x2e,
+	0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x22, 0x00, 0x12, 0x3c, 0x0a, 0x17, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x75, 0x62,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x15,
+	0x2e, 0x61, 0x67, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x1a, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22,
+	0x00, 0x12, 0x4f, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1c, 0x2e, 0x61, 0x67, 0x2e,
+	0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x61, 0x67, 0x2e, 0x53, 0x75,
+	0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x22, 0x00, 0x12, 0x36, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x15, 0x2e, 0x61, 0x67, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x61, 0x67, 0x2e, 0x51,
+	0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x00, 0x12, 0x35, 0x0a, 0x0e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x13, 0x2e, 0x61,
+	0x67, 0x2e, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x0c, 0x2e, 0x61, 0x67, 0x2e, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+	0x00, 0x12, 0x31, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x51, 0x75, 0x65, 0x73, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x13, 0x2e, 0x61, 0x67, 0x2e, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f,
+	0x69, 0x64, 0x22, 0x00, 0x12, 0x31, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x51, 0x75,
+	0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x13, 0x2e, 0x61, 0x67, 0x2e, 0x51, 0x75, 0x65, 0x73,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x08, 0x2e, 0x61, 0x67,
+	0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x2f, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x12, 0x11, 0x2e, 0x61, 0x67, 0x2e, 0x41, 0x6e, 0x73,
+	0x77, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0a, 0x2e, 0x61, 0x67, 0x2e,
+	0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x22, 0x00, 0x12, 0x2d, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x12, 0x11, 0x2e, 0x61, 0x67, 0x2e, 0x41, 0x6e,
+	0x73, 0x77, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x08, 0x2e, 0x61, 0x67,
+	0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x2d, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x12, 0x11, 0x2e, 0x61, 0x67, 0x2e, 0x41, 0x6e, 0x73,
+	0x77, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x08, 0x2e, 0x61, 0x67, 0x2e,
+	0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x41, 0x73, 0x73,
+	0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x15, 0x2e, 0x61, 0x67, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x61, 0x67, 0x2e, 0x44,
+	0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x10,
+	0x47, 0x65, 0x74, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x12, 0x11, 0x2e, 0x61, 0x67, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x61, 0x67, 0x2e, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x10,
+	0x2e, 0x61, 0x67, 0x2e, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x1a, 0x10, 0x2e, 0x61, 0x67, 0x2e, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x22, 0x00, 0x12, 0x32, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x6e,
+	0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x10, 0x2e, 0x61, 0x67, 0x2e,
+	0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x1a, 0x08, 0x2e, 0x61,
+	0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x14, 0x4d, 0x61, 0x72, 0x6b,
+	0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x61, 0x64,
+	0x12, 0x17, 0x2e, 0x61, 0x67, 0x2e, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56,
+	0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x46, 0x65, 0x65, 0x64, 0x55, 0x52, 0x4c, 0x12, 0x11, 0x2e, 0x61, 0x67, 0x2e, 0x43,
+	0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0b, 0x2e, 0x61,
+	0x67, 0x2e, 0x46, 0x65, 0x65, 0x64, 0x55, 0x52, 0x4c, 0x22, 0x00, 0x12, 0x41, 0x0a, 0x10, 0x47,
+	0x65, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x18, 0x2e, 0x61, 0x67, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x61, 0x67, 0x2e, 0x4e,
+	0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x00, 0x12, 0x3b,
+	0x0a, 0x14, 0x4d, 0x61, 0x72, 0x6b, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x61, 0x64, 0x12, 0x17, 0x2e, 0x61, 0x67, 0x2e, 0x4e, 0x6f, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x29, 0x0a, 0x0c, 0x47,
+	0x65, 0x74, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x12, 0x08, 0x2e, 0x61, 0x67,
+	0x2e, 0x56, 0x6f, 0x69, 0x64, 0x1a, 0x0d, 0x2e, 0x61, 0x67, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x73, 0x22, 0x00, 0x12, 0x35, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x67,
+	0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x2e, 0x61, 0x67, 0x2e, 0x4f,
+	0x72, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x61, 0x67, 0x2e, 0x4f,
+	0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x12, 0x35, 0x0a,
+	0x0f, 0x47, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73,
+	0x12, 0x0e, 0x2e, 0x61, 0x67, 0x2e, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x10, 0x2e, 0x61, 0x67, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x69,
+	0x65, 0x73, 0x22, 0x00, 0x12, 0x30, 0x0a, 0x0b, 0x49, 0x73, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52,
+	0x65, 0x70, 0x6f, 0x12, 0x15, 0x2e, 0x61, 0x67, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x08, 0x2e, 0x61, 0x67, 0x2e,
+	0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x70,
+	0x68, 0x61, 0x6e, 0x65, 0x64, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x11,
+	0x2e, 0x61, 0x67, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x15, 0x2e, 0x61, 0x67, 0x2e, 0x4f, 0x72, 0x70, 0x68, 0x61, 0x6e, 0x65, 0x64, 0x52,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x12, 0x41, 0x75,
+	0x64, 0x69, 0x74, 0x54, 0x65, 0x73, 0x74, 0x73, 0x45, 0x78, 0x70, 0x6f, 0x73, 0x75, 0x72, 0x65,
+	0x12, 0x18, 0x2e, 0x61, 0x67, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x73, 0x75, 0x72, 0x65, 0x41, 0x75,
+	0x64, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x61, 0x67, 0x2e,
+	0x45, 0x78, 0x70, 0x6f, 0x73, 0x75, 0x72, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x00,
+	0x12, 0x38, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x12, 0x11, 0x2e, 0x61, 0x67, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x61, 0x67, 0x2e, 0x41, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x00, 0x12, 0x56, 0x0a, 0x19, 0x53, 0x65,
+	0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72,
+	0x79, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x1b, 0x2e, 0x61, 0x67, 0x2e, 0x52, 0x65, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x67, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x6f, 0x72, 0x79, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x22, 0x00, 0x12, 0x31, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4f, 0x76,
+	0x65, 0x72, 0x76, 0x69, 0x65, 0x77, 0x12, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64,
+	0x1a, 0x11, 0x2e, 0x61, 0x67, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4f, 0x76, 0x65, 0x72, 0x76,
+	0x69, 0x65, 0x77, 0x22, 0x00, 0x12, 0x27, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x54, 0x65, 0x73, 0x74,
+	0x4a, 0x6f, 0x62, 0x73, 0x12, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x1a, 0x0c,
+	0x2e, 0x61, 0x67, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x73, 0x22, 0x00, 0x12, 0x28,
+	0x0a, 0x0d, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x54, 0x65, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x12,
+	0x0b, 0x2e, 0x61, 0x67, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x1a, 0x08, 0x2e, 0x61,
+	0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x57,
+	0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73,
+	0x12, 0x1a, 0x2e, 0x61, 0x67, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c,
+	0x69, 0x76, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x61,
+	0x67, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72,
+	0x69, 0x65, 0x73, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x15, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x57,
+	0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x12, 0x1a,
+	0x2e, 0x61, 0x67, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76,
+	0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x61, 0x67, 0x2e,
+	0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x22,
+	0x00, 0x12, 0x32, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67,
+	0x12, 0x13, 0x2e, 0x61, 0x67, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x61, 0x67, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74,
+	0x4c, 0x6f, 0x67, 0x22, 0x00, 0x12, 0x41, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x16, 0x2e, 0x61, 0x67, 0x2e,
+	0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x12, 0x2e, 0x61, 0x67, 0x2e, 0x4e, 0x65, 0x77, 0x41, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x00, 0x12, 0x2f, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x41,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x08, 0x2e, 0x61, 0x67,
+	0x2e, 0x56, 0x6f, 0x69, 0x64, 0x1a, 0x10, 0x2e, 0x61, 0x67, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x22, 0x00, 0x12, 0x30, 0x0a, 0x11, 0x52, 0x65, 0x76,
+	0x6f, 0x6b, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x0f,
+	0x2e, 0x61, 0x67, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x1a,
+	0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x27, 0x0a, 0x0b, 0x47,
+	0x65, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x08, 0x2e, 0x61, 0x67, 0x2e,
+	0x56, 0x6f, 0x69, 0x64, 0x1a, 0x0c, 0x2e, 0x61, 0x67, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x22, 0x00, 0x12, 0x28, 0x0a, 0x0d, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x53, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x0b, 0x2e, 0x61, 0x67, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x1a, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x29,
+	0x0a, 0x0a, 0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x55, 0x73, 0x65, 0x72, 0x12, 0x0f, 0x2e, 0x61,
+	0x67, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x08, 0x2e,
+	0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x33, 0x0a, 0x11, 0x47, 0x65, 0x74,
+	0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x08,
+	0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x1a, 0x12, 0x2e, 0x61, 0x67, 0x2e, 0x50, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x00, 0x12, 0x35,
+	0x0a, 0x14, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x11, 0x2e, 0x61, 0x67, 0x2e, 0x50, 0x65, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56,
+	0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x13, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x50,
+	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x11, 0x2e, 0x61,
+	0x67, 0x2e, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x1a,
+	0x08, 0x2e, 0x61, 0x67, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x42, 0x26, 0x5a, 0x21, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72,
+	0x61, 0x64, 0x65, 0x2f, 0x71, 0x75, 0x69, 0x63, 0x6b, 0x66, 0x65, 0x65, 0x64, 0x2f, 0x61, 0x67,
+	0xba, 0x02, 0x00, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_ag_ag_proto_rawDescOnce sync.Once
+	file_ag_ag_proto_rawDescData = file_ag_ag_proto_rawDesc
+)
+
+func file_ag_ag_proto_rawDescGZIP() []byte {
+	file_ag_ag_proto_rawDescOnce.Do(func() {
+		file_ag_ag_proto_rawDescData = protoimpl.X.CompressGZIP(file_ag_ag_proto_rawDescData)
+	})
+	return file_ag_ag_proto_rawDescData
+}
+
+var file_ag_ag_proto_enumTypes = make([]protoimpl.EnumInfo, 25)
+var file_ag_ag_proto_msgTypes = make([]protoimpl.MessageInfo, 180)
+var file_ag_ag_proto_goTypes = []interface{}{
+	(Group_GroupStatus)(0),                     // 0: ag.Group.GroupStatus
+	(GroupInvitation_Status)(0),                // 1: ag.GroupInvitation.Status
+	(Course_SetupStep)(0),                      // 2: ag.Course.SetupStep
+	(Course_AssistantPermission)(0),            // 3: ag.Course.AssistantPermission
+	(CourseCleanupRequest_RepositoryAction)(0), // 4: ag.CourseCleanupRequest.RepositoryAction
+	(SCMOperation_Kind)(0),                     // 5: ag.SCMOperation.Kind
+	(ScoreCurve_Method)(0),                     // 6: ag.ScoreCurve.Method
+	(ResultsExportRequest_Format)(0),           // 7: ag.ResultsExportRequest.Format
+	(Repository_Type)(0),                       // 8: ag.Repository.Type
+	(Enrollment_UserStatus)(0),                 // 9: ag.Enrollment.UserStatus
+	(Enrollment_DisplayState)(0),               // 10: ag.Enrollment.DisplayState
+	(Submission_Status)(0),                     // 11: ag.Submission.Status
+	(Submission_ExceededLimit)(0),              // 12: ag.Submission.ExceededLimit
+	(PolicyViolation_Rule)(0),                  // 13: ag.PolicyViolation.Rule
+	(TestResult_Status)(0),                     // 14: ag.TestResult.Status
+	(Appeal_Status)(0),                         // 15: ag.Appeal.Status
+	(TestJob_Status)(0),                        // 16: ag.TestJob.Status
+	(TestJob_Priority)(0),                      // 17: ag.TestJob.Priority
+	(WebhookDelivery_Status)(0),                // 18: ag.WebhookDelivery.Status
+	(PendingAction_Action)(0),                  // 19: ag.PendingAction.Action
+	(GradingCriterion_Grade)(0),                // 20: ag.GradingCriterion.Grade
+	(CourseEvent_Type)(0),                      // 21: ag.CourseEvent.Type
+	(Event_Type)(0),                            // 22: ag.Event.Type
+	(SubmissionStatus_State)(0),                // 23: ag.SubmissionStatus.State
+	(SubmissionsForCourseRequest_Type)(0),      // 24: ag.SubmissionsForCourseRequest.Type
+	(*User)(nil),                               // 25: ag.User
+	(*Users)(nil),                              // 26: ag.Users
+	(*UsersRequest)(nil),                       // 27: ag.UsersRequest
+	(*UserData)(nil),                           // 28: ag.UserData
+	(*RemoteIdentity)(nil),                     // 29: ag.RemoteIdentity
+	(*RemoteIdentities)(nil),                   // 30: ag.RemoteIdentities
+	(*UnlinkIdentityRequest)(nil),              // 31: ag.UnlinkIdentityRequest
+	(*Group)(nil),                              // 32: ag.Group
+	(*Groups)(nil),                             // 33: ag.Groups
+	(*GroupInvitation)(nil),                    // 34: ag.GroupInvitation
+	(*GroupInvitations)(nil),                   // 35: ag.GroupInvitations
+	(*GenerateGroupsRequest)(nil),              // 36: ag.GenerateGroupsRequest
+	(*GenerateGroupsReport)(nil),               // 37: ag.GenerateGroupsReport
+	(*Tenant)(nil),                             // 38: ag.Tenant
+	(*Tenants)(nil),                            // 39: ag.Tenants
+	(*TenantUserRequest)(nil),                  // 40: ag.TenantUserRequest
+	(*Course)(nil),                             // 41: ag.Course
+	(*CourseCleanupRequest)(nil),               // 42: ag.CourseCleanupRequest
+	(*CloneCourseRequest)(nil),                 // 43: ag.CloneCourseRequest
+	(*CourseCreationPlan)(nil),                 // 44: ag.CourseCreationPlan
+	(*SCMOperation)(nil),                       // 45: ag.SCMOperation
+	(*Certificate)(nil),                        // 46: ag.Certificate
+	(*GradeThreshold)(nil),                     // 47: ag.GradeThreshold
+	(*GradingScale)(nil),                       // 48: ag.GradingScale
+	(*FinalGrade)(nil),                         // 49: ag.FinalGrade
+	(*ScoreCurve)(nil),                         // 50: ag.ScoreCurve
+	(*FinalGrades)(nil),                        // 51: ag.FinalGrades
+	(*CourseCompletion)(nil),                   // 52: ag.CourseCompletion
+	(*StudentCompletion)(nil),                  // 53: ag.StudentCompletion
+	(*ResultsExportRequest)(nil),               // 54: ag.ResultsExportRequest
+	(*ResultsExport)(nil),                      // 55: ag.ResultsExport
+	(*CourseResults)(nil),                      // 56: ag.CourseResults
+	(*ResultsRow)(nil),                         // 57: ag.ResultsRow
+	(*CourseStatistics)(nil),                   // 58: ag.CourseStatistics
+	(*AssignmentStatistics)(nil),               // 59: ag.AssignmentStatistics
+	(*TestFailureStats)(nil),                   // 60: ag.TestFailureStats
+	(*TestStatistics)(nil),                     // 61: ag.TestStatistics
+	(*DailySubmissions)(nil),                   // 62: ag.DailySubmissions
+	(*UserProgress)(nil),                       // 63: ag.UserProgress
+	(*CourseProgress)(nil),                     // 64: ag.CourseProgress
+	(*UpcomingDeadline)(nil),                   // 65: ag.UpcomingDeadline
+	(*Courses)(nil),                            // 66: ag.Courses
+	(*Repository)(nil),                         // 67: ag.Repository
+	(*Enrollment)(nil),                         // 68: ag.Enrollment
+	(*UsedSlipDays)(nil),                       // 69: ag.UsedSlipDays
+	(*SlipDaysRequest)(nil),                    // 70: ag.SlipDaysRequest
+	(*SlipDays)(nil),                           // 71: ag.SlipDays
+	(*Enrollments)(nil),                        // 72: ag.Enrollments
+	(*PreEnrollment)(nil),                      // 73: ag.PreEnrollment
+	(*SubmissionLink)(nil),                     // 74: ag.SubmissionLink
+	(*EnrollmentLink)(nil),                     // 75: ag.EnrollmentLink
+	(*CourseSubmissions)(nil),                  // 76: ag.CourseSubmissions
+	(*Assignment)(nil),                         // 77: ag.Assignment
+	(*Assignments)(nil),                        // 78: ag.Assignments
+	(*AssignmentFile)(nil),                     // 79: ag.AssignmentFile
+	(*AssignmentFileError)(nil),                // 80: ag.AssignmentFileError
+	(*AssignmentFileErrors)(nil),               // 81: ag.AssignmentFileErrors
+	(*AssignmentValidation)(nil),               // 82: ag.AssignmentValidation
+	(*Task)(nil),                               // 83: ag.Task
+	(*TaskScore)(nil),                          // 84: ag.TaskScore
+	(*TaskScores)(nil),                         // 85: ag.TaskScores
+	(*Submission)(nil),                         // 86: ag.Submission
+	(*SubmissionTransition)(nil),               // 87: ag.SubmissionTransition
+	(*SubmissionTransitions)(nil),              // 88: ag.SubmissionTransitions
+	(*SubmissionTransitionRequest)(nil),        // 89: ag.SubmissionTransitionRequest
+	(*FileCoverage)(nil),                       // 90: ag.FileCoverage
+	(*PolicyViolation)(nil),                    // 91: ag.PolicyViolation
+	(*Artifact)(nil),                           // 92: ag.Artifact
+	(*Artifacts)(nil),                          // 93: ag.Artifacts
+	(*ArtifactRequest)(nil),                    // 94: ag.ArtifactRequest
+	(*TestResult)(nil),                         // 95: ag.TestResult
+	(*TestResults)(nil),                        // 96: ag.TestResults
+	(*TestResultRequest)(nil),                  // 97: ag.TestResultRequest
+	(*TaskScoreRequest)(nil),                   // 98: ag.TaskScoreRequest
+	(*Submissions)(nil),                        // 99: ag.Submissions
+	(*ForcePush)(nil),                          // 100: ag.ForcePush
+	(*ForcePushes)(nil),                        // 101: ag.ForcePushes
+	(*MissedPushesRequest)(nil),                // 102: ag.MissedPushesRequest
+	(*MissedPushesReport)(nil),                 // 103: ag.MissedPushesReport
+	(*Appeal)(nil),                             // 104: ag.Appeal
+	(*Appeals)(nil),                            // 105: ag.Appeals
+	(*AppealRequest)(nil),                      // 106: ag.AppealRequest
+	(*Extension)(nil),                          // 107: ag.Extension
+	(*Extensions)(nil),                         // 108: ag.Extensions
+	(*ExtensionRequest)(nil),                   // 109: ag.ExtensionRequest
+	(*TestJob)(nil),                            // 110: ag.TestJob
+	(*TestJobs)(nil),                           // 111: ag.TestJobs
+	(*SubmittedCommit)(nil),                    // 112: ag.SubmittedCommit
+	(*WebhookDelivery)(nil),                    // 113: ag.WebhookDelivery
+	(*WebhookDeliveries)(nil),                  // 114: ag.WebhookDeliveries
+	(*WebhookDeliveryRequest)(nil),             // 115: ag.WebhookDeliveryRequest
+	(*AuditEntry)(nil),                         // 116: ag.AuditEntry
+	(*AuditLogRequest)(nil),                    // 117: ag.AuditLogRequest
+	(*AuditLog)(nil),                           // 118: ag.AuditLog
+	(*AccessToken)(nil),                        // 119: ag.AccessToken
+	(*AccessTokenCourse)(nil),                  // 120: ag.AccessTokenCourse
+	(*AccessTokenRequest)(nil),                 // 121: ag.AccessTokenRequest
+	(*NewAccessToken)(nil),                     // 122: ag.NewAccessToken
+	(*AccessTokens)(nil),                       // 123: ag.AccessTokens
+	(*Session)(nil),                            // 124: ag.Session
+	(*Sessions)(nil),                           // 125: ag.Sessions
+	(*PendingAction)(nil),                      // 126: ag.PendingAction
+	(*PendingActions)(nil),                     // 127: ag.PendingActions
+	(*PlagiarismResult)(nil),                   // 128: ag.PlagiarismResult
+	(*PlagiarismResults)(nil),                  // 129: ag.PlagiarismResults
+	(*PlagiarismRequest)(nil),                  // 130: ag.PlagiarismRequest
+	(*GradingBenchmark)(nil),                   // 131: ag.GradingBenchmark
+	(*Benchmarks)(nil),                         // 132: ag.Benchmarks
+	(*GradingCriterion)(nil),                   // 133: ag.GradingCriterion
+	(*Review)(nil),                             // 134: ag.Review
+	(*Reviewers)(nil),                          // 135: ag.Reviewers
+	(*PeerReview)(nil),                         // 136: ag.PeerReview
+	(*PeerReviews)(nil),                        // 137: ag.PeerReviews
+	(*SubmissionComment)(nil),                  // 138: ag.SubmissionComment
+	(*SubmissionComments)(nil),                 // 139: ag.SubmissionComments
+	(*SubmissionCommentRequest)(nil),           // 140: ag.SubmissionCommentRequest
+	(*Announcement)(nil),                       // 141: ag.Announcement
+	(*Announcements)(nil),                      // 142: ag.Announcements
+	(*AnnouncementRead)(nil),                   // 143: ag.AnnouncementRead
+	(*CourseEvent)(nil),                        // 144: ag.CourseEvent
+	(*Notification)(nil),                       // 145: ag.Notification
+	(*Notifications)(nil),                      // 146: ag.Notifications
+	(*NotificationsRequest)(nil),               // 147: ag.NotificationsRequest
+	(*NotificationRequest)(nil),                // 148: ag.NotificationRequest
+	(*Question)(nil),                           // 149: ag.Question
+	(*Questions)(nil),                          // 150: ag.Questions
+	(*Answer)(nil),                             // 151: ag.Answer
+	(*Discussion)(nil),                         // 152: ag.Discussion
+	(*Discussions)(nil),                        // 153: ag.Discussions
+	(*ReviewRequest)(nil),                      // 154: ag.ReviewRequest
+	(*CourseRequest)(nil),                      // 155: ag.CourseRequest
+	(*UserRequest)(nil),                        // 156: ag.UserRequest
+	(*GetGroupRequest)(nil),                    // 157: ag.GetGroupRequest
+	(*GroupRequest)(nil),                       // 158: ag.GroupRequest
+	(*Provider)(nil),                           // 159: ag.Provider
+	(*OrgRequest)(nil),                         // 160: ag.OrgRequest
+	(*Organization)(nil),                       // 161: ag.Organization
+	(*Organizations)(nil),                      // 162: ag.Organizations
+	(*EnrollmentRequest)(nil),                  // 163: ag.EnrollmentRequest
+	(*ImportEnrollmentsRequest)(nil),           // 164: ag.ImportEnrollmentsRequest
+	(*EnrollmentImportReport)(nil),             // 165: ag.EnrollmentImportReport
+	(*EnrollmentStatusRequest)(nil),            // 166: ag.EnrollmentStatusRequest
+	(*SubmissionRequest)(nil),                  // 167: ag.SubmissionRequest
+	(*SubmissionStreamRequest)(nil),            // 168: ag.SubmissionStreamRequest
+	(*Event)(nil),                              // 169: ag.Event
+	(*SubmissionStatusRequest)(nil),            // 170: ag.SubmissionStatusRequest
+	(*SubmissionStatus)(nil),                   // 171: ag.SubmissionStatus
+	(*ReplayRequest)(nil),                      // 172: ag.ReplayRequest
+	(*ReplayResult)(nil),                       // 173: ag.ReplayResult
+	(*ReplayReport)(nil),                       // 174: ag.ReplayReport
+	(*UpdateSubmissionRequest)(nil),            // 175: ag.UpdateSubmissionRequest
+	(*UpdateSubmissionsRequest)(nil),           // 176: ag.UpdateSubmissionsRequest
+	(*SubmissionReviewersRequest)(nil),         // 177: ag.SubmissionReviewersRequest
+	(*Providers)(nil),                          // 178: ag.Providers
+	(*URLRequest)(nil),                         // 179: ag.URLRequest
+	(*RepositoryRequest)(nil),                  // 180: ag.RepositoryRequest
+	(*Repositories)(nil),                       // 181: ag.Repositories
+	(*FeedURL)(nil),                            // 182: ag.FeedURL
+	(*OrphanedResources)(nil),                  // 183: ag.OrphanedResources
+	(*AccessViolation)(nil),                    // 184: ag.AccessViolation
+	(*ExposureReport)(nil),                     // 185: ag.ExposureReport
+	(*AccessEntry)(nil),                        // 186: ag.AccessEntry
+	(*AccessReport)(nil),                       // 187: ag.AccessReport
+	(*RepositoryAccessRequest)(nil),            // 188: ag.RepositoryAccessRequest
+	(*RepositoryAccessResult)(nil),             // 189: ag.RepositoryAccessResult
+	(*RepositoryAccessReport)(nil),             // 190: ag.RepositoryAccessReport
+	(*CourseOverview)(nil),                     // 191: ag.CourseOverview
+	(*AdminOverview)(nil),                      // 192: ag.AdminOverview
+	(*AuthorizationResponse)(nil),              // 193: ag.AuthorizationResponse
+	(*Status)(nil),                             // 194: ag.Status
+	(*SubmissionsForCourseRequest)(nil),        // 195: ag.SubmissionsForCourseRequest
+	(*RebuildRequest)(nil),                     // 196: ag.RebuildRequest
+	(*CourseUserRequest)(nil),                  // 197: ag.CourseUserRequest
+	(*AssignmentRequest)(nil),                  // 198: ag.AssignmentRequest
+	(*QuestionRequest)(nil),                    // 199: ag.QuestionRequest
+	(*AnswerRequest)(nil),                      // 200: ag.AnswerRequest
+	(*ExposureAuditRequest)(nil),               // 201: ag.ExposureAuditRequest
+	(*AnnouncementRequest)(nil),                // 202: ag.AnnouncementRequest
+	(*Void)(nil),                               // 203: ag.Void
+	nil,                                        // 204: ag.Repositories.URLsEntry
+	(*score.BuildInfo)(nil),                    // 205: score.BuildInfo
+	(*score.Score)(nil),                        // 206: score.Score
+}
+var file_ag_ag_proto_depIdxs = []int32{
+	29,  // 0: ag.User.remoteIdentities:type_name -> ag.RemoteIdentity
+	68,  // 1: ag.User.enrollments:type_name -> ag.Enrollment
+	25,  // 2: ag.Users.users:type_name -> ag.User
+	25,  // 3: ag.UserData.user:type_name -> ag.User
+	68,  // 4: ag.UserData.enrollments:type_name -> ag.Enrollment
+	32,  // 5: ag.UserData.groups:type_name -> ag.Group
+	86,  // 6: ag.UserData.submissions:type_name -> ag.Submission
+	136, // 7: ag.UserData.peerReviews:type_name -> ag.PeerReview
+	104, // 8: ag.UserData.appeals:type_name -> ag.Appeal
+	107, // 9: ag.UserData.extensions:type_name -> ag.Extension
+	149, // 10: ag.UserData.questions:type_name -> ag.Question
+	151, // 11: ag.UserData.answers:type_name -> ag.Answer
+	46,  // 12: ag.UserData.certificates:type_name -> ag.Certificate
+	145, // 13: ag.UserData.notifications:type_name -> ag.Notification
+	119, // 14: ag.UserData.accessTokens:type_name -> ag.AccessToken
+	73,  // 15: ag.UserData.preEnrollments:type_name -> ag.PreEnrollment
+	116, // 16: ag.UserData.auditEntries:type_name -> ag.AuditEntry
+	29,  // 17: ag.RemoteIdentities.identities:type_name -> ag.RemoteIdentity
+	0,   // 18: ag.Group.status:type_name -> ag.Group.GroupStatus
+	25,  // 19: ag.Group.users:type_name -> ag.User
+	68,  // 20: ag.Group.enrollments:type_name -> ag.Enrollment
+	32,  // 21: ag.Groups.groups:type_name -> ag.Group
+	1,   // 22: ag.GroupInvitation.status:type_name -> ag.GroupInvitation.Status
+	34,  // 23: ag.GroupInvitations.invitations:type_name -> ag.GroupInvitation
+	32,  // 24: ag.GenerateGroupsReport.groups:type_name -> ag.Group
+	38,  // 25: ag.Tenants.tenants:type_name -> ag.Tenant
+	9,   // 26: ag.Course.enrolled:type_name -> ag.Enrollment.UserStatus
+	68,  // 27: ag.Course.enrollments:type_name -> ag.Enrollment
+	77,  // 28: ag.Course.assignments:type_name -> ag.Assignment
+	32,  // 29: ag.Course.groups:type_name -> ag.Group
+	2,   // 30: ag.Course.pendingSetup:type_name -> ag.Course.SetupStep
+	3,   // 31: ag.Course.assistantPermission:type_name -> ag.Course.AssistantPermission
+	4,   // 32: ag.CourseCleanupRequest.repositories:type_name -> ag.CourseCleanupRequest.RepositoryAction
+	41,  // 33: ag.CloneCourseRequest.course:type_name -> ag.Course
+	45,  // 34: ag.CourseCreationPlan.operations:type_name -> ag.SCMOperation
+	2,   // 35: ag.SCMOperation.step:type_name -> ag.Course.SetupStep
+	5,   // 36: ag.SCMOperation.kind:type_name -> ag.SCMOperation.Kind
+	47,  // 37: ag.GradingScale.thresholds:type_name -> ag.GradeThreshold
+	25,  // 38: ag.FinalGrade.user:type_name -> ag.User
+	6,   // 39: ag.ScoreCurve.method:type_name -> ag.ScoreCurve.Method
+	49,  // 40: ag.FinalGrades.grades:type_name -> ag.FinalGrade
+	53,  // 41: ag.CourseCompletion.students:type_name -> ag.StudentCompletion
+	68,  // 42: ag.StudentCompletion.enrollment:type_name -> ag.Enrollment
+	7,   // 43: ag.ResultsExportRequest.format:type_name -> ag.ResultsExportRequest.Format
+	77,  // 44: ag.CourseResults.assignments:type_name -> ag.Assignment
+	57,  // 45: ag.CourseResults.rows:type_name -> ag.ResultsRow
+	68,  // 46: ag.ResultsRow.enrollment:type_name -> ag.Enrollment
+	32,  // 47: ag.ResultsRow.group:type_name -> ag.Group
+	86,  // 48: ag.ResultsRow.submissions:type_name -> ag.Submission
+	59,  // 49: ag.CourseStatistics.assignments:type_name -> ag.AssignmentStatistics
+	62,  // 50: ag.AssignmentStatistics.submissionsPerDay:type_name -> ag.DailySubmissions
+	61,  // 51: ag.TestFailureStats.tests:type_name -> ag.TestStatistics
+	64,  // 52: ag.UserProgress.courses:type_name -> ag.CourseProgress
+	65,  // 53: ag.CourseProgress.upcomingDeadlines:type_name -> ag.UpcomingDeadline
+	41,  // 54: ag.Courses.courses:type_name -> ag.Course
+	8,   // 55: ag.Repository.repoType:type_name -> ag.Repository.Type
+	25,  // 56: ag.Enrollment.user:type_name -> ag.User
+	41,  // 57: ag.Enrollment.course:type_name -> ag.Course
+	32,  // 58: ag.Enrollment.group:type_name -> ag.Group
+	9,   // 59: ag.Enrollment.status:type_name -> ag.Enrollment.UserStatus
+	10,  // 60: ag.Enrollment.state:type_name -> ag.Enrollment.DisplayState
+	69,  // 61: ag.Enrollment.usedSlipDays:type_name -> ag.UsedSlipDays
+	69,  // 62: ag.SlipDays.usedSlipDays:type_name -> ag.UsedSlipDays
+	68,  // 63: ag.Enrollments.enrollments:type_name -> ag.Enrollment
+	77,  // 64: ag.SubmissionLink.assignment:type_name -> ag.Assignment
+	86,  // 65: ag.SubmissionLink.submission:type_name -> ag.Submission
+	68,  // 66: ag.EnrollmentLink.enrollment:type_name -> ag.Enrollment
+	74,  // 67: ag.EnrollmentLink.submissions:type_name -> ag.SubmissionLink
+	41,  // 68: ag.CourseSubmissions.course:type_name -> ag.Course
+	75,  // 69: ag.CourseSubmissions.links:type_name -> ag.EnrollmentLink
+	86,  // 70: ag.Assignment.submissions:type_name -> ag.Submission
+	131, // 71: ag.Assignment.gradingBenchmarks:type_name -> ag.GradingBenchmark
+	83,  // 72: ag.Assignment.tasks:type_name -> ag.Task
+	77,  // 73: ag.Assignments.assignments:type_name -> ag.Assignment
+	80,  // 74: ag.AssignmentFileErrors.errors:type_name -> ag.AssignmentFileError
+	77,  // 75: ag.AssignmentValidation.assignments:type_name -> ag.Assignment
+	80,  // 76: ag.AssignmentValidation.problems:type_name -> ag.AssignmentFileError
+	84,  // 77: ag.TaskScores.taskScores:type_name -> ag.TaskScore
+	11,  // 78: ag.Submission.status:type_name -> ag.Submission.Status
+	134, // 79: ag.Submission.reviews:type_name -> ag.Review
+	205, // 80: ag.Submission.BuildInfo:type_name -> score.BuildInfo
+	206, // 81: ag.Submission.Scores:type_name -> score.Score
+	12,  // 82: ag.Submission.exceededLimit:type_name -> ag.Submission.ExceededLimit
+	91,  // 83: ag.Submission.policyViolations:type_name -> ag.PolicyViolation
+	90,  // 84: ag.Submission.fileCoverage:type_name -> ag.FileCoverage
+	11,  // 85: ag.SubmissionTransition.previousStatus:type_name -> ag.Submission.Status
+	11,  // 86: ag.SubmissionTransition.requestedStatus:type_name -> ag.Submission.Status
+	11,  // 87: ag.SubmissionTransition.status:type_name -> ag.Submission.Status
+	87,  // 88: ag.SubmissionTransitions.transitions:type_name -> ag.SubmissionTransition
+	11,  // 89: ag.SubmissionTransitionRequest.status:type_name -> ag.Submission.Status
+	13,  // 90: ag.PolicyViolation.rule:type_name -> ag.PolicyViolation.Rule
+	92,  // 91: ag.Artifacts.artifacts:type_name -> ag.Artifact
+	14,  // 92: ag.TestResult.status:type_name -> ag.TestResult.Status
+	95,  // 93: ag.TestResults.testResults:type_name -> ag.TestResult
+	86,  // 94: ag.Submissions.submissions:type_name -> ag.Submission
+	100, // 95: ag.ForcePushes.forcePushes:type_name -> ag.ForcePush
+	15,  // 96: ag.Appeal.status:type_name -> ag.Appeal.Status
+	104, // 97: ag.Appeals.appeals:type_name -> ag.Appeal
+	107, // 98: ag.Extensions.extensions:type_name -> ag.Extension
+	17,  // 99: ag.TestJob.priority:type_name -> ag.TestJob.Priority
+	16,  // 100: ag.TestJob.status:type_name -> ag.TestJob.Status
+	110, // 101: ag.TestJobs.testJobs:type_name -> ag.TestJob
+	18,  // 102: ag.WebhookDelivery.status:type_name -> ag.WebhookDelivery.Status
+	113, // 103: ag.WebhookDeliveries.deliveries:type_name -> ag.WebhookDelivery
+	116, // 104: ag.AuditLog.entries:type_name -> ag.AuditEntry
+	120, // 105: ag.AccessToken.courses:type_name -> ag.AccessTokenCourse
+	119, // 106: ag.NewAccessToken.accessToken:type_name -> ag.AccessToken
+	119, // 107: ag.AccessTokens.accessTokens:type_name -> ag.AccessToken
+	124, // 108: ag.Sessions.sessions:type_name -> ag.Session
+	19,  // 109: ag.PendingAction.action:type_name -> ag.PendingAction.Action
+	9,   // 110: ag.PendingAction.status:type_name -> ag.Enrollment.UserStatus
+	4,   // 111: ag.PendingAction.repositories:type_name -> ag.CourseCleanupRequest.RepositoryAction
+	126, // 112: ag.PendingActions.actions:type_name -> ag.PendingAction
+	128, // 113: ag.PlagiarismResults.results:type_name -> ag.PlagiarismResult
+	133, // 114: ag.GradingBenchmark.criteria:type_name -> ag.GradingCriterion
+	131, // 115: ag.Benchmarks.benchmarks:type_name -> ag.GradingBenchmark
+	20,  // 116: ag.GradingCriterion.grade:type_name -> ag.GradingCriterion.Grade
+	131, // 117: ag.Review.gradingBenchmarks:type_name -> ag.GradingBenchmark
+	25,  // 118: ag.Reviewers.reviewers:type_name -> ag.User
+	86,  // 119: ag.PeerReview.submission:type_name -> ag.Submission
+	136, // 120: ag.PeerReviews.peerReviews:type_name -> ag.PeerReview
+	138, // 121: ag.SubmissionComments.comments:type_name -> ag.SubmissionComment
+	141, // 122: ag.Announcements.announcements:type_name -> ag.Announcement
+	21,  // 123: ag.CourseEvent.type:type_name -> ag.CourseEvent.Type
+	145, // 124: ag.Notifications.notifications:type_name -> ag.Notification
+	151, // 125: ag.Question.answers:type_name -> ag.Answer
+	149, // 126: ag.Questions.questions:type_name -> ag.Question
+	152, // 127: ag.Discussions.discussions:type_name -> ag.Discussion
+	134, // 128: ag.ReviewRequest.review:type_name -> ag.Review
+	161, // 129: ag.Organizations.organizations:type_name -> ag.Organization
+	9,   // 130: ag.EnrollmentRequest.statuses:type_name -> ag.Enrollment.UserStatus
+	73,  // 131: ag.EnrollmentImportReport.unmatched:type_name -> ag.PreEnrollment
+	9,   // 132: ag.EnrollmentStatusRequest.statuses:type_name -> ag.Enrollment.UserStatus
+	22,  // 133: ag.Event.type:type_name -> ag.Event.Type
+	23,  // 134: ag.SubmissionStatus.state:type_name -> ag.SubmissionStatus.State
+	173, // 135: ag.ReplayReport.results:type_name -> ag.ReplayResult
+	11,  // 136: ag.UpdateSubmissionRequest.status:type_name -> ag.Submission.Status
+	8,   // 137: ag.URLRequest.repoTypes:type_name -> ag.Repository.Type
+	204, // 138: ag.Repositories.URLs:type_name -> ag.Repositories.URLsEntry
+	184, // 139: ag.ExposureReport.violations:type_name -> ag.AccessViolation
+	186, // 140: ag.AccessReport.entries:type_name -> ag.AccessEntry
+	189, // 141: ag.RepositoryAccessReport.results:type_name -> ag.RepositoryAccessResult
+	41,  // 142: ag.CourseOverview.course:type_name -> ag.Course
+	191, // 143: ag.AdminOverview.courses:type_name -> ag.CourseOverview
+	24,  // 144: ag.SubmissionsForCourseRequest.type:type_name -> ag.SubmissionsForCourseRequest.Type
+	149, // 145: ag.QuestionRequest.question:type_name -> ag.Question
+	151, // 146: ag.AnswerRequest.answer:type_name -> ag.Answer
+	203, // 147: ag.AutograderService.GetUser:input_type -> ag.Void
+	27,  // 148: ag.AutograderService.GetUsers:input_type -> ag.UsersRequest
+	197, // 149: ag.AutograderService.GetUserByCourse:input_type -> ag.CourseUserRequest
+	25,  // 150: ag.AutograderService.UpdateUser:input_type -> ag.User
+	156, // 151: ag.AutograderService.ExportUserData:input_type -> ag.UserRequest
+	156, // 152: ag.AutograderService.DeleteUserData:input_type -> ag.UserRequest
+	203, // 153: ag.AutograderService.IsAuthorizedTeacher:input_type -> ag.Void
+	203, // 154: ag.AutograderService.GetRemoteIdentities:input_type -> ag.Void
+	31,  // 155: ag.AutograderService.UnlinkRemoteIdentity:input_type -> ag.UnlinkIdentityRequest
+	203, // 156: ag.AutograderService.GetTenants:input_type -> ag.Void
+	38,  // 157: ag.AutograderService.CreateTenant:input_type -> ag.Tenant
+	38,  // 158: ag.AutograderService.UpdateTenant:input_type -> ag.Tenant
+	40,  // 159: ag.AutograderService.SetUserTenant:input_type -> ag.TenantUserRequest
+	157, // 160: ag.AutograderService.GetGroup:input_type -> ag.GetGroupRequest
+	158, // 161: ag.AutograderService.GetGroupByUserAndCourse:input_type -> ag.GroupRequest
+	155, // 162: ag.AutograderService.GetGroupsByCourse:input_type -> ag.CourseRequest
+	32,  // 163: ag.AutograderService.CreateGroup:input_type -> ag.Group
+	32,  // 164: ag.AutograderService.UpdateGroup:input_type -> ag.Group
+	158, // 165: ag.AutograderService.DeleteGroup:input_type -> ag.GroupRequest
+	158, // 166: ag.AutograderService.RestoreGroup:input_type -> ag.GroupRequest
+	34,  // 167: ag.AutograderService.InviteToGroup:input_type -> ag.GroupInvitation
+	34,  // 168: ag.AutograderService.RespondToGroupInvitation:input_type -> ag.GroupInvitation
+	155, // 169: ag.AutograderService.GetGroupInvitations:input_type -> ag.CourseRequest
+	36,  // 170: ag.AutograderService.GenerateGroups:input_type -> ag.GenerateGroupsRequest
+	155, // 171: ag.AutograderService.GetCourse:input_type -> ag.CourseRequest
+	203, // 172: ag.AutograderService.GetCourses:input_type -> ag.Void
+	166, // 173: ag.AutograderService.GetCoursesByUser:input_type -> ag.EnrollmentStatusRequest
+	41,  // 174: ag.AutograderService.CreateCourse:input_type -> ag.Course
+	41,  // 175: ag.AutograderService.UpdateCourse:input_type -> ag.Course
+	68,  // 176: ag.AutograderService.UpdateCourseVisibility:input_type -> ag.Enrollment
+	42,  // 177: ag.AutograderService.ArchiveCourse:input_type -> ag.CourseCleanupRequest
+	42,  // 178: ag.AutograderService.DeleteCourse:input_type -> ag.CourseCleanupRequest
+	155, // 179: ag.AutograderService.RepairCourse:input_type -> ag.CourseRequest
+	43,  // 180: ag.AutograderService.CloneCourse:input_type -> ag.CloneCourseRequest
+	41,  // 181: ag.AutograderService.PreviewCourseCreation:input_type -> ag.Course
+	155, // 182: ag.AutograderService.GetGradingScale:input_type -> ag.CourseRequest
+	48,  // 183: ag.AutograderService.UpdateGradingScale:input_type -> ag.GradingScale
+	155, // 184: ag.AutograderService.ComputeFinalGrades:input_type -> ag.CourseRequest
+	155, // 185: ag.AutograderService.GetCourseCompletion:input_type -> ag.CourseRequest
+	198, // 186: ag.AutograderService.GetScoreCurve:input_type -> ag.AssignmentRequest
+	50,  // 187: ag.AutograderService.ApplyScoreCurve:input_type -> ag.ScoreCurve
+	54,  // 188: ag.AutograderService.ExportResults:input_type -> ag.ResultsExportRequest
+	155, // 189: ag.AutograderService.GetCertificate:input_type -> ag.CourseRequest
+	155, // 190: ag.AutograderService.GetAssignments:input_type -> ag.CourseRequest
+	155, // 191: ag.AutograderService.UpdateAssignments:input_type -> ag.CourseRequest
+	79,  // 192: ag.AutograderService.ValidateAssignmentFile:input_type -> ag.AssignmentFile
+	155, // 193: ag.AutograderService.ValidateAssignments:input_type -> ag.CourseRequest
+	166, // 194: ag.AutograderService.GetEnrollmentsByUser:input_type -> ag.EnrollmentStatusRequest
+	203, // 195: ag.AutograderService.GetUserProgress:input_type -> ag.Void
+	70,  // 196: ag.AutograderService.GetSlipDays:input_type -> ag.SlipDaysRequest
+	163, // 197: ag.AutograderService.GetEnrollmentsByCourse:input_type -> ag.EnrollmentRequest
+	68,  // 198: ag.AutograderService.CreateEnrollment:input_type -> ag.Enrollment
+	68,  // 199: ag.AutograderService.UpdateEnrollment:input_type -> ag.Enrollment
+	155, // 200: ag.AutograderService.UpdateEnrollments:input_type -> ag.CourseRequest
+	68,  // 201: ag.AutograderService.RestoreEnrollment:input_type -> ag.Enrollment
+	164, // 202: ag.AutograderService.ImportEnrollments:input_type -> ag.ImportEnrollmentsRequest
+	167, // 203: ag.AutograderService.GetSubmissions:input_type -> ag.SubmissionRequest
+	168, // 204: ag.AutograderService.SubmissionStream:input_type -> ag.SubmissionStreamRequest
+	195, // 205: ag.AutograderService.GetSubmissionsByCourse:input_type -> ag.SubmissionsForCourseRequest
+	155, // 206: ag.AutograderService.GetCourseResults:input_type -> ag.CourseRequest
+	155, // 207: ag.AutograderService.GetCourseStatistics:input_type -> ag.CourseRequest
+	198, // 208: ag.AutograderService.GetTestFailureStats:input_type -> ag.AssignmentRequest
+	170, // 209: ag.AutograderService.GetSubmissionStatus:input_type -> ag.SubmissionStatusRequest
+	94,  // 210: ag.AutograderService.GetSubmissionArtifacts:input_type -> ag.ArtifactRequest
+	97,  // 211: ag.AutograderService.GetTestResults:input_type -> ag.TestResultRequest
+	98,  // 212: ag.AutograderService.GetTaskScores:input_type -> ag.TaskScoreRequest
+	175, // 213: ag.AutograderService.UpdateSubmission:input_type -> ag.UpdateSubmissionRequest
+	176, // 214: ag.AutograderService.UpdateSubmissions:input_type -> ag.UpdateSubmissionsRequest
+	89,  // 215: ag.AutograderService.TransitionSubmission:input_type -> ag.SubmissionTransitionRequest
+	89,  // 216: ag.AutograderService.GetSubmissionTransitions:input_type -> ag.SubmissionTransitionRequest
+	196, // 217: ag.AutograderService.RebuildSubmission:input_type -> ag.RebuildRequest
+	198, // 218: ag.AutograderService.RebuildSubmissions:input_type -> ag.AssignmentRequest
+	102, // 219: ag.AutograderService.ProcessMissedPushes:input_type -> ag.MissedPushesRequest
+	172, // 220: ag.AutograderService.ReplaySubmissions:input_type -> ag.ReplayRequest
+	155, // 221: ag.AutograderService.GetForcePushes:input_type -> ag.CourseRequest
+	104, // 222: ag.AutograderService.CreateAppeal:input_type -> ag.Appeal
+	104, // 223: ag.AutograderService.UpdateAppeal:input_type -> ag.Appeal
+	106, // 224: ag.AutograderService.GetAppeals:input_type -> ag.AppealRequest
+	107, // 225: ag.AutograderService.GrantExtension:input_type -> ag.Extension
+	109, // 226: ag.AutograderService.ListExtensions:input_type -> ag.ExtensionRequest
+	130, // 227: ag.AutograderService.RunPlagiarismCheck:input_type -> ag.PlagiarismRequest
+	130, // 228: ag.AutograderService.GetPlagiarismResults:input_type -> ag.PlagiarismRequest
+	131, // 229: ag.AutograderService.CreateBenchmark:input_type -> ag.GradingBenchmark
+	131, // 230: ag.AutograderService.UpdateBenchmark:input_type -> ag.GradingBenchmark
+	131, // 231: ag.AutograderService.DeleteBenchmark:input_type -> ag.GradingBenchmark
+	133, // 232: ag.AutograderService.CreateCriterion:input_type -> ag.GradingCriterion
+	133, // 233: ag.AutograderService.UpdateCriterion:input_type -> ag.GradingCriterion
+	133, // 234: ag.AutograderService.DeleteCriterion:input_type -> ag.GradingCriterion
+	154, // 235: ag.AutograderService.CreateReview:input_type -> ag.ReviewRequest
+	154, // 236: ag.AutograderService.UpdateReview:input_type -> ag.ReviewRequest
+	177, // 237: ag.AutograderService.GetReviewers:input_type -> ag.SubmissionReviewersRequest
+	198, // 238: ag.AutograderService.ReleaseReviews:input_type -> ag.AssignmentRequest
+	198, // 239: ag.AutograderService.AssignPeerReviews:input_type -> ag.AssignmentRequest
+	198, // 240: ag.AutograderService.GetPeerReviewTasks:input_type -> ag.AssignmentRequest
+	136, // 241: ag.AutograderService.SubmitPeerReview:input_type -> ag.PeerReview
+	198, // 242: ag.AutograderService.GetPeerReviews:input_type -> ag.AssignmentRequest
+	136, // 243: ag.AutograderService.ModeratePeerReview:input_type -> ag.PeerReview
+	198, // 244: ag.AutograderService.ReleasePeerReviews:input_type -> ag.AssignmentRequest
+	138, // 245: ag.AutograderService.CreateSubmissionComment:input_type -> ag.SubmissionComment
+	138, // 246: ag.AutograderService.UpdateSubmissionComment:input_type -> ag.SubmissionComment
+	138, // 247: ag.AutograderService.DeleteSubmissionComment:input_type -> ag.SubmissionComment
+	140, // 248: ag.AutograderService.GetSubmissionComments:input_type -> ag.SubmissionCommentRequest
+	198, // 249: ag.AutograderService.GetQuestions:input_type -> ag.AssignmentRequest
+	199, // 250: ag.AutograderService.CreateQuestion:input_type -> ag.QuestionRequest
+	199, // 251: ag.AutograderService.UpdateQuestion:input_type -> ag.QuestionRequest
+	199, // 252: ag.AutograderService.DeleteQuestion:input_type -> ag.QuestionRequest
+	200, // 253: ag.AutograderService.CreateAnswer:input_type -> ag.AnswerRequest
+	200, // 254: ag.AutograderService.UpdateAnswer:input_type -> ag.AnswerRequest
+	200, // 255: ag.AutograderService.DeleteAnswer:input_type -> ag.AnswerRequest
+	198, // 256: ag.AutograderService.GetAssignmentDiscussions:input_type -> ag.AssignmentRequest
+	155, // 257: ag.AutograderService.GetAnnouncements:input_type -> ag.CourseRequest
+	141, // 258: ag.AutograderService.CreateAnnouncement:input_type -> ag.Announcement
+	141, // 259: ag.AutograderService.UpdateAnnouncement:input_type -> ag.Announcement
+	202, // 260: ag.AutograderService.MarkAnnouncementRead:input_type -> ag.AnnouncementRequest
+	155, // 261: ag.AutograderService.GetCourseFeedURL:input_type -> ag.CourseRequest
+	147, // 262: ag.AutograderService.GetNotifications:input_type -> ag.NotificationsRequest
+	148, // 263: ag.AutograderService.MarkNotificationRead:input_type -> ag.NotificationRequest
+	203, // 264: ag.AutograderService.GetProviders:input_type -> ag.Void
+	160, // 265: ag.AutograderService.GetOrganization:input_type -> ag.OrgRequest
+	179, // 266: ag.AutograderService.GetRepositories:input_type -> ag.URLRequest
+	180, // 267: ag.AutograderService.IsEmptyRepo:input_type -> ag.RepositoryRequest
+	155, // 268: ag.AutograderService.GetOrphanedResources:input_type -> ag.CourseRequest
+	201, // 269: ag.AutograderService.AuditTestsExposure:input_type -> ag.ExposureAuditRequest
+	155, // 270: ag.AutograderService.GetAccessReport:input_type -> ag.CourseRequest
+	188, // 271: ag.AutograderService.SetCourseRepositoryAccess:input_type -> ag.RepositoryAccessRequest
+	203, // 272: ag.AutograderService.GetAdminOverview:input_type -> ag.Void
+	203, // 273: ag.AutograderService.GetTestJobs:input_type -> ag.Void
+	110, // 274: ag.AutograderService.CancelTestJob:input_type -> ag.TestJob
+	115, // 275: ag.AutograderService.GetWebhookDeliveries:input_type -> ag.WebhookDeliveryRequest
+	115, // 276: ag.AutograderService.ReplayWebhookDelivery:input_type -> ag.WebhookDeliveryRequest
+	117, // 277: ag.AutograderService.GetAuditLog:input_type -> ag.AuditLogRequest
+	121, // 278: ag.AutograderService.CreateAccessToken:input_type -> ag.AccessTokenRequest
+	203, // 279: ag.AutograderService.GetAccessTokens:input_type -> ag.Void
+	119, // 280: ag.AutograderService.RevokeAccessToken:input_type -> ag.AccessToken
+	203, // 281: ag.AutograderService.GetSessions:input_type -> ag.Void
+	124, // 282: ag.AutograderService.RevokeSession:input_type -> ag.Session
+	156, // 283: ag.AutograderService.LogoutUser:input_type -> ag.UserRequest
+	203, // 284: ag.AutograderService.GetPendingActions:input_type -> ag.Void
+	126, // 285: ag.AutograderService.ConfirmPendingAction:input_type -> ag.PendingAction
+	126, // 286: ag.AutograderService.CancelPendingAction:input_type -> ag.PendingAction
+	25,  // 287: ag.AutograderService.GetUser:output_type -> ag.User
+	26,  // 288: ag.AutograderService.GetUsers:output_type -> ag.Users
+	25,  // 289: ag.AutograderService.GetUserByCourse:output_type -> ag.User
+	203, // 290: ag.AutograderService.UpdateUser:output_type -> ag.Void
+	28,  // 291: ag.AutograderService.ExportUserData:output_type -> ag.UserData
+	203, // 292: ag.AutograderService.DeleteUserData:output_type -> ag.Void
+	193, // 293: ag.AutograderService.IsAuthorizedTeacher:output_type -> ag.AuthorizationResponse
+	30,  // 294: ag.AutograderService.GetRemoteIdentities:output_type -> ag.RemoteIdentities
+	203, // 295: ag.AutograderService.UnlinkRemoteIdentity:output_type -> ag.Void
+	39,  // 296: ag.AutograderService.GetTenants:output_type -> ag.Tenants
+	38,  // 297: ag.AutograderService.CreateTenant:output_type -> ag.Tenant
+	203, // 298: ag.AutograderService.UpdateTenant:output_type -> ag.Void
+	203, // 299: ag.AutograderService.SetUserTenant:output_type -> ag.Void
+	32,  // 300: ag.AutograderService.GetGroup:output_type -> ag.Group
+	32,  // 301: ag.AutograderService.GetGroupByUserAndCourse:output_type -> ag.Group
+	33,  // 302: ag.AutograderService.GetGroupsByCourse:output_type -> ag.Groups
+	32,  // 303: ag.AutograderService.CreateGroup:output_type -> ag.Group
+	203, // 304: ag.AutograderService.UpdateGroup:output_type -> ag.Void
+	203, // 305: ag.AutograderService.DeleteGroup:output_type -> ag.Void
+	32,  // 306: ag.AutograderService.RestoreGroup:output_type -> ag.Group
+	34,  // 307: ag.AutograderService.InviteToGroup:output_type -> ag.GroupInvitation
+	203, // 308: ag.AutograderService.RespondToGroupInvitation:output_type -> ag.Void
+	35,  // 309: ag.AutograderService.GetGroupInvitations:output_type -> ag.GroupInvitations
+	37,  // 310: ag.AutograderService.GenerateGroups:output_type -> ag.GenerateGroupsReport
+	41,  // 311: ag.AutograderService.GetCourse:output_type -> ag.Course
+	66,  // 312: ag.AutograderService.GetCourses:output_type -> ag.Courses
+	66,  // 313: ag.AutograderService.GetCoursesByUser:output_type -> ag.Courses
+	41,  // 314: ag.AutograderService.CreateCourse:output_type -> ag.Course
+	203, // 315: ag.AutograderService.UpdateCourse:output_type -> ag.Void
+	203, // 316: ag.AutograderService.UpdateCourseVisibility:output_type -> ag.Void
+	203, // 317: ag.AutograderService.ArchiveCourse:output_type -> ag.Void
+	203, // 318: ag.AutograderService.DeleteCourse:output_type -> ag.Void
+	41,  // 319: ag.AutograderService.RepairCourse:output_type -> ag.Course
+	41,  // 320: ag.AutograderService.CloneCourse:output_type -> ag.Course
+	44,  // 321: ag.AutograderService.PreviewCourseCreation:output_type -> ag.CourseCreationPlan
+	48,  // 322: ag.AutograderService.GetGradingScale:output_type -> ag.GradingScale
+	203, // 323: ag.AutograderService.UpdateGradingScale:output_type -> ag.Void
+	51,  // 324: ag.AutograderService.ComputeFinalGrades:output_type -> ag.FinalGrades
+	52,  // 325: ag.AutograderService.GetCourseCompletion:output_type -> ag.CourseCompletion
+	50,  // 326: ag.AutograderService.GetScoreCurve:output_type -> ag.ScoreCurve
+	50,  // 327: ag.AutograderService.ApplyScoreCurve:output_type -> ag.ScoreCurve
+	55,  // 328: ag.AutograderService.ExportResults:output_type -> ag.ResultsExport
+	46,  // 329: ag.AutograderService.GetCertificate:output_type -> ag.Certificate
+	78,  // 330: ag.AutograderService.GetAssignments:output_type -> ag.Assignments
+	203, // 331: ag.AutograderService.UpdateAssignments:output_type -> ag.Void
+	81,  // 332: ag.AutograderService.ValidateAssignmentFile:output_type -> ag.AssignmentFileErrors
+	82,  // 333: ag.AutograderService.ValidateAssignments:output_type -> ag.AssignmentValidation
+	72,  // 334: ag.AutograderService.GetEnrollmentsByUser:output_type -> ag.Enrollments
+	63,  // 335: ag.AutograderService.GetUserProgress:output_type -> ag.UserProgress
+	71,  // 336: ag.AutograderService.GetSlipDays:output_type -> ag.SlipDays
+	72,  // 337: ag.AutograderService.GetEnrollmentsByCourse:output_type -> ag.Enrollments
+	203, // 338: ag.AutograderService.CreateEnrollment:output_type -> ag.Void
+	203, // 339: ag.AutograderService.UpdateEnrollment:output_type -> ag.Void
+	203, // 340: ag.AutograderService.UpdateEnrollments:output_type -> ag.Void
+	68,  // 341: ag.AutograderService.RestoreEnrollment:output_type -> ag.Enrollment
+	165, // 342: ag.AutograderService.ImportEnrollments:output_type -> ag.EnrollmentImportReport
+	99,  // 343: ag.AutograderService.GetSubmissions:output_type -> ag.Submissions
+	86,  // 344: ag.AutograderService.SubmissionStream:output_type -> ag.Submission
+	76,  // 345: ag.AutograderService.GetSubmissionsByCourse:output_type -> ag.CourseSubmissions
+	56,  // 346: ag.AutograderService.GetCourseResults:output_type -> ag.CourseResults
+	58,  // 347: ag.AutograderService.GetCourseStatistics:output_type -> ag.CourseStatistics
+	60,  // 348: ag.AutograderService.GetTestFailureStats:output_type -> ag.TestFailureStats
+	171, // 349: ag.AutograderService.GetSubmissionStatus:output_type -> ag.SubmissionStatus
+	93,  // 350: ag.AutograderService.GetSubmissionArtifacts:output_type -> ag.Artifacts
+	96,  // 351: ag.AutograderService.GetTestResults:output_type -> ag.TestResults
+	85,  // 352: ag.AutograderService.GetTaskScores:output_type -> ag.TaskScores
+	203, // 353: ag.AutograderService.UpdateSubmission:output_type -> ag.Void
+	203, // 354: ag.AutograderService.UpdateSubmissions:output_type -> ag.Void
+	86,  // 355: ag.AutograderService.TransitionSubmission:output_type -> ag.Submission
+	88,  // 356: ag.AutograderService.GetSubmissionTransitions:output_type -> ag.SubmissionTransitions
+	86,  // 357: ag.AutograderService.RebuildSubmission:output_type -> ag.Submission
+	203, // 358: ag.AutograderService.RebuildSubmissions:output_type -> ag.Void
+	103, // 359: ag.AutograderService.ProcessMissedPushes:output_type -> ag.MissedPushesReport
+	174, // 360: ag.AutograderService.ReplaySubmissions:output_type -> ag.ReplayReport
+	101, // 361: ag.AutograderService.GetForcePushes:output_type -> ag.ForcePushes
+	104, // 362: ag.AutograderService.CreateAppeal:output_type -> ag.Appeal
+	203, // 363: ag.AutograderService.UpdateAppeal:output_type -> ag.Void
+	105, // 364: ag.AutograderService.GetAppeals:output_type -> ag.Appeals
+	107, // 365: ag.AutograderService.GrantExtension:output_type -> ag.Extension
+	108, // 366: ag.AutograderService.ListExtensions:output_type -> ag.Extensions
+	129, // 367: ag.AutograderService.RunPlagiarismCheck:output_type -> ag.PlagiarismResults
+	129, // 368: ag.AutograderService.GetPlagiarismResults:output_type -> ag.PlagiarismResults
+	131, // 369: ag.AutograderService.CreateBenchmark:output_type -> ag.GradingBenchmark
+	203, // 370: ag.AutograderService.UpdateBenchmark:output_type -> ag.Void
+	203, // 371: ag.AutograderService.DeleteBenchmark:output_type -> ag.Void
+	133, // 372: ag.AutograderService.CreateCriterion:output_type -> ag.GradingCriterion
+	203, // 373: ag.AutograderService.UpdateCriterion:output_type -> ag.Void
+	203, // 374: ag.AutograderService.DeleteCriterion:output_type -> ag.Void
+	134, // 375: ag.AutograderService.CreateReview:output_type -> ag.Review
+	134, // 376: ag.AutograderService.UpdateReview:output_type -> ag.Review
+	135, // 377: ag.AutograderService.GetReviewers:output_type -> ag.Reviewers
+	203, // 378: ag.AutograderService.ReleaseReviews:output_type -> ag.Void
+	137, // 379: ag.AutograderService.AssignPeerReviews:output_type -> ag.PeerReviews
+	137, // 380: ag.AutograderService.GetPeerReviewTasks:output_type -> ag.PeerReviews
+	136, // 381: ag.AutograderService.SubmitPeerReview:output_type -> ag.PeerReview
+	137, // 382: ag.AutograderService.GetPeerReviews:output_type -> ag.PeerReviews
+	136, // 383: ag.AutograderService.ModeratePeerReview:output_type -> ag.PeerReview
+	203, // 384: ag.AutograderService.ReleasePeerReviews:output_type -> ag.Void
+	138, // 385: ag.AutograderService.CreateSubmissionComment:output_type -> ag.SubmissionComment
+	138, // 386: ag.AutograderService.UpdateSubmissionComment:output_type -> ag.SubmissionComment
+	203, // 387: ag.AutograderService.DeleteSubmissionComment:output_type -> ag.Void
+	139, // 388: ag.AutograderService.GetSubmissionComments:output_type -> ag.SubmissionComments
+	150, // 389: ag.AutograderService.GetQuestions:output_type -> ag.Questions
+	149, // 390: ag.AutograderService.CreateQuestion:output_type -> ag.Question
+	203, // 391: ag.AutograderService.UpdateQuestion:output_type -> ag.Void
+	203, // 392: ag.AutograderService.DeleteQuestion:output_type -> ag.Void
+	151, // 393: ag.AutograderService.CreateAnswer:output_type -> ag.Answer
+	203, // 394: ag.AutograderService.UpdateAnswer:output_type -> ag.Void
+	203, // 395: ag.AutograderService.DeleteAnswer:output_type -> ag.Void
+	153, // 396: ag.AutograderService.GetAssignmentDiscussions:output_type -> ag.Discussions
+	142, // 397: ag.AutograderService.GetAnnouncements:output_type -> ag.Announcements
+	141, // 398: ag.AutograderService.CreateAnnouncement:output_type -> ag.Announcement
+	203, // 399: ag.AutograderService.UpdateAnnouncement:output_type -> ag.Void
+	203, // 400: ag.AutograderService.MarkAnnouncementRead:output_type -> ag.Void
+	182, // 401: ag.AutograderService.GetCourseFeedURL:output_type -> ag.FeedURL
+	146, // 402: ag.AutograderService.GetNotifications:output_type -> ag.Notifications
+	203, // 403: ag.AutograderService.MarkNotificationRead:output_type -> ag.Void
+	178, // 404: ag.AutograderService.GetProviders:output_type -> ag.Providers
+	161, // 405: ag.AutograderService.GetOrganization:output_type -> ag.Organization
+	181, // 406: ag.AutograderService.GetRepositories:output_type -> ag.Repositories
+	203, // 407: ag.AutograderService.IsEmptyRepo:output_type -> ag.Void
+	183, // 408: ag.AutograderService.GetOrphanedResources:output_type -> ag.OrphanedResources
+	185, // 409: ag.AutograderService.AuditTestsExposure:output_type -> ag.ExposureReport
+	187, // 410: ag.AutograderService.GetAccessReport:output_type -> ag.AccessReport
+	190, // 411: ag.AutograderService.SetCourseRepositoryAccess:output_type -> ag.RepositoryAccessReport
+	192, // 412: ag.AutograderService.GetAdminOverview:output_type -> ag.AdminOverview
+	111, // 413: ag.AutograderService.GetTestJobs:output_type -> ag.TestJobs
+	203, // 414: ag.AutograderService.CancelTestJob:output_type -> ag.Void
+	114, // 415: ag.AutograderService.GetWebhookDeliveries:output_type -> ag.WebhookDeliveries
+	113, // 416: ag.AutograderService.ReplayWebhookDelivery:output_type -> ag.WebhookDelivery
+	118, // 417: ag.AutograderService.GetAuditLog:output_type -> ag.AuditLog
+	122, // 418: ag.AutograderService.CreateAccessToken:output_type -> ag.NewAccessToken
+	123, // 419: ag.AutograderService.GetAccessTokens:output_type -> ag.AccessTokens
+	203, // 420: ag.AutograderService.RevokeAccessToken:output_type -> ag.Void
+	125, // 421: ag.AutograderService.GetSessions:output_type -> ag.Sessions
+	203, // 422: ag.AutograderService.RevokeSession:output_type -> ag.Void
+	203, // 423: ag.AutograderService.LogoutUser:output_type -> ag.Void
+	127, // 424: ag.AutograderService.GetPendingActions:output_type -> ag.PendingActions
+	203, // 425: ag.AutograderService.ConfirmPendingAction:output_type -> ag.Void
+	203, // 426: ag.AutograderService.CancelPendingAction:output_type -> ag.Void
+	287, // [287:427] is the sub-list for method output_type
+	147, // [147:287] is the sub-list for method input_type
+	147, // [147:147] is the sub-list for extension type_name
+	147, // [147:147] is the sub-list for extension extendee
+	0,   // [0:147] is the sub-list for field type_name
+}
+
+func init() { file_ag_ag_proto_init() }
+func file_ag_ag_proto_init() {
+	if File_ag_ag_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_ag_ag_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*User); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Users); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UsersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoteIdentity); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoteIdentities); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnlinkIdentityRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Group); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Groups); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupInvitation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupInvitations); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GenerateGroupsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GenerateGroupsReport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Tenant); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Tenants); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TenantUserRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Course); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CourseCleanupRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CloneCourseRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CourseCreationPlan); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SCMOperation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Certificate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GradeThreshold); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GradingScale); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FinalGrade); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScoreCurve); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FinalGrades); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CourseCompletion); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StudentCompletion); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResultsExportRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResultsExport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CourseResults); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResultsRow); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CourseStatistics); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AssignmentStatistics); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TestFailureStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TestStatistics); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DailySubmissions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserProgress); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CourseProgress); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpcomingDeadline); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Courses); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Repository); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Enrollment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UsedSlipDays); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SlipDaysRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SlipDays); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Enrollments); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PreEnrollment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubmissionLink); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnrollmentLink); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CourseSubmissions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Assignment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Assignments); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AssignmentFile); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AssignmentFileError); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AssignmentFileErrors); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AssignmentValidation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Task); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TaskScore); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TaskScores); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Submission); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubmissionTransition); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubmissionTransitions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubmissionTransitionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FileCoverage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PolicyViolation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Artifact); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Artifacts); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArtifactRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TestResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TestResults); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TestResultRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TaskScoreRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Submissions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ForcePush); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ForcePushes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MissedPushesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MissedPushesReport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Appeal); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Appeals); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppealRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Extension); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Extensions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExtensionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TestJob); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TestJobs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubmittedCommit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WebhookDelivery); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WebhookDeliveries); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WebhookDeliveryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuditEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuditLogRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuditLog); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccessToken); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccessTokenCourse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccessTokenRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NewAccessToken); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccessTokens); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Session); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Sessions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PendingAction); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PendingActions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlagiarismResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[104].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlagiarismResults); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[105].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlagiarismRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[106].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GradingBenchmark); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[107].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Benchmarks); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[108].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GradingCriterion); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[109].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Review); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[110].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Reviewers); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[111].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PeerReview); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[112].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PeerReviews); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[113].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubmissionComment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[114].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubmissionComments); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4580,8 +19450,8 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Users); i {
+		file_ag_ag_proto_msgTypes[115].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubmissionCommentRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4592,8 +19462,8 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoteIdentity); i {
+		file_ag_ag_proto_msgTypes[116].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Announcement); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4604,8 +19474,8 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Group); i {
+		file_ag_ag_proto_msgTypes[117].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Announcements); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4616,8 +19486,8 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Groups); i {
+		file_ag_ag_proto_msgTypes[118].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AnnouncementRead); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4628,8 +19498,8 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Course); i {
+		file_ag_ag_proto_msgTypes[119].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CourseEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4640,8 +19510,8 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Courses); i {
+		file_ag_ag_proto_msgTypes[120].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Notification); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4652,8 +19522,8 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Repository); i {
+		file_ag_ag_proto_msgTypes[121].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Notifications); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4664,8 +19534,8 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Enrollment); i {
+		file_ag_ag_proto_msgTypes[122].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NotificationsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4676,8 +19546,8 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UsedSlipDays); i {
+		file_ag_ag_proto_msgTypes[123].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NotificationRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4688,8 +19558,8 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Enrollments); i {
+		file_ag_ag_proto_msgTypes[124].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Question); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4700,8 +19570,8 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubmissionLink); i {
+		file_ag_ag_proto_msgTypes[125].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Questions); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4712,8 +19582,8 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EnrollmentLink); i {
+		file_ag_ag_proto_msgTypes[126].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Answer); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4724,8 +19594,8 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CourseSubmissions); i {
+		file_ag_ag_proto_msgTypes[127].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Discussion); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4736,8 +19606,8 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Assignment); i {
+		file_ag_ag_proto_msgTypes[128].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Discussions); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4748,8 +19618,8 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Assignments); i {
+		file_ag_ag_proto_msgTypes[129].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReviewRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4760,8 +19630,8 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Submission); i {
+		file_ag_ag_proto_msgTypes[130].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CourseRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4772,8 +19642,8 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Submissions); i {
+		file_ag_ag_proto_msgTypes[131].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4784,8 +19654,8 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GradingBenchmark); i {
+		file_ag_ag_proto_msgTypes[132].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetGroupRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4796,8 +19666,8 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Benchmarks); i {
+		file_ag_ag_proto_msgTypes[133].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4808,8 +19678,8 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GradingCriterion); i {
+		file_ag_ag_proto_msgTypes[134].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Provider); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4820,8 +19690,8 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Review); i {
+		file_ag_ag_proto_msgTypes[135].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OrgRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4832,8 +19702,8 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Reviewers); i {
+		file_ag_ag_proto_msgTypes[136].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Organization); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4844,8 +19714,8 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReviewRequest); i {
+		file_ag_ag_proto_msgTypes[137].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Organizations); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4856,8 +19726,8 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CourseRequest); i {
+		file_ag_ag_proto_msgTypes[138].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnrollmentRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4868,8 +19738,8 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserRequest); i {
+		file_ag_ag_proto_msgTypes[139].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportEnrollmentsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4880,8 +19750,8 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetGroupRequest); i {
+		file_ag_ag_proto_msgTypes[140].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnrollmentImportReport); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4892,8 +19762,8 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GroupRequest); i {
+		file_ag_ag_proto_msgTypes[141].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnrollmentStatusRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4904,8 +19774,8 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Provider); i {
+		file_ag_ag_proto_msgTypes[142].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubmissionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4916,8 +19786,8 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OrgRequest); i {
+		file_ag_ag_proto_msgTypes[143].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubmissionStreamRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4928,8 +19798,8 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Organization); i {
+		file_ag_ag_proto_msgTypes[144].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Event); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4940,8 +19810,8 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Organizations); i {
+		file_ag_ag_proto_msgTypes[145].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubmissionStatusRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4952,8 +19822,8 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EnrollmentRequest); i {
+		file_ag_ag_proto_msgTypes[146].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubmissionStatus); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4964,8 +19834,8 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EnrollmentStatusRequest); i {
+		file_ag_ag_proto_msgTypes[147].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplayRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4976,8 +19846,8 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubmissionRequest); i {
+		file_ag_ag_proto_msgTypes[148].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplayResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4988,7 +19858,19 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+		file_ag_ag_proto_msgTypes[149].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplayReport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[150].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UpdateSubmissionRequest); i {
 			case 0:
 				return &v.state
@@ -5000,7 +19882,7 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+		file_ag_ag_proto_msgTypes[151].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UpdateSubmissionsRequest); i {
 			case 0:
 				return &v.state
@@ -5012,7 +19894,7 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+		file_ag_ag_proto_msgTypes[152].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SubmissionReviewersRequest); i {
 			case 0:
 				return &v.state
@@ -5024,7 +19906,7 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+		file_ag_ag_proto_msgTypes[153].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Providers); i {
 			case 0:
 				return &v.state
@@ -5036,7 +19918,7 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+		file_ag_ag_proto_msgTypes[154].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*URLRequest); i {
 			case 0:
 				return &v.state
@@ -5048,7 +19930,7 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+		file_ag_ag_proto_msgTypes[155].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RepositoryRequest); i {
 			case 0:
 				return &v.state
@@ -5060,7 +19942,7 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+		file_ag_ag_proto_msgTypes[156].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Repositories); i {
 			case 0:
 				return &v.state
@@ -5072,7 +19954,139 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+		file_ag_ag_proto_msgTypes[157].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FeedURL); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[158].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OrphanedResources); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[159].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccessViolation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[160].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExposureReport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[161].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccessEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[162].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccessReport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[163].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RepositoryAccessRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[164].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RepositoryAccessResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[165].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RepositoryAccessReport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[166].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CourseOverview); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[167].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminOverview); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[168].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AuthorizationResponse); i {
 			case 0:
 				return &v.state
@@ -5084,7 +20098,7 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+		file_ag_ag_proto_msgTypes[169].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Status); i {
 			case 0:
 				return &v.state
@@ -5096,7 +20110,7 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+		file_ag_ag_proto_msgTypes[170].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SubmissionsForCourseRequest); i {
 			case 0:
 				return &v.state
@@ -5108,7 +20122,7 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+		file_ag_ag_proto_msgTypes[171].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RebuildRequest); i {
 			case 0:
 				return &v.state
@@ -5120,7 +20134,7 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+		file_ag_ag_proto_msgTypes[172].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CourseUserRequest); i {
 			case 0:
 				return &v.state
@@ -5132,7 +20146,7 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+		file_ag_ag_proto_msgTypes[173].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AssignmentRequest); i {
 			case 0:
 				return &v.state
@@ -5144,7 +20158,55 @@ func file_ag_ag_proto_init() {
 				return nil
 			}
 		}
-		file_ag_ag_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+		file_ag_ag_proto_msgTypes[174].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuestionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[175].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AnswerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[176].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExposureAuditRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[177].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AnnouncementRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ag_ag_proto_msgTypes[178].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Void); i {
 			case 0:
 				return &v.state
@@ -5162,8 +20224,8 @@ func file_ag_ag_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_ag_ag_proto_rawDesc,
-			NumEnums:      7,
-			NumMessages:   50,
+			NumEnums:      25,
+			NumMessages:   180,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
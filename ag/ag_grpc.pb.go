@@ -19,37 +19,89 @@ const _ = grpc.SupportPackageIsVersion7
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type AutograderServiceClient interface {
 	GetUser(ctx context.Context, in *Void, opts ...grpc.CallOption) (*User, error)
-	GetUsers(ctx context.Context, in *Void, opts ...grpc.CallOption) (*Users, error)
+	GetUsers(ctx context.Context, in *UsersRequest, opts ...grpc.CallOption) (*Users, error)
 	GetUserByCourse(ctx context.Context, in *CourseUserRequest, opts ...grpc.CallOption) (*User, error)
 	UpdateUser(ctx context.Context, in *User, opts ...grpc.CallOption) (*Void, error)
+	ExportUserData(ctx context.Context, in *UserRequest, opts ...grpc.CallOption) (*UserData, error)
+	DeleteUserData(ctx context.Context, in *UserRequest, opts ...grpc.CallOption) (*Void, error)
 	IsAuthorizedTeacher(ctx context.Context, in *Void, opts ...grpc.CallOption) (*AuthorizationResponse, error)
+	GetRemoteIdentities(ctx context.Context, in *Void, opts ...grpc.CallOption) (*RemoteIdentities, error)
+	UnlinkRemoteIdentity(ctx context.Context, in *UnlinkIdentityRequest, opts ...grpc.CallOption) (*Void, error)
+	GetTenants(ctx context.Context, in *Void, opts ...grpc.CallOption) (*Tenants, error)
+	CreateTenant(ctx context.Context, in *Tenant, opts ...grpc.CallOption) (*Tenant, error)
+	UpdateTenant(ctx context.Context, in *Tenant, opts ...grpc.CallOption) (*Void, error)
+	SetUserTenant(ctx context.Context, in *TenantUserRequest, opts ...grpc.CallOption) (*Void, error)
 	GetGroup(ctx context.Context, in *GetGroupRequest, opts ...grpc.CallOption) (*Group, error)
 	GetGroupByUserAndCourse(ctx context.Context, in *GroupRequest, opts ...grpc.CallOption) (*Group, error)
 	GetGroupsByCourse(ctx context.Context, in *CourseRequest, opts ...grpc.CallOption) (*Groups, error)
 	CreateGroup(ctx context.Context, in *Group, opts ...grpc.CallOption) (*Group, error)
 	UpdateGroup(ctx context.Context, in *Group, opts ...grpc.CallOption) (*Void, error)
 	DeleteGroup(ctx context.Context, in *GroupRequest, opts ...grpc.CallOption) (*Void, error)
+	RestoreGroup(ctx context.Context, in *GroupRequest, opts ...grpc.CallOption) (*Group, error)
+	InviteToGroup(ctx context.Context, in *GroupInvitation, opts ...grpc.CallOption) (*GroupInvitation, error)
+	RespondToGroupInvitation(ctx context.Context, in *GroupInvitation, opts ...grpc.CallOption) (*Void, error)
+	GetGroupInvitations(ctx context.Context, in *CourseRequest, opts ...grpc.CallOption) (*GroupInvitations, error)
+	GenerateGroups(ctx context.Context, in *GenerateGroupsRequest, opts ...grpc.CallOption) (*GenerateGroupsReport, error)
 	GetCourse(ctx context.Context, in *CourseRequest, opts ...grpc.CallOption) (*Course, error)
 	GetCourses(ctx context.Context, in *Void, opts ...grpc.CallOption) (*Courses, error)
 	GetCoursesByUser(ctx context.Context, in *EnrollmentStatusRequest, opts ...grpc.CallOption) (*Courses, error)
 	CreateCourse(ctx context.Context, in *Course, opts ...grpc.CallOption) (*Course, error)
 	UpdateCourse(ctx context.Context, in *Course, opts ...grpc.CallOption) (*Void, error)
 	UpdateCourseVisibility(ctx context.Context, in *Enrollment, opts ...grpc.CallOption) (*Void, error)
+	ArchiveCourse(ctx context.Context, in *CourseCleanupRequest, opts ...grpc.CallOption) (*Void, error)
+	DeleteCourse(ctx context.Context, in *CourseCleanupRequest, opts ...grpc.CallOption) (*Void, error)
+	RepairCourse(ctx context.Context, in *CourseRequest, opts ...grpc.CallOption) (*Course, error)
+	CloneCourse(ctx context.Context, in *CloneCourseRequest, opts ...grpc.CallOption) (*Course, error)
+	PreviewCourseCreation(ctx context.Context, in *Course, opts ...grpc.CallOption) (*CourseCreationPlan, error)
+	GetGradingScale(ctx context.Context, in *CourseRequest, opts ...grpc.CallOption) (*GradingScale, error)
+	UpdateGradingScale(ctx context.Context, in *GradingScale, opts ...grpc.CallOption) (*Void, error)
+	ComputeFinalGrades(ctx context.Context, in *CourseRequest, opts ...grpc.CallOption) (*FinalGrades, error)
+	GetCourseCompletion(ctx context.Context, in *CourseRequest, opts ...grpc.CallOption) (*CourseCompletion, error)
+	GetScoreCurve(ctx context.Context, in *AssignmentRequest, opts ...grpc.CallOption) (*ScoreCurve, error)
+	ApplyScoreCurve(ctx context.Context, in *ScoreCurve, opts ...grpc.CallOption) (*ScoreCurve, error)
+	ExportResults(ctx context.Context, in *ResultsExportRequest, opts ...grpc.CallOption) (*ResultsExport, error)
+	GetCertificate(ctx context.Context, in *CourseRequest, opts ...grpc.CallOption) (*Certificate, error)
 	GetAssignments(ctx context.Context, in *CourseRequest, opts ...grpc.CallOption) (*Assignments, error)
 	UpdateAssignments(ctx context.Context, in *CourseRequest, opts ...grpc.CallOption) (*Void, error)
+	ValidateAssignmentFile(ctx context.Context, in *AssignmentFile, opts ...grpc.CallOption) (*AssignmentFileErrors, error)
+	ValidateAssignments(ctx context.Context, in *CourseRequest, opts ...grpc.CallOption) (*AssignmentValidation, error)
 	GetEnrollmentsByUser(ctx context.Context, in *EnrollmentStatusRequest, opts ...grpc.CallOption) (*Enrollments, error)
+	GetUserProgress(ctx context.Context, in *Void, opts ...grpc.CallOption) (*UserProgress, error)
+	GetSlipDays(ctx context.Context, in *SlipDaysRequest, opts ...grpc.CallOption) (*SlipDays, error)
 	GetEnrollmentsByCourse(ctx context.Context, in *EnrollmentRequest, opts ...grpc.CallOption) (*Enrollments, error)
 	CreateEnrollment(ctx context.Context, in *Enrollment, opts ...grpc.CallOption) (*Void, error)
 	UpdateEnrollment(ctx context.Context, in *Enrollment, opts ...grpc.CallOption) (*Void, error)
 	UpdateEnrollments(ctx context.Context, in *CourseRequest, opts ...grpc.CallOption) (*Void, error)
+	RestoreEnrollment(ctx context.Context, in *Enrollment, opts ...grpc.CallOption) (*Enrollment, error)
+	ImportEnrollments(ctx context.Context, in *ImportEnrollmentsRequest, opts ...grpc.CallOption) (*EnrollmentImportReport, error)
 	// Get latest submissions for all course assignments for a user or a group.
 	GetSubmissions(ctx context.Context, in *SubmissionRequest, opts ...grpc.CallOption) (*Submissions, error)
+	SubmissionStream(ctx context.Context, in *SubmissionStreamRequest, opts ...grpc.CallOption) (AutograderService_SubmissionStreamClient, error)
 	// Get lab submissions for every course user or every course group
 	GetSubmissionsByCourse(ctx context.Context, in *SubmissionsForCourseRequest, opts ...grpc.CallOption) (*CourseSubmissions, error)
+	GetCourseResults(ctx context.Context, in *CourseRequest, opts ...grpc.CallOption) (*CourseResults, error)
+	GetCourseStatistics(ctx context.Context, in *CourseRequest, opts ...grpc.CallOption) (*CourseStatistics, error)
+	GetTestFailureStats(ctx context.Context, in *AssignmentRequest, opts ...grpc.CallOption) (*TestFailureStats, error)
+	GetSubmissionStatus(ctx context.Context, in *SubmissionStatusRequest, opts ...grpc.CallOption) (*SubmissionStatus, error)
+	GetSubmissionArtifacts(ctx context.Context, in *ArtifactRequest, opts ...grpc.CallOption) (*Artifacts, error)
+	GetTestResults(ctx context.Context, in *TestResultRequest, opts ...grpc.CallOption) (*TestResults, error)
+	GetTaskScores(ctx context.Context, in *TaskScoreRequest, opts ...grpc.CallOption) (*TaskScores, error)
 	UpdateSubmission(ctx context.Context, in *UpdateSubmissionRequest, opts ...grpc.CallOption) (*Void, error)
 	UpdateSubmissions(ctx context.Context, in *UpdateSubmissionsRequest, opts ...grpc.CallOption) (*Void, error)
+	TransitionSubmission(ctx context.Context, in *SubmissionTransitionRequest, opts ...grpc.CallOption) (*Submission, error)
+	GetSubmissionTransitions(ctx context.Context, in *SubmissionTransitionRequest, opts ...grpc.CallOption) (*SubmissionTransitions, error)
 	RebuildSubmission(ctx context.Context, in *RebuildRequest, opts ...grpc.CallOption) (*Submission, error)
 	RebuildSubmissions(ctx context.Context, in *AssignmentRequest, opts ...grpc.CallOption) (*Void, error)
+	ProcessMissedPushes(ctx context.Context, in *MissedPushesRequest, opts ...grpc.CallOption) (*MissedPushesReport, error)
+	ReplaySubmissions(ctx context.Context, in *ReplayRequest, opts ...grpc.CallOption) (*ReplayReport, error)
+	GetForcePushes(ctx context.Context, in *CourseRequest, opts ...grpc.CallOption) (*ForcePushes, error)
+	CreateAppeal(ctx context.Context, in *Appeal, opts ...grpc.CallOption) (*Appeal, error)
+	UpdateAppeal(ctx context.Context, in *Appeal, opts ...grpc.CallOption) (*Void, error)
+	GetAppeals(ctx context.Context, in *AppealRequest, opts ...grpc.CallOption) (*Appeals, error)
+	GrantExtension(ctx context.Context, in *Extension, opts ...grpc.CallOption) (*Extension, error)
+	ListExtensions(ctx context.Context, in *ExtensionRequest, opts ...grpc.CallOption) (*Extensions, error)
+	RunPlagiarismCheck(ctx context.Context, in *PlagiarismRequest, opts ...grpc.CallOption) (*PlagiarismResults, error)
+	GetPlagiarismResults(ctx context.Context, in *PlagiarismRequest, opts ...grpc.CallOption) (*PlagiarismResults, error)
 	CreateBenchmark(ctx context.Context, in *GradingBenchmark, opts ...grpc.CallOption) (*GradingBenchmark, error)
 	UpdateBenchmark(ctx context.Context, in *GradingBenchmark, opts ...grpc.CallOption) (*Void, error)
 	DeleteBenchmark(ctx context.Context, in *GradingBenchmark, opts ...grpc.CallOption) (*Void, error)
@@ -59,10 +111,55 @@ type AutograderServiceClient interface {
 	CreateReview(ctx context.Context, in *ReviewRequest, opts ...grpc.CallOption) (*Review, error)
 	UpdateReview(ctx context.Context, in *ReviewRequest, opts ...grpc.CallOption) (*Review, error)
 	GetReviewers(ctx context.Context, in *SubmissionReviewersRequest, opts ...grpc.CallOption) (*Reviewers, error)
+	ReleaseReviews(ctx context.Context, in *AssignmentRequest, opts ...grpc.CallOption) (*Void, error)
+	AssignPeerReviews(ctx context.Context, in *AssignmentRequest, opts ...grpc.CallOption) (*PeerReviews, error)
+	GetPeerReviewTasks(ctx context.Context, in *AssignmentRequest, opts ...grpc.CallOption) (*PeerReviews, error)
+	SubmitPeerReview(ctx context.Context, in *PeerReview, opts ...grpc.CallOption) (*PeerReview, error)
+	GetPeerReviews(ctx context.Context, in *AssignmentRequest, opts ...grpc.CallOption) (*PeerReviews, error)
+	ModeratePeerReview(ctx context.Context, in *PeerReview, opts ...grpc.CallOption) (*PeerReview, error)
+	ReleasePeerReviews(ctx context.Context, in *AssignmentRequest, opts ...grpc.CallOption) (*Void, error)
+	CreateSubmissionComment(ctx context.Context, in *SubmissionComment, opts ...grpc.CallOption) (*SubmissionComment, error)
+	UpdateSubmissionComment(ctx context.Context, in *SubmissionComment, opts ...grpc.CallOption) (*SubmissionComment, error)
+	DeleteSubmissionComment(ctx context.Context, in *SubmissionComment, opts ...grpc.CallOption) (*Void, error)
+	GetSubmissionComments(ctx context.Context, in *SubmissionCommentRequest, opts ...grpc.CallOption) (*SubmissionComments, error)
+	GetQuestions(ctx context.Context, in *AssignmentRequest, opts ...grpc.CallOption) (*Questions, error)
+	CreateQuestion(ctx context.Context, in *QuestionRequest, opts ...grpc.CallOption) (*Question, error)
+	UpdateQuestion(ctx context.Context, in *QuestionRequest, opts ...grpc.CallOption) (*Void, error)
+	DeleteQuestion(ctx context.Context, in *QuestionRequest, opts ...grpc.CallOption) (*Void, error)
+	CreateAnswer(ctx context.Context, in *AnswerRequest, opts ...grpc.CallOption) (*Answer, error)
+	UpdateAnswer(ctx context.Context, in *AnswerRequest, opts ...grpc.CallOption) (*Void, error)
+	DeleteAnswer(ctx context.Context, in *AnswerRequest, opts ...grpc.CallOption) (*Void, error)
+	GetAssignmentDiscussions(ctx context.Context, in *AssignmentRequest, opts ...grpc.CallOption) (*Discussions, error)
+	GetAnnouncements(ctx context.Context, in *CourseRequest, opts ...grpc.CallOption) (*Announcements, error)
+	CreateAnnouncement(ctx context.Context, in *Announcement, opts ...grpc.CallOption) (*Announcement, error)
+	UpdateAnnouncement(ctx context.Context, in *Announcement, opts ...grpc.CallOption) (*Void, error)
+	MarkAnnouncementRead(ctx context.Context, in *AnnouncementRequest, opts ...grpc.CallOption) (*Void, error)
+	GetCourseFeedURL(ctx context.Context, in *CourseRequest, opts ...grpc.CallOption) (*FeedURL, error)
+	GetNotifications(ctx context.Context, in *NotificationsRequest, opts ...grpc.CallOption) (*Notifications, error)
+	MarkNotificationRead(ctx context.Context, in *NotificationRequest, opts ...grpc.CallOption) (*Void, error)
 	GetProviders(ctx context.Context, in *Void, opts ...grpc.CallOption) (*Providers, error)
 	GetOrganization(ctx context.Context, in *OrgRequest, opts ...grpc.CallOption) (*Organization, error)
 	GetRepositories(ctx context.Context, in *URLRequest, opts ...grpc.CallOption) (*Repositories, error)
 	IsEmptyRepo(ctx context.Context, in *RepositoryRequest, opts ...grpc.CallOption) (*Void, error)
+	GetOrphanedResources(ctx context.Context, in *CourseRequest, opts ...grpc.CallOption) (*OrphanedResources, error)
+	AuditTestsExposure(ctx context.Context, in *ExposureAuditRequest, opts ...grpc.CallOption) (*ExposureReport, error)
+	GetAccessReport(ctx context.Context, in *CourseRequest, opts ...grpc.CallOption) (*AccessReport, error)
+	SetCourseRepositoryAccess(ctx context.Context, in *RepositoryAccessRequest, opts ...grpc.CallOption) (*RepositoryAccessReport, error)
+	GetAdminOverview(ctx context.Context, in *Void, opts ...grpc.CallOption) (*AdminOverview, error)
+	GetTestJobs(ctx context.Context, in *Void, opts ...grpc.CallOption) (*TestJobs, error)
+	CancelTestJob(ctx context.Context, in *TestJob, opts ...grpc.CallOption) (*Void, error)
+	GetWebhookDeliveries(ctx context.Context, in *WebhookDeliveryRequest, opts ...grpc.CallOption) (*WebhookDeliveries, error)
+	ReplayWebhookDelivery(ctx context.Context, in *WebhookDeliveryRequest, opts ...grpc.CallOption) (*WebhookDelivery, error)
+	GetAuditLog(ctx context.Context, in *AuditLogRequest, opts ...grpc.CallOption) (*AuditLog, error)
+	CreateAccessToken(ctx context.Context, in *AccessTokenRequest, opts ...grpc.CallOption) (*NewAccessToken, error)
+	GetAccessTokens(ctx context.Context, in *Void, opts ...grpc.CallOption) (*AccessTokens, error)
+	RevokeAccessToken(ctx context.Context, in *AccessToken, opts ...grpc.CallOption) (*Void, error)
+	GetSessions(ctx context.Context, in *Void, opts ...grpc.CallOption) (*Sessions, error)
+	RevokeSession(ctx context.Context, in *Session, opts ...grpc.CallOption) (*Void, error)
+	LogoutUser(ctx context.Context, in *UserRequest, opts ...grpc.CallOption) (*Void, error)
+	GetPendingActions(ctx context.Context, in *Void, opts ...grpc.CallOption) (*PendingActions, error)
+	ConfirmPendingAction(ctx context.Context, in *PendingAction, opts ...grpc.CallOption) (*Void, error)
+	CancelPendingAction(ctx context.Context, in *PendingAction, opts ...grpc.CallOption) (*Void, error)
 }
 
 type autograderServiceClient struct {
@@ -82,7 +179,7 @@ func (c *autograderServiceClient) GetUser(ctx context.Context, in *Void, opts ..
 	return out, nil
 }
 
-func (c *autograderServiceClient) GetUsers(ctx context.Context, in *Void, opts ...grpc.CallOption) (*Users, error) {
+func (c *autograderServiceClient) GetUsers(ctx context.Context, in *UsersRequest, opts ...grpc.CallOption) (*Users, error) {
 	out := new(Users)
 	err := c.cc.Invoke(ctx, "/ag.AutograderService/GetUsers", in, out, opts...)
 	if err != nil {
@@ -109,6 +206,24 @@ func (c *autograderServiceClient) UpdateUser(ctx context.Context, in *User, opts
 	return out, nil
 }
 
+func (c *autograderServiceClient) ExportUserData(ctx context.Context, in *UserRequest, opts ...grpc.CallOption) (*UserData, error) {
+	out := new(UserData)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/ExportUserData", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *autograderServiceClient) DeleteUserData(ctx context.Context, in *UserRequest, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/DeleteUserData", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *autograderServiceClient) IsAuthorizedTeacher(ctx context.Context, in *Void, opts ...grpc.CallOption) (*AuthorizationResponse, error) {
 	out := new(AuthorizationResponse)
 	err := c.cc.Invoke(ctx, "/ag.AutograderService/IsAuthorizedTeacher", in, out, opts...)
@@ -118,6 +233,60 @@ func (c *autograderServiceClient) IsAuthorizedTeacher(ctx context.Context, in *V
 	return out, nil
 }
 
+func (c *autograderServiceClient) GetRemoteIdentities(ctx context.Context, in *Void, opts ...grpc.CallOption) (*RemoteIdentities, error) {
+	out := new(RemoteIdentities)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/GetRemoteIdentities", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *autograderServiceClient) UnlinkRemoteIdentity(ctx context.Context, in *UnlinkIdentityRequest, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/UnlinkRemoteIdentity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *autograderServiceClient) GetTenants(ctx context.Context, in *Void, opts ...grpc.CallOption) (*Tenants, error) {
+	out := new(Tenants)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/GetTenants", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *autograderServiceClient) CreateTenant(ctx context.Context, in *Tenant, opts ...grpc.CallOption) (*Tenant, error) {
+	out := new(Tenant)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/CreateTenant", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *autograderServiceClient) UpdateTenant(ctx context.Context, in *Tenant, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/UpdateTenant", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *autograderServiceClient) SetUserTenant(ctx context.Context, in *TenantUserRequest, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/SetUserTenant", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *autograderServiceClient) GetGroup(ctx context.Context, in *GetGroupRequest, opts ...grpc.CallOption) (*Group, error) {
 	out := new(Group)
 	err := c.cc.Invoke(ctx, "/ag.AutograderService/GetGroup", in, out, opts...)
@@ -172,6 +341,51 @@ func (c *autograderServiceClient) DeleteGroup(ctx context.Context, in *GroupRequ
 	return out, nil
 }
 
+func (c *autograderServiceClient) RestoreGroup(ctx context.Context, in *GroupRequest, opts ...grpc.CallOption) (*Group, error) {
+	out := new(Group)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/RestoreGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *autograderServiceClient) InviteToGroup(ctx context.Context, in *GroupInvitation, opts ...grpc.CallOption) (*GroupInvitation, error) {
+	out := new(GroupInvitation)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/InviteToGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *autograderServiceClient) RespondToGroupInvitation(ctx context.Context, in *GroupInvitation, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/RespondToGroupInvitation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *autograderServiceClient) GetGroupInvitations(ctx context.Context, in *CourseRequest, opts ...grpc.CallOption) (*GroupInvitations, error) {
+	out := new(GroupInvitations)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/GetGroupInvitations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *autograderServiceClient) GenerateGroups(ctx context.Context, in *GenerateGroupsRequest, opts ...grpc.CallOption) (*GenerateGroupsReport, error) {
+	out := new(GenerateGroupsReport)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/GenerateGroups", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *autograderServiceClient) GetCourse(ctx context.Context, in *CourseRequest, opts ...grpc.CallOption) (*Course, error) {
 	out := new(Course)
 	err := c.cc.Invoke(ctx, "/ag.AutograderService/GetCourse", in, out, opts...)
@@ -226,1208 +440,4124 @@ func (c *autograderServiceClient) UpdateCourseVisibility(ctx context.Context, in
 	return out, nil
 }
 
-func (c *autograderServiceClient) GetAssignments(ctx context.Context, in *CourseRequest, opts ...grpc.CallOption) (*Assignments, error) {
-	out := new(Assignments)
-	err := c.cc.Invoke(ctx, "/ag.AutograderService/GetAssignments", in, out, opts...)
+func (c *autograderServiceClient) ArchiveCourse(ctx context.Context, in *CourseCleanupRequest, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/ArchiveCourse", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *autograderServiceClient) UpdateAssignments(ctx context.Context, in *CourseRequest, opts ...grpc.CallOption) (*Void, error) {
+func (c *autograderServiceClient) DeleteCourse(ctx context.Context, in *CourseCleanupRequest, opts ...grpc.CallOption) (*Void, error) {
 	out := new(Void)
-	err := c.cc.Invoke(ctx, "/ag.AutograderService/UpdateAssignments", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/DeleteCourse", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *autograderServiceClient) GetEnrollmentsByUser(ctx context.Context, in *EnrollmentStatusRequest, opts ...grpc.CallOption) (*Enrollments, error) {
-	out := new(Enrollments)
-	err := c.cc.Invoke(ctx, "/ag.AutograderService/GetEnrollmentsByUser", in, out, opts...)
+func (c *autograderServiceClient) RepairCourse(ctx context.Context, in *CourseRequest, opts ...grpc.CallOption) (*Course, error) {
+	out := new(Course)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/RepairCourse", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *autograderServiceClient) GetEnrollmentsByCourse(ctx context.Context, in *EnrollmentRequest, opts ...grpc.CallOption) (*Enrollments, error) {
-	out := new(Enrollments)
-	err := c.cc.Invoke(ctx, "/ag.AutograderService/GetEnrollmentsByCourse", in, out, opts...)
+func (c *autograderServiceClient) CloneCourse(ctx context.Context, in *CloneCourseRequest, opts ...grpc.CallOption) (*Course, error) {
+	out := new(Course)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/CloneCourse", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *autograderServiceClient) CreateEnrollment(ctx context.Context, in *Enrollment, opts ...grpc.CallOption) (*Void, error) {
-	out := new(Void)
-	err := c.cc.Invoke(ctx, "/ag.AutograderService/CreateEnrollment", in, out, opts...)
+func (c *autograderServiceClient) PreviewCourseCreation(ctx context.Context, in *Course, opts ...grpc.CallOption) (*CourseCreationPlan, error) {
+	out := new(CourseCreationPlan)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/PreviewCourseCreation", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *autograderServiceClient) UpdateEnrollment(ctx context.Context, in *Enrollment, opts ...grpc.CallOption) (*Void, error) {
-	out := new(Void)
-	err := c.cc.Invoke(ctx, "/ag.AutograderService/UpdateEnrollment", in, out, opts...)
+func (c *autograderServiceClient) GetGradingScale(ctx context.Context, in *CourseRequest, opts ...grpc.CallOption) (*GradingScale, error) {
+	out := new(GradingScale)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/GetGradingScale", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *autograderServiceClient) UpdateEnrollments(ctx context.Context, in *CourseRequest, opts ...grpc.CallOption) (*Void, error) {
+func (c *autograderServiceClient) UpdateGradingScale(ctx context.Context, in *GradingScale, opts ...grpc.CallOption) (*Void, error) {
 	out := new(Void)
-	err := c.cc.Invoke(ctx, "/ag.AutograderService/UpdateEnrollments", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/UpdateGradingScale", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *autograderServiceClient) GetSubmissions(ctx context.Context, in *SubmissionRequest, opts ...grpc.CallOption) (*Submissions, error) {
-	out := new(Submissions)
-	err := c.cc.Invoke(ctx, "/ag.AutograderService/GetSubmissions", in, out, opts...)
+func (c *autograderServiceClient) ComputeFinalGrades(ctx context.Context, in *CourseRequest, opts ...grpc.CallOption) (*FinalGrades, error) {
+	out := new(FinalGrades)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/ComputeFinalGrades", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *autograderServiceClient) GetSubmissionsByCourse(ctx context.Context, in *SubmissionsForCourseRequest, opts ...grpc.CallOption) (*CourseSubmissions, error) {
-	out := new(CourseSubmissions)
-	err := c.cc.Invoke(ctx, "/ag.AutograderService/GetSubmissionsByCourse", in, out, opts...)
+func (c *autograderServiceClient) GetCourseCompletion(ctx context.Context, in *CourseRequest, opts ...grpc.CallOption) (*CourseCompletion, error) {
+	out := new(CourseCompletion)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/GetCourseCompletion", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *autograderServiceClient) UpdateSubmission(ctx context.Context, in *UpdateSubmissionRequest, opts ...grpc.CallOption) (*Void, error) {
-	out := new(Void)
-	err := c.cc.Invoke(ctx, "/ag.AutograderService/UpdateSubmission", in, out, opts...)
+func (c *autograderServiceClient) GetScoreCurve(ctx context.Context, in *AssignmentRequest, opts ...grpc.CallOption) (*ScoreCurve, error) {
+	out := new(ScoreCurve)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/GetScoreCurve", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *autograderServiceClient) UpdateSubmissions(ctx context.Context, in *UpdateSubmissionsRequest, opts ...grpc.CallOption) (*Void, error) {
-	out := new(Void)
-	err := c.cc.Invoke(ctx, "/ag.AutograderService/UpdateSubmissions", in, out, opts...)
+func (c *autograderServiceClient) ApplyScoreCurve(ctx context.Context, in *ScoreCurve, opts ...grpc.CallOption) (*ScoreCurve, error) {
+	out := new(ScoreCurve)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/ApplyScoreCurve", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *autograderServiceClient) RebuildSubmission(ctx context.Context, in *RebuildRequest, opts ...grpc.CallOption) (*Submission, error) {
-	out := new(Submission)
-	err := c.cc.Invoke(ctx, "/ag.AutograderService/RebuildSubmission", in, out, opts...)
+func (c *autograderServiceClient) ExportResults(ctx context.Context, in *ResultsExportRequest, opts ...grpc.CallOption) (*ResultsExport, error) {
+	out := new(ResultsExport)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/ExportResults", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *autograderServiceClient) RebuildSubmissions(ctx context.Context, in *AssignmentRequest, opts ...grpc.CallOption) (*Void, error) {
-	out := new(Void)
-	err := c.cc.Invoke(ctx, "/ag.AutograderService/RebuildSubmissions", in, out, opts...)
+func (c *autograderServiceClient) GetCertificate(ctx context.Context, in *CourseRequest, opts ...grpc.CallOption) (*Certificate, error) {
+	out := new(Certificate)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/GetCertificate", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *autograderServiceClient) CreateBenchmark(ctx context.Context, in *GradingBenchmark, opts ...grpc.CallOption) (*GradingBenchmark, error) {
-	out := new(GradingBenchmark)
-	err := c.cc.Invoke(ctx, "/ag.AutograderService/CreateBenchmark", in, out, opts...)
+func (c *autograderServiceClient) GetAssignments(ctx context.Context, in *CourseRequest, opts ...grpc.CallOption) (*Assignments, error) {
+	out := new(Assignments)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/GetAssignments", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *autograderServiceClient) UpdateBenchmark(ctx context.Context, in *GradingBenchmark, opts ...grpc.CallOption) (*Void, error) {
+func (c *autograderServiceClient) UpdateAssignments(ctx context.Context, in *CourseRequest, opts ...grpc.CallOption) (*Void, error) {
 	out := new(Void)
-	err := c.cc.Invoke(ctx, "/ag.AutograderService/UpdateBenchmark", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/UpdateAssignments", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *autograderServiceClient) DeleteBenchmark(ctx context.Context, in *GradingBenchmark, opts ...grpc.CallOption) (*Void, error) {
-	out := new(Void)
-	err := c.cc.Invoke(ctx, "/ag.AutograderService/DeleteBenchmark", in, out, opts...)
+func (c *autograderServiceClient) ValidateAssignmentFile(ctx context.Context, in *AssignmentFile, opts ...grpc.CallOption) (*AssignmentFileErrors, error) {
+	out := new(AssignmentFileErrors)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/ValidateAssignmentFile", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *autograderServiceClient) CreateCriterion(ctx context.Context, in *GradingCriterion, opts ...grpc.CallOption) (*GradingCriterion, error) {
-	out := new(GradingCriterion)
-	err := c.cc.Invoke(ctx, "/ag.AutograderService/CreateCriterion", in, out, opts...)
+func (c *autograderServiceClient) ValidateAssignments(ctx context.Context, in *CourseRequest, opts ...grpc.CallOption) (*AssignmentValidation, error) {
+	out := new(AssignmentValidation)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/ValidateAssignments", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *autograderServiceClient) UpdateCriterion(ctx context.Context, in *GradingCriterion, opts ...grpc.CallOption) (*Void, error) {
-	out := new(Void)
-	err := c.cc.Invoke(ctx, "/ag.AutograderService/UpdateCriterion", in, out, opts...)
+func (c *autograderServiceClient) GetEnrollmentsByUser(ctx context.Context, in *EnrollmentStatusRequest, opts ...grpc.CallOption) (*Enrollments, error) {
+	out := new(Enrollments)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/GetEnrollmentsByUser", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *autograderServiceClient) DeleteCriterion(ctx context.Context, in *GradingCriterion, opts ...grpc.CallOption) (*Void, error) {
-	out := new(Void)
-	err := c.cc.Invoke(ctx, "/ag.AutograderService/DeleteCriterion", in, out, opts...)
+func (c *autograderServiceClient) GetUserProgress(ctx context.Context, in *Void, opts ...grpc.CallOption) (*UserProgress, error) {
+	out := new(UserProgress)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/GetUserProgress", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *autograderServiceClient) CreateReview(ctx context.Context, in *ReviewRequest, opts ...grpc.CallOption) (*Review, error) {
-	out := new(Review)
-	err := c.cc.Invoke(ctx, "/ag.AutograderService/CreateReview", in, out, opts...)
+func (c *autograderServiceClient) GetSlipDays(ctx context.Context, in *SlipDaysRequest, opts ...grpc.CallOption) (*SlipDays, error) {
+	out := new(SlipDays)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/GetSlipDays", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *autograderServiceClient) UpdateReview(ctx context.Context, in *ReviewRequest, opts ...grpc.CallOption) (*Review, error) {
-	out := new(Review)
-	err := c.cc.Invoke(ctx, "/ag.AutograderService/UpdateReview", in, out, opts...)
+func (c *autograderServiceClient) GetEnrollmentsByCourse(ctx context.Context, in *EnrollmentRequest, opts ...grpc.CallOption) (*Enrollments, error) {
+	out := new(Enrollments)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/GetEnrollmentsByCourse", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *autograderServiceClient) GetReviewers(ctx context.Context, in *SubmissionReviewersRequest, opts ...grpc.CallOption) (*Reviewers, error) {
-	out := new(Reviewers)
-	err := c.cc.Invoke(ctx, "/ag.AutograderService/GetReviewers", in, out, opts...)
+func (c *autograderServiceClient) CreateEnrollment(ctx context.Context, in *Enrollment, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/CreateEnrollment", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *autograderServiceClient) GetProviders(ctx context.Context, in *Void, opts ...grpc.CallOption) (*Providers, error) {
-	out := new(Providers)
-	err := c.cc.Invoke(ctx, "/ag.AutograderService/GetProviders", in, out, opts...)
+func (c *autograderServiceClient) UpdateEnrollment(ctx context.Context, in *Enrollment, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/UpdateEnrollment", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *autograderServiceClient) GetOrganization(ctx context.Context, in *OrgRequest, opts ...grpc.CallOption) (*Organization, error) {
-	out := new(Organization)
-	err := c.cc.Invoke(ctx, "/ag.AutograderService/GetOrganization", in, out, opts...)
+func (c *autograderServiceClient) UpdateEnrollments(ctx context.Context, in *CourseRequest, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/UpdateEnrollments", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *autograderServiceClient) GetRepositories(ctx context.Context, in *URLRequest, opts ...grpc.CallOption) (*Repositories, error) {
-	out := new(Repositories)
-	err := c.cc.Invoke(ctx, "/ag.AutograderService/GetRepositories", in, out, opts...)
+func (c *autograderServiceClient) RestoreEnrollment(ctx context.Context, in *Enrollment, opts ...grpc.CallOption) (*Enrollment, error) {
+	out := new(Enrollment)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/RestoreEnrollment", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *autograderServiceClient) IsEmptyRepo(ctx context.Context, in *RepositoryRequest, opts ...grpc.CallOption) (*Void, error) {
-	out := new(Void)
-	err := c.cc.Invoke(ctx, "/ag.AutograderService/IsEmptyRepo", in, out, opts...)
+func (c *autograderServiceClient) ImportEnrollments(ctx context.Context, in *ImportEnrollmentsRequest, opts ...grpc.CallOption) (*EnrollmentImportReport, error) {
+	out := new(EnrollmentImportReport)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/ImportEnrollments", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// AutograderServiceServer is the server API for AutograderService service.
-// All implementations must embed UnimplementedAutograderServiceServer
-// for forward compatibility
-type AutograderServiceServer interface {
-	GetUser(context.Context, *Void) (*User, error)
-	GetUsers(context.Context, *Void) (*Users, error)
-	GetUserByCourse(context.Context, *CourseUserRequest) (*User, error)
-	UpdateUser(context.Context, *User) (*Void, error)
-	IsAuthorizedTeacher(context.Context, *Void) (*AuthorizationResponse, error)
-	GetGroup(context.Context, *GetGroupRequest) (*Group, error)
-	GetGroupByUserAndCourse(context.Context, *GroupRequest) (*Group, error)
-	GetGroupsByCourse(context.Context, *CourseRequest) (*Groups, error)
-	CreateGroup(context.Context, *Group) (*Group, error)
-	UpdateGroup(context.Context, *Group) (*Void, error)
-	DeleteGroup(context.Context, *GroupRequest) (*Void, error)
-	GetCourse(context.Context, *CourseRequest) (*Course, error)
-	GetCourses(context.Context, *Void) (*Courses, error)
-	GetCoursesByUser(context.Context, *EnrollmentStatusRequest) (*Courses, error)
-	CreateCourse(context.Context, *Course) (*Course, error)
-	UpdateCourse(context.Context, *Course) (*Void, error)
-	UpdateCourseVisibility(context.Context, *Enrollment) (*Void, error)
-	GetAssignments(context.Context, *CourseRequest) (*Assignments, error)
-	UpdateAssignments(context.Context, *CourseRequest) (*Void, error)
-	GetEnrollmentsByUser(context.Context, *EnrollmentStatusRequest) (*Enrollments, error)
-	GetEnrollmentsByCourse(context.Context, *EnrollmentRequest) (*Enrollments, error)
-	CreateEnrollment(context.Context, *Enrollment) (*Void, error)
-	UpdateEnrollment(context.Context, *Enrollment) (*Void, error)
-	UpdateEnrollments(context.Context, *CourseRequest) (*Void, error)
-	// Get latest submissions for all course assignments for a user or a group.
-	GetSubmissions(context.Context, *SubmissionRequest) (*Submissions, error)
-	// Get lab submissions for every course user or every course group
-	GetSubmissionsByCourse(context.Context, *SubmissionsForCourseRequest) (*CourseSubmissions, error)
-	UpdateSubmission(context.Context, *UpdateSubmissionRequest) (*Void, error)
-	UpdateSubmissions(context.Context, *UpdateSubmissionsRequest) (*Void, error)
-	RebuildSubmission(context.Context, *RebuildRequest) (*Submission, error)
-	RebuildSubmissions(context.Context, *AssignmentRequest) (*Void, error)
-	CreateBenchmark(context.Context, *GradingBenchmark) (*GradingBenchmark, error)
-	UpdateBenchmark(context.Context, *GradingBenchmark) (*Void, error)
-	DeleteBenchmark(context.Context, *GradingBenchmark) (*Void, error)
-	CreateCriterion(context.Context, *GradingCriterion) (*GradingCriterion, error)
-	UpdateCriterion(context.Context, *GradingCriterion) (*Void, error)
-	DeleteCriterion(context.Context, *GradingCriterion) (*Void, error)
-	CreateReview(context.Context, *ReviewRequest) (*Review, error)
-	UpdateReview(context.Context, *ReviewRequest) (*Review, error)
-	GetReviewers(context.Context, *SubmissionReviewersRequest) (*Reviewers, error)
-	GetProviders(context.Context, *Void) (*Providers, error)
-	GetOrganization(context.Context, *OrgRequest) (*Organization, error)
-	GetRepositories(context.Context, *URLRequest) (*Repositories, error)
-	IsEmptyRepo(context.Context, *RepositoryRequest) (*Void, error)
-	mustEmbedUnimplementedAutograderServiceServer()
+func (c *autograderServiceClient) GetSubmissions(ctx context.Context, in *SubmissionRequest, opts ...grpc.CallOption) (*Submissions, error) {
+	out := new(Submissions)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/GetSubmissions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedAutograderServiceServer must be embedded to have forward compatible implementations.
-type UnimplementedAutograderServiceServer struct {
+func (c *autograderServiceClient) SubmissionStream(ctx context.Context, in *SubmissionStreamRequest, opts ...grpc.CallOption) (AutograderService_SubmissionStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &AutograderService_ServiceDesc.Streams[0], "/ag.AutograderService/SubmissionStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &autograderServiceSubmissionStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-func (UnimplementedAutograderServiceServer) GetUser(context.Context, *Void) (*User, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetUser not implemented")
+type AutograderService_SubmissionStreamClient interface {
+	Recv() (*Submission, error)
+	grpc.ClientStream
 }
-func (UnimplementedAutograderServiceServer) GetUsers(context.Context, *Void) (*Users, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetUsers not implemented")
+
+type autograderServiceSubmissionStreamClient struct {
+	grpc.ClientStream
 }
-func (UnimplementedAutograderServiceServer) GetUserByCourse(context.Context, *CourseUserRequest) (*User, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetUserByCourse not implemented")
+
+func (x *autograderServiceSubmissionStreamClient) Recv() (*Submission, error) {
+	m := new(Submission)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
 }
-func (UnimplementedAutograderServiceServer) UpdateUser(context.Context, *User) (*Void, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateUser not implemented")
+
+func (c *autograderServiceClient) GetSubmissionsByCourse(ctx context.Context, in *SubmissionsForCourseRequest, opts ...grpc.CallOption) (*CourseSubmissions, error) {
+	out := new(CourseSubmissions)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/GetSubmissionsByCourse", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedAutograderServiceServer) IsAuthorizedTeacher(context.Context, *Void) (*AuthorizationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method IsAuthorizedTeacher not implemented")
+
+func (c *autograderServiceClient) GetCourseResults(ctx context.Context, in *CourseRequest, opts ...grpc.CallOption) (*CourseResults, error) {
+	out := new(CourseResults)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/GetCourseResults", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedAutograderServiceServer) GetGroup(context.Context, *GetGroupRequest) (*Group, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetGroup not implemented")
+
+func (c *autograderServiceClient) GetCourseStatistics(ctx context.Context, in *CourseRequest, opts ...grpc.CallOption) (*CourseStatistics, error) {
+	out := new(CourseStatistics)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/GetCourseStatistics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedAutograderServiceServer) GetGroupByUserAndCourse(context.Context, *GroupRequest) (*Group, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetGroupByUserAndCourse not implemented")
+
+func (c *autograderServiceClient) GetTestFailureStats(ctx context.Context, in *AssignmentRequest, opts ...grpc.CallOption) (*TestFailureStats, error) {
+	out := new(TestFailureStats)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/GetTestFailureStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedAutograderServiceServer) GetGroupsByCourse(context.Context, *CourseRequest) (*Groups, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetGroupsByCourse not implemented")
+
+func (c *autograderServiceClient) GetSubmissionStatus(ctx context.Context, in *SubmissionStatusRequest, opts ...grpc.CallOption) (*SubmissionStatus, error) {
+	out := new(SubmissionStatus)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/GetSubmissionStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedAutograderServiceServer) CreateGroup(context.Context, *Group) (*Group, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateGroup not implemented")
+
+func (c *autograderServiceClient) GetSubmissionArtifacts(ctx context.Context, in *ArtifactRequest, opts ...grpc.CallOption) (*Artifacts, error) {
+	out := new(Artifacts)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/GetSubmissionArtifacts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedAutograderServiceServer) UpdateGroup(context.Context, *Group) (*Void, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateGroup not implemented")
+
+func (c *autograderServiceClient) GetTestResults(ctx context.Context, in *TestResultRequest, opts ...grpc.CallOption) (*TestResults, error) {
+	out := new(TestResults)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/GetTestResults", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedAutograderServiceServer) DeleteGroup(context.Context, *GroupRequest) (*Void, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteGroup not implemented")
+
+func (c *autograderServiceClient) GetTaskScores(ctx context.Context, in *TaskScoreRequest, opts ...grpc.CallOption) (*TaskScores, error) {
+	out := new(TaskScores)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/GetTaskScores", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedAutograderServiceServer) GetCourse(context.Context, *CourseRequest) (*Course, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetCourse not implemented")
+
+func (c *autograderServiceClient) UpdateSubmission(ctx context.Context, in *UpdateSubmissionRequest, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/UpdateSubmission", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedAutograderServiceServer) GetCourses(context.Context, *Void) (*Courses, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetCourses not implemented")
+
+func (c *autograderServiceClient) UpdateSubmissions(ctx context.Context, in *UpdateSubmissionsRequest, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/UpdateSubmissions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedAutograderServiceServer) GetCoursesByUser(context.Context, *EnrollmentStatusRequest) (*Courses, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetCoursesByUser not implemented")
+
+func (c *autograderServiceClient) TransitionSubmission(ctx context.Context, in *SubmissionTransitionRequest, opts ...grpc.CallOption) (*Submission, error) {
+	out := new(Submission)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/TransitionSubmission", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedAutograderServiceServer) CreateCourse(context.Context, *Course) (*Course, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateCourse not implemented")
+
+func (c *autograderServiceClient) GetSubmissionTransitions(ctx context.Context, in *SubmissionTransitionRequest, opts ...grpc.CallOption) (*SubmissionTransitions, error) {
+	out := new(SubmissionTransitions)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/GetSubmissionTransitions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedAutograderServiceServer) UpdateCourse(context.Context, *Course) (*Void, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateCourse not implemented")
+
+func (c *autograderServiceClient) RebuildSubmission(ctx context.Context, in *RebuildRequest, opts ...grpc.CallOption) (*Submission, error) {
+	out := new(Submission)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/RebuildSubmission", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedAutograderServiceServer) UpdateCourseVisibility(context.Context, *Enrollment) (*Void, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateCourseVisibility not implemented")
+
+func (c *autograderServiceClient) RebuildSubmissions(ctx context.Context, in *AssignmentRequest, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/RebuildSubmissions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedAutograderServiceServer) GetAssignments(context.Context, *CourseRequest) (*Assignments, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAssignments not implemented")
+
+func (c *autograderServiceClient) ProcessMissedPushes(ctx context.Context, in *MissedPushesRequest, opts ...grpc.CallOption) (*MissedPushesReport, error) {
+	out := new(MissedPushesReport)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/ProcessMissedPushes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedAutograderServiceServer) UpdateAssignments(context.Context, *CourseRequest) (*Void, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateAssignments not implemented")
+
+func (c *autograderServiceClient) ReplaySubmissions(ctx context.Context, in *ReplayRequest, opts ...grpc.CallOption) (*ReplayReport, error) {
+	out := new(ReplayReport)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/ReplaySubmissions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedAutograderServiceServer) GetEnrollmentsByUser(context.Context, *EnrollmentStatusRequest) (*Enrollments, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetEnrollmentsByUser not implemented")
+
+func (c *autograderServiceClient) GetForcePushes(ctx context.Context, in *CourseRequest, opts ...grpc.CallOption) (*ForcePushes, error) {
+	out := new(ForcePushes)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/GetForcePushes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedAutograderServiceServer) GetEnrollmentsByCourse(context.Context, *EnrollmentRequest) (*Enrollments, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetEnrollmentsByCourse not implemented")
+
+func (c *autograderServiceClient) CreateAppeal(ctx context.Context, in *Appeal, opts ...grpc.CallOption) (*Appeal, error) {
+	out := new(Appeal)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/CreateAppeal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedAutograderServiceServer) CreateEnrollment(context.Context, *Enrollment) (*Void, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateEnrollment not implemented")
+
+func (c *autograderServiceClient) UpdateAppeal(ctx context.Context, in *Appeal, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/UpdateAppeal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedAutograderServiceServer) UpdateEnrollment(context.Context, *Enrollment) (*Void, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateEnrollment not implemented")
+
+func (c *autograderServiceClient) GetAppeals(ctx context.Context, in *AppealRequest, opts ...grpc.CallOption) (*Appeals, error) {
+	out := new(Appeals)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/GetAppeals", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedAutograderServiceServer) UpdateEnrollments(context.Context, *CourseRequest) (*Void, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateEnrollments not implemented")
+
+func (c *autograderServiceClient) GrantExtension(ctx context.Context, in *Extension, opts ...grpc.CallOption) (*Extension, error) {
+	out := new(Extension)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/GrantExtension", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedAutograderServiceServer) GetSubmissions(context.Context, *SubmissionRequest) (*Submissions, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetSubmissions not implemented")
+
+func (c *autograderServiceClient) ListExtensions(ctx context.Context, in *ExtensionRequest, opts ...grpc.CallOption) (*Extensions, error) {
+	out := new(Extensions)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/ListExtensions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedAutograderServiceServer) GetSubmissionsByCourse(context.Context, *SubmissionsForCourseRequest) (*CourseSubmissions, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetSubmissionsByCourse not implemented")
+
+func (c *autograderServiceClient) RunPlagiarismCheck(ctx context.Context, in *PlagiarismRequest, opts ...grpc.CallOption) (*PlagiarismResults, error) {
+	out := new(PlagiarismResults)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/RunPlagiarismCheck", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedAutograderServiceServer) UpdateSubmission(context.Context, *UpdateSubmissionRequest) (*Void, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateSubmission not implemented")
+
+func (c *autograderServiceClient) GetPlagiarismResults(ctx context.Context, in *PlagiarismRequest, opts ...grpc.CallOption) (*PlagiarismResults, error) {
+	out := new(PlagiarismResults)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/GetPlagiarismResults", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedAutograderServiceServer) UpdateSubmissions(context.Context, *UpdateSubmissionsRequest) (*Void, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateSubmissions not implemented")
+
+func (c *autograderServiceClient) CreateBenchmark(ctx context.Context, in *GradingBenchmark, opts ...grpc.CallOption) (*GradingBenchmark, error) {
+	out := new(GradingBenchmark)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/CreateBenchmark", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedAutograderServiceServer) RebuildSubmission(context.Context, *RebuildRequest) (*Submission, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RebuildSubmission not implemented")
+
+func (c *autograderServiceClient) UpdateBenchmark(ctx context.Context, in *GradingBenchmark, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/UpdateBenchmark", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedAutograderServiceServer) RebuildSubmissions(context.Context, *AssignmentRequest) (*Void, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RebuildSubmissions not implemented")
+
+func (c *autograderServiceClient) DeleteBenchmark(ctx context.Context, in *GradingBenchmark, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/DeleteBenchmark", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedAutograderServiceServer) CreateBenchmark(context.Context, *GradingBenchmark) (*GradingBenchmark, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateBenchmark not implemented")
+
+func (c *autograderServiceClient) CreateCriterion(ctx context.Context, in *GradingCriterion, opts ...grpc.CallOption) (*GradingCriterion, error) {
+	out := new(GradingCriterion)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/CreateCriterion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedAutograderServiceServer) UpdateBenchmark(context.Context, *GradingBenchmark) (*Void, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateBenchmark not implemented")
+
+func (c *autograderServiceClient) UpdateCriterion(ctx context.Context, in *GradingCriterion, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/UpdateCriterion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedAutograderServiceServer) DeleteBenchmark(context.Context, *GradingBenchmark) (*Void, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteBenchmark not implemented")
+
+func (c *autograderServiceClient) DeleteCriterion(ctx context.Context, in *GradingCriterion, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/DeleteCriterion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedAutograderServiceServer) CreateCriterion(context.Context, *GradingCriterion) (*GradingCriterion, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateCriterion not implemented")
+
+func (c *autograderServiceClient) CreateReview(ctx context.Context, in *ReviewRequest, opts ...grpc.CallOption) (*Review, error) {
+	out := new(Review)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/CreateReview", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedAutograderServiceServer) UpdateCriterion(context.Context, *GradingCriterion) (*Void, error) {
+
+func (c *autograderServiceClient) UpdateReview(ctx context.Context, in *ReviewRequest, opts ...grpc.CallOption) (*Review, error) {
+	out := new(Review)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/UpdateReview", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *autograderServiceClient) GetReviewers(ctx context.Context, in *SubmissionReviewersRequest, opts ...grpc.CallOption) (*Reviewers, error) {
+	out := new(Reviewers)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/GetReviewers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *autograderServiceClient) ReleaseReviews(ctx context.Context, in *AssignmentRequest, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/ReleaseReviews", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *autograderServiceClient) AssignPeerReviews(ctx context.Context, in *AssignmentRequest, opts ...grpc.CallOption) (*PeerReviews, error) {
+	out := new(PeerReviews)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/AssignPeerReviews", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *autograderServiceClient) GetPeerReviewTasks(ctx context.Context, in *AssignmentRequest, opts ...grpc.CallOption) (*PeerReviews, error) {
+	out := new(PeerReviews)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/GetPeerReviewTasks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *autograderServiceClient) SubmitPeerReview(ctx context.Context, in *PeerReview, opts ...grpc.CallOption) (*PeerReview, error) {
+	out := new(PeerReview)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/SubmitPeerReview", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *autograderServiceClient) GetPeerReviews(ctx context.Context, in *AssignmentRequest, opts ...grpc.CallOption) (*PeerReviews, error) {
+	out := new(PeerReviews)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/GetPeerReviews", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *autograderServiceClient) ModeratePeerReview(ctx context.Context, in *PeerReview, opts ...grpc.CallOption) (*PeerReview, error) {
+	out := new(PeerReview)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/ModeratePeerReview", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *autograderServiceClient) ReleasePeerReviews(ctx context.Context, in *AssignmentRequest, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/ReleasePeerReviews", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *autograderServiceClient) CreateSubmissionComment(ctx context.Context, in *SubmissionComment, opts ...grpc.CallOption) (*SubmissionComment, error) {
+	out := new(SubmissionComment)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/CreateSubmissionComment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *autograderServiceClient) UpdateSubmissionComment(ctx context.Context, in *SubmissionComment, opts ...grpc.CallOption) (*SubmissionComment, error) {
+	out := new(SubmissionComment)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/UpdateSubmissionComment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *autograderServiceClient) DeleteSubmissionComment(ctx context.Context, in *SubmissionComment, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/DeleteSubmissionComment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *autograderServiceClient) GetSubmissionComments(ctx context.Context, in *SubmissionCommentRequest, opts ...grpc.CallOption) (*SubmissionComments, error) {
+	out := new(SubmissionComments)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/GetSubmissionComments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *autograderServiceClient) GetQuestions(ctx context.Context, in *AssignmentRequest, opts ...grpc.CallOption) (*Questions, error) {
+	out := new(Questions)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/GetQuestions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *autograderServiceClient) CreateQuestion(ctx context.Context, in *QuestionRequest, opts ...grpc.CallOption) (*Question, error) {
+	out := new(Question)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/CreateQuestion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *autograderServiceClient) UpdateQuestion(ctx context.Context, in *QuestionRequest, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/UpdateQuestion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *autograderServiceClient) DeleteQuestion(ctx context.Context, in *QuestionRequest, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/DeleteQuestion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *autograderServiceClient) CreateAnswer(ctx context.Context, in *AnswerRequest, opts ...grpc.CallOption) (*Answer, error) {
+	out := new(Answer)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/CreateAnswer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *autograderServiceClient) UpdateAnswer(ctx context.Context, in *AnswerRequest, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/UpdateAnswer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *autograderServiceClient) DeleteAnswer(ctx context.Context, in *AnswerRequest, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/DeleteAnswer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *autograderServiceClient) GetAssignmentDiscussions(ctx context.Context, in *AssignmentRequest, opts ...grpc.CallOption) (*Discussions, error) {
+	out := new(Discussions)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/GetAssignmentDiscussions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *autograderServiceClient) GetAnnouncements(ctx context.Context, in *CourseRequest, opts ...grpc.CallOption) (*Announcements, error) {
+	out := new(Announcements)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/GetAnnouncements", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *autograderServiceClient) CreateAnnouncement(ctx context.Context, in *Announcement, opts ...grpc.CallOption) (*Announcement, error) {
+	out := new(Announcement)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/CreateAnnouncement", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *autograderServiceClient) UpdateAnnouncement(ctx context.Context, in *Announcement, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/UpdateAnnouncement", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *autograderServiceClient) MarkAnnouncementRead(ctx context.Context, in *AnnouncementRequest, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/MarkAnnouncementRead", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *autograderServiceClient) GetCourseFeedURL(ctx context.Context, in *CourseRequest, opts ...grpc.CallOption) (*FeedURL, error) {
+	out := new(FeedURL)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/GetCourseFeedURL", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *autograderServiceClient) GetNotifications(ctx context.Context, in *NotificationsRequest, opts ...grpc.CallOption) (*Notifications, error) {
+	out := new(Notifications)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/GetNotifications", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *autograderServiceClient) MarkNotificationRead(ctx context.Context, in *NotificationRequest, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/MarkNotificationRead", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *autograderServiceClient) GetProviders(ctx context.Context, in *Void, opts ...grpc.CallOption) (*Providers, error) {
+	out := new(Providers)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/GetProviders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *autograderServiceClient) GetOrganization(ctx context.Context, in *OrgRequest, opts ...grpc.CallOption) (*Organization, error) {
+	out := new(Organization)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/GetOrganization", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *autograderServiceClient) GetRepositories(ctx context.Context, in *URLRequest, opts ...grpc.CallOption) (*Repositories, error) {
+	out := new(Repositories)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/GetRepositories", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *autograderServiceClient) IsEmptyRepo(ctx context.Context, in *RepositoryRequest, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/IsEmptyRepo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *autograderServiceClient) GetOrphanedResources(ctx context.Context, in *CourseRequest, opts ...grpc.CallOption) (*OrphanedResources, error) {
+	out := new(OrphanedResources)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/GetOrphanedResources", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *autograderServiceClient) AuditTestsExposure(ctx context.Context, in *ExposureAuditRequest, opts ...grpc.CallOption) (*ExposureReport, error) {
+	out := new(ExposureReport)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/AuditTestsExposure", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *autograderServiceClient) GetAccessReport(ctx context.Context, in *CourseRequest, opts ...grpc.CallOption) (*AccessReport, error) {
+	out := new(AccessReport)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/GetAccessReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *autograderServiceClient) SetCourseRepositoryAccess(ctx context.Context, in *RepositoryAccessRequest, opts ...grpc.CallOption) (*RepositoryAccessReport, error) {
+	out := new(RepositoryAccessReport)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/SetCourseRepositoryAccess", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *autograderServiceClient) GetAdminOverview(ctx context.Context, in *Void, opts ...grpc.CallOption) (*AdminOverview, error) {
+	out := new(AdminOverview)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/GetAdminOverview", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *autograderServiceClient) GetTestJobs(ctx context.Context, in *Void, opts ...grpc.CallOption) (*TestJobs, error) {
+	out := new(TestJobs)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/GetTestJobs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *autograderServiceClient) CancelTestJob(ctx context.Context, in *TestJob, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/CancelTestJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *autograderServiceClient) GetWebhookDeliveries(ctx context.Context, in *WebhookDeliveryRequest, opts ...grpc.CallOption) (*WebhookDeliveries, error) {
+	out := new(WebhookDeliveries)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/GetWebhookDeliveries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *autograderServiceClient) ReplayWebhookDelivery(ctx context.Context, in *WebhookDeliveryRequest, opts ...grpc.CallOption) (*WebhookDelivery, error) {
+	out := new(WebhookDelivery)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/ReplayWebhookDelivery", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *autograderServiceClient) GetAuditLog(ctx context.Context, in *AuditLogRequest, opts ...grpc.CallOption) (*AuditLog, error) {
+	out := new(AuditLog)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/GetAuditLog", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *autograderServiceClient) CreateAccessToken(ctx context.Context, in *AccessTokenRequest, opts ...grpc.CallOption) (*NewAccessToken, error) {
+	out := new(NewAccessToken)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/CreateAccessToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *autograderServiceClient) GetAccessTokens(ctx context.Context, in *Void, opts ...grpc.CallOption) (*AccessTokens, error) {
+	out := new(AccessTokens)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/GetAccessTokens", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *autograderServiceClient) RevokeAccessToken(ctx context.Context, in *AccessToken, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/RevokeAccessToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *autograderServiceClient) GetSessions(ctx context.Context, in *Void, opts ...grpc.CallOption) (*Sessions, error) {
+	out := new(Sessions)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/GetSessions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *autograderServiceClient) RevokeSession(ctx context.Context, in *Session, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/RevokeSession", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *autograderServiceClient) LogoutUser(ctx context.Context, in *UserRequest, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/LogoutUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *autograderServiceClient) GetPendingActions(ctx context.Context, in *Void, opts ...grpc.CallOption) (*PendingActions, error) {
+	out := new(PendingActions)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/GetPendingActions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *autograderServiceClient) ConfirmPendingAction(ctx context.Context, in *PendingAction, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/ConfirmPendingAction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *autograderServiceClient) CancelPendingAction(ctx context.Context, in *PendingAction, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, "/ag.AutograderService/CancelPendingAction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AutograderServiceServer is the server API for AutograderService service.
+// All implementations must embed UnimplementedAutograderServiceServer
+// for forward compatibility
+type AutograderServiceServer interface {
+	GetUser(context.Context, *Void) (*User, error)
+	GetUsers(context.Context, *UsersRequest) (*Users, error)
+	GetUserByCourse(context.Context, *CourseUserRequest) (*User, error)
+	UpdateUser(context.Context, *User) (*Void, error)
+	ExportUserData(context.Context, *UserRequest) (*UserData, error)
+	DeleteUserData(context.Context, *UserRequest) (*Void, error)
+	IsAuthorizedTeacher(context.Context, *Void) (*AuthorizationResponse, error)
+	GetRemoteIdentities(context.Context, *Void) (*RemoteIdentities, error)
+	UnlinkRemoteIdentity(context.Context, *UnlinkIdentityRequest) (*Void, error)
+	GetTenants(context.Context, *Void) (*Tenants, error)
+	CreateTenant(context.Context, *Tenant) (*Tenant, error)
+	UpdateTenant(context.Context, *Tenant) (*Void, error)
+	SetUserTenant(context.Context, *TenantUserRequest) (*Void, error)
+	GetGroup(context.Context, *GetGroupRequest) (*Group, error)
+	GetGroupByUserAndCourse(context.Context, *GroupRequest) (*Group, error)
+	GetGroupsByCourse(context.Context, *CourseRequest) (*Groups, error)
+	CreateGroup(context.Context, *Group) (*Group, error)
+	UpdateGroup(context.Context, *Group) (*Void, error)
+	DeleteGroup(context.Context, *GroupRequest) (*Void, error)
+	RestoreGroup(context.Context, *GroupRequest) (*Group, error)
+	InviteToGroup(context.Context, *GroupInvitation) (*GroupInvitation, error)
+	RespondToGroupInvitation(context.Context, *GroupInvitation) (*Void, error)
+	GetGroupInvitations(context.Context, *CourseRequest) (*GroupInvitations, error)
+	GenerateGroups(context.Context, *GenerateGroupsRequest) (*GenerateGroupsReport, error)
+	GetCourse(context.Context, *CourseRequest) (*Course, error)
+	GetCourses(context.Context, *Void) (*Courses, error)
+	GetCoursesByUser(context.Context, *EnrollmentStatusRequest) (*Courses, error)
+	CreateCourse(context.Context, *Course) (*Course, error)
+	UpdateCourse(context.Context, *Course) (*Void, error)
+	UpdateCourseVisibility(context.Context, *Enrollment) (*Void, error)
+	ArchiveCourse(context.Context, *CourseCleanupRequest) (*Void, error)
+	DeleteCourse(context.Context, *CourseCleanupRequest) (*Void, error)
+	RepairCourse(context.Context, *CourseRequest) (*Course, error)
+	CloneCourse(context.Context, *CloneCourseRequest) (*Course, error)
+	PreviewCourseCreation(context.Context, *Course) (*CourseCreationPlan, error)
+	GetGradingScale(context.Context, *CourseRequest) (*GradingScale, error)
+	UpdateGradingScale(context.Context, *GradingScale) (*Void, error)
+	ComputeFinalGrades(context.Context, *CourseRequest) (*FinalGrades, error)
+	GetCourseCompletion(context.Context, *CourseRequest) (*CourseCompletion, error)
+	GetScoreCurve(context.Context, *AssignmentRequest) (*ScoreCurve, error)
+	ApplyScoreCurve(context.Context, *ScoreCurve) (*ScoreCurve, error)
+	ExportResults(context.Context, *ResultsExportRequest) (*ResultsExport, error)
+	GetCertificate(context.Context, *CourseRequest) (*Certificate, error)
+	GetAssignments(context.Context, *CourseRequest) (*Assignments, error)
+	UpdateAssignments(context.Context, *CourseRequest) (*Void, error)
+	ValidateAssignmentFile(context.Context, *AssignmentFile) (*AssignmentFileErrors, error)
+	ValidateAssignments(context.Context, *CourseRequest) (*AssignmentValidation, error)
+	GetEnrollmentsByUser(context.Context, *EnrollmentStatusRequest) (*Enrollments, error)
+	GetUserProgress(context.Context, *Void) (*UserProgress, error)
+	GetSlipDays(context.Context, *SlipDaysRequest) (*SlipDays, error)
+	GetEnrollmentsByCourse(context.Context, *EnrollmentRequest) (*Enrollments, error)
+	CreateEnrollment(context.Context, *Enrollment) (*Void, error)
+	UpdateEnrollment(context.Context, *Enrollment) (*Void, error)
+	UpdateEnrollments(context.Context, *CourseRequest) (*Void, error)
+	RestoreEnrollment(context.Context, *Enrollment) (*Enrollment, error)
+	ImportEnrollments(context.Context, *ImportEnrollmentsRequest) (*EnrollmentImportReport, error)
+	// Get latest submissions for all course assignments for a user or a group.
+	GetSubmissions(context.Context, *SubmissionRequest) (*Submissions, error)
+	SubmissionStream(*SubmissionStreamRequest, AutograderService_SubmissionStreamServer) error
+	// Get lab submissions for every course user or every course group
+	GetSubmissionsByCourse(context.Context, *SubmissionsForCourseRequest) (*CourseSubmissions, error)
+	GetCourseResults(context.Context, *CourseRequest) (*CourseResults, error)
+	GetCourseStatistics(context.Context, *CourseRequest) (*CourseStatistics, error)
+	GetTestFailureStats(context.Context, *AssignmentRequest) (*TestFailureStats, error)
+	GetSubmissionStatus(context.Context, *SubmissionStatusRequest) (*SubmissionStatus, error)
+	GetSubmissionArtifacts(context.Context, *ArtifactRequest) (*Artifacts, error)
+	GetTestResults(context.Context, *TestResultRequest) (*TestResults, error)
+	GetTaskScores(context.Context, *TaskScoreRequest) (*TaskScores, error)
+	UpdateSubmission(context.Context, *UpdateSubmissionRequest) (*Void, error)
+	UpdateSubmissions(context.Context, *UpdateSubmissionsRequest) (*Void, error)
+	TransitionSubmission(context.Context, *SubmissionTransitionRequest) (*Submission, error)
+	GetSubmissionTransitions(context.Context, *SubmissionTransitionRequest) (*SubmissionTransitions, error)
+	RebuildSubmission(context.Context, *RebuildRequest) (*Submission, error)
+	RebuildSubmissions(context.Context, *AssignmentRequest) (*Void, error)
+	ProcessMissedPushes(context.Context, *MissedPushesRequest) (*MissedPushesReport, error)
+	ReplaySubmissions(context.Context, *ReplayRequest) (*ReplayReport, error)
+	GetForcePushes(context.Context, *CourseRequest) (*ForcePushes, error)
+	CreateAppeal(context.Context, *Appeal) (*Appeal, error)
+	UpdateAppeal(context.Context, *Appeal) (*Void, error)
+	GetAppeals(context.Context, *AppealRequest) (*Appeals, error)
+	GrantExtension(context.Context, *Extension) (*Extension, error)
+	ListExtensions(context.Context, *ExtensionRequest) (*Extensions, error)
+	RunPlagiarismCheck(context.Context, *PlagiarismRequest) (*PlagiarismResults, error)
+	GetPlagiarismResults(context.Context, *PlagiarismRequest) (*PlagiarismResults, error)
+	CreateBenchmark(context.Context, *GradingBenchmark) (*GradingBenchmark, error)
+	UpdateBenchmark(context.Context, *GradingBenchmark) (*Void, error)
+	DeleteBenchmark(context.Context, *GradingBenchmark) (*Void, error)
+	CreateCriterion(context.Context, *GradingCriterion) (*GradingCriterion, error)
+	UpdateCriterion(context.Context, *GradingCriterion) (*Void, error)
+	DeleteCriterion(context.Context, *GradingCriterion) (*Void, error)
+	CreateReview(context.Context, *ReviewRequest) (*Review, error)
+	UpdateReview(context.Context, *ReviewRequest) (*Review, error)
+	GetReviewers(context.Context, *SubmissionReviewersRequest) (*Reviewers, error)
+	ReleaseReviews(context.Context, *AssignmentRequest) (*Void, error)
+	AssignPeerReviews(context.Context, *AssignmentRequest) (*PeerReviews, error)
+	GetPeerReviewTasks(context.Context, *AssignmentRequest) (*PeerReviews, error)
+	SubmitPeerReview(context.Context, *PeerReview) (*PeerReview, error)
+	GetPeerReviews(context.Context, *AssignmentRequest) (*PeerReviews, error)
+	ModeratePeerReview(context.Context, *PeerReview) (*PeerReview, error)
+	ReleasePeerReviews(context.Context, *AssignmentRequest) (*Void, error)
+	CreateSubmissionComment(context.Context, *SubmissionComment) (*SubmissionComment, error)
+	UpdateSubmissionComment(context.Context, *SubmissionComment) (*SubmissionComment, error)
+	DeleteSubmissionComment(context.Context, *SubmissionComment) (*Void, error)
+	GetSubmissionComments(context.Context, *SubmissionCommentRequest) (*SubmissionComments, error)
+	GetQuestions(context.Context, *AssignmentRequest) (*Questions, error)
+	CreateQuestion(context.Context, *QuestionRequest) (*Question, error)
+	UpdateQuestion(context.Context, *QuestionRequest) (*Void, error)
+	DeleteQuestion(context.Context, *QuestionRequest) (*Void, error)
+	CreateAnswer(context.Context, *AnswerRequest) (*Answer, error)
+	UpdateAnswer(context.Context, *AnswerRequest) (*Void, error)
+	DeleteAnswer(context.Context, *AnswerRequest) (*Void, error)
+	GetAssignmentDiscussions(context.Context, *AssignmentRequest) (*Discussions, error)
+	GetAnnouncements(context.Context, *CourseRequest) (*Announcements, error)
+	CreateAnnouncement(context.Context, *Announcement) (*Announcement, error)
+	UpdateAnnouncement(context.Context, *Announcement) (*Void, error)
+	MarkAnnouncementRead(context.Context, *AnnouncementRequest) (*Void, error)
+	GetCourseFeedURL(context.Context, *CourseRequest) (*FeedURL, error)
+	GetNotifications(context.Context, *NotificationsRequest) (*Notifications, error)
+	MarkNotificationRead(context.Context, *NotificationRequest) (*Void, error)
+	GetProviders(context.Context, *Void) (*Providers, error)
+	GetOrganization(context.Context, *OrgRequest) (*Organization, error)
+	GetRepositories(context.Context, *URLRequest) (*Repositories, error)
+	IsEmptyRepo(context.Context, *RepositoryRequest) (*Void, error)
+	GetOrphanedResources(context.Context, *CourseRequest) (*OrphanedResources, error)
+	AuditTestsExposure(context.Context, *ExposureAuditRequest) (*ExposureReport, error)
+	GetAccessReport(context.Context, *CourseRequest) (*AccessReport, error)
+	SetCourseRepositoryAccess(context.Context, *RepositoryAccessRequest) (*RepositoryAccessReport, error)
+	GetAdminOverview(context.Context, *Void) (*AdminOverview, error)
+	GetTestJobs(context.Context, *Void) (*TestJobs, error)
+	CancelTestJob(context.Context, *TestJob) (*Void, error)
+	GetWebhookDeliveries(context.Context, *WebhookDeliveryRequest) (*WebhookDeliveries, error)
+	ReplayWebhookDelivery(context.Context, *WebhookDeliveryRequest) (*WebhookDelivery, error)
+	GetAuditLog(context.Context, *AuditLogRequest) (*AuditLog, error)
+	CreateAccessToken(context.Context, *AccessTokenRequest) (*NewAccessToken, error)
+	GetAccessTokens(context.Context, *Void) (*AccessTokens, error)
+	RevokeAccessToken(context.Context, *AccessToken) (*Void, error)
+	GetSessions(context.Context, *Void) (*Sessions, error)
+	RevokeSession(context.Context, *Session) (*Void, error)
+	LogoutUser(context.Context, *UserRequest) (*Void, error)
+	GetPendingActions(context.Context, *Void) (*PendingActions, error)
+	ConfirmPendingAction(context.Context, *PendingAction) (*Void, error)
+	CancelPendingAction(context.Context, *PendingAction) (*Void, error)
+	mustEmbedUnimplementedAutograderServiceServer()
+}
+
+// UnimplementedAutograderServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedAutograderServiceServer struct {
+}
+
+func (UnimplementedAutograderServiceServer) GetUser(context.Context, *Void) (*User, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUser not implemented")
+}
+func (UnimplementedAutograderServiceServer) GetUsers(context.Context, *UsersRequest) (*Users, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUsers not implemented")
+}
+func (UnimplementedAutograderServiceServer) GetUserByCourse(context.Context, *CourseUserRequest) (*User, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserByCourse not implemented")
+}
+func (UnimplementedAutograderServiceServer) UpdateUser(context.Context, *User) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateUser not implemented")
+}
+func (UnimplementedAutograderServiceServer) ExportUserData(context.Context, *UserRequest) (*UserData, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportUserData not implemented")
+}
+func (UnimplementedAutograderServiceServer) DeleteUserData(context.Context, *UserRequest) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteUserData not implemented")
+}
+func (UnimplementedAutograderServiceServer) IsAuthorizedTeacher(context.Context, *Void) (*AuthorizationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsAuthorizedTeacher not implemented")
+}
+func (UnimplementedAutograderServiceServer) GetRemoteIdentities(context.Context, *Void) (*RemoteIdentities, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRemoteIdentities not implemented")
+}
+func (UnimplementedAutograderServiceServer) UnlinkRemoteIdentity(context.Context, *UnlinkIdentityRequest) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlinkRemoteIdentity not implemented")
+}
+func (UnimplementedAutograderServiceServer) GetTenants(context.Context, *Void) (*Tenants, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTenants not implemented")
+}
+func (UnimplementedAutograderServiceServer) CreateTenant(context.Context, *Tenant) (*Tenant, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTenant not implemented")
+}
+func (UnimplementedAutograderServiceServer) UpdateTenant(context.Context, *Tenant) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTenant not implemented")
+}
+func (UnimplementedAutograderServiceServer) SetUserTenant(context.Context, *TenantUserRequest) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetUserTenant not implemented")
+}
+func (UnimplementedAutograderServiceServer) GetGroup(context.Context, *GetGroupRequest) (*Group, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGroup not implemented")
+}
+func (UnimplementedAutograderServiceServer) GetGroupByUserAndCourse(context.Context, *GroupRequest) (*Group, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGroupByUserAndCourse not implemented")
+}
+func (UnimplementedAutograderServiceServer) GetGroupsByCourse(context.Context, *CourseRequest) (*Groups, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGroupsByCourse not implemented")
+}
+func (UnimplementedAutograderServiceServer) CreateGroup(context.Context, *Group) (*Group, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGroup not implemented")
+}
+func (UnimplementedAutograderServiceServer) UpdateGroup(context.Context, *Group) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateGroup not implemented")
+}
+func (UnimplementedAutograderServiceServer) DeleteGroup(context.Context, *GroupRequest) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteGroup not implemented")
+}
+func (UnimplementedAutograderServiceServer) RestoreGroup(context.Context, *GroupRequest) (*Group, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreGroup not implemented")
+}
+func (UnimplementedAutograderServiceServer) InviteToGroup(context.Context, *GroupInvitation) (*GroupInvitation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InviteToGroup not implemented")
+}
+func (UnimplementedAutograderServiceServer) RespondToGroupInvitation(context.Context, *GroupInvitation) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RespondToGroupInvitation not implemented")
+}
+func (UnimplementedAutograderServiceServer) GetGroupInvitations(context.Context, *CourseRequest) (*GroupInvitations, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGroupInvitations not implemented")
+}
+func (UnimplementedAutograderServiceServer) GenerateGroups(context.Context, *GenerateGroupsRequest) (*GenerateGroupsReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateGroups not implemented")
+}
+func (UnimplementedAutograderServiceServer) GetCourse(context.Context, *CourseRequest) (*Course, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCourse not implemented")
+}
+func (UnimplementedAutograderServiceServer) GetCourses(context.Context, *Void) (*Courses, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCourses not implemented")
+}
+func (UnimplementedAutograderServiceServer) GetCoursesByUser(context.Context, *EnrollmentStatusRequest) (*Courses, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCoursesByUser not implemented")
+}
+func (UnimplementedAutograderServiceServer) CreateCourse(context.Context, *Course) (*Course, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCourse not implemented")
+}
+func (UnimplementedAutograderServiceServer) UpdateCourse(context.Context, *Course) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCourse not implemented")
+}
+func (UnimplementedAutograderServiceServer) UpdateCourseVisibility(context.Context, *Enrollment) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCourseVisibility not implemented")
+}
+func (UnimplementedAutograderServiceServer) ArchiveCourse(context.Context, *CourseCleanupRequest) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArchiveCourse not implemented")
+}
+func (UnimplementedAutograderServiceServer) DeleteCourse(context.Context, *CourseCleanupRequest) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCourse not implemented")
+}
+func (UnimplementedAutograderServiceServer) RepairCourse(context.Context, *CourseRequest) (*Course, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RepairCourse not implemented")
+}
+func (UnimplementedAutograderServiceServer) CloneCourse(context.Context, *CloneCourseRequest) (*Course, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloneCourse not implemented")
+}
+func (UnimplementedAutograderServiceServer) PreviewCourseCreation(context.Context, *Course) (*CourseCreationPlan, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviewCourseCreation not implemented")
+}
+func (UnimplementedAutograderServiceServer) GetGradingScale(context.Context, *CourseRequest) (*GradingScale, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGradingScale not implemented")
+}
+func (UnimplementedAutograderServiceServer) UpdateGradingScale(context.Context, *GradingScale) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateGradingScale not implemented")
+}
+func (UnimplementedAutograderServiceServer) ComputeFinalGrades(context.Context, *CourseRequest) (*FinalGrades, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ComputeFinalGrades not implemented")
+}
+func (UnimplementedAutograderServiceServer) GetCourseCompletion(context.Context, *CourseRequest) (*CourseCompletion, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCourseCompletion not implemented")
+}
+func (UnimplementedAutograderServiceServer) GetScoreCurve(context.Context, *AssignmentRequest) (*ScoreCurve, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetScoreCurve not implemented")
+}
+func (UnimplementedAutograderServiceServer) ApplyScoreCurve(context.Context, *ScoreCurve) (*ScoreCurve, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyScoreCurve not implemented")
+}
+func (UnimplementedAutograderServiceServer) ExportResults(context.Context, *ResultsExportRequest) (*ResultsExport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportResults not implemented")
+}
+func (UnimplementedAutograderServiceServer) GetCertificate(context.Context, *CourseRequest) (*Certificate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCertificate not implemented")
+}
+func (UnimplementedAutograderServiceServer) GetAssignments(context.Context, *CourseRequest) (*Assignments, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAssignments not implemented")
+}
+func (UnimplementedAutograderServiceServer) UpdateAssignments(context.Context, *CourseRequest) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAssignments not implemented")
+}
+func (UnimplementedAutograderServiceServer) ValidateAssignmentFile(context.Context, *AssignmentFile) (*AssignmentFileErrors, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateAssignmentFile not implemented")
+}
+func (UnimplementedAutograderServiceServer) ValidateAssignments(context.Context, *CourseRequest) (*AssignmentValidation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateAssignments not implemented")
+}
+func (UnimplementedAutograderServiceServer) GetEnrollmentsByUser(context.Context, *EnrollmentStatusRequest) (*Enrollments, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEnrollmentsByUser not implemented")
+}
+func (UnimplementedAutograderServiceServer) GetUserProgress(context.Context, *Void) (*UserProgress, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserProgress not implemented")
+}
+func (UnimplementedAutograderServiceServer) GetSlipDays(context.Context, *SlipDaysRequest) (*SlipDays, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSlipDays not implemented")
+}
+func (UnimplementedAutograderServiceServer) GetEnrollmentsByCourse(context.Context, *EnrollmentRequest) (*Enrollments, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEnrollmentsByCourse not implemented")
+}
+func (UnimplementedAutograderServiceServer) CreateEnrollment(context.Context, *Enrollment) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateEnrollment not implemented")
+}
+func (UnimplementedAutograderServiceServer) UpdateEnrollment(context.Context, *Enrollment) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateEnrollment not implemented")
+}
+func (UnimplementedAutograderServiceServer) UpdateEnrollments(context.Context, *CourseRequest) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateEnrollments not implemented")
+}
+func (UnimplementedAutograderServiceServer) RestoreEnrollment(context.Context, *Enrollment) (*Enrollment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreEnrollment not implemented")
+}
+func (UnimplementedAutograderServiceServer) ImportEnrollments(context.Context, *ImportEnrollmentsRequest) (*EnrollmentImportReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportEnrollments not implemented")
+}
+func (UnimplementedAutograderServiceServer) GetSubmissions(context.Context, *SubmissionRequest) (*Submissions, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSubmissions not implemented")
+}
+func (UnimplementedAutograderServiceServer) SubmissionStream(*SubmissionStreamRequest, AutograderService_SubmissionStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubmissionStream not implemented")
+}
+func (UnimplementedAutograderServiceServer) GetSubmissionsByCourse(context.Context, *SubmissionsForCourseRequest) (*CourseSubmissions, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSubmissionsByCourse not implemented")
+}
+func (UnimplementedAutograderServiceServer) GetCourseResults(context.Context, *CourseRequest) (*CourseResults, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCourseResults not implemented")
+}
+func (UnimplementedAutograderServiceServer) GetCourseStatistics(context.Context, *CourseRequest) (*CourseStatistics, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCourseStatistics not implemented")
+}
+func (UnimplementedAutograderServiceServer) GetTestFailureStats(context.Context, *AssignmentRequest) (*TestFailureStats, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTestFailureStats not implemented")
+}
+func (UnimplementedAutograderServiceServer) GetSubmissionStatus(context.Context, *SubmissionStatusRequest) (*SubmissionStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSubmissionStatus not implemented")
+}
+func (UnimplementedAutograderServiceServer) GetSubmissionArtifacts(context.Context, *ArtifactRequest) (*Artifacts, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSubmissionArtifacts not implemented")
+}
+func (UnimplementedAutograderServiceServer) GetTestResults(context.Context, *TestResultRequest) (*TestResults, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTestResults not implemented")
+}
+func (UnimplementedAutograderServiceServer) GetTaskScores(context.Context, *TaskScoreRequest) (*TaskScores, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTaskScores not implemented")
+}
+func (UnimplementedAutograderServiceServer) UpdateSubmission(context.Context, *UpdateSubmissionRequest) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSubmission not implemented")
+}
+func (UnimplementedAutograderServiceServer) UpdateSubmissions(context.Context, *UpdateSubmissionsRequest) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSubmissions not implemented")
+}
+func (UnimplementedAutograderServiceServer) TransitionSubmission(context.Context, *SubmissionTransitionRequest) (*Submission, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransitionSubmission not implemented")
+}
+func (UnimplementedAutograderServiceServer) GetSubmissionTransitions(context.Context, *SubmissionTransitionRequest) (*SubmissionTransitions, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSubmissionTransitions not implemented")
+}
+func (UnimplementedAutograderServiceServer) RebuildSubmission(context.Context, *RebuildRequest) (*Submission, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RebuildSubmission not implemented")
+}
+func (UnimplementedAutograderServiceServer) RebuildSubmissions(context.Context, *AssignmentRequest) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RebuildSubmissions not implemented")
+}
+func (UnimplementedAutograderServiceServer) ProcessMissedPushes(context.Context, *MissedPushesRequest) (*MissedPushesReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProcessMissedPushes not implemented")
+}
+func (UnimplementedAutograderServiceServer) ReplaySubmissions(context.Context, *ReplayRequest) (*ReplayReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplaySubmissions not implemented")
+}
+func (UnimplementedAutograderServiceServer) GetForcePushes(context.Context, *CourseRequest) (*ForcePushes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetForcePushes not implemented")
+}
+func (UnimplementedAutograderServiceServer) CreateAppeal(context.Context, *Appeal) (*Appeal, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAppeal not implemented")
+}
+func (UnimplementedAutograderServiceServer) UpdateAppeal(context.Context, *Appeal) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAppeal not implemented")
+}
+func (UnimplementedAutograderServiceServer) GetAppeals(context.Context, *AppealRequest) (*Appeals, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAppeals not implemented")
+}
+func (UnimplementedAutograderServiceServer) GrantExtension(context.Context, *Extension) (*Extension, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantExtension not implemented")
+}
+func (UnimplementedAutograderServiceServer) ListExtensions(context.Context, *ExtensionRequest) (*Extensions, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListExtensions not implemented")
+}
+func (UnimplementedAutograderServiceServer) RunPlagiarismCheck(context.Context, *PlagiarismRequest) (*PlagiarismResults, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RunPlagiarismCheck not implemented")
+}
+func (UnimplementedAutograderServiceServer) GetPlagiarismResults(context.Context, *PlagiarismRequest) (*PlagiarismResults, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPlagiarismResults not implemented")
+}
+func (UnimplementedAutograderServiceServer) CreateBenchmark(context.Context, *GradingBenchmark) (*GradingBenchmark, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBenchmark not implemented")
+}
+func (UnimplementedAutograderServiceServer) UpdateBenchmark(context.Context, *GradingBenchmark) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBenchmark not implemented")
+}
+func (UnimplementedAutograderServiceServer) DeleteBenchmark(context.Context, *GradingBenchmark) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteBenchmark not implemented")
+}
+func (UnimplementedAutograderServiceServer) CreateCriterion(context.Context, *GradingCriterion) (*GradingCriterion, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCriterion not implemented")
+}
+func (UnimplementedAutograderServiceServer) UpdateCriterion(context.Context, *GradingCriterion) (*Void, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateCriterion not implemented")
 }
-func (UnimplementedAutograderServiceServer) DeleteCriterion(context.Context, *GradingCriterion) (*Void, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteCriterion not implemented")
+func (UnimplementedAutograderServiceServer) DeleteCriterion(context.Context, *GradingCriterion) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCriterion not implemented")
+}
+func (UnimplementedAutograderServiceServer) CreateReview(context.Context, *ReviewRequest) (*Review, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateReview not implemented")
+}
+func (UnimplementedAutograderServiceServer) UpdateReview(context.Context, *ReviewRequest) (*Review, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateReview not implemented")
+}
+func (UnimplementedAutograderServiceServer) GetReviewers(context.Context, *SubmissionReviewersRequest) (*Reviewers, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReviewers not implemented")
+}
+func (UnimplementedAutograderServiceServer) ReleaseReviews(context.Context, *AssignmentRequest) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseReviews not implemented")
+}
+func (UnimplementedAutograderServiceServer) AssignPeerReviews(context.Context, *AssignmentRequest) (*PeerReviews, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssignPeerReviews not implemented")
+}
+func (UnimplementedAutograderServiceServer) GetPeerReviewTasks(context.Context, *AssignmentRequest) (*PeerReviews, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPeerReviewTasks not implemented")
+}
+func (UnimplementedAutograderServiceServer) SubmitPeerReview(context.Context, *PeerReview) (*PeerReview, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitPeerReview not implemented")
+}
+func (UnimplementedAutograderServiceServer) GetPeerReviews(context.Context, *AssignmentRequest) (*PeerReviews, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPeerReviews not implemented")
+}
+func (UnimplementedAutograderServiceServer) ModeratePeerReview(context.Context, *PeerReview) (*PeerReview, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModeratePeerReview not implemented")
+}
+func (UnimplementedAutograderServiceServer) ReleasePeerReviews(context.Context, *AssignmentRequest) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleasePeerReviews not implemented")
+}
+func (UnimplementedAutograderServiceServer) CreateSubmissionComment(context.Context, *SubmissionComment) (*SubmissionComment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSubmissionComment not implemented")
+}
+func (UnimplementedAutograderServiceServer) UpdateSubmissionComment(context.Context, *SubmissionComment) (*SubmissionComment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSubmissionComment not implemented")
+}
+func (UnimplementedAutograderServiceServer) DeleteSubmissionComment(context.Context, *SubmissionComment) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSubmissionComment not implemented")
+}
+func (UnimplementedAutograderServiceServer) GetSubmissionComments(context.Context, *SubmissionCommentRequest) (*SubmissionComments, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSubmissionComments not implemented")
+}
+func (UnimplementedAutograderServiceServer) GetQuestions(context.Context, *AssignmentRequest) (*Questions, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetQuestions not implemented")
+}
+func (UnimplementedAutograderServiceServer) CreateQuestion(context.Context, *QuestionRequest) (*Question, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateQuestion not implemented")
+}
+func (UnimplementedAutograderServiceServer) UpdateQuestion(context.Context, *QuestionRequest) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateQuestion not implemented")
+}
+func (UnimplementedAutograderServiceServer) DeleteQuestion(context.Context, *QuestionRequest) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteQuestion not implemented")
+}
+func (UnimplementedAutograderServiceServer) CreateAnswer(context.Context, *AnswerRequest) (*Answer, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAnswer not implemented")
+}
+func (UnimplementedAutograderServiceServer) UpdateAnswer(context.Context, *AnswerRequest) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAnswer not implemented")
+}
+func (UnimplementedAutograderServiceServer) DeleteAnswer(context.Context, *AnswerRequest) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAnswer not implemented")
+}
+func (UnimplementedAutograderServiceServer) GetAssignmentDiscussions(context.Context, *AssignmentRequest) (*Discussions, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAssignmentDiscussions not implemented")
+}
+func (UnimplementedAutograderServiceServer) GetAnnouncements(context.Context, *CourseRequest) (*Announcements, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAnnouncements not implemented")
+}
+func (UnimplementedAutograderServiceServer) CreateAnnouncement(context.Context, *Announcement) (*Announcement, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAnnouncement not implemented")
+}
+func (UnimplementedAutograderServiceServer) UpdateAnnouncement(context.Context, *Announcement) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAnnouncement not implemented")
+}
+func (UnimplementedAutograderServiceServer) MarkAnnouncementRead(context.Context, *AnnouncementRequest) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkAnnouncementRead not implemented")
+}
+func (UnimplementedAutograderServiceServer) GetCourseFeedURL(context.Context, *CourseRequest) (*FeedURL, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCourseFeedURL not implemented")
+}
+func (UnimplementedAutograderServiceServer) GetNotifications(context.Context, *NotificationsRequest) (*Notifications, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNotifications not implemented")
+}
+func (UnimplementedAutograderServiceServer) MarkNotificationRead(context.Context, *NotificationRequest) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkNotificationRead not implemented")
+}
+func (UnimplementedAutograderServiceServer) GetProviders(context.Context, *Void) (*Providers, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProviders not implemented")
+}
+func (UnimplementedAutograderServiceServer) GetOrganization(context.Context, *OrgRequest) (*Organization, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrganization not implemented")
+}
+func (UnimplementedAutograderServiceServer) GetRepositories(context.Context, *URLRequest) (*Repositories, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRepositories not implemented")
+}
+func (UnimplementedAutograderServiceServer) IsEmptyRepo(context.Context, *RepositoryRequest) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsEmptyRepo not implemented")
+}
+func (UnimplementedAutograderServiceServer) GetOrphanedResources(context.Context, *CourseRequest) (*OrphanedResources, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrphanedResources not implemented")
+}
+func (UnimplementedAutograderServiceServer) AuditTestsExposure(context.Context, *ExposureAuditRequest) (*ExposureReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuditTestsExposure not implemented")
+}
+func (UnimplementedAutograderServiceServer) GetAccessReport(context.Context, *CourseRequest) (*AccessReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccessReport not implemented")
+}
+func (UnimplementedAutograderServiceServer) SetCourseRepositoryAccess(context.Context, *RepositoryAccessRequest) (*RepositoryAccessReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCourseRepositoryAccess not implemented")
+}
+func (UnimplementedAutograderServiceServer) GetAdminOverview(context.Context, *Void) (*AdminOverview, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAdminOverview not implemented")
+}
+func (UnimplementedAutograderServiceServer) GetTestJobs(context.Context, *Void) (*TestJobs, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTestJobs not implemented")
+}
+func (UnimplementedAutograderServiceServer) CancelTestJob(context.Context, *TestJob) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelTestJob not implemented")
+}
+func (UnimplementedAutograderServiceServer) GetWebhookDeliveries(context.Context, *WebhookDeliveryRequest) (*WebhookDeliveries, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWebhookDeliveries not implemented")
+}
+func (UnimplementedAutograderServiceServer) ReplayWebhookDelivery(context.Context, *WebhookDeliveryRequest) (*WebhookDelivery, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplayWebhookDelivery not implemented")
+}
+func (UnimplementedAutograderServiceServer) GetAuditLog(context.Context, *AuditLogRequest) (*AuditLog, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAuditLog not implemented")
+}
+func (UnimplementedAutograderServiceServer) CreateAccessToken(context.Context, *AccessTokenRequest) (*NewAccessToken, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAccessToken not implemented")
+}
+func (UnimplementedAutograderServiceServer) GetAccessTokens(context.Context, *Void) (*AccessTokens, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccessTokens not implemented")
+}
+func (UnimplementedAutograderServiceServer) RevokeAccessToken(context.Context, *AccessToken) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeAccessToken not implemented")
+}
+func (UnimplementedAutograderServiceServer) GetSessions(context.Context, *Void) (*Sessions, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSessions not implemented")
+}
+func (UnimplementedAutograderServiceServer) RevokeSession(context.Context, *Session) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeSession not implemented")
+}
+func (UnimplementedAutograderServiceServer) LogoutUser(context.Context, *UserRequest) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LogoutUser not implemented")
+}
+func (UnimplementedAutograderServiceServer) GetPendingActions(context.Context, *Void) (*PendingActions, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPendingActions not implemented")
+}
+func (UnimplementedAutograderServiceServer) ConfirmPendingAction(context.Context, *PendingAction) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmPendingAction not implemented")
+}
+func (UnimplementedAutograderServiceServer) CancelPendingAction(context.Context, *PendingAction) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelPendingAction not implemented")
+}
+func (UnimplementedAutograderServiceServer) mustEmbedUnimplementedAutograderServiceServer() {}
+
+// UnsafeAutograderServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AutograderServiceServer will
+// result in compilation errors.
+type UnsafeAutograderServiceServer interface {
+	mustEmbedUnimplementedAutograderServiceServer()
+}
+
+func RegisterAutograderServiceServer(s grpc.ServiceRegistrar, srv AutograderServiceServer) {
+	s.RegisterService(&AutograderService_ServiceDesc, srv)
+}
+
+func _AutograderService_GetUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Void)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).GetUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/GetUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).GetUser(ctx, req.(*Void))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_GetUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).GetUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/GetUsers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).GetUsers(ctx, req.(*UsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_GetUserByCourse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CourseUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).GetUserByCourse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/GetUserByCourse",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).GetUserByCourse(ctx, req.(*CourseUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_UpdateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(User)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).UpdateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/UpdateUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).UpdateUser(ctx, req.(*User))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_ExportUserData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).ExportUserData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/ExportUserData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).ExportUserData(ctx, req.(*UserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_DeleteUserData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).DeleteUserData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/DeleteUserData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).DeleteUserData(ctx, req.(*UserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_IsAuthorizedTeacher_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Void)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).IsAuthorizedTeacher(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/IsAuthorizedTeacher",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).IsAuthorizedTeacher(ctx, req.(*Void))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_GetRemoteIdentities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Void)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).GetRemoteIdentities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/GetRemoteIdentities",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).GetRemoteIdentities(ctx, req.(*Void))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_UnlinkRemoteIdentity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlinkIdentityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).UnlinkRemoteIdentity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/UnlinkRemoteIdentity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).UnlinkRemoteIdentity(ctx, req.(*UnlinkIdentityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_GetTenants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Void)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).GetTenants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/GetTenants",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).GetTenants(ctx, req.(*Void))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_CreateTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Tenant)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).CreateTenant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/CreateTenant",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).CreateTenant(ctx, req.(*Tenant))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_UpdateTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Tenant)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).UpdateTenant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/UpdateTenant",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).UpdateTenant(ctx, req.(*Tenant))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_SetUserTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TenantUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).SetUserTenant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/SetUserTenant",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).SetUserTenant(ctx, req.(*TenantUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_GetGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).GetGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/GetGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).GetGroup(ctx, req.(*GetGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_GetGroupByUserAndCourse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).GetGroupByUserAndCourse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/GetGroupByUserAndCourse",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).GetGroupByUserAndCourse(ctx, req.(*GroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_GetGroupsByCourse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CourseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).GetGroupsByCourse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/GetGroupsByCourse",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).GetGroupsByCourse(ctx, req.(*CourseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_CreateGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Group)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).CreateGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/CreateGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).CreateGroup(ctx, req.(*Group))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_UpdateGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Group)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).UpdateGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/UpdateGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).UpdateGroup(ctx, req.(*Group))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_DeleteGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).DeleteGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/DeleteGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).DeleteGroup(ctx, req.(*GroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_RestoreGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).RestoreGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/RestoreGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).RestoreGroup(ctx, req.(*GroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_InviteToGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GroupInvitation)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).InviteToGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/InviteToGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).InviteToGroup(ctx, req.(*GroupInvitation))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_RespondToGroupInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GroupInvitation)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).RespondToGroupInvitation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/RespondToGroupInvitation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).RespondToGroupInvitation(ctx, req.(*GroupInvitation))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_GetGroupInvitations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CourseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).GetGroupInvitations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/GetGroupInvitations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).GetGroupInvitations(ctx, req.(*CourseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_GenerateGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateGroupsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).GenerateGroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/GenerateGroups",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).GenerateGroups(ctx, req.(*GenerateGroupsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_GetCourse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CourseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).GetCourse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/GetCourse",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).GetCourse(ctx, req.(*CourseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_GetCourses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Void)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).GetCourses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/GetCourses",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).GetCourses(ctx, req.(*Void))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_GetCoursesByUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnrollmentStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).GetCoursesByUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/GetCoursesByUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).GetCoursesByUser(ctx, req.(*EnrollmentStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_CreateCourse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Course)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).CreateCourse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/CreateCourse",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).CreateCourse(ctx, req.(*Course))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_UpdateCourse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Course)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).UpdateCourse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/UpdateCourse",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).UpdateCourse(ctx, req.(*Course))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_UpdateCourseVisibility_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Enrollment)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).UpdateCourseVisibility(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/UpdateCourseVisibility",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).UpdateCourseVisibility(ctx, req.(*Enrollment))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_ArchiveCourse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CourseCleanupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).ArchiveCourse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/ArchiveCourse",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).ArchiveCourse(ctx, req.(*CourseCleanupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_DeleteCourse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CourseCleanupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).DeleteCourse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/DeleteCourse",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).DeleteCourse(ctx, req.(*CourseCleanupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_RepairCourse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CourseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).RepairCourse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/RepairCourse",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).RepairCourse(ctx, req.(*CourseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_CloneCourse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloneCourseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).CloneCourse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/CloneCourse",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).CloneCourse(ctx, req.(*CloneCourseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_PreviewCourseCreation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Course)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).PreviewCourseCreation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/PreviewCourseCreation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).PreviewCourseCreation(ctx, req.(*Course))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_GetGradingScale_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CourseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).GetGradingScale(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/GetGradingScale",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).GetGradingScale(ctx, req.(*CourseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_UpdateGradingScale_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GradingScale)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).UpdateGradingScale(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/UpdateGradingScale",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).UpdateGradingScale(ctx, req.(*GradingScale))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_ComputeFinalGrades_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CourseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).ComputeFinalGrades(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/ComputeFinalGrades",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).ComputeFinalGrades(ctx, req.(*CourseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_GetCourseCompletion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CourseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).GetCourseCompletion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/GetCourseCompletion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).GetCourseCompletion(ctx, req.(*CourseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_GetScoreCurve_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).GetScoreCurve(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/GetScoreCurve",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).GetScoreCurve(ctx, req.(*AssignmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_ApplyScoreCurve_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScoreCurve)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).ApplyScoreCurve(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/ApplyScoreCurve",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).ApplyScoreCurve(ctx, req.(*ScoreCurve))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_ExportResults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResultsExportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).ExportResults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/ExportResults",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).ExportResults(ctx, req.(*ResultsExportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_GetCertificate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CourseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).GetCertificate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/GetCertificate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).GetCertificate(ctx, req.(*CourseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_GetAssignments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CourseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).GetAssignments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/GetAssignments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).GetAssignments(ctx, req.(*CourseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_UpdateAssignments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CourseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).UpdateAssignments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/UpdateAssignments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).UpdateAssignments(ctx, req.(*CourseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_ValidateAssignmentFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignmentFile)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).ValidateAssignmentFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/ValidateAssignmentFile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).ValidateAssignmentFile(ctx, req.(*AssignmentFile))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_ValidateAssignments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CourseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).ValidateAssignments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/ValidateAssignments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).ValidateAssignments(ctx, req.(*CourseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_GetEnrollmentsByUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnrollmentStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).GetEnrollmentsByUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/GetEnrollmentsByUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).GetEnrollmentsByUser(ctx, req.(*EnrollmentStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_GetUserProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Void)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).GetUserProgress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/GetUserProgress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).GetUserProgress(ctx, req.(*Void))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_GetSlipDays_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SlipDaysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).GetSlipDays(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/GetSlipDays",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).GetSlipDays(ctx, req.(*SlipDaysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_GetEnrollmentsByCourse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnrollmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).GetEnrollmentsByCourse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/GetEnrollmentsByCourse",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).GetEnrollmentsByCourse(ctx, req.(*EnrollmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_CreateEnrollment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Enrollment)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).CreateEnrollment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/CreateEnrollment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).CreateEnrollment(ctx, req.(*Enrollment))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_UpdateEnrollment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Enrollment)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).UpdateEnrollment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/UpdateEnrollment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).UpdateEnrollment(ctx, req.(*Enrollment))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_UpdateEnrollments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CourseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).UpdateEnrollments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/UpdateEnrollments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).UpdateEnrollments(ctx, req.(*CourseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_RestoreEnrollment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Enrollment)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).RestoreEnrollment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/RestoreEnrollment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).RestoreEnrollment(ctx, req.(*Enrollment))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_ImportEnrollments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportEnrollmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).ImportEnrollments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/ImportEnrollments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).ImportEnrollments(ctx, req.(*ImportEnrollmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_GetSubmissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).GetSubmissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/GetSubmissions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).GetSubmissions(ctx, req.(*SubmissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_SubmissionStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubmissionStreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AutograderServiceServer).SubmissionStream(m, &autograderServiceSubmissionStreamServer{stream})
+}
+
+type AutograderService_SubmissionStreamServer interface {
+	Send(*Submission) error
+	grpc.ServerStream
+}
+
+type autograderServiceSubmissionStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *autograderServiceSubmissionStreamServer) Send(m *Submission) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _AutograderService_GetSubmissionsByCourse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmissionsForCourseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).GetSubmissionsByCourse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/GetSubmissionsByCourse",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).GetSubmissionsByCourse(ctx, req.(*SubmissionsForCourseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_GetCourseResults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CourseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).GetCourseResults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/GetCourseResults",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).GetCourseResults(ctx, req.(*CourseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_GetCourseStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CourseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).GetCourseStatistics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/GetCourseStatistics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).GetCourseStatistics(ctx, req.(*CourseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_GetTestFailureStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).GetTestFailureStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/GetTestFailureStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).GetTestFailureStats(ctx, req.(*AssignmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_GetSubmissionStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmissionStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).GetSubmissionStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/GetSubmissionStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).GetSubmissionStatus(ctx, req.(*SubmissionStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_GetSubmissionArtifacts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArtifactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).GetSubmissionArtifacts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/GetSubmissionArtifacts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).GetSubmissionArtifacts(ctx, req.(*ArtifactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_GetTestResults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TestResultRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).GetTestResults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/GetTestResults",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).GetTestResults(ctx, req.(*TestResultRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_GetTaskScores_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TaskScoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).GetTaskScores(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/GetTaskScores",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).GetTaskScores(ctx, req.(*TaskScoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_UpdateSubmission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSubmissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).UpdateSubmission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/UpdateSubmission",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).UpdateSubmission(ctx, req.(*UpdateSubmissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_UpdateSubmissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSubmissionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).UpdateSubmissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/UpdateSubmissions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).UpdateSubmissions(ctx, req.(*UpdateSubmissionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_TransitionSubmission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmissionTransitionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).TransitionSubmission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/TransitionSubmission",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).TransitionSubmission(ctx, req.(*SubmissionTransitionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_GetSubmissionTransitions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmissionTransitionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).GetSubmissionTransitions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/GetSubmissionTransitions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).GetSubmissionTransitions(ctx, req.(*SubmissionTransitionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_RebuildSubmission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RebuildRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).RebuildSubmission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/RebuildSubmission",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).RebuildSubmission(ctx, req.(*RebuildRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_RebuildSubmissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).RebuildSubmissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/RebuildSubmissions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).RebuildSubmissions(ctx, req.(*AssignmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_ProcessMissedPushes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MissedPushesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).ProcessMissedPushes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/ProcessMissedPushes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).ProcessMissedPushes(ctx, req.(*MissedPushesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_ReplaySubmissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplayRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).ReplaySubmissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/ReplaySubmissions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).ReplaySubmissions(ctx, req.(*ReplayRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_GetForcePushes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CourseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).GetForcePushes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/GetForcePushes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).GetForcePushes(ctx, req.(*CourseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_CreateAppeal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Appeal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).CreateAppeal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/CreateAppeal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).CreateAppeal(ctx, req.(*Appeal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_UpdateAppeal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Appeal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).UpdateAppeal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/UpdateAppeal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).UpdateAppeal(ctx, req.(*Appeal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_GetAppeals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AppealRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).GetAppeals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/GetAppeals",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).GetAppeals(ctx, req.(*AppealRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedAutograderServiceServer) CreateReview(context.Context, *ReviewRequest) (*Review, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateReview not implemented")
+
+func _AutograderService_GrantExtension_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Extension)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).GrantExtension(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/GrantExtension",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).GrantExtension(ctx, req.(*Extension))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedAutograderServiceServer) UpdateReview(context.Context, *ReviewRequest) (*Review, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateReview not implemented")
+
+func _AutograderService_ListExtensions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExtensionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).ListExtensions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/ListExtensions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).ListExtensions(ctx, req.(*ExtensionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedAutograderServiceServer) GetReviewers(context.Context, *SubmissionReviewersRequest) (*Reviewers, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetReviewers not implemented")
+
+func _AutograderService_RunPlagiarismCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlagiarismRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).RunPlagiarismCheck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/RunPlagiarismCheck",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).RunPlagiarismCheck(ctx, req.(*PlagiarismRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedAutograderServiceServer) GetProviders(context.Context, *Void) (*Providers, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetProviders not implemented")
+
+func _AutograderService_GetPlagiarismResults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlagiarismRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).GetPlagiarismResults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/GetPlagiarismResults",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).GetPlagiarismResults(ctx, req.(*PlagiarismRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedAutograderServiceServer) GetOrganization(context.Context, *OrgRequest) (*Organization, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetOrganization not implemented")
+
+func _AutograderService_CreateBenchmark_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GradingBenchmark)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).CreateBenchmark(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/CreateBenchmark",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).CreateBenchmark(ctx, req.(*GradingBenchmark))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_UpdateBenchmark_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GradingBenchmark)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).UpdateBenchmark(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/UpdateBenchmark",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).UpdateBenchmark(ctx, req.(*GradingBenchmark))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_DeleteBenchmark_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GradingBenchmark)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).DeleteBenchmark(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/DeleteBenchmark",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).DeleteBenchmark(ctx, req.(*GradingBenchmark))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_CreateCriterion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GradingCriterion)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).CreateCriterion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/CreateCriterion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).CreateCriterion(ctx, req.(*GradingCriterion))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_UpdateCriterion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GradingCriterion)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).UpdateCriterion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/UpdateCriterion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).UpdateCriterion(ctx, req.(*GradingCriterion))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_DeleteCriterion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GradingCriterion)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).DeleteCriterion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/DeleteCriterion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).DeleteCriterion(ctx, req.(*GradingCriterion))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_CreateReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).CreateReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/CreateReview",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).CreateReview(ctx, req.(*ReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_UpdateReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).UpdateReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/UpdateReview",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).UpdateReview(ctx, req.(*ReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_GetReviewers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmissionReviewersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).GetReviewers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/GetReviewers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).GetReviewers(ctx, req.(*SubmissionReviewersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_ReleaseReviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).ReleaseReviews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/ReleaseReviews",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).ReleaseReviews(ctx, req.(*AssignmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutograderService_AssignPeerReviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).AssignPeerReviews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/AssignPeerReviews",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).AssignPeerReviews(ctx, req.(*AssignmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedAutograderServiceServer) GetRepositories(context.Context, *URLRequest) (*Repositories, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetRepositories not implemented")
+
+func _AutograderService_GetPeerReviewTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).GetPeerReviewTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/GetPeerReviewTasks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).GetPeerReviewTasks(ctx, req.(*AssignmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedAutograderServiceServer) IsEmptyRepo(context.Context, *RepositoryRequest) (*Void, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method IsEmptyRepo not implemented")
+
+func _AutograderService_SubmitPeerReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PeerReview)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).SubmitPeerReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/SubmitPeerReview",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).SubmitPeerReview(ctx, req.(*PeerReview))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedAutograderServiceServer) mustEmbedUnimplementedAutograderServiceServer() {}
 
-// UnsafeAutograderServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to AutograderServiceServer will
-// result in compilation errors.
-type UnsafeAutograderServiceServer interface {
-	mustEmbedUnimplementedAutograderServiceServer()
+func _AutograderService_GetPeerReviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).GetPeerReviews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/GetPeerReviews",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).GetPeerReviews(ctx, req.(*AssignmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterAutograderServiceServer(s grpc.ServiceRegistrar, srv AutograderServiceServer) {
-	s.RegisterService(&AutograderService_ServiceDesc, srv)
+func _AutograderService_ModeratePeerReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PeerReview)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutograderServiceServer).ModeratePeerReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ag.AutograderService/ModeratePeerReview",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutograderServiceServer).ModeratePeerReview(ctx, req.(*PeerReview))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _AutograderService_GetUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Void)
+func _AutograderService_ReleasePeerReviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignmentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AutograderServiceServer).GetUser(ctx, in)
+		return srv.(AutograderServiceServer).ReleasePeerReviews(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ag.AutograderService/GetUser",
+		FullMethod: "/ag.AutograderService/ReleasePeerReviews",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AutograderServiceServer).GetUser(ctx, req.(*Void))
+		return srv.(AutograderServiceServer).ReleasePeerReviews(ctx, req.(*AssignmentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AutograderService_GetUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Void)
+func _AutograderService_CreateSubmissionComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmissionComment)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AutograderServiceServer).GetUsers(ctx, in)
+		return srv.(AutograderServiceServer).CreateSubmissionComment(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ag.AutograderService/GetUsers",
+		FullMethod: "/ag.AutograderService/CreateSubmissionComment",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AutograderServiceServer).GetUsers(ctx, req.(*Void))
+		return srv.(AutograderServiceServer).CreateSubmissionComment(ctx, req.(*SubmissionComment))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AutograderService_GetUserByCourse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CourseUserRequest)
+func _AutograderService_UpdateSubmissionComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmissionComment)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AutograderServiceServer).GetUserByCourse(ctx, in)
+		return srv.(AutograderServiceServer).UpdateSubmissionComment(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ag.AutograderService/GetUserByCourse",
+		FullMethod: "/ag.AutograderService/UpdateSubmissionComment",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AutograderServiceServer).GetUserByCourse(ctx, req.(*CourseUserRequest))
+		return srv.(AutograderServiceServer).UpdateSubmissionComment(ctx, req.(*SubmissionComment))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AutograderService_UpdateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(User)
+func _AutograderService_DeleteSubmissionComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmissionComment)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AutograderServiceServer).UpdateUser(ctx, in)
+		return srv.(AutograderServiceServer).DeleteSubmissionComment(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ag.AutograderService/UpdateUser",
+		FullMethod: "/ag.AutograderService/DeleteSubmissionComment",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AutograderServiceServer).UpdateUser(ctx, req.(*User))
+		return srv.(AutograderServiceServer).DeleteSubmissionComment(ctx, req.(*SubmissionComment))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AutograderService_IsAuthorizedTeacher_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Void)
+func _AutograderService_GetSubmissionComments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmissionCommentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AutograderServiceServer).IsAuthorizedTeacher(ctx, in)
+		return srv.(AutograderServiceServer).GetSubmissionComments(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ag.AutograderService/IsAuthorizedTeacher",
+		FullMethod: "/ag.AutograderService/GetSubmissionComments",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AutograderServiceServer).IsAuthorizedTeacher(ctx, req.(*Void))
+		return srv.(AutograderServiceServer).GetSubmissionComments(ctx, req.(*SubmissionCommentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AutograderService_GetGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetGroupRequest)
+func _AutograderService_GetQuestions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignmentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AutograderServiceServer).GetGroup(ctx, in)
+		return srv.(AutograderServiceServer).GetQuestions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ag.AutograderService/GetGroup",
+		FullMethod: "/ag.AutograderService/GetQuestions",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AutograderServiceServer).GetGroup(ctx, req.(*GetGroupRequest))
+		return srv.(AutograderServiceServer).GetQuestions(ctx, req.(*AssignmentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AutograderService_GetGroupByUserAndCourse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GroupRequest)
+func _AutograderService_CreateQuestion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuestionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AutograderServiceServer).GetGroupByUserAndCourse(ctx, in)
+		return srv.(AutograderServiceServer).CreateQuestion(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ag.AutograderService/GetGroupByUserAndCourse",
+		FullMethod: "/ag.AutograderService/CreateQuestion",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AutograderServiceServer).GetGroupByUserAndCourse(ctx, req.(*GroupRequest))
+		return srv.(AutograderServiceServer).CreateQuestion(ctx, req.(*QuestionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AutograderService_GetGroupsByCourse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CourseRequest)
+func _AutograderService_UpdateQuestion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuestionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AutograderServiceServer).GetGroupsByCourse(ctx, in)
+		return srv.(AutograderServiceServer).UpdateQuestion(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ag.AutograderService/GetGroupsByCourse",
+		FullMethod: "/ag.AutograderService/UpdateQuestion",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AutograderServiceServer).GetGroupsByCourse(ctx, req.(*CourseRequest))
+		return srv.(AutograderServiceServer).UpdateQuestion(ctx, req.(*QuestionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AutograderService_CreateGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Group)
+func _AutograderService_DeleteQuestion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuestionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AutograderServiceServer).CreateGroup(ctx, in)
+		return srv.(AutograderServiceServer).DeleteQuestion(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ag.AutograderService/CreateGroup",
+		FullMethod: "/ag.AutograderService/DeleteQuestion",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AutograderServiceServer).CreateGroup(ctx, req.(*Group))
+		return srv.(AutograderServiceServer).DeleteQuestion(ctx, req.(*QuestionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AutograderService_UpdateGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Group)
+func _AutograderService_CreateAnswer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AnswerRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AutograderServiceServer).UpdateGroup(ctx, in)
+		return srv.(AutograderServiceServer).CreateAnswer(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ag.AutograderService/UpdateGroup",
+		FullMethod: "/ag.AutograderService/CreateAnswer",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AutograderServiceServer).UpdateGroup(ctx, req.(*Group))
+		return srv.(AutograderServiceServer).CreateAnswer(ctx, req.(*AnswerRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AutograderService_DeleteGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GroupRequest)
+func _AutograderService_UpdateAnswer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AnswerRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AutograderServiceServer).DeleteGroup(ctx, in)
+		return srv.(AutograderServiceServer).UpdateAnswer(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ag.AutograderService/DeleteGroup",
+		FullMethod: "/ag.AutograderService/UpdateAnswer",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AutograderServiceServer).DeleteGroup(ctx, req.(*GroupRequest))
+		return srv.(AutograderServiceServer).UpdateAnswer(ctx, req.(*AnswerRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AutograderService_GetCourse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CourseRequest)
+func _AutograderService_DeleteAnswer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AnswerRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AutograderServiceServer).GetCourse(ctx, in)
+		return srv.(AutograderServiceServer).DeleteAnswer(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ag.AutograderService/GetCourse",
+		FullMethod: "/ag.AutograderService/DeleteAnswer",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AutograderServiceServer).GetCourse(ctx, req.(*CourseRequest))
+		return srv.(AutograderServiceServer).DeleteAnswer(ctx, req.(*AnswerRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AutograderService_GetCourses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Void)
+func _AutograderService_GetAssignmentDiscussions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignmentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AutograderServiceServer).GetCourses(ctx, in)
+		return srv.(AutograderServiceServer).GetAssignmentDiscussions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ag.AutograderService/GetCourses",
+		FullMethod: "/ag.AutograderService/GetAssignmentDiscussions",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AutograderServiceServer).GetCourses(ctx, req.(*Void))
+		return srv.(AutograderServiceServer).GetAssignmentDiscussions(ctx, req.(*AssignmentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AutograderService_GetCoursesByUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EnrollmentStatusRequest)
+func _AutograderService_GetAnnouncements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CourseRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AutograderServiceServer).GetCoursesByUser(ctx, in)
+		return srv.(AutograderServiceServer).GetAnnouncements(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ag.AutograderService/GetCoursesByUser",
+		FullMethod: "/ag.AutograderService/GetAnnouncements",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AutograderServiceServer).GetCoursesByUser(ctx, req.(*EnrollmentStatusRequest))
+		return srv.(AutograderServiceServer).GetAnnouncements(ctx, req.(*CourseRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AutograderService_CreateCourse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Course)
+func _AutograderService_CreateAnnouncement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Announcement)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AutograderServiceServer).CreateCourse(ctx, in)
+		return srv.(AutograderServiceServer).CreateAnnouncement(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ag.AutograderService/CreateCourse",
+		FullMethod: "/ag.AutograderService/CreateAnnouncement",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AutograderServiceServer).CreateCourse(ctx, req.(*Course))
+		return srv.(AutograderServiceServer).CreateAnnouncement(ctx, req.(*Announcement))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AutograderService_UpdateCourse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Course)
+func _AutograderService_UpdateAnnouncement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Announcement)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AutograderServiceServer).UpdateCourse(ctx, in)
+		return srv.(AutograderServiceServer).UpdateAnnouncement(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ag.AutograderService/UpdateCourse",
+		FullMethod: "/ag.AutograderService/UpdateAnnouncement",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AutograderServiceServer).UpdateCourse(ctx, req.(*Course))
+		return srv.(AutograderServiceServer).UpdateAnnouncement(ctx, req.(*Announcement))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AutograderService_UpdateCourseVisibility_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Enrollment)
+func _AutograderService_MarkAnnouncementRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AnnouncementRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AutograderServiceServer).UpdateCourseVisibility(ctx, in)
+		return srv.(AutograderServiceServer).MarkAnnouncementRead(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ag.AutograderService/UpdateCourseVisibility",
+		FullMethod: "/ag.AutograderService/MarkAnnouncementRead",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AutograderServiceServer).UpdateCourseVisibility(ctx, req.(*Enrollment))
+		return srv.(AutograderServiceServer).MarkAnnouncementRead(ctx, req.(*AnnouncementRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AutograderService_GetAssignments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _AutograderService_GetCourseFeedURL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CourseRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AutograderServiceServer).GetAssignments(ctx, in)
+		return srv.(AutograderServiceServer).GetCourseFeedURL(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ag.AutograderService/GetAssignments",
+		FullMethod: "/ag.AutograderService/GetCourseFeedURL",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AutograderServiceServer).GetAssignments(ctx, req.(*CourseRequest))
+		return srv.(AutograderServiceServer).GetCourseFeedURL(ctx, req.(*CourseRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AutograderService_UpdateAssignments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CourseRequest)
+func _AutograderService_GetNotifications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NotificationsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AutograderServiceServer).UpdateAssignments(ctx, in)
+		return srv.(AutograderServiceServer).GetNotifications(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ag.AutograderService/UpdateAssignments",
+		FullMethod: "/ag.AutograderService/GetNotifications",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AutograderServiceServer).UpdateAssignments(ctx, req.(*CourseRequest))
+		return srv.(AutograderServiceServer).GetNotifications(ctx, req.(*NotificationsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AutograderService_GetEnrollmentsByUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EnrollmentStatusRequest)
+func _AutograderService_MarkNotificationRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NotificationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AutograderServiceServer).GetEnrollmentsByUser(ctx, in)
+		return srv.(AutograderServiceServer).MarkNotificationRead(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ag.AutograderService/GetEnrollmentsByUser",
+		FullMethod: "/ag.AutograderService/MarkNotificationRead",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AutograderServiceServer).GetEnrollmentsByUser(ctx, req.(*EnrollmentStatusRequest))
+		return srv.(AutograderServiceServer).MarkNotificationRead(ctx, req.(*NotificationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AutograderService_GetEnrollmentsByCourse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EnrollmentRequest)
+func _AutograderService_GetProviders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Void)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AutograderServiceServer).GetEnrollmentsByCourse(ctx, in)
+		return srv.(AutograderServiceServer).GetProviders(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ag.AutograderService/GetEnrollmentsByCourse",
+		FullMethod: "/ag.AutograderService/GetProviders",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AutograderServiceServer).GetEnrollmentsByCourse(ctx, req.(*EnrollmentRequest))
+		return srv.(AutograderServiceServer).GetProviders(ctx, req.(*Void))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AutograderService_CreateEnrollment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Enrollment)
+func _AutograderService_GetOrganization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrgRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AutograderServiceServer).CreateEnrollment(ctx, in)
+		return srv.(AutograderServiceServer).GetOrganization(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ag.AutograderService/CreateEnrollment",
+		FullMethod: "/ag.AutograderService/GetOrganization",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AutograderServiceServer).CreateEnrollment(ctx, req.(*Enrollment))
+		return srv.(AutograderServiceServer).GetOrganization(ctx, req.(*OrgRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AutograderService_UpdateEnrollment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Enrollment)
+func _AutograderService_GetRepositories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(URLRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AutograderServiceServer).UpdateEnrollment(ctx, in)
+		return srv.(AutograderServiceServer).GetRepositories(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ag.AutograderService/UpdateEnrollment",
+		FullMethod: "/ag.AutograderService/GetRepositories",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AutograderServiceServer).UpdateEnrollment(ctx, req.(*Enrollment))
+		return srv.(AutograderServiceServer).GetRepositories(ctx, req.(*URLRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AutograderService_UpdateEnrollments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CourseRequest)
+func _AutograderService_IsEmptyRepo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RepositoryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AutograderServiceServer).UpdateEnrollments(ctx, in)
+		return srv.(AutograderServiceServer).IsEmptyRepo(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ag.AutograderService/UpdateEnrollments",
+		FullMethod: "/ag.AutograderService/IsEmptyRepo",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AutograderServiceServer).UpdateEnrollments(ctx, req.(*CourseRequest))
+		return srv.(AutograderServiceServer).IsEmptyRepo(ctx, req.(*RepositoryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AutograderService_GetSubmissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SubmissionRequest)
+func _AutograderService_GetOrphanedResources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CourseRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AutograderServiceServer).GetSubmissions(ctx, in)
+		return srv.(AutograderServiceServer).GetOrphanedResources(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ag.AutograderService/GetSubmissions",
+		FullMethod: "/ag.AutograderService/GetOrphanedResources",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AutograderServiceServer).GetSubmissions(ctx, req.(*SubmissionRequest))
+		return srv.(AutograderServiceServer).GetOrphanedResources(ctx, req.(*CourseRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AutograderService_GetSubmissionsByCourse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SubmissionsForCourseRequest)
+func _AutograderService_AuditTestsExposure_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExposureAuditRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AutograderServiceServer).GetSubmissionsByCourse(ctx, in)
+		return srv.(AutograderServiceServer).AuditTestsExposure(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ag.AutograderService/GetSubmissionsByCourse",
+		FullMethod: "/ag.AutograderService/AuditTestsExposure",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AutograderServiceServer).GetSubmissionsByCourse(ctx, req.(*SubmissionsForCourseRequest))
+		return srv.(AutograderServiceServer).AuditTestsExposure(ctx, req.(*ExposureAuditRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AutograderService_UpdateSubmission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateSubmissionRequest)
+func _AutograderService_GetAccessReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CourseRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AutograderServiceServer).UpdateSubmission(ctx, in)
+		return srv.(AutograderServiceServer).GetAccessReport(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ag.AutograderService/UpdateSubmission",
+		FullMethod: "/ag.AutograderService/GetAccessReport",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AutograderServiceServer).UpdateSubmission(ctx, req.(*UpdateSubmissionRequest))
+		return srv.(AutograderServiceServer).GetAccessReport(ctx, req.(*CourseRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AutograderService_UpdateSubmissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateSubmissionsRequest)
+func _AutograderService_SetCourseRepositoryAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RepositoryAccessRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AutograderServiceServer).UpdateSubmissions(ctx, in)
+		return srv.(AutograderServiceServer).SetCourseRepositoryAccess(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ag.AutograderService/UpdateSubmissions",
+		FullMethod: "/ag.AutograderService/SetCourseRepositoryAccess",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AutograderServiceServer).UpdateSubmissions(ctx, req.(*UpdateSubmissionsRequest))
+		return srv.(AutograderServiceServer).SetCourseRepositoryAccess(ctx, req.(*RepositoryAccessRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AutograderService_RebuildSubmission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RebuildRequest)
+func _AutograderService_GetAdminOverview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Void)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AutograderServiceServer).RebuildSubmission(ctx, in)
+		return srv.(AutograderServiceServer).GetAdminOverview(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ag.AutograderService/RebuildSubmission",
+		FullMethod: "/ag.AutograderService/GetAdminOverview",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AutograderServiceServer).RebuildSubmission(ctx, req.(*RebuildRequest))
+		return srv.(AutograderServiceServer).GetAdminOverview(ctx, req.(*Void))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AutograderService_RebuildSubmissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AssignmentRequest)
+func _AutograderService_GetTestJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Void)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AutograderServiceServer).RebuildSubmissions(ctx, in)
+		return srv.(AutograderServiceServer).GetTestJobs(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ag.AutograderService/RebuildSubmissions",
+		FullMethod: "/ag.AutograderService/GetTestJobs",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AutograderServiceServer).RebuildSubmissions(ctx, req.(*AssignmentRequest))
+		return srv.(AutograderServiceServer).GetTestJobs(ctx, req.(*Void))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AutograderService_CreateBenchmark_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GradingBenchmark)
+func _AutograderService_CancelTestJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TestJob)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AutograderServiceServer).CreateBenchmark(ctx, in)
+		return srv.(AutograderServiceServer).CancelTestJob(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ag.AutograderService/CreateBenchmark",
+		FullMethod: "/ag.AutograderService/CancelTestJob",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AutograderServiceServer).CreateBenchmark(ctx, req.(*GradingBenchmark))
+		return srv.(AutograderServiceServer).CancelTestJob(ctx, req.(*TestJob))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AutograderService_UpdateBenchmark_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GradingBenchmark)
+func _AutograderService_GetWebhookDeliveries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WebhookDeliveryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AutograderServiceServer).UpdateBenchmark(ctx, in)
+		return srv.(AutograderServiceServer).GetWebhookDeliveries(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ag.AutograderService/UpdateBenchmark",
+		FullMethod: "/ag.AutograderService/GetWebhookDeliveries",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AutograderServiceServer).UpdateBenchmark(ctx, req.(*GradingBenchmark))
+		return srv.(AutograderServiceServer).GetWebhookDeliveries(ctx, req.(*WebhookDeliveryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AutograderService_DeleteBenchmark_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GradingBenchmark)
+func _AutograderService_ReplayWebhookDelivery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WebhookDeliveryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AutograderServiceServer).DeleteBenchmark(ctx, in)
+		return srv.(AutograderServiceServer).ReplayWebhookDelivery(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ag.AutograderService/DeleteBenchmark",
+		FullMethod: "/ag.AutograderService/ReplayWebhookDelivery",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AutograderServiceServer).DeleteBenchmark(ctx, req.(*GradingBenchmark))
+		return srv.(AutograderServiceServer).ReplayWebhookDelivery(ctx, req.(*WebhookDeliveryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AutograderService_CreateCriterion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GradingCriterion)
+func _AutograderService_GetAuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuditLogRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AutograderServiceServer).CreateCriterion(ctx, in)
+		return srv.(AutograderServiceServer).GetAuditLog(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ag.AutograderService/CreateCriterion",
+		FullMethod: "/ag.AutograderService/GetAuditLog",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AutograderServiceServer).CreateCriterion(ctx, req.(*GradingCriterion))
+		return srv.(AutograderServiceServer).GetAuditLog(ctx, req.(*AuditLogRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AutograderService_UpdateCriterion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GradingCriterion)
+func _AutograderService_CreateAccessToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccessTokenRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AutograderServiceServer).UpdateCriterion(ctx, in)
+		return srv.(AutograderServiceServer).CreateAccessToken(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ag.AutograderService/UpdateCriterion",
+		FullMethod: "/ag.AutograderService/CreateAccessToken",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AutograderServiceServer).UpdateCriterion(ctx, req.(*GradingCriterion))
+		return srv.(AutograderServiceServer).CreateAccessToken(ctx, req.(*AccessTokenRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AutograderService_DeleteCriterion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GradingCriterion)
+func _AutograderService_GetAccessTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Void)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AutograderServiceServer).DeleteCriterion(ctx, in)
+		return srv.(AutograderServiceServer).GetAccessTokens(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ag.AutograderService/DeleteCriterion",
+		FullMethod: "/ag.AutograderService/GetAccessTokens",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AutograderServiceServer).DeleteCriterion(ctx, req.(*GradingCriterion))
+		return srv.(AutograderServiceServer).GetAccessTokens(ctx, req.(*Void))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AutograderService_CreateReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ReviewRequest)
+func _AutograderService_RevokeAccessToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccessToken)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AutograderServiceServer).CreateReview(ctx, in)
+		return srv.(AutograderServiceServer).RevokeAccessToken(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ag.AutograderService/CreateReview",
+		FullMethod: "/ag.AutograderService/RevokeAccessToken",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AutograderServiceServer).CreateReview(ctx, req.(*ReviewRequest))
+		return srv.(AutograderServiceServer).RevokeAccessToken(ctx, req.(*AccessToken))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AutograderService_UpdateReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ReviewRequest)
+func _AutograderService_GetSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Void)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AutograderServiceServer).UpdateReview(ctx, in)
+		return srv.(AutograderServiceServer).GetSessions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ag.AutograderService/UpdateReview",
+		FullMethod: "/ag.AutograderService/GetSessions",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AutograderServiceServer).UpdateReview(ctx, req.(*ReviewRequest))
+		return srv.(AutograderServiceServer).GetSessions(ctx, req.(*Void))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AutograderService_GetReviewers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SubmissionReviewersRequest)
+func _AutograderService_RevokeSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Session)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AutograderServiceServer).GetReviewers(ctx, in)
+		return srv.(AutograderServiceServer).RevokeSession(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ag.AutograderService/GetReviewers",
+		FullMethod: "/ag.AutograderService/RevokeSession",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AutograderServiceServer).GetReviewers(ctx, req.(*SubmissionReviewersRequest))
+		return srv.(AutograderServiceServer).RevokeSession(ctx, req.(*Session))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AutograderService_GetProviders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Void)
+func _AutograderService_LogoutUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AutograderServiceServer).GetProviders(ctx, in)
+		return srv.(AutograderServiceServer).LogoutUser(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ag.AutograderService/GetProviders",
+		FullMethod: "/ag.AutograderService/LogoutUser",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AutograderServiceServer).GetProviders(ctx, req.(*Void))
+		return srv.(AutograderServiceServer).LogoutUser(ctx, req.(*UserRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AutograderService_GetOrganization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(OrgRequest)
+func _AutograderService_GetPendingActions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Void)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AutograderServiceServer).GetOrganization(ctx, in)
+		return srv.(AutograderServiceServer).GetPendingActions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ag.AutograderService/GetOrganization",
+		FullMethod: "/ag.AutograderService/GetPendingActions",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AutograderServiceServer).GetOrganization(ctx, req.(*OrgRequest))
+		return srv.(AutograderServiceServer).GetPendingActions(ctx, req.(*Void))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AutograderService_GetRepositories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(URLRequest)
+func _AutograderService_ConfirmPendingAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PendingAction)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AutograderServiceServer).GetRepositories(ctx, in)
+		return srv.(AutograderServiceServer).ConfirmPendingAction(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ag.AutograderService/GetRepositories",
+		FullMethod: "/ag.AutograderService/ConfirmPendingAction",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AutograderServiceServer).GetRepositories(ctx, req.(*URLRequest))
+		return srv.(AutograderServiceServer).ConfirmPendingAction(ctx, req.(*PendingAction))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AutograderService_IsEmptyRepo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RepositoryRequest)
+func _AutograderService_CancelPendingAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PendingAction)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AutograderServiceServer).IsEmptyRepo(ctx, in)
+		return srv.(AutograderServiceServer).CancelPendingAction(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ag.AutograderService/IsEmptyRepo",
+		FullMethod: "/ag.AutograderService/CancelPendingAction",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AutograderServiceServer).IsEmptyRepo(ctx, req.(*RepositoryRequest))
+		return srv.(AutograderServiceServer).CancelPendingAction(ctx, req.(*PendingAction))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -1455,10 +4585,42 @@ var AutograderService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateUser",
 			Handler:    _AutograderService_UpdateUser_Handler,
 		},
+		{
+			MethodName: "ExportUserData",
+			Handler:    _AutograderService_ExportUserData_Handler,
+		},
+		{
+			MethodName: "DeleteUserData",
+			Handler:    _AutograderService_DeleteUserData_Handler,
+		},
 		{
 			MethodName: "IsAuthorizedTeacher",
 			Handler:    _AutograderService_IsAuthorizedTeacher_Handler,
 		},
+		{
+			MethodName: "GetRemoteIdentities",
+			Handler:    _AutograderService_GetRemoteIdentities_Handler,
+		},
+		{
+			MethodName: "UnlinkRemoteIdentity",
+			Handler:    _AutograderService_UnlinkRemoteIdentity_Handler,
+		},
+		{
+			MethodName: "GetTenants",
+			Handler:    _AutograderService_GetTenants_Handler,
+		},
+		{
+			MethodName: "CreateTenant",
+			Handler:    _AutograderService_CreateTenant_Handler,
+		},
+		{
+			MethodName: "UpdateTenant",
+			Handler:    _AutograderService_UpdateTenant_Handler,
+		},
+		{
+			MethodName: "SetUserTenant",
+			Handler:    _AutograderService_SetUserTenant_Handler,
+		},
 		{
 			MethodName: "GetGroup",
 			Handler:    _AutograderService_GetGroup_Handler,
@@ -1483,6 +4645,26 @@ var AutograderService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteGroup",
 			Handler:    _AutograderService_DeleteGroup_Handler,
 		},
+		{
+			MethodName: "RestoreGroup",
+			Handler:    _AutograderService_RestoreGroup_Handler,
+		},
+		{
+			MethodName: "InviteToGroup",
+			Handler:    _AutograderService_InviteToGroup_Handler,
+		},
+		{
+			MethodName: "RespondToGroupInvitation",
+			Handler:    _AutograderService_RespondToGroupInvitation_Handler,
+		},
+		{
+			MethodName: "GetGroupInvitations",
+			Handler:    _AutograderService_GetGroupInvitations_Handler,
+		},
+		{
+			MethodName: "GenerateGroups",
+			Handler:    _AutograderService_GenerateGroups_Handler,
+		},
 		{
 			MethodName: "GetCourse",
 			Handler:    _AutograderService_GetCourse_Handler,
@@ -1507,6 +4689,58 @@ var AutograderService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateCourseVisibility",
 			Handler:    _AutograderService_UpdateCourseVisibility_Handler,
 		},
+		{
+			MethodName: "ArchiveCourse",
+			Handler:    _AutograderService_ArchiveCourse_Handler,
+		},
+		{
+			MethodName: "DeleteCourse",
+			Handler:    _AutograderService_DeleteCourse_Handler,
+		},
+		{
+			MethodName: "RepairCourse",
+			Handler:    _AutograderService_RepairCourse_Handler,
+		},
+		{
+			MethodName: "CloneCourse",
+			Handler:    _AutograderService_CloneCourse_Handler,
+		},
+		{
+			MethodName: "PreviewCourseCreation",
+			Handler:    _AutograderService_PreviewCourseCreation_Handler,
+		},
+		{
+			MethodName: "GetGradingScale",
+			Handler:    _AutograderService_GetGradingScale_Handler,
+		},
+		{
+			MethodName: "UpdateGradingScale",
+			Handler:    _AutograderService_UpdateGradingScale_Handler,
+		},
+		{
+			MethodName: "ComputeFinalGrades",
+			Handler:    _AutograderService_ComputeFinalGrades_Handler,
+		},
+		{
+			MethodName: "GetCourseCompletion",
+			Handler:    _AutograderService_GetCourseCompletion_Handler,
+		},
+		{
+			MethodName: "GetScoreCurve",
+			Handler:    _AutograderService_GetScoreCurve_Handler,
+		},
+		{
+			MethodName: "ApplyScoreCurve",
+			Handler:    _AutograderService_ApplyScoreCurve_Handler,
+		},
+		{
+			MethodName: "ExportResults",
+			Handler:    _AutograderService_ExportResults_Handler,
+		},
+		{
+			MethodName: "GetCertificate",
+			Handler:    _AutograderService_GetCertificate_Handler,
+		},
 		{
 			MethodName: "GetAssignments",
 			Handler:    _AutograderService_GetAssignments_Handler,
@@ -1515,10 +4749,26 @@ var AutograderService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateAssignments",
 			Handler:    _AutograderService_UpdateAssignments_Handler,
 		},
+		{
+			MethodName: "ValidateAssignmentFile",
+			Handler:    _AutograderService_ValidateAssignmentFile_Handler,
+		},
+		{
+			MethodName: "ValidateAssignments",
+			Handler:    _AutograderService_ValidateAssignments_Handler,
+		},
 		{
 			MethodName: "GetEnrollmentsByUser",
 			Handler:    _AutograderService_GetEnrollmentsByUser_Handler,
 		},
+		{
+			MethodName: "GetUserProgress",
+			Handler:    _AutograderService_GetUserProgress_Handler,
+		},
+		{
+			MethodName: "GetSlipDays",
+			Handler:    _AutograderService_GetSlipDays_Handler,
+		},
 		{
 			MethodName: "GetEnrollmentsByCourse",
 			Handler:    _AutograderService_GetEnrollmentsByCourse_Handler,
@@ -1535,6 +4785,14 @@ var AutograderService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateEnrollments",
 			Handler:    _AutograderService_UpdateEnrollments_Handler,
 		},
+		{
+			MethodName: "RestoreEnrollment",
+			Handler:    _AutograderService_RestoreEnrollment_Handler,
+		},
+		{
+			MethodName: "ImportEnrollments",
+			Handler:    _AutograderService_ImportEnrollments_Handler,
+		},
 		{
 			MethodName: "GetSubmissions",
 			Handler:    _AutograderService_GetSubmissions_Handler,
@@ -1543,6 +4801,34 @@ var AutograderService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetSubmissionsByCourse",
 			Handler:    _AutograderService_GetSubmissionsByCourse_Handler,
 		},
+		{
+			MethodName: "GetCourseResults",
+			Handler:    _AutograderService_GetCourseResults_Handler,
+		},
+		{
+			MethodName: "GetCourseStatistics",
+			Handler:    _AutograderService_GetCourseStatistics_Handler,
+		},
+		{
+			MethodName: "GetTestFailureStats",
+			Handler:    _AutograderService_GetTestFailureStats_Handler,
+		},
+		{
+			MethodName: "GetSubmissionStatus",
+			Handler:    _AutograderService_GetSubmissionStatus_Handler,
+		},
+		{
+			MethodName: "GetSubmissionArtifacts",
+			Handler:    _AutograderService_GetSubmissionArtifacts_Handler,
+		},
+		{
+			MethodName: "GetTestResults",
+			Handler:    _AutograderService_GetTestResults_Handler,
+		},
+		{
+			MethodName: "GetTaskScores",
+			Handler:    _AutograderService_GetTaskScores_Handler,
+		},
 		{
 			MethodName: "UpdateSubmission",
 			Handler:    _AutograderService_UpdateSubmission_Handler,
@@ -1551,6 +4837,14 @@ var AutograderService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateSubmissions",
 			Handler:    _AutograderService_UpdateSubmissions_Handler,
 		},
+		{
+			MethodName: "TransitionSubmission",
+			Handler:    _AutograderService_TransitionSubmission_Handler,
+		},
+		{
+			MethodName: "GetSubmissionTransitions",
+			Handler:    _AutograderService_GetSubmissionTransitions_Handler,
+		},
 		{
 			MethodName: "RebuildSubmission",
 			Handler:    _AutograderService_RebuildSubmission_Handler,
@@ -1559,6 +4853,46 @@ var AutograderService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RebuildSubmissions",
 			Handler:    _AutograderService_RebuildSubmissions_Handler,
 		},
+		{
+			MethodName: "ProcessMissedPushes",
+			Handler:    _AutograderService_ProcessMissedPushes_Handler,
+		},
+		{
+			MethodName: "ReplaySubmissions",
+			Handler:    _AutograderService_ReplaySubmissions_Handler,
+		},
+		{
+			MethodName: "GetForcePushes",
+			Handler:    _AutograderService_GetForcePushes_Handler,
+		},
+		{
+			MethodName: "CreateAppeal",
+			Handler:    _AutograderService_CreateAppeal_Handler,
+		},
+		{
+			MethodName: "UpdateAppeal",
+			Handler:    _AutograderService_UpdateAppeal_Handler,
+		},
+		{
+			MethodName: "GetAppeals",
+			Handler:    _AutograderService_GetAppeals_Handler,
+		},
+		{
+			MethodName: "GrantExtension",
+			Handler:    _AutograderService_GrantExtension_Handler,
+		},
+		{
+			MethodName: "ListExtensions",
+			Handler:    _AutograderService_ListExtensions_Handler,
+		},
+		{
+			MethodName: "RunPlagiarismCheck",
+			Handler:    _AutograderService_RunPlagiarismCheck_Handler,
+		},
+		{
+			MethodName: "GetPlagiarismResults",
+			Handler:    _AutograderService_GetPlagiarismResults_Handler,
+		},
 		{
 			MethodName: "CreateBenchmark",
 			Handler:    _AutograderService_CreateBenchmark_Handler,
@@ -1595,6 +4929,110 @@ var AutograderService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetReviewers",
 			Handler:    _AutograderService_GetReviewers_Handler,
 		},
+		{
+			MethodName: "ReleaseReviews",
+			Handler:    _AutograderService_ReleaseReviews_Handler,
+		},
+		{
+			MethodName: "AssignPeerReviews",
+			Handler:    _AutograderService_AssignPeerReviews_Handler,
+		},
+		{
+			MethodName: "GetPeerReviewTasks",
+			Handler:    _AutograderService_GetPeerReviewTasks_Handler,
+		},
+		{
+			MethodName: "SubmitPeerReview",
+			Handler:    _AutograderService_SubmitPeerReview_Handler,
+		},
+		{
+			MethodName: "GetPeerReviews",
+			Handler:    _AutograderService_GetPeerReviews_Handler,
+		},
+		{
+			MethodName: "ModeratePeerReview",
+			Handler:    _AutograderService_ModeratePeerReview_Handler,
+		},
+		{
+			MethodName: "ReleasePeerReviews",
+			Handler:    _AutograderService_ReleasePeerReviews_Handler,
+		},
+		{
+			MethodName: "CreateSubmissionComment",
+			Handler:    _AutograderService_CreateSubmissionComment_Handler,
+		},
+		{
+			MethodName: "UpdateSubmissionComment",
+			Handler:    _AutograderService_UpdateSubmissionComment_Handler,
+		},
+		{
+			MethodName: "DeleteSubmissionComment",
+			Handler:    _AutograderService_DeleteSubmissionComment_Handler,
+		},
+		{
+			MethodName: "GetSubmissionComments",
+			Handler:    _AutograderService_GetSubmissionComments_Handler,
+		},
+		{
+			MethodName: "GetQuestions",
+			Handler:    _AutograderService_GetQuestions_Handler,
+		},
+		{
+			MethodName: "CreateQuestion",
+			Handler:    _AutograderService_CreateQuestion_Handler,
+		},
+		{
+			MethodName: "UpdateQuestion",
+			Handler:    _AutograderService_UpdateQuestion_Handler,
+		},
+		{
+			MethodName: "DeleteQuestion",
+			Handler:    _AutograderService_DeleteQuestion_Handler,
+		},
+		{
+			MethodName: "CreateAnswer",
+			Handler:    _AutograderService_CreateAnswer_Handler,
+		},
+		{
+			MethodName: "UpdateAnswer",
+			Handler:    _AutograderService_UpdateAnswer_Handler,
+		},
+		{
+			MethodName: "DeleteAnswer",
+			Handler:    _AutograderService_DeleteAnswer_Handler,
+		},
+		{
+			MethodName: "GetAssignmentDiscussions",
+			Handler:    _AutograderService_GetAssignmentDiscussions_Handler,
+		},
+		{
+			MethodName: "GetAnnouncements",
+			Handler:    _AutograderService_GetAnnouncements_Handler,
+		},
+		{
+			MethodName: "CreateAnnouncement",
+			Handler:    _AutograderService_CreateAnnouncement_Handler,
+		},
+		{
+			MethodName: "UpdateAnnouncement",
+			Handler:    _AutograderService_UpdateAnnouncement_Handler,
+		},
+		{
+			MethodName: "MarkAnnouncementRead",
+			Handler:    _AutograderService_MarkAnnouncementRead_Handler,
+		},
+		{
+			MethodName: "GetCourseFeedURL",
+			Handler:    _AutograderService_GetCourseFeedURL_Handler,
+		},
+		{
+			MethodName: "GetNotifications",
+			Handler:    _AutograderService_GetNotifications_Handler,
+		},
+		{
+			MethodName: "MarkNotificationRead",
+			Handler:    _AutograderService_MarkNotificationRead_Handler,
+		},
 		{
 			MethodName: "GetProviders",
 			Handler:    _AutograderService_GetProviders_Handler,
@@ -1611,7 +5049,89 @@ var AutograderService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "IsEmptyRepo",
 			Handler:    _AutograderService_IsEmptyRepo_Handler,
 		},
+		{
+			MethodName: "GetOrphanedResources",
+			Handler:    _AutograderService_GetOrphanedResources_Handler,
+		},
+		{
+			MethodName: "AuditTestsExposure",
+			Handler:    _AutograderService_AuditTestsExposure_Handler,
+		},
+		{
+			MethodName: "GetAccessReport",
+			Handler:    _AutograderService_GetAccessReport_Handler,
+		},
+		{
+			MethodName: "SetCourseRepositoryAccess",
+			Handler:    _AutograderService_SetCourseRepositoryAccess_Handler,
+		},
+		{
+			MethodName: "GetAdminOverview",
+			Handler:    _AutograderService_GetAdminOverview_Handler,
+		},
+		{
+			MethodName: "GetTestJobs",
+			Handler:    _AutograderService_GetTestJobs_Handler,
+		},
+		{
+			MethodName: "CancelTestJob",
+			Handler:    _AutograderService_CancelTestJob_Handler,
+		},
+		{
+			MethodName: "GetWebhookDeliveries",
+			Handler:    _AutograderService_GetWebhookDeliveries_Handler,
+		},
+		{
+			MethodName: "ReplayWebhookDelivery",
+			Handler:    _AutograderService_ReplayWebhookDelivery_Handler,
+		},
+		{
+			MethodName: "GetAuditLog",
+			Handler:    _AutograderService_GetAuditLog_Handler,
+		},
+		{
+			MethodName: "CreateAccessToken",
+			Handler:    _AutograderService_CreateAccessToken_Handler,
+		},
+		{
+			MethodName: "GetAccessTokens",
+			Handler:    _AutograderService_GetAccessTokens_Handler,
+		},
+		{
+			MethodName: "RevokeAccessToken",
+			Handler:    _AutograderService_RevokeAccessToken_Handler,
+		},
+		{
+			MethodName: "GetSessions",
+			Handler:    _AutograderService_GetSessions_Handler,
+		},
+		{
+			MethodName: "RevokeSession",
+			Handler:    _AutograderService_RevokeSession_Handler,
+		},
+		{
+			MethodName: "LogoutUser",
+			Handler:    _AutograderService_LogoutUser_Handler,
+		},
+		{
+			MethodName: "GetPendingActions",
+			Handler:    _AutograderService_GetPendingActions_Handler,
+		},
+		{
+			MethodName: "ConfirmPendingAction",
+			Handler:    _AutograderService_ConfirmPendingAction_Handler,
+		},
+		{
+			MethodName: "CancelPendingAction",
+			Handler:    _AutograderService_CancelPendingAction_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubmissionStream",
+			Handler:       _AutograderService_SubmissionStream_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "ag/ag.proto",
 }
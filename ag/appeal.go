@@ -0,0 +1,45 @@
+package ag
+
+import "time"
+
+const (
+	// DefaultAppealWindow is the number of days students can appeal a released grade,
+	// unless the course specifies its own appeal window.
+	DefaultAppealWindow = 21
+	// DefaultAppealResponseDays is the number of days teachers have to decide an appeal,
+	// unless the course specifies its own response time.
+	DefaultAppealResponseDays = 21
+)
+
+const day = 24 * time.Hour
+
+// AppealDeadline returns the last time that a grade released at the given time can be appealed.
+func (course *Course) AppealDeadline(released time.Time) time.Time {
+	window := course.GetAppealWindow()
+	if window == 0 {
+		window = DefaultAppealWindow
+	}
+	return released.Add(time.Duration(window) * day)
+}
+
+// ResponseDeadline returns the time by which an appeal opened at the given time must be decided.
+func (course *Course) ResponseDeadline(opened time.Time) time.Time {
+	days := course.GetAppealResponseDays()
+	if days == 0 {
+		days = DefaultAppealResponseDays
+	}
+	return opened.Add(time.Duration(days) * day)
+}
+
+// IsOverdue returns true if the appeal is still open after its response deadline.
+// Response deadlines are stored in UTC, like assignment deadlines.
+func (a *Appeal) IsOverdue(now time.Time) bool {
+	if a.GetStatus() != Appeal_OPEN {
+		return false
+	}
+	deadline, err := time.Parse(TimeLayout, a.GetResponseDeadline())
+	if err != nil {
+		return false
+	}
+	return now.After(deadline)
+}
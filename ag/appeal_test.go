@@ -0,0 +1,34 @@
+package ag_test
+
+import (
+	"testing"
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+)
+
+func TestAppealIsOverdue(t *testing.T) {
+	// the response deadline is stored in UTC, regardless of the server's time zone
+	local := time.Local
+	time.Local = time.FixedZone("CET", 3600)
+	defer func() { time.Local = local }()
+
+	deadline := time.Date(2022, 3, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		status pb.Appeal_Status
+		now    time.Time
+		want   bool
+	}{
+		{status: pb.Appeal_OPEN, now: deadline.Add(-30 * time.Minute), want: false},
+		{status: pb.Appeal_OPEN, now: deadline.Add(-30 * time.Minute).In(time.Local), want: false},
+		{status: pb.Appeal_OPEN, now: deadline.Add(30 * time.Minute), want: true},
+		{status: pb.Appeal_OPEN, now: deadline.Add(30 * time.Minute).In(time.Local), want: true},
+		{status: pb.Appeal_GRANTED, now: deadline.Add(30 * time.Minute), want: false},
+	}
+	for _, test := range tests {
+		appeal := &pb.Appeal{Status: test.status, ResponseDeadline: deadline.Format(pb.TimeLayout)}
+		if got := appeal.IsOverdue(test.now); got != test.want {
+			t.Errorf("IsOverdue(%v) of %v appeal = %t, want %t", test.now, test.status, got, test.want)
+		}
+	}
+}
@@ -1,6 +1,9 @@
 package ag
 
 import (
+	"fmt"
+	"math"
+	"strings"
 	"time"
 )
 
@@ -9,11 +12,81 @@ const (
 	zero = time.Duration(0)
 )
 
+// acceptedLayouts are the formats of deadlines accepted in addition to RFC3339.
+var acceptedLayouts = []string{
+	"2006-1-2T15:04:05",
+	"2006-1-2 15:04:05",
+	"2006-1-2T15:04",
+	"2006-1-2 15:04",
+	"2006-1-2T1504",
+	"2006-1-2 1504",
+	"2006-1-2T15",
+	"2006-1-2 15",
+	"2006-1-2 3pm",
+	"2006-1-2 3:04pm",
+	"2006-1-2 3:04:05pm",
+	"2-1-2006T15:04:05",
+	"2-1-2006 15:04:05",
+	"2-1-2006T15:04",
+	"2-1-2006 15:04",
+	"2-1-2006T1504",
+	"2-1-2006 1504",
+	"2-1-2006T15",
+	"2-1-2006 15",
+	"2-1-2006 3pm",
+	"2-1-2006 3:04pm",
+	"2-1-2006 3:04:05pm",
+}
+
+// FixDeadline returns the given deadline in the TimeLayout format. Deadlines in several
+// other formats are accepted, e.g., "2020-01-23 18:00" and "23-1-2020 6pm".
+// For an unrecognized format, an "Invalid date format" message is returned.
+func FixDeadline(in string) string {
+	for _, layout := range acceptedLayouts {
+		t, err := time.Parse(layout, in)
+		if err != nil {
+			continue
+		}
+		return t.Format(TimeLayout)
+	}
+	return "Invalid date format: " + in
+}
+
+// ParseDeadline returns the time of the given deadline. Deadlines in RFC3339 format,
+// e.g., "2020-01-23T18:00:00+01:00", are given with their offset from UTC, while
+// deadlines in the formats accepted by FixDeadline are in the given time zone.
+func ParseDeadline(in string, loc *time.Location) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, in); err == nil {
+		return t, nil
+	}
+	for _, layout := range acceptedLayouts {
+		if t, err := time.ParseInLocation(layout, in, loc); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid date format: %s", in)
+}
+
+// DeadlineInUTC returns the given deadline, parsed as by ParseDeadline,
+// as a UTC time in the TimeLayout format used to store deadlines.
+func DeadlineInUTC(in string, loc *time.Location) (string, error) {
+	t, err := ParseDeadline(in, loc)
+	if err != nil {
+		return "", err
+	}
+	return t.UTC().Format(TimeLayout), nil
+}
+
+// parseUTC returns the time of the given UTC time in the TimeLayout format.
+func parseUTC(utc string) (time.Time, error) {
+	return time.Parse(TimeLayout, utc)
+}
+
 // SinceDeadline returns the duration since the deadline.
 // A positive duration means the deadline has passed, whereas
 // a negative duration means the deadline has not yet passed.
 func (a *Assignment) SinceDeadline(now time.Time) (time.Duration, error) {
-	deadline, err := time.ParseInLocation(TimeLayout, a.GetDeadline(), now.Location())
+	deadline, err := parseUTC(a.GetDeadline())
 	if err != nil {
 		// this should not happen if deadlines are parsed and recorded correctly
 		return zero, err
@@ -21,9 +94,67 @@ func (a *Assignment) SinceDeadline(now time.Time) (time.Duration, error) {
 	return now.Sub(deadline), nil
 }
 
+// LocalDeadlineIn returns the assignment's deadline in the given time zone in RFC3339 format,
+// or an empty string if the assignment has no deadline.
+func (a *Assignment) LocalDeadlineIn(loc *time.Location) string {
+	deadline, err := parseUTC(a.GetDeadline())
+	if err != nil {
+		return ""
+	}
+	return deadline.In(loc).Format(time.RFC3339)
+}
+
+// WithExtension returns a copy of the assignment with the deadline granted by the
+// given extension. The assignment itself is returned if the extension is nil,
+// belongs to another assignment, or does not extend the deadline.
+func (a *Assignment) WithExtension(extension *Extension) *Assignment {
+	if extension.GetAssignmentID() != a.GetID() || extension.GetDeadline() <= a.GetDeadline() {
+		return a
+	}
+	extended := a.CloneWithoutSubmissions()
+	extended.Deadline = extension.GetDeadline()
+	return extended
+}
+
+// LatePenaltyFor returns the percentage deducted from the score of a submission delivered
+// at the given time, according to the assignment's late policy. Submissions delivered after
+// the grace period are penalized per started day after the deadline, and submissions
+// delivered after the hard cutoff get no score.
+func (a *Assignment) LatePenaltyFor(delivered time.Time) uint32 {
+	if a.GetLatePenalty() == 0 && a.GetLateCutoffDays() == 0 {
+		return 0
+	}
+	sinceDeadline, err := a.SinceDeadline(delivered)
+	if err != nil || sinceDeadline <= time.Duration(a.GetLateGraceHours())*time.Hour {
+		return 0
+	}
+	if cutoff := a.GetLateCutoffDays(); cutoff > 0 && sinceDeadline > time.Duration(cutoff)*days {
+		return 100
+	}
+	penalty := uint32((sinceDeadline+days-1)/days) * a.GetLatePenalty()
+	if penalty > 100 {
+		return 100
+	}
+	return penalty
+}
+
+// ApplyLatePenalty deducts the late penalty from the given score of the submission,
+// according to the assignment's late policy and the submission's build date,
+// and records the penalty in the submission. The penalized score is returned.
+func (a *Assignment) ApplyLatePenalty(submission *Submission, score uint32) uint32 {
+	submission.LatePenalty = 0
+	delivered, err := time.Parse(TimeLayout, submission.GetBuildInfo().GetBuildDate())
+	if err != nil {
+		return score
+	}
+	submission.LatePenalty = a.LatePenaltyFor(delivered)
+	return uint32(math.Round(float64(score) * float64(100-submission.LatePenalty) / 100))
+}
+
 // IsApproved returns an approved submission status if this assignment is already approved
-// for the latest submission, or if the score of the latest submission is sufficient
-// to autoapprove the assignment.
+// for the latest submission, or if the score of the latest submission is sufficient to
+// autoapprove the assignment. The test coverage is not considered, since the coverage
+// reports are written by the student's code, and can therefore not be trusted.
 func (a *Assignment) IsApproved(latest *Submission, score uint32) Submission_Status {
 	if a.GetAutoApprove() && score >= a.GetScoreLimit() {
 		return Submission_APPROVED
@@ -36,20 +167,130 @@ func (a *Assignment) IsApproved(latest *Submission, score uint32) Submission_Sta
 // without submissions.
 func (a *Assignment) CloneWithoutSubmissions() *Assignment {
 	return &Assignment{
-		ID:                a.ID,
-		CourseID:          a.CourseID,
-		Name:              a.Name,
-		Deadline:          a.Deadline,
-		AutoApprove:       a.AutoApprove,
-		Order:             a.Order,
-		IsGroupLab:        a.IsGroupLab,
-		ScoreLimit:        a.ScoreLimit,
-		Reviewers:         a.Reviewers,
-		GradingBenchmarks: a.GradingBenchmarks,
+		ID:                    a.ID,
+		CourseID:              a.CourseID,
+		Name:                  a.Name,
+		Deadline:              a.Deadline,
+		AutoApprove:           a.AutoApprove,
+		Order:                 a.Order,
+		IsGroupLab:            a.IsGroupLab,
+		ScoreLimit:            a.ScoreLimit,
+		Reviewers:             a.Reviewers,
+		GradingBenchmarks:     a.GradingBenchmarks,
+		TestScoreWeight:       a.TestScoreWeight,
+		GradeWeight:           a.GradeWeight,
+		Mandatory:             a.Mandatory,
+		RunnerPool:            a.RunnerPool,
+		LateGraceHours:        a.LateGraceHours,
+		LatePenalty:           a.LatePenalty,
+		LateCutoffDays:        a.LateCutoffDays,
+		PeerReviews:           a.PeerReviews,
+		ContainerImage:        a.ContainerImage,
+		Dockerfile:            a.Dockerfile,
+		CpuLimit:              a.CpuLimit,
+		MemoryLimit:           a.MemoryLimit,
+		PidsLimit:             a.PidsLimit,
+		ScanSecrets:           a.ScanSecrets,
+		ForbiddenFiles:        a.ForbiddenFiles,
+		MaxFileSize:           a.MaxFileSize,
+		DisallowedImports:     a.DisallowedImports,
+		BlockPolicyViolations: a.BlockPolicyViolations,
+		Release:               a.Release,
+		Released:              a.Released,
+		MinCoverage:           a.MinCoverage,
+		RunConfig:             a.RunConfig,
+		Tasks:                 a.Tasks,
+		FeedbackIssue:         a.FeedbackIssue,
+		SubmissionBranch:      a.SubmissionBranch,
+		Exam:                  a.Exam,
+		ExamStart:             a.ExamStart,
+	}
+}
+
+// IsHidden returns true if the assignment has a release time and has not yet been released.
+// The content of hidden assignments is kept from the students until the release time.
+func (a *Assignment) IsHidden() bool {
+	return a.GetRelease() != "" && !a.GetReleased()
+}
+
+// ReleaseDue returns true if the assignment is hidden and its release time has passed.
+func (a *Assignment) ReleaseDue(now time.Time) (bool, error) {
+	if !a.IsHidden() {
+		return false, nil
+	}
+	release, err := parseUTC(a.GetRelease())
+	if err != nil {
+		return false, err
+	}
+	return !now.Before(release), nil
+}
+
+// InExamWindow returns true if the assignment is an exam and the given time is within its
+// exam window, from the exam start until the deadline. Extensions granted to students or
+// groups must be applied to the assignment before checking their window.
+func (a *Assignment) InExamWindow(now time.Time) (bool, error) {
+	if !a.GetExam() {
+		return false, nil
+	}
+	start, err := parseUTC(a.GetExamStart())
+	if err != nil {
+		return false, err
+	}
+	sinceDeadline, err := a.SinceDeadline(now)
+	if err != nil {
+		return false, err
 	}
+	return !now.Before(start) && sinceDeadline < 0, nil
+}
+
+// HasPolicy returns true if pushed code must be checked against the assignment's policy before grading.
+func (a *Assignment) HasPolicy() bool {
+	return a.GetScanSecrets() || a.GetForbiddenFiles() != "" || a.GetMaxFileSize() > 0 || a.GetDisallowedImports() != ""
+}
+
+// ForbiddenFilePatterns returns the file name patterns not allowed in pushed code.
+func (a *Assignment) ForbiddenFilePatterns() []string {
+	return splitList(a.GetForbiddenFiles())
+}
+
+// DisallowedImportPaths returns the Go import paths not allowed in pushed code.
+func (a *Assignment) DisallowedImportPaths() []string {
+	return splitList(a.GetDisallowedImports())
+}
+
+// splitList returns the non-empty elements of the given comma-separated list.
+func splitList(list string) []string {
+	var elems []string
+	for _, elem := range strings.Split(list, ",") {
+		if elem = strings.TrimSpace(elem); elem != "" {
+			elems = append(elems, elem)
+		}
+	}
+	return elems
 }
 
 // GradedManually returns true if the assignment will be graded manually.
 func (a *Assignment) GradedManually() bool {
 	return a.GetReviewers() > 0
 }
+
+// SkipTests returns true if the assignment is graded by manual review only,
+// so that no tests need to be run for its submissions.
+func (a *Assignment) SkipTests() bool {
+	return a.GradedManually() && a.GetTestScoreWeight() == 0
+}
+
+// CombinedScore returns the submission score for the given test score and manual review score.
+// For manually graded assignments, the test score contributes the assignment's test score weight
+// (percentage) to the submission score, and the review score contributes the remainder.
+// For other assignments, the test score is the submission score.
+func (a *Assignment) CombinedScore(testScore, reviewScore uint32) uint32 {
+	if !a.GradedManually() {
+		return testScore
+	}
+	weight := a.GetTestScoreWeight()
+	if weight > 100 {
+		weight = 100
+	}
+	return uint32(math.Round(float64(testScore*weight+reviewScore*(100-weight)) / 100))
+}
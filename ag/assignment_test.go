@@ -2,8 +2,10 @@ package ag_test
 
 import (
 	"testing"
+	"time"
 
 	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/kit/score"
 )
 
 func TestIsApproved(t *testing.T) {
@@ -15,6 +17,11 @@ func TestIsApproved(t *testing.T) {
 		AutoApprove: true,
 		ScoreLimit:  80,
 	}
+	d := &pb.Assignment{
+		AutoApprove: true,
+		ScoreLimit:  80,
+		MinCoverage: 70,
+	}
 	isApprovedTests := []struct {
 		name       string
 		assignment *pb.Assignment
@@ -106,6 +113,13 @@ func TestIsApproved(t *testing.T) {
 			score:      0,
 			expected:   pb.Submission_APPROVED,
 		},
+		{
+			name:       "Assignment:ScoreLimit=80:MinCoverage=70:AutoApprove,Submission:Status=NONE:OldScore=50,NewScore:80",
+			assignment: d,
+			submission: &pb.Submission{Status: pb.Submission_NONE, Score: 50, Coverage: 10},
+			score:      80,
+			expected:   pb.Submission_APPROVED,
+		},
 	}
 
 	for _, test := range isApprovedTests {
@@ -117,3 +131,266 @@ func TestIsApproved(t *testing.T) {
 		})
 	}
 }
+
+func TestCombinedScore(t *testing.T) {
+	combinedScoreTests := []struct {
+		name        string
+		assignment  *pb.Assignment
+		testScore   uint32
+		reviewScore uint32
+		expected    uint32
+	}{
+		{name: "NotGradedManually", assignment: &pb.Assignment{TestScoreWeight: 40}, testScore: 80, reviewScore: 50, expected: 80},
+		{name: "ReviewOnly", assignment: &pb.Assignment{Reviewers: 1}, testScore: 80, reviewScore: 50, expected: 50},
+		{name: "TestsOnly", assignment: &pb.Assignment{Reviewers: 1, TestScoreWeight: 100}, testScore: 80, reviewScore: 50, expected: 80},
+		{name: "Weighted40/60", assignment: &pb.Assignment{Reviewers: 1, TestScoreWeight: 40}, testScore: 80, reviewScore: 50, expected: 62},
+		{name: "Weighted25/75", assignment: &pb.Assignment{Reviewers: 2, TestScoreWeight: 25}, testScore: 91, reviewScore: 100, expected: 98},
+	}
+	for _, test := range combinedScoreTests {
+		if got := test.assignment.CombinedScore(test.testScore, test.reviewScore); got != test.expected {
+			t.Errorf("%s: CombinedScore(%d, %d) = %d, expected %d", test.name, test.testScore, test.reviewScore, got, test.expected)
+		}
+	}
+}
+
+func TestLatePenalty(t *testing.T) {
+	policy := &pb.Assignment{
+		Deadline:       "2022-11-10T12:00:00",
+		LateGraceHours: 2,
+		LatePenalty:    15,
+		LateCutoffDays: 7,
+	}
+	latePenaltyTests := []struct {
+		name       string
+		assignment *pb.Assignment
+		buildDate  string
+		penalty    uint32
+		score      uint32
+	}{
+		{name: "NoPolicy", assignment: &pb.Assignment{Deadline: "2022-11-10T12:00:00"}, buildDate: "2022-11-20T12:00:00", penalty: 0, score: 90},
+		{name: "BeforeDeadline", assignment: policy, buildDate: "2022-11-10T11:00:00", penalty: 0, score: 90},
+		{name: "GracePeriod", assignment: policy, buildDate: "2022-11-10T14:00:00", penalty: 0, score: 90},
+		{name: "FirstDay", assignment: policy, buildDate: "2022-11-10T14:00:01", penalty: 15, score: 77},
+		{name: "SecondDay", assignment: policy, buildDate: "2022-11-11T12:00:01", penalty: 30, score: 63},
+		{name: "MaxPenalty", assignment: policy, buildDate: "2022-11-17T12:00:00", penalty: 100, score: 0},
+		{name: "AfterCutoff", assignment: &pb.Assignment{Deadline: "2022-11-10T12:00:00", LateCutoffDays: 2}, buildDate: "2022-11-12T12:00:01", penalty: 100, score: 0},
+		{name: "BeforeCutoff", assignment: &pb.Assignment{Deadline: "2022-11-10T12:00:00", LateCutoffDays: 2}, buildDate: "2022-11-12T12:00:00", penalty: 0, score: 90},
+		{name: "NoBuildDate", assignment: policy, buildDate: "", penalty: 0, score: 90},
+	}
+	for _, test := range latePenaltyTests {
+		submission := &pb.Submission{LatePenalty: 50, BuildInfo: &score.BuildInfo{BuildDate: test.buildDate}}
+		if got := test.assignment.ApplyLatePenalty(submission, 90); got != test.score || submission.GetLatePenalty() != test.penalty {
+			t.Errorf("%s: ApplyLatePenalty(%s, 90) = %d with penalty %d, expected %d with penalty %d",
+				test.name, test.buildDate, got, submission.GetLatePenalty(), test.score, test.penalty)
+		}
+	}
+	delivered := time.Date(2022, 11, 12, 12, 0, 0, 0, time.UTC)
+	if got := policy.LatePenaltyFor(delivered); got != 30 {
+		t.Errorf("LatePenaltyFor(%v) = %d, expected 30", delivered, got)
+	}
+}
+
+func TestReleaseDue(t *testing.T) {
+	now := time.Date(2022, 11, 10, 8, 0, 0, 0, time.UTC)
+	tests := []struct {
+		assignment *pb.Assignment
+		hidden     bool
+		due        bool
+	}{
+		{assignment: &pb.Assignment{}, hidden: false, due: false},
+		{assignment: &pb.Assignment{Release: "2022-11-10T08:00:00"}, hidden: true, due: true},
+		{assignment: &pb.Assignment{Release: "2022-11-09T12:00:00"}, hidden: true, due: true},
+		{assignment: &pb.Assignment{Release: "2022-11-10T08:00:01"}, hidden: true, due: false},
+		{assignment: &pb.Assignment{Release: "2022-11-09T12:00:00", Released: true}, hidden: false, due: false},
+	}
+	for _, test := range tests {
+		if hidden := test.assignment.IsHidden(); hidden != test.hidden {
+			t.Errorf("IsHidden(%q, released=%t) = %t, want %t", test.assignment.GetRelease(), test.assignment.GetReleased(), hidden, test.hidden)
+		}
+		due, err := test.assignment.ReleaseDue(now)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if due != test.due {
+			t.Errorf("ReleaseDue(%q, released=%t) = %t, want %t", test.assignment.GetRelease(), test.assignment.GetReleased(), due, test.due)
+		}
+	}
+	if _, err := (&pb.Assignment{Release: "tomorrow"}).ReleaseDue(now); err == nil {
+		t.Error("ReleaseDue() with invalid release time succeeded, want error")
+	}
+}
+
+func TestInExamWindow(t *testing.T) {
+	now := time.Date(2022, 12, 1, 10, 0, 0, 0, time.UTC)
+	exam := &pb.Assignment{ID: 1, Exam: true, ExamStart: "2022-12-01T09:00:00", Deadline: "2022-12-01T13:00:00"}
+	tests := []struct {
+		assignment *pb.Assignment
+		now        time.Time
+		want       bool
+	}{
+		{assignment: &pb.Assignment{Deadline: "2022-12-01T13:00:00"}, now: now, want: false},
+		{assignment: exam, now: now, want: true},
+		{assignment: exam, now: time.Date(2022, 12, 1, 9, 0, 0, 0, time.UTC), want: true},
+		{assignment: exam, now: time.Date(2022, 12, 1, 8, 59, 59, 0, time.UTC), want: false},
+		{assignment: exam, now: time.Date(2022, 12, 1, 13, 0, 0, 0, time.UTC), want: false},
+		{assignment: exam.WithExtension(&pb.Extension{AssignmentID: 1, Deadline: "2022-12-01T14:00:00"}), now: time.Date(2022, 12, 1, 13, 30, 0, 0, time.UTC), want: true},
+	}
+	for _, test := range tests {
+		got, err := test.assignment.InExamWindow(test.now)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != test.want {
+			t.Errorf("InExamWindow(%q-%q, %v) = %t, want %t", test.assignment.GetExamStart(), test.assignment.GetDeadline(), test.now, got, test.want)
+		}
+	}
+	if _, err := (&pb.Assignment{Exam: true, ExamStart: "today", Deadline: "2022-12-01T13:00:00"}).InExamWindow(now); err == nil {
+		t.Error("InExamWindow() with invalid exam start succeeded, want error")
+	}
+}
+
+func TestFixDeadline(t *testing.T) {
+	deadlineTests := []struct {
+		in, want string
+	}{
+		{"2020-01-23T18:00:20", "2020-01-23T18:00:20"},
+		{"2020-01-23 18:00:20", "2020-01-23T18:00:20"},
+		{"2020-01-23T18:00", "2020-01-23T18:00:00"},
+		{"2020-01-23 18:00", "2020-01-23T18:00:00"},
+		{"2020-01-23T1800", "2020-01-23T18:00:00"},
+		{"2020-01-23 1800", "2020-01-23T18:00:00"},
+		{"2020-01-23T18", "2020-01-23T18:00:00"},
+		{"2020-01-23 18", "2020-01-23T18:00:00"},
+		{"2020-01-23 6pm", "2020-01-23T18:00:00"},
+		{"2020-01-23 6am", "2020-01-23T06:00:00"},
+		//
+		{"2020-1-23T18:00:20", "2020-01-23T18:00:20"},
+		{"2020-1-23 18:00:20", "2020-01-23T18:00:20"},
+		{"2020-1-23T18:00", "2020-01-23T18:00:00"},
+		{"2020-1-23 18:00", "2020-01-23T18:00:00"},
+		{"2020-1-23T1800", "2020-01-23T18:00:00"},
+		{"2020-1-23 1800", "2020-01-23T18:00:00"},
+		{"2020-1-23T18", "2020-01-23T18:00:00"},
+		{"2020-1-23 18", "2020-01-23T18:00:00"},
+		{"2020-1-23 6pm", "2020-01-23T18:00:00"},
+		{"2020-1-23 6am", "2020-01-23T06:00:00"},
+		//
+		{"2020-1-1T18:00:20", "2020-01-01T18:00:20"},
+		{"2020-1-1 18:00:20", "2020-01-01T18:00:20"},
+		{"2020-1-1T18:00", "2020-01-01T18:00:00"},
+		{"2020-1-1 18:00", "2020-01-01T18:00:00"},
+		{"2020-1-1T1800", "2020-01-01T18:00:00"},
+		{"2020-1-1 1800", "2020-01-01T18:00:00"},
+		{"2020-1-1T18", "2020-01-01T18:00:00"},
+		{"2020-1-1 18", "2020-01-01T18:00:00"},
+		{"2020-1-1 6pm", "2020-01-01T18:00:00"},
+		{"2020-1-1 6am", "2020-01-01T06:00:00"},
+		//
+		{"23-01-2020T18:00:20", "2020-01-23T18:00:20"},
+		{"23-01-2020 18:00:20", "2020-01-23T18:00:20"},
+		{"23-01-2020T18:00", "2020-01-23T18:00:00"},
+		{"23-01-2020 18:00", "2020-01-23T18:00:00"},
+		{"23-01-2020T1800", "2020-01-23T18:00:00"},
+		{"23-01-2020 1800", "2020-01-23T18:00:00"},
+		{"23-01-2020T18", "2020-01-23T18:00:00"},
+		{"23-01-2020 18", "2020-01-23T18:00:00"},
+		{"23-01-2020 6pm", "2020-01-23T18:00:00"},
+		{"23-01-2020 6am", "2020-01-23T06:00:00"},
+		//
+		{"23-1-2020T18:00:20", "2020-01-23T18:00:20"},
+		{"23-1-2020 18:00:20", "2020-01-23T18:00:20"},
+		{"23-1-2020T18:00", "2020-01-23T18:00:00"},
+		{"23-1-2020 18:00", "2020-01-23T18:00:00"},
+		{"23-1-2020T1800", "2020-01-23T18:00:00"},
+		{"23-1-2020 1800", "2020-01-23T18:00:00"},
+		{"23-1-2020T18", "2020-01-23T18:00:00"},
+		{"23-1-2020 18", "2020-01-23T18:00:00"},
+		{"23-1-2020 6pm", "2020-01-23T18:00:00"},
+		{"23-1-2020 6am", "2020-01-23T06:00:00"},
+		//
+		{"1-1-2020T18:00:20", "2020-01-01T18:00:20"},
+		{"1-1-2020 18:00:20", "2020-01-01T18:00:20"},
+		{"1-1-2020T18:00", "2020-01-01T18:00:00"},
+		{"1-1-2020 18:00", "2020-01-01T18:00:00"},
+		{"1-1-2020T1800", "2020-01-01T18:00:00"},
+		{"1-1-2020 1800", "2020-01-01T18:00:00"},
+		{"1-1-2020T18", "2020-01-01T18:00:00"},
+		{"1-1-2020 18", "2020-01-01T18:00:00"},
+		{"1-1-2020 6pm", "2020-01-01T18:00:00"},
+		{"1-1-2020 6am", "2020-01-01T06:00:00"},
+		//
+		{"1-12-2020T18:00:20", "2020-12-01T18:00:20"},
+		{"1-12-2020 18:00:20", "2020-12-01T18:00:20"},
+		{"1-12-2020T18:00", "2020-12-01T18:00:00"},
+		{"1-12-2020 18:00", "2020-12-01T18:00:00"},
+		{"1-12-2020T1800", "2020-12-01T18:00:00"},
+		{"1-12-2020 1800", "2020-12-01T18:00:00"},
+		{"1-12-2020T18", "2020-12-01T18:00:00"},
+		{"1-12-2020 18", "2020-12-01T18:00:00"},
+		{"1-12-2020 6pm", "2020-12-01T18:00:00"},
+		{"1-12-2020 6am", "2020-12-01T06:00:00"},
+		{"1-12-2020 6:59pm", "2020-12-01T18:59:00"},
+		{"1-12-2020 6:59:30pm", "2020-12-01T18:59:30"},
+	}
+	for _, c := range deadlineTests {
+		got := pb.FixDeadline(c.in)
+		if got != c.want {
+			t.Errorf("FixDeadline(%q) == %q, want %q", c.in, got, c.want)
+		}
+	}
+}
+
+func TestDeadlineInUTC(t *testing.T) {
+	oslo, err := time.LoadLocation("Europe/Oslo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	deadlineTests := []struct {
+		in   string
+		want string
+	}{
+		// winter time is UTC+1 and summer time is UTC+2
+		{"2022-01-10 23:59", "2022-01-10T22:59:00"},
+		{"2022-06-10T23:59:00", "2022-06-10T21:59:00"},
+		// the night daylight saving time ends
+		{"2022-10-30 01:30", "2022-10-29T23:30:00"},
+		{"2022-10-30 03:30", "2022-10-30T02:30:00"},
+		// the offset of RFC3339 deadlines is kept
+		{"2022-06-10T23:59:00Z", "2022-06-10T23:59:00"},
+		{"2022-06-10T23:59:00-07:00", "2022-06-11T06:59:00"},
+	}
+	for _, c := range deadlineTests {
+		got, err := pb.DeadlineInUTC(c.in, oslo)
+		if err != nil || got != c.want {
+			t.Errorf("DeadlineInUTC(%q) = (%q, %v), want %q", c.in, got, err, c.want)
+		}
+	}
+	if got, err := pb.DeadlineInUTC("next friday", oslo); err == nil {
+		t.Errorf("DeadlineInUTC(%q) = %q, want error", "next friday", got)
+	}
+}
+
+func TestLocalDeadline(t *testing.T) {
+	course := &pb.Course{Timezone: "Europe/Oslo"}
+	assignments := []*pb.Assignment{
+		{Deadline: "2022-01-10T22:59:00"},
+		{Deadline: "2022-06-10T21:59:00"},
+		{},
+	}
+	course.LocalizeDeadlines(assignments)
+	want := []string{"2022-01-10T23:59:00+01:00", "2022-06-10T23:59:00+02:00", ""}
+	for i, a := range assignments {
+		if a.GetLocalDeadline() != want[i] {
+			t.Errorf("LocalizeDeadlines(%q) = %q, want %q", a.GetDeadline(), a.GetLocalDeadline(), want[i])
+		}
+	}
+
+	// deadlines are stored in UTC, so the time since the deadline does not depend on the time zone of now
+	assignment := &pb.Assignment{Deadline: "2022-10-29T23:30:00"}
+	deliveredUTC := time.Date(2022, 10, 30, 0, 30, 0, 0, time.UTC)
+	for _, delivered := range []time.Time{deliveredUTC, deliveredUTC.In(course.Location()), deliveredUTC.In(time.FixedZone("PDT", -7*3600))} {
+		if got, err := assignment.SinceDeadline(delivered); err != nil || got != time.Hour {
+			t.Errorf("SinceDeadline(%v) = (%v, %v), want %v", delivered, got, err, time.Hour)
+		}
+	}
+}
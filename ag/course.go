@@ -1,5 +1,10 @@
 package ag
 
+import (
+	"strings"
+	"time"
+)
+
 // cache of access tokens for courses; they are cached here when fetching from database
 var accessTokens = make(map[uint64]string)
 
@@ -13,6 +18,46 @@ func (course *Course) GetAccessToken() string {
 	return accessTokens[course.GetID()]
 }
 
+// AutoApprovesEmail returns true if enrollments of students with the given email
+// are accepted automatically, that is, if the email's domain is one of the
+// course's auto-approved domains. Domains are matched case-insensitively.
+func (course *Course) AutoApprovesEmail(email string) bool {
+	at := strings.LastIndex(email, "@")
+	if at < 0 {
+		return false
+	}
+	domain := email[at+1:]
+	for _, d := range strings.Split(course.GetAutoApproveDomains(), ",") {
+		d = strings.TrimPrefix(strings.TrimSpace(d), "@")
+		if d != "" && strings.EqualFold(d, domain) {
+			return true
+		}
+	}
+	return false
+}
+
+// Location returns the time zone of the course's deadlines. The server's time zone
+// is returned if the course has no time zone or the time zone is unknown.
+func (course *Course) Location() *time.Location {
+	if course.GetTimezone() == "" {
+		return time.Local
+	}
+	loc, err := time.LoadLocation(course.GetTimezone())
+	if err != nil {
+		return time.Local
+	}
+	return loc
+}
+
+// LocalizeDeadlines sets the local deadline of the given assignments
+// to their deadline in the course's time zone.
+func (course *Course) LocalizeDeadlines(assignments []*Assignment) {
+	loc := course.Location()
+	for _, a := range assignments {
+		a.LocalDeadline = a.LocalDeadlineIn(loc)
+	}
+}
+
 // SetSlipDays sets number of remaining slip days for each course enrollment
 func (course *Course) SetSlipDays() {
 	for _, e := range course.Enrollments {
@@ -23,3 +68,37 @@ func (course *Course) SetSlipDays() {
 		g.SetSlipDays(course)
 	}
 }
+
+// DefaultGradingScale returns the grading scale used for courses without their own grading scale.
+func DefaultGradingScale() []*GradeThreshold {
+	return []*GradeThreshold{
+		{Grade: "A", MinScore: 90, Passing: true},
+		{Grade: "B", MinScore: 80, Passing: true},
+		{Grade: "C", MinScore: 60, Passing: true},
+		{Grade: "D", MinScore: 50, Passing: true},
+		{Grade: "E", MinScore: 40, Passing: true},
+		{Grade: "F", MinScore: 0, Passing: false},
+	}
+}
+
+// Grade returns the grade threshold for the given percentage score according
+// to the grading scale, that is, the threshold with the highest minimum score
+// not above the given score.
+func (gs *GradingScale) Grade(score uint32) *GradeThreshold {
+	var grade *GradeThreshold
+	for _, t := range gs.GetThresholds() {
+		if t.GetMinScore() <= score && (grade == nil || t.GetMinScore() > grade.GetMinScore()) {
+			grade = t
+		}
+	}
+	return grade
+}
+
+// SetGrades sets the letter grade of each of the given submissions according to the grading scale.
+func (gs *GradingScale) SetGrades(submissions ...*Submission) {
+	for _, s := range submissions {
+		if s != nil {
+			s.Grade = gs.Grade(s.GetScore()).GetGrade()
+		}
+	}
+}
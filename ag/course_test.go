@@ -0,0 +1,46 @@
+package ag_test
+
+import (
+	"testing"
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+)
+
+func TestCourseAutoApprovesEmail(t *testing.T) {
+	course := &pb.Course{AutoApproveDomains: "stud.uis.no, @uis.no"}
+	tests := []struct {
+		email string
+		want  bool
+	}{
+		{email: "alice@stud.uis.no", want: true},
+		{email: "bob@UIS.no", want: true},
+		{email: "carol@gmail.com", want: false},
+		{email: "dave@evil.stud.uis.no", want: false},
+		{email: "uis.no", want: false},
+		{email: "", want: false},
+	}
+	for _, test := range tests {
+		if got := course.AutoApprovesEmail(test.email); got != test.want {
+			t.Errorf("AutoApprovesEmail(%q) = %t, want %t", test.email, got, test.want)
+		}
+	}
+	if (&pb.Course{}).AutoApprovesEmail("alice@stud.uis.no") {
+		t.Error("AutoApprovesEmail() = true for course without auto-approved domains, want false")
+	}
+}
+
+func TestCourseLocation(t *testing.T) {
+	if got := (&pb.Course{}).Location(); got != time.Local {
+		t.Errorf("Location() = %v for course without time zone, want %v", got, time.Local)
+	}
+	if got := (&pb.Course{Timezone: "Europe/Oslo"}).Location(); got.String() != "Europe/Oslo" {
+		t.Errorf("Location() = %v, want Europe/Oslo", got)
+	}
+	for timezone, want := range map[string]bool{"": true, "Europe/Oslo": true, "UTC": true, "Mars/Olympus_Mons": false} {
+		course := &pb.Course{Name: "Course", Code: "DAT100", Provider: "fake", OrganizationID: 1, Year: 2021, Tag: "Spring", Timezone: timezone}
+		if got := course.IsValid(); got != want {
+			t.Errorf("IsValid() = %t for time zone %q, want %t", got, timezone, want)
+		}
+	}
+}
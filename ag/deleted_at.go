@@ -0,0 +1,122 @@
+package ag
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+)
+
+// DeletedAt is the time a soft-deleted record was deleted, in seconds since the Unix epoch;
+// zero means that the record is not deleted. Records with a DeletedAt field are soft-deleted
+// by the database: deleting them sets the field to the current time, and queries ignore them,
+// unless the query is unscoped. Unlike gorm.DeletedAt, DeletedAt is an integer and can
+// therefore be used as the type of a protobuf message field.
+type DeletedAt int64
+
+// Time returns the time the record was deleted, or the zero time if it is not deleted.
+func (d DeletedAt) Time() time.Time {
+	if d == 0 {
+		return time.Time{}
+	}
+	return time.Unix(int64(d), 0)
+}
+
+// QueryClauses implements the gorm schema.QueryClausesInterface interface.
+func (DeletedAt) QueryClauses(f *schema.Field) []clause.Interface {
+	return []clause.Interface{softDeleteQueryClause{field: f}}
+}
+
+// UpdateClauses implements the gorm schema.UpdateClausesInterface interface.
+func (DeletedAt) UpdateClauses(f *schema.Field) []clause.Interface {
+	return []clause.Interface{softDeleteUpdateClause{field: f}}
+}
+
+// DeleteClauses implements the gorm schema.DeleteClausesInterface interface.
+func (DeletedAt) DeleteClauses(f *schema.Field) []clause.Interface {
+	return []clause.Interface{softDeleteDeleteClause{field: f}}
+}
+
+// softDeleteQueryClause restricts queries to records that are not deleted.
+type softDeleteQueryClause struct {
+	field *schema.Field
+}
+
+func (sd softDeleteQueryClause) Name() string               { return "" }
+func (sd softDeleteQueryClause) Build(clause.Builder)       {}
+func (sd softDeleteQueryClause) MergeClause(*clause.Clause) {}
+
+func (sd softDeleteQueryClause) ModifyStatement(stmt *gorm.Statement) {
+	if _, ok := stmt.Clauses["soft_delete_enabled"]; ok || stmt.Statement.Unscoped {
+		return
+	}
+	if c, ok := stmt.Clauses["WHERE"]; ok {
+		// wrap the existing conditions so that their OR conditions do not include deleted records
+		if where, ok := c.Expression.(clause.Where); ok && len(where.Exprs) > 0 {
+			for _, expr := range where.Exprs {
+				if orCond, ok := expr.(clause.OrConditions); ok && len(orCond.Exprs) == 1 {
+					where.Exprs = []clause.Expression{clause.And(where.Exprs...)}
+					c.Expression = where
+					stmt.Clauses["WHERE"] = c
+					break
+				}
+			}
+		}
+	}
+	stmt.AddClause(clause.Where{Exprs: []clause.Expression{
+		clause.Eq{Column: clause.Column{Table: clause.CurrentTable, Name: sd.field.DBName}, Value: 0},
+	}})
+	stmt.Clauses["soft_delete_enabled"] = clause.Clause{}
+}
+
+// softDeleteUpdateClause restricts updates to records that are not deleted.
+type softDeleteUpdateClause struct {
+	field *schema.Field
+}
+
+func (sd softDeleteUpdateClause) Name() string               { return "" }
+func (sd softDeleteUpdateClause) Build(clause.Builder)       {}
+func (sd softDeleteUpdateClause) MergeClause(*clause.Clause) {}
+
+func (sd softDeleteUpdateClause) ModifyStatement(stmt *gorm.Statement) {
+	if stmt.SQL.Len() > 0 || stmt.Statement.Unscoped {
+		return
+	}
+	if _, ok := stmt.Clauses["WHERE"]; stmt.DB.AllowGlobalUpdate || ok {
+		softDeleteQueryClause(sd).ModifyStatement(stmt)
+	}
+}
+
+// softDeleteDeleteClause turns deletions into updates setting the deletion time.
+type softDeleteDeleteClause struct {
+	field *schema.Field
+}
+
+func (sd softDeleteDeleteClause) Name() string               { return "" }
+func (sd softDeleteDeleteClause) Build(clause.Builder)       {}
+func (sd softDeleteDeleteClause) MergeClause(*clause.Clause) {}
+
+func (sd softDeleteDeleteClause) ModifyStatement(stmt *gorm.Statement) {
+	if stmt.SQL.Len() > 0 || stmt.Statement.Unscoped {
+		return
+	}
+	deletedAt := stmt.DB.NowFunc().Unix()
+	stmt.AddClause(clause.Set{{Column: clause.Column{Name: sd.field.DBName}, Value: deletedAt}})
+	stmt.SetColumn(sd.field.DBName, deletedAt, true)
+
+	if stmt.Schema != nil {
+		_, queryValues := schema.GetIdentityFieldValuesMap(stmt.ReflectValue, stmt.Schema.PrimaryFields)
+		column, values := schema.ToQueryValues(stmt.Table, stmt.Schema.PrimaryFieldDBNames, queryValues)
+		if len(values) > 0 {
+			stmt.AddClause(clause.Where{Exprs: []clause.Expression{clause.IN{Column: column, Values: values}}})
+		}
+	}
+	if _, ok := stmt.Clauses["WHERE"]; !stmt.DB.AllowGlobalUpdate && !ok {
+		stmt.DB.AddError(gorm.ErrMissingWhereClause)
+	} else {
+		softDeleteQueryClause(sd).ModifyStatement(stmt)
+	}
+	stmt.AddClauseIfNotExists(clause.Update{})
+	stmt.Build(stmt.DB.Callback().Update().Clauses...)
+}
@@ -24,17 +24,47 @@ func (m *Enrollment) UpdateSlipDays(start time.Time, assignment *Assignment, sub
 	}
 	// if score is less than limit and it's not yet approved, update slip days if deadline has passed
 	if submission.Score < assignment.ScoreLimit && submission.Status != Submission_APPROVED && sinceDeadline > 0 {
-		// deadline exceeded; calculate used slipdays for this assignment
-		slpDays, slpHours := uint32(sinceDeadline/days), sinceDeadline%days
-		// slpHours is hours after deadline, excluding subsequent full-day slipdays after deadline
-		if slpHours > gracePeriod {
-			slpDays++
-		}
-		m.updateSlipDays(assignment.GetID(), slpDays)
+		m.updateSlipDays(assignment.GetID(), slipDaysSince(sinceDeadline))
 	}
 	return nil
 }
 
+// SlipDaysFor returns the number of slip days used by a submission delivered at the given time.
+func (a *Assignment) SlipDaysFor(delivered time.Time) (uint32, error) {
+	sinceDeadline, err := a.SinceDeadline(delivered)
+	if err != nil || sinceDeadline <= 0 {
+		return 0, err
+	}
+	return slipDaysSince(sinceDeadline), nil
+}
+
+// slipDaysSince returns the number of slip days used for the given time after the deadline.
+// A slip day is used for each started day, except for the last day if within the grace period.
+func slipDaysSince(sinceDeadline time.Duration) uint32 {
+	slpDays, slpHours := uint32(sinceDeadline/days), sinceDeadline%days
+	// slpHours is hours after deadline, excluding subsequent full-day slipdays after deadline
+	if slpHours > gracePeriod {
+		slpDays++
+	}
+	return slpDays
+}
+
+// ExceedsSlipDays returns true if the course has a slip-day budget, and using the given
+// number of slip days for the assignment would exceed the enrollment's budget.
+// Slip days already used for the assignment are replaced, and are therefore not counted.
+func (m *Enrollment) ExceedsSlipDays(c *Course, assignmentID uint64, slipDays uint32) bool {
+	if c.GetSlipDays() == 0 {
+		return false
+	}
+	used := slipDays
+	for _, val := range m.GetUsedSlipDays() {
+		if val.GetAssignmentID() != assignmentID {
+			used += val.GetUsedSlipDays()
+		}
+	}
+	return used > c.GetSlipDays()
+}
+
 // updateSlipDays updates the number of slipdays for the given assignment.
 func (m *Enrollment) updateSlipDays(assignmentID uint64, slipDays uint32) {
 	for _, val := range m.GetUsedSlipDays() {
@@ -51,8 +81,8 @@ func (m *Enrollment) updateSlipDays(assignmentID uint64, slipDays uint32) {
 	})
 }
 
-// totalSlipDays returns the total number of slipdays used for this enrollment.
-func (m *Enrollment) totalSlipDays() uint32 {
+// TotalSlipDays returns the total number of slipdays used for this enrollment.
+func (m *Enrollment) TotalSlipDays() uint32 {
 	var total uint32
 	for _, val := range m.GetUsedSlipDays() {
 		total += val.GetUsedSlipDays()
@@ -67,7 +97,7 @@ func (m *Enrollment) RemainingSlipDays(c *Course) int32 {
 	if m.GetCourseID() != c.GetID() {
 		return 0
 	}
-	return int32(c.GetSlipDays() - m.totalSlipDays())
+	return int32(c.GetSlipDays() - m.TotalSlipDays())
 }
 
 // SetSlipDays updates SlipDaysRemaining field of an enrollment.
@@ -83,6 +113,30 @@ func (m *Enrollment) IsTeacher() bool {
 	return m.GetStatus() == Enrollment_TEACHER
 }
 
+// IsTA returns true if the enrollment is a teaching assistant enrollment.
+func (m *Enrollment) IsTA() bool {
+	return m.GetStatus() == Enrollment_TA
+}
+
+// IsTeachingStaff returns true if the enrollment is a teacher or teaching assistant enrollment.
+func (m *Enrollment) IsTeachingStaff() bool {
+	return m.IsTeacher() || m.IsTA()
+}
+
+// CanReview returns true if the enrolled user can review the course's submissions.
+// Teachers always can, while teaching assistants can unless the course only lets them view submissions.
+// The enrollment's course must be preloaded.
+func (m *Enrollment) CanReview() bool {
+	return m.IsTeacher() || m.IsTA() && m.GetCourse().GetAssistantPermission() != Course_VIEW_ONLY
+}
+
+// CanApprove returns true if the enrolled user can approve, reject and grade the course's submissions.
+// Teachers always can, while teaching assistants can if the course lets them.
+// The enrollment's course must be preloaded.
+func (m *Enrollment) CanApprove() bool {
+	return m.IsTeacher() || m.IsTA() && m.GetCourse().GetAssistantPermission() == Course_REVIEW_AND_APPROVE
+}
+
 func (m *Enrollment) IsStudent() bool {
 	return m.GetStatus() == Enrollment_STUDENT
 }
@@ -0,0 +1,34 @@
+package ag_test
+
+import (
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+)
+
+func TestEnrollmentTeachingStaffPermissions(t *testing.T) {
+	tests := []struct {
+		name        string
+		status      pb.Enrollment_UserStatus
+		permission  pb.Course_AssistantPermission
+		wantReview  bool
+		wantApprove bool
+	}{
+		{name: "Student", status: pb.Enrollment_STUDENT, permission: pb.Course_REVIEW_AND_APPROVE},
+		{name: "Teacher", status: pb.Enrollment_TEACHER, permission: pb.Course_VIEW_ONLY, wantReview: true, wantApprove: true},
+		{name: "AssistantReviewAndApprove", status: pb.Enrollment_TA, permission: pb.Course_REVIEW_AND_APPROVE, wantReview: true, wantApprove: true},
+		{name: "AssistantReviewOnly", status: pb.Enrollment_TA, permission: pb.Course_REVIEW_ONLY, wantReview: true},
+		{name: "AssistantViewOnly", status: pb.Enrollment_TA, permission: pb.Course_VIEW_ONLY},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			enrollment := &pb.Enrollment{Status: test.status, Course: &pb.Course{AssistantPermission: test.permission}}
+			if got := enrollment.CanReview(); got != test.wantReview {
+				t.Errorf("CanReview() = %t, want %t", got, test.wantReview)
+			}
+			if got := enrollment.CanApprove(); got != test.wantApprove {
+				t.Errorf("CanApprove() = %t, want %t", got, test.wantApprove)
+			}
+		})
+	}
+}
@@ -0,0 +1,77 @@
+package ag
+
+import (
+	"math"
+	"sort"
+)
+
+// ComputeFinalGrade returns the final grade for a student with the given submissions,
+// keyed by assignment ID, for the course's assignments.
+// The final score is the weighted average of the assignment scores, where assignments
+// without a submission count as zero. If no assignment has a grade weight, all assignments
+// are weighted equally; otherwise, assignments without a grade weight are not counted.
+// Submissions with an applied score curve count with their curved score.
+// The dropLowest lowest scoring non-mandatory assignments are not counted.
+// The student passes if the final score gives a passing grade and the student completes
+// the course, with at least minApproved assignments and all mandatory assignments approved.
+func ComputeFinalGrade(assignments []*Assignment, submissions map[uint64]*Submission, dropLowest, minApproved uint32, scale *GradingScale) *FinalGrade {
+	weighted := false
+	for _, a := range assignments {
+		weighted = weighted || a.GetGradeWeight() > 0
+	}
+	weight := func(a *Assignment) uint32 {
+		if !weighted {
+			return 1
+		}
+		return a.GetGradeWeight()
+	}
+
+	completion := ComputeCompletion(assignments, submissions, minApproved)
+	finalGrade := &FinalGrade{MissingMandatory: completion.GetMissingMandatory()}
+	var droppable []*Assignment
+	for _, a := range assignments {
+		if !a.GetMandatory() && weight(a) > 0 {
+			droppable = append(droppable, a)
+		}
+	}
+	sort.SliceStable(droppable, func(i, j int) bool {
+		return submissions[droppable[i].GetID()].CurvedScore() < submissions[droppable[j].GetID()].CurvedScore()
+	})
+	dropped := make(map[uint64]bool)
+	for i := 0; i < int(dropLowest) && i < len(droppable); i++ {
+		dropped[droppable[i].GetID()] = true
+	}
+
+	var totalWeight, weightedScore float64
+	for _, a := range assignments {
+		if dropped[a.GetID()] {
+			finalGrade.DroppedAssignments = append(finalGrade.DroppedAssignments, a.GetID())
+			continue
+		}
+		totalWeight += float64(weight(a))
+		weightedScore += float64(weight(a)) * float64(submissions[a.GetID()].CurvedScore())
+	}
+	if totalWeight > 0 {
+		finalGrade.Score = uint32(math.Round(weightedScore / totalWeight))
+	}
+	grade := scale.Grade(finalGrade.GetScore())
+	finalGrade.Grade = grade.GetGrade()
+	finalGrade.Passed = grade.GetPassing() && completion.GetCompleted()
+	return finalGrade
+}
+
+// ComputeCompletion returns the completion status of a student with the given submissions,
+// keyed by assignment ID, for the course's assignments. The student completes the course
+// if at least minApproved assignments are approved, and all mandatory assignments are approved.
+func ComputeCompletion(assignments []*Assignment, submissions map[uint64]*Submission, minApproved uint32) *StudentCompletion {
+	completion := &StudentCompletion{}
+	for _, a := range assignments {
+		if submissions[a.GetID()].GetStatus() == Submission_APPROVED {
+			completion.Approved++
+		} else if a.GetMandatory() {
+			completion.MissingMandatory = append(completion.MissingMandatory, a.GetID())
+		}
+	}
+	completion.Completed = completion.GetApproved() >= minApproved && len(completion.GetMissingMandatory()) == 0
+	return completion
+}
@@ -0,0 +1,134 @@
+package ag_test
+
+import (
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func TestComputeFinalGrade(t *testing.T) {
+	scale := &pb.GradingScale{Thresholds: pb.DefaultGradingScale()}
+	lab1 := &pb.Assignment{ID: 1}
+	lab2 := &pb.Assignment{ID: 2}
+	lab3 := &pb.Assignment{ID: 3}
+	weighted1 := &pb.Assignment{ID: 1, GradeWeight: 1}
+	weighted2 := &pb.Assignment{ID: 2, GradeWeight: 3}
+	mandatory := &pb.Assignment{ID: 3, Mandatory: true}
+
+	tests := []struct {
+		name        string
+		assignments []*pb.Assignment
+		submissions map[uint64]*pb.Submission
+		dropLowest  uint32
+		minApproved uint32
+		want        *pb.FinalGrade
+	}{
+		{
+			name:        "EqualWeights",
+			assignments: []*pb.Assignment{lab1, lab2, lab3},
+			submissions: map[uint64]*pb.Submission{1: {Score: 90}, 2: {Score: 60}, 3: {Score: 60}},
+			want:        &pb.FinalGrade{Score: 70, Grade: "C", Passed: true},
+		},
+		{
+			name:        "CurvedScores",
+			assignments: []*pb.Assignment{lab1, lab2, lab3},
+			submissions: map[uint64]*pb.Submission{1: {Score: 90}, 2: {Score: 30, AdjustedScore: 60, Curved: true}, 3: {Score: 60, AdjustedScore: 90}},
+			want:        &pb.FinalGrade{Score: 70, Grade: "C", Passed: true},
+		},
+		{
+			name:        "MissingSubmission",
+			assignments: []*pb.Assignment{lab1, lab2},
+			submissions: map[uint64]*pb.Submission{1: {Score: 70}},
+			want:        &pb.FinalGrade{Score: 35, Grade: "F"},
+		},
+		{
+			name:        "GradeWeights",
+			assignments: []*pb.Assignment{weighted1, weighted2, lab3},
+			submissions: map[uint64]*pb.Submission{1: {Score: 40}, 2: {Score: 100}, 3: {Score: 0}},
+			want:        &pb.FinalGrade{Score: 85, Grade: "B", Passed: true},
+		},
+		{
+			name:        "DropLowest",
+			assignments: []*pb.Assignment{lab1, lab2, lab3},
+			submissions: map[uint64]*pb.Submission{1: {Score: 90}, 2: {Score: 10}, 3: {Score: 80}},
+			dropLowest:  1,
+			want:        &pb.FinalGrade{Score: 85, Grade: "B", Passed: true, DroppedAssignments: []uint64{2}},
+		},
+		{
+			name:        "DropLowestSkipsMandatory",
+			assignments: []*pb.Assignment{lab1, lab2, mandatory},
+			submissions: map[uint64]*pb.Submission{1: {Score: 90}, 2: {Score: 50}, 3: {Score: 20, Status: pb.Submission_APPROVED}},
+			dropLowest:  1,
+			want:        &pb.FinalGrade{Score: 55, Grade: "D", Passed: true, DroppedAssignments: []uint64{2}},
+		},
+		{
+			name:        "MandatoryNotApproved",
+			assignments: []*pb.Assignment{lab1, mandatory},
+			submissions: map[uint64]*pb.Submission{1: {Score: 100}, 3: {Score: 100, Status: pb.Submission_REVISION}},
+			want:        &pb.FinalGrade{Score: 100, Grade: "A", MissingMandatory: []uint64{3}},
+		},
+		{
+			name:        "TooFewApproved",
+			assignments: []*pb.Assignment{lab1, lab2, lab3},
+			submissions: map[uint64]*pb.Submission{1: {Score: 90, Status: pb.Submission_APPROVED}, 2: {Score: 90}, 3: {Score: 90, Status: pb.Submission_APPROVED}},
+			minApproved: 3,
+			want:        &pb.FinalGrade{Score: 90, Grade: "A"},
+		},
+		{
+			name:        "EnoughApproved",
+			assignments: []*pb.Assignment{lab1, lab2, lab3},
+			submissions: map[uint64]*pb.Submission{1: {Score: 90, Status: pb.Submission_APPROVED}, 2: {Score: 90}, 3: {Score: 90, Status: pb.Submission_APPROVED}},
+			minApproved: 2,
+			want:        &pb.FinalGrade{Score: 90, Grade: "A", Passed: true},
+		},
+	}
+	for _, test := range tests {
+		got := pb.ComputeFinalGrade(test.assignments, test.submissions, test.dropLowest, test.minApproved, scale)
+		if diff := cmp.Diff(test.want, got, protocmp.Transform()); diff != "" {
+			t.Errorf("%s: ComputeFinalGrade() mismatch (-want +got):\n%s", test.name, diff)
+		}
+	}
+}
+
+func TestComputeCompletion(t *testing.T) {
+	labs := []*pb.Assignment{{ID: 1}, {ID: 2}, {ID: 3}, {ID: 4, Mandatory: true}, {ID: 5}}
+	approved := &pb.Submission{Status: pb.Submission_APPROVED}
+	tests := []struct {
+		name        string
+		submissions map[uint64]*pb.Submission
+		minApproved uint32
+		want        *pb.StudentCompletion
+	}{
+		{
+			name:        "NoRequirement",
+			submissions: map[uint64]*pb.Submission{4: approved},
+			want:        &pb.StudentCompletion{Approved: 1, Completed: true},
+		},
+		{
+			name:        "EnoughApproved",
+			submissions: map[uint64]*pb.Submission{1: approved, 2: approved, 3: {Status: pb.Submission_REVISION}, 4: approved},
+			minApproved: 3,
+			want:        &pb.StudentCompletion{Approved: 3, Completed: true},
+		},
+		{
+			name:        "TooFewApproved",
+			submissions: map[uint64]*pb.Submission{1: approved, 4: approved, 5: {Score: 100}},
+			minApproved: 3,
+			want:        &pb.StudentCompletion{Approved: 2},
+		},
+		{
+			name:        "MandatoryNotApproved",
+			submissions: map[uint64]*pb.Submission{1: approved, 2: approved, 3: approved, 5: approved},
+			minApproved: 3,
+			want:        &pb.StudentCompletion{Approved: 4, MissingMandatory: []uint64{4}},
+		},
+	}
+	for _, test := range tests {
+		got := pb.ComputeCompletion(labs, test.submissions, test.minApproved)
+		if diff := cmp.Diff(test.want, got, protocmp.Transform()); diff != "" {
+			t.Errorf("%s: ComputeCompletion() mismatch (-want +got):\n%s", test.name, diff)
+		}
+	}
+}
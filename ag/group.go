@@ -11,6 +11,15 @@ func (g *Group) UserNames() []string {
 	return gitUserNames
 }
 
+// UserIDs returns the IDs of the group's users.
+func (g *Group) UserIDs() []uint64 {
+	userIDs := make([]uint64, 0, len(g.GetUsers()))
+	for _, user := range g.GetUsers() {
+		userIDs = append(userIDs, user.GetID())
+	}
+	return userIDs
+}
+
 // Contains returns true if the given user is in the group.
 func (g *Group) Contains(user *User) bool {
 	for _, u := range g.GetUsers() {
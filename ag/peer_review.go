@@ -0,0 +1,42 @@
+package ag
+
+// AssignPeerReviewers returns the reviewers of each submission, given the authors of each submission.
+// Each submission is reviewed by the authors of the next n submissions, wrapping around at the end,
+// so that the authors of each submission review n other submissions. The number of reviews n is
+// limited to the number of other submissions, and authors never review their own submissions.
+// The submissions should be shuffled beforehand to make the reviewers unpredictable.
+func AssignPeerReviewers(authors [][]uint64, n int) [][]uint64 {
+	if n >= len(authors) {
+		n = len(authors) - 1
+	}
+	reviewers := make([][]uint64, len(authors))
+	for i := range authors {
+		own := make(map[uint64]bool)
+		for _, author := range authors[i] {
+			own[author] = true
+		}
+		for k := 1; k <= n; k++ {
+			for _, reviewer := range authors[(i+k)%len(authors)] {
+				if !own[reviewer] {
+					own[reviewer] = true
+					reviewers[i] = append(reviewers[i], reviewer)
+				}
+			}
+		}
+	}
+	return reviewers
+}
+
+// Anonymized returns a copy of the submission for peer reviewers,
+// without the identity of the submission's authors and their reviews.
+// The build info is left out, since the build log may name the author's repository.
+func (s *Submission) Anonymized() *Submission {
+	return &Submission{
+		ID:           s.GetID(),
+		AssignmentID: s.GetAssignmentID(),
+		Score:        s.GetScore(),
+		CommitHash:   s.GetCommitHash(),
+		Status:       s.GetStatus(),
+		Scores:       s.GetScores(),
+	}
+}
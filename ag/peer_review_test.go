@@ -0,0 +1,32 @@
+package ag_test
+
+import (
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestAssignPeerReviewers(t *testing.T) {
+	tests := []struct {
+		name    string
+		authors [][]uint64
+		n       int
+		want    [][]uint64
+	}{
+		{name: "NoSubmissions", authors: nil, n: 2, want: [][]uint64{}},
+		{name: "SingleSubmission", authors: [][]uint64{{1}}, n: 2, want: [][]uint64{nil}},
+		{name: "OneReviewEach", authors: [][]uint64{{1}, {2}, {3}}, n: 1, want: [][]uint64{{2}, {3}, {1}}},
+		{name: "TwoReviewsEach", authors: [][]uint64{{1}, {2}, {3}}, n: 2, want: [][]uint64{{2, 3}, {3, 1}, {1, 2}}},
+		{name: "ReviewsLimitedToOtherSubmissions", authors: [][]uint64{{1}, {2}}, n: 3, want: [][]uint64{{2}, {1}}},
+		{name: "Groups", authors: [][]uint64{{1, 2}, {3, 4}, {5}}, n: 1, want: [][]uint64{{3, 4}, {5}, {1, 2}}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := pb.AssignPeerReviewers(test.authors, test.n)
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Errorf("AssignPeerReviewers() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -69,23 +69,50 @@ func (t *Repository) IsUserRepo() bool {
 	return t.RepoType == Repository_USER
 }
 
-// GetTestURL returns the tests repository string for this repository.
-// This repository can be any repository belonging to a course,
+// GetTestURL returns the URL of the course's tests repository for this repository.
+// This repository can be any repository belonging to the course,
 // e.g. a user or group repository.
 // Using this method we can avoid a database lookup.
-func (t *Repository) GetTestURL() string {
+func (t *Repository) GetTestURL(course *Course) string {
 	repoURL := t.GetHTMLURL()
-	return repoURL[:strings.LastIndex(repoURL, "/")+1] + TestsRepo
+	return repoURL[:strings.LastIndex(repoURL, "/")+1] + course.TestsRepoName()
 }
 
-// RepoType returns the repository type for the given path name.
-func RepoType(path string) (repoType Repository_Type) {
+// InfoRepoName returns the name of the course's info repository.
+func (course *Course) InfoRepoName() string {
+	return repoName(course.GetInfoRepo(), InfoRepo)
+}
+
+// AssignmentsRepoName returns the name of the course's assignments repository.
+func (course *Course) AssignmentsRepoName() string {
+	return repoName(course.GetAssignmentsRepo(), AssignmentRepo)
+}
+
+// TestsRepoName returns the name of the course's tests repository.
+func (course *Course) TestsRepoName() string {
+	return repoName(course.GetTestsRepo(), TestsRepo)
+}
+
+func repoName(name, defaultName string) string {
+	if name == "" {
+		return defaultName
+	}
+	return name
+}
+
+// CourseRepoNames returns the names of the course's info, assignments and tests repositories.
+func (course *Course) CourseRepoNames() []string {
+	return []string{course.InfoRepoName(), course.AssignmentsRepoName(), course.TestsRepoName()}
+}
+
+// RepoType returns the type of the course repository with the given path name.
+func (course *Course) RepoType(path string) (repoType Repository_Type) {
 	switch path {
-	case InfoRepo:
+	case course.InfoRepoName():
 		repoType = Repository_COURSEINFO
-	case AssignmentRepo:
+	case course.AssignmentsRepoName():
 		repoType = Repository_ASSIGNMENTS
-	case TestsRepo:
+	case course.TestsRepoName():
 		repoType = Repository_TESTS
 	}
 	return
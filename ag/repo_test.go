@@ -7,12 +7,64 @@ import (
 )
 
 func TestGetTestURL(t *testing.T) {
-	want := "https://github.com/dat320-2020/" + pb.TestsRepo
 	repo := &pb.Repository{
 		HTMLURL: "https://github.com/dat320-2020/meling-labs",
 	}
-	got := repo.GetTestURL()
-	if got != want {
-		t.Errorf("GetTestURL() = %s, want %s", got, want)
+	tests := []struct {
+		course *pb.Course
+		want   string
+	}{
+		{&pb.Course{}, "https://github.com/dat320-2020/" + pb.TestsRepo},
+		{&pb.Course{TestsRepo: "dat320-tests"}, "https://github.com/dat320-2020/dat320-tests"},
+	}
+	for _, test := range tests {
+		if got := repo.GetTestURL(test.course); got != test.want {
+			t.Errorf("GetTestURL(%q) = %s, want %s", test.course.GetTestsRepo(), got, test.want)
+		}
+	}
+}
+
+func TestCourseRepoType(t *testing.T) {
+	course := &pb.Course{AssignmentsRepo: "labs", TestsRepo: "labs-tests"}
+	tests := []struct {
+		path string
+		want pb.Repository_Type
+	}{
+		{pb.InfoRepo, pb.Repository_COURSEINFO},
+		{"labs", pb.Repository_ASSIGNMENTS},
+		{"labs-tests", pb.Repository_TESTS},
+		{pb.AssignmentRepo, pb.Repository_NONE},
+		{pb.TestsRepo, pb.Repository_NONE},
+	}
+	for _, test := range tests {
+		if got := course.RepoType(test.path); got != test.want {
+			t.Errorf("RepoType(%q) = %v, want %v", test.path, got, test.want)
+		}
+	}
+}
+
+func TestCourseIsValidRepoLayout(t *testing.T) {
+	tests := []struct {
+		name   string
+		course *pb.Course
+		want   bool
+	}{
+		{"default names", &pb.Course{}, true},
+		{"custom names", &pb.Course{AssignmentsRepo: "labs", TestsRepo: "labs-tests", AssignmentsDir: "2021/labs"}, true},
+		{"duplicate names", &pb.Course{TestsRepo: pb.AssignmentRepo}, false},
+		{"name with slash", &pb.Course{TestsRepo: "labs/tests"}, false},
+		{"name with shell command", &pb.Course{TestsRepo: "tests;touch${IFS}pwned"}, false},
+		{"name with command substitution", &pb.Course{InfoRepo: "$(id)"}, false},
+		{"parent directory name", &pb.Course{AssignmentsRepo: ".."}, false},
+		{"absolute folder", &pb.Course{AssignmentsDir: "/labs"}, false},
+		{"folder outside repository", &pb.Course{AssignmentsDir: "../labs"}, false},
+		{"unclean folder", &pb.Course{AssignmentsDir: "labs/"}, false},
+	}
+	for _, test := range tests {
+		course := test.course
+		course.Name, course.Code, course.Provider, course.OrganizationID, course.Year, course.Tag = "Course", "DAT100", "fake", 1, 2021, "Spring"
+		if got := course.IsValid(); got != test.want {
+			t.Errorf("%s: IsValid() = %t, want %t", test.name, got, test.want)
+		}
 	}
 }
@@ -1,27 +1,74 @@
 package ag
 
+import "math"
+
 // ComputeScore computes the total score for the review and assigns it to r.
-// If the grading criteria have predefined points, the score is the sum of these points.
+// If the grading benchmarks have weights, the score is the weighted average of the benchmarks'
+// percentages of passed criteria; benchmarks without criteria are ignored.
+// Otherwise, if the grading criteria have weights, the score is the weighted percentage of passed criteria.
+// Otherwise, if the grading criteria have predefined points, the score is the sum of these points.
 // Otherwise, each criterion is given equal weight, such that the max score is 100.
 func (r *Review) ComputeScore() {
 	scorePoints := 0
 	totalCriteria := 0
 	passedCriteria := 0
+	totalWeight := 0
+	passedWeight := 0
+	benchmarkWeight := 0
+	weightedBenchmarks := 0.0
 	for _, bm := range r.GradingBenchmarks {
+		if percentage, ok := bm.passedPercentage(); ok {
+			benchmarkWeight += int(bm.Weight)
+			weightedBenchmarks += float64(bm.Weight) * percentage
+		}
 		for _, c := range bm.Criteria {
 			totalCriteria++
+			totalWeight += int(c.Weight)
 			if c.Grade == GradingCriterion_PASSED {
 				passedCriteria++
 				scorePoints += int(c.Points)
+				passedWeight += int(c.Weight)
 			}
 		}
 	}
-	if totalCriteria == 0 {
+	switch {
+	case totalCriteria == 0:
 		return
-	}
-	if scorePoints == 0 {
+	case benchmarkWeight > 0:
+		r.Score = uint32(math.Round(weightedBenchmarks / float64(benchmarkWeight)))
+	case totalWeight > 0:
+		r.Score = uint32(math.Round(100 * float64(passedWeight) / float64(totalWeight)))
+	case scorePoints == 0:
 		r.Score = uint32(100 * passedCriteria / totalCriteria)
-	} else {
+	default:
 		r.Score = uint32(scorePoints)
 	}
 }
+
+// passedPercentage returns the percentage of the benchmark's criteria that are passed,
+// weighted by the criteria's weights or points, if any, and false if the benchmark has no criteria.
+func (bm *GradingBenchmark) passedPercentage() (float64, bool) {
+	var total, passed int
+	var totalWeight, passedWeight int
+	var totalPoints, passedPoints int
+	for _, c := range bm.GetCriteria() {
+		total++
+		totalWeight += int(c.GetWeight())
+		totalPoints += int(c.GetPoints())
+		if c.GetGrade() == GradingCriterion_PASSED {
+			passed++
+			passedWeight += int(c.GetWeight())
+			passedPoints += int(c.GetPoints())
+		}
+	}
+	switch {
+	case total == 0:
+		return 0, false
+	case totalWeight > 0:
+		return 100 * float64(passedWeight) / float64(totalWeight), true
+	case totalPoints > 0:
+		return 100 * float64(passedPoints) / float64(totalPoints), true
+	default:
+		return 100 * float64(passed) / float64(total), true
+	}
+}
@@ -103,6 +103,77 @@ var reviewScoreTests = []struct {
 			},
 		},
 	},
+	{
+		"Test weighted 70% score",
+		70,
+		&pb.Review{
+			ID:       4,
+			Feedback: "Test weighted 70% score",
+			GradingBenchmarks: []*pb.GradingBenchmark{
+				{
+					Criteria: []*pb.GradingCriterion{
+						{
+							Points: 1,
+							Weight: 5,
+							Grade:  pb.GradingCriterion_PASSED,
+						},
+						{
+							Points: 1,
+							Weight: 3,
+							Grade:  pb.GradingCriterion_FAILED,
+						},
+					},
+				},
+				{
+					Criteria: []*pb.GradingCriterion{
+						{
+							Points: 1,
+							Weight: 2,
+							Grade:  pb.GradingCriterion_PASSED,
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		"Test weighted benchmarks 63% score",
+		63,
+		&pb.Review{
+			ID:       5,
+			Feedback: "Test weighted benchmarks 63% score",
+			GradingBenchmarks: []*pb.GradingBenchmark{
+				{
+					Weight: 3,
+					Criteria: []*pb.GradingCriterion{
+						{
+							Points: 2,
+							Grade:  pb.GradingCriterion_PASSED,
+						},
+						{
+							Points: 2,
+							Grade:  pb.GradingCriterion_FAILED,
+						},
+					},
+				},
+				{
+					Weight: 1,
+					Criteria: []*pb.GradingCriterion{
+						{
+							Grade: pb.GradingCriterion_PASSED,
+						},
+						{
+							Grade: pb.GradingCriterion_PASSED,
+						},
+					},
+				},
+				{
+					// benchmarks without criteria are ignored
+					Weight: 5,
+				},
+			},
+		},
+	},
 }
 
 func TestComputeScore(t *testing.T) {
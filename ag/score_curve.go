@@ -0,0 +1,42 @@
+package ag
+
+import "math"
+
+// AdjustedScores returns the adjusted score of each of the given raw scores according to the curve.
+// Percentile ranks are computed among the given scores, counting equal scores as half below.
+// Without a curve method, the raw scores are returned unchanged.
+func (c *ScoreCurve) AdjustedScores(scores []uint32) []uint32 {
+	adjusted := make([]uint32, len(scores))
+	for i, score := range scores {
+		var x float64
+		switch c.GetMethod() {
+		case ScoreCurve_LINEAR:
+			x = c.GetSlope()*float64(score) + c.GetOffset()
+		case ScoreCurve_PERCENTILE:
+			var below, equal int
+			for _, other := range scores {
+				switch {
+				case other < score:
+					below++
+				case other == score:
+					equal++
+				}
+			}
+			rank := (float64(below) + float64(equal)/2) / float64(len(scores))
+			x = float64(c.GetMinScore()) + rank*float64(c.GetMaxScore()-c.GetMinScore())
+		default:
+			x = float64(score)
+		}
+		adjusted[i] = uint32(math.Round(math.Max(0, math.Min(100, x))))
+	}
+	return adjusted
+}
+
+// CurvedScore returns the submission's score adjusted by the assignment's score curve,
+// if a curve has been applied to the submission; otherwise the raw score is returned.
+func (s *Submission) CurvedScore() uint32 {
+	if s.GetCurved() {
+		return s.GetAdjustedScore()
+	}
+	return s.GetScore()
+}
@@ -0,0 +1,29 @@
+package ag_test
+
+import (
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestAdjustedScores(t *testing.T) {
+	scores := []uint32{40, 60, 60, 95}
+	tests := []struct {
+		name  string
+		curve *pb.ScoreCurve
+		want  []uint32
+	}{
+		{name: "None", curve: &pb.ScoreCurve{}, want: []uint32{40, 60, 60, 95}},
+		{name: "Linear", curve: &pb.ScoreCurve{Method: pb.ScoreCurve_LINEAR, Slope: 0.5, Offset: 50}, want: []uint32{70, 80, 80, 98}},
+		{name: "LinearClamped", curve: &pb.ScoreCurve{Method: pb.ScoreCurve_LINEAR, Slope: 1.2, Offset: -50}, want: []uint32{0, 22, 22, 64}},
+		{name: "Percentile", curve: &pb.ScoreCurve{Method: pb.ScoreCurve_PERCENTILE, MinScore: 20, MaxScore: 100}, want: []uint32{30, 60, 60, 90}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if diff := cmp.Diff(test.want, test.curve.AdjustedScores(scores)); diff != "" {
+				t.Errorf("AdjustedScores(%v) mismatch (-want +got):\n%s", scores, diff)
+			}
+		})
+	}
+}
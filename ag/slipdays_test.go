@@ -15,7 +15,7 @@ const (
 )
 
 var (
-	testNow = time.Now()
+	testNow = time.Now().UTC()
 
 	course = &pb.Course{
 		ID:       1,
@@ -82,7 +82,7 @@ var slipTests = []struct {
 
 func TestSlipDays(t *testing.T) {
 	for _, sd := range slipTests {
-		testNow = time.Now()
+		testNow = time.Now().UTC()
 		enrol := &pb.Enrollment{
 			Course:       course,
 			CourseID:     course.ID,
@@ -123,7 +123,7 @@ func TestSlipDays(t *testing.T) {
 }
 
 func TestScoreLimitSlipDays(t *testing.T) {
-	testNow = time.Now()
+	testNow = time.Now().UTC()
 	neg2, a2 := a(-2), a(2)
 
 	scoreLimitSlipDayTests := []struct {
@@ -361,3 +361,75 @@ func TestSlipDaysWGracePeriod(t *testing.T) {
 		})
 	}
 }
+
+func TestSlipDaysWithExtension(t *testing.T) {
+	// lab1's deadline passed three days ago, but the student was granted an extension
+	lab1 := a(-3)
+	lab1.ID = 1
+	extensions := []struct {
+		extension *pb.Extension
+		want      int32
+	}{
+		{nil, 2},
+		{&pb.Extension{AssignmentID: lab1.ID, Deadline: testNow.Add(-1 * days).Format(pb.TimeLayout)}, 4},
+		{&pb.Extension{AssignmentID: lab1.ID, Deadline: testNow.Add(1 * days).Format(pb.TimeLayout)}, 5},
+		// extensions of other assignments and shorter extensions are ignored
+		{&pb.Extension{AssignmentID: lab1.ID + 1, Deadline: testNow.Add(1 * days).Format(pb.TimeLayout)}, 2},
+		{&pb.Extension{AssignmentID: lab1.ID, Deadline: testNow.Add(-5 * days).Format(pb.TimeLayout)}, 2},
+	}
+	for _, test := range extensions {
+		enrol := &pb.Enrollment{
+			Course:       course,
+			CourseID:     course.ID,
+			UsedSlipDays: make([]*pb.UsedSlipDays, 0),
+		}
+		submission := &pb.Submission{Status: pb.Submission_NONE, AssignmentID: lab1.ID}
+		if err := enrol.UpdateSlipDays(testNow, lab1.WithExtension(test.extension), submission); err != nil {
+			t.Fatal(err)
+		}
+		if got := enrol.RemainingSlipDays(course); got != test.want {
+			t.Errorf("RemainingSlipDays() with extension %v = %d, want %d", test.extension, got, test.want)
+		}
+	}
+	if lab1.GetDeadline() != a(-3).GetDeadline() {
+		t.Errorf("WithExtension() modified the assignment's deadline")
+	}
+}
+
+func TestExceedsSlipDays(t *testing.T) {
+	enrol := &pb.Enrollment{
+		CourseID: course.ID,
+		UsedSlipDays: []*pb.UsedSlipDays{
+			{AssignmentID: 1, UsedSlipDays: 2},
+			{AssignmentID: 2, UsedSlipDays: 1},
+		},
+	}
+	tests := []struct {
+		course       *pb.Course
+		assignmentID uint64
+		slipDays     uint32
+		want         bool
+	}{
+		{course, 3, 2, false},
+		{course, 3, 3, true},
+		// the slip days already used for the assignment are replaced
+		{course, 2, 3, false},
+		{course, 2, 4, true},
+		// courses without slip days have no budget to exceed
+		{&pb.Course{ID: course.ID}, 3, 10, false},
+	}
+	for _, test := range tests {
+		if got := enrol.ExceedsSlipDays(test.course, test.assignmentID, test.slipDays); got != test.want {
+			t.Errorf("ExceedsSlipDays(%d slip days, assignment %d, %d slip days) = %t, want %t",
+				test.course.GetSlipDays(), test.assignmentID, test.slipDays, got, test.want)
+		}
+	}
+
+	lab := a(-2)
+	if got, err := lab.SlipDaysFor(testNow); err != nil || got != 2 {
+		t.Errorf("SlipDaysFor(2 days after deadline) = %d, %v, want 2", got, err)
+	}
+	if got, err := lab.SlipDaysFor(testNow.Add(-3 * days)); err != nil || got != 0 {
+		t.Errorf("SlipDaysFor(before deadline) = %d, %v, want 0", got, err)
+	}
+}
@@ -26,3 +26,19 @@ func (s *Submission) NewestBuildDate(submissionDate time.Time) (t time.Time, err
 	}
 	return submissionDate, nil
 }
+
+// ApprovalSignOffs returns the IDs of the reviewers that have signed off on the approval of a submission,
+// given the submission's transitions, oldest first. Sign-offs are the approvals since the submission was
+// last moved to a status other than in review, e.g., when changes were requested, or its approval revoked.
+func ApprovalSignOffs(transitions []*SubmissionTransition) map[uint64]bool {
+	signOffs := make(map[uint64]bool)
+	for _, transition := range transitions {
+		switch {
+		case transition.GetRequestedStatus() == Submission_APPROVED:
+			signOffs[transition.GetUserID()] = true
+		case transition.GetRequestedStatus() != Submission_IN_REVIEW || transition.GetPreviousStatus() == Submission_APPROVED:
+			signOffs = make(map[uint64]bool)
+		}
+	}
+	return signOffs
+}
@@ -54,3 +54,48 @@ func TestNewestSubmissionDate(t *testing.T) {
 		t.Errorf("NewestBuildDate(%v) = %v, expected '%v'\n", tim, new, buildDate)
 	}
 }
+
+func TestApprovalSignOffs(t *testing.T) {
+	transition := func(userID uint64, previous, requested pb.Submission_Status) *pb.SubmissionTransition {
+		return &pb.SubmissionTransition{UserID: userID, PreviousStatus: previous, RequestedStatus: requested}
+	}
+	tests := []struct {
+		name        string
+		transitions []*pb.SubmissionTransition
+		want        []uint64
+	}{
+		{name: "NoTransitions", want: nil},
+		{name: "InReview", transitions: []*pb.SubmissionTransition{
+			transition(1, pb.Submission_NONE, pb.Submission_IN_REVIEW),
+		}, want: nil},
+		{name: "TwoApprovals", transitions: []*pb.SubmissionTransition{
+			transition(1, pb.Submission_NONE, pb.Submission_IN_REVIEW),
+			transition(1, pb.Submission_IN_REVIEW, pb.Submission_APPROVED),
+			transition(2, pb.Submission_IN_REVIEW, pb.Submission_IN_REVIEW),
+			transition(2, pb.Submission_IN_REVIEW, pb.Submission_APPROVED),
+		}, want: []uint64{1, 2}},
+		{name: "ChangesRequested", transitions: []*pb.SubmissionTransition{
+			transition(1, pb.Submission_NONE, pb.Submission_APPROVED),
+			transition(2, pb.Submission_IN_REVIEW, pb.Submission_REVISION),
+			transition(3, pb.Submission_REVISION, pb.Submission_APPROVED),
+		}, want: []uint64{3}},
+		{name: "ApprovalRevoked", transitions: []*pb.SubmissionTransition{
+			transition(1, pb.Submission_NONE, pb.Submission_APPROVED),
+			transition(2, pb.Submission_IN_REVIEW, pb.Submission_APPROVED),
+			transition(1, pb.Submission_APPROVED, pb.Submission_IN_REVIEW),
+		}, want: nil},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := pb.ApprovalSignOffs(test.transitions)
+			if len(got) != len(test.want) {
+				t.Fatalf("ApprovalSignOffs() = %v, want %v", got, test.want)
+			}
+			for _, userID := range test.want {
+				if !got[userID] {
+					t.Errorf("ApprovalSignOffs() = %v, want %v", got, test.want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,31 @@
+package ag
+
+import "strings"
+
+// TestPrefixes returns the prefixes of the names of the task's tests. If the task's
+// tests are not given, the prefix is the task's name prefixed by "Test", e.g., TestFib
+// for the task fib.
+func (t *Task) TestPrefixes() []string {
+	var prefixes []string
+	for _, prefix := range strings.Split(t.GetTests(), ",") {
+		if prefix = strings.TrimSpace(prefix); prefix != "" {
+			prefixes = append(prefixes, prefix)
+		}
+	}
+	if len(prefixes) == 0 {
+		prefixes = []string{"Test" + t.GetName()}
+	}
+	return prefixes
+}
+
+// HasTest returns true if the named test, or its parent test, belongs to the task.
+// Test names are matched to the task's test prefixes ignoring case.
+func (t *Task) HasTest(testName string) bool {
+	name := strings.ToLower(testName)
+	for _, prefix := range t.TestPrefixes() {
+		if strings.HasPrefix(name, strings.ToLower(prefix)) {
+			return true
+		}
+	}
+	return false
+}
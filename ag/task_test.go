@@ -0,0 +1,26 @@
+package ag_test
+
+import (
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+)
+
+func TestTaskHasTest(t *testing.T) {
+	tests := []struct {
+		task     *pb.Task
+		testName string
+		want     bool
+	}{
+		{&pb.Task{Name: "fib"}, "TestFib", true},
+		{&pb.Task{Name: "fib"}, "TestFibonacci/n=10", true},
+		{&pb.Task{Name: "fib"}, "TestPrimes", false},
+		{&pb.Task{Name: "primes", Tests: "TestIsPrime, TestSieve"}, "TestSieveLarge", true},
+		{&pb.Task{Name: "primes", Tests: "TestIsPrime, TestSieve"}, "TestPrimes", false},
+	}
+	for _, tt := range tests {
+		if got := tt.task.HasTest(tt.testName); got != tt.want {
+			t.Errorf("Task{Name: %q, Tests: %q}.HasTest(%q) = %t, want %t", tt.task.GetName(), tt.task.GetTests(), tt.testName, got, tt.want)
+		}
+	}
+}
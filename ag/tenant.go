@@ -0,0 +1,17 @@
+package ag
+
+import "strings"
+
+// HasProvider returns true if the tenant's courses may use the given SCM provider.
+func (t *Tenant) HasProvider(provider string) bool {
+	return t.GetProvider() == "" || t.GetProvider() == provider
+}
+
+// BaseURLFor returns the base URL of the tenant's server for the given SCM provider,
+// or the empty string if the server's default is used for the provider.
+func (t *Tenant) BaseURLFor(provider string) string {
+	if t.GetProvider() != provider {
+		return ""
+	}
+	return strings.TrimSuffix(t.GetProviderURL(), "/")
+}
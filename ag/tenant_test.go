@@ -0,0 +1,46 @@
+package ag_test
+
+import (
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+)
+
+func TestTenantIsValid(t *testing.T) {
+	tests := []struct {
+		tenant *pb.Tenant
+		want   bool
+	}{
+		{&pb.Tenant{Name: "Physics"}, true},
+		{&pb.Tenant{Name: "Physics", Provider: "github"}, true},
+		{&pb.Tenant{Name: "Physics", Provider: "gitea", ProviderURL: "https://git.phys.example.org"}, true},
+		{&pb.Tenant{Name: "Physics", Provider: "bitbucket", ProviderURL: "http://bitbucket.local:7990/"}, true},
+		{&pb.Tenant{Name: " "}, false},
+		{&pb.Tenant{Name: "Physics", Provider: "svn"}, false},
+		{&pb.Tenant{Name: "Physics", Provider: "github", ProviderURL: "https://github.example.org"}, false},
+		{&pb.Tenant{Name: "Physics", ProviderURL: "https://git.phys.example.org"}, false},
+		{&pb.Tenant{Name: "Physics", Provider: "gitea", ProviderURL: "git.phys.example.org"}, false},
+	}
+	for _, test := range tests {
+		if got := test.tenant.IsValid(); got != test.want {
+			t.Errorf("IsValid(%v) = %t, want %t", test.tenant, got, test.want)
+		}
+	}
+}
+
+func TestTenantProvider(t *testing.T) {
+	tenant := &pb.Tenant{Name: "Physics", Provider: "gitea", ProviderURL: "https://git.phys.example.org/"}
+	if !tenant.HasProvider("gitea") || tenant.HasProvider("github") {
+		t.Errorf("HasProvider() of tenant using gitea: gitea = %t, github = %t", tenant.HasProvider("gitea"), tenant.HasProvider("github"))
+	}
+	if got := tenant.BaseURLFor("gitea"); got != "https://git.phys.example.org" {
+		t.Errorf("BaseURLFor(gitea) = %q, want %q", got, "https://git.phys.example.org")
+	}
+	if got := tenant.BaseURLFor("github"); got != "" {
+		t.Errorf("BaseURLFor(github) = %q, want empty", got)
+	}
+	var none *pb.Tenant
+	if !(&pb.Tenant{}).HasProvider("github") || none.BaseURLFor("gitea") != "" {
+		t.Error("tenant without provider must accept any provider with the server's default URL")
+	}
+}
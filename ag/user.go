@@ -7,6 +7,12 @@ func (u *User) IsOwner(userID uint64) bool {
 	return u.GetID() == userID
 }
 
+// IsGlobalAdmin returns true if the user is an admin that does not belong to a tenant,
+// and thus administers the server and all its tenants.
+func (u *User) IsGlobalAdmin() bool {
+	return u.GetIsAdmin() && u.GetTenantID() == 0
+}
+
 // GetRemoteIDFor returns the user's remote identity for the given provider.
 // If no remote identity for the given provider is found, then nil is returned.
 func (u *User) GetRemoteIDFor(provider string) *RemoteIdentity {
@@ -29,6 +35,15 @@ func (u *User) GetAccessToken(provider string) (string, error) {
 	return remoteID.GetAccessToken(), nil
 }
 
+// GetLoginFor returns the user's login on the given provider. The user's login is
+// returned if the user's remote identity for the provider has no login of its own.
+func (u *User) GetLoginFor(provider string) string {
+	if login := u.GetRemoteIDFor(provider).GetLogin(); login != "" {
+		return login
+	}
+	return u.GetLogin()
+}
+
 // SetSlipDays sets number of remaining slip days for each enrollment
 func (u *User) SetSlipDays(c *Course) {
 	for _, e := range u.Enrollments {
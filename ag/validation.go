@@ -2,7 +2,10 @@ package ag
 
 import (
 	"context"
+	"net/url"
+	"path"
 	"reflect"
+	"regexp"
 	"strings"
 	"time"
 
@@ -76,14 +79,88 @@ func (grp *Group) IsValid() bool {
 	return grp.GetName() != "" && grp.GetCourseID() > 0
 }
 
+// IsValid ensures that course ID is provided, and either the invitation ID,
+// or the group and the invited user.
+func (inv *GroupInvitation) IsValid() bool {
+	return inv.GetCourseID() > 0 &&
+		(inv.GetID() > 0 || inv.GetGroupID() > 0 && inv.GetUserID() > 0)
+}
+
+// IsValid ensures that course ID and the file's path are provided.
+func (f *AssignmentFile) IsValid() bool {
+	return f.GetCourseID() > 0 && f.GetPath() != ""
+}
+
+// IsValid ensures that course ID and group size are provided.
+func (req *GenerateGroupsRequest) IsValid() bool {
+	return req.GetCourseID() > 0 && req.GetGroupSize() > 0
+}
+
 // IsValid checks required fields of a course request
 func (c *Course) IsValid() bool {
 	return c.GetName() != "" &&
 		c.GetCode() != "" &&
-		(c.GetProvider() == "github" || c.GetProvider() == "gitlab" || c.GetProvider() == "fake") &&
+		(c.GetProvider() == "github" || c.GetProvider() == "gitlab" || c.GetProvider() == "bitbucket" || c.GetProvider() == "gitea" || c.GetProvider() == "fake") &&
 		c.GetOrganizationID() != 0 &&
 		c.GetYear() != 0 &&
-		c.GetTag() != ""
+		c.GetTag() != "" &&
+		c.GetAssistantPermission() <= Course_VIEW_ONLY &&
+		(c.GetMaxGroupSize() == 0 || c.GetMinGroupSize() <= c.GetMaxGroupSize()) &&
+		c.hasValidRepoLayout() &&
+		c.hasValidTimezone()
+}
+
+// IsValid ensures that the tenant has a name, and that a provider URL is only given for
+// a self-hosted provider, i.e., gitea or bitbucket, as an http or https URL.
+func (t *Tenant) IsValid() bool {
+	if strings.TrimSpace(t.GetName()) == "" {
+		return false
+	}
+	switch t.GetProvider() {
+	case "", "github", "gitlab", "fake":
+		return t.GetProviderURL() == ""
+	case "gitea", "bitbucket":
+		if t.GetProviderURL() == "" {
+			return true
+		}
+		u, err := url.Parse(t.GetProviderURL())
+		return err == nil && (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+	}
+	return false
+}
+
+// IsValid ensures that the user ID is provided.
+func (r *TenantUserRequest) IsValid() bool {
+	return r.GetUserID() > 0
+}
+
+// hasValidTimezone returns true if the course has no time zone or a known IANA time zone.
+func (c *Course) hasValidTimezone() bool {
+	if c.GetTimezone() == "" {
+		return true
+	}
+	_, err := time.LoadLocation(c.GetTimezone())
+	return err == nil
+}
+
+// repoNamePattern matches the characters allowed in course repository names.
+// The names are used in clone commands and paths, so they must not contain
+// slashes, spaces or other characters with a special meaning to shells.
+var repoNamePattern = regexp.MustCompile(`^[A-Za-z0-9._-]+$`)
+
+// hasValidRepoLayout returns true if the course's repository names are distinct and
+// valid, and the assignments folder is a path inside the tests repository.
+func (c *Course) hasValidRepoLayout() bool {
+	names := make(map[string]bool)
+	for _, name := range c.CourseRepoNames() {
+		if !repoNamePattern.MatchString(name) || name == "." || name == ".." || names[name] {
+			return false
+		}
+		names[name] = true
+	}
+	dir := c.GetAssignmentsDir()
+	return dir == "" ||
+		path.Clean(dir) == dir && !path.IsAbs(dir) && dir != "." && dir != ".." && !strings.HasPrefix(dir, "../")
 }
 
 // IsValid checks required fields of a user request
@@ -98,7 +175,7 @@ func (u *UserRequest) IsValid() bool {
 
 // IsValid checks required fields of an enrollment request.
 func (req *Enrollment) IsValid() bool {
-	return req.GetStatus() <= Enrollment_TEACHER &&
+	return req.GetStatus() <= Enrollment_TA &&
 		req.GetUserID() > 0 && req.GetCourseID() > 0
 }
 
@@ -107,6 +184,21 @@ func (req *CourseRequest) IsValid() bool {
 	return req.GetCourseID() > 0
 }
 
+// IsValid ensures that course ID is set and the repository action is known.
+func (req *CourseCleanupRequest) IsValid() bool {
+	return req.GetCourseID() > 0 && req.GetRepositories() <= CourseCleanupRequest_DELETE
+}
+
+// IsValid ensures that the ID of the course to clone is set and the new course is valid.
+func (req *CloneCourseRequest) IsValid() bool {
+	return req.GetCourseID() > 0 && req.GetCourse().IsValid()
+}
+
+// IsValid ensures that course ID is set and the export format is known.
+func (req *ResultsExportRequest) IsValid() bool {
+	return req.GetCourseID() > 0 && req.GetFormat() <= ResultsExportRequest_XLSX
+}
+
 // IsValid ensures that user ID is set
 func (req *EnrollmentStatusRequest) IsValid() bool {
 	return req.GetUserID() > 0
@@ -149,6 +241,39 @@ func (req *SubmissionRequest) IsValid() bool {
 		(uid > 0 && gid == 0)
 }
 
+// IsValid ensures that course and assignment IDs are provided,
+// and that either user ID or group ID is provided, but not both.
+func (req *SubmissionStatusRequest) IsValid() bool {
+	uid, gid := req.GetUserID(), req.GetGroupID()
+	return req.GetCourseID() > 0 && req.GetAssignmentID() > 0 &&
+		((uid == 0 && gid > 0) || (uid > 0 && gid == 0))
+}
+
+// IsValid ensures that course and submission IDs are provided.
+func (req *ArtifactRequest) IsValid() bool {
+	return req.GetCourseID() > 0 && req.GetSubmissionID() > 0
+}
+
+// IsValid ensures that course and submission IDs are provided.
+func (req *TestResultRequest) IsValid() bool {
+	return req.GetCourseID() > 0 && req.GetSubmissionID() > 0
+}
+
+// IsValid ensures that course and submission IDs are provided.
+func (req *TaskScoreRequest) IsValid() bool {
+	return req.GetCourseID() > 0 && req.GetSubmissionID() > 0
+}
+
+// IsValid ensures that course and user IDs are provided.
+func (req *SlipDaysRequest) IsValid() bool {
+	return req.GetCourseID() > 0 && req.GetUserID() > 0
+}
+
+// IsValid ensures that course ID, sample size and runner pool are set.
+func (req *ReplayRequest) IsValid() bool {
+	return req.GetCourseID() > 0 && req.GetSample() > 0 && req.GetRunnerPool() != ""
+}
+
 // IsValid ensures that both submission and course IDs are set
 func (req *UpdateSubmissionRequest) IsValid() bool {
 	return req.GetCourseID() > 0 && req.GetSubmissionID() > 0
@@ -175,11 +300,55 @@ func (req *AssignmentRequest) IsValid() bool {
 	return req.CourseID > 0 && req.AssignmentID > 0
 }
 
+// IsValid ensures that both course and peer review IDs are set.
+func (r *PeerReview) IsValid() bool {
+	return r.GetCourseID() > 0 && r.GetID() > 0
+}
+
+// IsValid ensures that the course ID is set, and either the comment ID,
+// or the submission ID and the path of the commented file.
+func (c *SubmissionComment) IsValid() bool {
+	return c.GetCourseID() > 0 && (c.GetID() > 0 || (c.GetSubmissionID() > 0 && c.GetPath() != ""))
+}
+
+// IsValid ensures that course and submission IDs are provided.
+func (req *SubmissionCommentRequest) IsValid() bool {
+	return req.GetCourseID() > 0 && req.GetSubmissionID() > 0
+}
+
+// IsValid ensures that course and submission IDs are provided.
+func (req *SubmissionTransitionRequest) IsValid() bool {
+	return req.GetCourseID() > 0 && req.GetSubmissionID() > 0
+}
+
+// IsValid ensures that the test job ID is set.
+func (j *TestJob) IsValid() bool {
+	return j.GetID() > 0
+}
+
+// IsValid on webhook delivery request always returns true; the delivery ID is only required for replays.
+func (req *WebhookDeliveryRequest) IsValid() bool {
+	return true
+}
+
+// IsValid ensures that course ID is set, and that the since time is valid.
+func (req *MissedPushesRequest) IsValid() bool {
+	_, err := time.Parse(TimeLayout, req.GetSince())
+	return req.GetCourseID() > 0 && err == nil
+}
+
+// IsValid ensures that course ID is set.
+func (req *SubmissionStreamRequest) IsValid() bool {
+	return req.GetCourseID() > 0
+}
+
 // IsValid ensures that provider string is one of implemented providers
 func (req *Provider) IsValid() bool {
 	provider := req.GetProvider()
 	return provider == "github" ||
 		provider == "gitlab" ||
+		provider == "bitbucket" ||
+		provider == "gitea" ||
 		provider == "fake"
 }
 
@@ -242,3 +411,116 @@ func (c *GradingCriterion) IsValid() bool {
 func (r *CourseUserRequest) IsValid() bool {
 	return r.CourseCode != "" && r.UserLogin != "" && r.CourseYear > 2019
 }
+
+// IsValid ensures that an announcement belongs to a course and has a title.
+func (a *Announcement) IsValid() bool {
+	return a.GetCourseID() > 0 && a.GetTitle() != ""
+}
+
+// IsValid ensures that course and announcement IDs are set.
+func (req *AnnouncementRequest) IsValid() bool {
+	return req.GetCourseID() > 0 && req.GetAnnouncementID() > 0
+}
+
+// IsValid ensures that a question belongs to an assignment and has a title.
+func (q *Question) IsValid() bool {
+	return q.GetAssignmentID() > 0 && q.GetTitle() != ""
+}
+
+// IsValid ensures that course ID is provided and the question is valid.
+func (req *QuestionRequest) IsValid() bool {
+	return req.GetCourseID() > 0 && req.GetQuestion().IsValid()
+}
+
+// IsValid ensures that an answer belongs to a question.
+func (a *Answer) IsValid() bool {
+	return a.GetQuestionID() > 0
+}
+
+// IsValid ensures that course ID is provided and the answer is valid.
+func (req *AnswerRequest) IsValid() bool {
+	return req.GetCourseID() > 0 && req.GetAnswer().IsValid()
+}
+
+// IsValid ensures that course ID is provided, and that the grading scale has
+// uniquely named grades with scores at most 100, including a grade for a zero score.
+func (gs *GradingScale) IsValid() bool {
+	if gs.GetCourseID() == 0 || len(gs.GetThresholds()) == 0 {
+		return false
+	}
+	grades := make(map[string]bool)
+	hasZero := false
+	for _, t := range gs.GetThresholds() {
+		if t.GetGrade() == "" || grades[t.GetGrade()] || t.GetMinScore() > 100 {
+			return false
+		}
+		grades[t.GetGrade()] = true
+		hasZero = hasZero || t.GetMinScore() == 0
+	}
+	return hasZero
+}
+
+// IsValid ensures that the score curve belongs to an assignment in a course, and that linear
+// curves preserve the order of scores and percentile curves map to a score range within 0 to 100.
+func (c *ScoreCurve) IsValid() bool {
+	if c.GetCourseID() == 0 || c.GetAssignmentID() == 0 {
+		return false
+	}
+	switch c.GetMethod() {
+	case ScoreCurve_NONE:
+		return true
+	case ScoreCurve_LINEAR:
+		return c.GetSlope() > 0
+	case ScoreCurve_PERCENTILE:
+		return c.GetMinScore() <= c.GetMaxScore() && c.GetMaxScore() <= 100
+	}
+	return false
+}
+
+// IsValid ensures that an appeal belongs to a course.
+func (a *Appeal) IsValid() bool {
+	return a.GetCourseID() > 0
+}
+
+// IsValid ensures that course ID is provided.
+func (req *AppealRequest) IsValid() bool {
+	return req.GetCourseID() > 0
+}
+
+// IsValid ensures that an extension belongs to an assignment in a course,
+// is granted to either a student or a group, and has a deadline.
+func (e *Extension) IsValid() bool {
+	return e.GetCourseID() > 0 && e.GetAssignmentID() > 0 &&
+		(e.GetUserID() > 0) != (e.GetGroupID() > 0) &&
+		e.GetDeadline() != ""
+}
+
+// IsValid ensures that course ID is provided.
+func (req *ExtensionRequest) IsValid() bool {
+	return req.GetCourseID() > 0
+}
+
+// IsValid ensures that course ID and assignment ID are provided.
+func (req *PlagiarismRequest) IsValid() bool {
+	return req.GetCourseID() > 0 && req.GetAssignmentID() > 0
+}
+
+// IsValid ensures that the token is named and valid for at least one course.
+func (req *AccessTokenRequest) IsValid() bool {
+	return req.GetName() != "" && len(req.GetCourseIDs()) > 0
+}
+
+// IsValid ensures that the access token ID is set.
+func (t *AccessToken) IsValid() bool {
+	return t.GetID() > 0
+}
+
+// IsValid ensures that the course ID and the CSV rows are set.
+func (req *ImportEnrollmentsRequest) IsValid() bool {
+	return req.GetCourseID() > 0 && strings.TrimSpace(req.GetCsv()) != ""
+}
+
+// IsValid ensures that the provider is set.
+func (req *UnlinkIdentityRequest) IsValid() bool {
+	return req.GetProvider() != ""
+}
@@ -0,0 +1,71 @@
+package artifacts
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+// FileStore stores artifacts as files in a directory.
+type FileStore struct {
+	root string
+}
+
+// NewFileStore returns a store keeping artifacts in the given directory, which is created if needed.
+func NewFileStore(root string) (*FileStore, error) {
+	if err := os.MkdirAll(root, 0o700); err != nil {
+		return nil, err
+	}
+	return &FileStore{root: root}, nil
+}
+
+// Put implements the Store interface. The content is written to a temporary file
+// that replaces the previous content, such that readers never see partial content.
+func (f *FileStore) Put(_ context.Context, key string, content []byte) error {
+	if err := validKey(key); err != nil {
+		return err
+	}
+	file := filepath.Join(f.root, filepath.FromSlash(key))
+	if err := os.MkdirAll(filepath.Dir(file), 0o700); err != nil {
+		return err
+	}
+	tmp, err := ioutil.TempFile(filepath.Dir(file), ".artifact-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(content); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), file)
+}
+
+// Get implements the Store interface.
+func (f *FileStore) Get(_ context.Context, key string) (io.ReadCloser, error) {
+	if err := validKey(key); err != nil {
+		return nil, err
+	}
+	file, err := os.Open(filepath.Join(f.root, filepath.FromSlash(key)))
+	if os.IsNotExist(err) {
+		return nil, ErrNotFound
+	}
+	return file, err
+}
+
+// Delete implements the Store interface.
+func (f *FileStore) Delete(_ context.Context, key string) error {
+	if err := validKey(key); err != nil {
+		return err
+	}
+	err := os.Remove(filepath.Join(f.root, filepath.FromSlash(key)))
+	if os.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,203 @@
+package artifacts
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+)
+
+// S3Options holds the settings for an S3-compatible object store.
+type S3Options struct {
+	// Endpoint is the object store's URL, e.g., https://s3.eu-north-1.amazonaws.com.
+	Endpoint string
+	// Bucket is the bucket storing the artifacts; it must exist.
+	Bucket string
+	// Region is the bucket's region; defaults to us-east-1, which MinIO also uses by default.
+	Region string
+	// AccessKey and SecretKey are the credentials used to sign requests.
+	AccessKey string
+	SecretKey string
+}
+
+// S3Store stores artifacts as objects in a bucket of an S3-compatible object store,
+// such as Amazon S3 or MinIO. Requests are signed with AWS Signature Version 4,
+// and objects are addressed by path, e.g., https://s3.example.com/bucket/key.
+type S3Store struct {
+	opts   S3Options
+	client *http.Client
+	// now returns the current time used to sign requests; replaced in tests.
+	now func() time.Time
+}
+
+// NewS3Store returns a store keeping artifacts in the given bucket.
+func NewS3Store(opts S3Options) (*S3Store, error) {
+	if opts.Endpoint == "" || opts.Bucket == "" {
+		return nil, errors.New("object store endpoint and bucket must be set")
+	}
+	if opts.AccessKey == "" || opts.SecretKey == "" {
+		return nil, errors.New("object store access key and secret key must be set")
+	}
+	if opts.Region == "" {
+		opts.Region = "us-east-1"
+	}
+	opts.Endpoint = strings.TrimSuffix(opts.Endpoint, "/")
+	return &S3Store{
+		opts:   opts,
+		client: &http.Client{Timeout: time.Minute},
+		now:    time.Now,
+	}, nil
+}
+
+// Put implements the Store interface.
+func (s *S3Store) Put(ctx context.Context, key string, content []byte) error {
+	resp, err := s.do(ctx, http.MethodPut, key, content)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return s3Error(resp)
+	}
+	return nil
+}
+
+// Get implements the Store interface.
+func (s *S3Store) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	resp, err := s.do(ctx, http.MethodGet, key, nil)
+	if err != nil {
+		return nil, err
+	}
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return resp.Body, nil
+	case http.StatusNotFound:
+		resp.Body.Close()
+		return nil, ErrNotFound
+	}
+	defer resp.Body.Close()
+	return nil, s3Error(resp)
+}
+
+// Delete implements the Store interface.
+func (s *S3Store) Delete(ctx context.Context, key string) error {
+	resp, err := s.do(ctx, http.MethodDelete, key, nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	switch resp.StatusCode {
+	case http.StatusOK, http.StatusNoContent, http.StatusNotFound:
+		return nil
+	}
+	return s3Error(resp)
+}
+
+// do sends a signed request for the object with the given key.
+func (s *S3Store) do(ctx context.Context, method, key string, content []byte) (*http.Response, error) {
+	if err := validKey(key); err != nil {
+		return nil, err
+	}
+	url := s.opts.Endpoint + "/" + uriEncode(s.opts.Bucket+"/"+key)
+	req, err := http.NewRequestWithContext(ctx, method, url, bytes.NewReader(content))
+	if err != nil {
+		return nil, err
+	}
+	if content != nil {
+		req.ContentLength = int64(len(content))
+	}
+	payloadHash := sha256.Sum256(content)
+	req.Header.Set("x-amz-content-sha256", hex.EncodeToString(payloadHash[:]))
+	req.Header.Set("x-amz-date", s.now().UTC().Format(amzDateLayout))
+	signV4(req, s.opts.AccessKey, s.opts.SecretKey, s.opts.Region)
+	return s.client.Do(req)
+}
+
+// s3Error returns an error with the status and the error message returned by the object store.
+func s3Error(resp *http.Response) error {
+	body, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 1024))
+	return fmt.Errorf("object store request failed: %s: %s", resp.Status, strings.TrimSpace(string(body)))
+}
+
+const amzDateLayout = "20060102T150405Z"
+
+// signV4 adds an Authorization header signing the request and all its headers with
+// AWS Signature Version 4. The request's x-amz-date and x-amz-content-sha256 headers must be set.
+// See https://docs.aws.amazon.com/AmazonS3/latest/API/sig-v4-header-based-auth.html.
+func signV4(req *http.Request, accessKey, secretKey, region string) {
+	amzDate := req.Header.Get("x-amz-date")
+	date := amzDate[:8]
+	scope := date + "/" + region + "/s3/aws4_request"
+
+	headers := map[string]string{"host": req.URL.Host}
+	for name, values := range req.Header {
+		headers[strings.ToLower(name)] = strings.TrimSpace(strings.Join(values, ","))
+	}
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + headers[name] + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		uriEncode(req.URL.Path),
+		req.URL.RawQuery,
+		canonicalHeaders.String(),
+		signedHeaders,
+		req.Header.Get("x-amz-content-sha256"),
+	}, "\n")
+	hashedRequest := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := strings.Join([]string{
+		"AWS4-HMAC-SHA256",
+		amzDate,
+		scope,
+		hex.EncodeToString(hashedRequest[:]),
+	}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+secretKey), date)
+	key = hmacSHA256(key, region)
+	key = hmacSHA256(key, "s3")
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		accessKey, scope, signedHeaders, signature))
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
+
+// uriEncode percent-encodes every byte of the path except slashes and the unreserved
+// characters, as required by AWS Signature Version 4.
+func uriEncode(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case 'A' <= c && c <= 'Z', 'a' <= c && c <= 'z', '0' <= c && c <= '9',
+			c == '-', c == '_', c == '.', c == '~', c == '/':
+			b.WriteByte(c)
+		default:
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+	return b.String()
+}
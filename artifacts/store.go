@@ -0,0 +1,70 @@
+// Package artifacts stores the artifacts produced by test runs, such as full build logs
+// and coverage reports, as blobs on the filesystem or in an S3-compatible object store.
+package artifacts
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"path"
+	"strings"
+)
+
+// ErrNotFound is returned when the requested artifact is not in the store.
+var ErrNotFound = errors.New("artifact not found")
+
+// Store stores artifacts as blobs identified by keys, e.g., "submissions/12/coverage.html".
+type Store interface {
+	// Put stores the content under the given key, replacing any previous content.
+	Put(ctx context.Context, key string, content []byte) error
+	// Get returns the content stored under the given key, or ErrNotFound.
+	// The caller must close the returned reader.
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	// Delete removes the content stored under the given key, if any.
+	Delete(ctx context.Context, key string) error
+}
+
+// Open returns the store at the given location: either a directory, or the URL of
+// a bucket in an S3-compatible object store, e.g., https://s3.eu-north-1.amazonaws.com/artifacts.
+// For object stores, the credentials are the given access key and secret key.
+func Open(location, region, accessKey, secretKey string) (Store, error) {
+	if strings.HasPrefix(location, "http://") || strings.HasPrefix(location, "https://") {
+		u, err := url.Parse(location)
+		if err != nil {
+			return nil, fmt.Errorf("invalid artifact store URL: %w", err)
+		}
+		bucket := strings.Trim(u.Path, "/")
+		if bucket == "" || strings.Contains(bucket, "/") {
+			return nil, fmt.Errorf("invalid artifact store URL %q: must name a single bucket, e.g., https://s3.example.com/artifacts", location)
+		}
+		return NewS3Store(S3Options{
+			Endpoint:  u.Scheme + "://" + u.Host,
+			Bucket:    bucket,
+			Region:    region,
+			AccessKey: accessKey,
+			SecretKey: secretKey,
+		})
+	}
+	return NewFileStore(location)
+}
+
+// Key returns the key of the named artifact of the given submission.
+func Key(submissionID uint64, name string) string {
+	return fmt.Sprintf("submissions/%d/%s", submissionID, name)
+}
+
+// ValidName returns true if the artifact name is a clean relative path
+// that does not refer to a parent directory, e.g., "coverage/index.html".
+func ValidName(name string) bool {
+	return name != "" && name != "." && !strings.HasPrefix(name, "/") &&
+		path.Clean(name) == name && name != ".." && !strings.HasPrefix(name, "../")
+}
+
+func validKey(key string) error {
+	if !ValidName(key) {
+		return fmt.Errorf("invalid artifact key %q", key)
+	}
+	return nil
+}
@@ -0,0 +1,258 @@
+package assignments
+
+import (
+	"encoding/json"
+	"fmt"
+	"path"
+	"reflect"
+	"strings"
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"gopkg.in/yaml.v2"
+)
+
+// FileError is a problem with a field of an assignment file.
+// Field is empty if the problem concerns the whole file, e.g., if the file is malformed.
+// A warning does not prevent updating the assignments, but may not be intended.
+type FileError struct {
+	File    string
+	Field   string
+	Reason  string
+	Warning bool
+}
+
+func (e *FileError) Error() string {
+	if e.Field == "" {
+		return fmt.Sprintf("%s: %s", e.File, e.Reason)
+	}
+	return fmt.Sprintf("%s: %s: %s", e.File, e.Field, e.Reason)
+}
+
+// FileErrors is a list of problems with assignment files.
+type FileErrors []*FileError
+
+func (e FileErrors) Error() string {
+	reasons := make([]string, len(e))
+	for i, err := range e {
+		reasons[i] = err.Error()
+	}
+	return strings.Join(reasons, "; ")
+}
+
+// ToProto returns the problems as a message for the frontend.
+func (e FileErrors) ToProto() *pb.AssignmentFileErrors {
+	return &pb.AssignmentFileErrors{Errors: e.toProto()}
+}
+
+func (e FileErrors) toProto() []*pb.AssignmentFileError {
+	errs := make([]*pb.AssignmentFileError, len(e))
+	for i, err := range e {
+		errs[i] = &pb.AssignmentFileError{File: err.File, Field: err.Field, Reason: err.Reason, Warning: err.Warning}
+	}
+	return errs
+}
+
+// isAssignmentFile returns true if the named file may describe an assignment.
+// A README.md file only describes an assignment if it has YAML front-matter.
+func isAssignmentFile(filename string) bool {
+	switch filename {
+	case target, targetYaml, targetJSON, readmeFile:
+		return true
+	}
+	return false
+}
+
+// ValidateAssignmentFile returns the problems with the given assignment file, such that teachers
+// can check the file before pushing it to the tests repository. The file is given by its path
+// in the course's assignments folder, e.g., lab1/assignment.yml. Times without an offset from
+// UTC are in the given time zone of the course.
+func ValidateAssignmentFile(file string, contents []byte, loc *time.Location) FileErrors {
+	if !isAssignmentFile(path.Base(file)) {
+		return FileErrors{{File: file, Reason: fmt.Sprintf("not an assignment file; must be one of %s, %s, %s or %s with front-matter",
+			target, targetYaml, targetJSON, readmeFile)}}
+	}
+	data, errs := decodeAssignmentFile(file, contents)
+	if len(errs) > 0 {
+		return errs
+	}
+	if data == nil {
+		return FileErrors{{File: file, Reason: "no YAML front-matter describing the assignment"}}
+	}
+	return data.validate(file, loc)
+}
+
+// decodeAssignmentFile returns the assignment described by the given assignment file, which is an
+// assignment.yml, assignment.yaml or assignment.json file, or a README.md file with YAML front-matter.
+// The fields are checked against the assignment file schema, while fields not in the schema are ignored.
+// It returns nil if the file is a README.md file without front-matter.
+func decodeAssignmentFile(file string, contents []byte) (*assignmentData, FileErrors) {
+	switch path.Base(file) {
+	case readmeFile:
+		var ok bool
+		if contents, ok = frontMatter(contents); !ok {
+			return nil, nil
+		}
+	case targetJSON:
+		var raw interface{}
+		if err := json.Unmarshal(contents, &raw); err != nil {
+			return nil, FileErrors{{File: file, Reason: fmt.Sprintf("malformed JSON: %v", err)}}
+		}
+		// JSON is decoded as YAML below, to use the same schema for all assignment files
+		var err error
+		if contents, err = yaml.Marshal(raw); err != nil {
+			return nil, FileErrors{{File: file, Reason: fmt.Sprintf("malformed JSON: %v", err)}}
+		}
+	}
+	var raw interface{}
+	if err := yaml.Unmarshal(contents, &raw); err != nil {
+		return nil, FileErrors{{File: file, Reason: fmt.Sprintf("malformed YAML: %v", err)}}
+	}
+	if raw == nil {
+		// an empty file describes an assignment with the default settings
+		raw = map[interface{}]interface{}{}
+	}
+	if errs := checkSchema(file, "", raw, reflect.TypeOf(assignmentData{})); len(errs) > 0 {
+		return nil, errs
+	}
+	var data assignmentData
+	if err := yaml.Unmarshal(contents, &data); err != nil {
+		return nil, FileErrors{{File: file, Reason: fmt.Sprintf("malformed YAML: %v", err)}}
+	}
+	return &data, nil
+}
+
+// checkSchema returns the problems with the given value of the named field, decoded from YAML,
+// compared with the field's type in assignmentData.
+func checkSchema(file, field string, value interface{}, typ reflect.Type) FileErrors {
+	fail := func(reason string) FileErrors {
+		return FileErrors{{File: file, Field: field, Reason: reason}}
+	}
+	switch typ.Kind() {
+	case reflect.Struct:
+		fields, ok := value.(map[interface{}]interface{})
+		if !ok {
+			return fail("must be a mapping of field names to values")
+		}
+		var errs FileErrors
+		for i := 0; i < typ.NumField(); i++ {
+			name := typ.Field(i).Tag.Get("yaml")
+			v, ok := fields[name]
+			if !ok || v == nil {
+				continue
+			}
+			if field != "" {
+				name = field + "." + name
+			}
+			errs = append(errs, checkSchema(file, name, v, typ.Field(i).Type)...)
+		}
+		return errs
+	case reflect.Slice:
+		list, ok := value.([]interface{})
+		if !ok {
+			return fail("must be a list")
+		}
+		var errs FileErrors
+		for i, v := range list {
+			errs = append(errs, checkSchema(file, fmt.Sprintf("%s[%d]", field, i+1), v, typ.Elem())...)
+		}
+		return errs
+	case reflect.Bool:
+		if _, ok := value.(bool); !ok {
+			return fail("must be true or false")
+		}
+	case reflect.Uint:
+		switch v := value.(type) {
+		case int:
+			if v < 0 {
+				return fail("must not be negative")
+			}
+		case uint64:
+		default:
+			return fail("must be a whole number")
+		}
+	case reflect.Float64:
+		switch value.(type) {
+		case int, uint64, float64:
+		default:
+			return fail("must be a number")
+		}
+	case reflect.String:
+		switch value.(type) {
+		case map[interface{}]interface{}, []interface{}:
+			return fail("must be text")
+		}
+	}
+	return nil
+}
+
+// frontMatter returns the YAML front-matter of the given markdown, that is, the lines
+// between a first line '---' and the next '---' line, and false if there is none.
+func frontMatter(markdown []byte) ([]byte, bool) {
+	lines := strings.Split(strings.ReplaceAll(string(markdown), "\r\n", "\n"), "\n")
+	if len(lines) == 0 || strings.TrimSpace(lines[0]) != "---" {
+		return nil, false
+	}
+	for i := 1; i < len(lines); i++ {
+		if strings.TrimSpace(lines[i]) == "---" {
+			return []byte(strings.Join(lines[1:i], "\n")), true
+		}
+	}
+	return nil, false
+}
+
+// validate returns the problems with the values of the assignment's fields.
+// Times without an offset from UTC are in the given time zone.
+func (a *assignmentData) validate(file string, loc *time.Location) FileErrors {
+	var errs FileErrors
+	fail := func(field, format string, args ...interface{}) {
+		errs = append(errs, &FileError{File: file, Field: field, Reason: fmt.Sprintf(format, args...)})
+	}
+	if a.ScoreLimit > 100 {
+		fail("scorelimit", "%d must be at most 100", a.ScoreLimit)
+	}
+	if a.MinCoverage > 100 {
+		fail("mincoverage", "%d must be at most 100", a.MinCoverage)
+	}
+	if a.TestScoreWeight > 100 {
+		fail("testscoreweight", "%d must be at most 100", a.TestScoreWeight)
+	}
+	if a.LatePolicy.Penalty > 100 {
+		fail("latepolicy.penalty", "%d must be at most 100", a.LatePolicy.Penalty)
+	}
+	if a.Limits.CPUs < 0 {
+		fail("limits.cpus", "%v must not be negative", a.Limits.CPUs)
+	}
+	names := make(map[string]bool)
+	for i, t := range a.Tasks {
+		field := fmt.Sprintf("tasks[%d].name", i+1)
+		switch {
+		case t.Name == "":
+			fail(field, "is required")
+		case names[t.Name]:
+			fail(field, "duplicate task %s", t.Name)
+		}
+		names[t.Name] = true
+	}
+	deadline, deadlineErr := pb.ParseDeadline(a.Deadline, loc)
+	switch {
+	case a.Deadline != "" && deadlineErr != nil:
+		fail("deadline", "invalid time %q", a.Deadline)
+	case a.Deadline == "" && a.Exam:
+		fail("deadline", "is required for exams")
+	}
+	if a.Release != "" {
+		if _, err := pb.ParseDeadline(a.Release, loc); err != nil {
+			fail("release", "invalid time %q", a.Release)
+		}
+	}
+	if a.Exam {
+		start, err := pb.ParseDeadline(a.ExamStart, loc)
+		if err != nil {
+			fail("examstart", "invalid time %q", a.ExamStart)
+		} else if deadlineErr == nil && !start.Before(deadline) {
+			fail("examstart", "%q must be before the deadline %q", a.ExamStart, a.Deadline)
+		}
+	}
+	return errs
+}
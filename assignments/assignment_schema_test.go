@@ -0,0 +1,154 @@
+package assignments
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/google/go-cmp/cmp"
+)
+
+const (
+	jsonAssignment = `{
+	"assignmentid": 1,
+	"deadline": "27-08-2017 12:00",
+	"autoapprove": true,
+	"scorelimit": 90,
+	"latepolicy": {"penalty": 10}
+}`
+	readmeAssignment = `---
+assignmentid: 2
+deadline: "27-08-2018 12:00"
+isgrouplab: true
+---
+# Lab 2
+
+Nested loops.
+`
+	readmeWithoutFrontMatter = `# Lab 3
+
+---
+
+Not front-matter.
+`
+)
+
+func TestParseAssignmentFileFormats(t *testing.T) {
+	testsDir := t.TempDir()
+	for file, contents := range map[string]string{
+		"lab1/assignment.json": jsonAssignment,
+		"lab2/README.md":       readmeAssignment,
+		"lab3/README.md":       readmeWithoutFrontMatter,
+		"lab3/assignment.yml":  "assignmentid: 3\ndeadline: \"27-08-2019 12:00\"\n",
+		// the tests repository's own README.md does not describe an assignment
+		"README.md": "---\nassignmentid: 4\n---\n",
+	} {
+		name := filepath.Join(testsDir, filepath.FromSlash(file))
+		if err := os.MkdirAll(filepath.Dir(name), 0o700); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(name, []byte(contents), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	assignments, _, err := parseAssignments(testsDir, &pb.Course{ID: 1, Timezone: "UTC"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	type summary struct {
+		Name       string
+		Order      uint32
+		Deadline   string
+		ScoreLimit uint32
+		Penalty    uint32
+		GroupLab   bool
+	}
+	var got []summary
+	for _, a := range assignments {
+		got = append(got, summary{a.GetName(), a.GetOrder(), a.GetDeadline(), a.GetScoreLimit(), a.GetLatePenalty(), a.GetIsGroupLab()})
+	}
+	want := []summary{
+		{"lab1", 1, "2017-08-27T12:00:00", 90, 10, false},
+		{"lab2", 2, "2018-08-27T12:00:00", defaultAutoApproveScoreLimit, 0, true},
+		{"lab3", 3, "2019-08-27T12:00:00", defaultAutoApproveScoreLimit, 0, false},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("parseAssignments() mismatch (-want +got):\n%s", diff)
+	}
+
+	// an assignment can only be described by one file
+	if err := ioutil.WriteFile(filepath.Join(testsDir, "lab1", target), []byte("assignmentid: 1\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := parseAssignments(testsDir, &pb.Course{ID: 1}); err == nil {
+		t.Error("parseAssignments() with assignment.json and assignment.yml for lab1 succeeded, want error")
+	}
+}
+
+func TestValidateAssignmentFile(t *testing.T) {
+	tests := []struct {
+		name     string
+		file     string
+		contents string
+		want     FileErrors
+	}{
+		{"valid yaml", "lab1/assignment.yml", "assignmentid: 1\nautoapprove: true\n", nil},
+		{"valid json", "lab1/assignment.json", jsonAssignment, nil},
+		{"valid front-matter", "lab2/README.md", readmeAssignment, nil},
+		{"unknown fields are ignored", "lab1/assignment.yml", "assignmentid: 1\nsubject: Go\n", nil},
+		{
+			"not an assignment file", "lab1/criteria.json", "[]",
+			FileErrors{{File: "lab1/criteria.json", Reason: "not an assignment file; must be one of assignment.yml, assignment.yaml, assignment.json or README.md with front-matter"}},
+		},
+		{
+			"no front-matter", "lab3/README.md", readmeWithoutFrontMatter,
+			FileErrors{{File: "lab3/README.md", Reason: "no YAML front-matter describing the assignment"}},
+		},
+		{
+			"wrong types", "lab1/assignment.yml",
+			"assignmentid: first\nautoapprove: yes please\nlimits:\n  cpus: many\ntasks:\n  - name: [a, b]\nlatepolicy: 10\n",
+			FileErrors{
+				{File: "lab1/assignment.yml", Field: "assignmentid", Reason: "must be a whole number"},
+				{File: "lab1/assignment.yml", Field: "autoapprove", Reason: "must be true or false"},
+				{File: "lab1/assignment.yml", Field: "latepolicy", Reason: "must be a mapping of field names to values"},
+				{File: "lab1/assignment.yml", Field: "limits.cpus", Reason: "must be a number"},
+				{File: "lab1/assignment.yml", Field: "tasks[1].name", Reason: "must be text"},
+			},
+		},
+		{
+			"invalid values", "lab1/assignment.json",
+			`{"scorelimit": 120, "mincoverage": 101, "latepolicy": {"penalty": 150}, "release": "soon", "tasks": [{"name": "fib"}, {"name": "fib"}, {}]}`,
+			FileErrors{
+				{File: "lab1/assignment.json", Field: "scorelimit", Reason: "120 must be at most 100"},
+				{File: "lab1/assignment.json", Field: "mincoverage", Reason: "101 must be at most 100"},
+				{File: "lab1/assignment.json", Field: "latepolicy.penalty", Reason: "150 must be at most 100"},
+				{File: "lab1/assignment.json", Field: "tasks[2].name", Reason: "duplicate task fib"},
+				{File: "lab1/assignment.json", Field: "tasks[3].name", Reason: "is required"},
+				{File: "lab1/assignment.json", Field: "release", Reason: `invalid time "soon"`},
+			},
+		},
+		{"negative number", "lab1/assignment.yml", "reviewers: -1\n", FileErrors{{File: "lab1/assignment.yml", Field: "reviewers", Reason: "must not be negative"}}},
+	}
+	for _, test := range tests {
+		got := ValidateAssignmentFile(test.file, []byte(test.contents), time.UTC)
+		if diff := cmp.Diff(test.want, got); diff != "" {
+			t.Errorf("%s: ValidateAssignmentFile() mismatch (-want +got):\n%s", test.name, diff)
+		}
+	}
+
+	// malformed files are reported without a field
+	for file, contents := range map[string]string{
+		"lab1/assignment.json": `{"assignmentid": 1,}`,
+		"lab1/assignment.yml":  "assignmentid: [1\n",
+		"lab2/README.md":       "---\nassignmentid: [1\n---\n",
+	} {
+		got := ValidateAssignmentFile(file, []byte(contents), time.UTC)
+		if len(got) != 1 || got[0].File != file || got[0].Field != "" {
+			t.Errorf("ValidateAssignmentFile(%s) = %v, want one error for the whole file", file, got)
+		}
+	}
+}
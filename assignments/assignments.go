@@ -5,8 +5,10 @@ import (
 	"fmt"
 	"io/ioutil"
 	"os"
+	"os/exec"
 	"path/filepath"
 	"strings"
+	"time"
 
 	pb "github.com/autograde/quickfeed/ag"
 	"github.com/autograde/quickfeed/ci"
@@ -20,15 +22,15 @@ import (
 
 // UpdateFromTestsRepo updates the database record for the course assignments.
 func UpdateFromTestsRepo(logger *zap.SugaredLogger, db database.Database, course *pb.Course) {
-	logger.Debugf("Updating %s from '%s' repository", course.GetCode(), pb.TestsRepo)
-	s, err := scm.NewSCMClient(logger, course.GetProvider(), course.GetAccessToken())
+	logger.Debugf("Updating %s from '%s' repository", course.GetCode(), course.TestsRepoName())
+	s, err := scm.NewSCMClientForURL(logger, course.GetProvider(), providerURL(db, course), course.GetAccessToken())
 	if err != nil {
 		logger.Errorf("Failed to create SCM Client: %v", err)
 		return
 	}
 	assignments, dockerfile, err := fetchAssignments(context.Background(), logger, s, course)
 	if err != nil {
-		logger.Errorf("Failed to fetch assignments from '%s' repository: %v", pb.TestsRepo, err)
+		logger.Errorf("Failed to fetch assignments from '%s' repository: %v", course.TestsRepoName(), err)
 		return
 	}
 	for _, assignment := range assignments {
@@ -36,11 +38,16 @@ func UpdateFromTestsRepo(logger *zap.SugaredLogger, db database.Database, course
 	}
 	if dockerfile != "" && dockerfile != course.Dockerfile {
 		course.Dockerfile = dockerfile
-		if err := db.UpdateCourse(course); err != nil {
+		if err := db.UpdateCourseDockerfile(course.GetID(), dockerfile); err != nil {
 			logger.Debugf("Failed to update Dockerfile for course %s: %s", course.GetCode(), err)
 			return
 		}
 	}
+	existing, err := db.GetAssignmentsByCourse(course.GetID(), false)
+	if err != nil {
+		logger.Errorf("Failed to get existing assignments for course %s: %v", course.GetCode(), err)
+		return
+	}
 	if err = db.UpdateAssignments(assignments); err != nil {
 		for _, assignment := range assignments {
 			logger.Debugf("Failed to update database for: %v", assignment)
@@ -48,13 +55,71 @@ func UpdateFromTestsRepo(logger *zap.SugaredLogger, db database.Database, course
 		logger.Errorf("Failed to update assignments in database: %v", err)
 		return
 	}
-	logger.Debugf("Assignments for %s successfully updated from '%s' repo", course.GetCode(), pb.TestsRepo)
+	for _, event := range courseEvents(course.Location(), existing, assignments) {
+		if err := db.CreateCourseEvent(event); err != nil {
+			logger.Errorf("Failed to record course event %q for course %s: %v", event.GetTitle(), course.GetCode(), err)
+		}
+	}
+	logger.Debugf("Assignments for %s successfully updated from '%s' repo", course.GetCode(), course.TestsRepoName())
+}
+
+// providerURL returns the base URL of the SCM provider's server for the course's tenant,
+// or the empty string if the server's default is used.
+func providerURL(db database.Database, course *pb.Course) string {
+	if course.GetTenantID() == 0 {
+		return ""
+	}
+	tenant, err := db.GetTenant(course.GetTenantID())
+	if err != nil {
+		return ""
+	}
+	return tenant.BaseURLFor(course.GetProvider())
+}
+
+// courseEvents returns events for assignments that are new or whose deadline
+// has changed, compared to the existing assignments. Assignments are matched by
+// their order, since this is how assignments are identified in the database.
+// The deadlines are described in the given time zone of the course.
+func courseEvents(loc *time.Location, existing, updated []*pb.Assignment) []*pb.CourseEvent {
+	previous := make(map[uint32]*pb.Assignment)
+	for _, assignment := range existing {
+		previous[assignment.GetOrder()] = assignment
+	}
+	var events []*pb.CourseEvent
+	for _, assignment := range updated {
+		old, ok := previous[assignment.GetOrder()]
+		if assignment.GetRelease() != "" && !old.GetReleased() {
+			// the students are notified when the assignment is released
+			continue
+		}
+		switch {
+		case !ok:
+			events = append(events, &pb.CourseEvent{
+				CourseID:     assignment.GetCourseID(),
+				AssignmentID: assignment.GetID(),
+				Type:         pb.CourseEvent_ASSIGNMENT_RELEASED,
+				Title:        fmt.Sprintf("Assignment %s released", assignment.GetName()),
+				Description:  fmt.Sprintf("Assignment %s has been released with deadline %s.", assignment.GetName(), assignment.LocalDeadlineIn(loc)),
+			})
+		case old.GetDeadline() != assignment.GetDeadline():
+			events = append(events, &pb.CourseEvent{
+				CourseID:     assignment.GetCourseID(),
+				AssignmentID: assignment.GetID(),
+				Type:         pb.CourseEvent_DEADLINE_CHANGED,
+				Title:        fmt.Sprintf("Deadline changed for assignment %s", assignment.GetName()),
+				Description:  fmt.Sprintf("The deadline for assignment %s has changed from %s to %s.", assignment.GetName(), old.LocalDeadlineIn(loc), assignment.LocalDeadlineIn(loc)),
+			})
+		}
+	}
+	return events
 }
 
 // fetchAssignments returns a list of assignments for the given course, by
 // cloning the 'tests' repo for the given course and extracting the assignments
 // from the 'assignment.yml' files, one for each assignment. If there is a Dockerfile
-// in 'tests/script' will also return its contents.
+// in 'tests/script' will also return its contents. The course may give its tests
+// repository another name, and keep the assignment folders, including 'script',
+// in a folder of the tests repository.
 //
 // Note: This will typically be called on a push event to the 'tests' repo,
 // which should happen infrequently. It may also be called manually by a
@@ -67,34 +132,18 @@ func fetchAssignments(c context.Context, logger *zap.SugaredLogger, sc scm.SCM,
 	ctx, cancel := context.WithTimeout(c, pb.MaxWait)
 	defer cancel()
 
-	cloneURL := sc.CreateCloneURL(&scm.URLPathOptions{
-		Organization: course.OrganizationPath,
-		Repository:   pb.TestsRepo,
-	})
-	cloneDir, err := ioutil.TempDir("", pb.TestsRepo)
+	cloneDir, err := ioutil.TempDir("", course.TestsRepoName())
 	if err != nil {
 		return nil, "", err
 	}
 	defer os.RemoveAll(cloneDir)
 
-	// clone the tests repository to cloneDir
-	job := &ci.Job{
-		Commands: []string{
-			"cd " + cloneDir,
-			"git clone " + cloneURL,
-		},
-	}
-	logger.Debugf("cd %v", cloneDir)
-	logger.Debugf("git clone %v", cloneURL)
-
-	runner := ci.Local{}
-	_, err = runner.Run(ctx, job)
+	assignmentsDir, err := cloneTestsRepo(ctx, logger, sc, course, cloneDir)
 	if err != nil {
 		return nil, "", err
 	}
-
-	// parse assignments found in the cloned tests directory
-	assignments, dockerfile, err := parseAssignments(cloneDir, course.ID)
+	// parse assignments found in the course's assignments folder of the cloned tests directory
+	assignments, dockerfile, err := parseAssignments(assignmentsDir, course)
 	if err != nil {
 		return nil, "", err
 	}
@@ -102,13 +151,16 @@ func fetchAssignments(c context.Context, logger *zap.SugaredLogger, sc scm.SCM,
 	// if a Dockerfile added/updated, build docker image locally
 	// tag the image with the course code
 	if dockerfile != "" && dockerfile != course.Dockerfile {
-		buildDir := filepath.Join(cloneDir, pb.TestsRepo, scriptFolder)
+		runner := ci.Local{}
+		buildDir := filepath.Join(assignmentsDir, scriptFolder)
 		buildCmd := fmt.Sprintf("docker build -t %s .", strings.ToLower(course.GetCode()))
-		job.Commands = []string{
-			"cd " + buildDir,
-			"ls -la",
-			"cat Dockerfile",
-			buildCmd,
+		job := &ci.Job{
+			Commands: []string{
+				"cd " + buildDir,
+				"ls -la",
+				"cat Dockerfile",
+				buildCmd,
+			},
 		}
 		logger.Debugf("cd %v", buildDir)
 		logger.Debugf(buildCmd)
@@ -121,6 +173,27 @@ func fetchAssignments(c context.Context, logger *zap.SugaredLogger, sc scm.SCM,
 	return assignments, dockerfile, nil
 }
 
+// cloneTestsRepo clones the course's tests repository into cloneDir, and returns
+// the course's assignments folder in the cloned repository.
+func cloneTestsRepo(ctx context.Context, logger *zap.SugaredLogger, sc scm.SCM, course *pb.Course, cloneDir string) (string, error) {
+	cloneURL := sc.CreateCloneURL(&scm.URLPathOptions{
+		Organization: course.OrganizationPath,
+		Repository:   course.TestsRepoName(),
+	})
+	repoDir := filepath.Join(cloneDir, course.TestsRepoName())
+	logger.Debugf("git clone %v %v", cloneURL, repoDir)
+
+	// the clone URL and directory are passed as arguments, not through a shell
+	if out, err := exec.CommandContext(ctx, "git", "clone", "--", cloneURL, repoDir).CombinedOutput(); err != nil {
+		return "", fmt.Errorf("failed to clone the %s repository: %w: %s", course.TestsRepoName(), err, out)
+	}
+	assignmentsDir := filepath.Join(repoDir, filepath.FromSlash(course.GetAssignmentsDir()))
+	if _, err := os.Stat(assignmentsDir); err != nil {
+		return "", fmt.Errorf("no assignments folder %q in the %s repository: %w", course.GetAssignmentsDir(), course.TestsRepoName(), err)
+	}
+	return assignmentsDir, nil
+}
+
 // updateGradingCriteria will remove old grading criteria and related reviews when criteria.json gets updated
 func updateGradingCriteria(logger *zap.SugaredLogger, db database.Database, assignment *pb.Assignment) {
 	if len(assignment.GetGradingBenchmarks()) > 0 {
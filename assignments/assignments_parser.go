@@ -2,150 +2,280 @@ package assignments
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"os"
+	"path"
 	"path/filepath"
-	"time"
+	"sort"
+	"strings"
 
 	pb "github.com/autograde/quickfeed/ag"
-
-	"gopkg.in/yaml.v2"
+	"github.com/autograde/quickfeed/ci"
 )
 
 const (
 	target                       = "assignment.yml"
 	targetYaml                   = "assignment.yaml"
+	targetJSON                   = "assignment.json"
+	readmeFile                   = "README.md"
 	criteriaFile                 = "criteria.json"
 	scriptFile                   = "run.sh"
+	runConfigFile                = "run.json"
 	scriptFolder                 = "scripts"
+	releaseFolder                = "release"
 	dockerfile                   = "Dockerfile"
+	taskFilePrefix               = "task-"
+	taskFileSuffix               = ".md"
 	defaultAutoApproveScoreLimit = 80
 )
 
 // assignmentData holds information about a single assignment.
-// This is only used for parsing the 'assignment.yml' file.
+// This is only used for parsing the 'assignment.yml' file, or the
+// other assignment files, which use the same field names.
 // Note that the struct can be private, but the fields must be
 // public to allow parsing.
 type assignmentData struct {
 	AssignmentID     uint   `yaml:"assignmentid"`
 	Deadline         string `yaml:"deadline"`
+	Release          string `yaml:"release"`
 	AutoApprove      bool   `yaml:"autoapprove"`
 	ScoreLimit       uint   `yaml:"scorelimit"`
+	MinCoverage      uint   `yaml:"mincoverage"`
 	IsGroupLab       bool   `yaml:"isgrouplab"`
 	Reviewers        uint   `yaml:"reviewers"`
 	ContainerTimeout uint   `yaml:"containertimeout"`
 	SkipTests        bool   `yaml:"skiptests"`
+	TestScoreWeight  uint   `yaml:"testscoreweight"`
+	GradeWeight      uint   `yaml:"gradeweight"`
+	Mandatory        bool   `yaml:"mandatory"`
+	RunnerPool       string `yaml:"runnerpool"`
+	PeerReviews      uint   `yaml:"peerreviews"`
+	FeedbackIssue    bool   `yaml:"feedbackissue"`
+	SubmissionBranch string `yaml:"submissionbranch"`
+	Exam             bool   `yaml:"exam"`
+	ExamStart        string `yaml:"examstart"`
+	ContainerImage   string `yaml:"containerimage"`
+	LatePolicy       struct {
+		GraceHours uint `yaml:"gracehours"`
+		Penalty    uint `yaml:"penalty"`
+		CutoffDays uint `yaml:"cutoffdays"`
+	} `yaml:"latepolicy"`
+	Limits struct {
+		CPUs   float64 `yaml:"cpus"`
+		Memory uint    `yaml:"memory"`
+		Pids   uint    `yaml:"pids"`
+	} `yaml:"limits"`
+	Policy struct {
+		ScanSecrets       bool     `yaml:"scansecrets"`
+		ForbiddenFiles    []string `yaml:"forbiddenfiles"`
+		MaxFileSize       uint     `yaml:"maxfilesize"`
+		DisallowedImports []string `yaml:"disallowedimports"`
+		Block             bool     `yaml:"block"`
+	} `yaml:"policy"`
+	Tasks []struct {
+		Name  string   `yaml:"name"`
+		Title string   `yaml:"title"`
+		Tests []string `yaml:"tests"`
+	} `yaml:"tasks"`
+}
+
+// testsFolder is the content of the assignments folder of a tests repository.
+type testsFolder struct {
+	assignments []*pb.Assignment
+	// dockerfile is the contents of the Dockerfile in the scripts folder, if any
+	dockerfile string
+	// files maps the names of the assignments to the paths of the files describing them
+	files map[string]string
 }
 
 // TODO(meling) this func should be renamed now that it does more than parseAssignments
 
 // ParseAssignments recursively walks the given directory and parses
 // any 'assignment.yml' files found and returns an array of assignments.
-func parseAssignments(dir string, courseID uint64) ([]*pb.Assignment, string, error) {
+// An assignment may instead be described by an 'assignment.json' file,
+// or by YAML front-matter in the assignment folder's README.md file.
+// The Dockerfile in the scripts folder is returned as the course's Dockerfile,
+// while a Dockerfile in an assignment folder is stored with the assignment.
+func parseAssignments(dir string, course *pb.Course) ([]*pb.Assignment, string, error) {
+	folder, err := parseTestsFolder(dir, course)
+	if err != nil {
+		return nil, "", err
+	}
+	return folder.assignments, folder.dockerfile, nil
+}
+
+// parseTestsFolder parses the given directory as described for parseAssignments.
+// The problems with the files in the directory are returned together as FileErrors,
+// such that they can all be fixed at once, while failing to read the directory
+// is returned as is.
+func parseTestsFolder(dir string, course *pb.Course) (*testsFolder, error) {
 	// check if directory exist
 	if _, err := os.Stat(dir); os.IsNotExist(err) {
-		return nil, "", err
+		return nil, err
 	}
 
-	var assignments []*pb.Assignment
-	var defaultScript string
-	var courseDockerfile string
+	folder := &testsFolder{files: make(map[string]string)}
+	var problems FileErrors
+	addProblem := func(file string, err error) {
+		var fileErrs FileErrors
+		var fileErr *FileError
+		switch {
+		case errors.As(err, &fileErrs):
+			problems = append(problems, fileErrs...)
+		case errors.As(err, &fileErr):
+			problems = append(problems, fileErr)
+		default:
+			problems = append(problems, &FileError{File: file, Reason: err.Error()})
+		}
+	}
+	var defaultScript, defaultRunConfig string
+	// Dockerfiles are walked before the assignment files in the same folder,
+	// so they are added to their assignments after the walk
+	assignmentDockerfiles := make(map[string]string)
+	// the file describing the assignment with each order; assignments are identified by their order
+	orders := make(map[uint32]string)
 	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
 		if err != nil {
 			// Walk unable to read path; stop walking the tree
 			return err
 		}
+		if info.IsDir() && info.Name() == releaseFolder {
+			// the content published to students at the assignment's release time is not parsed
+			return filepath.SkipDir
+		}
+		if info.IsDir() {
+			return nil
+		}
 		assignmentName := filepath.Base(filepath.Dir(path))
-		if !info.IsDir() {
-			filename := filepath.Base(path)
-			var contents []byte
-			switch filename {
-			case target, targetYaml, criteriaFile, scriptFile, dockerfile:
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		file := filepath.ToSlash(rel)
+		filename := filepath.Base(path)
+		var contents []byte
+		switch filename {
+		case target, targetYaml, targetJSON, readmeFile, criteriaFile, scriptFile, runConfigFile, dockerfile:
+			contents, err = ioutil.ReadFile(path)
+			if err != nil {
+				return err
+			}
+		default:
+			if isTaskFile(filename) {
 				contents, err = ioutil.ReadFile(path)
 				if err != nil {
 					return err
 				}
-			default:
-				// no need to parse this file
+				if err := updateTaskFromFile(contents, filename, assignmentName, folder.assignments); err != nil {
+					addProblem(file, err)
+				}
+			}
+			// no need to parse this file
+			return nil
+		}
+		switch filename {
+		case target, targetYaml, targetJSON, readmeFile:
+			if filename == readmeFile && (filepath.Dir(path) == dir || assignmentName == scriptFolder) {
+				// only the README.md files of assignment folders can describe assignments
 				return nil
 			}
-			switch filename {
-			case target, targetYaml:
-				assignment, err := readAssignmentFile(contents, assignmentName, courseID)
-				if err != nil {
-					return err
-				}
-				assignments = append(assignments, assignment)
+			assignment, err := readAssignmentFile(file, contents, course)
+			if err != nil {
+				addProblem(file, err)
+				return nil
+			}
+			if assignment == nil {
+				// README.md without front-matter
+				return nil
+			}
+			if other, ok := folder.files[assignmentName]; ok {
+				addProblem(file, fmt.Errorf("assignment %s is already described by %s", assignmentName, other))
+				return nil
+			}
+			if other, ok := orders[assignment.GetOrder()]; ok {
+				addProblem(file, &FileError{File: file, Field: "assignmentid",
+					Reason: fmt.Sprintf("%d is also the assignmentid of %s", assignment.GetOrder(), other)})
+				return nil
+			}
+			folder.files[assignmentName] = file
+			orders[assignment.GetOrder()] = file
+			folder.assignments = append(folder.assignments, assignment)
 
-			case criteriaFile:
-				if err := updateCriteriaFromFile(contents, assignmentName, assignments); err != nil {
-					return err
-				}
+		case criteriaFile:
+			if err := updateCriteriaFromFile(contents, assignmentName, folder.assignments); err != nil {
+				addProblem(file, err)
+			}
 
-			case scriptFile:
-				script, err := readScriptFile(contents, assignmentName, assignments)
-				if err != nil {
-					return err
-				}
-				defaultScript = script
+		case scriptFile:
+			script, err := readScriptFile(contents, assignmentName, folder.assignments)
+			if err != nil {
+				addProblem(file, err)
+			}
+			defaultScript = script
 
-			case dockerfile:
-				courseDockerfile = string(contents)
+		case runConfigFile:
+			config, err := readRunConfigFile(contents, assignmentName, folder.assignments)
+			if err != nil {
+				addProblem(file, err)
+			}
+			defaultRunConfig = config
+
+		case dockerfile:
+			if assignmentName == scriptFolder {
+				folder.dockerfile = string(contents)
+			} else {
+				assignmentDockerfiles[file] = string(contents)
 			}
 		}
 		return nil
 	})
 	if err != nil {
-		return nil, "", err
+		return nil, err
 	}
 
-	// if there is a script in `scripts` folder, save it for every assignment
-	// that's missing the assignment specific script
-	if defaultScript != "" {
-		for _, assignment := range assignments {
-			if assignment.ScriptFile == "" {
-				assignment.ScriptFile = defaultScript
-			}
+	dockerfiles := make([]string, 0, len(assignmentDockerfiles))
+	for file := range assignmentDockerfiles {
+		dockerfiles = append(dockerfiles, file)
+	}
+	sort.Strings(dockerfiles)
+	for _, file := range dockerfiles {
+		assignmentName := path.Base(path.Dir(file))
+		assignment := findAssignmentByName(folder.assignments, assignmentName)
+		if assignment == nil {
+			addProblem(file, fmt.Errorf("could not find assignment %s for %s", assignmentName, dockerfile))
+			continue
 		}
+		assignment.Dockerfile = assignmentDockerfiles[file]
 	}
-	return assignments, courseDockerfile, nil
-}
 
-func FixDeadline(in string) string {
-	wantLayout := pb.TimeLayout
-	acceptedLayouts := []string{
-		"2006-1-2T15:04:05",
-		"2006-1-2 15:04:05",
-		"2006-1-2T15:04",
-		"2006-1-2 15:04",
-		"2006-1-2T1504",
-		"2006-1-2 1504",
-		"2006-1-2T15",
-		"2006-1-2 15",
-		"2006-1-2 3pm",
-		"2006-1-2 3:04pm",
-		"2006-1-2 3:04:05pm",
-		"2-1-2006T15:04:05",
-		"2-1-2006 15:04:05",
-		"2-1-2006T15:04",
-		"2-1-2006 15:04",
-		"2-1-2006T1504",
-		"2-1-2006 1504",
-		"2-1-2006T15",
-		"2-1-2006 15",
-		"2-1-2006 3pm",
-		"2-1-2006 3:04pm",
-		"2-1-2006 3:04:05pm",
-	}
-	for _, layout := range acceptedLayouts {
-		t, err := time.Parse(layout, in)
-		if err != nil {
-			continue
+	if defaultScript != "" && defaultRunConfig != "" {
+		addProblem(scriptFolder, fmt.Errorf("both %s and %s in %s folder", scriptFile, runConfigFile, scriptFolder))
+	}
+	for _, assignment := range folder.assignments {
+		for _, task := range assignment.Tasks {
+			// tasks without a title in assignment.yml or a heading in their task file are titled by their name
+			if task.Title == "" {
+				task.Title = task.Name
+			}
 		}
-		return t.Format(wantLayout)
+		if assignment.ScriptFile != "" && assignment.RunConfig != "" {
+			addProblem(path.Dir(folder.files[assignment.Name]), fmt.Errorf("both %s and %s for assignment %s", scriptFile, runConfigFile, assignment.Name))
+		}
+	}
+	if len(problems) > 0 {
+		return nil, problems
 	}
-	return "Invalid date format: " + in
+	// if there is a script or run configuration in `scripts` folder, save it for
+	// every assignment that's missing an assignment specific script or run configuration
+	for _, assignment := range folder.assignments {
+		if assignment.ScriptFile == "" && assignment.RunConfig == "" {
+			assignment.ScriptFile = defaultScript
+			assignment.RunConfig = defaultRunConfig
+		}
+	}
+	return folder, nil
 }
 
 func updateCriteriaFromFile(criteria []byte, assignmentName string, assignments []*pb.Assignment) error {
@@ -173,30 +303,142 @@ func readScriptFile(contents []byte, assignmentName string, assignments []*pb.As
 	return string(contents), nil
 }
 
-func readAssignmentFile(contents []byte, assignmentName string, courseID uint64) (*pb.Assignment, error) {
-	var newAssignment assignmentData
-	err := yaml.Unmarshal(contents, &newAssignment)
-	if err != nil {
-		return nil, fmt.Errorf("error unmarshalling assignment: %w", err)
+// isTaskFile returns true if the named file describes a task, e.g., task-fib.md.
+func isTaskFile(filename string) bool {
+	return strings.HasPrefix(filename, taskFilePrefix) && strings.HasSuffix(filename, taskFileSuffix) &&
+		len(filename) > len(taskFilePrefix)+len(taskFileSuffix)
+}
+
+// updateTaskFromFile sets the description of the assignment's task named by the given task file
+// to the file's contents, adding the task if it is not in the assignment's assignment.yml file.
+// The task's title, if not given in assignment.yml, is the file's first heading.
+func updateTaskFromFile(contents []byte, filename, assignmentName string, assignments []*pb.Assignment) error {
+	assignment := findAssignmentByName(assignments, assignmentName)
+	if assignment == nil {
+		return fmt.Errorf("could not find assignment %s for %s", assignmentName, filename)
+	}
+	name := strings.TrimSuffix(strings.TrimPrefix(filename, taskFilePrefix), taskFileSuffix)
+	var task *pb.Task
+	for _, t := range assignment.Tasks {
+		if t.GetName() == name {
+			task = t
+		}
+	}
+	if task == nil {
+		task = &pb.Task{Name: name, Order: uint32(len(assignment.Tasks) + 1)}
+		assignment.Tasks = append(assignment.Tasks, task)
+	}
+	task.Description = string(contents)
+	if task.Title == "" {
+		task.Title = taskTitle(task.Description)
+	}
+	return nil
+}
+
+// taskTitle returns the first heading of the given markdown, or the empty string if it has no heading.
+func taskTitle(markdown string) string {
+	for _, line := range strings.Split(markdown, "\n") {
+		if strings.HasPrefix(line, "#") {
+			if title := strings.TrimSpace(strings.TrimLeft(line, "#")); title != "" {
+				return title
+			}
+		}
+	}
+	return ""
+}
+
+// readRunConfigFile checks the run configuration given by the contents of a run.json file,
+// and saves it for the assignment, or returns it if it is in the scripts folder.
+func readRunConfigFile(contents []byte, assignmentName string, assignments []*pb.Assignment) (string, error) {
+	if _, err := ci.ParseRunConfig(contents); err != nil {
+		return "", fmt.Errorf("invalid %s for %s: %w", runConfigFile, assignmentName, err)
+	}
+	if assignmentName != scriptFolder {
+		assignment := findAssignmentByName(assignments, assignmentName)
+		if assignment == nil {
+			return "", fmt.Errorf("could not find assignment %s for %s", assignmentName, runConfigFile)
+		}
+		assignment.RunConfig = string(contents)
+		return "", nil
+	}
+	return string(contents), nil
+}
+
+// readAssignmentFile returns the assignment described by the given assignment file, given by its
+// path in the assignments folder, or an error listing the problems with the file. The assignment's
+// name is the name of the file's folder. Its times are in the course's time zone, unless given in RFC3339
+// format, and are stored in UTC. It returns nil if the file is a README.md file without front-matter.
+func readAssignmentFile(file string, contents []byte, course *pb.Course) (*pb.Assignment, error) {
+	newAssignment, errs := decodeAssignmentFile(file, contents)
+	if len(errs) > 0 {
+		return nil, errs
+	}
+	if newAssignment == nil {
+		return nil, nil
 	}
+	loc := course.Location()
+	if errs := newAssignment.validate(file, loc); len(errs) > 0 {
+		return nil, errs
+	}
+	assignmentName := path.Base(path.Dir(file))
 	// if no auto approve score limit is defined; use the default
 	if newAssignment.ScoreLimit < 1 {
 		newAssignment.ScoreLimit = defaultAutoApproveScoreLimit
 	}
+	var tasks []*pb.Task
+	for i, t := range newAssignment.Tasks {
+		tasks = append(tasks, &pb.Task{Name: t.Name, Title: t.Title, Tests: strings.Join(t.Tests, ","), Order: uint32(i + 1)})
+	}
+	// the times are valid, since the assignment has been validated
+	var deadline, release string
+	if newAssignment.Deadline != "" {
+		deadline, _ = pb.DeadlineInUTC(newAssignment.Deadline, loc)
+	}
+	if newAssignment.Release != "" {
+		release, _ = pb.DeadlineInUTC(newAssignment.Release, loc)
+	}
+	var examStart string
+	if newAssignment.Exam {
+		examStart, _ = pb.DeadlineInUTC(newAssignment.ExamStart, loc)
+	}
 
 	// AssignmentID field from the parsed yaml is used to set Order, not assignment ID,
 	// or it will cause a database constraint violation (IDs must be unique)
 	// The Name field below is the folder name of the assignment.
 	assignment := &pb.Assignment{
-		CourseID:         courseID,
-		Deadline:         FixDeadline(newAssignment.Deadline),
-		Name:             assignmentName,
-		Order:            uint32(newAssignment.AssignmentID),
-		AutoApprove:      newAssignment.AutoApprove,
-		ScoreLimit:       uint32(newAssignment.ScoreLimit),
-		IsGroupLab:       newAssignment.IsGroupLab,
-		Reviewers:        uint32(newAssignment.Reviewers),
-		ContainerTimeout: uint32(newAssignment.ContainerTimeout),
+		CourseID:              course.GetID(),
+		Deadline:              deadline,
+		Release:               release,
+		Name:                  assignmentName,
+		Order:                 uint32(newAssignment.AssignmentID),
+		AutoApprove:           newAssignment.AutoApprove,
+		ScoreLimit:            uint32(newAssignment.ScoreLimit),
+		MinCoverage:           uint32(newAssignment.MinCoverage),
+		IsGroupLab:            newAssignment.IsGroupLab,
+		Reviewers:             uint32(newAssignment.Reviewers),
+		ContainerTimeout:      uint32(newAssignment.ContainerTimeout),
+		TestScoreWeight:       uint32(newAssignment.TestScoreWeight),
+		GradeWeight:           uint32(newAssignment.GradeWeight),
+		Mandatory:             newAssignment.Mandatory,
+		RunnerPool:            newAssignment.RunnerPool,
+		LateGraceHours:        uint32(newAssignment.LatePolicy.GraceHours),
+		LatePenalty:           uint32(newAssignment.LatePolicy.Penalty),
+		LateCutoffDays:        uint32(newAssignment.LatePolicy.CutoffDays),
+		PeerReviews:           uint32(newAssignment.PeerReviews),
+		FeedbackIssue:         newAssignment.FeedbackIssue,
+		SubmissionBranch:      newAssignment.SubmissionBranch,
+		Exam:                  newAssignment.Exam,
+		ExamStart:             examStart,
+		ContainerImage:        newAssignment.ContainerImage,
+		CpuLimit:              uint32(newAssignment.Limits.CPUs * 1000),
+		MemoryLimit:           uint32(newAssignment.Limits.Memory),
+		PidsLimit:             uint32(newAssignment.Limits.Pids),
+		ScanSecrets:           newAssignment.Policy.ScanSecrets,
+		ForbiddenFiles:        strings.Join(newAssignment.Policy.ForbiddenFiles, ","),
+		MaxFileSize:           uint32(newAssignment.Policy.MaxFileSize),
+		DisallowedImports:     strings.Join(newAssignment.Policy.DisallowedImports, ","),
+		BlockPolicyViolations: newAssignment.Policy.Block,
+		Tasks:                 tasks,
 	}
 	return assignment, nil
 }
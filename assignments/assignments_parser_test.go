@@ -2,6 +2,7 @@ package assignments
 
 import (
 	"context"
+	"fmt"
 	"io/ioutil"
 	"os"
 	"path/filepath"
@@ -15,7 +16,7 @@ import (
 
 func TestParseWithInvalidDir(t *testing.T) {
 	const dir = "invalid/dir"
-	_, _, err := parseAssignments(dir, 0)
+	_, _, err := parseAssignments(dir, &pb.Course{})
 	if err == nil {
 		t.Errorf("want no such file or directory error, got nil")
 	}
@@ -25,12 +26,24 @@ const (
 	y1 = `assignmentid: 1
 name: "For loops"
 deadline: "27-08-2017 12:00"
+release: "20-08-2017 09:00"
 autoapprove: false
+limits:
+  cpus: 0.5
+  memory: 512
 `
 	y2 = `assignmentid: 2
 name: "Nested loops"
 deadline: "27-08-2018 12:00"
 autoapprove: false
+mincoverage: 60
+containerimage: "python:3"
+policy:
+  scansecrets: true
+  forbiddenfiles: [".env", "*.exe"]
+  maxfilesize: 5
+  disallowedimports: ["os/exec"]
+  block: true
 `
 
 	yUnknownFields = `assignmentid: 1
@@ -45,6 +58,7 @@ autoapprove: false
 	script   = `Default script`
 	script1  = `Script for Lab1`
 	df       = `A dockerfile in training`
+	df1      = `A dockerfile for lab1`
 	criteria = `
 	[
 		{
@@ -83,6 +97,7 @@ func TestParse(t *testing.T) {
 		Commands: []string{
 			"cd " + testsDir,
 			"mkdir lab1",
+			"mkdir lab1/release",
 			"mkdir lab2",
 			"mkdir scripts",
 		},
@@ -108,10 +123,19 @@ func TestParse(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
+	// the content published at the release time is not parsed
+	err = ioutil.WriteFile(filepath.Join(testsDir, "lab1", "release", "run.sh"), []byte("Student script"), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
 	err = ioutil.WriteFile(filepath.Join(testsDir, "scripts", "Dockerfile"), []byte(df), 0644)
 	if err != nil {
 		t.Fatal(err)
 	}
+	err = ioutil.WriteFile(filepath.Join(testsDir, "lab1", "Dockerfile"), []byte(df1), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
 	err = ioutil.WriteFile(filepath.Join(testsDir, "lab2", "criteria.json"), []byte(criteria), 0644)
 	if err != nil {
 		t.Fatal(err)
@@ -146,23 +170,34 @@ func TestParse(t *testing.T) {
 	wantAssignment1 := &pb.Assignment{
 		Name:        "lab1",
 		Deadline:    "2017-08-27T12:00:00",
+		Release:     "2017-08-20T09:00:00",
 		ScriptFile:  "Script for Lab1",
 		AutoApprove: false,
 		Order:       1,
 		ScoreLimit:  80,
+		Dockerfile:  "A dockerfile for lab1",
+		CpuLimit:    500,
+		MemoryLimit: 512,
 	}
 
 	wantAssignment2 := &pb.Assignment{
-		Name:              "lab2",
-		Deadline:          "2018-08-27T12:00:00",
-		ScriptFile:        "Default script",
-		AutoApprove:       false,
-		Order:             2,
-		ScoreLimit:        80,
-		GradingBenchmarks: wantCriteria,
+		Name:                  "lab2",
+		Deadline:              "2018-08-27T12:00:00",
+		ScriptFile:            "Default script",
+		AutoApprove:           false,
+		Order:                 2,
+		ScoreLimit:            80,
+		MinCoverage:           60,
+		GradingBenchmarks:     wantCriteria,
+		ContainerImage:        "python:3",
+		ScanSecrets:           true,
+		ForbiddenFiles:        ".env,*.exe",
+		MaxFileSize:           5,
+		DisallowedImports:     "os/exec",
+		BlockPolicyViolations: true,
 	}
 
-	assignments, dockerfile, err := parseAssignments(testsDir, 0)
+	assignments, dockerfile, err := parseAssignments(testsDir, &pb.Course{Timezone: "UTC"})
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -216,7 +251,7 @@ func TestParseUnknownFields(t *testing.T) {
 		ScoreLimit:  80,
 	}
 
-	assignments, _, err := parseAssignments(testsDir, 0)
+	assignments, _, err := parseAssignments(testsDir, &pb.Course{Timezone: "UTC"})
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -228,93 +263,175 @@ func TestParseUnknownFields(t *testing.T) {
 	}
 }
 
-func TestFixDeadline(t *testing.T) {
-	deadlineTests := []struct {
-		in, want string
-	}{
-		{"2020-01-23T18:00:20", "2020-01-23T18:00:20"},
-		{"2020-01-23 18:00:20", "2020-01-23T18:00:20"},
-		{"2020-01-23T18:00", "2020-01-23T18:00:00"},
-		{"2020-01-23 18:00", "2020-01-23T18:00:00"},
-		{"2020-01-23T1800", "2020-01-23T18:00:00"},
-		{"2020-01-23 1800", "2020-01-23T18:00:00"},
-		{"2020-01-23T18", "2020-01-23T18:00:00"},
-		{"2020-01-23 18", "2020-01-23T18:00:00"},
-		{"2020-01-23 6pm", "2020-01-23T18:00:00"},
-		{"2020-01-23 6am", "2020-01-23T06:00:00"},
-		//
-		{"2020-1-23T18:00:20", "2020-01-23T18:00:20"},
-		{"2020-1-23 18:00:20", "2020-01-23T18:00:20"},
-		{"2020-1-23T18:00", "2020-01-23T18:00:00"},
-		{"2020-1-23 18:00", "2020-01-23T18:00:00"},
-		{"2020-1-23T1800", "2020-01-23T18:00:00"},
-		{"2020-1-23 1800", "2020-01-23T18:00:00"},
-		{"2020-1-23T18", "2020-01-23T18:00:00"},
-		{"2020-1-23 18", "2020-01-23T18:00:00"},
-		{"2020-1-23 6pm", "2020-01-23T18:00:00"},
-		{"2020-1-23 6am", "2020-01-23T06:00:00"},
-		//
-		{"2020-1-1T18:00:20", "2020-01-01T18:00:20"},
-		{"2020-1-1 18:00:20", "2020-01-01T18:00:20"},
-		{"2020-1-1T18:00", "2020-01-01T18:00:00"},
-		{"2020-1-1 18:00", "2020-01-01T18:00:00"},
-		{"2020-1-1T1800", "2020-01-01T18:00:00"},
-		{"2020-1-1 1800", "2020-01-01T18:00:00"},
-		{"2020-1-1T18", "2020-01-01T18:00:00"},
-		{"2020-1-1 18", "2020-01-01T18:00:00"},
-		{"2020-1-1 6pm", "2020-01-01T18:00:00"},
-		{"2020-1-1 6am", "2020-01-01T06:00:00"},
-		//
-		{"23-01-2020T18:00:20", "2020-01-23T18:00:20"},
-		{"23-01-2020 18:00:20", "2020-01-23T18:00:20"},
-		{"23-01-2020T18:00", "2020-01-23T18:00:00"},
-		{"23-01-2020 18:00", "2020-01-23T18:00:00"},
-		{"23-01-2020T1800", "2020-01-23T18:00:00"},
-		{"23-01-2020 1800", "2020-01-23T18:00:00"},
-		{"23-01-2020T18", "2020-01-23T18:00:00"},
-		{"23-01-2020 18", "2020-01-23T18:00:00"},
-		{"23-01-2020 6pm", "2020-01-23T18:00:00"},
-		{"23-01-2020 6am", "2020-01-23T06:00:00"},
-		//
-		{"23-1-2020T18:00:20", "2020-01-23T18:00:20"},
-		{"23-1-2020 18:00:20", "2020-01-23T18:00:20"},
-		{"23-1-2020T18:00", "2020-01-23T18:00:00"},
-		{"23-1-2020 18:00", "2020-01-23T18:00:00"},
-		{"23-1-2020T1800", "2020-01-23T18:00:00"},
-		{"23-1-2020 1800", "2020-01-23T18:00:00"},
-		{"23-1-2020T18", "2020-01-23T18:00:00"},
-		{"23-1-2020 18", "2020-01-23T18:00:00"},
-		{"23-1-2020 6pm", "2020-01-23T18:00:00"},
-		{"23-1-2020 6am", "2020-01-23T06:00:00"},
-		//
-		{"1-1-2020T18:00:20", "2020-01-01T18:00:20"},
-		{"1-1-2020 18:00:20", "2020-01-01T18:00:20"},
-		{"1-1-2020T18:00", "2020-01-01T18:00:00"},
-		{"1-1-2020 18:00", "2020-01-01T18:00:00"},
-		{"1-1-2020T1800", "2020-01-01T18:00:00"},
-		{"1-1-2020 1800", "2020-01-01T18:00:00"},
-		{"1-1-2020T18", "2020-01-01T18:00:00"},
-		{"1-1-2020 18", "2020-01-01T18:00:00"},
-		{"1-1-2020 6pm", "2020-01-01T18:00:00"},
-		{"1-1-2020 6am", "2020-01-01T06:00:00"},
-		//
-		{"1-12-2020T18:00:20", "2020-12-01T18:00:20"},
-		{"1-12-2020 18:00:20", "2020-12-01T18:00:20"},
-		{"1-12-2020T18:00", "2020-12-01T18:00:00"},
-		{"1-12-2020 18:00", "2020-12-01T18:00:00"},
-		{"1-12-2020T1800", "2020-12-01T18:00:00"},
-		{"1-12-2020 1800", "2020-12-01T18:00:00"},
-		{"1-12-2020T18", "2020-12-01T18:00:00"},
-		{"1-12-2020 18", "2020-12-01T18:00:00"},
-		{"1-12-2020 6pm", "2020-12-01T18:00:00"},
-		{"1-12-2020 6am", "2020-12-01T06:00:00"},
-		{"1-12-2020 6:59pm", "2020-12-01T18:59:00"},
-		{"1-12-2020 6:59:30pm", "2020-12-01T18:59:30"},
-	}
-	for _, c := range deadlineTests {
-		got := FixDeadline(c.in)
-		if got != c.want {
-			t.Errorf("FixDeadline(%q) == %q, want %q", c.in, got, c.want)
+func TestReadAssignmentFileLatePolicy(t *testing.T) {
+	const yLatePolicy = `assignmentid: 1
+deadline: "2022-11-10 12:00"
+latepolicy:
+  gracehours: 2
+  penalty: 10
+  cutoffdays: 7
+`
+	assignment, err := readAssignmentFile("lab1/assignment.yml", []byte(yLatePolicy), &pb.Course{ID: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if assignment.GetLateGraceHours() != 2 || assignment.GetLatePenalty() != 10 || assignment.GetLateCutoffDays() != 7 {
+		t.Errorf("readAssignmentFile() late policy = (%d, %d, %d), want (2, 10, 7)",
+			assignment.GetLateGraceHours(), assignment.GetLatePenalty(), assignment.GetLateCutoffDays())
+	}
+
+	const yInvalidPenalty = `assignmentid: 1
+deadline: "2022-11-10 12:00"
+latepolicy:
+  penalty: 110
+`
+	if _, err := readAssignmentFile("lab1/assignment.yml", []byte(yInvalidPenalty), &pb.Course{ID: 1}); err == nil {
+		t.Error("readAssignmentFile() with late penalty above 100 succeeded, want error")
+	}
+}
+
+func TestReadAssignmentFileInvalidMinCoverage(t *testing.T) {
+	const yInvalidMinCoverage = `assignmentid: 1
+deadline: "2022-11-10 12:00"
+mincoverage: 101
+`
+	if _, err := readAssignmentFile("lab1/assignment.yml", []byte(yInvalidMinCoverage), &pb.Course{ID: 1}); err == nil {
+		t.Error("readAssignmentFile() with minimum coverage above 100 succeeded, want error")
+	}
+}
+
+func TestReadAssignmentFileInvalidRelease(t *testing.T) {
+	const yInvalidRelease = `assignmentid: 1
+deadline: "2022-11-10 12:00"
+release: "next monday"
+`
+	if _, err := readAssignmentFile("lab1/assignment.yml", []byte(yInvalidRelease), &pb.Course{ID: 1}); err == nil {
+		t.Error("readAssignmentFile() with invalid release time succeeded, want error")
+	}
+}
+
+func TestParseRunConfig(t *testing.T) {
+	const (
+		runConfig        = `{"image": "golang:1.17", "test": ["go test -v ./..."], "timeout": 5}`
+		defaultRunConfig = `{"image": "golang:1.17", "build": ["go vet ./..."], "test": ["go test ./..."]}`
+	)
+	testsDir := t.TempDir()
+	files := map[string]string{
+		"lab1/assignment.yml": "assignmentid: 1\n",
+		"lab1/run.json":       runConfig,
+		"lab2/assignment.yml": "assignmentid: 2\n",
+		"lab2/run.sh":         script1,
+		"lab3/assignment.yml": "assignmentid: 3\n",
+		"scripts/run.json":    defaultRunConfig,
+	}
+	for name, contents := range files {
+		path := filepath.Join(testsDir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(path, []byte(contents), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	assignments, _, err := parseAssignments(testsDir, &pb.Course{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []struct{ script, runConfig string }{
+		{runConfig: runConfig},
+		{script: script1},
+		{runConfig: defaultRunConfig},
+	}
+	for i, assignment := range assignments {
+		if assignment.GetScriptFile() != want[i].script || assignment.GetRunConfig() != want[i].runConfig {
+			t.Errorf("%s: (ScriptFile, RunConfig) = (%q, %q), want (%q, %q)", assignment.GetName(),
+				assignment.GetScriptFile(), assignment.GetRunConfig(), want[i].script, want[i].runConfig)
+		}
+	}
+
+	// an assignment cannot have both a script and a run configuration
+	if err := ioutil.WriteFile(filepath.Join(testsDir, "lab1", "run.sh"), []byte(script1), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := parseAssignments(testsDir, &pb.Course{}); err == nil {
+		t.Error("parseAssignments() with run.sh and run.json for lab1 succeeded, want error")
+	}
+	if err := os.Remove(filepath.Join(testsDir, "lab1", "run.sh")); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(testsDir, "lab1", "run.json"), []byte(`{"image": "golang:1.17"}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := parseAssignments(testsDir, &pb.Course{}); err == nil {
+		t.Error("parseAssignments() with run.json without test commands succeeded, want error")
+	}
+}
+
+func TestParseTasks(t *testing.T) {
+	const yTasks = `assignmentid: 1
+tasks:
+  - name: fib
+    title: "Fibonacci numbers"
+  - name: primes
+    tests: ["TestIsPrime", "TestSieve"]
+`
+	testsDir := t.TempDir()
+	files := map[string]string{
+		"lab1/assignment.yml":      yTasks,
+		"lab1/task-primes.md":      "# Prime numbers\n\nImplement the sieve of Eratosthenes.\n",
+		"lab1/task-sort.md":        "Implement merge sort.\n",
+		"lab1/release/task-fib.md": "# Not parsed\n",
+	}
+	for name, contents := range files {
+		path := filepath.Join(testsDir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(path, []byte(contents), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	assignments, _, err := parseAssignments(testsDir, &pb.Course{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*pb.Task{
+		{Name: "fib", Title: "Fibonacci numbers", Order: 1},
+		{Name: "primes", Title: "Prime numbers", Description: files["lab1/task-primes.md"], Tests: "TestIsPrime,TestSieve", Order: 2},
+		{Name: "sort", Title: "sort", Description: files["lab1/task-sort.md"], Order: 3},
+	}
+	if diff := cmp.Diff(want, assignments[0].GetTasks(), protocmp.Transform()); diff != "" {
+		t.Errorf("parseAssignments() tasks mismatch (-want +got):\n%s", diff)
+	}
+
+	const yDuplicateTasks = `assignmentid: 1
+tasks:
+  - name: fib
+  - name: fib
+`
+	if _, err := readAssignmentFile("lab1/assignment.yml", []byte(yDuplicateTasks), &pb.Course{ID: 1}); err == nil {
+		t.Error("readAssignmentFile() with duplicate tasks succeeded, want error")
+	}
+}
+
+func TestReadAssignmentFileExam(t *testing.T) {
+	const yExam = `assignmentid: 1
+deadline: "2022-12-01 13:00"
+exam: true
+examstart: "2022-12-01 09:00"
+`
+	assignment, err := readAssignmentFile("exam/assignment.yml", []byte(yExam), &pb.Course{ID: 1, Timezone: "UTC"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !assignment.GetExam() || assignment.GetExamStart() != "2022-12-01T09:00:00" {
+		t.Errorf("readAssignmentFile() exam = (%t, %q), want (true, %q)", assignment.GetExam(), assignment.GetExamStart(), "2022-12-01T09:00:00")
+	}
+
+	for _, examStart := range []string{"", "tomorrow", "2022-12-01 13:00", "2022-12-02 09:00"} {
+		yInvalidExam := fmt.Sprintf("assignmentid: 1\ndeadline: \"2022-12-01 13:00\"\nexam: true\nexamstart: %q\n", examStart)
+		if _, err := readAssignmentFile("exam/assignment.yml", []byte(yInvalidExam), &pb.Course{ID: 1}); err == nil {
+			t.Errorf("readAssignmentFile() with exam start %q succeeded, want error", examStart)
 		}
 	}
 }
@@ -3,6 +3,7 @@ package assignments
 import (
 	"context"
 	"testing"
+	"time"
 
 	pb "github.com/autograde/quickfeed/ag"
 	"github.com/autograde/quickfeed/scm"
@@ -34,3 +35,39 @@ func TestFetchAssignments(t *testing.T) {
 		t.Logf("assignment: %v", assignment)
 	}
 }
+
+func TestCourseEvents(t *testing.T) {
+	existing := []*pb.Assignment{
+		{ID: 1, CourseID: 1, Name: "lab1", Order: 1, Deadline: "2022-01-10T23:59:00"},
+		{ID: 2, CourseID: 1, Name: "lab2", Order: 2, Deadline: "2022-01-20T23:59:00"},
+	}
+	updated := []*pb.Assignment{
+		{ID: 1, CourseID: 1, Name: "lab1", Order: 1, Deadline: "2022-01-10T23:59:00"},
+		{ID: 2, CourseID: 1, Name: "lab2", Order: 2, Deadline: "2022-01-27T23:59:00"},
+		{ID: 3, CourseID: 1, Name: "lab3", Order: 3, Deadline: "2022-02-01T23:59:00"},
+	}
+	events := courseEvents(time.UTC, existing, updated)
+	if len(events) != 2 {
+		t.Fatalf("courseEvents() returned %d events, want 2", len(events))
+	}
+	if events[0].GetType() != pb.CourseEvent_DEADLINE_CHANGED || events[0].GetAssignmentID() != 2 {
+		t.Errorf("courseEvents()[0] = %v, want deadline change for assignment 2", events[0])
+	}
+	if events[1].GetType() != pb.CourseEvent_ASSIGNMENT_RELEASED || events[1].GetAssignmentID() != 3 {
+		t.Errorf("courseEvents()[1] = %v, want release of assignment 3", events[1])
+	}
+	if events := courseEvents(time.UTC, updated, updated); len(events) != 0 {
+		t.Errorf("courseEvents() for unchanged assignments returned %d events, want 0", len(events))
+	}
+
+	// assignments with a release time are announced when released
+	scheduled := []*pb.Assignment{
+		{ID: 2, CourseID: 1, Name: "lab2", Order: 2, Deadline: "2022-01-30T23:59:00", Release: "2022-01-15T08:00:00"},
+		{ID: 3, CourseID: 1, Name: "lab3", Order: 3, Deadline: "2022-02-01T23:59:00", Release: "2022-01-25T08:00:00"},
+	}
+	existing[1].Release, existing[1].Released = "2022-01-15T08:00:00", true
+	events = courseEvents(time.UTC, existing, scheduled)
+	if len(events) != 1 || events[0].GetType() != pb.CourseEvent_DEADLINE_CHANGED || events[0].GetAssignmentID() != 2 {
+		t.Errorf("courseEvents() = %v, want only deadline change for released assignment 2", events)
+	}
+}
@@ -0,0 +1,119 @@
+package assignments
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	pb "github.com/autograde/quickfeed/ag"
+)
+
+// ReleaseCommitPrefix starts the message of the commits publishing an assignment's content.
+// Pushes of these commits to student repositories are not graded.
+const ReleaseCommitPrefix = "QuickFeed: release "
+
+// PublishAssignment copies the content of the assignment's release folder in the course's tests
+// repository, i.e., 'tests/<assignment>/release', into the assignment's folder in each of
+// the target repositories, and pushes it as a single commit. Repositories that already
+// have the content are left unchanged, so publishing can be retried after a failure.
+// The repositories are given by their clone URLs, which must include credentials
+// allowing the content to be pushed.
+func PublishAssignment(ctx context.Context, course *pb.Course, testsURL string, assignment *pb.Assignment, targetURLs []string) error {
+	ctx, cancel := context.WithTimeout(ctx, pb.MaxWait)
+	defer cancel()
+
+	cloneDir, err := ioutil.TempDir("", "release")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(cloneDir)
+
+	testsDir := filepath.Join(cloneDir, course.TestsRepoName())
+	// clone URLs contain access tokens, and are therefore not included in errors
+	if err := runGit(ctx, "", "clone", "--quiet", "--depth", "1", "--", testsURL, testsDir); err != nil {
+		return fmt.Errorf("failed to clone %s repository: %w", course.TestsRepoName(), err)
+	}
+	releaseDir := filepath.Join(testsDir, filepath.FromSlash(course.GetAssignmentsDir()), assignment.GetName(), releaseFolder)
+	if info, err := os.Stat(releaseDir); err != nil || !info.IsDir() {
+		return fmt.Errorf("assignment %s has no %s folder in the %s repository", assignment.GetName(), releaseFolder, course.TestsRepoName())
+	}
+
+	var failed []string
+	for i, targetURL := range targetURLs {
+		repoDir := filepath.Join(cloneDir, fmt.Sprintf("target%d", i))
+		if err := publish(ctx, releaseDir, targetURL, repoDir, assignment.GetName()); err != nil {
+			failed = append(failed, fmt.Sprintf("repository %d: %v", i+1, err))
+		}
+	}
+	if len(failed) > 0 {
+		return fmt.Errorf("failed to publish assignment %s to %d of %d repositories: %s",
+			assignment.GetName(), len(failed), len(targetURLs), strings.Join(failed, "; "))
+	}
+	return nil
+}
+
+// publish clones the target repository into repoDir, copies the content of the release folder
+// into the assignment's folder, and pushes it as a single commit, unless it was published before.
+func publish(ctx context.Context, releaseDir, targetURL, repoDir, assignmentName string) error {
+	if err := runGit(ctx, "", "clone", "--quiet", "--", targetURL, repoDir); err != nil {
+		return err
+	}
+	if err := copyDir(releaseDir, filepath.Join(repoDir, assignmentName)); err != nil {
+		return err
+	}
+	if err := runGit(ctx, repoDir, "add", "--all"); err != nil {
+		return err
+	}
+	// nothing to commit if the content was published before
+	if err := runGit(ctx, repoDir, "diff", "--cached", "--quiet"); err == nil {
+		return nil
+	}
+	if err := runGit(ctx, repoDir, "commit", "--quiet", "-m", ReleaseCommitPrefix+assignmentName); err != nil {
+		return err
+	}
+	return runGit(ctx, repoDir, "push", "--quiet", "origin", "HEAD")
+}
+
+// runGit runs the given git command in the given repository, or in the current directory if dir
+// is empty. The arguments are passed to git directly, without a shell interpreting them.
+// The command's output is not included in errors, since it may contain clone URLs.
+func runGit(ctx context.Context, dir, command string, args ...string) error {
+	cmd := exec.CommandContext(ctx, "git", append([]string{command}, args...)...)
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(),
+		"GIT_AUTHOR_NAME=QuickFeed", "GIT_AUTHOR_EMAIL=quickfeed@localhost",
+		"GIT_COMMITTER_NAME=QuickFeed", "GIT_COMMITTER_EMAIL=quickfeed@localhost")
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("git %s: %w", command, err)
+	}
+	return nil
+}
+
+// copyDir copies the files of the src folder into the dst folder, creating dst if needed.
+func copyDir(src, dst string) error {
+	return filepath.Walk(src, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dst, rel)
+		if info.IsDir() {
+			return os.MkdirAll(target, 0o755)
+		}
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+		content, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		return ioutil.WriteFile(target, content, info.Mode().Perm())
+	})
+}
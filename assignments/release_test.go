@@ -0,0 +1,84 @@
+package assignments
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+)
+
+// git runs git with the given arguments in the given directory, and returns its output.
+func git(t *testing.T, dir string, args ...string) string {
+	t.Helper()
+	cmd := exec.Command("git", append([]string{"-c", "user.name=test", "-c", "user.email=test@localhost"}, args...)...)
+	cmd.Dir = dir
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		t.Fatalf("git %v: %v: %s", args, err, out)
+	}
+	return strings.TrimSpace(string(out))
+}
+
+func TestPublishAssignment(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+	// the course keeps its assignment folders in a folder of its tests repository
+	course := &pb.Course{TestsRepo: "dat520-tests", AssignmentsDir: "labs"}
+	dir := t.TempDir()
+	testsDir := filepath.Join(dir, course.GetTestsRepo())
+	releaseDir := filepath.Join(testsDir, "labs", "lab1", releaseFolder)
+	if err := os.MkdirAll(releaseDir, 0o700); err != nil {
+		t.Fatal(err)
+	}
+	for name, contents := range map[string]string{
+		filepath.Join(testsDir, "labs", "lab1", "assignment.yml"): "assignmentid: 1\n",
+		filepath.Join(releaseDir, "main.go"):                      "package main\n",
+		filepath.Join(releaseDir, "README.md"):                    "# Lab 1\n",
+	} {
+		if err := ioutil.WriteFile(name, []byte(contents), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	git(t, testsDir, "init", "--quiet")
+	git(t, testsDir, "add", "--all")
+	git(t, testsDir, "commit", "--quiet", "-m", "lab1")
+
+	var targets []string
+	for _, name := range []string{pb.AssignmentRepo, "student-labs"} {
+		target := filepath.Join(dir, name+".git")
+		if err := os.Mkdir(target, 0o700); err != nil {
+			t.Fatal(err)
+		}
+		git(t, target, "init", "--quiet", "--bare")
+		targets = append(targets, target)
+	}
+
+	assignment := &pb.Assignment{Name: "lab1"}
+	for i := 0; i < 2; i++ {
+		// publishing again leaves the repositories unchanged
+		if err := PublishAssignment(context.Background(), course, testsDir, assignment, targets); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, target := range targets {
+		if got := git(t, target, "rev-list", "--count", "HEAD"); got != "1" {
+			t.Errorf("%s has %s commits, want 1", filepath.Base(target), got)
+		}
+		if got := git(t, target, "log", "-1", "--format=%s"); got != ReleaseCommitPrefix+"lab1" {
+			t.Errorf("%s commit message = %q, want %q", filepath.Base(target), got, ReleaseCommitPrefix+"lab1")
+		}
+		if got := git(t, target, "ls-tree", "-r", "--name-only", "HEAD"); got != "lab1/README.md\nlab1/main.go" {
+			t.Errorf("%s files = %q, want lab1/README.md and lab1/main.go", filepath.Base(target), got)
+		}
+	}
+
+	if err := PublishAssignment(context.Background(), course, testsDir, &pb.Assignment{Name: "lab2"}, targets); err == nil {
+		t.Error("PublishAssignment() for assignment without release folder succeeded, want error")
+	}
+}
@@ -0,0 +1,75 @@
+package assignments
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/scm"
+	"go.uber.org/zap"
+)
+
+// ValidateAssignments clones the course's tests repository and checks the assignments in it,
+// as when the course's assignments are updated from the repository, but without updating the
+// database or building the course's Dockerfile. Teachers can thus check their changes before
+// pushing them to the tests repository. An error is returned if the repository cannot be cloned.
+func ValidateAssignments(ctx context.Context, logger *zap.SugaredLogger, sc scm.SCM, course *pb.Course) (*pb.AssignmentValidation, error) {
+	ctx, cancel := context.WithTimeout(ctx, pb.MaxWait)
+	defer cancel()
+
+	cloneDir, err := ioutil.TempDir("", course.TestsRepoName())
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(cloneDir)
+
+	assignmentsDir, err := cloneTestsRepo(ctx, logger, sc, course, cloneDir)
+	if err != nil {
+		return nil, err
+	}
+	return checkTestsFolder(assignmentsDir, course)
+}
+
+// checkTestsFolder returns the assignments in the given assignments folder with the
+// errors preventing them from being updated, or, if there are none, the warnings.
+func checkTestsFolder(dir string, course *pb.Course) (*pb.AssignmentValidation, error) {
+	validation := &pb.AssignmentValidation{CourseID: course.GetID()}
+	folder, err := parseTestsFolder(dir, course)
+	if err != nil {
+		var problems FileErrors
+		if !errors.As(err, &problems) {
+			return nil, err
+		}
+		validation.Problems = problems.toProto()
+		return validation, nil
+	}
+	validation.Assignments = folder.assignments
+	validation.Problems = assignmentWarnings(folder).toProto()
+	return validation, nil
+}
+
+// assignmentWarnings returns the problems with the assignments that do not prevent them from
+// being updated, but may not be intended: assignments without an assignmentid, which are listed
+// first, assignments without a deadline, and manually approved assignments without grading criteria.
+func assignmentWarnings(folder *testsFolder) FileErrors {
+	var warnings FileErrors
+	warn := func(file, field, reason string) {
+		warnings = append(warnings, &FileError{File: file, Field: field, Reason: reason, Warning: true})
+	}
+	for _, assignment := range folder.assignments {
+		file := folder.files[assignment.GetName()]
+		if assignment.GetOrder() == 0 {
+			warn(file, "assignmentid", "is missing; the assignment is listed first")
+		}
+		if assignment.GetDeadline() == "" {
+			warn(file, "deadline", "is missing")
+		}
+		if !assignment.GetAutoApprove() && len(assignment.GetGradingBenchmarks()) == 0 {
+			warn(path.Join(path.Dir(file), criteriaFile), "", "is missing; the assignment is approved manually without grading criteria")
+		}
+	}
+	return warnings
+}
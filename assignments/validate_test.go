@@ -0,0 +1,81 @@
+package assignments
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func writeTestsFolder(t *testing.T, files map[string]string) string {
+	t.Helper()
+	dir := t.TempDir()
+	for file, contents := range files {
+		name := filepath.Join(dir, filepath.FromSlash(file))
+		if err := os.MkdirAll(filepath.Dir(name), 0o700); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(name, []byte(contents), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return dir
+}
+
+func TestCheckTestsFolderWarnings(t *testing.T) {
+	dir := writeTestsFolder(t, map[string]string{
+		"lab1/assignment.yml": "assignmentid: 1\ndeadline: \"2022-09-01 23:59\"\nautoapprove: true\n",
+		"lab2/assignment.yml": "assignmentid: 2\n",
+		"lab3/assignment.yml": "deadline: \"2022-10-01 23:59\"\n",
+		"lab3/criteria.json":  `[{"heading": "Code quality", "criteria": [{"description": "Readable"}]}]`,
+	})
+	validation, err := checkTestsFolder(dir, &pb.Course{ID: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(validation.GetAssignments()) != 3 {
+		t.Errorf("checkTestsFolder() found %d assignments, want 3", len(validation.GetAssignments()))
+	}
+	want := []*pb.AssignmentFileError{
+		{File: "lab2/assignment.yml", Field: "deadline", Reason: "is missing", Warning: true},
+		{File: "lab2/criteria.json", Reason: "is missing; the assignment is approved manually without grading criteria", Warning: true},
+		{File: "lab3/assignment.yml", Field: "assignmentid", Reason: "is missing; the assignment is listed first", Warning: true},
+	}
+	if diff := cmp.Diff(want, validation.GetProblems(), protocmp.Transform()); diff != "" {
+		t.Errorf("checkTestsFolder() problems mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestCheckTestsFolderErrors(t *testing.T) {
+	dir := writeTestsFolder(t, map[string]string{
+		"lab1/assignment.yml": "assignmentid: 1\ndeadline: \"2022-09-01 23:59\"\n",
+		"lab2/assignment.yml": "assignmentid: 1\ndeadline: \"2022-10-01 23:59\"\n",
+		"lab3/assignment.yml": "assignmentid: 3\ndeadline: tomorrow\n",
+		"lab4/assignment.yml": "assignmentid: 4\n",
+		"lab4/criteria.json":  `[{"heading": "Code quality"`,
+	})
+	validation, err := checkTestsFolder(dir, &pb.Course{ID: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(validation.GetAssignments()) != 0 {
+		t.Errorf("checkTestsFolder() found %d assignments, want none for tests folder with errors", len(validation.GetAssignments()))
+	}
+	want := []*pb.AssignmentFileError{
+		{File: "lab2/assignment.yml", Field: "assignmentid", Reason: "1 is also the assignmentid of lab1/assignment.yml"},
+		{File: "lab3/assignment.yml", Field: "deadline", Reason: `invalid time "tomorrow"`},
+		{File: "lab4/criteria.json", Reason: "could not unmarshal criteria.json: unexpected end of JSON input"},
+	}
+	if diff := cmp.Diff(want, validation.GetProblems(), protocmp.Transform()); diff != "" {
+		t.Errorf("checkTestsFolder() problems mismatch (-want +got):\n%s", diff)
+	}
+
+	// the update fails with the same problems
+	if _, _, err := parseAssignments(dir, &pb.Course{ID: 1}); err == nil {
+		t.Error("parseAssignments() succeeded, want error")
+	}
+}
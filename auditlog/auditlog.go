@@ -0,0 +1,128 @@
+// Package auditlog records the actions performed by teachers and admins, such as
+// updating enrollments, submissions and groups, in the database and the server log.
+package auditlog
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// redacted lists the names of the fields that are cleared from recorded records.
+var redacted = map[protoreflect.Name]bool{
+	"accessToken": true,
+}
+
+// Store stores audit log entries.
+type Store interface {
+	CreateAuditEntry(*pb.AuditEntry) error
+}
+
+// Log records audit log entries.
+type Log struct {
+	logger *zap.SugaredLogger
+	store  Store
+	now    func() time.Time
+}
+
+// New returns an audit log recording entries in the given store and logger.
+func New(logger *zap.SugaredLogger, store Store) *Log {
+	return &Log{
+		logger: logger,
+		store:  store,
+		now:    time.Now,
+	}
+}
+
+// Record records an action performed by the given user in the given course; the course ID
+// is zero for actions outside a course. The before and after records are the state of the
+// record affected by the action, if any, and are recorded without secrets such as access tokens.
+// The keysAndValues are additional details about the action.
+// Failures to store the entry are logged, but do not affect the action.
+func (l *Log) Record(usr *pb.User, courseID uint64, action string, before, after proto.Message, keysAndValues ...interface{}) {
+	l.logger.With("audit", true, "user", usr.GetLogin(), "userID", usr.GetID()).
+		Infow(action, keysAndValues...)
+
+	entry := &pb.AuditEntry{
+		CourseID:  courseID,
+		UserID:    usr.GetID(),
+		Login:     usr.GetLogin(),
+		Action:    action,
+		Details:   details(keysAndValues),
+		Before:    marshal(before),
+		After:     marshal(after),
+		Timestamp: l.now().UTC().Format(pb.TimeLayout),
+	}
+	if err := l.store.CreateAuditEntry(entry); err != nil {
+		l.logger.Errorf("Failed to record audit log entry %q by %s: %v", action, usr.GetLogin(), err)
+	}
+}
+
+// details returns the given keys and values as a JSON object,
+// or the empty string if there are none.
+func details(keysAndValues []interface{}) string {
+	if len(keysAndValues) == 0 {
+		return ""
+	}
+	fields := make(map[string]interface{})
+	for i := 0; i < len(keysAndValues); i += 2 {
+		var value interface{}
+		if i+1 < len(keysAndValues) {
+			value = keysAndValues[i+1]
+		}
+		fields[fmt.Sprint(keysAndValues[i])] = value
+	}
+	b, err := json.Marshal(fields)
+	if err != nil {
+		return fmt.Sprint(keysAndValues...)
+	}
+	return string(b)
+}
+
+// marshal returns the record encoded as JSON without secrets,
+// or the empty string if there is no record.
+func marshal(record proto.Message) string {
+	if record == nil || !record.ProtoReflect().IsValid() {
+		return ""
+	}
+	clone := proto.Clone(record)
+	redact(clone.ProtoReflect())
+	b, err := protojson.Marshal(clone)
+	if err != nil {
+		return ""
+	}
+	return string(b)
+}
+
+// redact clears the redacted fields of the message and its nested messages.
+func redact(m protoreflect.Message) {
+	var cleared []protoreflect.FieldDescriptor
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		switch {
+		case redacted[fd.Name()]:
+			cleared = append(cleared, fd)
+		case fd.IsList() && fd.Message() != nil:
+			list := v.List()
+			for i := 0; i < list.Len(); i++ {
+				redact(list.Get(i).Message())
+			}
+		case fd.IsMap() && fd.MapValue().Message() != nil:
+			v.Map().Range(func(_ protoreflect.MapKey, v protoreflect.Value) bool {
+				redact(v.Message())
+				return true
+			})
+		case fd.Message() != nil && !fd.IsMap():
+			redact(v.Message())
+		}
+		return true
+	})
+	for _, fd := range cleared {
+		m.Clear(fd)
+	}
+}
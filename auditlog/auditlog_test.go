@@ -0,0 +1,111 @@
+package auditlog
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/google/go-cmp/cmp"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+type fakeStore struct {
+	entries []*pb.AuditEntry
+	err     error
+}
+
+func (f *fakeStore) CreateAuditEntry(entry *pb.AuditEntry) error {
+	f.entries = append(f.entries, entry)
+	return f.err
+}
+
+func TestRecord(t *testing.T) {
+	store := &fakeStore{}
+	log := New(zap.NewNop().Sugar(), store)
+	now := time.Date(2021, 3, 4, 10, 30, 0, 0, time.UTC)
+	// timestamps are recorded in UTC, regardless of the server's time zone
+	log.now = func() time.Time { return now.In(time.FixedZone("CET", 3600)) }
+
+	teacher := &pb.User{ID: 1, Login: "teacher"}
+	before := &pb.Enrollment{ID: 3, CourseID: 2, UserID: 4, Status: pb.Enrollment_PENDING}
+	after := &pb.Enrollment{
+		ID:       3,
+		CourseID: 2,
+		UserID:   4,
+		Status:   pb.Enrollment_STUDENT,
+		User: &pb.User{ID: 4, Login: "student", RemoteIdentities: []*pb.RemoteIdentity{
+			{Provider: "github", RemoteID: 40, AccessToken: "secret"},
+		}},
+	}
+	log.Record(teacher, 2, "update enrollment", before, after, "user", "student", "slipDays", 2)
+
+	if len(store.entries) != 1 {
+		t.Fatalf("Record() stored %d entries, want 1", len(store.entries))
+	}
+	entry := store.entries[0]
+	want := &pb.AuditEntry{
+		CourseID:  2,
+		UserID:    1,
+		Login:     "teacher",
+		Action:    "update enrollment",
+		Timestamp: now.Format(pb.TimeLayout),
+	}
+	got := &pb.AuditEntry{
+		CourseID:  entry.GetCourseID(),
+		UserID:    entry.GetUserID(),
+		Login:     entry.GetLogin(),
+		Action:    entry.GetAction(),
+		Timestamp: entry.GetTimestamp(),
+	}
+	if diff := cmp.Diff(want, got, protocmp.Transform()); diff != "" {
+		t.Errorf("Record() mismatch (-want +got):\n%s", diff)
+	}
+
+	var details map[string]interface{}
+	if err := json.Unmarshal([]byte(entry.GetDetails()), &details); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(map[string]interface{}{"user": "student", "slipDays": 2.0}, details); diff != "" {
+		t.Errorf("Record() details mismatch (-want +got):\n%s", diff)
+	}
+
+	var recordedBefore, recordedAfter pb.Enrollment
+	if err := protojson.Unmarshal([]byte(entry.GetBefore()), &recordedBefore); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(before, &recordedBefore, protocmp.Transform()); diff != "" {
+		t.Errorf("Record() before mismatch (-want +got):\n%s", diff)
+	}
+	if err := protojson.Unmarshal([]byte(entry.GetAfter()), &recordedAfter); err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(entry.GetAfter(), "secret") || recordedAfter.GetUser().GetRemoteIdentities()[0].GetAccessToken() != "" {
+		t.Errorf("Record() after = %s, want access token redacted", entry.GetAfter())
+	}
+	if after.GetUser().GetRemoteIdentities()[0].GetAccessToken() != "secret" {
+		t.Error("Record() redacted the access token of the given record, want a redacted copy")
+	}
+	if recordedAfter.GetUser().GetRemoteIdentities()[0].GetRemoteID() != 40 {
+		t.Errorf("Record() after = %s, want remote identity without access token", entry.GetAfter())
+	}
+}
+
+func TestRecordWithoutRecords(t *testing.T) {
+	store := &fakeStore{err: errors.New("database unavailable")}
+	log := New(zap.NewNop().Sugar(), store)
+
+	var deleted *pb.Group
+	log.Record(&pb.User{ID: 1, Login: "admin"}, 0, "delete group", deleted, nil)
+	if len(store.entries) != 1 {
+		t.Fatalf("Record() stored %d entries, want 1", len(store.entries))
+	}
+	entry := store.entries[0]
+	if entry.GetBefore() != "" || entry.GetAfter() != "" || entry.GetDetails() != "" {
+		t.Errorf("Record() = %v, want entry without records and details", entry)
+	}
+}
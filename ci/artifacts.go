@@ -0,0 +1,164 @@
+package ci
+
+import (
+	"archive/tar"
+	"context"
+	"io"
+	"io/ioutil"
+	"mime"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/artifacts"
+	"github.com/autograde/quickfeed/database"
+	"github.com/autograde/quickfeed/kit/score"
+	"go.uber.org/zap"
+)
+
+const (
+	// artifactsEnv names the environment variable holding the directory where a job
+	// can write files, such as coverage reports, to be stored as artifacts of the submission.
+	artifactsEnv = "QUICKFEED_ARTIFACTS"
+	// BuildLogArtifact is the name of the artifact holding the full output of the job.
+	BuildLogArtifact = "build.log"
+)
+
+var (
+	// maxArtifacts is the maximum number of files stored from a job's artifacts directory.
+	maxArtifacts = 20
+	// maxArtifactSize is the maximum size of an artifact; larger artifacts are not stored.
+	maxArtifactSize = int64(10 << 20) // bytes
+)
+
+// artifact is a file produced by a job.
+type artifact struct {
+	name    string
+	content []byte
+}
+
+// readArtifactsDir reports the regular files in the given directory as artifacts of the job.
+func readArtifactsDir(dir string, job *Job) error {
+	count := 0
+	return filepath.Walk(dir, func(file string, info os.FileInfo, err error) error {
+		if err != nil || !info.Mode().IsRegular() || info.Size() > maxArtifactSize {
+			return err
+		}
+		if count++; count > maxArtifacts {
+			return nil
+		}
+		name, err := filepath.Rel(dir, file)
+		if err != nil {
+			return err
+		}
+		content, err := ioutil.ReadFile(file)
+		if err != nil {
+			return err
+		}
+		job.Artifact(filepath.ToSlash(name), content)
+		return nil
+	})
+}
+
+// readArtifactsTar reports the regular files in the given tar archive of the job's
+// artifacts directory as artifacts of the job. Archived names start with the directory's name.
+func readArtifactsTar(r io.Reader, job *Job) error {
+	tr := tar.NewReader(r)
+	count := 0
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if header.Typeflag != tar.TypeReg || header.Size > maxArtifactSize {
+			continue
+		}
+		if count++; count > maxArtifacts {
+			return nil
+		}
+		name := header.Name
+		if i := strings.Index(name, "/"); i >= 0 {
+			name = name[i+1:]
+		}
+		content, err := ioutil.ReadAll(tr)
+		if err != nil {
+			return err
+		}
+		job.Artifact(name, content)
+	}
+}
+
+// withoutScores returns the output without the JSON score strings, which hold the run's secret.
+func withoutScores(out string) string {
+	lines := strings.Split(out, "\n")
+	filtered := lines[:0]
+	for _, line := range lines {
+		if !score.HasPrefix(line) {
+			filtered = append(filtered, line)
+		}
+	}
+	return strings.Join(filtered, "\n")
+}
+
+// storeArtifacts stores the artifacts of the submission's test run in the store,
+// replacing the artifacts of previous test runs of the submission.
+func storeArtifacts(ctx context.Context, logger *zap.SugaredLogger, db database.Database, store artifacts.Store, submission *pb.Submission, produced []artifact) {
+	previous, err := db.GetArtifacts(submission.GetID())
+	if err != nil {
+		logger.Errorf("Failed to get artifacts of submission %d: %v", submission.GetID(), err)
+		return
+	}
+	created := time.Now().Format(pb.TimeLayout)
+	stored := make([]*pb.Artifact, 0, len(produced))
+	keys := make(map[string]bool)
+	for _, a := range produced {
+		if !artifacts.ValidName(a.name) || keys[a.name] {
+			logger.Errorf("Skipping artifact %q of submission %d: invalid or duplicate name", a.name, submission.GetID())
+			continue
+		}
+		key := artifacts.Key(submission.GetID(), a.name)
+		if err := store.Put(ctx, key, a.content); err != nil {
+			logger.Errorf("Failed to store artifact %q of submission %d: %v", a.name, submission.GetID(), err)
+			continue
+		}
+		keys[a.name] = true
+		stored = append(stored, &pb.Artifact{
+			SubmissionID: submission.GetID(),
+			Name:         a.name,
+			Size:         uint64(len(a.content)),
+			ContentType:  contentType(a.name, a.content),
+			Created:      created,
+			Key:          key,
+		})
+	}
+	if err := db.UpdateArtifacts(submission.GetID(), stored); err != nil {
+		logger.Errorf("Failed to update artifacts of submission %d: %v", submission.GetID(), err)
+		return
+	}
+	// remove the content of previous artifacts that were not replaced
+	for _, a := range previous {
+		if !keys[a.GetName()] {
+			if err := store.Delete(ctx, a.GetKey()); err != nil {
+				logger.Errorf("Failed to delete artifact %q of submission %d: %v", a.GetName(), submission.GetID(), err)
+			}
+		}
+	}
+}
+
+// contentType returns the MIME type of the named artifact, based on its extension or content.
+func contentType(name string, content []byte) string {
+	if name == BuildLogArtifact {
+		return "text/plain; charset=utf-8"
+	}
+	if ct := mime.TypeByExtension(path.Ext(name)); ct != "" {
+		return ct
+	}
+	return http.DetectContentType(content)
+}
@@ -2,8 +2,15 @@ package ci
 
 import (
 	"context"
+	"fmt"
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
 )
 
+// heartbeatInterval is the interval between heartbeats from running jobs.
+var heartbeatInterval = 30 * time.Second
+
 // Job describes how to execute a CI job.
 type Job struct {
 	// Name describes the running job; mainly used to name docker containers.
@@ -14,6 +21,43 @@ type Job struct {
 	Dockerfile string
 	// Commands is a list of shell commands to run as part of the job.
 	Commands []string
+	// Env holds environment variables of the commands, in the form "NAME=value".
+	Env []string
+	// Timeout is how long the job may run, if given by the assignment's run configuration.
+	// It is enforced by RunTests; the assignment's container timeout takes precedence.
+	Timeout time.Duration
+	// Limits holds the resources the job may use; only enforced by container runners.
+	Limits Limits
+	// Heartbeat, if set, is called periodically by the runner while the job is alive.
+	Heartbeat func()
+	// Artifact, if set, is called by the runner for each artifact produced by the job:
+	// first the job's full output, named BuildLogArtifact, and then the files written to
+	// the directory named by the job's QUICKFEED_ARTIFACTS environment variable.
+	Artifact func(name string, content []byte)
+}
+
+// Limits holds the resource limits of a job; zero values mean no limit.
+type Limits struct {
+	// CPU is the CPU limit in millicores, where 1000 is one CPU.
+	CPU uint32
+	// Memory is the memory limit in megabytes.
+	Memory uint32
+	// Pids is the maximum number of processes; zero means the runner's default.
+	Pids uint32
+}
+
+// LimitExceededError is returned by a runner when a job is stopped for exceeding a resource limit.
+type LimitExceededError struct {
+	Limit pb.Submission_ExceededLimit
+	Err   error
+}
+
+func (e *LimitExceededError) Error() string {
+	return fmt.Sprintf("%s exceeded: %v", e.Limit, e.Err)
+}
+
+func (e *LimitExceededError) Unwrap() error {
+	return e.Err
 }
 
 // Runner contains methods for running user provided code in isolation.
@@ -21,3 +65,42 @@ type Runner interface {
 	// Run should synchronously execute the described job and return the output.
 	Run(context.Context, *Job) (string, error)
 }
+
+// Pinger is implemented by runners that depend on a daemon, such as Docker, to run jobs.
+type Pinger interface {
+	// Ping returns an error if the runner's daemon is unavailable.
+	Ping(context.Context) error
+}
+
+// Ping returns an error if the given runner depends on a daemon that is unavailable.
+// Runners that do not implement Pinger are always available.
+func Ping(ctx context.Context, runner Runner) error {
+	if pinger, ok := runner.(Pinger); ok {
+		return pinger.Ping(ctx)
+	}
+	return nil
+}
+
+// startHeartbeat calls the job's heartbeat function every heartbeatInterval
+// for as long as alive returns true, until the returned stop function is called.
+func startHeartbeat(job *Job, alive func() bool) (stop func()) {
+	if job.Heartbeat == nil {
+		return func() {}
+	}
+	done := make(chan struct{})
+	go func() {
+		ticker := time.NewTicker(heartbeatInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				if alive() {
+					job.Heartbeat()
+				}
+			}
+		}
+	}()
+	return func() { close(done) }
+}
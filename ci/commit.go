@@ -0,0 +1,67 @@
+package ci
+
+import (
+	"fmt"
+	"path"
+	"strings"
+)
+
+// pinCommit makes the job's commands test the given commit of the repository at cloneURL,
+// rather than the head of its default branch, which may have moved on since the commit was
+// pushed. A checkout of the commit is inserted after each command cloning the repository;
+// the commit is fetched if the clone is shallow. The job fails if the commit cannot be checked out.
+// Returns true if the commit was pinned, and false if the commands do not clone the repository,
+// or the commit is not a valid commit hash, e.g., if it is unknown.
+func pinCommit(job *Job, cloneURL, commit string) bool {
+	if cloneURL == "" || !validCommit.MatchString(commit) {
+		return false
+	}
+	pinned := false
+	var commands []string
+	for _, command := range job.Commands {
+		commands = append(commands, command)
+		if dir, ok := cloneDir(command, cloneURL); ok {
+			commands = append(commands, checkoutCommand(dir, commit))
+			pinned = true
+		}
+	}
+	job.Commands = commands
+	return pinned
+}
+
+// cloneDir returns the directory that the given command clones the repository at cloneURL into,
+// and true if the command is a git clone of the repository.
+func cloneDir(command, cloneURL string) (string, bool) {
+	fields := strings.Fields(command)
+	if len(fields) < 3 || fields[0] != "git" || fields[1] != "clone" {
+		return "", false
+	}
+	for i, field := range fields[2:] {
+		if unquote(field) != cloneURL {
+			continue
+		}
+		for _, arg := range fields[i+3:] {
+			if !strings.HasPrefix(arg, "-") {
+				return unquote(arg), true
+			}
+		}
+		// git clones into a directory named after the repository, if no directory is given
+		return strings.TrimSuffix(path.Base(cloneURL), ".git"), true
+	}
+	return "", false
+}
+
+// checkoutCommand returns the command checking out the commit in the repository in dir,
+// fetching the commit first if it is not in the clone.
+func checkoutCommand(dir, commit string) string {
+	git := fmt.Sprintf("git -C %q", dir)
+	return fmt.Sprintf(`{ %[1]s checkout --quiet %[2]s || { %[1]s fetch --quiet --depth 1 origin %[2]s && %[1]s checkout --quiet %[2]s; }; } || { printf "Failed to check out commit %[2]s\n"; exit 1; }`, git, commit)
+}
+
+// unquote returns the given shell word without surrounding quotes.
+func unquote(word string) string {
+	if len(word) >= 2 && (word[0] == '"' || word[0] == '\'') && word[len(word)-1] == word[0] {
+		return word[1 : len(word)-1]
+	}
+	return word
+}
@@ -0,0 +1,66 @@
+package ci
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+const (
+	pinURL    = "https://github.com/qf101/student-labs"
+	pinnedSHA = "4bb5bd5e1bd3cb6b2f1a0c1e5f0ff0cd2a4b8e21"
+)
+
+func TestCloneDir(t *testing.T) {
+	tests := []struct {
+		command string
+		wantDir string
+		wantOK  bool
+	}{
+		{"git clone " + pinURL + " $ASSIGNMENTS", "$ASSIGNMENTS", true},
+		{"git clone --depth 1 " + pinURL + " \"$ASSIGNMENTS\"", "$ASSIGNMENTS", true},
+		{"git clone '" + pinURL + "' -q labs", "labs", true},
+		{"git clone " + pinURL, "student-labs", true},
+		{"git clone " + pinURL + ".git", "", false},
+		{"git clone https://github.com/qf101/tests $TESTS", "", false},
+		{"echo git clone " + pinURL, "", false},
+		{"cd $ASSIGNMENTS", "", false},
+	}
+	for _, test := range tests {
+		dir, ok := cloneDir(test.command, pinURL)
+		if dir != test.wantDir || ok != test.wantOK {
+			t.Errorf("cloneDir(%q) = (%q, %t), want (%q, %t)", test.command, dir, ok, test.wantDir, test.wantOK)
+		}
+	}
+}
+
+func TestPinCommit(t *testing.T) {
+	commands := []string{
+		"cd /quickfeed",
+		"git clone " + pinURL + " $ASSIGNMENTS",
+		"go test ./...",
+	}
+	job := &Job{Commands: append([]string{}, commands...)}
+	if !pinCommit(job, pinURL, pinnedSHA) {
+		t.Fatal("pinCommit() = false, want true")
+	}
+	want := []string{commands[0], commands[1], checkoutCommand("$ASSIGNMENTS", pinnedSHA), commands[2]}
+	if diff := cmp.Diff(want, job.Commands); diff != "" {
+		t.Errorf("pinCommit() mismatch (-want +got):\n%s", diff)
+	}
+
+	for _, commit := range []string{"", "main", "4bb5bd5e; rm -rf /"} {
+		job := &Job{Commands: append([]string{}, commands...)}
+		if pinCommit(job, pinURL, commit) {
+			t.Errorf("pinCommit(%q) = true, want false", commit)
+		}
+		if diff := cmp.Diff(commands, job.Commands); diff != "" {
+			t.Errorf("pinCommit(%q) changed commands (-want +got):\n%s", commit, diff)
+		}
+	}
+
+	job = &Job{Commands: append([]string{}, commands...)}
+	if pinCommit(job, "https://github.com/qf101/other-labs", pinnedSHA) {
+		t.Error("pinCommit() = true for a repository not cloned by the commands, want false")
+	}
+}
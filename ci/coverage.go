@@ -0,0 +1,173 @@
+package ci
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"math"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+
+	pb "github.com/autograde/quickfeed/ag"
+)
+
+// goCoverProfileHeader starts the cover profiles written by go test -coverprofile.
+const goCoverProfileHeader = "mode: "
+
+// extractCoverage returns the per-file coverage found in the coverage reports among
+// the artifacts produced by a job, sorted by path. Go cover profiles, JaCoCo XML reports
+// and coverage.py JSON reports are recognized; other artifacts are ignored.
+func extractCoverage(produced []artifact) []*pb.FileCoverage {
+	files := make(map[string]*pb.FileCoverage)
+	add := func(filePath string, covered, total uint32) {
+		file, ok := files[filePath]
+		if !ok {
+			file = &pb.FileCoverage{Path: filePath}
+			files[filePath] = file
+		}
+		file.Covered += covered
+		file.Total += total
+	}
+	// blocks of Go cover profiles may be reported by several profiles, and are therefore merged before adding them
+	blocks := make(map[string]map[string]*goCoverBlock)
+	for _, a := range produced {
+		switch {
+		case bytes.HasPrefix(a.content, []byte(goCoverProfileHeader)):
+			parseGoCoverProfile(a.content, blocks)
+		case strings.HasSuffix(a.name, ".xml"):
+			parseJaCoCoReport(a.content, add)
+		case strings.HasSuffix(a.name, ".json"):
+			parseCoveragePyReport(a.content, add)
+		}
+	}
+	for filePath, fileBlocks := range blocks {
+		var covered, total uint32
+		for _, block := range fileBlocks {
+			total += block.statements
+			if block.covered {
+				covered += block.statements
+			}
+		}
+		add(filePath, covered, total)
+	}
+
+	coverage := make([]*pb.FileCoverage, 0, len(files))
+	for _, file := range files {
+		if file.GetTotal() > 0 {
+			coverage = append(coverage, file)
+		}
+	}
+	sort.Slice(coverage, func(i, j int) bool {
+		return coverage[i].GetPath() < coverage[j].GetPath()
+	})
+	return coverage
+}
+
+// totalCoverage returns the percentage of statements covered in the given files, rounded to two decimals.
+func totalCoverage(files []*pb.FileCoverage) float64 {
+	var covered, total uint64
+	for _, file := range files {
+		covered += uint64(file.GetCovered())
+		total += uint64(file.GetTotal())
+	}
+	if total == 0 {
+		return 0
+	}
+	return math.Round(float64(covered)/float64(total)*10000) / 100
+}
+
+// goCoverBlock is a block of statements in a Go cover profile.
+type goCoverBlock struct {
+	statements uint32
+	covered    bool
+}
+
+// parseGoCoverProfile adds the blocks of the given Go cover profile to the blocks of each file.
+// Each line of the profile has the format "file.go:line.column,line.column statements count".
+func parseGoCoverProfile(content []byte, blocks map[string]map[string]*goCoverBlock) {
+	for _, line := range strings.Split(string(content), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) != 3 || strings.HasPrefix(line, goCoverProfileHeader) {
+			continue
+		}
+		i := strings.LastIndex(fields[0], ":")
+		if i < 0 {
+			continue
+		}
+		statements, err := strconv.ParseUint(fields[1], 10, 32)
+		if err != nil {
+			continue
+		}
+		count, err := strconv.ParseUint(fields[2], 10, 64)
+		if err != nil {
+			continue
+		}
+		file, position := fields[0][:i], fields[0][i+1:]
+		if blocks[file] == nil {
+			blocks[file] = make(map[string]*goCoverBlock)
+		}
+		block, ok := blocks[file][position]
+		if !ok {
+			block = &goCoverBlock{statements: uint32(statements)}
+			blocks[file][position] = block
+		}
+		block.covered = block.covered || count > 0
+	}
+}
+
+// jacocoReport is the part of a JaCoCo XML report holding the line coverage of each source file.
+type jacocoReport struct {
+	XMLName  xml.Name `xml:"report"`
+	Packages []struct {
+		Name        string `xml:"name,attr"`
+		SourceFiles []struct {
+			Name     string `xml:"name,attr"`
+			Counters []struct {
+				Type    string `xml:"type,attr"`
+				Missed  uint32 `xml:"missed,attr"`
+				Covered uint32 `xml:"covered,attr"`
+			} `xml:"counter"`
+		} `xml:"sourcefile"`
+	} `xml:"package"`
+}
+
+// parseJaCoCoReport adds the line coverage of each source file in the given JaCoCo XML report.
+func parseJaCoCoReport(content []byte, add func(filePath string, covered, total uint32)) {
+	var report jacocoReport
+	if err := xml.Unmarshal(content, &report); err != nil {
+		return
+	}
+	for _, pkg := range report.Packages {
+		for _, sourceFile := range pkg.SourceFiles {
+			for _, counter := range sourceFile.Counters {
+				if counter.Type == "LINE" {
+					add(path.Join(pkg.Name, sourceFile.Name), counter.Covered, counter.Covered+counter.Missed)
+				}
+			}
+		}
+	}
+}
+
+// coveragePyReport is the part of a coverage.py JSON report holding the statement coverage of each file.
+type coveragePyReport struct {
+	Meta  *json.RawMessage `json:"meta"`
+	Files map[string]struct {
+		Summary struct {
+			CoveredLines  uint32 `json:"covered_lines"`
+			NumStatements uint32 `json:"num_statements"`
+		} `json:"summary"`
+	} `json:"files"`
+}
+
+// parseCoveragePyReport adds the statement coverage of each file in the given coverage.py JSON report.
+func parseCoveragePyReport(content []byte, add func(filePath string, covered, total uint32)) {
+	var report coveragePyReport
+	if err := json.Unmarshal(content, &report); err != nil || report.Meta == nil {
+		return
+	}
+	for filePath, file := range report.Files {
+		add(filePath, file.Summary.CoveredLines, file.Summary.NumStatements)
+	}
+}
@@ -0,0 +1,155 @@
+package ci
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/internal/qtest"
+	"github.com/google/go-cmp/cmp"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func TestExtractCoverage(t *testing.T) {
+	tests := []struct {
+		name     string
+		produced []artifact
+		want     []*pb.FileCoverage
+		coverage float64
+	}{
+		{
+			name: "go cover profiles",
+			produced: []artifact{
+				{name: BuildLogArtifact, content: []byte("mode: set in the build log is not a profile\nok")},
+				{name: "cover.out", content: []byte(`mode: set
+example.com/lab1/add.go:3.24,5.2 2 1
+example.com/lab1/sub.go:3.24,5.2 2 0
+example.com/lab1/sub.go:7.24,9.2 1 1
+`)},
+				// blocks reported by several profiles are counted once, and are covered if covered by any profile
+				{name: "pkg/cover.out", content: []byte(`mode: count
+example.com/lab1/sub.go:3.24,5.2 2 3
+example.com/lab1/sub.go:7.24,9.2 1 0
+example.com/lab1/mul.go:3.24,5.2 3 0
+`)},
+			},
+			want: []*pb.FileCoverage{
+				{Path: "example.com/lab1/add.go", Covered: 2, Total: 2},
+				{Path: "example.com/lab1/mul.go", Covered: 0, Total: 3},
+				{Path: "example.com/lab1/sub.go", Covered: 3, Total: 3},
+			},
+			coverage: 62.5,
+		},
+		{
+			name: "jacoco",
+			produced: []artifact{
+				{name: "jacoco.xml", content: []byte(`<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<!DOCTYPE report PUBLIC "-//JACOCO//DTD Report 1.1//EN" "report.dtd">
+<report name="lab1">
+  <package name="no/uis/lab1">
+    <class name="no/uis/lab1/Calculator" sourcefilename="Calculator.java">
+      <counter type="LINE" missed="100" covered="100"/>
+    </class>
+    <sourcefile name="Calculator.java">
+      <line nr="3" mi="0" ci="3" mb="0" cb="0"/>
+      <counter type="INSTRUCTION" missed="3" covered="12"/>
+      <counter type="LINE" missed="1" covered="2"/>
+    </sourcefile>
+    <sourcefile name="Main.java">
+      <counter type="LINE" missed="0" covered="0"/>
+    </sourcefile>
+    <counter type="LINE" missed="1" covered="2"/>
+  </package>
+  <counter type="LINE" missed="1" covered="2"/>
+</report>`)},
+				{name: "pom.xml", content: []byte(`<project><modelVersion>4.0.0</modelVersion></project>`)},
+			},
+			want: []*pb.FileCoverage{
+				{Path: "no/uis/lab1/Calculator.java", Covered: 2, Total: 3},
+			},
+			coverage: 66.67,
+		},
+		{
+			name: "coverage.py",
+			produced: []artifact{
+				{name: "coverage.json", content: []byte(`{
+  "meta": {"version": "6.3.2", "format": 2},
+  "files": {
+    "lab1/calc.py": {"executed_lines": [1, 2], "summary": {"covered_lines": 9, "num_statements": 10, "percent_covered": 90.0}},
+    "lab1/main.py": {"executed_lines": [], "summary": {"covered_lines": 0, "num_statements": 5, "percent_covered": 0.0}}
+  },
+  "totals": {"covered_lines": 9, "num_statements": 15}
+}`)},
+				{name: "results.json", content: []byte(`{"files": {"lab1/calc.py": {"summary": {"covered_lines": 10, "num_statements": 10}}}}`)},
+			},
+			want: []*pb.FileCoverage{
+				{Path: "lab1/calc.py", Covered: 9, Total: 10},
+				{Path: "lab1/main.py", Covered: 0, Total: 5},
+			},
+			coverage: 60,
+		},
+		{
+			name: "no reports",
+			produced: []artifact{
+				{name: BuildLogArtifact, content: []byte("ok")},
+				{name: "invalid.xml", content: []byte("<report")},
+				{name: "invalid.json", content: []byte("{")},
+			},
+			want: []*pb.FileCoverage{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := extractCoverage(tt.produced)
+			if diff := cmp.Diff(tt.want, got, protocmp.Transform()); diff != "" {
+				t.Errorf("extractCoverage() mismatch (-want +got):\n%s", diff)
+			}
+			if coverage := totalCoverage(got); coverage != tt.coverage {
+				t.Errorf("totalCoverage() = %v, want %v", coverage, tt.coverage)
+			}
+		})
+	}
+}
+
+func TestRunTestsCoverage(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	course := &pb.Course{Code: "DAT320", OrganizationID: 1}
+	admin := qtest.CreateFakeUser(t, db, 1)
+	qtest.CreateCourse(t, db, admin, course)
+	assignment := &pb.Assignment{
+		CourseID: course.ID,
+		Name:     "lab1",
+		ScriptFile: `#image/qf101
+echo '{"Secret":"{{ .RandomSecret }}","TestName":"TestAdd","Score":1,"MaxScore":1,"Weight":1}'
+printf 'mode: set\nexample.com/lab1/add.go:3.24,5.2 3 1\nexample.com/lab1/sub.go:3.24,5.2 1 0\n' > "$QUICKFEED_ARTIFACTS/cover.out"`,
+		Deadline:    "2022-11-11T13:00:00",
+		AutoApprove: true,
+		ScoreLimit:  80,
+		MinCoverage: 80,
+		Order:       1,
+	}
+	if err := db.CreateAssignment(assignment); err != nil {
+		t.Fatal(err)
+	}
+	// coverage is recorded even if artifacts are not stored
+	runData := &RunData{Course: course, Assignment: assignment, Repo: &pb.Repository{UserID: admin.ID}, JobOwner: "test"}
+	submission, err := RunTests(context.Background(), zap.NewNop().Sugar(), db, &Local{}, runData)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := db.GetSubmission(&pb.Submission{ID: submission.GetID()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.GetCoverage() != 75 || len(got.GetFileCoverage()) != 2 {
+		t.Errorf("submission coverage = %v with %d files, want 75 with 2 files", got.GetCoverage(), len(got.GetFileCoverage()))
+	}
+	// the coverage is below the minimum coverage, but coverage reports are written by the
+	// student's code and do not affect approval; the score is above the score limit
+	if got.GetStatus() != pb.Submission_APPROVED {
+		t.Errorf("submission status = %v, want %v", got.GetStatus(), pb.Submission_APPROVED)
+	}
+}
@@ -13,6 +13,7 @@ import (
 	"strings"
 	"time"
 
+	pb "github.com/autograde/quickfeed/ag"
 	"github.com/docker/docker/api/types"
 	"github.com/docker/docker/api/types/container"
 	"github.com/docker/docker/client"
@@ -25,6 +26,11 @@ var (
 	maxToScan        = 1_000_000 // bytes
 	maxLogSize       = 30_000    // bytes
 	lastSegmentSize  = 1_000     // bytes
+	// defaultPidsLimit is the maximum number of processes in a container
+	// for jobs without a pids limit; this stops fork bombs.
+	defaultPidsLimit = 1024
+	// containerArtifactsDir is the directory where jobs write artifacts in the container.
+	containerArtifactsDir = "/quickfeed/artifacts"
 )
 
 // Docker is an implementation of the CI interface using Docker.
@@ -45,6 +51,19 @@ func NewDockerCI(logger *zap.Logger) (*Docker, error) {
 	}, nil
 }
 
+// NewDockerCIWithHost returns a runner to run CI tests on the Docker daemon at the given host,
+// e.g., "tcp://10.0.0.2:2376"; other client options are taken from the environment.
+func NewDockerCIWithHost(logger *zap.Logger, host string) (*Docker, error) {
+	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithHost(host))
+	if err != nil {
+		return nil, err
+	}
+	return &Docker{
+		client: cli,
+		logger: logger.Sugar(),
+	}, nil
+}
+
 // Close ensures that the docker client is closed.
 func (d *Docker) Close() error {
 	if d.logger != nil {
@@ -53,6 +72,15 @@ func (d *Docker) Close() error {
 	return d.client.Close()
 }
 
+// Ping implements the Pinger interface by pinging the Docker daemon.
+func (d *Docker) Ping(ctx context.Context) error {
+	if d.client == nil {
+		return errors.New("docker client not initialized")
+	}
+	_, err := d.client.Ping(ctx)
+	return err
+}
+
 // Run implements the CI interface. This method blocks until the job has been
 // completed or an error occurs, e.g., the context times out.
 func (d *Docker) Run(ctx context.Context, job *Job) (string, error) {
@@ -60,7 +88,10 @@ func (d *Docker) Run(ctx context.Context, job *Job) (string, error) {
 		return "", fmt.Errorf("cannot run job: %s; docker client not initialized", job.Name)
 	}
 
+	// the job is alive while the image is pulled or built and the container is created
+	stopHeartbeat := startHeartbeat(job, func() bool { return true })
 	resp, err := d.createImage(ctx, job)
+	stopHeartbeat()
 	if err != nil {
 		return "", err
 	}
@@ -68,11 +99,17 @@ func (d *Docker) Run(ctx context.Context, job *Job) (string, error) {
 	if err := d.client.ContainerStart(ctx, resp.ID, types.ContainerStartOptions{}); err != nil {
 		return "", err
 	}
+	// the job is alive as long as its container is running
+	defer startHeartbeat(job, func() bool { return d.containerRunning(resp.ID) })()
 
 	msg, err := d.waitForContainer(ctx, job, resp.ID)
 	if err != nil {
 		return msg, err
 	}
+	info, err := d.client.ContainerInspect(ctx, resp.ID)
+	if err != nil {
+		return "", err
+	}
 
 	// extract the logs before removing the container below
 	logReader, err := d.client.ContainerLogs(ctx, resp.ID, types.ContainerLogsOptions{
@@ -82,29 +119,48 @@ func (d *Docker) Run(ctx context.Context, job *Job) (string, error) {
 		return "", err
 	}
 
+	var stdout bytes.Buffer
+	if _, err := stdcopy.StdCopy(&stdout, ioutil.Discard, logReader); err != nil {
+		return "", err
+	}
+	if job.Artifact != nil {
+		job.Artifact(BuildLogArtifact, stdout.Bytes())
+		d.copyArtifacts(ctx, job, resp.ID)
+	}
+
 	// remove the container when finished to prevent too many open files
 	err = d.client.ContainerRemove(ctx, resp.ID, types.ContainerRemoveOptions{})
 	if err != nil {
 		return "", err
 	}
 
-	var stdout bytes.Buffer
-	if _, err := stdcopy.StdCopy(&stdout, ioutil.Discard, logReader); err != nil {
-		return "", err
-	}
+	out := stdout.String()
 	if stdout.Len() > maxLogSize+lastSegmentSize {
-		return truncateLog(&stdout, maxLogSize, lastSegmentSize, maxToScan), nil
+		out = truncateLog(&stdout, maxLogSize, lastSegmentSize, maxToScan)
+	}
+	if info.State != nil && info.State.OOMKilled {
+		d.logger.Errorf("Container for %s killed: memory limit of %d MB exceeded", job.Name, job.Limits.Memory)
+		msg := fmt.Sprintf("Memory limit of %d MB exceeded. Please check for excessive memory use.", job.Limits.Memory)
+		return out + "\n" + msg, &LimitExceededError{Limit: pb.Submission_MEMORY_LIMIT, Err: errors.New("container killed: out of memory")}
 	}
-	return stdout.String(), nil
+	return out, nil
 }
 
 // createImage creates an image for the given job.
 func (d *Docker) createImage(ctx context.Context, job *Job) (*container.ContainerCreateCreatedBody, error) {
+	commands := job.Commands
+	var env []string
+	if job.Artifact != nil {
+		env = []string{artifactsEnv + "=" + containerArtifactsDir}
+		commands = append([]string{"mkdir -p " + containerArtifactsDir}, commands...)
+	}
+	env = append(env, job.Env...)
 	create := func() (container.ContainerCreateCreatedBody, error) {
 		return d.client.ContainerCreate(ctx, &container.Config{
 			Image: job.Image,
-			Cmd:   []string{"/bin/bash", "-c", strings.Join(job.Commands, "\n")},
-		}, nil, nil, nil, job.Name)
+			Env:   env,
+			Cmd:   []string{"/bin/bash", "-c", strings.Join(commands, "\n")},
+		}, hostConfig(job.Limits), nil, nil, job.Name)
 	}
 
 	resp, err := create()
@@ -112,7 +168,7 @@ func (d *Docker) createImage(ctx context.Context, job *Job) (*container.Containe
 		d.logger.Errorf("Failed to create container image '%s' for %s: %v", job.Image, job.Name, err)
 		// if image not found locally, try to pull it
 		if err := d.pullImage(ctx, job.Image); err != nil {
-			d.logger.Errorf("Failed to pull image '%s' from docker.io: %v", job.Image, err)
+			d.logger.Errorf("Failed to pull image '%s': %v", job.Image, err)
 			if err := d.buildImage(ctx, job.Dockerfile, job.Image); err != nil {
 				return nil, err
 			}
@@ -125,6 +181,42 @@ func (d *Docker) createImage(ctx context.Context, job *Job) (*container.Containe
 	return &resp, err
 }
 
+// copyArtifacts reports the files in the stopped container's artifacts directory as artifacts of the job.
+// Failing to copy the artifacts does not fail the job, since the tests have been run.
+func (d *Docker) copyArtifacts(ctx context.Context, job *Job, containerID string) {
+	content, _, err := d.client.CopyFromContainer(ctx, containerID, containerArtifactsDir)
+	if err != nil {
+		d.logger.Errorf("Failed to copy artifacts of %s: %v", job.Name, err)
+		return
+	}
+	defer content.Close()
+	if err := readArtifactsTar(content, job); err != nil {
+		d.logger.Errorf("Failed to read artifacts of %s: %v", job.Name, err)
+	}
+}
+
+// hostConfig returns the container configuration enforcing the given limits.
+// Processes in the container cannot gain privileges, e.g., through setuid binaries.
+func hostConfig(limits Limits) *container.HostConfig {
+	pids := int64(defaultPidsLimit)
+	if limits.Pids > 0 {
+		pids = int64(limits.Pids)
+	}
+	resources := container.Resources{
+		NanoCPUs:  int64(limits.CPU) * 1_000_000,
+		PidsLimit: &pids,
+	}
+	if limits.Memory > 0 {
+		resources.Memory = int64(limits.Memory) << 20
+		// disable swap, so that the memory limit is a hard limit
+		resources.MemorySwap = resources.Memory
+	}
+	return &container.HostConfig{
+		Resources:   resources,
+		SecurityOpt: []string{"no-new-privileges"},
+	}
+}
+
 // waitForContainer waits until the container stops or context times out.
 func (d *Docker) waitForContainer(ctx context.Context, job *Job, respID string) (string, error) {
 	statusCh, errCh := d.client.ContainerWait(ctx, respID, container.WaitConditionNotRunning)
@@ -147,18 +239,34 @@ func (d *Docker) waitForContainer(ctx context.Context, job *Job, respID string)
 				return "", rmErr
 			}
 			// return message to user to be shown in the results log
-			return "Container timeout. Please check for infinite loops or other slowness.", err
+			return "Container timeout. Please check for infinite loops or other slowness.", &LimitExceededError{Limit: pb.Submission_TIME_LIMIT, Err: err}
 		}
 	case <-statusCh:
 	}
 	return "", nil
 }
 
-// pullImage pulls an image from docker hub; this can be slow and should be
-// avoided if possible.
+// containerRunning returns true if the container with the given ID is running.
+func (d *Docker) containerRunning(containerID string) bool {
+	ctx, cancel := context.WithTimeout(context.Background(), heartbeatInterval)
+	defer cancel()
+	info, err := d.client.ContainerInspect(ctx, containerID)
+	if err != nil {
+		d.logger.Errorf("Failed to inspect container %s: %v", containerID, err)
+		return false
+	}
+	return info.State != nil && info.State.Running
+}
+
+// pullImage pulls an image from docker hub, or the registry named in the image;
+// this can be slow and should be avoided if possible.
 func (d *Docker) pullImage(ctx context.Context, image string) error {
-	d.logger.Infof("Pulling Docker image: '%s' from docker.io", image)
-	progress, err := d.client.ImagePull(ctx, "docker.io/library/"+image, types.ImagePullOptions{})
+	if !strings.Contains(image, "/") {
+		// official images are in the library namespace
+		image = "docker.io/library/" + image
+	}
+	d.logger.Infof("Pulling Docker image: '%s'", image)
+	progress, err := d.client.ImagePull(ctx, image, types.ImagePullOptions{})
 	if err != nil {
 		return err
 	}
@@ -171,7 +279,7 @@ func (d *Docker) pullImage(ctx context.Context, image string) error {
 // buildImage builds and installs an image locally to be reused in a future run.
 func (d *Docker) buildImage(ctx context.Context, dockerfile string, image string) error {
 	if dockerfile == "" || image == "" {
-		return fmt.Errorf("failed to build image %s: missing Dockerfile in Tests/scripts/ or image name in run.sh", image)
+		return fmt.Errorf("failed to build image %s: missing Dockerfile in the tests repository or image name in run.sh or run.json", image)
 	}
 	dockerFileContents := []byte(dockerfile)
 	header := &tar.Header{
@@ -0,0 +1,33 @@
+package ci
+
+import (
+	"context"
+
+	"github.com/autograde/quickfeed/internal/fault"
+)
+
+// FaultyRunner implements the Runner interface by injecting faults
+// into the container operations of another runner.
+type FaultyRunner struct {
+	runner   Runner
+	injector *fault.Injector
+}
+
+// NewFaultyRunner returns a runner injecting the injector's faults into the jobs run by the given runner.
+func NewFaultyRunner(runner Runner, injector *fault.Injector) *FaultyRunner {
+	return &FaultyRunner{runner: runner, injector: injector}
+}
+
+// Run implements the CI interface. The job fails with an injected error
+// before it is started, or is started after the injected latency.
+func (r *FaultyRunner) Run(ctx context.Context, job *Job) (string, error) {
+	if err := r.injector.Inject(ctx, "Run "+job.Name); err != nil {
+		return "", err
+	}
+	return r.runner.Run(ctx, job)
+}
+
+// Ping implements the Pinger interface by pinging the underlying runner, if it is a Pinger.
+func (r *FaultyRunner) Ping(ctx context.Context) error {
+	return Ping(ctx, r.runner)
+}
@@ -4,6 +4,8 @@ package ci
 
 import (
 	"context"
+	"io/ioutil"
+	"os"
 	"os/exec"
 	"strings"
 )
@@ -15,10 +17,28 @@ type Local struct{}
 // completed or an error occurs, e.g., the context times out.
 func (l *Local) Run(ctx context.Context, job *Job) (string, error) {
 	// TODO: Execute tests in something like ioutil.TempDir(os.TempDir(), "local-ci").
-	cmd := exec.Command("/bin/sh", "-c", strings.Join(job.Commands, "\n"))
+	cmd := exec.CommandContext(ctx, "/bin/sh", "-c", strings.Join(job.Commands, "\n"))
+	cmd.Env = append(os.Environ(), job.Env...)
+	var dir string
+	if job.Artifact != nil {
+		var err error
+		if dir, err = ioutil.TempDir("", "local-ci-artifacts"); err != nil {
+			return "", err
+		}
+		defer os.RemoveAll(dir)
+		cmd.Env = append(cmd.Env, artifactsEnv+"="+dir)
+	}
+	// the job is alive while the command is running
+	defer startHeartbeat(job, func() bool { return true })()
 	b, err := cmd.Output()
 	if err != nil {
 		return "", err
 	}
+	if job.Artifact != nil {
+		job.Artifact(BuildLogArtifact, b)
+		if err := readArtifactsDir(dir, job); err != nil {
+			return "", err
+		}
+	}
 	return string(b), nil
 }
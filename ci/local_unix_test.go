@@ -3,8 +3,10 @@ package ci_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/autograde/quickfeed/ci"
+	"github.com/google/go-cmp/cmp"
 )
 
 func TestLocal(t *testing.T) {
@@ -25,3 +27,40 @@ func TestLocal(t *testing.T) {
 		t.Errorf("have %#v want %#v", out, wantOut)
 	}
 }
+
+func TestLocalArtifacts(t *testing.T) {
+	const script = `echo "running tests"
+mkdir -p "$QUICKFEED_ARTIFACTS/coverage"
+printf "<html>" > "$QUICKFEED_ARTIFACTS/coverage/index.html"`
+
+	got := make(map[string]string)
+	local := ci.Local{}
+	out, err := local.Run(context.Background(), &ci.Job{
+		Commands: []string{script},
+		Artifact: func(name string, content []byte) { got[name] = string(content) },
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]string{
+		ci.BuildLogArtifact:   out,
+		"coverage/index.html": "<html>",
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("artifacts mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestLocalTimeout(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	local := ci.Local{}
+	start := time.Now()
+	if _, err := local.Run(ctx, &ci.Job{Commands: []string{"exec sleep 10"}}); err == nil {
+		t.Error("Run() of job exceeding the context's deadline: got nil, want error")
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("Run() returned after %v, want it to stop when the context's deadline expires", elapsed)
+	}
+}
@@ -2,6 +2,8 @@ package ci
 
 import (
 	"context"
+	"io/ioutil"
+	"os"
 	"os/exec"
 	"strings"
 )
@@ -12,10 +14,28 @@ type Local struct{}
 // Run implements the CI interface. This method blocks until the job has been
 // completed or an error occurs, e.g., the context times out.
 func (l *Local) Run(ctx context.Context, job *Job) (string, error) {
-	cmd := exec.Command("bash", "-c", strings.Join(job.Commands, "\n"))
+	cmd := exec.CommandContext(ctx, "bash", "-c", strings.Join(job.Commands, "\n"))
+	cmd.Env = append(os.Environ(), job.Env...)
+	var dir string
+	if job.Artifact != nil {
+		var err error
+		if dir, err = ioutil.TempDir("", "local-ci-artifacts"); err != nil {
+			return "", err
+		}
+		defer os.RemoveAll(dir)
+		cmd.Env = append(cmd.Env, artifactsEnv+"="+dir)
+	}
+	// the job is alive while the command is running
+	defer startHeartbeat(job, func() bool { return true })()
 	b, err := cmd.Output()
 	if err != nil {
 		return "", err
 	}
+	if job.Artifact != nil {
+		job.Artifact(BuildLogArtifact, b)
+		if err := readArtifactsDir(dir, job); err != nil {
+			return "", err
+		}
+	}
 	return string(b), nil
 }
@@ -2,7 +2,10 @@ package ci
 
 import (
 	"bytes"
+	"crypto/sha256"
 	"fmt"
+	"path"
+	"regexp"
 	"strings"
 	"text/template"
 
@@ -15,20 +18,28 @@ import (
 type AssignmentInfo struct {
 	AssignmentName     string
 	Script             string
+	RunConfig          string
 	CreatorAccessToken string
 	GetURL             string
 	TestURL            string
-	RandomSecret       string
+	// AssignmentDir is the path of the assignment's folder in the tests repository, e.g., lab1.
+	AssignmentDir string
+	RandomSecret  string
+	// CommitID is the pushed commit to test; the commands cloning GetURL check it out.
+	CommitID string
 }
 
-func newAssignmentInfo(course *pb.Course, assignment *pb.Assignment, cloneURL, testURL string) *AssignmentInfo {
+func newAssignmentInfo(course *pb.Course, assignment *pb.Assignment, cloneURL, testURL, commitID string) *AssignmentInfo {
 	return &AssignmentInfo{
 		AssignmentName:     assignment.GetName(),
 		Script:             assignment.ScriptFile,
+		RunConfig:          assignment.GetRunConfig(),
 		CreatorAccessToken: course.GetAccessToken(),
 		GetURL:             cloneURL,
 		TestURL:            testURL,
+		AssignmentDir:      path.Join(course.GetAssignmentsDir(), assignment.GetName()),
 		RandomSecret:       rand.String(),
+		CommitID:           commitID,
 	}
 }
 
@@ -55,3 +66,31 @@ func parseScriptTemplate(info *AssignmentInfo) (*Job, error) {
 	}
 	return &Job{Image: parts[1], Commands: s[1:]}, nil
 }
+
+// invalidImageChars matches characters not allowed in docker image names.
+var invalidImageChars = regexp.MustCompile(`[^a-z0-9._-]+`)
+
+// assignmentImage returns the docker image to run the assignment's tests, and the Dockerfile
+// to build the image from if it cannot be found locally or pulled. The assignment's container
+// image overrides the script's image. An assignment with a Dockerfile but no container image
+// gets an image named after the course and assignment, tagged with a hash of the Dockerfile,
+// so that the image is built once and rebuilt only when the Dockerfile changes.
+func assignmentImage(course *pb.Course, assignment *pb.Assignment, scriptImage string) (image, dockerfile string) {
+	if assignment.GetDockerfile() == "" {
+		if assignment.GetContainerImage() != "" {
+			return assignment.GetContainerImage(), ""
+		}
+		// the course's Dockerfile is built into an image named after the course code
+		if scriptImage == strings.ToLower(course.GetCode()) {
+			return scriptImage, course.GetDockerfile()
+		}
+		return scriptImage, ""
+	}
+	image = assignment.GetContainerImage()
+	if image == "" {
+		name := invalidImageChars.ReplaceAllString(strings.ToLower(course.GetCode()+"-"+assignment.GetName()), "-")
+		sum := sha256.Sum256([]byte(assignment.GetDockerfile()))
+		image = fmt.Sprintf("%s:%x", name, sum[:6])
+	}
+	return image, assignment.GetDockerfile()
+}
@@ -52,3 +52,29 @@ func TestParseScript(t *testing.T) {
 		fmt.Println(j.Image)
 	}
 }
+
+func TestAssignmentImage(t *testing.T) {
+	course := &pb.Course{Code: "DAT320", Dockerfile: "FROM golang"}
+	const assignmentDockerfile = "FROM python:3"
+	tests := []struct {
+		name           string
+		assignment     *pb.Assignment
+		scriptImage    string
+		wantImage      string
+		wantDockerfile string
+	}{
+		{name: "script image", assignment: &pb.Assignment{Name: "lab1"}, scriptImage: "golang:latest", wantImage: "golang:latest"},
+		{name: "course image", assignment: &pb.Assignment{Name: "lab1"}, scriptImage: "dat320", wantImage: "dat320", wantDockerfile: course.Dockerfile},
+		{name: "assignment image", assignment: &pb.Assignment{Name: "lab1", ContainerImage: "python:3"}, scriptImage: "dat320", wantImage: "python:3"},
+		{name: "named assignment Dockerfile", assignment: &pb.Assignment{Name: "lab1", ContainerImage: "lab1:v2", Dockerfile: assignmentDockerfile}, scriptImage: "dat320", wantImage: "lab1:v2", wantDockerfile: assignmentDockerfile},
+		{name: "assignment Dockerfile", assignment: &pb.Assignment{Name: "Lab 1", Dockerfile: assignmentDockerfile}, scriptImage: "dat320", wantImage: "dat320-lab-1:905f4e5d0c6e", wantDockerfile: assignmentDockerfile},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			image, dockerfile := assignmentImage(course, tt.assignment, tt.scriptImage)
+			if image != tt.wantImage || dockerfile != tt.wantDockerfile {
+				t.Errorf("assignmentImage() = (%q, %q), want (%q, %q)", image, dockerfile, tt.wantImage, tt.wantDockerfile)
+			}
+		})
+	}
+}
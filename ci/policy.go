@@ -0,0 +1,226 @@
+package ci
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+)
+
+const (
+	// policyTimeout is the time allowed for cloning and scanning pushed code.
+	policyTimeout = 2 * time.Minute
+	// maxScanSize is the size of the largest file scanned for secrets and imports.
+	maxScanSize = 1 << 20
+	// maxViolations is the largest number of policy violations recorded for a submission.
+	maxViolations = 100
+)
+
+// secretPatterns match common secrets that should never be committed,
+// along with a description of each secret.
+var secretPatterns = []struct {
+	re          *regexp.Regexp
+	description string
+}{
+	{regexp.MustCompile(`-----BEGIN (RSA |EC |DSA |OPENSSH |PGP )?PRIVATE KEY( BLOCK)?-----`), "private key"},
+	{regexp.MustCompile(`\b(AKIA|ASIA)[0-9A-Z]{16}\b`), "AWS access key"},
+	{regexp.MustCompile(`\bgh[pousr]_[A-Za-z0-9]{36,}\b`), "GitHub token"},
+	{regexp.MustCompile(`\bxox[abpors]-[A-Za-z0-9-]{10,}`), "Slack token"},
+	{regexp.MustCompile(`\bAIza[0-9A-Za-z_-]{35}\b`), "Google API key"},
+	{regexp.MustCompile(`(?i)\b(api[_-]?key|secret[_-]?key|access[_-]?token|password|passwd)\b["']?\s*[:=]\s*["'][^"'\s]{8,}["']`), "hard-coded credential"},
+}
+
+// validCommit matches commit hashes that can safely be passed to git.
+var validCommit = regexp.MustCompile(`^[0-9a-fA-F]{7,40}$`)
+
+// checkPolicy clones the pushed commit and scans it for violations of the assignment's
+// policy. No violations are returned if the assignment has no policy.
+func checkPolicy(ctx context.Context, info *AssignmentInfo, rData *RunData) ([]*pb.PolicyViolation, error) {
+	if !rData.Assignment.HasPolicy() {
+		return nil, nil
+	}
+	cloneDir, err := ioutil.TempDir("", "policy")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(cloneDir)
+
+	cloneURL, err := url.Parse(info.GetURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid repository URL %q: %w", info.GetURL, err)
+	}
+	if info.CreatorAccessToken != "" && (cloneURL.Scheme == "https" || cloneURL.Scheme == "http") {
+		cloneURL.User = url.UserPassword(info.CreatorAccessToken, "x-oauth-basic")
+	}
+	commit := "HEAD"
+	if validCommit.MatchString(rData.CommitID) {
+		commit = rData.CommitID
+	}
+	repoDir := filepath.Join(cloneDir, "repo")
+	job := &Job{
+		Commands: []string{
+			"set -e",
+			fmt.Sprintf("git clone --quiet --no-checkout %q %q", cloneURL.String(), repoDir),
+			fmt.Sprintf("cd %q", repoDir),
+			"git checkout --quiet " + commit,
+		},
+		// the policy check is part of the test job; keep the job alive while cloning
+		Heartbeat: rData.heartbeat,
+	}
+	ctx, cancel := context.WithTimeout(ctx, policyTimeout)
+	defer cancel()
+	runner := Local{}
+	// the clone URL contains the access token, and is therefore not included in the error
+	if _, err := runner.Run(ctx, job); err != nil {
+		return nil, fmt.Errorf("failed to clone %s at %s: %w", info.GetURL, commit, err)
+	}
+	return scanPolicy(repoDir, rData.Assignment)
+}
+
+// scanPolicy returns the violations of the assignment's policy found in the given directory.
+// Only the first maxViolations violations are returned.
+func scanPolicy(dir string, assignment *pb.Assignment) ([]*pb.PolicyViolation, error) {
+	var violations []*pb.PolicyViolation
+	add := func(rule pb.PolicyViolation_Rule, path string, line int, format string, args ...interface{}) {
+		if len(violations) < maxViolations {
+			violations = append(violations, &pb.PolicyViolation{
+				Rule:   rule,
+				Path:   path,
+				Line:   uint32(line),
+				Detail: fmt.Sprintf(format, args...),
+			})
+		}
+	}
+	forbidden := assignment.ForbiddenFilePatterns()
+	disallowed := assignment.DisallowedImportPaths()
+	maxFileSize := int64(assignment.GetMaxFileSize()) << 20
+
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			if info.Name() == ".git" {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+
+		if pattern := matchingPattern(forbidden, rel); pattern != "" {
+			add(pb.PolicyViolation_FORBIDDEN_FILE, rel, 0, "file matches forbidden pattern %q", pattern)
+		}
+		if maxFileSize > 0 && info.Size() > maxFileSize {
+			add(pb.PolicyViolation_LARGE_FILE, rel, 0, "file size %.1f MB exceeds the limit of %d MB",
+				float64(info.Size())/(1<<20), assignment.GetMaxFileSize())
+		}
+		scanSecrets := assignment.GetScanSecrets()
+		scanImports := len(disallowed) > 0 && strings.HasSuffix(rel, ".go")
+		if info.Size() > maxScanSize || !(scanSecrets || scanImports) {
+			return nil
+		}
+		contents, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		if scanSecrets && !isBinary(contents) {
+			lineNum := 0
+			scanner := bufio.NewScanner(bytes.NewReader(contents))
+			scanner.Buffer(make([]byte, 0, 64*1024), maxScanSize)
+			for scanner.Scan() {
+				lineNum++
+				for _, secret := range secretPatterns {
+					if secret.re.Match(scanner.Bytes()) {
+						add(pb.PolicyViolation_SECRET, rel, lineNum, "possible %s", secret.description)
+						break
+					}
+				}
+			}
+		}
+		if scanImports {
+			fset := token.NewFileSet()
+			file, err := parser.ParseFile(fset, rel, contents, parser.ImportsOnly)
+			if err != nil {
+				// files that do not compile are reported by the tests
+				return nil
+			}
+			for _, imp := range file.Imports {
+				importPath := strings.Trim(imp.Path.Value, "\"`")
+				if matchingImport(disallowed, importPath) {
+					add(pb.PolicyViolation_DISALLOWED_IMPORT, rel, fset.Position(imp.Pos()).Line, "import of %q is not allowed", importPath)
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return violations, nil
+}
+
+// matchingPattern returns the first of the given patterns matching the given
+// slash-separated path or its base name, or the empty string if none match.
+func matchingPattern(patterns []string, path string) string {
+	base := filepath.Base(path)
+	for _, pattern := range patterns {
+		if ok, _ := filepath.Match(pattern, base); ok {
+			return pattern
+		}
+		if ok, _ := filepath.Match(pattern, path); ok {
+			return pattern
+		}
+	}
+	return ""
+}
+
+// matchingImport returns true if the import path is one of the given paths or within one of them.
+func matchingImport(paths []string, importPath string) bool {
+	for _, path := range paths {
+		if importPath == path || strings.HasPrefix(importPath, path+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// isBinary returns true if the given contents look like a binary file.
+func isBinary(contents []byte) bool {
+	if len(contents) > 8000 {
+		contents = contents[:8000]
+	}
+	return bytes.IndexByte(contents, 0) >= 0
+}
+
+// policyLog returns the build log of a submission whose tests were not run
+// because of the given policy violations.
+func policyLog(violations []*pb.PolicyViolation) string {
+	var b strings.Builder
+	b.WriteString("The tests were not run, since the submission violates the assignment's policy:\n")
+	for _, v := range violations {
+		if v.GetLine() > 0 {
+			fmt.Fprintf(&b, "%s:%d: %s\n", v.GetPath(), v.GetLine(), v.GetDetail())
+		} else {
+			fmt.Fprintf(&b, "%s: %s\n", v.GetPath(), v.GetDetail())
+		}
+	}
+	return b.String()
+}
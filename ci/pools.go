@@ -0,0 +1,335 @@
+package ci
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/artifacts"
+	"github.com/autograde/quickfeed/database"
+	"github.com/autograde/quickfeed/kit/score"
+	"go.uber.org/zap"
+)
+
+// Pools routes test jobs to named runner pools. An assignment pinned to a pool,
+// e.g., because its tests need access to on-premise resources, always runs on that pool.
+// Other jobs run on the course's pool, or the default pool if the course has none.
+// Rebuilds of jobs not pinned by their assignment overflow to the overflow pool,
+// if set, when their pool has queued jobs and the overflow pool is less busy.
+type Pools struct {
+	logger       *zap.SugaredLogger
+	defaultQueue *Queue
+	results      *Results
+	// artifactStore stores the artifacts produced by jobs in all pools; may be nil.
+	artifactStore artifacts.Store
+	// queued is called with the run data of each job submitted to the pools; may be nil.
+	queued func(*RunData)
+
+	mu       sync.RWMutex
+	pools    map[string]*Queue
+	overflow string
+}
+
+// NewPools returns runner pools where jobs without a pool run on the given default queue.
+func NewPools(logger *zap.SugaredLogger, defaultQueue *Queue) *Pools {
+	results := NewResults()
+	defaultQueue.results = results
+	return &Pools{
+		logger:       logger,
+		defaultQueue: defaultQueue,
+		results:      results,
+		pools:        make(map[string]*Queue),
+	}
+}
+
+// Add adds a named runner pool running jobs on the given queue.
+func (p *Pools) Add(name string, queue *Queue) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	queue.results = p.results
+	queue.artifactStore = p.artifactStore
+	queue.name = name
+	p.pools[name] = queue
+}
+
+// Results returns the broker delivering the submissions recorded by jobs in all pools.
+func (p *Pools) Results() *Results {
+	return p.results
+}
+
+// SetArtifactStore sets the store for the artifacts produced by jobs in all pools,
+// such as full build logs and coverage reports. Must be called before jobs are submitted.
+func (p *Pools) SetArtifactStore(store artifacts.Store) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.artifactStore = store
+	p.defaultQueue.artifactStore = store
+	for _, queue := range p.pools {
+		queue.artifactStore = store
+	}
+}
+
+// SetOverflow sets the pool that rebuilds overflow to when their pool is busy.
+func (p *Pools) SetOverflow(name string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if _, ok := p.pools[name]; !ok {
+		return fmt.Errorf("unknown runner pool %q", name)
+	}
+	p.overflow = name
+	return nil
+}
+
+// Names returns the sorted names of the runner pools.
+func (p *Pools) Names() []string {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	names := make([]string, 0, len(p.pools))
+	for name := range p.pools {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// DefaultPool is the name used for the default runner pool when reporting on all pools.
+const DefaultPool = "default"
+
+// Ping pings the runner of each pool, and returns the outcome keyed by pool name;
+// the error is nil for pools whose runners are available.
+func (p *Pools) Ping(ctx context.Context) map[string]error {
+	p.mu.RLock()
+	queues := map[string]*Queue{DefaultPool: p.defaultQueue}
+	for name, queue := range p.pools {
+		queues[name] = queue
+	}
+	p.mu.RUnlock()
+	errs := make(map[string]error)
+	for name, queue := range queues {
+		errs[name] = Ping(ctx, queue.runner)
+	}
+	return errs
+}
+
+// Drain stops starting queued jobs in all pools, and waits until the running jobs
+// have completed, or the context is done. The queued jobs remain stored in the database,
+// such that they are resumed when the server restarts.
+func (p *Pools) Drain(ctx context.Context) error {
+	p.mu.RLock()
+	queues := []*Queue{p.defaultQueue}
+	for _, queue := range p.pools {
+		queues = append(queues, queue)
+	}
+	p.mu.RUnlock()
+	errs := make(chan error, len(queues))
+	for _, queue := range queues {
+		go func(queue *Queue) {
+			errs <- queue.Drain(ctx)
+		}(queue)
+	}
+	var err error
+	for range queues {
+		if e := <-errs; e != nil {
+			err = e
+		}
+	}
+	return err
+}
+
+// queue returns the queue that should run the tests specified by the run data.
+func (p *Pools) queue(rData *RunData) *Queue {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	if name := rData.Assignment.GetRunnerPool(); name != "" {
+		if queue, ok := p.pools[name]; ok {
+			return queue
+		}
+		p.logger.Errorf("Assignment %s pinned to unknown runner pool %q; using default pool", rData.Assignment.GetName(), name)
+		return p.defaultQueue
+	}
+
+	queue := p.defaultQueue
+	if name := rData.Course.GetRunnerPool(); name != "" {
+		if q, ok := p.pools[name]; ok {
+			queue = q
+		} else {
+			p.logger.Errorf("Course %s uses unknown runner pool %q; using default pool", rData.Course.GetCode(), name)
+		}
+	}
+	if overflow, ok := p.pools[p.overflow]; ok && rData.Rebuild && queue != overflow {
+		if waiting := queue.waiting(); waiting > 0 && overflow.waiting() < waiting {
+			return overflow
+		}
+	}
+	return queue
+}
+
+// OnQueued sets the function called with the run data of each job submitted to the pools,
+// e.g., to report that the job's commit is being tested. The function must not block.
+// Must be called before jobs are submitted.
+func (p *Pools) OnQueued(queued func(*RunData)) {
+	p.queued = queued
+}
+
+// RunTests runs the tests specified by the run data on the pool selected for
+// the job's assignment and course, and blocks until the results are recorded.
+func (p *Pools) RunTests(rData *RunData) {
+	p.notifyQueued(rData)
+	p.queue(rData).RunTests(rData)
+}
+
+// Submit queues the tests specified by the run data on the pool selected for
+// the job's assignment and course, and returns immediately.
+func (p *Pools) Submit(rData *RunData) {
+	p.notifyQueued(rData)
+	p.queue(rData).Submit(rData)
+}
+
+// notifyQueued calls the queued function, if set, with the run data of a submitted job.
+func (p *Pools) notifyQueued(rData *RunData) {
+	if p.queued != nil {
+		p.queued(rData)
+	}
+}
+
+// SetWorkers sets the maximum number of concurrently running test jobs in each pool.
+func (p *Pools) SetWorkers(workers int) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	p.defaultQueue.SetWorkers(workers)
+	for _, queue := range p.pools {
+		queue.SetWorkers(workers)
+	}
+}
+
+// Cancel cancels the queued or running test job with the given ID in any pool,
+// and returns false if there is no such job.
+func (p *Pools) Cancel(jobID uint64) bool {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	if p.defaultQueue.Cancel(jobID) {
+		return true
+	}
+	for _, queue := range p.pools {
+		if queue.Cancel(jobID) {
+			return true
+		}
+	}
+	return false
+}
+
+// Resume queues the test jobs stored in the database, e.g., jobs that were queued
+// or running when the server stopped. Jobs return to the pool they were queued on,
+// if it still exists. Jobs whose course, assignment or repository no longer exists are deleted.
+func (p *Pools) Resume(db database.Database) error {
+	jobs, err := db.GetTestJobs()
+	if err != nil {
+		return err
+	}
+	for _, job := range jobs {
+		rData, err := resumeRunData(db, job)
+		if err != nil {
+			p.logger.Errorf("Failed to resume test job %d: %v", job.GetID(), err)
+			if err := db.DeleteTestJob(job.GetID()); err != nil {
+				p.logger.Errorf("Failed to delete test job %d: %v", job.GetID(), err)
+			}
+			continue
+		}
+		p.mu.RLock()
+		queue, ok := p.pools[job.GetRunnerPool()]
+		p.mu.RUnlock()
+		if !ok {
+			queue = p.queue(rData)
+		}
+		p.logger.Debugf("Resuming test job %d for %s, assignment %s", job.GetID(), job.GetJobOwner(), rData.Assignment.GetName())
+		queue.resume(rData, job)
+	}
+	return nil
+}
+
+// resumeRunData returns the run data of the stored test job.
+func resumeRunData(db database.Database, job *pb.TestJob) (*RunData, error) {
+	course, err := db.GetCourse(job.GetCourseID(), false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get course %d: %w", job.GetCourseID(), err)
+	}
+	assignment, err := db.GetAssignment(&pb.Assignment{ID: job.GetAssignmentID()})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get assignment %d: %w", job.GetAssignmentID(), err)
+	}
+	if job.GetRepositoryID() == 0 {
+		return nil, errors.New("missing repository")
+	}
+	repos, err := db.GetRepositories(&pb.Repository{ID: job.GetRepositoryID()})
+	if err != nil || len(repos) == 0 {
+		return nil, fmt.Errorf("failed to get repository %d: %v", job.GetRepositoryID(), err)
+	}
+	return &RunData{
+		Course:     course,
+		Assignment: assignment,
+		Repo:       repos[0],
+		CommitID:   job.GetCommitID(),
+		JobOwner:   job.GetJobOwner(),
+		Rebuild:    job.GetRebuild(),
+		Priority:   job.GetPriority(),
+
+		PullRequest: job.GetPullRequest(),
+	}, nil
+}
+
+// Replay runs the tests specified by the run data on the named pool, e.g., a staging pool
+// with upgraded runners or test images, and returns the results without recording them.
+func (p *Pools) Replay(pool string, rData *RunData) (*score.Results, error) {
+	p.mu.RLock()
+	queue, ok := p.pools[pool]
+	p.mu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("unknown runner pool %q", pool)
+	}
+	return queue.Replay(rData)
+}
+
+// Status returns the status of the most recently queued job for the given
+// assignment and user or group in any pool, or false if there is no such job.
+func (p *Pools) Status(assignmentID, userID, groupID uint64) (*QueueStatus, bool) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	if status, ok := p.defaultQueue.Status(assignmentID, userID, groupID); ok {
+		return status, true
+	}
+	for _, queue := range p.pools {
+		if status, ok := queue.Status(assignmentID, userID, groupID); ok {
+			return status, true
+		}
+	}
+	return nil, false
+}
+
+// ParsePools parses a comma-separated list of runner pools on the form name=host,
+// where host is the address of the Docker daemon running the pool's jobs,
+// e.g., "campus=unix:///var/run/docker.sock,cloud=tcp://10.0.0.2:2376".
+func ParsePools(spec string) (map[string]string, error) {
+	pools := make(map[string]string)
+	if spec == "" {
+		return pools, nil
+	}
+	for _, pool := range strings.Split(spec, ",") {
+		name, host := pool, ""
+		if i := strings.Index(pool, "="); i >= 0 {
+			name, host = pool[:i], pool[i+1:]
+		}
+		name, host = strings.TrimSpace(name), strings.TrimSpace(host)
+		if name == "" || host == "" {
+			return nil, fmt.Errorf("invalid runner pool %q: must be name=host", pool)
+		}
+		if _, ok := pools[name]; ok {
+			return nil, fmt.Errorf("duplicate runner pool %q", name)
+		}
+		pools[name] = host
+	}
+	return pools, nil
+}
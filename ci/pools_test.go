@@ -0,0 +1,72 @@
+package ci
+
+import (
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/google/go-cmp/cmp"
+	"go.uber.org/zap"
+)
+
+func TestPoolsRouting(t *testing.T) {
+	logger := zap.NewNop().Sugar()
+	defaultQueue := NewQueue(logger, nil, nil, 1)
+	campus := NewQueue(logger, nil, nil, 1)
+	cloud := NewQueue(logger, nil, nil, 1)
+	pools := NewPools(logger, defaultQueue)
+	pools.Add("campus", campus)
+	pools.Add("cloud", cloud)
+	if err := pools.SetOverflow("gpu"); err == nil {
+		t.Error("SetOverflow(gpu): expected error for unknown pool")
+	}
+	if err := pools.SetOverflow("cloud"); err != nil {
+		t.Fatal(err)
+	}
+
+	// a waiting job in the campus pool makes the campus pool busy
+	campus.jobs = append(campus.jobs, &queuedJob{data: &RunData{}})
+
+	tests := []struct {
+		name       string
+		course     *pb.Course
+		assignment *pb.Assignment
+		rebuild    bool
+		want       *Queue
+	}{
+		{"NoPool", &pb.Course{}, &pb.Assignment{}, false, defaultQueue},
+		{"CoursePool", &pb.Course{RunnerPool: "campus"}, &pb.Assignment{}, false, campus},
+		{"AssignmentPool", &pb.Course{RunnerPool: "cloud"}, &pb.Assignment{RunnerPool: "campus"}, false, campus},
+		{"UnknownPool", &pb.Course{}, &pb.Assignment{RunnerPool: "gpu"}, false, defaultQueue},
+		{"RebuildOverflow", &pb.Course{RunnerPool: "campus"}, &pb.Assignment{}, true, cloud},
+		{"RebuildPinned", &pb.Course{}, &pb.Assignment{RunnerPool: "campus"}, true, campus},
+		{"RebuildNotBusy", &pb.Course{}, &pb.Assignment{}, true, defaultQueue},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := pools.queue(&RunData{Course: tt.course, Assignment: tt.assignment, Rebuild: tt.rebuild})
+			if got != tt.want {
+				t.Errorf("queue() returned wrong pool for course pool %q, assignment pool %q, rebuild %t",
+					tt.course.GetRunnerPool(), tt.assignment.GetRunnerPool(), tt.rebuild)
+			}
+		})
+	}
+	if diff := cmp.Diff([]string{"campus", "cloud"}, pools.Names()); diff != "" {
+		t.Errorf("Names() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestParsePools(t *testing.T) {
+	pools, err := ParsePools("campus=unix:///var/run/docker.sock, cloud=tcp://10.0.0.2:2376")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]string{"campus": "unix:///var/run/docker.sock", "cloud": "tcp://10.0.0.2:2376"}
+	if diff := cmp.Diff(want, pools); diff != "" {
+		t.Errorf("ParsePools() mismatch (-want +got):\n%s", diff)
+	}
+	for _, spec := range []string{"campus", "=tcp://host", "campus=", "a=x,a=y"} {
+		if _, err := ParsePools(spec); err == nil {
+			t.Errorf("ParsePools(%q): expected error", spec)
+		}
+	}
+}
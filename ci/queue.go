@@ -0,0 +1,544 @@
+package ci
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/artifacts"
+	"github.com/autograde/quickfeed/database"
+	"github.com/autograde/quickfeed/kit/score"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+)
+
+// recentRuns is the number of recent run durations used to estimate the duration of a run.
+const recentRuns = 20
+
+// defaultRunDuration is the estimated duration of a run for assignments without recent runs.
+const defaultRunDuration = time.Minute
+
+// missedHeartbeats is the number of heartbeats a running job can miss before its runner is considered dead.
+const missedHeartbeats = 3
+
+// maxAttempts is the maximum number of times a job is run when its runner dies or fails.
+const maxAttempts = 3
+
+// retryDelay is the delay before a job whose runner failed is queued again;
+// the delay grows with the number of attempts.
+var retryDelay = 10 * time.Second
+
+var (
+	errDeadRunner  = errors.New("runner stopped sending heartbeats")
+	errJobCanceled = errors.New("test job canceled")
+)
+
+// DeadRunnersMetric counts the jobs whose runner stopped sending heartbeats.
+var DeadRunnersMetric = prometheus.NewCounter(prometheus.CounterOpts{
+	Name: "ci_dead_runners",
+	Help: "Number of test jobs whose runner stopped sending heartbeats.",
+})
+
+// Queue limits the number of concurrently running test jobs to its number of workers,
+// and runs queued jobs in order of priority. The queue keeps track of queued and running
+// jobs to report their queue position and estimated wait. Queued jobs are stored in the
+// database until they complete, so that they can be resumed after a restart.
+type Queue struct {
+	logger *zap.SugaredLogger
+	db     database.Database
+	runner Runner
+	// name is the name of the queue's runner pool; empty for the default pool.
+	name string
+	// results receives the submissions recorded by the queue's jobs; may be nil.
+	results *Results
+	// artifactStore stores the artifacts produced by the queue's jobs; may be nil.
+	artifactStore artifacts.Store
+
+	mu        sync.Mutex
+	workers   int                        // maximum number of concurrently running jobs
+	running   int                        // number of running jobs
+	draining  bool                       // true if queued jobs are no longer started
+	drained   chan struct{}              // closed when no jobs are running after draining started
+	jobs      []*queuedJob               // queued and running jobs, in the order they were queued
+	durations map[uint64][]time.Duration // recent run durations for each assignment
+}
+
+type queuedJob struct {
+	data      *RunData
+	record    *pb.TestJob // the job's database record; nil if the job is not stored
+	replay    bool        // true for replayed jobs, which are not reported by Status
+	enqueued  time.Time
+	started   time.Time // time when the job's current attempt started; zero if not running
+	heartbeat time.Time // time of the most recent heartbeat from the job's runner
+	attempts  int       // number of times the job has been started
+	dead      bool      // true if the job's runner stopped sending heartbeats
+	waiting   bool      // true while the job waits for a worker
+	canceled  bool      // true if the job was canceled
+	// ready is closed when the waiting job gets a worker, or is canceled.
+	ready chan struct{}
+	// cancel aborts the job's current attempt; nil if the job is not running.
+	cancel context.CancelFunc
+}
+
+// rank returns the rank of the job's priority; queued jobs with lower rank run first.
+func (j *queuedJob) rank() int {
+	switch j.data.Priority {
+	case pb.TestJob_HIGH:
+		return 0
+	case pb.TestJob_LOW:
+		return 2
+	}
+	return 1
+}
+
+// QueueStatus describes the state of a queued or running job.
+type QueueStatus struct {
+	// Running is true if the job is running; otherwise it is waiting in the queue.
+	Running bool
+	// Position is the number of jobs ahead of the job in the queue; zero if the job is running.
+	Position int
+	// Enqueued is the time when the job was queued.
+	Enqueued time.Time
+	// EstimatedWait is the estimated time until the job's results are ready.
+	EstimatedWait time.Duration
+}
+
+// NewQueue returns a queue running at most workers test jobs concurrently using the given runner.
+func NewQueue(logger *zap.SugaredLogger, db database.Database, runner Runner, workers int) *Queue {
+	if workers < 1 {
+		workers = 1
+	}
+	return &Queue{
+		logger:    logger,
+		db:        db,
+		runner:    runner,
+		workers:   workers,
+		durations: make(map[uint64][]time.Duration),
+	}
+}
+
+// SetWorkers sets the maximum number of concurrently running test jobs.
+func (q *Queue) SetWorkers(workers int) {
+	if workers < 1 {
+		workers = 1
+	}
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.workers = workers
+	q.dispatch()
+}
+
+// RunTests queues the tests specified by the run data, and blocks until the tests
+// have been run and the results recorded. If the job's runner stops sending heartbeats
+// while running the tests, or fails to run the tests, the job is queued again,
+// up to maxAttempts times.
+func (q *Queue) RunTests(rData *RunData) {
+	q.process(q.enqueue(rData, nil))
+}
+
+// Submit queues the tests specified by the run data, and returns immediately.
+// The tests are run and the results recorded when a worker is free.
+func (q *Queue) Submit(rData *RunData) {
+	go q.process(q.enqueue(rData, nil))
+}
+
+// resume queues the stored test job with the given run data, and returns immediately.
+func (q *Queue) resume(rData *RunData, record *pb.TestJob) {
+	go q.process(q.enqueue(rData, record))
+}
+
+// Replay runs the tests specified by the run data when a worker is free, and returns
+// the test results without recording them. Replayed jobs are not reported by Status.
+func (q *Queue) Replay(rData *RunData) (*score.Results, error) {
+	job := &queuedJob{data: rData, replay: true, enqueued: time.Now()}
+	q.mu.Lock()
+	q.jobs = append(q.jobs, job)
+	q.mu.Unlock()
+	defer q.remove(job)
+	if !q.acquire(job) {
+		return nil, errJobCanceled
+	}
+	defer q.release(job)
+	info := newAssignmentInfo(rData.Course, rData.Assignment, rData.Repo.GetHTMLURL(), rData.Repo.GetTestURL(rData.Course), rData.CommitID)
+	ed, err := runTests(context.Background(), q.runner, info, rData)
+	if err != nil {
+		return nil, err
+	}
+	return score.ExtractResults(ed.out, info.RandomSecret, ed.execTime), nil
+}
+
+// Drain stops starting queued jobs, and waits until the running jobs have completed,
+// or the context is done. The queued jobs remain stored in the database, such that
+// they are resumed when the server restarts.
+func (q *Queue) Drain(ctx context.Context) error {
+	q.mu.Lock()
+	if !q.draining {
+		q.draining = true
+		q.drained = make(chan struct{})
+		if q.running == 0 {
+			close(q.drained)
+		}
+	}
+	drained := q.drained
+	q.mu.Unlock()
+	select {
+	case <-drained:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Cancel cancels the queued or running test job with the given ID,
+// and returns false if the queue has no such job.
+func (q *Queue) Cancel(jobID uint64) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	for _, job := range q.jobs {
+		if job.record == nil || job.record.GetID() != jobID {
+			continue
+		}
+		job.canceled = true
+		if job.waiting {
+			job.waiting = false
+			close(job.ready)
+		}
+		if job.cancel != nil {
+			job.cancel()
+		}
+		return true
+	}
+	return false
+}
+
+// enqueue adds a job with the given run data to the queue, and stores the job in the
+// database, unless the job is resumed from the given record.
+func (q *Queue) enqueue(rData *RunData, record *pb.TestJob) *queuedJob {
+	job := &queuedJob{data: rData, record: record, enqueued: time.Now()}
+	if record != nil {
+		job.attempts = int(record.GetAttempts())
+		record.Status = pb.TestJob_QUEUED
+		q.store(job)
+	} else if q.db != nil {
+		job.record = &pb.TestJob{
+			CourseID:     rData.Course.GetID(),
+			AssignmentID: rData.Assignment.GetID(),
+			RepositoryID: rData.Repo.GetID(),
+			UserID:       rData.Repo.GetUserID(),
+			GroupID:      rData.Repo.GetGroupID(),
+			CommitID:     rData.CommitID,
+			JobOwner:     rData.JobOwner,
+			Rebuild:      rData.Rebuild,
+			PullRequest:  rData.PullRequest,
+			RunnerPool:   q.name,
+			Priority:     rData.Priority,
+			Enqueued:     job.enqueued.Format(pb.TimeLayout),
+		}
+		if err := q.db.CreateTestJob(job.record); err != nil {
+			q.logger.Errorf("Failed to store test job for %s, assignment %s: %v", rData.JobOwner, rData.Assignment.GetName(), err)
+			job.record = nil
+		}
+	}
+	q.mu.Lock()
+	q.jobs = append(q.jobs, job)
+	q.mu.Unlock()
+	return job
+}
+
+// process runs the job until its tests have been run and the results recorded,
+// retrying the job if its runner dies or fails, and then removes the job from the queue.
+func (q *Queue) process(job *queuedJob) {
+	defer q.remove(job)
+	rData := job.data
+	for {
+		dead, err := q.run(job)
+		if q.isCanceled(job) {
+			q.logger.Debugf("Canceled tests for %s, assignment %s", rData.JobOwner, rData.Assignment.GetName())
+			return
+		}
+		if !dead && err == nil {
+			return
+		}
+		if dead {
+			DeadRunnersMetric.Inc()
+			err = errDeadRunner
+		}
+		if job.attempts >= maxAttempts {
+			q.logger.Errorf("Giving up tests for %s, assignment %s after %d attempts: %v", rData.JobOwner, rData.Assignment.GetName(), job.attempts, err)
+			return
+		}
+		q.logger.Errorf("Tests for %s, assignment %s failed: %v; retrying tests", rData.JobOwner, rData.Assignment.GetName(), err)
+		if job.record != nil {
+			job.record.Status = pb.TestJob_QUEUED
+			job.record.LastError = err.Error()
+			q.store(job)
+		}
+		if !dead {
+			// give the runner time to recover from transient failures
+			time.Sleep(retryDelay * time.Duration(job.attempts))
+		}
+	}
+}
+
+// run runs a single attempt of the job, and returns true if the tests were aborted
+// because the job's runner stopped sending heartbeats, or the error if the runner
+// failed to run the tests.
+func (q *Queue) run(job *queuedJob) (bool, error) {
+	if !q.acquire(job) {
+		return false, nil
+	}
+	defer q.release(job)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	q.mu.Lock()
+	job.cancel = cancel
+	job.attempts++
+	job.dead = false
+	q.mu.Unlock()
+	if job.record != nil {
+		job.record.Status = pb.TestJob_RUNNING
+		job.record.Attempts = uint32(job.attempts)
+		job.record.Started = job.started.Format(pb.TimeLayout)
+		q.store(job)
+	}
+
+	monitorDone := make(chan struct{})
+	go func() {
+		q.monitor(ctx, cancel, job)
+		close(monitorDone)
+	}()
+
+	data := *job.data
+	data.heartbeat = func() {
+		q.mu.Lock()
+		job.heartbeat = time.Now()
+		q.mu.Unlock()
+	}
+	data.artifactStore = q.artifactStore
+	submission, err := RunTests(ctx, q.logger, q.db, q.runner, &data)
+	cancel()
+	<-monitorDone
+	q.results.Publish(job.data.Course.GetID(), submission)
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	job.cancel = nil
+	if job.dead || err != nil || job.canceled {
+		return job.dead, err
+	}
+	assignmentID := job.data.Assignment.GetID()
+	durations := append(q.durations[assignmentID], time.Since(job.started))
+	if len(durations) > recentRuns {
+		durations = durations[len(durations)-recentRuns:]
+	}
+	q.durations[assignmentID] = durations
+	return false, nil
+}
+
+// acquire waits until the job gets a worker, and returns false if the job was canceled.
+func (q *Queue) acquire(job *queuedJob) bool {
+	q.mu.Lock()
+	if job.canceled {
+		q.mu.Unlock()
+		return false
+	}
+	job.waiting = true
+	job.ready = make(chan struct{})
+	ready := job.ready
+	q.dispatch()
+	q.mu.Unlock()
+
+	<-ready
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return !job.canceled
+}
+
+// release frees the job's worker for the next queued job.
+func (q *Queue) release(job *queuedJob) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	job.started = time.Time{}
+	q.running--
+	if q.draining && q.running == 0 {
+		close(q.drained)
+	}
+	q.dispatch()
+}
+
+// dispatch gives free workers to the waiting jobs with the highest priority,
+// in the order they were queued, unless the queue is draining. Must be called with q.mu held.
+func (q *Queue) dispatch() {
+	for !q.draining && q.running < q.workers {
+		var next *queuedJob
+		for _, job := range q.jobs {
+			if job.waiting && (next == nil || job.rank() < next.rank()) {
+				next = job
+			}
+		}
+		if next == nil {
+			return
+		}
+		q.running++
+		next.waiting = false
+		next.started = time.Now()
+		next.heartbeat = next.started
+		close(next.ready)
+	}
+}
+
+// isCanceled returns true if the job was canceled.
+func (q *Queue) isCanceled(job *queuedJob) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return job.canceled
+}
+
+// remove removes the job from the queue and the database.
+func (q *Queue) remove(job *queuedJob) {
+	q.mu.Lock()
+	for i, j := range q.jobs {
+		if j == job {
+			q.jobs = append(q.jobs[:i], q.jobs[i+1:]...)
+			break
+		}
+	}
+	q.mu.Unlock()
+	if job.record != nil {
+		if err := q.db.DeleteTestJob(job.record.GetID()); err != nil {
+			q.logger.Errorf("Failed to delete test job %d: %v", job.record.GetID(), err)
+		}
+	}
+}
+
+// store updates the job's database record.
+func (q *Queue) store(job *queuedJob) {
+	if err := q.db.UpdateTestJob(job.record); err != nil {
+		q.logger.Errorf("Failed to update test job %d: %v", job.record.GetID(), err)
+	}
+}
+
+// monitor cancels the job if its runner misses too many heartbeats.
+func (q *Queue) monitor(ctx context.Context, cancel context.CancelFunc, job *queuedJob) {
+	ticker := time.NewTicker(heartbeatInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			q.mu.Lock()
+			dead := time.Since(job.heartbeat) > missedHeartbeats*heartbeatInterval
+			job.dead = dead
+			q.mu.Unlock()
+			if dead {
+				cancel()
+				return
+			}
+		}
+	}
+}
+
+// waiting returns the number of jobs waiting for a free worker.
+func (q *Queue) waiting() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	waiting := 0
+	for _, job := range q.jobs {
+		if job.started.IsZero() && !job.replay {
+			waiting++
+		}
+	}
+	return waiting
+}
+
+// estimate returns the estimated run duration for the given assignment,
+// based on the assignment's recent runs. Must be called with q.mu held.
+func (q *Queue) estimate(assignmentID uint64) time.Duration {
+	durations := q.durations[assignmentID]
+	if len(durations) == 0 {
+		return defaultRunDuration
+	}
+	var total time.Duration
+	for _, d := range durations {
+		total += d
+	}
+	return total / time.Duration(len(durations))
+}
+
+// Status returns the status of the most recently queued job for the given
+// assignment and user or group, or false if there is no such job.
+func (q *Queue) Status(assignmentID, userID, groupID uint64) (*QueueStatus, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	now := time.Now()
+
+	requested := -1
+	for i, job := range q.jobs {
+		if !job.replay && job.data.Assignment.GetID() == assignmentID &&
+			job.data.Repo.GetUserID() == userID && job.data.Repo.GetGroupID() == groupID {
+			requested = i
+		}
+	}
+	if requested < 0 {
+		return nil, false
+	}
+	job := q.jobs[requested]
+	estimate := q.estimate(job.data.Assignment.GetID())
+	if !job.started.IsZero() {
+		remaining := estimate - now.Sub(job.started)
+		if remaining < 0 {
+			remaining = 0
+		}
+		return &QueueStatus{Running: true, Enqueued: job.enqueued, EstimatedWait: remaining}, true
+	}
+
+	// remaining time of the running jobs, and the waiting jobs ahead of the requested job
+	var running []time.Duration
+	var ahead []*queuedJob
+	for i, j := range q.jobs {
+		switch {
+		case !j.started.IsZero():
+			remaining := q.estimate(j.data.Assignment.GetID()) - now.Sub(j.started)
+			if remaining < 0 {
+				remaining = 0
+			}
+			running = append(running, remaining)
+		case j.replay || i == requested:
+		case j.rank() < job.rank() || (j.rank() == job.rank() && i < requested):
+			ahead = append(ahead, j)
+		}
+	}
+	return &QueueStatus{
+		Position:      len(ahead),
+		Enqueued:      job.enqueued,
+		EstimatedWait: waitTime(running, ahead, q.workers, q.estimate) + estimate,
+	}, true
+}
+
+// waitTime returns the estimated time until a free slot is available for a job
+// queued after the given waiting jobs, when the running jobs have the given remaining time.
+func waitTime(running []time.Duration, waiting []*queuedJob, workers int, estimate func(uint64) time.Duration) time.Duration {
+	// simulate the workers: each slot is free after its current job's remaining time
+	slots := make([]time.Duration, workers)
+	copy(slots, running)
+	for _, job := range waiting {
+		i := earliest(slots)
+		slots[i] += estimate(job.data.Assignment.GetID())
+	}
+	return slots[earliest(slots)]
+}
+
+// earliest returns the index of the slot that is free first.
+func earliest(slots []time.Duration) int {
+	min := 0
+	for i := range slots {
+		if slots[i] < slots[min] {
+			min = i
+		}
+	}
+	return min
+}
@@ -0,0 +1,368 @@
+package ci
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/internal/qtest"
+	"github.com/google/go-cmp/cmp"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"go.uber.org/zap"
+)
+
+// blockingRunner blocks each job until it is released.
+type blockingRunner struct {
+	started chan string
+	release chan struct{}
+}
+
+func (r *blockingRunner) Run(_ context.Context, job *Job) (string, error) {
+	r.started <- job.Name
+	<-r.release
+	return "done", nil
+}
+
+func TestQueueStatus(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	runner := &blockingRunner{started: make(chan string), release: make(chan struct{})}
+	queue := NewQueue(zap.NewNop().Sugar(), db, runner, 1)
+	assignment := &pb.Assignment{ID: 1, Name: "lab1", ScriptFile: "#image/qf101\necho test"}
+	runData := func(userID uint64) *RunData {
+		return &RunData{
+			Course:     &pb.Course{Code: "DAT320"},
+			Assignment: assignment,
+			Repo:       &pb.Repository{UserID: userID},
+			JobOwner:   "user",
+		}
+	}
+
+	if _, ok := queue.Status(1, 1, 0); ok {
+		t.Error("Status() for empty queue: expected no job")
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() { queue.RunTests(runData(1)); wg.Done() }()
+	<-runner.started
+	go func() { queue.RunTests(runData(2)); wg.Done() }()
+
+	// wait for the second job to be queued
+	var waiting *QueueStatus
+	for deadline := time.Now().Add(5 * time.Second); waiting == nil && time.Now().Before(deadline); {
+		waiting, _ = queue.Status(1, 2, 0)
+		time.Sleep(time.Millisecond)
+	}
+	if waiting == nil {
+		t.Fatal("Status() for queued job: expected job")
+	}
+	if waiting.Running || waiting.Position != 0 {
+		t.Errorf("Status() for queued job = %+v, want queued first in line", waiting)
+	}
+	// the queued job waits for the running job, and then runs itself
+	if waiting.EstimatedWait <= defaultRunDuration || waiting.EstimatedWait > 2*defaultRunDuration {
+		t.Errorf("Status() estimated wait = %v, want between %v and %v", waiting.EstimatedWait, defaultRunDuration, 2*defaultRunDuration)
+	}
+	running, ok := queue.Status(1, 1, 0)
+	if !ok || !running.Running {
+		t.Errorf("Status() for running job = %+v, want running", running)
+	}
+
+	runner.release <- struct{}{}
+	<-runner.started
+	runner.release <- struct{}{}
+	wg.Wait()
+
+	if _, ok := queue.Status(1, 2, 0); ok {
+		t.Error("Status() for completed job: expected no job")
+	}
+	queue.mu.Lock()
+	defer queue.mu.Unlock()
+	if got := queue.estimate(assignment.ID); got >= defaultRunDuration {
+		t.Errorf("estimate() = %v, want estimate based on recent runs", got)
+	}
+}
+
+// stallingRunner stalls without heartbeats on the first run, and completes subsequent runs.
+type stallingRunner struct {
+	mu   sync.Mutex
+	runs int
+}
+
+func (r *stallingRunner) Run(ctx context.Context, job *Job) (string, error) {
+	r.mu.Lock()
+	r.runs++
+	runs := r.runs
+	r.mu.Unlock()
+	if runs == 1 {
+		<-ctx.Done()
+		return "", ctx.Err()
+	}
+	return "done", nil
+}
+
+func TestQueueRetriesDeadRunner(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	defer func(interval time.Duration) { heartbeatInterval = interval }(heartbeatInterval)
+	heartbeatInterval = 10 * time.Millisecond
+
+	runner := &stallingRunner{}
+	queue := NewQueue(zap.NewNop().Sugar(), db, runner, 1)
+	deadBefore := testutil.ToFloat64(DeadRunnersMetric)
+	queue.RunTests(&RunData{
+		Course:     &pb.Course{Code: "DAT320"},
+		Assignment: &pb.Assignment{ID: 1, Name: "lab1", ScriptFile: "#image/qf101\necho test"},
+		Repo:       &pb.Repository{UserID: 1},
+		JobOwner:   "user",
+	})
+
+	if runner.runs != 2 {
+		t.Errorf("RunTests() ran job %d times, want 2", runner.runs)
+	}
+	if got := testutil.ToFloat64(DeadRunnersMetric) - deadBefore; got != 1 {
+		t.Errorf("DeadRunnersMetric increased by %v, want 1", got)
+	}
+	if _, ok := queue.Status(1, 1, 0); ok {
+		t.Error("Status() for completed job: expected no job")
+	}
+}
+
+func TestQueuePriorityAndCancel(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	runner := &blockingRunner{started: make(chan string), release: make(chan struct{})}
+	queue := NewQueue(zap.NewNop().Sugar(), db, runner, 1)
+	assignment := &pb.Assignment{ID: 1, Name: "lab1", ScriptFile: "#image/qf101\necho test"}
+	runData := func(userID uint64, owner string, priority pb.TestJob_Priority) *RunData {
+		return &RunData{
+			Course:     &pb.Course{Code: "DAT320"},
+			Assignment: assignment,
+			Repo:       &pb.Repository{UserID: userID},
+			JobOwner:   owner,
+			Priority:   priority,
+		}
+	}
+	storedJobs := func() []*pb.TestJob {
+		t.Helper()
+		jobs, err := db.GetTestJobs()
+		if err != nil {
+			t.Fatal(err)
+		}
+		return jobs
+	}
+
+	queue.Submit(runData(1, "first", pb.TestJob_NORMAL))
+	<-runner.started
+	queue.Submit(runData(2, "low", pb.TestJob_LOW))
+	queue.Submit(runData(3, "normal", pb.TestJob_NORMAL))
+	queue.Submit(runData(4, "high", pb.TestJob_HIGH))
+	queue.Submit(runData(5, "canceled", pb.TestJob_HIGH))
+	for deadline := time.Now().Add(5 * time.Second); queue.waiting() < 4 && time.Now().Before(deadline); {
+		time.Sleep(time.Millisecond)
+	}
+	jobs := storedJobs()
+	if len(jobs) != 5 || jobs[0].GetStatus() != pb.TestJob_RUNNING || jobs[1].GetStatus() != pb.TestJob_QUEUED {
+		t.Fatalf("GetTestJobs() = %v, want one running and four queued jobs", jobs)
+	}
+	if status, ok := queue.Status(1, 4, 0); !ok || status.Position != 0 {
+		t.Errorf("Status() for high priority job = %+v, want first in line", status)
+	}
+	if status, ok := queue.Status(1, 2, 0); !ok || status.Position != 3 {
+		t.Errorf("Status() for low priority job = %+v, want last in line", status)
+	}
+
+	if !queue.Cancel(jobs[4].GetID()) {
+		t.Fatalf("Cancel(%d) = false, want true", jobs[4].GetID())
+	}
+	if queue.Cancel(jobs[4].GetID() + 100) {
+		t.Errorf("Cancel(%d) = true for unknown job, want false", jobs[4].GetID()+100)
+	}
+
+	// the queued jobs run in order of priority; the canceled job never runs
+	var order []string
+	for i := 0; i < 4; i++ {
+		runner.release <- struct{}{}
+		if i < 3 {
+			name := <-runner.started
+			order = append(order, strings.Split(name, "-")[2])
+		}
+	}
+	if diff := cmp.Diff([]string{"high", "normal", "low"}, order); diff != "" {
+		t.Errorf("job order mismatch (-want +got):\n%s", diff)
+	}
+	for deadline := time.Now().Add(5 * time.Second); len(storedJobs()) > 0 && time.Now().Before(deadline); {
+		time.Sleep(time.Millisecond)
+	}
+	if jobs := storedJobs(); len(jobs) != 0 {
+		t.Errorf("GetTestJobs() = %v after all jobs completed, want no jobs", jobs)
+	}
+}
+
+func TestQueueDrain(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	runner := &blockingRunner{started: make(chan string), release: make(chan struct{})}
+	queue := NewQueue(zap.NewNop().Sugar(), db, runner, 1)
+	assignment := &pb.Assignment{ID: 1, Name: "lab1", ScriptFile: "#image/qf101\necho test"}
+	runData := func(userID uint64) *RunData {
+		return &RunData{
+			Course:     &pb.Course{Code: "DAT320"},
+			Assignment: assignment,
+			Repo:       &pb.Repository{UserID: userID},
+			JobOwner:   "user",
+		}
+	}
+
+	queue.Submit(runData(1))
+	<-runner.started
+	queue.Submit(runData(2))
+	for deadline := time.Now().Add(5 * time.Second); queue.waiting() < 1 && time.Now().Before(deadline); {
+		time.Sleep(time.Millisecond)
+	}
+
+	// draining times out while the first job is running
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := queue.Drain(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Drain() = %v, want %v", err, context.DeadlineExceeded)
+	}
+
+	drained := make(chan error)
+	go func() { drained <- queue.Drain(context.Background()) }()
+	runner.release <- struct{}{}
+	select {
+	case err := <-drained:
+		if err != nil {
+			t.Errorf("Drain() = %v, want nil", err)
+		}
+	case name := <-runner.started:
+		t.Fatalf("queued job %s started while draining", name)
+	case <-time.After(5 * time.Second):
+		t.Fatal("Drain() did not return after the running job completed")
+	}
+
+	// the queued job remains stored, such that it is resumed after a restart
+	for deadline := time.Now().Add(5 * time.Second); time.Now().Before(deadline); {
+		jobs, err := db.GetTestJobs()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(jobs) == 1 {
+			if jobs[0].GetUserID() != 2 || jobs[0].GetStatus() != pb.TestJob_QUEUED {
+				t.Errorf("GetTestJobs() = %v, want the queued job of user 2", jobs)
+			}
+			return
+		}
+		time.Sleep(time.Millisecond)
+	}
+	t.Error("GetTestJobs() after draining: want the queued job")
+}
+
+// failingRunner fails to run the first job, and completes subsequent runs.
+type failingRunner struct {
+	mu   sync.Mutex
+	runs int
+}
+
+func (r *failingRunner) Run(_ context.Context, _ *Job) (string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.runs++
+	if r.runs == 1 {
+		return "", errors.New("cannot connect to the docker daemon")
+	}
+	return "done", nil
+}
+
+func TestQueueRetriesFailedRunner(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	defer func(delay time.Duration) { retryDelay = delay }(retryDelay)
+	retryDelay = time.Millisecond
+
+	runner := &failingRunner{}
+	queue := NewQueue(zap.NewNop().Sugar(), db, runner, 1)
+	queue.RunTests(&RunData{
+		Course:     &pb.Course{Code: "DAT320"},
+		Assignment: &pb.Assignment{ID: 1, Name: "lab1", ScriptFile: "#image/qf101\necho test"},
+		Repo:       &pb.Repository{UserID: 1},
+		JobOwner:   "user",
+	})
+	if runner.runs != 2 {
+		t.Errorf("RunTests() ran job %d times, want 2", runner.runs)
+	}
+
+	// jobs with invalid scripts are not retried
+	runner.runs = 0
+	queue.RunTests(&RunData{
+		Course:     &pb.Course{Code: "DAT320"},
+		Assignment: &pb.Assignment{ID: 1, Name: "lab1", ScriptFile: "no image"},
+		Repo:       &pb.Repository{UserID: 1},
+		JobOwner:   "user",
+	})
+	if runner.runs != 0 {
+		t.Errorf("RunTests() with invalid script ran job %d times, want 0", runner.runs)
+	}
+}
+
+func TestPoolsResume(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	admin := qtest.CreateFakeUser(t, db, 1)
+	course := &pb.Course{Code: "DAT320", OrganizationID: 1}
+	qtest.CreateCourse(t, db, admin, course)
+	assignment := &pb.Assignment{CourseID: course.ID, Name: "lab1", Order: 1, ScriptFile: "#image/qf101\necho test"}
+	if err := db.CreateAssignment(assignment); err != nil {
+		t.Fatal(err)
+	}
+	repo := &pb.Repository{OrganizationID: 1, RepositoryID: 10, UserID: admin.ID, RepoType: pb.Repository_USER}
+	if err := db.CreateRepository(repo); err != nil {
+		t.Fatal(err)
+	}
+	jobs := []*pb.TestJob{
+		{CourseID: course.ID, AssignmentID: assignment.ID, RepositoryID: repo.ID, UserID: admin.ID, JobOwner: "user", Status: pb.TestJob_RUNNING, Attempts: 1},
+		{CourseID: course.ID, AssignmentID: assignment.ID + 1, RepositoryID: repo.ID, UserID: admin.ID, JobOwner: "user"},
+	}
+	for _, job := range jobs {
+		if err := db.CreateTestJob(job); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	runner := &blockingRunner{started: make(chan string), release: make(chan struct{})}
+	pools := NewPools(zap.NewNop().Sugar(), NewQueue(zap.NewNop().Sugar(), db, runner, 1))
+	if err := pools.Resume(db); err != nil {
+		t.Fatal(err)
+	}
+	<-runner.started
+	stored, err := db.GetTestJobs()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the job for the unknown assignment is deleted, and the resumed job is running its second attempt
+	if len(stored) != 1 || stored[0].GetID() != jobs[0].GetID() || stored[0].GetAttempts() != 2 || stored[0].GetStatus() != pb.TestJob_RUNNING {
+		t.Errorf("GetTestJobs() = %v, want job %d running its second attempt", stored, jobs[0].GetID())
+	}
+	runner.release <- struct{}{}
+	for deadline := time.Now().Add(5 * time.Second); len(stored) > 0 && time.Now().Before(deadline); {
+		time.Sleep(time.Millisecond)
+		if stored, err = db.GetTestJobs(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if len(stored) != 0 {
+		t.Errorf("GetTestJobs() = %v after the resumed job completed, want no jobs", stored)
+	}
+}
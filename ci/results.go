@@ -0,0 +1,73 @@
+package ci
+
+import (
+	"sync"
+
+	pb "github.com/autograde/quickfeed/ag"
+)
+
+// resultsBuffer is the number of results buffered for each subscriber.
+// Results are dropped for subscribers that fall further behind.
+const resultsBuffer = 16
+
+// Results delivers newly recorded submission results to subscribers,
+// such as clients streaming live results for a course.
+type Results struct {
+	mu          sync.Mutex
+	subscribers map[*subscriber]struct{}
+}
+
+type subscriber struct {
+	courseID uint64
+	filter   func(*pb.Submission) bool
+	ch       chan *pb.Submission
+}
+
+// NewResults returns a new results broker without subscribers.
+func NewResults() *Results {
+	return &Results{subscribers: make(map[*subscriber]struct{})}
+}
+
+// Subscribe returns a channel receiving the results recorded for the given course,
+// or for all courses if the course ID is zero, that match the filter, and a function
+// to cancel the subscription.
+// The channel is closed when the subscription is canceled.
+func (r *Results) Subscribe(courseID uint64, filter func(*pb.Submission) bool) (<-chan *pb.Submission, func()) {
+	sub := &subscriber{
+		courseID: courseID,
+		filter:   filter,
+		ch:       make(chan *pb.Submission, resultsBuffer),
+	}
+	r.mu.Lock()
+	r.subscribers[sub] = struct{}{}
+	r.mu.Unlock()
+
+	var once sync.Once
+	return sub.ch, func() {
+		once.Do(func() {
+			r.mu.Lock()
+			delete(r.subscribers, sub)
+			r.mu.Unlock()
+			close(sub.ch)
+		})
+	}
+}
+
+// Publish delivers the submission result recorded for the given course to the
+// matching subscribers. Publish does not block on subscribers that fall behind.
+func (r *Results) Publish(courseID uint64, submission *pb.Submission) {
+	if r == nil || submission == nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for sub := range r.subscribers {
+		if sub.courseID > 0 && sub.courseID != courseID || !sub.filter(submission) {
+			continue
+		}
+		select {
+		case sub.ch <- submission:
+		default:
+		}
+	}
+}
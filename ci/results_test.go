@@ -0,0 +1,57 @@
+package ci
+
+import (
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+)
+
+func TestResults(t *testing.T) {
+	results := NewResults()
+	all, cancelAll := results.Subscribe(1, func(*pb.Submission) bool { return true })
+	own, cancelOwn := results.Subscribe(1, func(s *pb.Submission) bool { return s.GetUserID() == 2 })
+	defer cancelOwn()
+	anyCourse, cancelAnyCourse := results.Subscribe(0, func(s *pb.Submission) bool { return s.GetUserID() == 2 })
+	defer cancelAnyCourse()
+
+	results.Publish(1, &pb.Submission{ID: 1, UserID: 2})
+	results.Publish(1, &pb.Submission{ID: 2, UserID: 3})
+	results.Publish(2, &pb.Submission{ID: 3, UserID: 2}) // other course
+
+	for _, want := range []uint64{1, 2} {
+		if got := <-all; got.GetID() != want {
+			t.Errorf("all: got submission %d, want %d", got.GetID(), want)
+		}
+	}
+	if got := <-own; got.GetID() != 1 {
+		t.Errorf("own: got submission %d, want 1", got.GetID())
+	}
+	for _, want := range []uint64{1, 3} {
+		if got := <-anyCourse; got.GetID() != want {
+			t.Errorf("anyCourse: got submission %d, want %d", got.GetID(), want)
+		}
+	}
+	select {
+	case got := <-own:
+		t.Errorf("own: got unexpected submission %d", got.GetID())
+	default:
+	}
+
+	// publishing does not block on subscribers that fall behind
+	for i := 0; i < 2*resultsBuffer; i++ {
+		results.Publish(1, &pb.Submission{ID: uint64(10 + i), UserID: 2})
+	}
+	if len(own) != resultsBuffer {
+		t.Errorf("own: %d buffered submissions, want %d", len(own), resultsBuffer)
+	}
+
+	cancelAll()
+	cancelAll() // canceling twice is allowed
+	// buffered submissions are still received before the channel is closed
+	if n := len(all); n != resultsBuffer {
+		t.Errorf("all: %d buffered submissions, want %d", n, resultsBuffer)
+	}
+	for range all {
+	}
+	results.Publish(1, &pb.Submission{ID: 4, UserID: 2})
+}
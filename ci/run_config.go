@@ -0,0 +1,124 @@
+package ci
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"text/template"
+	"time"
+)
+
+// sessionSecretEnv is the environment variable holding the secret that the tests
+// must print with their scores; it is set for jobs given by a run configuration.
+const sessionSecretEnv = "QUICKFEED_SESSION_SECRET"
+
+// envName matches valid environment variable names.
+var envName = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// RunConfig describes how to run an assignment's tests. It is given by a run.json file
+// in the tests repository, as an alternative to a run.sh script template. The commands
+// and environment variable values are templates with the fields of AssignmentInfo,
+// e.g., {{ .TestURL }}, as in run.sh scripts.
+type RunConfig struct {
+	// Image is the docker image to run the tests in; it may be overridden by the assignment.
+	Image string `json:"image"`
+	// Build is a list of shell commands building the code; the tests are not run if any of them fail.
+	Build []string `json:"build"`
+	// Test is a list of shell commands running the tests.
+	Test []string `json:"test"`
+	// Env holds the environment variables of the commands.
+	Env map[string]string `json:"env"`
+	// Timeout is the number of minutes the commands may run; the assignment's container timeout takes precedence.
+	Timeout uint32 `json:"timeout"`
+}
+
+// ParseRunConfig returns the run configuration given by the contents of a run.json file.
+func ParseRunConfig(contents []byte) (*RunConfig, error) {
+	dec := json.NewDecoder(bytes.NewReader(contents))
+	dec.DisallowUnknownFields()
+	config := &RunConfig{}
+	if err := dec.Decode(config); err != nil {
+		return nil, fmt.Errorf("malformed run configuration: %w", err)
+	}
+	if len(config.Test) == 0 {
+		return nil, errors.New("no test commands in run configuration")
+	}
+	for name, value := range config.Env {
+		if !envName.MatchString(name) {
+			return nil, fmt.Errorf("invalid environment variable name %q in run configuration", name)
+		}
+		if _, err := template.New(name).Parse(value); err != nil {
+			return nil, fmt.Errorf("invalid value of environment variable %s in run configuration: %w", name, err)
+		}
+	}
+	for _, command := range append(config.Build, config.Test...) {
+		if _, err := template.New("command").Parse(command); err != nil {
+			return nil, fmt.Errorf("invalid command %q in run configuration: %w", command, err)
+		}
+	}
+	return config, nil
+}
+
+// job returns the job running the configured commands for the given assignment.
+func (c *RunConfig) job(info *AssignmentInfo) (*Job, error) {
+	// build commands stop at the first failure, while all test commands are run
+	commands := append([]string{"set -e"}, c.Build...)
+	commands = append(commands, "set +e")
+	commands = append(commands, c.Test...)
+	for i, command := range commands {
+		expanded, err := expandTemplate(command, info)
+		if err != nil {
+			return nil, err
+		}
+		commands[i] = expanded
+	}
+	names := make([]string, 0, len(c.Env))
+	for name := range c.Env {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	env := make([]string, 0, len(names)+1)
+	for _, name := range names {
+		value, err := expandTemplate(c.Env[name], info)
+		if err != nil {
+			return nil, err
+		}
+		env = append(env, name+"="+value)
+	}
+	env = append(env, sessionSecretEnv+"="+info.RandomSecret)
+	return &Job{
+		Image:    c.Image,
+		Commands: commands,
+		Env:      env,
+		Timeout:  time.Duration(c.Timeout) * time.Minute,
+	}, nil
+}
+
+// expandTemplate returns the given template text executed with the given assignment information.
+func expandTemplate(text string, info *AssignmentInfo) (string, error) {
+	t, err := template.New("runconfig").Parse(text)
+	if err != nil {
+		return "", err
+	}
+	buffer := new(bytes.Buffer)
+	if err := t.Execute(buffer, info); err != nil {
+		return "", err
+	}
+	return buffer.String(), nil
+}
+
+// parseJob returns the job running the assignment's tests, given by the assignment's
+// run configuration if it has one, and otherwise by its script template.
+func parseJob(info *AssignmentInfo) (*Job, error) {
+	if info.RunConfig == "" {
+		return parseScriptTemplate(info)
+	}
+	config, err := ParseRunConfig([]byte(info.RunConfig))
+	if err != nil {
+		return nil, err
+	}
+	return config.job(info)
+}
@@ -0,0 +1,75 @@
+package ci
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/internal/qtest"
+	"go.uber.org/zap"
+)
+
+func TestParseRunConfig(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  string
+		wantErr bool
+	}{
+		{name: "valid", config: `{"image": "golang:1.17", "build": ["go vet ./..."], "test": ["go test ./..."], "env": {"GOFLAGS": "-mod=mod"}, "timeout": 5}`},
+		{name: "no image", config: `{"test": ["go test ./..."]}`},
+		{name: "no test commands", config: `{"image": "golang:1.17", "build": ["go vet ./..."]}`, wantErr: true},
+		{name: "unknown field", config: `{"image": "golang:1.17", "test": ["go test ./..."], "timeot": 5}`, wantErr: true},
+		{name: "invalid environment variable", config: `{"test": ["go test ./..."], "env": {"GO FLAGS": "-mod=mod"}}`, wantErr: true},
+		{name: "invalid template", config: `{"test": ["cd {{ .AssignmentName"]}`, wantErr: true},
+		{name: "malformed", config: `{"test": "go test ./..."}`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := ParseRunConfig([]byte(tt.config)); (err != nil) != tt.wantErr {
+				t.Errorf("ParseRunConfig() error = %v, want error %t", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRunTestsRunConfig(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	course := &pb.Course{Code: "DAT320", OrganizationID: 1}
+	admin := qtest.CreateFakeUser(t, db, 1)
+	qtest.CreateCourse(t, db, admin, course)
+	config, err := json.Marshal(&RunConfig{
+		Image: "qf101",
+		// the build fails unless the environment variable is set from the template
+		Build: []string{`test "$LAB" = lab1`},
+		Test: []string{
+			`echo "{\"Secret\":\"$QUICKFEED_SESSION_SECRET\",\"TestName\":\"TestAdd\",\"Score\":1,\"MaxScore\":1,\"Weight\":1}"`,
+			`echo '{"Secret":"{{ .RandomSecret }}","TestName":"TestSub","Score":1,"MaxScore":1,"Weight":1}'`,
+		},
+		Env:     map[string]string{"LAB": "{{ .AssignmentName }}"},
+		Timeout: 1,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assignment := &pb.Assignment{
+		CourseID:  course.ID,
+		Name:      "lab1",
+		RunConfig: string(config),
+		Deadline:  "2022-11-11T13:00:00",
+		Order:     1,
+	}
+	if err := db.CreateAssignment(assignment); err != nil {
+		t.Fatal(err)
+	}
+	runData := &RunData{Course: course, Assignment: assignment, Repo: &pb.Repository{UserID: admin.ID}, JobOwner: "test"}
+	submission, err := RunTests(context.Background(), zap.NewNop().Sugar(), db, &Local{}, runData)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if submission.GetScore() != 100 || len(submission.GetScores()) != 2 {
+		t.Errorf("submission score = %d with %d tests, want 100 with 2 tests", submission.GetScore(), len(submission.GetScores()))
+	}
+}
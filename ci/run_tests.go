@@ -2,10 +2,12 @@ package ci
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
 	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/artifacts"
 	"github.com/autograde/quickfeed/database"
 	"github.com/autograde/quickfeed/kit/score"
 	"github.com/autograde/quickfeed/log"
@@ -21,6 +23,14 @@ type RunData struct {
 	CommitID   string
 	JobOwner   string
 	Rebuild    bool
+	// PullRequest is the number of the pull request whose head commit is tested; 0 for pushes.
+	PullRequest uint64
+	// Priority determines when the job runs relative to other queued jobs.
+	Priority pb.TestJob_Priority
+	// heartbeat is called periodically while the tests are running.
+	heartbeat func()
+	// artifactStore stores the artifacts produced by the tests; nil if artifacts are not stored.
+	artifactStore artifacts.Store
 }
 
 // String returns a string representation of the run data structure
@@ -28,17 +38,61 @@ func (r RunData) String(secret string) string {
 	return fmt.Sprintf("%s-%s-%s-%s", r.Course.GetCode(), r.Assignment.GetName(), r.JobOwner, secret)
 }
 
-// RunTests runs the assignment specified in the provided RunData structure.
-func RunTests(logger *zap.SugaredLogger, db database.Database, runner Runner, rData *RunData) {
-	info := newAssignmentInfo(rData.Course, rData.Assignment, rData.Repo.GetHTMLURL(), rData.Repo.GetTestURL())
+// RunTests runs the assignment specified in the provided RunData structure,
+// and returns the recorded submission, or nil if no results were recorded.
+// The tests are aborted without recording results if the context is canceled.
+// An error is returned if the runner failed to run the tests, e.g., because
+// the runner's docker daemon is unavailable; the tests may then be retried.
+func RunTests(ctx context.Context, logger *zap.SugaredLogger, db database.Database, runner Runner, rData *RunData) (*pb.Submission, error) {
+	info := newAssignmentInfo(rData.Course, rData.Assignment, rData.Repo.GetHTMLURL(), rData.Repo.GetTestURL(rData.Course), rData.CommitID)
+	// the submission is graded if its policy could not be checked
+	violations, err := checkPolicy(ctx, info, rData)
+	if err != nil {
+		logger.Errorf("Failed to check policy for %s: %v", rData.JobOwner, err)
+	}
+	if len(violations) > 0 {
+		logger.Debugf("Found %d policy violations for %s", len(violations), rData.JobOwner)
+		if rData.Assignment.GetBlockPolicyViolations() {
+			results := &score.Results{
+				BuildInfo: &score.BuildInfo{
+					BuildDate: time.Now().UTC().Format(pb.TimeLayout),
+					BuildLog:  policyLog(violations),
+				},
+			}
+			// the blocked submission is the commit checked out by the policy check
+			var gradedCommit string
+			if validCommit.MatchString(rData.CommitID) {
+				gradedCommit = rData.CommitID
+			}
+			submission := recordResults(logger, db, rData, results, pb.Submission_NO_LIMIT, gradedCommit, violations, nil)
+			if submission != nil {
+				// the tests of the previous test run do not apply to the blocked submission
+				recordTestResults(logger, db, submission, nil)
+				recordTaskScores(logger, db, submission, nil)
+			}
+			return submission, nil
+		}
+	}
 	logger.Debugf("Running tests for %s", rData.JobOwner)
-	ed, err := runTests(runner, info, rData)
+	ed, err := runTests(ctx, runner, info, rData)
+	exceeded := pb.Submission_NO_LIMIT
 	if err != nil {
 		logger.Errorf("Failed to run tests: %v", err)
 		if ed == nil {
-			return
+			if ctx.Err() == nil && !errors.Is(err, errScriptTemplate) {
+				return nil, err
+			}
+			return nil, nil
 		}
-		// we only get here if err was a timeout, so that we can log 'out' to the user
+		// we only get here if the tests exceeded a resource limit, so that we can log 'out' to the user
+		var limitErr *LimitExceededError
+		if errors.As(err, &limitErr) {
+			exceeded = limitErr.Limit
+		}
+	}
+	if ctx.Err() != nil {
+		logger.Errorf("Tests for %s aborted: %v", rData.JobOwner, ctx.Err())
+		return nil, nil
 	}
 	results := score.ExtractResults(ed.out, info.RandomSecret, ed.execTime)
 	if len(results.Errors) > 0 {
@@ -47,48 +101,94 @@ func RunTests(logger *zap.SugaredLogger, db database.Database, runner Runner, rD
 		}
 	}
 	logger.Debug("ci.RunTests", zap.Any("Results", log.IndentJson(results)))
-	recordResults(logger, db, rData, results)
+	submission := recordResults(logger, db, rData, results, exceeded, ed.gradedCommit, violations, extractCoverage(ed.artifacts))
+	if submission == nil {
+		return nil, nil
+	}
+	tests := testResults(ed.out, submission.GetScores())
+	recordTestResults(logger, db, submission, tests)
+	recordTaskScores(logger, db, submission, tests)
+	if rData.artifactStore != nil {
+		storeArtifacts(ctx, logger, db, rData.artifactStore, submission, ed.artifacts)
+	}
+	return submission, nil
 }
 
+// errScriptTemplate is returned by runTests if the assignment's script template is invalid.
+var errScriptTemplate = errors.New("failed to parse script template")
+
 type execData struct {
-	out      string
-	execTime time.Duration
+	out       string
+	execTime  time.Duration
+	artifacts []artifact
+	// gradedCommit is the commit the tests ran on; empty if the tests ran on the head of the default branch.
+	gradedCommit string
 }
 
 // runTests returns execData struct.
 // An error is returned if the execution fails, or times out.
 // If a timeout is the cause of the error, we also return an output string to the user.
-func runTests(runner Runner, info *AssignmentInfo, rData *RunData) (*execData, error) {
-	job, err := parseScriptTemplate(info)
+func runTests(ctx context.Context, runner Runner, info *AssignmentInfo, rData *RunData) (*execData, error) {
+	job, err := parseJob(info)
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse script template: %w", err)
+		return nil, fmt.Errorf("%w: %v", errScriptTemplate, err)
+	}
+
+	var gradedCommit string
+	if pinCommit(job, info.GetURL, info.CommitID) {
+		gradedCommit = info.CommitID
 	}
 
+	job.Image, job.Dockerfile = assignmentImage(rData.Course, rData.Assignment, job.Image)
+	if job.Image == "" {
+		return nil, fmt.Errorf("%w: no docker image specified for assignment %s", errScriptTemplate, info.AssignmentName)
+	}
+	job.Limits = Limits{
+		CPU:    rData.Assignment.GetCpuLimit(),
+		Memory: rData.Assignment.GetMemoryLimit(),
+		Pids:   rData.Assignment.GetPidsLimit(),
+	}
 	job.Name = rData.String(info.RandomSecret[:6])
+	job.Heartbeat = rData.heartbeat
 	start := time.Now()
 
 	timeout := containerTimeout
+	if job.Timeout > 0 {
+		timeout = job.Timeout
+	}
 	t := rData.Assignment.GetContainerTimeout()
 	if t > 0 {
 		timeout = time.Duration(t) * time.Minute
 	}
-	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
+	// artifacts are collected even if they are not stored, since they may hold coverage reports
+	var produced []artifact
+	job.Artifact = func(name string, content []byte) {
+		if name == BuildLogArtifact {
+			content = []byte(withoutScores(string(content)))
+		}
+		produced = append(produced, artifact{name: name, content: content})
+	}
 	out, err := runner.Run(ctx, job)
 	if err != nil && out == "" {
 		return nil, fmt.Errorf("test execution failed: %w", err)
 	}
 	// this may return a timeout error as well
-	return &execData{out: out, execTime: time.Since(start)}, err
+	return &execData{out: out, execTime: time.Since(start), artifacts: produced, gradedCommit: gradedCommit}, err
 }
 
-// recordResults for the assignment given by the run data structure.
-func recordResults(logger *zap.SugaredLogger, db database.Database, rData *RunData, result *score.Results) {
+// recordResults for the assignment given by the run data structure, the resource limit
+// exceeded by the tests, if any, the commit the tests ran on, if pinned, the policy
+// violations found in the pushed code, and the test coverage of the pushed code, if reported by the tests.
+// If the assignment blocks policy violations and there are any, the submission is recorded
+// as blocked. Returns the recorded submission, or nil if the results could not be recorded.
+func recordResults(logger *zap.SugaredLogger, db database.Database, rData *RunData, result *score.Results, exceeded pb.Submission_ExceededLimit, gradedCommit string, violations []*pb.PolicyViolation, coverage []*pb.FileCoverage) *pb.Submission {
 	// Sanity check of the result object
 	if result == nil || result.BuildInfo == nil {
 		logger.Errorf("No build info found; faulty Results object received: %v", result)
-		return
+		return nil
 	}
 
 	assignment := rData.Assignment
@@ -101,7 +201,7 @@ func recordResults(logger *zap.SugaredLogger, db database.Database, rData *RunDa
 	newest, err := db.GetSubmission(submissionQuery)
 	if err != nil && err != gorm.ErrRecordNotFound {
 		logger.Errorf("Failed to get submission data from database: %v", err)
-		return
+		return nil
 	}
 
 	// Keep the original submission's delivery date (obtained from the database (newest)) if this is a manual rebuild.
@@ -116,26 +216,68 @@ func recordResults(logger *zap.SugaredLogger, db database.Database, rData *RunDa
 	}
 
 	score := result.Sum()
+	if assignment.GradedManually() && newest.GetReleased() {
+		// keep the released review's contribution to the submission score
+		score = assignment.CombinedScore(score, releasedReviewScore(newest))
+	}
 	newSubmission := &pb.Submission{
-		ID:           newest.GetID(),
-		AssignmentID: assignment.GetID(),
-		CommitHash:   rData.CommitID,
-		Score:        score,
-		BuildInfo:    result.BuildInfo,
-		Scores:       result.Scores,
-		UserID:       rData.Repo.GetUserID(),
-		GroupID:      rData.Repo.GetGroupID(),
-		Status:       assignment.IsApproved(newest, score),
+		ID:            newest.GetID(),
+		AssignmentID:  assignment.GetID(),
+		CommitHash:    rData.CommitID,
+		GradedCommit:  gradedCommit,
+		PullRequest:   rData.PullRequest,
+		Score:         score,
+		ReviewScore:   newest.GetReviewScore(),
+		ExceededLimit: exceeded,
+		BuildInfo:     result.BuildInfo,
+		Scores:        result.Scores,
+		UserID:        rData.Repo.GetUserID(),
+		GroupID:       rData.Repo.GetGroupID(),
+
+		PolicyViolations: violations,
+		PolicyBlocked:    len(violations) > 0 && assignment.GetBlockPolicyViolations(),
+
+		Coverage:     totalCoverage(coverage),
+		FileCoverage: coverage,
+	}
+	// late submissions are penalized from the student's or group's extended deadline, if any
+	newSubmission.Score = extendedAssignment(db, assignment, newSubmission).ApplyLatePenalty(newSubmission, score)
+	newSubmission.Status = assignment.IsApproved(newest, newSubmission.GetScore())
+	if newSubmission.Status == pb.Submission_APPROVED && newest.GetStatus() != pb.Submission_APPROVED &&
+		exceedsSlipDays(logger, db, rData.Course, assignment, newSubmission) {
+		// late submissions are not approved automatically when the student or group has no slip days left
+		logger.Debugf("Submission for assignment '%s' exceeds the slip days of %s", assignment.GetName(), rData.JobOwner)
+		newSubmission.Status = newest.GetStatus()
 	}
 	err = db.CreateSubmission(newSubmission)
 	if err != nil {
 		logger.Errorf("Failed to add submission to database: %v", err)
-		return
+		return nil
 	}
-	logger.Debugf("Created submission for assignment '%s' with score %d, status %s", assignment.GetName(), score, newSubmission.GetStatus())
+	logger.Debugf("Created submission for assignment '%s' with score %d, status %s", assignment.GetName(), newSubmission.GetScore(), newSubmission.GetStatus())
 	if !rData.Rebuild {
 		updateSlipDays(logger, db, rData.Assignment, newSubmission)
 	}
+	return newSubmission
+}
+
+// releasedReviewScore returns the score of the submission's ready review, if any.
+func releasedReviewScore(submission *pb.Submission) uint32 {
+	for _, review := range submission.GetReviews() {
+		if review.GetReady() {
+			return review.GetScore()
+		}
+	}
+	return 0
+}
+
+// extendedAssignment returns the assignment with the deadline extended for the
+// submission's student or group, if they were granted an extension.
+func extendedAssignment(db database.Database, assignment *pb.Assignment, submission *pb.Submission) *pb.Assignment {
+	if extension, err := db.GetExtension(assignment.GetID(), submission.GetUserID(), submission.GetGroupID()); err == nil {
+		return assignment.WithExtension(extension)
+	}
+	return assignment
 }
 
 func updateSlipDays(logger *zap.SugaredLogger, db database.Database, assignment *pb.Assignment, submission *pb.Submission) {
@@ -145,24 +287,14 @@ func updateSlipDays(logger *zap.SugaredLogger, db database.Database, assignment
 		logger.Errorf("Failed to parse time from build date (%s): %v", buildDate, err)
 		return
 	}
+	// slip days are counted from the extended deadline, if the student or group was granted an extension
+	assignment = extendedAssignment(db, assignment, submission)
 
-	enrollments := make([]*pb.Enrollment, 0)
-	if submission.GroupID > 0 {
-		group, err := db.GetGroup(submission.GroupID)
-		if err != nil {
-			logger.Errorf("Failed to get group %d: %v", submission.GroupID, err)
-			return
-		}
-		enrollments = append(enrollments, group.Enrollments...)
-	} else {
-		enrol, err := db.GetEnrollmentByCourseAndUser(assignment.CourseID, submission.UserID)
-		if err != nil {
-			logger.Errorf("Failed to get enrollment for user %d: %v", submission.UserID, err)
-			return
-		}
-		enrollments = append(enrollments, enrol)
+	enrollments, err := ownerEnrollments(db, assignment, submission)
+	if err != nil {
+		logger.Errorf("Failed to get enrollments of submission %d: %v", submission.ID, err)
+		return
 	}
-
 	for _, enrol := range enrollments {
 		if err := enrol.UpdateSlipDays(buildTime, assignment, submission); err != nil {
 			logger.Errorf("Failed to update slip days for submission %d: %v", submission.ID, err)
@@ -174,3 +306,47 @@ func updateSlipDays(logger *zap.SugaredLogger, db database.Database, assignment
 		}
 	}
 }
+
+// ownerEnrollments returns the course enrollment of the submission's student,
+// or the enrollments of the members of the submission's group.
+func ownerEnrollments(db database.Database, assignment *pb.Assignment, submission *pb.Submission) ([]*pb.Enrollment, error) {
+	if submission.GroupID > 0 {
+		group, err := db.GetGroup(submission.GroupID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get group %d: %w", submission.GroupID, err)
+		}
+		return group.Enrollments, nil
+	}
+	enrol, err := db.GetEnrollmentByCourseAndUser(assignment.CourseID, submission.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get enrollment for user %d: %w", submission.UserID, err)
+	}
+	return []*pb.Enrollment{enrol}, nil
+}
+
+// exceedsSlipDays returns true if the course has a slip-day budget, and the slip days used
+// by the late submission exceed the remaining slip days of its student or any group member.
+func exceedsSlipDays(logger *zap.SugaredLogger, db database.Database, course *pb.Course, assignment *pb.Assignment, submission *pb.Submission) bool {
+	if course.GetSlipDays() == 0 {
+		return false
+	}
+	delivered, err := time.Parse(pb.TimeLayout, submission.GetBuildInfo().GetBuildDate())
+	if err != nil {
+		return false
+	}
+	slipDays, err := extendedAssignment(db, assignment, submission).SlipDaysFor(delivered)
+	if err != nil || slipDays == 0 {
+		return false
+	}
+	enrollments, err := ownerEnrollments(db, assignment, submission)
+	if err != nil {
+		logger.Errorf("Failed to check slip days of submission to assignment %d: %v", assignment.GetID(), err)
+		return false
+	}
+	for _, enrol := range enrollments {
+		if enrol.ExceedsSlipDays(course, assignment.GetID(), slipDays) {
+			return true
+		}
+	}
+	return false
+}
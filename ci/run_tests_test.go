@@ -2,9 +2,12 @@ package ci
 
 import (
 	"context"
+	"errors"
+	"io/ioutil"
 	"testing"
 
 	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/artifacts"
 	"github.com/autograde/quickfeed/internal/qtest"
 	"github.com/autograde/quickfeed/kit/score"
 	"github.com/autograde/quickfeed/log"
@@ -59,7 +62,7 @@ func TestRunTests(t *testing.T) {
 		t.Fatal(err)
 	}
 	defer runner.Close()
-	ed, err := runTests(runner, info, runData)
+	ed, err := runTests(context.Background(), runner, info, runData)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -122,7 +125,7 @@ func TestRecordResults(t *testing.T) {
 		JobOwner: "test",
 	}
 
-	recordResults(zap.NewNop().Sugar(), db, runData, results)
+	recordResults(zap.NewNop().Sugar(), db, runData, results, pb.Submission_NO_LIMIT, "", nil, nil)
 	submission, err := db.GetSubmission(&pb.Submission{AssignmentID: assignment.ID, UserID: admin.ID})
 	if err != nil {
 		t.Fatal(err)
@@ -140,7 +143,7 @@ func TestRecordResults(t *testing.T) {
 	// Updating submission after deadline: build info and slip days must be updated
 	newBuildDate := "2022-11-12T13:00:00"
 	results.BuildInfo.BuildDate = newBuildDate
-	recordResults(zap.NewNop().Sugar(), db, runData, results)
+	recordResults(zap.NewNop().Sugar(), db, runData, results, pb.Submission_NO_LIMIT, "", nil, nil)
 
 	enrollment, err := db.GetEnrollmentByCourseAndUser(course.ID, admin.ID)
 	if err != nil {
@@ -161,7 +164,7 @@ func TestRecordResults(t *testing.T) {
 	runData.Rebuild = true
 	results.BuildInfo.BuildDate = "2022-11-13T13:00:00"
 	slipDaysBeforeUpdate := enrollment.RemainingSlipDays(course)
-	recordResults(zap.NewNop().Sugar(), db, runData, results)
+	recordResults(zap.NewNop().Sugar(), db, runData, results, pb.Submission_NO_LIMIT, "", nil, nil)
 	updatedEnrollment, err := db.GetEnrollmentByCourseAndUser(course.ID, admin.ID)
 	if err != nil {
 		t.Fatal(err)
@@ -170,3 +173,288 @@ func TestRecordResults(t *testing.T) {
 		t.Errorf("Incorrect number of slip days: expected %d, got %d", slipDaysBeforeUpdate, updatedEnrollment.RemainingSlipDays(course))
 	}
 }
+
+func TestRecordResultsLatePenalty(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	course := &pb.Course{Name: "Test", OrganizationID: 1}
+	admin := qtest.CreateFakeUser(t, db, 1)
+	qtest.CreateCourse(t, db, admin, course)
+	assignment := &pb.Assignment{
+		CourseID:       course.ID,
+		Name:           "lab1",
+		Deadline:       "2022-11-10T12:00:00",
+		AutoApprove:    true,
+		ScoreLimit:     90,
+		Order:          1,
+		LateGraceHours: 2,
+		LatePenalty:    10,
+		LateCutoffDays: 3,
+	}
+	if err := db.CreateAssignment(assignment); err != nil {
+		t.Fatal(err)
+	}
+	runData := &RunData{
+		Course:     course,
+		Assignment: assignment,
+		Repo:       &pb.Repository{UserID: admin.ID},
+		JobOwner:   "test",
+	}
+	results := &score.Results{
+		BuildInfo: &score.BuildInfo{BuildLog: "Testing"},
+		Scores:    []*score.Score{{TestName: "Test", Score: 15, MaxScore: 15, Weight: 1}},
+	}
+
+	latePenaltyTests := []struct {
+		name      string
+		buildDate string
+		extension string
+		penalty   uint32
+		score     uint32
+		status    pb.Submission_Status
+	}{
+		// penalized scores below the score limit are not approved
+		{name: "SecondDay", buildDate: "2022-11-11T13:00:00", penalty: 20, score: 80, status: pb.Submission_NONE},
+		{name: "AfterCutoff", buildDate: "2022-11-13T13:00:00", penalty: 100, score: 0, status: pb.Submission_NONE},
+		{name: "GracePeriod", buildDate: "2022-11-10T13:00:00", penalty: 0, score: 100, status: pb.Submission_APPROVED},
+		// the penalty is counted from the extended deadline
+		{name: "Extended", buildDate: "2022-11-12T13:00:00", extension: "2022-11-12T12:00:00", penalty: 0, score: 100, status: pb.Submission_APPROVED},
+	}
+	for _, test := range latePenaltyTests {
+		if test.extension != "" {
+			if err := db.UpdateExtension(&pb.Extension{CourseID: course.ID, AssignmentID: assignment.ID, UserID: admin.ID, Deadline: test.extension}); err != nil {
+				t.Fatal(err)
+			}
+		}
+		results.BuildInfo.BuildDate = test.buildDate
+		recordResults(zap.NewNop().Sugar(), db, runData, results, pb.Submission_NO_LIMIT, "", nil, nil)
+		submission, err := db.GetSubmission(&pb.Submission{AssignmentID: assignment.ID, UserID: admin.ID})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if submission.GetScore() != test.score || submission.GetLatePenalty() != test.penalty {
+			t.Errorf("%s: score %d with penalty %d, want score %d with penalty %d", test.name, submission.GetScore(), submission.GetLatePenalty(), test.score, test.penalty)
+		}
+		if submission.GetStatus() != test.status {
+			t.Errorf("%s: status %v, want %v", test.name, submission.GetStatus(), test.status)
+		}
+	}
+}
+
+func TestRecordResultsSlipDays(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	course := &pb.Course{Name: "Test", OrganizationID: 1, SlipDays: 2}
+	admin := qtest.CreateFakeUser(t, db, 1)
+	qtest.CreateCourse(t, db, admin, course)
+	lab1 := &pb.Assignment{CourseID: course.ID, Name: "lab1", Deadline: "2022-11-01T12:00:00", Order: 1}
+	lab2 := &pb.Assignment{CourseID: course.ID, Name: "lab2", Deadline: "2022-11-10T12:00:00", AutoApprove: true, ScoreLimit: 50, Order: 2}
+	for _, lab := range []*pb.Assignment{lab1, lab2} {
+		if err := db.CreateAssignment(lab); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	slipDayTests := []struct {
+		name      string
+		buildDate string
+		status    pb.Submission_Status
+	}{
+		// each student has used one of the course's two slip days for lab1
+		{name: "OneSlipDay", buildDate: "2022-11-11T13:00:00", status: pb.Submission_APPROVED},
+		{name: "TwoSlipDays", buildDate: "2022-11-11T15:00:00", status: pb.Submission_NONE},
+		{name: "BeforeDeadline", buildDate: "2022-11-10T11:00:00", status: pb.Submission_APPROVED},
+	}
+	for i, test := range slipDayTests {
+		student := qtest.CreateFakeUser(t, db, uint64(i+2))
+		qtest.EnrollStudent(t, db, student, course)
+		enrollment, err := db.GetEnrollmentByCourseAndUser(course.ID, student.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := db.UpdateSlipDays([]*pb.UsedSlipDays{{EnrollmentID: enrollment.ID, AssignmentID: lab1.ID, UsedSlipDays: 1}}); err != nil {
+			t.Fatal(err)
+		}
+		runData := &RunData{
+			Course:     course,
+			Assignment: lab2,
+			Repo:       &pb.Repository{UserID: student.ID},
+			JobOwner:   "test",
+		}
+		results := &score.Results{
+			BuildInfo: &score.BuildInfo{BuildDate: test.buildDate, BuildLog: "Testing"},
+			Scores:    []*score.Score{{TestName: "Test", Score: 15, MaxScore: 15, Weight: 1}},
+		}
+		submission := recordResults(zap.NewNop().Sugar(), db, runData, results, pb.Submission_NO_LIMIT, "", nil, nil)
+		if submission.GetStatus() != test.status {
+			t.Errorf("%s: status %v, want %v", test.name, submission.GetStatus(), test.status)
+		}
+	}
+}
+
+// limitRunner records the job's limits and stops the job as if it exceeded the given limit.
+type limitRunner struct {
+	limit  pb.Submission_ExceededLimit
+	limits Limits
+}
+
+func (r *limitRunner) Run(_ context.Context, job *Job) (string, error) {
+	r.limits = job.Limits
+	return "Container timeout. Please check for infinite loops or other slowness.", &LimitExceededError{Limit: r.limit, Err: context.DeadlineExceeded}
+}
+
+func TestRunTestsLimitExceeded(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	course := &pb.Course{Code: "DAT320", OrganizationID: 1}
+	admin := qtest.CreateFakeUser(t, db, 1)
+	qtest.CreateCourse(t, db, admin, course)
+	assignment := &pb.Assignment{
+		CourseID:    course.ID,
+		Name:        "lab1",
+		ScriptFile:  "#image/qf101\necho test",
+		Deadline:    "2022-11-11T13:00:00",
+		Order:       1,
+		CpuLimit:    1500,
+		MemoryLimit: 256,
+		PidsLimit:   64,
+	}
+	if err := db.CreateAssignment(assignment); err != nil {
+		t.Fatal(err)
+	}
+
+	runner := &limitRunner{limit: pb.Submission_TIME_LIMIT}
+	runData := &RunData{Course: course, Assignment: assignment, Repo: &pb.Repository{UserID: admin.ID}, JobOwner: "test"}
+	submission, err := RunTests(context.Background(), zap.NewNop().Sugar(), db, runner, runData)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if submission == nil {
+		t.Fatal("RunTests() recorded no submission")
+	}
+	if want := (Limits{CPU: 1500, Memory: 256, Pids: 64}); runner.limits != want {
+		t.Errorf("job limits = %+v, want %+v", runner.limits, want)
+	}
+	stored, err := db.GetSubmission(&pb.Submission{ID: submission.GetID()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stored.GetExceededLimit() != pb.Submission_TIME_LIMIT || stored.GetScore() != 0 {
+		t.Errorf("submission exceeded limit = %v with score %d, want %v with score 0", stored.GetExceededLimit(), stored.GetScore(), pb.Submission_TIME_LIMIT)
+	}
+}
+
+func TestHostConfig(t *testing.T) {
+	config := hostConfig(Limits{CPU: 1500, Memory: 256})
+	if config.NanoCPUs != 1_500_000_000 || config.Memory != 256<<20 || config.MemorySwap != config.Memory {
+		t.Errorf("hostConfig() resources = %+v, want 1.5 CPUs and 256 MB memory without swap", config.Resources)
+	}
+	if *config.PidsLimit != int64(defaultPidsLimit) {
+		t.Errorf("hostConfig() pids limit = %d, want default %d", *config.PidsLimit, defaultPidsLimit)
+	}
+	config = hostConfig(Limits{Pids: 64})
+	if config.NanoCPUs != 0 || config.Memory != 0 || *config.PidsLimit != 64 {
+		t.Errorf("hostConfig() resources = %+v, want no CPU and memory limits with pids limit 64", config.Resources)
+	}
+}
+
+// artifactRunner outputs the given output, and reports it and the given files as artifacts.
+type artifactRunner struct {
+	out   string
+	files map[string]string
+}
+
+func (r *artifactRunner) Run(_ context.Context, job *Job) (string, error) {
+	if job.Artifact != nil {
+		job.Artifact(BuildLogArtifact, []byte(r.out))
+		for name, content := range r.files {
+			job.Artifact(name, []byte(content))
+		}
+	}
+	return r.out, nil
+}
+
+func TestRunTestsArtifacts(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	course := &pb.Course{Code: "DAT320", OrganizationID: 1}
+	admin := qtest.CreateFakeUser(t, db, 1)
+	qtest.CreateCourse(t, db, admin, course)
+	assignment := &pb.Assignment{
+		CourseID:   course.ID,
+		Name:       "lab1",
+		ScriptFile: "#image/qf101\necho test",
+		Deadline:   "2022-11-11T13:00:00",
+		Order:      1,
+	}
+	if err := db.CreateAssignment(assignment); err != nil {
+		t.Fatal(err)
+	}
+	store, err := artifacts.NewFileStore(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	runData := &RunData{Course: course, Assignment: assignment, Repo: &pb.Repository{UserID: admin.ID}, JobOwner: "test", artifactStore: store}
+	ctx := context.Background()
+
+	runner := &artifactRunner{
+		out: "=== RUN TestLab1\n{\"Secret\":\"secret\",\"TestName\":\"TestLab1\",\"Score\":1,\"MaxScore\":1,\"Weight\":1}\n--- PASS: TestLab1",
+		files: map[string]string{
+			"coverage.html": "<html><body>coverage</body></html>",
+			"../escape":     "outside",
+			"report.bin":    "\x00\x01",
+		},
+	}
+	submission, err := RunTests(ctx, zap.NewNop().Sugar(), db, runner, runData)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := db.GetArtifacts(submission.GetID())
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]string{
+		BuildLogArtifact: "text/plain; charset=utf-8",
+		"coverage.html":  "text/html; charset=utf-8",
+		"report.bin":     "application/octet-stream",
+	}
+	gotTypes := make(map[string]string)
+	for _, a := range got {
+		gotTypes[a.GetName()] = a.GetContentType()
+	}
+	if diff := cmp.Diff(want, gotTypes); diff != "" {
+		t.Errorf("artifact content types mismatch (-want +got):\n%s", diff)
+	}
+	// the stored build log does not contain the score strings holding the secret
+	r, err := store.Get(ctx, artifacts.Key(submission.GetID(), BuildLogArtifact))
+	if err != nil {
+		t.Fatal(err)
+	}
+	buildLog, err := ioutil.ReadAll(r)
+	r.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "=== RUN TestLab1\n--- PASS: TestLab1"; string(buildLog) != want {
+		t.Errorf("build log = %q, want %q", buildLog, want)
+	}
+
+	// artifacts of a rebuild replace the previous artifacts
+	runner.files = nil
+	if submission, err = RunTests(ctx, zap.NewNop().Sugar(), db, runner, runData); err != nil {
+		t.Fatal(err)
+	}
+	if got, err = db.GetArtifacts(submission.GetID()); err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 1 || got[0].GetName() != BuildLogArtifact {
+		t.Errorf("artifacts after rebuild = %v, want only %s", got, BuildLogArtifact)
+	}
+	if _, err := store.Get(ctx, artifacts.Key(submission.GetID(), "coverage.html")); !errors.Is(err, artifacts.ErrNotFound) {
+		t.Errorf("Get(coverage.html) after rebuild: err = %v, want %v", err, artifacts.ErrNotFound)
+	}
+}
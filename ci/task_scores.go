@@ -0,0 +1,51 @@
+package ci
+
+import (
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/database"
+	"github.com/autograde/quickfeed/kit/score"
+	"go.uber.org/zap"
+)
+
+// taskScores returns the score of each of the given tasks on the given test results,
+// computed from the scores of the task's tests as the submission's score is computed
+// from the scores of all tests. The results must be the test results of the scores.
+func taskScores(tasks []*pb.Task, scores []*score.Score, results []*pb.TestResult) []*pb.TaskScore {
+	taskScores := make([]*pb.TaskScore, 0, len(tasks))
+	for _, task := range tasks {
+		taskScore := &pb.TaskScore{TaskName: task.GetName(), TaskTitle: task.GetTitle()}
+		var taskTests []*score.Score
+		for i, sc := range scores {
+			if !task.HasTest(sc.GetTestName()) {
+				continue
+			}
+			taskTests = append(taskTests, sc)
+			taskScore.TotalTests++
+			if i < len(results) && results[i].GetStatus() == pb.TestResult_PASSED {
+				taskScore.PassedTests++
+			}
+		}
+		if len(taskTests) > 0 {
+			taskScore.Score = (&score.Results{Scores: taskTests}).Sum()
+		}
+		taskScores = append(taskScores, taskScore)
+	}
+	return taskScores
+}
+
+// recordTaskScores replaces the task scores of the submission with its scores on the tasks
+// of its assignment, given the submission's test results. Nothing is recorded for assignments
+// without tasks.
+func recordTaskScores(logger *zap.SugaredLogger, db database.Database, submission *pb.Submission, results []*pb.TestResult) {
+	tasks, err := db.GetTasks(submission.GetAssignmentID())
+	if err != nil {
+		logger.Errorf("Failed to get tasks of assignment %d: %v", submission.GetAssignmentID(), err)
+		return
+	}
+	if len(tasks) == 0 {
+		return
+	}
+	if err := db.UpdateTaskScores(submission.GetID(), taskScores(tasks, submission.GetScores(), results)); err != nil {
+		logger.Errorf("Failed to update task scores of submission %d: %v", submission.GetID(), err)
+	}
+}
@@ -0,0 +1,57 @@
+package ci
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/internal/qtest"
+	"github.com/google/go-cmp/cmp"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func TestRunTestsRecordsTaskScores(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	course := &pb.Course{Code: "DAT320", OrganizationID: 1}
+	admin := qtest.CreateFakeUser(t, db, 1)
+	qtest.CreateCourse(t, db, admin, course)
+	assignment := &pb.Assignment{
+		CourseID: course.ID,
+		Name:     "lab1",
+		ScriptFile: `#image/qf101
+echo '{"Secret":"{{ .RandomSecret }}","TestName":"TestFibSmall","Score":2,"MaxScore":2,"Weight":1}'
+echo '{"Secret":"{{ .RandomSecret }}","TestName":"TestFibLarge","Score":0,"MaxScore":2,"Weight":1}'
+echo '{"Secret":"{{ .RandomSecret }}","TestName":"TestIsPrime","Score":3,"MaxScore":3,"Weight":1}'
+echo '{"Secret":"{{ .RandomSecret }}","TestName":"TestPrimes","Score":3,"MaxScore":3,"Weight":1}'`,
+		Deadline: "2022-11-11T13:00:00",
+		Order:    1,
+		Tasks: []*pb.Task{
+			{Name: "fib", Title: "Fibonacci", Order: 1},
+			{Name: "primes", Title: "Primes", Tests: "TestIsPrime, TestPrimes", Order: 2},
+			{Name: "sort", Title: "Sorting", Order: 3},
+		},
+	}
+	if err := db.UpdateAssignments([]*pb.Assignment{assignment}); err != nil {
+		t.Fatal(err)
+	}
+	runData := &RunData{Course: course, Assignment: assignment, Repo: &pb.Repository{UserID: admin.ID}, JobOwner: "test"}
+	submission, err := RunTests(context.Background(), zap.NewNop().Sugar(), db, &Local{}, runData)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := db.GetTaskScores(submission.GetID())
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*pb.TaskScore{
+		{TaskName: "fib", TaskTitle: "Fibonacci", Score: 50, PassedTests: 1, TotalTests: 2},
+		{TaskName: "primes", TaskTitle: "Primes", Score: 100, PassedTests: 2, TotalTests: 2},
+		{TaskName: "sort", TaskTitle: "Sorting"},
+	}
+	if diff := cmp.Diff(want, got, protocmp.Transform(), protocmp.IgnoreFields(&pb.TaskScore{}, "ID", "submissionID")); diff != "" {
+		t.Errorf("GetTaskScores() mismatch (-want +got):\n%s", diff)
+	}
+}
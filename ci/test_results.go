@@ -0,0 +1,134 @@
+package ci
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/database"
+	"github.com/autograde/quickfeed/kit/score"
+	"go.uber.org/zap"
+)
+
+// maxTestOutput is the maximum size of the output recorded for a test.
+var maxTestOutput = 10_000 // bytes
+
+var (
+	// testEventLine matches the lines of go test's verbose output that start, pause and continue tests.
+	testEventLine = regexp.MustCompile(`^=== (RUN|PAUSE|CONT|NAME)\s+(\S+)`)
+	// testResultLine matches the lines of go test's output that report a test's result and duration.
+	testResultLine = regexp.MustCompile(`^\s*--- (PASS|FAIL|SKIP): (\S+) \(([0-9.]+)s\)`)
+	// packageResultLine matches the lines of go test's output that report a package's result.
+	packageResultLine = regexp.MustCompile(`^(PASS|FAIL)$|^(ok|FAIL|\?)\s`)
+)
+
+// goTest holds the status and duration of a test reported by go test.
+type goTest struct {
+	status   pb.TestResult_Status
+	duration int64 // milliseconds
+}
+
+// testOutputLine is a line of test output and the test that produced it.
+type testOutputLine struct {
+	test string
+	line string
+}
+
+// parseGoTestOutput returns the tests reported by the output of go test, and the output
+// lines attributed to each test. Score strings are not included in the output lines.
+func parseGoTestOutput(out string) (map[string]*goTest, []testOutputLine) {
+	tests := make(map[string]*goTest)
+	var lines []testOutputLine
+	current := ""
+	for _, line := range strings.Split(out, "\n") {
+		if score.HasPrefix(line) {
+			continue
+		}
+		if m := testEventLine.FindStringSubmatch(line); m != nil {
+			if _, ok := tests[m[2]]; !ok {
+				tests[m[2]] = &goTest{}
+			}
+			current = m[2]
+			if m[1] == "PAUSE" {
+				current = ""
+			}
+			continue
+		}
+		if m := testResultLine.FindStringSubmatch(line); m != nil {
+			seconds, _ := strconv.ParseFloat(m[3], 64)
+			tests[m[2]] = &goTest{status: goTestStatus(m[1]), duration: int64(seconds * 1000)}
+			// test output printed without -v follows the test's result line
+			current = m[2]
+			continue
+		}
+		if packageResultLine.MatchString(line) {
+			current = ""
+			continue
+		}
+		if current != "" {
+			lines = append(lines, testOutputLine{test: current, line: line})
+		}
+	}
+	return tests, lines
+}
+
+func goTestStatus(result string) pb.TestResult_Status {
+	switch result {
+	case "PASS":
+		return pb.TestResult_PASSED
+	case "FAIL":
+		return pb.TestResult_FAILED
+	}
+	return pb.TestResult_SKIPPED
+}
+
+// testResults returns the results of the scored tests. The status, duration and output
+// of each test are taken from the test output, if it is the output of go test.
+// Otherwise, a test has passed if it obtained the maximum points.
+func testResults(out string, scores []*score.Score) []*pb.TestResult {
+	tests, lines := parseGoTestOutput(out)
+	results := make([]*pb.TestResult, 0, len(scores))
+	for _, sc := range scores {
+		result := &pb.TestResult{
+			Name:      sc.GetTestName(),
+			Points:    sc.GetScore(),
+			MaxPoints: sc.GetMaxScore(),
+			Output:    testOutput(sc.GetTestName(), lines),
+		}
+		if test, ok := tests[sc.GetTestName()]; ok && test.status != pb.TestResult_UNKNOWN {
+			result.Status = test.status
+			result.Duration = test.duration
+		} else if sc.GetScore() >= 0 && sc.GetScore() >= sc.GetMaxScore() {
+			result.Status = pb.TestResult_PASSED
+		} else {
+			result.Status = pb.TestResult_FAILED
+		}
+		results = append(results, result)
+	}
+	return results
+}
+
+// testOutput returns the output of the named test and its subtests, truncated to maxTestOutput bytes.
+func testOutput(name string, lines []testOutputLine) string {
+	const truncated = "... (output truncated)"
+	var b strings.Builder
+	for _, l := range lines {
+		if l.test != name && !strings.HasPrefix(l.test, name+"/") {
+			continue
+		}
+		if b.Len()+len(l.line)+1 > maxTestOutput-len(truncated) {
+			b.WriteString(truncated)
+			break
+		}
+		b.WriteString(l.line + "\n")
+	}
+	return strings.TrimRight(b.String(), "\n")
+}
+
+// recordTestResults replaces the test results of the submission with the given results.
+func recordTestResults(logger *zap.SugaredLogger, db database.Database, submission *pb.Submission, results []*pb.TestResult) {
+	if err := db.UpdateTestResults(submission.GetID(), results); err != nil {
+		logger.Errorf("Failed to update test results of submission %d: %v", submission.GetID(), err)
+	}
+}
@@ -0,0 +1,143 @@
+package ci
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/internal/qtest"
+	"github.com/autograde/quickfeed/kit/score"
+	"github.com/google/go-cmp/cmp"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func TestTestResults(t *testing.T) {
+	scores := []*score.Score{
+		{TestName: "TestAdd", Score: 2, MaxScore: 2},
+		{TestName: "TestDiv", Score: 0, MaxScore: 3},
+		{TestName: "TestSkip", Score: 0, MaxScore: 1},
+		{TestName: "TestPython", Score: 1, MaxScore: 1},
+		{TestName: "TestFaulty", Score: -1, MaxScore: 1},
+	}
+	tests := []struct {
+		name string
+		out  string
+		want []*pb.TestResult
+	}{
+		{
+			name: "verbose",
+			out: `=== RUN   TestAdd
+    add_test.go:10: adding
+{"Secret":"secret","TestName":"TestAdd","Score":2,"MaxScore":2,"Weight":1}
+--- PASS: TestAdd (0.25s)
+=== RUN   TestDiv
+=== RUN   TestDiv/zero
+    div_test.go:20: division by zero
+=== RUN   TestDiv/one
+--- FAIL: TestDiv (1.50s)
+    --- FAIL: TestDiv/zero (0.00s)
+    --- PASS: TestDiv/one (0.00s)
+=== RUN   TestSkip
+    skip_test.go:5: not implemented
+--- SKIP: TestSkip (0.00s)
+FAIL
+exit status 1
+FAIL	example.com/lab1	1.760s`,
+			want: []*pb.TestResult{
+				{Name: "TestAdd", Points: 2, MaxPoints: 2, Duration: 250, Status: pb.TestResult_PASSED, Output: "    add_test.go:10: adding"},
+				{Name: "TestDiv", Points: 0, MaxPoints: 3, Duration: 1500, Status: pb.TestResult_FAILED, Output: "    div_test.go:20: division by zero"},
+				{Name: "TestSkip", Points: 0, MaxPoints: 1, Status: pb.TestResult_SKIPPED, Output: "    skip_test.go:5: not implemented"},
+				{Name: "TestPython", Points: 1, MaxPoints: 1, Status: pb.TestResult_PASSED},
+				{Name: "TestFaulty", Points: -1, MaxPoints: 1, Status: pb.TestResult_FAILED},
+			},
+		},
+		{
+			name: "not verbose",
+			out: `--- FAIL: TestDiv (0.01s)
+    div_test.go:20: division by zero
+FAIL
+FAIL	example.com/lab1	0.020s`,
+			want: []*pb.TestResult{
+				{Name: "TestAdd", Points: 2, MaxPoints: 2, Status: pb.TestResult_PASSED},
+				{Name: "TestDiv", Points: 0, MaxPoints: 3, Duration: 10, Status: pb.TestResult_FAILED, Output: "    div_test.go:20: division by zero"},
+				{Name: "TestSkip", Points: 0, MaxPoints: 1, Status: pb.TestResult_FAILED},
+				{Name: "TestPython", Points: 1, MaxPoints: 1, Status: pb.TestResult_PASSED},
+				{Name: "TestFaulty", Points: -1, MaxPoints: 1, Status: pb.TestResult_FAILED},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := testResults(tt.out, scores)
+			if diff := cmp.Diff(tt.want, got, protocmp.Transform()); diff != "" {
+				t.Errorf("testResults() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestTestOutputTruncated(t *testing.T) {
+	out := "=== RUN   TestLong\n" + strings.Repeat("0123456789\n", 2*maxTestOutput/11) + "--- FAIL: TestLong (0.00s)"
+	got := testResults(out, []*score.Score{{TestName: "TestLong", MaxScore: 1}})
+	output := got[0].GetOutput()
+	if len(output) > maxTestOutput || !strings.HasSuffix(output, "... (output truncated)") {
+		t.Errorf("output of %d bytes ending with %q, want at most %d bytes ending with truncation note", len(output), output[len(output)-30:], maxTestOutput)
+	}
+}
+
+func TestRunTestsRecordsTestResults(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	course := &pb.Course{Code: "DAT320", OrganizationID: 1}
+	admin := qtest.CreateFakeUser(t, db, 1)
+	qtest.CreateCourse(t, db, admin, course)
+	assignment := &pb.Assignment{
+		CourseID: course.ID,
+		Name:     "lab1",
+		ScriptFile: `#image/qf101
+echo '=== RUN   TestAdd'
+echo '{"Secret":"{{ .RandomSecret }}","TestName":"TestAdd","Score":2,"MaxScore":2,"Weight":1}'
+echo '--- PASS: TestAdd (0.10s)'
+echo '=== RUN   TestDiv'
+echo '    div_test.go:20: division by zero'
+echo '{"Secret":"{{ .RandomSecret }}","TestName":"TestDiv","Score":0,"MaxScore":3,"Weight":1}'
+echo '--- FAIL: TestDiv (0.00s)'`,
+		Deadline: "2022-11-11T13:00:00",
+		Order:    1,
+	}
+	if err := db.CreateAssignment(assignment); err != nil {
+		t.Fatal(err)
+	}
+	runData := &RunData{Course: course, Assignment: assignment, Repo: &pb.Repository{UserID: admin.ID}, JobOwner: "test"}
+	submission, err := RunTests(context.Background(), zap.NewNop().Sugar(), db, &Local{}, runData)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := db.GetTestResults(submission.GetID())
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*pb.TestResult{
+		{Name: "TestAdd", Points: 2, MaxPoints: 2, Duration: 100, Status: pb.TestResult_PASSED},
+		{Name: "TestDiv", Points: 0, MaxPoints: 3, Status: pb.TestResult_FAILED, Output: "    div_test.go:20: division by zero"},
+	}
+	if diff := cmp.Diff(want, got, protocmp.Transform(), protocmp.IgnoreFields(&pb.TestResult{}, "ID", "submissionID")); diff != "" {
+		t.Errorf("GetTestResults() mismatch (-want +got):\n%s", diff)
+	}
+
+	// the test results of a rebuild replace the previous results
+	assignment.ScriptFile = `#image/qf101
+echo '{"Secret":"{{ .RandomSecret }}","TestName":"TestAdd","Score":2,"MaxScore":2,"Weight":1}'`
+	if submission, err = RunTests(context.Background(), zap.NewNop().Sugar(), db, &Local{}, runData); err != nil {
+		t.Fatal(err)
+	}
+	if got, err = db.GetTestResults(submission.GetID()); err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 1 || got[0].GetName() != "TestAdd" {
+		t.Errorf("GetTestResults() after rebuild = %v, want only TestAdd", got)
+	}
+}
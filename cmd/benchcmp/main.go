@@ -0,0 +1,115 @@
+// Command benchcmp compares the results of two runs of the benchmarks,
+// as produced by go test -bench, and fails if a benchmark has regressed.
+//
+//	go test -run XXX -bench . -count 5 ./... > new.txt
+//	benchcmp -threshold 10 baseline.txt new.txt
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+)
+
+func main() {
+	threshold := flag.Float64("threshold", 10, "maximum allowed increase in ns/op, in percent")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s [flags] baseline.txt new.txt\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() != 2 {
+		flag.Usage()
+		os.Exit(2)
+	}
+	baseline, err := parseFile(flag.Arg(0))
+	if err != nil {
+		log.Fatal(err)
+	}
+	current, err := parseFile(flag.Arg(1))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	names := make([]string, 0, len(current))
+	for name := range current {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	tw := tabwriter.NewWriter(os.Stdout, 2, 8, 2, ' ', 0)
+	fmt.Fprint(tw, "Benchmark\tBaseline ns/op\tNew ns/op\tDelta\n")
+	regressions := 0
+	for _, name := range names {
+		old, ok := baseline[name]
+		if !ok {
+			fmt.Fprintf(tw, "%s\t-\t%.0f\tnew\n", name, current[name].mean())
+			continue
+		}
+		delta := 100 * (current[name].mean() - old.mean()) / old.mean()
+		mark := ""
+		if delta > *threshold {
+			mark = " REGRESSION"
+			regressions++
+		}
+		fmt.Fprintf(tw, "%s\t%.0f\t%.0f\t%+.1f%%%s\n", name, old.mean(), current[name].mean(), delta, mark)
+	}
+	tw.Flush()
+	if regressions > 0 {
+		fmt.Printf("%d benchmark(s) regressed more than %.0f%%\n", regressions, *threshold)
+		os.Exit(1)
+	}
+}
+
+// samples holds the ns/op measurements of a benchmark from repeated runs.
+type samples []float64
+
+func (s samples) mean() float64 {
+	var sum float64
+	for _, v := range s {
+		sum += v
+	}
+	return sum / float64(len(s))
+}
+
+// parseFile returns the ns/op measurements in the given go test -bench output,
+// keyed by the package and benchmark name.
+func parseFile(name string) (map[string]samples, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	results := make(map[string]samples)
+	pkg := ""
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 2 && fields[0] == "pkg:" {
+			pkg = fields[1]
+			continue
+		}
+		if len(fields) < 4 || !strings.HasPrefix(fields[0], "Benchmark") {
+			continue
+		}
+		for i := 2; i+1 < len(fields); i += 2 {
+			if fields[i+1] != "ns/op" {
+				continue
+			}
+			nsPerOp, err := strconv.ParseFloat(fields[i], 64)
+			if err != nil {
+				return nil, fmt.Errorf("%s: invalid ns/op in %q: %w", name, scanner.Text(), err)
+			}
+			key := pkg + "." + fields[0]
+			results[key] = append(results[key], nsPerOp)
+		}
+	}
+	return results, scanner.Err()
+}
@@ -0,0 +1,34 @@
+package database_test
+
+import (
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/internal/qtest"
+)
+
+// BenchmarkUpdateAssignments measures the database updates made when refreshing
+// a course with 500 students and 10 assignments from its tests repository.
+func BenchmarkUpdateAssignments(b *testing.B) {
+	db, cleanup := qtest.TestDB(b)
+	defer cleanup()
+
+	teacher := qtest.CreateFakeUser(b, db, 1)
+	course := &pb.Course{OrganizationID: 1}
+	qtest.CreateCourse(b, db, teacher, course)
+	qtest.PopulateCourse(b, db, course, 500, 10)
+	assignments, err := db.GetAssignmentsByCourse(course.ID, false)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, assignment := range assignments {
+			assignment.ScoreLimit = uint32(50 + i%50)
+		}
+		if err := db.UpdateAssignments(assignments); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -1,6 +1,9 @@
 package database
 
 import (
+	"context"
+	"time"
+
 	pb "github.com/autograde/quickfeed/ag"
 )
 
@@ -9,7 +12,9 @@ type Database interface {
 	// CreateUserFromRemoteIdentity creates new user record from remote identity, sets user with ID 1 as admin.
 	CreateUserFromRemoteIdentity(*pb.User, *pb.RemoteIdentity) error
 	// AssociateUserWithRemoteIdentity associates user with the given remote identity.
-	AssociateUserWithRemoteIdentity(userID uint64, provider string, remoteID uint64, accessToken string) error
+	AssociateUserWithRemoteIdentity(userID uint64, provider string, remoteID uint64, login, accessToken string) error
+	// UnlinkRemoteIdentity removes the user's remote identity for the given provider.
+	UnlinkRemoteIdentity(userID uint64, provider string) error
 	// UpdateAccessToken updates the access token for the given remote identity.
 	// The supplied remote identity must contain Provider, RemoteID and AccessToken.
 	UpdateAccessToken(*pb.RemoteIdentity) error
@@ -26,8 +31,27 @@ type Database interface {
 	GetUserWithEnrollments(uint64) (*pb.User, error)
 	// GetUsers returns the users for the given set of user IDs.
 	GetUsers(...uint64) ([]*pb.User, error)
+	// GetUsersPage returns the given page of users, including remote identities.
+	GetUsersPage(*Page) ([]*pb.User, error)
+	// GetTenantUsers returns the given page of the tenant's users, including remote identities.
+	// If page is nil, all the tenant's users are returned.
+	GetTenantUsers(tenantID uint64, page *Page) ([]*pb.User, error)
 	// UpdateUser updates the user's details, excluding remote identities.
 	UpdateUser(*pb.User) error
+	// GetUserData returns the data stored about the given user, without access tokens.
+	GetUserData(userID uint64) (*pb.UserData, error)
+	// AnonymizeUser replaces the given user's personal data with a pseudonym and deletes the user's
+	// remote identities, keeping the user's course results.
+	AnonymizeUser(userID uint64) error
+
+	// CreateTenant creates a new tenant.
+	CreateTenant(*pb.Tenant) error
+	// GetTenant returns the tenant with the given ID.
+	GetTenant(tenantID uint64) (*pb.Tenant, error)
+	// GetTenants returns all tenants, ordered by name.
+	GetTenants() ([]*pb.Tenant, error)
+	// UpdateTenant updates the tenant's name and SCM provider configuration.
+	UpdateTenant(*pb.Tenant) error
 
 	// CreateCourse creates a new course if user with given ID is admin, enrolls user as course teacher.
 	CreateCourse(uint64, *pb.Course) error
@@ -44,19 +68,55 @@ type Database interface {
 	// If enrollment statuses is provided, the set of courses returned
 	// is filtered according to these enrollment statuses.
 	GetCoursesByUser(userID uint64, statuses ...pb.Enrollment_UserStatus) ([]*pb.Course, error)
-	// UpdateCourse updates course information.
+	// UpdateCourse updates the course's settings, including settings reset to their zero values.
 	UpdateCourse(*pb.Course) error
+	// UpdateCourseSetup records the next course creation step to perform for the course.
+	UpdateCourseSetup(courseID uint64, step pb.Course_SetupStep) error
+	// UpdateCourseDockerfile updates the Dockerfile of the course's tests.
+	UpdateCourseDockerfile(courseID uint64, dockerfile string) error
+	// ArchiveCourse marks the course as archived, making it read-only.
+	ArchiveCourse(courseID uint64) error
+	// UpdateCourseLastWebhook records the time when the last push event for the course's repositories was received.
+	UpdateCourseLastWebhook(courseID uint64, received string) error
+	// DeleteCourse deletes the course and all its records, including enrollments,
+	// groups, assignments, submissions and repositories.
+	DeleteCourse(courseID uint64) error
+	// GetGradingScale returns the grading scale for the given course.
+	GetGradingScale(courseID uint64) ([]*pb.GradeThreshold, error)
+	// UpdateGradingScale replaces the grading scale for the given course.
+	UpdateGradingScale(courseID uint64, thresholds []*pb.GradeThreshold) error
+	// GetScoreCurve returns the score curve of the given assignment.
+	GetScoreCurve(assignmentID uint64) (*pb.ScoreCurve, error)
+	// ApplyScoreCurve replaces the score curve of the curve's assignment and records the adjusted
+	// scores of the assignment's submissions, keyed by submission ID.
+	ApplyScoreCurve(curve *pb.ScoreCurve, adjustedScores map[uint64]uint32) error
 
 	// CreateEnrollment creates a new pending enrollment.
 	CreateEnrollment(*pb.Enrollment) error
-	// RejectEnrollment removes the user enrollment from the database
+	// GetUserByLoginOrEmail returns the user with the given login, or if no such user exists,
+	// the user with the given email.
+	GetUserByLoginOrEmail(login, email string) (*pb.User, error)
+	// CreatePreEnrollment creates the given pre-enrollment, or updates the course's
+	// pre-enrollment with the same login, or if no login is given, the same email.
+	CreatePreEnrollment(*pb.PreEnrollment) error
+	// MatchPreEnrollments creates pending enrollments for the given user in the courses with
+	// unmatched pre-enrollments matching the user's login or email, and records the user in
+	// the matched pre-enrollments. It returns the number of enrollments created.
+	MatchPreEnrollments(*pb.User) (int, error)
+	// IsPreEnrolled returns true if the given user is in the roster of the given course.
+	IsPreEnrolled(courseID uint64, user *pb.User) (bool, error)
+	// RejectEnrollment soft-deletes the user enrollment.
 	RejectEnrollment(userID, courseID uint64) error
+	// RestoreEnrollment restores the soft-deleted enrollment of the given user in the given course as pending.
+	RestoreEnrollment(courseID, userID uint64) (*pb.Enrollment, error)
 	// UpdateEnrollmentStatus changes status of the course enrollment for the given user and course.
 	UpdateEnrollment(*pb.Enrollment) error
 	// GetEnrollmentByCourseAndUser returns a user enrollment for the given course ID.
 	GetEnrollmentByCourseAndUser(courseID uint64, userID uint64) (*pb.Enrollment, error)
 	// GetEnrollmentsByCourse fetches all course enrollments with given statuses.
 	GetEnrollmentsByCourse(courseID uint64, statuses ...pb.Enrollment_UserStatus) ([]*pb.Enrollment, error)
+	// GetEnrollmentsByCoursePage fetches the given page of course enrollments with given statuses.
+	GetEnrollmentsByCoursePage(courseID uint64, page *Page, statuses ...pb.Enrollment_UserStatus) ([]*pb.Enrollment, error)
 	// GetEnrollmentsByUser fetches all enrollments for the given user
 	GetEnrollmentsByUser(userID uint64, statuses ...pb.Enrollment_UserStatus) ([]*pb.Enrollment, error)
 
@@ -66,12 +126,23 @@ type Database interface {
 	UpdateGroup(group *pb.Group) error
 	// UpdateGroupStatus updates status field of a group.
 	UpdateGroupStatus(*pb.Group) error
-	// DeleteGroup deletes a group and its corresponding enrollments.
+	// DeleteGroup soft-deletes a group and removes its members from the group.
 	DeleteGroup(uint64) error
+	// RestoreGroup restores a soft-deleted group as pending, and returns the group to its members.
+	RestoreGroup(groupID uint64) (*pb.Group, error)
 	// GetGroup returns the group with the specified group ID.
 	GetGroup(uint64) (*pb.Group, error)
 	// GetGroupsByCourse returns the groups for the given course.
 	GetGroupsByCourse(courseID uint64, statuses ...pb.Group_GroupStatus) ([]*pb.Group, error)
+	// CreateGroupInvitation creates a new invitation to join a group.
+	CreateGroupInvitation(*pb.GroupInvitation) error
+	// GetGroupInvitation returns the group invitation with the given ID.
+	GetGroupInvitation(invitationID uint64) (*pb.GroupInvitation, error)
+	// GetGroupInvitations returns all group invitations matching the given query, oldest first.
+	// If statuses are provided, only invitations with one of the given statuses are returned.
+	GetGroupInvitations(query *pb.GroupInvitation, statuses ...pb.GroupInvitation_Status) ([]*pb.GroupInvitation, error)
+	// UpdateGroupInvitationStatus updates the status of the given group invitation.
+	UpdateGroupInvitationStatus(*pb.GroupInvitation) error
 
 	// CreateAssignment creates a new or updates an existing assignment.
 	CreateAssignment(*pb.Assignment) error
@@ -81,18 +152,24 @@ type Database interface {
 	GetAssignmentsByCourse(uint64, bool) ([]*pb.Assignment, error)
 	// UpdateAssignments updates the specified list of assignments.
 	UpdateAssignments([]*pb.Assignment) error
+	// UpdateAssignmentReleased records that the content of the given assignment has been published.
+	UpdateAssignmentReleased(assignmentID uint64) error
 	// CreateBenchmark creates a new grading benchmark.
 	CreateBenchmark(*pb.GradingBenchmark) error
 	// UpdateBenchmark updates the given benchmark.
 	UpdateBenchmark(*pb.GradingBenchmark) error
 	// DeleteBenchmark deletes the given benchmark.
 	DeleteBenchmark(*pb.GradingBenchmark) error
+	// GetBenchmark returns the benchmark with the given ID.
+	GetBenchmark(uint64) (*pb.GradingBenchmark, error)
 	// CreateCriterion creates a new grading criterion.
 	CreateCriterion(*pb.GradingCriterion) error
 	// UpdateCriterion updates the given criterion.
 	UpdateCriterion(*pb.GradingCriterion) error
 	// DeleteCriterion deletes the given criterion.
 	DeleteCriterion(*pb.GradingCriterion) error
+	// GetCriterion returns the criterion with the given ID.
+	GetCriterion(uint64) (*pb.GradingCriterion, error)
 
 	// CreateSubmission creates a new submission record or updates the most
 	// recent submission, as defined by the provided submissionQuery.
@@ -103,6 +180,16 @@ type Database interface {
 	GetSubmission(query *pb.Submission) (*pb.Submission, error)
 	// GetLastSubmissions returns a list of submission entries for the given course, matching the given query.
 	GetLastSubmissions(courseID uint64, query *pb.Submission) ([]*pb.Submission, error)
+	// GetLastSubmissionsPage returns the submission entries matching the given query
+	// for the given page of the course's assignments.
+	GetLastSubmissionsPage(courseID uint64, query *pb.Submission, page *Page) ([]*pb.Submission, error)
+	// GetLatestSubmissionsByCourse returns the latest submission of each student and group
+	// to each of the course's assignments.
+	GetLatestSubmissionsByCourse(courseID uint64) ([]*pb.Submission, error)
+	// GetCourseStatistics returns aggregates of the submissions to each of the course's assignments.
+	GetCourseStatistics(courseID uint64) (*pb.CourseStatistics, error)
+	// GetTestFailureStats returns the number of passed, failed and skipped runs of each test of the given assignment.
+	GetTestFailureStats(assignmentID uint64) (*pb.TestFailureStats, error)
 	// GetSubmissions returns all submissions matching the query.
 	GetSubmissions(*pb.Submission) ([]*pb.Submission, error)
 	// GetAssignmentsWithSubmissions returns a list of assignments with the latest submissions for the given course.
@@ -117,6 +204,8 @@ type Database interface {
 	UpdateReview(*pb.Review) error
 	// DeleteReview removes all review records matching the query.
 	DeleteReview(*pb.Review) error
+	// ReleaseReviews makes the ready reviews of the submissions to the given assignment visible to the submissions' authors.
+	ReleaseReviews(assignmentID uint64) error
 	// GetBenchmarks return all benchmarks and criteria for an assignmend
 	GetBenchmarks(*pb.Assignment) ([]*pb.GradingBenchmark, error)
 	// CreateRepository creates a new repository.
@@ -130,4 +219,206 @@ type Database interface {
 
 	// UpdateSlipDays updates used slipdays for the given course enrollment
 	UpdateSlipDays([]*pb.UsedSlipDays) error
+
+	// CreateAnnouncement creates a new course announcement.
+	CreateAnnouncement(*pb.Announcement) error
+	// UpdateAnnouncement updates the title and body of the given announcement.
+	UpdateAnnouncement(*pb.Announcement) error
+	// GetAnnouncement returns the announcement with the given ID.
+	GetAnnouncement(uint64) (*pb.Announcement, error)
+	// GetAnnouncements returns all announcements for the given course, newest first.
+	// The announcements' read status is set for the given user.
+	GetAnnouncements(courseID, userID uint64) ([]*pb.Announcement, error)
+	// MarkAnnouncementRead records that the given user has read the announcement.
+	MarkAnnouncementRead(announcementID, userID uint64) error
+
+	// CreateQuestion creates a new question about an assignment.
+	CreateQuestion(*pb.Question) error
+	// UpdateQuestion updates the title, body and accepted answer of the given question.
+	UpdateQuestion(*pb.Question) error
+	// DeleteQuestion deletes the question with the given ID and its answers.
+	DeleteQuestion(uint64) error
+	// GetQuestion returns the question with the given ID with preloaded answers.
+	GetQuestion(uint64) (*pb.Question, error)
+	// GetQuestions returns all questions for the given assignment with preloaded answers.
+	GetQuestions(assignmentID uint64) ([]*pb.Question, error)
+	// CreateAnswer creates a new answer to a question.
+	CreateAnswer(*pb.Answer) error
+	// UpdateAnswer updates the body and endorsement of the given answer.
+	UpdateAnswer(*pb.Answer) error
+	// DeleteAnswer deletes the answer with the given ID.
+	DeleteAnswer(uint64) error
+	// GetAnswer returns the answer with the given ID.
+	GetAnswer(uint64) (*pb.Answer, error)
+
+	// CreateCourseEvent records a new course event.
+	CreateCourseEvent(*pb.CourseEvent) error
+	// GetCourseEvents returns all events for the given course, newest first.
+	GetCourseEvents(courseID uint64) ([]*pb.CourseEvent, error)
+
+	// CreateNotifications creates the given notifications.
+	CreateNotifications([]*pb.Notification) error
+	// GetNotifications returns at most limit notifications of the given user, newest first.
+	// If unreadOnly is true, only notifications that have not been read are returned.
+	GetNotifications(userID uint64, unreadOnly bool, limit int) ([]*pb.Notification, error)
+	// HasNotification returns true if a notification with the given subject
+	// has been created for the given course.
+	HasNotification(courseID uint64, subject string) (bool, error)
+	// MarkNotificationsRead marks the notification with the given ID belonging to the given user as read.
+	// If notificationID is zero, all the user's notifications are marked as read.
+	MarkNotificationsRead(userID, notificationID uint64) error
+
+	// CreateForcePush records a force push affecting a submission.
+	CreateForcePush(*pb.ForcePush) error
+	// GetForcePushes returns all force pushes recorded for the given course, newest first.
+	GetForcePushes(courseID uint64) ([]*pb.ForcePush, error)
+
+	// GetArtifacts returns the artifacts of the given submission, ordered by name.
+	GetArtifacts(submissionID uint64) ([]*pb.Artifact, error)
+	// UpdateArtifacts replaces the artifacts of the given submission with the given artifacts.
+	UpdateArtifacts(submissionID uint64, artifacts []*pb.Artifact) error
+
+	// GetTestResults returns the test results of the given submission, in the order they were recorded.
+	GetTestResults(submissionID uint64) ([]*pb.TestResult, error)
+	// UpdateTestResults replaces the test results of the given submission with the given results.
+	UpdateTestResults(submissionID uint64, results []*pb.TestResult) error
+
+	// GetTasks returns the tasks of the given assignment, in their order in the assignment.
+	GetTasks(assignmentID uint64) ([]*pb.Task, error)
+	// GetTaskScores returns the task scores of the given submission, in the order they were recorded.
+	GetTaskScores(submissionID uint64) ([]*pb.TaskScore, error)
+	// UpdateTaskScores replaces the task scores of the given submission with the given scores.
+	UpdateTaskScores(submissionID uint64, scores []*pb.TaskScore) error
+
+	// CreateAppeal creates a new grade appeal.
+	CreateAppeal(*pb.Appeal) error
+	// GetAppeal returns the appeal with the given ID.
+	GetAppeal(appealID uint64) (*pb.Appeal, error)
+	// GetAppeals returns all appeals matching the given query, oldest first.
+	GetAppeals(*pb.Appeal) ([]*pb.Appeal, error)
+	// UpdateAppeal records the decision of the given appeal.
+	UpdateAppeal(*pb.Appeal) error
+
+	// CreateSubmissionComment creates a new comment on a submission.
+	CreateSubmissionComment(*pb.SubmissionComment) error
+	// GetSubmissionComment returns the submission comment with the given ID.
+	GetSubmissionComment(commentID uint64) (*pb.SubmissionComment, error)
+	// GetSubmissionComments returns all comments on the given submission, oldest first.
+	GetSubmissionComments(submissionID uint64) ([]*pb.SubmissionComment, error)
+	// UpdateSubmissionComment updates the body of the given submission comment.
+	UpdateSubmissionComment(*pb.SubmissionComment) error
+	// DeleteSubmissionComment deletes the submission comment with the given ID.
+	DeleteSubmissionComment(commentID uint64) error
+	// CreateSubmissionTransition records a transition of a submission to a new status.
+	CreateSubmissionTransition(*pb.SubmissionTransition) error
+	// GetSubmissionTransitions returns the transitions of the given submission, oldest first.
+	GetSubmissionTransitions(submissionID uint64) ([]*pb.SubmissionTransition, error)
+
+	// UpdateExtension grants the given extension, replacing any previous extension
+	// of the assignment for the same student or group.
+	UpdateExtension(*pb.Extension) error
+	// GetExtension returns the extension of the assignment for the given student or group.
+	GetExtension(assignmentID, userID, groupID uint64) (*pb.Extension, error)
+	// GetExtensions returns all extensions matching the given query.
+	GetExtensions(*pb.Extension) ([]*pb.Extension, error)
+
+	// UpdatePlagiarismResults replaces the plagiarism results of the given assignment.
+	UpdatePlagiarismResults(assignmentID uint64, results []*pb.PlagiarismResult) error
+	// GetPlagiarismResults returns the plagiarism results matching the given query, most similar first.
+	GetPlagiarismResults(*pb.PlagiarismResult) ([]*pb.PlagiarismResult, error)
+
+	// CreatePeerReviews records the given peer reviews.
+	CreatePeerReviews([]*pb.PeerReview) error
+	// GetPeerReview returns the peer review matching the given query.
+	GetPeerReview(*pb.PeerReview) (*pb.PeerReview, error)
+	// GetPeerReviews returns all peer reviews matching the given query.
+	GetPeerReviews(*pb.PeerReview) ([]*pb.PeerReview, error)
+	// UpdatePeerReview updates the given peer review.
+	UpdatePeerReview(*pb.PeerReview) error
+	// ReleasePeerReviews makes the submitted peer reviews of the given assignment visible to the submissions' authors.
+	ReleasePeerReviews(assignmentID uint64) error
+
+	// CreateTestJob records the given test job.
+	CreateTestJob(*pb.TestJob) error
+	// GetTestJobs returns all queued and running test jobs, in the order they were created.
+	GetTestJobs() ([]*pb.TestJob, error)
+	// UpdateTestJob updates the given test job.
+	UpdateTestJob(*pb.TestJob) error
+	// DeleteTestJob deletes the test job with the given ID.
+	DeleteTestJob(uint64) error
+
+	// CreateWebhookDelivery records the given webhook delivery.
+	// Returns an error if a delivery with the same delivery ID has already been recorded.
+	CreateWebhookDelivery(*pb.WebhookDelivery) error
+	// GetWebhookDelivery returns the webhook delivery with the given delivery ID, including its payload.
+	GetWebhookDelivery(deliveryID string) (*pb.WebhookDelivery, error)
+	// GetWebhookDeliveries returns the webhook deliveries matching the given query, newest first.
+	// The payloads are omitted.
+	GetWebhookDeliveries(query *pb.WebhookDelivery) ([]*pb.WebhookDelivery, error)
+	// UpdateWebhookDelivery updates the given webhook delivery.
+	UpdateWebhookDelivery(*pb.WebhookDelivery) error
+	// PurgeWebhookPayloads deletes the payloads of the webhook deliveries received before the given time.
+	PurgeWebhookPayloads(before time.Time) error
+
+	// CreateSubmittedCommit records that the given commit was submitted for the assignment by the
+	// repository's owner. Returns ErrDuplicateSubmittedCommit if the commit was already submitted.
+	CreateSubmittedCommit(*pb.SubmittedCommit) error
+	// DeleteSubmittedCommit deletes the submitted commit with the given ID.
+	DeleteSubmittedCommit(uint64) error
+
+	// CreateAuditEntry records the given audit log entry.
+	CreateAuditEntry(*pb.AuditEntry) error
+	// GetAuditEntries returns the audit log entries matching the given query, oldest first.
+	// If page is non-nil, only the given page of entries is returned.
+	GetAuditEntries(query *pb.AuditEntry, page *Page) ([]*pb.AuditEntry, error)
+
+	// CreateAccessToken creates the given access token, along with the courses it is valid for.
+	CreateAccessToken(*pb.AccessToken) error
+	// GetAccessToken returns the access token with the given hash, along with the courses it is valid for.
+	GetAccessToken(hash string) (*pb.AccessToken, error)
+	// GetAccessTokens returns the access tokens of the given user, oldest first.
+	GetAccessTokens(userID uint64) ([]*pb.AccessToken, error)
+	// UpdateAccessTokenLastUsed records the time the access token with the given ID was last used.
+	UpdateAccessTokenLastUsed(tokenID uint64, lastUsed string) error
+	// DeleteAccessToken deletes the access token with the given ID belonging to the given user.
+	DeleteAccessToken(userID, tokenID uint64) error
+
+	// CreateSession records a new session of a user.
+	CreateSession(*pb.Session) error
+	// GetSession returns the session with the given hash.
+	GetSession(hash string) (*pb.Session, error)
+	// GetSessions returns the sessions of the given user, oldest first.
+	GetSessions(userID uint64) ([]*pb.Session, error)
+	// UpdateSessionLastSeen records the time of the last request in the session with the given ID.
+	UpdateSessionLastSeen(sessionID uint64, lastSeen string) error
+	// DeleteSession deletes the session with the given ID belonging to the given user.
+	DeleteSession(userID, sessionID uint64) error
+	// DeleteSessions deletes all sessions of the given user.
+	DeleteSessions(userID uint64) error
+
+	// CreatePendingAction records a destructive action awaiting confirmation.
+	CreatePendingAction(*pb.PendingAction) error
+	// GetPendingAction returns the pending action with the given ID.
+	GetPendingAction(actionID uint64) (*pb.PendingAction, error)
+	// GetPendingActions returns all pending actions, oldest first.
+	GetPendingActions() ([]*pb.PendingAction, error)
+	// DeletePendingAction deletes the pending action with the given ID.
+	DeletePendingAction(actionID uint64) error
+
+	// CreateCertificate records a new certificate of completion.
+	CreateCertificate(*pb.Certificate) error
+	// GetCertificate returns the certificate matching the given query.
+	GetCertificate(*pb.Certificate) (*pb.Certificate, error)
+
+	// PurgeDeleted permanently deletes the users, groups and enrollments soft-deleted before the given time.
+	PurgeDeleted(before time.Time) error
+
+	// OnChange registers a function that is called with the name of the table after each write
+	// to the database, or with an empty name if the table is unknown, e.g., for raw SQL statements.
+	OnChange(func(table string))
+
+	// Ping returns an error if the database cannot be reached.
+	Ping(context.Context) error
+	// Close closes the database connections.
+	Close() error
 }
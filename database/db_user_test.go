@@ -1,13 +1,16 @@
 package database_test
 
 import (
+	"errors"
 	"reflect"
 	"testing"
 
 	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/database"
 	"github.com/autograde/quickfeed/internal/qtest"
 	"github.com/google/go-cmp/cmp"
 	"google.golang.org/protobuf/testing/protocmp"
+	"gorm.io/gorm"
 )
 
 func TestGormDBUpdateAccessToken(t *testing.T) {
@@ -225,3 +228,57 @@ func TestGormDBUpdateAccessTokenCourseTokenCache(t *testing.T) {
 		t.Errorf("cached token different from expected updated token: %s != %s", cachedToken, anotherToken)
 	}
 }
+
+func TestGormDBUnlinkRemoteIdentity(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+	user := qtest.CreateFakeUser(t, db, 10)
+
+	if err := db.AssociateUserWithRemoteIdentity(user.ID, "gitlab", 20, "alice", "secret"); err != nil {
+		t.Fatal(err)
+	}
+	gotUser, err := db.GetUser(user.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if login := gotUser.GetLoginFor("gitlab"); login != "alice" {
+		t.Errorf("GetLoginFor(gitlab) = %q, want %q", login, "alice")
+	}
+
+	// the login is updated along with the access token
+	if err := db.UpdateAccessToken(&pb.RemoteIdentity{Provider: "gitlab", RemoteID: 20, AccessToken: "secret2", Login: "alice2"}); err != nil {
+		t.Fatal(err)
+	}
+	gotUser, err = db.GetUser(user.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if login := gotUser.GetLoginFor("gitlab"); login != "alice2" {
+		t.Errorf("GetLoginFor(gitlab) = %q, want %q", login, "alice2")
+	}
+
+	if err := db.UnlinkRemoteIdentity(user.ID, "github"); !errors.Is(err, gorm.ErrRecordNotFound) {
+		t.Errorf("UnlinkRemoteIdentity(github) = %v, want %v", err, gorm.ErrRecordNotFound)
+	}
+	if err := db.UnlinkRemoteIdentity(user.ID, "gitlab"); err != nil {
+		t.Fatal(err)
+	}
+	gotUser, err = db.GetUser(user.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if gotUser.GetRemoteIDFor("gitlab") != nil || gotUser.GetRemoteIDFor("fake") == nil {
+		t.Errorf("remote identities after unlinking gitlab: %v, want only fake", gotUser.GetRemoteIdentities())
+	}
+	// the last remote identity cannot be removed
+	if err := db.UnlinkRemoteIdentity(user.ID, "fake"); !errors.Is(err, database.ErrLastIdentity) {
+		t.Errorf("UnlinkRemoteIdentity(fake) = %v, want %v", err, database.ErrLastIdentity)
+	}
+	gotUser, err = db.GetUser(user.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if gotUser.GetRemoteIDFor("fake") == nil {
+		t.Error("last remote identity was removed")
+	}
+}
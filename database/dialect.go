@@ -0,0 +1,88 @@
+package database
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	gomysql "github.com/go-sql-driver/mysql"
+	"github.com/jackc/pgconn"
+	"gorm.io/driver/mysql"
+	"gorm.io/driver/postgres"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+// Supported database drivers.
+const (
+	SQLite   = "sqlite"
+	Postgres = "postgres"
+	MySQL    = "mysql"
+)
+
+// Config holds the settings for connecting to the database.
+type Config struct {
+	// Driver is the database driver: sqlite, postgres or mysql.
+	Driver string
+	// DSN is the path of the SQLite database file, or the data source name
+	// of the PostgreSQL or MySQL database, e.g., "host=localhost user=quickfeed dbname=quickfeed"
+	// or "quickfeed:secret@tcp(localhost:3306)/quickfeed".
+	DSN string
+	// MaxOpenConns is the maximum number of open connections; 0 means unlimited.
+	MaxOpenConns int
+	// MaxIdleConns is the maximum number of idle connections kept in the pool;
+	// 0 means the database/sql default.
+	MaxIdleConns int
+	// ConnMaxLifetime is the maximum time a connection may be reused; 0 means forever.
+	ConnMaxLifetime time.Duration
+}
+
+// dialector returns the gorm dialector for the configured driver.
+func (c Config) dialector() (gorm.Dialector, error) {
+	if c.DSN == "" {
+		return nil, fmt.Errorf("missing data source name for %s database", c.Driver)
+	}
+	switch c.Driver {
+	case SQLite, "":
+		return sqlite.Open(c.DSN), nil
+	case Postgres:
+		return postgres.Open(c.DSN), nil
+	case MySQL:
+		cfg, err := gomysql.ParseDSN(c.DSN)
+		if err != nil {
+			return nil, fmt.Errorf("invalid mysql data source name: %w", err)
+		}
+		// time columns must be scanned into time.Time
+		cfg.ParseTime = true
+		return mysql.Open(cfg.FormatDSN()), nil
+	}
+	return nil, fmt.Errorf("unsupported database driver %q; must be one of %s, %s or %s", c.Driver, SQLite, Postgres, MySQL)
+}
+
+// configurePool applies the connection pool settings to the database connection.
+func (c Config) configurePool(conn *gorm.DB) error {
+	sqlDB, err := conn.DB()
+	if err != nil {
+		return err
+	}
+	sqlDB.SetMaxOpenConns(c.MaxOpenConns)
+	if c.MaxIdleConns > 0 {
+		sqlDB.SetMaxIdleConns(c.MaxIdleConns)
+	}
+	sqlDB.SetConnMaxLifetime(c.ConnMaxLifetime)
+	return nil
+}
+
+// isUniqueViolation returns true if the error is caused by violating a unique constraint.
+func isUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		return pgErr.Code == "23505" // unique_violation
+	}
+	var mysqlErr *gomysql.MySQLError
+	if errors.As(err, &mysqlErr) {
+		return mysqlErr.Number == 1062 // ER_DUP_ENTRY
+	}
+	return strings.HasPrefix(err.Error(), "UNIQUE constraint failed")
+}
@@ -0,0 +1,138 @@
+package database
+
+import (
+	"errors"
+	"os"
+	"testing"
+
+	gomysql "github.com/go-sql-driver/mysql"
+	"github.com/jackc/pgconn"
+	"go.uber.org/zap"
+
+	pb "github.com/autograde/quickfeed/ag"
+)
+
+func TestConfigDialector(t *testing.T) {
+	tests := []struct {
+		cfg     Config
+		want    string
+		wantErr bool
+	}{
+		{cfg: Config{DSN: "qf.db"}, want: SQLite},
+		{cfg: Config{Driver: SQLite, DSN: "qf.db"}, want: SQLite},
+		{cfg: Config{Driver: Postgres, DSN: "host=localhost user=quickfeed dbname=quickfeed"}, want: Postgres},
+		{cfg: Config{Driver: MySQL, DSN: "quickfeed:secret@tcp(localhost:3306)/quickfeed"}, want: MySQL},
+		{cfg: Config{Driver: MySQL, DSN: "quickfeed:secret@localhost/quickfeed"}, wantErr: true},
+		{cfg: Config{Driver: Postgres}, wantErr: true},
+		{cfg: Config{Driver: "oracle", DSN: "quickfeed"}, wantErr: true},
+	}
+	for _, test := range tests {
+		dialector, err := test.cfg.dialector()
+		if (err != nil) != test.wantErr {
+			t.Errorf("dialector(%+v) error = %v, want error: %t", test.cfg, err, test.wantErr)
+			continue
+		}
+		if err == nil && dialector.Name() != test.want {
+			t.Errorf("dialector(%+v) = %s, want %s", test.cfg, dialector.Name(), test.want)
+		}
+	}
+}
+
+func TestIsUniqueViolation(t *testing.T) {
+	tests := []struct {
+		err  error
+		want bool
+	}{
+		{err: errors.New("UNIQUE constraint failed: groups.name, groups.course_id"), want: true},
+		{err: &pgconn.PgError{Code: "23505"}, want: true},
+		{err: &gomysql.MySQLError{Number: 1062}, want: true},
+		{err: &pgconn.PgError{Code: "23503"}, want: false},
+		{err: &gomysql.MySQLError{Number: 1452}, want: false},
+		{err: errors.New("record not found"), want: false},
+	}
+	for _, test := range tests {
+		if got := isUniqueViolation(test.err); got != test.want {
+			t.Errorf("isUniqueViolation(%v) = %t, want %t", test.err, got, test.want)
+		}
+	}
+}
+
+// TestGormDBDialects runs a few operations that depend on the SQL dialect against
+// SQLite, and the PostgreSQL and MySQL databases given by the QUICKFEED_TEST_POSTGRES_DSN
+// and QUICKFEED_TEST_MYSQL_DSN environment variables. The databases are emptied.
+func TestGormDBDialects(t *testing.T) {
+	for _, driver := range []string{SQLite, Postgres, MySQL} {
+		t.Run(driver, func(t *testing.T) {
+			if driver == SQLite {
+				testDialect(t, testGormDB(t))
+				return
+			}
+			env := "QUICKFEED_TEST_" + map[string]string{Postgres: "POSTGRES", MySQL: "MYSQL"}[driver] + "_DSN"
+			dsn := os.Getenv(env)
+			if dsn == "" {
+				t.Skipf("Skipping %s integration test; set %s to run it", driver, env)
+			}
+			cfg := Config{Driver: driver, DSN: dsn, MaxOpenConns: 5, MaxIdleConns: 2}
+			db, err := OpenGormDB(cfg, zap.NewNop())
+			if err != nil {
+				t.Fatal(err)
+			}
+			// start from an empty schema, since the database is reused between runs
+			dropTables(t, db)
+			if db, err = OpenGormDB(cfg, zap.NewNop()); err != nil {
+				t.Fatal(err)
+			}
+			defer dropTables(t, db)
+			testDialect(t, db)
+		})
+	}
+}
+
+func dropTables(t *testing.T, db *GormDB) {
+	t.Helper()
+	tables := append([]interface{}{"group_users", &schemaMigration{}, &schemaMigrationLock{}}, models...)
+	if err := db.conn.Migrator().DropTable(tables...); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func testDialect(t *testing.T, db *GormDB) {
+	admin := &pb.User{Name: "Admin", Login: "admin"}
+	if err := db.CreateUserFromRemoteIdentity(admin, &pb.RemoteIdentity{Provider: "fake", RemoteID: 1, AccessToken: "token"}); err != nil {
+		t.Fatal(err)
+	}
+	course := &pb.Course{Name: "Distributed Systems", Code: "DAT520", Provider: "fake", OrganizationID: 1}
+	if err := db.CreateCourse(admin.ID, course); err != nil {
+		t.Fatal(err)
+	}
+
+	// assignments are sorted by their order
+	for _, order := range []uint32{2, 1} {
+		if err := db.CreateAssignment(&pb.Assignment{CourseID: course.ID, Name: "lab", Order: order, Deadline: "2022-01-23 18:00"}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	assignments, err := db.GetAssignmentsWithSubmissions(course.ID, pb.SubmissionsForCourseRequest_ALL, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(assignments) != 2 || assignments[0].GetOrder() != 1 || assignments[1].GetOrder() != 2 {
+		t.Errorf("GetAssignmentsWithSubmissions() = %v, want assignments sorted by order", assignments)
+	}
+
+	// unique constraint violations are reported as such
+	if err := db.CreateGroup(&pb.Group{Name: "group", CourseID: course.ID, Users: []*pb.User{admin}}); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.CreateGroup(&pb.Group{Name: "group", CourseID: course.ID, Users: []*pb.User{admin}}); err != ErrDuplicateGroup {
+		t.Errorf("CreateGroup() = %v, want %v", err, ErrDuplicateGroup)
+	}
+
+	// migrations are recorded and not applied twice
+	if err := db.Migrate(); err != nil {
+		t.Fatal(err)
+	}
+	if v, err := db.SchemaVersion(); err != nil || v != migrations[len(migrations)-1].version {
+		t.Errorf("SchemaVersion() = %d, %v, want %d", v, err, migrations[len(migrations)-1].version)
+	}
+}
@@ -1,6 +1,7 @@
 package database
 
 import (
+	"context"
 	"errors"
 	"fmt"
 
@@ -9,7 +10,6 @@ import (
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
-	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
 )
 
@@ -17,6 +17,8 @@ var (
 	// ErrDuplicateIdentity is returned when trying to associate a remote identity
 	// with a user account and the identity is already in use.
 	ErrDuplicateIdentity = errors.New("remote identity registered with another user")
+	// ErrLastIdentity is returned when trying to remove the last remote identity of a user.
+	ErrLastIdentity = errors.New("cannot remove the user's last remote identity")
 	// ErrEmptyGroup is returned when trying to create a group without users.
 	ErrEmptyGroup = errors.New("cannot create group without users")
 	// ErrDuplicateGroup is returned when trying to create a group with the same
@@ -35,42 +37,146 @@ var (
 	// ErrNotEnrolled is returned when the requested user or group do not have
 	// the expected association with the given course
 	ErrNotEnrolled = errors.New("user or group not enrolled in the course")
+	// ErrInvalidCourseEvent is returned when trying to create a course event without a course.
+	ErrInvalidCourseEvent = errors.New("cannot create course event without an associated course")
+	// ErrInvalidForcePush is returned when trying to record a force push without a course or submission.
+	ErrInvalidForcePush = errors.New("cannot record force push without an associated course and submission")
+	// ErrInvalidAppeal is returned when trying to create an appeal without a course or user.
+	ErrInvalidAppeal = errors.New("cannot create appeal without an associated course and user")
+	// ErrInvalidGroupInvitation is returned when trying to create a group invitation without a course, group or invited user.
+	ErrInvalidGroupInvitation = errors.New("cannot create group invitation without an associated course, group and user")
+	// ErrInvalidSubmissionComment is returned when trying to create a submission comment without a course, submission or author.
+	ErrInvalidSubmissionComment = errors.New("cannot create submission comment without an associated course, submission and author")
+	// ErrInvalidSubmissionTransition is returned when trying to record a submission transition without a submission or grader.
+	ErrInvalidSubmissionTransition = errors.New("cannot create submission transition without an associated submission and grader")
+	// ErrInvalidSession is returned when trying to create a session without a user or cookie hash.
+	ErrInvalidSession = errors.New("cannot create session without an associated user and cookie hash")
+	// ErrInvalidPendingAction is returned when trying to create a pending action without a course or requester.
+	ErrInvalidPendingAction = errors.New("cannot create pending action without an associated course and requester")
+	// ErrInvalidScoreCurve is returned when trying to apply a score curve without a course or assignment.
+	ErrInvalidScoreCurve = errors.New("cannot apply score curve without an associated course and assignment")
+	// ErrInvalidCertificate is returned when trying to create a certificate without a course, user or verification code.
+	ErrInvalidCertificate = errors.New("cannot create certificate without an associated course, user and verification code")
+	// ErrInvalidExtension is returned when trying to grant an extension without an assignment, or to neither or both a user and a group.
+	ErrInvalidExtension = errors.New("cannot grant extension without an associated assignment and either a user or a group")
+	// ErrInvalidWebhookDelivery is returned when trying to record a webhook delivery without a delivery ID.
+	ErrInvalidWebhookDelivery = errors.New("cannot record webhook delivery without a delivery ID")
+	// ErrInvalidSubmittedCommit is returned when trying to record a submitted commit without an assignment, owner or commit ID.
+	ErrInvalidSubmittedCommit = errors.New("cannot record submitted commit without an associated assignment, owner and commit ID")
+	// ErrDuplicateSubmittedCommit is returned when trying to record a commit that has
+	// already been submitted for the assignment by the same owner.
+	ErrDuplicateSubmittedCommit = errors.New("commit already submitted for the assignment")
 )
 
 // GormDB implements the Database interface.
 type GormDB struct {
-	conn *gorm.DB
+	conn    *gorm.DB
+	changes *changeListeners
 }
 
-// NewGormDB creates a new gorm database using the provided driver.
+// models lists the records stored in the database.
+var models = []interface{}{
+	&pb.User{},
+	&pb.RemoteIdentity{},
+	&pb.Course{},
+	&pb.GradeThreshold{},
+	&pb.Enrollment{},
+	&pb.Assignment{},
+	&pb.Submission{},
+	&pb.Group{},
+	&pb.Repository{},
+	&pb.UsedSlipDays{},
+	&pb.GradingBenchmark{},
+	&pb.GradingCriterion{},
+	&pb.Review{},
+	&pb.Announcement{},
+	&pb.AnnouncementRead{},
+	&pb.CourseEvent{},
+	&pb.Question{},
+	&pb.Answer{},
+	&pb.ForcePush{},
+	&pb.Appeal{},
+	&pb.Certificate{},
+	&pb.Extension{},
+	&pb.PlagiarismResult{},
+	&pb.PeerReview{},
+	&pb.TestJob{},
+	&pb.WebhookDelivery{},
+	&pb.SubmittedCommit{},
+	&pb.AuditEntry{},
+	&pb.AccessToken{},
+	&pb.AccessTokenCourse{},
+	&pb.Session{},
+	&pb.PendingAction{},
+	&pb.Notification{},
+	&pb.PreEnrollment{},
+	&pb.PolicyViolation{},
+	&pb.Artifact{},
+	&pb.TestResult{},
+	&pb.Task{},
+	&pb.TaskScore{},
+	&pb.SubmissionComment{},
+	&pb.SubmissionTransition{},
+	&pb.ScoreCurve{},
+	&pb.GroupInvitation{},
+	&pb.FileCoverage{},
+	&pb.Tenant{},
+	&score.BuildInfo{},
+	&score.Score{},
+}
+
+// NewGormDB creates a new gorm database using the SQLite database file at the given path.
 func NewGormDB(path string, logger *zap.Logger) (*GormDB, error) {
-	conn, err := gorm.Open(sqlite.Open(path), &gorm.Config{
+	return OpenGormDB(Config{Driver: SQLite, DSN: path}, logger)
+}
+
+// OpenGormDB creates a new gorm database using the configured driver.
+// The schema is created or updated, and pending migrations are applied.
+func OpenGormDB(cfg Config, logger *zap.Logger) (*GormDB, error) {
+	dialector, err := cfg.dialector()
+	if err != nil {
+		return nil, err
+	}
+	conn, err := gorm.Open(dialector, &gorm.Config{
 		Logger: NewGORMLogger(logger),
 	})
 	if err != nil {
 		return nil, err
 	}
+	if err := cfg.configurePool(conn); err != nil {
+		return nil, err
+	}
 
-	if err := conn.AutoMigrate(
-		&pb.User{},
-		&pb.RemoteIdentity{},
-		&pb.Course{},
-		&pb.Enrollment{},
-		&pb.Assignment{},
-		&pb.Submission{},
-		&pb.Group{},
-		&pb.Repository{},
-		&pb.UsedSlipDays{},
-		&pb.GradingBenchmark{},
-		&pb.GradingCriterion{},
-		&pb.Review{},
-		&score.BuildInfo{},
-		&score.Score{},
-	); err != nil {
+	if err := conn.AutoMigrate(models...); err != nil {
 		return nil, err
 	}
 
-	return &GormDB{conn}, nil
+	db := &GormDB{conn: conn, changes: &changeListeners{}}
+	if err := db.changes.register(conn); err != nil {
+		return nil, err
+	}
+	if err := db.Migrate(); err != nil {
+		return nil, err
+	}
+	return db, nil
+}
+
+// Ping returns an error if the database cannot be reached.
+func (db *GormDB) Ping(ctx context.Context) error {
+	sqlDB, err := db.conn.DB()
+	if err != nil {
+		return err
+	}
+	return sqlDB.PingContext(ctx)
+}
+
+// Close closes the database connections.
+func (db *GormDB) Close() error {
+	sqlDB, err := db.conn.DB()
+	if err != nil {
+		return err
+	}
+	return sqlDB.Close()
 }
 
 ///  Remote Identities ///
@@ -92,7 +198,8 @@ func (db *GormDB) CreateUserFromRemoteIdentity(user *pb.User, remoteIdentity *pb
 }
 
 // AssociateUserWithRemoteIdentity associates remote identity with the user with given ID.
-func (db *GormDB) AssociateUserWithRemoteIdentity(uid uint64, provider string, remoteID uint64, accessToken string) error {
+// The login is the user's login on the provider.
+func (db *GormDB) AssociateUserWithRemoteIdentity(uid uint64, provider string, remoteID uint64, login, accessToken string) error {
 	var count int64
 	if err := db.conn.
 		Model(&pb.RemoteIdentity{}).
@@ -113,10 +220,32 @@ func (db *GormDB) AssociateUserWithRemoteIdentity(uid uint64, provider string, r
 	var remoteIdentity pb.RemoteIdentity
 	return db.conn.
 		Where(pb.RemoteIdentity{Provider: provider, RemoteID: remoteID, UserID: uid}).
-		Assign(pb.RemoteIdentity{AccessToken: accessToken}).
+		Assign(pb.RemoteIdentity{AccessToken: accessToken, Login: login}).
 		FirstOrCreate(&remoteIdentity).Error
 }
 
+// UnlinkRemoteIdentity removes the user's remote identity for the given provider.
+// The user's last remote identity cannot be removed, since the user could no longer log in.
+func (db *GormDB) UnlinkRemoteIdentity(userID uint64, provider string) error {
+	return db.conn.Transaction(func(tx *gorm.DB) error {
+		var count int64
+		if err := tx.Model(&pb.RemoteIdentity{}).Where(&pb.RemoteIdentity{UserID: userID}).Count(&count).Error; err != nil {
+			return err
+		}
+		result := tx.Where("user_id = ? AND provider = ?", userID, provider).Delete(&pb.RemoteIdentity{})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		if count <= result.RowsAffected {
+			return ErrLastIdentity
+		}
+		return nil
+	})
+}
+
 // UpdateAccessToken refreshes the token info for the given remote identity.
 func (db *GormDB) UpdateAccessToken(remote *pb.RemoteIdentity) error {
 	tx := db.conn.Begin()
@@ -133,7 +262,12 @@ func (db *GormDB) UpdateAccessToken(remote *pb.RemoteIdentity) error {
 		return err
 	}
 
-	if err := tx.Model(&remoteIdentity).Update("access_token", remote.AccessToken).Error; err != nil {
+	updates := map[string]interface{}{"access_token": remote.AccessToken}
+	if remote.Login != "" {
+		// the login may have been changed on the provider
+		updates["login"] = remote.Login
+	}
+	if err := tx.Model(&remoteIdentity).Updates(updates).Error; err != nil {
 		tx.Rollback()
 		return err
 	}
@@ -0,0 +1,60 @@
+package database
+
+import (
+	pb "github.com/autograde/quickfeed/ag"
+	"gorm.io/gorm"
+)
+
+/// Access tokens ///
+
+// CreateAccessToken creates the given access token, along with the courses it is valid for.
+func (db *GormDB) CreateAccessToken(token *pb.AccessToken) error {
+	if token.GetUserID() == 0 || token.GetHash() == "" {
+		return gorm.ErrRecordNotFound
+	}
+	var users int64
+	if err := db.conn.Model(&pb.User{}).Where(&pb.User{ID: token.GetUserID()}).Count(&users).Error; err != nil {
+		return err
+	}
+	if users != 1 {
+		return gorm.ErrRecordNotFound
+	}
+	return db.conn.Create(token).Error
+}
+
+// GetAccessToken returns the access token with the given hash, along with the courses it is valid for.
+func (db *GormDB) GetAccessToken(hash string) (*pb.AccessToken, error) {
+	var token pb.AccessToken
+	if err := db.conn.Preload("Courses").Where(&pb.AccessToken{Hash: hash}).First(&token).Error; err != nil {
+		return nil, err
+	}
+	return &token, nil
+}
+
+// GetAccessTokens returns the access tokens of the given user, oldest first.
+func (db *GormDB) GetAccessTokens(userID uint64) ([]*pb.AccessToken, error) {
+	var tokens []*pb.AccessToken
+	if err := db.conn.Preload("Courses").Where(&pb.AccessToken{UserID: userID}).Order("id").Find(&tokens).Error; err != nil {
+		return nil, err
+	}
+	return tokens, nil
+}
+
+// UpdateAccessTokenLastUsed records the time the access token with the given ID was last used.
+func (db *GormDB) UpdateAccessTokenLastUsed(tokenID uint64, lastUsed string) error {
+	return db.conn.Model(&pb.AccessToken{ID: tokenID}).Update("last_used", lastUsed).Error
+}
+
+// DeleteAccessToken deletes the access token with the given ID belonging to the given user.
+func (db *GormDB) DeleteAccessToken(userID, tokenID uint64) error {
+	return db.conn.Transaction(func(tx *gorm.DB) error {
+		query := tx.Where(&pb.AccessToken{ID: tokenID, UserID: userID}).Delete(&pb.AccessToken{})
+		if query.Error != nil {
+			return query.Error
+		}
+		if query.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		return tx.Where(&pb.AccessTokenCourse{AccessTokenID: tokenID}).Delete(&pb.AccessTokenCourse{}).Error
+	})
+}
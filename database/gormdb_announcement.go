@@ -0,0 +1,77 @@
+package database
+
+import (
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"gorm.io/gorm"
+)
+
+/// Announcements ///
+
+// CreateAnnouncement creates a new course announcement.
+func (db *GormDB) CreateAnnouncement(announcement *pb.Announcement) error {
+	var course int64
+	if err := db.conn.Model(&pb.Course{}).Where(&pb.Course{
+		ID: announcement.CourseID,
+	}).Count(&course).Error; err != nil {
+		return err
+	}
+	if course != 1 {
+		return gorm.ErrRecordNotFound
+	}
+	announcement.Created = time.Now().Format(pb.TimeLayout)
+	return db.conn.Create(announcement).Error
+}
+
+// UpdateAnnouncement updates the title and body of the given announcement.
+func (db *GormDB) UpdateAnnouncement(announcement *pb.Announcement) error {
+	return db.conn.Model(&pb.Announcement{}).
+		Where(&pb.Announcement{ID: announcement.GetID(), CourseID: announcement.GetCourseID()}).
+		Updates(&pb.Announcement{
+			Title:   announcement.GetTitle(),
+			Body:    announcement.GetBody(),
+			Updated: time.Now().Format(pb.TimeLayout),
+		}).Error
+}
+
+// GetAnnouncement returns the announcement with the given ID.
+func (db *GormDB) GetAnnouncement(announcementID uint64) (*pb.Announcement, error) {
+	var announcement pb.Announcement
+	if err := db.conn.First(&announcement, announcementID).Error; err != nil {
+		return nil, err
+	}
+	return &announcement, nil
+}
+
+// GetAnnouncements returns all announcements for the given course, newest first.
+// The announcements' read status is set for the given user.
+func (db *GormDB) GetAnnouncements(courseID, userID uint64) ([]*pb.Announcement, error) {
+	var announcements []*pb.Announcement
+	if err := db.conn.Where(&pb.Announcement{CourseID: courseID}).
+		Order("id desc").
+		Find(&announcements).Error; err != nil {
+		return nil, err
+	}
+	var reads []*pb.AnnouncementRead
+	if err := db.conn.Where(&pb.AnnouncementRead{UserID: userID}).Find(&reads).Error; err != nil {
+		return nil, err
+	}
+	read := make(map[uint64]bool)
+	for _, r := range reads {
+		read[r.GetAnnouncementID()] = true
+	}
+	for _, announcement := range announcements {
+		announcement.Read = read[announcement.GetID()]
+	}
+	return announcements, nil
+}
+
+// MarkAnnouncementRead records that the given user has read the announcement.
+func (db *GormDB) MarkAnnouncementRead(announcementID, userID uint64) error {
+	var readStatus pb.AnnouncementRead
+	return db.conn.
+		Where(pb.AnnouncementRead{AnnouncementID: announcementID, UserID: userID}).
+		Attrs(pb.AnnouncementRead{ReadAt: time.Now().Format(pb.TimeLayout)}).
+		FirstOrCreate(&readStatus).Error
+}
@@ -0,0 +1,48 @@
+package database
+
+import (
+	pb "github.com/autograde/quickfeed/ag"
+)
+
+/// Appeals ///
+
+// CreateAppeal creates a new grade appeal.
+func (db *GormDB) CreateAppeal(appeal *pb.Appeal) error {
+	if appeal.CourseID < 1 || appeal.UserID < 1 {
+		return ErrInvalidAppeal
+	}
+	return db.conn.Create(appeal).Error
+}
+
+// GetAppeal returns the appeal with the given ID.
+func (db *GormDB) GetAppeal(appealID uint64) (*pb.Appeal, error) {
+	var appeal pb.Appeal
+	if err := db.conn.First(&appeal, appealID).Error; err != nil {
+		return nil, err
+	}
+	return &appeal, nil
+}
+
+// GetAppeals returns all appeals matching the given query, oldest first.
+func (db *GormDB) GetAppeals(query *pb.Appeal) ([]*pb.Appeal, error) {
+	var appeals []*pb.Appeal
+	if err := db.conn.Where(query).
+		Order("id").
+		Find(&appeals).Error; err != nil {
+		return nil, err
+	}
+	return appeals, nil
+}
+
+// UpdateAppeal records the decision of the given appeal.
+func (db *GormDB) UpdateAppeal(appeal *pb.Appeal) error {
+	return db.conn.Model(&pb.Appeal{ID: appeal.GetID()}).
+		Select("status", "decided", "decider_id", "rationale", "new_grade").
+		Updates(&pb.Appeal{
+			Status:    appeal.GetStatus(),
+			Decided:   appeal.GetDecided(),
+			DeciderID: appeal.GetDeciderID(),
+			Rationale: appeal.GetRationale(),
+			NewGrade:  appeal.GetNewGrade(),
+		}).Error
+}
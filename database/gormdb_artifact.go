@@ -0,0 +1,40 @@
+package database
+
+import (
+	pb "github.com/autograde/quickfeed/ag"
+	"gorm.io/gorm"
+)
+
+/// Artifacts ///
+
+// GetArtifacts returns the artifacts of the given submission, ordered by name.
+func (db *GormDB) GetArtifacts(submissionID uint64) ([]*pb.Artifact, error) {
+	var artifacts []*pb.Artifact
+	if err := db.conn.Where(&pb.Artifact{SubmissionID: submissionID}).
+		Order("name").
+		Find(&artifacts).Error; err != nil {
+		return nil, err
+	}
+	return artifacts, nil
+}
+
+// UpdateArtifacts replaces the artifacts of the given submission with the given artifacts.
+// Returns gorm.ErrRecordNotFound if the submission does not exist.
+func (db *GormDB) UpdateArtifacts(submissionID uint64, artifacts []*pb.Artifact) error {
+	return db.conn.Transaction(func(tx *gorm.DB) error {
+		if err := tx.First(&pb.Submission{}, &pb.Submission{ID: submissionID}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("submission_id = ?", submissionID).Delete(&pb.Artifact{}).Error; err != nil {
+			return err
+		}
+		for _, artifact := range artifacts {
+			artifact.ID = 0
+			artifact.SubmissionID = submissionID
+			if err := tx.Create(artifact).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,58 @@
+package database_test
+
+import (
+	"errors"
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/internal/qtest"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/testing/protocmp"
+	"gorm.io/gorm"
+)
+
+func TestGormDBUpdateArtifacts(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	user, _, assignment := setupCourseAssignment(t, db)
+	submission := &pb.Submission{AssignmentID: assignment.ID, UserID: user.ID}
+	if err := db.CreateSubmission(submission); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := db.UpdateArtifacts(submission.ID+1, []*pb.Artifact{{Name: "build.log"}}); !errors.Is(err, gorm.ErrRecordNotFound) {
+		t.Errorf("UpdateArtifacts(unknown submission): err = %v, want %v", err, gorm.ErrRecordNotFound)
+	}
+
+	first := []*pb.Artifact{
+		{Name: "coverage.html", Size: 10, ContentType: "text/html; charset=utf-8", Key: "submissions/1/coverage.html"},
+		{Name: "build.log", Size: 20, ContentType: "text/plain; charset=utf-8", Key: "submissions/1/build.log"},
+	}
+	if err := db.UpdateArtifacts(submission.ID, first); err != nil {
+		t.Fatal(err)
+	}
+	got, err := db.GetArtifacts(submission.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*pb.Artifact{first[1], first[0]}
+	if diff := cmp.Diff(want, got, protocmp.Transform()); diff != "" {
+		t.Errorf("GetArtifacts() mismatch (-want +got):\n%s", diff)
+	}
+
+	// artifacts of a new test run replace the previous artifacts
+	second := []*pb.Artifact{{Name: "build.log", Size: 30, Key: "submissions/1/build.log"}}
+	if err := db.UpdateArtifacts(submission.ID, second); err != nil {
+		t.Fatal(err)
+	}
+	if got, err = db.GetArtifacts(submission.ID); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(second, got, protocmp.Transform()); diff != "" {
+		t.Errorf("GetArtifacts() after update mismatch (-want +got):\n%s", diff)
+	}
+	if got[0].GetSubmissionID() != submission.ID {
+		t.Errorf("artifact submission ID = %d, want %d", got[0].GetSubmissionID(), submission.ID)
+	}
+}
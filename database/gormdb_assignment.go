@@ -3,6 +3,7 @@ package database
 import (
 	pb "github.com/autograde/quickfeed/ag"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 /// Assignments ///
@@ -30,15 +31,40 @@ func (db *GormDB) CreateAssignment(assignment *pb.Assignment) error {
 			Order:    assignment.Order,
 		}).
 		Assign(map[string]interface{}{
-			"name":              assignment.Name,
-			"order":             assignment.Order,
-			"script_file":       assignment.ScriptFile,
-			"deadline":          assignment.Deadline,
-			"auto_approve":      assignment.AutoApprove,
-			"score_limit":       assignment.ScoreLimit,
-			"is_group_lab":      assignment.IsGroupLab,
-			"reviewers":         assignment.Reviewers,
-			"container_timeout": assignment.ContainerTimeout,
+			"name":                    assignment.Name,
+			"order":                   assignment.Order,
+			"script_file":             assignment.ScriptFile,
+			"run_config":              assignment.RunConfig,
+			"deadline":                assignment.Deadline,
+			"auto_approve":            assignment.AutoApprove,
+			"score_limit":             assignment.ScoreLimit,
+			"min_coverage":            assignment.MinCoverage,
+			"is_group_lab":            assignment.IsGroupLab,
+			"reviewers":               assignment.Reviewers,
+			"container_timeout":       assignment.ContainerTimeout,
+			"test_score_weight":       assignment.TestScoreWeight,
+			"grade_weight":            assignment.GradeWeight,
+			"mandatory":               assignment.Mandatory,
+			"runner_pool":             assignment.RunnerPool,
+			"late_grace_hours":        assignment.LateGraceHours,
+			"late_penalty":            assignment.LatePenalty,
+			"late_cutoff_days":        assignment.LateCutoffDays,
+			"peer_reviews":            assignment.PeerReviews,
+			"feedback_issue":          assignment.FeedbackIssue,
+			"submission_branch":       assignment.SubmissionBranch,
+			"exam":                    assignment.Exam,
+			"exam_start":              assignment.ExamStart,
+			"container_image":         assignment.ContainerImage,
+			"dockerfile":              assignment.Dockerfile,
+			"cpu_limit":               assignment.CpuLimit,
+			"memory_limit":            assignment.MemoryLimit,
+			"pids_limit":              assignment.PidsLimit,
+			"scan_secrets":            assignment.ScanSecrets,
+			"forbidden_files":         assignment.ForbiddenFiles,
+			"max_file_size":           assignment.MaxFileSize,
+			"disallowed_imports":      assignment.DisallowedImports,
+			"block_policy_violations": assignment.BlockPolicyViolations,
+			"release":                 assignment.Release,
 		}).FirstOrCreate(assignment).Error
 }
 
@@ -83,12 +109,25 @@ func (db *GormDB) GetAssignmentsByCourse(courseID uint64, withGrading bool) ([]*
 	return assignments, nil
 }
 
+// UpdateAssignmentReleased records that the content of the given assignment has been published.
+func (db *GormDB) UpdateAssignmentReleased(assignmentID uint64) error {
+	return db.conn.Model(&pb.Assignment{ID: assignmentID}).Update("released", true).Error
+}
+
 // UpdateAssignments updates assignment information.
 func (db *GormDB) UpdateAssignments(assignments []*pb.Assignment) error {
 	// TODO(meling) Updating the database may need locking?? Or maybe rewrite as a single query or txn.
 	for _, v := range assignments {
+		// the tasks are replaced after the assignment has been created or updated
+		tasks := v.Tasks
+		v.Tasks = nil
 		// this will create or update an existing assignment
-		if err := db.CreateAssignment(v); err != nil {
+		err := db.CreateAssignment(v)
+		v.Tasks = tasks
+		if err != nil {
+			return err
+		}
+		if err := db.updateTasks(v.GetID(), tasks); err != nil {
 			return err
 		}
 	}
@@ -99,17 +138,18 @@ func (db *GormDB) UpdateAssignments(assignments []*pb.Assignment) error {
 // of requested type with preloaded submissions.
 func (db *GormDB) GetAssignmentsWithSubmissions(courseID uint64, submissionType pb.SubmissionsForCourseRequest_Type, withBuildInfo bool) ([]*pb.Assignment, error) {
 	var assignments []*pb.Assignment
-	// the 'order' field of pb.Assignment must be in 'quotes' since otherwise it will be interpreted as SQL
+	// the 'order' column must be quoted by the dialect, since otherwise it will be interpreted as SQL
 	m := db.conn.Preload("Submissions").
 		Preload("Submissions.Reviews").
 		Preload("Submissions.Reviews.GradingBenchmarks").
 		Preload("Submissions.Reviews.GradingBenchmarks.Criteria").
-		Preload("Submissions.Scores")
+		Preload("Submissions.Scores").
+		Preload("Submissions.PolicyViolations")
 	if withBuildInfo {
 		m.Preload("Submissions.BuildInfo")
 	}
 	if err := m.Where(&pb.Assignment{CourseID: courseID}).
-		Order("'order'").
+		Order(clause.OrderByColumn{Column: clause.Column{Name: "order"}}).
 		Find(&assignments).Error; err != nil {
 		return nil, err
 	}
@@ -149,6 +189,15 @@ func (db *GormDB) DeleteBenchmark(query *pb.GradingBenchmark) error {
 	return db.conn.Delete(query).Error
 }
 
+// GetBenchmark returns the benchmark with the given ID, without its criteria
+func (db *GormDB) GetBenchmark(benchmarkID uint64) (*pb.GradingBenchmark, error) {
+	var benchmark pb.GradingBenchmark
+	if err := db.conn.First(&benchmark, benchmarkID).Error; err != nil {
+		return nil, err
+	}
+	return &benchmark, nil
+}
+
 // CreateCriterion creates a new grading criterion
 func (db *GormDB) CreateCriterion(query *pb.GradingCriterion) error {
 	return db.conn.Create(query).Error
@@ -158,7 +207,7 @@ func (db *GormDB) CreateCriterion(query *pb.GradingCriterion) error {
 func (db *GormDB) UpdateCriterion(query *pb.GradingCriterion) error {
 	return db.conn.
 		Where(&pb.GradingCriterion{ID: query.ID, BenchmarkID: query.BenchmarkID}).
-		Updates(&pb.GradingCriterion{Description: query.Description, Comment: query.Comment, Grade: query.Grade, Points: query.Points}).Error
+		Updates(&pb.GradingCriterion{Description: query.Description, Comment: query.Comment, Grade: query.Grade, Points: query.Points, Weight: query.Weight}).Error
 }
 
 // DeleteCriterion removes the given criterion
@@ -166,6 +215,15 @@ func (db *GormDB) DeleteCriterion(query *pb.GradingCriterion) error {
 	return db.conn.Delete(query).Error
 }
 
+// GetCriterion returns the criterion with the given ID
+func (db *GormDB) GetCriterion(criterionID uint64) (*pb.GradingCriterion, error) {
+	var criterion pb.GradingCriterion
+	if err := db.conn.First(&criterion, criterionID).Error; err != nil {
+		return nil, err
+	}
+	return &criterion, nil
+}
+
 // GetBenchmarks returns all benchmarks and associated criteria for a given assignment ID
 func (db *GormDB) GetBenchmarks(query *pb.Assignment) ([]*pb.GradingBenchmark, error) {
 	var benchmarks []*pb.GradingBenchmark
@@ -119,6 +119,9 @@ func TestUpdateAssignment(t *testing.T) {
 		a.Reviewers = 0
 		a.AutoApprove = !a.AutoApprove
 		a.IsGroupLab = !a.IsGroupLab
+		a.ScanSecrets = !a.ScanSecrets
+		a.ForbiddenFiles = ".env"
+		a.BlockPolicyViolations = !a.BlockPolicyViolations
 		wantAssignments[i] = (proto.Clone(assignments[i])).(*pb.Assignment)
 	}
 
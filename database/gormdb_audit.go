@@ -0,0 +1,31 @@
+package database
+
+import (
+	pb "github.com/autograde/quickfeed/ag"
+)
+
+/// Audit log ///
+
+// CreateAuditEntry records the given audit log entry.
+func (db *GormDB) CreateAuditEntry(entry *pb.AuditEntry) error {
+	return db.conn.Create(entry).Error
+}
+
+// GetAuditEntries returns the audit log entries matching the given query, oldest first.
+// If page is non-nil, only the given page of entries is returned.
+func (db *GormDB) GetAuditEntries(query *pb.AuditEntry, page *Page) ([]*pb.AuditEntry, error) {
+	var entries []*pb.AuditEntry
+	tx := db.conn.Where(query)
+	if page != nil {
+		tx = tx.Scopes(page.scope("id"))
+	} else {
+		tx = tx.Order("id")
+	}
+	if err := tx.Find(&entries).Error; err != nil {
+		return nil, err
+	}
+	if page != nil {
+		entries = entries[:page.trim(len(entries), func(i int) uint64 { return entries[i].GetID() })]
+	}
+	return entries, nil
+}
@@ -0,0 +1,24 @@
+package database
+
+import (
+	pb "github.com/autograde/quickfeed/ag"
+)
+
+/// Certificates ///
+
+// CreateCertificate records a new certificate of completion.
+func (db *GormDB) CreateCertificate(certificate *pb.Certificate) error {
+	if certificate.CourseID < 1 || certificate.UserID < 1 || certificate.Code == "" {
+		return ErrInvalidCertificate
+	}
+	return db.conn.Create(certificate).Error
+}
+
+// GetCertificate returns the certificate matching the given query.
+func (db *GormDB) GetCertificate(query *pb.Certificate) (*pb.Certificate, error) {
+	var certificate pb.Certificate
+	if err := db.conn.Where(query).First(&certificate).Error; err != nil {
+		return nil, err
+	}
+	return &certificate, nil
+}
@@ -0,0 +1,51 @@
+package database
+
+import (
+	"fmt"
+	"sync"
+
+	"gorm.io/gorm"
+)
+
+// changeListeners holds the functions called after each write to the database.
+type changeListeners struct {
+	mu        sync.RWMutex
+	listeners []func(table string)
+}
+
+// register adds callbacks notifying the listeners to the gorm create, update, delete and raw SQL operations.
+func (c *changeListeners) register(conn *gorm.DB) error {
+	callbacks := conn.Callback()
+	for name, err := range map[string]error{
+		"create": callbacks.Create().After("gorm:create").Register("quickfeed:changed_create", c.notify),
+		"update": callbacks.Update().After("gorm:update").Register("quickfeed:changed_update", c.notify),
+		"delete": callbacks.Delete().After("gorm:delete").Register("quickfeed:changed_delete", c.notify),
+		"raw":    callbacks.Raw().After("gorm:raw").Register("quickfeed:changed_raw", c.notify),
+	} {
+		if err != nil {
+			return fmt.Errorf("failed to register %s callback: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// notify calls the listeners with the table written to by the statement, if successful.
+func (c *changeListeners) notify(tx *gorm.DB) {
+	if tx.Error != nil || tx.RowsAffected == 0 {
+		return
+	}
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	for _, listener := range c.listeners {
+		listener(tx.Statement.Table)
+	}
+}
+
+// OnChange registers a function that is called with the name of the table after each write
+// to the database, or with an empty name if the table is unknown, e.g., for raw SQL statements.
+// The function is called before the transaction performing the write, if any, is committed.
+func (db *GormDB) OnChange(fn func(table string)) {
+	db.changes.mu.Lock()
+	defer db.changes.mu.Unlock()
+	db.changes.listeners = append(db.changes.listeners, fn)
+}
@@ -0,0 +1,64 @@
+package database_test
+
+import (
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/internal/qtest"
+)
+
+func TestGormDBOnChange(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	var tables []string
+	db.OnChange(func(table string) { tables = append(tables, table) })
+
+	admin := qtest.CreateFakeUser(t, db, 1)
+	course := &pb.Course{}
+	qtest.CreateCourse(t, db, admin, course)
+	if !contains(tables, "users") || !contains(tables, "courses") || !contains(tables, "enrollments") {
+		t.Errorf("changed tables = %v, want users, courses and enrollments", tables)
+	}
+
+	// reads are not reported
+	tables = nil
+	if _, err := db.GetCourses(); err != nil {
+		t.Fatal(err)
+	}
+	if len(tables) != 0 {
+		t.Errorf("changed tables after read = %v, want none", tables)
+	}
+
+	// updates without affected rows are not reported
+	if err := db.UpdateAssignmentReleased(100); err != nil {
+		t.Fatal(err)
+	}
+	if len(tables) != 0 {
+		t.Errorf("changed tables after no-op update = %v, want none", tables)
+	}
+
+	// raw SQL statements are reported with an unknown table
+	student := qtest.CreateFakeUser(t, db, 2)
+	qtest.EnrollStudent(t, db, student, course)
+	group := &pb.Group{Name: "group", CourseID: course.ID, Users: []*pb.User{student}}
+	if err := db.CreateGroup(group); err != nil {
+		t.Fatal(err)
+	}
+	tables = nil
+	if err := db.DeleteGroup(group.ID); err != nil {
+		t.Fatal(err)
+	}
+	if !contains(tables, "groups") || !contains(tables, "") {
+		t.Errorf("changed tables after deleting group = %v, want groups and unknown table", tables)
+	}
+}
+
+func contains(tables []string, table string) bool {
+	for _, t := range tables {
+		if t == table {
+			return true
+		}
+	}
+	return false
+}
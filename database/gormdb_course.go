@@ -2,6 +2,8 @@ package database
 
 import (
 	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/kit/score"
+	"gorm.io/gorm"
 )
 
 // CreateCourse creates a new course if user with given ID is admin, enrolls user as course teacher.
@@ -65,6 +67,7 @@ func (db *GormDB) GetCourse(courseID uint64, withEnrollments bool) (*pb.Course,
 		userStates := []pb.Enrollment_UserStatus{
 			pb.Enrollment_STUDENT,
 			pb.Enrollment_TEACHER,
+			pb.Enrollment_TA,
 		}
 		// and only group submissions from approved groups
 		modelGroup := &pb.Group{Status: pb.Group_APPROVED, CourseID: courseID}
@@ -159,9 +162,165 @@ func (db *GormDB) GetCoursesByUser(userID uint64, statuses ...pb.Enrollment_User
 	return courses, nil
 }
 
-// UpdateCourse updates course information.
+// courseColumns are the columns of a course written by UpdateCourse, i.e., the course's settings.
+// The course's creator, provider, repositories and tenant are chosen when the course is created.
+// Its creation step, Dockerfile, archived status and last push event are only written by
+// UpdateCourseSetup, UpdateCourseDockerfile, ArchiveCourse and UpdateCourseLastWebhook.
+var courseColumns = []string{
+	"name", "code", "year", "tag", "organization_id", "organization_path", "slip_days",
+	"drop_lowest", "final_grades_released", "appeal_window", "appeal_response_days",
+	"certificates", "runner_pool", "assistant_permission", "auto_approve_domains",
+	"auto_approve_roster", "min_group_size", "max_group_size", "assignments_template",
+	"assignments_dir", "timezone", "min_approved",
+}
+
+// UpdateCourse updates the course's settings. All of the settings are written, including
+// those with zero values, so the given course must hold all of the course's settings.
 func (db *GormDB) UpdateCourse(course *pb.Course) error {
+	return db.conn.Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&pb.Course{}).
+			Where(&pb.Course{ID: course.GetID()}).
+			Select(courseColumns).
+			Updates(course)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		return nil
+	})
+}
+
+// UpdateCourseSetup records the next course creation step to perform for the course.
+func (db *GormDB) UpdateCourseSetup(courseID uint64, step pb.Course_SetupStep) error {
+	return db.conn.Model(&pb.Course{}).
+		Where(&pb.Course{ID: courseID}).
+		Update("pending_setup", step).Error
+}
+
+// UpdateCourseDockerfile updates the Dockerfile of the course's tests.
+func (db *GormDB) UpdateCourseDockerfile(courseID uint64, dockerfile string) error {
 	return db.conn.Model(&pb.Course{}).
-		Where(&pb.Course{ID: course.GetID()}).
-		Updates(course).Error
+		Where(&pb.Course{ID: courseID}).
+		Update("dockerfile", dockerfile).Error
+}
+
+// ArchiveCourse marks the course as archived, making it read-only.
+func (db *GormDB) ArchiveCourse(courseID uint64) error {
+	return db.conn.Model(&pb.Course{}).
+		Where(&pb.Course{ID: courseID}).
+		Update("archived", true).Error
+}
+
+// UpdateCourseLastWebhook records the time when the last push event for the course's repositories was received.
+func (db *GormDB) UpdateCourseLastWebhook(courseID uint64, received string) error {
+	return db.conn.Model(&pb.Course{}).
+		Where(&pb.Course{ID: courseID}).
+		Update("last_webhook", received).Error
+}
+
+// DeleteCourse deletes the course and all its records in a single transaction.
+func (db *GormDB) DeleteCourse(courseID uint64) error {
+	course, err := db.GetCourse(courseID, false)
+	if err != nil {
+		return err
+	}
+	return db.conn.Transaction(func(tx *gorm.DB) error {
+		assignments := tx.Model(&pb.Assignment{}).Select("id").Where("course_id = ?", courseID)
+		submissions := tx.Model(&pb.Submission{}).Select("id").Where("assignment_id IN (?)", assignments)
+		reviews := tx.Model(&pb.Review{}).Select("id").Where("submission_id IN (?)", submissions)
+		benchmarks := tx.Model(&pb.GradingBenchmark{}).Select("id").
+			Where("assignment_id IN (?) OR review_id IN (?)", assignments, reviews)
+		questions := tx.Model(&pb.Question{}).Select("id").Where("assignment_id IN (?)", assignments)
+		enrollments := tx.Unscoped().Model(&pb.Enrollment{}).Select("id").Where("course_id = ?", courseID)
+		groups := tx.Unscoped().Model(&pb.Group{}).Select("id").Where("course_id = ?", courseID)
+		announcements := tx.Model(&pb.Announcement{}).Select("id").Where("course_id = ?", courseID)
+
+		// records are deleted before the records they refer to; soft-deleted records are deleted as well
+		deletions := []struct {
+			model interface{}
+			query string
+			arg   interface{}
+		}{
+			{&pb.GradingCriterion{}, "benchmark_id IN (?)", benchmarks},
+			{&pb.GradingBenchmark{}, "id IN (?)", benchmarks},
+			{&pb.Review{}, "id IN (?)", reviews},
+			{&score.Score{}, "submission_id IN (?)", submissions},
+			{&pb.PolicyViolation{}, "submission_id IN (?)", submissions},
+			{&pb.Artifact{}, "submission_id IN (?)", submissions},
+			{&pb.TestResult{}, "submission_id IN (?)", submissions},
+			{&pb.TaskScore{}, "submission_id IN (?)", submissions},
+			{&pb.SubmissionComment{}, "submission_id IN (?)", submissions},
+			{&pb.SubmissionTransition{}, "submission_id IN (?)", submissions},
+			{&pb.FileCoverage{}, "submission_id IN (?)", submissions},
+			{&score.BuildInfo{}, "submission_id IN (?)", submissions},
+			{&pb.ForcePush{}, "course_id = ?", courseID},
+			{&pb.Appeal{}, "course_id = ?", courseID},
+			{&pb.Submission{}, "id IN (?)", submissions},
+			{&pb.Answer{}, "question_id IN (?)", questions},
+			{&pb.Question{}, "id IN (?)", questions},
+			{&pb.UsedSlipDays{}, "enrollment_id IN (?)", enrollments},
+			{&pb.GroupInvitation{}, "course_id = ?", courseID},
+			{&pb.Enrollment{}, "course_id = ?", courseID},
+			{&pb.PreEnrollment{}, "course_id = ?", courseID},
+			{&pb.AnnouncementRead{}, "announcement_id IN (?)", announcements},
+			{&pb.Announcement{}, "course_id = ?", courseID},
+			{&pb.CourseEvent{}, "course_id = ?", courseID},
+			{&pb.Notification{}, "course_id = ?", courseID},
+			{&pb.Certificate{}, "course_id = ?", courseID},
+			{&pb.Extension{}, "course_id = ?", courseID},
+			{&pb.PlagiarismResult{}, "course_id = ?", courseID},
+			{&pb.PeerReview{}, "course_id = ?", courseID},
+			{&pb.TestJob{}, "course_id = ?", courseID},
+			{&pb.SubmittedCommit{}, "assignment_id IN (?)", assignments},
+			{&pb.AccessTokenCourse{}, "course_id = ?", courseID},
+			{&pb.GradeThreshold{}, "course_id = ?", courseID},
+			{&pb.ScoreCurve{}, "course_id = ?", courseID},
+			{&pb.PendingAction{}, "course_id = ?", courseID},
+			{&pb.Task{}, "assignment_id IN (?)", assignments},
+			{&pb.Assignment{}, "course_id = ?", courseID},
+			{&pb.Repository{}, "organization_id = ?", course.GetOrganizationID()},
+		}
+		if err := tx.Exec("DELETE FROM group_users WHERE group_id IN (?)", groups).Error; err != nil {
+			return err
+		}
+		for _, d := range deletions {
+			if err := tx.Unscoped().Where(d.query, d.arg).Delete(d.model).Error; err != nil {
+				return err
+			}
+		}
+		if err := tx.Unscoped().Where("course_id = ?", courseID).Delete(&pb.Group{}).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&pb.Course{}, courseID).Error
+	})
+}
+
+// GetGradingScale returns the grading scale for the given course, ordered by decreasing minimum score.
+func (db *GormDB) GetGradingScale(courseID uint64) ([]*pb.GradeThreshold, error) {
+	var thresholds []*pb.GradeThreshold
+	if err := db.conn.Where(&pb.GradeThreshold{CourseID: courseID}).
+		Order("min_score desc").
+		Find(&thresholds).Error; err != nil {
+		return nil, err
+	}
+	return thresholds, nil
+}
+
+// UpdateGradingScale replaces the grading scale for the given course.
+func (db *GormDB) UpdateGradingScale(courseID uint64, thresholds []*pb.GradeThreshold) error {
+	return db.conn.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("course_id = ?", courseID).Delete(&pb.GradeThreshold{}).Error; err != nil {
+			return err // will rollback transaction
+		}
+		for _, t := range thresholds {
+			t.ID = 0
+			t.CourseID = courseID
+		}
+		if len(thresholds) == 0 {
+			return nil
+		}
+		return tx.Create(&thresholds).Error
+	})
 }
@@ -0,0 +1,31 @@
+package database
+
+import (
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+)
+
+/// Course events ///
+
+// CreateCourseEvent records a new course event.
+func (db *GormDB) CreateCourseEvent(event *pb.CourseEvent) error {
+	if event.CourseID < 1 {
+		return ErrInvalidCourseEvent
+	}
+	if event.Created == "" {
+		event.Created = time.Now().Format(pb.TimeLayout)
+	}
+	return db.conn.Create(event).Error
+}
+
+// GetCourseEvents returns all events for the given course, newest first.
+func (db *GormDB) GetCourseEvents(courseID uint64) ([]*pb.CourseEvent, error) {
+	var events []*pb.CourseEvent
+	if err := db.conn.Where(&pb.CourseEvent{CourseID: courseID}).
+		Order("id desc").
+		Find(&events).Error; err != nil {
+		return nil, err
+	}
+	return events, nil
+}
@@ -0,0 +1,142 @@
+package database
+
+import (
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"gorm.io/gorm"
+)
+
+// RestoreGroup restores the soft-deleted group with the given ID, and returns the group to its members.
+// The restored group is pending, and must be approved again to recreate its repository and team.
+func (db *GormDB) RestoreGroup(groupID uint64) (*pb.Group, error) {
+	err := db.conn.Transaction(func(tx *gorm.DB) error {
+		var group pb.Group
+		if err := tx.Unscoped().Where("deleted_at > 0").First(&group, groupID).Error; err != nil {
+			return err
+		}
+		var duplicates int64
+		if err := tx.Model(&pb.Group{}).
+			Where(&pb.Group{CourseID: group.CourseID, Name: group.Name}).
+			Count(&duplicates).Error; err != nil {
+			return err
+		}
+		if duplicates > 0 {
+			return ErrDuplicateGroup
+		}
+		var userIDs []uint64
+		if err := tx.Table("group_users").Where("group_id = ?", group.ID).Pluck("user_id", &userIDs).Error; err != nil {
+			return err
+		}
+		if len(userIDs) == 0 {
+			return ErrEmptyGroup
+		}
+		// the members must still be enrolled in the course, and not have joined another group
+		query := tx.Model(&pb.Enrollment{}).
+			Where("course_id = ? AND group_id = ?", group.CourseID, 0).
+			Where("user_id IN (?) AND status IN (?)", userIDs,
+				[]pb.Enrollment_UserStatus{pb.Enrollment_STUDENT, pb.Enrollment_TEACHER}).
+			Updates(&pb.Enrollment{GroupID: group.ID})
+		if query.Error != nil {
+			return query.Error
+		}
+		if query.RowsAffected != int64(len(userIDs)) {
+			return ErrUpdateGroup
+		}
+		return tx.Unscoped().Model(&group).Updates(map[string]interface{}{
+			"deleted_at": 0,
+			"status":     pb.Group_PENDING,
+		}).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return db.GetGroup(groupID)
+}
+
+// RestoreEnrollment restores the soft-deleted enrollment of the given user in the given course.
+// The restored enrollment is pending, and must be accepted again to recreate the user's repository.
+func (db *GormDB) RestoreEnrollment(courseID, userID uint64) (*pb.Enrollment, error) {
+	var enrollment pb.Enrollment
+	if err := db.conn.Unscoped().
+		Where("course_id = ? AND user_id = ? AND deleted_at > 0", courseID, userID).
+		First(&enrollment).Error; err != nil {
+		return nil, err
+	}
+	if err := db.conn.Unscoped().Model(&enrollment).Updates(map[string]interface{}{
+		"deleted_at": 0,
+		"status":     pb.Enrollment_PENDING,
+		"group_id":   0,
+	}).Error; err != nil {
+		return nil, err
+	}
+	return db.GetEnrollmentByCourseAndUser(courseID, userID)
+}
+
+// PurgeDeleted permanently deletes the users, groups and enrollments soft-deleted before the given time.
+func (db *GormDB) PurgeDeleted(before time.Time) error {
+	return db.conn.Transaction(func(tx *gorm.DB) error {
+		if err := purgeDeletedUsers(tx, "deleted_at < ?", before.Unix()); err != nil {
+			return err
+		}
+		if err := purgeDeletedGroups(tx, "deleted_at < ?", before.Unix()); err != nil {
+			return err
+		}
+		return purgeDeletedEnrollments(tx, "deleted_at < ?", before.Unix())
+	})
+}
+
+// purgeDeletedUsers permanently deletes the soft-deleted users matching the query,
+// along with their remote identities, access tokens, sessions, notifications, group memberships and enrollments.
+func purgeDeletedUsers(tx *gorm.DB, query string, args ...interface{}) error {
+	users := tx.Unscoped().Model(&pb.User{}).Select("id").Where("deleted_at > 0").Where(query, args...)
+	enrollments := tx.Unscoped().Model(&pb.Enrollment{}).Select("id").Where("user_id IN (?)", users)
+	tokens := tx.Model(&pb.AccessToken{}).Select("id").Where("user_id IN (?)", users)
+	if err := tx.Where("access_token_id IN (?)", tokens).Delete(&pb.AccessTokenCourse{}).Error; err != nil {
+		return err
+	}
+	if err := tx.Where("user_id IN (?)", users).Delete(&pb.AccessToken{}).Error; err != nil {
+		return err
+	}
+	if err := tx.Where("user_id IN (?)", users).Delete(&pb.Session{}).Error; err != nil {
+		return err
+	}
+	if err := tx.Where("user_id IN (?)", users).Delete(&pb.Notification{}).Error; err != nil {
+		return err
+	}
+	if err := tx.Where("user_id IN (?)", users).Delete(&pb.PreEnrollment{}).Error; err != nil {
+		return err
+	}
+	if err := tx.Where("enrollment_id IN (?)", enrollments).Delete(&pb.UsedSlipDays{}).Error; err != nil {
+		return err
+	}
+	if err := tx.Unscoped().Where("user_id IN (?)", users).Delete(&pb.Enrollment{}).Error; err != nil {
+		return err
+	}
+	if err := tx.Exec("DELETE FROM group_users WHERE user_id IN (?)", users).Error; err != nil {
+		return err
+	}
+	if err := tx.Where("user_id IN (?)", users).Delete(&pb.RemoteIdentity{}).Error; err != nil {
+		return err
+	}
+	return tx.Unscoped().Where("deleted_at > 0").Where(query, args...).Delete(&pb.User{}).Error
+}
+
+// purgeDeletedGroups permanently deletes the soft-deleted groups matching the query, along with their memberships.
+func purgeDeletedGroups(tx *gorm.DB, query string, args ...interface{}) error {
+	groups := tx.Unscoped().Model(&pb.Group{}).Select("id").Where("deleted_at > 0").Where(query, args...)
+	if err := tx.Exec("DELETE FROM group_users WHERE group_id IN (?)", groups).Error; err != nil {
+		return err
+	}
+	return tx.Unscoped().Where("deleted_at > 0").Where(query, args...).Delete(&pb.Group{}).Error
+}
+
+// purgeDeletedEnrollments permanently deletes the soft-deleted enrollments matching the query,
+// along with their used slip days.
+func purgeDeletedEnrollments(tx *gorm.DB, query string, args ...interface{}) error {
+	enrollments := tx.Unscoped().Model(&pb.Enrollment{}).Select("id").Where("deleted_at > 0").Where(query, args...)
+	if err := tx.Where("enrollment_id IN (?)", enrollments).Delete(&pb.UsedSlipDays{}).Error; err != nil {
+		return err
+	}
+	return tx.Unscoped().Where("deleted_at > 0").Where(query, args...).Delete(&pb.Enrollment{}).Error
+}
@@ -0,0 +1,124 @@
+package database_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"gorm.io/gorm"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/database"
+	"github.com/autograde/quickfeed/internal/qtest"
+)
+
+func TestGormDBRestoreGroup(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	admin := qtest.CreateFakeUser(t, db, 1)
+	course := &pb.Course{}
+	qtest.CreateCourse(t, db, admin, course)
+	student1 := qtest.CreateFakeUser(t, db, 2)
+	qtest.EnrollStudent(t, db, student1, course)
+	student2 := qtest.CreateFakeUser(t, db, 3)
+	qtest.EnrollStudent(t, db, student2, course)
+	group := &pb.Group{Name: "team", CourseID: course.ID, Users: []*pb.User{student1, student2}}
+	if err := db.CreateGroup(group); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.UpdateGroupStatus(&pb.Group{ID: group.ID, Status: pb.Group_APPROVED}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := db.DeleteGroup(group.ID); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.GetGroup(group.ID); !errors.Is(err, gorm.ErrRecordNotFound) {
+		t.Errorf("GetGroup() after delete: got %v, want %v", err, gorm.ErrRecordNotFound)
+	}
+	restored, err := db.RestoreGroup(group.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if restored.GetStatus() != pb.Group_PENDING || restored.GetDeletedAt() != 0 || len(restored.GetEnrollments()) != 2 {
+		t.Errorf("RestoreGroup() = %v, want pending group with 2 members", restored)
+	}
+	if _, err := db.RestoreGroup(group.ID); !errors.Is(err, gorm.ErrRecordNotFound) {
+		t.Errorf("RestoreGroup() of restored group: got %v, want %v", err, gorm.ErrRecordNotFound)
+	}
+
+	// a group cannot be restored if its members have joined another group
+	if err := db.DeleteGroup(group.ID); err != nil {
+		t.Fatal(err)
+	}
+	other := &pb.Group{Name: "other", CourseID: course.ID, Users: []*pb.User{student1}}
+	if err := db.CreateGroup(other); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.RestoreGroup(group.ID); err != database.ErrUpdateGroup {
+		t.Errorf("RestoreGroup() with member in another group: got %v, want %v", err, database.ErrUpdateGroup)
+	}
+
+	// a new group with the same name replaces the deleted group
+	if err := db.DeleteGroup(other.ID); err != nil {
+		t.Fatal(err)
+	}
+	replacement := &pb.Group{Name: "team", CourseID: course.ID, Users: []*pb.User{student1, student2}}
+	if err := db.CreateGroup(replacement); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.RestoreGroup(group.ID); !errors.Is(err, gorm.ErrRecordNotFound) {
+		t.Errorf("RestoreGroup() of replaced group: got %v, want %v", err, gorm.ErrRecordNotFound)
+	}
+}
+
+func TestGormDBRestoreEnrollment(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	admin := qtest.CreateFakeUser(t, db, 1)
+	course := &pb.Course{}
+	qtest.CreateCourse(t, db, admin, course)
+	student := qtest.CreateFakeUser(t, db, 2)
+	qtest.EnrollStudent(t, db, student, course)
+
+	if _, err := db.RestoreEnrollment(course.ID, student.ID); !errors.Is(err, gorm.ErrRecordNotFound) {
+		t.Errorf("RestoreEnrollment() of active enrollment: got %v, want %v", err, gorm.ErrRecordNotFound)
+	}
+	if err := db.RejectEnrollment(student.ID, course.ID); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.GetEnrollmentByCourseAndUser(course.ID, student.ID); !errors.Is(err, gorm.ErrRecordNotFound) {
+		t.Errorf("GetEnrollmentByCourseAndUser() after reject: got %v, want %v", err, gorm.ErrRecordNotFound)
+	}
+	enrollment, err := db.RestoreEnrollment(course.ID, student.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if enrollment.GetStatus() != pb.Enrollment_PENDING || enrollment.GetUser().GetID() != student.ID {
+		t.Errorf("RestoreEnrollment() = %v, want pending enrollment of user %d", enrollment, student.ID)
+	}
+
+	// deleted records are kept until they are purged
+	if err := db.RejectEnrollment(student.ID, course.ID); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.PurgeDeleted(time.Now().Add(-time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.RestoreEnrollment(course.ID, student.ID); err != nil {
+		t.Errorf("RestoreEnrollment() after purge of older records: got %v, want no error", err)
+	}
+	if err := db.RejectEnrollment(student.ID, course.ID); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.PurgeDeleted(time.Now().Add(time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.RestoreEnrollment(course.ID, student.ID); !errors.Is(err, gorm.ErrRecordNotFound) {
+		t.Errorf("RestoreEnrollment() after purge: got %v, want %v", err, gorm.ErrRecordNotFound)
+	}
+	// the user can enroll again
+	qtest.EnrollStudent(t, db, student, course)
+}
@@ -22,12 +22,16 @@ func (db *GormDB) CreateEnrollment(enrollment *pb.Enrollment) error {
 		return gorm.ErrRecordNotFound
 	}
 
+	// a deleted enrollment in the same course can no longer be restored
+	if err := purgeDeletedEnrollments(db.conn, "course_id = ? AND user_id = ?", enrollment.CourseID, enrollment.UserID); err != nil {
+		return err
+	}
 	enrollment.Status = pb.Enrollment_PENDING
 	enrollment.State = pb.Enrollment_VISIBLE
 	return db.conn.Create(&enrollment).Error
 }
 
-// RejectEnrollment removes the user enrollment from the database.
+// RejectEnrollment soft-deletes the user enrollment.
 func (db *GormDB) RejectEnrollment(userID, courseID uint64) error {
 	enrol, err := db.GetEnrollmentByCourseAndUser(courseID, userID)
 	if err != nil {
@@ -63,6 +67,30 @@ func (db *GormDB) GetEnrollmentsByCourse(courseID uint64, statuses ...pb.Enrollm
 	return db.getEnrollments(&pb.Course{ID: courseID}, statuses...)
 }
 
+// GetEnrollmentsByCoursePage returns the given page of course enrollments with the given statuses.
+func (db *GormDB) GetEnrollmentsByCoursePage(courseID uint64, page *Page, statuses ...pb.Enrollment_UserStatus) ([]*pb.Enrollment, error) {
+	if len(statuses) == 0 {
+		statuses = []pb.Enrollment_UserStatus{
+			pb.Enrollment_PENDING,
+			pb.Enrollment_STUDENT,
+			pb.Enrollment_TEACHER,
+			pb.Enrollment_TA,
+		}
+	}
+	var enrollments []*pb.Enrollment
+	if err := db.conn.Scopes(page.scope("id")).
+		Preload("User").
+		Preload("Course").
+		Preload("Group").
+		Preload("UsedSlipDays").
+		Where("course_id = ?", courseID).
+		Where("status in (?)", statuses).
+		Find(&enrollments).Error; err != nil {
+		return nil, err
+	}
+	return enrollments[:page.trim(len(enrollments), func(i int) uint64 { return enrollments[i].GetID() })], nil
+}
+
 // GetEnrollmentsByUser returns all existing enrollments for the given user
 func (db *GormDB) GetEnrollmentsByUser(userID uint64, statuses ...pb.Enrollment_UserStatus) ([]*pb.Enrollment, error) {
 	return db.getEnrollments(&pb.User{ID: userID}, statuses...)
@@ -75,6 +103,7 @@ func (db *GormDB) getEnrollments(model interface{}, statuses ...pb.Enrollment_Us
 			pb.Enrollment_PENDING,
 			pb.Enrollment_STUDENT,
 			pb.Enrollment_TEACHER,
+			pb.Enrollment_TA,
 		}
 	}
 	var enrollments []*pb.Enrollment
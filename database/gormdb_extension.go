@@ -0,0 +1,50 @@
+package database
+
+import (
+	pb "github.com/autograde/quickfeed/ag"
+)
+
+/// Extensions ///
+
+// UpdateExtension grants the given extension, replacing any previous extension
+// of the assignment for the same student or group.
+func (db *GormDB) UpdateExtension(extension *pb.Extension) error {
+	if extension.AssignmentID < 1 || (extension.UserID > 0) == (extension.GroupID > 0) {
+		return ErrInvalidExtension
+	}
+	var previous pb.Extension
+	err := db.conn.Where(map[string]interface{}{
+		"assignment_id": extension.GetAssignmentID(),
+		"user_id":       extension.GetUserID(),
+		"group_id":      extension.GetGroupID(),
+	}).Limit(1).Find(&previous).Error
+	if err != nil {
+		return err
+	}
+	extension.ID = previous.GetID()
+	return db.conn.Save(extension).Error
+}
+
+// GetExtension returns the extension of the assignment for the given student or group.
+func (db *GormDB) GetExtension(assignmentID, userID, groupID uint64) (*pb.Extension, error) {
+	var extension pb.Extension
+	if err := db.conn.Where(map[string]interface{}{
+		"assignment_id": assignmentID,
+		"user_id":       userID,
+		"group_id":      groupID,
+	}).First(&extension).Error; err != nil {
+		return nil, err
+	}
+	return &extension, nil
+}
+
+// GetExtensions returns all extensions matching the given query.
+func (db *GormDB) GetExtensions(query *pb.Extension) ([]*pb.Extension, error) {
+	var extensions []*pb.Extension
+	if err := db.conn.Where(query).
+		Order("assignment_id, id").
+		Find(&extensions).Error; err != nil {
+		return nil, err
+	}
+	return extensions, nil
+}
@@ -0,0 +1,31 @@
+package database
+
+import (
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+)
+
+/// Force pushes ///
+
+// CreateForcePush records a force push affecting a submission.
+func (db *GormDB) CreateForcePush(forcePush *pb.ForcePush) error {
+	if forcePush.CourseID < 1 || forcePush.SubmissionID < 1 {
+		return ErrInvalidForcePush
+	}
+	if forcePush.Pushed == "" {
+		forcePush.Pushed = time.Now().Format(pb.TimeLayout)
+	}
+	return db.conn.Create(forcePush).Error
+}
+
+// GetForcePushes returns all force pushes recorded for the given course, newest first.
+func (db *GormDB) GetForcePushes(courseID uint64) ([]*pb.ForcePush, error) {
+	var forcePushes []*pb.ForcePush
+	if err := db.conn.Where(&pb.ForcePush{CourseID: courseID}).
+		Order("id desc").
+		Find(&forcePushes).Error; err != nil {
+		return nil, err
+	}
+	return forcePushes, nil
+}
@@ -2,7 +2,6 @@ package database
 
 import (
 	"fmt"
-	"strings"
 
 	pb "github.com/autograde/quickfeed/ag"
 	"gorm.io/gorm"
@@ -27,9 +26,14 @@ func (db *GormDB) CreateGroup(group *pb.Group) error {
 	}
 
 	tx := db.conn.Begin()
+	// a deleted group with the same name can no longer be restored
+	if err := purgeDeletedGroups(tx, "course_id = ? AND name = ?", group.CourseID, group.Name); err != nil {
+		tx.Rollback()
+		return err
+	}
 	if err := tx.Model(&pb.Group{}).Create(group).Error; err != nil {
 		tx.Rollback()
-		if strings.HasPrefix(err.Error(), "UNIQUE constraint failed") {
+		if isUniqueViolation(err) {
 			return ErrDuplicateGroup
 		}
 		return err
@@ -75,7 +79,7 @@ func (db *GormDB) UpdateGroup(group *pb.Group) error {
 	tx := db.conn.Begin()
 	if err := tx.Model(group).Updates(group).Error; err != nil {
 		tx.Rollback()
-		if strings.HasPrefix(err.Error(), "UNIQUE constraint failed") {
+		if isUniqueViolation(err) {
 			return ErrDuplicateGroup
 		}
 		return err
@@ -112,7 +116,8 @@ func (db *GormDB) UpdateGroupStatus(group *pb.Group) error {
 	return db.conn.Model(group).Update("status", group.Status).Error
 }
 
-// DeleteGroup deletes a group and its corresponding enrollments.
+// DeleteGroup soft-deletes a group and removes its members from the group.
+// The group's memberships are kept, so that the group can be restored.
 func (db *GormDB) DeleteGroup(groupID uint64) error {
 	group, err := db.GetGroup(groupID)
 	if err != nil {
@@ -166,6 +171,7 @@ func (db *GormDB) GetGroupsByCourse(courseID uint64, statuses ...pb.Group_GroupS
 		statuses = []pb.Group_GroupStatus{
 			pb.Group_PENDING,
 			pb.Group_APPROVED,
+			pb.Group_INVITING,
 		}
 	}
 	var groups []*pb.Group
@@ -0,0 +1,44 @@
+package database
+
+import (
+	pb "github.com/autograde/quickfeed/ag"
+)
+
+/// Group invitations ///
+
+// CreateGroupInvitation creates a new invitation to join a group.
+func (db *GormDB) CreateGroupInvitation(invitation *pb.GroupInvitation) error {
+	if invitation.CourseID < 1 || invitation.GroupID < 1 || invitation.UserID < 1 {
+		return ErrInvalidGroupInvitation
+	}
+	return db.conn.Create(invitation).Error
+}
+
+// GetGroupInvitation returns the group invitation with the given ID.
+func (db *GormDB) GetGroupInvitation(invitationID uint64) (*pb.GroupInvitation, error) {
+	var invitation pb.GroupInvitation
+	if err := db.conn.First(&invitation, invitationID).Error; err != nil {
+		return nil, err
+	}
+	return &invitation, nil
+}
+
+// GetGroupInvitations returns all group invitations matching the given query, oldest first.
+// If statuses are provided, only invitations with one of the given statuses are returned.
+func (db *GormDB) GetGroupInvitations(query *pb.GroupInvitation, statuses ...pb.GroupInvitation_Status) ([]*pb.GroupInvitation, error) {
+	tx := db.conn.Where(query)
+	if len(statuses) > 0 {
+		tx = tx.Where("status IN (?)", statuses)
+	}
+	var invitations []*pb.GroupInvitation
+	if err := tx.Order("id").Find(&invitations).Error; err != nil {
+		return nil, err
+	}
+	return invitations, nil
+}
+
+// UpdateGroupInvitationStatus updates the status of the given group invitation.
+func (db *GormDB) UpdateGroupInvitationStatus(invitation *pb.GroupInvitation) error {
+	return db.conn.Model(&pb.GroupInvitation{ID: invitation.GetID()}).
+		Update("status", invitation.GetStatus()).Error
+}
@@ -0,0 +1,63 @@
+package database
+
+import (
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+)
+
+/// Notifications ///
+
+// CreateNotifications creates the given notifications.
+func (db *GormDB) CreateNotifications(notifications []*pb.Notification) error {
+	if len(notifications) == 0 {
+		return nil
+	}
+	created := time.Now().Format(pb.TimeLayout)
+	for _, notification := range notifications {
+		if notification.Created == "" {
+			notification.Created = created
+		}
+	}
+	return db.conn.Create(notifications).Error
+}
+
+// GetNotifications returns at most limit notifications of the given user, newest first.
+// If unreadOnly is true, only notifications that have not been read are returned.
+func (db *GormDB) GetNotifications(userID uint64, unreadOnly bool, limit int) ([]*pb.Notification, error) {
+	var notifications []*pb.Notification
+	tx := db.conn.Where(&pb.Notification{UserID: userID})
+	if unreadOnly {
+		tx = tx.Where("read = ?", false)
+	}
+	if err := tx.Order("id desc").Limit(limit).Find(&notifications).Error; err != nil {
+		return nil, err
+	}
+	return notifications, nil
+}
+
+// HasNotification returns true if a notification with the given subject
+// has been created for the given course.
+func (db *GormDB) HasNotification(courseID uint64, subject string) (bool, error) {
+	var count int64
+	if err := db.conn.Model(&pb.Notification{}).
+		Where(&pb.Notification{CourseID: courseID, Subject: subject}).
+		Count(&count).Error; err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// MarkNotificationsRead marks the notification with the given ID belonging to the given user as read.
+// If notificationID is zero, all the user's notifications are marked as read.
+func (db *GormDB) MarkNotificationsRead(userID, notificationID uint64) error {
+	if notificationID > 0 {
+		var notification pb.Notification
+		if err := db.conn.Where(&pb.Notification{ID: notificationID, UserID: userID}).First(&notification).Error; err != nil {
+			return err
+		}
+	}
+	return db.conn.Model(&pb.Notification{}).
+		Where(&pb.Notification{ID: notificationID, UserID: userID}).
+		Update("read", true).Error
+}
@@ -0,0 +1,48 @@
+package database
+
+import (
+	pb "github.com/autograde/quickfeed/ag"
+)
+
+/// Peer reviews ///
+
+// CreatePeerReviews records the given peer reviews.
+func (db *GormDB) CreatePeerReviews(reviews []*pb.PeerReview) error {
+	if len(reviews) == 0 {
+		return nil
+	}
+	return db.conn.Create(&reviews).Error
+}
+
+// GetPeerReview returns the peer review matching the given query.
+func (db *GormDB) GetPeerReview(query *pb.PeerReview) (*pb.PeerReview, error) {
+	var review pb.PeerReview
+	if err := db.conn.Where(query).First(&review).Error; err != nil {
+		return nil, err
+	}
+	return &review, nil
+}
+
+// GetPeerReviews returns all peer reviews matching the given query.
+func (db *GormDB) GetPeerReviews(query *pb.PeerReview) ([]*pb.PeerReview, error) {
+	var reviews []*pb.PeerReview
+	if err := db.conn.Where(query).
+		Order("submission_id, id").
+		Find(&reviews).Error; err != nil {
+		return nil, err
+	}
+	return reviews, nil
+}
+
+// UpdatePeerReview updates the given peer review.
+func (db *GormDB) UpdatePeerReview(review *pb.PeerReview) error {
+	return db.conn.Save(review).Error
+}
+
+// ReleasePeerReviews makes the submitted peer reviews of the given assignment visible to the submissions' authors.
+func (db *GormDB) ReleasePeerReviews(assignmentID uint64) error {
+	return db.conn.Model(&pb.PeerReview{}).
+		Where("assignment_id = ?", assignmentID).
+		Where("submitted = ?", true).
+		Update("released", true).Error
+}
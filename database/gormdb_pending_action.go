@@ -0,0 +1,38 @@
+package database
+
+import (
+	pb "github.com/autograde/quickfeed/ag"
+)
+
+/// Pending Actions ///
+
+// CreatePendingAction records a destructive action awaiting confirmation.
+func (db *GormDB) CreatePendingAction(action *pb.PendingAction) error {
+	if action.GetCourseID() == 0 || action.GetRequestedByID() == 0 {
+		return ErrInvalidPendingAction
+	}
+	return db.conn.Create(action).Error
+}
+
+// GetPendingAction returns the pending action with the given ID.
+func (db *GormDB) GetPendingAction(actionID uint64) (*pb.PendingAction, error) {
+	var action pb.PendingAction
+	if err := db.conn.First(&action, actionID).Error; err != nil {
+		return nil, err
+	}
+	return &action, nil
+}
+
+// GetPendingActions returns all pending actions, oldest first.
+func (db *GormDB) GetPendingActions() ([]*pb.PendingAction, error) {
+	var actions []*pb.PendingAction
+	if err := db.conn.Order("id").Find(&actions).Error; err != nil {
+		return nil, err
+	}
+	return actions, nil
+}
+
+// DeletePendingAction deletes the pending action with the given ID.
+func (db *GormDB) DeletePendingAction(actionID uint64) error {
+	return db.conn.Delete(&pb.PendingAction{}, actionID).Error
+}
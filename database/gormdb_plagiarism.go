@@ -0,0 +1,32 @@
+package database
+
+import (
+	pb "github.com/autograde/quickfeed/ag"
+	"gorm.io/gorm"
+)
+
+/// Plagiarism ///
+
+// UpdatePlagiarismResults replaces the plagiarism results of the given assignment.
+func (db *GormDB) UpdatePlagiarismResults(assignmentID uint64, results []*pb.PlagiarismResult) error {
+	return db.conn.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("assignment_id = ?", assignmentID).Delete(&pb.PlagiarismResult{}).Error; err != nil {
+			return err
+		}
+		if len(results) == 0 {
+			return nil
+		}
+		return tx.Create(&results).Error
+	})
+}
+
+// GetPlagiarismResults returns the plagiarism results matching the given query, most similar first.
+func (db *GormDB) GetPlagiarismResults(query *pb.PlagiarismResult) ([]*pb.PlagiarismResult, error) {
+	var results []*pb.PlagiarismResult
+	if err := db.conn.Where(query).
+		Order("similarity desc, id").
+		Find(&results).Error; err != nil {
+		return nil, err
+	}
+	return results, nil
+}
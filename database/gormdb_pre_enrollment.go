@@ -0,0 +1,104 @@
+package database
+
+import (
+	"errors"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"gorm.io/gorm"
+)
+
+/// Pre-enrollments ///
+
+// GetUserByLoginOrEmail returns the user with the given login, or if no such user exists,
+// the user with the given email. Logins and emails are matched case-insensitively.
+func (db *GormDB) GetUserByLoginOrEmail(login, email string) (*pb.User, error) {
+	var user pb.User
+	if login != "" {
+		err := db.conn.Where("LOWER(login) = LOWER(?)", login).First(&user).Error
+		if err == nil || !errors.Is(err, gorm.ErrRecordNotFound) {
+			return &user, err
+		}
+	}
+	if email != "" {
+		if err := db.conn.Where("LOWER(email) = LOWER(?)", email).First(&user).Error; err != nil {
+			return nil, err
+		}
+		return &user, nil
+	}
+	return nil, gorm.ErrRecordNotFound
+}
+
+// CreatePreEnrollment creates the given pre-enrollment, or updates the course's
+// pre-enrollment with the same login, or if no login is given, the same email.
+func (db *GormDB) CreatePreEnrollment(preEnrollment *pb.PreEnrollment) error {
+	query := db.conn.Where(&pb.PreEnrollment{CourseID: preEnrollment.GetCourseID()})
+	if preEnrollment.GetLogin() != "" {
+		query = query.Where("LOWER(login) = LOWER(?)", preEnrollment.GetLogin())
+	} else {
+		query = query.Where("login = '' AND LOWER(email) = LOWER(?)", preEnrollment.GetEmail())
+	}
+	var existing pb.PreEnrollment
+	err := query.First(&existing).Error
+	switch {
+	case err == nil:
+		preEnrollment.ID = existing.GetID()
+		if preEnrollment.GetUserID() == 0 {
+			preEnrollment.UserID = existing.GetUserID()
+		}
+		return db.conn.Save(preEnrollment).Error
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return db.conn.Create(preEnrollment).Error
+	}
+	return err
+}
+
+// MatchPreEnrollments creates pending enrollments for the given user in the courses with
+// unmatched pre-enrollments matching the user's login or email, and records the user in
+// the matched pre-enrollments. The user's student ID is set from the pre-enrollment,
+// unless already set. It returns the number of enrollments created.
+func (db *GormDB) MatchPreEnrollments(user *pb.User) (int, error) {
+	var preEnrollments []*pb.PreEnrollment
+	if err := db.conn.Where("user_id = 0 AND ("+matchesUser+")", user.GetLogin(), user.GetEmail()).
+		Find(&preEnrollments).Error; err != nil {
+		return 0, err
+	}
+	enrolled := 0
+	for _, preEnrollment := range preEnrollments {
+		_, err := db.GetEnrollmentByCourseAndUser(preEnrollment.GetCourseID(), user.GetID())
+		switch {
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			if err := db.CreateEnrollment(&pb.Enrollment{CourseID: preEnrollment.GetCourseID(), UserID: user.GetID()}); err != nil {
+				return enrolled, err
+			}
+			enrolled++
+		case err != nil:
+			return enrolled, err
+		}
+		if user.GetStudentID() == "" && preEnrollment.GetStudentID() != "" {
+			user.StudentID = preEnrollment.GetStudentID()
+			if err := db.UpdateUser(user); err != nil {
+				return enrolled, err
+			}
+		}
+		if err := db.conn.Model(preEnrollment).Update("user_id", user.GetID()).Error; err != nil {
+			return enrolled, err
+		}
+	}
+	return enrolled, nil
+}
+
+// IsPreEnrolled returns true if the given user is in the roster of the given course,
+// that is, if a pre-enrollment in the course is matched with the user or
+// has the user's login or email.
+func (db *GormDB) IsPreEnrolled(courseID uint64, user *pb.User) (bool, error) {
+	var count int64
+	if err := db.conn.Model(&pb.PreEnrollment{}).
+		Where("course_id = ? AND (user_id = ? OR "+matchesUser+")", courseID, user.GetID(), user.GetLogin(), user.GetEmail()).
+		Count(&count).Error; err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// matchesUser is the condition matching pre-enrollments with a given login or email.
+const matchesUser = "(login <> '' AND LOWER(login) = LOWER(?)) OR (email <> '' AND LOWER(email) = LOWER(?))"
@@ -0,0 +1,60 @@
+package database_test
+
+import (
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/internal/qtest"
+)
+
+func TestMatchPreEnrollments(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	admin := qtest.CreateUser(t, db, 1, &pb.User{Login: "admin"})
+	course1 := &pb.Course{Code: "DAT100", OrganizationID: 1}
+	course2 := &pb.Course{Code: "DAT200", OrganizationID: 2}
+	qtest.CreateCourse(t, db, admin, course1)
+	qtest.CreateCourse(t, db, admin, course2)
+
+	for _, preEnrollment := range []*pb.PreEnrollment{
+		{CourseID: course1.ID, StudentID: "123456", Login: "Student"},
+		{CourseID: course1.ID, StudentID: "654321", Login: "student"}, // updates the pre-enrollment above
+		{CourseID: course2.ID, Email: "student@example.com"},
+		{CourseID: course2.ID, Login: "other"},
+	} {
+		if err := db.CreatePreEnrollment(preEnrollment); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	user := qtest.CreateUser(t, db, 2, &pb.User{Login: "student", Email: "Student@example.com"})
+	enrolled, err := db.MatchPreEnrollments(user)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if enrolled != 2 {
+		t.Errorf("MatchPreEnrollments() = %d, want 2", enrolled)
+	}
+	for _, course := range []*pb.Course{course1, course2} {
+		enrollment, err := db.GetEnrollmentByCourseAndUser(course.ID, user.ID)
+		if err != nil {
+			t.Fatalf("expected enrollment in course %s: %v", course.Code, err)
+		}
+		if enrollment.Status != pb.Enrollment_PENDING {
+			t.Errorf("enrollment in course %s has status %v, want %v", course.Code, enrollment.Status, pb.Enrollment_PENDING)
+		}
+	}
+	gotUser, err := db.GetUser(user.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if gotUser.StudentID != "654321" {
+		t.Errorf("StudentID = %q, want %q", gotUser.StudentID, "654321")
+	}
+
+	// matched pre-enrollments are not matched again
+	if enrolled, err := db.MatchPreEnrollments(user); err != nil || enrolled != 0 {
+		t.Errorf("MatchPreEnrollments() again = (%d, %v), want (0, nil)", enrolled, err)
+	}
+}
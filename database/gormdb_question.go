@@ -0,0 +1,109 @@
+package database
+
+import (
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"gorm.io/gorm"
+)
+
+/// Questions and answers ///
+
+// CreateQuestion creates a new question about an assignment.
+func (db *GormDB) CreateQuestion(question *pb.Question) error {
+	var assignment int64
+	if err := db.conn.Model(&pb.Assignment{}).Where(&pb.Assignment{
+		ID: question.AssignmentID,
+	}).Count(&assignment).Error; err != nil {
+		return err
+	}
+	if assignment != 1 {
+		return gorm.ErrRecordNotFound
+	}
+	question.Created = time.Now().Format(pb.TimeLayout)
+	return db.conn.Create(question).Error
+}
+
+// UpdateQuestion updates the title, body and accepted answer of the given question.
+func (db *GormDB) UpdateQuestion(question *pb.Question) error {
+	// Select is needed to also update the accepted answer when unset (zero value).
+	return db.conn.Model(&pb.Question{ID: question.GetID()}).
+		Select("title", "body", "accepted_answer_id", "updated").
+		Updates(&pb.Question{
+			Title:            question.GetTitle(),
+			Body:             question.GetBody(),
+			AcceptedAnswerID: question.GetAcceptedAnswerID(),
+			Updated:          time.Now().Format(pb.TimeLayout),
+		}).Error
+}
+
+// DeleteQuestion deletes the question with the given ID and its answers.
+func (db *GormDB) DeleteQuestion(questionID uint64) error {
+	return db.conn.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("question_id = ?", questionID).Delete(&pb.Answer{}).Error; err != nil {
+			return err // will rollback transaction
+		}
+		return tx.Delete(&pb.Question{}, questionID).Error
+	})
+}
+
+// GetQuestion returns the question with the given ID with preloaded answers.
+func (db *GormDB) GetQuestion(questionID uint64) (*pb.Question, error) {
+	var question pb.Question
+	if err := db.conn.Preload("Answers").First(&question, questionID).Error; err != nil {
+		return nil, err
+	}
+	return &question, nil
+}
+
+// GetQuestions returns all questions for the given assignment with preloaded answers.
+func (db *GormDB) GetQuestions(assignmentID uint64) ([]*pb.Question, error) {
+	var questions []*pb.Question
+	if err := db.conn.Preload("Answers").
+		Where(&pb.Question{AssignmentID: assignmentID}).
+		Find(&questions).Error; err != nil {
+		return nil, err
+	}
+	return questions, nil
+}
+
+// CreateAnswer creates a new answer to a question.
+func (db *GormDB) CreateAnswer(answer *pb.Answer) error {
+	var question int64
+	if err := db.conn.Model(&pb.Question{}).Where(&pb.Question{
+		ID: answer.QuestionID,
+	}).Count(&question).Error; err != nil {
+		return err
+	}
+	if question != 1 {
+		return gorm.ErrRecordNotFound
+	}
+	answer.Created = time.Now().Format(pb.TimeLayout)
+	return db.conn.Create(answer).Error
+}
+
+// UpdateAnswer updates the body and endorsement of the given answer.
+func (db *GormDB) UpdateAnswer(answer *pb.Answer) error {
+	// Select is needed to also update the endorsed flag when false (zero value).
+	return db.conn.Model(&pb.Answer{ID: answer.GetID()}).
+		Select("body", "endorsed", "updated").
+		Updates(&pb.Answer{
+			Body:     answer.GetBody(),
+			Endorsed: answer.GetEndorsed(),
+			Updated:  time.Now().Format(pb.TimeLayout),
+		}).Error
+}
+
+// DeleteAnswer deletes the answer with the given ID.
+func (db *GormDB) DeleteAnswer(answerID uint64) error {
+	return db.conn.Delete(&pb.Answer{}, answerID).Error
+}
+
+// GetAnswer returns the answer with the given ID.
+func (db *GormDB) GetAnswer(answerID uint64) (*pb.Answer, error) {
+	var answer pb.Answer
+	if err := db.conn.First(&answer, answerID).Error; err != nil {
+		return nil, err
+	}
+	return &answer, nil
+}
@@ -100,3 +100,41 @@ func updateSubmission(t *testing.T, db database.Database, wantReview *pb.Review)
 		t.Errorf("Expected same review, but got (-got +want):\n%s", diff)
 	}
 }
+
+func TestReleaseReviews(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	user, _, assignment := setupCourseAssignment(t, db)
+	submission := &pb.Submission{AssignmentID: assignment.ID, UserID: user.ID}
+	if err := db.CreateSubmission(submission); err != nil {
+		t.Fatal(err)
+	}
+	ready := &pb.Review{SubmissionID: submission.ID, ReviewerID: 1, Ready: true, Score: 80}
+	draft := &pb.Review{SubmissionID: submission.ID, ReviewerID: 2, Score: 50}
+	for _, review := range []*pb.Review{ready, draft} {
+		if err := db.CreateReview(review); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := db.ReleaseReviews(assignment.ID); err != nil {
+		t.Fatal(err)
+	}
+	// updating a released review keeps it released
+	ready.Feedback = "updated feedback"
+	if err := db.UpdateReview(ready); err != nil {
+		t.Fatal(err)
+	}
+	got, err := db.GetSubmission(&pb.Submission{ID: submission.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	released := make(map[uint64]bool)
+	for _, review := range got.GetReviews() {
+		released[review.GetID()] = review.GetReleased()
+	}
+	if !released[ready.ID] || released[draft.ID] {
+		t.Errorf("released reviews = %v, want only the ready review %d released", released, ready.ID)
+	}
+}
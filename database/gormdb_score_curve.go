@@ -0,0 +1,51 @@
+package database
+
+import (
+	pb "github.com/autograde/quickfeed/ag"
+	"gorm.io/gorm"
+)
+
+/// Score curves ///
+
+// GetScoreCurve returns the score curve of the given assignment.
+func (db *GormDB) GetScoreCurve(assignmentID uint64) (*pb.ScoreCurve, error) {
+	var curve pb.ScoreCurve
+	if err := db.conn.Where(&pb.ScoreCurve{AssignmentID: assignmentID}).First(&curve).Error; err != nil {
+		return nil, err
+	}
+	return &curve, nil
+}
+
+// ApplyScoreCurve replaces the score curve of the curve's assignment and records the adjusted
+// scores, keyed by submission ID. Submissions of the assignment without an adjusted score are
+// no longer curved. A curve without a method removes the assignment's curve.
+func (db *GormDB) ApplyScoreCurve(curve *pb.ScoreCurve, adjustedScores map[uint64]uint32) error {
+	if curve.GetCourseID() < 1 || curve.GetAssignmentID() < 1 {
+		return ErrInvalidScoreCurve
+	}
+	return db.conn.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("assignment_id = ?", curve.GetAssignmentID()).Delete(&pb.ScoreCurve{}).Error; err != nil {
+			return err // will rollback transaction
+		}
+		if err := tx.Model(&pb.Submission{}).
+			Where("assignment_id = ?", curve.GetAssignmentID()).
+			Updates(map[string]interface{}{"adjusted_score": 0, "curved": false}).Error; err != nil {
+			return err // will rollback transaction
+		}
+		if curve.GetMethod() == pb.ScoreCurve_NONE {
+			return nil
+		}
+		curve.ID = 0
+		if err := tx.Create(curve).Error; err != nil {
+			return err // will rollback transaction
+		}
+		for submissionID, score := range adjustedScores {
+			if err := tx.Model(&pb.Submission{}).
+				Where("id = ? AND assignment_id = ?", submissionID, curve.GetAssignmentID()).
+				Updates(map[string]interface{}{"adjusted_score": score, "curved": true}).Error; err != nil {
+				return err // will rollback transaction
+			}
+		}
+		return nil // will commit transaction
+	})
+}
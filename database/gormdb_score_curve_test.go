@@ -0,0 +1,60 @@
+package database_test
+
+import (
+	"errors"
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/internal/qtest"
+	"gorm.io/gorm"
+)
+
+func TestApplyScoreCurve(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	user, course, assignment := setupCourseAssignment(t, db)
+	submission := &pb.Submission{AssignmentID: assignment.ID, UserID: user.ID, Score: 50}
+	if err := db.CreateSubmission(submission); err != nil {
+		t.Fatal(err)
+	}
+	curved := func() *pb.Submission {
+		t.Helper()
+		got, err := db.GetSubmission(&pb.Submission{ID: submission.ID})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return got
+	}
+
+	curve := &pb.ScoreCurve{CourseID: course.ID, AssignmentID: assignment.ID, Method: pb.ScoreCurve_LINEAR, Slope: 1, Offset: 10}
+	if err := db.ApplyScoreCurve(curve, map[uint64]uint32{submission.ID: 60}); err != nil {
+		t.Fatal(err)
+	}
+	// applying the curve again replaces it
+	curve.Offset = 20
+	if err := db.ApplyScoreCurve(curve, map[uint64]uint32{submission.ID: 70}); err != nil {
+		t.Fatal(err)
+	}
+	got, err := db.GetScoreCurve(assignment.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.GetOffset() != 20 {
+		t.Errorf("GetScoreCurve() offset = %v, want 20", got.GetOffset())
+	}
+	if sub := curved(); !sub.GetCurved() || sub.GetAdjustedScore() != 70 || sub.GetScore() != 50 {
+		t.Errorf("curved submission = (%t, %d, %d), want (true, 70, 50)", sub.GetCurved(), sub.GetAdjustedScore(), sub.GetScore())
+	}
+
+	// a curve without a method removes the curve
+	if err := db.ApplyScoreCurve(&pb.ScoreCurve{CourseID: course.ID, AssignmentID: assignment.ID}, nil); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.GetScoreCurve(assignment.ID); !errors.Is(err, gorm.ErrRecordNotFound) {
+		t.Errorf("GetScoreCurve() after removal: got %v, want %v", err, gorm.ErrRecordNotFound)
+	}
+	if sub := curved(); sub.GetCurved() || sub.CurvedScore() != 50 {
+		t.Errorf("submission after removal = (%t, %d), want (false, 50)", sub.GetCurved(), sub.CurvedScore())
+	}
+}
@@ -0,0 +1,56 @@
+package database
+
+import (
+	pb "github.com/autograde/quickfeed/ag"
+	"gorm.io/gorm"
+)
+
+/// Sessions ///
+
+// CreateSession records a new session of a user.
+func (db *GormDB) CreateSession(session *pb.Session) error {
+	if session.GetUserID() == 0 || session.GetHash() == "" {
+		return ErrInvalidSession
+	}
+	return db.conn.Create(session).Error
+}
+
+// GetSession returns the session with the given hash.
+func (db *GormDB) GetSession(hash string) (*pb.Session, error) {
+	var session pb.Session
+	if err := db.conn.Where(&pb.Session{Hash: hash}).First(&session).Error; err != nil {
+		return nil, err
+	}
+	return &session, nil
+}
+
+// GetSessions returns the sessions of the given user, oldest first.
+func (db *GormDB) GetSessions(userID uint64) ([]*pb.Session, error) {
+	var sessions []*pb.Session
+	if err := db.conn.Where(&pb.Session{UserID: userID}).Order("id").Find(&sessions).Error; err != nil {
+		return nil, err
+	}
+	return sessions, nil
+}
+
+// UpdateSessionLastSeen records the time of the last request in the session with the given ID.
+func (db *GormDB) UpdateSessionLastSeen(sessionID uint64, lastSeen string) error {
+	return db.conn.Model(&pb.Session{ID: sessionID}).Update("last_seen", lastSeen).Error
+}
+
+// DeleteSession deletes the session with the given ID belonging to the given user.
+func (db *GormDB) DeleteSession(userID, sessionID uint64) error {
+	query := db.conn.Where(&pb.Session{ID: sessionID, UserID: userID}).Delete(&pb.Session{})
+	if query.Error != nil {
+		return query.Error
+	}
+	if query.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// DeleteSessions deletes all sessions of the given user.
+func (db *GormDB) DeleteSessions(userID uint64) error {
+	return db.conn.Where("user_id = ?", userID).Delete(&pb.Session{}).Error
+}
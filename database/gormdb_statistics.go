@@ -0,0 +1,163 @@
+package database
+
+import (
+	"fmt"
+	"strings"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/kit/score"
+	"gorm.io/gorm/clause"
+)
+
+// scoreBuckets is the number of buckets in the score distribution of an assignment;
+// each bucket holds ten percentage points, except the last, which also holds 100.
+const scoreBuckets = 10
+
+// scoreBucket is an SQL expression for the bucket of a submission's score; integer
+// division is avoided, since its result type differs between the supported databases.
+var scoreBucket = func() string {
+	var b strings.Builder
+	b.WriteString("CASE")
+	for i := 1; i < scoreBuckets; i++ {
+		fmt.Fprintf(&b, " WHEN score < %d THEN %d", i*100/scoreBuckets, i-1)
+	}
+	fmt.Fprintf(&b, " ELSE %d END", scoreBuckets-1)
+	return b.String()
+}()
+
+// GetCourseStatistics returns aggregates of the submissions to each of the course's assignments.
+// The aggregates are computed by the database, and only count the latest submission of each student
+// and group to an assignment, except the number of submissions per day, which counts all submissions.
+func (db *GormDB) GetCourseStatistics(courseID uint64) (*pb.CourseStatistics, error) {
+	var assignments []*pb.Assignment
+	if err := db.conn.Select("id", "name").
+		Where(&pb.Assignment{CourseID: courseID}).
+		Order(clause.OrderByColumn{Column: clause.Column{Name: "order"}}).
+		Find(&assignments).Error; err != nil {
+		return nil, err
+	}
+	assignmentIDs := db.conn.Model(&pb.Assignment{}).Select("id").Where("course_id = ?", courseID)
+	latest := db.conn.Model(&pb.Submission{}).Select("MAX(id)").
+		Where("assignment_id IN (?)", assignmentIDs).
+		Group("assignment_id, user_id, group_id")
+
+	var totals []struct {
+		AssignmentID uint64
+		Submissions  uint32
+		Approved     uint32
+		AverageScore float64
+	}
+	if err := db.conn.Model(&pb.Submission{}).
+		Select("assignment_id, COUNT(*) AS submissions, SUM(CASE WHEN status = ? THEN 1 ELSE 0 END) AS approved, AVG(score) AS average_score", pb.Submission_APPROVED).
+		Where("id IN (?)", latest).
+		Group("assignment_id").
+		Scan(&totals).Error; err != nil {
+		return nil, err
+	}
+	var buckets []struct {
+		AssignmentID uint64
+		Bucket       int
+		Count        uint32
+	}
+	if err := db.conn.Model(&pb.Submission{}).
+		Select("assignment_id, "+scoreBucket+" AS bucket, COUNT(*) AS count").
+		Where("id IN (?)", latest).
+		Group("assignment_id, bucket").
+		Scan(&buckets).Error; err != nil {
+		return nil, err
+	}
+	var days []struct {
+		AssignmentID uint64
+		Day          string
+		Count        uint32
+	}
+	// build dates are stored as text in the pb.TimeLayout format, starting with the date
+	if err := db.conn.Model(&score.BuildInfo{}).
+		Select("submissions.assignment_id, SUBSTR(build_infos.build_date, 1, 10) AS day, COUNT(*) AS count").
+		Joins("JOIN submissions ON submissions.id = build_infos.submission_id").
+		Where("submissions.assignment_id IN (?)", assignmentIDs).
+		Group("submissions.assignment_id, day").
+		Order("day").
+		Scan(&days).Error; err != nil {
+		return nil, err
+	}
+
+	stats := make(map[uint64]*pb.AssignmentStatistics)
+	statistics := &pb.CourseStatistics{CourseID: courseID}
+	for _, assignment := range assignments {
+		stats[assignment.GetID()] = &pb.AssignmentStatistics{
+			AssignmentID:      assignment.GetID(),
+			Name:              assignment.GetName(),
+			ScoreDistribution: make([]uint32, scoreBuckets),
+		}
+		statistics.Assignments = append(statistics.Assignments, stats[assignment.GetID()])
+	}
+	for _, total := range totals {
+		if s, ok := stats[total.AssignmentID]; ok {
+			s.Submissions = total.Submissions
+			s.Approved = total.Approved
+			s.AverageScore = total.AverageScore
+			if total.Submissions > 0 {
+				s.PassRate = float64(total.Approved) / float64(total.Submissions) * 100
+			}
+		}
+	}
+	for _, bucket := range buckets {
+		if s, ok := stats[bucket.AssignmentID]; ok && bucket.Bucket >= 0 && bucket.Bucket < scoreBuckets {
+			s.ScoreDistribution[bucket.Bucket] = bucket.Count
+		}
+	}
+	for _, day := range days {
+		if s, ok := stats[day.AssignmentID]; ok && day.Day != "" {
+			s.SubmissionsPerDay = append(s.SubmissionsPerDay, &pb.DailySubmissions{Date: day.Day, Count: day.Count})
+		}
+	}
+	return statistics, nil
+}
+
+// GetTestFailureStats returns the number of times each test of the given assignment passed, failed and
+// was skipped, counting only the test results of the latest submission of each student and group.
+// The tests are ordered by decreasing number of failures, and then by name.
+func (db *GormDB) GetTestFailureStats(assignmentID uint64) (*pb.TestFailureStats, error) {
+	latest := db.conn.Model(&pb.Submission{}).Select("MAX(id)").
+		Where("assignment_id = ?", assignmentID).
+		Group("user_id, group_id")
+
+	var tests []struct {
+		Name    string
+		Passed  uint32
+		Failed  uint32
+		Skipped uint32
+	}
+	if err := db.conn.Model(&pb.TestResult{}).
+		Select("name, SUM(CASE WHEN status = ? THEN 1 ELSE 0 END) AS passed, SUM(CASE WHEN status = ? THEN 1 ELSE 0 END) AS failed, SUM(CASE WHEN status = ? THEN 1 ELSE 0 END) AS skipped",
+			pb.TestResult_PASSED, pb.TestResult_FAILED, pb.TestResult_SKIPPED).
+		Where("submission_id IN (?)", latest).
+		Group("name").
+		Order("failed DESC, name").
+		Scan(&tests).Error; err != nil {
+		return nil, err
+	}
+	var submissions int64
+	if err := db.conn.Model(&pb.TestResult{}).
+		Distinct("submission_id").
+		Where("submission_id IN (?)", latest).
+		Count(&submissions).Error; err != nil {
+		return nil, err
+	}
+
+	stats := &pb.TestFailureStats{AssignmentID: assignmentID, Submissions: uint32(submissions)}
+	for _, test := range tests {
+		testStats := &pb.TestStatistics{
+			Name:    test.Name,
+			Passed:  test.Passed,
+			Failed:  test.Failed,
+			Skipped: test.Skipped,
+		}
+		if runs := test.Passed + test.Failed; runs > 0 {
+			testStats.FailureRate = float64(test.Failed) / float64(runs) * 100
+		}
+		stats.Tests = append(stats.Tests, testStats)
+	}
+	return stats, nil
+}
@@ -0,0 +1,146 @@
+package database_test
+
+import (
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/internal/qtest"
+	"github.com/autograde/quickfeed/kit/score"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func TestGormDBGetCourseStatistics(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	teacher := qtest.CreateFakeUser(t, db, 1)
+	course := &pb.Course{OrganizationID: 1}
+	qtest.CreateCourse(t, db, teacher, course)
+	var students []*pb.User
+	for i := uint64(2); i <= 4; i++ {
+		student := qtest.CreateFakeUser(t, db, i)
+		qtest.EnrollStudent(t, db, student, course)
+		students = append(students, student)
+	}
+	// created out of order to check that the statistics follow the assignment order
+	lab2 := &pb.Assignment{CourseID: course.ID, Name: "lab2", Order: 2}
+	lab1 := &pb.Assignment{CourseID: course.ID, Name: "lab1", Order: 1}
+	for _, lab := range []*pb.Assignment{lab2, lab1} {
+		if err := db.CreateAssignment(lab); err != nil {
+			t.Fatal(err)
+		}
+	}
+	otherCourse := &pb.Course{OrganizationID: 2}
+	qtest.CreateCourse(t, db, teacher, otherCourse)
+	otherLab := &pb.Assignment{CourseID: otherCourse.ID, Name: "lab1", Order: 1}
+	if err := db.CreateAssignment(otherLab); err != nil {
+		t.Fatal(err)
+	}
+
+	build := func(date string) *score.BuildInfo {
+		return &score.BuildInfo{BuildDate: date}
+	}
+	submissions := []*pb.Submission{
+		// only the latest submission of the first student counts for the aggregates
+		{AssignmentID: lab1.ID, UserID: students[0].ID, Score: 40, BuildInfo: build("2022-09-01T10:00:00")},
+		{AssignmentID: lab1.ID, UserID: students[0].ID, Score: 100, Status: pb.Submission_APPROVED, BuildInfo: build("2022-09-02T10:00:00")},
+		{AssignmentID: lab1.ID, UserID: students[1].ID, Score: 95, Status: pb.Submission_APPROVED, BuildInfo: build("2022-09-02T23:59:59")},
+		{AssignmentID: lab1.ID, UserID: students[2].ID, Score: 9, BuildInfo: build("2022-09-01T08:00:00")},
+		{AssignmentID: otherLab.ID, UserID: teacher.ID, Score: 50, BuildInfo: build("2022-09-01T08:00:00")},
+	}
+	for _, submission := range submissions {
+		if err := db.CreateSubmission(submission); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	got, err := db.GetCourseStatistics(course.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &pb.CourseStatistics{
+		CourseID: course.ID,
+		Assignments: []*pb.AssignmentStatistics{
+			{
+				AssignmentID:      lab1.ID,
+				Name:              "lab1",
+				Submissions:       3,
+				Approved:          2,
+				PassRate:          float64(2) / 3 * 100,
+				AverageScore:      float64(100+95+9) / 3,
+				ScoreDistribution: []uint32{1, 0, 0, 0, 0, 0, 0, 0, 0, 2},
+				SubmissionsPerDay: []*pb.DailySubmissions{
+					{Date: "2022-09-01", Count: 2},
+					{Date: "2022-09-02", Count: 2},
+				},
+			},
+			{
+				AssignmentID:      lab2.ID,
+				Name:              "lab2",
+				ScoreDistribution: make([]uint32, 10),
+			},
+		},
+	}
+	if diff := cmp.Diff(want, got, protocmp.Transform()); diff != "" {
+		t.Errorf("GetCourseStatistics() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestGormDBGetTestFailureStats(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	teacher := qtest.CreateFakeUser(t, db, 1)
+	course := &pb.Course{OrganizationID: 1}
+	qtest.CreateCourse(t, db, teacher, course)
+	student1 := qtest.CreateFakeUser(t, db, 2)
+	student2 := qtest.CreateFakeUser(t, db, 3)
+	lab := &pb.Assignment{CourseID: course.ID, Name: "lab1", Order: 1}
+	if err := db.CreateAssignment(lab); err != nil {
+		t.Fatal(err)
+	}
+
+	results := func(statuses ...pb.TestResult_Status) []*pb.TestResult {
+		names := []string{"TestAdd", "TestSub", "TestMul"}
+		var results []*pb.TestResult
+		for i, status := range statuses {
+			results = append(results, &pb.TestResult{Name: names[i], Status: status})
+		}
+		return results
+	}
+	submissions := []struct {
+		submission *pb.Submission
+		results    []*pb.TestResult
+	}{
+		// only the latest submission of the first student counts
+		{&pb.Submission{AssignmentID: lab.ID, UserID: student1.ID}, results(pb.TestResult_FAILED, pb.TestResult_FAILED, pb.TestResult_FAILED)},
+		{&pb.Submission{AssignmentID: lab.ID, UserID: student1.ID}, results(pb.TestResult_PASSED, pb.TestResult_FAILED, pb.TestResult_SKIPPED)},
+		{&pb.Submission{AssignmentID: lab.ID, UserID: student2.ID}, results(pb.TestResult_PASSED, pb.TestResult_FAILED, pb.TestResult_FAILED)},
+	}
+	for _, s := range submissions {
+		if err := db.CreateSubmission(s.submission); err != nil {
+			t.Fatal(err)
+		}
+		if err := db.UpdateTestResults(s.submission.ID, s.results); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	got, err := db.GetTestFailureStats(lab.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &pb.TestFailureStats{
+		AssignmentID: lab.ID,
+		Submissions:  2,
+		Tests: []*pb.TestStatistics{
+			{Name: "TestSub", Failed: 2, FailureRate: 100},
+			{Name: "TestMul", Failed: 1, Skipped: 1, FailureRate: 100},
+			{Name: "TestAdd", Passed: 2},
+		},
+	}
+	if diff := cmp.Diff(want, got, protocmp.Transform()); diff != "" {
+		t.Errorf("GetTestFailureStats() mismatch (-want +got):\n%s", diff)
+	}
+}
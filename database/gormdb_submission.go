@@ -3,6 +3,7 @@ package database
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	pb "github.com/autograde/quickfeed/ag"
 	"github.com/autograde/quickfeed/kit/score"
@@ -52,12 +53,24 @@ func (db *GormDB) CreateSubmission(submission *pb.Submission) error {
 			if err := tx.Where("submission_id = ?", submission.ID).Delete(&score.BuildInfo{}).Error; err != nil {
 				return err // will rollback transaction
 			}
+			if err := tx.Where("submission_id = ?", submission.ID).Delete(&pb.PolicyViolation{}).Error; err != nil {
+				return err // will rollback transaction
+			}
+			if err := tx.Where("submission_id = ?", submission.ID).Delete(&pb.FileCoverage{}).Error; err != nil {
+				return err // will rollback transaction
+			}
 			if submission.BuildInfo != nil {
 				submission.BuildInfo.SubmissionID = submission.ID
 			}
 			for _, sc := range submission.Scores {
 				sc.SubmissionID = submission.ID
 			}
+			for _, violation := range submission.PolicyViolations {
+				violation.SubmissionID = submission.ID
+			}
+			for _, file := range submission.FileCoverage {
+				file.SubmissionID = submission.ID
+			}
 		}
 		if err := tx.Save(submission).Error; err != nil {
 			return err // will rollback transaction
@@ -118,6 +131,10 @@ func (db *GormDB) GetSubmission(query *pb.Submission) (*pb.Submission, error) {
 	if err := db.conn.Preload("Reviews").
 		Preload("BuildInfo").
 		Preload("Scores").
+		Preload("PolicyViolations").
+		Preload("FileCoverage", func(db *gorm.DB) *gorm.DB {
+			return db.Order("path")
+		}).
 		Preload("Reviews.GradingBenchmarks").
 		Preload("Reviews.GradingBenchmarks.Criteria").
 		Where(query).Last(&submission).Error; err != nil {
@@ -150,6 +167,48 @@ func (db *GormDB) GetLastSubmissions(courseID uint64, query *pb.Submission) ([]*
 	return latestSubs, nil
 }
 
+// GetLastSubmissionsPage returns the latest submissions matching the given query
+// for the given page of the course's assignments. The page is over assignment IDs.
+func (db *GormDB) GetLastSubmissionsPage(courseID uint64, query *pb.Submission, page *Page) ([]*pb.Submission, error) {
+	var assignments []*pb.Assignment
+	if err := db.conn.Scopes(page.scope("id")).
+		Where("course_id = ?", courseID).
+		Find(&assignments).Error; err != nil {
+		return nil, err
+	}
+	assignments = assignments[:page.trim(len(assignments), func(i int) uint64 { return assignments[i].GetID() })]
+
+	var latestSubs []*pb.Submission
+	for _, a := range assignments {
+		query.AssignmentID = a.GetID()
+		temp, err := db.GetSubmission(query)
+		if err != nil {
+			if err == gorm.ErrRecordNotFound {
+				continue
+			}
+			return nil, err
+		}
+		latestSubs = append(latestSubs, temp)
+	}
+	return latestSubs, nil
+}
+
+// GetLatestSubmissionsByCourse returns the latest submission of each student and group
+// to each of the course's assignments, ordered by assignment.
+func (db *GormDB) GetLatestSubmissionsByCourse(courseID uint64) ([]*pb.Submission, error) {
+	assignments := db.conn.Model(&pb.Assignment{}).Select("id").Where("course_id = ?", courseID)
+	latest := db.conn.Model(&pb.Submission{}).Select("MAX(id)").
+		Where("assignment_id IN (?)", assignments).
+		Group("assignment_id, user_id, group_id")
+	var submissions []*pb.Submission
+	if err := db.conn.Where("id IN (?)", latest).
+		Order("assignment_id, id").
+		Find(&submissions).Error; err != nil {
+		return nil, err
+	}
+	return submissions, nil
+}
+
 // GetSubmissions returns all submissions matching the query.
 func (db *GormDB) GetSubmissions(query *pb.Submission) ([]*pb.Submission, error) {
 	var submissions []*pb.Submission
@@ -167,6 +226,16 @@ func (db *GormDB) UpdateSubmission(query *pb.Submission) error {
 // UpdateSubmissions approves and/or releases all submissions that have score
 // equal or above the provided score for the given assignment ID
 func (db *GormDB) UpdateSubmissions(courseID uint64, query *pb.Submission) error {
+	if query.Released {
+		// record the release date of submissions that have not been released before
+		if err := db.conn.Model(&pb.Submission{}).
+			Where("assignment_id = ?", query.AssignmentID).
+			Where("score >= ?", query.Score).
+			Where("released = ?", false).
+			Update("released_date", time.Now().Format(pb.TimeLayout)).Error; err != nil {
+			return err
+		}
+	}
 	return db.conn.
 		Model(query).
 		Where("assignment_id = ?", query.AssignmentID).
@@ -187,7 +256,8 @@ func (db *GormDB) UpdateReview(query *pb.Review) error {
 	// By default, Gorm will not update zero value fields; such as the Ready bool field.
 	// Therefore we use Select before the Updates call. For additional context, see
 	// https://github.com/quickfeed/quickfeed/issues/569#issuecomment-1013729572
-	return db.conn.Model(&query).Select("*").Updates(&pb.Review{
+	// The released field is only updated by ReleaseReviews.
+	return db.conn.Model(&query).Select("*").Omit("released").Updates(&pb.Review{
 		ID:           query.ID,
 		SubmissionID: query.SubmissionID,
 		Feedback:     query.Feedback,
@@ -198,6 +268,15 @@ func (db *GormDB) UpdateReview(query *pb.Review) error {
 	}).Error
 }
 
+// ReleaseReviews makes the ready reviews of the submissions to the given assignment visible to the submissions' authors.
+func (db *GormDB) ReleaseReviews(assignmentID uint64) error {
+	submissions := db.conn.Model(&pb.Submission{}).Select("id").Where("assignment_id = ?", assignmentID)
+	return db.conn.Model(&pb.Review{}).
+		Where("submission_id IN (?)", submissions).
+		Where("ready = ?", true).
+		Update("released", true).Error
+}
+
 // DeleteReview removes all reviews matching the query
 func (db *GormDB) DeleteReview(query *pb.Review) error {
 	return db.conn.Delete(&pb.Review{}, &query).Error
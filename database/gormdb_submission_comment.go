@@ -0,0 +1,51 @@
+package database
+
+import (
+	pb "github.com/autograde/quickfeed/ag"
+)
+
+/// Submission comments ///
+
+// CreateSubmissionComment creates a new comment on a submission.
+func (db *GormDB) CreateSubmissionComment(comment *pb.SubmissionComment) error {
+	if comment.CourseID < 1 || comment.SubmissionID < 1 || comment.AuthorID < 1 {
+		return ErrInvalidSubmissionComment
+	}
+	return db.conn.Create(comment).Error
+}
+
+// GetSubmissionComment returns the submission comment with the given ID.
+func (db *GormDB) GetSubmissionComment(commentID uint64) (*pb.SubmissionComment, error) {
+	var comment pb.SubmissionComment
+	if err := db.conn.First(&comment, commentID).Error; err != nil {
+		return nil, err
+	}
+	return &comment, nil
+}
+
+// GetSubmissionComments returns all comments on the given submission, oldest first.
+func (db *GormDB) GetSubmissionComments(submissionID uint64) ([]*pb.SubmissionComment, error) {
+	var comments []*pb.SubmissionComment
+	if err := db.conn.Where(&pb.SubmissionComment{SubmissionID: submissionID}).
+		Order("id").
+		Find(&comments).Error; err != nil {
+		return nil, err
+	}
+	return comments, nil
+}
+
+// UpdateSubmissionComment updates the body of the given submission comment.
+func (db *GormDB) UpdateSubmissionComment(comment *pb.SubmissionComment) error {
+	return db.conn.Model(&pb.SubmissionComment{ID: comment.GetID()}).
+		Select("body", "updated", "commit_comment_url").
+		Updates(&pb.SubmissionComment{
+			Body:             comment.GetBody(),
+			Updated:          comment.GetUpdated(),
+			CommitCommentURL: comment.GetCommitCommentURL(),
+		}).Error
+}
+
+// DeleteSubmissionComment deletes the submission comment with the given ID.
+func (db *GormDB) DeleteSubmissionComment(commentID uint64) error {
+	return db.conn.Delete(&pb.SubmissionComment{}, commentID).Error
+}
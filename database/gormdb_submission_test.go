@@ -232,11 +232,13 @@ func TestGormDBInsertSubmissions(t *testing.T) {
 		t.Fatalf("have %d submissions want %d", len(submissions), 1)
 	}
 	want := &pb.Submission{
-		ID:           submissions[0].ID,
-		AssignmentID: assignment.ID,
-		UserID:       user.ID,
-		Reviews:      []*pb.Review{},
-		Scores:       []*score.Score{},
+		ID:               submissions[0].ID,
+		AssignmentID:     assignment.ID,
+		UserID:           user.ID,
+		Reviews:          []*pb.Review{},
+		Scores:           []*score.Score{},
+		PolicyViolations: []*pb.PolicyViolation{},
+		FileCoverage:     []*pb.FileCoverage{},
 	}
 	if !reflect.DeepEqual(submissions[0], want) {
 		t.Errorf("have %#v want %#v", submissions[0], want)
@@ -490,3 +492,99 @@ func TestGormDBCreateUpdateWithBuildInfoAndScores(t *testing.T) {
 		t.Fatal("expected error: record not found")
 	}
 }
+
+func TestGormDBGetLatestSubmissionsByCourse(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+	user, course, assignment := setupCourseAssignment(t, db)
+	user2 := qtest.CreateFakeUser(t, db, 12)
+	lab2 := &pb.Assignment{CourseID: course.ID, Order: 2}
+	if err := db.CreateAssignment(lab2); err != nil {
+		t.Fatal(err)
+	}
+	// submissions to another course's assignment are not included
+	admin, err := db.GetUser(course.CourseCreatorID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	otherCourse := &pb.Course{OrganizationID: 2}
+	qtest.CreateCourse(t, db, admin, otherCourse)
+	otherLab := &pb.Assignment{CourseID: otherCourse.ID, Order: 1}
+	if err := db.CreateAssignment(otherLab); err != nil {
+		t.Fatal(err)
+	}
+
+	submissions := []*pb.Submission{
+		{AssignmentID: assignment.ID, UserID: user.ID, Score: 10},
+		{AssignmentID: assignment.ID, UserID: user.ID, Score: 20},
+		{AssignmentID: lab2.ID, UserID: user.ID, Score: 30},
+		{AssignmentID: lab2.ID, UserID: user.ID, Score: 40},
+		{AssignmentID: lab2.ID, UserID: user2.ID, Score: 50},
+		{AssignmentID: otherLab.ID, UserID: user.ID, Score: 60},
+	}
+	for _, submission := range submissions {
+		if err := db.CreateSubmission(submission); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	latest, err := db.GetLatestSubmissionsByCourse(course.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var scores []uint32
+	for _, submission := range latest {
+		scores = append(scores, submission.GetScore())
+	}
+	if diff := cmp.Diff([]uint32{20, 40, 50}, scores); diff != "" {
+		t.Errorf("GetLatestSubmissionsByCourse() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestGormDBCreateSubmissionWithFileCoverage(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	user, _, assignment := setupCourseAssignment(t, db)
+	submission := &pb.Submission{
+		AssignmentID: assignment.ID,
+		UserID:       user.ID,
+		Coverage:     75,
+		FileCoverage: []*pb.FileCoverage{
+			{Path: "lab1/sub.go", Covered: 1, Total: 2},
+			{Path: "lab1/add.go", Covered: 2, Total: 2},
+		},
+	}
+	if err := db.CreateSubmission(submission); err != nil {
+		t.Fatal(err)
+	}
+	got, err := db.GetSubmission(&pb.Submission{ID: submission.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*pb.FileCoverage{submission.FileCoverage[1], submission.FileCoverage[0]}
+	if diff := cmp.Diff(want, got.GetFileCoverage(), protocmp.Transform()); diff != "" {
+		t.Errorf("GetSubmission() file coverage mismatch (-want +got):\n%s", diff)
+	}
+	if got.GetCoverage() != 75 {
+		t.Errorf("GetSubmission() coverage = %v, want 75", got.GetCoverage())
+	}
+
+	// the coverage of a rebuild replaces the previous coverage
+	rebuild := &pb.Submission{
+		ID:           submission.ID,
+		AssignmentID: assignment.ID,
+		UserID:       user.ID,
+		Coverage:     100,
+		FileCoverage: []*pb.FileCoverage{{Path: "lab1/add.go", Covered: 2, Total: 2}},
+	}
+	if err := db.CreateSubmission(rebuild); err != nil {
+		t.Fatal(err)
+	}
+	if got, err = db.GetSubmission(&pb.Submission{ID: submission.ID}); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(rebuild.FileCoverage, got.GetFileCoverage(), protocmp.Transform()); diff != "" {
+		t.Errorf("GetSubmission() file coverage after rebuild mismatch (-want +got):\n%s", diff)
+	}
+}
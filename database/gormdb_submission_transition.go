@@ -0,0 +1,26 @@
+package database
+
+import (
+	pb "github.com/autograde/quickfeed/ag"
+)
+
+/// Submission transitions ///
+
+// CreateSubmissionTransition records a transition of a submission to a new status.
+func (db *GormDB) CreateSubmissionTransition(transition *pb.SubmissionTransition) error {
+	if transition.SubmissionID < 1 || transition.UserID < 1 {
+		return ErrInvalidSubmissionTransition
+	}
+	return db.conn.Create(transition).Error
+}
+
+// GetSubmissionTransitions returns the transitions of the given submission, oldest first.
+func (db *GormDB) GetSubmissionTransitions(submissionID uint64) ([]*pb.SubmissionTransition, error) {
+	var transitions []*pb.SubmissionTransition
+	if err := db.conn.Where(&pb.SubmissionTransition{SubmissionID: submissionID}).
+		Order("id").
+		Find(&transitions).Error; err != nil {
+		return nil, err
+	}
+	return transitions, nil
+}
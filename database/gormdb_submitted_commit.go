@@ -0,0 +1,27 @@
+package database
+
+import (
+	pb "github.com/autograde/quickfeed/ag"
+)
+
+/// Submitted commits ///
+
+// CreateSubmittedCommit records that the given commit was submitted for the assignment by the
+// repository's owner. Returns ErrDuplicateSubmittedCommit if the commit was already submitted.
+func (db *GormDB) CreateSubmittedCommit(commit *pb.SubmittedCommit) error {
+	if commit.GetAssignmentID() == 0 || commit.GetUserID() == 0 && commit.GetGroupID() == 0 || commit.GetCommitID() == "" {
+		return ErrInvalidSubmittedCommit
+	}
+	if err := db.conn.Create(commit).Error; err != nil {
+		if isUniqueViolation(err) {
+			return ErrDuplicateSubmittedCommit
+		}
+		return err
+	}
+	return nil
+}
+
+// DeleteSubmittedCommit deletes the submitted commit with the given ID.
+func (db *GormDB) DeleteSubmittedCommit(commitID uint64) error {
+	return db.conn.Delete(&pb.SubmittedCommit{}, commitID).Error
+}
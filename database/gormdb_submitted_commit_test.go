@@ -0,0 +1,45 @@
+package database_test
+
+import (
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/database"
+	"github.com/autograde/quickfeed/internal/qtest"
+)
+
+func TestCreateSubmittedCommit(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	tests := []struct {
+		commit *pb.SubmittedCommit
+		want   error
+	}{
+		{commit: &pb.SubmittedCommit{AssignmentID: 1, UserID: 1, CommitID: "c1"}, want: nil},
+		{commit: &pb.SubmittedCommit{AssignmentID: 1, UserID: 1, CommitID: "c1"}, want: database.ErrDuplicateSubmittedCommit},
+		{commit: &pb.SubmittedCommit{AssignmentID: 1, UserID: 1, CommitID: "c2"}, want: nil},
+		{commit: &pb.SubmittedCommit{AssignmentID: 1, UserID: 2, CommitID: "c1"}, want: nil},
+		{commit: &pb.SubmittedCommit{AssignmentID: 1, GroupID: 1, CommitID: "c1"}, want: nil},
+		{commit: &pb.SubmittedCommit{AssignmentID: 2, UserID: 1, CommitID: "c1"}, want: nil},
+		{commit: &pb.SubmittedCommit{AssignmentID: 1, CommitID: "c3"}, want: database.ErrInvalidSubmittedCommit},
+		{commit: &pb.SubmittedCommit{AssignmentID: 1, UserID: 1}, want: database.ErrInvalidSubmittedCommit},
+	}
+	for _, test := range tests {
+		if err := db.CreateSubmittedCommit(test.commit); err != test.want {
+			t.Errorf("CreateSubmittedCommit(%v) = %v, want %v", test.commit, err, test.want)
+		}
+	}
+
+	// deleted commits can be submitted again
+	commit := &pb.SubmittedCommit{AssignmentID: 3, UserID: 1, CommitID: "c1"}
+	if err := db.CreateSubmittedCommit(commit); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.DeleteSubmittedCommit(commit.GetID()); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.CreateSubmittedCommit(&pb.SubmittedCommit{AssignmentID: 3, UserID: 1, CommitID: "c1"}); err != nil {
+		t.Errorf("CreateSubmittedCommit() after delete = %v, want nil", err)
+	}
+}
@@ -0,0 +1,69 @@
+package database
+
+import (
+	pb "github.com/autograde/quickfeed/ag"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+/// Tasks ///
+
+// GetTasks returns the tasks of the given assignment, in their order in the assignment.
+func (db *GormDB) GetTasks(assignmentID uint64) ([]*pb.Task, error) {
+	var tasks []*pb.Task
+	if err := db.conn.Where(&pb.Task{AssignmentID: assignmentID}).
+		Order(clause.OrderByColumn{Column: clause.Column{Name: "order"}}).
+		Find(&tasks).Error; err != nil {
+		return nil, err
+	}
+	return tasks, nil
+}
+
+// updateTasks replaces the tasks of the given assignment with the given tasks.
+func (db *GormDB) updateTasks(assignmentID uint64, tasks []*pb.Task) error {
+	return db.conn.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("assignment_id = ?", assignmentID).Delete(&pb.Task{}).Error; err != nil {
+			return err
+		}
+		for _, task := range tasks {
+			task.ID = 0
+			task.AssignmentID = assignmentID
+			if err := tx.Create(task).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// GetTaskScores returns the task scores of the given submission, in the order they were recorded.
+func (db *GormDB) GetTaskScores(submissionID uint64) ([]*pb.TaskScore, error) {
+	var scores []*pb.TaskScore
+	if err := db.conn.Where(&pb.TaskScore{SubmissionID: submissionID}).
+		Order("id").
+		Find(&scores).Error; err != nil {
+		return nil, err
+	}
+	return scores, nil
+}
+
+// UpdateTaskScores replaces the task scores of the given submission with the given scores.
+// Returns gorm.ErrRecordNotFound if the submission does not exist.
+func (db *GormDB) UpdateTaskScores(submissionID uint64, scores []*pb.TaskScore) error {
+	return db.conn.Transaction(func(tx *gorm.DB) error {
+		if err := tx.First(&pb.Submission{}, &pb.Submission{ID: submissionID}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("submission_id = ?", submissionID).Delete(&pb.TaskScore{}).Error; err != nil {
+			return err
+		}
+		for _, score := range scores {
+			score.ID = 0
+			score.SubmissionID = submissionID
+			if err := tx.Create(score).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,58 @@
+package database
+
+import (
+	pb "github.com/autograde/quickfeed/ag"
+)
+
+/// Tenants ///
+
+// CreateTenant creates a new tenant.
+func (db *GormDB) CreateTenant(tenant *pb.Tenant) error {
+	return db.conn.Create(tenant).Error
+}
+
+// GetTenant returns the tenant with the given ID.
+func (db *GormDB) GetTenant(tenantID uint64) (*pb.Tenant, error) {
+	var tenant pb.Tenant
+	if err := db.conn.First(&tenant, tenantID).Error; err != nil {
+		return nil, err
+	}
+	return &tenant, nil
+}
+
+// GetTenants returns all tenants, ordered by name.
+func (db *GormDB) GetTenants() ([]*pb.Tenant, error) {
+	var tenants []*pb.Tenant
+	if err := db.conn.Order("name").Find(&tenants).Error; err != nil {
+		return nil, err
+	}
+	return tenants, nil
+}
+
+// UpdateTenant updates the tenant's name and SCM provider configuration.
+// Unlike the other fields, the provider and provider URL can be cleared.
+func (db *GormDB) UpdateTenant(tenant *pb.Tenant) error {
+	if err := db.conn.First(&pb.Tenant{}, tenant.GetID()).Error; err != nil {
+		return err
+	}
+	return db.conn.Model(&pb.Tenant{ID: tenant.GetID()}).
+		Select("name", "provider", "provider_url").
+		Updates(tenant).Error
+}
+
+// GetTenantUsers returns the given page of the tenant's users, including remote identities.
+// If page is nil, all the tenant's users are returned.
+func (db *GormDB) GetTenantUsers(tenantID uint64, page *Page) ([]*pb.User, error) {
+	m := db.conn.Where(&pb.User{TenantID: tenantID})
+	if page != nil {
+		m = m.Scopes(page.scope("id"))
+	}
+	var users []*pb.User
+	if err := m.Preload("RemoteIdentities").Find(&users).Error; err != nil {
+		return nil, err
+	}
+	if page != nil {
+		users = users[:page.trim(len(users), func(i int) uint64 { return users[i].GetID() })]
+	}
+	return users, nil
+}
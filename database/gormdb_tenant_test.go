@@ -0,0 +1,108 @@
+package database_test
+
+import (
+	"errors"
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/database"
+	"github.com/autograde/quickfeed/internal/qtest"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/testing/protocmp"
+	"gorm.io/gorm"
+)
+
+func TestGormDBTenants(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	physics := &pb.Tenant{Name: "Physics", Provider: "gitea", ProviderURL: "https://git.phys.example.org"}
+	if err := db.CreateTenant(physics); err != nil {
+		t.Fatal(err)
+	}
+	chemistry := &pb.Tenant{Name: "Chemistry"}
+	if err := db.CreateTenant(chemistry); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.CreateTenant(&pb.Tenant{Name: "Physics"}); err == nil {
+		t.Error("CreateTenant() with duplicate name succeeded, want error")
+	}
+
+	// the provider configuration can be cleared
+	physics.Provider, physics.ProviderURL = "", ""
+	if err := db.UpdateTenant(physics); err != nil {
+		t.Fatal(err)
+	}
+	got, err := db.GetTenant(physics.GetID())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(physics, got, protocmp.Transform()); diff != "" {
+		t.Errorf("GetTenant() mismatch (-want +got):\n%s", diff)
+	}
+	if err := db.UpdateTenant(&pb.Tenant{ID: 99, Name: "Biology"}); !errors.Is(err, gorm.ErrRecordNotFound) {
+		t.Errorf("UpdateTenant() of unknown tenant = %v, want %v", err, gorm.ErrRecordNotFound)
+	}
+
+	tenants, err := db.GetTenants()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]*pb.Tenant{chemistry, physics}, tenants, protocmp.Transform()); diff != "" {
+		t.Errorf("GetTenants() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestGormDBGetTenantUsers(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	tenant := &pb.Tenant{Name: "Physics"}
+	if err := db.CreateTenant(tenant); err != nil {
+		t.Fatal(err)
+	}
+	var want []uint64
+	for remoteID := uint64(1); remoteID <= 5; remoteID++ {
+		user := qtest.CreateFakeUser(t, db, remoteID)
+		if remoteID%2 == 0 {
+			continue
+		}
+		user.TenantID = tenant.GetID()
+		if err := db.UpdateUser(user); err != nil {
+			t.Fatal(err)
+		}
+		want = append(want, user.GetID())
+	}
+	userIDs := func(users []*pb.User) []uint64 {
+		var ids []uint64
+		for _, user := range users {
+			ids = append(ids, user.GetID())
+		}
+		return ids
+	}
+
+	users, err := db.GetTenantUsers(tenant.GetID(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(want, userIDs(users)); diff != "" {
+		t.Errorf("GetTenantUsers() mismatch (-want +got):\n%s", diff)
+	}
+
+	page := &database.Page{Size: 2}
+	users, err = db.GetTenantUsers(tenant.GetID(), page)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(want[:2], userIDs(users)); diff != "" {
+		t.Errorf("GetTenantUsers() first page mismatch (-want +got):\n%s", diff)
+	}
+	page = &database.Page{After: page.Next, Size: 2}
+	users, err = db.GetTenantUsers(tenant.GetID(), page)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(want[2:], userIDs(users)); diff != "" || page.Next != 0 {
+		t.Errorf("GetTenantUsers() last page mismatch (-want +got):\n%s, next = %d", diff, page.Next)
+	}
+}
@@ -482,7 +482,7 @@ func TestGormDBAssociateUserWithRemoteIdentity(t *testing.T) {
 		t.Errorf("have user %+v want %+v", &user1, wantUser1)
 	}
 
-	if err := db.AssociateUserWithRemoteIdentity(user1.ID, provider2, remoteID2, secret2); err != nil {
+	if err := db.AssociateUserWithRemoteIdentity(user1.ID, provider2, remoteID2, "", secret2); err != nil {
 		t.Fatal(err)
 	}
 
@@ -495,7 +495,7 @@ func TestGormDBAssociateUserWithRemoteIdentity(t *testing.T) {
 		t.Errorf("have user %+v want %+v", user2, wantUser2)
 	}
 
-	if err := db.AssociateUserWithRemoteIdentity(user1.ID, provider2, remoteID2, secret3); err != nil {
+	if err := db.AssociateUserWithRemoteIdentity(user1.ID, provider2, remoteID2, "", secret3); err != nil {
 		t.Fatal(err)
 	}
 
@@ -714,6 +714,9 @@ func TestGormDBUpdateCourse(t *testing.T) {
 		Tag:            "Spring",
 		Provider:       "github",
 		OrganizationID: 1234,
+		SlipDays:       5,
+		MinGroupSize:   2,
+		TestsRepo:      "labs-tests",
 	}
 	wantCourse := &pb.Course{
 		Name:           "Test Course Edit",
@@ -733,9 +736,21 @@ func TestGormDBUpdateCourse(t *testing.T) {
 
 	wantCourse.ID = course.ID
 	wantCourse.CourseCreatorID = admin.ID
+	// the Dockerfile and archived status are not settings, and are kept by UpdateCourse
+	if err := db.UpdateCourseDockerfile(course.ID, "FROM golang"); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.ArchiveCourse(course.ID); err != nil {
+		t.Fatal(err)
+	}
+	// settings are reset to their zero values, while the provider and repository names are kept
 	if err := db.UpdateCourse(wantCourse); err != nil {
 		t.Fatal(err)
 	}
+	wantCourse.Provider = "github"
+	wantCourse.TestsRepo = "labs-tests"
+	wantCourse.Dockerfile = "FROM golang"
+	wantCourse.Archived = true
 
 	// Get the updated course.
 	gotCourse, err := db.GetCourse(course.ID, false)
@@ -0,0 +1,31 @@
+package database
+
+import (
+	pb "github.com/autograde/quickfeed/ag"
+)
+
+/// Test jobs ///
+
+// CreateTestJob records the given test job.
+func (db *GormDB) CreateTestJob(job *pb.TestJob) error {
+	return db.conn.Create(job).Error
+}
+
+// GetTestJobs returns all queued and running test jobs, in the order they were created.
+func (db *GormDB) GetTestJobs() ([]*pb.TestJob, error) {
+	var jobs []*pb.TestJob
+	if err := db.conn.Order("id").Find(&jobs).Error; err != nil {
+		return nil, err
+	}
+	return jobs, nil
+}
+
+// UpdateTestJob updates the given test job.
+func (db *GormDB) UpdateTestJob(job *pb.TestJob) error {
+	return db.conn.Save(job).Error
+}
+
+// DeleteTestJob deletes the test job with the given ID.
+func (db *GormDB) DeleteTestJob(jobID uint64) error {
+	return db.conn.Delete(&pb.TestJob{}, jobID).Error
+}
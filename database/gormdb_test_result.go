@@ -0,0 +1,40 @@
+package database
+
+import (
+	pb "github.com/autograde/quickfeed/ag"
+	"gorm.io/gorm"
+)
+
+/// Test results ///
+
+// GetTestResults returns the test results of the given submission, in the order they were recorded.
+func (db *GormDB) GetTestResults(submissionID uint64) ([]*pb.TestResult, error) {
+	var results []*pb.TestResult
+	if err := db.conn.Where(&pb.TestResult{SubmissionID: submissionID}).
+		Order("id").
+		Find(&results).Error; err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// UpdateTestResults replaces the test results of the given submission with the given results.
+// Returns gorm.ErrRecordNotFound if the submission does not exist.
+func (db *GormDB) UpdateTestResults(submissionID uint64, results []*pb.TestResult) error {
+	return db.conn.Transaction(func(tx *gorm.DB) error {
+		if err := tx.First(&pb.Submission{}, &pb.Submission{ID: submissionID}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("submission_id = ?", submissionID).Delete(&pb.TestResult{}).Error; err != nil {
+			return err
+		}
+		for _, result := range results {
+			result.ID = 0
+			result.SubmissionID = submissionID
+			if err := tx.Create(result).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
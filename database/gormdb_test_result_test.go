@@ -0,0 +1,55 @@
+package database_test
+
+import (
+	"errors"
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/internal/qtest"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/testing/protocmp"
+	"gorm.io/gorm"
+)
+
+func TestGormDBUpdateTestResults(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	user, _, assignment := setupCourseAssignment(t, db)
+	submission := &pb.Submission{AssignmentID: assignment.ID, UserID: user.ID}
+	if err := db.CreateSubmission(submission); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := db.UpdateTestResults(submission.ID+1, []*pb.TestResult{{Name: "TestA"}}); !errors.Is(err, gorm.ErrRecordNotFound) {
+		t.Errorf("UpdateTestResults(unknown submission): err = %v, want %v", err, gorm.ErrRecordNotFound)
+	}
+
+	first := []*pb.TestResult{
+		{Name: "TestB", Points: 0, MaxPoints: 2, Duration: 20, Output: "failed", Status: pb.TestResult_FAILED},
+		{Name: "TestA", Points: 1, MaxPoints: 1, Duration: 10, Status: pb.TestResult_PASSED},
+	}
+	if err := db.UpdateTestResults(submission.ID, first); err != nil {
+		t.Fatal(err)
+	}
+	got, err := db.GetTestResults(submission.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the results are returned in the order they were recorded
+	if diff := cmp.Diff(first, got, protocmp.Transform()); diff != "" {
+		t.Errorf("GetTestResults() mismatch (-want +got):\n%s", diff)
+	}
+
+	// the results of a new test run replace the previous results
+	second := []*pb.TestResult{{Name: "TestA", Points: 1, MaxPoints: 1, Status: pb.TestResult_PASSED}}
+	if err := db.UpdateTestResults(submission.ID, second); err != nil {
+		t.Fatal(err)
+	}
+	if got, err = db.GetTestResults(submission.ID); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(second, got, protocmp.Transform()); diff != "" {
+		t.Errorf("GetTestResults() after update mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -50,6 +50,7 @@ func (db *GormDB) GetUserByCourse(query *pb.Course, login string) (*pb.User, *pb
 	enrollmentStatuses := []pb.Enrollment_UserStatus{
 		pb.Enrollment_STUDENT,
 		pb.Enrollment_TEACHER,
+		pb.Enrollment_TA,
 	}
 
 	if err := db.conn.First(&course, query).Error; err != nil {
@@ -92,6 +93,17 @@ func (db *GormDB) GetUsers(userIDs ...uint64) ([]*pb.User, error) {
 	return users, nil
 }
 
+// GetUsersPage fetches the given page of users with remote identities.
+func (db *GormDB) GetUsersPage(page *Page) ([]*pb.User, error) {
+	var users []*pb.User
+	if err := db.conn.Scopes(page.scope("id")).
+		Preload("RemoteIdentities").
+		Find(&users).Error; err != nil {
+		return nil, err
+	}
+	return users[:page.trim(len(users), func(i int) uint64 { return users[i].GetID() })], nil
+}
+
 // UpdateUser updates user information.
 func (db *GormDB) UpdateUser(user *pb.User) error {
 	if err := db.conn.First(&pb.User{ID: user.GetID()}).Error; err != nil {
@@ -0,0 +1,111 @@
+package database
+
+import (
+	"fmt"
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"gorm.io/gorm"
+)
+
+/// User data ///
+
+// GetUserData returns the data stored about the given user. The user's access token hashes
+// and the access tokens of the user's remote identities are left out.
+func (db *GormDB) GetUserData(userID uint64) (*pb.UserData, error) {
+	user, err := db.GetUserWithEnrollments(userID)
+	if err != nil {
+		return nil, err
+	}
+	var identities []*pb.RemoteIdentity
+	if err := db.conn.Where(&pb.RemoteIdentity{UserID: userID}).Find(&identities).Error; err != nil {
+		return nil, err
+	}
+	for _, identity := range identities {
+		identity.AccessToken = ""
+	}
+	data := &pb.UserData{
+		User:        user,
+		Enrollments: user.Enrollments,
+		Exported:    time.Now().Format(pb.TimeLayout),
+	}
+	user.Enrollments = nil
+	user.RemoteIdentities = identities
+
+	groupIDs := db.conn.Table("group_users").Select("group_id").Where("user_id = ?", userID)
+	queries := []struct {
+		dest  interface{}
+		query string
+		args  []interface{}
+	}{
+		{&data.Groups, "id IN (?)", []interface{}{groupIDs}},
+		{&data.PeerReviews, "reviewer_id = ?", []interface{}{userID}},
+		{&data.Appeals, "user_id = ?", []interface{}{userID}},
+		{&data.Extensions, "user_id = ?", []interface{}{userID}},
+		{&data.Questions, "author_id = ?", []interface{}{userID}},
+		{&data.Answers, "author_id = ?", []interface{}{userID}},
+		{&data.Certificates, "user_id = ?", []interface{}{userID}},
+		{&data.Notifications, "user_id = ?", []interface{}{userID}},
+		{&data.AccessTokens, "user_id = ?", []interface{}{userID}},
+		{&data.PreEnrollments, "user_id = ?", []interface{}{userID}},
+		{&data.AuditEntries, "user_id = ?", []interface{}{userID}},
+	}
+	for _, q := range queries {
+		if err := db.conn.Where(q.query, q.args...).Order("id").Find(q.dest).Error; err != nil {
+			return nil, err
+		}
+	}
+	// submissions are returned with their test scores and reviews
+	if err := db.conn.Preload("Scores").Preload("Reviews").
+		Where("user_id = ? OR group_id IN (?)", userID, groupIDs).
+		Order("id").Find(&data.Submissions).Error; err != nil {
+		return nil, err
+	}
+	for _, token := range data.AccessTokens {
+		token.Hash = ""
+	}
+	return data, nil
+}
+
+// AnonymizeUser replaces the given user's name, login, email, student ID and avatar with a pseudonym,
+// and deletes the user's remote identities, access tokens, sessions, notifications and pre-enrollments.
+// The user's login is also replaced with the pseudonym in the audit log, force pushes and
+// certificates. Enrollments, groups, submissions and reviews are kept, such that the user's
+// results still count in the course statistics.
+func (db *GormDB) AnonymizeUser(userID uint64) error {
+	user, err := db.GetUser(userID)
+	if err != nil {
+		return err
+	}
+	pseudonym := fmt.Sprintf("deleted-user-%d", userID)
+	return db.conn.Transaction(func(tx *gorm.DB) error {
+		tokens := tx.Model(&pb.AccessToken{}).Select("id").Where("user_id = ?", userID)
+		if err := tx.Where("access_token_id IN (?)", tokens).Delete(&pb.AccessTokenCourse{}).Error; err != nil {
+			return err // will rollback transaction
+		}
+		for _, model := range []interface{}{&pb.AccessToken{}, &pb.Session{}, &pb.Notification{}, &pb.PreEnrollment{}, &pb.RemoteIdentity{}} {
+			if err := tx.Where("user_id = ?", userID).Delete(model).Error; err != nil {
+				return err // will rollback transaction
+			}
+		}
+		if err := tx.Model(&pb.AuditEntry{}).Where("user_id = ?", userID).Update("login", pseudonym).Error; err != nil {
+			return err // will rollback transaction
+		}
+		if err := tx.Model(&pb.Certificate{}).Where("user_id = ?", userID).Update("student_name", pseudonym).Error; err != nil {
+			return err // will rollback transaction
+		}
+		if user.GetLogin() != "" {
+			if err := tx.Model(&pb.ForcePush{}).Where("pusher = ?", user.GetLogin()).Update("pusher", pseudonym).Error; err != nil {
+				return err // will rollback transaction
+			}
+		}
+		return tx.Model(&pb.User{}).Where("id = ?", userID).Updates(map[string]interface{}{
+			"name":       pseudonym,
+			"login":      pseudonym,
+			"email":      "",
+			"student_id": "",
+			"avatar_url": "",
+			"is_admin":   false,
+		}).Error
+	})
+}
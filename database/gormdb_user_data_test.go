@@ -0,0 +1,76 @@
+package database_test
+
+import (
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/internal/qtest"
+)
+
+func TestGormDBAnonymizeUser(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	teacher := qtest.CreateFakeUser(t, db, 1)
+	course := &pb.Course{OrganizationID: 1}
+	qtest.CreateCourse(t, db, teacher, course)
+	student := qtest.CreateUser(t, db, 2, &pb.User{Name: "Ola Nordmann", Login: "ola", Email: "ola@example.com", StudentID: "123456"})
+	qtest.EnrollStudent(t, db, student, course)
+	lab := &pb.Assignment{CourseID: course.ID, Name: "lab1", Order: 1}
+	if err := db.CreateAssignment(lab); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.CreateSubmission(&pb.Submission{AssignmentID: lab.ID, UserID: student.ID, Score: 80}); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.CreateAccessToken(&pb.AccessToken{UserID: student.ID, Name: "cli", Hash: "secret"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.CreateSession(&pb.Session{UserID: student.ID, Hash: "cookie"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.CreateCertificate(&pb.Certificate{CourseID: course.ID, UserID: student.ID, StudentName: student.Name, Code: "code"}); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := db.GetUserData(student.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if data.GetUser().GetEmail() != "ola@example.com" || len(data.GetEnrollments()) != 1 || len(data.GetSubmissions()) != 1 ||
+		len(data.GetCertificates()) != 1 || len(data.GetAccessTokens()) != 1 || len(data.GetUser().GetRemoteIdentities()) != 1 {
+		t.Errorf("GetUserData() = %v, want the user with one enrollment, submission, certificate, access token and remote identity", data)
+	}
+	if data.GetAccessTokens()[0].GetHash() != "" || data.GetUser().GetRemoteIdentities()[0].GetAccessToken() != "" {
+		t.Error("GetUserData() exported access tokens")
+	}
+
+	if err := db.AnonymizeUser(student.ID); err != nil {
+		t.Fatal(err)
+	}
+	data, err = db.GetUserData(student.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	user := data.GetUser()
+	if user.GetName() != "deleted-user-2" || user.GetLogin() != "deleted-user-2" || user.GetEmail() != "" || user.GetStudentID() != "" {
+		t.Errorf("anonymized user = %v, want the pseudonym deleted-user-2 without email and student ID", user)
+	}
+	if len(user.GetRemoteIdentities()) != 0 || len(data.GetAccessTokens()) != 0 {
+		t.Errorf("anonymized user has remote identities %v and access tokens %v, want none", user.GetRemoteIdentities(), data.GetAccessTokens())
+	}
+	if sessions, err := db.GetSessions(student.ID); err != nil || len(sessions) != 0 {
+		t.Errorf("anonymized user has sessions %v (%v), want none", sessions, err)
+	}
+	if len(data.GetCertificates()) != 1 || data.GetCertificates()[0].GetStudentName() != "deleted-user-2" {
+		t.Errorf("anonymized certificates = %v, want the pseudonym as student name", data.GetCertificates())
+	}
+	// the results are kept for the course statistics
+	stats, err := db.GetCourseStatistics(course.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stats.GetAssignments()[0].GetSubmissions() != 1 || len(data.GetEnrollments()) != 1 {
+		t.Errorf("anonymized user's results are not kept: statistics %v, enrollments %v", stats, data.GetEnrollments())
+	}
+}
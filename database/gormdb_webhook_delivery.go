@@ -0,0 +1,53 @@
+package database
+
+import (
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+)
+
+/// Webhook deliveries ///
+
+// CreateWebhookDelivery records the given webhook delivery.
+// Returns an error if a delivery with the same delivery ID has already been recorded.
+func (db *GormDB) CreateWebhookDelivery(delivery *pb.WebhookDelivery) error {
+	if delivery.GetDeliveryID() == "" {
+		return ErrInvalidWebhookDelivery
+	}
+	return db.conn.Create(delivery).Error
+}
+
+// GetWebhookDelivery returns the webhook delivery with the given delivery ID, including its payload.
+func (db *GormDB) GetWebhookDelivery(deliveryID string) (*pb.WebhookDelivery, error) {
+	var delivery pb.WebhookDelivery
+	if err := db.conn.Where(&pb.WebhookDelivery{DeliveryID: deliveryID}).First(&delivery).Error; err != nil {
+		return nil, err
+	}
+	return &delivery, nil
+}
+
+// GetWebhookDeliveries returns the webhook deliveries matching the given query, newest first.
+// The payloads are omitted.
+func (db *GormDB) GetWebhookDeliveries(query *pb.WebhookDelivery) ([]*pb.WebhookDelivery, error) {
+	var deliveries []*pb.WebhookDelivery
+	if err := db.conn.Omit("payload").
+		Where(query).
+		Order("id desc").
+		Find(&deliveries).Error; err != nil {
+		return nil, err
+	}
+	return deliveries, nil
+}
+
+// UpdateWebhookDelivery updates the given webhook delivery.
+func (db *GormDB) UpdateWebhookDelivery(delivery *pb.WebhookDelivery) error {
+	return db.conn.Save(delivery).Error
+}
+
+// PurgeWebhookPayloads deletes the payloads of the webhook deliveries received before the given time.
+// The deliveries are kept, such that redeliveries are still recognized, but can no longer be replayed.
+func (db *GormDB) PurgeWebhookPayloads(before time.Time) error {
+	return db.conn.Model(&pb.WebhookDelivery{}).
+		Where("received < ? AND payload IS NOT NULL", before.Format(pb.TimeLayout)).
+		Update("payload", nil).Error
+}
@@ -0,0 +1,207 @@
+package database
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+var (
+	// ErrMigrationLocked is returned when the migration lock could not be
+	// acquired because another process is migrating the database.
+	ErrMigrationLocked = errors.New("database migrations are locked by another process")
+	// ErrIrreversibleMigration is returned when trying to roll back a migration without a down step.
+	ErrIrreversibleMigration = errors.New("migration cannot be rolled back")
+)
+
+const (
+	// migrationLockTimeout is how long to wait for another process to release the migration lock.
+	migrationLockTimeout = time.Minute
+	// migrationLockStale is the age after which a migration lock is considered abandoned,
+	// e.g., by a process that crashed while migrating, and may be broken.
+	migrationLockStale = 10 * time.Minute
+	// migrationLockRetry is the interval between attempts to acquire the migration lock.
+	migrationLockRetry = 100 * time.Millisecond
+)
+
+// migration is a versioned change to the database's schema or data that AutoMigrate
+// cannot perform, such as renaming a column or backfilling values. Each migration
+// is defined in its own migration_<version>_<name>.go file and listed in migrations.
+type migration struct {
+	version uint64
+	name    string
+	// up applies the migration.
+	up func(tx *gorm.DB) error
+	// down reverts the migration; nil if the migration cannot be reverted.
+	down func(tx *gorm.DB) error
+}
+
+// migrations lists all migrations in the order they are applied.
+// Versions must be unique and increasing; never change an applied migration,
+// add a new one instead.
+var migrations = []migration{
+	fixAssignmentDeadlines,
+	releaseReviewsOfReleasedSubmissions,
+	convertDeadlinesToUTC,
+}
+
+// schemaMigration records a migration applied to the database.
+type schemaMigration struct {
+	Version uint64 `gorm:"primaryKey;autoIncrement:false"`
+	Name    string
+	Applied time.Time
+}
+
+func (schemaMigration) TableName() string { return "schema_migrations" }
+
+// schemaMigrationLock is held by the process running migrations.
+type schemaMigrationLock struct {
+	ID     uint64 `gorm:"primaryKey;autoIncrement:false"`
+	Owner  string
+	Locked time.Time
+}
+
+func (schemaMigrationLock) TableName() string { return "schema_migration_lock" }
+
+// Migrate applies all pending migrations in version order. Each migration runs in
+// its own transaction together with recording its version, such that a failed
+// migration leaves the database at the previous version. Migrate is run on start
+// after AutoMigrate, and holds a lock so that only one process migrates at a time.
+func (db *GormDB) Migrate() error {
+	return db.migrate(migrations)
+}
+
+// Rollback reverts the applied migrations newer than the given version, newest first.
+// Rollback(0) reverts all migrations.
+func (db *GormDB) Rollback(version uint64) error {
+	return db.rollback(migrations, version)
+}
+
+// SchemaVersion returns the version of the newest migration applied to the database,
+// or 0 if no migrations have been applied.
+func (db *GormDB) SchemaVersion() (uint64, error) {
+	var applied schemaMigration
+	err := db.conn.Order("version desc").Limit(1).Find(&applied).Error
+	return applied.Version, err
+}
+
+func (db *GormDB) migrate(ms []migration) error {
+	if err := checkMigrations(ms); err != nil {
+		return err
+	}
+	unlock, err := db.lockMigrations()
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	applied, err := db.appliedMigrations()
+	if err != nil {
+		return err
+	}
+	for _, m := range ms {
+		if applied[m.version] {
+			continue
+		}
+		m := m
+		if err := db.conn.Transaction(func(tx *gorm.DB) error {
+			if err := m.up(tx); err != nil {
+				return err
+			}
+			return tx.Create(&schemaMigration{Version: m.version, Name: m.name, Applied: time.Now()}).Error
+		}); err != nil {
+			return fmt.Errorf("migration %d (%s) failed: %w", m.version, m.name, err)
+		}
+	}
+	return nil
+}
+
+func (db *GormDB) rollback(ms []migration, version uint64) error {
+	if err := checkMigrations(ms); err != nil {
+		return err
+	}
+	unlock, err := db.lockMigrations()
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	applied, err := db.appliedMigrations()
+	if err != nil {
+		return err
+	}
+	for i := len(ms) - 1; i >= 0; i-- {
+		m := ms[i]
+		if m.version <= version || !applied[m.version] {
+			continue
+		}
+		if m.down == nil {
+			return fmt.Errorf("migration %d (%s): %w", m.version, m.name, ErrIrreversibleMigration)
+		}
+		if err := db.conn.Transaction(func(tx *gorm.DB) error {
+			if err := m.down(tx); err != nil {
+				return err
+			}
+			return tx.Delete(&schemaMigration{}, m.version).Error
+		}); err != nil {
+			return fmt.Errorf("rollback of migration %d (%s) failed: %w", m.version, m.name, err)
+		}
+	}
+	return nil
+}
+
+// appliedMigrations returns the set of migration versions applied to the database.
+func (db *GormDB) appliedMigrations() (map[uint64]bool, error) {
+	var versions []uint64
+	if err := db.conn.Model(&schemaMigration{}).Pluck("version", &versions).Error; err != nil {
+		return nil, err
+	}
+	applied := make(map[uint64]bool, len(versions))
+	for _, v := range versions {
+		applied[v] = true
+	}
+	return applied, nil
+}
+
+// lockMigrations acquires the migration lock, waiting for another process to release it,
+// and breaking it if it is stale. The returned function releases the lock.
+func (db *GormDB) lockMigrations() (func(), error) {
+	if err := db.conn.AutoMigrate(&schemaMigration{}, &schemaMigrationLock{}); err != nil {
+		return nil, err
+	}
+	owner, _ := os.Hostname()
+	owner = fmt.Sprintf("%s:%d", owner, os.Getpid())
+	deadline := time.Now().Add(migrationLockTimeout)
+	for {
+		lock := &schemaMigrationLock{ID: 1, Owner: owner, Locked: time.Now()}
+		if err := db.conn.Create(lock).Error; err == nil {
+			return func() { db.conn.Delete(&schemaMigrationLock{}, 1) }, nil
+		}
+		// break the lock if its holder has not released it in a long time
+		if err := db.conn.Where("locked < ?", time.Now().Add(-migrationLockStale)).
+			Delete(&schemaMigrationLock{}, 1).Error; err != nil {
+			return nil, err
+		}
+		if time.Now().After(deadline) {
+			return nil, ErrMigrationLocked
+		}
+		time.Sleep(migrationLockRetry)
+	}
+}
+
+// checkMigrations returns an error if the migrations are not in increasing version order.
+func checkMigrations(ms []migration) error {
+	if !sort.SliceIsSorted(ms, func(i, j int) bool { return ms[i].version < ms[j].version }) {
+		return errors.New("migrations must be listed in version order")
+	}
+	for i := 1; i < len(ms); i++ {
+		if ms[i].version == ms[i-1].version {
+			return fmt.Errorf("duplicate migration version %d", ms[i].version)
+		}
+	}
+	return nil
+}
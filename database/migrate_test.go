@@ -0,0 +1,301 @@
+package database
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/kit/score"
+	"github.com/google/go-cmp/cmp"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+func testGormDB(t *testing.T) *GormDB {
+	t.Helper()
+	db, err := NewGormDB(filepath.Join(t.TempDir(), "test.db"), zap.NewNop())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return db
+}
+
+func TestMigrateAndRollback(t *testing.T) {
+	db := testGormDB(t)
+	if err := db.conn.Create(&pb.Course{Name: "Distributed Systems", Code: "DAT520"}).Error; err != nil {
+		t.Fatal(err)
+	}
+
+	var calls []string
+	ms := []migration{
+		{
+			version: 10,
+			name:    "rename_code",
+			up: func(tx *gorm.DB) error {
+				calls = append(calls, "up 10")
+				return tx.Exec("UPDATE courses SET code = 'X' || code").Error
+			},
+			down: func(tx *gorm.DB) error {
+				calls = append(calls, "down 10")
+				return tx.Exec("UPDATE courses SET code = substr(code, 2)").Error
+			},
+		},
+		{
+			version: 11,
+			name:    "noop",
+			up:      func(tx *gorm.DB) error { calls = append(calls, "up 11"); return nil },
+			down:    func(tx *gorm.DB) error { calls = append(calls, "down 11"); return nil },
+		},
+	}
+	courseCode := func() string {
+		var course pb.Course
+		if err := db.conn.First(&course).Error; err != nil {
+			t.Fatal(err)
+		}
+		return course.Code
+	}
+
+	if err := db.migrate(ms); err != nil {
+		t.Fatal(err)
+	}
+	// applying the migrations again is a no-op
+	if err := db.migrate(ms); err != nil {
+		t.Fatal(err)
+	}
+	if got := courseCode(); got != "XDAT520" {
+		t.Errorf("course code = %q, want %q", got, "XDAT520")
+	}
+	if v, err := db.SchemaVersion(); err != nil || v != 11 {
+		t.Errorf("SchemaVersion() = %d, %v, want 11", v, err)
+	}
+
+	if err := db.rollback(ms, 0); err != nil {
+		t.Fatal(err)
+	}
+	if got := courseCode(); got != "DAT520" {
+		t.Errorf("course code = %q, want %q", got, "DAT520")
+	}
+	// the built-in migrations are still applied
+	if v, err := db.SchemaVersion(); err != nil || v != migrations[len(migrations)-1].version {
+		t.Errorf("SchemaVersion() = %d, %v, want %d", v, err, migrations[len(migrations)-1].version)
+	}
+	want := []string{"up 10", "up 11", "down 11", "down 10"}
+	if len(calls) != len(want) {
+		t.Fatalf("calls = %v, want %v", calls, want)
+	}
+	for i := range want {
+		if calls[i] != want[i] {
+			t.Errorf("calls = %v, want %v", calls, want)
+			break
+		}
+	}
+}
+
+func TestMigrateFailure(t *testing.T) {
+	db := testGormDB(t)
+	ms := []migration{
+		{
+			version: 10,
+			name:    "fails",
+			up: func(tx *gorm.DB) error {
+				if err := tx.Create(&pb.Course{Code: "DAT520"}).Error; err != nil {
+					return err
+				}
+				return errors.New("backfill failed")
+			},
+		},
+	}
+	if err := db.migrate(ms); err == nil {
+		t.Fatal("expected migration to fail")
+	}
+	// the failed migration is neither applied nor recorded
+	var count int64
+	if err := db.conn.Model(&pb.Course{}).Count(&count).Error; err != nil || count != 0 {
+		t.Errorf("found %d courses (%v), want 0", count, err)
+	}
+	if v, err := db.SchemaVersion(); err != nil || v != migrations[len(migrations)-1].version {
+		t.Errorf("SchemaVersion() = %d, %v, want %d", v, err, migrations[len(migrations)-1].version)
+	}
+	// the lock is released after a failure
+	if err := db.migrate(nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestRollbackIrreversible(t *testing.T) {
+	db := testGormDB(t)
+	if err := db.Rollback(0); !errors.Is(err, ErrIrreversibleMigration) {
+		t.Errorf("Rollback(0) = %v, want %v", err, ErrIrreversibleMigration)
+	}
+}
+
+func TestMigrateInvalidOrder(t *testing.T) {
+	db := testGormDB(t)
+	noop := func(tx *gorm.DB) error { return nil }
+	for _, ms := range [][]migration{
+		{{version: 11, up: noop}, {version: 10, up: noop}},
+		{{version: 10, up: noop}, {version: 10, up: noop}},
+	} {
+		if err := db.migrate(ms); err == nil {
+			t.Errorf("migrate(%v) succeeded, want error", ms)
+		}
+	}
+}
+
+func TestMigrateLock(t *testing.T) {
+	db := testGormDB(t)
+	// a recent lock held by another process
+	if err := db.conn.Create(&schemaMigrationLock{ID: 1, Owner: "other", Locked: time.Now()}).Error; err != nil {
+		t.Fatal(err)
+	}
+	done := make(chan error)
+	go func() { done <- db.migrate(nil) }()
+	select {
+	case err := <-done:
+		t.Fatalf("migrate() = %v while locked, want it to wait", err)
+	case <-time.After(3 * migrationLockRetry):
+	}
+	if err := db.conn.Delete(&schemaMigrationLock{}, 1).Error; err != nil {
+		t.Fatal(err)
+	}
+	if err := <-done; err != nil {
+		t.Errorf("migrate() = %v after the lock was released", err)
+	}
+
+	// a stale lock left behind by a crashed process is broken
+	stale := time.Now().Add(-2 * migrationLockStale)
+	if err := db.conn.Create(&schemaMigrationLock{ID: 1, Owner: "crashed", Locked: stale}).Error; err != nil {
+		t.Fatal(err)
+	}
+	if err := db.migrate(nil); err != nil {
+		t.Errorf("migrate() = %v with a stale lock", err)
+	}
+}
+
+func TestMigrationAssignmentDeadlines(t *testing.T) {
+	db := testGormDB(t)
+	deadlines := []struct {
+		in, want string
+	}{
+		{in: "2020-01-23 18:00", want: "2020-01-23T18:00:00"},
+		{in: "23-1-2020 6pm", want: "2020-01-23T18:00:00"},
+		{in: "2021-03-20T23:59", want: "2021-03-20T23:59:00"},
+		{in: "2022-12-24T12:00:00", want: "2022-12-24T12:00:00"},
+		{in: "next friday", want: "next friday"},
+	}
+	for _, d := range deadlines {
+		if err := db.conn.Create(&pb.Assignment{CourseID: 1, Deadline: d.in}).Error; err != nil {
+			t.Fatal(err)
+		}
+	}
+	// pretend the migration has not yet been applied
+	if err := db.conn.Delete(&schemaMigration{}, fixAssignmentDeadlines.version).Error; err != nil {
+		t.Fatal(err)
+	}
+	if err := db.Migrate(); err != nil {
+		t.Fatal(err)
+	}
+
+	var assignments []*pb.Assignment
+	if err := db.conn.Order("id").Find(&assignments).Error; err != nil {
+		t.Fatal(err)
+	}
+	if len(assignments) != len(deadlines) {
+		t.Fatalf("found %d assignments, want %d", len(assignments), len(deadlines))
+	}
+	for i, d := range deadlines {
+		if got := assignments[i].GetDeadline(); got != d.want {
+			t.Errorf("deadline %q migrated to %q, want %q", d.in, got, d.want)
+		}
+	}
+}
+
+func TestMigrationReleasedReviews(t *testing.T) {
+	db := testGormDB(t)
+	released := &pb.Submission{AssignmentID: 1, UserID: 1, Released: true}
+	unreleased := &pb.Submission{AssignmentID: 1, UserID: 2}
+	for _, submission := range []*pb.Submission{released, unreleased} {
+		if err := db.conn.Create(submission).Error; err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, review := range []*pb.Review{
+		{SubmissionID: released.ID, ReviewerID: 1, Ready: true},
+		{SubmissionID: unreleased.ID, ReviewerID: 1, Ready: true},
+	} {
+		if err := db.conn.Create(review).Error; err != nil {
+			t.Fatal(err)
+		}
+	}
+	// pretend the migration has not yet been applied
+	if err := db.conn.Delete(&schemaMigration{}, releaseReviewsOfReleasedSubmissions.version).Error; err != nil {
+		t.Fatal(err)
+	}
+	if err := db.Migrate(); err != nil {
+		t.Fatal(err)
+	}
+
+	var reviews []*pb.Review
+	if err := db.conn.Order("id").Find(&reviews).Error; err != nil {
+		t.Fatal(err)
+	}
+	if len(reviews) != 2 || !reviews[0].GetReleased() || reviews[1].GetReleased() {
+		t.Errorf("reviews = %v, want only the review of the released submission released", reviews)
+	}
+}
+
+func TestMigrationUTCDeadlines(t *testing.T) {
+	local := time.Local
+	time.Local = time.FixedZone("CET", 3600)
+	defer func() { time.Local = local }()
+
+	db := testGormDB(t)
+	assignment := &pb.Assignment{CourseID: 1, Deadline: "2022-01-10T23:59:00", Release: "2022-01-01T08:00:00", ExamStart: "not a time"}
+	if err := db.conn.Create(assignment).Error; err != nil {
+		t.Fatal(err)
+	}
+	extension := &pb.Extension{CourseID: 1, AssignmentID: assignment.ID, UserID: 2, Deadline: "2022-01-12T00:30:00"}
+	if err := db.conn.Create(extension).Error; err != nil {
+		t.Fatal(err)
+	}
+	buildInfo := &score.BuildInfo{SubmissionID: 1, BuildDate: "2022-01-11T10:00:00"}
+	if err := db.conn.Create(buildInfo).Error; err != nil {
+		t.Fatal(err)
+	}
+	check := func(deadline, release, examStart, extended, buildDate string) {
+		t.Helper()
+		var a pb.Assignment
+		var e pb.Extension
+		var b score.BuildInfo
+		if err := db.conn.First(&a, assignment.ID).Error; err != nil {
+			t.Fatal(err)
+		}
+		if err := db.conn.First(&e, extension.ID).Error; err != nil {
+			t.Fatal(err)
+		}
+		if err := db.conn.First(&b, buildInfo.ID).Error; err != nil {
+			t.Fatal(err)
+		}
+		got := []string{a.GetDeadline(), a.GetRelease(), a.GetExamStart(), e.GetDeadline(), b.GetBuildDate()}
+		want := []string{deadline, release, examStart, extended, buildDate}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("times mismatch (-want +got):\n%s", diff)
+		}
+	}
+
+	// pretend the migration has not yet been applied
+	if err := db.conn.Delete(&schemaMigration{}, convertDeadlinesToUTC.version).Error; err != nil {
+		t.Fatal(err)
+	}
+	if err := db.Migrate(); err != nil {
+		t.Fatal(err)
+	}
+	check("2022-01-10T22:59:00", "2022-01-01T07:00:00", "not a time", "2022-01-11T23:30:00", "2022-01-11T09:00:00")
+
+	if err := db.Rollback(releaseReviewsOfReleasedSubmissions.version); err != nil {
+		t.Fatal(err)
+	}
+	check("2022-01-10T23:59:00", "2022-01-01T08:00:00", "not a time", "2022-01-12T00:30:00", "2022-01-11T10:00:00")
+}
@@ -0,0 +1,34 @@
+package database
+
+import (
+	"strings"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"gorm.io/gorm"
+)
+
+// fixAssignmentDeadlines rewrites assignment deadlines stored in other formats,
+// e.g., "2020-01-23 18:00", to the TimeLayout format expected by the frontend.
+// Deadlines in unrecognized formats are left unchanged.
+// The old formats are not recorded, so the migration cannot be reverted.
+var fixAssignmentDeadlines = migration{
+	version: 1,
+	name:    "assignment_deadlines",
+	up: func(tx *gorm.DB) error {
+		var assignments []*pb.Assignment
+		if err := tx.Model(&pb.Assignment{}).Select("id", "deadline").Find(&assignments).Error; err != nil {
+			return err
+		}
+		for _, assignment := range assignments {
+			deadline := pb.FixDeadline(assignment.GetDeadline())
+			if deadline == assignment.GetDeadline() || strings.HasPrefix(deadline, "Invalid date format") {
+				continue
+			}
+			if err := tx.Model(&pb.Assignment{}).Where("id = ?", assignment.GetID()).
+				Update("deadline", deadline).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	},
+}
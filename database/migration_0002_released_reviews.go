@@ -0,0 +1,20 @@
+package database
+
+import (
+	pb "github.com/autograde/quickfeed/ag"
+	"gorm.io/gorm"
+)
+
+// releaseReviewsOfReleasedSubmissions releases the existing reviews of released submissions,
+// such that reviews already shown to students stay visible now that reviews are released
+// explicitly. Reviews released later cannot be told apart, so the migration cannot be reverted.
+var releaseReviewsOfReleasedSubmissions = migration{
+	version: 2,
+	name:    "released_reviews",
+	up: func(tx *gorm.DB) error {
+		submissions := tx.Model(&pb.Submission{}).Select("id").Where("released = ?", true)
+		return tx.Model(&pb.Review{}).
+			Where("submission_id IN (?)", submissions).
+			Update("released", true).Error
+	},
+}
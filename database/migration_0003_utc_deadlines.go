@@ -0,0 +1,63 @@
+package database
+
+import (
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/kit/score"
+	"gorm.io/gorm"
+)
+
+// utcTimeColumns are the times that were stored in the server's time zone, and are now stored in UTC,
+// such that deadlines can be compared with build dates regardless of daylight saving time.
+var utcTimeColumns = []struct {
+	model  interface{}
+	column string
+}{
+	{&pb.Assignment{}, "deadline"},
+	{&pb.Assignment{}, "release"},
+	{&pb.Assignment{}, "exam_start"},
+	{&pb.Extension{}, "deadline"},
+	{&score.BuildInfo{}, "build_date"},
+}
+
+// convertDeadlinesToUTC rewrites the assignment deadlines, release and exam start times,
+// the extended deadlines and the build dates from the server's time zone to UTC.
+// Times not in the TimeLayout format are left unchanged.
+var convertDeadlinesToUTC = migration{
+	version: 3,
+	name:    "utc_deadlines",
+	up: func(tx *gorm.DB) error {
+		return convertTimeColumns(tx, time.Local, time.UTC)
+	},
+	down: func(tx *gorm.DB) error {
+		return convertTimeColumns(tx, time.UTC, time.Local)
+	},
+}
+
+// convertTimeColumns rewrites the times in utcTimeColumns from one time zone to another.
+func convertTimeColumns(tx *gorm.DB, from, to *time.Location) error {
+	for _, c := range utcTimeColumns {
+		var rows []struct {
+			ID    uint64
+			Value string
+		}
+		if err := tx.Model(c.model).Select("id", c.column+" AS value").Scan(&rows).Error; err != nil {
+			return err
+		}
+		for _, row := range rows {
+			t, err := time.ParseInLocation(pb.TimeLayout, row.Value, from)
+			if err != nil {
+				continue
+			}
+			value := t.In(to).Format(pb.TimeLayout)
+			if value == row.Value {
+				continue
+			}
+			if err := tx.Model(c.model).Where("id = ?", row.ID).Update(c.column, value).Error; err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
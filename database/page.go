@@ -0,0 +1,37 @@
+package database
+
+import "gorm.io/gorm"
+
+// Page selects a page of records ordered by ID. At most Size records with IDs
+// greater than After are selected; if Size is zero, all such records are selected.
+// Queries set Next to the ID to continue after to get the next page,
+// or to zero if there are no more records.
+type Page struct {
+	After uint64
+	Size  int
+	Next  uint64
+}
+
+// scope restricts the query to the records of the page, ordered by the given ID column.
+// One record more than the page size is selected to determine whether there is a next page.
+func (p *Page) scope(column string) func(*gorm.DB) *gorm.DB {
+	return func(tx *gorm.DB) *gorm.DB {
+		tx = tx.Where(column+" > ?", p.After).Order(column)
+		if p.Size > 0 {
+			tx = tx.Limit(p.Size + 1)
+		}
+		return tx
+	}
+}
+
+// trim sets Next for the n records found by a query restricted to the page,
+// where id(i) returns the ID of the i'th record, and returns the number of
+// records that belong to the page.
+func (p *Page) trim(n int, id func(i int) uint64) int {
+	p.Next = 0
+	if p.Size > 0 && n > p.Size {
+		n = p.Size
+		p.Next = id(n - 1)
+	}
+	return n
+}
@@ -0,0 +1,121 @@
+// Package fault injects latency, rate limit errors and failures into calls to
+// external dependencies, such as SCM providers and test runners, to exercise
+// retry and rollback paths in integration tests and game days.
+package fault
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+var (
+	// ErrInjected is returned by calls failed by fault injection.
+	ErrInjected = errors.New("injected failure")
+	// ErrRateLimited is returned by calls rate limited by fault injection.
+	ErrRateLimited = errors.New("injected rate limit exceeded")
+)
+
+// Config describes the faults to inject.
+type Config struct {
+	// Latency is the maximum latency added to each call; the added latency is uniformly distributed.
+	Latency time.Duration
+	// ErrorRate is the fraction of calls that fail with ErrInjected.
+	ErrorRate float64
+	// RateLimitRate is the fraction of calls that fail with ErrRateLimited.
+	RateLimitRate float64
+}
+
+// ParseConfig parses a comma-separated fault configuration on the form
+// "latency=200ms,errors=0.05,ratelimit=0.01". Omitted faults are not injected.
+func ParseConfig(spec string) (Config, error) {
+	var cfg Config
+	for _, field := range strings.Split(spec, ",") {
+		field = strings.TrimSpace(field)
+		if field == "" {
+			continue
+		}
+		kv := strings.SplitN(field, "=", 2)
+		if len(kv) != 2 {
+			return Config{}, fmt.Errorf("invalid fault %q: must be name=value", field)
+		}
+		var err error
+		switch kv[0] {
+		case "latency":
+			cfg.Latency, err = time.ParseDuration(kv[1])
+		case "errors":
+			cfg.ErrorRate, err = parseRate(kv[1])
+		case "ratelimit":
+			cfg.RateLimitRate, err = parseRate(kv[1])
+		default:
+			return Config{}, fmt.Errorf("unknown fault %q", kv[0])
+		}
+		if err != nil {
+			return Config{}, fmt.Errorf("invalid fault %q: %w", field, err)
+		}
+	}
+	if cfg.ErrorRate+cfg.RateLimitRate > 1 {
+		return Config{}, fmt.Errorf("error and rate limit rates add up to more than 1")
+	}
+	return cfg, nil
+}
+
+func parseRate(s string) (float64, error) {
+	rate, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0, err
+	}
+	if rate < 0 || rate > 1 {
+		return 0, fmt.Errorf("rate %v must be between 0 and 1", rate)
+	}
+	return rate, nil
+}
+
+// Injector injects the configured faults into calls.
+type Injector struct {
+	cfg Config
+	mu  sync.Mutex
+	rng *rand.Rand
+}
+
+// NewInjector returns an injector for the given faults. The seed makes the injected faults reproducible.
+func NewInjector(cfg Config, seed int64) *Injector {
+	return &Injector{cfg: cfg, rng: rand.New(rand.NewSource(seed))}
+}
+
+// Inject delays the named operation by a random latency, and returns an injected
+// error for the configured fraction of calls. A nil injector injects no faults.
+func (i *Injector) Inject(ctx context.Context, op string) error {
+	if i == nil {
+		return nil
+	}
+	i.mu.Lock()
+	var latency time.Duration
+	if i.cfg.Latency > 0 {
+		latency = time.Duration(i.rng.Int63n(int64(i.cfg.Latency)))
+	}
+	p := i.rng.Float64()
+	i.mu.Unlock()
+
+	if latency > 0 {
+		timer := time.NewTimer(latency)
+		defer timer.Stop()
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+	switch {
+	case p < i.cfg.ErrorRate:
+		return fmt.Errorf("%s: %w", op, ErrInjected)
+	case p < i.cfg.ErrorRate+i.cfg.RateLimitRate:
+		return fmt.Errorf("%s: %w", op, ErrRateLimited)
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+package fault
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParseConfig(t *testing.T) {
+	cfg, err := ParseConfig("latency=200ms, errors=0.05,ratelimit=0.01")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := Config{Latency: 200 * time.Millisecond, ErrorRate: 0.05, RateLimitRate: 0.01}
+	if cfg != want {
+		t.Errorf("ParseConfig() = %+v, want %+v", cfg, want)
+	}
+	for _, spec := range []string{"latency", "latency=fast", "errors=2", "ratelimit=-0.1", "errors=0.6,ratelimit=0.6", "crash=0.1"} {
+		if _, err := ParseConfig(spec); err == nil {
+			t.Errorf("ParseConfig(%q): expected error", spec)
+		}
+	}
+}
+
+func TestInject(t *testing.T) {
+	ctx := context.Background()
+	var injector *Injector
+	if err := injector.Inject(ctx, "op"); err != nil {
+		t.Errorf("Inject() with nil injector: unexpected error: %v", err)
+	}
+
+	injector = NewInjector(Config{ErrorRate: 0.3, RateLimitRate: 0.2}, 1)
+	counts := make(map[error]int)
+	const calls = 10000
+	for i := 0; i < calls; i++ {
+		err := injector.Inject(ctx, "op")
+		switch {
+		case errors.Is(err, ErrInjected):
+			counts[ErrInjected]++
+		case errors.Is(err, ErrRateLimited):
+			counts[ErrRateLimited]++
+		case err != nil:
+			t.Fatalf("Inject() = %v, want injected error or nil", err)
+		}
+	}
+	for err, rate := range map[error]float64{ErrInjected: 0.3, ErrRateLimited: 0.2} {
+		if got := float64(counts[err]) / calls; got < rate-0.02 || got > rate+0.02 {
+			t.Errorf("Inject() returned %v for %.3f of calls, want %.2f", err, got, rate)
+		}
+	}
+
+	injector = NewInjector(Config{Latency: time.Hour}, 1)
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	if err := injector.Inject(ctx, "op"); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Inject() with latency beyond deadline = %v, want %v", err, context.DeadlineExceeded)
+	}
+}
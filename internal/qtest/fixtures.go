@@ -0,0 +1,63 @@
+package qtest
+
+import (
+	"fmt"
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/database"
+	"github.com/autograde/quickfeed/kit/score"
+)
+
+// ScoreScript is an assignment script template for fake runners that output the job's
+// commands instead of running them; the output is a passing score for the test TestScore.
+const ScoreScript = "#image/qf101\n" + `{"Secret":"{{ .RandomSecret }}","TestName":"TestScore","Score":8,"MaxScore":10,"Weight":1}`
+
+// PopulateCourse is a test helper to populate the given course with representative data:
+// the given number of enrolled students with user repositories, and assignments lab1, lab2, ...,
+// each with a submission with test scores for every student. The course's organization ID
+// must be set; the student repositories get remote IDs 1001, 1002, ...
+// It returns the students in the order they were created.
+func PopulateCourse(t testing.TB, db database.Database, course *pb.Course, numStudents, numAssignments int) []*pb.User {
+	t.Helper()
+	students := make([]*pb.User, numStudents)
+	for i := range students {
+		students[i] = CreateNamedUser(t, db, uint64(1000+i), fmt.Sprintf("Student %d", i))
+		EnrollStudent(t, db, students[i], course)
+		if err := db.CreateRepository(&pb.Repository{
+			OrganizationID: course.GetOrganizationID(),
+			RepositoryID:   uint64(1001 + i),
+			UserID:         students[i].GetID(),
+			HTMLURL:        fmt.Sprintf("https://example.com/student%d-labs", i),
+			RepoType:       pb.Repository_USER,
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for i := 1; i <= numAssignments; i++ {
+		assignment := &pb.Assignment{
+			CourseID:   course.GetID(),
+			Name:       fmt.Sprintf("lab%d", i),
+			Order:      uint32(i),
+			Deadline:   "2021-01-01T00:00:00",
+			ScriptFile: ScoreScript,
+			ScoreLimit: 80,
+		}
+		if err := db.CreateAssignment(assignment); err != nil {
+			t.Fatal(err)
+		}
+		for j, student := range students {
+			if err := db.CreateSubmission(&pb.Submission{
+				AssignmentID: assignment.GetID(),
+				UserID:       student.GetID(),
+				Score:        uint32((i*j)%100 + 1),
+				CommitHash:   fmt.Sprintf("commit-%d-%d", i, j),
+				BuildInfo:    &score.BuildInfo{BuildDate: "2021-01-01T00:00:00", BuildLog: "ok", ExecTime: 100},
+				Scores:       []*score.Score{{TestName: "TestScore", Score: int32((i * j) % 11), MaxScore: 10, Weight: 1}},
+			}); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	return students
+}
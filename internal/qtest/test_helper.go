@@ -18,7 +18,7 @@ import (
 
 // TestDB returns a test database and close function.
 // This function should only be used as a test helper.
-func TestDB(t *testing.T) (database.Database, func()) {
+func TestDB(t testing.TB) (database.Database, func()) {
 	t.Helper()
 
 	f, err := ioutil.TempFile(t.TempDir(), "test.db")
@@ -45,7 +45,7 @@ func TestDB(t *testing.T) (database.Database, func()) {
 
 // CreateFakeUser is a test helper to create a user in the database
 // with the given remote id and the fake scm provider.
-func CreateFakeUser(t *testing.T, db database.Database, remoteID uint64) *pb.User {
+func CreateFakeUser(t testing.TB, db database.Database, remoteID uint64) *pb.User {
 	t.Helper()
 	var user pb.User
 	err := db.CreateUserFromRemoteIdentity(&user,
@@ -60,7 +60,7 @@ func CreateFakeUser(t *testing.T, db database.Database, remoteID uint64) *pb.Use
 	return &user
 }
 
-func CreateUserFromRemoteIdentity(t *testing.T, db database.Database, remoteID *pb.RemoteIdentity) *pb.User {
+func CreateUserFromRemoteIdentity(t testing.TB, db database.Database, remoteID *pb.RemoteIdentity) *pb.User {
 	t.Helper()
 	var user pb.User
 	if err := db.CreateUserFromRemoteIdentity(&user, remoteID); err != nil {
@@ -69,7 +69,7 @@ func CreateUserFromRemoteIdentity(t *testing.T, db database.Database, remoteID *
 	return &user
 }
 
-func CreateNamedUser(t *testing.T, db database.Database, remoteID uint64, name string) *pb.User {
+func CreateNamedUser(t testing.TB, db database.Database, remoteID uint64, name string) *pb.User {
 	t.Helper()
 	user := &pb.User{Name: name}
 	err := db.CreateUserFromRemoteIdentity(user,
@@ -84,7 +84,7 @@ func CreateNamedUser(t *testing.T, db database.Database, remoteID uint64, name s
 	return user
 }
 
-func CreateUser(t *testing.T, db database.Database, remoteID uint64, user *pb.User) *pb.User {
+func CreateUser(t testing.TB, db database.Database, remoteID uint64, user *pb.User) *pb.User {
 	t.Helper()
 	err := db.CreateUserFromRemoteIdentity(user,
 		&pb.RemoteIdentity{
@@ -98,7 +98,7 @@ func CreateUser(t *testing.T, db database.Database, remoteID uint64, user *pb.Us
 	return user
 }
 
-func CreateCourse(t *testing.T, db database.Database, user *pb.User, course *pb.Course) {
+func CreateCourse(t testing.TB, db database.Database, user *pb.User, course *pb.Course) {
 	t.Helper()
 	if course.Provider == "" {
 		for _, rid := range user.RemoteIdentities {
@@ -112,7 +112,7 @@ func CreateCourse(t *testing.T, db database.Database, user *pb.User, course *pb.
 	}
 }
 
-func EnrollStudent(t *testing.T, db database.Database, student *pb.User, course *pb.Course) {
+func EnrollStudent(t testing.TB, db database.Database, student *pb.User, course *pb.Course) {
 	t.Helper()
 	if err := db.CreateEnrollment(&pb.Enrollment{UserID: student.ID, CourseID: course.ID}); err != nil {
 		t.Fatal(err)
@@ -127,7 +127,7 @@ func EnrollStudent(t *testing.T, db database.Database, student *pb.User, course
 }
 
 // FakeProviderMap is a test helper function to create an SCM map.
-func FakeProviderMap(t *testing.T) (scm.SCM, *auth.Scms) {
+func FakeProviderMap(t testing.TB) (scm.SCM, *auth.Scms) {
 	t.Helper()
 	scms := auth.NewScms()
 	scm, err := scms.GetOrCreateSCMEntry(zap.NewNop(), "fake", "token")
@@ -137,7 +137,7 @@ func FakeProviderMap(t *testing.T) (scm.SCM, *auth.Scms) {
 	return scm, scms
 }
 
-func RandomString(t *testing.T) string {
+func RandomString(t testing.TB) string {
 	t.Helper()
 	randomness := make([]byte, 10)
 	if _, err := rand.Read(randomness); err != nil {
@@ -62,7 +62,7 @@ func ExtractResults(out, secret string, execTime time.Duration) *Results {
 	}
 	return &Results{
 		BuildInfo: &BuildInfo{
-			BuildDate: time.Now().Format(layout),
+			BuildDate: time.Now().UTC().Format(layout),
 			BuildLog:  strings.Join(filteredLog, "\n"),
 			ExecTime:  execTime.Milliseconds(),
 		},
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"fmt"
 	"log"
@@ -8,13 +9,21 @@ import (
 	"net"
 	"net/http"
 	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
 
 	"github.com/autograde/quickfeed/ci"
+	"github.com/autograde/quickfeed/internal/fault"
 	logq "github.com/autograde/quickfeed/log"
+	"github.com/autograde/quickfeed/plagiarism"
+	"github.com/autograde/quickfeed/scm"
 	"github.com/autograde/quickfeed/web"
 	"github.com/autograde/quickfeed/web/auth"
 
 	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/artifacts"
 	"github.com/autograde/quickfeed/database"
 
 	"google.golang.org/grpc"
@@ -49,6 +58,10 @@ func init() {
 		pb.AgFailedMethodsMetric,
 		pb.AgMethodSuccessRateMetric,
 		pb.AgResponseTimeByMethodsMetric,
+		ci.DeadRunnersMetric,
+		scm.RateLimitRemainingMetric,
+		scm.RateLimitRetriesMetric,
+		web.CacheRequestsMetric,
 	)
 }
 
@@ -57,21 +70,69 @@ var reg = prometheus.NewRegistry()
 
 func main() {
 	var (
-		baseURL  = flag.String("service.url", "", "base service DNS name")
-		dbFile   = flag.String("database.file", "qf.db", "database file")
-		public   = flag.String("http.public", "public", "path to content to serve")
-		httpAddr = flag.String("http.addr", ":8081", "HTTP listen address")
-		grpcAddr = flag.String("grpc.addr", ":9090", "gRPC listen address")
+		baseURL   = flag.String("service.url", "", "base service DNS name")
+		dbFile    = flag.String("database.file", "qf.db", "database file")
+		dbDriver  = flag.String("database.driver", database.SQLite, "database driver [sqlite|postgres|mysql]; the postgres and mysql data source name is read from the QUICKFEED_DATABASE_DSN environment variable")
+		maxConns  = flag.Int("database.maxconns", 0, "maximum number of open database connections (0 is unlimited)")
+		maxIdle   = flag.Int("database.maxidle", 0, "maximum number of idle database connections (default: 2)")
+		connLife  = flag.Duration("database.connlifetime", 0, "maximum time a database connection is reused (0 is forever)")
+		public    = flag.String("http.public", "public", "path to content to serve")
+		httpAddr  = flag.String("http.addr", ":8081", "HTTP listen address")
+		grpcAddr  = flag.String("grpc.addr", ":9090", "gRPC listen address")
+		cleanup   = flag.String("group.cleanup", "delete", "cleanup of deleted groups' repositories and teams [delete|archive]")
+		dryRun    = flag.Bool("group.dryrun", false, "log the repositories, teams and team members that group cleanup would remove, without removing them")
+		checks    = flag.Duration("checks.interval", 24*time.Hour, "interval between course checks: repository access repairs, orphaned resources and tests exposure (0 disables)")
+		revoke    = flag.Bool("exposure.revoke", false, "revoke student access to the tests repository found by course checks")
+		pools     = flag.String("runner.pools", "", "named runner pools as comma-separated name=dockerhost pairs, e.g., campus=unix:///var/run/docker.sock,cloud=tcp://10.0.0.2:2376")
+		overflow  = flag.String("runner.overflow", "", "runner pool that rebuilds overflow to when their runner pool is busy")
+		workers   = flag.Int("runner.workers", 10, "maximum number of concurrently running tests in each runner pool")
+		diagAddr  = flag.String("diagnostics.addr", "", "listen address for the admin-only profiling and diagnostics endpoints, e.g., localhost:6060 (default: served by the HTTP server)")
+		origins   = flag.String("grpcweb.origins", "", "comma-separated origins allowed to make cross-origin gRPC-web requests, e.g., https://example.com (default: same-origin only)")
+		certFile  = flag.String("http.cert", "", "TLS certificate file for serving HTTPS and HTTP/2 (default: plain HTTP)")
+		keyFile   = flag.String("http.key", "", "TLS key file for serving HTTPS and HTTP/2")
+		acmeHost  = flag.String("autocert.hosts", "", "comma-separated host names to obtain and renew certificates for from Let's Encrypt; the certificate files are the fallback")
+		acmeDir   = flag.String("autocert.cache", "autocert", "directory for caching certificates obtained from Let's Encrypt")
+		acmeHTTP  = flag.String("autocert.http", "", "listen address for the Let's Encrypt HTTP-01 challenges and HTTPS redirects, e.g., :80 (default: TLS-ALPN-01 challenges on the HTTP listen address)")
+		jplag     = flag.String("plagiarism.jplag", "", "command running JPlag for plagiarism checks, e.g., 'java -jar /opt/jplag.jar' (default: JPlag disabled)")
+		retain    = flag.Duration("database.retention", 30*24*time.Hour, "time deleted users, groups and enrollments are kept for restoring before they are permanently deleted (0 keeps them forever)")
+		payloads  = flag.Duration("webhook.retention", 14*24*time.Hour, "time webhook delivery payloads are kept for replays before they are deleted (0 keeps them forever)")
+		rollback  = flag.Int("database.rollback", -1, "roll back the database migrations newer than the given schema version, and exit (default: no rollback)")
+		artStore  = flag.String("artifacts.store", "", "directory, or URL of an S3-compatible bucket, e.g., https://s3.eu-north-1.amazonaws.com/artifacts, for storing test run artifacts; the bucket credentials are read from the ARTIFACTS_ACCESS_KEY and ARTIFACTS_SECRET_KEY environment variables (default: artifacts not stored)")
+		artRegion = flag.String("artifacts.region", "", "region of the artifacts bucket (default: us-east-1)")
+		cacheTTL  = flag.Duration("cache.ttl", time.Minute, "time the results of frequently called read RPCs are cached; cached results are invalidated by database updates (0 disables caching)")
+		drainTime = flag.Duration("shutdown.timeout", 5*time.Minute, "time to wait for running tests to complete when shutting down; queued tests are resumed on restart")
+		faults    = flag.String("faults", "", "inject faults into SCM calls and test runs for testing, e.g., latency=200ms,errors=0.05,ratelimit=0.01")
 	)
 	flag.Parse()
 
 	logger := logq.Zap(true)
 	defer logger.Sync()
 
-	db, err := database.NewGormDB(*dbFile, logger)
+	// shut down gracefully on interrupt and termination signals, e.g., from docker stop or Kubernetes
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	dbConfig := database.Config{
+		Driver:          *dbDriver,
+		DSN:             *dbFile,
+		MaxOpenConns:    *maxConns,
+		MaxIdleConns:    *maxIdle,
+		ConnMaxLifetime: *connLife,
+	}
+	if *dbDriver != database.SQLite {
+		dbConfig.DSN = os.Getenv("QUICKFEED_DATABASE_DSN")
+	}
+	db, err := database.OpenGormDB(dbConfig, logger)
 	if err != nil {
 		log.Fatalf("can't connect to database: %v\n", err)
 	}
+	if *rollback >= 0 {
+		if err := db.Rollback(uint64(*rollback)); err != nil {
+			log.Fatalf("failed to roll back database migrations: %v\n", err)
+		}
+		log.Printf("Rolled back database to schema version %d\n", *rollback)
+		return
+	}
 
 	// holds references for activated providers for current user token
 	scms := auth.NewScms()
@@ -80,11 +141,25 @@ func main() {
 		Secret:  os.Getenv("WEBHOOK_SECRET"),
 	}
 
-	runner, err := ci.NewDockerCI(logger)
+	dockerRunner, err := ci.NewDockerCI(logger)
 	if err != nil {
 		log.Fatalf("failed to set up docker client: %v\n", err)
 	}
-	defer runner.Close()
+	defer dockerRunner.Close()
+	var runner ci.Runner = dockerRunner
+
+	// inject faults into SCM calls and test runs, if configured
+	var injector *fault.Injector
+	if *faults != "" {
+		faultConfig, err := fault.ParseConfig(*faults)
+		if err != nil {
+			log.Fatalf("invalid fault configuration: %v\n", err)
+		}
+		injector = fault.NewInjector(faultConfig, time.Now().UnixNano())
+		scms.SetFaults(injector)
+		runner = ci.NewFaultyRunner(runner, injector)
+		log.Printf("Injecting faults: %+v\n", faultConfig)
+	}
 
 	// Add application token for external applications (to allow invoking gRPC methods)
 	// TODO(meling): this is a temporary solution, and we should find a better way to do this
@@ -94,28 +169,132 @@ func main() {
 		log.Println("Added application token")
 	}
 
+	groupCleanup, err := web.ParseGroupCleanup(*cleanup)
+	if err != nil {
+		log.Fatalf("invalid group cleanup policy: %v\n", err)
+	}
+
 	agService := web.NewAutograderService(logger, db, scms, bh, runner)
-	go web.New(agService, *public, *httpAddr)
+	agService.SetGroupCleanup(groupCleanup)
+	agService.SetGroupCleanupDryRun(*dryRun)
+	agService.SetCacheTTL(*cacheTTL)
+	if feedSecret := os.Getenv("QUICKFEED_FEED_SECRET"); feedSecret != "" {
+		agService.SetFeedSecret(feedSecret)
+	}
+	if certificateSecret := os.Getenv("QUICKFEED_CERTIFICATE_SECRET"); certificateSecret != "" {
+		agService.SetCertificateSecret(certificateSecret)
+	}
+	agService.SetRevokeExposure(*revoke)
+	if mossUserID := os.Getenv("MOSS_USERID"); mossUserID != "" {
+		agService.AddPlagiarismChecker("moss", plagiarism.NewMOSS(mossUserID))
+	}
+	if *jplag != "" {
+		agService.AddPlagiarismChecker("jplag", plagiarism.NewJPlag(*jplag))
+	}
+	agService.SetDiagnosticsAddr(*diagAddr)
+	if *artStore != "" {
+		store, err := artifacts.Open(*artStore, *artRegion, os.Getenv("ARTIFACTS_ACCESS_KEY"), os.Getenv("ARTIFACTS_SECRET_KEY"))
+		if err != nil {
+			log.Fatalf("failed to open artifact store: %v\n", err)
+		}
+		agService.SetArtifactStore(store)
+	}
+	poolHosts, err := ci.ParsePools(*pools)
+	if err != nil {
+		log.Fatalf("invalid runner pools: %v\n", err)
+	}
+	for name, host := range poolHosts {
+		poolRunner, err := ci.NewDockerCIWithHost(logger, host)
+		if err != nil {
+			log.Fatalf("failed to set up docker client for runner pool %s: %v\n", name, err)
+		}
+		defer poolRunner.Close()
+		if injector != nil {
+			agService.AddRunnerPool(name, ci.NewFaultyRunner(poolRunner, injector))
+			continue
+		}
+		agService.AddRunnerPool(name, poolRunner)
+	}
+	if *overflow != "" {
+		if err := agService.SetOverflowPool(*overflow); err != nil {
+			log.Fatalf("invalid overflow runner pool: %v\n", err)
+		}
+	}
+	agService.SetTestWorkers(*workers)
+	if err := agService.ResumeTestJobs(); err != nil {
+		log.Printf("Failed to resume test jobs: %v\n", err)
+	}
+	if *checks > 0 {
+		go agService.RunCourseChecks(ctx, *checks)
+	}
+	if *retain > 0 {
+		go agService.RunRetentionPolicy(ctx, *retain)
+	}
+	if *payloads > 0 {
+		go agService.RunWebhookRetention(ctx, *payloads)
+	}
+
+	opt := grpc.ChainUnaryInterceptor(auth.TokenVerifier(logger.Sugar(), db), auth.UserVerifier(db), pb.Interceptor(logger), agService.AccessControl())
+	streamOpt := grpc.ChainStreamInterceptor(auth.StreamUserVerifier(db))
+	grpcServer := grpc.NewServer(opt, streamOpt)
+	pb.RegisterAutograderServiceServer(grpcServer, agService)
+	var allowedOrigins []string
+	if *origins != "" {
+		allowedOrigins = strings.Split(*origins, ",")
+	}
+	// the web server serves gRPC-web requests directly, without a proxy translating them
+	agService.SetGRPCWeb(grpcServer, allowedOrigins)
+	tlsOpts := web.TLSOptions{
+		CertFile:      *certFile,
+		KeyFile:       *keyFile,
+		CacheDir:      *acmeDir,
+		ChallengeAddr: *acmeHTTP,
+	}
+	if *acmeHost != "" {
+		tlsOpts.Hosts = strings.Split(*acmeHost, ",")
+	}
+	agService.SetTLS(tlsOpts)
+	webServer := web.New(agService, *public, *httpAddr)
 
 	lis, err := net.Listen("tcp", *grpcAddr)
 	if err != nil {
 		log.Fatalf("failed to start tcp listener: %v\n", err)
 	}
-	opt := grpc.ChainUnaryInterceptor(auth.UserVerifier(), pb.Interceptor(logger))
-	grpcServer := grpc.NewServer(opt)
 	// Create a HTTP server for prometheus.
 	httpServer := &http.Server{
 		Handler: promhttp.HandlerFor(reg, promhttp.HandlerOpts{}),
 		Addr:    fmt.Sprintf("0.0.0.0:%d", 9097),
 	}
 	go func() {
-		if err := httpServer.ListenAndServe(); err != nil {
+		if err := httpServer.ListenAndServe(); err != http.ErrServerClosed {
 			log.Fatal("Unable to start a http server.")
 		}
 	}()
 
-	pb.RegisterAutograderServiceServer(grpcServer, agService)
-	if err := grpcServer.Serve(lis); err != nil {
-		log.Fatalf("failed to start grpc server: %v\n", err)
+	go func() {
+		if err := grpcServer.Serve(lis); err != nil {
+			log.Fatalf("failed to start grpc server: %v\n", err)
+		}
+	}()
+
+	<-ctx.Done()
+	stop()
+	log.Printf("Shutting down; waiting up to %v for running tests to complete\n", *drainTime)
+	drainCtx, cancel := context.WithTimeout(context.Background(), *drainTime)
+	defer cancel()
+	if err := agService.Shutdown(drainCtx); err != nil {
+		log.Printf("Stopped waiting for running tests: %v\n", err)
+	}
+	shutdownCtx, cancelShutdown := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancelShutdown()
+	if err := webServer.Shutdown(shutdownCtx); err != nil {
+		log.Printf("Failed to shut down web server: %v\n", err)
+	}
+	grpcServer.Stop()
+	if err := httpServer.Shutdown(shutdownCtx); err != nil {
+		log.Printf("Failed to shut down metrics server: %v\n", err)
+	}
+	if err := db.Close(); err != nil {
+		log.Printf("Failed to close database: %v\n", err)
 	}
 }
@@ -0,0 +1,37 @@
+package notify
+
+import (
+	"context"
+
+	pb "github.com/autograde/quickfeed/ag"
+)
+
+// Store stores in-app notifications.
+type Store interface {
+	CreateNotifications([]*pb.Notification) error
+}
+
+// InApp is a notifier that stores a notification for each user in the given store,
+// from where the users' notification feeds are served.
+type InApp struct {
+	store Store
+}
+
+// NewInApp returns a notifier storing notifications in the given store.
+func NewInApp(store Store) *InApp {
+	return &InApp{store: store}
+}
+
+// Notify implements the Notifier interface.
+func (n *InApp) Notify(_ context.Context, notification *Notification) error {
+	notifications := make([]*pb.Notification, 0, len(notification.UserIDs))
+	for _, userID := range notification.UserIDs {
+		notifications = append(notifications, &pb.Notification{
+			UserID:   userID,
+			CourseID: notification.CourseID,
+			Subject:  notification.Subject,
+			Body:     notification.Body,
+		})
+	}
+	return n.store.CreateNotifications(notifications)
+}
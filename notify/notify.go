@@ -0,0 +1,62 @@
+// Package notify provides a dispatcher that delivers notifications about
+// course events, such as new announcements, to course participants.
+package notify
+
+import (
+	"context"
+	"sync"
+
+	"go.uber.org/zap"
+)
+
+// Notification is a message about a course event delivered to a set of users.
+type Notification struct {
+	CourseID uint64
+	UserIDs  []uint64
+	Subject  string
+	Body     string
+}
+
+// Notifier delivers notifications to users, e.g., by email or in-app.
+type Notifier interface {
+	Notify(context.Context, *Notification) error
+}
+
+// Dispatcher delivers notifications to all registered notifiers.
+type Dispatcher struct {
+	logger    *zap.SugaredLogger
+	mu        sync.RWMutex
+	notifiers []Notifier
+}
+
+// NewDispatcher returns a dispatcher delivering notifications to the given notifiers.
+func NewDispatcher(logger *zap.Logger, notifiers ...Notifier) *Dispatcher {
+	return &Dispatcher{
+		logger:    logger.Sugar(),
+		notifiers: notifiers,
+	}
+}
+
+// Register adds a notifier to the dispatcher.
+func (d *Dispatcher) Register(notifier Notifier) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.notifiers = append(d.notifiers, notifier)
+}
+
+// Dispatch delivers the notification to all registered notifiers.
+// Delivery failures are logged, but do not prevent delivery by other notifiers.
+func (d *Dispatcher) Dispatch(ctx context.Context, notification *Notification) {
+	if len(notification.UserIDs) == 0 {
+		return
+	}
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	d.logger.Debugf("Dispatching notification %q to %d users in course %d",
+		notification.Subject, len(notification.UserIDs), notification.CourseID)
+	for _, notifier := range d.notifiers {
+		if err := notifier.Notify(ctx, notification); err != nil {
+			d.logger.Errorf("Failed to deliver notification %q: %v", notification.Subject, err)
+		}
+	}
+}
@@ -0,0 +1,113 @@
+package plagiarism
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/autograde/quickfeed/ci"
+)
+
+// jplagLanguages maps programming languages to the names of JPlag's language modules.
+var jplagLanguages = map[string]string{
+	"go":     "golang",
+	"python": "python3",
+	"c":      "cpp",
+	"cc":     "cpp",
+}
+
+// jplagResults is the CSV file with the similarity of each pair of submissions,
+// exported by JPlag to the result directory.
+const jplagResults = "results.csv"
+
+// JPlag checks submissions for plagiarism by running JPlag (https://github.com/jplag/JPlag) locally.
+type JPlag struct {
+	Command string    // the command running JPlag, e.g., java -jar /opt/jplag.jar
+	Runner  ci.Runner // the runner running the command
+}
+
+// NewJPlag returns a JPlag checker running JPlag with the given command on the local machine.
+func NewJPlag(command string) *JPlag {
+	return &JPlag{Command: command, Runner: &ci.Local{}}
+}
+
+// Check runs JPlag on the submissions and returns the similarity of each pair of submissions.
+func (j *JPlag) Check(ctx context.Context, language string, submissions []*Submission) ([]*Match, error) {
+	if language == "" {
+		language = DefaultLanguage
+	}
+	if module, ok := jplagLanguages[language]; ok {
+		language = module
+	}
+	dir, err := ioutil.TempDir("", "jplag")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dir)
+
+	// JPlag compares the subdirectories of the root directory, each named by the submission's ID
+	rootDir := filepath.Join(dir, "submissions")
+	if err := os.Mkdir(rootDir, 0o700); err != nil {
+		return nil, err
+	}
+	for _, submission := range fetched(submissions) {
+		if err := os.Symlink(submission.Dir, filepath.Join(rootDir, strconv.FormatUint(submission.ID, 10))); err != nil {
+			return nil, err
+		}
+	}
+	resultDir := filepath.Join(dir, "results")
+	job := &ci.Job{
+		Commands: []string{
+			fmt.Sprintf("%s --language %s --csv-export --result-directory %s %s", j.Command, language, resultDir, rootDir),
+		},
+	}
+	if out, err := j.Runner.Run(ctx, job); err != nil {
+		return nil, fmt.Errorf("failed to run JPlag: %w: %s", err, out)
+	}
+	f, err := os.Open(filepath.Join(resultDir, jplagResults))
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return parseJPlagResults(f)
+}
+
+// parseJPlagResults parses the rows of JPlag's CSV export, each with the names of two submissions
+// and their similarity as a fraction. Rows without a similarity, such as the header, are skipped.
+func parseJPlagResults(r io.Reader) ([]*Match, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	var matches []*Match
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			return matches, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if len(record) < 3 {
+			continue
+		}
+		similarity, err := strconv.ParseFloat(strings.TrimSpace(record[2]), 32)
+		if err != nil || math.IsNaN(similarity) {
+			continue
+		}
+		first, err := strconv.ParseUint(strings.TrimSpace(record[0]), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("unexpected submission %q in JPlag results", record[0])
+		}
+		second, err := strconv.ParseUint(strings.TrimSpace(record[1]), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("unexpected submission %q in JPlag results", record[1])
+		}
+		matches = append(matches, &Match{FirstID: first, SecondID: second, Similarity: float32(similarity * 100)})
+	}
+}
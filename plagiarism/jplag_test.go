@@ -0,0 +1,41 @@
+package plagiarism
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/autograde/quickfeed/ci"
+)
+
+// fakeJPlag is a shell function with the same arguments as JPlag, which exports the
+// similarity of submissions 1 and 2, provided that the submissions are found.
+const fakeJPlag = `jplag() {
+	test "$2" = golang && test -d "$6/1" && test -d "$6/2" || exit 1
+	mkdir -p "$5" && printf 'submission1,submission2,similarity\n1,2,0.875\n2,1,nan\n' > "$5/results.csv"
+}; jplag`
+
+func TestJPlag(t *testing.T) {
+	dir := t.TempDir()
+	writeFiles(t, dir, map[string]string{"1/main.go": "package main", "2/main.go": "package main"})
+	submissions := []*Submission{{ID: 1, Dir: dir + "/1"}, {ID: 2, Dir: dir + "/2"}}
+
+	jplag := &JPlag{Command: fakeJPlag, Runner: &ci.Local{}}
+	matches, err := jplag.Check(context.Background(), "go", submissions)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(matches) != 1 || *matches[0] != (Match{FirstID: 1, SecondID: 2, Similarity: 87.5}) {
+		t.Errorf("Check() = %v, want submissions 1 and 2 with similarity 87.5", matches)
+	}
+	if _, err := jplag.Check(context.Background(), "java", submissions); err == nil {
+		t.Error("Check() with failing JPlag succeeded, want error")
+	}
+}
+
+func TestParseJPlagResults(t *testing.T) {
+	_, err := parseJPlagResults(strings.NewReader("alice,2,0.5\n"))
+	if err == nil {
+		t.Error("parseJPlagResults() with unknown submission succeeded, want error")
+	}
+}
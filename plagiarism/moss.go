@@ -0,0 +1,177 @@
+package plagiarism
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// MOSSAddr is the address of the MOSS server at Stanford.
+const MOSSAddr = "moss.stanford.edu:7690"
+
+// mossMaxMatches is the number of times a code fragment may appear before
+// MOSS ignores it as legitimately shared code, such as provided skeleton code.
+const mossMaxMatches = 10
+
+// mossShow is the number of matching submission pairs shown in MOSS results.
+const mossShow = 250
+
+// MOSS checks submissions for plagiarism using the MOSS service (https://theory.stanford.edu/~aiken/moss/).
+type MOSS struct {
+	UserID string       // the registered MOSS user ID
+	Addr   string       // the address of the MOSS server; empty for MOSSAddr
+	Client *http.Client // the client for fetching the results; nil for http.DefaultClient
+}
+
+// NewMOSS returns a MOSS checker for the given registered MOSS user ID.
+func NewMOSS(userID string) *MOSS {
+	return &MOSS{UserID: userID}
+}
+
+// Check uploads the submissions to the MOSS server and returns the matches reported by the server.
+func (m *MOSS) Check(ctx context.Context, language string, submissions []*Submission) ([]*Match, error) {
+	if language == "" {
+		language = DefaultLanguage
+	}
+	resultsURL, err := m.upload(ctx, language, fetched(submissions))
+	if err != nil {
+		return nil, err
+	}
+	return m.results(ctx, resultsURL)
+}
+
+// upload sends the submitted code to the MOSS server and returns the URL of the results.
+// Each submission's files are named by the submission's ID, so that they are reported
+// as directories named by the submission's ID in the results.
+func (m *MOSS) upload(ctx context.Context, language string, submissions []*Submission) (string, error) {
+	addr := m.Addr
+	if addr == "" {
+		addr = MOSSAddr
+	}
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return "", err
+	}
+	defer conn.Close()
+	if deadline, ok := ctx.Deadline(); ok {
+		if err := conn.SetDeadline(deadline); err != nil {
+			return "", err
+		}
+	}
+	w := bufio.NewWriter(conn)
+	r := bufio.NewReader(conn)
+
+	fmt.Fprintf(w, "moss %s\n", m.UserID)
+	fmt.Fprintf(w, "directory 1\n")
+	fmt.Fprintf(w, "X 0\n")
+	fmt.Fprintf(w, "maxmatches %d\n", mossMaxMatches)
+	fmt.Fprintf(w, "show %d\n", mossShow)
+	fmt.Fprintf(w, "language %s\n", language)
+	if err := w.Flush(); err != nil {
+		return "", err
+	}
+	reply, err := r.ReadString('\n')
+	if err != nil {
+		return "", err
+	}
+	if strings.TrimSpace(reply) == "no" {
+		return "", fmt.Errorf("language %q not supported by MOSS", language)
+	}
+
+	fileID := 1
+	for _, submission := range submissions {
+		files, err := sourceFiles(submission.Dir, language)
+		if err != nil {
+			return "", err
+		}
+		for _, file := range files {
+			content, err := ioutil.ReadFile(filepath.Join(submission.Dir, file))
+			if err != nil {
+				return "", err
+			}
+			name := strconv.FormatUint(submission.ID, 10) + "/" + strings.ReplaceAll(filepath.ToSlash(file), " ", "_")
+			fmt.Fprintf(w, "file %d %s %d %s\n", fileID, language, len(content), name)
+			if _, err := w.Write(content); err != nil {
+				return "", err
+			}
+			fileID++
+		}
+	}
+	fmt.Fprintf(w, "query 0 quickfeed\n")
+	if err := w.Flush(); err != nil {
+		return "", err
+	}
+	resultsURL, err := r.ReadString('\n')
+	if err != nil {
+		return "", err
+	}
+	resultsURL = strings.TrimSpace(resultsURL)
+	fmt.Fprintf(w, "end\n")
+	_ = w.Flush()
+	if !strings.HasPrefix(resultsURL, "http") {
+		return "", fmt.Errorf("unexpected reply from MOSS server: %q", resultsURL)
+	}
+	return resultsURL, nil
+}
+
+// mossMatch matches a link to the comparison of a submission in the MOSS results,
+// e.g., <A HREF="http://moss.stanford.edu/results/1/2/match0.html">42/ (35%)</A>.
+var mossMatch = regexp.MustCompile(`(?i)<a href="([^"]+)">\s*(\d+)/?\s+\((\d+)%\)</a>`)
+
+// results fetches and parses the results page of the MOSS server.
+// The results list matching pairs of submissions, each linking to their comparison.
+func (m *MOSS) results(ctx context.Context, resultsURL string) ([]*Match, error) {
+	client := m.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, resultsURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to get MOSS results %s: %s", resultsURL, resp.Status)
+	}
+	page, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	links := mossMatch.FindAllStringSubmatch(string(page), -1)
+	var matches []*Match
+	for i := 0; i+1 < len(links); i += 2 {
+		first, second := links[i], links[i+1]
+		match := &Match{URL: first[1]}
+		if match.FirstID, err = strconv.ParseUint(first[2], 10, 64); err != nil {
+			return nil, err
+		}
+		if match.SecondID, err = strconv.ParseUint(second[2], 10, 64); err != nil {
+			return nil, err
+		}
+		// each percentage is the portion of the submission's code matching the other submission
+		for _, percent := range []string{first[3], second[3]} {
+			similarity, err := strconv.ParseFloat(percent, 32)
+			if err != nil {
+				return nil, err
+			}
+			if float32(similarity) > match.Similarity {
+				match.Similarity = float32(similarity)
+			}
+		}
+		matches = append(matches, match)
+	}
+	return matches, nil
+}
@@ -0,0 +1,124 @@
+package plagiarism
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// fakeMOSS serves the MOSS protocol, recording the names of the uploaded files
+// and replying with the given results URL.
+func fakeMOSS(t *testing.T, language, resultsURL string, files chan<- string) string {
+	t.Helper()
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { lis.Close() })
+	go func() {
+		conn, err := lis.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		defer close(files)
+		r := bufio.NewReader(conn)
+		for {
+			line, err := r.ReadString('\n')
+			if err != nil {
+				return
+			}
+			fields := strings.Fields(line)
+			switch fields[0] {
+			case "language":
+				if fields[1] != language {
+					fmt.Fprintln(conn, "no")
+					return
+				}
+				fmt.Fprintln(conn, "yes")
+			case "file":
+				var size int
+				fmt.Sscan(fields[3], &size)
+				if _, err := io.CopyN(io.Discard, r, int64(size)); err != nil {
+					return
+				}
+				files <- fields[4]
+			case "query":
+				fmt.Fprintln(conn, resultsURL)
+			case "end":
+				return
+			}
+		}
+	}()
+	return lis.Addr().String()
+}
+
+const mossResultsPage = `<HTML><TABLE>
+<TR><TH>File 1<TH>File 2<TH>Lines Matched
+<TR><TD><A HREF="http://moss.example.com/results/1/match0.html">1/ (35%)</A>
+    <TD><A HREF="http://moss.example.com/results/1/match0.html">2/ (62%)</A>
+<TD ALIGN=right>21
+<TR><TD><A HREF="http://moss.example.com/results/1/match1.html">3/ (10%)</A>
+    <TD><A HREF="http://moss.example.com/results/1/match1.html">1/ (8%)</A>
+<TD ALIGN=right>4
+</TABLE></HTML>`
+
+func TestMOSS(t *testing.T) {
+	results := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, mossResultsPage)
+	}))
+	defer results.Close()
+
+	dir := t.TempDir()
+	writeFiles(t, dir, map[string]string{
+		"1/main.go":     "package main",
+		"1/README.md":   "# lab1",
+		"2/main.go":     "package main",
+		"2/lab/util.go": "package lab",
+		"3/main.go":     "package main",
+	})
+	submissions := []*Submission{
+		{ID: 1, Dir: dir + "/1"},
+		{ID: 2, Dir: dir + "/2"},
+		{ID: 3, Dir: dir + "/3"},
+		{ID: 4}, // not fetched
+	}
+
+	files := make(chan string, 10)
+	moss := &MOSS{UserID: "12345", Addr: fakeMOSS(t, "go", results.URL, files)}
+	matches, err := moss.Check(context.Background(), "", submissions)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var uploaded []string
+	for file := range files {
+		uploaded = append(uploaded, file)
+	}
+	wantFiles := "1/main.go,2/lab/util.go,2/main.go,3/main.go"
+	if strings.Join(uploaded, ",") != wantFiles {
+		t.Errorf("MOSS uploaded %v, want %s", uploaded, wantFiles)
+	}
+	want := []Match{
+		{FirstID: 1, SecondID: 2, Similarity: 62, URL: "http://moss.example.com/results/1/match0.html"},
+		{FirstID: 3, SecondID: 1, Similarity: 10, URL: "http://moss.example.com/results/1/match1.html"},
+	}
+	if len(matches) != len(want) {
+		t.Fatalf("Check() returned %d matches, want %d", len(matches), len(want))
+	}
+	for i, match := range matches {
+		if *match != want[i] {
+			t.Errorf("Check() match %d = %+v, want %+v", i, *match, want[i])
+		}
+	}
+
+	unsupported := &MOSS{UserID: "12345", Addr: fakeMOSS(t, "go", results.URL, make(chan string, 10))}
+	if _, err := unsupported.Check(context.Background(), "brainfuck", submissions); err == nil {
+		t.Error("Check() with unsupported language succeeded, want error")
+	}
+}
@@ -0,0 +1,127 @@
+// Package plagiarism compares the code submitted by students for an assignment
+// using external plagiarism detectors, such as MOSS and JPlag.
+package plagiarism
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/autograde/quickfeed/ci"
+)
+
+// DefaultLanguage is the programming language of the submissions if none is given.
+const DefaultLanguage = "go"
+
+// extensions maps programming languages to the file extensions of their source files.
+var extensions = map[string][]string{
+	"go":         {".go"},
+	"java":       {".java"},
+	"c":          {".c", ".h"},
+	"cc":         {".cc", ".cpp", ".h", ".hpp"},
+	"python":     {".py"},
+	"javascript": {".js"},
+	"csharp":     {".cs"},
+	"haskell":    {".hs"},
+}
+
+// Submission is the code submitted by a student or group for an assignment.
+type Submission struct {
+	ID       uint64 // the submission's ID
+	Name     string // the student's login or the group's name
+	CloneURL string // the URL for cloning the submission's repository
+	Commit   string // the submitted commit; empty for the default branch
+	Dir      string // the directory with the submitted code; set by Fetch
+}
+
+// Match is the similarity of the code of two submissions.
+type Match struct {
+	FirstID    uint64  // the first submission's ID
+	SecondID   uint64  // the second submission's ID
+	Similarity float32 // the percentage of similar code
+	URL        string  // the detector's comparison of the two submissions, if available
+}
+
+// Checker compares the code of submissions for similarity.
+type Checker interface {
+	// Check returns the similarity of the pairs of submissions with similar code,
+	// written in the given programming language.
+	Check(ctx context.Context, language string, submissions []*Submission) ([]*Match, error)
+}
+
+// Fetch clones the repository of each submission into its own directory in dir
+// and checks out the submitted commit. The submission's Dir is set to the subpath
+// of the cloned repository, typically the assignment's directory. Submissions
+// without code in the subpath are left without a Dir and should not be checked.
+func Fetch(ctx context.Context, runner ci.Runner, dir, subpath string, submissions []*Submission) error {
+	for _, submission := range submissions {
+		repoDir := filepath.Join(dir, strconv.FormatUint(submission.ID, 10))
+		commands := []string{"git clone --quiet " + submission.CloneURL + " " + repoDir}
+		if submission.Commit != "" {
+			commands = append(commands, "git -C "+repoDir+" checkout --quiet "+submission.Commit)
+		}
+		if _, err := runner.Run(ctx, &ci.Job{Commands: commands}); err != nil {
+			// the clone URL is not included in the error, since it may contain an access token
+			return fmt.Errorf("failed to fetch submission %d by %s: %w", submission.ID, submission.Name, err)
+		}
+		codeDir := filepath.Join(repoDir, subpath)
+		if info, err := os.Stat(codeDir); err == nil && info.IsDir() {
+			submission.Dir = codeDir
+		}
+	}
+	return nil
+}
+
+// fetched returns the submissions with code to check.
+func fetched(submissions []*Submission) []*Submission {
+	var withCode []*Submission
+	for _, submission := range submissions {
+		if submission.Dir != "" {
+			withCode = append(withCode, submission)
+		}
+	}
+	return withCode
+}
+
+// sourceFiles returns the paths, relative to dir, of the source files in dir
+// for the given language, or all files if the language has no known extensions.
+// Hidden files and directories, such as .git, are skipped.
+func sourceFiles(dir, language string) ([]string, error) {
+	exts := extensions[language]
+	var files []string
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if path != dir && info.Name()[0] == '.' {
+			if info.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !info.Mode().IsRegular() || !hasExtension(path, exts) {
+			return nil
+		}
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		files = append(files, rel)
+		return nil
+	})
+	return files, err
+}
+
+func hasExtension(path string, exts []string) bool {
+	if len(exts) == 0 {
+		return true
+	}
+	for _, ext := range exts {
+		if filepath.Ext(path) == ext {
+			return true
+		}
+	}
+	return false
+}
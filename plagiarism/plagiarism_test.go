@@ -0,0 +1,122 @@
+package plagiarism
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/autograde/quickfeed/ci"
+)
+
+// writeFiles writes the given files, by path relative to dir, with the given contents.
+func writeFiles(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(path, []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+// gitRepo creates a git repository in dir with a commit for each of the given sets of files,
+// and returns the commit hashes.
+func gitRepo(t *testing.T, dir string, commits ...map[string]string) []string {
+	t.Helper()
+	git := func(args ...string) string {
+		cmd := exec.Command("git", append([]string{"-c", "user.name=test", "-c", "user.email=test@example.com"}, args...)...)
+		cmd.Dir = dir
+		out, err := cmd.CombinedOutput()
+		if err != nil {
+			t.Fatalf("git %s: %v: %s", strings.Join(args, " "), err, out)
+		}
+		return strings.TrimSpace(string(out))
+	}
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		t.Fatal(err)
+	}
+	git("init", "--quiet")
+	var hashes []string
+	for _, files := range commits {
+		writeFiles(t, dir, files)
+		git("add", "-A")
+		git("commit", "--quiet", "-m", "commit")
+		hashes = append(hashes, git("rev-parse", "HEAD"))
+	}
+	return hashes
+}
+
+func TestFetch(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not installed")
+	}
+	dir := t.TempDir()
+	alice := filepath.Join(dir, "alice-labs")
+	hashes := gitRepo(t, alice,
+		map[string]string{"lab1/main.go": "package main // submitted"},
+		map[string]string{"lab1/main.go": "package main // after the submission"},
+	)
+	bob := filepath.Join(dir, "bob-labs")
+	gitRepo(t, bob, map[string]string{"lab2/main.go": "package main"})
+
+	submissions := []*Submission{
+		{ID: 1, Name: "alice", CloneURL: alice, Commit: hashes[0]},
+		{ID: 2, Name: "bob", CloneURL: bob},
+	}
+	if err := Fetch(context.Background(), &ci.Local{}, filepath.Join(dir, "fetched"), "lab1", submissions); err != nil {
+		t.Fatal(err)
+	}
+	content, err := ioutil.ReadFile(filepath.Join(submissions[0].Dir, "main.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(content) != "package main // submitted" {
+		t.Errorf("Fetch() checked out %q, want the submitted commit", content)
+	}
+	// bob has no code for lab1
+	if submissions[1].Dir != "" {
+		t.Errorf("Fetch() set Dir = %q for submission without code, want empty", submissions[1].Dir)
+	}
+	if got := fetched(submissions); len(got) != 1 || got[0].ID != 1 {
+		t.Errorf("fetched() = %v, want only submission 1", got)
+	}
+
+	missing := []*Submission{{ID: 3, Name: "carol", CloneURL: filepath.Join(dir, "missing")}}
+	if err := Fetch(context.Background(), &ci.Local{}, filepath.Join(dir, "fetched"), "lab1", missing); err == nil {
+		t.Error("Fetch() of missing repository succeeded, want error")
+	}
+}
+
+func TestSourceFiles(t *testing.T) {
+	dir := t.TempDir()
+	writeFiles(t, dir, map[string]string{
+		"main.go":      "package main",
+		"util/util.go": "package util",
+		"README.md":    "# lab1",
+		".git/config":  "[core]",
+		".hidden.go":   "package main",
+	})
+	files, err := sourceFiles(dir, "go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"main.go", filepath.Join("util", "util.go")}
+	if strings.Join(files, ",") != strings.Join(want, ",") {
+		t.Errorf("sourceFiles(go) = %v, want %v", files, want)
+	}
+	// all files are compared for languages without known extensions
+	files, err = sourceFiles(dir, "ascii")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 3 {
+		t.Errorf("sourceFiles(ascii) = %v, want 3 files", files)
+	}
+}
@@ -0,0 +1,1100 @@
+package scm
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/gosimple/slug"
+	"go.uber.org/zap"
+)
+
+// BitbucketSCM implements the SCM interface for Bitbucket Server and Data Center,
+// using its REST API. Organizations are Bitbucket projects, identified by their
+// project key, and teams are Bitbucket groups named after the project key.
+// Organization members are the members of the project's members group.
+type BitbucketSCM struct {
+	logger  *zap.SugaredLogger
+	client  *http.Client
+	baseURL string
+	token   string
+}
+
+// NewBitbucketSCMClient returns a new Bitbucket client implementing the SCM interface,
+// for the Bitbucket server at baseURL, e.g., https://bitbucket.example.com.
+func NewBitbucketSCMClient(logger *zap.SugaredLogger, baseURL, token string) *BitbucketSCM {
+	return &BitbucketSCM{
+		logger:  logger,
+		client:  &http.Client{Timeout: 30 * time.Second, Transport: newRateLimitTransport(logger, "bitbucket", nil)},
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+		token:   token,
+	}
+}
+
+// CreateOrganization implements the SCM interface.
+func (s *BitbucketSCM) CreateOrganization(ctx context.Context, opt *OrganizationOptions) (*pb.Organization, error) {
+	if !opt.valid() {
+		return nil, ErrMissingFields{
+			Method:  "CreateOrganization",
+			Message: fmt.Sprintf("%+v", opt),
+		}
+	}
+	name := opt.Name
+	if name == "" {
+		name = opt.Path
+	}
+	var project bitbucketProject
+	in := map[string]string{"key": bitbucketProjectKey(opt.Path), "name": name}
+	if err := s.do(ctx, http.MethodPost, apiPath("projects"), nil, in, &project); err != nil {
+		return nil, fmt.Errorf("CreateOrganization: failed to create Bitbucket project %s: %w", opt.Path, err)
+	}
+	if err := s.do(ctx, http.MethodPost, apiPath("admin", "groups"), url.Values{"name": {bitbucketMembersGroup(project.Key)}}, nil, nil); err != nil {
+		return nil, fmt.Errorf("CreateOrganization: failed to create members group for Bitbucket project %s: %w", project.Key, err)
+	}
+	if err := s.UpdateOrganization(ctx, &OrganizationOptions{Path: project.Key, DefaultPermission: opt.DefaultPermission}); err != nil {
+		return nil, err
+	}
+	return &pb.Organization{
+		ID:     project.ID,
+		Path:   project.Key,
+		Avatar: project.Avatar,
+	}, nil
+}
+
+// UpdateOrganization implements the SCM interface.
+// The default permission is the members' permission on all repositories of the project.
+// Only project administrators can create repositories in Bitbucket; hence,
+// members can never create repositories.
+func (s *BitbucketSCM) UpdateOrganization(ctx context.Context, opt *OrganizationOptions) error {
+	if !opt.valid() {
+		return ErrMissingFields{
+			Method:  "UpdateOrganization",
+			Message: fmt.Sprintf("%+v", opt),
+		}
+	}
+	key := bitbucketProjectKey(opt.Path)
+	members := url.Values{"name": {bitbucketMembersGroup(key)}}
+	path := apiPath("projects", key, "permissions", "groups")
+	var err error
+	switch opt.DefaultPermission {
+	case OrgNone:
+		err = s.do(ctx, http.MethodDelete, path, members, nil, nil)
+	case OrgPull:
+		members.Set("permission", "PROJECT_READ")
+		err = s.do(ctx, http.MethodPut, path, members, nil, nil)
+	case OrgPush:
+		members.Set("permission", "PROJECT_WRITE")
+		err = s.do(ctx, http.MethodPut, path, members, nil, nil)
+	case OrgFull:
+		members.Set("permission", "PROJECT_ADMIN")
+		err = s.do(ctx, http.MethodPut, path, members, nil, nil)
+	default:
+		return fmt.Errorf("UpdateOrganization: unknown default permission %q", opt.DefaultPermission)
+	}
+	if err != nil {
+		return fmt.Errorf("UpdateOrganization: failed to update default permission of Bitbucket project %s: %w", key, err)
+	}
+	return nil
+}
+
+// GetOrganization implements the SCM interface.
+func (s *BitbucketSCM) GetOrganization(ctx context.Context, opt *GetOrgOptions) (*pb.Organization, error) {
+	if !opt.valid() {
+		return nil, ErrMissingFields{
+			Method:  "GetOrganization",
+			Message: fmt.Sprintf("%+v", opt),
+		}
+	}
+	project, err := s.project(ctx, opt.ID, opt.Name)
+	if err != nil {
+		return nil, ErrFailedSCM{
+			Method:   "GetOrganization",
+			Message:  fmt.Sprintf("could not find Bitbucket project %s (ID %d)", opt.Name, opt.ID),
+			GitError: err,
+		}
+	}
+	// if user name is provided, return the found project only if the user is one of its administrators
+	if opt.Username != "" {
+		var permission string
+		err := s.pages(ctx, apiPath("projects", project.Key, "permissions", "users"), url.Values{"filter": {opt.Username}}, func(values json.RawMessage) (bool, error) {
+			var permissions []bitbucketUserPermission
+			if err := json.Unmarshal(values, &permissions); err != nil {
+				return true, err
+			}
+			for _, p := range permissions {
+				if p.User.Name == opt.Username {
+					permission = p.Permission
+					return true, nil
+				}
+			}
+			return false, nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("GetOrganization: failed to get permissions of %s in Bitbucket project %s: %w", opt.Username, project.Key, err)
+		}
+		switch permission {
+		case "PROJECT_ADMIN":
+		case "":
+			return nil, ErrNotMember
+		default:
+			return nil, ErrNotOwner
+		}
+	}
+	return &pb.Organization{
+		ID:     project.ID,
+		Path:   project.Key,
+		Avatar: project.Avatar,
+	}, nil
+}
+
+// CreateRepository implements the SCM interface.
+func (s *BitbucketSCM) CreateRepository(ctx context.Context, opt *CreateRepositoryOptions) (*Repository, error) {
+	if !opt.valid() {
+		return nil, ErrMissingFields{
+			Method:  "CreateRepository",
+			Message: fmt.Sprintf("%+v", opt),
+		}
+	}
+	if opt.Template != nil {
+		// Bitbucket Server has no template repositories
+		return nil, ErrNotSupported{
+			SCM:    "bitbucket",
+			Method: "CreateRepository",
+		}
+	}
+	key := bitbucketProjectKey(opt.Organization.GetPath())
+	// check that repo does not already exist in the project
+	var repo bitbucketRepository
+	err := s.do(ctx, http.MethodGet, apiPath("projects", key, "repos", slug.Make(opt.Path)), nil, nil, &repo)
+	if err == nil {
+		s.logger.Debugf("CreateRepository: found existing repository (skipping creation): %s: %v", opt.Path, repo)
+		return repo.toRepository(), nil
+	}
+	if !errors.Is(err, errBitbucketNotFound) {
+		s.logger.Debugf("CreateRepository: check for repository %s: %s", opt.Path, err)
+	}
+	in := map[string]interface{}{"name": opt.Path, "scmId": "git", "public": !opt.Private}
+	if err := s.do(ctx, http.MethodPost, apiPath("projects", key, "repos"), nil, in, &repo); err != nil {
+		return nil, ErrFailedSCM{
+			Method:   "CreateRepository",
+			Message:  fmt.Sprintf("failed to create repository %s, make sure it does not already exist", opt.Path),
+			GitError: err,
+		}
+	}
+	return repo.toRepository(), nil
+}
+
+// GetRepository implements the SCM interface.
+func (s *BitbucketSCM) GetRepository(ctx context.Context, opt *RepositoryOptions) (*Repository, error) {
+	if !opt.valid() {
+		return nil, ErrMissingFields{
+			Method:  "GetRepository",
+			Message: fmt.Sprintf("%+v", opt),
+		}
+	}
+	repo, err := s.repository(ctx, opt)
+	if err != nil {
+		return nil, fmt.Errorf("GetRepository: failed to get Bitbucket repository %+v: %w", opt, err)
+	}
+	return repo.toRepository(), nil
+}
+
+// GetRepositories implements the SCM interface.
+func (s *BitbucketSCM) GetRepositories(ctx context.Context, org *pb.Organization) ([]*Repository, error) {
+	project, err := s.project(ctx, org.GetID(), org.GetPath())
+	if err != nil {
+		return nil, fmt.Errorf("GetRepositories: failed to get Bitbucket project %s: %w", org.GetPath(), err)
+	}
+	var repositories []*Repository
+	err = s.pages(ctx, apiPath("projects", project.Key, "repos"), nil, func(values json.RawMessage) (bool, error) {
+		var repos []*bitbucketRepository
+		if err := json.Unmarshal(values, &repos); err != nil {
+			return true, err
+		}
+		for _, repo := range repos {
+			repositories = append(repositories, repo.toRepository())
+		}
+		return false, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("GetRepositories: failed to list repositories of Bitbucket project %s: %w", project.Key, err)
+	}
+	return repositories, nil
+}
+
+// DeleteRepository implements the SCM interface.
+func (s *BitbucketSCM) DeleteRepository(ctx context.Context, opt *RepositoryOptions) error {
+	if !opt.valid() {
+		return ErrMissingFields{
+			Method:  "DeleteRepository",
+			Message: fmt.Sprintf("%+v", opt),
+		}
+	}
+	repo, err := s.repository(ctx, opt)
+	if err != nil {
+		return fmt.Errorf("DeleteRepository: failed to get Bitbucket repository %+v: %w", opt, err)
+	}
+	if err := s.do(ctx, http.MethodDelete, apiPath("projects", repo.Project.Key, "repos", repo.Slug), nil, nil, nil); err != nil {
+		return fmt.Errorf("DeleteRepository: failed to delete Bitbucket repository %s: %w", repo.Slug, err)
+	}
+	return nil
+}
+
+// ArchiveRepository implements the SCM interface.
+func (s *BitbucketSCM) ArchiveRepository(ctx context.Context, opt *RepositoryOptions) error {
+	if !opt.valid() {
+		return ErrMissingFields{
+			Method:  "ArchiveRepository",
+			Message: fmt.Sprintf("%+v", opt),
+		}
+	}
+	repo, err := s.repository(ctx, opt)
+	if err != nil {
+		return fmt.Errorf("ArchiveRepository: failed to get Bitbucket repository %+v: %w", opt, err)
+	}
+	if err := s.do(ctx, http.MethodPut, apiPath("projects", repo.Project.Key, "repos", repo.Slug), nil, map[string]bool{"archived": true}, nil); err != nil {
+		return fmt.Errorf("ArchiveRepository: failed to archive Bitbucket repository %s: %w", repo.Slug, err)
+	}
+	return nil
+}
+
+// UpdateRepoAccess implements the SCM interface.
+func (s *BitbucketSCM) UpdateRepoAccess(ctx context.Context, repo *Repository, user, permission string) error {
+	if repo == nil || !repo.valid() || user == "" {
+		return ErrMissingFields{
+			Method:  "UpdateRepoAccess",
+			Message: fmt.Sprintf("%+v, user %q", repo, user),
+		}
+	}
+	bbPermission, err := bitbucketRepoPermission(permission)
+	if err != nil {
+		return fmt.Errorf("UpdateRepoAccess: %w", err)
+	}
+	query := url.Values{"name": {user}, "permission": {bbPermission}}
+	if err := s.do(ctx, http.MethodPut, apiPath("projects", repo.Owner, "repos", repo.Path, "permissions", "users"), query, nil, nil); err != nil {
+		return fmt.Errorf("UpdateRepoAccess: failed to grant %s %s access to Bitbucket repository %s: %w", user, permission, repo.Path, err)
+	}
+	return nil
+}
+
+// RemoveRepoCollaborator implements the SCM interface.
+func (s *BitbucketSCM) RemoveRepoCollaborator(ctx context.Context, repo *Repository, user string) error {
+	if repo == nil || !repo.valid() || user == "" {
+		return ErrMissingFields{
+			Method:  "RemoveRepoCollaborator",
+			Message: fmt.Sprintf("%+v, user %q", repo, user),
+		}
+	}
+	if err := s.do(ctx, http.MethodDelete, apiPath("projects", repo.Owner, "repos", repo.Path, "permissions", "users"), url.Values{"name": {user}}, nil, nil); err != nil {
+		return fmt.Errorf("RemoveRepoCollaborator: failed to revoke %s's access to Bitbucket repository %s: %w", user, repo.Path, err)
+	}
+	return nil
+}
+
+// GetRepositoryAccess implements the SCM interface.
+func (s *BitbucketSCM) GetRepositoryAccess(ctx context.Context, opt *RepositoryOptions) (*RepositoryAccess, error) {
+	if !opt.valid() {
+		return nil, ErrMissingFields{
+			Method:  "GetRepositoryAccess",
+			Message: fmt.Sprintf("%+v", opt),
+		}
+	}
+	repo, err := s.repository(ctx, opt)
+	if err != nil {
+		return nil, fmt.Errorf("GetRepositoryAccess: failed to get Bitbucket repository %+v: %w", opt, err)
+	}
+	access := &RepositoryAccess{}
+	err = s.pages(ctx, apiPath("projects", repo.Project.Key, "repos", repo.Slug, "permissions", "groups"), nil, func(values json.RawMessage) (bool, error) {
+		var permissions []bitbucketGroupPermission
+		if err := json.Unmarshal(values, &permissions); err != nil {
+			return true, err
+		}
+		for _, p := range permissions {
+			access.Teams = append(access.Teams, &Permission{
+				ID:         bitbucketTeamID(p.Group.Name),
+				Name:       bitbucketTeamName(repo.Project.Key, p.Group.Name),
+				Permission: repoPermission(p.Permission),
+			})
+		}
+		return false, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("GetRepositoryAccess: failed to list groups with access to Bitbucket repository %s: %w", repo.Slug, err)
+	}
+	err = s.pages(ctx, apiPath("projects", repo.Project.Key, "repos", repo.Slug, "permissions", "users"), nil, func(values json.RawMessage) (bool, error) {
+		var permissions []bitbucketUserPermission
+		if err := json.Unmarshal(values, &permissions); err != nil {
+			return true, err
+		}
+		for _, p := range permissions {
+			access.Collaborators = append(access.Collaborators, &Permission{
+				Name:       p.User.Name,
+				Permission: repoPermission(p.Permission),
+			})
+		}
+		return false, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("GetRepositoryAccess: failed to list users with access to Bitbucket repository %s: %w", repo.Slug, err)
+	}
+	return access, nil
+}
+
+// RepositoryIsEmpty implements the SCM interface.
+func (s *BitbucketSCM) RepositoryIsEmpty(ctx context.Context, opt *RepositoryOptions) bool {
+	repo, err := s.repository(ctx, opt)
+	if err != nil {
+		return false
+	}
+	var commits bitbucketPage
+	err = s.do(ctx, http.MethodGet, apiPath("projects", repo.Project.Key, "repos", repo.Slug, "commits"), url.Values{"limit": {"1"}}, nil, &commits)
+	if err != nil {
+		// Bitbucket reports that an empty repository has no commits as not found
+		return errors.Is(err, errBitbucketNotFound)
+	}
+	var values []bitbucketCommit
+	if err := json.Unmarshal(commits.Values, &values); err != nil {
+		return false
+	}
+	return len(values) == 0
+}
+
+// ListHooks implements the SCM interface.
+func (s *BitbucketSCM) ListHooks(ctx context.Context, repo *Repository, org string) ([]*Hook, error) {
+	var path string
+	switch {
+	case org != "":
+		path = apiPath("projects", bitbucketProjectKey(org), "webhooks")
+	case repo != nil && repo.valid():
+		path = apiPath("projects", repo.Owner, "repos", repo.Path, "webhooks")
+	default:
+		return nil, ErrMissingFields{
+			Method:  "ListHooks",
+			Message: fmt.Sprintf("%+v, org %q", repo, org),
+		}
+	}
+	var hooks []*Hook
+	err := s.pages(ctx, path, nil, func(values json.RawMessage) (bool, error) {
+		var webhooks []*bitbucketWebhook
+		if err := json.Unmarshal(values, &webhooks); err != nil {
+			return true, err
+		}
+		for _, hook := range webhooks {
+			hooks = append(hooks, &Hook{
+				ID:     hook.ID,
+				Name:   hook.Name,
+				URL:    hook.URL,
+				Events: hook.Events,
+			})
+		}
+		return false, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("ListHooks: failed to list Bitbucket webhooks: %w", err)
+	}
+	return hooks, nil
+}
+
+// CreateHook implements the SCM interface.
+// The webhook is triggered when branches or tags are pushed to the repository,
+// or any repository of the project if the organization is provided.
+func (s *BitbucketSCM) CreateHook(ctx context.Context, opt *CreateHookOptions) error {
+	if !opt.valid() {
+		return ErrMissingFields{
+			Method:  "CreateHook",
+			Message: fmt.Sprintf("%+v", opt),
+		}
+	}
+	path := apiPath("projects", bitbucketProjectKey(opt.Organization), "webhooks")
+	if opt.Organization == "" {
+		path = apiPath("projects", opt.Repository.Owner, "repos", opt.Repository.Path, "webhooks")
+	}
+	hook := &bitbucketWebhook{
+		Name:          "quickfeed",
+		URL:           opt.URL,
+		Events:        []string{"repo:refs_changed"},
+		Active:        true,
+		Configuration: map[string]string{"secret": opt.Secret},
+	}
+	if err := s.do(ctx, http.MethodPost, path, nil, hook, nil); err != nil {
+		return ErrFailedSCM{
+			GitError: err,
+			Method:   "CreateHook",
+			Message:  fmt.Sprintf("failed to create Bitbucket webhook with query: %+v", opt),
+		}
+	}
+	return nil
+}
+
+// CreateTeam implements the SCM interface.
+func (s *BitbucketSCM) CreateTeam(ctx context.Context, opt *NewTeamOptions) (*Team, error) {
+	if !opt.valid() {
+		return nil, ErrMissingFields{
+			Method:  "CreateTeam",
+			Message: fmt.Sprintf("%+v", opt),
+		}
+	}
+	key := bitbucketProjectKey(opt.Organization)
+	group := bitbucketGroupName(key, opt.TeamName)
+	if err := s.do(ctx, http.MethodPost, apiPath("admin", "groups"), url.Values{"name": {group}}, nil, nil); err != nil {
+		if opt.TeamName != TeachersTeam && opt.TeamName != StudentsTeam {
+			return nil, ErrFailedSCM{
+				Method:   "CreateTeam",
+				Message:  fmt.Sprintf("failed to create Bitbucket group %s, make sure it does not already exist", group),
+				GitError: err,
+			}
+		}
+		// continue if it is one of standard teacher/student teams. Such teams can be safely reused
+		s.logger.Debugf("Group %s already exists for project %s", group, key)
+	}
+	if len(opt.Users) > 0 {
+		if err := s.addGroupMembers(ctx, group, opt.Users...); err != nil {
+			return nil, fmt.Errorf("CreateTeam: %w", err)
+		}
+	}
+	return &Team{
+		ID:           bitbucketTeamID(group),
+		Name:         opt.TeamName,
+		Organization: key,
+	}, nil
+}
+
+// DeleteTeam implements the SCM interface.
+func (s *BitbucketSCM) DeleteTeam(ctx context.Context, opt *TeamOptions) error {
+	if !opt.valid() {
+		return ErrMissingFields{
+			Method:  "DeleteTeam",
+			Message: fmt.Sprintf("%+v", opt),
+		}
+	}
+	_, group, err := s.teamGroup(ctx, opt.OrganizationID, opt.Organization, opt.TeamID, opt.TeamName)
+	if err != nil {
+		return fmt.Errorf("DeleteTeam: %w", err)
+	}
+	if err := s.do(ctx, http.MethodDelete, apiPath("admin", "groups"), url.Values{"name": {group}}, nil, nil); err != nil {
+		return fmt.Errorf("DeleteTeam: failed to delete Bitbucket group %s: %w", group, err)
+	}
+	return nil
+}
+
+// GetTeam implements the SCM interface.
+func (s *BitbucketSCM) GetTeam(ctx context.Context, opt *TeamOptions) (*Team, error) {
+	if !opt.valid() {
+		return nil, ErrMissingFields{
+			Method:  "GetTeam",
+			Message: fmt.Sprintf("%+v", opt),
+		}
+	}
+	key, group, err := s.teamGroup(ctx, opt.OrganizationID, opt.Organization, opt.TeamID, opt.TeamName)
+	if err != nil {
+		return nil, fmt.Errorf("GetTeam: %w", err)
+	}
+	groups, err := s.groups(ctx, key)
+	if err != nil {
+		return nil, fmt.Errorf("GetTeam: %w", err)
+	}
+	for _, g := range groups {
+		if g == group {
+			return &Team{ID: bitbucketTeamID(group), Name: bitbucketTeamName(key, group), Organization: key}, nil
+		}
+	}
+	return nil, fmt.Errorf("GetTeam: Bitbucket group %s: %w", group, errBitbucketNotFound)
+}
+
+// GetTeams implements the SCM interface.
+func (s *BitbucketSCM) GetTeams(ctx context.Context, org *pb.Organization) ([]*Team, error) {
+	project, err := s.project(ctx, org.GetID(), org.GetPath())
+	if err != nil {
+		return nil, fmt.Errorf("GetTeams: failed to get Bitbucket project %s: %w", org.GetPath(), err)
+	}
+	groups, err := s.groups(ctx, project.Key)
+	if err != nil {
+		return nil, fmt.Errorf("GetTeams: %w", err)
+	}
+	var teams []*Team
+	for _, group := range groups {
+		teams = append(teams, &Team{ID: bitbucketTeamID(group), Name: bitbucketTeamName(project.Key, group), Organization: project.Key})
+	}
+	return teams, nil
+}
+
+// AddTeamRepo implements the SCM interface.
+func (s *BitbucketSCM) AddTeamRepo(ctx context.Context, opt *AddTeamRepoOptions) error {
+	if !opt.valid() {
+		return ErrMissingFields{
+			Method:  "AddTeamRepo",
+			Message: fmt.Sprintf("%+v", opt),
+		}
+	}
+	key, group, err := s.teamGroup(ctx, opt.OrganizationID, opt.Owner, opt.TeamID, "")
+	if err != nil {
+		return fmt.Errorf("AddTeamRepo: %w", err)
+	}
+	permission, err := bitbucketRepoPermission(opt.Permission)
+	if err != nil {
+		return fmt.Errorf("AddTeamRepo: %w", err)
+	}
+	query := url.Values{"name": {group}, "permission": {permission}}
+	if err := s.do(ctx, http.MethodPut, apiPath("projects", key, "repos", opt.Repo, "permissions", "groups"), query, nil, nil); err != nil {
+		return ErrFailedSCM{
+			GitError: fmt.Errorf("failed to grant Bitbucket group %s access to repository %s: %w", group, opt.Repo, err),
+			Method:   "AddTeamRepo",
+			Message:  fmt.Sprintf("failed to make Bitbucket repository '%s' a team repository", opt.Repo),
+		}
+	}
+	return nil
+}
+
+// RemoveTeamRepo implements the SCM interface.
+func (s *BitbucketSCM) RemoveTeamRepo(ctx context.Context, opt *RemoveTeamRepoOptions) error {
+	if !opt.valid() {
+		return ErrMissingFields{
+			Method:  "RemoveTeamRepo",
+			Message: fmt.Sprintf("%+v", opt),
+		}
+	}
+	key, group, err := s.teamGroup(ctx, opt.OrganizationID, opt.Owner, opt.TeamID, "")
+	if err != nil {
+		return fmt.Errorf("RemoveTeamRepo: %w", err)
+	}
+	if err := s.do(ctx, http.MethodDelete, apiPath("projects", key, "repos", opt.Repo, "permissions", "groups"), url.Values{"name": {group}}, nil, nil); err != nil {
+		return fmt.Errorf("RemoveTeamRepo: failed to revoke Bitbucket group %s's access to repository %s: %w", group, opt.Repo, err)
+	}
+	return nil
+}
+
+// AddTeamMember implements the SCM interface.
+// Bitbucket groups have no maintainers; the role is ignored.
+func (s *BitbucketSCM) AddTeamMember(ctx context.Context, opt *TeamMembershipOptions) error {
+	if !opt.valid() {
+		return ErrMissingFields{
+			Method:  "AddTeamMember",
+			Message: fmt.Sprintf("%+v", opt),
+		}
+	}
+	_, group, err := s.teamGroup(ctx, opt.OrganizationID, opt.Organization, opt.TeamID, opt.TeamName)
+	if err != nil {
+		return fmt.Errorf("AddTeamMember: %w", err)
+	}
+	if err := s.addGroupMembers(ctx, group, opt.Username); err != nil {
+		return fmt.Errorf("AddTeamMember: %w", err)
+	}
+	return nil
+}
+
+// RemoveTeamMember implements the SCM interface.
+func (s *BitbucketSCM) RemoveTeamMember(ctx context.Context, opt *TeamMembershipOptions) error {
+	if !opt.valid() {
+		return ErrMissingFields{
+			Method:  "RemoveTeamMember",
+			Message: fmt.Sprintf("%+v", opt),
+		}
+	}
+	_, group, err := s.teamGroup(ctx, opt.OrganizationID, opt.Organization, opt.TeamID, opt.TeamName)
+	if err != nil {
+		return fmt.Errorf("RemoveTeamMember: %w", err)
+	}
+	if err := s.removeGroupMember(ctx, group, opt.Username); err != nil {
+		return fmt.Errorf("RemoveTeamMember: %w", err)
+	}
+	return nil
+}
+
+// UpdateTeamMembers implements the SCM interface.
+func (s *BitbucketSCM) UpdateTeamMembers(ctx context.Context, opt *UpdateTeamOptions) error {
+	if !opt.valid() {
+		return ErrMissingFields{
+			Method:  "UpdateTeamMembers",
+			Message: fmt.Sprintf("%+v", opt),
+		}
+	}
+	_, group, err := s.teamGroup(ctx, opt.OrganizationID, "", opt.TeamID, "")
+	if err != nil {
+		return fmt.Errorf("UpdateTeamMembers: %w", err)
+	}
+	oldUsers, err := s.groupMembers(ctx, group)
+	if err != nil {
+		return fmt.Errorf("UpdateTeamMembers: %w", err)
+	}
+	if len(opt.Users) > 0 {
+		if err := s.addGroupMembers(ctx, group, opt.Users...); err != nil {
+			return fmt.Errorf("UpdateTeamMembers: %w", err)
+		}
+	}
+	keep := make(map[string]bool)
+	for _, user := range opt.Users {
+		keep[user] = true
+	}
+	for _, user := range oldUsers {
+		if keep[user] {
+			continue
+		}
+		if err := s.removeGroupMember(ctx, group, user); err != nil {
+			return fmt.Errorf("UpdateTeamMembers: %w", err)
+		}
+	}
+	return nil
+}
+
+// GetUserName implements the SCM interface.
+func (s *BitbucketSCM) GetUserName(ctx context.Context) (string, error) {
+	var name string
+	if err := s.do(ctx, http.MethodGet, "/plugins/servlet/applinks/whoami", nil, nil, &name); err != nil {
+		return "", fmt.Errorf("GetUserName: failed to get Bitbucket user: %w", err)
+	}
+	if name == "" {
+		return "", errors.New("GetUserName: no authenticated Bitbucket user")
+	}
+	return name, nil
+}
+
+// GetUserNameByID implements the SCM interface.
+func (s *BitbucketSCM) GetUserNameByID(ctx context.Context, remoteID uint64) (string, error) {
+	var name string
+	err := s.pages(ctx, apiPath("users"), nil, func(values json.RawMessage) (bool, error) {
+		var users []bitbucketUser
+		if err := json.Unmarshal(values, &users); err != nil {
+			return true, err
+		}
+		for _, user := range users {
+			if user.ID == remoteID {
+				name = user.Name
+				return true, nil
+			}
+		}
+		return false, nil
+	})
+	if err != nil {
+		return "", fmt.Errorf("GetUserNameByID: failed to list Bitbucket users: %w", err)
+	}
+	if name == "" {
+		return "", fmt.Errorf("GetUserNameByID: Bitbucket user %d: %w", remoteID, errBitbucketNotFound)
+	}
+	return name, nil
+}
+
+// CreateCloneURL implements the SCM interface.
+func (s *BitbucketSCM) CreateCloneURL(opt *URLPathOptions) string {
+	token := s.token
+	if len(opt.UserToken) > 0 {
+		token = opt.UserToken
+	}
+	u, err := url.Parse(s.baseURL)
+	if err != nil {
+		return ""
+	}
+	u.User = url.UserPassword("x-token-auth", token)
+	u.Path = strings.TrimSuffix(u.Path, "/") + "/scm/" + strings.ToLower(bitbucketProjectKey(opt.Organization)) + "/" + opt.Repository + ".git"
+	return u.String()
+}
+
+// UpdateOrgMembership implements the SCM interface.
+// Organization owners are project administrators; all organization members
+// are members of the project's members group.
+func (s *BitbucketSCM) UpdateOrgMembership(ctx context.Context, opt *OrgMembershipOptions) error {
+	if !opt.valid() {
+		return ErrMissingFields{
+			Method:  "UpdateOrgMembership",
+			Message: fmt.Sprintf("%+v", opt),
+		}
+	}
+	key := bitbucketProjectKey(opt.Organization)
+	if err := s.addGroupMembers(ctx, bitbucketMembersGroup(key), opt.Username); err != nil {
+		return fmt.Errorf("UpdateOrgMembership: %w", err)
+	}
+	path := apiPath("projects", key, "permissions", "users")
+	var err error
+	switch opt.Role {
+	case OrgOwner:
+		err = s.do(ctx, http.MethodPut, path, url.Values{"name": {opt.Username}, "permission": {"PROJECT_ADMIN"}}, nil, nil)
+	case OrgMember:
+		err = s.do(ctx, http.MethodDelete, path, url.Values{"name": {opt.Username}}, nil, nil)
+	default:
+		return fmt.Errorf("UpdateOrgMembership: unknown role %q", opt.Role)
+	}
+	if err != nil {
+		return ErrFailedSCM{
+			GitError: fmt.Errorf("failed to update membership for user %s in project %s: %w", opt.Username, key, err),
+			Method:   "UpdateOrgMembership",
+			Message:  fmt.Sprintf("failed to update membership for user %s", opt.Username),
+		}
+	}
+	return nil
+}
+
+// RemoveMember implements the SCM interface.
+func (s *BitbucketSCM) RemoveMember(ctx context.Context, opt *OrgMembershipOptions) error {
+	if !opt.valid() {
+		return ErrMissingFields{
+			Method:  "RemoveMember",
+			Message: fmt.Sprintf("%+v", opt),
+		}
+	}
+	key := bitbucketProjectKey(opt.Organization)
+	if err := s.do(ctx, http.MethodDelete, apiPath("projects", key, "permissions", "users"), url.Values{"name": {opt.Username}}, nil, nil); err != nil {
+		return fmt.Errorf("RemoveMember: failed to revoke %s's permissions in Bitbucket project %s: %w", opt.Username, key, err)
+	}
+	// remove user from the members group and all teams of the project
+	groups, err := s.userGroups(ctx, opt.Username, key)
+	if err != nil {
+		return fmt.Errorf("RemoveMember: %w", err)
+	}
+	for _, group := range groups {
+		if err := s.removeGroupMember(ctx, group, opt.Username); err != nil {
+			return fmt.Errorf("RemoveMember: %w", err)
+		}
+	}
+	return nil
+}
+
+// GetOrgMembers implements the SCM interface.
+func (s *BitbucketSCM) GetOrgMembers(ctx context.Context, org *pb.Organization) ([]string, error) {
+	project, err := s.project(ctx, org.GetID(), org.GetPath())
+	if err != nil {
+		return nil, fmt.Errorf("GetOrgMembers: failed to get Bitbucket project %s: %w", org.GetPath(), err)
+	}
+	members, err := s.groupMembers(ctx, bitbucketMembersGroup(project.Key))
+	if err != nil {
+		return nil, fmt.Errorf("GetOrgMembers: %w", err)
+	}
+	return members, nil
+}
+
+// GetDiscussions implements the SCM interface.
+func (s *BitbucketSCM) GetDiscussions(ctx context.Context, opt *DiscussionOptions) ([]*Discussion, error) {
+	// Bitbucket has no repository discussions
+	return nil, ErrNotSupported{
+		SCM:    "bitbucket",
+		Method: "GetDiscussions",
+	}
+}
+
+// CreateCommitComment implements the SCM interface.
+// Comments on a file are anchored to the file, or to the given line of the file as changed by the commit.
+func (s *BitbucketSCM) CreateCommitComment(ctx context.Context, opt *CommitCommentOptions) (string, error) {
+	if !opt.valid() {
+		return "", ErrMissingFields{
+			Method:  "CreateCommitComment",
+			Message: fmt.Sprintf("%+v", opt),
+		}
+	}
+	key, repo := bitbucketProjectKey(opt.Owner), slug.Make(opt.Repository)
+	in := map[string]interface{}{"text": opt.Body}
+	if opt.Path != "" {
+		anchor := map[string]interface{}{"path": opt.Path, "diffType": "COMMIT"}
+		if opt.Line > 0 {
+			anchor["line"] = opt.Line
+			anchor["lineType"] = "ADDED"
+			anchor["fileType"] = "TO"
+		}
+		in["anchor"] = anchor
+	}
+	var comment struct {
+		ID uint64 `json:"id"`
+	}
+	if err := s.do(ctx, http.MethodPost, apiPath("projects", key, "repos", repo, "commits", opt.CommitSHA, "comments"), nil, in, &comment); err != nil {
+		return "", ErrFailedSCM{
+			Method:   "CreateCommitComment",
+			Message:  fmt.Sprintf("failed to create comment on commit %s in repository %s", opt.CommitSHA, opt.Repository),
+			GitError: err,
+		}
+	}
+	return fmt.Sprintf("%s/projects/%s/repos/%s/commits/%s?commentId=%d", s.baseURL, key, repo, opt.CommitSHA, comment.ID), nil
+}
+
+// CreateIssue implements the SCM interface.
+func (s *BitbucketSCM) CreateIssue(ctx context.Context, opt *IssueOptions) (string, error) {
+	// TODO no implementation provided yet
+	return "", ErrNotSupported{
+		SCM:    "bitbucket",
+		Method: "CreateIssue",
+	}
+}
+
+// GetCommits implements the SCM interface.
+func (s *BitbucketSCM) GetCommits(ctx context.Context, opt *CommitOptions) ([]*Commit, error) {
+	if !opt.valid() {
+		return nil, ErrMissingFields{
+			Method:  "GetCommits",
+			Message: fmt.Sprintf("%+v", opt),
+		}
+	}
+	key, repo := bitbucketProjectKey(opt.Owner), slug.Make(opt.Repository)
+	query := url.Values{}
+	if opt.Branch != "" {
+		query.Set("until", opt.Branch)
+	}
+	var commits []*Commit
+	err := s.pages(ctx, apiPath("projects", key, "repos", repo, "commits"), query, func(values json.RawMessage) (bool, error) {
+		var repoCommits []*bitbucketCommit
+		if err := json.Unmarshal(values, &repoCommits); err != nil {
+			return true, err
+		}
+		for _, repoCommit := range repoCommits {
+			commit := repoCommit.toCommit()
+			// the commits are listed newest first, so the remaining commits are older
+			if !commit.Date.After(opt.Since) {
+				return true, nil
+			}
+			files, err := s.commitFiles(ctx, key, repo, commit.SHA)
+			if err != nil {
+				return true, err
+			}
+			commit.Files = files
+			commits = append(commits, commit)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return nil, ErrFailedSCM{
+			Method:   "GetCommits",
+			Message:  fmt.Sprintf("failed to list commits in repository %s", opt.Repository),
+			GitError: err,
+		}
+	}
+	return commits, nil
+}
+
+// CreateCommitStatus implements the SCM interface.
+// Statuses are Bitbucket build statuses, keyed by the status' context.
+func (s *BitbucketSCM) CreateCommitStatus(ctx context.Context, opt *CommitStatusOptions) error {
+	if !opt.valid() {
+		return ErrMissingFields{
+			Method:  "CreateCommitStatus",
+			Message: fmt.Sprintf("%+v", opt),
+		}
+	}
+	state, err := bitbucketBuildState(opt.State)
+	if err != nil {
+		return fmt.Errorf("CreateCommitStatus: %w", err)
+	}
+	key := opt.Context
+	if key == "" {
+		key = "quickfeed"
+	}
+	// build statuses must link somewhere; without a target URL, link to the commit
+	targetURL := opt.TargetURL
+	if targetURL == "" {
+		targetURL = fmt.Sprintf("%s/projects/%s/repos/%s/commits/%s", s.baseURL, bitbucketProjectKey(opt.Owner), slug.Make(opt.Repository), opt.CommitSHA)
+	}
+	in := map[string]string{
+		"state":       state,
+		"key":         key,
+		"name":        key,
+		"url":         targetURL,
+		"description": opt.Description,
+	}
+	if err := s.do(ctx, http.MethodPost, buildStatusPath(opt.CommitSHA), nil, in, nil); err != nil {
+		return ErrFailedSCM{
+			Method:   "CreateCommitStatus",
+			Message:  fmt.Sprintf("failed to set status of commit %s in repository %s", opt.CommitSHA, opt.Repository),
+			GitError: err,
+		}
+	}
+	return nil
+}
+
+// GetUserScopes implements the SCM interface.
+// Bitbucket access tokens have permissions rather than scopes; no scopes are returned.
+func (s *BitbucketSCM) GetUserScopes(ctx context.Context) *Authorization {
+	return &Authorization{Scopes: []string{}}
+}
+
+// project returns the Bitbucket project with the given ID, or the given key if the ID is zero.
+func (s *BitbucketSCM) project(ctx context.Context, id uint64, key string) (*bitbucketProject, error) {
+	if id == 0 {
+		var project bitbucketProject
+		if err := s.do(ctx, http.MethodGet, apiPath("projects", bitbucketProjectKey(key)), nil, nil, &project); err != nil {
+			return nil, err
+		}
+		return &project, nil
+	}
+	// projects cannot be fetched by ID
+	var found *bitbucketProject
+	err := s.pages(ctx, apiPath("projects"), nil, func(values json.RawMessage) (bool, error) {
+		var projects []*bitbucketProject
+		if err := json.Unmarshal(values, &projects); err != nil {
+			return true, err
+		}
+		for _, project := range projects {
+			if project.ID == id {
+				found = project
+				return true, nil
+			}
+		}
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if found == nil {
+		return nil, fmt.Errorf("project %d: %w", id, errBitbucketNotFound)
+	}
+	return found, nil
+}
+
+// repository returns the Bitbucket repository with the given ID, or the given path and owner if the ID is zero.
+func (s *BitbucketSCM) repository(ctx context.Context, opt *RepositoryOptions) (*bitbucketRepository, error) {
+	if opt.ID == 0 {
+		var repo bitbucketRepository
+		if err := s.do(ctx, http.MethodGet, apiPath("projects", bitbucketProjectKey(opt.Owner), "repos", slug.Make(opt.Path)), nil, nil, &repo); err != nil {
+			return nil, err
+		}
+		return &repo, nil
+	}
+	// repositories cannot be fetched by ID
+	var found *bitbucketRepository
+	var query url.Values
+	if opt.Owner != "" {
+		query = url.Values{"projectkey": {bitbucketProjectKey(opt.Owner)}}
+	}
+	err := s.pages(ctx, apiPath("repos"), query, func(values json.RawMessage) (bool, error) {
+		var repos []*bitbucketRepository
+		if err := json.Unmarshal(values, &repos); err != nil {
+			return true, err
+		}
+		for _, repo := range repos {
+			if repo.ID == opt.ID {
+				found = repo
+				return true, nil
+			}
+		}
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if found == nil {
+		return nil, fmt.Errorf("repository %d: %w", opt.ID, errBitbucketNotFound)
+	}
+	return found, nil
+}
+
+// commitFiles returns the paths of the files changed by the given commit.
+func (s *BitbucketSCM) commitFiles(ctx context.Context, projectKey, repo, sha string) ([]string, error) {
+	var files []string
+	err := s.pages(ctx, apiPath("projects", projectKey, "repos", repo, "commits", sha, "changes"), nil, func(values json.RawMessage) (bool, error) {
+		var changes []bitbucketChange
+		if err := json.Unmarshal(values, &changes); err != nil {
+			return true, err
+		}
+		for _, change := range changes {
+			files = append(files, change.Path.ToString)
+		}
+		return false, nil
+	})
+	return files, err
+}
+
+// groups returns the names of the groups of the teams of the given project.
+func (s *BitbucketSCM) groups(ctx context.Context, projectKey string) ([]string, error) {
+	var names []string
+	err := s.pages(ctx, apiPath("admin", "groups"), url.Values{"filter": {bitbucketGroupName(projectKey, "")}}, func(values json.RawMessage) (bool, error) {
+		var groups []bitbucketGroup
+		if err := json.Unmarshal(values, &groups); err != nil {
+			return true, err
+		}
+		for _, group := range groups {
+			// the filter matches group names containing the prefix
+			if bitbucketTeamName(projectKey, group.Name) != "" {
+				names = append(names, group.Name)
+			}
+		}
+		return false, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list Bitbucket groups of project %s: %w", projectKey, err)
+	}
+	return names, nil
+}
+
+// teamGroup returns the project key and the group name of the team with the given ID,
+// or the given name if the ID is zero. The project is given by its ID or key.
+func (s *BitbucketSCM) teamGroup(ctx context.Context, orgID uint64, org string, teamID uint64, teamName string) (key, group string, err error) {
+	key = bitbucketProjectKey(org)
+	if key == "" {
+		project, err := s.project(ctx, orgID, "")
+		if err != nil {
+			return "", "", fmt.Errorf("failed to get Bitbucket project %d: %w", orgID, err)
+		}
+		key = project.Key
+	}
+	if teamID == 0 {
+		return key, bitbucketGroupName(key, teamName), nil
+	}
+	groups, err := s.groups(ctx, key)
+	if err != nil {
+		return "", "", err
+	}
+	for _, group := range groups {
+		if bitbucketTeamID(group) == teamID {
+			return key, group, nil
+		}
+	}
+	return "", "", fmt.Errorf("team %d in project %s: %w", teamID, key, errBitbucketNotFound)
+}
+
+// userGroups returns the names of the given project's groups that the given user is a member of,
+// including the project's members group.
+func (s *BitbucketSCM) userGroups(ctx context.Context, user, projectKey string) ([]string, error) {
+	var names []string
+	err := s.pages(ctx, apiPath("admin", "users", "more-members"), url.Values{"context": {user}, "filter": {bitbucketMembersGroup(projectKey)}}, func(values json.RawMessage) (bool, error) {
+		var groups []bitbucketGroup
+		if err := json.Unmarshal(values, &groups); err != nil {
+			return true, err
+		}
+		for _, group := range groups {
+			if group.Name == bitbucketMembersGroup(projectKey) || bitbucketTeamName(projectKey, group.Name) != "" {
+				names = append(names, group.Name)
+			}
+		}
+		return false, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list Bitbucket groups of user %s: %w", user, err)
+	}
+	return names, nil
+}
+
+// groupMembers returns the user names of the members of the given group.
+func (s *BitbucketSCM) groupMembers(ctx context.Context, group string) ([]string, error) {
+	var members []string
+	err := s.pages(ctx, apiPath("admin", "groups", "more-members"), url.Values{"context": {group}}, func(values json.RawMessage) (bool, error) {
+		var users []bitbucketUser
+		if err := json.Unmarshal(values, &users); err != nil {
+			return true, err
+		}
+		for _, user := range users {
+			members = append(members, user.Name)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list members of Bitbucket group %s: %w", group, err)
+	}
+	return members, nil
+}
+
+// addGroupMembers adds the given users to the given group.
+func (s *BitbucketSCM) addGroupMembers(ctx context.Context, group string, users ...string) error {
+	in := map[string]interface{}{"group": group, "users": users}
+	if err := s.do(ctx, http.MethodPost, apiPath("admin", "groups", "add-users"), nil, in, nil); err != nil {
+		return fmt.Errorf("failed to add %v to Bitbucket group %s: %w", users, group, err)
+	}
+	return nil
+}
+
+// removeGroupMember removes the given user from the given group.
+func (s *BitbucketSCM) removeGroupMember(ctx context.Context, group, user string) error {
+	in := map[string]string{"context": group, "itemName": user}
+	if err := s.do(ctx, http.MethodPost, apiPath("admin", "groups", "remove-user"), nil, in, nil); err != nil {
+		return fmt.Errorf("failed to remove %s from Bitbucket group %s: %w", user, group, err)
+	}
+	return nil
+}
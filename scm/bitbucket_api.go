@@ -0,0 +1,328 @@
+package scm
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// bitbucketPageSize is the number of values fetched per page from paged Bitbucket resources.
+const bitbucketPageSize = 100
+
+// errBitbucketNotFound is returned when the requested Bitbucket resource does not exist.
+var errBitbucketNotFound = errors.New("bitbucket resource not found")
+
+// bitbucketError is the error returned by the Bitbucket REST API.
+type bitbucketError struct {
+	StatusCode int
+	Errors     []struct {
+		Message string `json:"message"`
+	} `json:"errors"`
+}
+
+func (e *bitbucketError) Error() string {
+	var messages []string
+	for _, err := range e.Errors {
+		messages = append(messages, err.Message)
+	}
+	return fmt.Sprintf("bitbucket: %d %s: %s", e.StatusCode, http.StatusText(e.StatusCode), strings.Join(messages, "; "))
+}
+
+func (e *bitbucketError) Unwrap() error {
+	if e.StatusCode == http.StatusNotFound {
+		return errBitbucketNotFound
+	}
+	return nil
+}
+
+// bitbucketPage is a page of values from a paged Bitbucket resource.
+type bitbucketPage struct {
+	Values        json.RawMessage `json:"values"`
+	IsLastPage    bool            `json:"isLastPage"`
+	NextPageStart int             `json:"nextPageStart"`
+}
+
+type bitbucketLink struct {
+	Href string `json:"href"`
+	Name string `json:"name"`
+}
+
+type bitbucketLinks struct {
+	Self  []bitbucketLink `json:"self"`
+	Clone []bitbucketLink `json:"clone"`
+}
+
+type bitbucketProject struct {
+	ID     uint64         `json:"id"`
+	Key    string         `json:"key"`
+	Name   string         `json:"name"`
+	Avatar string         `json:"avatar"`
+	Links  bitbucketLinks `json:"links"`
+}
+
+type bitbucketRepository struct {
+	ID       uint64           `json:"id"`
+	Slug     string           `json:"slug"`
+	Name     string           `json:"name"`
+	Public   bool             `json:"public"`
+	Archived bool             `json:"archived"`
+	Project  bitbucketProject `json:"project"`
+	Links    bitbucketLinks   `json:"links"`
+}
+
+type bitbucketUser struct {
+	ID   uint64 `json:"id"`
+	Name string `json:"name"`
+	Slug string `json:"slug"`
+}
+
+type bitbucketUserPermission struct {
+	User       bitbucketUser `json:"user"`
+	Permission string        `json:"permission"`
+}
+
+type bitbucketGroupPermission struct {
+	Group struct {
+		Name string `json:"name"`
+	} `json:"group"`
+	Permission string `json:"permission"`
+}
+
+type bitbucketGroup struct {
+	Name string `json:"name"`
+}
+
+type bitbucketWebhook struct {
+	ID            uint64            `json:"id,omitempty"`
+	Name          string            `json:"name"`
+	URL           string            `json:"url"`
+	Events        []string          `json:"events"`
+	Active        bool              `json:"active"`
+	Configuration map[string]string `json:"configuration,omitempty"`
+}
+
+type bitbucketCommit struct {
+	ID      string `json:"id"`
+	Message string `json:"message"`
+	Author  struct {
+		Name string `json:"name"`
+	} `json:"author"`
+	CommitterTimestamp int64 `json:"committerTimestamp"` // milliseconds since the Unix epoch
+}
+
+type bitbucketChange struct {
+	Path struct {
+		ToString string `json:"toString"`
+	} `json:"path"`
+}
+
+// do sends a request to the Bitbucket REST API at the given path, relative to the
+// base URL, and decodes the JSON response into out, unless out is nil.
+func (s *BitbucketSCM) do(ctx context.Context, method, path string, query url.Values, in, out interface{}) error {
+	var body io.Reader
+	if in != nil {
+		b, err := json.Marshal(in)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(b)
+	}
+	u := s.baseURL + path
+	if len(query) > 0 {
+		u += "?" + query.Encode()
+	}
+	req, err := http.NewRequestWithContext(ctx, method, u, body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+s.token)
+	req.Header.Set("Accept", "application/json")
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	// the XSRF check must be disabled for requests without a JSON body
+	req.Header.Set("X-Atlassian-Token", "no-check")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= http.StatusBadRequest {
+		bbErr := &bitbucketError{StatusCode: resp.StatusCode}
+		// the error body is optional; the status code is sufficient
+		_ = json.NewDecoder(resp.Body).Decode(bbErr)
+		return bbErr
+	}
+	if out == nil {
+		_, err = io.Copy(ioutil.Discard, resp.Body)
+		return err
+	}
+	if w, ok := out.(*string); ok {
+		b, err := ioutil.ReadAll(resp.Body)
+		*w = strings.TrimSpace(string(b))
+		return err
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
+
+// pages calls fn with the values of each page of the paged resource at the given path,
+// until fn returns done or there are no more pages.
+func (s *BitbucketSCM) pages(ctx context.Context, path string, query url.Values, fn func(values json.RawMessage) (done bool, err error)) error {
+	q := url.Values{}
+	for k, v := range query {
+		q[k] = v
+	}
+	q.Set("limit", strconv.Itoa(bitbucketPageSize))
+	start := 0
+	for {
+		q.Set("start", strconv.Itoa(start))
+		var page bitbucketPage
+		if err := s.do(ctx, http.MethodGet, path, q, nil, &page); err != nil {
+			return err
+		}
+		done, err := fn(page.Values)
+		if err != nil || done || page.IsLastPage {
+			return err
+		}
+		start = page.NextPageStart
+	}
+}
+
+// apiPath returns the path of the given core REST API resource.
+func apiPath(elem ...string) string {
+	for i := range elem {
+		elem[i] = url.PathEscape(elem[i])
+	}
+	return "/rest/api/1.0/" + strings.Join(elem, "/")
+}
+
+// buildStatusPath returns the path of the build statuses of the given commit in the build status API.
+func buildStatusPath(sha string) string {
+	return "/rest/build-status/1.0/commits/" + url.PathEscape(sha)
+}
+
+// bitbucketBuildState returns the Bitbucket build state for the given commit state.
+func bitbucketBuildState(state CommitState) (string, error) {
+	switch state {
+	case CommitPending:
+		return "INPROGRESS", nil
+	case CommitSuccess:
+		return "SUCCESSFUL", nil
+	case CommitFailure, CommitError:
+		return "FAILED", nil
+	}
+	return "", fmt.Errorf("unknown commit state %q", state)
+}
+
+// bitbucketProjectKey returns the project key for the given organization path.
+// Project keys must start with a letter, and may only contain letters, numbers and underscores.
+func bitbucketProjectKey(path string) string {
+	var key strings.Builder
+	for _, r := range strings.ToUpper(path) {
+		switch {
+		case r >= 'A' && r <= 'Z', r >= '0' && r <= '9' && key.Len() > 0:
+			key.WriteRune(r)
+		case (r == '-' || r == '_' || r == ' ') && key.Len() > 0:
+			key.WriteRune('_')
+		}
+	}
+	return key.String()
+}
+
+// bitbucketGroupName returns the name of the Bitbucket group for the given team.
+// Bitbucket groups are global; the project key namespaces the team names of different courses.
+func bitbucketGroupName(projectKey, team string) string {
+	return strings.ToLower(projectKey) + "-" + team
+}
+
+// bitbucketMembersGroup returns the name of the Bitbucket group with all members of the given project.
+// The name cannot clash with the team groups, since it has no team name suffix.
+func bitbucketMembersGroup(projectKey string) string {
+	return strings.ToLower(projectKey)
+}
+
+// bitbucketTeamName returns the team name of the given Bitbucket group, or the empty string
+// if the group does not belong to the given project.
+func bitbucketTeamName(projectKey, group string) string {
+	prefix := strings.ToLower(projectKey) + "-"
+	if !strings.HasPrefix(group, prefix) {
+		return ""
+	}
+	return strings.TrimPrefix(group, prefix)
+}
+
+// bitbucketTeamID returns the team ID for the given Bitbucket group. Bitbucket groups have
+// no numeric IDs; the ID is derived from the group name and fits in a JavaScript number.
+func bitbucketTeamID(group string) uint64 {
+	h := fnv.New64a()
+	h.Write([]byte(group))
+	return h.Sum64() & (1<<53 - 1)
+}
+
+// bitbucketRepoPermission returns the Bitbucket repository permission for the given permission.
+func bitbucketRepoPermission(permission string) (string, error) {
+	switch permission {
+	case RepoPull, OrgPull:
+		return "REPO_READ", nil
+	case RepoPush, OrgPush:
+		return "REPO_WRITE", nil
+	case RepoFull:
+		return "REPO_ADMIN", nil
+	}
+	return "", fmt.Errorf("unknown repository permission %q", permission)
+}
+
+// repoPermission returns the permission for the given Bitbucket repository or project permission.
+func repoPermission(bitbucketPermission string) string {
+	switch bitbucketPermission {
+	case "REPO_READ", "PROJECT_READ":
+		return RepoPull
+	case "REPO_WRITE", "PROJECT_WRITE":
+		return RepoPush
+	}
+	return RepoFull
+}
+
+// toRepository converts a Bitbucket repository to a Repository.
+func (r *bitbucketRepository) toRepository() *Repository {
+	repo := &Repository{
+		ID:       r.ID,
+		Path:     r.Slug,
+		Owner:    r.Project.Key,
+		OrgID:    r.Project.ID,
+		Archived: r.Archived,
+	}
+	if len(r.Links.Self) > 0 {
+		repo.WebURL = r.Links.Self[0].Href
+	}
+	for _, link := range r.Links.Clone {
+		switch link.Name {
+		case "http", "https":
+			repo.HTTPURL = link.Href
+		case "ssh":
+			repo.SSHURL = link.Href
+		}
+	}
+	return repo
+}
+
+// toCommit converts a Bitbucket commit to a Commit, without the files it changed.
+func (c *bitbucketCommit) toCommit() *Commit {
+	return &Commit{
+		SHA:     c.ID,
+		Message: c.Message,
+		Author:  c.Author.Name,
+		Date:    time.Unix(0, c.CommitterTimestamp*int64(time.Millisecond)),
+	}
+}
@@ -0,0 +1,320 @@
+package scm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+
+	pb "github.com/autograde/quickfeed/ag"
+)
+
+func TestBitbucketProjectKey(t *testing.T) {
+	tests := []struct {
+		path, want string
+	}{
+		{"qf101", "QF101"},
+		{"dat520-2022", "DAT520_2022"},
+		{"2022-dat520", "DAT520"},
+		{"Course Name", "COURSE_NAME"},
+	}
+	for _, test := range tests {
+		if got := bitbucketProjectKey(test.path); got != test.want {
+			t.Errorf("bitbucketProjectKey(%q) = %q, want %q", test.path, got, test.want)
+		}
+	}
+}
+
+// fakeBitbucket is a fake Bitbucket server with a single project, serving
+// its repositories two per page, and recording changes to group members.
+// The commits of its labs repository are served newest first, and
+// the build statuses and comments created for them are recorded.
+type fakeBitbucket struct {
+	mu       sync.Mutex
+	repos    []bitbucketRepository
+	groups   map[string][]string
+	commits  []bitbucketCommit
+	changes  map[string][]string
+	statuses map[string][]map[string]string
+	comments map[string][]map[string]interface{}
+}
+
+func (f *fakeBitbucket) writePage(w http.ResponseWriter, r *http.Request, values interface{}, n int) {
+	start, _ := strconv.Atoi(r.URL.Query().Get("start"))
+	v := reflect.ValueOf(values)
+	end := start + 2
+	if end > n {
+		end = n
+	}
+	page := map[string]interface{}{
+		"values":        v.Slice(start, end).Interface(),
+		"isLastPage":    end == n,
+		"nextPageStart": end,
+	}
+	_ = json.NewEncoder(w).Encode(page)
+}
+
+func (f *fakeBitbucket) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if r.Header.Get("Authorization") != "Bearer token" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	project := bitbucketProject{ID: 7, Key: "QF101", Name: "qf101"}
+	switch path := r.URL.Path; {
+	case path == "/rest/api/1.0/projects" && r.Method == http.MethodGet:
+		projects := []bitbucketProject{{ID: 1, Key: "OTHER"}, {ID: 3, Key: "ANOTHER"}, project}
+		f.writePage(w, r, projects, len(projects))
+	case path == "/rest/api/1.0/projects/QF101" && r.Method == http.MethodGet:
+		_ = json.NewEncoder(w).Encode(project)
+	case path == "/rest/api/1.0/projects/QF101/repos" && r.Method == http.MethodGet:
+		f.writePage(w, r, f.repos, len(f.repos))
+	case path == "/rest/api/1.0/projects/QF101/repos/new-labs" && r.Method == http.MethodGet:
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"errors":[{"message":"Repository QF101/new-labs does not exist."}]}`)
+	case path == "/rest/api/1.0/projects/QF101/repos" && r.Method == http.MethodPost:
+		var in map[string]interface{}
+		_ = json.NewDecoder(r.Body).Decode(&in)
+		repo := bitbucketRepository{ID: uint64(100 + len(f.repos)), Slug: in["name"].(string), Name: in["name"].(string), Project: project}
+		repo.Links.Clone = []bitbucketLink{{Name: "http", Href: "https://bitbucket.example.com/scm/qf101/" + repo.Slug + ".git"}}
+		f.repos = append(f.repos, repo)
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(repo)
+	case path == "/rest/api/1.0/admin/groups" && r.Method == http.MethodPost:
+		f.groups[r.URL.Query().Get("name")] = nil
+	case path == "/rest/api/1.0/admin/groups" && r.Method == http.MethodGet:
+		var groups []bitbucketGroup
+		for name := range f.groups {
+			groups = append(groups, bitbucketGroup{Name: name})
+		}
+		sort.Slice(groups, func(i, j int) bool { return groups[i].Name < groups[j].Name })
+		f.writePage(w, r, groups, len(groups))
+	case path == "/rest/api/1.0/admin/groups/more-members":
+		var users []bitbucketUser
+		for _, name := range f.groups[r.URL.Query().Get("context")] {
+			users = append(users, bitbucketUser{Name: name})
+		}
+		f.writePage(w, r, users, len(users))
+	case path == "/rest/api/1.0/admin/groups/add-users":
+		var in struct {
+			Group string   `json:"group"`
+			Users []string `json:"users"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&in)
+		for _, user := range in.Users {
+			if !contains(f.groups[in.Group], user) {
+				f.groups[in.Group] = append(f.groups[in.Group], user)
+			}
+		}
+	case path == "/rest/api/1.0/admin/groups/remove-user":
+		var in map[string]string
+		_ = json.NewDecoder(r.Body).Decode(&in)
+		var members []string
+		for _, member := range f.groups[in["context"]] {
+			if member != in["itemName"] {
+				members = append(members, member)
+			}
+		}
+		f.groups[in["context"]] = members
+	case path == "/rest/api/1.0/projects/QF101/repos/labs/commits" && r.Method == http.MethodGet:
+		f.writePage(w, r, f.commits, len(f.commits))
+	case strings.HasPrefix(path, "/rest/api/1.0/projects/QF101/repos/labs/commits/") && strings.HasSuffix(path, "/changes"):
+		sha := strings.TrimSuffix(strings.TrimPrefix(path, "/rest/api/1.0/projects/QF101/repos/labs/commits/"), "/changes")
+		var changes []bitbucketChange
+		for _, file := range f.changes[sha] {
+			var change bitbucketChange
+			change.Path.ToString = file
+			changes = append(changes, change)
+		}
+		f.writePage(w, r, changes, len(changes))
+	case strings.HasPrefix(path, "/rest/api/1.0/projects/QF101/repos/labs/commits/") && strings.HasSuffix(path, "/comments") && r.Method == http.MethodPost:
+		sha := strings.TrimSuffix(strings.TrimPrefix(path, "/rest/api/1.0/projects/QF101/repos/labs/commits/"), "/comments")
+		var in map[string]interface{}
+		_ = json.NewDecoder(r.Body).Decode(&in)
+		f.comments[sha] = append(f.comments[sha], in)
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, `{"id":%d}`, len(f.comments[sha]))
+	case strings.HasPrefix(path, "/rest/build-status/1.0/commits/") && r.Method == http.MethodPost:
+		var in map[string]string
+		_ = json.NewDecoder(r.Body).Decode(&in)
+		sha := strings.TrimPrefix(path, "/rest/build-status/1.0/commits/")
+		f.statuses[sha] = append(f.statuses[sha], in)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func TestBitbucketRepositories(t *testing.T) {
+	fake := &fakeBitbucket{groups: make(map[string][]string)}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+	s := NewBitbucketSCMClient(zap.NewNop().Sugar(), server.URL+"/", "token")
+	ctx := context.Background()
+
+	org := &pb.Organization{ID: 7, Path: "qf101"}
+	for _, path := range []string{"assignments", "tests", "info"} {
+		fake.repos = append(fake.repos, bitbucketRepository{ID: uint64(len(fake.repos) + 1), Slug: path, Project: bitbucketProject{ID: 7, Key: "QF101"}})
+	}
+	repo, err := s.CreateRepository(ctx, &CreateRepositoryOptions{Organization: org, Path: "new-labs", Private: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &Repository{ID: 103, Path: "new-labs", Owner: "QF101", OrgID: 7, HTTPURL: "https://bitbucket.example.com/scm/qf101/new-labs.git"}
+	if !reflect.DeepEqual(repo, want) {
+		t.Errorf("CreateRepository() = %+v, want %+v", repo, want)
+	}
+
+	// the repositories are served two per page
+	repos, err := s.GetRepositories(ctx, &pb.Organization{ID: 7})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var paths []string
+	for _, repo := range repos {
+		paths = append(paths, repo.Path)
+	}
+	if wantPaths := []string{"assignments", "tests", "info", "new-labs"}; !reflect.DeepEqual(paths, wantPaths) {
+		t.Errorf("GetRepositories() = %v, want %v", paths, wantPaths)
+	}
+
+	cloneURL := s.CreateCloneURL(&URLPathOptions{Organization: "qf101", Repository: "new-labs", UserToken: "secret"})
+	wantURL := strings.Replace(server.URL, "http://", "http://x-token-auth:secret@", 1) + "/scm/qf101/new-labs.git"
+	if cloneURL != wantURL {
+		t.Errorf("CreateCloneURL() = %q, want %q", cloneURL, wantURL)
+	}
+}
+
+func TestBitbucketTeams(t *testing.T) {
+	fake := &fakeBitbucket{groups: map[string][]string{"other-group1": {"alice"}}}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+	s := NewBitbucketSCMClient(zap.NewNop().Sugar(), server.URL, "token")
+	ctx := context.Background()
+
+	team, err := s.CreateTeam(ctx, &NewTeamOptions{Organization: "qf101", TeamName: "group1", Users: []string{"alice", "bob"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if team.Name != "group1" || team.Organization != "QF101" || team.ID != bitbucketTeamID("qf101-group1") {
+		t.Errorf("CreateTeam() = %+v, want team group1 in project QF101", team)
+	}
+
+	// teams are found by the ID derived from the group name
+	got, err := s.GetTeam(ctx, &TeamOptions{OrganizationID: 7, TeamID: team.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, team) {
+		t.Errorf("GetTeam() = %+v, want %+v", got, team)
+	}
+	teams, err := s.GetTeams(ctx, &pb.Organization{Path: "qf101"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(teams) != 1 || !reflect.DeepEqual(teams[0], team) {
+		t.Errorf("GetTeams() = %v, want only %+v", teams, team)
+	}
+
+	if err := s.UpdateTeamMembers(ctx, &UpdateTeamOptions{OrganizationID: 7, TeamID: team.ID, Users: []string{"bob", "carol"}}); err != nil {
+		t.Fatal(err)
+	}
+	members := fake.groups["qf101-group1"]
+	sort.Strings(members)
+	if want := []string{"bob", "carol"}; !reflect.DeepEqual(members, want) {
+		t.Errorf("UpdateTeamMembers(): group members = %v, want %v", members, want)
+	}
+	// groups of other projects are not changed
+	if want := []string{"alice"}; !reflect.DeepEqual(fake.groups["other-group1"], want) {
+		t.Errorf("UpdateTeamMembers(): other group members = %v, want %v", fake.groups["other-group1"], want)
+	}
+
+	if _, err := s.GetTeam(ctx, &TeamOptions{OrganizationID: 7, TeamID: 1}); err == nil {
+		t.Error("GetTeam() with unknown team ID: expected error")
+	}
+}
+
+func TestBitbucketCommits(t *testing.T) {
+	now := time.Now()
+	fake := &fakeBitbucket{
+		groups: make(map[string][]string),
+		commits: []bitbucketCommit{
+			{ID: "c3", Message: "lab2", CommitterTimestamp: now.Add(-time.Hour).UnixNano() / int64(time.Millisecond)},
+			{ID: "c2", Message: "lab1", CommitterTimestamp: now.Add(-2*time.Hour).UnixNano() / int64(time.Millisecond)},
+			{ID: "c1", Message: "initial", CommitterTimestamp: now.Add(-48*time.Hour).UnixNano() / int64(time.Millisecond)},
+		},
+		changes:  map[string][]string{"c3": {"lab2/main.go"}, "c2": {"lab1/main.go", "lab1/go.mod"}},
+		statuses: make(map[string][]map[string]string),
+		comments: make(map[string][]map[string]interface{}),
+	}
+	fake.commits[0].Author.Name = "alice"
+	server := httptest.NewServer(fake)
+	defer server.Close()
+	s := NewBitbucketSCMClient(zap.NewNop().Sugar(), server.URL, "token")
+	ctx := context.Background()
+
+	// the commits are served two per page, and listed until the first commit before since
+	commits, err := s.GetCommits(ctx, &CommitOptions{Owner: "qf101", Repository: "labs", Since: now.Add(-24 * time.Hour)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, commit := range commits {
+		got = append(got, fmt.Sprintf("%s %s %v", commit.SHA, commit.Author, commit.Files))
+	}
+	if want := []string{"c3 alice [lab2/main.go]", "c2  [lab1/main.go lab1/go.mod]"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("GetCommits() = %q, want %q", got, want)
+	}
+
+	if err := s.CreateCommitStatus(ctx, &CommitStatusOptions{Owner: "qf101", Repository: "labs", CommitSHA: "c3", State: CommitSuccess, Context: "quickfeed/lab2", Description: "Score 100%"}); err != nil {
+		t.Fatal(err)
+	}
+	wantStatus := map[string]string{
+		"state":       "SUCCESSFUL",
+		"key":         "quickfeed/lab2",
+		"name":        "quickfeed/lab2",
+		"url":         server.URL + "/projects/QF101/repos/labs/commits/c3",
+		"description": "Score 100%",
+	}
+	if statuses := fake.statuses["c3"]; len(statuses) != 1 || !reflect.DeepEqual(statuses[0], wantStatus) {
+		t.Errorf("CreateCommitStatus(): statuses = %v, want %v", statuses, wantStatus)
+	}
+	if err := s.CreateCommitStatus(ctx, &CommitStatusOptions{Owner: "qf101", Repository: "labs", CommitSHA: "c3", State: "unknown"}); err == nil {
+		t.Error("CreateCommitStatus() with unknown state: expected error")
+	}
+
+	url, err := s.CreateCommitComment(ctx, &CommitCommentOptions{Owner: "qf101", Repository: "labs", CommitSHA: "c2", Path: "lab1/main.go", Line: 7, Body: "Use a constant"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := server.URL + "/projects/QF101/repos/labs/commits/c2?commentId=1"; url != want {
+		t.Errorf("CreateCommitComment() = %q, want %q", url, want)
+	}
+	wantComment := map[string]interface{}{
+		"text":   "Use a constant",
+		"anchor": map[string]interface{}{"path": "lab1/main.go", "line": 7.0, "lineType": "ADDED", "fileType": "TO", "diffType": "COMMIT"},
+	}
+	if comments := fake.comments["c2"]; len(comments) != 1 || !reflect.DeepEqual(comments[0], wantComment) {
+		t.Errorf("CreateCommitComment(): comments = %v, want %v", comments, wantComment)
+	}
+}
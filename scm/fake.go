@@ -3,6 +3,7 @@ package scm
 import (
 	"context"
 	"errors"
+	"fmt"
 	"strconv"
 
 	pb "github.com/autograde/quickfeed/ag"
@@ -14,15 +15,48 @@ type FakeSCM struct {
 	Organizations map[uint64]*pb.Organization
 	Hooks         map[uint64]int
 	Teams         map[uint64]*Team
+	// OrgHooks maps organization paths to the organization's hooks.
+	OrgHooks map[string][]*Hook
+	// TeamRepos maps team IDs to the team's repositories and permissions.
+	TeamRepos map[uint64]map[string]string
+	// TeamMembers maps team IDs to the logins of the team's members.
+	TeamMembers map[uint64][]string
+	// Collaborators maps repository paths to the repository's collaborators and permissions.
+	Collaborators map[string]map[string]string
+	// Discussions maps "owner/repository/category" to the category's discussions.
+	Discussions map[string][]*Discussion
+	// CommitComments maps "owner/repository/sha" to the comments on the commit.
+	CommitComments map[string][]*CommitCommentOptions
+	// Issues maps "owner/repository" to the issues created in the repository.
+	Issues map[string][]*IssueOptions
+	// Commits maps "owner/repository" to the commits on the repository's default branch, newest first.
+	Commits map[string][]*Commit
+	// CommitStatuses maps "owner/repository/sha" to the statuses set for the commit, oldest first.
+	CommitStatuses map[string][]*CommitStatusOptions
+	// RepoTemplates maps repository IDs to the template the repository was created from.
+	RepoTemplates map[uint64]*Repository
+	// OrgMembers maps organization paths to the logins of the organization's members.
+	OrgMembers map[string][]string
 }
 
 // NewFakeSCMClient returns a new Fake client implementing the SCM interface.
 func NewFakeSCMClient() *FakeSCM {
 	return &FakeSCM{
-		Repositories:  make(map[uint64]*Repository),
-		Organizations: make(map[uint64]*pb.Organization),
-		Hooks:         make(map[uint64]int),
-		Teams:         make(map[uint64]*Team),
+		Repositories:   make(map[uint64]*Repository),
+		Organizations:  make(map[uint64]*pb.Organization),
+		Hooks:          make(map[uint64]int),
+		OrgHooks:       make(map[string][]*Hook),
+		Teams:          make(map[uint64]*Team),
+		TeamRepos:      make(map[uint64]map[string]string),
+		TeamMembers:    make(map[uint64][]string),
+		Collaborators:  make(map[string]map[string]string),
+		Discussions:    make(map[string][]*Discussion),
+		CommitComments: make(map[string][]*CommitCommentOptions),
+		Issues:         make(map[string][]*IssueOptions),
+		Commits:        make(map[string][]*Commit),
+		CommitStatuses: make(map[string][]*CommitStatusOptions),
+		RepoTemplates:  make(map[uint64]*Repository),
+		OrgMembers:     make(map[string][]string),
 	}
 }
 
@@ -64,6 +98,9 @@ func (s *FakeSCM) CreateRepository(ctx context.Context, opt *CreateRepositoryOpt
 		OrgID:   opt.Organization.ID,
 	}
 	s.Repositories[repo.ID] = repo
+	if opt.Template != nil {
+		s.RepoTemplates[repo.ID] = opt.Template
+	}
 	return repo, nil
 }
 
@@ -93,12 +130,53 @@ func (s *FakeSCM) DeleteRepository(ctx context.Context, opt *RepositoryOptions)
 	return nil
 }
 
+// ArchiveRepository implements the SCM interface.
+func (s *FakeSCM) ArchiveRepository(ctx context.Context, opt *RepositoryOptions) error {
+	repo, ok := s.Repositories[opt.ID]
+	if !ok {
+		return errors.New("repository not found")
+	}
+	repo.Archived = true
+	return nil
+}
+
 // UpdateRepoAccess implements the SCM interface.
 func (s *FakeSCM) UpdateRepoAccess(ctx context.Context, repo *Repository, user, permission string) error {
-	// TODO no implementation provided yet
+	if s.Collaborators[repo.Path] == nil {
+		s.Collaborators[repo.Path] = make(map[string]string)
+	}
+	s.Collaborators[repo.Path][user] = permission
+	return nil
+}
+
+// RemoveRepoCollaborator implements the SCM interface.
+func (s *FakeSCM) RemoveRepoCollaborator(ctx context.Context, repo *Repository, user string) error {
+	delete(s.Collaborators[repo.Path], user)
 	return nil
 }
 
+// GetRepositoryAccess implements the SCM interface.
+func (s *FakeSCM) GetRepositoryAccess(ctx context.Context, opt *RepositoryOptions) (*RepositoryAccess, error) {
+	path := opt.Path
+	if opt.ID > 0 {
+		repo, ok := s.Repositories[opt.ID]
+		if !ok {
+			return nil, errors.New("repository not found")
+		}
+		path = repo.Path
+	}
+	access := &RepositoryAccess{}
+	for teamID, repos := range s.TeamRepos {
+		if permission, ok := repos[path]; ok {
+			access.Teams = append(access.Teams, &Permission{ID: teamID, Name: s.Teams[teamID].Name, Permission: permission})
+		}
+	}
+	for user, permission := range s.Collaborators[path] {
+		access.Collaborators = append(access.Collaborators, &Permission{Name: user, Permission: permission})
+	}
+	return access, nil
+}
+
 // RepositoryIsEmpty implements the SCM interface
 func (s *FakeSCM) RepositoryIsEmpty(ctx context.Context, opt *RepositoryOptions) bool {
 	// TODO no implementation provided yet
@@ -107,7 +185,9 @@ func (s *FakeSCM) RepositoryIsEmpty(ctx context.Context, opt *RepositoryOptions)
 
 // ListHooks implements the SCM interface.
 func (s *FakeSCM) ListHooks(ctx context.Context, repo *Repository, org string) ([]*Hook, error) {
-	// TODO no implementation provided yet
+	if org != "" {
+		return s.OrgHooks[org], nil
+	}
 	return nil, nil
 }
 
@@ -118,7 +198,10 @@ func (s *FakeSCM) CreateHook(ctx context.Context, opt *CreateHookOptions) error
 			return errors.New("repository not found")
 		}
 		s.Hooks[opt.Repository.ID]++
+		return nil
 	}
+	hooks := s.OrgHooks[opt.Organization]
+	s.OrgHooks[opt.Organization] = append(hooks, &Hook{ID: uint64(len(hooks) + 1), URL: opt.URL, Events: []string{"push"}})
 	return nil
 }
 
@@ -130,15 +213,18 @@ func (s *FakeSCM) CreateTeam(ctx context.Context, opt *NewTeamOptions) (*Team, e
 		Organization: opt.Organization,
 	}
 	s.Teams[newTeam.ID] = newTeam
+	s.TeamMembers[newTeam.ID] = append([]string{}, opt.Users...)
 	return newTeam, nil
 }
 
 // DeleteTeam implements the SCM interface.
 func (s *FakeSCM) DeleteTeam(ctx context.Context, opt *TeamOptions) error {
 	if _, ok := s.Teams[opt.TeamID]; !ok {
-		return errors.New("repository not found")
+		return errors.New("team not found")
 	}
-	delete(s.Repositories, opt.TeamID)
+	delete(s.Teams, opt.TeamID)
+	delete(s.TeamRepos, opt.TeamID)
+	delete(s.TeamMembers, opt.TeamID)
 	return nil
 }
 
@@ -176,7 +262,10 @@ func (s *FakeSCM) RemoveTeamMember(ctx context.Context, opt *TeamMembershipOptio
 
 // UpdateTeamMembers implements the SCM interface.
 func (s *FakeSCM) UpdateTeamMembers(ctx context.Context, opt *UpdateTeamOptions) error {
-	// TODO no implementation provided yet
+	if _, ok := s.Teams[opt.TeamID]; !ok {
+		return errors.New("team not found")
+	}
+	s.TeamMembers[opt.TeamID] = append([]string{}, opt.Users...)
 	return nil
 }
 
@@ -187,6 +276,19 @@ func (s *FakeSCM) CreateCloneURL(opt *URLPathOptions) string {
 
 // AddTeamRepo implements the SCM interface.
 func (s *FakeSCM) AddTeamRepo(ctx context.Context, opt *AddTeamRepoOptions) error {
+	if _, ok := s.Teams[opt.TeamID]; !ok {
+		return errors.New("team not found")
+	}
+	if s.TeamRepos[opt.TeamID] == nil {
+		s.TeamRepos[opt.TeamID] = make(map[string]string)
+	}
+	s.TeamRepos[opt.TeamID][opt.Repo] = opt.Permission
+	return nil
+}
+
+// RemoveTeamRepo implements the SCM interface.
+func (s *FakeSCM) RemoveTeamRepo(ctx context.Context, opt *RemoveTeamRepoOptions) error {
+	delete(s.TeamRepos[opt.TeamID], opt.Repo)
 	return nil
 }
 
@@ -206,6 +308,66 @@ func (s *FakeSCM) UpdateOrgMembership(ctx context.Context, opt *OrgMembershipOpt
 	return nil
 }
 
+// GetOrgMembers implements the SCM interface
+func (s *FakeSCM) GetOrgMembers(ctx context.Context, org *pb.Organization) ([]string, error) {
+	if !org.IsValid() {
+		return nil, errors.New("invalid argument")
+	}
+	return append([]string{}, s.OrgMembers[org.GetPath()]...), nil
+}
+
+// GetDiscussions implements the SCM interface
+func (s *FakeSCM) GetDiscussions(ctx context.Context, opt *DiscussionOptions) ([]*Discussion, error) {
+	if !opt.valid() {
+		return nil, errors.New("invalid argument")
+	}
+	return s.Discussions[opt.Owner+"/"+opt.Repository+"/"+opt.Category], nil
+}
+
+// CreateCommitComment implements the SCM interface
+func (s *FakeSCM) CreateCommitComment(ctx context.Context, opt *CommitCommentOptions) (string, error) {
+	if !opt.valid() {
+		return "", errors.New("invalid argument")
+	}
+	key := opt.Owner + "/" + opt.Repository + "/" + opt.CommitSHA
+	s.CommitComments[key] = append(s.CommitComments[key], opt)
+	return fmt.Sprintf("https://example.com/%s/commit/%s#commitcomment-%d", opt.Owner+"/"+opt.Repository, opt.CommitSHA, len(s.CommitComments[key])), nil
+}
+
+// CreateIssue implements the SCM interface
+func (s *FakeSCM) CreateIssue(ctx context.Context, opt *IssueOptions) (string, error) {
+	if !opt.valid() {
+		return "", errors.New("invalid argument")
+	}
+	key := opt.Owner + "/" + opt.Repository
+	s.Issues[key] = append(s.Issues[key], opt)
+	return fmt.Sprintf("https://example.com/%s/issues/%d", key, len(s.Issues[key])), nil
+}
+
+// GetCommits implements the SCM interface
+func (s *FakeSCM) GetCommits(ctx context.Context, opt *CommitOptions) ([]*Commit, error) {
+	if !opt.valid() {
+		return nil, errors.New("invalid argument")
+	}
+	var commits []*Commit
+	for _, commit := range s.Commits[opt.Owner+"/"+opt.Repository] {
+		if commit.Date.After(opt.Since) {
+			commits = append(commits, commit)
+		}
+	}
+	return commits, nil
+}
+
+// CreateCommitStatus implements the SCM interface
+func (s *FakeSCM) CreateCommitStatus(ctx context.Context, opt *CommitStatusOptions) error {
+	if !opt.valid() {
+		return errors.New("invalid argument")
+	}
+	key := opt.Owner + "/" + opt.Repository + "/" + opt.CommitSHA
+	s.CommitStatuses[key] = append(s.CommitStatuses[key], opt)
+	return nil
+}
+
 // RemoveMember implements the SCM interface
 func (s *FakeSCM) RemoveMember(ctx context.Context, opt *OrgMembershipOptions) error {
 	// TODO no implementation provided yet
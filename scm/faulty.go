@@ -0,0 +1,295 @@
+package scm
+
+import (
+	"context"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/internal/fault"
+)
+
+// FaultySCM implements the SCM interface by injecting faults into the calls
+// to another SCM client, to exercise retry and rollback paths.
+// Calls that cannot return an error are only delayed.
+type FaultySCM struct {
+	scm      SCM
+	injector *fault.Injector
+}
+
+// NewFaultySCMClient returns an SCM client injecting the injector's faults into the calls to the given client.
+func NewFaultySCMClient(scm SCM, injector *fault.Injector) *FaultySCM {
+	return &FaultySCM{scm: scm, injector: injector}
+}
+
+// CreateOrganization implements the SCM interface.
+func (s *FaultySCM) CreateOrganization(ctx context.Context, opt *OrganizationOptions) (*pb.Organization, error) {
+	if err := s.injector.Inject(ctx, "CreateOrganization"); err != nil {
+		return nil, err
+	}
+	return s.scm.CreateOrganization(ctx, opt)
+}
+
+// UpdateOrganization implements the SCM interface.
+func (s *FaultySCM) UpdateOrganization(ctx context.Context, opt *OrganizationOptions) error {
+	if err := s.injector.Inject(ctx, "UpdateOrganization"); err != nil {
+		return err
+	}
+	return s.scm.UpdateOrganization(ctx, opt)
+}
+
+// GetOrganization implements the SCM interface.
+func (s *FaultySCM) GetOrganization(ctx context.Context, opt *GetOrgOptions) (*pb.Organization, error) {
+	if err := s.injector.Inject(ctx, "GetOrganization"); err != nil {
+		return nil, err
+	}
+	return s.scm.GetOrganization(ctx, opt)
+}
+
+// CreateRepository implements the SCM interface.
+func (s *FaultySCM) CreateRepository(ctx context.Context, opt *CreateRepositoryOptions) (*Repository, error) {
+	if err := s.injector.Inject(ctx, "CreateRepository"); err != nil {
+		return nil, err
+	}
+	return s.scm.CreateRepository(ctx, opt)
+}
+
+// GetRepository implements the SCM interface.
+func (s *FaultySCM) GetRepository(ctx context.Context, opt *RepositoryOptions) (*Repository, error) {
+	if err := s.injector.Inject(ctx, "GetRepository"); err != nil {
+		return nil, err
+	}
+	return s.scm.GetRepository(ctx, opt)
+}
+
+// GetRepositories implements the SCM interface.
+func (s *FaultySCM) GetRepositories(ctx context.Context, org *pb.Organization) ([]*Repository, error) {
+	if err := s.injector.Inject(ctx, "GetRepositories"); err != nil {
+		return nil, err
+	}
+	return s.scm.GetRepositories(ctx, org)
+}
+
+// DeleteRepository implements the SCM interface.
+func (s *FaultySCM) DeleteRepository(ctx context.Context, opt *RepositoryOptions) error {
+	if err := s.injector.Inject(ctx, "DeleteRepository"); err != nil {
+		return err
+	}
+	return s.scm.DeleteRepository(ctx, opt)
+}
+
+// ArchiveRepository implements the SCM interface.
+func (s *FaultySCM) ArchiveRepository(ctx context.Context, opt *RepositoryOptions) error {
+	if err := s.injector.Inject(ctx, "ArchiveRepository"); err != nil {
+		return err
+	}
+	return s.scm.ArchiveRepository(ctx, opt)
+}
+
+// UpdateRepoAccess implements the SCM interface.
+func (s *FaultySCM) UpdateRepoAccess(ctx context.Context, repo *Repository, user, permission string) error {
+	if err := s.injector.Inject(ctx, "UpdateRepoAccess"); err != nil {
+		return err
+	}
+	return s.scm.UpdateRepoAccess(ctx, repo, user, permission)
+}
+
+// RemoveRepoCollaborator implements the SCM interface.
+func (s *FaultySCM) RemoveRepoCollaborator(ctx context.Context, repo *Repository, user string) error {
+	if err := s.injector.Inject(ctx, "RemoveRepoCollaborator"); err != nil {
+		return err
+	}
+	return s.scm.RemoveRepoCollaborator(ctx, repo, user)
+}
+
+// GetRepositoryAccess implements the SCM interface.
+func (s *FaultySCM) GetRepositoryAccess(ctx context.Context, opt *RepositoryOptions) (*RepositoryAccess, error) {
+	if err := s.injector.Inject(ctx, "GetRepositoryAccess"); err != nil {
+		return nil, err
+	}
+	return s.scm.GetRepositoryAccess(ctx, opt)
+}
+
+// RepositoryIsEmpty implements the SCM interface.
+func (s *FaultySCM) RepositoryIsEmpty(ctx context.Context, opt *RepositoryOptions) bool {
+	_ = s.injector.Inject(ctx, "RepositoryIsEmpty")
+	return s.scm.RepositoryIsEmpty(ctx, opt)
+}
+
+// ListHooks implements the SCM interface.
+func (s *FaultySCM) ListHooks(ctx context.Context, repo *Repository, org string) ([]*Hook, error) {
+	if err := s.injector.Inject(ctx, "ListHooks"); err != nil {
+		return nil, err
+	}
+	return s.scm.ListHooks(ctx, repo, org)
+}
+
+// CreateHook implements the SCM interface.
+func (s *FaultySCM) CreateHook(ctx context.Context, opt *CreateHookOptions) error {
+	if err := s.injector.Inject(ctx, "CreateHook"); err != nil {
+		return err
+	}
+	return s.scm.CreateHook(ctx, opt)
+}
+
+// CreateTeam implements the SCM interface.
+func (s *FaultySCM) CreateTeam(ctx context.Context, opt *NewTeamOptions) (*Team, error) {
+	if err := s.injector.Inject(ctx, "CreateTeam"); err != nil {
+		return nil, err
+	}
+	return s.scm.CreateTeam(ctx, opt)
+}
+
+// DeleteTeam implements the SCM interface.
+func (s *FaultySCM) DeleteTeam(ctx context.Context, opt *TeamOptions) error {
+	if err := s.injector.Inject(ctx, "DeleteTeam"); err != nil {
+		return err
+	}
+	return s.scm.DeleteTeam(ctx, opt)
+}
+
+// GetTeam implements the SCM interface.
+func (s *FaultySCM) GetTeam(ctx context.Context, opt *TeamOptions) (*Team, error) {
+	if err := s.injector.Inject(ctx, "GetTeam"); err != nil {
+		return nil, err
+	}
+	return s.scm.GetTeam(ctx, opt)
+}
+
+// GetTeams implements the SCM interface.
+func (s *FaultySCM) GetTeams(ctx context.Context, org *pb.Organization) ([]*Team, error) {
+	if err := s.injector.Inject(ctx, "GetTeams"); err != nil {
+		return nil, err
+	}
+	return s.scm.GetTeams(ctx, org)
+}
+
+// AddTeamRepo implements the SCM interface.
+func (s *FaultySCM) AddTeamRepo(ctx context.Context, opt *AddTeamRepoOptions) error {
+	if err := s.injector.Inject(ctx, "AddTeamRepo"); err != nil {
+		return err
+	}
+	return s.scm.AddTeamRepo(ctx, opt)
+}
+
+// RemoveTeamRepo implements the SCM interface.
+func (s *FaultySCM) RemoveTeamRepo(ctx context.Context, opt *RemoveTeamRepoOptions) error {
+	if err := s.injector.Inject(ctx, "RemoveTeamRepo"); err != nil {
+		return err
+	}
+	return s.scm.RemoveTeamRepo(ctx, opt)
+}
+
+// AddTeamMember implements the SCM interface.
+func (s *FaultySCM) AddTeamMember(ctx context.Context, opt *TeamMembershipOptions) error {
+	if err := s.injector.Inject(ctx, "AddTeamMember"); err != nil {
+		return err
+	}
+	return s.scm.AddTeamMember(ctx, opt)
+}
+
+// RemoveTeamMember implements the SCM interface.
+func (s *FaultySCM) RemoveTeamMember(ctx context.Context, opt *TeamMembershipOptions) error {
+	if err := s.injector.Inject(ctx, "RemoveTeamMember"); err != nil {
+		return err
+	}
+	return s.scm.RemoveTeamMember(ctx, opt)
+}
+
+// UpdateTeamMembers implements the SCM interface.
+func (s *FaultySCM) UpdateTeamMembers(ctx context.Context, opt *UpdateTeamOptions) error {
+	if err := s.injector.Inject(ctx, "UpdateTeamMembers"); err != nil {
+		return err
+	}
+	return s.scm.UpdateTeamMembers(ctx, opt)
+}
+
+// GetUserName implements the SCM interface.
+func (s *FaultySCM) GetUserName(ctx context.Context) (string, error) {
+	if err := s.injector.Inject(ctx, "GetUserName"); err != nil {
+		return "", err
+	}
+	return s.scm.GetUserName(ctx)
+}
+
+// GetUserNameByID implements the SCM interface.
+func (s *FaultySCM) GetUserNameByID(ctx context.Context, remoteID uint64) (string, error) {
+	if err := s.injector.Inject(ctx, "GetUserNameByID"); err != nil {
+		return "", err
+	}
+	return s.scm.GetUserNameByID(ctx, remoteID)
+}
+
+// CreateCloneURL implements the SCM interface.
+func (s *FaultySCM) CreateCloneURL(opt *URLPathOptions) string {
+	// no call to the provider; nothing to inject
+	return s.scm.CreateCloneURL(opt)
+}
+
+// UpdateOrgMembership implements the SCM interface.
+func (s *FaultySCM) UpdateOrgMembership(ctx context.Context, opt *OrgMembershipOptions) error {
+	if err := s.injector.Inject(ctx, "UpdateOrgMembership"); err != nil {
+		return err
+	}
+	return s.scm.UpdateOrgMembership(ctx, opt)
+}
+
+// RemoveMember implements the SCM interface.
+func (s *FaultySCM) RemoveMember(ctx context.Context, opt *OrgMembershipOptions) error {
+	if err := s.injector.Inject(ctx, "RemoveMember"); err != nil {
+		return err
+	}
+	return s.scm.RemoveMember(ctx, opt)
+}
+
+// GetOrgMembers implements the SCM interface.
+func (s *FaultySCM) GetOrgMembers(ctx context.Context, org *pb.Organization) ([]string, error) {
+	if err := s.injector.Inject(ctx, "GetOrgMembers"); err != nil {
+		return nil, err
+	}
+	return s.scm.GetOrgMembers(ctx, org)
+}
+
+// GetDiscussions implements the SCM interface.
+func (s *FaultySCM) GetDiscussions(ctx context.Context, opt *DiscussionOptions) ([]*Discussion, error) {
+	if err := s.injector.Inject(ctx, "GetDiscussions"); err != nil {
+		return nil, err
+	}
+	return s.scm.GetDiscussions(ctx, opt)
+}
+
+// CreateCommitComment implements the SCM interface.
+func (s *FaultySCM) CreateCommitComment(ctx context.Context, opt *CommitCommentOptions) (string, error) {
+	if err := s.injector.Inject(ctx, "CreateCommitComment"); err != nil {
+		return "", err
+	}
+	return s.scm.CreateCommitComment(ctx, opt)
+}
+
+// CreateIssue implements the SCM interface.
+func (s *FaultySCM) CreateIssue(ctx context.Context, opt *IssueOptions) (string, error) {
+	if err := s.injector.Inject(ctx, "CreateIssue"); err != nil {
+		return "", err
+	}
+	return s.scm.CreateIssue(ctx, opt)
+}
+
+// GetCommits implements the SCM interface.
+func (s *FaultySCM) GetCommits(ctx context.Context, opt *CommitOptions) ([]*Commit, error) {
+	if err := s.injector.Inject(ctx, "GetCommits"); err != nil {
+		return nil, err
+	}
+	return s.scm.GetCommits(ctx, opt)
+}
+
+// CreateCommitStatus implements the SCM interface.
+func (s *FaultySCM) CreateCommitStatus(ctx context.Context, opt *CommitStatusOptions) error {
+	if err := s.injector.Inject(ctx, "CreateCommitStatus"); err != nil {
+		return err
+	}
+	return s.scm.CreateCommitStatus(ctx, opt)
+}
+
+// GetUserScopes implements the SCM interface.
+func (s *FaultySCM) GetUserScopes(ctx context.Context) *Authorization {
+	_ = s.injector.Inject(ctx, "GetUserScopes")
+	return s.scm.GetUserScopes(ctx)
+}
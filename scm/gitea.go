@@ -0,0 +1,981 @@
+package scm
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"go.uber.org/zap"
+)
+
+// giteaOwnersTeam is the team of organization owners, created with every Gitea organization.
+const giteaOwnersTeam = "Owners"
+
+// GiteaURL returns the base URL of the Gitea server, given by the GITEA_URL
+// environment variable; the default is https://gitea.com.
+func GiteaURL() string {
+	if u := os.Getenv("GITEA_URL"); u != "" {
+		return strings.TrimSuffix(u, "/")
+	}
+	return "https://gitea.com"
+}
+
+// GiteaSCM implements the SCM interface for Gitea, using its REST API.
+type GiteaSCM struct {
+	logger  *zap.SugaredLogger
+	client  *http.Client
+	baseURL string
+	token   string
+}
+
+// NewGiteaSCMClient returns a new Gitea client implementing the SCM interface,
+// for the Gitea server at baseURL, e.g., https://gitea.example.com.
+func NewGiteaSCMClient(logger *zap.SugaredLogger, baseURL, token string) *GiteaSCM {
+	return &GiteaSCM{
+		logger:  logger,
+		client:  &http.Client{Timeout: 30 * time.Second, Transport: newRateLimitTransport(logger, "gitea", nil)},
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+		token:   token,
+	}
+}
+
+// CreateOrganization implements the SCM interface.
+func (s *GiteaSCM) CreateOrganization(ctx context.Context, opt *OrganizationOptions) (*pb.Organization, error) {
+	if !opt.valid() {
+		return nil, ErrMissingFields{
+			Method:  "CreateOrganization",
+			Message: fmt.Sprintf("%+v", opt),
+		}
+	}
+	in := map[string]interface{}{
+		"username":   opt.Path,
+		"full_name":  opt.Name,
+		"visibility": "private",
+		// only owners can change the teams' repository access
+		"repo_admin_change_team_access": false,
+	}
+	var org giteaOrganization
+	if err := s.do(ctx, http.MethodPost, giteaPath("orgs"), nil, in, &org); err != nil {
+		return nil, fmt.Errorf("CreateOrganization: failed to create Gitea organization %s: %w", opt.Path, err)
+	}
+	return &pb.Organization{
+		ID:     org.ID,
+		Path:   org.UserName,
+		Avatar: org.AvatarURL,
+	}, nil
+}
+
+// UpdateOrganization implements the SCM interface.
+// Gitea organization members only have access to repositories through their teams,
+// and only members of teams allowed to create repositories can create repositories.
+// Hence, the only supported default permission is none.
+func (s *GiteaSCM) UpdateOrganization(ctx context.Context, opt *OrganizationOptions) error {
+	if !opt.valid() {
+		return ErrMissingFields{
+			Method:  "UpdateOrganization",
+			Message: fmt.Sprintf("%+v", opt),
+		}
+	}
+	if opt.DefaultPermission != OrgNone {
+		return ErrNotSupported{
+			SCM:    "gitea",
+			Method: "UpdateOrganization with default permission " + opt.DefaultPermission,
+		}
+	}
+	return nil
+}
+
+// GetOrganization implements the SCM interface.
+func (s *GiteaSCM) GetOrganization(ctx context.Context, opt *GetOrgOptions) (*pb.Organization, error) {
+	if !opt.valid() {
+		return nil, ErrMissingFields{
+			Method:  "GetOrganization",
+			Message: fmt.Sprintf("%+v", opt),
+		}
+	}
+	org, err := s.organization(ctx, opt.ID, opt.Name)
+	if err != nil {
+		return nil, ErrFailedSCM{
+			Method:   "GetOrganization",
+			Message:  fmt.Sprintf("could not find Gitea organization %s (ID %d)", opt.Name, opt.ID),
+			GitError: err,
+		}
+	}
+	// if user name is provided, return the found organization only if the user is one of its owners
+	if opt.Username != "" {
+		var permissions struct {
+			IsOwner bool `json:"is_owner"`
+		}
+		err := s.do(ctx, http.MethodGet, giteaPath("users", opt.Username, "orgs", org.UserName, "permissions"), nil, nil, &permissions)
+		switch {
+		case errors.Is(err, errGiteaNotFound):
+			return nil, ErrNotMember
+		case err != nil:
+			return nil, fmt.Errorf("GetOrganization: failed to get permissions of %s in Gitea organization %s: %w", opt.Username, org.UserName, err)
+		case !permissions.IsOwner:
+			return nil, ErrNotOwner
+		}
+	}
+	return &pb.Organization{
+		ID:     org.ID,
+		Path:   org.UserName,
+		Avatar: org.AvatarURL,
+	}, nil
+}
+
+// CreateRepository implements the SCM interface.
+func (s *GiteaSCM) CreateRepository(ctx context.Context, opt *CreateRepositoryOptions) (*Repository, error) {
+	if !opt.valid() {
+		return nil, ErrMissingFields{
+			Method:  "CreateRepository",
+			Message: fmt.Sprintf("%+v", opt),
+		}
+	}
+	org := opt.Organization.GetPath()
+	// check that repo does not already exist for this organization
+	var repo giteaRepository
+	err := s.do(ctx, http.MethodGet, giteaPath("repos", org, opt.Path), nil, nil, &repo)
+	if err == nil {
+		s.logger.Debugf("CreateRepository: found existing repository (skipping creation): %s: %v", opt.Path, repo)
+		return repo.toRepository(), nil
+	}
+	if !errors.Is(err, errGiteaNotFound) {
+		s.logger.Debugf("CreateRepository: check for repository %s: %s", opt.Path, err)
+	}
+	if opt.Template != nil {
+		err = s.createFromTemplate(ctx, opt, &repo)
+	} else {
+		in := map[string]interface{}{"name": opt.Path, "private": opt.Private}
+		err = s.do(ctx, http.MethodPost, giteaPath("orgs", org, "repos"), nil, in, &repo)
+	}
+	if err != nil {
+		return nil, ErrFailedSCM{
+			Method:   "CreateRepository",
+			Message:  fmt.Sprintf("failed to create repository %s, make sure it does not already exist", opt.Path),
+			GitError: err,
+		}
+	}
+	return repo.toRepository(), nil
+}
+
+// createFromTemplate generates the repository with the git contents of the template repository.
+// The template repository is marked as a template first, if it is not already.
+func (s *GiteaSCM) createFromTemplate(ctx context.Context, opt *CreateRepositoryOptions, repo *giteaRepository) error {
+	owner := opt.Template.Owner
+	if owner == "" {
+		owner = opt.Organization.GetPath()
+	}
+	template, err := s.repository(ctx, &RepositoryOptions{ID: opt.Template.ID, Owner: owner, Path: opt.Template.Path})
+	if err != nil {
+		return fmt.Errorf("failed to get template repository %s: %w", opt.Template.Path, err)
+	}
+	if !template.Template {
+		edit := map[string]interface{}{"template": true}
+		if err := s.do(ctx, http.MethodPatch, giteaPath("repos", template.Owner.Login, template.Name), nil, edit, nil); err != nil {
+			return fmt.Errorf("failed to mark repository %s as template: %w", template.Name, err)
+		}
+	}
+	in := map[string]interface{}{
+		"owner":       opt.Organization.GetPath(),
+		"name":        opt.Path,
+		"private":     opt.Private,
+		"git_content": true,
+	}
+	return s.do(ctx, http.MethodPost, giteaPath("repos", template.Owner.Login, template.Name, "generate"), nil, in, repo)
+}
+
+// GetRepository implements the SCM interface.
+func (s *GiteaSCM) GetRepository(ctx context.Context, opt *RepositoryOptions) (*Repository, error) {
+	if !opt.valid() {
+		return nil, ErrMissingFields{
+			Method:  "GetRepository",
+			Message: fmt.Sprintf("%+v", opt),
+		}
+	}
+	repo, err := s.repository(ctx, opt)
+	if err != nil {
+		return nil, fmt.Errorf("GetRepository: failed to get Gitea repository %+v: %w", opt, err)
+	}
+	return repo.toRepository(), nil
+}
+
+// GetRepositories implements the SCM interface.
+func (s *GiteaSCM) GetRepositories(ctx context.Context, org *pb.Organization) ([]*Repository, error) {
+	giteaOrg, err := s.organization(ctx, org.GetID(), org.GetPath())
+	if err != nil {
+		return nil, fmt.Errorf("GetRepositories: failed to get Gitea organization %s: %w", org.GetPath(), err)
+	}
+	var repositories []*Repository
+	err = s.pages(ctx, giteaPath("orgs", giteaOrg.UserName, "repos"), nil, func(items json.RawMessage) (bool, error) {
+		var repos []*giteaRepository
+		if err := json.Unmarshal(items, &repos); err != nil {
+			return true, err
+		}
+		for _, repo := range repos {
+			repositories = append(repositories, repo.toRepository())
+		}
+		return false, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("GetRepositories: failed to list repositories of Gitea organization %s: %w", giteaOrg.UserName, err)
+	}
+	return repositories, nil
+}
+
+// DeleteRepository implements the SCM interface.
+func (s *GiteaSCM) DeleteRepository(ctx context.Context, opt *RepositoryOptions) error {
+	if !opt.valid() {
+		return ErrMissingFields{
+			Method:  "DeleteRepository",
+			Message: fmt.Sprintf("%+v", opt),
+		}
+	}
+	repo, err := s.repository(ctx, opt)
+	if err != nil {
+		return fmt.Errorf("DeleteRepository: failed to get Gitea repository %+v: %w", opt, err)
+	}
+	if err := s.do(ctx, http.MethodDelete, giteaPath("repos", repo.Owner.Login, repo.Name), nil, nil, nil); err != nil {
+		return fmt.Errorf("DeleteRepository: failed to delete Gitea repository %s: %w", repo.Name, err)
+	}
+	return nil
+}
+
+// ArchiveRepository implements the SCM interface.
+func (s *GiteaSCM) ArchiveRepository(ctx context.Context, opt *RepositoryOptions) error {
+	if !opt.valid() {
+		return ErrMissingFields{
+			Method:  "ArchiveRepository",
+			Message: fmt.Sprintf("%+v", opt),
+		}
+	}
+	repo, err := s.repository(ctx, opt)
+	if err != nil {
+		return fmt.Errorf("ArchiveRepository: failed to get Gitea repository %+v: %w", opt, err)
+	}
+	if err := s.do(ctx, http.MethodPatch, giteaPath("repos", repo.Owner.Login, repo.Name), nil, map[string]bool{"archived": true}, nil); err != nil {
+		return fmt.Errorf("ArchiveRepository: failed to archive Gitea repository %s: %w", repo.Name, err)
+	}
+	return nil
+}
+
+// UpdateRepoAccess implements the SCM interface.
+func (s *GiteaSCM) UpdateRepoAccess(ctx context.Context, repo *Repository, user, permission string) error {
+	if repo == nil || !repo.valid() || user == "" {
+		return ErrMissingFields{
+			Method:  "UpdateRepoAccess",
+			Message: fmt.Sprintf("%+v, user %q", repo, user),
+		}
+	}
+	giteaPerm, err := giteaPermission(permission)
+	if err != nil {
+		return fmt.Errorf("UpdateRepoAccess: %w", err)
+	}
+	in := map[string]string{"permission": giteaPerm}
+	if err := s.do(ctx, http.MethodPut, giteaPath("repos", repo.Owner, repo.Path, "collaborators", user), nil, in, nil); err != nil {
+		return fmt.Errorf("UpdateRepoAccess: failed to grant %s %s access to Gitea repository %s: %w", user, permission, repo.Path, err)
+	}
+	return nil
+}
+
+// RemoveRepoCollaborator implements the SCM interface.
+func (s *GiteaSCM) RemoveRepoCollaborator(ctx context.Context, repo *Repository, user string) error {
+	if repo == nil || !repo.valid() || user == "" {
+		return ErrMissingFields{
+			Method:  "RemoveRepoCollaborator",
+			Message: fmt.Sprintf("%+v, user %q", repo, user),
+		}
+	}
+	if err := s.do(ctx, http.MethodDelete, giteaPath("repos", repo.Owner, repo.Path, "collaborators", user), nil, nil, nil); err != nil {
+		return fmt.Errorf("RemoveRepoCollaborator: failed to remove %s from Gitea repository %s: %w", user, repo.Path, err)
+	}
+	return nil
+}
+
+// GetRepositoryAccess implements the SCM interface.
+func (s *GiteaSCM) GetRepositoryAccess(ctx context.Context, opt *RepositoryOptions) (*RepositoryAccess, error) {
+	if !opt.valid() {
+		return nil, ErrMissingFields{
+			Method:  "GetRepositoryAccess",
+			Message: fmt.Sprintf("%+v", opt),
+		}
+	}
+	repo, err := s.repository(ctx, opt)
+	if err != nil {
+		return nil, fmt.Errorf("GetRepositoryAccess: failed to get Gitea repository %+v: %w", opt, err)
+	}
+	owner, name := repo.Owner.Login, repo.Name
+
+	var teams []*giteaTeam
+	if err := s.do(ctx, http.MethodGet, giteaPath("repos", owner, name, "teams"), nil, nil, &teams); err != nil {
+		return nil, fmt.Errorf("GetRepositoryAccess: failed to list teams with access to Gitea repository %s: %w", name, err)
+	}
+	access := &RepositoryAccess{}
+	for _, team := range teams {
+		access.Teams = append(access.Teams, &Permission{
+			ID:         team.ID,
+			Name:       team.Name,
+			Permission: giteaRepoPermission(team.Permission),
+		})
+	}
+	var collaborators []string
+	err = s.pages(ctx, giteaPath("repos", owner, name, "collaborators"), nil, func(items json.RawMessage) (bool, error) {
+		var users []giteaUser
+		if err := json.Unmarshal(items, &users); err != nil {
+			return true, err
+		}
+		for _, user := range users {
+			collaborators = append(collaborators, user.Login)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("GetRepositoryAccess: failed to list collaborators of Gitea repository %s: %w", name, err)
+	}
+	for _, login := range collaborators {
+		var permission struct {
+			Permission string `json:"permission"`
+		}
+		if err := s.do(ctx, http.MethodGet, giteaPath("repos", owner, name, "collaborators", login, "permission"), nil, nil, &permission); err != nil {
+			return nil, fmt.Errorf("GetRepositoryAccess: failed to get permission of %s on Gitea repository %s: %w", login, name, err)
+		}
+		access.Collaborators = append(access.Collaborators, &Permission{
+			Name:       login,
+			Permission: giteaRepoPermission(permission.Permission),
+		})
+	}
+	return access, nil
+}
+
+// RepositoryIsEmpty implements the SCM interface.
+func (s *GiteaSCM) RepositoryIsEmpty(ctx context.Context, opt *RepositoryOptions) bool {
+	repo, err := s.repository(ctx, opt)
+	if err != nil {
+		return false
+	}
+	return repo.Empty
+}
+
+// ListHooks implements the SCM interface.
+func (s *GiteaSCM) ListHooks(ctx context.Context, repo *Repository, org string) ([]*Hook, error) {
+	var path string
+	switch {
+	case org != "":
+		path = giteaPath("orgs", org, "hooks")
+	case repo != nil && repo.valid():
+		path = giteaPath("repos", repo.Owner, repo.Path, "hooks")
+	default:
+		return nil, ErrMissingFields{
+			Method:  "ListHooks",
+			Message: fmt.Sprintf("%+v, org %q", repo, org),
+		}
+	}
+	var hooks []*Hook
+	err := s.pages(ctx, path, nil, func(items json.RawMessage) (bool, error) {
+		var giteaHooks []*giteaHook
+		if err := json.Unmarshal(items, &giteaHooks); err != nil {
+			return true, err
+		}
+		for _, hook := range giteaHooks {
+			hooks = append(hooks, &Hook{
+				ID:     hook.ID,
+				Name:   hook.Type,
+				URL:    hook.Config["url"],
+				Events: hook.Events,
+			})
+		}
+		return false, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("ListHooks: failed to list Gitea webhooks: %w", err)
+	}
+	return hooks, nil
+}
+
+// CreateHook implements the SCM interface.
+// The webhook is triggered by pushes and pull requests to the repository, or any
+// repository of the organization if the organization is provided.
+func (s *GiteaSCM) CreateHook(ctx context.Context, opt *CreateHookOptions) error {
+	if !opt.valid() {
+		return ErrMissingFields{
+			Method:  "CreateHook",
+			Message: fmt.Sprintf("%+v", opt),
+		}
+	}
+	path := giteaPath("orgs", opt.Organization, "hooks")
+	if opt.Organization == "" {
+		path = giteaPath("repos", opt.Repository.Owner, opt.Repository.Path, "hooks")
+	}
+	hook := &giteaHook{
+		Type: "gitea",
+		Config: map[string]string{
+			"url":          opt.URL,
+			"secret":       opt.Secret,
+			"content_type": "json",
+		},
+		Events: []string{"push", "pull_request"},
+		Active: true,
+	}
+	if err := s.do(ctx, http.MethodPost, path, nil, hook, nil); err != nil {
+		return ErrFailedSCM{
+			GitError: err,
+			Method:   "CreateHook",
+			Message:  fmt.Sprintf("failed to create Gitea webhook with query: %+v", opt),
+		}
+	}
+	return nil
+}
+
+// CreateTeam implements the SCM interface.
+func (s *GiteaSCM) CreateTeam(ctx context.Context, opt *NewTeamOptions) (*Team, error) {
+	if !opt.valid() {
+		return nil, ErrMissingFields{
+			Method:  "CreateTeam",
+			Message: fmt.Sprintf("%+v", opt),
+		}
+	}
+	// check that the team name does not already exist for this organization
+	team, err := s.teamByName(ctx, opt.Organization, opt.TeamName)
+	if err != nil {
+		// error expected to be not found; logging here in case it's a different error
+		s.logger.Debugf("CreateTeam: check for team %s: %s", opt.TeamName, err)
+	}
+	if team == nil {
+		in := map[string]interface{}{
+			"name": opt.TeamName,
+			// the permission is replaced by the permission given when adding the team's repositories
+			"permission":                "read",
+			"units":                     []string{"repo.code", "repo.issues", "repo.pulls", "repo.releases", "repo.wiki"},
+			"includes_all_repositories": false,
+		}
+		team = &giteaTeam{}
+		if err := s.do(ctx, http.MethodPost, giteaPath("orgs", opt.Organization, "teams"), nil, in, team); err != nil {
+			return nil, ErrFailedSCM{
+				Method:   "CreateTeam",
+				Message:  fmt.Sprintf("failed to create Gitea team %s, make sure it does not already exist", opt.TeamName),
+				GitError: fmt.Errorf("failed to create Gitea team %s: %w", opt.TeamName, err),
+			}
+		}
+	}
+	for _, user := range opt.Users {
+		if err := s.do(ctx, http.MethodPut, giteaPath("teams", fmt.Sprint(team.ID), "members", user), nil, nil, nil); err != nil {
+			return nil, ErrFailedSCM{
+				Method:   "CreateTeam",
+				Message:  fmt.Sprintf("failed to add user '%s' to Gitea team '%s'", user, team.Name),
+				GitError: fmt.Errorf("failed to add '%s' to Gitea team '%s': %w", user, team.Name, err),
+			}
+		}
+	}
+	return &Team{ID: team.ID, Name: team.Name, Organization: opt.Organization}, nil
+}
+
+// DeleteTeam implements the SCM interface.
+func (s *GiteaSCM) DeleteTeam(ctx context.Context, opt *TeamOptions) error {
+	if !opt.valid() {
+		return ErrMissingFields{
+			Method:  "DeleteTeam",
+			Message: fmt.Sprintf("%+v", opt),
+		}
+	}
+	teamID, err := s.teamID(ctx, opt.TeamID, opt.Organization, opt.TeamName)
+	if err != nil {
+		return fmt.Errorf("DeleteTeam: %w", err)
+	}
+	if err := s.do(ctx, http.MethodDelete, giteaPath("teams", fmt.Sprint(teamID)), nil, nil, nil); err != nil {
+		return fmt.Errorf("DeleteTeam: failed to delete Gitea team %d: %w", teamID, err)
+	}
+	return nil
+}
+
+// GetTeam implements the SCM interface.
+func (s *GiteaSCM) GetTeam(ctx context.Context, opt *TeamOptions) (*Team, error) {
+	if !opt.valid() {
+		return nil, ErrMissingFields{
+			Method:  "GetTeam",
+			Message: fmt.Sprintf("%+v", opt),
+		}
+	}
+	if opt.TeamID < 1 {
+		team, err := s.teamByName(ctx, opt.Organization, opt.TeamName)
+		if err != nil {
+			return nil, fmt.Errorf("GetTeam: failed to get Gitea team '%s': %w", opt.TeamName, err)
+		}
+		return &Team{ID: team.ID, Name: team.Name, Organization: opt.Organization}, nil
+	}
+	var team giteaTeam
+	if err := s.do(ctx, http.MethodGet, giteaPath("teams", fmt.Sprint(opt.TeamID)), nil, nil, &team); err != nil {
+		return nil, fmt.Errorf("GetTeam: failed to get Gitea team by ID '%d': %w", opt.TeamID, err)
+	}
+	return team.toTeam(), nil
+}
+
+// GetTeams implements the SCM interface.
+func (s *GiteaSCM) GetTeams(ctx context.Context, org *pb.Organization) ([]*Team, error) {
+	if !org.IsValid() {
+		return nil, ErrMissingFields{
+			Method:  "GetTeams",
+			Message: fmt.Sprintf("%+v", org),
+		}
+	}
+	var teams []*Team
+	err := s.pages(ctx, giteaPath("orgs", org.GetPath(), "teams"), nil, func(items json.RawMessage) (bool, error) {
+		var giteaTeams []*giteaTeam
+		if err := json.Unmarshal(items, &giteaTeams); err != nil {
+			return true, err
+		}
+		for _, team := range giteaTeams {
+			teams = append(teams, &Team{ID: team.ID, Name: team.Name, Organization: org.GetPath()})
+		}
+		return false, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("GetTeams: failed to list Gitea teams: %w", err)
+	}
+	return teams, nil
+}
+
+// AddTeamRepo implements the SCM interface.
+// Gitea team permissions apply to all the team's repositories; the team's
+// permission is updated to the given permission.
+func (s *GiteaSCM) AddTeamRepo(ctx context.Context, opt *AddTeamRepoOptions) error {
+	if !opt.valid() {
+		return ErrMissingFields{
+			Method:  "AddTeamRepo",
+			Message: fmt.Sprintf("%+v", opt),
+		}
+	}
+	permission, err := giteaPermission(opt.Permission)
+	if err != nil {
+		return fmt.Errorf("AddTeamRepo: %w", err)
+	}
+	teamID := fmt.Sprint(opt.TeamID)
+	var team giteaTeam
+	if err := s.do(ctx, http.MethodGet, giteaPath("teams", teamID), nil, nil, &team); err != nil {
+		return fmt.Errorf("AddTeamRepo: failed to get Gitea team %d: %w", opt.TeamID, err)
+	}
+	if team.Permission != permission {
+		in := map[string]string{"name": team.Name, "permission": permission}
+		if err := s.do(ctx, http.MethodPatch, giteaPath("teams", teamID), nil, in, nil); err != nil {
+			return fmt.Errorf("AddTeamRepo: failed to update permission of Gitea team %s: %w", team.Name, err)
+		}
+	}
+	if err := s.do(ctx, http.MethodPut, giteaPath("teams", teamID, "repos", opt.Owner, opt.Repo), nil, nil, nil); err != nil {
+		return ErrFailedSCM{
+			GitError: fmt.Errorf("failed to make Gitea repository '%s' a team repository for team %d: %w", opt.Repo, opt.TeamID, err),
+			Method:   "AddTeamRepo",
+			Message:  fmt.Sprintf("failed to make Gitea repository '%s' a team repository", opt.Repo),
+		}
+	}
+	return nil
+}
+
+// RemoveTeamRepo implements the SCM interface.
+func (s *GiteaSCM) RemoveTeamRepo(ctx context.Context, opt *RemoveTeamRepoOptions) error {
+	if !opt.valid() {
+		return ErrMissingFields{
+			Method:  "RemoveTeamRepo",
+			Message: fmt.Sprintf("%+v", opt),
+		}
+	}
+	if err := s.do(ctx, http.MethodDelete, giteaPath("teams", fmt.Sprint(opt.TeamID), "repos", opt.Owner, opt.Repo), nil, nil, nil); err != nil {
+		return fmt.Errorf("RemoveTeamRepo: failed to remove Gitea repository %s from team %d: %w", opt.Repo, opt.TeamID, err)
+	}
+	return nil
+}
+
+// AddTeamMember implements the SCM interface.
+// Gitea teams have no maintainers; the role is ignored.
+func (s *GiteaSCM) AddTeamMember(ctx context.Context, opt *TeamMembershipOptions) error {
+	if !opt.valid() {
+		return ErrMissingFields{
+			Method:  "AddTeamMember",
+			Message: fmt.Sprintf("%+v", opt),
+		}
+	}
+	teamID, err := s.teamID(ctx, opt.TeamID, opt.Organization, opt.TeamName)
+	if err != nil {
+		return fmt.Errorf("AddTeamMember: %w", err)
+	}
+	if err := s.do(ctx, http.MethodPut, giteaPath("teams", fmt.Sprint(teamID), "members", opt.Username), nil, nil, nil); err != nil {
+		return ErrFailedSCM{
+			GitError: err,
+			Method:   "AddTeamMember",
+			Message:  fmt.Sprintf("failed to add user (%s) to team (ID %d, team name: %s)", opt.Username, teamID, opt.TeamName),
+		}
+	}
+	return nil
+}
+
+// RemoveTeamMember implements the SCM interface.
+func (s *GiteaSCM) RemoveTeamMember(ctx context.Context, opt *TeamMembershipOptions) error {
+	if !opt.valid() {
+		return ErrMissingFields{
+			Method:  "RemoveTeamMember",
+			Message: fmt.Sprintf("%+v", opt),
+		}
+	}
+	teamID, err := s.teamID(ctx, opt.TeamID, opt.Organization, opt.TeamName)
+	if err != nil {
+		return fmt.Errorf("RemoveTeamMember: %w", err)
+	}
+	if err := s.do(ctx, http.MethodDelete, giteaPath("teams", fmt.Sprint(teamID), "members", opt.Username), nil, nil, nil); err != nil {
+		return fmt.Errorf("RemoveTeamMember: failed to remove user %s from Gitea team %d: %w", opt.Username, teamID, err)
+	}
+	return nil
+}
+
+// UpdateTeamMembers implements the SCM interface.
+func (s *GiteaSCM) UpdateTeamMembers(ctx context.Context, opt *UpdateTeamOptions) error {
+	if !opt.valid() {
+		return ErrMissingFields{
+			Method:  "UpdateTeamMembers",
+			Message: fmt.Sprintf("%+v", opt),
+		}
+	}
+	teamID := fmt.Sprint(opt.TeamID)
+	var oldUsers []string
+	err := s.pages(ctx, giteaPath("teams", teamID, "members"), nil, func(items json.RawMessage) (bool, error) {
+		var users []giteaUser
+		if err := json.Unmarshal(items, &users); err != nil {
+			return true, err
+		}
+		for _, user := range users {
+			oldUsers = append(oldUsers, user.Login)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return fmt.Errorf("UpdateTeamMembers: failed to get members of Gitea team %d: %w", opt.TeamID, err)
+	}
+	keep := make(map[string]bool)
+	for _, user := range opt.Users {
+		keep[user] = true
+		if err := s.do(ctx, http.MethodPut, giteaPath("teams", teamID, "members", user), nil, nil, nil); err != nil {
+			return fmt.Errorf("UpdateTeamMembers: failed to add user %s to Gitea team %d: %w", user, opt.TeamID, err)
+		}
+	}
+	for _, user := range oldUsers {
+		if keep[user] {
+			continue
+		}
+		if err := s.do(ctx, http.MethodDelete, giteaPath("teams", teamID, "members", user), nil, nil, nil); err != nil {
+			return fmt.Errorf("UpdateTeamMembers: failed to remove user %s from Gitea team %d: %w", user, opt.TeamID, err)
+		}
+	}
+	return nil
+}
+
+// GetUserName implements the SCM interface.
+func (s *GiteaSCM) GetUserName(ctx context.Context) (string, error) {
+	var user giteaUser
+	if err := s.do(ctx, http.MethodGet, giteaPath("user"), nil, nil, &user); err != nil {
+		return "", fmt.Errorf("GetUserName: failed to get Gitea user: %w", err)
+	}
+	return user.Login, nil
+}
+
+// GetUserNameByID implements the SCM interface.
+func (s *GiteaSCM) GetUserNameByID(ctx context.Context, remoteID uint64) (string, error) {
+	var result struct {
+		Data []giteaUser `json:"data"`
+	}
+	if err := s.do(ctx, http.MethodGet, giteaPath("users", "search"), url.Values{"uid": {fmt.Sprint(remoteID)}}, nil, &result); err != nil {
+		return "", fmt.Errorf("GetUserNameByID: failed to get Gitea user '%d': %w", remoteID, err)
+	}
+	for _, user := range result.Data {
+		if user.ID == remoteID {
+			return user.Login, nil
+		}
+	}
+	return "", fmt.Errorf("GetUserNameByID: Gitea user '%d': %w", remoteID, errGiteaNotFound)
+}
+
+// CreateCloneURL implements the SCM interface.
+func (s *GiteaSCM) CreateCloneURL(opt *URLPathOptions) string {
+	token := s.token
+	if len(opt.UserToken) > 0 {
+		token = opt.UserToken
+	}
+	u, err := url.Parse(s.baseURL)
+	if err != nil {
+		return ""
+	}
+	u.User = url.User(token)
+	u.Path = strings.TrimSuffix(u.Path, "/") + "/" + opt.Organization + "/" + opt.Repository + ".git"
+	return u.String()
+}
+
+// UpdateOrgMembership implements the SCM interface.
+// Organization owners are the members of the organization's Owners team.
+func (s *GiteaSCM) UpdateOrgMembership(ctx context.Context, opt *OrgMembershipOptions) error {
+	if !opt.valid() {
+		return ErrMissingFields{
+			Method:  "UpdateOrgMembership",
+			Message: fmt.Sprintf("%+v", opt),
+		}
+	}
+	owners, err := s.teamByName(ctx, opt.Organization, giteaOwnersTeam)
+	if err != nil {
+		return fmt.Errorf("UpdateOrgMembership: failed to get owners of Gitea organization %s: %w", opt.Organization, err)
+	}
+	path := giteaPath("teams", fmt.Sprint(owners.ID), "members", opt.Username)
+	switch opt.Role {
+	case OrgOwner:
+		err = s.do(ctx, http.MethodPut, path, nil, nil, nil)
+	case OrgMember:
+		err = s.do(ctx, http.MethodDelete, path, nil, nil, nil)
+		if errors.Is(err, errGiteaNotFound) {
+			// the user is not an owner
+			err = nil
+		}
+	default:
+		return fmt.Errorf("UpdateOrgMembership: unknown role %q", opt.Role)
+	}
+	if err != nil {
+		return ErrFailedSCM{
+			GitError: fmt.Errorf("failed to update membership for user %s in organization %s: %w", opt.Username, opt.Organization, err),
+			Method:   "UpdateOrgMembership",
+			Message:  fmt.Sprintf("failed to update membership for user %s", opt.Username),
+		}
+	}
+	return nil
+}
+
+// RemoveMember implements the SCM interface.
+func (s *GiteaSCM) RemoveMember(ctx context.Context, opt *OrgMembershipOptions) error {
+	if !opt.valid() {
+		return ErrMissingFields{
+			Method:  "RemoveMember",
+			Message: fmt.Sprintf("%+v", opt),
+		}
+	}
+	// remove user from the organization and all teams
+	if err := s.do(ctx, http.MethodDelete, giteaPath("orgs", opt.Organization, "members", opt.Username), nil, nil, nil); err != nil {
+		return ErrFailedSCM{
+			Method:   "RemoveMember",
+			GitError: fmt.Errorf("failed to remove user %s from organization %s: %w", opt.Username, opt.Organization, err),
+			Message:  fmt.Sprintf("failed to remove user %s from the organization", opt.Username),
+		}
+	}
+	return nil
+}
+
+// GetOrgMembers implements the SCM interface.
+func (s *GiteaSCM) GetOrgMembers(ctx context.Context, org *pb.Organization) ([]string, error) {
+	if !org.IsValid() {
+		return nil, ErrMissingFields{
+			Method:  "GetOrgMembers",
+			Message: fmt.Sprintf("%+v", org),
+		}
+	}
+	var members []string
+	err := s.pages(ctx, giteaPath("orgs", org.GetPath(), "members"), nil, func(items json.RawMessage) (bool, error) {
+		var users []giteaUser
+		if err := json.Unmarshal(items, &users); err != nil {
+			return true, err
+		}
+		for _, user := range users {
+			members = append(members, user.Login)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return nil, ErrFailedSCM{
+			Method:   "GetOrgMembers",
+			GitError: fmt.Errorf("failed to list members of organization %s: %w", org.GetPath(), err),
+			Message:  fmt.Sprintf("failed to fetch members of organization %s", org.GetPath()),
+		}
+	}
+	return members, nil
+}
+
+// GetDiscussions implements the SCM interface.
+func (s *GiteaSCM) GetDiscussions(ctx context.Context, opt *DiscussionOptions) ([]*Discussion, error) {
+	// Gitea has no repository discussions
+	return nil, ErrNotSupported{
+		SCM:    "gitea",
+		Method: "GetDiscussions",
+	}
+}
+
+// CreateCommitComment implements the SCM interface.
+func (s *GiteaSCM) CreateCommitComment(ctx context.Context, opt *CommitCommentOptions) (string, error) {
+	// the Gitea API has no commit comments; comments are only shown in QuickFeed
+	return "", ErrNotSupported{
+		SCM:    "gitea",
+		Method: "CreateCommitComment",
+	}
+}
+
+// CreateIssue implements the SCM interface.
+func (s *GiteaSCM) CreateIssue(ctx context.Context, opt *IssueOptions) (string, error) {
+	if !opt.valid() {
+		return "", ErrMissingFields{
+			Method:  "CreateIssue",
+			Message: fmt.Sprintf("%+v", opt),
+		}
+	}
+	in := map[string]interface{}{
+		"title": opt.Title,
+		"body":  opt.Body,
+	}
+	var issue struct {
+		HTMLURL string `json:"html_url"`
+	}
+	if err := s.do(ctx, http.MethodPost, giteaPath("repos", opt.Owner, opt.Repository, "issues"), nil, in, &issue); err != nil {
+		return "", ErrFailedSCM{
+			Method:   "CreateIssue",
+			Message:  fmt.Sprintf("failed to create issue in repository %s", opt.Repository),
+			GitError: err,
+		}
+	}
+	return issue.HTMLURL, nil
+}
+
+// GetCommits implements the SCM interface.
+func (s *GiteaSCM) GetCommits(ctx context.Context, opt *CommitOptions) ([]*Commit, error) {
+	if !opt.valid() {
+		return nil, ErrMissingFields{
+			Method:  "GetCommits",
+			Message: fmt.Sprintf("%+v", opt),
+		}
+	}
+	query := url.Values{}
+	if opt.Branch != "" {
+		query.Set("sha", opt.Branch)
+	}
+	var commits []*Commit
+	err := s.pages(ctx, giteaPath("repos", opt.Owner, opt.Repository, "commits"), query, func(items json.RawMessage) (bool, error) {
+		var repoCommits []*giteaCommit
+		if err := json.Unmarshal(items, &repoCommits); err != nil {
+			return true, err
+		}
+		for _, repoCommit := range repoCommits {
+			// the commits are listed newest first, so the remaining commits are older
+			if !repoCommit.Created.After(opt.Since) {
+				return true, nil
+			}
+			commits = append(commits, repoCommit.toCommit())
+		}
+		return false, nil
+	})
+	if err != nil {
+		return nil, ErrFailedSCM{
+			Method:   "GetCommits",
+			Message:  fmt.Sprintf("failed to list commits in repository %s", opt.Repository),
+			GitError: err,
+		}
+	}
+	return commits, nil
+}
+
+// CreateCommitStatus implements the SCM interface.
+func (s *GiteaSCM) CreateCommitStatus(ctx context.Context, opt *CommitStatusOptions) error {
+	if !opt.valid() {
+		return ErrMissingFields{
+			Method:  "CreateCommitStatus",
+			Message: fmt.Sprintf("%+v", opt),
+		}
+	}
+	in := map[string]interface{}{
+		"state":       opt.State,
+		"context":     opt.Context,
+		"description": opt.Description,
+		"target_url":  opt.TargetURL,
+	}
+	if err := s.do(ctx, http.MethodPost, giteaPath("repos", opt.Owner, opt.Repository, "statuses", opt.CommitSHA), nil, in, nil); err != nil {
+		return ErrFailedSCM{
+			Method:   "CreateCommitStatus",
+			Message:  fmt.Sprintf("failed to set status of commit %s in repository %s", opt.CommitSHA, opt.Repository),
+			GitError: err,
+		}
+	}
+	return nil
+}
+
+// GetUserScopes implements the SCM interface.
+// Gitea does not report the scopes of access tokens; no scopes are returned.
+func (s *GiteaSCM) GetUserScopes(ctx context.Context) *Authorization {
+	return &Authorization{Scopes: []string{}}
+}
+
+// organization returns the Gitea organization with the given ID, or the given name if the ID is zero.
+func (s *GiteaSCM) organization(ctx context.Context, id uint64, name string) (*giteaOrganization, error) {
+	if id == 0 {
+		var org giteaOrganization
+		if err := s.do(ctx, http.MethodGet, giteaPath("orgs", name), nil, nil, &org); err != nil {
+			return nil, err
+		}
+		return &org, nil
+	}
+	// organizations cannot be fetched by ID; search the current user's organizations
+	var found *giteaOrganization
+	err := s.pages(ctx, giteaPath("user", "orgs"), nil, func(items json.RawMessage) (bool, error) {
+		var orgs []*giteaOrganization
+		if err := json.Unmarshal(items, &orgs); err != nil {
+			return true, err
+		}
+		for _, org := range orgs {
+			if org.ID == id {
+				found = org
+				return true, nil
+			}
+		}
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if found == nil {
+		return nil, fmt.Errorf("organization %d: %w", id, errGiteaNotFound)
+	}
+	return found, nil
+}
+
+// repository returns the Gitea repository with the given ID, or the given path and owner if the ID is zero.
+func (s *GiteaSCM) repository(ctx context.Context, opt *RepositoryOptions) (*giteaRepository, error) {
+	path := giteaPath("repositories", fmt.Sprint(opt.ID))
+	if opt.ID == 0 {
+		path = giteaPath("repos", opt.Owner, opt.Path)
+	}
+	var repo giteaRepository
+	if err := s.do(ctx, http.MethodGet, path, nil, nil, &repo); err != nil {
+		return nil, err
+	}
+	return &repo, nil
+}
+
+// teamByName returns the organization's team with the given name.
+func (s *GiteaSCM) teamByName(ctx context.Context, org, name string) (*giteaTeam, error) {
+	var result struct {
+		Data []*giteaTeam `json:"data"`
+	}
+	if err := s.do(ctx, http.MethodGet, giteaPath("orgs", org, "teams", "search"), url.Values{"q": {name}}, nil, &result); err != nil {
+		return nil, err
+	}
+	// the search matches team names containing the query
+	for _, team := range result.Data {
+		if strings.EqualFold(team.Name, name) {
+			return team, nil
+		}
+	}
+	return nil, fmt.Errorf("team %s in organization %s: %w", name, org, errGiteaNotFound)
+}
+
+// teamID returns the given team ID, or the ID of the organization's team with the given name if the ID is zero.
+func (s *GiteaSCM) teamID(ctx context.Context, teamID uint64, org, name string) (uint64, error) {
+	if teamID > 0 {
+		return teamID, nil
+	}
+	team, err := s.teamByName(ctx, org, name)
+	if err != nil {
+		return 0, err
+	}
+	return team.ID, nil
+}
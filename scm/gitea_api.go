@@ -0,0 +1,231 @@
+package scm
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// giteaPageSize is the number of items fetched per page from paged Gitea resources.
+const giteaPageSize = 50
+
+// errGiteaNotFound is returned when the requested Gitea resource does not exist.
+var errGiteaNotFound = errors.New("gitea resource not found")
+
+// giteaError is the error returned by the Gitea API.
+type giteaError struct {
+	StatusCode int
+	Message    string `json:"message"`
+}
+
+func (e *giteaError) Error() string {
+	return fmt.Sprintf("gitea: %d %s: %s", e.StatusCode, http.StatusText(e.StatusCode), e.Message)
+}
+
+func (e *giteaError) Unwrap() error {
+	if e.StatusCode == http.StatusNotFound {
+		return errGiteaNotFound
+	}
+	return nil
+}
+
+type giteaUser struct {
+	ID    uint64 `json:"id"`
+	Login string `json:"login"`
+}
+
+type giteaOrganization struct {
+	ID        uint64 `json:"id"`
+	UserName  string `json:"username"`
+	AvatarURL string `json:"avatar_url"`
+}
+
+type giteaRepository struct {
+	ID       uint64    `json:"id"`
+	Name     string    `json:"name"`
+	Owner    giteaUser `json:"owner"`
+	HTMLURL  string    `json:"html_url"`
+	SSHURL   string    `json:"ssh_url"`
+	CloneURL string    `json:"clone_url"`
+	Size     uint64    `json:"size"`
+	Empty    bool      `json:"empty"`
+	Archived bool      `json:"archived"`
+	Template bool      `json:"template"`
+}
+
+type giteaTeam struct {
+	ID           uint64            `json:"id"`
+	Name         string            `json:"name"`
+	Permission   string            `json:"permission"`
+	Organization giteaOrganization `json:"organization"`
+}
+
+type giteaHook struct {
+	ID     uint64            `json:"id"`
+	Type   string            `json:"type"`
+	Config map[string]string `json:"config"`
+	Events []string          `json:"events"`
+	Active bool              `json:"active"`
+}
+
+// giteaCommit is a commit listed by the Gitea API, along with the files it changed.
+type giteaCommit struct {
+	SHA     string     `json:"sha"`
+	Created time.Time  `json:"created"`
+	Author  *giteaUser `json:"author"`
+	Commit  struct {
+		Message string `json:"message"`
+	} `json:"commit"`
+	Files []struct {
+		Filename string `json:"filename"`
+	} `json:"files"`
+}
+
+// do sends a request to the Gitea API at the given path, relative to the API base URL,
+// and decodes the JSON response into out, unless out is nil.
+func (s *GiteaSCM) do(ctx context.Context, method, path string, query url.Values, in, out interface{}) error {
+	var body io.Reader
+	if in != nil {
+		b, err := json.Marshal(in)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(b)
+	}
+	u := s.baseURL + "/api/v1" + path
+	if len(query) > 0 {
+		u += "?" + query.Encode()
+	}
+	req, err := http.NewRequestWithContext(ctx, method, u, body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "token "+s.token)
+	req.Header.Set("Accept", "application/json")
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= http.StatusBadRequest {
+		giteaErr := &giteaError{StatusCode: resp.StatusCode}
+		// the error body is optional; the status code is sufficient
+		_ = json.NewDecoder(resp.Body).Decode(giteaErr)
+		return giteaErr
+	}
+	if out == nil {
+		_, err = io.Copy(ioutil.Discard, resp.Body)
+		return err
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
+
+// pages calls fn with the items of each page of the paged resource at the given path,
+// until fn returns done or there are no more pages.
+func (s *GiteaSCM) pages(ctx context.Context, path string, query url.Values, fn func(items json.RawMessage) (done bool, err error)) error {
+	q := url.Values{}
+	for k, v := range query {
+		q[k] = v
+	}
+	q.Set("limit", strconv.Itoa(giteaPageSize))
+	for page := 1; ; page++ {
+		q.Set("page", strconv.Itoa(page))
+		var items []json.RawMessage
+		if err := s.do(ctx, http.MethodGet, path, q, nil, &items); err != nil {
+			return err
+		}
+		b, err := json.Marshal(items)
+		if err != nil {
+			return err
+		}
+		done, err := fn(b)
+		if err != nil || done || len(items) < giteaPageSize {
+			return err
+		}
+	}
+}
+
+// giteaPath returns the API path of the given resource.
+func giteaPath(elem ...string) string {
+	for i := range elem {
+		elem[i] = url.PathEscape(elem[i])
+	}
+	return "/" + strings.Join(elem, "/")
+}
+
+// giteaPermission returns the Gitea permission for the given repository permission.
+func giteaPermission(permission string) (string, error) {
+	switch permission {
+	case RepoPull, OrgPull:
+		return "read", nil
+	case RepoPush, OrgPush:
+		return "write", nil
+	case RepoFull:
+		return "admin", nil
+	}
+	return "", fmt.Errorf("unknown repository permission %q", permission)
+}
+
+// giteaRepoPermission returns the repository permission for the given Gitea permission.
+func giteaRepoPermission(permission string) string {
+	switch permission {
+	case "read":
+		return RepoPull
+	case "write":
+		return RepoPush
+	}
+	return RepoFull
+}
+
+// toRepository converts a Gitea repository to a Repository.
+func (r *giteaRepository) toRepository() *Repository {
+	return &Repository{
+		ID:       r.ID,
+		Path:     r.Name,
+		Owner:    r.Owner.Login,
+		WebURL:   r.HTMLURL,
+		SSHURL:   r.SSHURL,
+		HTTPURL:  r.CloneURL,
+		OrgID:    r.Owner.ID,
+		Size:     r.Size,
+		Archived: r.Archived,
+	}
+}
+
+// toTeam converts a Gitea team to a Team.
+// toCommit converts a Gitea commit to a Commit.
+func (c *giteaCommit) toCommit() *Commit {
+	commit := &Commit{
+		SHA:     c.SHA,
+		Message: c.Commit.Message,
+		Date:    c.Created,
+	}
+	if c.Author != nil {
+		commit.Author = c.Author.Login
+	}
+	for _, file := range c.Files {
+		commit.Files = append(commit.Files, file.Filename)
+	}
+	return commit
+}
+
+func (t *giteaTeam) toTeam() *Team {
+	return &Team{
+		ID:           t.ID,
+		Name:         t.Name,
+		Organization: t.Organization.UserName,
+	}
+}
@@ -0,0 +1,392 @@
+package scm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+
+	pb "github.com/autograde/quickfeed/ag"
+)
+
+// fakeGitea is a fake Gitea server with a single organization, serving
+// its repositories by page, and recording changes to team members.
+type fakeGitea struct {
+	mu      sync.Mutex
+	repos   []giteaRepository
+	teams   []giteaTeam
+	members map[uint64][]string
+	commits []giteaCommit // commits of the qf101/student-labs repository, newest first
+	// statuses maps commit SHAs of the qf101/student-labs repository to the statuses set for them
+	statuses map[string][]map[string]string
+}
+
+func (f *fakeGitea) writePage(w http.ResponseWriter, r *http.Request, values interface{}) {
+	page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+	limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+	v := reflect.ValueOf(values)
+	start, end := (page-1)*limit, page*limit
+	if start > v.Len() {
+		start = v.Len()
+	}
+	if end > v.Len() {
+		end = v.Len()
+	}
+	_ = json.NewEncoder(w).Encode(v.Slice(start, end).Interface())
+}
+
+func (f *fakeGitea) team(id string) *giteaTeam {
+	for i := range f.teams {
+		if fmt.Sprint(f.teams[i].ID) == id {
+			return &f.teams[i]
+		}
+	}
+	return nil
+}
+
+func (f *fakeGitea) repo(name string) *giteaRepository {
+	for i := range f.repos {
+		if f.repos[i].Name == name {
+			return &f.repos[i]
+		}
+	}
+	return nil
+}
+
+func (f *fakeGitea) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if r.Header.Get("Authorization") != "token token" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	org := giteaOrganization{ID: 7, UserName: "qf101"}
+	elem := strings.Split(strings.TrimPrefix(r.URL.Path, "/api/v1/"), "/")
+	switch path := strings.Join(elem, "/"); {
+	case path == "orgs/qf101" && r.Method == http.MethodGet:
+		_ = json.NewEncoder(w).Encode(org)
+	case path == "users/teacher/orgs/qf101/permissions":
+		fmt.Fprint(w, `{"is_owner":true}`)
+	case path == "users/student/orgs/qf101/permissions":
+		fmt.Fprint(w, `{"is_owner":false}`)
+	case path == "orgs/qf101/repos" && r.Method == http.MethodGet:
+		f.writePage(w, r, f.repos)
+	case path == "orgs/qf101/repos" && r.Method == http.MethodPost:
+		var in map[string]interface{}
+		_ = json.NewDecoder(r.Body).Decode(&in)
+		name := in["name"].(string)
+		repo := giteaRepository{ID: uint64(100 + len(f.repos)), Name: name, Owner: giteaUser{ID: 7, Login: "qf101"}, CloneURL: "https://gitea.example.com/qf101/" + name + ".git"}
+		f.repos = append(f.repos, repo)
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(repo)
+	case len(elem) == 3 && elem[0] == "repos" && elem[1] == "qf101":
+		repo := f.repo(elem[2])
+		if repo == nil {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if r.Method == http.MethodPatch {
+			var in map[string]interface{}
+			_ = json.NewDecoder(r.Body).Decode(&in)
+			repo.Template, _ = in["template"].(bool)
+		}
+		_ = json.NewEncoder(w).Encode(repo)
+	case len(elem) == 4 && elem[0] == "repos" && elem[1] == "qf101" && elem[3] == "generate":
+		template := f.repo(elem[2])
+		if template == nil || !template.Template {
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			return
+		}
+		var in map[string]interface{}
+		_ = json.NewDecoder(r.Body).Decode(&in)
+		if in["owner"] != "qf101" || in["git_content"] != true {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		name := in["name"].(string)
+		repo := giteaRepository{ID: uint64(100 + len(f.repos)), Name: name, Owner: giteaUser{ID: 7, Login: "qf101"}, Size: template.Size}
+		f.repos = append(f.repos, repo)
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(repo)
+	case path == "orgs/qf101/teams/search":
+		var teams []giteaTeam
+		for _, team := range f.teams {
+			if strings.Contains(team.Name, r.URL.Query().Get("q")) {
+				teams = append(teams, team)
+			}
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"ok": true, "data": teams})
+	case path == "orgs/qf101/teams" && r.Method == http.MethodPost:
+		var in map[string]interface{}
+		_ = json.NewDecoder(r.Body).Decode(&in)
+		team := giteaTeam{ID: uint64(10 + len(f.teams)), Name: in["name"].(string), Permission: in["permission"].(string), Organization: org}
+		f.teams = append(f.teams, team)
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(team)
+	case path == "repos/qf101/student-labs/commits":
+		f.writePage(w, r, f.commits)
+	case len(elem) == 5 && path == "repos/qf101/student-labs/statuses/"+elem[4] && r.Method == http.MethodPost:
+		var in map[string]string
+		_ = json.NewDecoder(r.Body).Decode(&in)
+		f.statuses[elem[4]] = append(f.statuses[elem[4]], in)
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(in)
+	case len(elem) == 3 && elem[0] == "teams" && elem[2] == "members":
+		f.writePage(w, r, f.users(f.members[f.team(elem[1]).ID]))
+	case len(elem) == 4 && elem[0] == "teams" && elem[2] == "members":
+		team := f.team(elem[1])
+		if team == nil {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		var members []string
+		for _, member := range f.members[team.ID] {
+			if member != elem[3] {
+				members = append(members, member)
+			}
+		}
+		if r.Method == http.MethodPut {
+			members = append(members, elem[3])
+		}
+		f.members[team.ID] = members
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"message":"The target couldn't be found."}`)
+	}
+}
+
+func (f *fakeGitea) users(names []string) []giteaUser {
+	users := []giteaUser{}
+	for _, name := range names {
+		users = append(users, giteaUser{Login: name})
+	}
+	return users
+}
+
+func TestGiteaRepositories(t *testing.T) {
+	fake := &fakeGitea{members: make(map[uint64][]string)}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+	s := NewGiteaSCMClient(zap.NewNop().Sugar(), server.URL+"/", "token")
+	ctx := context.Background()
+
+	// more repositories than fit on a single page
+	var wantPaths []string
+	for i := 0; i < giteaPageSize; i++ {
+		path := fmt.Sprintf("student%d-labs", i)
+		fake.repos = append(fake.repos, giteaRepository{ID: uint64(i + 1), Name: path, Owner: giteaUser{ID: 7, Login: "qf101"}})
+		wantPaths = append(wantPaths, path)
+	}
+	org := &pb.Organization{Path: "qf101"}
+	repo, err := s.CreateRepository(ctx, &CreateRepositoryOptions{Organization: org, Path: "new-labs", Private: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &Repository{ID: 150, Path: "new-labs", Owner: "qf101", OrgID: 7, HTTPURL: "https://gitea.example.com/qf101/new-labs.git"}
+	if !reflect.DeepEqual(repo, want) {
+		t.Errorf("CreateRepository() = %+v, want %+v", repo, want)
+	}
+
+	repos, err := s.GetRepositories(ctx, org)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var paths []string
+	for _, repo := range repos {
+		paths = append(paths, repo.Path)
+	}
+	if wantPaths = append(wantPaths, "new-labs"); !reflect.DeepEqual(paths, wantPaths) {
+		t.Errorf("GetRepositories() = %v, want %v", paths, wantPaths)
+	}
+
+	cloneURL := s.CreateCloneURL(&URLPathOptions{Organization: "qf101", Repository: "new-labs", UserToken: "secret"})
+	wantURL := strings.Replace(server.URL, "http://", "http://secret@", 1) + "/qf101/new-labs.git"
+	if cloneURL != wantURL {
+		t.Errorf("CreateCloneURL() = %q, want %q", cloneURL, wantURL)
+	}
+}
+
+func TestGiteaCreateRepositoryFromTemplate(t *testing.T) {
+	fake := &fakeGitea{members: make(map[uint64][]string)}
+	fake.repos = append(fake.repos, giteaRepository{ID: 1, Name: pb.AssignmentRepo, Owner: giteaUser{ID: 7, Login: "qf101"}, Size: 42})
+	server := httptest.NewServer(fake)
+	defer server.Close()
+	s := NewGiteaSCMClient(zap.NewNop().Sugar(), server.URL+"/", "token")
+
+	opt := &CreateRepositoryOptions{
+		Organization: &pb.Organization{Path: "qf101"},
+		Path:         "student-labs",
+		Private:      true,
+		Template:     &Repository{Owner: "qf101", Path: pb.AssignmentRepo},
+	}
+	repo, err := s.CreateRepository(context.Background(), opt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &Repository{ID: 101, Path: "student-labs", Owner: "qf101", OrgID: 7, Size: 42}
+	if !reflect.DeepEqual(repo, want) {
+		t.Errorf("CreateRepository() = %+v, want %+v", repo, want)
+	}
+	if !fake.repo(pb.AssignmentRepo).Template {
+		t.Errorf("CreateRepository() did not mark %s as template", pb.AssignmentRepo)
+	}
+
+	opt.Path = "other-labs"
+	opt.Template = &Repository{Owner: "qf101", Path: "missing"}
+	if _, err := s.CreateRepository(context.Background(), opt); err == nil {
+		t.Error("CreateRepository() with missing template succeeded, want error")
+	}
+}
+
+func TestGiteaGetOrganization(t *testing.T) {
+	server := httptest.NewServer(&fakeGitea{})
+	defer server.Close()
+	s := NewGiteaSCMClient(zap.NewNop().Sugar(), server.URL, "token")
+	ctx := context.Background()
+
+	org, err := s.GetOrganization(ctx, &GetOrgOptions{Name: "qf101", Username: "teacher"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if org.GetID() != 7 || org.GetPath() != "qf101" {
+		t.Errorf("GetOrganization() = %+v, want organization qf101", org)
+	}
+	if _, err := s.GetOrganization(ctx, &GetOrgOptions{Name: "qf101", Username: "student"}); err != ErrNotOwner {
+		t.Errorf("GetOrganization() for student: got error %v, want %v", err, ErrNotOwner)
+	}
+	if _, err := s.GetOrganization(ctx, &GetOrgOptions{Name: "qf101", Username: "stranger"}); err != ErrNotMember {
+		t.Errorf("GetOrganization() for stranger: got error %v, want %v", err, ErrNotMember)
+	}
+}
+
+func TestGiteaTeams(t *testing.T) {
+	fake := &fakeGitea{
+		teams:   []giteaTeam{{ID: 1, Name: giteaOwnersTeam, Permission: "owner"}},
+		members: map[uint64][]string{1: {"admin"}},
+	}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+	s := NewGiteaSCMClient(zap.NewNop().Sugar(), server.URL, "token")
+	ctx := context.Background()
+
+	team, err := s.CreateTeam(ctx, &NewTeamOptions{Organization: "qf101", TeamName: "group1", Users: []string{"alice", "bob"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (&Team{ID: 11, Name: "group1", Organization: "qf101"}); !reflect.DeepEqual(team, want) {
+		t.Errorf("CreateTeam() = %+v, want %+v", team, want)
+	}
+	got, err := s.GetTeam(ctx, &TeamOptions{Organization: "qf101", TeamName: "group1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, team) {
+		t.Errorf("GetTeam() = %+v, want %+v", got, team)
+	}
+
+	if err := s.UpdateTeamMembers(ctx, &UpdateTeamOptions{OrganizationID: 7, TeamID: team.ID, Users: []string{"bob", "carol"}}); err != nil {
+		t.Fatal(err)
+	}
+	members := fake.members[team.ID]
+	sort.Strings(members)
+	if want := []string{"bob", "carol"}; !reflect.DeepEqual(members, want) {
+		t.Errorf("UpdateTeamMembers(): team members = %v, want %v", members, want)
+	}
+
+	// organization owners are members of the Owners team
+	if err := s.UpdateOrgMembership(ctx, &OrgMembershipOptions{Organization: "qf101", Username: "carol", Role: OrgOwner}); err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"admin", "carol"}; !reflect.DeepEqual(fake.members[1], want) {
+		t.Errorf("UpdateOrgMembership(): owners = %v, want %v", fake.members[1], want)
+	}
+	if err := s.UpdateOrgMembership(ctx, &OrgMembershipOptions{Organization: "qf101", Username: "carol", Role: OrgMember}); err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"admin"}; !reflect.DeepEqual(fake.members[1], want) {
+		t.Errorf("UpdateOrgMembership(): owners = %v, want %v", fake.members[1], want)
+	}
+}
+
+func TestGiteaGetCommits(t *testing.T) {
+	// more commits than fit on a single page, one per hour, newest first
+	fake := &fakeGitea{}
+	start := time.Date(2021, 9, 1, 0, 0, 0, 0, time.UTC)
+	for i := giteaPageSize + 10; i > 0; i-- {
+		commit := giteaCommit{SHA: fmt.Sprintf("sha%d", i), Created: start.Add(time.Duration(i) * time.Hour), Author: &giteaUser{Login: "student"}}
+		commit.Commit.Message = fmt.Sprintf("commit %d", i)
+		commit.Files = append(commit.Files, struct {
+			Filename string `json:"filename"`
+		}{Filename: fmt.Sprintf("lab%d/main.go", i)})
+		fake.commits = append(fake.commits, commit)
+	}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+	s := NewGiteaSCMClient(zap.NewNop().Sugar(), server.URL, "token")
+
+	commits, err := s.GetCommits(context.Background(), &CommitOptions{Owner: "qf101", Repository: "student-labs", Since: start.Add(5 * time.Hour)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(commits) != giteaPageSize+5 {
+		t.Fatalf("GetCommits() returned %d commits, want %d", len(commits), giteaPageSize+5)
+	}
+	newest := giteaPageSize + 10
+	want := &Commit{
+		SHA:     fmt.Sprintf("sha%d", newest),
+		Message: fmt.Sprintf("commit %d", newest),
+		Author:  "student",
+		Date:    start.Add(time.Duration(newest) * time.Hour),
+		Files:   []string{fmt.Sprintf("lab%d/main.go", newest)},
+	}
+	if !reflect.DeepEqual(commits[0], want) {
+		t.Errorf("GetCommits()[0] = %+v, want %+v", commits[0], want)
+	}
+	if last := commits[len(commits)-1]; last.SHA != "sha6" {
+		t.Errorf("GetCommits() oldest commit = %s, want sha6", last.SHA)
+	}
+}
+
+func TestGiteaCreateCommitStatus(t *testing.T) {
+	fake := &fakeGitea{statuses: make(map[string][]map[string]string)}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+	s := NewGiteaSCMClient(zap.NewNop().Sugar(), server.URL, "token")
+
+	opt := &CommitStatusOptions{
+		Owner:       "qf101",
+		Repository:  "student-labs",
+		CommitSHA:   "sha1",
+		State:       CommitSuccess,
+		Context:     "quickfeed/lab1",
+		Description: "Score: 80%",
+		TargetURL:   "https://example.com/course/1",
+	}
+	if err := s.CreateCommitStatus(context.Background(), opt); err != nil {
+		t.Fatal(err)
+	}
+	want := []map[string]string{{
+		"state":       "success",
+		"context":     "quickfeed/lab1",
+		"description": "Score: 80%",
+		"target_url":  "https://example.com/course/1",
+	}}
+	if !reflect.DeepEqual(fake.statuses["sha1"], want) {
+		t.Errorf("CreateCommitStatus() set statuses %v, want %v", fake.statuses["sha1"], want)
+	}
+
+	if err := s.CreateCommitStatus(context.Background(), &CommitStatusOptions{Owner: "qf101", Repository: "student-labs", CommitSHA: "sha1"}); err == nil {
+		t.Error("CreateCommitStatus() without state succeeded, want error")
+	}
+}
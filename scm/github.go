@@ -2,9 +2,11 @@ package scm
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"go.uber.org/zap"
 
@@ -24,7 +26,9 @@ type GithubSCM struct {
 // NewGithubSCMClient returns a new Github client implementing the SCM interface.
 func NewGithubSCMClient(logger *zap.SugaredLogger, token string) *GithubSCM {
 	ts := oauth2.StaticTokenSource(&oauth2.Token{AccessToken: token})
-	client := github.NewClient(oauth2.NewClient(context.Background(), ts))
+	httpClient := oauth2.NewClient(context.Background(), ts)
+	httpClient.Transport = newRateLimitTransport(logger, "github", httpClient.Transport)
+	client := github.NewClient(httpClient)
 	return &GithubSCM{
 		logger: logger,
 		client: client,
@@ -126,10 +130,14 @@ func (s *GithubSCM) CreateRepository(ctx context.Context, opt *CreateRepositoryO
 
 	// repo does not exist, create it
 	s.logger.Debugf("CreateRepository: creating %s", opt.Path)
-	repo, _, err = s.client.Repositories.Create(ctx, opt.Organization.Path, &github.Repository{
-		Name:    &opt.Path,
-		Private: &opt.Private,
-	})
+	if opt.Template != nil {
+		repo, err = s.createFromTemplate(ctx, opt)
+	} else {
+		repo, _, err = s.client.Repositories.Create(ctx, opt.Organization.Path, &github.Repository{
+			Name:    &opt.Path,
+			Private: &opt.Private,
+		})
+	}
 	if err != nil {
 		return nil, ErrFailedSCM{
 			Method:   "CreateRepository",
@@ -142,6 +150,30 @@ func (s *GithubSCM) CreateRepository(ctx context.Context, opt *CreateRepositoryO
 	return toRepository(repo), nil
 }
 
+// createFromTemplate creates the repository with the contents of the template repository.
+// The template repository is marked as a template first, if it is not already.
+func (s *GithubSCM) createFromTemplate(ctx context.Context, opt *CreateRepositoryOptions) (*github.Repository, error) {
+	owner := opt.Template.Owner
+	if owner == "" {
+		owner = opt.Organization.Path
+	}
+	template, _, err := s.client.Repositories.Get(ctx, owner, opt.Template.Path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get template repository %s: %w", opt.Template.Path, err)
+	}
+	if !template.GetIsTemplate() {
+		if _, _, err = s.client.Repositories.Edit(ctx, owner, opt.Template.Path, &github.Repository{IsTemplate: github.Bool(true)}); err != nil {
+			return nil, fmt.Errorf("failed to mark repository %s as template: %w", opt.Template.Path, err)
+		}
+	}
+	repo, _, err := s.client.Repositories.CreateFromTemplate(ctx, owner, opt.Template.Path, &github.TemplateRepoRequest{
+		Name:    &opt.Path,
+		Owner:   &opt.Organization.Path,
+		Private: &opt.Private,
+	})
+	return repo, err
+}
+
 // GetRepository implements the SCM interface.
 func (s *GithubSCM) GetRepository(ctx context.Context, opt *RepositoryOptions) (*Repository, error) {
 	if !opt.valid() {
@@ -234,6 +266,41 @@ func (s *GithubSCM) DeleteRepository(ctx context.Context, opt *RepositoryOptions
 	return nil
 }
 
+// ArchiveRepository implements the SCM interface.
+func (s *GithubSCM) ArchiveRepository(ctx context.Context, opt *RepositoryOptions) error {
+	if !opt.valid() {
+		return ErrMissingFields{
+			Method:  "ArchiveRepository",
+			Message: fmt.Sprintf("%+v", opt),
+		}
+	}
+
+	// if ID provided, get path and owner from github
+	if opt.ID > 0 {
+		repo, _, err := s.client.Repositories.GetByID(ctx, int64(opt.ID))
+		if err != nil {
+			return ErrFailedSCM{
+				GitError: err,
+				Method:   "ArchiveRepository",
+				Message:  fmt.Sprintf("failed to fetch repository %d: may not exists in the course organization", opt.ID),
+			}
+		}
+		opt.Path = repo.GetName()
+		opt.Owner = repo.Owner.GetLogin()
+	}
+
+	if _, _, err := s.client.Repositories.Edit(ctx, opt.Owner, opt.Path, &github.Repository{
+		Archived: github.Bool(true),
+	}); err != nil {
+		return ErrFailedSCM{
+			GitError: err,
+			Method:   "ArchiveRepository",
+			Message:  fmt.Sprintf("failed to archive repository %s", opt.Path),
+		}
+	}
+	return nil
+}
+
 // UpdateRepoAccess implements the SCM interface.
 func (s *GithubSCM) UpdateRepoAccess(ctx context.Context, repo *Repository, user, permission string) error {
 	if repo == nil || !repo.valid() {
@@ -255,6 +322,83 @@ func (s *GithubSCM) UpdateRepoAccess(ctx context.Context, repo *Repository, user
 	return nil
 }
 
+// RemoveRepoCollaborator implements the SCM interface.
+func (s *GithubSCM) RemoveRepoCollaborator(ctx context.Context, repo *Repository, user string) error {
+	if repo == nil || !repo.valid() || user == "" {
+		return ErrMissingFields{
+			Method:  "RemoveRepoCollaborator",
+			Message: fmt.Sprintf("%+v, user: %s", repo, user),
+		}
+	}
+	if _, err := s.client.Repositories.RemoveCollaborator(ctx, repo.Owner, repo.Path, user); err != nil {
+		return ErrFailedSCM{
+			GitError: err,
+			Method:   "RemoveRepoCollaborator",
+			Message:  fmt.Sprintf("failed to remove user %s from repository %s", user, repo.Path),
+		}
+	}
+	return nil
+}
+
+// GetRepositoryAccess implements the SCM interface.
+func (s *GithubSCM) GetRepositoryAccess(ctx context.Context, opt *RepositoryOptions) (*RepositoryAccess, error) {
+	if !opt.valid() {
+		return nil, ErrMissingFields{
+			Method:  "GetRepositoryAccess",
+			Message: fmt.Sprintf("%+v", opt),
+		}
+	}
+	owner, path := opt.Owner, opt.Path
+	if opt.ID > 0 {
+		repo, _, err := s.client.Repositories.GetByID(ctx, int64(opt.ID))
+		if err != nil {
+			return nil, ErrFailedSCM{
+				GitError: err,
+				Method:   "GetRepositoryAccess",
+				Message:  fmt.Sprintf("failed to fetch repository %d: may not exists in the course organization", opt.ID),
+			}
+		}
+		owner, path = repo.Owner.GetLogin(), repo.GetName()
+	}
+
+	teams, _, err := s.client.Repositories.ListTeams(ctx, owner, path, &github.ListOptions{PerPage: 100})
+	if err != nil {
+		return nil, ErrFailedSCM{
+			GitError: err,
+			Method:   "GetRepositoryAccess",
+			Message:  fmt.Sprintf("failed to fetch teams with access to repository %s", path),
+		}
+	}
+	// only list collaborators added directly to the repository, not organization owners
+	collaborators, _, err := s.client.Repositories.ListCollaborators(ctx, owner, path, &github.ListCollaboratorsOptions{
+		Affiliation: "direct",
+		ListOptions: github.ListOptions{PerPage: 100},
+	})
+	if err != nil {
+		return nil, ErrFailedSCM{
+			GitError: err,
+			Method:   "GetRepositoryAccess",
+			Message:  fmt.Sprintf("failed to fetch collaborators of repository %s", path),
+		}
+	}
+
+	access := &RepositoryAccess{}
+	for _, team := range teams {
+		access.Teams = append(access.Teams, &Permission{
+			ID:         uint64(team.GetID()),
+			Name:       team.GetName(),
+			Permission: toPermission(team.Permissions),
+		})
+	}
+	for _, user := range collaborators {
+		access.Collaborators = append(access.Collaborators, &Permission{
+			Name:       user.GetLogin(),
+			Permission: toPermission(user.Permissions),
+		})
+	}
+	return access, nil
+}
+
 // RepositoryIsEmpty implements the SCM interface
 func (s *GithubSCM) RepositoryIsEmpty(ctx context.Context, opt *RepositoryOptions) bool {
 	repo, err := s.GetRepository(ctx, opt)
@@ -288,11 +432,11 @@ func (s *GithubSCM) ListHooks(ctx context.Context, repo *Repository, org string)
 	case repo != nil && repo.valid():
 		githubHooks, _, err = s.client.Repositories.ListHooks(ctx, repo.Owner, repo.Path, nil)
 		if err != nil {
-			return nil, fmt.Errorf("ListHooks: failed to get hooks for repository %q: %w", repo, err)
+			return nil, fmt.Errorf("ListHooks: failed to get hooks for repository %q: %w", repo.Path, err)
 		}
 
 	default:
-		return nil, fmt.Errorf("ListHooks: called with missing or incompatible arguments: %q %q", repo, org)
+		return nil, fmt.Errorf("ListHooks: called with missing or incompatible arguments: %+v %q", repo, org)
 	}
 
 	for _, hook := range githubHooks {
@@ -321,6 +465,8 @@ func (s *GithubSCM) CreateHook(ctx context.Context, opt *CreateHookOptions) erro
 			"content_type": "json",
 			"insecure_ssl": "0",
 		},
+		// pull request events are needed for assignments submitted by pull request
+		Events: []string{"push", "pull_request"},
 	}
 	var err error
 	// prioritize creating an organization hook
@@ -608,6 +754,24 @@ func (s *GithubSCM) AddTeamRepo(ctx context.Context, opt *AddTeamRepoOptions) er
 	return nil
 }
 
+// RemoveTeamRepo implements the SCM interface.
+func (s *GithubSCM) RemoveTeamRepo(ctx context.Context, opt *RemoveTeamRepoOptions) error {
+	if !opt.valid() {
+		return ErrMissingFields{
+			Method:  "RemoveTeamRepo",
+			Message: fmt.Sprintf("%+v", opt),
+		}
+	}
+	if _, err := s.client.Teams.RemoveTeamRepoByID(ctx, int64(opt.OrganizationID), int64(opt.TeamID), opt.Owner, opt.Repo); err != nil {
+		return ErrFailedSCM{
+			GitError: fmt.Errorf("failed to remove GitHub repository '%s' from team %d: %w", opt.Repo, opt.TeamID, err),
+			Method:   "RemoveTeamRepo",
+			Message:  fmt.Sprintf("failed to revoke team access to repository '%s'", opt.Repo),
+		}
+	}
+	return nil
+}
+
 // GetUserName implements the SCM interface.
 func (s *GithubSCM) GetUserName(ctx context.Context) (string, error) {
 	user, _, err := s.client.Users.Get(ctx, "")
@@ -646,6 +810,35 @@ func (s *GithubSCM) UpdateOrgMembership(ctx context.Context, opt *OrgMembershipO
 	return nil
 }
 
+// GetOrgMembers implements the SCM interface
+func (s *GithubSCM) GetOrgMembers(ctx context.Context, org *pb.Organization) ([]string, error) {
+	if !org.IsValid() {
+		return nil, ErrMissingFields{
+			Method:  "GetOrgMembers",
+			Message: fmt.Sprintf("%+v", org),
+		}
+	}
+	listOpt := &github.ListMembersOptions{ListOptions: github.ListOptions{PerPage: 100}}
+	var members []string
+	for {
+		gitMembers, resp, err := s.client.Organizations.ListMembers(ctx, org.Path, listOpt)
+		if err != nil {
+			return nil, ErrFailedSCM{
+				Method:   "GetOrgMembers",
+				GitError: fmt.Errorf("failed to list members of organization %s: %w", org.Path, err),
+				Message:  fmt.Sprintf("failed to fetch members of organization %s", org.Path),
+			}
+		}
+		for _, member := range gitMembers {
+			members = append(members, member.GetLogin())
+		}
+		if resp.NextPage == 0 {
+			return members, nil
+		}
+		listOpt.Page = resp.NextPage
+	}
+}
+
 // RemoveMember implements the SCM interface
 func (s *GithubSCM) RemoveMember(ctx context.Context, opt *OrgMembershipOptions) error {
 	if !opt.valid() {
@@ -689,15 +882,289 @@ func (s *GithubSCM) GetUserScopes(ctx context.Context) *Authorization {
 	return &Authorization{Scopes: gitScopes}
 }
 
+// GetDiscussions implements the SCM interface.
+// GitHub's API does not support creating discussion categories; they must
+// be created in the repository's settings, using the assignment name as the category name.
+func (s *GithubSCM) GetDiscussions(ctx context.Context, opt *DiscussionOptions) ([]*Discussion, error) {
+	if !opt.valid() {
+		return nil, ErrMissingFields{
+			Method:  "GetDiscussions",
+			Message: fmt.Sprintf("%+v", opt),
+		}
+	}
+	limit := opt.Limit
+	if limit <= 0 {
+		limit = 10
+	}
+
+	var categories struct {
+		Repository struct {
+			DiscussionCategories struct {
+				Nodes []struct {
+					ID   string
+					Name string
+				}
+			}
+		}
+	}
+	categoriesQuery := `query($owner: String!, $name: String!) {
+		repository(owner: $owner, name: $name) {
+			discussionCategories(first: 100) { nodes { id name } }
+		}
+	}`
+	if err := s.graphQL(ctx, categoriesQuery, map[string]interface{}{
+		"owner": opt.Owner,
+		"name":  opt.Repository,
+	}, &categories); err != nil {
+		return nil, ErrFailedSCM{
+			GitError: err,
+			Method:   "GetDiscussions",
+			Message:  fmt.Sprintf("failed to fetch discussion categories for repository %s", opt.Repository),
+		}
+	}
+	var categoryID string
+	for _, category := range categories.Repository.DiscussionCategories.Nodes {
+		if strings.EqualFold(category.Name, opt.Category) {
+			categoryID = category.ID
+			break
+		}
+	}
+	if categoryID == "" {
+		return nil, ErrFailedSCM{
+			GitError: fmt.Errorf("category %q not found in repository %s/%s", opt.Category, opt.Owner, opt.Repository),
+			Method:   "GetDiscussions",
+			Message:  fmt.Sprintf("discussion category %s not found in repository %s", opt.Category, opt.Repository),
+		}
+	}
+
+	var discussions struct {
+		Repository struct {
+			Discussions struct {
+				Nodes []struct {
+					Title     string
+					URL       string
+					CreatedAt time.Time
+					Author    struct{ Login string }
+					Comments  struct{ TotalCount uint32 }
+					Answer    *struct{ ID string }
+				}
+			}
+		}
+	}
+	discussionsQuery := `query($owner: String!, $name: String!, $category: ID!, $first: Int!) {
+		repository(owner: $owner, name: $name) {
+			discussions(first: $first, categoryId: $category, orderBy: {field: CREATED_AT, direction: DESC}) {
+				nodes { title url createdAt author { login } comments { totalCount } answer { id } }
+			}
+		}
+	}`
+	if err := s.graphQL(ctx, discussionsQuery, map[string]interface{}{
+		"owner":    opt.Owner,
+		"name":     opt.Repository,
+		"category": categoryID,
+		"first":    limit,
+	}, &discussions); err != nil {
+		return nil, ErrFailedSCM{
+			GitError: err,
+			Method:   "GetDiscussions",
+			Message:  fmt.Sprintf("failed to fetch discussions for repository %s", opt.Repository),
+		}
+	}
+	var result []*Discussion
+	for _, d := range discussions.Repository.Discussions.Nodes {
+		result = append(result, &Discussion{
+			Title:    d.Title,
+			URL:      d.URL,
+			Author:   d.Author.Login,
+			Created:  d.CreatedAt,
+			Comments: d.Comments.TotalCount,
+			Answered: d.Answer != nil,
+		})
+	}
+	return result, nil
+}
+
+// CreateCommitComment implements the SCM interface.
+func (s *GithubSCM) CreateCommitComment(ctx context.Context, opt *CommitCommentOptions) (string, error) {
+	if !opt.valid() {
+		return "", ErrMissingFields{
+			Method:  "CreateCommitComment",
+			Message: fmt.Sprintf("%+v", opt),
+		}
+	}
+	// the go-github RepositoryComment has no line field, so the request is built directly
+	body := map[string]interface{}{"body": opt.Body}
+	if opt.Path != "" {
+		body["path"] = opt.Path
+	}
+	if opt.Line > 0 {
+		body["line"] = opt.Line
+	}
+	u := fmt.Sprintf("repos/%s/%s/commits/%s/comments", opt.Owner, opt.Repository, opt.CommitSHA)
+	req, err := s.client.NewRequest("POST", u, body)
+	if err != nil {
+		return "", ErrFailedSCM{
+			GitError: err,
+			Method:   "CreateCommitComment",
+			Message:  fmt.Sprintf("failed to create comment on commit %s in repository %s", opt.CommitSHA, opt.Repository),
+		}
+	}
+	var comment github.RepositoryComment
+	if _, err := s.client.Do(ctx, req, &comment); err != nil {
+		return "", ErrFailedSCM{
+			GitError: err,
+			Method:   "CreateCommitComment",
+			Message:  fmt.Sprintf("failed to create comment on commit %s in repository %s", opt.CommitSHA, opt.Repository),
+		}
+	}
+	return comment.GetHTMLURL(), nil
+}
+
+// CreateIssue implements the SCM interface.
+func (s *GithubSCM) CreateIssue(ctx context.Context, opt *IssueOptions) (string, error) {
+	if !opt.valid() {
+		return "", ErrMissingFields{
+			Method:  "CreateIssue",
+			Message: fmt.Sprintf("%+v", opt),
+		}
+	}
+	issue, _, err := s.client.Issues.Create(ctx, opt.Owner, opt.Repository, &github.IssueRequest{
+		Title: &opt.Title,
+		Body:  &opt.Body,
+	})
+	if err != nil {
+		return "", ErrFailedSCM{
+			GitError: err,
+			Method:   "CreateIssue",
+			Message:  fmt.Sprintf("failed to create issue in repository %s", opt.Repository),
+		}
+	}
+	return issue.GetHTMLURL(), nil
+}
+
+// GetCommits implements the SCM interface.
+// GitHub does not list the files changed by each commit, so each commit is fetched separately.
+func (s *GithubSCM) GetCommits(ctx context.Context, opt *CommitOptions) ([]*Commit, error) {
+	if !opt.valid() {
+		return nil, ErrMissingFields{
+			Method:  "GetCommits",
+			Message: fmt.Sprintf("%+v", opt),
+		}
+	}
+	listOpt := &github.CommitsListOptions{
+		SHA:         opt.Branch,
+		Since:       opt.Since,
+		ListOptions: github.ListOptions{PerPage: 100},
+	}
+	var commits []*Commit
+	for {
+		repoCommits, resp, err := s.client.Repositories.ListCommits(ctx, opt.Owner, opt.Repository, listOpt)
+		if err != nil {
+			return nil, ErrFailedSCM{
+				GitError: err,
+				Method:   "GetCommits",
+				Message:  fmt.Sprintf("failed to list commits in repository %s", opt.Repository),
+			}
+		}
+		for _, repoCommit := range repoCommits {
+			repoCommit, _, err = s.client.Repositories.GetCommit(ctx, opt.Owner, opt.Repository, repoCommit.GetSHA())
+			if err != nil {
+				return nil, ErrFailedSCM{
+					GitError: err,
+					Method:   "GetCommits",
+					Message:  fmt.Sprintf("failed to get commit %s in repository %s", repoCommit.GetSHA(), opt.Repository),
+				}
+			}
+			commit := &Commit{
+				SHA:     repoCommit.GetSHA(),
+				Message: repoCommit.GetCommit().GetMessage(),
+				Author:  repoCommit.GetAuthor().GetLogin(),
+				Date:    repoCommit.GetCommit().GetCommitter().GetDate(),
+			}
+			for _, file := range repoCommit.Files {
+				commit.Files = append(commit.Files, file.GetFilename())
+			}
+			commits = append(commits, commit)
+		}
+		if resp.NextPage == 0 {
+			return commits, nil
+		}
+		listOpt.Page = resp.NextPage
+	}
+}
+
+// graphQL sends the given query to GitHub's GraphQL API and decodes the response data into result.
+// The REST API used by the go-github client does not provide access to discussions.
+func (s *GithubSCM) graphQL(ctx context.Context, query string, variables map[string]interface{}, result interface{}) error {
+	req, err := s.client.NewRequest("POST", "graphql", map[string]interface{}{
+		"query":     query,
+		"variables": variables,
+	})
+	if err != nil {
+		return err
+	}
+	var resp struct {
+		Data   json.RawMessage
+		Errors []struct{ Message string }
+	}
+	if _, err := s.client.Do(ctx, req, &resp); err != nil {
+		return err
+	}
+	if len(resp.Errors) > 0 {
+		return fmt.Errorf("graphql: %s", resp.Errors[0].Message)
+	}
+	return json.Unmarshal(resp.Data, result)
+}
+
+// toPermission returns the highest repository permission level among the given GitHub permissions.
+func toPermission(permissions map[string]bool) string {
+	switch {
+	case permissions["admin"]:
+		return RepoFull
+	case permissions["maintain"], permissions["push"]:
+		return RepoPush
+	case permissions["triage"], permissions["pull"]:
+		return RepoPull
+	}
+	return ""
+}
+
 func toRepository(repo *github.Repository) *Repository {
 	return &Repository{
-		ID:      uint64(repo.GetID()),
-		Path:    repo.GetName(),
-		Owner:   repo.Owner.GetLogin(),
-		WebURL:  repo.GetHTMLURL(),
-		SSHURL:  repo.GetSSHURL(),
-		HTTPURL: repo.GetCloneURL(),
-		OrgID:   uint64(repo.Organization.GetID()),
-		Size:    uint64(repo.GetSize()),
+		ID:       uint64(repo.GetID()),
+		Path:     repo.GetName(),
+		Owner:    repo.Owner.GetLogin(),
+		WebURL:   repo.GetHTMLURL(),
+		SSHURL:   repo.GetSSHURL(),
+		HTTPURL:  repo.GetCloneURL(),
+		OrgID:    uint64(repo.Organization.GetID()),
+		Size:     uint64(repo.GetSize()),
+		Archived: repo.GetArchived(),
 	}
 }
+
+// CreateCommitStatus implements the SCM interface.
+func (s *GithubSCM) CreateCommitStatus(ctx context.Context, opt *CommitStatusOptions) error {
+	if !opt.valid() {
+		return ErrMissingFields{
+			Method:  "CreateCommitStatus",
+			Message: fmt.Sprintf("%+v", opt),
+		}
+	}
+	status := &github.RepoStatus{
+		State:       github.String(string(opt.State)),
+		Context:     github.String(opt.Context),
+		Description: github.String(opt.Description),
+	}
+	if opt.TargetURL != "" {
+		status.TargetURL = github.String(opt.TargetURL)
+	}
+	if _, _, err := s.client.Repositories.CreateStatus(ctx, opt.Owner, opt.Repository, opt.CommitSHA, status); err != nil {
+		return ErrFailedSCM{
+			GitError: err,
+			Method:   "CreateCommitStatus",
+			Message:  fmt.Sprintf("failed to set status of commit %s in repository %s", opt.CommitSHA, opt.Repository),
+		}
+	}
+	return nil
+}
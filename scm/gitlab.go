@@ -2,6 +2,8 @@ package scm
 
 import (
 	"context"
+	"fmt"
+	"net/http"
 	"strconv"
 
 	pb "github.com/autograde/quickfeed/ag"
@@ -15,7 +17,8 @@ type GitlabSCM struct {
 
 // NewGitlabSCMClient returns a new GitLab client implementing the SCM interface.
 func NewGitlabSCMClient(token string) *GitlabSCM {
-	cli, _ := gitlab.NewOAuthClient(token, gitlab.WithoutRetries())
+	httpClient := &http.Client{Transport: newRateLimitTransport(nil, "gitlab", nil)}
+	cli, _ := gitlab.NewOAuthClient(token, gitlab.WithoutRetries(), gitlab.WithHTTPClient(httpClient))
 	return &GitlabSCM{
 		client: cli,
 	}
@@ -65,13 +68,21 @@ func (s *GitlabSCM) GetOrganization(ctx context.Context, opt *GetOrgOptions) (*p
 // CreateRepository implements the SCM interface.
 func (s *GitlabSCM) CreateRepository(ctx context.Context, opt *CreateRepositoryOptions) (*Repository, error) {
 	directoryID := int(opt.Organization.ID)
-	repo, _, err := s.client.Projects.CreateProject(
-		&gitlab.CreateProjectOptions{
-			Path:        &opt.Path,
-			NamespaceID: &directoryID,
-		},
-		gitlab.WithContext(ctx),
-	)
+	projectOptions := &gitlab.CreateProjectOptions{
+		Path:        &opt.Path,
+		NamespaceID: &directoryID,
+	}
+	if opt.Template != nil {
+		// the template must be one of the group's project templates
+		templateID, err := s.templateProjectID(ctx, opt)
+		if err != nil {
+			return nil, err
+		}
+		projectOptions.TemplateProjectID = &templateID
+		projectOptions.UseCustomTemplate = gitlab.Bool(true)
+		projectOptions.GroupWithProjectTemplatesID = &directoryID
+	}
+	repo, _, err := s.client.Projects.CreateProject(projectOptions, gitlab.WithContext(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -86,6 +97,27 @@ func (s *GitlabSCM) CreateRepository(ctx context.Context, opt *CreateRepositoryO
 	}, nil
 }
 
+// templateProjectID returns the project ID of the template repository,
+// looking up the project by its path if the ID is not given.
+func (s *GitlabSCM) templateProjectID(ctx context.Context, opt *CreateRepositoryOptions) (int, error) {
+	if opt.Template.ID > 0 {
+		return int(opt.Template.ID), nil
+	}
+	owner := opt.Template.Owner
+	if owner == "" {
+		owner = opt.Organization.Path
+	}
+	project, _, err := s.client.Projects.GetProject(owner+"/"+opt.Template.Path, nil, gitlab.WithContext(ctx))
+	if err != nil {
+		return 0, ErrFailedSCM{
+			Method:   "CreateRepository",
+			Message:  fmt.Sprintf("failed to get template repository %s", opt.Template.Path),
+			GitError: err,
+		}
+	}
+	return project.ID, nil
+}
+
 // GetRepository implements the SCM interface.
 func (s *GitlabSCM) GetRepository(cts context.Context, opt *RepositoryOptions) (*Repository, error) {
 	// TODO no implementation provided yet
@@ -130,6 +162,12 @@ func (s *GitlabSCM) DeleteRepository(ctx context.Context, opt *RepositoryOptions
 	return
 }
 
+// ArchiveRepository implements the SCM interface.
+func (s *GitlabSCM) ArchiveRepository(ctx context.Context, opt *RepositoryOptions) (err error) {
+	_, _, err = s.client.Projects.ArchiveProject(strconv.FormatUint(opt.ID, 10), gitlab.WithContext(ctx))
+	return
+}
+
 // UpdateRepoAccess implements the SCM interface.
 func (s *GitlabSCM) UpdateRepoAccess(ctx context.Context, repo *Repository, user, permission string) error {
 	// TODO no implementation provided yet
@@ -139,6 +177,24 @@ func (s *GitlabSCM) UpdateRepoAccess(ctx context.Context, repo *Repository, user
 	}
 }
 
+// RemoveRepoCollaborator implements the SCM interface
+func (s *GitlabSCM) RemoveRepoCollaborator(ctx context.Context, repo *Repository, user string) error {
+	// TODO no implementation provided yet
+	return ErrNotSupported{
+		SCM:    "gitlab",
+		Method: "RemoveRepoCollaborator",
+	}
+}
+
+// GetRepositoryAccess implements the SCM interface
+func (s *GitlabSCM) GetRepositoryAccess(ctx context.Context, opt *RepositoryOptions) (*RepositoryAccess, error) {
+	// TODO no implementation provided yet
+	return nil, ErrNotSupported{
+		SCM:    "gitlab",
+		Method: "GetRepositoryAccess",
+	}
+}
+
 // RepositoryIsEmpty implements the SCM interface
 func (s *GitlabSCM) RepositoryIsEmpty(ctx context.Context, opt *RepositoryOptions) bool {
 	// TODO no implementation provided yet
@@ -147,20 +203,73 @@ func (s *GitlabSCM) RepositoryIsEmpty(ctx context.Context, opt *RepositoryOption
 
 // ListHooks implements the SCM interface.
 func (s *GitlabSCM) ListHooks(ctx context.Context, repo *Repository, org string) ([]*Hook, error) {
-	// TODO no implementation provided yet
-	return nil, ErrNotSupported{
-		SCM:    "gitlab",
-		Method: "ListHooks",
+	var hooks []*Hook
+	switch {
+	case org != "":
+		groupHooks, _, err := s.client.Groups.ListGroupHooks(org)
+		if err != nil {
+			return nil, fmt.Errorf("ListHooks: failed to get hooks for group %q: %w", org, err)
+		}
+		for _, hook := range groupHooks {
+			hooks = append(hooks, &Hook{ID: uint64(hook.ID), URL: hook.URL, Events: gitlabHookEvents(hook.PushEvents, hook.MergeRequestsEvents)})
+		}
+	case repo != nil:
+		projectHooks, _, err := s.client.Projects.ListProjectHooks(strconv.FormatUint(repo.ID, 10), nil, gitlab.WithContext(ctx))
+		if err != nil {
+			return nil, fmt.Errorf("ListHooks: failed to get hooks for repository %q: %w", repo.Path, err)
+		}
+		for _, hook := range projectHooks {
+			hooks = append(hooks, &Hook{ID: uint64(hook.ID), URL: hook.URL, Events: gitlabHookEvents(hook.PushEvents, hook.MergeRequestsEvents)})
+		}
+	default:
+		return nil, fmt.Errorf("ListHooks: called with missing or incompatible arguments: %+v %q", repo, org)
 	}
+	return hooks, nil
 }
 
-// CreateHook implements the SCM interface.
-func (s *GitlabSCM) CreateHook(ctx context.Context, opt *CreateHookOptions) (err error) {
-	_, _, err = s.client.Projects.AddProjectHook(strconv.FormatUint(opt.Repository.ID, 10), &gitlab.AddProjectHookOptions{
-		URL:   &opt.URL,
-		Token: &opt.Secret,
-	}, gitlab.WithContext(ctx))
-	return
+// gitlabHookEvents returns the names of the events enabled for a GitLab hook.
+func gitlabHookEvents(push, mergeRequests bool) []string {
+	var events []string
+	if push {
+		events = append(events, "push")
+	}
+	if mergeRequests {
+		events = append(events, "merge_requests")
+	}
+	return events
+}
+
+// CreateHook implements the SCM interface. A hook for an organization is created
+// as a group hook, which is triggered on push to any of the group's projects.
+func (s *GitlabSCM) CreateHook(ctx context.Context, opt *CreateHookOptions) error {
+	if !opt.valid() {
+		return ErrMissingFields{
+			Method:  "CreateHook",
+			Message: fmt.Sprintf("%+v", opt),
+		}
+	}
+	var err error
+	if opt.Organization != "" {
+		_, _, err = s.client.Groups.AddGroupHook(opt.Organization, &gitlab.AddGroupHookOptions{
+			URL:        &opt.URL,
+			Token:      &opt.Secret,
+			PushEvents: gitlab.Bool(true),
+		}, gitlab.WithContext(ctx))
+	} else {
+		_, _, err = s.client.Projects.AddProjectHook(strconv.FormatUint(opt.Repository.ID, 10), &gitlab.AddProjectHookOptions{
+			URL:        &opt.URL,
+			Token:      &opt.Secret,
+			PushEvents: gitlab.Bool(true),
+		}, gitlab.WithContext(ctx))
+	}
+	if err != nil {
+		return ErrFailedSCM{
+			GitError: err,
+			Method:   "CreateHook",
+			Message:  fmt.Sprintf("failed to create GitLab hook with query: %+v", opt),
+		}
+	}
+	return nil
 }
 
 // CreateTeam implements the SCM interface.
@@ -235,6 +344,15 @@ func (s *GitlabSCM) AddTeamRepo(ctx context.Context, opt *AddTeamRepoOptions) er
 	}
 }
 
+// RemoveTeamRepo implements the SCM interface.
+func (s *GitlabSCM) RemoveTeamRepo(ctx context.Context, opt *RemoveTeamRepoOptions) error {
+	// TODO no implementation provided yet
+	return ErrNotSupported{
+		SCM:    "gitlab",
+		Method: "RemoveTeamRepo",
+	}
+}
+
 // GetUserName implements the SCM interface.
 func (s *GitlabSCM) GetUserName(ctx context.Context) (string, error) {
 	return "", nil
@@ -266,6 +384,82 @@ func (s *GitlabSCM) UpdateOrgMembership(ctx context.Context, opt *OrgMembershipO
 	}
 }
 
+// GetOrgMembers implements the SCM interface
+func (s *GitlabSCM) GetOrgMembers(ctx context.Context, org *pb.Organization) ([]string, error) {
+	// TODO no implementation provided yet
+	return nil, ErrNotSupported{
+		SCM:    "gitlab",
+		Method: "GetOrgMembers",
+	}
+}
+
+// GetDiscussions implements the SCM interface
+func (s *GitlabSCM) GetDiscussions(ctx context.Context, opt *DiscussionOptions) ([]*Discussion, error) {
+	// GitLab has no repository discussions
+	return nil, ErrNotSupported{
+		SCM:    "gitlab",
+		Method: "GetDiscussions",
+	}
+}
+
+// CreateCommitComment implements the SCM interface.
+func (s *GitlabSCM) CreateCommitComment(ctx context.Context, opt *CommitCommentOptions) (string, error) {
+	// TODO no implementation provided yet
+	return "", ErrNotSupported{
+		SCM:    "gitlab",
+		Method: "CreateCommitComment",
+	}
+}
+
+// CreateIssue implements the SCM interface.
+func (s *GitlabSCM) CreateIssue(ctx context.Context, opt *IssueOptions) (string, error) {
+	// TODO no implementation provided yet
+	return "", ErrNotSupported{
+		SCM:    "gitlab",
+		Method: "CreateIssue",
+	}
+}
+
+// GetCommits implements the SCM interface.
+func (s *GitlabSCM) GetCommits(ctx context.Context, opt *CommitOptions) ([]*Commit, error) {
+	// TODO no implementation provided yet
+	return nil, ErrNotSupported{
+		SCM:    "gitlab",
+		Method: "GetCommits",
+	}
+}
+
+// CreateCommitStatus implements the SCM interface.
+// GitLab has no error state; the error state is reported as failed.
+func (s *GitlabSCM) CreateCommitStatus(ctx context.Context, opt *CommitStatusOptions) error {
+	if !opt.valid() {
+		return ErrMissingFields{
+			Method:  "CreateCommitStatus",
+			Message: fmt.Sprintf("%+v", opt),
+		}
+	}
+	state := gitlab.BuildStateValue(opt.State)
+	if opt.State == CommitFailure || opt.State == CommitError {
+		state = gitlab.Failed
+	}
+	status := &gitlab.SetCommitStatusOptions{
+		State:       state,
+		Context:     &opt.Context,
+		Description: &opt.Description,
+	}
+	if opt.TargetURL != "" {
+		status.TargetURL = &opt.TargetURL
+	}
+	if _, _, err := s.client.Commits.SetCommitStatus(opt.Owner+"/"+opt.Repository, opt.CommitSHA, status, gitlab.WithContext(ctx)); err != nil {
+		return ErrFailedSCM{
+			GitError: err,
+			Method:   "CreateCommitStatus",
+			Message:  fmt.Sprintf("failed to set status of commit %s in repository %s", opt.CommitSHA, opt.Repository),
+		}
+	}
+	return nil
+}
+
 // RemoveMember implements the SCM interface
 func (s *GitlabSCM) RemoveMember(ctx context.Context, opt *OrgMembershipOptions) error {
 	// TODO no implementation provided yet
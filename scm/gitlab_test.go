@@ -0,0 +1,156 @@
+package scm
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+func TestGitlabCreateCommitStatus(t *testing.T) {
+	var got []map[string]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.EscapedPath() != "/api/v4/projects/qf101%2Fstudent-labs/statuses/sha1" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		var in map[string]string
+		_ = json.NewDecoder(r.Body).Decode(&in)
+		got = append(got, in)
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"id": len(got), "sha": "sha1", "status": in["state"]})
+	}))
+	defer server.Close()
+	client, err := gitlab.NewOAuthClient("token", gitlab.WithBaseURL(server.URL), gitlab.WithoutRetries())
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &GitlabSCM{client: client}
+
+	for _, state := range []CommitState{CommitPending, CommitSuccess, CommitFailure} {
+		if err := s.CreateCommitStatus(context.Background(), &CommitStatusOptions{
+			Owner:       "qf101",
+			Repository:  "student-labs",
+			CommitSHA:   "sha1",
+			State:       state,
+			Context:     "quickfeed/lab1",
+			Description: "Score 80%",
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// GitLab reports failures as failed
+	var states []string
+	for _, status := range got {
+		states = append(states, status["state"])
+		if status["context"] != "quickfeed/lab1" || status["description"] != "Score 80%" {
+			t.Errorf("CreateCommitStatus() set status %v, want context quickfeed/lab1 and description Score 80%%", status)
+		}
+	}
+	if want := []string{"pending", "success", "failed"}; !reflect.DeepEqual(states, want) {
+		t.Errorf("CreateCommitStatus() set states %v, want %v", states, want)
+	}
+
+	if err := s.CreateCommitStatus(context.Background(), &CommitStatusOptions{Owner: "qf101", Repository: "student-labs"}); err == nil {
+		t.Error("CreateCommitStatus() without commit succeeded, want error")
+	}
+}
+
+func TestGitlabCreateGroupHook(t *testing.T) {
+	var got []map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.EscapedPath() != "/api/v4/groups/qf101/hooks" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if r.Method == http.MethodGet {
+			hooks := []map[string]interface{}{}
+			for i, hook := range got {
+				hooks = append(hooks, map[string]interface{}{"id": i + 1, "url": hook["url"], "push_events": hook["push_events"]})
+			}
+			_ = json.NewEncoder(w).Encode(hooks)
+			return
+		}
+		var in map[string]interface{}
+		_ = json.NewDecoder(r.Body).Decode(&in)
+		got = append(got, in)
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"id": len(got), "url": in["url"]})
+	}))
+	defer server.Close()
+	client, err := gitlab.NewOAuthClient("token", gitlab.WithBaseURL(server.URL), gitlab.WithoutRetries())
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &GitlabSCM{client: client}
+
+	opt := &CreateHookOptions{URL: "https://qf.example.com/hook/gitlab/events", Secret: "secret", Organization: "qf101"}
+	if err := s.CreateHook(context.Background(), opt); err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 1 || got[0]["url"] != opt.URL || got[0]["token"] != opt.Secret || got[0]["push_events"] != true {
+		t.Errorf("CreateHook() created hooks %v, want a group push hook for %s", got, opt.URL)
+	}
+	hooks, err := s.ListHooks(context.Background(), nil, "qf101")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*Hook{{ID: 1, URL: opt.URL, Events: []string{"push"}}}
+	if !reflect.DeepEqual(hooks, want) {
+		t.Errorf("ListHooks() = %v, want %v", hooks, want)
+	}
+
+	if err := s.CreateHook(context.Background(), &CreateHookOptions{Organization: "qf101"}); err == nil {
+		t.Error("CreateHook() without URL succeeded, want error")
+	}
+}
+
+func TestGitlabCreateRepositoryFromTemplate(t *testing.T) {
+	var got map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.EscapedPath() == "/api/v4/projects/qf101%2Fassignments":
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"id": 5, "path": "assignments"})
+		case r.Method == http.MethodPost && r.URL.EscapedPath() == "/api/v4/projects":
+			_ = json.NewDecoder(r.Body).Decode(&got)
+			w.WriteHeader(http.StatusCreated)
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"id": 6, "path": got["path"]})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	client, err := gitlab.NewOAuthClient("token", gitlab.WithBaseURL(server.URL), gitlab.WithoutRetries())
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &GitlabSCM{client: client}
+
+	opt := &CreateRepositoryOptions{
+		Organization: &pb.Organization{ID: 7, Path: "qf101"},
+		Path:         "student-labs",
+		Template:     &Repository{Owner: "qf101", Path: pb.AssignmentRepo},
+	}
+	repo, err := s.CreateRepository(context.Background(), opt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if repo.ID != 6 || repo.Path != "student-labs" {
+		t.Errorf("CreateRepository() = %+v, want project 6 with path student-labs", repo)
+	}
+	want := map[string]interface{}{
+		"path":                            "student-labs",
+		"namespace_id":                    float64(7),
+		"template_project_id":             float64(5),
+		"use_custom_template":             true,
+		"group_with_project_templates_id": float64(7),
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("CreateRepository() requested %v, want %v", got, want)
+	}
+}
@@ -75,6 +75,13 @@ func (opt AddTeamRepoOptions) valid() bool {
 		opt.Permission != ""
 }
 
+func (opt RemoveTeamRepoOptions) valid() bool {
+	return opt.TeamID > 0 &&
+		opt.OrganizationID > 0 &&
+		opt.Repo != "" &&
+		opt.Owner != ""
+}
+
 func (opt UpdateTeamOptions) valid() bool {
 	return opt.TeamID > 0 && opt.OrganizationID > 0
 }
@@ -112,6 +119,27 @@ func (opt RepositoryOptions) valid() bool {
 	return opt.ID > 0 || (opt.Path != "" && opt.Owner != "")
 }
 
+func (opt DiscussionOptions) valid() bool {
+	return opt.Owner != "" && opt.Repository != "" && opt.Category != ""
+}
+
+func (opt CommitCommentOptions) valid() bool {
+	return opt.Owner != "" && opt.Repository != "" && opt.CommitSHA != "" && opt.Body != "" &&
+		(opt.Line == 0 || opt.Path != "")
+}
+
+func (opt IssueOptions) valid() bool {
+	return opt.Owner != "" && opt.Repository != "" && opt.Title != ""
+}
+
+func (opt CommitOptions) valid() bool {
+	return opt.Owner != "" && opt.Repository != ""
+}
+
+func (opt CommitStatusOptions) valid() bool {
+	return opt.Owner != "" && opt.Repository != "" && opt.CommitSHA != "" && opt.State != ""
+}
+
 // Errors //
 
 // ErrNotSupported is returned when the source code management solution used
@@ -0,0 +1,186 @@
+package scm
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+)
+
+const (
+	// maxRateLimitRetries is the maximum number of times a rate limited request is retried.
+	maxRateLimitRetries = 5
+	// maxRateLimitWait is the longest time a request waits for the rate limit to reset;
+	// rate limited responses requiring a longer wait are returned to the caller.
+	maxRateLimitWait = 10 * time.Minute
+	// rateLimitBackoff is the wait before the first retry of a rate limited request
+	// whose response does not say when to retry; the wait doubles for each retry.
+	rateLimitBackoff = time.Second
+)
+
+var (
+	// RateLimitRemainingMetric is the number of requests remaining in the current rate limit window.
+	RateLimitRemainingMetric = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "scm_rate_limit_remaining",
+		Help: "Number of requests remaining in the current rate limit window of the SCM provider.",
+	}, []string{"provider"})
+	// RateLimitRetriesMetric counts the requests retried due to rate limiting.
+	RateLimitRetriesMetric = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "scm_rate_limit_retries",
+		Help: "Number of SCM provider requests retried due to rate limiting.",
+	}, []string{"provider"})
+)
+
+// rateLimitTransport is an HTTP transport that sends the requests of an SCM client
+// one at a time, waits for the rate limit to reset when the quota is used up, and
+// retries requests rejected by primary or secondary (abuse) rate limits.
+type rateLimitTransport struct {
+	logger   *zap.SugaredLogger
+	provider string
+	base     http.RoundTripper
+	// queue holds a token while a request is in flight; requests wait in line for the token.
+	queue chan struct{}
+	// sleep waits for the given duration, or until the context is done.
+	sleep func(context.Context, time.Duration) error
+
+	mu        sync.Mutex
+	remaining int       // requests remaining in the current window; -1 if unknown
+	reset     time.Time // time when the current window resets
+}
+
+// newRateLimitTransport returns a rate limit aware transport for the given provider's
+// requests, sent using the base transport, or http.DefaultTransport if base is nil.
+func newRateLimitTransport(logger *zap.SugaredLogger, provider string, base http.RoundTripper) *rateLimitTransport {
+	if logger == nil {
+		logger = zap.NewNop().Sugar()
+	}
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &rateLimitTransport{
+		logger:    logger,
+		provider:  provider,
+		base:      base,
+		queue:     make(chan struct{}, 1),
+		sleep:     sleep,
+		remaining: -1,
+	}
+}
+
+// RoundTrip implements the http.RoundTripper interface.
+func (t *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	select {
+	case t.queue <- struct{}{}:
+		defer func() { <-t.queue }()
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	if wait := t.quotaWait(time.Now()); wait > 0 {
+		t.logger.Debugf("%s rate limit exhausted: waiting %v for reset", t.provider, wait)
+		if err := t.sleep(ctx, wait); err != nil {
+			return nil, err
+		}
+	}
+	for attempt := 0; ; attempt++ {
+		r := req
+		if attempt > 0 {
+			// requests with a body can only be retried if the body can be recreated
+			r = req.Clone(ctx)
+			if req.Body != nil {
+				body, err := req.GetBody()
+				if err != nil {
+					return nil, err
+				}
+				r.Body = body
+			}
+		}
+		resp, err := t.base.RoundTrip(r)
+		if err != nil {
+			return nil, err
+		}
+		t.update(resp.Header)
+		wait, limited := retryWait(resp, time.Now(), attempt)
+		if !limited || attempt == maxRateLimitRetries || wait > maxRateLimitWait || (req.Body != nil && req.GetBody == nil) {
+			return resp, nil
+		}
+		resp.Body.Close()
+		RateLimitRetriesMetric.WithLabelValues(t.provider).Inc()
+		t.logger.Debugf("%s rate limit: %s %s rejected with status %d: retrying in %v", t.provider, req.Method, req.URL.Path, resp.StatusCode, wait)
+		if err := t.sleep(ctx, wait); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// quotaWait returns the time to wait for the rate limit window to reset,
+// or zero if there are requests remaining in the current window.
+func (t *rateLimitTransport) quotaWait(now time.Time) time.Duration {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.remaining != 0 || !t.reset.After(now) {
+		return 0
+	}
+	if wait := t.reset.Sub(now); wait <= maxRateLimitWait {
+		return wait
+	}
+	// let the request through; the provider rejects it if the quota is still used up
+	return 0
+}
+
+// update records the remaining quota and reset time reported by the response headers.
+func (t *rateLimitTransport) update(header http.Header) {
+	remaining, err := strconv.Atoi(header.Get("X-RateLimit-Remaining"))
+	if err != nil {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.remaining = remaining
+	if reset, err := strconv.ParseInt(header.Get("X-RateLimit-Reset"), 10, 64); err == nil {
+		t.reset = time.Unix(reset, 0)
+	}
+	RateLimitRemainingMetric.WithLabelValues(t.provider).Set(float64(remaining))
+}
+
+// retryWait returns the time to wait before retrying the request of the response,
+// and whether the response was rejected due to rate limiting.
+// A 403 Forbidden response is only considered rate limited if its headers say so.
+func retryWait(resp *http.Response, now time.Time, attempt int) (time.Duration, bool) {
+	retryAfter := resp.Header.Get("Retry-After")
+	exhausted := resp.Header.Get("X-RateLimit-Remaining") == "0"
+	switch {
+	case resp.StatusCode == http.StatusTooManyRequests:
+	case resp.StatusCode == http.StatusForbidden && (retryAfter != "" || exhausted):
+	default:
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(retryAfter); err == nil {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if exhausted {
+		if reset, err := strconv.ParseInt(resp.Header.Get("X-RateLimit-Reset"), 10, 64); err == nil {
+			if wait := time.Unix(reset, 0).Sub(now); wait > 0 {
+				return wait, true
+			}
+		}
+	}
+	return rateLimitBackoff << attempt, true
+}
+
+// sleep waits for the given duration, or until the context is done.
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
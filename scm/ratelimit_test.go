@@ -0,0 +1,163 @@
+package scm
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// rateLimitedServer rejects the first requests with the given responses,
+// and accepts the remaining requests, echoing their body.
+type rateLimitedServer struct {
+	mu        sync.Mutex
+	rejects   []func(http.ResponseWriter)
+	requests  int
+	remaining int
+}
+
+func (s *rateLimitedServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.requests++
+	if len(s.rejects) > 0 {
+		reject := s.rejects[0]
+		s.rejects = s.rejects[1:]
+		reject(w)
+		return
+	}
+	s.remaining--
+	w.Header().Set("X-RateLimit-Remaining", strconv.Itoa(s.remaining))
+	w.Header().Set("X-RateLimit-Reset", strconv.FormatInt(time.Now().Add(time.Hour).Unix(), 10))
+	io.Copy(w, r.Body)
+}
+
+// recordSleeps makes the transport record its waits instead of sleeping.
+func recordSleeps(transport *rateLimitTransport) *[]time.Duration {
+	var waits []time.Duration
+	transport.sleep = func(_ context.Context, d time.Duration) error {
+		waits = append(waits, d)
+		return nil
+	}
+	return &waits
+}
+
+func TestRateLimitTransportRetries(t *testing.T) {
+	reset := time.Now().Add(30 * time.Second).Unix()
+	server := &rateLimitedServer{
+		remaining: 10,
+		rejects: []func(http.ResponseWriter){
+			// secondary rate limit
+			func(w http.ResponseWriter) {
+				w.Header().Set("Retry-After", "60")
+				w.WriteHeader(http.StatusForbidden)
+			},
+			// primary rate limit
+			func(w http.ResponseWriter) {
+				w.Header().Set("X-RateLimit-Remaining", "0")
+				w.Header().Set("X-RateLimit-Reset", strconv.FormatInt(reset, 10))
+				w.WriteHeader(http.StatusForbidden)
+			},
+			// too many requests without a retry time
+			func(w http.ResponseWriter) {
+				w.WriteHeader(http.StatusTooManyRequests)
+			},
+		},
+	}
+	ts := httptest.NewServer(server)
+	defer ts.Close()
+
+	transport := newRateLimitTransport(nil, "test", nil)
+	waits := recordSleeps(transport)
+	client := &http.Client{Transport: transport}
+
+	resp, err := client.Post(ts.URL, "text/plain", strings.NewReader("payload"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK || string(body) != "payload" {
+		t.Errorf("Post() = %d %q, want %d %q", resp.StatusCode, body, http.StatusOK, "payload")
+	}
+	if server.requests != 4 {
+		t.Errorf("server received %d requests, want 4", server.requests)
+	}
+	if len(*waits) != 3 {
+		t.Fatalf("transport waited %d times, want 3", len(*waits))
+	}
+	if (*waits)[0] != time.Minute {
+		t.Errorf("wait after Retry-After = %v, want %v", (*waits)[0], time.Minute)
+	}
+	if w := (*waits)[1]; w <= 0 || w > 30*time.Second {
+		t.Errorf("wait after exhausted quota = %v, want until reset in at most 30s", w)
+	}
+	if (*waits)[2] != 4*rateLimitBackoff {
+		t.Errorf("wait after third rejection = %v, want %v", (*waits)[2], 4*rateLimitBackoff)
+	}
+
+	// other forbidden responses are not retried
+	server.rejects = []func(http.ResponseWriter){func(w http.ResponseWriter) { w.WriteHeader(http.StatusForbidden) }}
+	server.requests = 0
+	resp, err = client.Get(ts.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusForbidden || server.requests != 1 {
+		t.Errorf("Get() = %d after %d requests, want %d after 1 request", resp.StatusCode, server.requests, http.StatusForbidden)
+	}
+}
+
+func TestRateLimitTransportWaitsForReset(t *testing.T) {
+	server := &rateLimitedServer{remaining: 1}
+	ts := httptest.NewServer(server)
+	defer ts.Close()
+
+	transport := newRateLimitTransport(nil, "test", nil)
+	waits := recordSleeps(transport)
+	client := &http.Client{Transport: transport}
+
+	for i := 0; i < 2; i++ {
+		resp, err := client.Get(ts.URL)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+	}
+	// the first request uses up the quota, and the reset is beyond the longest wait
+	if len(*waits) != 0 {
+		t.Errorf("transport waited %v, want no waits", *waits)
+	}
+
+	transport.mu.Lock()
+	transport.remaining = 0
+	transport.reset = time.Now().Add(time.Minute)
+	transport.mu.Unlock()
+	resp, err := client.Get(ts.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if len(*waits) != 1 || (*waits)[0] <= 0 || (*waits)[0] > time.Minute {
+		t.Errorf("transport waited %v, want a single wait of at most a minute", *waits)
+	}
+
+	// requests waiting for the quota give up when their context is canceled
+	transport.sleep = sleep
+	transport.mu.Lock()
+	transport.remaining = 0
+	transport.reset = time.Now().Add(time.Minute)
+	transport.mu.Unlock()
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, ts.URL, nil)
+	if _, err := client.Do(req); err == nil {
+		t.Error("Do() with canceled context succeeded, want error")
+	}
+}
@@ -3,13 +3,15 @@ package scm
 import (
 	"context"
 	"errors"
+	"os"
+	"time"
 
 	pb "github.com/autograde/quickfeed/ag"
 	"go.uber.org/zap"
 )
 
 // SCM is a common interface for different source code management solutions,
-// i.e., GitHub and GitLab.
+// i.e., GitHub, GitLab and Bitbucket.
 type SCM interface {
 	// Creates a new organization.
 	CreateOrganization(context.Context, *OrganizationOptions) (*pb.Organization, error)
@@ -25,8 +27,14 @@ type SCM interface {
 	GetRepositories(context.Context, *pb.Organization) ([]*Repository, error)
 	// Delete repository.
 	DeleteRepository(context.Context, *RepositoryOptions) error
+	// Archive repository, making it read-only.
+	ArchiveRepository(context.Context, *RepositoryOptions) error
 	// Add user as repository collaborator with provided permissions
 	UpdateRepoAccess(context.Context, *Repository, string, string) error
+	// RemoveRepoCollaborator removes the user as a collaborator of the repository.
+	RemoveRepoCollaborator(context.Context, *Repository, string) error
+	// GetRepositoryAccess returns the teams and direct collaborators with access to the repository.
+	GetRepositoryAccess(context.Context, *RepositoryOptions) (*RepositoryAccess, error)
 	// Returns true if there are no commits in the given repository
 	RepositoryIsEmpty(context.Context, *RepositoryOptions) bool
 	// List the webhooks associated with the provided repository or organization.
@@ -44,6 +52,8 @@ type SCM interface {
 	GetTeams(context.Context, *pb.Organization) ([]*Team, error)
 	// Add repo to team.
 	AddTeamRepo(context.Context, *AddTeamRepoOptions) error
+	// RemoveTeamRepo revokes the team's access to the repo.
+	RemoveTeamRepo(context.Context, *RemoveTeamRepoOptions) error
 	// AddTeamMember adds a member to a team.
 	AddTeamMember(context.Context, *TeamMembershipOptions) error
 	// RemoveTeamMember removes team member.
@@ -60,23 +70,77 @@ type SCM interface {
 	UpdateOrgMembership(context.Context, *OrgMembershipOptions) error
 	// RevokeOrgMembership removes user from the organization.
 	RemoveMember(context.Context, *OrgMembershipOptions) error
+	// GetOrgMembers returns the login names of all members of the organization.
+	GetOrgMembers(context.Context, *pb.Organization) ([]string, error)
+	// GetDiscussions returns the most recent discussions in the given repository's discussion category.
+	GetDiscussions(context.Context, *DiscussionOptions) ([]*Discussion, error)
+	// CreateCommitComment comments on a line of a file in the given commit, and returns the comment's URL.
+	CreateCommitComment(context.Context, *CommitCommentOptions) (string, error)
+	// CreateIssue creates an issue in the given repository, and returns the issue's URL.
+	CreateIssue(context.Context, *IssueOptions) (string, error)
+	// GetCommits returns the commits on a branch of the given repository, newest first, with the files they changed.
+	GetCommits(context.Context, *CommitOptions) ([]*Commit, error)
+	// CreateCommitStatus sets the status of the given commit, shown as a status check on pull requests.
+	CreateCommitStatus(context.Context, *CommitStatusOptions) error
 	// Lists all authorizations for authenticated user.
 	GetUserScopes(context.Context) *Authorization
 }
 
 // NewSCMClient returns a new provider client implementing the SCM interface.
 func NewSCMClient(logger *zap.SugaredLogger, provider, token string) (SCM, error) {
+	return NewSCMClientForURL(logger, provider, "", token)
+}
+
+// NewSCMClientForURL returns a new provider client implementing the SCM interface,
+// for the self-hosted gitea or bitbucket server with the given base URL.
+// If the base URL is empty, the server given by the environment is used.
+func NewSCMClientForURL(logger *zap.SugaredLogger, provider, baseURL, token string) (SCM, error) {
 	switch provider {
 	case "github":
 		return NewGithubSCMClient(logger, token), nil
 	case "gitlab":
 		return NewGitlabSCMClient(token), nil
+	case "bitbucket":
+		if baseURL == "" {
+			baseURL = os.Getenv("BITBUCKET_URL")
+		}
+		if baseURL == "" {
+			return nil, errors.New("BITBUCKET_URL must be set to use the bitbucket provider")
+		}
+		return NewBitbucketSCMClient(logger, baseURL, token), nil
+	case "gitea":
+		if baseURL == "" {
+			baseURL = GiteaURL()
+		}
+		return NewGiteaSCMClient(logger, baseURL, token), nil
 	case "fake":
 		return NewFakeSCMClient(), nil
 	}
 	return nil, errors.New("invalid provider: " + provider)
 }
 
+// APIURL returns the base URL of the given provider's API, used to check that the provider
+// is reachable. The fake provider has no API, and its URL is empty.
+func APIURL(provider string) (string, error) {
+	switch provider {
+	case "github":
+		return "https://api.github.com", nil
+	case "gitlab":
+		return "https://gitlab.com/api/v4", nil
+	case "bitbucket":
+		baseURL := os.Getenv("BITBUCKET_URL")
+		if baseURL == "" {
+			return "", errors.New("BITBUCKET_URL must be set to use the bitbucket provider")
+		}
+		return baseURL, nil
+	case "gitea":
+		return GiteaURL() + "/api/v1", nil
+	case "fake":
+		return "", nil
+	}
+	return "", errors.New("invalid provider: " + provider)
+}
+
 // OrganizationOptions contains information on how an organization should be
 // created.
 type OrganizationOptions struct {
@@ -107,6 +171,8 @@ type Repository struct {
 	HTTPURL string // HTTP(S) clone URL.
 	OrgID   uint64
 	Size    uint64
+	// Archived is true if the repository is archived (read-only).
+	Archived bool
 }
 
 // RepositoryOptions is used to fetch a single repository by ID or name.
@@ -132,6 +198,9 @@ type CreateRepositoryOptions struct {
 	Private      bool
 	Owner        string // The owner of an organization's repo is always the organization itself.
 	Permission   string // Default permission level for the given repo. Can be "read", "write", "admin", "none".
+	// Template is the repository whose contents are copied to the new repository, if any.
+	// The template is identified by its owner and path, or by its ID on GitLab.
+	Template *Repository
 }
 
 // CreateHookOptions contains information on how to create a webhook.
@@ -203,6 +272,29 @@ type AddTeamRepoOptions struct {
 	Permission     string // Permission level for team members. Can be "push", "pull", "admin".
 }
 
+// RepositoryAccess lists the teams and direct collaborators with access to a repository.
+type RepositoryAccess struct {
+	Teams         []*Permission
+	Collaborators []*Permission
+}
+
+// Permission is the permission level of a team or user on a repository.
+// Can be "pull", "push" or "admin".
+type Permission struct {
+	ID         uint64 // Team ID; only used for teams.
+	Name       string // Team name or user login name.
+	Permission string
+}
+
+// RemoveTeamRepoOptions contains information about the repo to be removed from a team.
+// All fields must be provided.
+type RemoveTeamRepoOptions struct {
+	OrganizationID uint64
+	TeamID         uint64
+	Repo           string
+	Owner          string // Name of the organization. Only used by GitHub.
+}
+
 // Team represents a git Team
 type Team struct {
 	ID           uint64
@@ -210,6 +302,86 @@ type Team struct {
 	Organization string
 }
 
+// DiscussionOptions is used to fetch discussions in a repository's discussion category.
+// Owner, Repository and Category must be provided.
+type DiscussionOptions struct {
+	Owner      string
+	Repository string
+	Category   string
+	Limit      int // Maximum number of discussions to fetch; defaults to 10.
+}
+
+// Discussion represents a discussion thread in a repository.
+type Discussion struct {
+	Title    string
+	URL      string
+	Author   string
+	Created  time.Time
+	Comments uint32
+	Answered bool
+}
+
+// CommitCommentOptions is used to comment on a commit.
+// Owner, Repository, CommitSHA and Body must be provided.
+type CommitCommentOptions struct {
+	Owner      string
+	Repository string
+	CommitSHA  string
+	Path       string // Path of the file to comment on; empty => the comment is on the whole commit.
+	Line       uint32 // Line number in the file; 0 => the comment is on the whole file.
+	Body       string
+}
+
+// IssueOptions is used to create an issue in a repository.
+// Owner, Repository and Title must be provided.
+type IssueOptions struct {
+	Owner      string
+	Repository string
+	Title      string
+	Body       string
+}
+
+// CommitOptions is used to list the commits of a repository.
+// Owner and Repository must be provided.
+type CommitOptions struct {
+	Owner      string
+	Repository string
+	Branch     string    // Branch to list the commits of; empty => the default branch.
+	Since      time.Time // Only commits after this time are listed; zero => all commits.
+}
+
+// Commit represents a commit in a repository.
+type Commit struct {
+	SHA     string
+	Message string
+	Author  string // Login name of the commit's author, if known.
+	Date    time.Time
+	Files   []string // Paths of the files added, modified or removed by the commit.
+}
+
+// CommitState is the state of a commit status.
+type CommitState string
+
+// Commit states.
+const (
+	CommitPending CommitState = "pending"
+	CommitSuccess CommitState = "success"
+	CommitFailure CommitState = "failure"
+	CommitError   CommitState = "error"
+)
+
+// CommitStatusOptions is used to set the status of a commit.
+// Owner, Repository, CommitSHA and State must be provided.
+type CommitStatusOptions struct {
+	Owner       string
+	Repository  string
+	CommitSHA   string
+	State       CommitState
+	Context     string // Label distinguishing the status from other statuses of the commit, e.g., "quickfeed/lab1".
+	Description string
+	TargetURL   string // URL of the status' details; empty => no link.
+}
+
 // Authorization stores information about user scopes
 type Authorization struct {
 	Scopes []string
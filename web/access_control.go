@@ -17,6 +17,12 @@ import (
 // ErrInvalidUserInfo is returned to user if user information in context is invalid.
 var ErrInvalidUserInfo = status.Errorf(codes.PermissionDenied, "authorization failed. please try to logout and sign in again")
 
+// ErrCourseArchived is returned to user when attempting to modify an archived course.
+var ErrCourseArchived = status.Errorf(codes.FailedPrecondition, "course is archived and cannot be modified")
+
+// ErrMissingIdentity is returned to user when the user has no remote identity for the course's provider.
+var ErrMissingIdentity = status.Errorf(codes.FailedPrecondition, "no account linked for the course's provider; sign in with the provider to link it")
+
 func (s *AutograderService) getCurrentUser(ctx context.Context) (*pb.User, error) {
 	// process user id from context
 	meta, ok := metadata.FromIncomingContext(ctx)
@@ -46,6 +52,13 @@ func (s *AutograderService) getSCM(ctx context.Context, user *pb.User, provider
 	if !providers.IsValidProvider(provider) {
 		return nil, fmt.Errorf("invalid provider(%s)", provider)
 	}
+	tenant, err := s.getTenant(user.GetTenantID())
+	if err != nil {
+		return nil, err
+	}
+	if tenant != nil && !tenant.HasProvider(provider) {
+		return nil, fmt.Errorf("%w: tenant %s uses %s", errTenantProvider, tenant.GetName(), tenant.GetProvider())
+	}
 
 	for _, remoteID := range user.RemoteIdentities {
 		if remoteID.Provider == provider {
@@ -56,7 +69,7 @@ func (s *AutograderService) getSCM(ctx context.Context, user *pb.User, provider
 			return scm, nil
 		}
 	}
-	return nil, errors.New("no SCM found")
+	return nil, fmt.Errorf("no SCM found: user %d has no %s identity", user.ID, provider)
 }
 
 // hasCourseAccess returns true if the given user has access to the given course,
@@ -74,7 +87,7 @@ func (s *AutograderService) hasCourseAccess(userID, courseID uint64, check func(
 // isEnrolled returns true if the given user is enrolled in the given course.
 func (s *AutograderService) isEnrolled(userID, courseID uint64) bool {
 	return s.hasCourseAccess(userID, courseID, func(e *pb.Enrollment) bool {
-		return e.Status == pb.Enrollment_STUDENT || e.Status == pb.Enrollment_TEACHER
+		return e.Status == pb.Enrollment_STUDENT || e.IsTeachingStaff()
 	})
 }
 
@@ -118,6 +131,37 @@ func (s *AutograderService) isValidSubmission(submissionID uint64) bool {
 	return s.isValidSubmissionRequest(request)
 }
 
+// isSubmissionAuthor returns true if the given submission belongs to the given user or the user's group.
+func (s *AutograderService) isSubmissionAuthor(usr *pb.User, submission *pb.Submission) bool {
+	if usr.IsOwner(submission.GetUserID()) {
+		return true
+	}
+	if submission.GetGroupID() == 0 {
+		return false
+	}
+	group, err := s.db.GetGroup(submission.GetGroupID())
+	return err == nil && group.Contains(usr)
+}
+
+// canAccessSubmission returns true if the given user is teacher or teaching assistant
+// of the given course, or an author of the submission enrolled in the course,
+// and the submission belongs to the course.
+func (s *AutograderService) canAccessSubmission(usr *pb.User, courseID uint64, submission *pb.Submission) bool {
+	assignment, err := s.db.GetAssignment(&pb.Assignment{ID: submission.GetAssignmentID()})
+	if err != nil || assignment.GetCourseID() != courseID {
+		return false
+	}
+	return s.hasCourseAccess(usr.GetID(), courseID, func(e *pb.Enrollment) bool {
+		switch e.Status {
+		case pb.Enrollment_TEACHER, pb.Enrollment_TA:
+			return true
+		case pb.Enrollment_STUDENT:
+			return s.isSubmissionAuthor(usr, submission)
+		}
+		return false
+	})
+}
+
 // isTeacher returns true if the given user is teacher for the given course.
 func (s *AutograderService) isTeacher(userID, courseID uint64) bool {
 	return s.hasCourseAccess(userID, courseID, func(e *pb.Enrollment) bool {
@@ -125,12 +169,52 @@ func (s *AutograderService) isTeacher(userID, courseID uint64) bool {
 	})
 }
 
+// isTeachingStaff returns true if the given user is teacher or teaching assistant for the given course.
+func (s *AutograderService) isTeachingStaff(userID, courseID uint64) bool {
+	return s.hasCourseAccess(userID, courseID, func(e *pb.Enrollment) bool {
+		return e.IsTeachingStaff()
+	})
+}
+
+// canReview returns true if the given user can review submissions for the given course.
+func (s *AutograderService) canReview(userID, courseID uint64) bool {
+	return s.hasCourseAccess(userID, courseID, func(e *pb.Enrollment) bool {
+		return e.CanReview()
+	})
+}
+
+// canApprove returns true if the given user can approve, reject and grade submissions for the given course.
+func (s *AutograderService) canApprove(userID, courseID uint64) bool {
+	return s.hasCourseAccess(userID, courseID, func(e *pb.Enrollment) bool {
+		return e.CanApprove()
+	})
+}
+
 // isCourseCreator returns true if the given user is course creator for the given course.
 func (s *AutograderService) isCourseCreator(courseID, userID uint64) bool {
 	course, _ := s.db.GetCourse(courseID, false)
 	return course.GetCourseCreatorID() == userID
 }
 
+// isArchived returns true if the given course is archived, and thus read-only.
+func (s *AutograderService) isArchived(courseID uint64) bool {
+	course, _ := s.db.GetCourse(courseID, false)
+	return course.GetArchived()
+}
+
+// hasCourseIdentity returns true if the given user has a remote identity for the provider of the given course.
+func (s *AutograderService) hasCourseIdentity(userID, courseID uint64) bool {
+	course, err := s.db.GetCourse(courseID, false)
+	if err != nil {
+		return false
+	}
+	user, err := s.db.GetUser(userID)
+	if err != nil {
+		return false
+	}
+	return user.GetRemoteIDFor(course.GetProvider()) != nil
+}
+
 // getUserAndSCM returns the current user and scm for the given provider.
 // All errors are logged, but only a single error is returned to the client.
 // This is a helper method to facilitate consistent treatment of errors and logging.
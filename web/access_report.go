@@ -0,0 +1,248 @@
+package web
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/scm"
+	"github.com/autograde/quickfeed/web/auth"
+	"github.com/labstack/echo/v4"
+)
+
+// accessReports holds the most recent access report for each course.
+type accessReports struct {
+	mu      sync.RWMutex
+	reports map[uint64]*pb.AccessReport
+}
+
+func newAccessReports() *accessReports {
+	return &accessReports{reports: make(map[uint64]*pb.AccessReport)}
+}
+
+func (a *accessReports) get(courseID uint64) (*pb.AccessReport, bool) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	report, ok := a.reports[courseID]
+	return report, ok
+}
+
+func (a *accessReports) set(report *pb.AccessReport) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.reports[report.GetCourseID()] = report
+}
+
+// expectedAccess holds the expected permissions of teams and users on a repository.
+type expectedAccess struct {
+	teams map[string]string
+	users map[string]string
+}
+
+// expectedRepoAccess returns the permissions that QuickFeed expects teams and users to have
+// on the given course repository: students have read access to the info and assignments
+// repositories, and write access to their own repositories, except outside the exam windows
+// in courses with exams. Nobody but the teachers should have access to the tests repository.
+func (s *AutograderService) expectedRepoAccess(repo *pb.Repository, students []string, exams []*pb.Assignment, now time.Time) (*expectedAccess, error) {
+	expected := &expectedAccess{teams: make(map[string]string), users: make(map[string]string)}
+	switch repo.GetRepoType() {
+	case pb.Repository_COURSEINFO, pb.Repository_ASSIGNMENTS:
+		expected.teams[scm.StudentsTeam] = scm.RepoPull
+		for _, student := range students {
+			expected.users[student] = scm.RepoPull
+		}
+	case pb.Repository_USER:
+		user, err := s.db.GetUser(repo.GetUserID())
+		if err != nil {
+			return nil, err
+		}
+		permission, err := s.examRepoPermission(exams, repo, now)
+		if err != nil {
+			return nil, err
+		}
+		expected.users[user.GetLogin()] = permission
+	case pb.Repository_GROUP:
+		group, err := s.db.GetGroup(repo.GetGroupID())
+		if err != nil {
+			return nil, err
+		}
+		permission, err := s.examRepoPermission(exams, repo, now)
+		if err != nil {
+			return nil, err
+		}
+		expected.teams[group.GetName()] = permission
+	}
+	return expected, nil
+}
+
+// buildAccessReport compares every team and collaborator permission on every repository
+// of the course with the expected permissions. The teachers team and the course teachers and
+// teaching assistants are expected to have access to all course repositories, with any permission.
+// The report is also stored for later retrieval.
+func (s *AutograderService) buildAccessReport(ctx context.Context, sc scm.SCM, course *pb.Course) (*pb.AccessReport, error) {
+	enrollments, err := s.db.GetEnrollmentsByCourse(course.GetID(), pb.Enrollment_STUDENT, pb.Enrollment_TEACHER, pb.Enrollment_TA)
+	if err != nil {
+		return nil, err
+	}
+	var students []string
+	teachers := make(map[string]bool)
+	for _, enrollment := range enrollments {
+		if enrollment.IsTeachingStaff() {
+			teachers[enrollment.GetUser().GetLogin()] = true
+		} else {
+			students = append(students, enrollment.GetUser().GetLogin())
+		}
+	}
+	repos, err := s.db.GetRepositories(&pb.Repository{OrganizationID: course.GetOrganizationID()})
+	if err != nil {
+		return nil, err
+	}
+
+	exams, err := s.courseExams(course.GetID())
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	report := &pb.AccessReport{
+		CourseID:  course.GetID(),
+		CheckedAt: now.Format(pb.TimeLayout),
+	}
+	for _, repo := range repos {
+		expected, err := s.expectedRepoAccess(repo, students, exams, now)
+		if err != nil {
+			return nil, err
+		}
+		access, err := sc.GetRepositoryAccess(ctx, &scm.RepositoryOptions{ID: repo.GetRepositoryID()})
+		if err != nil {
+			return nil, err
+		}
+		repoName := path.Base(repo.GetHTMLURL())
+		var entries []*pb.AccessEntry
+		for _, team := range access.Teams {
+			expectedPermission := expected.teams[team.Name]
+			if team.Name == scm.TeachersTeam {
+				expectedPermission = team.Permission
+			}
+			entries = append(entries, &pb.AccessEntry{
+				Repository:         repoName,
+				Team:               team.Name,
+				Permission:         team.Permission,
+				ExpectedPermission: expectedPermission,
+			})
+			delete(expected.teams, team.Name)
+		}
+		for _, user := range access.Collaborators {
+			expectedPermission := expected.users[user.Name]
+			if teachers[user.Name] {
+				expectedPermission = user.Permission
+			}
+			entries = append(entries, &pb.AccessEntry{
+				Repository:         repoName,
+				User:               user.Name,
+				Permission:         user.Permission,
+				ExpectedPermission: expectedPermission,
+			})
+			delete(expected.users, user.Name)
+		}
+		// teams and users expected to have access, but without access
+		for team, expectedPermission := range expected.teams {
+			entries = append(entries, &pb.AccessEntry{Repository: repoName, Team: team, ExpectedPermission: expectedPermission})
+		}
+		for user, expectedPermission := range expected.users {
+			entries = append(entries, &pb.AccessEntry{Repository: repoName, User: user, ExpectedPermission: expectedPermission})
+		}
+		report.Entries = append(report.Entries, entries...)
+	}
+	sort.SliceStable(report.Entries, func(i, j int) bool {
+		ei, ej := report.Entries[i], report.Entries[j]
+		if ei.GetRepository() != ej.GetRepository() {
+			return ei.GetRepository() < ej.GetRepository()
+		}
+		if ei.GetTeam() != ej.GetTeam() {
+			return ei.GetTeam() > ej.GetTeam() // teams before users
+		}
+		return ei.GetUser() < ej.GetUser()
+	})
+	s.accessReports.set(report)
+	return report, nil
+}
+
+// getAccessReport returns the most recent access report for the course.
+// If the course has not yet been checked, the course is checked immediately.
+func (s *AutograderService) getAccessReport(ctx context.Context, sc scm.SCM, courseID uint64) (*pb.AccessReport, error) {
+	if report, ok := s.accessReports.get(courseID); ok {
+		return report, nil
+	}
+	course, err := s.db.GetCourse(courseID, false)
+	if err != nil {
+		return nil, err
+	}
+	return s.buildAccessReport(ctx, sc, course)
+}
+
+// AccessReport returns a handler serving the course's most recent access report
+// as a CSV file, to be downloaded by teachers for access reviews.
+func (s *AutograderService) AccessReport() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		courseID, err := strconv.ParseUint(c.Param("course"), 10, 64)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, "invalid course ID")
+		}
+		user, ok := c.Get(auth.UserKey).(*pb.User)
+		if !ok || !s.isTeacher(user.GetID(), courseID) {
+			return echo.ErrUnauthorized
+		}
+		course, err := s.db.GetCourse(courseID, false)
+		if err != nil {
+			return echo.ErrNotFound
+		}
+		sc, err := s.getCourseSCM(course)
+		if err != nil {
+			s.logger.Errorf("AccessReport failed to get SCM for course %d: %v", courseID, err)
+			return echo.ErrInternalServerError
+		}
+		report, err := s.getAccessReport(c.Request().Context(), sc, courseID)
+		if err != nil {
+			s.logger.Errorf("AccessReport failed for course %d: %v", courseID, err)
+			return echo.ErrInternalServerError
+		}
+
+		c.Response().Header().Set(echo.HeaderContentType, "text/csv; charset=utf-8")
+		c.Response().Header().Set(echo.HeaderContentDisposition,
+			fmt.Sprintf("attachment; filename=%q", fmt.Sprintf("access-report-%s-%d.csv", course.GetCode(), course.GetYear())))
+		c.Response().WriteHeader(http.StatusOK)
+		return writeAccessReport(c.Response(), report)
+	}
+}
+
+// writeAccessReport writes the access report as CSV.
+func writeAccessReport(w io.Writer, report *pb.AccessReport) error {
+	out := csv.NewWriter(w)
+	if err := out.Write([]string{"repository", "team", "user", "permission", "expected permission", "state", "checked at"}); err != nil {
+		return err
+	}
+	for _, entry := range report.GetEntries() {
+		if err := out.Write([]string{
+			entry.GetRepository(),
+			entry.GetTeam(),
+			entry.GetUser(),
+			entry.GetPermission(),
+			entry.GetExpectedPermission(),
+			entry.State(),
+			report.GetCheckedAt(),
+		}); err != nil {
+			return err
+		}
+	}
+	out.Flush()
+	return out.Error()
+}
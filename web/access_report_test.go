@@ -0,0 +1,75 @@
+package web_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/ci"
+	"github.com/autograde/quickfeed/internal/qtest"
+	"github.com/autograde/quickfeed/scm"
+	"github.com/autograde/quickfeed/web"
+)
+
+func TestGetAccessReport(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	fakeGothProvider()
+	teacher := qtest.CreateUser(t, db, 1, &pb.User{Login: "teacher"})
+	ctx := withUserContext(context.Background(), teacher)
+	fakeProvider, scms := qtest.FakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	client := accessControlled(t, ags)
+	org, err := fakeProvider.CreateOrganization(ctx, &scm.OrganizationOptions{Path: "path", Name: "name"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	course, err := ags.CreateCourse(ctx, allCourses[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the student is enrolled without access to the course repositories
+	student := qtest.CreateUser(t, db, 2, &pb.User{Login: "student"})
+	qtest.EnrollStudent(t, db, student, course)
+	// a stranger has access to the tests repository
+	if err := fakeProvider.UpdateRepoAccess(ctx, &scm.Repository{Owner: org.Path, Path: pb.TestsRepo}, "stranger", scm.RepoPull); err != nil {
+		t.Fatal(err)
+	}
+
+	report, err := ags.GetAccessReport(ctx, &pb.CourseRequest{CourseID: course.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*pb.AccessEntry{
+		{Repository: pb.AssignmentRepo, Team: scm.StudentsTeam, Permission: scm.RepoPull, ExpectedPermission: scm.RepoPull},
+		{Repository: pb.AssignmentRepo, User: "student", ExpectedPermission: scm.RepoPull},
+		{Repository: pb.InfoRepo, Team: scm.StudentsTeam, Permission: scm.RepoPull, ExpectedPermission: scm.RepoPull},
+		{Repository: pb.InfoRepo, User: "student", ExpectedPermission: scm.RepoPull},
+		{Repository: pb.StudentRepoName("teacher"), User: "teacher", Permission: scm.RepoPush, ExpectedPermission: scm.RepoPush},
+		{Repository: pb.TestsRepo, User: "stranger", Permission: scm.RepoPull},
+	}
+	if diff := cmp.Diff(want, report.GetEntries(), protocmp.Transform()); diff != "" {
+		t.Errorf("GetAccessReport() mismatch (-want +got):\n%s", diff)
+	}
+	wantStates := []string{pb.AccessOK, pb.AccessMissing, pb.AccessOK, pb.AccessMissing, pb.AccessOK, pb.AccessUnexpected}
+	var gotStates []string
+	for _, entry := range report.GetEntries() {
+		gotStates = append(gotStates, entry.State())
+	}
+	if diff := cmp.Diff(wantStates, gotStates); diff != "" {
+		t.Errorf("AccessEntry.State() mismatch (-want +got):\n%s", diff)
+	}
+
+	studentCtx := withUserContext(context.Background(), student)
+	_, err = client.GetAccessReport(studentCtx, &pb.CourseRequest{CourseID: course.ID})
+	if status.Code(err) != codes.PermissionDenied {
+		t.Errorf("GetAccessReport() for student: got %v, want %v", err, codes.PermissionDenied)
+	}
+}
@@ -0,0 +1,60 @@
+package web
+
+import (
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/web/auth"
+)
+
+// createAccessToken creates a personal access token for the given user, valid for the requested courses.
+// The returned token is the only copy of the secret token; only its hash is stored.
+func (s *AutograderService) createAccessToken(usr *pb.User, request *pb.AccessTokenRequest) (*pb.NewAccessToken, error) {
+	secret, hash, err := auth.NewAccessToken()
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	token := &pb.AccessToken{
+		UserID:  usr.GetID(),
+		Name:    request.GetName(),
+		Hash:    hash,
+		Created: now.Format(pb.TimeLayout),
+	}
+	if days := request.GetExpiresInDays(); days > 0 {
+		token.Expires = now.AddDate(0, 0, int(days)).Format(pb.TimeLayout)
+	}
+	for _, courseID := range request.GetCourseIDs() {
+		if _, err := s.db.GetCourse(courseID, false); err != nil {
+			return nil, err
+		}
+		token.Courses = append(token.Courses, &pb.AccessTokenCourse{CourseID: courseID})
+	}
+	if err := s.db.CreateAccessToken(token); err != nil {
+		return nil, err
+	}
+	s.audit(usr, 0, "create access token", "name", token.GetName(), "tokenID", token.GetID(), "courses", request.GetCourseIDs())
+	token.Hash = ""
+	return &pb.NewAccessToken{AccessToken: token, Token: secret}, nil
+}
+
+// getAccessTokens returns the access tokens of the given user, without their hashes.
+func (s *AutograderService) getAccessTokens(usr *pb.User) (*pb.AccessTokens, error) {
+	tokens, err := s.db.GetAccessTokens(usr.GetID())
+	if err != nil {
+		return nil, err
+	}
+	for _, token := range tokens {
+		token.Hash = ""
+	}
+	return &pb.AccessTokens{AccessTokens: tokens}, nil
+}
+
+// revokeAccessToken deletes the given access token of the given user; the token cannot be used afterwards.
+func (s *AutograderService) revokeAccessToken(usr *pb.User, tokenID uint64) error {
+	if err := s.db.DeleteAccessToken(usr.GetID(), tokenID); err != nil {
+		return err
+	}
+	s.audit(usr, 0, "revoke access token", "tokenID", tokenID)
+	return nil
+}
@@ -0,0 +1,174 @@
+package web_test
+
+import (
+	"context"
+	"log"
+	"net"
+	"testing"
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/ci"
+	"github.com/autograde/quickfeed/internal/qtest"
+	"github.com/autograde/quickfeed/web"
+	"github.com/autograde/quickfeed/web/auth"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+func TestAccessTokens(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	admin := qtest.CreateFakeUser(t, db, 1)
+	course := &pb.Course{Code: "DAT101", OrganizationID: 1}
+	qtest.CreateCourse(t, db, admin, course)
+	otherCourse := &pb.Course{Code: "DAT102", OrganizationID: 2}
+	qtest.CreateCourse(t, db, admin, otherCourse)
+	teacher := qtest.CreateFakeUser(t, db, 2)
+	qtest.EnrollStudent(t, db, teacher, course)
+	if err := db.UpdateEnrollment(&pb.Enrollment{UserID: teacher.GetID(), CourseID: course.GetID(), Status: pb.Enrollment_TEACHER}); err != nil {
+		t.Fatal(err)
+	}
+	// a course the teacher teaches, but the token is not valid for
+	taughtCourse := &pb.Course{Code: "DAT103", OrganizationID: 3}
+	qtest.CreateCourse(t, db, admin, taughtCourse)
+	qtest.EnrollStudent(t, db, teacher, taughtCourse)
+	if err := db.UpdateEnrollment(&pb.Enrollment{UserID: teacher.GetID(), CourseID: taughtCourse.GetID(), Status: pb.Enrollment_TEACHER}); err != nil {
+		t.Fatal(err)
+	}
+	student := qtest.CreateFakeUser(t, db, 3)
+	qtest.EnrollStudent(t, db, student, taughtCourse)
+	otherGroup := &pb.Group{Name: "group", CourseID: taughtCourse.GetID(), Users: []*pb.User{student}}
+	if err := db.CreateGroup(otherGroup); err != nil {
+		t.Fatal(err)
+	}
+
+	_, scms := qtest.FakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	teacherCtx := withUserContext(context.Background(), teacher)
+
+	if _, err := ags.CreateAccessToken(teacherCtx, &pb.AccessTokenRequest{Name: "other", CourseIDs: []uint64{otherCourse.GetID()}}); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("CreateAccessToken() for course without enrollment: got %v, want %v", err, codes.PermissionDenied)
+	}
+	created, err := ags.CreateAccessToken(teacherCtx, &pb.AccessTokenRequest{Name: "grades", CourseIDs: []uint64{course.GetID()}, ExpiresInDays: 30})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if created.GetToken() == "" || created.GetAccessToken().GetHash() != "" || created.GetAccessToken().GetExpires() == "" {
+		t.Errorf("CreateAccessToken() = %v, want token with expiration time and without hash", created)
+	}
+
+	// an expired token of the teacher
+	expired := "qf_expired"
+	if err := db.CreateAccessToken(&pb.AccessToken{
+		UserID:  teacher.GetID(),
+		Name:    "expired",
+		Hash:    auth.HashAccessToken(expired),
+		Courses: []*pb.AccessTokenCourse{{CourseID: course.GetID()}},
+		Expires: time.Now().Add(-time.Hour).Format(pb.TimeLayout),
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	lis := bufconn.Listen(1024 * 1024)
+	s := grpc.NewServer(grpc.ChainUnaryInterceptor(auth.TokenVerifier(zap.NewNop().Sugar(), db), auth.UserVerifier(db), ags.AccessControl()))
+	pb.RegisterAutograderServiceServer(s, ags)
+	go func() {
+		if err := s.Serve(lis); err != nil {
+			log.Fatalf("Server exited with error: %v", err)
+		}
+	}()
+	defer s.Stop()
+
+	conn, err := grpc.DialContext(context.Background(), "bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) { return lis.Dial() }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	client := pb.NewAutograderServiceClient(conn)
+	withToken := func(token string) context.Context {
+		return metadata.NewOutgoingContext(context.Background(), metadata.Pairs(auth.Authorization, "Bearer "+token))
+	}
+	ctx := withToken(created.GetToken())
+
+	enrollments, err := client.GetEnrollmentsByCourse(ctx, &pb.EnrollmentRequest{CourseID: course.GetID()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(enrollments.GetEnrollments()) == 0 {
+		t.Errorf("GetEnrollmentsByCourse() for token course = %v, want enrollments", enrollments)
+	}
+
+	for _, tt := range []struct {
+		name string
+		call func() error
+		want codes.Code
+	}{
+		{name: "other course", want: codes.PermissionDenied, call: func() error {
+			_, err := client.GetEnrollmentsByCourse(ctx, &pb.EnrollmentRequest{CourseID: otherCourse.GetID()})
+			return err
+		}},
+		{name: "group of other course", want: codes.PermissionDenied, call: func() error {
+			_, err := client.GetGroup(ctx, &pb.GetGroupRequest{GroupID: otherGroup.GetID()})
+			return err
+		}},
+		{name: "request without course", want: codes.PermissionDenied, call: func() error {
+			_, err := client.GetUser(ctx, &pb.Void{})
+			return err
+		}},
+		{name: "update user", want: codes.PermissionDenied, call: func() error {
+			_, err := client.UpdateUser(ctx, &pb.User{ID: teacher.GetID(), Name: "Renamed"})
+			return err
+		}},
+		{name: "create access token", want: codes.PermissionDenied, call: func() error {
+			_, err := client.CreateAccessToken(ctx, &pb.AccessTokenRequest{Name: "more", CourseIDs: []uint64{course.GetID()}})
+			return err
+		}},
+		{name: "revoke access token", want: codes.PermissionDenied, call: func() error {
+			_, err := client.RevokeAccessToken(ctx, created.GetAccessToken())
+			return err
+		}},
+		{name: "invalid token", want: codes.Unauthenticated, call: func() error {
+			_, err := client.GetUser(withToken("qf_invalid"), &pb.Void{})
+			return err
+		}},
+		{name: "expired token", want: codes.Unauthenticated, call: func() error {
+			_, err := client.GetUser(withToken(expired), &pb.Void{})
+			return err
+		}},
+	} {
+		if err := tt.call(); status.Code(err) != tt.want {
+			t.Errorf("%s: got %v, want %v", tt.name, err, tt.want)
+		}
+	}
+
+	tokens, err := ags.GetAccessTokens(teacherCtx, &pb.Void{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tokens.GetAccessTokens()) != 2 {
+		t.Fatalf("GetAccessTokens() = %v, want 2 tokens", tokens.GetAccessTokens())
+	}
+	got := tokens.GetAccessTokens()[0]
+	if got.GetName() != "grades" || got.GetHash() != "" || got.GetLastUsed() == "" || !got.HasCourse(course.GetID()) {
+		t.Errorf("GetAccessTokens()[0] = %v, want used token for course %d without hash", got, course.GetID())
+	}
+
+	if _, err := ags.RevokeAccessToken(withUserContext(context.Background(), admin), got); status.Code(err) != codes.NotFound {
+		t.Errorf("RevokeAccessToken() of other user's token: got %v, want %v", err, codes.NotFound)
+	}
+	if _, err := ags.RevokeAccessToken(teacherCtx, got); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.GetUser(ctx, &pb.Void{}); status.Code(err) != codes.Unauthenticated {
+		t.Errorf("GetUser() with revoked token: got %v, want %v", err, codes.Unauthenticated)
+	}
+}
@@ -0,0 +1,92 @@
+package web
+
+import (
+	"context"
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/scm"
+)
+
+// tokenCheckTimeout limits the time spent checking the access token of each course.
+const tokenCheckTimeout = 10 * time.Second
+
+// getAdminOverview returns an overview of all courses with their enrollment counts, the time of
+// their last push event, whether their access tokens are valid, and their test jobs backlog.
+// Archived courses are included, but their access tokens are not checked.
+func (s *AutograderService) getAdminOverview(ctx context.Context) (*pb.AdminOverview, error) {
+	courses, err := s.db.GetCourses()
+	if err != nil {
+		return nil, err
+	}
+	jobs, err := s.db.GetTestJobs()
+	if err != nil {
+		return nil, err
+	}
+	overviews := make(map[uint64]*pb.CourseOverview)
+	overview := &pb.AdminOverview{CheckedAt: time.Now().Format(pb.TimeLayout)}
+	for _, course := range courses {
+		courseOverview, err := s.getCourseOverview(ctx, course)
+		if err != nil {
+			return nil, err
+		}
+		overviews[course.GetID()] = courseOverview
+		overview.Courses = append(overview.Courses, courseOverview)
+	}
+	for _, job := range jobs {
+		courseOverview, ok := overviews[job.GetCourseID()]
+		if !ok {
+			continue
+		}
+		switch job.GetStatus() {
+		case pb.TestJob_QUEUED:
+			courseOverview.QueuedJobs++
+		case pb.TestJob_RUNNING:
+			courseOverview.RunningJobs++
+		}
+	}
+	return overview, nil
+}
+
+// getCourseOverview returns the enrollment counts of the given course,
+// the time of its last push event, and whether its access token is valid.
+func (s *AutograderService) getCourseOverview(ctx context.Context, course *pb.Course) (*pb.CourseOverview, error) {
+	enrollments, err := s.db.GetEnrollmentsByCourse(course.GetID())
+	if err != nil {
+		return nil, err
+	}
+	courseOverview := &pb.CourseOverview{
+		Course:      course,
+		LastWebhook: course.GetLastWebhook(),
+	}
+	for _, enrollment := range enrollments {
+		switch enrollment.GetStatus() {
+		case pb.Enrollment_STUDENT:
+			courseOverview.Students++
+		case pb.Enrollment_TEACHER, pb.Enrollment_TA:
+			courseOverview.Teachers++
+		case pb.Enrollment_PENDING:
+			courseOverview.Pending++
+		}
+	}
+	if !course.GetArchived() {
+		if err := s.checkCourseToken(ctx, course); err != nil {
+			courseOverview.TokenError = err.Error()
+		} else {
+			courseOverview.TokenValid = true
+		}
+	}
+	return courseOverview, nil
+}
+
+// checkCourseToken returns an error if the course's access token cannot access the course organization.
+func (s *AutograderService) checkCourseToken(ctx context.Context, course *pb.Course) error {
+	sc, err := s.getCourseSCM(course)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(ctx, tokenCheckTimeout)
+	defer cancel()
+	_, err = sc.GetOrganization(ctx, &scm.GetOrgOptions{ID: course.GetOrganizationID()})
+	return err
+}
@@ -0,0 +1,87 @@
+package web_test
+
+import (
+	"context"
+	"testing"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/ci"
+	"github.com/autograde/quickfeed/internal/qtest"
+	"github.com/autograde/quickfeed/scm"
+	"github.com/autograde/quickfeed/web"
+)
+
+func TestGetAdminOverview(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	admin := qtest.CreateFakeUser(t, db, 1)
+	active := &pb.Course{Code: "DAT101", Provider: "fake", OrganizationID: 1, OrganizationPath: "DAT101"}
+	qtest.CreateCourse(t, db, admin, active)
+	// the organization of this course does not exist on the SCM provider
+	broken := &pb.Course{Code: "DAT102", Provider: "fake", OrganizationID: 2, OrganizationPath: "DAT102"}
+	qtest.CreateCourse(t, db, admin, broken)
+
+	for i := 2; i <= 3; i++ {
+		qtest.EnrollStudent(t, db, qtest.CreateFakeUser(t, db, uint64(i)), active)
+	}
+	student := qtest.CreateFakeUser(t, db, 4)
+	if err := db.CreateEnrollment(&pb.Enrollment{UserID: student.ID, CourseID: active.ID}); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.UpdateCourseLastWebhook(active.ID, "2021-09-01T10:00:00"); err != nil {
+		t.Fatal(err)
+	}
+	for _, job := range []*pb.TestJob{
+		{CourseID: active.ID, Status: pb.TestJob_QUEUED},
+		{CourseID: active.ID, Status: pb.TestJob_QUEUED},
+		{CourseID: active.ID, Status: pb.TestJob_RUNNING},
+	} {
+		if err := db.CreateTestJob(job); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	fakeProvider, scms := qtest.FakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	client := accessControlled(t, ags)
+	ctx := withUserContext(context.Background(), admin)
+	if _, err := fakeProvider.CreateOrganization(ctx, &scm.OrganizationOptions{Name: active.Code, Path: active.Code}); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := client.GetAdminOverview(withUserContext(context.Background(), student), &pb.Void{}); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("GetAdminOverview() by student: err = %v, want %v", err, codes.PermissionDenied)
+	}
+	overview, err := ags.GetAdminOverview(ctx, &pb.Void{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(overview.GetCourses()) != 2 {
+		t.Fatalf("GetAdminOverview() returned %d courses, want 2", len(overview.GetCourses()))
+	}
+	got := overview.GetCourses()[0]
+	if got.GetStudents() != 2 || got.GetTeachers() != 1 || got.GetPending() != 1 {
+		t.Errorf("GetAdminOverview() enrollments = %d students, %d teachers, %d pending, want 2, 1, 1", got.GetStudents(), got.GetTeachers(), got.GetPending())
+	}
+	if got.GetLastWebhook() != "2021-09-01T10:00:00" {
+		t.Errorf("GetAdminOverview() last webhook = %q, want %q", got.GetLastWebhook(), "2021-09-01T10:00:00")
+	}
+	if !got.GetTokenValid() {
+		t.Errorf("GetAdminOverview() token of %s is not valid: %s", got.GetCourse().GetCode(), got.GetTokenError())
+	}
+	if got.GetQueuedJobs() != 2 || got.GetRunningJobs() != 1 {
+		t.Errorf("GetAdminOverview() jobs = %d queued, %d running, want 2, 1", got.GetQueuedJobs(), got.GetRunningJobs())
+	}
+	got = overview.GetCourses()[1]
+	if got.GetTokenValid() || got.GetTokenError() == "" {
+		t.Errorf("GetAdminOverview() token of %s is valid, want token error", got.GetCourse().GetCode())
+	}
+	if got.GetLastWebhook() != "" || got.GetQueuedJobs() != 0 {
+		t.Errorf("GetAdminOverview() reports activity for %s, want none", got.GetCourse().GetCode())
+	}
+}
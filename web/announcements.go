@@ -0,0 +1,84 @@
+package web
+
+import (
+	"context"
+	"fmt"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/notify"
+)
+
+// getAnnouncements returns all announcements for the given course,
+// with read status for the given user.
+func (s *AutograderService) getAnnouncements(courseID, userID uint64) (*pb.Announcements, error) {
+	announcements, err := s.db.GetAnnouncements(courseID, userID)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.Announcements{Announcements: announcements}, nil
+}
+
+// createAnnouncement creates a new announcement authored by the given user
+// and notifies the course participants.
+func (s *AutograderService) createAnnouncement(ctx context.Context, author *pb.User, announcement *pb.Announcement) (*pb.Announcement, error) {
+	announcement.ID = 0
+	announcement.AuthorID = author.GetID()
+	announcement.Updated = ""
+	if err := s.db.CreateAnnouncement(announcement); err != nil {
+		return nil, err
+	}
+	if err := s.notifyCourse(ctx, announcement.GetCourseID(), announcement.GetTitle(), announcement.GetBody()); err != nil {
+		s.logger.Errorf("Failed to notify course %d about announcement %d: %v", announcement.GetCourseID(), announcement.GetID(), err)
+	}
+	return announcement, nil
+}
+
+// updateAnnouncement updates the title and body of an existing announcement
+// and notifies the course participants.
+func (s *AutograderService) updateAnnouncement(ctx context.Context, announcement *pb.Announcement) error {
+	existing, err := s.db.GetAnnouncement(announcement.GetID())
+	if err != nil {
+		return err
+	}
+	if existing.GetCourseID() != announcement.GetCourseID() {
+		return fmt.Errorf("announcement %d does not belong to course %d", announcement.GetID(), announcement.GetCourseID())
+	}
+	if err := s.db.UpdateAnnouncement(announcement); err != nil {
+		return err
+	}
+	if err := s.notifyCourse(ctx, announcement.GetCourseID(), "Updated: "+announcement.GetTitle(), announcement.GetBody()); err != nil {
+		s.logger.Errorf("Failed to notify course %d about announcement %d: %v", announcement.GetCourseID(), announcement.GetID(), err)
+	}
+	return nil
+}
+
+// markAnnouncementRead records that the given user has read the announcement.
+func (s *AutograderService) markAnnouncementRead(userID uint64, request *pb.AnnouncementRequest) error {
+	announcement, err := s.db.GetAnnouncement(request.GetAnnouncementID())
+	if err != nil {
+		return err
+	}
+	if announcement.GetCourseID() != request.GetCourseID() {
+		return fmt.Errorf("announcement %d does not belong to course %d", request.GetAnnouncementID(), request.GetCourseID())
+	}
+	return s.db.MarkAnnouncementRead(announcement.GetID(), userID)
+}
+
+// notifyCourse dispatches a notification to all students, teachers and teaching assistants of the given course.
+func (s *AutograderService) notifyCourse(ctx context.Context, courseID uint64, subject, body string) error {
+	enrollments, err := s.db.GetEnrollmentsByCourse(courseID, pb.Enrollment_STUDENT, pb.Enrollment_TEACHER, pb.Enrollment_TA)
+	if err != nil {
+		return err
+	}
+	userIDs := make([]uint64, 0, len(enrollments))
+	for _, enrollment := range enrollments {
+		userIDs = append(userIDs, enrollment.GetUserID())
+	}
+	s.notifier.Dispatch(ctx, &notify.Notification{
+		CourseID: courseID,
+		UserIDs:  userIDs,
+		Subject:  subject,
+		Body:     body,
+	})
+	return nil
+}
@@ -0,0 +1,106 @@
+package web_test
+
+import (
+	"context"
+	"testing"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/ci"
+	"github.com/autograde/quickfeed/internal/qtest"
+	"github.com/autograde/quickfeed/notify"
+	"github.com/autograde/quickfeed/web"
+)
+
+type testNotifier struct {
+	notifications []*notify.Notification
+}
+
+func (n *testNotifier) Notify(_ context.Context, notification *notify.Notification) error {
+	n.notifications = append(n.notifications, notification)
+	return nil
+}
+
+func TestAnnouncements(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	teacher := qtest.CreateFakeUser(t, db, 1)
+	course := &pb.Course{}
+	qtest.CreateCourse(t, db, teacher, course)
+	student := qtest.CreateFakeUser(t, db, 2)
+	qtest.EnrollStudent(t, db, student, course)
+
+	_, scms := qtest.FakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	client := accessControlled(t, ags)
+	notifier := &testNotifier{}
+	ags.RegisterNotifier(notifier)
+
+	teacherCtx := withUserContext(context.Background(), teacher)
+	studentCtx := withUserContext(context.Background(), student)
+
+	if _, err := client.CreateAnnouncement(studentCtx, &pb.Announcement{CourseID: course.ID, Title: "Not allowed"}); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("CreateAnnouncement() by student: got %v, want %v", err, codes.PermissionDenied)
+	}
+
+	announcement, err := ags.CreateAnnouncement(teacherCtx, &pb.Announcement{CourseID: course.ID, Title: "Lab 1 released", Body: "Good luck!"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if announcement.GetAuthorID() != teacher.ID {
+		t.Errorf("CreateAnnouncement() author = %d, want %d", announcement.GetAuthorID(), teacher.ID)
+	}
+	if len(notifier.notifications) != 1 {
+		t.Fatalf("expected 1 notification, got %d", len(notifier.notifications))
+	}
+	if got := len(notifier.notifications[0].UserIDs); got != 2 {
+		t.Errorf("expected notification to 2 users, got %d", got)
+	}
+
+	announcement.Body = "Deadline extended"
+	if _, err := ags.UpdateAnnouncement(teacherCtx, announcement); err != nil {
+		t.Fatal(err)
+	}
+
+	announcements, err := ags.GetAnnouncements(studentCtx, &pb.CourseRequest{CourseID: course.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(announcements.GetAnnouncements()) != 1 {
+		t.Fatalf("expected 1 announcement, got %d", len(announcements.GetAnnouncements()))
+	}
+	got := announcements.GetAnnouncements()[0]
+	if got.GetBody() != "Deadline extended" || got.GetUpdated() == "" {
+		t.Errorf("expected updated announcement, got %+v", got)
+	}
+	if got.GetRead() {
+		t.Error("expected announcement to be unread")
+	}
+
+	request := &pb.AnnouncementRequest{CourseID: course.ID, AnnouncementID: announcement.ID}
+	if _, err := ags.MarkAnnouncementRead(studentCtx, request); err != nil {
+		t.Fatal(err)
+	}
+	// marking an announcement read twice is not an error
+	if _, err := ags.MarkAnnouncementRead(studentCtx, request); err != nil {
+		t.Fatal(err)
+	}
+	announcements, err = ags.GetAnnouncements(studentCtx, &pb.CourseRequest{CourseID: course.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !announcements.GetAnnouncements()[0].GetRead() {
+		t.Error("expected announcement to be read by student")
+	}
+	announcements, err = ags.GetAnnouncements(teacherCtx, &pb.CourseRequest{CourseID: course.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if announcements.GetAnnouncements()[0].GetRead() {
+		t.Error("expected announcement to be unread by teacher")
+	}
+}
@@ -0,0 +1,149 @@
+package web
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/notify"
+)
+
+// appealedGradeReleased returns the time when the grade appealed by the given user was released.
+// For submission appeals, the submission must be released and belong to the user or the user's group.
+// For final grade appeals, the course's final grades must be released.
+func (s *AutograderService) appealedGradeReleased(usr *pb.User, course *pb.Course, submissionID uint64) (string, error) {
+	if submissionID == 0 {
+		if course.GetFinalGradesReleased() == "" {
+			return "", errors.New("final grades not released")
+		}
+		return course.GetFinalGradesReleased(), nil
+	}
+	submission, err := s.db.GetSubmission(&pb.Submission{ID: submissionID})
+	if err != nil {
+		return "", err
+	}
+	if _, err := s.getAssignmentInCourse(submission.GetAssignmentID(), course.GetID()); err != nil {
+		return "", err
+	}
+	if !s.isSubmissionAuthor(usr, submission) {
+		return "", fmt.Errorf("submission %d does not belong to user %d", submissionID, usr.GetID())
+	}
+	if !submission.GetReleased() || submission.GetReleasedDate() == "" {
+		return "", fmt.Errorf("submission %d not released", submissionID)
+	}
+	return submission.GetReleasedDate(), nil
+}
+
+// createAppeal opens a new appeal by the given user against a released grade,
+// if the course's appeal window has not passed, and notifies the course teachers.
+func (s *AutograderService) createAppeal(ctx context.Context, usr *pb.User, request *pb.Appeal) (*pb.Appeal, error) {
+	course, err := s.db.GetCourse(request.GetCourseID(), false)
+	if err != nil {
+		return nil, err
+	}
+	released, err := s.appealedGradeReleased(usr, course, request.GetSubmissionID())
+	if err != nil {
+		return nil, err
+	}
+	releasedTime, err := time.ParseInLocation(pb.TimeLayout, released, time.Local)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	if now.After(course.AppealDeadline(releasedTime)) {
+		return nil, fmt.Errorf("appeal window for grade released %s has passed", released)
+	}
+	previous, err := s.db.GetAppeals(&pb.Appeal{CourseID: course.GetID(), UserID: usr.GetID(), SubmissionID: request.GetSubmissionID()})
+	if err != nil {
+		return nil, err
+	}
+	for _, appeal := range previous {
+		if appeal.GetSubmissionID() == request.GetSubmissionID() && appeal.GetStatus() == pb.Appeal_OPEN {
+			return nil, fmt.Errorf("user %d already has an open appeal %d", usr.GetID(), appeal.GetID())
+		}
+	}
+
+	appeal := &pb.Appeal{
+		CourseID:         course.GetID(),
+		UserID:           usr.GetID(),
+		SubmissionID:     request.GetSubmissionID(),
+		Reason:           request.GetReason(),
+		Status:           pb.Appeal_OPEN,
+		Opened:           now.UTC().Format(pb.TimeLayout),
+		ResponseDeadline: course.ResponseDeadline(now).UTC().Format(pb.TimeLayout),
+	}
+	if err := s.db.CreateAppeal(appeal); err != nil {
+		return nil, err
+	}
+	teachers, err := s.db.GetEnrollmentsByCourse(course.GetID(), pb.Enrollment_TEACHER)
+	if err != nil {
+		s.logger.Errorf("Failed to notify teachers about appeal %d: %v", appeal.GetID(), err)
+		return appeal, nil
+	}
+	var teacherIDs []uint64
+	for _, teacher := range teachers {
+		teacherIDs = append(teacherIDs, teacher.GetUserID())
+	}
+	s.notifier.Dispatch(ctx, &notify.Notification{
+		CourseID: course.GetID(),
+		UserIDs:  teacherIDs,
+		Subject:  fmt.Sprintf("New grade appeal from %s, to be decided by %s", usr.GetName(), appeal.GetResponseDeadline()),
+		Body:     appeal.GetReason(),
+	})
+	return appeal, nil
+}
+
+// updateAppeal records the given teacher's decision of an appeal and notifies the appealing student.
+func (s *AutograderService) updateAppeal(ctx context.Context, usr *pb.User, update *pb.Appeal) error {
+	appeal, err := s.db.GetAppeal(update.GetID())
+	if err != nil {
+		return err
+	}
+	if appeal.GetCourseID() != update.GetCourseID() {
+		return fmt.Errorf("appeal %d does not belong to course %d", appeal.GetID(), update.GetCourseID())
+	}
+	if update.GetStatus() == pb.Appeal_OPEN {
+		return fmt.Errorf("appeal %d must be granted or rejected", appeal.GetID())
+	}
+	appeal.Status = update.GetStatus()
+	appeal.Rationale = update.GetRationale()
+	appeal.NewGrade = update.GetNewGrade()
+	appeal.DeciderID = usr.GetID()
+	appeal.Decided = time.Now().Format(pb.TimeLayout)
+	if err := s.db.UpdateAppeal(appeal); err != nil {
+		return err
+	}
+	s.notifier.Dispatch(ctx, &notify.Notification{
+		CourseID: appeal.GetCourseID(),
+		UserIDs:  []uint64{appeal.GetUserID()},
+		Subject:  fmt.Sprintf("Your grade appeal has been %s", appeal.GetStatus().String()),
+		Body:     appeal.GetRationale(),
+	})
+	return nil
+}
+
+// getAppeals returns the appeals for the given course. Teaching staff and admins get all
+// appeals for the course, while students only get their own appeals.
+func (s *AutograderService) getAppeals(usr *pb.User, request *pb.AppealRequest) (*pb.Appeals, error) {
+	query := &pb.Appeal{CourseID: request.GetCourseID()}
+	if !s.isCourseAdmin(usr, request.GetCourseID()) && !s.isTeachingStaff(usr.GetID(), request.GetCourseID()) {
+		query.UserID = usr.GetID()
+	}
+	appeals, err := s.db.GetAppeals(query)
+	if err != nil {
+		return nil, err
+	}
+	if !request.GetOverdue() {
+		return &pb.Appeals{Appeals: appeals}, nil
+	}
+	now := time.Now()
+	overdue := make([]*pb.Appeal, 0)
+	for _, appeal := range appeals {
+		if appeal.IsOverdue(now) {
+			overdue = append(overdue, appeal)
+		}
+	}
+	return &pb.Appeals{Appeals: overdue}, nil
+}
@@ -0,0 +1,152 @@
+package web_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/ci"
+	"github.com/autograde/quickfeed/internal/qtest"
+	"github.com/autograde/quickfeed/web"
+)
+
+func TestAppeals(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	teacher := qtest.CreateFakeUser(t, db, 1)
+	course := &pb.Course{}
+	qtest.CreateCourse(t, db, teacher, course)
+	student := qtest.CreateFakeUser(t, db, 2)
+	qtest.EnrollStudent(t, db, student, course)
+	other := qtest.CreateFakeUser(t, db, 3)
+	qtest.EnrollStudent(t, db, other, course)
+
+	assignment := &pb.Assignment{CourseID: course.ID, Name: "lab1", Order: 1}
+	if err := db.CreateAssignment(assignment); err != nil {
+		t.Fatal(err)
+	}
+	submission := &pb.Submission{AssignmentID: assignment.ID, UserID: student.ID, Score: 40}
+	if err := db.CreateSubmission(submission); err != nil {
+		t.Fatal(err)
+	}
+
+	_, scms := qtest.FakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	client := accessControlled(t, ags)
+	notifier := &testNotifier{}
+	ags.RegisterNotifier(notifier)
+	teacherCtx := withUserContext(context.Background(), teacher)
+	studentCtx := withUserContext(context.Background(), student)
+	otherCtx := withUserContext(context.Background(), other)
+
+	request := &pb.Appeal{CourseID: course.ID, SubmissionID: submission.ID, Reason: "Task 2 was solved"}
+	if _, err := ags.CreateAppeal(studentCtx, request); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("CreateAppeal() for unreleased submission: got %v, want %v", err, codes.FailedPrecondition)
+	}
+	if _, err := ags.UpdateSubmission(teacherCtx, &pb.UpdateSubmissionRequest{
+		SubmissionID: submission.ID,
+		CourseID:     course.ID,
+		Released:     true,
+		Status:       pb.Submission_REJECTED,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ags.CreateAppeal(otherCtx, request); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("CreateAppeal() for another student's submission: got %v, want %v", err, codes.FailedPrecondition)
+	}
+	if _, err := ags.CreateAppeal(teacherCtx, request); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("CreateAppeal() by teacher: got %v, want %v", err, codes.PermissionDenied)
+	}
+	appeal, err := ags.CreateAppeal(studentCtx, request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if appeal.GetStatus() != pb.Appeal_OPEN || appeal.GetResponseDeadline() == "" {
+		t.Errorf("CreateAppeal() = %v, want open appeal with response deadline", appeal)
+	}
+	// the student is notified about the released review, and the teacher about the appeal
+	if len(notifier.notifications) != 2 || notifier.notifications[0].UserIDs[0] != student.ID || notifier.notifications[1].UserIDs[0] != teacher.ID {
+		t.Errorf("expected notifications to student and teacher, got %v", notifier.notifications)
+	}
+	if _, err := ags.CreateAppeal(studentCtx, request); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("CreateAppeal() with open appeal: got %v, want %v", err, codes.FailedPrecondition)
+	}
+
+	// final grades cannot be appealed before they are released
+	finalGradeAppeal := &pb.Appeal{CourseID: course.ID, Reason: "Final grade"}
+	if _, err := ags.CreateAppeal(otherCtx, finalGradeAppeal); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("CreateAppeal() for unreleased final grade: got %v, want %v", err, codes.FailedPrecondition)
+	}
+	course.FinalGradesReleased = time.Now().Add(-30 * 24 * time.Hour).Format(pb.TimeLayout)
+	if err := db.UpdateCourse(course); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ags.CreateAppeal(otherCtx, finalGradeAppeal); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("CreateAppeal() after appeal window: got %v, want %v", err, codes.FailedPrecondition)
+	}
+	course.FinalGradesReleased = time.Now().Format(pb.TimeLayout)
+	if err := db.UpdateCourse(course); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ags.CreateAppeal(otherCtx, finalGradeAppeal); err != nil {
+		t.Fatal(err)
+	}
+
+	appeals, err := ags.GetAppeals(studentCtx, &pb.AppealRequest{CourseID: course.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(appeals.GetAppeals()) != 1 || appeals.GetAppeals()[0].GetID() != appeal.GetID() {
+		t.Errorf("GetAppeals() by student = %v, want only own appeal %d", appeals.GetAppeals(), appeal.GetID())
+	}
+	appeals, err = ags.GetAppeals(teacherCtx, &pb.AppealRequest{CourseID: course.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(appeals.GetAppeals()) != 2 {
+		t.Errorf("GetAppeals() by teacher: got %d appeals, want 2", len(appeals.GetAppeals()))
+	}
+
+	// an appeal past its response deadline is overdue
+	overdue := &pb.Appeal{
+		CourseID:         course.ID,
+		UserID:           other.ID,
+		SubmissionID:     submission.ID,
+		ResponseDeadline: time.Now().Add(-time.Hour).UTC().Format(pb.TimeLayout),
+	}
+	if err := db.CreateAppeal(overdue); err != nil {
+		t.Fatal(err)
+	}
+	appeals, err = ags.GetAppeals(teacherCtx, &pb.AppealRequest{CourseID: course.ID, Overdue: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(appeals.GetAppeals()) != 1 || appeals.GetAppeals()[0].GetID() != overdue.GetID() {
+		t.Errorf("GetAppeals(overdue) = %v, want only appeal %d", appeals.GetAppeals(), overdue.GetID())
+	}
+
+	decision := &pb.Appeal{ID: appeal.ID, CourseID: course.ID, Status: pb.Appeal_GRANTED, Rationale: "Task 2 was correct", NewGrade: "C"}
+	if _, err := client.UpdateAppeal(studentCtx, decision); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("UpdateAppeal() by student: got %v, want %v", err, codes.PermissionDenied)
+	}
+	if _, err := ags.UpdateAppeal(teacherCtx, decision); err != nil {
+		t.Fatal(err)
+	}
+	decided, err := db.GetAppeal(appeal.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if decided.GetStatus() != pb.Appeal_GRANTED || decided.GetDeciderID() != teacher.ID || decided.GetNewGrade() != "C" || decided.GetDecided() == "" {
+		t.Errorf("UpdateAppeal() stored %v, want granted appeal decided by teacher", decided)
+	}
+	last := notifier.notifications[len(notifier.notifications)-1]
+	if len(last.UserIDs) != 1 || last.UserIDs[0] != student.ID {
+		t.Errorf("expected decision notification to student, got %v", last)
+	}
+}
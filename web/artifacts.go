@@ -0,0 +1,91 @@
+package web
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"strconv"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/artifacts"
+	"github.com/autograde/quickfeed/web/auth"
+	"github.com/labstack/echo/v4"
+)
+
+// artifactURL returns the download URL of the named artifact of the given submission.
+func artifactURL(submissionID uint64, name string) string {
+	return fmt.Sprintf("/artifacts/%d/%s", submissionID, (&url.URL{Path: name}).EscapedPath())
+}
+
+// getArtifacts returns the artifacts of the given submission with their download URLs.
+func (s *AutograderService) getArtifacts(submissionID uint64) (*pb.Artifacts, error) {
+	stored, err := s.db.GetArtifacts(submissionID)
+	if err != nil {
+		return nil, err
+	}
+	for _, artifact := range stored {
+		artifact.URL = artifactURL(submissionID, artifact.GetName())
+	}
+	return &pb.Artifacts{Artifacts: stored}, nil
+}
+
+// DownloadArtifact returns a handler serving the named artifact of a submission as an attachment.
+// Artifacts are produced by student code, and are therefore never rendered by the browser.
+// Access policy: Teacher or TA of the submission's course, Owner of submission, or Member of group.
+func (s *AutograderService) DownloadArtifact() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		if s.artifactStore == nil {
+			return echo.ErrNotFound
+		}
+		submissionID, err := strconv.ParseUint(c.Param("submission"), 10, 64)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, "invalid submission ID")
+		}
+		name, err := url.PathUnescape(c.Param("*"))
+		if err != nil || !artifacts.ValidName(name) {
+			return echo.NewHTTPError(http.StatusBadRequest, "invalid artifact name")
+		}
+		user, ok := c.Get(auth.UserKey).(*pb.User)
+		if !ok {
+			return echo.ErrUnauthorized
+		}
+		submission, err := s.db.GetSubmission(&pb.Submission{ID: submissionID})
+		if err != nil {
+			return echo.ErrNotFound
+		}
+		assignment, err := s.db.GetAssignment(&pb.Assignment{ID: submission.GetAssignmentID()})
+		if err != nil || !s.canAccessSubmission(user, assignment.GetCourseID(), submission) {
+			return echo.ErrUnauthorized
+		}
+		stored, err := s.db.GetArtifacts(submissionID)
+		if err != nil {
+			s.logger.Errorf("DownloadArtifact failed to get artifacts of submission %d: %v", submissionID, err)
+			return echo.ErrInternalServerError
+		}
+		var artifact *pb.Artifact
+		for _, a := range stored {
+			if a.GetName() == name {
+				artifact = a
+			}
+		}
+		if artifact == nil {
+			return echo.ErrNotFound
+		}
+		content, err := s.artifactStore.Get(c.Request().Context(), artifact.GetKey())
+		if errors.Is(err, artifacts.ErrNotFound) {
+			return echo.ErrNotFound
+		}
+		if err != nil {
+			s.logger.Errorf("DownloadArtifact failed to get artifact %q of submission %d: %v", name, submissionID, err)
+			return echo.ErrInternalServerError
+		}
+		defer content.Close()
+
+		c.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", path.Base(name)))
+		c.Response().Header().Set(echo.HeaderXContentTypeOptions, "nosniff")
+		c.Response().Header().Set(echo.HeaderContentSecurityPolicy, "sandbox")
+		return c.Stream(http.StatusOK, artifact.GetContentType(), content)
+	}
+}
@@ -0,0 +1,145 @@
+package web_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/artifacts"
+	"github.com/autograde/quickfeed/ci"
+	"github.com/autograde/quickfeed/internal/qtest"
+	"github.com/autograde/quickfeed/web"
+	"github.com/autograde/quickfeed/web/auth"
+)
+
+func TestSubmissionArtifacts(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	teacher := qtest.CreateFakeUser(t, db, 1)
+	course := &pb.Course{Name: "Distributed Systems", Provider: "fake", OrganizationID: 1}
+	qtest.CreateCourse(t, db, teacher, course)
+	otherCourse := &pb.Course{Name: "Operating Systems", Provider: "fake", OrganizationID: 2}
+	qtest.CreateCourse(t, db, teacher, otherCourse)
+	student := qtest.CreateFakeUser(t, db, 2)
+	qtest.EnrollStudent(t, db, student, course)
+	other := qtest.CreateFakeUser(t, db, 3)
+	qtest.EnrollStudent(t, db, other, course)
+
+	assignment := &pb.Assignment{CourseID: course.ID, Name: "lab1", Order: 1}
+	if err := db.CreateAssignment(assignment); err != nil {
+		t.Fatal(err)
+	}
+	submission := &pb.Submission{AssignmentID: assignment.ID, UserID: student.ID}
+	if err := db.CreateSubmission(submission); err != nil {
+		t.Fatal(err)
+	}
+	store, err := artifacts.NewFileStore(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	for name, content := range map[string]string{"build.log": "ok", "coverage/index.html": "<html>"} {
+		if err := store.Put(ctx, artifacts.Key(submission.ID, name), []byte(content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := db.UpdateArtifacts(submission.ID, []*pb.Artifact{
+		{Name: "build.log", Size: 2, ContentType: "text/plain; charset=utf-8", Key: artifacts.Key(submission.ID, "build.log")},
+		{Name: "coverage/index.html", Size: 6, ContentType: "text/html; charset=utf-8", Key: artifacts.Key(submission.ID, "coverage/index.html")},
+		{Name: "missing.txt", Size: 1, ContentType: "text/plain; charset=utf-8", Key: artifacts.Key(submission.ID, "missing.txt")},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	_, scms := qtest.FakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	ags.SetArtifactStore(store)
+
+	// the submission's author and the teacher can list the artifacts
+	request := &pb.ArtifactRequest{CourseID: course.ID, SubmissionID: submission.ID}
+	for _, user := range []*pb.User{student, teacher} {
+		got, err := ags.GetSubmissionArtifacts(withUserContext(ctx, user), request)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(got.GetArtifacts()) != 3 {
+			t.Fatalf("GetSubmissionArtifacts() returned %d artifacts, want 3", len(got.GetArtifacts()))
+		}
+		if url, want := got.GetArtifacts()[1].GetURL(), fmt.Sprintf("/artifacts/%d/coverage/index.html", submission.ID); url != want {
+			t.Errorf("GetSubmissionArtifacts() URL = %q, want %q", url, want)
+		}
+	}
+	// other students, and requests naming another course, are denied
+	for _, tt := range []struct {
+		user    *pb.User
+		request *pb.ArtifactRequest
+	}{
+		{other, request},
+		{teacher, &pb.ArtifactRequest{CourseID: otherCourse.ID, SubmissionID: submission.ID}},
+		{teacher, &pb.ArtifactRequest{CourseID: course.ID, SubmissionID: submission.ID + 1}},
+	} {
+		_, err := ags.GetSubmissionArtifacts(withUserContext(ctx, tt.user), tt.request)
+		if status.Code(err) != codes.PermissionDenied {
+			t.Errorf("GetSubmissionArtifacts(%v) by %s: err = %v, want %v", tt.request, tt.user.GetLogin(), err, codes.PermissionDenied)
+		}
+	}
+
+	// serve requests as the user given by the request's user header
+	users := map[string]*pb.User{"teacher": teacher, "student": student, "other": other}
+	e := echo.New()
+	e.Use(func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if user, ok := users[c.Request().Header.Get("user")]; ok {
+				c.Set(auth.UserKey, user)
+			}
+			return next(c)
+		}
+	})
+	e.GET("/artifacts/:submission/*", ags.DownloadArtifact())
+	get := func(user, path string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		req.Header.Set("user", user)
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		return rec
+	}
+
+	path := fmt.Sprintf("/artifacts/%d/coverage/index.html", submission.ID)
+	for _, user := range []string{"student", "teacher"} {
+		rec := get(user, path)
+		if rec.Code != http.StatusOK || rec.Body.String() != "<html>" {
+			t.Errorf("GET %s by %s: status = %d, body = %q, want %d with artifact", path, user, rec.Code, rec.Body.String(), http.StatusOK)
+		}
+		if got, want := rec.Header().Get(echo.HeaderContentDisposition), `attachment; filename="index.html"`; got != want {
+			t.Errorf("GET %s by %s: Content-Disposition = %q, want %q", path, user, got, want)
+		}
+		if got := rec.Header().Get(echo.HeaderContentType); got != "text/html; charset=utf-8" {
+			t.Errorf("GET %s by %s: Content-Type = %q, want text/html", path, user, got)
+		}
+	}
+	for _, tt := range []struct {
+		user, path string
+		want       int
+	}{
+		{"", path, http.StatusUnauthorized},
+		{"other", path, http.StatusUnauthorized},
+		{"teacher", fmt.Sprintf("/artifacts/%d/unknown.txt", submission.ID), http.StatusNotFound},
+		{"teacher", fmt.Sprintf("/artifacts/%d/missing.txt", submission.ID), http.StatusNotFound},
+		{"teacher", fmt.Sprintf("/artifacts/%d/coverage/%%2E%%2E/%%2E%%2E/build.log", submission.ID), http.StatusBadRequest},
+		{"teacher", "/artifacts/abc/build.log", http.StatusBadRequest},
+		{"teacher", fmt.Sprintf("/artifacts/%d/build.log", submission.ID+1), http.StatusNotFound},
+	} {
+		if rec := get(tt.user, tt.path); rec.Code != tt.want {
+			t.Errorf("GET %s by %q: status = %d, want %d", tt.path, tt.user, rec.Code, tt.want)
+		}
+	}
+}
@@ -0,0 +1,55 @@
+package web_test
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/ci"
+	"github.com/autograde/quickfeed/internal/qtest"
+	"github.com/autograde/quickfeed/web"
+	"github.com/google/go-cmp/cmp"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func TestValidateAssignmentFile(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	teacher := qtest.CreateFakeUser(t, db, 1)
+	student := qtest.CreateFakeUser(t, db, 2)
+	course := &pb.Course{Provider: "fake", OrganizationID: 1}
+	qtest.CreateCourse(t, db, teacher, course)
+	qtest.EnrollStudent(t, db, student, course)
+	_, scms := qtest.FakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	client := accessControlled(t, ags)
+	ctx := context.Background()
+
+	file := &pb.AssignmentFile{CourseID: course.GetID(), Path: "lab1/README.md", Contents: "---\nassignmentid: 1\nautoapprove: sometimes\n---\n# Lab 1\n"}
+	if _, err := client.ValidateAssignmentFile(withUserContext(ctx, student), file); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("ValidateAssignmentFile() by student = %v, want code %v", err, codes.PermissionDenied)
+	}
+
+	got, err := ags.ValidateAssignmentFile(withUserContext(ctx, teacher), file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &pb.AssignmentFileErrors{Errors: []*pb.AssignmentFileError{
+		{File: "lab1/README.md", Field: "autoapprove", Reason: "must be true or false"},
+	}}
+	if diff := cmp.Diff(want, got, protocmp.Transform()); diff != "" {
+		t.Errorf("ValidateAssignmentFile() mismatch (-want +got):\n%s", diff)
+	}
+
+	file.Contents = "---\nassignmentid: 1\nautoapprove: true\n---\n# Lab 1\n"
+	if got, err = ags.ValidateAssignmentFile(withUserContext(ctx, teacher), file); err != nil {
+		t.Fatal(err)
+	}
+	if len(got.GetErrors()) != 0 {
+		t.Errorf("ValidateAssignmentFile() for valid file = %v, want no errors", got.GetErrors())
+	}
+}
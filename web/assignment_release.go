@@ -0,0 +1,85 @@
+package web
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/assignments"
+	"github.com/autograde/quickfeed/scm"
+)
+
+// releaseAssignments publishes the content of the course's assignments whose release time has passed
+// to the assignments repository and the students' repositories: the user repositories for individual
+// assignments, and the group repositories for group assignments. It returns the number of assignments
+// released. An assignment that fails to be published is retried at the next check.
+func (s *AutograderService) releaseAssignments(ctx context.Context, sc scm.SCM, course *pb.Course, now time.Time) (int, error) {
+	courseAssignments, err := s.db.GetAssignmentsByCourse(course.GetID(), false)
+	if err != nil {
+		return 0, err
+	}
+	released := 0
+	for _, assignment := range courseAssignments {
+		due, err := assignment.ReleaseDue(now)
+		if err != nil {
+			s.logger.Errorf("Invalid release time %q for assignment %s in course %s: %v", assignment.GetRelease(), assignment.GetName(), course.GetCode(), err)
+			continue
+		}
+		if !due {
+			continue
+		}
+		repoType := pb.Repository_USER
+		if assignment.GetIsGroupLab() {
+			repoType = pb.Repository_GROUP
+		}
+		repos, err := s.db.GetRepositories(&pb.Repository{OrganizationID: course.GetOrganizationID(), RepoType: repoType})
+		if err != nil {
+			return released, err
+		}
+		targets := []string{courseCloneURL(sc, course, course.AssignmentsRepoName())}
+		for _, repo := range repos {
+			targets = append(targets, courseCloneURL(sc, course, path.Base(repo.GetHTMLURL())))
+		}
+		if err := assignments.PublishAssignment(ctx, course, courseCloneURL(sc, course, course.TestsRepoName()), assignment, targets); err != nil {
+			s.logger.Errorf("Failed to release assignment %s in course %s: %v", assignment.GetName(), course.GetCode(), err)
+			continue
+		}
+		if err := s.db.UpdateAssignmentReleased(assignment.GetID()); err != nil {
+			return released, err
+		}
+		if err := s.db.CreateCourseEvent(&pb.CourseEvent{
+			CourseID:     course.GetID(),
+			AssignmentID: assignment.GetID(),
+			Type:         pb.CourseEvent_ASSIGNMENT_RELEASED,
+			Title:        fmt.Sprintf("Assignment %s released", assignment.GetName()),
+			Description:  fmt.Sprintf("Assignment %s has been released with deadline %s.", assignment.GetName(), assignment.LocalDeadlineIn(course.Location())),
+		}); err != nil {
+			s.logger.Errorf("Failed to record release of assignment %s in course %s: %v", assignment.GetName(), course.GetCode(), err)
+		}
+		s.audit(nil, course.GetID(), "release assignment", "assignment", assignment.GetName(), "repositories", len(targets))
+		released++
+	}
+	return released, nil
+}
+
+// courseCloneURL returns the URL for cloning and pushing to the given repository of the course,
+// using the access token of the given SCM client.
+func courseCloneURL(sc scm.SCM, course *pb.Course, repo string) string {
+	return sc.CreateCloneURL(&scm.URLPathOptions{
+		Organization: course.GetOrganizationPath(),
+		Repository:   repo,
+	})
+}
+
+// visibleAssignments returns the given assignments without those hidden until their release time.
+func visibleAssignments(all []*pb.Assignment) []*pb.Assignment {
+	visible := make([]*pb.Assignment, 0, len(all))
+	for _, assignment := range all {
+		if !assignment.IsHidden() {
+			visible = append(visible, assignment)
+		}
+	}
+	return visible
+}
@@ -0,0 +1,69 @@
+package web_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/ci"
+	"github.com/autograde/quickfeed/internal/qtest"
+	"github.com/autograde/quickfeed/web"
+	"go.uber.org/zap"
+)
+
+func TestHiddenAssignments(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	teacher := qtest.CreateFakeUser(t, db, 1)
+	student := qtest.CreateFakeUser(t, db, 2)
+	course := &pb.Course{OrganizationID: 1}
+	qtest.CreateCourse(t, db, teacher, course)
+	qtest.EnrollStudent(t, db, student, course)
+	_, scms := qtest.FakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+
+	lab2 := &pb.Assignment{CourseID: course.ID, Name: "lab2", Order: 2, Deadline: "2099-11-20T12:00:00", Release: "2099-11-10T08:00:00"}
+	for _, assignment := range []*pb.Assignment{
+		{CourseID: course.ID, Name: "lab1", Order: 1, Deadline: "2022-11-01T12:00:00"},
+		lab2,
+	} {
+		if err := db.CreateAssignment(assignment); err != nil {
+			t.Fatal(err)
+		}
+	}
+	names := func(user *pb.User) []string {
+		t.Helper()
+		assignments, err := ags.GetAssignments(withUserContext(context.Background(), user), &pb.CourseRequest{CourseID: course.ID})
+		if err != nil {
+			t.Fatal(err)
+		}
+		var names []string
+		for _, assignment := range assignments.GetAssignments() {
+			names = append(names, assignment.GetName())
+		}
+		return names
+	}
+	if got := names(teacher); len(got) != 2 {
+		t.Errorf("GetAssignments() for teacher = %v, want [lab1 lab2]", got)
+	}
+	if got := names(student); len(got) != 1 || got[0] != "lab1" {
+		t.Errorf("GetAssignments() for student = %v, want [lab1]", got)
+	}
+
+	// checking the course before the release time leaves the assignment hidden
+	canceledCtx, cancel := context.WithCancel(context.Background())
+	cancel()
+	ags.RunCourseChecks(canceledCtx, time.Hour)
+	if got := names(student); len(got) != 1 {
+		t.Errorf("GetAssignments() for student before release = %v, want [lab1]", got)
+	}
+
+	if err := db.UpdateAssignmentReleased(lab2.ID); err != nil {
+		t.Fatal(err)
+	}
+	if got := names(student); len(got) != 2 {
+		t.Errorf("GetAssignments() for student after release = %v, want [lab1 lab2]", got)
+	}
+}
@@ -6,23 +6,30 @@ import (
 
 	pb "github.com/autograde/quickfeed/ag"
 	"github.com/autograde/quickfeed/assignments"
+	"github.com/autograde/quickfeed/kit/score"
 )
 
 const reviewLayout = "02 Jan 15:04"
 
-// getAssignments lists the assignments for the provided course.
+// getAssignments lists the assignments for the provided course, with their deadlines
+// also given in the course's time zone.
 func (s *AutograderService) getAssignments(courseID uint64) (*pb.Assignments, error) {
-	allAssignments, err := s.db.GetAssignmentsByCourse(courseID, true)
+	key := fmt.Sprintf("assignments/%d", courseID)
+	if assignments, ok := s.cache.get(key); ok {
+		return assignments.(*pb.Assignments), nil
+	}
+	course, err := s.db.GetCourse(courseID, false)
 	if err != nil {
 		return nil, err
 	}
-	// Hack to ensure that assignments stored in database with wrong format
-	// is displayed correctly in the frontend. This should ideally be removed
-	// when the database no longer contains any incorrectly formatted dates.
-	for _, assignment := range allAssignments {
-		assignment.Deadline = assignments.FixDeadline(assignment.GetDeadline())
+	allAssignments, err := s.db.GetAssignmentsByCourse(courseID, true)
+	if err != nil {
+		return nil, err
 	}
-	return &pb.Assignments{Assignments: allAssignments}, nil
+	course.LocalizeDeadlines(allAssignments)
+	assignments := &pb.Assignments{Assignments: allAssignments}
+	s.cache.set(key, assignments, assignmentsTables)
+	return assignments, nil
 }
 
 // updateAssignments updates the assignments for the given course.
@@ -62,6 +69,40 @@ func (s *AutograderService) createCriterion(query *pb.GradingCriterion) (*pb.Gra
 	return query, nil
 }
 
+// releaseReviews makes the ready manual reviews of the given assignment visible to the submissions' authors.
+func (s *AutograderService) releaseReviews(usr *pb.User, request *pb.AssignmentRequest) error {
+	assignment, err := s.getAssignmentInCourse(request.GetAssignmentID(), request.GetCourseID())
+	if err != nil {
+		return err
+	}
+	if err := s.db.ReleaseReviews(assignment.GetID()); err != nil {
+		return err
+	}
+	s.audit(usr, request.GetCourseID(), "release reviews", "course", request.GetCourseID(), "assignment", assignment.GetName())
+	return nil
+}
+
+// hideUnreleasedReviews removes the reviews that have not yet been released from the given submissions.
+// Until one of its reviews is released, a reviewed submission's score is the score of its tests,
+// such that the review score cannot be derived from the submission's score or grade.
+// Grades must therefore be set after hiding the reviews.
+func hideUnreleasedReviews(submissions []*pb.Submission) {
+	for _, submission := range submissions {
+		reviewed := len(submission.GetReviews()) > 0
+		released := submission.GetReviews()[:0]
+		for _, review := range submission.GetReviews() {
+			if review.GetReleased() {
+				released = append(released, review)
+			}
+		}
+		submission.Reviews = released
+		if reviewed && len(released) == 0 {
+			submission.ReviewScore = 0
+			submission.Score = score.NewResults(submission.GetScores()...).Sum()
+		}
+	}
+}
+
 func (s *AutograderService) updateCriterion(query *pb.GradingCriterion) error {
 	return s.db.UpdateCriterion(query)
 }
@@ -84,6 +125,8 @@ func (s *AutograderService) createReview(review *pb.Review) (*pb.Review, error)
 			submission.ID, assignment.Name, assignment.Reviewers)
 	}
 	review.Edited = time.Now().Format(reviewLayout)
+	// reviews are hidden from the submission's authors until released by ReleaseReviews
+	review.Released = false
 	review.ComputeScore()
 
 	benchmarks, err := s.db.GetBenchmarks(&pb.Assignment{ID: submission.AssignmentID})
@@ -134,7 +177,9 @@ func (s *AutograderService) updateReview(review *pb.Review) (*pb.Review, error)
 	if submission.Released {
 		// Updated review will most probably have a new score. Update the submission score as well
 		// for submissions with released review.
-		submission.Score = review.Score
+		if err := s.setReviewScore(submission, review.Score); err != nil {
+			return nil, err
+		}
 		if err := s.db.UpdateSubmission(submission); err != nil {
 			return nil, err
 		}
@@ -142,6 +187,28 @@ func (s *AutograderService) updateReview(review *pb.Review) (*pb.Review, error)
 	return review, nil
 }
 
+// setReviewScore records the given manual review score on the submission, and sets the submission's score
+// from the review score combined with the submission's test score according to the assignment's test score weight.
+// The score of late submissions is penalized according to the assignment's late policy.
+func (s *AutograderService) setReviewScore(submission *pb.Submission, reviewScore uint32) error {
+	assignment, err := s.db.GetAssignment(&pb.Assignment{ID: submission.GetAssignmentID()})
+	if err != nil {
+		return err
+	}
+	submission.ReviewScore = reviewScore
+	combinedScore := reviewScore
+	if assignment.GradedManually() {
+		testScore := score.NewResults(submission.GetScores()...).Sum()
+		combinedScore = assignment.CombinedScore(testScore, reviewScore)
+	}
+	// late submissions are penalized from the student's or group's extended deadline, if any
+	if extension, err := s.db.GetExtension(assignment.GetID(), submission.GetUserID(), submission.GetGroupID()); err == nil {
+		assignment = assignment.WithExtension(extension)
+	}
+	submission.Score = assignment.ApplyLatePenalty(submission, combinedScore)
+	return nil
+}
+
 func (s *AutograderService) getAssignmentWithCourse(query *pb.Assignment, withCourseInfo bool) (*pb.Assignment, *pb.Course, error) {
 	assignment, err := s.db.GetAssignment(query)
 	if err != nil {
@@ -0,0 +1,111 @@
+package web
+
+import (
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/database"
+	"google.golang.org/protobuf/proto"
+)
+
+// audit records an action performed by the given user in the given course in the audit log.
+// The keysAndValues are additional details about the action.
+func (s *AutograderService) audit(usr *pb.User, courseID uint64, action string, keysAndValues ...interface{}) {
+	s.auditLog.Record(usr, courseID, action, nil, nil, keysAndValues...)
+}
+
+// auditChange records an action performed by the given user in the given course in the audit log,
+// along with the affected record before and after the action.
+func (s *AutograderService) auditChange(usr *pb.User, courseID uint64, action string, before, after proto.Message, keysAndValues ...interface{}) {
+	s.auditLog.Record(usr, courseID, action, before, after, keysAndValues...)
+}
+
+// getAuditLog returns the given page of the audit log entries matching the request.
+func (s *AutograderService) getAuditLog(request *pb.AuditLogRequest, page *database.Page) (*pb.AuditLog, error) {
+	entries, err := s.db.GetAuditEntries(&pb.AuditEntry{
+		CourseID: request.GetCourseID(),
+		UserID:   request.GetUserID(),
+		Action:   request.GetAction(),
+	}, page)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.AuditLog{Entries: entries, NextPageToken: nextPageToken(page)}, nil
+}
+
+// The record functions return the audited fields of a record, leaving out
+// preloaded associations; they return nil if there is no record.
+
+func courseRecord(course *pb.Course) *pb.Course {
+	if course == nil {
+		return nil
+	}
+	return &pb.Course{
+		ID:                  course.GetID(),
+		CourseCreatorID:     course.GetCourseCreatorID(),
+		Name:                course.GetName(),
+		Code:                course.GetCode(),
+		Year:                course.GetYear(),
+		Tag:                 course.GetTag(),
+		Provider:            course.GetProvider(),
+		OrganizationID:      course.GetOrganizationID(),
+		OrganizationPath:    course.GetOrganizationPath(),
+		SlipDays:            course.GetSlipDays(),
+		Dockerfile:          course.GetDockerfile(),
+		DropLowest:          course.GetDropLowest(),
+		FinalGradesReleased: course.GetFinalGradesReleased(),
+		AppealWindow:        course.GetAppealWindow(),
+		AppealResponseDays:  course.GetAppealResponseDays(),
+		Certificates:        course.GetCertificates(),
+		RunnerPool:          course.GetRunnerPool(),
+		Archived:            course.GetArchived(),
+		PendingSetup:        course.GetPendingSetup(),
+	}
+}
+
+func enrollmentRecord(enrollment *pb.Enrollment) *pb.Enrollment {
+	if enrollment == nil {
+		return nil
+	}
+	return &pb.Enrollment{
+		ID:       enrollment.GetID(),
+		CourseID: enrollment.GetCourseID(),
+		UserID:   enrollment.GetUserID(),
+		GroupID:  enrollment.GetGroupID(),
+		Status:   enrollment.GetStatus(),
+		State:    enrollment.GetState(),
+	}
+}
+
+func groupRecord(group *pb.Group) *pb.Group {
+	if group == nil {
+		return nil
+	}
+	record := &pb.Group{
+		ID:       group.GetID(),
+		Name:     group.GetName(),
+		CourseID: group.GetCourseID(),
+		TeamID:   group.GetTeamID(),
+		Status:   group.GetStatus(),
+	}
+	for _, user := range group.GetUsers() {
+		record.Users = append(record.Users, &pb.User{ID: user.GetID(), Login: user.GetLogin()})
+	}
+	return record
+}
+
+func submissionRecord(submission *pb.Submission) *pb.Submission {
+	if submission == nil {
+		return nil
+	}
+	return &pb.Submission{
+		ID:           submission.GetID(),
+		AssignmentID: submission.GetAssignmentID(),
+		UserID:       submission.GetUserID(),
+		GroupID:      submission.GetGroupID(),
+		Score:        submission.GetScore(),
+		Status:       submission.GetStatus(),
+		Released:     submission.GetReleased(),
+		ApprovedDate: submission.GetApprovedDate(),
+		ReleasedDate: submission.GetReleasedDate(),
+		ReviewScore:  submission.GetReviewScore(),
+	}
+}
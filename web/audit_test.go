@@ -0,0 +1,126 @@
+package web_test
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/ci"
+	"github.com/autograde/quickfeed/internal/qtest"
+	"github.com/autograde/quickfeed/web"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func TestGetAuditLog(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	admin := qtest.CreateFakeUser(t, db, 1)
+	course := &pb.Course{Code: "DAT101", OrganizationID: 1}
+	qtest.CreateCourse(t, db, admin, course)
+	otherTeacher := qtest.CreateFakeUser(t, db, 2)
+	otherCourse := &pb.Course{Code: "DAT102", OrganizationID: 2}
+	qtest.CreateCourse(t, db, admin, otherCourse)
+	qtest.EnrollStudent(t, db, otherTeacher, otherCourse)
+	if err := db.UpdateEnrollment(&pb.Enrollment{UserID: otherTeacher.GetID(), CourseID: otherCourse.GetID(), Status: pb.Enrollment_TEACHER}); err != nil {
+		t.Fatal(err)
+	}
+	student := qtest.CreateFakeUser(t, db, 3)
+	qtest.EnrollStudent(t, db, student, course)
+
+	lab := &pb.Assignment{CourseID: course.GetID(), Name: "lab1", Order: 1}
+	if err := db.CreateAssignment(lab); err != nil {
+		t.Fatal(err)
+	}
+	submission := &pb.Submission{AssignmentID: lab.GetID(), UserID: student.GetID(), Score: 80}
+	if err := db.CreateSubmission(submission); err != nil {
+		t.Fatal(err)
+	}
+
+	_, scms := qtest.FakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	adminCtx := withUserContext(context.Background(), admin)
+
+	if _, err := ags.UpdateSubmission(adminCtx, &pb.UpdateSubmissionRequest{
+		CourseID:     course.GetID(),
+		SubmissionID: submission.GetID(),
+		Status:       pb.Submission_APPROVED,
+		Released:     true,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ags.ExportResults(adminCtx, &pb.ResultsExportRequest{CourseID: course.GetID()}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ags.ExportResults(adminCtx, &pb.ResultsExportRequest{CourseID: otherCourse.GetID()}); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tt := range []struct {
+		name    string
+		user    *pb.User
+		request *pb.AuditLogRequest
+	}{
+		{name: "student", user: student, request: &pb.AuditLogRequest{CourseID: course.GetID()}},
+		{name: "teacher of other course", user: otherTeacher, request: &pb.AuditLogRequest{CourseID: course.GetID()}},
+		{name: "teacher without course", user: otherTeacher, request: &pb.AuditLogRequest{}},
+	} {
+		if _, err := ags.GetAuditLog(withUserContext(context.Background(), tt.user), tt.request); status.Code(err) != codes.PermissionDenied {
+			t.Errorf("GetAuditLog() by %s: got %v, want %v", tt.name, err, codes.PermissionDenied)
+		}
+	}
+
+	// the teacher of the other course only gets that course's entries
+	log, err := ags.GetAuditLog(withUserContext(context.Background(), otherTeacher), &pb.AuditLogRequest{CourseID: otherCourse.GetID()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(log.GetEntries()) != 1 || log.GetEntries()[0].GetAction() != "export results" {
+		t.Errorf("GetAuditLog() for other course = %v, want a single export results entry", log.GetEntries())
+	}
+
+	log, err = ags.GetAuditLog(adminCtx, &pb.AuditLogRequest{CourseID: course.GetID(), UserID: admin.GetID(), Action: "update submission"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(log.GetEntries()) != 1 {
+		t.Fatalf("GetAuditLog() = %v, want a single update submission entry", log.GetEntries())
+	}
+	entry := log.GetEntries()[0]
+	var before, after pb.Submission
+	if err := protojson.Unmarshal([]byte(entry.GetBefore()), &before); err != nil {
+		t.Fatal(err)
+	}
+	if err := protojson.Unmarshal([]byte(entry.GetAfter()), &after); err != nil {
+		t.Fatal(err)
+	}
+	if before.GetStatus() != pb.Submission_NONE || after.GetStatus() != pb.Submission_APPROVED || !after.GetReleased() {
+		t.Errorf("GetAuditLog() = entry before %v and after %v, want approved and released submission", &before, &after)
+	}
+	if entry.GetLogin() != admin.GetLogin() || entry.GetTimestamp() == "" {
+		t.Errorf("GetAuditLog() = %v, want entry by %s with timestamp", entry, admin.GetLogin())
+	}
+
+	// all entries, one page at a time
+	var actions []string
+	request := &pb.AuditLogRequest{PageSize: 2}
+	for {
+		page, err := ags.GetAuditLog(adminCtx, request)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, entry := range page.GetEntries() {
+			actions = append(actions, entry.GetAction())
+		}
+		if page.GetNextPageToken() == "" {
+			break
+		}
+		request.PageToken = page.GetNextPageToken()
+	}
+	if len(actions) != 3 || actions[0] != "update submission" {
+		t.Errorf("GetAuditLog() pages = %v, want 3 entries, oldest first", actions)
+	}
+}
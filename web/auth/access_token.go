@@ -0,0 +1,97 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/database"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// Authorization is the metadata key holding the access token of requests from scripts and
+	// command line tools, as in the HTTP header "Authorization: Bearer <token>".
+	Authorization = "authorization"
+	bearer        = "Bearer "
+	// accessTokenPrefix makes access tokens easy to recognize, e.g., by secret scanners.
+	accessTokenPrefix = "qf_"
+)
+
+var (
+	ErrInvalidAccessToken = status.Errorf(codes.Unauthenticated, "Request does not contain a valid access token.")
+	ErrAccessTokenCourse  = status.Errorf(codes.PermissionDenied, "Access token is only valid for requests for its courses.")
+)
+
+// accessTokenKey is the context key of the access token authenticating a request.
+type accessTokenKey struct{}
+
+// NewAccessToken returns a new random access token and the hash to store for it.
+func NewAccessToken() (token, hash string, err error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", "", err
+	}
+	token = accessTokenPrefix + base64.RawURLEncoding.EncodeToString(b)
+	return token, HashAccessToken(token), nil
+}
+
+// HashAccessToken returns the hash of the given access token.
+// Access tokens are random, so a salt is not needed.
+func HashAccessToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// AccessTokenFromContext returns the access token authenticating the request of the given context, if any.
+// Requests from browsers are authenticated with session cookies instead.
+func AccessTokenFromContext(ctx context.Context) (*pb.AccessToken, bool) {
+	token, ok := ctx.Value(accessTokenKey{}).(*pb.AccessToken)
+	return token, ok
+}
+
+// TokenVerifier returns a unary interceptor authenticating requests with personal access tokens.
+// Requests without an access token are passed on unchanged to be authenticated by UserVerifier,
+// which must follow this interceptor. The courses of the access token are enforced by the access control
+// of the service, which resolves the course of each request.
+func TokenVerifier(logger *zap.SugaredLogger, db database.Database) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		meta, ok := metadata.FromIncomingContext(ctx)
+		if !ok || len(meta.Get(Authorization)) == 0 {
+			return handler(ctx, req)
+		}
+		token, err := verifyAccessToken(db, meta.Get(Authorization)[0])
+		if err != nil {
+			logger.Debugf("Access token verification failed for %s: %v", info.FullMethod, err)
+			return nil, ErrInvalidAccessToken
+		}
+		if err := db.UpdateAccessTokenLastUsed(token.GetID(), time.Now().Format(pb.TimeLayout)); err != nil {
+			logger.Errorf("Failed to update last use of access token %d: %v", token.GetID(), err)
+		}
+		return handler(context.WithValue(ctx, accessTokenKey{}, token), req)
+	}
+}
+
+// verifyAccessToken returns the unexpired access token given in the authorization metadata value.
+func verifyAccessToken(db database.Database, authorization string) (*pb.AccessToken, error) {
+	if !strings.HasPrefix(authorization, bearer) {
+		return nil, ErrInvalidAccessToken
+	}
+	token, err := db.GetAccessToken(HashAccessToken(strings.TrimPrefix(authorization, bearer)))
+	if err != nil {
+		return nil, err
+	}
+	if token.IsExpired(time.Now()) {
+		return nil, ErrInvalidAccessToken
+	}
+	return token, nil
+}
@@ -66,10 +66,12 @@ func (us UserSession) String() string {
 	return fmt.Sprintf("UserSession{ID: %d, Providers: %v}", us.ID, providers)
 }
 
-// map from session cookies to user IDs.
+// map from application tokens to user IDs.
+// Browser sessions are stored in the database; see registerSession.
 var cookieStore = make(map[string]uint64)
 
 // Add adds cookie for userID, replacing userID's current cookie, if any.
+// It is used to authenticate applications, such as the helpbot, with a static token.
 func Add(cookie string, userID uint64) {
 	for currentCookie, id := range cookieStore {
 		if id == userID && currentCookie != cookie {
@@ -84,10 +86,11 @@ func Get(cookie string) uint64 {
 }
 
 // OAuth2Logout invalidates the session for the logged in user.
-func OAuth2Logout(logger *zap.SugaredLogger) echo.HandlerFunc {
+func OAuth2Logout(logger *zap.SugaredLogger, db database.Database) echo.HandlerFunc {
 	return func(c echo.Context) error {
 		r := c.Request()
 		w := c.Response()
+		endSession(logger, db, requestSessionToken(c))
 
 		sess, err := session.Get(SessionKey, c)
 		if err != nil {
@@ -149,7 +152,7 @@ func PreAuth(logger *zap.SugaredLogger, db database.Database) echo.MiddlewareFun
 				user, err := db.GetUser(us.ID)
 				if err != nil {
 					logger.Error(err.Error())
-					return OAuth2Logout(logger)(c)
+					return OAuth2Logout(logger, db)(c)
 				}
 				logger.Debugf("User: %v", user)
 			}
@@ -268,14 +271,14 @@ func OAuth2Callback(logger *zap.SugaredLogger, db database.Database, scms *Scms)
 			i, ok := sess.Values[UserKey]
 			if !ok {
 				logger.Debug("failed to get logged in user from session; logout")
-				return OAuth2Logout(logger)(c)
+				return OAuth2Logout(logger, db)(c)
 			}
 			// If type assertions fails, the recover middleware will catch the panic and log a stack trace.
 			us := i.(*UserSession)
 			logger.Debug(us)
 			// Associate user with remote identity.
 			if err := db.AssociateUserWithRemoteIdentity(
-				us.ID, provider, remoteID, externalUser.AccessToken,
+				us.ID, provider, remoteID, externalUser.NickName, externalUser.AccessToken,
 			); err != nil {
 				logger.Debugf("Associate failed: %d, %s, %d, %s", us.ID, provider, remoteID, externalUser.AccessToken)
 				logger.Error("failed to associate user with remote identity", zap.Error(err))
@@ -295,7 +298,7 @@ func OAuth2Callback(logger *zap.SugaredLogger, db database.Database, scms *Scms)
 				// handle
 				logger.Errorf("Failed to get user: %v", err)
 			} else {
-				if ok := updateScm(c, logger, scms, user); !ok {
+				if ok := updateScm(c, logger, db, scms, user); !ok {
 					logger.Debugf("Failed to update SCM for User: %v", user)
 				}
 			}
@@ -303,7 +306,8 @@ func OAuth2Callback(logger *zap.SugaredLogger, db database.Database, scms *Scms)
 			// Enable gRPC requests for session
 			if token := extractSessionCookie(w); len(token) > 0 {
 				logger.Debugf("extractSessionCookie: %v", token)
-				Add(token, us.ID)
+				endSession(logger, db, requestSessionToken(c))
+				registerSession(logger, db, c, token, us.ID)
 			} else {
 				logger.Debugf("no session cookie found in w: %v", w)
 			}
@@ -315,6 +319,7 @@ func OAuth2Callback(logger *zap.SugaredLogger, db database.Database, scms *Scms)
 			Provider:    provider,
 			RemoteID:    remoteID,
 			AccessToken: externalUser.AccessToken,
+			Login:       externalUser.NickName,
 		}
 		// Try to get user from database.
 		user, err := db.GetUserByRemoteIdentity(remote)
@@ -344,6 +349,11 @@ func OAuth2Callback(logger *zap.SugaredLogger, db database.Database, scms *Scms)
 				return err
 			}
 			logger.Debugf("New user created: %v, remote: %v", user, remote)
+			if enrolled, err := db.MatchPreEnrollments(user); err != nil {
+				logger.Error("failed to match pre-enrollments for user", zap.Error(err), zap.String("user", user.String()))
+			} else if enrolled > 0 {
+				logger.Debugf("New user %s enrolled in %d pre-enrolled courses", user.GetLogin(), enrolled)
+			}
 
 		default:
 			logger.Error("failed to fetch user for remote identity", zap.Error(err))
@@ -370,14 +380,14 @@ func OAuth2Callback(logger *zap.SugaredLogger, db database.Database, scms *Scms)
 		}
 		logger.Debugf("Session.Save: %v", sess)
 
-		if ok := updateScm(c, logger, scms, user); !ok {
+		if ok := updateScm(c, logger, db, scms, user); !ok {
 			logger.Debugf("Failed to update SCM for User: %v", user)
 		}
 
 		// Register session and associated user ID to enable gRPC requests for this session.
 		if token := extractSessionCookie(w); len(token) > 0 {
 			logger.Debugf("extractSessionCookie: %v", token)
-			Add(token, us.ID)
+			registerSession(logger, db, c, token, us.ID)
 		} else {
 			logger.Debugf("No session cookie found in w: %v", w)
 		}
@@ -413,6 +423,11 @@ func AccessControl(logger *zap.SugaredLogger, db database.Database, scms *Scms)
 			// If type assertion fails, the recover middleware will catch the panic and log a stack trace.
 			us := i.(*UserSession)
 			logger.Debug(us)
+			if sessionUser(db, requestSessionToken(c)) != us.ID {
+				// The session has been revoked, has expired, or belongs to another user.
+				logger.Debugf("No registered session for user %d", us.ID)
+				return next(c)
+			}
 			user, err := db.GetUser(us.ID)
 			if err != nil {
 				logger.Error(err.Error())
@@ -420,7 +435,7 @@ func AccessControl(logger *zap.SugaredLogger, db database.Database, scms *Scms)
 				// from the database, but a valid session still exists.
 				if err == gorm.ErrRecordNotFound {
 					logger.Error(err.Error())
-					return OAuth2Logout(logger)(c)
+					return OAuth2Logout(logger, db)(c)
 				}
 				logger.Error(echo.ErrUnauthorized.Error())
 				return next(c)
@@ -439,10 +454,20 @@ func AccessControl(logger *zap.SugaredLogger, db database.Database, scms *Scms)
 	}
 }
 
-func updateScm(ctx echo.Context, logger *zap.SugaredLogger, scms *Scms, user *pb.User) bool {
+// updateScm creates scm clients for the user's remote identities. The clients of users
+// belonging to a tenant use the server configured for the tenant's provider, if any.
+func updateScm(ctx echo.Context, logger *zap.SugaredLogger, db database.Database, scms *Scms, user *pb.User) bool {
+	var tenant *pb.Tenant
+	if user.GetTenantID() > 0 {
+		var err error
+		if tenant, err = db.GetTenant(user.GetTenantID()); err != nil {
+			logger.Errorf("Failed to get tenant %d: %v", user.GetTenantID(), err)
+		}
+	}
 	foundSCMProvider := false
 	for _, remoteID := range user.RemoteIdentities {
-		scm, err := scms.GetOrCreateSCMEntry(logger.Desugar(), remoteID.GetProvider(), remoteID.GetAccessToken())
+		baseURL := tenant.BaseURLFor(remoteID.GetProvider())
+		scm, err := scms.GetOrCreateSCMEntryForURL(logger.Desugar(), remoteID.GetProvider(), baseURL, remoteID.GetAccessToken())
 		if err != nil {
 			logger.Errorf("Unknown SCM provider: %v", err)
 			continue
@@ -494,13 +519,21 @@ var (
 	ErrContextMetadata      = status.Errorf(codes.Unauthenticated, "Could not obtain metadata from context")
 )
 
-func UserVerifier() grpc.UnaryServerInterceptor {
+// UserVerifier returns a unary interceptor authenticating requests with
+// application tokens or the session cookies of signed in users.
+func UserVerifier(db database.Database) grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
 		meta, ok := metadata.FromIncomingContext(ctx)
 		if !ok {
 			return nil, ErrContextMetadata
 		}
-		newMeta, err := userValidation(meta)
+		if token, ok := AccessTokenFromContext(ctx); ok {
+			// the request was authenticated with an access token by TokenVerifier
+			meta = meta.Copy()
+			meta.Set(UserKey, strconv.FormatUint(token.GetUserID(), 10))
+			return handler(metadata.NewIncomingContext(ctx, meta), req)
+		}
+		newMeta, err := userValidation(db, meta)
 		if err != nil {
 			return nil, err
 		}
@@ -511,11 +544,42 @@ func UserVerifier() grpc.UnaryServerInterceptor {
 	}
 }
 
+// StreamUserVerifier is the streaming counterpart of UserVerifier.
+func StreamUserVerifier(db database.Database) grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		meta, ok := metadata.FromIncomingContext(stream.Context())
+		if !ok {
+			return ErrContextMetadata
+		}
+		newMeta, err := userValidation(db, meta)
+		if err != nil {
+			return err
+		}
+		// create new context with user id instead of cookie for use internally
+		newCtx := metadata.NewIncomingContext(stream.Context(), newMeta)
+		return handler(srv, &userStream{ServerStream: stream, ctx: newCtx})
+	}
+}
+
+// userStream is a server stream with a context containing the user id.
+type userStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *userStream) Context() context.Context {
+	return s.ctx
+}
+
 // userValidation returns modified metadata containing a valid user.
 // An error is returned if the user is not authenticated.
-func userValidation(meta metadata.MD) (metadata.MD, error) {
+func userValidation(db database.Database, meta metadata.MD) (metadata.MD, error) {
 	for _, cookie := range meta.Get(Cookie) {
-		if user := Get(cookie); user > 0 {
+		user := Get(cookie)
+		if user == 0 {
+			user = sessionUser(db, sessionToken(cookie))
+		}
+		if user > 0 {
 			meta.Set(UserKey, strconv.FormatUint(user, 10))
 			return meta, nil
 		}
@@ -70,7 +70,10 @@ func TestOAuth2Logout(t *testing.T) {
 		t.Errorf("have %d sessions want %d", ns, 2)
 	}
 
-	authHandler := auth.OAuth2Logout(logger(t))
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	authHandler := auth.OAuth2Logout(logger(t), db)
 	withSession := session.Middleware(store)(authHandler)
 
 	if err := withSession(c); err != nil {
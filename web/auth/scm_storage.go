@@ -3,6 +3,7 @@ package auth
 import (
 	"sync"
 
+	"github.com/autograde/quickfeed/internal/fault"
 	"github.com/autograde/quickfeed/scm"
 	"go.uber.org/zap"
 )
@@ -11,6 +12,8 @@ import (
 type Scms struct {
 	scms map[string]scm.SCM
 	mu   sync.RWMutex
+	// faults, if set, injects faults into the calls of new scm clients.
+	faults *fault.Injector
 }
 
 // NewScms returns reference to new thread-safe map
@@ -18,6 +21,14 @@ func NewScms() *Scms {
 	return &Scms{scms: make(map[string]scm.SCM)}
 }
 
+// SetFaults injects the given faults into the calls of scm clients created after this call.
+// This is meant for exercising retry and rollback paths in testing only.
+func (s *Scms) SetFaults(injector *fault.Injector) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.faults = injector
+}
+
 // GetSCM returns an scm client for the given access token, if such token exists;
 // otherwise, nil and false is returned.
 func (s *Scms) GetSCM(accessToken string) (sc scm.SCM, ok bool) {
@@ -31,16 +42,26 @@ func (s *Scms) GetSCM(accessToken string) (sc scm.SCM, ok bool) {
 // (provider, access token) pair. If no scm client exists for the given
 // remote identity, one will be created and stored for later retrival.
 func (s *Scms) GetOrCreateSCMEntry(logger *zap.Logger, provider, accessToken string) (scm.SCM, error) {
+	return s.GetOrCreateSCMEntryForURL(logger, provider, "", accessToken)
+}
+
+// GetOrCreateSCMEntryForURL is like GetOrCreateSCMEntry, but creates the scm client
+// for the provider's server with the given base URL, e.g., a tenant's gitea server.
+// An access token is only valid on one server, and thus identifies the client.
+func (s *Scms) GetOrCreateSCMEntryForURL(logger *zap.Logger, provider, baseURL, accessToken string) (scm.SCM, error) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 	client, ok := s.scms[accessToken]
 	if ok {
 		return client, nil
 	}
-	client, err := scm.NewSCMClient(logger.Sugar(), provider, accessToken)
+	client, err := scm.NewSCMClientForURL(logger.Sugar(), provider, baseURL, accessToken)
 	if err != nil {
 		return nil, err
 	}
+	if s.faults != nil {
+		client = scm.NewFaultySCMClient(client, s.faults)
+	}
 	s.scms[accessToken] = client
 	return client, nil
 }
@@ -0,0 +1,91 @@
+package auth
+
+import (
+	"strings"
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/database"
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+)
+
+const (
+	// sessionMaxAge is the lifetime of a session, matching the max age of the session cookie.
+	sessionMaxAge = 30 * 24 * time.Hour
+	// lastSeenInterval limits how often the last seen time of a session is updated.
+	lastSeenInterval = time.Minute
+)
+
+// sessionToken returns the session cookie, on the form "session=<value>",
+// contained in the given cookie header, or the empty string if there is none.
+func sessionToken(cookies string) string {
+	for _, cookie := range strings.Split(cookies, ";") {
+		if cookie = strings.TrimSpace(cookie); strings.HasPrefix(cookie, SessionKey+"=") {
+			return cookie
+		}
+	}
+	return ""
+}
+
+// requestSessionToken returns the session cookie of the given request, if any.
+func requestSessionToken(c echo.Context) string {
+	cookie, err := c.Cookie(SessionKey)
+	if err != nil || cookie.Value == "" {
+		return ""
+	}
+	return SessionKey + "=" + cookie.Value
+}
+
+// registerSession stores a new session for the given user, identified by
+// the hash of the session cookie, together with the client's address and user agent.
+func registerSession(logger *zap.SugaredLogger, db database.Database, c echo.Context, token string, userID uint64) {
+	now := time.Now().Format(pb.TimeLayout)
+	session := &pb.Session{
+		UserID:    userID,
+		Hash:      HashAccessToken(token),
+		UserAgent: c.Request().UserAgent(),
+		IP:        c.RealIP(),
+		Created:   now,
+		LastSeen:  now,
+	}
+	if err := db.CreateSession(session); err != nil {
+		logger.Errorf("Failed to register session for user %d: %v", userID, err)
+	}
+}
+
+// sessionUser returns the ID of the user signed in with the given session cookie,
+// or zero if the cookie does not belong to a current session. Expired sessions are deleted.
+func sessionUser(db database.Database, token string) uint64 {
+	if token == "" {
+		return 0
+	}
+	session, err := db.GetSession(HashAccessToken(token))
+	if err != nil {
+		return 0
+	}
+	now := time.Now()
+	created, err := time.Parse(pb.TimeLayout, session.GetCreated())
+	if err != nil || now.Sub(created) > sessionMaxAge {
+		_ = db.DeleteSession(session.GetUserID(), session.GetID())
+		return 0
+	}
+	if lastSeen, err := time.Parse(pb.TimeLayout, session.GetLastSeen()); err != nil || now.Sub(lastSeen) >= lastSeenInterval {
+		_ = db.UpdateSessionLastSeen(session.GetID(), now.Format(pb.TimeLayout))
+	}
+	return session.GetUserID()
+}
+
+// endSession deletes the session with the given session cookie, if any.
+func endSession(logger *zap.SugaredLogger, db database.Database, token string) {
+	if token == "" {
+		return
+	}
+	session, err := db.GetSession(HashAccessToken(token))
+	if err != nil {
+		return
+	}
+	if err := db.DeleteSession(session.GetUserID(), session.GetID()); err != nil {
+		logger.Errorf("Failed to end session %d: %v", session.GetID(), err)
+	}
+}
@@ -0,0 +1,387 @@
+package web
+
+import (
+	"context"
+	"strings"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/web/auth"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// access is a set of roles; a user with any of the roles may invoke an RPC.
+type access uint
+
+const (
+	// accessStudent is granted to students of the request's course.
+	accessStudent access = 1 << iota
+	// accessTA is granted to teaching assistants of the request's course.
+	accessTA
+	// accessTeacher is granted to teachers of the request's course.
+	accessTeacher
+	// accessAdmin is granted to admins, whether they are enrolled in the request's course or not.
+	// Admins of a tenant are only granted access to requests for the tenant's courses and users.
+	accessAdmin
+	// accessGlobalAdmin is granted to admins that do not belong to a tenant, who administer the server.
+	accessGlobalAdmin
+	// accessOwner is granted to users owning all the users, groups and submissions of the request.
+	accessOwner
+	// accessAnyUser is granted to all signed in users; the RPC performs its own access control.
+	accessAnyUser
+
+	// accessStaff is granted to the teaching staff of the request's course.
+	accessStaff = accessTA | accessTeacher
+	// accessEnrolled is granted to students and teaching staff of the request's course.
+	accessEnrolled = accessStudent | accessStaff
+)
+
+// accessRules maps each RPC to the roles allowed to invoke it. RPCs without
+// a rule are denied. The rules are enforced before the RPC is invoked, while
+// the RPCs may restrict access further, e.g., to authors of questions or to
+// teaching assistants with permission to approve submissions.
+var accessRules = map[string]access{
+	"GetUser":                   accessAnyUser,
+	"GetUsers":                  accessAdmin,
+	"GetUserByCourse":           accessAnyUser,
+	"UpdateUser":                accessAnyUser,
+	"ExportUserData":            accessAdmin | accessOwner,
+	"DeleteUserData":            accessAdmin,
+	"IsAuthorizedTeacher":       accessAnyUser,
+	"GetRemoteIdentities":       accessAnyUser,
+	"UnlinkRemoteIdentity":      accessAnyUser,
+	"GetTenants":                accessGlobalAdmin,
+	"CreateTenant":              accessGlobalAdmin,
+	"UpdateTenant":              accessGlobalAdmin,
+	"SetUserTenant":             accessGlobalAdmin,
+	"GetGroup":                  accessStaff | accessOwner,
+	"GetGroupByUserAndCourse":   accessStaff | accessOwner,
+	"GetGroupsByCourse":         accessStaff,
+	"CreateGroup":               accessEnrolled,
+	"UpdateGroup":               accessTeacher,
+	"DeleteGroup":               accessTeacher,
+	"RestoreGroup":              accessAdmin,
+	"InviteToGroup":             accessStudent,
+	"RespondToGroupInvitation":  accessStudent,
+	"GetGroupInvitations":       accessEnrolled,
+	"GenerateGroups":            accessTeacher,
+	"GetCourse":                 accessAnyUser,
+	"GetCourses":                accessAnyUser,
+	"GetCoursesByUser":          accessAnyUser,
+	"CreateCourse":              accessAdmin,
+	"UpdateCourse":              accessTeacher,
+	"UpdateCourseVisibility":    accessOwner,
+	"ArchiveCourse":             accessTeacher,
+	"DeleteCourse":              accessTeacher,
+	"RepairCourse":              accessTeacher,
+	"CloneCourse":               accessAdmin,
+	"PreviewCourseCreation":     accessAdmin,
+	"GetGradingScale":           accessEnrolled,
+	"UpdateGradingScale":        accessTeacher,
+	"GetCourseCompletion":       accessStaff,
+	"ComputeFinalGrades":        accessTeacher,
+	"GetScoreCurve":             accessTeacher,
+	"ApplyScoreCurve":           accessTeacher,
+	"ExportResults":             accessTeacher,
+	"GetCertificate":            accessStudent,
+	"GetAssignments":            accessAnyUser,
+	"UpdateAssignments":         accessTeacher,
+	"ValidateAssignmentFile":    accessTeacher,
+	"ValidateAssignments":       accessTeacher,
+	"GetEnrollmentsByUser":      accessAdmin | accessOwner,
+	"GetUserProgress":           accessAnyUser,
+	"GetSlipDays":               accessStaff | accessOwner,
+	"GetEnrollmentsByCourse":    accessEnrolled,
+	"CreateEnrollment":          accessAnyUser,
+	"UpdateEnrollment":          accessTeacher,
+	"UpdateEnrollments":         accessTeacher,
+	"RestoreEnrollment":         accessAdmin,
+	"ImportEnrollments":         accessTeacher,
+	"GetSubmissions":            accessStaff | accessOwner,
+	"SubmissionStream":          accessAnyUser,
+	"GetSubmissionsByCourse":    accessAdmin | accessStaff,
+	"GetCourseResults":          accessStaff,
+	"GetCourseStatistics":       accessStaff,
+	"GetTestFailureStats":       accessStaff,
+	"GetSubmissionStatus":       accessStaff | accessOwner,
+	"GetSubmissionArtifacts":    accessStaff | accessOwner,
+	"GetTestResults":            accessStaff | accessOwner,
+	"GetTaskScores":             accessStaff | accessOwner,
+	"UpdateSubmission":          accessStaff,
+	"TransitionSubmission":      accessStaff,
+	"GetSubmissionTransitions":  accessStaff,
+	"UpdateSubmissions":         accessTeacher,
+	"RebuildSubmission":         accessStaff | accessOwner,
+	"RebuildSubmissions":        accessTeacher,
+	"ProcessMissedPushes":       accessTeacher,
+	"ReplaySubmissions":         accessAdmin,
+	"GetForcePushes":            accessStaff,
+	"CreateAppeal":              accessStudent,
+	"UpdateAppeal":              accessTeacher,
+	"GetAppeals":                accessAdmin | accessEnrolled,
+	"GrantExtension":            accessTeacher,
+	"ListExtensions":            accessEnrolled,
+	"RunPlagiarismCheck":        accessTeacher,
+	"GetPlagiarismResults":      accessTeacher,
+	"CreateBenchmark":           accessTeacher,
+	"UpdateBenchmark":           accessTeacher,
+	"DeleteBenchmark":           accessTeacher,
+	"CreateCriterion":           accessTeacher,
+	"UpdateCriterion":           accessTeacher,
+	"DeleteCriterion":           accessTeacher,
+	"CreateReview":              accessStaff,
+	"UpdateReview":              accessStaff,
+	"GetReviewers":              accessStaff,
+	"AssignPeerReviews":         accessTeacher,
+	"GetPeerReviewTasks":        accessStudent,
+	"SubmitPeerReview":          accessStudent,
+	"GetPeerReviews":            accessEnrolled,
+	"ModeratePeerReview":        accessTeacher,
+	"ReleasePeerReviews":        accessTeacher,
+	"ReleaseReviews":            accessTeacher,
+	"CreateSubmissionComment":   accessEnrolled,
+	"UpdateSubmissionComment":   accessEnrolled,
+	"DeleteSubmissionComment":   accessEnrolled,
+	"GetSubmissionComments":     accessEnrolled,
+	"GetQuestions":              accessEnrolled,
+	"CreateQuestion":            accessEnrolled,
+	"UpdateQuestion":            accessEnrolled,
+	"DeleteQuestion":            accessEnrolled,
+	"CreateAnswer":              accessEnrolled,
+	"UpdateAnswer":              accessEnrolled,
+	"DeleteAnswer":              accessEnrolled,
+	"GetAssignmentDiscussions":  accessEnrolled,
+	"GetAnnouncements":          accessEnrolled,
+	"CreateAnnouncement":        accessTeacher,
+	"UpdateAnnouncement":        accessTeacher,
+	"MarkAnnouncementRead":      accessEnrolled,
+	"GetCourseFeedURL":          accessEnrolled,
+	"GetNotifications":          accessAnyUser,
+	"MarkNotificationRead":      accessAnyUser,
+	"GetProviders":              accessAnyUser,
+	"GetOrganization":           accessAdmin,
+	"GetRepositories":           accessAnyUser,
+	"IsEmptyRepo":               accessTeacher,
+	"GetOrphanedResources":      accessAdmin,
+	"AuditTestsExposure":        accessTeacher,
+	"GetAccessReport":           accessTeacher,
+	"SetCourseRepositoryAccess": accessTeacher,
+	"GetAdminOverview":          accessGlobalAdmin,
+	"GetTestJobs":               accessGlobalAdmin,
+	"CancelTestJob":             accessGlobalAdmin,
+	"GetWebhookDeliveries":      accessGlobalAdmin,
+	"ReplayWebhookDelivery":     accessGlobalAdmin,
+	"GetAuditLog":               accessAdmin | accessTeacher,
+	"CreateAccessToken":         accessAnyUser,
+	"GetAccessTokens":           accessAnyUser,
+	"RevokeAccessToken":         accessAnyUser,
+	"GetSessions":               accessAnyUser,
+	"RevokeSession":             accessAnyUser,
+	"LogoutUser":                accessAdmin,
+	"GetPendingActions":         accessAdmin,
+	"ConfirmPendingAction":      accessAdmin,
+	"CancelPendingAction":       accessAnyUser,
+}
+
+// ErrAccessDenied is returned to user when the user's roles do not permit the request.
+var ErrAccessDenied = status.Errorf(codes.PermissionDenied, "access denied")
+
+// AccessControl returns a unary interceptor enforcing the access rules of the RPCs.
+// The request's course is given by its course ID, or by the submission, group,
+// assignment or benchmark it refers to; requests referring to several courses are denied.
+// Requests authenticated with an access token are only allowed for the token's courses;
+// requests without a course are denied, since the token is not valid for them.
+// The interceptor must follow UserVerifier, which adds the current user to the context.
+func (s *AutograderService) AccessControl() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		method := info.FullMethod[strings.LastIndex(info.FullMethod, "/")+1:]
+		rule, ok := accessRules[method]
+		if !ok {
+			s.logger.Errorf("%s denied: no access rule", method)
+			return nil, status.Errorf(codes.PermissionDenied, "access denied: no access rule for %s", method)
+		}
+		if token, ok := auth.AccessTokenFromContext(ctx); ok {
+			if courseID, ok := s.requestCourseID(req); !ok || courseID == 0 || !token.HasCourse(courseID) {
+				s.logger.Errorf("%s denied for access token %d: %+v", method, token.GetID(), req)
+				return nil, auth.ErrAccessTokenCourse
+			}
+		}
+		if rule&accessAnyUser != 0 {
+			return handler(ctx, req)
+		}
+		usr, err := s.getCurrentUser(ctx)
+		if err != nil {
+			s.logger.Errorf("%s failed: authentication error: %v", method, err)
+			return nil, ErrInvalidUserInfo
+		}
+		if !s.hasAccess(usr, rule, req) {
+			s.logger.Errorf("%s denied for user %s: %+v", method, usr.GetLogin(), req)
+			return nil, ErrAccessDenied
+		}
+		return handler(ctx, req)
+	}
+}
+
+// hasAccess returns true if the given user has any of the roles of the rule for the given request.
+// Admins of a tenant only have the admin role for requests whose course and users belong to the tenant.
+func (s *AutograderService) hasAccess(usr *pb.User, rule access, req interface{}) bool {
+	if rule&(accessAdmin|accessGlobalAdmin) != 0 && usr.IsGlobalAdmin() {
+		return true
+	}
+	courseID, ok := s.requestCourseID(req)
+	if !ok {
+		return false
+	}
+	if rule&accessAdmin != 0 && usr.GetIsAdmin() && s.inTenant(usr.GetTenantID(), courseID, req) {
+		return true
+	}
+	if courseID > 0 {
+		enrollment, err := s.db.GetEnrollmentByCourseAndUser(courseID, usr.GetID())
+		if err == nil && rule&enrollmentAccess(enrollment.GetStatus()) != 0 {
+			return true
+		}
+	}
+	return rule&accessOwner != 0 && s.isRequestOwner(usr, req)
+}
+
+// enrollmentAccess returns the role of a user with the given enrollment status.
+func enrollmentAccess(enrollmentStatus pb.Enrollment_UserStatus) access {
+	switch enrollmentStatus {
+	case pb.Enrollment_STUDENT:
+		return accessStudent
+	case pb.Enrollment_TA:
+		return accessTA
+	case pb.Enrollment_TEACHER:
+		return accessTeacher
+	}
+	return 0
+}
+
+// requestCourseID returns the ID of the course of the given request, or zero if the request has no course.
+// It returns false if a submission, group, assignment or benchmark of the request does not exist,
+// or if they belong to different courses.
+func (s *AutograderService) requestCourseID(req interface{}) (uint64, bool) {
+	var courseIDs []uint64
+	switch r := req.(type) {
+	case *pb.Course:
+		courseIDs = append(courseIDs, r.GetID())
+	case interface{ GetCourseID() uint64 }:
+		courseIDs = append(courseIDs, r.GetCourseID())
+	}
+	if r, ok := req.(interface{ GetSubmissionID() uint64 }); ok && r.GetSubmissionID() > 0 {
+		submission, err := s.db.GetSubmission(&pb.Submission{ID: r.GetSubmissionID()})
+		if err != nil {
+			return 0, false
+		}
+		courseID, ok := s.assignmentCourseID(submission.GetAssignmentID())
+		if !ok {
+			return 0, false
+		}
+		courseIDs = append(courseIDs, courseID)
+	}
+	if r, ok := req.(interface{ GetAssignmentID() uint64 }); ok && r.GetAssignmentID() > 0 {
+		courseID, ok := s.assignmentCourseID(r.GetAssignmentID())
+		if !ok {
+			return 0, false
+		}
+		courseIDs = append(courseIDs, courseID)
+	}
+	groupID := uint64(0)
+	switch r := req.(type) {
+	case *pb.Group:
+		groupID = r.GetID()
+	case interface{ GetGroupID() uint64 }:
+		groupID = r.GetGroupID()
+	}
+	if groupID > 0 {
+		group, err := s.db.GetGroup(groupID)
+		if err != nil {
+			return 0, false
+		}
+		courseIDs = append(courseIDs, group.GetCourseID())
+	}
+	switch r := req.(type) {
+	case *pb.GradingBenchmark:
+		// the stored benchmark is updated and deleted, regardless of the request's assignment
+		if r.GetID() > 0 {
+			courseID, ok := s.benchmarkCourseID(r.GetID())
+			if !ok {
+				return 0, false
+			}
+			courseIDs = append(courseIDs, courseID)
+		}
+	case *pb.GradingCriterion:
+		benchmarkID := r.GetBenchmarkID()
+		if r.GetID() > 0 {
+			criterion, err := s.db.GetCriterion(r.GetID())
+			if err != nil {
+				return 0, false
+			}
+			benchmarkID = criterion.GetBenchmarkID()
+		}
+		courseID, ok := s.benchmarkCourseID(benchmarkID)
+		if !ok {
+			return 0, false
+		}
+		courseIDs = append(courseIDs, courseID)
+	}
+
+	requestCourseID := uint64(0)
+	for _, courseID := range courseIDs {
+		if courseID == 0 {
+			continue
+		}
+		if requestCourseID > 0 && requestCourseID != courseID {
+			return 0, false
+		}
+		requestCourseID = courseID
+	}
+	return requestCourseID, true
+}
+
+// assignmentCourseID returns the course ID of the given assignment.
+func (s *AutograderService) assignmentCourseID(assignmentID uint64) (uint64, bool) {
+	assignment, err := s.db.GetAssignment(&pb.Assignment{ID: assignmentID})
+	if err != nil {
+		return 0, false
+	}
+	return assignment.GetCourseID(), true
+}
+
+// benchmarkCourseID returns the course ID of the assignment of the given benchmark.
+func (s *AutograderService) benchmarkCourseID(benchmarkID uint64) (uint64, bool) {
+	benchmark, err := s.db.GetBenchmark(benchmarkID)
+	if err != nil {
+		return 0, false
+	}
+	return s.assignmentCourseID(benchmark.GetAssignmentID())
+}
+
+// isRequestOwner returns true if the given user is the user, a member of the group
+// and an author of the submission the given request refers to.
+// The request must refer to at least one of them.
+func (s *AutograderService) isRequestOwner(usr *pb.User, req interface{}) bool {
+	owns := false
+	if r, ok := req.(interface{ GetUserID() uint64 }); ok && r.GetUserID() > 0 {
+		if !usr.IsOwner(r.GetUserID()) {
+			return false
+		}
+		owns = true
+	}
+	if r, ok := req.(interface{ GetGroupID() uint64 }); ok && r.GetGroupID() > 0 {
+		group, err := s.db.GetGroup(r.GetGroupID())
+		if err != nil || !group.Contains(usr) {
+			return false
+		}
+		owns = true
+	}
+	if r, ok := req.(interface{ GetSubmissionID() uint64 }); ok && r.GetSubmissionID() > 0 {
+		submission, err := s.db.GetSubmission(&pb.Submission{ID: r.GetSubmissionID()})
+		if err != nil || !s.isSubmissionAuthor(usr, submission) {
+			return false
+		}
+		owns = true
+	}
+	return owns
+}
@@ -0,0 +1,145 @@
+package web_test
+
+import (
+	"context"
+	"log"
+	"net"
+	"strings"
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/ci"
+	"github.com/autograde/quickfeed/internal/qtest"
+	"github.com/autograde/quickfeed/web"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// accessControlled returns a client invoking the RPCs of the given service through its access control,
+// as the QuickFeed server does. The current user of the calls is given with withUserContext.
+func accessControlled(t *testing.T, ags *web.AutograderService) pb.AutograderServiceClient {
+	t.Helper()
+	lis := bufconn.Listen(1024 * 1024)
+	s := grpc.NewServer(grpc.UnaryInterceptor(ags.AccessControl()))
+	pb.RegisterAutograderServiceServer(s, ags)
+	go func() {
+		if err := s.Serve(lis); err != nil {
+			log.Printf("Server exited with error: %v", err)
+		}
+	}()
+	conn, err := grpc.DialContext(context.Background(), "bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) { return lis.Dial() }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		conn.Close()
+		s.Stop()
+	})
+	return pb.NewAutograderServiceClient(conn)
+}
+
+func TestAccessControlRules(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	admin := qtest.CreateFakeUser(t, db, 1)
+	_, scms := qtest.FakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	interceptor := ags.AccessControl()
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) { return &pb.Void{}, nil }
+
+	var methods []string
+	for _, method := range pb.AutograderService_ServiceDesc.Methods {
+		methods = append(methods, method.MethodName)
+	}
+	for _, stream := range pb.AutograderService_ServiceDesc.Streams {
+		methods = append(methods, stream.StreamName)
+	}
+	for _, method := range methods {
+		info := &grpc.UnaryServerInfo{FullMethod: "/ag.AutograderService/" + method}
+		_, err := interceptor(withUserContext(context.Background(), admin), &pb.Void{}, info, handler)
+		if strings.Contains(status.Convert(err).Message(), "no access rule") {
+			t.Errorf("%s has no access rule", method)
+		}
+	}
+}
+
+func TestAccessControl(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	admin := qtest.CreateFakeUser(t, db, 1)
+	course := &pb.Course{Code: "DAT101", OrganizationID: 1}
+	qtest.CreateCourse(t, db, admin, course)
+	otherCourse := &pb.Course{Code: "DAT320", OrganizationID: 2}
+	qtest.CreateCourse(t, db, admin, otherCourse)
+	student := qtest.CreateFakeUser(t, db, 2)
+	qtest.EnrollStudent(t, db, student, course)
+	other := qtest.CreateFakeUser(t, db, 3)
+	qtest.EnrollStudent(t, db, other, course)
+	teacher := qtest.CreateFakeUser(t, db, 4)
+	qtest.EnrollStudent(t, db, teacher, otherCourse)
+	if err := db.UpdateEnrollment(&pb.Enrollment{UserID: teacher.GetID(), CourseID: otherCourse.GetID(), Status: pb.Enrollment_TEACHER}); err != nil {
+		t.Fatal(err)
+	}
+
+	assignment := &pb.Assignment{CourseID: course.GetID(), Name: "lab1", Order: 1}
+	if err := db.CreateAssignment(assignment); err != nil {
+		t.Fatal(err)
+	}
+	submission := &pb.Submission{AssignmentID: assignment.GetID(), UserID: student.GetID()}
+	if err := db.CreateSubmission(submission); err != nil {
+		t.Fatal(err)
+	}
+	benchmark := &pb.GradingBenchmark{AssignmentID: assignment.GetID(), Heading: "Code quality"}
+	if err := db.CreateBenchmark(benchmark); err != nil {
+		t.Fatal(err)
+	}
+
+	_, scms := qtest.FakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	interceptor := ags.AccessControl()
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) { return &pb.Void{}, nil }
+
+	tests := []struct {
+		name    string
+		method  string
+		user    *pb.User
+		request interface{}
+		allowed bool
+	}{
+		{"teacher updates course", "UpdateCourse", admin, course, true},
+		{"student updates course", "UpdateCourse", student, course, false},
+		{"teacher of other course updates course", "UpdateCourse", teacher, course, false},
+		{"admin gets users", "GetUsers", admin, &pb.UsersRequest{}, true},
+		{"student gets users", "GetUsers", student, &pb.UsersRequest{}, false},
+		{"any user gets courses", "GetCourses", other, &pb.Void{}, true},
+		{"author gets test results", "GetTestResults", student, &pb.TestResultRequest{CourseID: course.GetID(), SubmissionID: submission.GetID()}, true},
+		{"other student gets test results", "GetTestResults", other, &pb.TestResultRequest{CourseID: course.GetID(), SubmissionID: submission.GetID()}, false},
+		{"teacher gets test results", "GetTestResults", admin, &pb.TestResultRequest{CourseID: course.GetID(), SubmissionID: submission.GetID()}, true},
+		{"teacher gets test results of other course", "GetTestResults", teacher, &pb.TestResultRequest{CourseID: otherCourse.GetID(), SubmissionID: submission.GetID()}, false},
+		{"student gets own slip days", "GetSlipDays", student, &pb.SlipDaysRequest{CourseID: course.GetID(), UserID: student.GetID()}, true},
+		{"student gets slip days of other", "GetSlipDays", other, &pb.SlipDaysRequest{CourseID: course.GetID(), UserID: student.GetID()}, false},
+		{"teacher creates criterion", "CreateCriterion", admin, &pb.GradingCriterion{BenchmarkID: benchmark.GetID()}, true},
+		{"student creates criterion", "CreateCriterion", student, &pb.GradingCriterion{BenchmarkID: benchmark.GetID()}, false},
+		{"teacher of other course deletes benchmark", "DeleteBenchmark", teacher, &pb.GradingBenchmark{ID: benchmark.GetID()}, false},
+		{"unknown benchmark", "UpdateBenchmark", admin, &pb.GradingBenchmark{ID: 99}, false},
+		{"unknown method", "DeleteEverything", admin, &pb.Void{}, false},
+	}
+	for _, tt := range tests {
+		info := &grpc.UnaryServerInfo{FullMethod: "/ag.AutograderService/" + tt.method}
+		_, err := interceptor(withUserContext(context.Background(), tt.user), tt.request, info, handler)
+		if tt.allowed && err != nil {
+			t.Errorf("%s: %s() = %v, want access", tt.name, tt.method, err)
+		}
+		if !tt.allowed && status.Code(err) != codes.PermissionDenied {
+			t.Errorf("%s: %s() = %v, want code %v", tt.name, tt.method, err, codes.PermissionDenied)
+		}
+	}
+}
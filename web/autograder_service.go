@@ -3,14 +3,24 @@ package web
 import (
 	"context"
 	"errors"
+	"sync/atomic"
+	"time"
 
 	"go.uber.org/zap"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
 
 	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/artifacts"
+	"github.com/autograde/quickfeed/assignments"
+	"github.com/autograde/quickfeed/auditlog"
 	"github.com/autograde/quickfeed/ci"
 	"github.com/autograde/quickfeed/database"
+	"github.com/autograde/quickfeed/internal/rand"
+	"github.com/autograde/quickfeed/notify"
+	"github.com/autograde/quickfeed/plagiarism"
 	scms "github.com/autograde/quickfeed/scm"
 	"github.com/autograde/quickfeed/web/auth"
 )
@@ -22,19 +32,201 @@ type AutograderService struct {
 	db     database.Database
 	scms   *auth.Scms
 	bh     BaseHookOptions
-	runner ci.Runner
+	// runners run the tests for submissions on the runner pools, limiting the number of concurrently running tests.
+	runners *ci.Pools
+	// testWorkers is the maximum number of concurrently running tests in each runner pool.
+	testWorkers int
+	// groupCleanup determines how group repositories and teams are cleaned up on deletion.
+	groupCleanup GroupCleanup
+	// groupCleanupDryRun logs the SCM resources that group cleanup would remove, without removing them.
+	groupCleanupDryRun bool
+	// orphans holds the most recent orphaned resources report for each course.
+	orphans *orphanReports
+	// accessReports holds the most recent access report for each course.
+	accessReports *accessReports
+	// revokeExposure determines whether access to the tests repository found by course checks is revoked.
+	revokeExposure bool
+	// auditLog records the actions performed by teachers and admins.
+	auditLog *auditlog.Log
+	// notifier delivers notifications about course events to course participants.
+	notifier *notify.Dispatcher
+	// events delivers events to the event streams of the signed in users' browsers.
+	events *eventHub
+	// feedSecret is used to sign the tokens granting access to course feeds.
+	feedSecret string
+	// certificateSecret is used to sign certificates of completion.
+	certificateSecret string
+	// diagnosticsAddr is the listen address of a separate server for the diagnostics endpoints;
+	// if empty, the diagnostics endpoints are served by the main web server.
+	diagnosticsAddr string
+	// plagiarismCheckers are the plagiarism checkers available to teachers, by name.
+	plagiarismCheckers map[string]plagiarism.Checker
+	// grpcServer serves the gRPC-web requests received by the web server; if nil, gRPC-web is not served.
+	grpcServer *grpc.Server
+	// grpcWebOrigins are the origins allowed to make cross-origin gRPC-web requests.
+	grpcWebOrigins []string
+	// tls determines how the web server obtains its certificate for serving HTTPS;
+	// if not enabled, the web server serves plain HTTP, e.g., behind a TLS terminating proxy.
+	tls TLSOptions
+	// cache holds the results of frequently called read RPCs.
+	cache *resultCache
+	// artifactStore stores the artifacts produced by test runs; if nil, artifacts are not stored.
+	artifactStore artifacts.Store
+	// shuttingDown is set when the server is shutting down; webhook deliveries are then rejected.
+	shuttingDown int32
 	pb.UnimplementedAutograderServiceServer
 }
 
 // NewAutograderService returns an AutograderService object.
 func NewAutograderService(logger *zap.Logger, db database.Database, scms *auth.Scms, bh BaseHookOptions, runner ci.Runner) *AutograderService {
-	return &AutograderService{
-		logger: logger.Sugar(),
-		db:     db,
-		scms:   scms,
-		bh:     bh,
-		runner: runner,
-	}
+	cache := newResultCache(defaultCacheTTL)
+	db.OnChange(cache.invalidate)
+	s := &AutograderService{
+		logger:  logger.Sugar(),
+		db:      db,
+		scms:    scms,
+		bh:      bh,
+		runners: ci.NewPools(logger.Sugar(), ci.NewQueue(logger.Sugar(), db, runner, maxContainers)),
+
+		testWorkers: maxContainers,
+
+		groupCleanup:  GroupCleanupDelete,
+		orphans:       newOrphanReports(),
+		accessReports: newAccessReports(),
+		notifier:      notify.NewDispatcher(logger, notify.NewInApp(db)),
+		events:        newEventHub(),
+		feedSecret:    rand.String(),
+		auditLog:      auditlog.New(logger.Sugar(), db),
+
+		certificateSecret: rand.String(),
+
+		plagiarismCheckers: make(map[string]plagiarism.Checker),
+		cache:              cache,
+	}
+	results, _ := s.runners.Results().Subscribe(0, func(*pb.Submission) bool { return true })
+	queued := make(chan *ci.RunData, queuedBuffer)
+	s.runners.OnQueued(func(rData *ci.RunData) {
+		select {
+		case queued <- rData:
+		default:
+			// commit statuses are informative; the pending status is skipped if the publisher falls behind
+		}
+	})
+	go s.publishResults(results, queued)
+	return s
+}
+
+// AddRunnerPool adds a named runner pool running tests with the given runner.
+// Courses and assignments can be pinned to the pool by name.
+func (s *AutograderService) AddRunnerPool(name string, runner ci.Runner) {
+	s.runners.Add(name, ci.NewQueue(s.logger, s.db, runner, s.testWorkers))
+}
+
+// SetTestWorkers sets the maximum number of concurrently running tests in each runner pool.
+func (s *AutograderService) SetTestWorkers(workers int) {
+	s.testWorkers = workers
+	s.runners.SetWorkers(workers)
+}
+
+// ResumeTestJobs queues the test jobs that were queued or running when the server stopped.
+// Must be called after the runner pools have been added.
+func (s *AutograderService) ResumeTestJobs() error {
+	return s.runners.Resume(s.db)
+}
+
+// Shutdown stops accepting webhook deliveries and starting queued test jobs, and waits until
+// the running test jobs have completed, or the context is done. The queued test jobs remain
+// stored in the database, and are resumed by ResumeTestJobs when the server restarts.
+func (s *AutograderService) Shutdown(ctx context.Context) error {
+	atomic.StoreInt32(&s.shuttingDown, 1)
+	return s.runners.Drain(ctx)
+}
+
+// isShuttingDown returns true if the server is shutting down.
+func (s *AutograderService) isShuttingDown() bool {
+	return atomic.LoadInt32(&s.shuttingDown) == 1
+}
+
+// AddPlagiarismChecker adds a named plagiarism checker that teachers can run
+// to compare the code submitted for an assignment, e.g., moss or jplag.
+func (s *AutograderService) AddPlagiarismChecker(name string, checker plagiarism.Checker) {
+	s.plagiarismCheckers[name] = checker
+}
+
+// SetOverflowPool sets the runner pool that rebuilds overflow to when their runner pool is busy.
+func (s *AutograderService) SetOverflowPool(name string) error {
+	return s.runners.SetOverflow(name)
+}
+
+// SetDiagnosticsAddr sets the listen address of a separate server for the admin-only
+// profiling and runtime diagnostics endpoints, e.g., "localhost:6060".
+func (s *AutograderService) SetDiagnosticsAddr(addr string) {
+	s.diagnosticsAddr = addr
+}
+
+// SetGRPCWeb enables serving gRPC-web requests from browsers by the web server, using
+// the given gRPC server. Cross-origin requests are allowed from the given origins;
+// same-origin requests are always allowed.
+func (s *AutograderService) SetGRPCWeb(server *grpc.Server, allowedOrigins []string) {
+	s.grpcServer = server
+	s.grpcWebOrigins = allowedOrigins
+}
+
+// SetTLS sets how the web server obtains its certificate for serving HTTPS and HTTP/2:
+// from certificate files, automatically from Let's Encrypt, or both.
+func (s *AutograderService) SetTLS(opts TLSOptions) {
+	s.tls = opts
+}
+
+// SetFeedSecret sets the secret used to sign the tokens granting access to course feeds.
+// Unless set, a random secret is used, and feed URLs are invalidated on restart.
+func (s *AutograderService) SetFeedSecret(secret string) {
+	s.feedSecret = secret
+}
+
+// SetCertificateSecret sets the secret used to sign certificates of completion.
+// Unless set, a random secret is used, and issued certificates fail verification after restart.
+func (s *AutograderService) SetCertificateSecret(secret string) {
+	s.certificateSecret = secret
+}
+
+// SetCacheTTL sets how long the results of frequently called read RPCs, such as
+// GetCourses, GetAssignments and GetEnrollmentsByCourse, are cached. Cached results
+// are invalidated when the database is updated. Results are not cached if ttl is zero.
+func (s *AutograderService) SetCacheTTL(ttl time.Duration) {
+	s.cache.setTTL(ttl)
+}
+
+// SetArtifactStore sets the store for the artifacts produced by test runs, such as full
+// build logs and coverage reports, which can be downloaded by the submission's authors
+// and the course's teaching staff. Unless set, artifacts are not stored.
+func (s *AutograderService) SetArtifactStore(store artifacts.Store) {
+	s.artifactStore = store
+	s.runners.SetArtifactStore(store)
+}
+
+// RegisterNotifier adds a notifier that will receive notifications
+// about course events, such as new announcements.
+func (s *AutograderService) RegisterNotifier(notifier notify.Notifier) {
+	s.notifier.Register(notifier)
+}
+
+// SetRevokeExposure determines whether student access to the tests repository
+// found by the periodic course checks is revoked, or only reported.
+func (s *AutograderService) SetRevokeExposure(revoke bool) {
+	s.revokeExposure = revoke
+}
+
+// SetGroupCleanup sets the policy used to clean up the SCM resources
+// (repository and team) of deleted groups.
+func (s *AutograderService) SetGroupCleanup(policy GroupCleanup) {
+	s.groupCleanup = policy
+}
+
+// SetGroupCleanupDryRun determines whether the SCM resources (repositories, teams and
+// team members) removed when groups are deleted or updated are removed, or only logged.
+func (s *AutograderService) SetGroupCleanupDryRun(dryRun bool) {
+	s.groupCleanupDryRun = dryRun
 }
 
 // GetUser will return current user with active course enrollments
@@ -53,20 +245,22 @@ func (s *AutograderService) GetUser(ctx context.Context, in *pb.Void) (*pb.User,
 	return userInfo, nil
 }
 
-// GetUsers returns a list of all users.
+// GetUsers returns a list of all users, or the requested page of users.
+// Admins of a tenant only get the tenant's users.
 // Access policy: Admin.
 // Frontend note: This method is called from AdminPage.
-func (s *AutograderService) GetUsers(ctx context.Context, in *pb.Void) (*pb.Users, error) {
+func (s *AutograderService) GetUsers(ctx context.Context, in *pb.UsersRequest) (*pb.Users, error) {
 	usr, err := s.getCurrentUser(ctx)
 	if err != nil {
 		s.logger.Errorf("GetUsers failed: authentication error: %v", err)
 		return nil, ErrInvalidUserInfo
 	}
-	if !usr.IsAdmin {
-		s.logger.Error("GetUsers failed: user is not admin")
-		return nil, status.Error(codes.PermissionDenied, "only admin can access other users")
+	page, err := newPage(in.GetPageSize(), in.GetPageToken())
+	if err != nil {
+		s.logger.Errorf("GetUsers failed: %v", err)
+		return nil, status.Error(codes.InvalidArgument, "invalid page token")
 	}
-	users, err := s.getUsers()
+	users, err := s.getUsers(usr, page)
 	if err != nil {
 		s.logger.Errorf("GetUsers failed: %v", err)
 		return nil, status.Error(codes.NotFound, "failed to get users")
@@ -76,7 +270,7 @@ func (s *AutograderService) GetUsers(ctx context.Context, in *pb.Void) (*pb.User
 
 // GetUserByCourse returns the user matching the given course name and GitHub login
 // specified in CourseUserRequest.
-// Access policy: Admins or course teachers
+// Access policy: Admins or course teaching staff
 func (s *AutograderService) GetUserByCourse(ctx context.Context, in *pb.CourseUserRequest) (*pb.User, error) {
 	usr, err := s.getCurrentUser(ctx)
 	if err != nil {
@@ -101,7 +295,7 @@ func (s *AutograderService) UpdateUser(ctx context.Context, in *pb.User) (*pb.Vo
 		s.logger.Errorf("UpdateUser failed: authentication error: %v", err)
 		return nil, ErrInvalidUserInfo
 	}
-	if !(usr.IsAdmin || usr.IsOwner(in.GetID())) {
+	if !(s.isUserAdmin(usr, in.GetID()) || usr.IsOwner(in.GetID())) {
 		s.logger.Errorf("UpdateUser failed to update user %d: user is not admin or course creator", in.GetID())
 		return nil, status.Error(codes.PermissionDenied, "only admin can update another user's information")
 	}
@@ -112,6 +306,41 @@ func (s *AutograderService) UpdateUser(ctx context.Context, in *pb.User) (*pb.Vo
 	return &pb.Void{}, err
 }
 
+// ExportUserData returns the data stored about the given user in a machine-readable form.
+// Access policy: Admin, or Current User if Owner.
+func (s *AutograderService) ExportUserData(ctx context.Context, in *pb.UserRequest) (*pb.UserData, error) {
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("ExportUserData failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	data, err := s.exportUserData(usr, in.GetUserID())
+	if err != nil {
+		s.logger.Errorf("ExportUserData failed to export user %d: %v", in.GetUserID(), err)
+		return nil, status.Error(codes.NotFound, "failed to export user data")
+	}
+	return data, nil
+}
+
+// DeleteUserData anonymizes the given user's personal data, i.e., name, login, email, student ID and
+// remote identities, while keeping the user's course results for the course statistics.
+// Access policy: Admin.
+func (s *AutograderService) DeleteUserData(ctx context.Context, in *pb.UserRequest) (*pb.Void, error) {
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("DeleteUserData failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	if err := s.deleteUserData(usr, in.GetUserID()); err != nil {
+		s.logger.Errorf("DeleteUserData failed to delete user %d: %v", in.GetUserID(), err)
+		if errors.Is(err, errAdminUserData) {
+			return nil, status.Error(codes.FailedPrecondition, "admins must be demoted before their data can be deleted")
+		}
+		return nil, status.Error(codes.NotFound, "failed to delete user data")
+	}
+	return &pb.Void{}, nil
+}
+
 // IsAuthorizedTeacher checks whether current user has teacher scopes.
 // Access policy: Any User.
 func (s *AutograderService) IsAuthorizedTeacher(ctx context.Context, in *pb.Void) (*pb.AuthorizationResponse, error) {
@@ -126,6 +355,103 @@ func (s *AutograderService) IsAuthorizedTeacher(ctx context.Context, in *pb.Void
 	}, nil
 }
 
+// GetRemoteIdentities returns the remote identities linked to the current user's account,
+// one for each provider. Other providers are linked by signing in with them while logged in.
+// Access policy: Any User.
+func (s *AutograderService) GetRemoteIdentities(ctx context.Context, in *pb.Void) (*pb.RemoteIdentities, error) {
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("GetRemoteIdentities failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	return getRemoteIdentities(usr), nil
+}
+
+// UnlinkRemoteIdentity removes the current user's remote identity for the given provider.
+// Access policy: Any User, for an identity not needed by any of the user's courses.
+func (s *AutograderService) UnlinkRemoteIdentity(ctx context.Context, in *pb.UnlinkIdentityRequest) (*pb.Void, error) {
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("UnlinkRemoteIdentity failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	if err := s.unlinkRemoteIdentity(usr, in.GetProvider()); err != nil {
+		s.logger.Errorf("UnlinkRemoteIdentity failed: %v", err)
+		if errors.Is(err, errIdentityInUse) || errors.Is(err, database.ErrLastIdentity) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return nil, status.Error(codes.InvalidArgument, "failed to unlink remote identity")
+	}
+	s.audit(usr, 0, "unlink remote identity", "provider", in.GetProvider())
+	return &pb.Void{}, nil
+}
+
+// GetTenants returns all tenants.
+// Access policy: Admin not belonging to a tenant.
+func (s *AutograderService) GetTenants(ctx context.Context, in *pb.Void) (*pb.Tenants, error) {
+	tenants, err := s.getTenants()
+	if err != nil {
+		s.logger.Errorf("GetTenants failed: %v", err)
+		return nil, status.Error(codes.NotFound, "failed to get tenants")
+	}
+	return tenants, nil
+}
+
+// CreateTenant creates a new tenant with the given name and SCM provider configuration.
+// Access policy: Admin not belonging to a tenant.
+func (s *AutograderService) CreateTenant(ctx context.Context, in *pb.Tenant) (*pb.Tenant, error) {
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("CreateTenant failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	tenant, err := s.createTenant(usr, in)
+	if err != nil {
+		s.logger.Errorf("CreateTenant failed: %v", err)
+		return nil, status.Error(codes.InvalidArgument, "failed to create tenant")
+	}
+	return tenant, nil
+}
+
+// UpdateTenant updates the name and SCM provider configuration of the given tenant.
+// Access policy: Admin not belonging to a tenant.
+func (s *AutograderService) UpdateTenant(ctx context.Context, in *pb.Tenant) (*pb.Void, error) {
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("UpdateTenant failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	if err := s.updateTenant(usr, in); err != nil {
+		s.logger.Errorf("UpdateTenant failed: %v", err)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Error(codes.NotFound, "tenant not found")
+		}
+		return nil, status.Error(codes.InvalidArgument, "failed to update tenant")
+	}
+	return &pb.Void{}, nil
+}
+
+// SetUserTenant moves the given user to the given tenant, or out of its tenant if the tenant ID is zero.
+// Access policy: Admin not belonging to a tenant.
+func (s *AutograderService) SetUserTenant(ctx context.Context, in *pb.TenantUserRequest) (*pb.Void, error) {
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("SetUserTenant failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	if err := s.setUserTenant(usr, in); err != nil {
+		s.logger.Errorf("SetUserTenant failed: %v", err)
+		switch {
+		case errors.Is(err, errSuperAdminTenant):
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			return nil, status.Error(codes.NotFound, "user or tenant not found")
+		}
+		return nil, status.Error(codes.InvalidArgument, "failed to move user to tenant")
+	}
+	return &pb.Void{}, nil
+}
+
 // CreateCourse creates a new course.
 // Access policy: Admin.
 func (s *AutograderService) CreateCourse(ctx context.Context, in *pb.Course) (*pb.Course, error) {
@@ -134,9 +460,9 @@ func (s *AutograderService) CreateCourse(ctx context.Context, in *pb.Course) (*p
 		s.logger.Errorf("CreateCourse failed: scm authentication error: %v", err)
 		return nil, ErrInvalidUserInfo
 	}
-	if !usr.IsAdmin {
-		s.logger.Error("CreateCourse failed: user is not admin")
-		return nil, status.Error(codes.PermissionDenied, "user must be admin to create course")
+	if err := s.setCourseTenant(usr, in); err != nil {
+		s.logger.Errorf("CreateCourse failed: %v", err)
+		return nil, tenantError(err)
 	}
 
 	// make sure that the current user is set as course creator
@@ -157,9 +483,38 @@ func (s *AutograderService) CreateCourse(ctx context.Context, in *pb.Course) (*p
 		}
 		return nil, status.Error(codes.InvalidArgument, "failed to create course")
 	}
+	s.auditChange(usr, course.GetID(), "create course", nil, courseRecord(course), "course", course.GetCode())
 	return course, nil
 }
 
+// PreviewCourseCreation returns the operations on the SCM provider that CreateCourse would
+// perform for the given course, without performing them.
+// Access policy: Admin.
+func (s *AutograderService) PreviewCourseCreation(ctx context.Context, in *pb.Course) (*pb.CourseCreationPlan, error) {
+	usr, scm, err := s.getUserAndSCM(ctx, in.Provider)
+	if err != nil {
+		s.logger.Errorf("PreviewCourseCreation failed: scm authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	if err := s.setCourseTenant(usr, in); err != nil {
+		s.logger.Errorf("PreviewCourseCreation failed: %v", err)
+		return nil, tenantError(err)
+	}
+	in.CourseCreatorID = usr.GetID()
+	plan, err := s.previewCourseCreation(ctx, scm, in)
+	if err != nil {
+		s.logger.Errorf("PreviewCourseCreation failed: %v", err)
+		if contextCanceled(ctx) {
+			return nil, status.Error(codes.FailedPrecondition, ErrContextCanceled)
+		}
+		if ok, parsedErr := parseSCMError(err); ok {
+			return nil, parsedErr
+		}
+		return nil, status.Error(codes.InvalidArgument, "failed to preview course creation")
+	}
+	return plan, nil
+}
+
 // UpdateCourse changes the course information details.
 // Access policy: Teacher of CourseID.
 func (s *AutograderService) UpdateCourse(ctx context.Context, in *pb.Course) (*pb.Void, error) {
@@ -169,11 +524,12 @@ func (s *AutograderService) UpdateCourse(ctx context.Context, in *pb.Course) (*p
 		return nil, ErrInvalidUserInfo
 	}
 	courseID := in.GetID()
-	if !s.isTeacher(usr.GetID(), courseID) {
-		s.logger.Error("UpdateCourse failed: user is not teacher")
-		return nil, status.Error(codes.PermissionDenied, "only teachers can update course")
+	if s.isArchived(courseID) {
+		s.logger.Errorf("UpdateCourse failed: course %d is archived", courseID)
+		return nil, ErrCourseArchived
 	}
 
+	before, _ := s.db.GetCourse(courseID, false)
 	if err = s.updateCourse(ctx, scm, in); err != nil {
 		s.logger.Errorf("UpdateCourse failed: %v", err)
 		if contextCanceled(ctx) {
@@ -184,45 +540,54 @@ func (s *AutograderService) UpdateCourse(ctx context.Context, in *pb.Course) (*p
 		}
 		return nil, status.Error(codes.InvalidArgument, "failed to update course")
 	}
+	after, _ := s.db.GetCourse(courseID, false)
+	s.auditChange(usr, courseID, "update course", courseRecord(before), courseRecord(after))
 	return &pb.Void{}, nil
 }
 
 // GetCourse returns course information for the given course.
+// Users that belong to a tenant can only get the tenant's courses.
 // Access policy: Any User.
 func (s *AutograderService) GetCourse(ctx context.Context, in *pb.CourseRequest) (*pb.Course, error) {
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("GetCourse failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
 	courseID := in.GetCourseID()
 	course, err := s.getCourse(courseID)
 	if err != nil {
 		s.logger.Errorf("GetCourse failed: %v", err)
 		return nil, status.Error(codes.NotFound, "course not found")
 	}
+	if !canSeeCourse(usr, course) {
+		s.logger.Errorf("GetCourse failed: user %s cannot see course %d of another tenant", usr.GetLogin(), courseID)
+		return nil, status.Error(codes.NotFound, "course not found")
+	}
 	return course, nil
 }
 
 // GetCourses returns a list of all courses.
+// Users that belong to a tenant only get the tenant's courses.
 // Access policy: Any User.
 func (s *AutograderService) GetCourses(ctx context.Context, in *pb.Void) (*pb.Courses, error) {
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("GetCourses failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
 	courses, err := s.getCourses()
 	if err != nil {
 		s.logger.Errorf("GetCourses failed: %v", err)
 		return nil, status.Error(codes.NotFound, "no courses found")
 	}
-	return courses, nil
+	return visibleCourses(usr, courses), nil
 }
 
 // UpdateCourseVisibility allows to edit what courses are visible in the sidebar.
 // Access policy: Any User.
 func (s *AutograderService) UpdateCourseVisibility(ctx context.Context, in *pb.Enrollment) (*pb.Void, error) {
-	usr, err := s.getCurrentUser(ctx)
-	if err != nil {
-		s.logger.Errorf("ChangeCourseVisibility failed: authentication error: %v", err)
-		return nil, ErrInvalidUserInfo
-	}
-	if !usr.IsOwner(in.GetUserID()) {
-		s.logger.Errorf("ChangeCourseVisibility failed: user %d attempts to update enrollment for user %d", usr.GetID(), in.GetUserID())
-		return nil, status.Error(codes.PermissionDenied, "users cannot set course visibility for another users")
-	}
-	err = s.changeCourseVisibility(in)
+	err := s.changeCourseVisibility(in)
 	if err != nil {
 		s.logger.Errorf("ChangeCourseVisibility failed: %v", err)
 		err = status.Error(codes.InvalidArgument, "failed to update course visibility")
@@ -230,187 +595,483 @@ func (s *AutograderService) UpdateCourseVisibility(ctx context.Context, in *pb.E
 	return &pb.Void{}, err
 }
 
-// CreateEnrollment enrolls a new student for the course specified in the request.
-// Access policy: Any User.
-func (s *AutograderService) CreateEnrollment(ctx context.Context, in *pb.Enrollment) (*pb.Void, error) {
-	err := s.createEnrollment(in)
+// ArchiveCourse marks the course as archived, making it read-only, and optionally
+// archives or deletes the course's repositories on the SCM provider.
+// Access policy: Teacher of CourseID.
+func (s *AutograderService) ArchiveCourse(ctx context.Context, in *pb.CourseCleanupRequest) (*pb.Void, error) {
+	usr, scm, err := s.getUserAndSCMForCourse(ctx, in.GetCourseID())
 	if err != nil {
-		s.logger.Errorf("CreateEnrollment failed: %v", err)
-		err = status.Error(codes.InvalidArgument, "failed to create enrollment")
+		s.logger.Errorf("ArchiveCourse failed: scm authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
 	}
-	return &pb.Void{}, err
+	if err = s.archiveCourse(ctx, scm, usr, in); err != nil {
+		s.logger.Errorf("ArchiveCourse failed: %v", err)
+		if contextCanceled(ctx) {
+			return nil, status.Error(codes.FailedPrecondition, ErrContextCanceled)
+		}
+		if ok, parsedErr := parseSCMError(err); ok {
+			return nil, parsedErr
+		}
+		return nil, status.Error(codes.InvalidArgument, "failed to archive course")
+	}
+	return &pb.Void{}, nil
 }
 
-// UpdateEnrollment updates the enrollment status of a student as specified in the request.
+// RepairCourse finishes the creation of a course whose creation failed part way,
+// skipping the course resources that were already created.
 // Access policy: Teacher of CourseID.
-func (s *AutograderService) UpdateEnrollment(ctx context.Context, in *pb.Enrollment) (*pb.Void, error) {
+func (s *AutograderService) RepairCourse(ctx context.Context, in *pb.CourseRequest) (*pb.Course, error) {
 	usr, scm, err := s.getUserAndSCMForCourse(ctx, in.GetCourseID())
 	if err != nil {
-		s.logger.Errorf("UpdateEnrollment failed: scm authentication error: %v", err)
+		s.logger.Errorf("RepairCourse failed: scm authentication error: %v", err)
 		return nil, ErrInvalidUserInfo
 	}
-	if !s.isTeacher(usr.GetID(), in.GetCourseID()) {
-		s.logger.Error("UpdateEnrollment failed: user is not teacher")
-		return nil, status.Error(codes.PermissionDenied, "only teachers can update enrollment status")
-	}
-	if s.isCourseCreator(in.CourseID, in.UserID) {
-		s.logger.Errorf("UpdateEnrollment failed: user %s attempted to demote course creator", usr.GetName())
-		return nil, status.Error(codes.PermissionDenied, "course creator cannot be demoted")
-	}
-	err = s.updateEnrollment(ctx, scm, usr.Login, in)
+	course, err := s.repairCourse(ctx, scm, in.GetCourseID())
 	if err != nil {
-		s.logger.Errorf("UpdateEnrollment failed: %v", err)
+		s.logger.Errorf("RepairCourse failed: %v", err)
 		if contextCanceled(ctx) {
 			return nil, status.Error(codes.FailedPrecondition, ErrContextCanceled)
 		}
 		if ok, parsedErr := parseSCMError(err); ok {
 			return nil, parsedErr
 		}
-		return nil, status.Error(codes.InvalidArgument, "failed to update enrollment")
+		return nil, status.Error(codes.InvalidArgument, "failed to repair course")
 	}
-	return &pb.Void{}, nil
+	s.audit(usr, course.GetID(), "repair course", "course", course.GetCode(), "courseID", course.GetID())
+	return course, nil
 }
 
-// UpdateEnrollments changes status of all pending enrollments for the given course to approved
-// Access policy: Teacher of CourseID
-func (s *AutograderService) UpdateEnrollments(ctx context.Context, in *pb.CourseRequest) (*pb.Void, error) {
-	usr, scm, err := s.getUserAndSCMForCourse(ctx, in.GetCourseID())
+// CloneCourse creates a new course from a previous instance of the course, copying its settings,
+// grading scale, assignments with grading benchmarks and criteria, and the contents of its
+// assignments and tests repositories. The new course's organization must be on the same provider.
+// Access policy: Admin.
+func (s *AutograderService) CloneCourse(ctx context.Context, in *pb.CloneCourseRequest) (*pb.Course, error) {
+	usr, scm, err := s.getUserAndSCM(ctx, in.GetCourse().GetProvider())
 	if err != nil {
-		s.logger.Errorf("UpdateEnrollments failed: scm authentication error: %v", err)
+		s.logger.Errorf("CloneCourse failed: scm authentication error: %v", err)
 		return nil, ErrInvalidUserInfo
 	}
-	if !s.isTeacher(usr.GetID(), in.GetCourseID()) {
-		s.logger.Error("UpdateEnrollments failed: user is not teacher")
-		return nil, status.Error(codes.PermissionDenied, "only teachers can update enrollment status")
+	previous, err := s.db.GetCourse(in.GetCourseID(), false)
+	if err != nil {
+		s.logger.Errorf("CloneCourse failed: %v", err)
+		return nil, status.Error(codes.NotFound, "course not found")
 	}
-	err = s.updateEnrollments(ctx, scm, in.GetCourseID())
+
+	// make sure that the current user is set as course creator
+	in.Course.CourseCreatorID = usr.GetID()
+	course, err := s.cloneCourse(ctx, scm, previous, in.GetCourse())
 	if err != nil {
-		s.logger.Errorf("UpdateEnrollments failed: %v", err)
+		s.logger.Errorf("CloneCourse failed: %v", err)
 		if contextCanceled(ctx) {
 			return nil, status.Error(codes.FailedPrecondition, ErrContextCanceled)
 		}
+		if err == ErrAlreadyExists || err == ErrFreePlan || err == errCloneProvider {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
 		if ok, parsedErr := parseSCMError(err); ok {
 			return nil, parsedErr
 		}
-		err = status.Error(codes.InvalidArgument, "failed to update pending enrollments")
+		return nil, status.Error(codes.InvalidArgument, "failed to clone course")
 	}
-	return &pb.Void{}, err
+	s.auditChange(usr, course.GetID(), "clone course", nil, courseRecord(course), "course", course.GetCode(), "previousCourseID", previous.GetID())
+	return course, nil
 }
 
-// GetCoursesByUser returns all courses the given user is enrolled into with the given status.
-// Access policy: Any User.
-func (s *AutograderService) GetCoursesByUser(ctx context.Context, in *pb.EnrollmentStatusRequest) (*pb.Courses, error) {
-	courses, err := s.getCoursesByUser(in)
+// DeleteCourse requests deletion of the course with its enrollments, groups, assignments and submissions,
+// and optionally archiving or deleting the course's repositories on the SCM provider.
+// The course is deleted when another admin confirms the request with ConfirmPendingAction.
+// Access policy: Teacher of CourseID.
+func (s *AutograderService) DeleteCourse(ctx context.Context, in *pb.CourseCleanupRequest) (*pb.Void, error) {
+	usr, err := s.getCurrentUser(ctx)
 	if err != nil {
-		s.logger.Errorf("GetCoursesWithEnrollment failed: %v", err)
-		return nil, status.Error(codes.NotFound, "no courses with enrollment found")
+		s.logger.Errorf("DeleteCourse failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
 	}
-	return courses, nil
+	action := &pb.PendingAction{Action: pb.PendingAction_DELETE_COURSE, CourseID: in.GetCourseID(), Repositories: in.GetRepositories()}
+	if err := s.requestAction(usr, action); err != nil {
+		s.logger.Errorf("DeleteCourse failed: %v", err)
+		return nil, status.Error(codes.InvalidArgument, "failed to request course deletion")
+	}
+	return nil, ErrPendingAction
 }
 
-// GetEnrollmentsByUser returns all enrollments for the given user and enrollment status with preloaded courses and groups.
-// Access policy: user with userID or admin
-func (s *AutograderService) GetEnrollmentsByUser(ctx context.Context, in *pb.EnrollmentStatusRequest) (*pb.Enrollments, error) {
-	usr, err := s.getCurrentUser(ctx)
+// GetGradingScale returns the grading scale used to convert scores to letter grades in the given course.
+// Access policy: Any User enrolled in CourseID.
+func (s *AutograderService) GetGradingScale(ctx context.Context, in *pb.CourseRequest) (*pb.GradingScale, error) {
+	scale, err := s.getGradingScale(in.GetCourseID())
 	if err != nil {
-		s.logger.Errorf("GetEnrollmentsByUser failed: authentication error: %v", err)
-		return nil, ErrInvalidUserInfo
+		s.logger.Errorf("GetGradingScale failed: %v", err)
+		return nil, status.Error(codes.NotFound, "failed to get grading scale")
 	}
-	if usr.GetID() != in.GetUserID() && !usr.IsAdmin {
-		s.logger.Errorf("GetEnrollmentsByUser failed: current user ID: %d, but requested user ID is %d", usr.ID, in.UserID)
-		return nil, status.Error(codes.PermissionDenied, "only admins can request enrollments for other users")
+	return scale, nil
+}
+
+// UpdateGradingScale replaces the grading scale of the given course.
+// Access policy: Teacher of CourseID.
+func (s *AutograderService) UpdateGradingScale(ctx context.Context, in *pb.GradingScale) (*pb.Void, error) {
+	if err := s.db.UpdateGradingScale(in.GetCourseID(), in.GetThresholds()); err != nil {
+		s.logger.Errorf("UpdateGradingScale failed: %v", err)
+		return nil, status.Error(codes.InvalidArgument, "failed to update grading scale")
 	}
+	return &pb.Void{}, nil
+}
 
-	// get all enrollments from the db (no scm)
-	enrols, err := s.getEnrollmentsByUser(in)
+// ComputeFinalGrades computes the final grades of all students in the given course for review by teachers.
+// Access policy: Teacher of CourseID.
+func (s *AutograderService) ComputeFinalGrades(ctx context.Context, in *pb.CourseRequest) (*pb.FinalGrades, error) {
+	finalGrades, err := s.computeFinalGrades(in.GetCourseID())
 	if err != nil {
-		s.logger.Errorf("Get enrollments for user %d failed: %v", in.GetUserID(), err)
+		s.logger.Errorf("ComputeFinalGrades failed: %v", err)
+		return nil, status.Error(codes.NotFound, "failed to compute final grades")
 	}
-	return enrols, nil
+	return finalGrades, nil
 }
 
-// GetEnrollmentsByCourse returns all enrollments for the course specified in the request.
-// Access policy: Teacher or student of CourseID.
-func (s *AutograderService) GetEnrollmentsByCourse(ctx context.Context, in *pb.EnrollmentRequest) (*pb.Enrollments, error) {
-	usr, err := s.getCurrentUser(ctx)
+// GetCourseCompletion returns the completion status of each student in the given course: the number
+// of approved assignments, the mandatory assignments not approved, and whether the student meets
+// the course's pass requirement.
+// Access policy: Teacher or TA of CourseID.
+func (s *AutograderService) GetCourseCompletion(ctx context.Context, in *pb.CourseRequest) (*pb.CourseCompletion, error) {
+	completion, err := s.courseCompletion(in.GetCourseID())
 	if err != nil {
-		s.logger.Errorf("GetEnrollmentsByCourse failed: authentication error: %v", err)
-		return nil, ErrInvalidUserInfo
-	}
-	if !s.isEnrolled(usr.GetID(), in.GetCourseID()) {
-		s.logger.Error("GetEnrollmentsByCourse failed: user is not teacher")
-		return nil, status.Error(codes.PermissionDenied, "only teachers can get course enrollments")
+		s.logger.Errorf("GetCourseCompletion failed: %v", err)
+		return nil, status.Error(codes.NotFound, "failed to get course completion")
 	}
+	return completion, nil
+}
 
-	enrolls, err := s.getEnrollmentsByCourse(in)
+// GetScoreCurve returns the score curve applied to the given assignment's scores for final grading.
+// Access policy: Teacher of CourseID.
+func (s *AutograderService) GetScoreCurve(ctx context.Context, in *pb.AssignmentRequest) (*pb.ScoreCurve, error) {
+	curve, err := s.getScoreCurve(in)
 	if err != nil {
-		s.logger.Errorf("GetEnrollmentsByCourse failed: %v", err)
-		return nil, status.Error(codes.InvalidArgument, "failed to get enrollments for given course")
+		s.logger.Errorf("GetScoreCurve failed: %v", err)
+		return nil, status.Error(codes.NotFound, "failed to get score curve")
 	}
-	return enrolls, nil
+	return curve, nil
 }
 
-// GetGroup returns information about a group.
-// Access policy: Group members, Teacher of CourseID.
-func (s *AutograderService) GetGroup(ctx context.Context, in *pb.GetGroupRequest) (*pb.Group, error) {
+// ApplyScoreCurve adjusts the scores of the given assignment's submissions with a linear or percentile curve,
+// keeping the raw scores. The curved scores are used for final grades and exported results.
+// Access policy: Teacher of CourseID.
+func (s *AutograderService) ApplyScoreCurve(ctx context.Context, in *pb.ScoreCurve) (*pb.ScoreCurve, error) {
 	usr, err := s.getCurrentUser(ctx)
 	if err != nil {
-		s.logger.Errorf("GetGroup failed: authentication error: %v", err)
+		s.logger.Errorf("ApplyScoreCurve failed: authentication error: %v", err)
 		return nil, ErrInvalidUserInfo
 	}
-	group, err := s.getGroup(in)
-	if err != nil {
-		s.logger.Errorf("GetGroup failed: %v", err)
-		return nil, status.Error(codes.NotFound, "failed to get group")
+	if s.isArchived(in.GetCourseID()) {
+		s.logger.Errorf("ApplyScoreCurve failed: course %d is archived", in.GetCourseID())
+		return nil, ErrCourseArchived
 	}
-	if !(group.Contains(usr) || s.isTeacher(usr.GetID(), group.GetCourseID())) {
-		s.logger.Error("GetGroup failed: user is not group member or teacher")
-		return nil, status.Error(codes.PermissionDenied, "only group members and teachers can access a group")
+	curve, err := s.applyScoreCurve(usr, in)
+	if err != nil {
+		s.logger.Errorf("ApplyScoreCurve failed: %v", err)
+		return nil, status.Error(codes.InvalidArgument, "failed to apply score curve")
 	}
-	return group, nil
+	return curve, nil
 }
 
-// GetGroupsByCourse returns a list of groups created for the course id in the record request.
+// ExportResults returns a CSV or Excel file with the assignment scores and approval status
+// of all students in the given course, for import into other grading systems.
 // Access policy: Teacher of CourseID.
-func (s *AutograderService) GetGroupsByCourse(ctx context.Context, in *pb.CourseRequest) (*pb.Groups, error) {
+func (s *AutograderService) ExportResults(ctx context.Context, in *pb.ResultsExportRequest) (*pb.ResultsExport, error) {
 	usr, err := s.getCurrentUser(ctx)
 	if err != nil {
-		s.logger.Errorf("GetGroups failed: authentication error: %v", err)
+		s.logger.Errorf("ExportResults failed: authentication error: %v", err)
 		return nil, ErrInvalidUserInfo
 	}
-	courseID := in.GetCourseID()
-	if !s.isTeacher(usr.GetID(), courseID) {
-		s.logger.Error("GetGroups failed: user is not teacher")
-		return nil, status.Error(codes.PermissionDenied, "only teachers can access other groups")
-	}
-	groups, err := s.getGroups(in)
+	export, err := s.exportResults(in)
 	if err != nil {
-		s.logger.Errorf("GetGroups failed: %v", err)
-		return nil, status.Error(codes.NotFound, "failed to get groups")
+		s.logger.Errorf("ExportResults failed: %v", err)
+		return nil, status.Error(codes.NotFound, "failed to export results")
 	}
-	return groups, nil
+	s.audit(usr, in.GetCourseID(), "export results", "courseID", in.GetCourseID(), "format", in.GetFormat().String())
+	return export, nil
 }
 
-// GetGroupByUserAndCourse returns the group of the given student for a given course.
-// Access policy: Group members, Teacher of CourseID.
-func (s *AutograderService) GetGroupByUserAndCourse(ctx context.Context, in *pb.GroupRequest) (*pb.Group, error) {
+// GetCertificate returns the current user's certificate of completion for the given course.
+// The certificate is issued on the first request, if the course issues certificates,
+// the final grades have been released, and the user has passed the course.
+// Access policy: Student of CourseID.
+func (s *AutograderService) GetCertificate(ctx context.Context, in *pb.CourseRequest) (*pb.Certificate, error) {
 	usr, err := s.getCurrentUser(ctx)
 	if err != nil {
-		s.logger.Errorf("GetGroupByUserAndCourse failed: authentication error: %v", err)
+		s.logger.Errorf("GetCertificate failed: authentication error: %v", err)
 		return nil, ErrInvalidUserInfo
 	}
-	group, err := s.getGroupByUserAndCourse(in)
-	if err != nil {
-		if err != ErrUserNotInGroup {
-			s.logger.Errorf("GetGroupByUserAndCourse failed: %v", err)
-		}
-		return nil, status.Error(codes.NotFound, "failed to get group for given user and course")
+	if !s.hasCourseAccess(usr.GetID(), in.GetCourseID(), func(e *pb.Enrollment) bool {
+		return e.Status == pb.Enrollment_STUDENT
+	}) {
+		s.logger.Errorf("GetCertificate failed: user %s is not student in course %d", usr.GetLogin(), in.GetCourseID())
+		return nil, status.Error(codes.PermissionDenied, "only students can get certificates")
 	}
-	if !(group.Contains(usr) || s.isTeacher(usr.GetID(), group.GetCourseID())) {
-		s.logger.Error("GetGroupByUserAndCourse failed: user is not group member or teacher")
-		return nil, status.Error(codes.PermissionDenied, "only group members and teachers can access another group")
+	certificate, err := s.getCertificate(usr, in.GetCourseID())
+	if err != nil {
+		s.logger.Errorf("GetCertificate failed: %v", err)
+		return nil, status.Error(codes.FailedPrecondition, "failed to get certificate")
 	}
-	return group, nil
+	return certificate, nil
+}
+
+// CreateEnrollment enrolls a new student for the course specified in the request.
+// The enrollment is accepted right away if the course's automatic approval rules admit the student.
+// Access policy: Any User.
+func (s *AutograderService) CreateEnrollment(ctx context.Context, in *pb.Enrollment) (*pb.Void, error) {
+	if s.isArchived(in.GetCourseID()) {
+		s.logger.Errorf("CreateEnrollment failed: course %d is archived", in.GetCourseID())
+		return nil, ErrCourseArchived
+	}
+	if !s.hasCourseIdentity(in.GetUserID(), in.GetCourseID()) {
+		s.logger.Errorf("CreateEnrollment failed: user %d has no remote identity for the provider of course %d", in.GetUserID(), in.GetCourseID())
+		return nil, ErrMissingIdentity
+	}
+	err := s.createEnrollment(in)
+	if err != nil {
+		s.logger.Errorf("CreateEnrollment failed: %v", err)
+		if errors.Is(err, errOtherTenant) {
+			return nil, status.Error(codes.NotFound, "course not found")
+		}
+		return nil, status.Error(codes.InvalidArgument, "failed to create enrollment")
+	}
+	// the enrollment remains pending if it cannot be accepted automatically
+	if course, err := s.getCourse(in.GetCourseID()); err != nil {
+		s.logger.Errorf("CreateEnrollment: failed to get course %d: %v", in.GetCourseID(), err)
+	} else if _, err := s.autoApproveEnrollments(ctx, course); err != nil {
+		s.logger.Errorf("CreateEnrollment: automatic approval failed for course %s: %v", course.GetCode(), err)
+	}
+	return &pb.Void{}, nil
+}
+
+// UpdateEnrollment updates the enrollment status of a student as specified in the request.
+// Demoting the last teacher of a course must be confirmed by another admin with ConfirmPendingAction.
+// Access policy: Teacher of CourseID.
+func (s *AutograderService) UpdateEnrollment(ctx context.Context, in *pb.Enrollment) (*pb.Void, error) {
+	usr, scm, err := s.getUserAndSCMForCourse(ctx, in.GetCourseID())
+	if err != nil {
+		s.logger.Errorf("UpdateEnrollment failed: scm authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	if s.isCourseCreator(in.CourseID, in.UserID) {
+		s.logger.Errorf("UpdateEnrollment failed: user %s attempted to demote course creator", usr.GetName())
+		return nil, status.Error(codes.PermissionDenied, "course creator cannot be demoted")
+	}
+	if s.isArchived(in.GetCourseID()) {
+		s.logger.Errorf("UpdateEnrollment failed: course %d is archived", in.GetCourseID())
+		return nil, ErrCourseArchived
+	}
+	if in.GetStatus() != pb.Enrollment_TEACHER && s.isLastTeacher(in.GetCourseID(), in.GetUserID()) {
+		action := &pb.PendingAction{Action: pb.PendingAction_DEMOTE_TEACHER, CourseID: in.GetCourseID(), EnrolledUserID: in.GetUserID(), Status: in.GetStatus()}
+		if err := s.requestAction(usr, action); err != nil {
+			s.logger.Errorf("UpdateEnrollment failed: %v", err)
+			return nil, status.Error(codes.InvalidArgument, "failed to request demotion of teacher")
+		}
+		return nil, ErrPendingAction
+	}
+	before, _ := s.db.GetEnrollmentByCourseAndUser(in.GetCourseID(), in.GetUserID())
+	err = s.updateEnrollment(ctx, scm, usr.Login, in)
+	if err != nil {
+		s.logger.Errorf("UpdateEnrollment failed: %v", err)
+		if contextCanceled(ctx) {
+			return nil, status.Error(codes.FailedPrecondition, ErrContextCanceled)
+		}
+		if ok, parsedErr := parseSCMError(err); ok {
+			return nil, parsedErr
+		}
+		return nil, status.Error(codes.InvalidArgument, "failed to update enrollment")
+	}
+	// the enrollment is deleted if it was rejected
+	after, _ := s.db.GetEnrollmentByCourseAndUser(in.GetCourseID(), in.GetUserID())
+	s.auditChange(usr, in.GetCourseID(), "update enrollment", enrollmentRecord(before), enrollmentRecord(after),
+		"user", before.GetUser().GetLogin())
+	return &pb.Void{}, nil
+}
+
+// UpdateEnrollments changes status of all pending enrollments for the given course to approved
+// Access policy: Teacher of CourseID
+func (s *AutograderService) UpdateEnrollments(ctx context.Context, in *pb.CourseRequest) (*pb.Void, error) {
+	usr, scm, err := s.getUserAndSCMForCourse(ctx, in.GetCourseID())
+	if err != nil {
+		s.logger.Errorf("UpdateEnrollments failed: scm authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	if s.isArchived(in.GetCourseID()) {
+		s.logger.Errorf("UpdateEnrollments failed: course %d is archived", in.GetCourseID())
+		return nil, ErrCourseArchived
+	}
+	err = s.updateEnrollments(ctx, scm, in.GetCourseID())
+	if err != nil {
+		s.logger.Errorf("UpdateEnrollments failed: %v", err)
+		if contextCanceled(ctx) {
+			return nil, status.Error(codes.FailedPrecondition, ErrContextCanceled)
+		}
+		if ok, parsedErr := parseSCMError(err); ok {
+			return nil, parsedErr
+		}
+		err = status.Error(codes.InvalidArgument, "failed to update pending enrollments")
+	} else {
+		s.audit(usr, in.GetCourseID(), "accept pending enrollments")
+	}
+	return &pb.Void{}, err
+}
+
+// RestoreEnrollment restores a rejected enrollment of the given user in the given course.
+// The enrollment is restored as pending, and must be accepted again.
+// Access policy: Admin.
+func (s *AutograderService) RestoreEnrollment(ctx context.Context, in *pb.Enrollment) (*pb.Enrollment, error) {
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("RestoreEnrollment failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	enrollment, err := s.restoreEnrollment(usr, in)
+	if err != nil {
+		s.logger.Errorf("RestoreEnrollment failed: %v", err)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Error(codes.NotFound, "no deleted enrollment found")
+		}
+		return nil, status.Error(codes.InvalidArgument, "failed to restore enrollment")
+	}
+	return enrollment, nil
+}
+
+// ImportEnrollments enrolls the students listed in the CSV in the given course.
+// Students that have not yet logged in are pre-enrolled and enrolled on their first login.
+// Access policy: Teacher of CourseID.
+func (s *AutograderService) ImportEnrollments(ctx context.Context, in *pb.ImportEnrollmentsRequest) (*pb.EnrollmentImportReport, error) {
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("ImportEnrollments failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	courseID := in.GetCourseID()
+	if s.isArchived(courseID) {
+		s.logger.Errorf("ImportEnrollments failed: course %d is archived", courseID)
+		return nil, ErrCourseArchived
+	}
+	report, err := s.importEnrollments(in)
+	if err != nil {
+		s.logger.Errorf("ImportEnrollments failed: %v", err)
+		return nil, status.Error(codes.InvalidArgument, "failed to import enrollments")
+	}
+	s.audit(usr, courseID, "import enrollments", "enrolled", report.GetEnrolled(), "unmatched", len(report.GetUnmatched()), "errors", len(report.GetErrors()))
+	if course, err := s.getCourse(courseID); err != nil {
+		s.logger.Errorf("ImportEnrollments: failed to get course %d: %v", courseID, err)
+	} else if _, err := s.autoApproveEnrollments(ctx, course); err != nil {
+		s.logger.Errorf("ImportEnrollments: automatic approval failed for course %s: %v", course.GetCode(), err)
+	}
+	return report, nil
+}
+
+// GetCoursesByUser returns all courses the given user is enrolled into with the given status.
+// Access policy: Any User.
+func (s *AutograderService) GetCoursesByUser(ctx context.Context, in *pb.EnrollmentStatusRequest) (*pb.Courses, error) {
+	courses, err := s.getCoursesByUser(in)
+	if err != nil {
+		s.logger.Errorf("GetCoursesWithEnrollment failed: %v", err)
+		return nil, status.Error(codes.NotFound, "no courses with enrollment found")
+	}
+	return courses, nil
+}
+
+// GetEnrollmentsByUser returns all enrollments for the given user and enrollment status with preloaded courses and groups.
+// Access policy: user with userID or admin
+func (s *AutograderService) GetEnrollmentsByUser(ctx context.Context, in *pb.EnrollmentStatusRequest) (*pb.Enrollments, error) {
+	// get all enrollments from the db (no scm)
+	enrols, err := s.getEnrollmentsByUser(in)
+	if err != nil {
+		s.logger.Errorf("Get enrollments for user %d failed: %v", in.GetUserID(), err)
+	}
+	return enrols, nil
+}
+
+// GetUserProgress returns the current user's progress in each course the user is enrolled in
+// as a student: the number of approved assignments, the total score, the slip days used and
+// remaining, and the upcoming deadlines.
+// Access policy: Any User.
+func (s *AutograderService) GetUserProgress(ctx context.Context, _ *pb.Void) (*pb.UserProgress, error) {
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("GetUserProgress failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	progress, err := s.userProgress(usr.GetID(), time.Now())
+	if err != nil {
+		s.logger.Errorf("GetUserProgress failed: %v", err)
+		return nil, status.Error(codes.NotFound, "failed to get progress")
+	}
+	return progress, nil
+}
+
+// GetSlipDays returns the student's use of the course's slip-day budget.
+// Access policy: Teacher or TA of CourseID, User with UserID.
+func (s *AutograderService) GetSlipDays(ctx context.Context, in *pb.SlipDaysRequest) (*pb.SlipDays, error) {
+	enrollment, err := s.db.GetEnrollmentByCourseAndUser(in.GetCourseID(), in.GetUserID())
+	if err != nil {
+		s.logger.Errorf("GetSlipDays failed: %v", err)
+		return nil, status.Error(codes.NotFound, "user not enrolled in course")
+	}
+	enrollment.SetSlipDays(enrollment.GetCourse())
+	return &pb.SlipDays{
+		CourseID:     in.GetCourseID(),
+		UserID:       in.GetUserID(),
+		Budget:       enrollment.GetCourse().GetSlipDays(),
+		Used:         enrollment.TotalSlipDays(),
+		Remaining:    enrollment.GetSlipDaysRemaining(),
+		UsedSlipDays: enrollment.GetUsedSlipDays(),
+	}, nil
+}
+
+// GetEnrollmentsByCoursereturns all enrollments for the course specified in the request.
+// Access policy: Teacher or student of CourseID.
+func (s *AutograderService) GetEnrollmentsByCourse(ctx context.Context, in *pb.EnrollmentRequest) (*pb.Enrollments, error) {
+	page, err := newPage(in.GetPageSize(), in.GetPageToken())
+	if err != nil {
+		s.logger.Errorf("GetEnrollmentsByCourse failed: %v", err)
+		return nil, status.Error(codes.InvalidArgument, "invalid page token")
+	}
+
+	enrolls, err := s.getEnrollmentsByCourse(in, page)
+	if err != nil {
+		s.logger.Errorf("GetEnrollmentsByCourse failed: %v", err)
+		return nil, status.Error(codes.InvalidArgument, "failed to get enrollments for given course")
+	}
+	return enrolls, nil
+}
+
+// GetGroup returns information about a group.
+// Access policy: Group members, Teacher or TA of CourseID.
+func (s *AutograderService) GetGroup(ctx context.Context, in *pb.GetGroupRequest) (*pb.Group, error) {
+	group, err := s.getGroup(in)
+	if err != nil {
+		s.logger.Errorf("GetGroup failed: %v", err)
+		return nil, status.Error(codes.NotFound, "failed to get group")
+	}
+	return group, nil
+}
+
+// GetGroupsByCourse returns a list of groups created for the course id in the record request.
+// Access policy: Teacher or TA of CourseID.
+func (s *AutograderService) GetGroupsByCourse(ctx context.Context, in *pb.CourseRequest) (*pb.Groups, error) {
+	groups, err := s.getGroups(in)
+	if err != nil {
+		s.logger.Errorf("GetGroups failed: %v", err)
+		return nil, status.Error(codes.NotFound, "failed to get groups")
+	}
+	return groups, nil
+}
+
+// GetGroupByUserAndCourse returns the group of the given student for a given course.
+// Access policy: Group members, Teacher or TA of CourseID.
+func (s *AutograderService) GetGroupByUserAndCourse(ctx context.Context, in *pb.GroupRequest) (*pb.Group, error) {
+	group, err := s.getGroupByUserAndCourse(in)
+	if err != nil {
+		if err != ErrUserNotInGroup {
+			s.logger.Errorf("GetGroupByUserAndCourse failed: %v", err)
+		}
+		return nil, status.Error(codes.NotFound, "failed to get group for given user and course")
+	}
+	return group, nil
 }
 
 // CreateGroup creates a new group in the database.
@@ -421,20 +1082,28 @@ func (s *AutograderService) CreateGroup(ctx context.Context, in *pb.Group) (*pb.
 		s.logger.Errorf("CreateGroup failed: authentication error: %v", err)
 		return nil, ErrInvalidUserInfo
 	}
-	if !s.isEnrolled(usr.GetID(), in.GetCourseID()) {
-		s.logger.Errorf("CreateGroup failed: user %s not enrolled in course %d", usr.GetLogin(), in.GetCourseID())
-		return nil, status.Error(codes.PermissionDenied, "user not enrolled in given course")
-	}
 	if !(in.Contains(usr) || s.isTeacher(usr.GetID(), in.GetCourseID())) {
 		s.logger.Error("CreateGroup failed: user is not group member or teacher")
 		return nil, status.Error(codes.PermissionDenied, "only group member or teacher can create group")
 	}
-	group, err := s.createGroup(in)
+	if s.isArchived(in.GetCourseID()) {
+		s.logger.Errorf("CreateGroup failed: course %d is archived", in.GetCourseID())
+		return nil, ErrCourseArchived
+	}
+	var group *pb.Group
+	if s.isTeacher(usr.GetID(), in.GetCourseID()) {
+		group, err = s.createGroup(in)
+	} else {
+		group, err = s.createInvitingGroup(ctx, usr, in)
+	}
 	if err != nil {
 		if err == ErrGroupNameDuplicate {
 			return nil, err
 		}
 		s.logger.Errorf("CreateGroup failed: %v", err)
+		if errors.Is(err, errGroupSize) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
 		return nil, status.Error(codes.InvalidArgument, "failed to create group")
 	}
 	return group, nil
@@ -448,10 +1117,11 @@ func (s *AutograderService) UpdateGroup(ctx context.Context, in *pb.Group) (*pb.
 		s.logger.Errorf("UpdateGroup failed: scm authentication error: %v", err)
 		return nil, ErrInvalidUserInfo
 	}
-	if !s.isTeacher(usr.GetID(), in.GetCourseID()) {
-		s.logger.Error("UpdateGroup failed: user is not teacher")
-		return nil, status.Error(codes.PermissionDenied, "only teachers can update groups")
+	if s.isArchived(in.GetCourseID()) {
+		s.logger.Errorf("UpdateGroup failed: course %d is archived", in.GetCourseID())
+		return nil, ErrCourseArchived
 	}
+	before, _ := s.db.GetGroup(in.GetID())
 	err = s.updateGroup(ctx, scm, in)
 	if err != nil {
 		s.logger.Errorf("UpdateGroup failed: %v", err)
@@ -461,12 +1131,18 @@ func (s *AutograderService) UpdateGroup(ctx context.Context, in *pb.Group) (*pb.
 		if ok, parsedErr := parseSCMError(err); ok {
 			return nil, parsedErr
 		}
+		if errors.Is(err, errGroupSize) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
 		return nil, status.Error(codes.InvalidArgument, "failed to update group")
 	}
+	after, _ := s.db.GetGroup(in.GetID())
+	s.auditChange(usr, in.GetCourseID(), "update group", groupRecord(before), groupRecord(after))
 	return &pb.Void{}, nil
 }
 
-// DeleteGroup removes group record from the database.
+// DeleteGroup removes group record from the database. Groups with submissions
+// are deleted when another admin confirms the request with ConfirmPendingAction.
 // Access policy: Teacher of CourseID.
 func (s *AutograderService) DeleteGroup(ctx context.Context, in *pb.GroupRequest) (*pb.Void, error) {
 	usr, scm, err := s.getUserAndSCMForCourse(ctx, in.GetCourseID())
@@ -479,11 +1155,15 @@ func (s *AutograderService) DeleteGroup(ctx context.Context, in *pb.GroupRequest
 		s.logger.Errorf("DeleteGroup failed: %v", err)
 		return nil, status.Error(codes.NotFound, "failed to get group")
 	}
-	if !s.isTeacher(usr.GetID(), grp.GetCourseID()) {
-		s.logger.Error("DeleteGroup failed: user is not teacher")
-		return nil, status.Error(codes.PermissionDenied, "only teachers can delete groups")
+	if s.hasGroupSubmissions(grp.GetID()) {
+		action := &pb.PendingAction{Action: pb.PendingAction_DELETE_GROUP, CourseID: grp.GetCourseID(), GroupID: grp.GetID()}
+		if err := s.requestAction(usr, action); err != nil {
+			s.logger.Errorf("DeleteGroup failed: %v", err)
+			return nil, status.Error(codes.InvalidArgument, "failed to request group deletion")
+		}
+		return nil, ErrPendingAction
 	}
-	if err = s.deleteGroup(ctx, scm, in); err != nil {
+	if err = s.deleteGroup(ctx, scm, usr, in); err != nil {
 		s.logger.Errorf("DeleteGroup failed: %v", err)
 		if contextCanceled(ctx) {
 			return nil, status.Error(codes.FailedPrecondition, ErrContextCanceled)
@@ -496,38 +1176,170 @@ func (s *AutograderService) DeleteGroup(ctx context.Context, in *pb.GroupRequest
 	return &pb.Void{}, nil
 }
 
+// InviteToGroup invites a student to join the current user's group, which must not be approved.
+// Access policy: Member of GroupID.
+func (s *AutograderService) InviteToGroup(ctx context.Context, in *pb.GroupInvitation) (*pb.GroupInvitation, error) {
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("InviteToGroup failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	group, err := s.db.GetGroup(in.GetGroupID())
+	if err != nil || group.GetCourseID() != in.GetCourseID() {
+		s.logger.Errorf("InviteToGroup failed: group %d not found in course %d: %v", in.GetGroupID(), in.GetCourseID(), err)
+		return nil, status.Error(codes.NotFound, "failed to get group")
+	}
+	if !group.Contains(usr) {
+		s.logger.Errorf("InviteToGroup failed: user %s is not member of group %d", usr.GetLogin(), group.GetID())
+		return nil, status.Error(codes.PermissionDenied, "only group members can invite to the group")
+	}
+	if s.isArchived(in.GetCourseID()) {
+		s.logger.Errorf("InviteToGroup failed: course %d is archived", in.GetCourseID())
+		return nil, ErrCourseArchived
+	}
+	invitation, err := s.inviteToGroup(ctx, usr, group, in.GetUserID())
+	if err != nil {
+		s.logger.Errorf("InviteToGroup failed: %v", err)
+		if errors.Is(err, errGroupSize) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Error(codes.InvalidArgument, "failed to invite to group")
+	}
+	return invitation, nil
+}
+
+// RespondToGroupInvitation accepts or declines an invitation to join a group, given by the
+// invitation's status. The group is submitted for approval when no invitations are pending.
+// Access policy: Invited User of ID.
+func (s *AutograderService) RespondToGroupInvitation(ctx context.Context, in *pb.GroupInvitation) (*pb.Void, error) {
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("RespondToGroupInvitation failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	invitation, err := s.db.GetGroupInvitation(in.GetID())
+	if err != nil || invitation.GetCourseID() != in.GetCourseID() {
+		s.logger.Errorf("RespondToGroupInvitation failed: invitation %d not found in course %d: %v", in.GetID(), in.GetCourseID(), err)
+		return nil, status.Error(codes.NotFound, "failed to get group invitation")
+	}
+	if !usr.IsOwner(invitation.GetUserID()) {
+		s.logger.Errorf("RespondToGroupInvitation failed: user %s is not invited by invitation %d", usr.GetLogin(), invitation.GetID())
+		return nil, status.Error(codes.PermissionDenied, "only the invited user can answer a group invitation")
+	}
+	if s.isArchived(in.GetCourseID()) {
+		s.logger.Errorf("RespondToGroupInvitation failed: course %d is archived", in.GetCourseID())
+		return nil, ErrCourseArchived
+	}
+	var accept bool
+	switch in.GetStatus() {
+	case pb.GroupInvitation_ACCEPTED:
+		accept = true
+	case pb.GroupInvitation_DECLINED:
+	default:
+		s.logger.Errorf("RespondToGroupInvitation failed: invalid response %s", in.GetStatus())
+		return nil, status.Error(codes.InvalidArgument, "invitation can only be accepted or declined")
+	}
+	if err := s.respondToGroupInvitation(invitation, accept); err != nil {
+		s.logger.Errorf("RespondToGroupInvitation failed: %v", err)
+		if errors.Is(err, errGroupSize) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return nil, status.Error(codes.FailedPrecondition, "failed to answer group invitation")
+	}
+	return &pb.Void{}, nil
+}
+
+// GetGroupInvitations returns the group invitations of the given course. Teaching staff get all
+// invitations, while students get the invitations they have received and those to their own group.
+// Access policy: Any User enrolled in CourseID.
+func (s *AutograderService) GetGroupInvitations(ctx context.Context, in *pb.CourseRequest) (*pb.GroupInvitations, error) {
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("GetGroupInvitations failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	invitations, err := s.getGroupInvitations(usr, in.GetCourseID())
+	if err != nil {
+		s.logger.Errorf("GetGroupInvitations failed: %v", err)
+		return nil, status.Error(codes.NotFound, "failed to get group invitations")
+	}
+	return invitations, nil
+}
+
+// GenerateGroups partitions the course's accepted students that are not in a group into groups
+// of at most the given size, at random or balanced by their scores so far, and approves the groups,
+// creating their repositories and teams. The report lists the groups created and any failures.
+// Access policy: Teacher of CourseID.
+func (s *AutograderService) GenerateGroups(ctx context.Context, in *pb.GenerateGroupsRequest) (*pb.GenerateGroupsReport, error) {
+	usr, scm, err := s.getUserAndSCMForCourse(ctx, in.GetCourseID())
+	if err != nil {
+		s.logger.Errorf("GenerateGroups failed: scm authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	if s.isArchived(in.GetCourseID()) {
+		s.logger.Errorf("GenerateGroups failed: course %d is archived", in.GetCourseID())
+		return nil, ErrCourseArchived
+	}
+	report, err := s.generateGroups(ctx, scm, in)
+	if err != nil {
+		s.logger.Errorf("GenerateGroups failed: %v", err)
+		return nil, status.Error(codes.InvalidArgument, "failed to generate groups")
+	}
+	for _, e := range report.GetErrors() {
+		s.logger.Errorf("GenerateGroups: %s", e)
+	}
+	s.audit(usr, in.GetCourseID(), "generate groups", "groupSize", in.GetGroupSize(), "balanced", in.GetBalanced(), "groups", len(report.GetGroups()), "errors", len(report.GetErrors()))
+	return report, nil
+}
+
+// RestoreGroup restores a deleted group with its members. The group is restored as pending,
+// and must be approved again to recreate its repository and team.
+// Access policy: Admin.
+func (s *AutograderService) RestoreGroup(ctx context.Context, in *pb.GroupRequest) (*pb.Group, error) {
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("RestoreGroup failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	group, err := s.restoreGroup(usr, in)
+	if err != nil {
+		s.logger.Errorf("RestoreGroup failed: %v", err)
+		switch {
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			return nil, status.Error(codes.NotFound, "no deleted group found")
+		case err == database.ErrDuplicateGroup:
+			return nil, err
+		}
+		return nil, status.Error(codes.InvalidArgument, "failed to restore group: members have left the course or joined another group")
+	}
+	return group, nil
+}
+
 // GetSubmissions returns the submissions matching the query encoded in the action request.
 // Access policy:
-// Admin enrolled in CourseID,
-// Current User if Owner of submission,
+// Current User if Owner of submission and enrolled in CourseID,
 // Current User if member of group for group submission,
-// Teacher of CourseID.
+// Teacher or TA of CourseID.
 func (s *AutograderService) GetSubmissions(ctx context.Context, in *pb.SubmissionRequest) (*pb.Submissions, error) {
 	usr, err := s.getCurrentUser(ctx)
 	if err != nil {
 		s.logger.Errorf("GetSubmissions failed: authentication error: %v", err)
 		return nil, ErrInvalidUserInfo
 	}
-
-	// grp may be nil if there is no group ID in request; this is fine, since the grp.Contains() returns false in this case.
-	grp, _ := s.getGroup(&pb.GetGroupRequest{GroupID: in.GetGroupID()})
-
-	// ensure that current user is teacher, enrolled admin, or the current user is owner of the submission request
-	if !s.hasCourseAccess(usr.GetID(), in.GetCourseID(), func(e *pb.Enrollment) bool {
-		switch e.Status {
-		case pb.Enrollment_TEACHER:
-			return true
-		case pb.Enrollment_STUDENT:
-			return usr.IsAdmin || usr.IsOwner(in.GetUserID()) || grp.Contains(usr)
-		}
-		return false
-	}) {
-		s.logger.Errorf("GetSubmissions failed: user %s is not teacher or submission author", usr.GetLogin())
+	// owners must also be enrolled in the course; this is not covered by the access rules
+	if !s.isEnrolled(usr.GetID(), in.GetCourseID()) {
+		s.logger.Errorf("GetSubmissions failed: user %s is not enrolled in course %d", usr.GetLogin(), in.GetCourseID())
 		return nil, status.Error(codes.PermissionDenied, "only owner and teachers can get submissions")
 	}
 	s.logger.Debugf("GetSubmissions: %v", in)
+	page, err := newPage(in.GetPageSize(), in.GetPageToken())
+	if err != nil {
+		s.logger.Errorf("GetSubmissions failed: %v", err)
+		return nil, status.Error(codes.InvalidArgument, "invalid page token")
+	}
 
-	submissions, err := s.getSubmissions(in)
+	hideReviews := !s.isTeachingStaff(usr.GetID(), in.GetCourseID())
+	submissions, err := s.getSubmissions(in, page, hideReviews)
 	if err != nil {
 		s.logger.Errorf("GetSubmissions failed: %v", err)
 		return nil, status.Error(codes.NotFound, "no submissions found")
@@ -535,376 +1347,1716 @@ func (s *AutograderService) GetSubmissions(ctx context.Context, in *pb.Submissio
 	return submissions, nil
 }
 
-// GetSubmissionsByCourse returns all the latest submissions
-// for every individual or group course assignment for all course students/groups.
-// Access policy: Admin enrolled in CourseID, Teacher of CourseID.
-func (s *AutograderService) GetSubmissionsByCourse(ctx context.Context, in *pb.SubmissionsForCourseRequest) (*pb.CourseSubmissions, error) {
-	usr, err := s.getCurrentUser(ctx)
+// SubmissionStream sends the submission results recorded for the given course
+// as the tests finish, until the client closes the stream.
+// Access policy: Teacher of CourseID receives all results; students receive
+// the results of their own submissions and their group's submissions.
+func (s *AutograderService) SubmissionStream(in *pb.SubmissionStreamRequest, stream pb.AutograderService_SubmissionStreamServer) error {
+	usr, err := s.getCurrentUser(stream.Context())
 	if err != nil {
-		s.logger.Errorf("GetSubmissionsByCourse failed: authentication error: %v", err)
-		return nil, ErrInvalidUserInfo
+		s.logger.Errorf("SubmissionStream failed: authentication error: %v", err)
+		return ErrInvalidUserInfo
+	}
+	if !in.IsValid() {
+		return status.Error(codes.InvalidArgument, "invalid payload")
+	}
+	filter, err := s.submissionStreamFilter(usr, in.GetCourseID())
+	if err != nil {
+		s.logger.Errorf("SubmissionStream failed: user %s: %v", usr.GetLogin(), err)
+		return status.Error(codes.PermissionDenied, "only course teachers and students can stream submissions")
+	}
+	hideReviews := !s.isTeachingStaff(usr.GetID(), in.GetCourseID())
+	if err := s.streamSubmissions(stream, in.GetCourseID(), filter, hideReviews); err != nil {
+		s.logger.Errorf("SubmissionStream failed: %v", err)
+		return status.Error(codes.Unavailable, "failed to stream submissions")
 	}
-	// TODO(meling) This is a hack to give access to cmd/approvelist via the root usr admin
-	// Normally, the root admin should not have access to submissions for all courses.
-	//	if usr.IsAdmin {
-	//		goto BYPASS
-	//	}
+	return nil
+}
 
-	// ensure that current user is teacher or enrolled admin to process the submission request
-	if !s.hasCourseAccess(usr.GetID(), in.GetCourseID(), func(e *pb.Enrollment) bool {
-		switch e.Status {
-		case pb.Enrollment_TEACHER:
-			return true
-		case pb.Enrollment_STUDENT:
-			return usr.IsAdmin
-		}
-		return false
-	}) {
-		s.logger.Errorf("GetSubmissionsByCourse failed: user %s is not teacher or submission author", usr.GetLogin())
-		return nil, status.Error(codes.PermissionDenied, "only teachers can get all lab submissions")
+// GetSubmissionStatus returns the queue position and estimated wait for the tests
+// of a user's or group's submission for the given assignment.
+// Access policy: Teacher or TA of CourseID, Owner of submission, or Member of group.
+func (s *AutograderService) GetSubmissionStatus(ctx context.Context, in *pb.SubmissionStatusRequest) (*pb.SubmissionStatus, error) {
+	submissionStatus, err := s.getSubmissionStatus(in)
+	if err != nil {
+		s.logger.Errorf("GetSubmissionStatus failed: %v", err)
+		return nil, status.Error(codes.NotFound, "failed to get submission status")
 	}
-	//BYPASS:
-	s.logger.Debugf("GetSubmissionsByCourse: %v", in)
+	return submissionStatus, nil
+}
 
-	courseLinks, err := s.getAllCourseSubmissions(in)
+// GetSubmissionArtifacts returns the artifacts produced by the most recent test run
+// of the given submission, such as the full build log, with their download URLs.
+// Access policy: Teacher or TA of CourseID, Owner of submission, or Member of group.
+func (s *AutograderService) GetSubmissionArtifacts(ctx context.Context, in *pb.ArtifactRequest) (*pb.Artifacts, error) {
+	usr, err := s.getCurrentUser(ctx)
 	if err != nil {
-		s.logger.Errorf("GetSubmissionsByCourse failed: %v", err)
+		s.logger.Errorf("GetSubmissionArtifacts failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	submission, err := s.db.GetSubmission(&pb.Submission{ID: in.GetSubmissionID()})
+	if err != nil || !s.canAccessSubmission(usr, in.GetCourseID(), submission) {
+		s.logger.Errorf("GetSubmissionArtifacts failed: user %s is not teacher or author of submission %d", usr.GetLogin(), in.GetSubmissionID())
+		return nil, status.Error(codes.PermissionDenied, "only owner and teachers can get submission artifacts")
+	}
+	submissionArtifacts, err := s.getArtifacts(in.GetSubmissionID())
+	if err != nil {
+		s.logger.Errorf("GetSubmissionArtifacts failed: %v", err)
+		return nil, status.Error(codes.NotFound, "failed to get submission artifacts")
+	}
+	return submissionArtifacts, nil
+}
+
+// GetTestResults returns the results of the scored tests in the most recent test run of the
+// given submission, with the status, duration and output of each test.
+// Access policy: Teacher or TA of CourseID, Owner of submission, or Member of group.
+func (s *AutograderService) GetTestResults(ctx context.Context, in *pb.TestResultRequest) (*pb.TestResults, error) {
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("GetTestResults failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	submission, err := s.db.GetSubmission(&pb.Submission{ID: in.GetSubmissionID()})
+	if err != nil || !s.canAccessSubmission(usr, in.GetCourseID(), submission) {
+		s.logger.Errorf("GetTestResults failed: user %s is not teacher or author of submission %d", usr.GetLogin(), in.GetSubmissionID())
+		return nil, status.Error(codes.PermissionDenied, "only owner and teachers can get test results")
+	}
+	results, err := s.db.GetTestResults(in.GetSubmissionID())
+	if err != nil {
+		s.logger.Errorf("GetTestResults failed: %v", err)
+		return nil, status.Error(codes.NotFound, "failed to get test results")
+	}
+	return &pb.TestResults{TestResults: results}, nil
+}
+
+// GetTaskScores returns the scores of the given submission on each of the tasks of
+// its assignment, as recorded for the most recent test run of the submission.
+// Access policy: Teacher or TA of CourseID, Owner of submission, or Member of group.
+func (s *AutograderService) GetTaskScores(ctx context.Context, in *pb.TaskScoreRequest) (*pb.TaskScores, error) {
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("GetTaskScores failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	submission, err := s.db.GetSubmission(&pb.Submission{ID: in.GetSubmissionID()})
+	if err != nil || !s.canAccessSubmission(usr, in.GetCourseID(), submission) {
+		s.logger.Errorf("GetTaskScores failed: user %s is not teacher or author of submission %d", usr.GetLogin(), in.GetSubmissionID())
+		return nil, status.Error(codes.PermissionDenied, "only owner and teachers can get task scores")
+	}
+	scores, err := s.db.GetTaskScores(in.GetSubmissionID())
+	if err != nil {
+		s.logger.Errorf("GetTaskScores failed: %v", err)
+		return nil, status.Error(codes.NotFound, "failed to get task scores")
+	}
+	return &pb.TaskScores{TaskScores: scores}, nil
+}
+
+// GetSubmissionsByCourse returns all the latest submissions
+// for every individual or group course assignment for all course students/groups.
+// Access policy: Admin, Teacher or TA of CourseID.
+func (s *AutograderService) GetSubmissionsByCourse(ctx context.Context, in *pb.SubmissionsForCourseRequest) (*pb.CourseSubmissions, error) {
+	s.logger.Debugf("GetSubmissionsByCourse: %v", in)
+
+	courseLinks, err := s.getAllCourseSubmissions(in)
+	if err != nil {
+		s.logger.Errorf("GetSubmissionsByCourse failed: %v", err)
 		return nil, status.Error(codes.NotFound, "no submissions found")
 	}
-	return courseLinks, nil
+	return courseLinks, nil
+}
+
+// GetCourseResults returns the results matrix of the given course, holding the latest submission
+// of each student and group to each of the course's assignments.
+// Access policy: Teacher or TA of CourseID.
+func (s *AutograderService) GetCourseResults(ctx context.Context, in *pb.CourseRequest) (*pb.CourseResults, error) {
+	results, err := s.courseResults(in.GetCourseID())
+	if err != nil {
+		s.logger.Errorf("GetCourseResults failed: %v", err)
+		return nil, status.Error(codes.NotFound, "failed to get course results")
+	}
+	return results, nil
+}
+
+// GetCourseStatistics returns aggregates of the submissions to each of the course's assignments:
+// the number of submissions, pass rate, average score, score distribution and submissions per day.
+// Access policy: Teacher or TA of CourseID.
+func (s *AutograderService) GetCourseStatistics(ctx context.Context, in *pb.CourseRequest) (*pb.CourseStatistics, error) {
+	statistics, err := s.db.GetCourseStatistics(in.GetCourseID())
+	if err != nil {
+		s.logger.Errorf("GetCourseStatistics failed: %v", err)
+		return nil, status.Error(codes.NotFound, "failed to get course statistics")
+	}
+	return statistics, nil
+}
+
+// GetTestFailureStats returns the number of times each test of the given assignment passed and failed
+// in the students' and groups' latest submissions, with the most often failed tests first.
+// Access policy: Teacher or TA of CourseID.
+func (s *AutograderService) GetTestFailureStats(ctx context.Context, in *pb.AssignmentRequest) (*pb.TestFailureStats, error) {
+	assignment, err := s.getAssignmentInCourse(in.GetAssignmentID(), in.GetCourseID())
+	if err != nil {
+		s.logger.Errorf("GetTestFailureStats failed: %v", err)
+		return nil, status.Error(codes.NotFound, "assignment not found")
+	}
+	stats, err := s.db.GetTestFailureStats(assignment.GetID())
+	if err != nil {
+		s.logger.Errorf("GetTestFailureStats failed: %v", err)
+		return nil, status.Error(codes.NotFound, "failed to get test failure statistics")
+	}
+	return stats, nil
+}
+
+// UpdateSubmission is called to approve the given submission or to undo approval.
+// Access policy: Teacher of CourseID, TA of CourseID allowed to approve.
+func (s *AutograderService) UpdateSubmission(ctx context.Context, in *pb.UpdateSubmissionRequest) (*pb.Void, error) {
+	if !s.isValidSubmission(in.SubmissionID) {
+		s.logger.Errorf("UpdateSubmission failed: submission author has no access to the course")
+		return nil, status.Error(codes.PermissionDenied, "submission author has no course access")
+	}
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("UpdateSubmission failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	if !s.canApprove(usr.ID, in.GetCourseID()) {
+		s.logger.Error("UpdateSubmission failed: user is not teacher or teaching assistant allowed to approve")
+		return nil, status.Error(codes.PermissionDenied, "only teachers and teaching assistants allowed to approve can approve submissions")
+	}
+	if s.isArchived(in.GetCourseID()) {
+		s.logger.Errorf("UpdateSubmission failed: course %d is archived", in.GetCourseID())
+		return nil, ErrCourseArchived
+	}
+	before, _ := s.db.GetSubmission(&pb.Submission{ID: in.GetSubmissionID()})
+	newStatus := in.GetStatus()
+	var transition *pb.SubmissionTransition
+	if newStatus != before.GetStatus() {
+		// status changes are recorded in the approval trail, and approvals may need more sign-offs
+		transition, err = s.submissionTransition(usr, before, newStatus, "")
+		if err != nil {
+			s.logger.Errorf("UpdateSubmission failed: %v", err)
+			if errors.Is(err, errAlreadySignedOff) {
+				return nil, status.Error(codes.FailedPrecondition, "already signed off on submission")
+			}
+			return nil, status.Error(codes.InvalidArgument, "failed to approve submission")
+		}
+		newStatus = transition.GetStatus()
+	}
+	err = s.updateSubmission(ctx, in.GetCourseID(), in.GetSubmissionID(), newStatus, in.GetReleased(), in.GetScore())
+	if err != nil {
+		s.logger.Errorf("UpdateSubmission failed: %v", err)
+		return nil, status.Error(codes.InvalidArgument, "failed to approve submission")
+	}
+	if transition != nil {
+		if err := s.db.CreateSubmissionTransition(transition); err != nil {
+			s.logger.Errorf("UpdateSubmission failed to record transition of submission %d: %v", in.GetSubmissionID(), err)
+		}
+	}
+	after, _ := s.db.GetSubmission(&pb.Submission{ID: in.GetSubmissionID()})
+	s.auditChange(usr, in.GetCourseID(), "update submission", submissionRecord(before), submissionRecord(after))
+	return &pb.Void{}, nil
+}
+
+// RebuildSubmission rebuilds the submission with the given ID, running the current tests
+// for the submission's commit. Students can only rebuild their own submissions, and only
+// when the tests of their submissions for the assignment are not already queued.
+// Access policy: Teacher or TA allowed to approve in the submission's course, Author of the submission.
+func (s *AutograderService) RebuildSubmission(ctx context.Context, in *pb.RebuildRequest) (*pb.Submission, error) {
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("RebuildSubmission failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	if !s.isValidSubmission(in.GetSubmissionID()) {
+		s.logger.Errorf("RebuildSubmission failed: submitter has no access to the course")
+		return nil, status.Error(codes.PermissionDenied, "submitter has no course access")
+	}
+	submission, err := s.getRebuildSubmission(in)
+	if err != nil {
+		s.logger.Errorf("RebuildSubmission failed: %v", err)
+		return nil, status.Error(codes.InvalidArgument, "failed to rebuild submission")
+	}
+	assignment, err := s.db.GetAssignment(&pb.Assignment{ID: submission.GetAssignmentID()})
+	if err != nil {
+		s.logger.Errorf("RebuildSubmission failed: %v", err)
+		return nil, status.Error(codes.NotFound, "failed to get assignment")
+	}
+	priority, err := s.rebuildPriority(usr, submission, assignment)
+	switch {
+	case errors.Is(err, errRebuildQueued):
+		s.logger.Errorf("RebuildSubmission failed: %v", err)
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	case err != nil:
+		s.logger.Errorf("RebuildSubmission failed: user %s cannot rebuild submission %d: %v", usr.GetLogin(), submission.GetID(), err)
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+	rebuilt, err := s.rebuildSubmission(in, priority)
+	if err != nil {
+		s.logger.Errorf("RebuildSubmission failed: %v", err)
+		return nil, status.Error(codes.InvalidArgument, "failed to rebuild submission")
+	}
+	return rebuilt, nil
+}
+
+// ReplaySubmissions replays the tests for a sample of the course's most recent submissions
+// on a staging runner pool, and reports the differences from the recorded test scores.
+// Access policy: Admin.
+func (s *AutograderService) ReplaySubmissions(ctx context.Context, in *pb.ReplayRequest) (*pb.ReplayReport, error) {
+	report, err := s.replaySubmissions(in)
+	if err != nil {
+		s.logger.Errorf("ReplaySubmissions failed: %v", err)
+		return nil, status.Error(codes.InvalidArgument, "failed to replay submissions")
+	}
+	return report, nil
+}
+
+// RebuildSubmissions runs tests for all submissions for the given assignment ID.
+// Access policy: Teacher of CourseID.
+func (s *AutograderService) RebuildSubmissions(ctx context.Context, in *pb.AssignmentRequest) (*pb.Void, error) {
+	if err := s.rebuildSubmissions(in); err != nil {
+		s.logger.Errorf("RebuildSubmissions failed: %v", err)
+		return nil, status.Error(codes.InvalidArgument, "failed to rebuild submissions")
+	}
+	return &pb.Void{}, nil
+}
+
+// ProcessMissedPushes processes the commits pushed to the course's student and group repositories
+// since the given time, as if their push events had been received, to recover from webhook outages.
+// Commits that have already been submitted are not tested again. The report lists any failures.
+// Access policy: Teacher of CourseID.
+func (s *AutograderService) ProcessMissedPushes(ctx context.Context, in *pb.MissedPushesRequest) (*pb.MissedPushesReport, error) {
+	usr, scm, err := s.getUserAndSCMForCourse(ctx, in.GetCourseID())
+	if err != nil {
+		s.logger.Errorf("ProcessMissedPushes failed: scm authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	if s.isArchived(in.GetCourseID()) {
+		s.logger.Errorf("ProcessMissedPushes failed: course %d is archived", in.GetCourseID())
+		return nil, ErrCourseArchived
+	}
+	report, err := s.processMissedPushes(ctx, scm, in)
+	if err != nil {
+		s.logger.Errorf("ProcessMissedPushes failed: %v", err)
+		if contextCanceled(ctx) {
+			return nil, status.Error(codes.FailedPrecondition, ErrContextCanceled)
+		}
+		return nil, status.Error(codes.InvalidArgument, "failed to process missed pushes")
+	}
+	for _, e := range report.GetErrors() {
+		s.logger.Errorf("ProcessMissedPushes: %s", e)
+	}
+	s.audit(usr, in.GetCourseID(), "process missed pushes", "since", in.GetSince(), "pushes", report.GetPushes(), "errors", len(report.GetErrors()))
+	return report, nil
+}
+
+// GetForcePushes returns all force pushes that rewrote the history of submissions in the given course.
+// Access policy: Teacher or TA of CourseID.
+func (s *AutograderService) GetForcePushes(ctx context.Context, in *pb.CourseRequest) (*pb.ForcePushes, error) {
+	forcePushes, err := s.getForcePushes(in.GetCourseID())
+	if err != nil {
+		s.logger.Errorf("GetForcePushes failed: %v", err)
+		return nil, status.Error(codes.NotFound, "failed to get force pushes")
+	}
+	return forcePushes, nil
+}
+
+// CreateAppeal opens an appeal against a released assignment grade or final grade.
+// Access policy: Student of CourseID.
+func (s *AutograderService) CreateAppeal(ctx context.Context, in *pb.Appeal) (*pb.Appeal, error) {
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("CreateAppeal failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	if !s.hasCourseAccess(usr.GetID(), in.GetCourseID(), func(e *pb.Enrollment) bool {
+		return e.Status == pb.Enrollment_STUDENT
+	}) {
+		s.logger.Errorf("CreateAppeal failed: user %s is not student in course %d", usr.GetLogin(), in.GetCourseID())
+		return nil, status.Error(codes.PermissionDenied, "only students can appeal grades")
+	}
+	appeal, err := s.createAppeal(ctx, usr, in)
+	if err != nil {
+		s.logger.Errorf("CreateAppeal failed: %v", err)
+		return nil, status.Error(codes.FailedPrecondition, "failed to create appeal")
+	}
+	return appeal, nil
+}
+
+// UpdateAppeal records the decision and rationale of an appeal.
+// Access policy: Teacher of CourseID.
+func (s *AutograderService) UpdateAppeal(ctx context.Context, in *pb.Appeal) (*pb.Void, error) {
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("UpdateAppeal failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	if err := s.updateAppeal(ctx, usr, in); err != nil {
+		s.logger.Errorf("UpdateAppeal failed: %v", err)
+		return nil, status.Error(codes.InvalidArgument, "failed to update appeal")
+	}
+	return &pb.Void{}, nil
+}
+
+// GetAppeals returns the appeals for the given course, optionally only the overdue appeals.
+// Teaching staff and admins get all appeals, while students only get their own appeals.
+// Access policy: Admin, Any User enrolled in CourseID.
+func (s *AutograderService) GetAppeals(ctx context.Context, in *pb.AppealRequest) (*pb.Appeals, error) {
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("GetAppeals failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	appeals, err := s.getAppeals(usr, in)
+	if err != nil {
+		s.logger.Errorf("GetAppeals failed: %v", err)
+		return nil, status.Error(codes.NotFound, "failed to get appeals")
+	}
+	return appeals, nil
+}
+
+// GrantExtension grants a student or group an extended deadline for an assignment,
+// replacing any previous extension of the assignment for the same student or group.
+// Access policy: Teacher of CourseID.
+func (s *AutograderService) GrantExtension(ctx context.Context, in *pb.Extension) (*pb.Extension, error) {
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("GrantExtension failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	if s.isArchived(in.GetCourseID()) {
+		s.logger.Errorf("GrantExtension failed: course %d is archived", in.GetCourseID())
+		return nil, ErrCourseArchived
+	}
+	extension, err := s.grantExtension(usr, in)
+	if err != nil {
+		s.logger.Errorf("GrantExtension failed: %v", err)
+		return nil, status.Error(codes.InvalidArgument, "failed to grant extension")
+	}
+	return extension, nil
+}
+
+// ListExtensions returns the extensions granted in the given course, optionally only for one assignment.
+// Teaching staff get all extensions, while students only get the extensions granted to themselves and their group.
+// Access policy: Any User enrolled in CourseID.
+func (s *AutograderService) ListExtensions(ctx context.Context, in *pb.ExtensionRequest) (*pb.Extensions, error) {
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("ListExtensions failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	extensions, err := s.listExtensions(usr, in)
+	if err != nil {
+		s.logger.Errorf("ListExtensions failed: %v", err)
+		return nil, status.Error(codes.NotFound, "failed to get extensions")
+	}
+	return extensions, nil
+}
+
+// RunPlagiarismCheck compares the code of the latest submissions for the given assignment
+// using the requested plagiarism checker, and returns the similarity of the submission pairs.
+// The results replace the results of the assignment's previous plagiarism check.
+// Access policy: Teacher of CourseID.
+func (s *AutograderService) RunPlagiarismCheck(ctx context.Context, in *pb.PlagiarismRequest) (*pb.PlagiarismResults, error) {
+	usr, scm, err := s.getUserAndSCMForCourse(ctx, in.GetCourseID())
+	if err != nil {
+		s.logger.Errorf("RunPlagiarismCheck failed: scm authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	results, err := s.runPlagiarismCheck(ctx, scm, usr, in)
+	if err != nil {
+		s.logger.Errorf("RunPlagiarismCheck failed: %v", err)
+		if contextCanceled(ctx) {
+			return nil, status.Error(codes.FailedPrecondition, ErrContextCanceled)
+		}
+		return nil, status.Error(codes.InvalidArgument, "failed to run plagiarism check")
+	}
+	return results, nil
+}
+
+// GetPlagiarismResults returns the results of the latest plagiarism check of the given assignment,
+// most similar submission pairs first.
+// Access policy: Teacher of CourseID.
+func (s *AutograderService) GetPlagiarismResults(ctx context.Context, in *pb.PlagiarismRequest) (*pb.PlagiarismResults, error) {
+	results, err := s.getPlagiarismResults(in)
+	if err != nil {
+		s.logger.Errorf("GetPlagiarismResults failed: %v", err)
+		return nil, status.Error(codes.NotFound, "failed to get plagiarism results")
+	}
+	return results, nil
+}
+
+// CreateBenchmark adds a new grading benchmark for an assignment
+// Access policy: Teacher of CourseID
+func (s *AutograderService) CreateBenchmark(ctx context.Context, in *pb.GradingBenchmark) (*pb.GradingBenchmark, error) {
+	bm, err := s.createBenchmark(in)
+	if err != nil {
+		s.logger.Errorf("CreateBenchmark failed for %+v: %v", in, err)
+		return nil, status.Error(codes.InvalidArgument, "failed to add benchmark")
+	}
+	return bm, nil
+}
+
+// UpdateBenchmark edits a grading benchmark for an assignment
+// Access policy: Teacher of CourseID
+func (s *AutograderService) UpdateBenchmark(ctx context.Context, in *pb.GradingBenchmark) (*pb.Void, error) {
+	err := s.updateBenchmark(in)
+	if err != nil {
+		s.logger.Errorf("UpdateBenchmark failed for %+v: %v", in, err)
+		err = status.Error(codes.InvalidArgument, "failed to update benchmark")
+	}
+	return &pb.Void{}, err
+}
+
+// DeleteBenchmark removes a grading benchmark
+// Access policy: Teacher of CourseID
+func (s *AutograderService) DeleteBenchmark(ctx context.Context, in *pb.GradingBenchmark) (*pb.Void, error) {
+	err := s.deleteBenchmark(in)
+	if err != nil {
+		s.logger.Errorf("DeleteBenchmark failed for %+v: %v", in, err)
+		err = status.Error(codes.InvalidArgument, "failed to delete benchmark")
+	}
+	return &pb.Void{}, err
+}
+
+// CreateCriterion adds a new grading criterion for an assignment
+// Access policy: Teacher of CourseID
+func (s *AutograderService) CreateCriterion(ctx context.Context, in *pb.GradingCriterion) (*pb.GradingCriterion, error) {
+	c, err := s.createCriterion(in)
+	if err != nil {
+		s.logger.Errorf("CreateCriterion failed for %+v: %v", in, err)
+		return nil, status.Error(codes.InvalidArgument, "failed to add criterion")
+	}
+	return c, nil
+}
+
+// UpdateCriterion edits a grading criterion for an assignment
+// Access policy: Teacher of CourseID
+func (s *AutograderService) UpdateCriterion(ctx context.Context, in *pb.GradingCriterion) (*pb.Void, error) {
+	err := s.updateCriterion(in)
+	if err != nil {
+		s.logger.Errorf("UpdateCriterion failed for %+v: %v", in, err)
+		err = status.Error(codes.InvalidArgument, "failed to update criterion")
+	}
+	return &pb.Void{}, err
+}
+
+// DeleteCriterion removes a grading criterion for an assignment
+// Access policy: Teacher of CourseID
+func (s *AutograderService) DeleteCriterion(ctx context.Context, in *pb.GradingCriterion) (*pb.Void, error) {
+	err := s.deleteCriterion(in)
+	if err != nil {
+		s.logger.Errorf("DeleteCriterion failed for %+v: %v", in, err)
+		err = status.Error(codes.InvalidArgument, "failed to delete criterion")
+	}
+	return &pb.Void{}, err
+}
+
+// CreateReview adds a new submission review
+// Access policy: Teacher of CourseID, TA of CourseID allowed to review
+func (s *AutograderService) CreateReview(ctx context.Context, in *pb.ReviewRequest) (*pb.Review, error) {
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("CreateReview failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	if !s.canReview(usr.ID, in.GetCourseID()) {
+		s.logger.Error("CreateReview failed: user is not teacher or teaching assistant allowed to review")
+		return nil, status.Error(codes.PermissionDenied, "only teachers and teaching assistants allowed to review can add reviews")
+	}
+	if !usr.IsOwner(in.Review.GetReviewerID()) {
+		s.logger.Errorf("CreateReview failed: current user's ID: %d, when the reviewer's ID is %d ", usr.ID, in.Review.ReviewerID)
+		return nil, status.Error(codes.PermissionDenied, "failed to create review: reviewers' IDs don't match")
+	}
+	review, err := s.createReview(in.Review)
+	if err != nil {
+		s.logger.Errorf("CreateReview failed for review %+v: %v", in, err)
+		return nil, status.Error(codes.InvalidArgument, "failed to create review")
+	}
+	return review, nil
+}
+
+// UpdateReview updates a submission review
+// Access policy: Teacher of CourseID, TA of CourseID allowed to review, Author of the given Review
+func (s *AutograderService) UpdateReview(ctx context.Context, in *pb.ReviewRequest) (*pb.Review, error) {
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("UpdateReview failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	if !s.canReview(usr.ID, in.GetCourseID()) {
+		s.logger.Error("UpdateReview failed: user is not teacher or teaching assistant allowed to review")
+		return nil, status.Error(codes.PermissionDenied, "only teachers and teaching assistants allowed to review can update reviews")
+	}
+	if !(usr.IsOwner(in.Review.GetReviewerID()) || s.isCourseCreator(in.CourseID, usr.ID)) {
+		s.logger.Errorf("UpdateReview failed: current user's ID: %d, when the original reviewer's ID is %d ", usr.ID, in.Review.ReviewerID)
+		return nil, status.Error(codes.PermissionDenied, "reviews can only be updated by original authors or course creator")
+	}
+	review, err := s.updateReview(in.Review)
+	if err != nil {
+		s.logger.Errorf("UpdateReview failed for review %+v: %v", in, err)
+		err = status.Error(codes.InvalidArgument, "failed to update review")
+	}
+	return review, err
+}
+
+// UpdateSubmissions approves and/or releases all manual reviews for student submission for the given assignment
+// with the given score.
+// Access policy: Creator of CourseID
+func (s *AutograderService) UpdateSubmissions(ctx context.Context, in *pb.UpdateSubmissionsRequest) (*pb.Void, error) {
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("UpdateSubmissions failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	if !s.isCourseCreator(in.CourseID, usr.ID) {
+		s.logger.Error("UpdateSubmissions failed: user is not teacher")
+		return nil, status.Error(codes.PermissionDenied, "only teachers can update reviews")
+	}
+	if s.isArchived(in.GetCourseID()) {
+		s.logger.Errorf("UpdateSubmissions failed: course %d is archived", in.GetCourseID())
+		return nil, ErrCourseArchived
+	}
+
+	if err = s.updateSubmissions(in); err != nil {
+		s.logger.Errorf("UpdateSubmissions failed for request %+v", in)
+		return nil, status.Error(codes.InvalidArgument, "failed to update submissions")
+	}
+	s.audit(usr, in.GetCourseID(), "update submissions",
+		"assignmentID", in.GetAssignmentID(),
+		"scoreLimit", in.GetScoreLimit(),
+		"approve", in.GetApprove(),
+		"release", in.GetRelease(),
+	)
+	return &pb.Void{}, nil
+}
+
+// TransitionSubmission moves a submission to the requested status, and records the transition,
+// with the grader's comment, in the submission's approval trail. Approvals of assignments with
+// more than one reviewer are sign-offs, which keep the submission in review until the assignment's
+// number of reviewers have signed off. The updated submission is returned.
+// Access policy: Teacher or TA allowed to approve in CourseID.
+func (s *AutograderService) TransitionSubmission(ctx context.Context, in *pb.SubmissionTransitionRequest) (*pb.Submission, error) {
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("TransitionSubmission failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	if !s.canApprove(usr.GetID(), in.GetCourseID()) {
+		s.logger.Errorf("TransitionSubmission failed: user %s is not teacher or teaching assistant allowed to approve", usr.GetLogin())
+		return nil, status.Error(codes.PermissionDenied, "only teachers and teaching assistants allowed to approve can grade submissions")
+	}
+	if s.isArchived(in.GetCourseID()) {
+		s.logger.Errorf("TransitionSubmission failed: course %d is archived", in.GetCourseID())
+		return nil, ErrCourseArchived
+	}
+	submission, err := s.getSubmissionInCourse(in.GetSubmissionID(), in.GetCourseID())
+	if err != nil {
+		s.logger.Errorf("TransitionSubmission failed: %v", err)
+		return nil, status.Error(codes.NotFound, "failed to get submission")
+	}
+	if in.GetStatus() == submission.GetStatus() {
+		s.logger.Errorf("TransitionSubmission failed: submission %d is already %s", submission.GetID(), submission.GetStatus())
+		return nil, status.Errorf(codes.FailedPrecondition, "submission is already %s", submission.GetStatus())
+	}
+	transition, err := s.submissionTransition(usr, submission, in.GetStatus(), in.GetComment())
+	if err != nil {
+		s.logger.Errorf("TransitionSubmission failed: %v", err)
+		if errors.Is(err, errAlreadySignedOff) {
+			return nil, status.Error(codes.FailedPrecondition, "already signed off on submission")
+		}
+		return nil, status.Error(codes.InvalidArgument, "failed to grade submission")
+	}
+	if err := s.applySubmissionTransition(ctx, in.GetCourseID(), submission, transition); err != nil {
+		s.logger.Errorf("TransitionSubmission failed: %v", err)
+		return nil, status.Error(codes.InvalidArgument, "failed to grade submission")
+	}
+	s.audit(usr, in.GetCourseID(), "transition submission",
+		"submissionID", submission.GetID(),
+		"previous", transition.GetPreviousStatus().String(),
+		"requested", transition.GetRequestedStatus().String(),
+		"status", transition.GetStatus().String(),
+	)
+	updated, err := s.db.GetSubmission(&pb.Submission{ID: submission.GetID()})
+	if err != nil {
+		s.logger.Errorf("TransitionSubmission failed: %v", err)
+		return nil, status.Error(codes.NotFound, "failed to get submission")
+	}
+	return updated, nil
+}
+
+// GetSubmissionTransitions returns the approval trail of a submission, i.e., its transitions, oldest first.
+// Access policy: Teacher or TA of CourseID.
+func (s *AutograderService) GetSubmissionTransitions(ctx context.Context, in *pb.SubmissionTransitionRequest) (*pb.SubmissionTransitions, error) {
+	submission, err := s.getSubmissionInCourse(in.GetSubmissionID(), in.GetCourseID())
+	if err != nil {
+		s.logger.Errorf("GetSubmissionTransitions failed: %v", err)
+		return nil, status.Error(codes.NotFound, "failed to get submission")
+	}
+	transitions, err := s.getSubmissionTransitions(submission.GetID())
+	if err != nil {
+		s.logger.Errorf("GetSubmissionTransitions failed: %v", err)
+		return nil, status.Error(codes.NotFound, "failed to get submission transitions")
+	}
+	return transitions, nil
+}
+
+// GetReviewers returns names of all active reviewers for a student submission
+// Access policy: Teacher or TA of CourseID
+func (s *AutograderService) GetReviewers(ctx context.Context, in *pb.SubmissionReviewersRequest) (*pb.Reviewers, error) {
+	reviewers, err := s.getReviewers(in.SubmissionID)
+	if err != nil {
+		s.logger.Errorf("GetReviewers failed: error fetching from database: %v", err)
+		return nil, status.Error(codes.InvalidArgument, "failed to get reviewers")
+	}
+	return &pb.Reviewers{Reviewers: reviewers}, err
+}
+
+// AssignPeerReviews assigns anonymous peer reviewers to the latest submissions of the given assignment.
+// Access policy: Teacher of CourseID.
+func (s *AutograderService) AssignPeerReviews(ctx context.Context, in *pb.AssignmentRequest) (*pb.PeerReviews, error) {
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("AssignPeerReviews failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	if s.isArchived(in.GetCourseID()) {
+		s.logger.Errorf("AssignPeerReviews failed: course %d is archived", in.GetCourseID())
+		return nil, ErrCourseArchived
+	}
+	reviews, err := s.assignPeerReviews(usr, in)
+	if err != nil {
+		s.logger.Errorf("AssignPeerReviews failed: %v", err)
+		if err == errPeerReviewsAssigned {
+			return nil, status.Error(codes.AlreadyExists, err.Error())
+		}
+		return nil, status.Error(codes.InvalidArgument, "failed to assign peer reviews")
+	}
+	return reviews, nil
+}
+
+// GetPeerReviewTasks returns the current user's peer reviews of the given assignment,
+// with the submissions to review anonymized.
+// Access policy: Student of CourseID.
+func (s *AutograderService) GetPeerReviewTasks(ctx context.Context, in *pb.AssignmentRequest) (*pb.PeerReviews, error) {
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("GetPeerReviewTasks failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	reviews, err := s.getPeerReviewTasks(usr, in)
+	if err != nil {
+		s.logger.Errorf("GetPeerReviewTasks failed: %v", err)
+		return nil, status.Error(codes.NotFound, "failed to get peer reviews")
+	}
+	return reviews, nil
+}
+
+// SubmitPeerReview records the score and feedback of a peer review assigned to the current user.
+// Access policy: Reviewer of the given PeerReview.
+func (s *AutograderService) SubmitPeerReview(ctx context.Context, in *pb.PeerReview) (*pb.PeerReview, error) {
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("SubmitPeerReview failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	if s.isArchived(in.GetCourseID()) {
+		s.logger.Errorf("SubmitPeerReview failed: course %d is archived", in.GetCourseID())
+		return nil, ErrCourseArchived
+	}
+	review, err := s.submitPeerReview(usr, in)
+	if err != nil {
+		s.logger.Errorf("SubmitPeerReview failed: %v", err)
+		return nil, status.Error(codes.InvalidArgument, "failed to submit peer review")
+	}
+	return review, nil
+}
+
+// GetPeerReviews returns the peer reviews of the given assignment. Teaching staff get all peer reviews,
+// while students get the released peer reviews of their submissions, without the reviewers' identity.
+// Access policy: Student, Teacher or TA of CourseID.
+func (s *AutograderService) GetPeerReviews(ctx context.Context, in *pb.AssignmentRequest) (*pb.PeerReviews, error) {
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("GetPeerReviews failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	reviews, err := s.getPeerReviews(usr, in)
+	if err != nil {
+		s.logger.Errorf("GetPeerReviews failed: %v", err)
+		return nil, status.Error(codes.NotFound, "failed to get peer reviews")
+	}
+	return reviews, nil
+}
+
+// ModeratePeerReview overrides the score and feedback of a peer review, and releases or withholds it.
+// Access policy: Teacher of CourseID.
+func (s *AutograderService) ModeratePeerReview(ctx context.Context, in *pb.PeerReview) (*pb.PeerReview, error) {
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("ModeratePeerReview failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	if s.isArchived(in.GetCourseID()) {
+		s.logger.Errorf("ModeratePeerReview failed: course %d is archived", in.GetCourseID())
+		return nil, ErrCourseArchived
+	}
+	review, err := s.moderatePeerReview(usr, in)
+	if err != nil {
+		s.logger.Errorf("ModeratePeerReview failed: %v", err)
+		return nil, status.Error(codes.InvalidArgument, "failed to moderate peer review")
+	}
+	return review, nil
+}
+
+// ReleasePeerReviews makes the submitted peer reviews of the given assignment visible to the submissions' authors.
+// Access policy: Teacher of CourseID.
+func (s *AutograderService) ReleasePeerReviews(ctx context.Context, in *pb.AssignmentRequest) (*pb.Void, error) {
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("ReleasePeerReviews failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	if s.isArchived(in.GetCourseID()) {
+		s.logger.Errorf("ReleasePeerReviews failed: course %d is archived", in.GetCourseID())
+		return nil, ErrCourseArchived
+	}
+	if err := s.releasePeerReviews(usr, in); err != nil {
+		s.logger.Errorf("ReleasePeerReviews failed: %v", err)
+		return nil, status.Error(codes.InvalidArgument, "failed to release peer reviews")
+	}
+	return &pb.Void{}, nil
+}
+
+// ReleaseReviews makes the ready manual reviews of the given assignment visible to the submissions' authors.
+// Access policy: Teacher of CourseID.
+func (s *AutograderService) ReleaseReviews(ctx context.Context, in *pb.AssignmentRequest) (*pb.Void, error) {
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("ReleaseReviews failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	if s.isArchived(in.GetCourseID()) {
+		s.logger.Errorf("ReleaseReviews failed: course %d is archived", in.GetCourseID())
+		return nil, ErrCourseArchived
+	}
+	if err := s.releaseReviews(usr, in); err != nil {
+		s.logger.Errorf("ReleaseReviews failed: %v", err)
+		return nil, status.Error(codes.InvalidArgument, "failed to release reviews")
+	}
+	return &pb.Void{}, nil
+}
+
+// CreateSubmissionComment comments on a line of a file in the graded commit of a submission.
+// Comments by the teaching staff can be mirrored to the submission's repository as commit comments.
+// Access policy: Teacher or permitted Teaching Assistant of CourseID, or Peer Reviewer of SubmissionID.
+func (s *AutograderService) CreateSubmissionComment(ctx context.Context, in *pb.SubmissionComment) (*pb.SubmissionComment, error) {
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("CreateSubmissionComment failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	submission, err := s.getSubmissionInCourse(in.GetSubmissionID(), in.GetCourseID())
+	if err != nil {
+		s.logger.Errorf("CreateSubmissionComment failed: %v", err)
+		return nil, status.Error(codes.NotFound, "failed to get submission")
+	}
+	if !s.canReview(usr.GetID(), in.GetCourseID()) && !s.isPeerReviewer(usr, submission.GetID()) {
+		s.logger.Errorf("CreateSubmissionComment failed: user %s cannot review submission %d", usr.GetLogin(), submission.GetID())
+		return nil, status.Error(codes.PermissionDenied, "only teaching staff and peer reviewers can comment on submissions")
+	}
+	if s.isArchived(in.GetCourseID()) {
+		s.logger.Errorf("CreateSubmissionComment failed: course %d is archived", in.GetCourseID())
+		return nil, ErrCourseArchived
+	}
+	comment, err := s.createSubmissionComment(ctx, usr, submission, in)
+	if err != nil {
+		s.logger.Errorf("CreateSubmissionComment failed: %v", err)
+		return nil, status.Error(codes.InvalidArgument, "failed to create submission comment")
+	}
+	return comment, nil
+}
+
+// UpdateSubmissionComment updates the body of a submission comment.
+// Access policy: Author of the comment.
+func (s *AutograderService) UpdateSubmissionComment(ctx context.Context, in *pb.SubmissionComment) (*pb.SubmissionComment, error) {
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("UpdateSubmissionComment failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	comment, err := s.getSubmissionCommentInCourse(in.GetID(), in.GetCourseID())
+	if err != nil {
+		s.logger.Errorf("UpdateSubmissionComment failed: %v", err)
+		return nil, status.Error(codes.NotFound, "failed to get submission comment")
+	}
+	if !usr.IsOwner(comment.GetAuthorID()) {
+		s.logger.Error("UpdateSubmissionComment failed: user is not author")
+		return nil, status.Error(codes.PermissionDenied, "only the author can update submission comments")
+	}
+	if s.isArchived(in.GetCourseID()) {
+		s.logger.Errorf("UpdateSubmissionComment failed: course %d is archived", in.GetCourseID())
+		return nil, ErrCourseArchived
+	}
+	comment, err = s.updateSubmissionComment(comment, in)
+	if err != nil {
+		s.logger.Errorf("UpdateSubmissionComment failed: %v", err)
+		return nil, status.Error(codes.InvalidArgument, "failed to update submission comment")
+	}
+	return comment, nil
+}
+
+// DeleteSubmissionComment deletes a submission comment.
+// Comments mirrored to the SCM are not deleted there.
+// Access policy: Author of the comment or Teacher of CourseID.
+func (s *AutograderService) DeleteSubmissionComment(ctx context.Context, in *pb.SubmissionComment) (*pb.Void, error) {
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("DeleteSubmissionComment failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	comment, err := s.getSubmissionCommentInCourse(in.GetID(), in.GetCourseID())
+	if err != nil {
+		s.logger.Errorf("DeleteSubmissionComment failed: %v", err)
+		return nil, status.Error(codes.NotFound, "failed to get submission comment")
+	}
+	if !s.isAuthorOrTeacher(usr, comment.GetAuthorID(), in.GetCourseID()) {
+		s.logger.Error("DeleteSubmissionComment failed: user is not author or teacher")
+		return nil, status.Error(codes.PermissionDenied, "only the author or teachers can delete submission comments")
+	}
+	if s.isArchived(in.GetCourseID()) {
+		s.logger.Errorf("DeleteSubmissionComment failed: course %d is archived", in.GetCourseID())
+		return nil, ErrCourseArchived
+	}
+	if err := s.db.DeleteSubmissionComment(comment.GetID()); err != nil {
+		s.logger.Errorf("DeleteSubmissionComment failed: %v", err)
+		return nil, status.Error(codes.InvalidArgument, "failed to delete submission comment")
+	}
+	return &pb.Void{}, nil
+}
+
+// GetSubmissionComments returns the comments on a submission. The authors of peer comments
+// are hidden from the submission's authors, and peer reviewers only get their own comments.
+// Access policy: Teacher or Teaching Assistant of CourseID, Author or Peer Reviewer of SubmissionID.
+func (s *AutograderService) GetSubmissionComments(ctx context.Context, in *pb.SubmissionCommentRequest) (*pb.SubmissionComments, error) {
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("GetSubmissionComments failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	submission, err := s.getSubmissionInCourse(in.GetSubmissionID(), in.GetCourseID())
+	if err != nil {
+		s.logger.Errorf("GetSubmissionComments failed: %v", err)
+		return nil, status.Error(codes.NotFound, "failed to get submission")
+	}
+	if !s.canAccessSubmission(usr, in.GetCourseID(), submission) && !s.isPeerReviewer(usr, submission.GetID()) {
+		s.logger.Errorf("GetSubmissionComments failed: user %s cannot access submission %d", usr.GetLogin(), submission.GetID())
+		return nil, status.Error(codes.PermissionDenied, "only teaching staff, authors and peer reviewers can access submission comments")
+	}
+	comments, err := s.getSubmissionComments(usr, submission, in.GetCourseID())
+	if err != nil {
+		s.logger.Errorf("GetSubmissionComments failed: %v", err)
+		return nil, status.Error(codes.NotFound, "failed to get submission comments")
+	}
+	return comments, nil
+}
+
+// GetAssignments returns a list of all assignments for the given course.
+// Assignments that are hidden until their release time are only returned to the teaching staff.
+// Access policy: Any User.
+func (s *AutograderService) GetAssignments(ctx context.Context, in *pb.CourseRequest) (*pb.Assignments, error) {
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("GetAssignments failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	courseID := in.GetCourseID()
+	assignments, err := s.getAssignments(courseID)
+	if err != nil {
+		s.logger.Errorf("GetAssignments failed: %v", err)
+		return nil, status.Error(codes.NotFound, "no assignments found for course")
+	}
+	if !s.isTeachingStaff(usr.GetID(), courseID) {
+		assignments.Assignments = visibleAssignments(assignments.GetAssignments())
+	}
+	return assignments, nil
+}
+
+// UpdateAssignments updates the assignments record in the database
+// by fetching assignment information from the course's test repository.
+// Access policy: Teacher of CourseID.
+func (s *AutograderService) UpdateAssignments(ctx context.Context, in *pb.CourseRequest) (*pb.Void, error) {
+	courseID := in.GetCourseID()
+	if s.isArchived(courseID) {
+		s.logger.Errorf("UpdateAssignments failed: course %d is archived", courseID)
+		return nil, ErrCourseArchived
+	}
+	err := s.updateAssignments(courseID)
+	if err != nil {
+		s.logger.Errorf("UpdateAssignments failed: %v", err)
+		return nil, status.Error(codes.NotFound, "course not found")
+	}
+	return &pb.Void{}, nil
+}
+
+// ValidateAssignmentFile returns the problems with the given assignment file, with the field
+// and reason of each problem, such that teachers can check the file before pushing it to the
+// course's tests repository. The file is valid if no problems are returned.
+// Access policy: Teacher of CourseID.
+func (s *AutograderService) ValidateAssignmentFile(ctx context.Context, in *pb.AssignmentFile) (*pb.AssignmentFileErrors, error) {
+	course, err := s.db.GetCourse(in.GetCourseID(), false)
+	if err != nil {
+		s.logger.Errorf("ValidateAssignmentFile failed: %v", err)
+		return nil, status.Error(codes.NotFound, "course not found")
+	}
+	return assignments.ValidateAssignmentFile(in.GetPath(), []byte(in.GetContents()), course.Location()).ToProto(), nil
+}
+
+// ValidateAssignments checks the assignments in the course's tests repository as when updating
+// the course's assignments, and returns the errors that would prevent the update, or the warnings,
+// without updating the course's assignments.
+// Access policy: Teacher of CourseID.
+func (s *AutograderService) ValidateAssignments(ctx context.Context, in *pb.CourseRequest) (*pb.AssignmentValidation, error) {
+	_, scm, err := s.getUserAndSCMForCourse(ctx, in.GetCourseID())
+	if err != nil {
+		s.logger.Errorf("ValidateAssignments failed: scm authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	course, err := s.db.GetCourse(in.GetCourseID(), false)
+	if err != nil {
+		s.logger.Errorf("ValidateAssignments failed: %v", err)
+		return nil, status.Error(codes.NotFound, "course not found")
+	}
+	validation, err := assignments.ValidateAssignments(ctx, s.logger, scm, course)
+	if err != nil {
+		s.logger.Errorf("ValidateAssignments failed: %v", err)
+		if contextCanceled(ctx) {
+			return nil, status.Error(codes.FailedPrecondition, ErrContextCanceled)
+		}
+		return nil, status.Error(codes.FailedPrecondition, "failed to fetch the tests repository")
+	}
+	return validation, nil
+}
+
+// GetProviders returns a list of SCM providers supported by the backend.
+// Access policy: Any User.
+func (s *AutograderService) GetProviders(ctx context.Context, in *pb.Void) (*pb.Providers, error) {
+	providers := auth.GetProviders()
+	if len(providers.GetProviders()) < 1 {
+		s.logger.Error("GetProviders failed: found no enabled SCM providers")
+		return nil, status.Error(codes.NotFound, "found no enabled SCM providers")
+	}
+	return providers, nil
+}
+
+// GetOrganization fetches a github organization by name.
+// Access policy: Admin
+func (s *AutograderService) GetOrganization(ctx context.Context, in *pb.OrgRequest) (*pb.Organization, error) {
+	usr, scm, err := s.getUserAndSCM(ctx, "github")
+	if err != nil {
+		s.logger.Errorf("GetOrganization failed: scm authentication error: %v", err)
+		return nil, err
+	}
+	org, err := s.getOrganization(ctx, scm, in.GetOrgName(), usr.GetLogin())
+	if err != nil {
+		s.logger.Errorf("GetOrganization failed: %v", err)
+		if contextCanceled(ctx) {
+			return nil, status.Error(codes.FailedPrecondition, ErrContextCanceled)
+		}
+		if err == scms.ErrNotMember {
+			return nil, status.Error(codes.NotFound, "organization membership not confirmed, please enable third-party access")
+		}
+		if err == ErrFreePlan || err == ErrAlreadyExists || err == scms.ErrNotOwner {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		if ok, parsedErr := parseSCMError(err); ok {
+			return nil, parsedErr
+		}
+		return nil, status.Error(codes.NotFound, "organization not found. Please make sure that 3rd-party access is enabled for your organization")
+	}
+	return org, nil
+}
+
+// GetRepositories returns URL strings for repositories of given type for the given course
+// Access policy: Any User.
+func (s *AutograderService) GetRepositories(ctx context.Context, in *pb.URLRequest) (*pb.Repositories, error) {
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("GetRepositories failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	urls := make(map[string]string)
+	for _, repoType := range in.GetRepoTypes() {
+		repo, _ := s.getRepositoryURL(usr, in.GetCourseID(), repoType)
+		// we do not care if some repo was not found, this will append an empty url string in that case
+		// frontend will take care of the rest
+		urls[repoType.String()] = repo
+	}
+	return &pb.Repositories{URLs: urls}, nil
+}
+
+// IsEmptyRepo ensures that group repository is empty and can be deleted
+// Access policy: Teacher of Course ID
+func (s *AutograderService) IsEmptyRepo(ctx context.Context, in *pb.RepositoryRequest) (*pb.Void, error) {
+	_, scm, err := s.getUserAndSCMForCourse(ctx, in.GetCourseID())
+	if err != nil {
+		s.logger.Errorf("IsEmptyRepo failed: scm authentication error: %v", err)
+		return nil, err
+	}
+
+	if err := s.isEmptyRepo(ctx, scm, in); err != nil {
+		s.logger.Errorf("IsEmptyRepo failed: %v", err)
+		if contextCanceled(ctx) {
+			return nil, status.Error(codes.FailedPrecondition, ErrContextCanceled)
+		}
+		if ok, parsedErr := parseSCMError(err); ok {
+			return nil, parsedErr
+		}
+		return nil, status.Error(codes.FailedPrecondition, "group repository does not exist or not empty")
+	}
+	return &pb.Void{}, nil
+}
+
+// GetQuestions returns all questions and answers for the given assignment.
+// Access policy: Any User enrolled in CourseID.
+func (s *AutograderService) GetQuestions(ctx context.Context, in *pb.AssignmentRequest) (*pb.Questions, error) {
+	questions, err := s.getQuestions(in)
+	if err != nil {
+		s.logger.Errorf("GetQuestions failed: %v", err)
+		return nil, status.Error(codes.NotFound, "failed to get questions")
+	}
+	return questions, nil
+}
+
+// CreateQuestion creates a new question about an assignment and notifies the course teachers.
+// Access policy: Any User enrolled in CourseID.
+func (s *AutograderService) CreateQuestion(ctx context.Context, in *pb.QuestionRequest) (*pb.Question, error) {
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("CreateQuestion failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	question, err := s.createQuestion(ctx, usr, in)
+	if err != nil {
+		s.logger.Errorf("CreateQuestion failed: %v", err)
+		return nil, status.Error(codes.InvalidArgument, "failed to create question")
+	}
+	return question, nil
+}
+
+// UpdateQuestion updates a question, including its accepted answer.
+// Access policy: Author of the question or Teacher of CourseID.
+func (s *AutograderService) UpdateQuestion(ctx context.Context, in *pb.QuestionRequest) (*pb.Void, error) {
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("UpdateQuestion failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	question, err := s.getQuestionInCourse(in.GetQuestion().GetID(), in.GetCourseID())
+	if err != nil {
+		s.logger.Errorf("UpdateQuestion failed: %v", err)
+		return nil, status.Error(codes.NotFound, "failed to get question")
+	}
+	if !s.isAuthorOrTeacher(usr, question.GetAuthorID(), in.GetCourseID()) {
+		s.logger.Error("UpdateQuestion failed: user is not author or teacher")
+		return nil, status.Error(codes.PermissionDenied, "only the author or teachers can update questions")
+	}
+	if err := s.updateQuestion(question, in.GetQuestion()); err != nil {
+		s.logger.Errorf("UpdateQuestion failed: %v", err)
+		return nil, status.Error(codes.InvalidArgument, "failed to update question")
+	}
+	return &pb.Void{}, nil
+}
+
+// DeleteQuestion deletes a question and its answers.
+// Access policy: Author of the question or Teacher of CourseID.
+func (s *AutograderService) DeleteQuestion(ctx context.Context, in *pb.QuestionRequest) (*pb.Void, error) {
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("DeleteQuestion failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	question, err := s.getQuestionInCourse(in.GetQuestion().GetID(), in.GetCourseID())
+	if err != nil {
+		s.logger.Errorf("DeleteQuestion failed: %v", err)
+		return nil, status.Error(codes.NotFound, "failed to get question")
+	}
+	if !s.isAuthorOrTeacher(usr, question.GetAuthorID(), in.GetCourseID()) {
+		s.logger.Error("DeleteQuestion failed: user is not author or teacher")
+		return nil, status.Error(codes.PermissionDenied, "only the author or teachers can delete questions")
+	}
+	if err := s.db.DeleteQuestion(question.GetID()); err != nil {
+		s.logger.Errorf("DeleteQuestion failed: %v", err)
+		return nil, status.Error(codes.InvalidArgument, "failed to delete question")
+	}
+	return &pb.Void{}, nil
+}
+
+// CreateAnswer creates a new answer to a question and notifies the question's author.
+// Access policy: Any User enrolled in CourseID.
+func (s *AutograderService) CreateAnswer(ctx context.Context, in *pb.AnswerRequest) (*pb.Answer, error) {
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("CreateAnswer failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	answer, err := s.createAnswer(ctx, usr, in)
+	if err != nil {
+		s.logger.Errorf("CreateAnswer failed: %v", err)
+		return nil, status.Error(codes.InvalidArgument, "failed to create answer")
+	}
+	return answer, nil
+}
+
+// UpdateAnswer updates an answer. Teachers can endorse answers.
+// Access policy: Author of the answer or Teacher of CourseID.
+func (s *AutograderService) UpdateAnswer(ctx context.Context, in *pb.AnswerRequest) (*pb.Void, error) {
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("UpdateAnswer failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	answer, _, err := s.getAnswerInCourse(in.GetAnswer().GetID(), in.GetCourseID())
+	if err != nil {
+		s.logger.Errorf("UpdateAnswer failed: %v", err)
+		return nil, status.Error(codes.NotFound, "failed to get answer")
+	}
+	if !s.isAuthorOrTeacher(usr, answer.GetAuthorID(), in.GetCourseID()) {
+		s.logger.Error("UpdateAnswer failed: user is not author or teacher")
+		return nil, status.Error(codes.PermissionDenied, "only the author or teachers can update answers")
+	}
+	if err := s.updateAnswer(usr, in.GetCourseID(), answer, in.GetAnswer()); err != nil {
+		s.logger.Errorf("UpdateAnswer failed: %v", err)
+		return nil, status.Error(codes.InvalidArgument, "failed to update answer")
+	}
+	return &pb.Void{}, nil
+}
+
+// DeleteAnswer deletes an answer.
+// Access policy: Author of the answer or Teacher of CourseID.
+func (s *AutograderService) DeleteAnswer(ctx context.Context, in *pb.AnswerRequest) (*pb.Void, error) {
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("DeleteAnswer failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	answer, question, err := s.getAnswerInCourse(in.GetAnswer().GetID(), in.GetCourseID())
+	if err != nil {
+		s.logger.Errorf("DeleteAnswer failed: %v", err)
+		return nil, status.Error(codes.NotFound, "failed to get answer")
+	}
+	if !s.isAuthorOrTeacher(usr, answer.GetAuthorID(), in.GetCourseID()) {
+		s.logger.Error("DeleteAnswer failed: user is not author or teacher")
+		return nil, status.Error(codes.PermissionDenied, "only the author or teachers can delete answers")
+	}
+	if err := s.deleteAnswer(answer, question); err != nil {
+		s.logger.Errorf("DeleteAnswer failed: %v", err)
+		return nil, status.Error(codes.InvalidArgument, "failed to delete answer")
+	}
+	return &pb.Void{}, nil
+}
+
+// GetAssignmentDiscussions returns the most recent discussions about the given assignment
+// from the course info repository's discussion category named after the assignment.
+// Access policy: Any User enrolled in CourseID.
+func (s *AutograderService) GetAssignmentDiscussions(ctx context.Context, in *pb.AssignmentRequest) (*pb.Discussions, error) {
+	discussions, err := s.getAssignmentDiscussions(ctx, in)
+	if err != nil {
+		s.logger.Errorf("GetAssignmentDiscussions failed: %v", err)
+		if contextCanceled(ctx) {
+			return nil, status.Error(codes.FailedPrecondition, ErrContextCanceled)
+		}
+		if ok, parsedErr := parseSCMError(err); ok {
+			return nil, parsedErr
+		}
+		return nil, status.Error(codes.NotFound, "failed to get discussions")
+	}
+	return discussions, nil
+}
+
+// GetAnnouncements returns all announcements for the given course.
+// Access policy: Any User enrolled in CourseID.
+func (s *AutograderService) GetAnnouncements(ctx context.Context, in *pb.CourseRequest) (*pb.Announcements, error) {
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("GetAnnouncements failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	announcements, err := s.getAnnouncements(in.GetCourseID(), usr.GetID())
+	if err != nil {
+		s.logger.Errorf("GetAnnouncements failed: %v", err)
+		return nil, status.Error(codes.NotFound, "failed to get announcements")
+	}
+	return announcements, nil
+}
+
+// CreateAnnouncement creates a new course announcement and notifies the course participants.
+// Access policy: Teacher of CourseID.
+func (s *AutograderService) CreateAnnouncement(ctx context.Context, in *pb.Announcement) (*pb.Announcement, error) {
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("CreateAnnouncement failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	announcement, err := s.createAnnouncement(ctx, usr, in)
+	if err != nil {
+		s.logger.Errorf("CreateAnnouncement failed: %v", err)
+		return nil, status.Error(codes.InvalidArgument, "failed to create announcement")
+	}
+	return announcement, nil
+}
+
+// UpdateAnnouncement updates the title and body of a course announcement.
+// Access policy: Teacher of CourseID.
+func (s *AutograderService) UpdateAnnouncement(ctx context.Context, in *pb.Announcement) (*pb.Void, error) {
+	if err := s.updateAnnouncement(ctx, in); err != nil {
+		s.logger.Errorf("UpdateAnnouncement failed: %v", err)
+		return nil, status.Error(codes.InvalidArgument, "failed to update announcement")
+	}
+	return &pb.Void{}, nil
+}
+
+// MarkAnnouncementRead records that the current user has read the announcement.
+// Access policy: Any User enrolled in CourseID.
+func (s *AutograderService) MarkAnnouncementRead(ctx context.Context, in *pb.AnnouncementRequest) (*pb.Void, error) {
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("MarkAnnouncementRead failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	if err := s.markAnnouncementRead(usr.GetID(), in); err != nil {
+		s.logger.Errorf("MarkAnnouncementRead failed: %v", err)
+		return nil, status.Error(codes.InvalidArgument, "failed to mark announcement as read")
+	}
+	return &pb.Void{}, nil
+}
+
+// GetCourseFeedURL returns the URL of the course's Atom feed for the current user.
+// Access policy: Any User enrolled in CourseID.
+func (s *AutograderService) GetCourseFeedURL(ctx context.Context, in *pb.CourseRequest) (*pb.FeedURL, error) {
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("GetCourseFeedURL failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	return s.getCourseFeedURL(usr.GetID(), in.GetCourseID()), nil
+}
+
+// GetNotifications returns the current user's most recent notifications, newest first.
+// Access policy: Current User.
+func (s *AutograderService) GetNotifications(ctx context.Context, in *pb.NotificationsRequest) (*pb.Notifications, error) {
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("GetNotifications failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	notifications, err := s.getNotifications(usr.GetID(), in)
+	if err != nil {
+		s.logger.Errorf("GetNotifications failed: %v", err)
+		return nil, status.Error(codes.NotFound, "failed to get notifications")
+	}
+	return notifications, nil
+}
+
+// MarkNotificationRead marks the current user's notification as read.
+// If no notification is given, all the current user's notifications are marked as read.
+// Access policy: Current User.
+func (s *AutograderService) MarkNotificationRead(ctx context.Context, in *pb.NotificationRequest) (*pb.Void, error) {
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("MarkNotificationRead failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	if err := s.markNotificationRead(usr.GetID(), in); err != nil {
+		s.logger.Errorf("MarkNotificationRead failed: %v", err)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Error(codes.NotFound, "notification not found")
+		}
+		return nil, status.Error(codes.InvalidArgument, "failed to mark notification as read")
+	}
+	return &pb.Void{}, nil
+}
+
+// GetOrphanedResources returns repositories, teams and organization members of the course's
+// organization that have no corresponding records in QuickFeed.
+// Access policy: Admin.
+func (s *AutograderService) GetOrphanedResources(ctx context.Context, in *pb.CourseRequest) (*pb.OrphanedResources, error) {
+	_, scm, err := s.getUserAndSCMForCourse(ctx, in.GetCourseID())
+	if err != nil {
+		s.logger.Errorf("GetOrphanedResources failed: scm authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	report, err := s.getOrphanedResources(ctx, scm, in.GetCourseID())
+	if err != nil {
+		s.logger.Errorf("GetOrphanedResources failed: %v", err)
+		if contextCanceled(ctx) {
+			return nil, status.Error(codes.FailedPrecondition, ErrContextCanceled)
+		}
+		if ok, parsedErr := parseSCMError(err); ok {
+			return nil, parsedErr
+		}
+		return nil, status.Error(codes.FailedPrecondition, "failed to check course for orphaned resources")
+	}
+	return report, nil
 }
 
-// UpdateSubmission is called to approve the given submission or to undo approval.
+// AuditTestsExposure checks that no student or group team, and no student, has access
+// to the course's tests repository, and optionally revokes the access found.
 // Access policy: Teacher of CourseID.
-func (s *AutograderService) UpdateSubmission(ctx context.Context, in *pb.UpdateSubmissionRequest) (*pb.Void, error) {
-	if !s.isValidSubmission(in.SubmissionID) {
-		s.logger.Errorf("UpdateSubmission failed: submission author has no access to the course")
-		return nil, status.Error(codes.PermissionDenied, "submission author has no course access")
-	}
-	usr, err := s.getCurrentUser(ctx)
+func (s *AutograderService) AuditTestsExposure(ctx context.Context, in *pb.ExposureAuditRequest) (*pb.ExposureReport, error) {
+	_, scm, err := s.getUserAndSCMForCourse(ctx, in.GetCourseID())
 	if err != nil {
-		s.logger.Errorf("UpdateSubmission failed: authentication error: %v", err)
+		s.logger.Errorf("AuditTestsExposure failed: scm authentication error: %v", err)
 		return nil, ErrInvalidUserInfo
 	}
-	if !s.isTeacher(usr.ID, in.GetCourseID()) {
-		s.logger.Error("UpdateSubmission failed: user is not teacher")
-		return nil, status.Error(codes.PermissionDenied, "only teachers can approve submissions")
+	course, err := s.db.GetCourse(in.GetCourseID(), false)
+	if err != nil {
+		s.logger.Errorf("AuditTestsExposure failed: %v", err)
+		return nil, status.Error(codes.NotFound, "course not found")
 	}
-	err = s.updateSubmission(in.GetCourseID(), in.GetSubmissionID(), in.GetStatus(), in.GetReleased(), in.GetScore())
+	report, err := s.auditTestsExposure(ctx, scm, course, in.GetRevoke())
 	if err != nil {
-		s.logger.Errorf("UpdateSubmission failed: %v", err)
-		err = status.Error(codes.InvalidArgument, "failed to approve submission")
+		s.logger.Errorf("AuditTestsExposure failed: %v", err)
+		if contextCanceled(ctx) {
+			return nil, status.Error(codes.FailedPrecondition, ErrContextCanceled)
+		}
+		if ok, parsedErr := parseSCMError(err); ok {
+			return nil, parsedErr
+		}
+		return nil, status.Error(codes.FailedPrecondition, "failed to audit course repositories")
 	}
-	return &pb.Void{}, err
+	return report, nil
 }
 
-// RebuildSubmission rebuilds the submission with the given ID
-// Access policy: any user.
-func (s *AutograderService) RebuildSubmission(ctx context.Context, in *pb.RebuildRequest) (*pb.Submission, error) {
-	if !s.isValidSubmission(in.GetSubmissionID()) {
-		s.logger.Errorf("ApproveSubmission failed: submitter has no access to the course")
-		return nil, status.Error(codes.PermissionDenied, "submitter has no course access")
+// GetAccessReport returns the most recent report comparing the permissions of every team
+// and collaborator on every course repository with the expected permissions.
+// Access policy: Teacher of CourseID.
+func (s *AutograderService) GetAccessReport(ctx context.Context, in *pb.CourseRequest) (*pb.AccessReport, error) {
+	_, scm, err := s.getUserAndSCMForCourse(ctx, in.GetCourseID())
+	if err != nil {
+		s.logger.Errorf("GetAccessReport failed: scm authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
 	}
-	submission, err := s.rebuildSubmission(in)
+	report, err := s.getAccessReport(ctx, scm, in.GetCourseID())
 	if err != nil {
-		s.logger.Errorf("RebuildSubmission failed: %v", err)
-		return nil, status.Error(codes.InvalidArgument, "failed to rebuild submission")
+		s.logger.Errorf("GetAccessReport failed: %v", err)
+		if contextCanceled(ctx) {
+			return nil, status.Error(codes.FailedPrecondition, ErrContextCanceled)
+		}
+		if ok, parsedErr := parseSCMError(err); ok {
+			return nil, parsedErr
+		}
+		return nil, status.Error(codes.FailedPrecondition, "failed to check course repository access")
 	}
-	return submission, nil
+	return report, nil
 }
 
-// RebuildSubmissions runs tests for all submissions for the given assignment ID.
+// SetCourseRepositoryAccess makes all student and group repositories of the course read-only
+// for their owners, e.g., to freeze the course at the end of the semester, or writable again.
+// The outcome is reported per repository.
 // Access policy: Teacher of CourseID.
-func (s *AutograderService) RebuildSubmissions(ctx context.Context, in *pb.AssignmentRequest) (*pb.Void, error) {
-	usr, err := s.getCurrentUser(ctx)
+func (s *AutograderService) SetCourseRepositoryAccess(ctx context.Context, in *pb.RepositoryAccessRequest) (*pb.RepositoryAccessReport, error) {
+	usr, scm, err := s.getUserAndSCMForCourse(ctx, in.GetCourseID())
 	if err != nil {
-		s.logger.Errorf("RebuildSubmissions failed: authentication error: %v", err)
+		s.logger.Errorf("SetCourseRepositoryAccess failed: scm authentication error: %v", err)
 		return nil, ErrInvalidUserInfo
 	}
-	if !s.isTeacher(usr.ID, in.GetCourseID()) {
-		s.logger.Error("RebuildSubmissions failed: user is not teacher")
-		return nil, status.Error(codes.PermissionDenied, "only teachers can rebuild all submissions")
-	}
-	if err := s.rebuildSubmissions(in); err != nil {
-		s.logger.Errorf("RebuildSubmissions failed: %v", err)
-		return nil, status.Error(codes.InvalidArgument, "failed to rebuild submissions")
+	report, err := s.setCourseRepositoryAccess(ctx, scm, usr, in)
+	if err != nil {
+		s.logger.Errorf("SetCourseRepositoryAccess failed: %v", err)
+		return nil, status.Error(codes.InvalidArgument, "failed to update access to course repositories")
 	}
-	return &pb.Void{}, nil
+	return report, nil
 }
 
-// CreateBenchmark adds a new grading benchmark for an assignment
-// Access policy: Teacher of CourseID
-func (s *AutograderService) CreateBenchmark(ctx context.Context, in *pb.GradingBenchmark) (*pb.GradingBenchmark, error) {
-	bm, err := s.createBenchmark(in)
+// GetAdminOverview returns an overview of all courses with their enrollment counts, last push event,
+// access token validity and test jobs backlog, to spot inactive courses and broken integrations.
+// Access policy: Admin not belonging to a tenant.
+func (s *AutograderService) GetAdminOverview(ctx context.Context, in *pb.Void) (*pb.AdminOverview, error) {
+	overview, err := s.getAdminOverview(ctx)
 	if err != nil {
-		s.logger.Errorf("CreateBenchmark failed for %+v: %v", in, err)
-		return nil, status.Error(codes.InvalidArgument, "failed to add benchmark")
+		s.logger.Errorf("GetAdminOverview failed: %v", err)
+		if contextCanceled(ctx) {
+			return nil, status.Error(codes.FailedPrecondition, ErrContextCanceled)
+		}
+		return nil, status.Error(codes.NotFound, "failed to get admin overview")
 	}
-	return bm, nil
+	return overview, nil
 }
 
-// UpdateBenchmark edits a grading benchmark for an assignment
-// Access policy: Teacher of CourseID
-func (s *AutograderService) UpdateBenchmark(ctx context.Context, in *pb.GradingBenchmark) (*pb.Void, error) {
-	err := s.updateBenchmark(in)
+// GetTestJobs returns the queued and running test jobs in all runner pools.
+// Access policy: Admin not belonging to a tenant.
+func (s *AutograderService) GetTestJobs(ctx context.Context, in *pb.Void) (*pb.TestJobs, error) {
+	jobs, err := s.getTestJobs()
 	if err != nil {
-		s.logger.Errorf("UpdateBenchmark failed for %+v: %v", in, err)
-		err = status.Error(codes.InvalidArgument, "failed to update benchmark")
+		s.logger.Errorf("GetTestJobs failed: %v", err)
+		return nil, status.Error(codes.NotFound, "failed to get test jobs")
 	}
-	return &pb.Void{}, err
+	return jobs, nil
 }
 
-// DeleteBenchmark removes a grading benchmark
-// Access policy: Teacher of CourseID
-func (s *AutograderService) DeleteBenchmark(ctx context.Context, in *pb.GradingBenchmark) (*pb.Void, error) {
-	err := s.deleteBenchmark(in)
+// CancelTestJob cancels the given queued or running test job.
+// Access policy: Admin not belonging to a tenant.
+func (s *AutograderService) CancelTestJob(ctx context.Context, in *pb.TestJob) (*pb.Void, error) {
+	usr, err := s.getCurrentUser(ctx)
 	if err != nil {
-		s.logger.Errorf("DeleteBenchmark failed for %+v: %v", in, err)
-		err = status.Error(codes.InvalidArgument, "failed to delete benchmark")
+		s.logger.Errorf("CancelTestJob failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
 	}
-	return &pb.Void{}, err
+	if err := s.cancelTestJob(usr, in.GetID()); err != nil {
+		s.logger.Errorf("CancelTestJob failed: %v", err)
+		if err == errTestJobNotFound {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.InvalidArgument, "failed to cancel test job")
+	}
+	return &pb.Void{}, nil
 }
 
-// CreateCriterion adds a new grading criterion for an assignment
-// Access policy: Teacher of CourseID
-func (s *AutograderService) CreateCriterion(ctx context.Context, in *pb.GradingCriterion) (*pb.GradingCriterion, error) {
-	c, err := s.createCriterion(in)
+// GetWebhookDeliveries returns the recorded webhook deliveries, newest first; only the failed deliveries if requested.
+// Access policy: Admin not belonging to a tenant.
+func (s *AutograderService) GetWebhookDeliveries(ctx context.Context, in *pb.WebhookDeliveryRequest) (*pb.WebhookDeliveries, error) {
+	deliveries, err := s.getWebhookDeliveries(in.GetFailed())
 	if err != nil {
-		s.logger.Errorf("CreateCriterion failed for %+v: %v", in, err)
-		return nil, status.Error(codes.InvalidArgument, "failed to add criterion")
+		s.logger.Errorf("GetWebhookDeliveries failed: %v", err)
+		return nil, status.Error(codes.NotFound, "failed to get webhook deliveries")
 	}
-	return c, nil
+	return deliveries, nil
 }
 
-// UpdateCriterion edits a grading criterion for an assignment
-// Access policy: Teacher of CourseID
-func (s *AutograderService) UpdateCriterion(ctx context.Context, in *pb.GradingCriterion) (*pb.Void, error) {
-	err := s.updateCriterion(in)
+// ReplayWebhookDelivery processes the given recorded webhook delivery again, e.g., after it failed,
+// and returns the delivery with the outcome of the replay.
+// Access policy: Admin not belonging to a tenant.
+func (s *AutograderService) ReplayWebhookDelivery(ctx context.Context, in *pb.WebhookDeliveryRequest) (*pb.WebhookDelivery, error) {
+	usr, err := s.getCurrentUser(ctx)
 	if err != nil {
-		s.logger.Errorf("UpdateCriterion failed for %+v: %v", in, err)
-		err = status.Error(codes.InvalidArgument, "failed to update criterion")
+		s.logger.Errorf("ReplayWebhookDelivery failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
 	}
-	return &pb.Void{}, err
-}
-
-// DeleteCriterion removes a grading criterion for an assignment
-// Access policy: Teacher of CourseID
-func (s *AutograderService) DeleteCriterion(ctx context.Context, in *pb.GradingCriterion) (*pb.Void, error) {
-	err := s.deleteCriterion(in)
+	delivery, err := s.replayWebhookDelivery(usr, in.GetDeliveryID())
 	if err != nil {
-		s.logger.Errorf("DeleteCriterion failed for %+v: %v", in, err)
-		err = status.Error(codes.InvalidArgument, "failed to delete criterion")
+		s.logger.Errorf("ReplayWebhookDelivery failed: %v", err)
+		if err == errWebhookDeliveryNotFound {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		if err == errWebhookPayloadPurged {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return nil, status.Error(codes.InvalidArgument, "failed to replay webhook delivery")
 	}
-	return &pb.Void{}, err
+	return delivery, nil
 }
 
-// CreateReview adds a new submission review
-// Access policy: Teacher of CourseID
-func (s *AutograderService) CreateReview(ctx context.Context, in *pb.ReviewRequest) (*pb.Review, error) {
+// GetAuditLog returns the audit log entries of the given course, user and action.
+// Teachers and admins of a tenant can only get the entries of their own courses.
+// Access policy: Admin, Teacher of CourseID.
+func (s *AutograderService) GetAuditLog(ctx context.Context, in *pb.AuditLogRequest) (*pb.AuditLog, error) {
 	usr, err := s.getCurrentUser(ctx)
 	if err != nil {
-		s.logger.Errorf("CreateReview failed: authentication error: %v", err)
+		s.logger.Errorf("GetAuditLog failed: authentication error: %v", err)
 		return nil, ErrInvalidUserInfo
 	}
-	if !s.isTeacher(usr.ID, in.GetCourseID()) {
-		s.logger.Error("CreateReview failed: user is not teacher")
-		return nil, status.Error(codes.PermissionDenied, "only teachers can add reviews")
+	if !s.isCourseAdmin(usr, in.GetCourseID()) && (in.GetCourseID() == 0 || !s.isTeacher(usr.GetID(), in.GetCourseID())) {
+		s.logger.Error("GetAuditLog failed: user is not admin or teacher")
+		return nil, status.Error(codes.PermissionDenied, "only admin and course teachers can access the audit log")
 	}
-	if !usr.IsOwner(in.Review.GetReviewerID()) {
-		s.logger.Errorf("CreateReview failed: current user's ID: %d, when the reviewer's ID is %d ", usr.ID, in.Review.ReviewerID)
-		return nil, status.Error(codes.PermissionDenied, "failed to create review: reviewers' IDs don't match")
+	page, err := newPage(in.GetPageSize(), in.GetPageToken())
+	if err != nil {
+		s.logger.Errorf("GetAuditLog failed: %v", err)
+		return nil, status.Error(codes.InvalidArgument, "invalid page token")
 	}
-	review, err := s.createReview(in.Review)
+	log, err := s.getAuditLog(in, page)
 	if err != nil {
-		s.logger.Errorf("CreateReview failed for review %+v: %v", in, err)
-		return nil, status.Error(codes.InvalidArgument, "failed to create review")
+		s.logger.Errorf("GetAuditLog failed: %v", err)
+		return nil, status.Error(codes.NotFound, "failed to get audit log")
 	}
-	return review, nil
+	return log, nil
 }
 
-// UpdateReview updates a submission review
-// Access policy: Teacher of CourseID, Author of the given Review
-func (s *AutograderService) UpdateReview(ctx context.Context, in *pb.ReviewRequest) (*pb.Review, error) {
+// CreateAccessToken creates a personal access token for the current user, allowing scripts and
+// command line tools to call the API on the user's behalf for the requested courses.
+// Access tokens cannot be used to create new access tokens.
+// Access policy: Admin, Student or Teacher of CourseIDs.
+func (s *AutograderService) CreateAccessToken(ctx context.Context, in *pb.AccessTokenRequest) (*pb.NewAccessToken, error) {
 	usr, err := s.getCurrentUser(ctx)
 	if err != nil {
-		s.logger.Errorf("UpdateReview failed: authentication error: %v", err)
+		s.logger.Errorf("CreateAccessToken failed: authentication error: %v", err)
 		return nil, ErrInvalidUserInfo
 	}
-	if !s.isTeacher(usr.ID, in.GetCourseID()) {
-		s.logger.Error("UpdateReview failed: user is not teacher")
-		return nil, status.Error(codes.PermissionDenied, "only teachers can update reviews")
+	if _, ok := auth.AccessTokenFromContext(ctx); ok {
+		s.logger.Error("CreateAccessToken failed: request authenticated with access token")
+		return nil, status.Error(codes.PermissionDenied, "access tokens cannot be used to create access tokens")
 	}
-	if !(usr.IsOwner(in.Review.GetReviewerID()) || s.isCourseCreator(in.CourseID, usr.ID)) {
-		s.logger.Errorf("UpdateReview failed: current user's ID: %d, when the original reviewer's ID is %d ", usr.ID, in.Review.ReviewerID)
-		return nil, status.Error(codes.PermissionDenied, "reviews can only be updated by original authors or course creator")
+	for _, courseID := range in.GetCourseIDs() {
+		if !s.isCourseAdmin(usr, courseID) && !s.isEnrolled(usr.GetID(), courseID) {
+			s.logger.Errorf("CreateAccessToken failed: user %s is not enrolled in course %d", usr.GetLogin(), courseID)
+			return nil, status.Error(codes.PermissionDenied, "access tokens can only be created for enrolled courses")
+		}
 	}
-	review, err := s.updateReview(in.Review)
+	token, err := s.createAccessToken(usr, in)
 	if err != nil {
-		s.logger.Errorf("UpdateReview failed for review %+v: %v", in, err)
-		err = status.Error(codes.InvalidArgument, "failed to update review")
+		s.logger.Errorf("CreateAccessToken failed: %v", err)
+		return nil, status.Error(codes.InvalidArgument, "failed to create access token")
 	}
-	return review, err
+	return token, nil
 }
 
-// UpdateSubmissions approves and/or releases all manual reviews for student submission for the given assignment
-// with the given score.
-// Access policy: Creator of CourseID
-func (s *AutograderService) UpdateSubmissions(ctx context.Context, in *pb.UpdateSubmissionsRequest) (*pb.Void, error) {
+// GetAccessTokens returns the current user's access tokens, without the secret tokens.
+// Access policy: Any User.
+func (s *AutograderService) GetAccessTokens(ctx context.Context, in *pb.Void) (*pb.AccessTokens, error) {
 	usr, err := s.getCurrentUser(ctx)
 	if err != nil {
-		s.logger.Errorf("UpdateSubmissions failed: authentication error: %v", err)
+		s.logger.Errorf("GetAccessTokens failed: authentication error: %v", err)
 		return nil, ErrInvalidUserInfo
 	}
-	if !s.isCourseCreator(in.CourseID, usr.ID) {
-		s.logger.Error("UpdateSubmissions failed: user is not teacher")
-		return nil, status.Error(codes.PermissionDenied, "only teachers can update reviews")
-	}
-
-	if err = s.updateSubmissions(in); err != nil {
-		s.logger.Errorf("UpdateSubmissions failed for request %+v", in)
-		err = status.Error(codes.InvalidArgument, "failed to update submissions")
+	tokens, err := s.getAccessTokens(usr)
+	if err != nil {
+		s.logger.Errorf("GetAccessTokens failed: %v", err)
+		return nil, status.Error(codes.NotFound, "failed to get access tokens")
 	}
-	return &pb.Void{}, err
+	return tokens, nil
 }
 
-// GetReviewers returns names of all active reviewers for a student submission
-// Access policy: Teacher of CourseID
-func (s *AutograderService) GetReviewers(ctx context.Context, in *pb.SubmissionReviewersRequest) (*pb.Reviewers, error) {
+// RevokeAccessToken revokes the given access token of the current user.
+// Access tokens cannot be used to revoke access tokens.
+// Access policy: Owner of access token.
+func (s *AutograderService) RevokeAccessToken(ctx context.Context, in *pb.AccessToken) (*pb.Void, error) {
 	usr, err := s.getCurrentUser(ctx)
 	if err != nil {
-		s.logger.Errorf("GetReviewers failed: authentication error: %v", err)
+		s.logger.Errorf("RevokeAccessToken failed: authentication error: %v", err)
 		return nil, ErrInvalidUserInfo
 	}
-	if !s.isTeacher(usr.GetID(), in.GetCourseID()) {
-		s.logger.Error("GetReviewers failed: user is not course creator")
-		return nil, status.Error(codes.PermissionDenied, "only course creator teacher can request information about reviewers")
+	if _, ok := auth.AccessTokenFromContext(ctx); ok {
+		s.logger.Error("RevokeAccessToken failed: request authenticated with access token")
+		return nil, status.Error(codes.PermissionDenied, "access tokens cannot be used to revoke access tokens")
 	}
-	reviewers, err := s.getReviewers(in.SubmissionID)
-	if err != nil {
-		s.logger.Errorf("GetReviewers failed: error fetching from database: %v", err)
-		return nil, status.Error(codes.InvalidArgument, "failed to get reviewers")
+	if err := s.revokeAccessToken(usr, in.GetID()); err != nil {
+		s.logger.Errorf("RevokeAccessToken failed: %v", err)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Error(codes.NotFound, "access token not found")
+		}
+		return nil, status.Error(codes.InvalidArgument, "failed to revoke access token")
 	}
-	return &pb.Reviewers{Reviewers: reviewers}, err
+	return &pb.Void{}, nil
 }
 
-// GetAssignments returns a list of all assignments for the given course.
+// GetSessions returns the current user's signed in sessions, with the client's address,
+// user agent and time of the last request, but without the session cookies.
 // Access policy: Any User.
-func (s *AutograderService) GetAssignments(ctx context.Context, in *pb.CourseRequest) (*pb.Assignments, error) {
-	courseID := in.GetCourseID()
-	assignments, err := s.getAssignments(courseID)
+func (s *AutograderService) GetSessions(ctx context.Context, in *pb.Void) (*pb.Sessions, error) {
+	usr, err := s.getCurrentUser(ctx)
 	if err != nil {
-		s.logger.Errorf("GetAssignments failed: %v", err)
-		return nil, status.Error(codes.NotFound, "no assignments found for course")
+		s.logger.Errorf("GetSessions failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
 	}
-	return assignments, nil
+	sessions, err := s.getSessions(usr)
+	if err != nil {
+		s.logger.Errorf("GetSessions failed: %v", err)
+		return nil, status.Error(codes.NotFound, "failed to get sessions")
+	}
+	return sessions, nil
 }
 
-// UpdateAssignments updates the assignments record in the database
-// by fetching assignment information from the course's test repository.
-// Access policy: Teacher of CourseID.
-func (s *AutograderService) UpdateAssignments(ctx context.Context, in *pb.CourseRequest) (*pb.Void, error) {
+// RevokeSession signs the current user out of the given session.
+// Access policy: Owner of session.
+func (s *AutograderService) RevokeSession(ctx context.Context, in *pb.Session) (*pb.Void, error) {
 	usr, err := s.getCurrentUser(ctx)
 	if err != nil {
-		s.logger.Errorf("UpdateAssignments failed: scm authentication error: %v", err)
-		return nil, err
-	}
-	courseID := in.GetCourseID()
-	if !s.isTeacher(usr.ID, courseID) {
-		s.logger.Error("UpdateAssignments failed: user is not teacher")
-		return nil, status.Error(codes.PermissionDenied, "only teachers can update course assignments")
+		s.logger.Errorf("RevokeSession failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
 	}
-	err = s.updateAssignments(courseID)
-	if err != nil {
-		s.logger.Errorf("UpdateAssignments failed: %v", err)
-		return nil, status.Error(codes.NotFound, "course not found")
+	if err := s.revokeSession(usr, in.GetID()); err != nil {
+		s.logger.Errorf("RevokeSession failed: %v", err)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Error(codes.NotFound, "session not found")
+		}
+		return nil, status.Error(codes.InvalidArgument, "failed to revoke session")
 	}
 	return &pb.Void{}, nil
 }
 
-// GetProviders returns a list of SCM providers supported by the backend.
-// Access policy: Any User.
-func (s *AutograderService) GetProviders(ctx context.Context, in *pb.Void) (*pb.Providers, error) {
-	providers := auth.GetProviders()
-	if len(providers.GetProviders()) < 1 {
-		s.logger.Error("GetProviders failed: found no enabled SCM providers")
-		return nil, status.Error(codes.NotFound, "found no enabled SCM providers")
+// LogoutUser signs the given user out of all sessions and revokes the user's access tokens,
+// for instance when the user's account has been compromised.
+// Access policy: Admin.
+func (s *AutograderService) LogoutUser(ctx context.Context, in *pb.UserRequest) (*pb.Void, error) {
+	usr, err := s.getCurrentUser(ctx)
+	if err != nil {
+		s.logger.Errorf("LogoutUser failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
 	}
-	return providers, nil
+	if err := s.logoutUser(usr, in.GetUserID()); err != nil {
+		s.logger.Errorf("LogoutUser failed: %v", err)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Error(codes.NotFound, "user not found")
+		}
+		return nil, status.Error(codes.InvalidArgument, "failed to log out user")
+	}
+	return &pb.Void{}, nil
 }
 
-// GetOrganization fetches a github organization by name.
-// Access policy: Admin
-func (s *AutograderService) GetOrganization(ctx context.Context, in *pb.OrgRequest) (*pb.Organization, error) {
-	usr, scm, err := s.getUserAndSCM(ctx, "github")
+// GetPendingActions returns the destructive actions awaiting confirmation by an admin.
+// Admins of a tenant only get the actions for the tenant's courses.
+// Access policy: Admin.
+func (s *AutograderService) GetPendingActions(ctx context.Context, in *pb.Void) (*pb.PendingActions, error) {
+	usr, err := s.getCurrentUser(ctx)
 	if err != nil {
-		s.logger.Errorf("GetOrganization failed: scm authentication error: %v", err)
-		return nil, err
+		s.logger.Errorf("GetPendingActions failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
 	}
-	if !usr.IsAdmin {
-		s.logger.Error("GetOrganization failed: user is not admin")
-		return nil, status.Error(codes.PermissionDenied, "only admin can access organizations")
+	actions, err := s.getPendingActions(usr)
+	if err != nil {
+		s.logger.Errorf("GetPendingActions failed: %v", err)
+		return nil, status.Error(codes.NotFound, "failed to get pending actions")
 	}
-	org, err := s.getOrganization(ctx, scm, in.GetOrgName(), usr.GetLogin())
+	return &pb.PendingActions{Actions: actions}, nil
+}
+
+// ConfirmPendingAction executes the given destructive action,
+// which must have been requested by another user than the confirming admin.
+// Access policy: Admin.
+func (s *AutograderService) ConfirmPendingAction(ctx context.Context, in *pb.PendingAction) (*pb.Void, error) {
+	usr, err := s.getCurrentUser(ctx)
 	if err != nil {
-		s.logger.Errorf("GetOrganization failed: %v", err)
+		s.logger.Errorf("ConfirmPendingAction failed: authentication error: %v", err)
+		return nil, ErrInvalidUserInfo
+	}
+	if err := s.confirmPendingAction(ctx, usr, in.GetID()); err != nil {
+		s.logger.Errorf("ConfirmPendingAction failed: %v", err)
 		if contextCanceled(ctx) {
 			return nil, status.Error(codes.FailedPrecondition, ErrContextCanceled)
 		}
-		if err == scms.ErrNotMember {
-			return nil, status.Error(codes.NotFound, "organization membership not confirmed, please enable third-party access")
-		}
-		if err == ErrFreePlan || err == ErrAlreadyExists || err == scms.ErrNotOwner {
-			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		switch {
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			return nil, status.Error(codes.NotFound, "pending action not found")
+		case errors.Is(err, errConfirmOwnAction), errors.Is(err, errConfirmTenant):
+			return nil, status.Error(codes.PermissionDenied, err.Error())
 		}
 		if ok, parsedErr := parseSCMError(err); ok {
 			return nil, parsedErr
 		}
-		return nil, status.Error(codes.NotFound, "organization not found. Please make sure that 3rd-party access is enabled for your organization")
+		if ok, parsedErr := parseSCMError(errors.Unwrap(err)); ok {
+			return nil, parsedErr
+		}
+		return nil, status.Error(codes.InvalidArgument, "failed to confirm pending action")
 	}
-	return org, nil
+	return &pb.Void{}, nil
 }
 
-// GetRepositories returns URL strings for repositories of given type for the given course
-// Access policy: Any User.
-func (s *AutograderService) GetRepositories(ctx context.Context, in *pb.URLRequest) (*pb.Repositories, error) {
+// CancelPendingAction cancels the given destructive action without executing it.
+// Access policy: Admin or requester of the action.
+func (s *AutograderService) CancelPendingAction(ctx context.Context, in *pb.PendingAction) (*pb.Void, error) {
 	usr, err := s.getCurrentUser(ctx)
 	if err != nil {
-		s.logger.Errorf("GetRepositories failed: authentication error: %v", err)
+		s.logger.Errorf("CancelPendingAction failed: authentication error: %v", err)
 		return nil, ErrInvalidUserInfo
 	}
-	urls := make(map[string]string)
-	for _, repoType := range in.GetRepoTypes() {
-		repo, _ := s.getRepositoryURL(usr, in.GetCourseID(), repoType)
-		// we do not care if some repo was not found, this will append an empty url string in that case
-		// frontend will take care of the rest
-		urls[repoType.String()] = repo
-	}
-	return &pb.Repositories{URLs: urls}, nil
-}
-
-// IsEmptyRepo ensures that group repository is empty and can be deleted
-// Access policy: Teacher of Course ID
-func (s *AutograderService) IsEmptyRepo(ctx context.Context, in *pb.RepositoryRequest) (*pb.Void, error) {
-	usr, scm, err := s.getUserAndSCMForCourse(ctx, in.GetCourseID())
-	if err != nil {
-		s.logger.Errorf("IsEmptyRepo failed: scm authentication error: %v", err)
-		return nil, err
-	}
-
-	if !s.isTeacher(usr.GetID(), in.GetCourseID()) {
-		s.logger.Error("IsEmptyRepo failed: user is not teacher")
-		return nil, status.Error(codes.PermissionDenied, "only teachers can access repository info")
-	}
-
-	if err := s.isEmptyRepo(ctx, scm, in); err != nil {
-		s.logger.Errorf("IsEmptyRepo failed: %v", err)
-		if contextCanceled(ctx) {
-			return nil, status.Error(codes.FailedPrecondition, ErrContextCanceled)
+	if err := s.cancelPendingAction(usr, in.GetID()); err != nil {
+		s.logger.Errorf("CancelPendingAction failed: %v", err)
+		switch {
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			return nil, status.Error(codes.NotFound, "pending action not found")
+		case errors.Is(err, errCancelOtherAction):
+			return nil, status.Error(codes.PermissionDenied, err.Error())
 		}
-		if ok, parsedErr := parseSCMError(err); ok {
-			return nil, parsedErr
-		}
-		return nil, status.Error(codes.FailedPrecondition, "group repository does not exist or not empty")
+		return nil, status.Error(codes.InvalidArgument, "failed to cancel pending action")
 	}
 	return &pb.Void{}, nil
 }
@@ -0,0 +1,57 @@
+package web_test
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/ci"
+	"github.com/autograde/quickfeed/internal/qtest"
+	"github.com/autograde/quickfeed/web"
+	"go.uber.org/zap"
+)
+
+// BenchmarkGetSubmissionsByCourse measures fetching the results of all students
+// in a course with 500 students and 10 assignments, as shown on the teacher's results page.
+func BenchmarkGetSubmissionsByCourse(b *testing.B) {
+	db, cleanup := qtest.TestDB(b)
+	defer cleanup()
+
+	teacher := qtest.CreateFakeUser(b, db, 1)
+	course := &pb.Course{OrganizationID: 1}
+	qtest.CreateCourse(b, db, teacher, course)
+	students := qtest.PopulateCourse(b, db, course, 500, 10)
+
+	_, scms := qtest.FakeProviderMap(b)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	ctx := withUserContext(context.Background(), teacher)
+
+	for _, withBuildInfo := range []bool{false, true} {
+		name := "WithoutBuildInfo"
+		if withBuildInfo {
+			name = "WithBuildInfo"
+		}
+		b.Run(name, func(b *testing.B) {
+			request := &pb.SubmissionsForCourseRequest{CourseID: course.ID, Type: pb.SubmissionsForCourseRequest_ALL, WithBuildInfo: withBuildInfo}
+			for i := 0; i < b.N; i++ {
+				links, err := ags.GetSubmissionsByCourse(ctx, request)
+				if err != nil {
+					b.Fatal(err)
+				}
+				// the teacher is also enrolled in the course
+				if len(links.GetLinks()) != len(students)+1 {
+					b.Fatalf("GetSubmissionsByCourse() returned %d enrollments, want %d", len(links.GetLinks()), len(students)+1)
+				}
+			}
+		})
+	}
+	b.Run("Student", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			student := students[i%len(students)]
+			ctx := withUserContext(context.Background(), student)
+			if _, err := ags.GetSubmissions(ctx, &pb.SubmissionRequest{CourseID: course.ID, UserID: student.ID}); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
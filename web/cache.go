@@ -0,0 +1,106 @@
+package web
+
+import (
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/protobuf/proto"
+)
+
+// defaultCacheTTL is how long cached results are served by default. Cached results are
+// invalidated by writes to the tables they are read from; the expiry bounds the time
+// a result read concurrently with a write that has not yet been committed is served.
+const defaultCacheTTL = time.Minute
+
+// CacheRequestsMetric counts the cached RPC results served (hit) and read from the database (miss).
+var CacheRequestsMetric = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Name: "web_cache_requests",
+	Help: "Number of requests for cached results, by result (hit or miss).",
+}, []string{"result"})
+
+// Tables that the cached results are read from.
+var (
+	coursesTables     = []string{"courses"}
+	assignmentsTables = []string{"assignments", "grading_benchmarks", "grading_criterions", "courses"}
+	enrollmentsTables = []string{"enrollments", "users", "courses", "groups", "group_users", "used_slip_days"}
+)
+
+type cacheEntry struct {
+	value   proto.Message
+	tables  []string
+	expires time.Time
+}
+
+// resultCache holds the results of frequently called read RPCs, such as the courses,
+// assignments and enrollments fetched by every student's dashboard. The results are
+// invalidated when any of the tables they are read from are written to.
+type resultCache struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	entries map[string]*cacheEntry
+}
+
+func newResultCache(ttl time.Duration) *resultCache {
+	return &resultCache{ttl: ttl, entries: make(map[string]*cacheEntry)}
+}
+
+// get returns a copy of the cached result for the given key, if any.
+func (c *resultCache) get(key string) (proto.Message, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, ok := c.entries[key]
+	if ok && time.Now().After(entry.expires) {
+		delete(c.entries, key)
+		ok = false
+	}
+	if !ok {
+		CacheRequestsMetric.WithLabelValues("miss").Inc()
+		return nil, false
+	}
+	CacheRequestsMetric.WithLabelValues("hit").Inc()
+	return proto.Clone(entry.value), true
+}
+
+// set caches a copy of the result for the given key, read from the given tables.
+func (c *resultCache) set(key string, value proto.Message, tables []string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.ttl <= 0 {
+		return
+	}
+	c.entries[key] = &cacheEntry{
+		value:   proto.Clone(value),
+		tables:  tables,
+		expires: time.Now().Add(c.ttl),
+	}
+}
+
+// invalidate removes the cached results read from the given table;
+// all cached results are removed if the table is unknown.
+func (c *resultCache) invalidate(table string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for key, entry := range c.entries {
+		if table == "" || contains(entry.tables, table) {
+			delete(c.entries, key)
+		}
+	}
+}
+
+// setTTL sets the time results are cached; results are not cached if ttl is zero.
+func (c *resultCache) setTTL(ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.ttl = ttl
+	c.entries = make(map[string]*cacheEntry)
+}
+
+func contains(tables []string, table string) bool {
+	for _, t := range tables {
+		if t == table {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,128 @@
+package web_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"go.uber.org/zap"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/ci"
+	"github.com/autograde/quickfeed/internal/qtest"
+	"github.com/autograde/quickfeed/web"
+)
+
+func TestResultCache(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	admin := qtest.CreateFakeUser(t, db, 1)
+	course := &pb.Course{Name: "Distributed Systems", Code: "DAT520", Provider: "fake", OrganizationID: 1}
+	qtest.CreateCourse(t, db, admin, course)
+	student := qtest.CreateFakeUser(t, db, 2)
+	qtest.EnrollStudent(t, db, student, course)
+	if err := db.CreateAssignment(&pb.Assignment{CourseID: course.ID, Name: "lab1", Order: 1}); err != nil {
+		t.Fatal(err)
+	}
+
+	_, scms := qtest.FakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	ctx := withUserContext(context.Background(), student)
+	hits := func() float64 { return testutil.ToFloat64(web.CacheRequestsMetric.WithLabelValues("hit")) }
+
+	// courses
+	courses, err := ags.GetCourses(ctx, &pb.Void{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// changes to returned results do not change the cached results
+	courses.Courses[0].Name = "Changed"
+	before := hits()
+	if courses, err = ags.GetCourses(ctx, &pb.Void{}); err != nil {
+		t.Fatal(err)
+	}
+	if hits()-before != 1 {
+		t.Error("GetCourses: expected cached result")
+	}
+	if name := courses.GetCourses()[0].GetName(); name != "Distributed Systems" {
+		t.Errorf("GetCourses: course name = %q, want %q", name, "Distributed Systems")
+	}
+	course.Name = "Distributed Systems and Clouds"
+	if err := db.UpdateCourse(course); err != nil {
+		t.Fatal(err)
+	}
+	if courses, err = ags.GetCourses(ctx, &pb.Void{}); err != nil {
+		t.Fatal(err)
+	}
+	if name := courses.GetCourses()[0].GetName(); name != course.Name {
+		t.Errorf("GetCourses after update: course name = %q, want %q", name, course.Name)
+	}
+
+	// assignments
+	if _, err := ags.GetAssignments(ctx, &pb.CourseRequest{CourseID: course.ID}); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.UpdateAssignments([]*pb.Assignment{
+		{CourseID: course.ID, Name: "lab1", Order: 1},
+		{CourseID: course.ID, Name: "lab2", Order: 2},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	assignments, err := ags.GetAssignments(ctx, &pb.CourseRequest{CourseID: course.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(assignments.GetAssignments()) != 2 {
+		t.Errorf("GetAssignments after update: got %d assignments, want 2", len(assignments.GetAssignments()))
+	}
+
+	// enrollments
+	request := &pb.EnrollmentRequest{CourseID: course.ID, IgnoreGroupMembers: true}
+	enrollments, err := ags.GetEnrollmentsByCourse(ctx, request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(enrollments.GetEnrollments()) != 2 {
+		t.Fatalf("GetEnrollmentsByCourse: got %d enrollments, want 2", len(enrollments.GetEnrollments()))
+	}
+	before = hits()
+	if _, err := ags.GetEnrollmentsByCourse(ctx, request); err != nil {
+		t.Fatal(err)
+	}
+	if hits()-before != 1 {
+		t.Error("GetEnrollmentsByCourse: expected cached result")
+	}
+	// creating a group updates the members' enrollments
+	if err := db.CreateGroup(&pb.Group{Name: "group", CourseID: course.ID, Users: []*pb.User{student}}); err != nil {
+		t.Fatal(err)
+	}
+	if enrollments, err = ags.GetEnrollmentsByCourse(ctx, request); err != nil {
+		t.Fatal(err)
+	}
+	if len(enrollments.GetEnrollments()) != 1 {
+		t.Errorf("GetEnrollmentsByCourse after creating group: got %d enrollments without group, want 1", len(enrollments.GetEnrollments()))
+	}
+}
+
+func TestResultCacheDisabled(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	admin := qtest.CreateFakeUser(t, db, 1)
+	qtest.CreateCourse(t, db, admin, &pb.Course{Name: "Distributed Systems", Provider: "fake", OrganizationID: 1})
+
+	_, scms := qtest.FakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	ags.SetCacheTTL(0)
+	ctx := withUserContext(context.Background(), admin)
+	hits := testutil.ToFloat64(web.CacheRequestsMetric.WithLabelValues("hit"))
+	for i := 0; i < 2; i++ {
+		if _, err := ags.GetCourses(ctx, &pb.Void{}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if got := testutil.ToFloat64(web.CacheRequestsMetric.WithLabelValues("hit")) - hits; got != 0 {
+		t.Errorf("got %v cache hits with caching disabled, want 0", got)
+	}
+}
@@ -0,0 +1,138 @@
+package web
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"html/template"
+	"net/http"
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/internal/rand"
+	"github.com/labstack/echo/v4"
+	"gorm.io/gorm"
+)
+
+// certificateSignature returns the signature of the certificate's content.
+func (s *AutograderService) certificateSignature(certificate *pb.Certificate) string {
+	mac := hmac.New(sha256.New, []byte(s.certificateSecret))
+	fmt.Fprintf(mac, "%d/%d/%s/%s/%s/%s/%s",
+		certificate.GetCourseID(), certificate.GetUserID(), certificate.GetStudentName(),
+		certificate.GetCourseName(), certificate.GetGrade(), certificate.GetIssued(), certificate.GetCode())
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// certificateURL returns the public verification URL of the certificate with the given code.
+func (s *AutograderService) certificateURL(code string) string {
+	return fmt.Sprintf("https://%s/certificates/%s", s.bh.BaseURL, code)
+}
+
+// getCertificate returns the given user's certificate for the course, issuing a new
+// certificate if the user has passed the course and the final grades have been released.
+func (s *AutograderService) getCertificate(usr *pb.User, courseID uint64) (*pb.Certificate, error) {
+	course, err := s.db.GetCourse(courseID, false)
+	if err != nil {
+		return nil, err
+	}
+	if !course.GetCertificates() {
+		return nil, fmt.Errorf("course %d does not issue certificates", courseID)
+	}
+	certificate, err := s.db.GetCertificate(&pb.Certificate{CourseID: courseID, UserID: usr.GetID()})
+	if err == nil {
+		certificate.URL = s.certificateURL(certificate.GetCode())
+		return certificate, nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+	if course.GetFinalGradesReleased() == "" {
+		return nil, fmt.Errorf("final grades for course %d not released", courseID)
+	}
+
+	finalGrades, err := s.computeFinalGrades(courseID)
+	if err != nil {
+		return nil, err
+	}
+	var finalGrade *pb.FinalGrade
+	for _, grade := range finalGrades.GetGrades() {
+		if grade.GetUserID() == usr.GetID() {
+			finalGrade = grade
+		}
+	}
+	if !finalGrade.GetPassed() {
+		return nil, fmt.Errorf("user %d has not passed course %d", usr.GetID(), courseID)
+	}
+
+	certificate = &pb.Certificate{
+		CourseID:    courseID,
+		UserID:      usr.GetID(),
+		StudentName: usr.GetName(),
+		CourseName:  fmt.Sprintf("%s %s (%s %d)", course.GetCode(), course.GetName(), course.GetTag(), course.GetYear()),
+		Grade:       finalGrade.GetGrade(),
+		Issued:      time.Now().Format(pb.TimeLayout),
+		Code:        rand.String()[:20],
+	}
+	certificate.Signature = s.certificateSignature(certificate)
+	if err := s.db.CreateCertificate(certificate); err != nil {
+		return nil, err
+	}
+	certificate.URL = s.certificateURL(certificate.GetCode())
+	return certificate, nil
+}
+
+var certificateTemplate = template.Must(template.New("certificate").Parse(`<!DOCTYPE html>
+<html>
+<head><meta charset="utf-8"><title>Certificate of Completion</title></head>
+<body>
+{{if .Valid}}
+<h1>Certificate of Completion</h1>
+<p>This certifies that</p>
+<h2>{{.StudentName}}</h2>
+<p>has completed the course</p>
+<h2>{{.CourseName}}</h2>
+<p>with the grade <strong>{{.Grade}}</strong>.</p>
+<p>Issued {{.Issued}}. Verification code: <code>{{.Code}}</code></p>
+<p>Verify this certificate at <a href="{{.URL}}">{{.URL}}</a></p>
+{{else}}
+<h1>Invalid certificate</h1>
+<p>The certificate with verification code <code>{{.Code}}</code> could not be verified.</p>
+{{end}}
+</body>
+</html>
+`))
+
+// VerifyCertificate returns a handler serving the certificate with the given verification code
+// as an HTML page, which can also be downloaded as the certificate. The page is public,
+// such that anyone with the verification URL can verify the certificate.
+func (s *AutograderService) VerifyCertificate() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		code := c.Param("code")
+		certificate, err := s.db.GetCertificate(&pb.Certificate{Code: code})
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			s.logger.Errorf("VerifyCertificate failed for code %s: %v", code, err)
+			return echo.ErrInternalServerError
+		}
+		valid := err == nil && hmac.Equal([]byte(certificate.GetSignature()), []byte(s.certificateSignature(certificate)))
+		if !valid {
+			certificate = &pb.Certificate{Code: code}
+		}
+		certificate.URL = s.certificateURL(code)
+
+		var out bytes.Buffer
+		if err := certificateTemplate.Execute(&out, struct {
+			*pb.Certificate
+			Valid bool
+		}{certificate, valid}); err != nil {
+			return err
+		}
+		statusCode := http.StatusOK
+		if !valid {
+			statusCode = http.StatusNotFound
+		}
+		return c.HTMLBlob(statusCode, out.Bytes())
+	}
+}
@@ -0,0 +1,111 @@
+package web_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/ci"
+	"github.com/autograde/quickfeed/internal/qtest"
+	"github.com/autograde/quickfeed/web"
+)
+
+func TestCertificates(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	teacher := qtest.CreateFakeUser(t, db, 1)
+	course := &pb.Course{Code: "DAT320", Name: "Operating Systems", Certificates: true}
+	qtest.CreateCourse(t, db, teacher, course)
+	student := qtest.CreateUser(t, db, 2, &pb.User{Name: "Ola Nordmann", Login: "ola"})
+	qtest.EnrollStudent(t, db, student, course)
+	failing := qtest.CreateUser(t, db, 3, &pb.User{Name: "Kari Nordmann", Login: "kari"})
+	qtest.EnrollStudent(t, db, failing, course)
+
+	assignment := &pb.Assignment{CourseID: course.ID, Name: "lab1", Order: 1}
+	if err := db.CreateAssignment(assignment); err != nil {
+		t.Fatal(err)
+	}
+	for _, submission := range []*pb.Submission{
+		{AssignmentID: assignment.ID, UserID: student.ID, Score: 95},
+		{AssignmentID: assignment.ID, UserID: failing.ID, Score: 10},
+	} {
+		if err := db.CreateSubmission(submission); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	_, scms := qtest.FakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{BaseURL: "example.com"}, &ci.Local{})
+	studentCtx := withUserContext(context.Background(), student)
+	request := &pb.CourseRequest{CourseID: course.ID}
+
+	if _, err := ags.GetCertificate(studentCtx, request); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("GetCertificate() before final grades are released: got %v, want %v", err, codes.FailedPrecondition)
+	}
+	course.FinalGradesReleased = time.Now().Format(pb.TimeLayout)
+	if err := db.UpdateCourse(course); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ags.GetCertificate(withUserContext(context.Background(), failing), request); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("GetCertificate() for failing student: got %v, want %v", err, codes.FailedPrecondition)
+	}
+	if _, err := ags.GetCertificate(withUserContext(context.Background(), teacher), request); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("GetCertificate() by teacher: got %v, want %v", err, codes.PermissionDenied)
+	}
+	certificate, err := ags.GetCertificate(studentCtx, request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if certificate.GetGrade() != "A" || certificate.GetStudentName() != student.Name || certificate.GetCode() == "" {
+		t.Errorf("GetCertificate() = %v, want grade A for %s", certificate, student.Name)
+	}
+	again, err := ags.GetCertificate(studentCtx, request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if again.GetCode() != certificate.GetCode() {
+		t.Errorf("GetCertificate() issued new certificate %s, want existing certificate %s", again.GetCode(), certificate.GetCode())
+	}
+
+	u, err := url.Parse(certificate.GetURL())
+	if err != nil {
+		t.Fatal(err)
+	}
+	e := echo.New()
+	e.GET("/certificates/:code", ags.VerifyCertificate())
+
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, u.RequestURI(), nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("VerifyCertificate() status = %d, want %d", rec.Code, http.StatusOK)
+	}
+	for _, want := range []string{"Certificate of Completion", student.Name, "DAT320 Operating Systems", certificate.GetCode()} {
+		if !strings.Contains(rec.Body.String(), want) {
+			t.Errorf("VerifyCertificate() missing %q in certificate:\n%s", want, rec.Body.String())
+		}
+	}
+
+	// a certificate signed with another secret is not valid
+	ags.SetCertificateSecret("another secret")
+	rec = httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, u.RequestURI(), nil))
+	if rec.Code != http.StatusNotFound || !strings.Contains(rec.Body.String(), "Invalid certificate") {
+		t.Errorf("VerifyCertificate() with another secret status = %d, want %d", rec.Code, http.StatusNotFound)
+	}
+	rec = httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/certificates/unknown", nil))
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("VerifyCertificate() for unknown code status = %d, want %d", rec.Code, http.StatusNotFound)
+	}
+}
@@ -0,0 +1,87 @@
+package web
+
+import (
+	"context"
+	"time"
+)
+
+// RunCourseChecks checks all courses at the given interval, until the context is canceled.
+// For each course, the students' access to the info and assignments repositories is repaired,
+// the course is checked for orphaned resources and for exposure of the tests repository,
+// and the course's access report is updated. Students are also reminded of deadlines
+// within the next day, and unanswered group invitations expire. Problems found are reported in the log,
+// and the most recent reports for each course are available through GetOrphanedResources
+// and GetAccessReport. Pending enrollments admitted by the course's automatic approval
+// rules are accepted, and assignments whose release time has passed are published.
+// Student repositories are opened for pushes during exams, and frozen when the exams end.
+func (s *AutograderService) RunCourseChecks(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		s.checkCourses(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// checkCourses runs the periodic checks for all courses.
+func (s *AutograderService) checkCourses(ctx context.Context) {
+	courses, err := s.db.GetCourses()
+	if err != nil {
+		s.logger.Errorf("Course checks failed to get courses: %v", err)
+		return
+	}
+	for _, course := range courses {
+		if !course.GetArchived() {
+			if err := s.notifyDeadlines(ctx, course, time.Now()); err != nil {
+				s.logger.Errorf("Deadline reminders failed for course %s: %v", course.GetCode(), err)
+			}
+			if _, err := s.expireGroupInvitations(course, time.Now()); err != nil {
+				s.logger.Errorf("Group invitation expiry failed for course %s: %v", course.GetCode(), err)
+			}
+			// students in the roster are enrolled as pending when they first log in
+			if _, err := s.autoApproveEnrollments(ctx, course); err != nil {
+				s.logger.Errorf("Automatic enrollment approval failed for course %s: %v", course.GetCode(), err)
+			}
+		}
+
+		sc, err := s.getCourseSCM(course)
+		if err != nil {
+			s.logger.Errorf("Course checks failed to get SCM for course %s: %v", course.GetCode(), err)
+			continue
+		}
+		if !course.GetArchived() {
+			if _, err := s.releaseAssignments(ctx, sc, course, time.Now()); err != nil {
+				s.logger.Errorf("Assignment release failed for course %s: %v", course.GetCode(), err)
+			}
+			if _, err := s.enforceExamWindows(ctx, sc, course, time.Now()); err != nil {
+				s.logger.Errorf("Exam access update failed for course %s: %v", course.GetCode(), err)
+			}
+		}
+		if err := s.repairCourseRepoAccess(ctx, sc, course); err != nil {
+			s.logger.Errorf("Failed to repair repository access for course %s: %v", course.GetCode(), err)
+		}
+
+		report, err := s.findOrphanedResources(ctx, sc, course)
+		if err != nil {
+			s.logger.Errorf("Orphan detection failed for course %s: %v", course.GetCode(), err)
+		} else if len(report.GetRepositories())+len(report.GetTeams())+len(report.GetUsers()) > 0 {
+			s.logger.Warnf("Orphan detection found orphaned resources in course %s: repositories: %v, teams: %v, users: %v",
+				course.GetCode(), report.GetRepositories(), report.GetTeams(), report.GetUsers())
+		}
+
+		if _, err := s.buildAccessReport(ctx, sc, course); err != nil {
+			s.logger.Errorf("Access report failed for course %s: %v", course.GetCode(), err)
+		}
+
+		exposure, err := s.auditTestsExposure(ctx, sc, course, s.revokeExposure)
+		if err != nil {
+			s.logger.Errorf("Tests exposure audit failed for course %s: %v", course.GetCode(), err)
+		} else if len(exposure.GetViolations()) > 0 {
+			s.reportTestsExposure(ctx, course, exposure)
+		}
+	}
+}
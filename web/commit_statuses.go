@@ -0,0 +1,98 @@
+package web
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/ci"
+	"github.com/autograde/quickfeed/scm"
+)
+
+// postPendingStatus posts a pending status on the commit to be tested by the given test job,
+// such that students can see that their commit is being graded, e.g., on a pull request.
+func (s *AutograderService) postPendingStatus(ctx context.Context, rData *ci.RunData) {
+	if rData.CommitID == "" {
+		return
+	}
+	description := "Tests queued"
+	if rData.Rebuild {
+		description = "Tests queued for rebuild"
+	}
+	s.postCommitStatus(ctx, rData.Course, rData.Assignment, rData.Repo, &scm.CommitStatusOptions{
+		CommitSHA:   rData.CommitID,
+		State:       scm.CommitPending,
+		Description: description,
+	})
+}
+
+// postSubmissionStatus posts the result of the given submission as the status of the graded commit,
+// which is shown as a status check on pull requests, with the score in the status' description.
+func (s *AutograderService) postSubmissionStatus(ctx context.Context, submission *pb.Submission) error {
+	commit := submission.GetGradedCommit()
+	if commit == "" {
+		commit = submission.GetCommitHash()
+	}
+	if commit == "" {
+		return nil
+	}
+	assignment, course, err := s.getAssignmentWithCourse(&pb.Assignment{ID: submission.GetAssignmentID()}, false)
+	if err != nil {
+		return err
+	}
+	repo, err := s.submissionRepository(course, submission)
+	if err != nil {
+		return err
+	}
+	state, description := submissionStatus(assignment, submission)
+	s.postCommitStatus(ctx, course, assignment, repo, &scm.CommitStatusOptions{
+		CommitSHA:   commit,
+		State:       state,
+		Description: description,
+	})
+	return nil
+}
+
+// postCommitStatus posts the given status of a commit in repo, for the given assignment,
+// with a link to the assignment in QuickFeed. Failures are logged, but otherwise ignored,
+// since statuses are only informative; providers not supporting statuses are skipped.
+func (s *AutograderService) postCommitStatus(ctx context.Context, course *pb.Course, assignment *pb.Assignment, repo *pb.Repository, opt *scm.CommitStatusOptions) {
+	sc, err := s.getCourseSCM(course)
+	if err != nil {
+		s.logger.Errorf("Failed to get SCM to post status of commit %s for course %s: %v", opt.CommitSHA, course.GetCode(), err)
+		return
+	}
+	opt.Owner = course.GetOrganizationPath()
+	opt.Repository = path.Base(repo.GetHTMLURL())
+	opt.Context = "quickfeed/" + assignment.GetName()
+	if s.bh.BaseURL != "" {
+		opt.TargetURL = fmt.Sprintf("https://%s/app/student/courses/%d/lab/%d", s.bh.BaseURL, course.GetID(), assignment.GetID())
+	}
+	if err := sc.CreateCommitStatus(ctx, opt); err != nil {
+		if errors.As(err, &scm.ErrNotSupported{}) {
+			s.logger.Debugf("Commit statuses are not supported for course %s: %v", course.GetCode(), err)
+			return
+		}
+		s.logger.Errorf("Failed to post status of commit %s in %s: %v", opt.CommitSHA, repo.GetHTMLURL(), err)
+	}
+}
+
+// submissionStatus returns the state and description of the commit status for the given submission.
+// The status is success if the submission reaches the assignment's score limit and minimal test coverage,
+// and pending for manually reviewed assignments, whose submissions are not tested.
+func submissionStatus(assignment *pb.Assignment, submission *pb.Submission) (scm.CommitState, string) {
+	switch {
+	case assignment.SkipTests():
+		return scm.CommitPending, "Awaiting manual review"
+	case submission.GetPolicyBlocked():
+		return scm.CommitFailure, "Tests not run: the pushed code violates the assignment's policy"
+	case submission.GetScore() < assignment.GetScoreLimit():
+		return scm.CommitFailure, fmt.Sprintf("Score %d%%, %d%% required", submission.GetScore(), assignment.GetScoreLimit())
+	case submission.GetCoverage() < float64(assignment.GetMinCoverage()):
+		return scm.CommitFailure, fmt.Sprintf("Score %d%%, coverage %.1f%%, %d%% coverage required", submission.GetScore(), submission.GetCoverage(), assignment.GetMinCoverage())
+	default:
+		return scm.CommitSuccess, fmt.Sprintf("Score %d%%", submission.GetScore())
+	}
+}
@@ -0,0 +1,151 @@
+package web_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"go.uber.org/zap"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/ci"
+	"github.com/autograde/quickfeed/internal/qtest"
+	"github.com/autograde/quickfeed/scm"
+	"github.com/autograde/quickfeed/web"
+)
+
+func TestPullRequestStatus(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	admin := qtest.CreateFakeUser(t, db, 1)
+	course := &pb.Course{Provider: "fake", OrganizationID: 1, OrganizationPath: "test"}
+	qtest.CreateCourse(t, db, admin, course)
+	student := qtest.CreateFakeUser(t, db, 2)
+	qtest.EnrollStudent(t, db, student, course)
+	if err := db.CreateRepository(&pb.Repository{
+		OrganizationID: course.OrganizationID,
+		RepositoryID:   42,
+		UserID:         student.ID,
+		RepoType:       pb.Repository_USER,
+		HTMLURL:        "https://example.com/test/student-labs",
+	}); err != nil {
+		t.Fatal(err)
+	}
+	// manually reviewed, such that submissions are recorded without running tests
+	assignment := &pb.Assignment{CourseID: course.ID, Name: "lab1", Order: 1, Reviewers: 1, SubmissionBranch: "submit"}
+	if err := db.CreateAssignment(assignment); err != nil {
+		t.Fatal(err)
+	}
+	// a pull request opened before the webhook could be processed
+	payload := []byte(`{"action":"opened","repository":{"id":42,"name":"student-labs","full_name":"test/student-labs"},` +
+		`"sender":{"login":"student"},"pull_request":{"number":3,"base":{"ref":"submit"},"head":{"ref":"lab1","sha":"c1"}}}`)
+	if err := db.CreateWebhookDelivery(&pb.WebhookDelivery{DeliveryID: "d1", Event: "pull_request", Status: pb.WebhookDelivery_FAILED, Deliveries: 1, Payload: payload}); err != nil {
+		t.Fatal(err)
+	}
+
+	fakeProvider, scms := qtest.FakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{BaseURL: "example.com"}, &ci.Local{})
+	delivery, err := ags.ReplayWebhookDelivery(withUserContext(context.Background(), admin), &pb.WebhookDeliveryRequest{DeliveryID: "d1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if delivery.GetStatus() != pb.WebhookDelivery_PROCESSED {
+		t.Fatalf("ReplayWebhookDelivery() = %v, want processed", delivery)
+	}
+	submission, err := db.GetSubmission(&pb.Submission{AssignmentID: assignment.ID, UserID: student.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if submission.GetCommitHash() != "c1" || submission.GetPullRequest() != 3 {
+		t.Errorf("submission = %v, want submission of c1 by pull request 3", submission)
+	}
+
+	// the status is posted when the submission's results are published
+	want := []*scm.CommitStatusOptions{{
+		Owner:       "test",
+		Repository:  "student-labs",
+		CommitSHA:   "c1",
+		State:       scm.CommitPending,
+		Context:     "quickfeed/lab1",
+		Description: "Awaiting manual review",
+		TargetURL:   fmt.Sprintf("https://example.com/app/student/courses/%d/lab/%d", course.ID, assignment.ID),
+	}}
+	if diff := cmp.Diff(want, waitForStatuses(fakeProvider.(*scm.FakeSCM), "test/student-labs/c1", 1)); diff != "" {
+		t.Errorf("commit statuses mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestCommitStatus(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	admin := qtest.CreateFakeUser(t, db, 1)
+	course := &pb.Course{Provider: "fake", OrganizationID: 1, OrganizationPath: "test"}
+	qtest.CreateCourse(t, db, admin, course)
+	student := qtest.CreateFakeUser(t, db, 2)
+	qtest.EnrollStudent(t, db, student, course)
+	if err := db.CreateRepository(&pb.Repository{
+		OrganizationID: course.OrganizationID,
+		RepositoryID:   42,
+		UserID:         student.ID,
+		RepoType:       pb.Repository_USER,
+		HTMLURL:        "https://example.com/test/student-labs",
+	}); err != nil {
+		t.Fatal(err)
+	}
+	// the tests always give a score of 80
+	assignment := &pb.Assignment{
+		CourseID:   course.ID,
+		Name:       "lab1",
+		Order:      1,
+		ScoreLimit: 90,
+		ScriptFile: `#image/qf101` + "\n" + `echo '{"Secret":"{{ .RandomSecret }}","TestName":"TestLab1","Score":8,"MaxScore":10,"Weight":1}'`,
+	}
+	if err := db.CreateAssignment(assignment); err != nil {
+		t.Fatal(err)
+	}
+	payload := []byte(`{"ref":"refs/heads/main","repository":{"id":42,"name":"student-labs","full_name":"test/student-labs","default_branch":"main"},` +
+		`"sender":{"login":"student"},"head_commit":{"id":"c1"},"commits":[{"id":"c1","modified":["lab1/main.go"]}]}`)
+	if err := db.CreateWebhookDelivery(&pb.WebhookDelivery{DeliveryID: "d1", Event: "push", Status: pb.WebhookDelivery_FAILED, Deliveries: 1, Payload: payload}); err != nil {
+		t.Fatal(err)
+	}
+
+	fakeProvider, scms := qtest.FakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	if _, err := ags.ReplayWebhookDelivery(withUserContext(context.Background(), admin), &pb.WebhookDeliveryRequest{DeliveryID: "d1"}); err != nil {
+		t.Fatal(err)
+	}
+
+	// the commit is pending while queued, and fails when graded below the score limit
+	status := func(state scm.CommitState, description string) *scm.CommitStatusOptions {
+		return &scm.CommitStatusOptions{
+			Owner:       "test",
+			Repository:  "student-labs",
+			CommitSHA:   "c1",
+			State:       state,
+			Context:     "quickfeed/lab1",
+			Description: description,
+		}
+	}
+	want := []*scm.CommitStatusOptions{
+		status(scm.CommitPending, "Tests queued"),
+		status(scm.CommitFailure, "Score 80%, 90% required"),
+	}
+	if diff := cmp.Diff(want, waitForStatuses(fakeProvider.(*scm.FakeSCM), "test/student-labs/c1", 2)); diff != "" {
+		t.Errorf("commit statuses mismatch (-want +got):\n%s", diff)
+	}
+}
+
+// waitForStatuses waits until the given number of statuses have been posted for the commit
+// with the given key, or a timeout, since statuses are posted in the background.
+func waitForStatuses(fake *scm.FakeSCM, key string, n int) []*scm.CommitStatusOptions {
+	for deadline := time.Now().Add(10 * time.Second); time.Now().Before(deadline); time.Sleep(10 * time.Millisecond) {
+		if statuses := fake.CommitStatuses[key]; len(statuses) >= n {
+			return statuses
+		}
+	}
+	return fake.CommitStatuses[key]
+}
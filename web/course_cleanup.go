@@ -0,0 +1,71 @@
+package web
+
+import (
+	"context"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/scm"
+)
+
+// archiveCourse marks the course as archived, making it read-only.
+// The course's repositories on the SCM provider are kept, archived or deleted as requested.
+func (s *AutograderService) archiveCourse(ctx context.Context, sc scm.SCM, usr *pb.User, request *pb.CourseCleanupRequest) error {
+	course, err := s.db.GetCourse(request.GetCourseID(), false)
+	if err != nil {
+		return err
+	}
+	if err := s.cleanupCourseRepositories(ctx, sc, usr, course, request.GetRepositories()); err != nil {
+		return err
+	}
+	if err := s.db.ArchiveCourse(course.GetID()); err != nil {
+		return err
+	}
+	s.audit(usr, course.GetID(), "archive course", "course", course.GetCode(), "courseID", course.GetID())
+	return nil
+}
+
+// deleteCourse deletes the course with its enrollments, groups, assignments and submissions.
+// The course's repositories on the SCM provider are kept, archived or deleted as requested.
+func (s *AutograderService) deleteCourse(ctx context.Context, sc scm.SCM, usr *pb.User, request *pb.CourseCleanupRequest) error {
+	course, err := s.db.GetCourse(request.GetCourseID(), false)
+	if err != nil {
+		return err
+	}
+	if err := s.cleanupCourseRepositories(ctx, sc, usr, course, request.GetRepositories()); err != nil {
+		return err
+	}
+	if err := s.db.DeleteCourse(course.GetID()); err != nil {
+		return err
+	}
+	s.audit(usr, course.GetID(), "delete course", "course", course.GetCode(), "courseID", course.GetID())
+	return nil
+}
+
+// cleanupCourseRepositories archives or deletes the course's repositories on the SCM provider.
+func (s *AutograderService) cleanupCourseRepositories(ctx context.Context, sc scm.SCM, usr *pb.User, course *pb.Course, action pb.CourseCleanupRequest_RepositoryAction) error {
+	if action == pb.CourseCleanupRequest_KEEP {
+		return nil
+	}
+	repos, err := s.db.GetRepositories(&pb.Repository{OrganizationID: course.GetOrganizationID()})
+	if err != nil {
+		return err
+	}
+	for _, repo := range repos {
+		opt := &scm.RepositoryOptions{ID: repo.GetRepositoryID()}
+		switch action {
+		case pb.CourseCleanupRequest_ARCHIVE:
+			err = sc.ArchiveRepository(ctx, opt)
+		case pb.CourseCleanupRequest_DELETE:
+			err = sc.DeleteRepository(ctx, opt)
+		}
+		if err != nil {
+			return err
+		}
+		s.audit(usr, course.GetID(), "cleanup course repository",
+			"action", action.String(),
+			"course", course.GetCode(),
+			"repository", repo.GetHTMLURL(),
+		)
+	}
+	return nil
+}
@@ -0,0 +1,169 @@
+package web_test
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/ci"
+	"github.com/autograde/quickfeed/database"
+	"github.com/autograde/quickfeed/internal/qtest"
+	"github.com/autograde/quickfeed/scm"
+	"github.com/autograde/quickfeed/web"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeCourseRepos adds the course's repositories in the database to the fake SCM.
+func fakeCourseRepos(t *testing.T, db database.Database, fakeSCM *scm.FakeSCM, course *pb.Course) []uint64 {
+	t.Helper()
+	repos, err := db.GetRepositories(&pb.Repository{OrganizationID: course.GetOrganizationID()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var ids []uint64
+	for _, repo := range repos {
+		fakeSCM.Repositories[repo.GetRepositoryID()] = &scm.Repository{ID: repo.GetRepositoryID(), OrgID: course.GetOrganizationID()}
+		ids = append(ids, repo.GetRepositoryID())
+	}
+	return ids
+}
+
+func TestArchiveCourse(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	teacher := qtest.CreateFakeUser(t, db, 1)
+	course := &pb.Course{Code: "DAT101", OrganizationID: 1}
+	qtest.CreateCourse(t, db, teacher, course)
+	students := qtest.PopulateCourse(t, db, course, 2, 1)
+	fakeProvider, scms := qtest.FakeProviderMap(t)
+	fakeSCM := fakeProvider.(*scm.FakeSCM)
+	repoIDs := fakeCourseRepos(t, db, fakeSCM, course)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	client := accessControlled(t, ags)
+	fakeGothProvider()
+
+	request := &pb.CourseCleanupRequest{CourseID: course.GetID(), Repositories: pb.CourseCleanupRequest_ARCHIVE}
+	_, err := client.ArchiveCourse(withUserContext(context.Background(), students[0]), request)
+	if status.Code(err) != codes.PermissionDenied {
+		t.Errorf("ArchiveCourse() by student = %v, want code %v", err, codes.PermissionDenied)
+	}
+
+	ctx := withUserContext(context.Background(), teacher)
+	if _, err := ags.ArchiveCourse(ctx, request); err != nil {
+		t.Fatal(err)
+	}
+	gotCourse, err := db.GetCourse(course.GetID(), false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !gotCourse.GetArchived() {
+		t.Error("ArchiveCourse() did not archive the course")
+	}
+	for _, id := range repoIDs {
+		if !fakeSCM.Repositories[id].Archived {
+			t.Errorf("ArchiveCourse() did not archive repository %d", id)
+		}
+	}
+
+	// the archived course is read-only
+	if _, err := ags.UpdateAssignments(ctx, &pb.CourseRequest{CourseID: course.GetID()}); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("UpdateAssignments() for archived course = %v, want code %v", err, codes.FailedPrecondition)
+	}
+	user := qtest.CreateFakeUser(t, db, 2)
+	enrollment := &pb.Enrollment{UserID: user.GetID(), CourseID: course.GetID()}
+	if _, err := ags.CreateEnrollment(withUserContext(context.Background(), user), enrollment); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("CreateEnrollment() for archived course = %v, want code %v", err, codes.FailedPrecondition)
+	}
+}
+
+func TestDeleteCourse(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	teacher := qtest.CreateFakeUser(t, db, 1)
+	course := &pb.Course{Code: "DAT101", OrganizationID: 1}
+	qtest.CreateCourse(t, db, teacher, course)
+	students := qtest.PopulateCourse(t, db, course, 2, 2)
+	group := &pb.Group{Name: "group", CourseID: course.GetID(), Users: students}
+	if err := db.CreateGroup(group); err != nil {
+		t.Fatal(err)
+	}
+	// another course whose records must not be affected
+	otherCourse := &pb.Course{Code: "DAT102", OrganizationID: 2}
+	qtest.CreateCourse(t, db, teacher, otherCourse)
+	otherAssignment := &pb.Assignment{CourseID: otherCourse.GetID(), Name: "lab1", Order: 1}
+	if err := db.CreateAssignment(otherAssignment); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.CreateSubmission(&pb.Submission{AssignmentID: otherAssignment.GetID(), UserID: teacher.GetID()}); err != nil {
+		t.Fatal(err)
+	}
+
+	fakeProvider, scms := qtest.FakeProviderMap(t)
+	fakeSCM := fakeProvider.(*scm.FakeSCM)
+	repoIDs := fakeCourseRepos(t, db, fakeSCM, course)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	client := accessControlled(t, ags)
+	fakeGothProvider()
+
+	request := &pb.CourseCleanupRequest{CourseID: course.GetID(), Repositories: pb.CourseCleanupRequest_DELETE}
+	_, err := client.DeleteCourse(withUserContext(context.Background(), students[0]), request)
+	if status.Code(err) != codes.PermissionDenied {
+		t.Errorf("DeleteCourse() by student = %v, want code %v", err, codes.PermissionDenied)
+	}
+	if _, err := ags.DeleteCourse(withUserContext(context.Background(), teacher), request); err != web.ErrPendingAction {
+		t.Fatalf("DeleteCourse() = %v, want %v", err, web.ErrPendingAction)
+	}
+	if _, err := db.GetCourse(course.GetID(), false); err != nil {
+		t.Fatalf("DeleteCourse() deleted the course before confirmation: %v", err)
+	}
+	// another admin must confirm the deletion
+	admin := qtest.CreateFakeUser(t, db, 10)
+	if err := db.UpdateUser(&pb.User{ID: admin.GetID(), IsAdmin: true}); err != nil {
+		t.Fatal(err)
+	}
+	actions, err := db.GetPendingActions()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(actions) != 1 {
+		t.Fatalf("DeleteCourse() recorded %d pending actions, want 1", len(actions))
+	}
+	if _, err := ags.ConfirmPendingAction(withUserContext(context.Background(), admin), actions[0]); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := db.GetCourse(course.GetID(), false); err == nil {
+		t.Error("DeleteCourse() did not delete the course")
+	}
+	for _, id := range repoIDs {
+		if _, ok := fakeSCM.Repositories[id]; ok {
+			t.Errorf("DeleteCourse() did not delete repository %d", id)
+		}
+	}
+	if enrollments, _ := db.GetEnrollmentsByCourse(course.GetID()); len(enrollments) != 0 {
+		t.Errorf("DeleteCourse() left %d enrollments", len(enrollments))
+	}
+	if groups, _ := db.GetGroupsByCourse(course.GetID()); len(groups) != 0 {
+		t.Errorf("DeleteCourse() left %d groups", len(groups))
+	}
+	if assignments, _ := db.GetAssignmentsByCourse(course.GetID(), false); len(assignments) != 0 {
+		t.Errorf("DeleteCourse() left %d assignments", len(assignments))
+	}
+	if submissions, _ := db.GetSubmissions(&pb.Submission{UserID: students[0].GetID()}); len(submissions) != 0 {
+		t.Errorf("DeleteCourse() left %d submissions", len(submissions))
+	}
+	if repos, _ := db.GetRepositories(&pb.Repository{OrganizationID: course.GetOrganizationID()}); len(repos) != 0 {
+		t.Errorf("DeleteCourse() left %d repositories", len(repos))
+	}
+
+	if _, err := db.GetCourse(otherCourse.GetID(), false); err != nil {
+		t.Errorf("DeleteCourse() deleted another course: %v", err)
+	}
+	if submissions, _ := db.GetSubmissions(&pb.Submission{AssignmentID: otherAssignment.GetID()}); len(submissions) != 1 {
+		t.Errorf("DeleteCourse() deleted another course's submissions: got %d, want 1", len(submissions))
+	}
+}
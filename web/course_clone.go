@@ -0,0 +1,206 @@
+package web
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/ci"
+	"github.com/autograde/quickfeed/scm"
+)
+
+var errCloneProvider = errors.New("course can only be cloned to an organization on the same provider")
+
+// cloneCourse creates a new course from the given previous course. The new course gets the previous
+// course's settings, grading scale, assignments with their grading benchmarks and criteria, and the
+// contents of its assignments and tests repositories. The repositories are copied before the
+// assignments, since the push to the new tests repository updates the assignments as well.
+func (s *AutograderService) cloneCourse(ctx context.Context, sc scm.SCM, previous, request *pb.Course) (*pb.Course, error) {
+	if request.GetProvider() != previous.GetProvider() {
+		return nil, errCloneProvider
+	}
+	request.SlipDays = previous.GetSlipDays()
+	request.Dockerfile = previous.GetDockerfile()
+	request.DropLowest = previous.GetDropLowest()
+	request.AppealWindow = previous.GetAppealWindow()
+	request.AppealResponseDays = previous.GetAppealResponseDays()
+	request.Certificates = previous.GetCertificates()
+	request.RunnerPool = previous.GetRunnerPool()
+	request.AutoApproveDomains = previous.GetAutoApproveDomains()
+	request.AutoApproveRoster = previous.GetAutoApproveRoster()
+	request.MinGroupSize = previous.GetMinGroupSize()
+	request.MaxGroupSize = previous.GetMaxGroupSize()
+	request.AssignmentsTemplate = previous.GetAssignmentsTemplate()
+	request.InfoRepo = previous.GetInfoRepo()
+	request.AssignmentsRepo = previous.GetAssignmentsRepo()
+	request.TestsRepo = previous.GetTestsRepo()
+	request.AssignmentsDir = previous.GetAssignmentsDir()
+	request.Timezone = previous.GetTimezone()
+	request.MinApproved = previous.GetMinApproved()
+	request.TenantID = previous.GetTenantID()
+	course, err := s.createCourse(ctx, sc, request)
+	if err != nil {
+		return nil, err
+	}
+	for _, repo := range []string{course.AssignmentsRepoName(), course.TestsRepoName()} {
+		if err := s.copyRepository(ctx, sc, previous, course, repo); err != nil {
+			return nil, fmt.Errorf("failed to copy %s repository: %w", repo, err)
+		}
+	}
+	if err := s.copyGradingScale(previous, course); err != nil {
+		return nil, err
+	}
+	if err := s.copyAssignments(previous, course); err != nil {
+		return nil, err
+	}
+	return course, nil
+}
+
+// copyRepository copies the contents of the given repository of the previous course to the
+// repository of the same name in the new course, as a single commit on the default branch.
+// The commit history is not copied, to avoid revealing old commits, e.g., of solutions, to the
+// students of the new course. Repositories that are not empty are skipped, to avoid overwriting
+// changes made to the new course's repository. Empty repositories of the previous course are not copied.
+func (s *AutograderService) copyRepository(ctx context.Context, sc scm.SCM, previous, course *pb.Course, repo string) error {
+	if !sc.RepositoryIsEmpty(ctx, &scm.RepositoryOptions{Owner: course.GetOrganizationPath(), Path: repo}) {
+		s.logger.Debugf("cloneCourse: skipping copy of non-empty %s repository of course %s", repo, course.GetCode())
+		return nil
+	}
+	if sc.RepositoryIsEmpty(ctx, &scm.RepositoryOptions{Owner: previous.GetOrganizationPath(), Path: repo}) {
+		s.logger.Debugf("cloneCourse: skipping copy of empty %s repository of course %s", repo, previous.GetCode())
+		return nil
+	}
+	src := sc.CreateCloneURL(&scm.URLPathOptions{Organization: previous.GetOrganizationPath(), Repository: repo})
+	dst := sc.CreateCloneURL(&scm.URLPathOptions{Organization: course.GetOrganizationPath(), Repository: repo})
+	cloneDir, err := ioutil.TempDir("", repo)
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(cloneDir)
+
+	repoDir := filepath.Join(cloneDir, repo)
+	message := fmt.Sprintf("Copy %s from %s", repo, previous.GetOrganizationPath())
+	job := &ci.Job{
+		Commands: []string{
+			"set -e",
+			fmt.Sprintf("git clone --quiet --depth 1 %s %s", src, repoDir),
+			"cd " + repoDir,
+			"branch=$(git symbolic-ref --short HEAD)",
+			"rm -rf .git",
+			"git init --quiet",
+			"git add --all",
+			fmt.Sprintf("git -c user.name=QuickFeed -c user.email=quickfeed@localhost commit --quiet -m %q", message),
+			fmt.Sprintf("git push --quiet %s HEAD:refs/heads/$branch", dst),
+		},
+	}
+	runner := ci.Local{}
+	// the clone URLs contain access tokens, and are therefore not logged
+	if _, err := runner.Run(ctx, job); err != nil {
+		return err
+	}
+	return nil
+}
+
+// copyGradingScale copies the grading scale of the previous course to the new course.
+func (s *AutograderService) copyGradingScale(previous, course *pb.Course) error {
+	thresholds, err := s.db.GetGradingScale(previous.GetID())
+	if err != nil || len(thresholds) == 0 {
+		return err
+	}
+	copies := make([]*pb.GradeThreshold, len(thresholds))
+	for i, t := range thresholds {
+		copies[i] = &pb.GradeThreshold{
+			CourseID: course.GetID(),
+			Grade:    t.GetGrade(),
+			MinScore: t.GetMinScore(),
+			Passing:  t.GetPassing(),
+		}
+	}
+	return s.db.UpdateGradingScale(course.GetID(), copies)
+}
+
+// copyAssignments copies the assignments of the previous course, with their grading benchmarks
+// and criteria, to the new course. The assignments keep their deadlines, which the teachers
+// update for the new semester in the tests repository.
+func (s *AutograderService) copyAssignments(previous, course *pb.Course) error {
+	assignments, err := s.db.GetAssignmentsByCourse(previous.GetID(), true)
+	if err != nil {
+		return err
+	}
+	for _, a := range assignments {
+		assignment := &pb.Assignment{
+			CourseID:          course.GetID(),
+			Name:              a.GetName(),
+			ScriptFile:        a.GetScriptFile(),
+			RunConfig:         a.GetRunConfig(),
+			Deadline:          a.GetDeadline(),
+			AutoApprove:       a.GetAutoApprove(),
+			Order:             a.GetOrder(),
+			IsGroupLab:        a.GetIsGroupLab(),
+			ScoreLimit:        a.GetScoreLimit(),
+			Reviewers:         a.GetReviewers(),
+			ContainerTimeout:  a.GetContainerTimeout(),
+			TestScoreWeight:   a.GetTestScoreWeight(),
+			GradeWeight:       a.GetGradeWeight(),
+			Mandatory:         a.GetMandatory(),
+			RunnerPool:        a.GetRunnerPool(),
+			LateGraceHours:    a.GetLateGraceHours(),
+			LatePenalty:       a.GetLatePenalty(),
+			LateCutoffDays:    a.GetLateCutoffDays(),
+			PeerReviews:       a.GetPeerReviews(),
+			FeedbackIssue:     a.GetFeedbackIssue(),
+			SubmissionBranch:  a.GetSubmissionBranch(),
+			Exam:              a.GetExam(),
+			ExamStart:         a.GetExamStart(),
+			ContainerImage:    a.GetContainerImage(),
+			Dockerfile:        a.GetDockerfile(),
+			CpuLimit:          a.GetCpuLimit(),
+			MemoryLimit:       a.GetMemoryLimit(),
+			PidsLimit:         a.GetPidsLimit(),
+			GradingBenchmarks: copyBenchmarks(a.GetGradingBenchmarks()),
+		}
+		existing, err := s.db.GetAssignment(&pb.Assignment{CourseID: course.GetID(), Order: a.GetOrder()})
+		if err == nil {
+			// the assignment was already created from the new tests repository; only its grading is copied
+			if len(existing.GetGradingBenchmarks()) == 0 {
+				for _, bm := range assignment.GetGradingBenchmarks() {
+					bm.AssignmentID = existing.GetID()
+					if err := s.db.CreateBenchmark(bm); err != nil {
+						return err
+					}
+				}
+			}
+			continue
+		}
+		if err := s.db.CreateAssignment(assignment); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// copyBenchmarks returns copies of the given grading benchmarks and their criteria, without IDs.
+func copyBenchmarks(benchmarks []*pb.GradingBenchmark) []*pb.GradingBenchmark {
+	copies := make([]*pb.GradingBenchmark, 0, len(benchmarks))
+	for _, bm := range benchmarks {
+		criteria := make([]*pb.GradingCriterion, 0, len(bm.GetCriteria()))
+		for _, c := range bm.GetCriteria() {
+			criteria = append(criteria, &pb.GradingCriterion{
+				Points:      c.GetPoints(),
+				Description: c.GetDescription(),
+				Weight:      c.GetWeight(),
+			})
+		}
+		copies = append(copies, &pb.GradingBenchmark{
+			Heading:  bm.GetHeading(),
+			Comment:  bm.GetComment(),
+			Weight:   bm.GetWeight(),
+			Criteria: criteria,
+		})
+	}
+	return copies
+}
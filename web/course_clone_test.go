@@ -0,0 +1,130 @@
+package web_test
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/ci"
+	"github.com/autograde/quickfeed/internal/qtest"
+	"github.com/autograde/quickfeed/scm"
+	"github.com/autograde/quickfeed/web"
+	"github.com/google/go-cmp/cmp"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func TestCloneCourse(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	fakeGothProvider()
+	admin := qtest.CreateFakeUser(t, db, 1)
+	student := qtest.CreateFakeUser(t, db, 2)
+	ctx := withUserContext(context.Background(), admin)
+	fakeProvider, scms := qtest.FakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	client := accessControlled(t, ags)
+	for _, path := range []string{"dat100-2021", "dat100-2022"} {
+		if _, err := fakeProvider.CreateOrganization(ctx, &scm.OrganizationOptions{Path: path, Name: path}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	previous, err := ags.CreateCourse(ctx, &pb.Course{
+		Name:           "Introduction to Programming",
+		Code:           "DAT100",
+		Year:           2021,
+		Tag:            "Fall",
+		Provider:       "fake",
+		OrganizationID: 1,
+		SlipDays:       5,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	previous.DropLowest = 1
+	if err := db.UpdateCourse(previous); err != nil {
+		t.Fatal(err)
+	}
+	scale := []*pb.GradeThreshold{
+		{CourseID: previous.GetID(), Grade: "Pass", MinScore: 50, Passing: true},
+		{CourseID: previous.GetID(), Grade: "Fail", MinScore: 0},
+	}
+	if err := db.UpdateGradingScale(previous.GetID(), scale); err != nil {
+		t.Fatal(err)
+	}
+	labs := []*pb.Assignment{
+		{CourseID: previous.GetID(), Name: "lab1", Order: 1, Deadline: "2021-09-01T23:59:00", ScoreLimit: 80, AutoApprove: true},
+		{CourseID: previous.GetID(), Name: "lab2", Order: 2, Deadline: "2021-10-01T23:59:00", IsGroupLab: true, Reviewers: 1,
+			GradingBenchmarks: []*pb.GradingBenchmark{
+				{Heading: "Code quality", Weight: 2, Criteria: []*pb.GradingCriterion{
+					{Description: "Readable", Points: 5},
+					{Description: "Tested", Points: 10},
+				}},
+			},
+		},
+	}
+	for _, lab := range labs {
+		if err := db.CreateAssignment(lab); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	request := &pb.CloneCourseRequest{
+		CourseID: previous.GetID(),
+		Course: &pb.Course{
+			Name:           "Introduction to Programming",
+			Code:           "DAT100",
+			Year:           2022,
+			Tag:            "Fall",
+			Provider:       "fake",
+			OrganizationID: 2,
+		},
+	}
+	if _, err := client.CloneCourse(withUserContext(context.Background(), student), request); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("CloneCourse() by student: got %v, want %v", err, codes.PermissionDenied)
+	}
+	course, err := ags.CloneCourse(ctx, request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if course.GetID() == previous.GetID() || course.GetYear() != 2022 || course.GetSlipDays() != 5 || course.GetDropLowest() != 1 {
+		t.Errorf("CloneCourse() = %v, want new course with settings of %v", course, previous)
+	}
+
+	gotScale, err := db.GetGradingScale(course.GetID())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(scale, gotScale, protocmp.Transform(), protocmp.IgnoreFields(&pb.GradeThreshold{}, "ID", "courseID")); diff != "" {
+		t.Errorf("GetGradingScale() mismatch (-want +got):\n%s", diff)
+	}
+	got, err := db.GetAssignmentsByCourse(course.GetID(), true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(labs, got, protocmp.Transform(),
+		protocmp.IgnoreFields(&pb.Assignment{}, "ID", "CourseID"),
+		protocmp.IgnoreFields(&pb.GradingBenchmark{}, "ID", "AssignmentID"),
+		protocmp.IgnoreFields(&pb.GradingCriterion{}, "ID", "BenchmarkID"),
+	); diff != "" {
+		t.Errorf("GetAssignmentsByCourse() mismatch (-want +got):\n%s", diff)
+	}
+	// the previous course is unchanged
+	prevLabs, err := db.GetAssignmentsByCourse(previous.GetID(), true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(prevLabs) != 2 || len(prevLabs[1].GetGradingBenchmarks()) != 1 || prevLabs[1].GetGradingBenchmarks()[0].GetID() != labs[1].GetGradingBenchmarks()[0].GetID() {
+		t.Errorf("previous course's assignments changed: %v", prevLabs)
+	}
+
+	request.Course.OrganizationID = 3
+	request.Course.Year = 2023
+	if _, err := ags.CloneCourse(ctx, &pb.CloneCourseRequest{CourseID: 100, Course: request.Course}); status.Code(err) != codes.NotFound {
+		t.Errorf("CloneCourse() of unknown course: got %v, want %v", err, codes.NotFound)
+	}
+}
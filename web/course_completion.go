@@ -0,0 +1,43 @@
+package web
+
+import (
+	pb "github.com/autograde/quickfeed/ag"
+)
+
+// courseCompletion returns the completion status of each student in the given course according
+// to the course's pass requirement, counting the latest submissions of the student and the student's group.
+func (s *AutograderService) courseCompletion(courseID uint64) (*pb.CourseCompletion, error) {
+	course, err := s.db.GetCourse(courseID, false)
+	if err != nil {
+		return nil, err
+	}
+	results, err := s.courseResults(courseID)
+	if err != nil {
+		return nil, err
+	}
+	completion := &pb.CourseCompletion{CourseID: courseID, MinApproved: course.GetMinApproved()}
+	for _, a := range results.GetAssignments() {
+		if a.GetMandatory() {
+			completion.Mandatory = append(completion.Mandatory, a.GetID())
+		}
+	}
+	for _, row := range results.GetRows() {
+		if row.GetEnrollment() == nil {
+			// group rows are only counted for the group's members
+			continue
+		}
+		student := studentCompletion(results.GetAssignments(), row, course.GetMinApproved())
+		student.Enrollment = row.GetEnrollment()
+		completion.Students = append(completion.Students, student)
+	}
+	return completion, nil
+}
+
+// studentCompletion returns the completion status of the student in the given row of the course's results.
+func studentCompletion(assignments []*pb.Assignment, row *pb.ResultsRow, minApproved uint32) *pb.StudentCompletion {
+	submissions := make(map[uint64]*pb.Submission)
+	for i, a := range assignments {
+		submissions[a.GetID()] = row.GetSubmissions()[i]
+	}
+	return pb.ComputeCompletion(assignments, submissions, minApproved)
+}
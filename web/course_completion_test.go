@@ -0,0 +1,91 @@
+package web_test
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/ci"
+	"github.com/autograde/quickfeed/internal/qtest"
+	"github.com/autograde/quickfeed/web"
+	"github.com/google/go-cmp/cmp"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGetCourseCompletion(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	teacher := qtest.CreateFakeUser(t, db, 1)
+	course := &pb.Course{Code: "DAT101", OrganizationID: 1, MinApproved: 2}
+	qtest.CreateCourse(t, db, teacher, course)
+	ann := qtest.CreateFakeUser(t, db, 2)
+	qtest.EnrollStudent(t, db, ann, course)
+	bob := qtest.CreateFakeUser(t, db, 3)
+	qtest.EnrollStudent(t, db, bob, course)
+	carl := qtest.CreateFakeUser(t, db, 4)
+	qtest.EnrollStudent(t, db, carl, course)
+	group := &pb.Group{Name: "team", CourseID: course.GetID(), Users: []*pb.User{ann, bob}}
+	if err := db.CreateGroup(group); err != nil {
+		t.Fatal(err)
+	}
+
+	labs := []*pb.Assignment{
+		{CourseID: course.GetID(), Name: "lab1", Order: 1},
+		{CourseID: course.GetID(), Name: "lab2", Order: 2, IsGroupLab: true},
+		{CourseID: course.GetID(), Name: "lab3", Order: 3, Mandatory: true},
+	}
+	for _, lab := range labs {
+		if err := db.CreateAssignment(lab); err != nil {
+			t.Fatal(err)
+		}
+	}
+	submissions := []*pb.Submission{
+		{AssignmentID: labs[1].GetID(), GroupID: group.GetID(), Score: 90, Status: pb.Submission_APPROVED},
+		{AssignmentID: labs[2].GetID(), UserID: ann.GetID(), Score: 80, Status: pb.Submission_APPROVED},
+		{AssignmentID: labs[0].GetID(), UserID: bob.GetID(), Score: 100, Status: pb.Submission_APPROVED},
+		{AssignmentID: labs[0].GetID(), UserID: carl.GetID(), Score: 100, Status: pb.Submission_APPROVED},
+		{AssignmentID: labs[2].GetID(), UserID: carl.GetID(), Score: 50, Status: pb.Submission_REVISION},
+	}
+	for _, submission := range submissions {
+		if err := db.CreateSubmission(submission); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	_, scms := qtest.FakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	client := accessControlled(t, ags)
+	if _, err := client.GetCourseCompletion(withUserContext(context.Background(), ann), &pb.CourseRequest{CourseID: course.GetID()}); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("GetCourseCompletion() by student = %v, want code %v", err, codes.PermissionDenied)
+	}
+	completion, err := ags.GetCourseCompletion(withUserContext(context.Background(), teacher), &pb.CourseRequest{CourseID: course.GetID()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if completion.GetMinApproved() != 2 || len(completion.GetMandatory()) != 1 || completion.GetMandatory()[0] != labs[2].GetID() {
+		t.Errorf("GetCourseCompletion() requirement = (%d, %v), want (2, [%d])", completion.GetMinApproved(), completion.GetMandatory(), labs[2].GetID())
+	}
+
+	type result struct {
+		UserID           uint64
+		Approved         uint32
+		MissingMandatory []uint64
+		Completed        bool
+	}
+	var got []result
+	for _, student := range completion.GetStudents() {
+		got = append(got, result{student.GetEnrollment().GetUserID(), student.GetApproved(), student.GetMissingMandatory(), student.GetCompleted()})
+	}
+	want := []result{
+		// the group's approved submission counts for both members
+		{ann.GetID(), 2, nil, true},
+		{bob.GetID(), 2, []uint64{labs[2].GetID()}, false},
+		{carl.GetID(), 1, []uint64{labs[2].GetID()}, false},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("GetCourseCompletion() mismatch (-want +got):\n%s", diff)
+	}
+}
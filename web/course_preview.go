@@ -0,0 +1,214 @@
+package web
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/scm"
+	"github.com/autograde/quickfeed/web/auth"
+)
+
+// previewCourseCreation returns the operations on the SCM provider that createCourse would
+// perform for the given course, without performing them or changing the database. Like
+// createCourse, the creation of a partially created course is resumed at its pending step,
+// and resources that already exist are reused; such operations are marked as skipped.
+// The plan must be kept in sync with the course setup steps in setupCourse.
+func (s *AutograderService) previewCourseCreation(ctx context.Context, sc scm.SCM, request *pb.Course) (*pb.CourseCreationPlan, error) {
+	org, err := sc.GetOrganization(ctx, &scm.GetOrgOptions{ID: request.GetOrganizationID()})
+	if err != nil {
+		return nil, err
+	}
+	plan := &pb.CourseCreationPlan{}
+	if org.GetPaymentPlan() == FreeOrgPlan {
+		plan.Problem = ErrFreePlan.Error()
+		return plan, nil
+	}
+	course, start := request, pb.Course_ORGANIZATION
+	if existing, err := s.db.GetCourseByOrganizationID(org.GetID()); err == nil && existing.GetPendingSetup() != pb.Course_COMPLETE {
+		course, start = existing, existing.GetPendingSetup()
+		plan.Resume = true
+	} else {
+		repos, err := sc.GetRepositories(ctx, org)
+		if err != nil {
+			return nil, err
+		}
+		if isDirty(course, repos) {
+			plan.Problem = ErrAlreadyExists.Error()
+			return plan, nil
+		}
+	}
+	courseCreator, err := s.db.GetUser(course.GetCourseCreatorID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get course creator record from database: %w", err)
+	}
+
+	for _, step := range courseSetupSteps {
+		if step < start {
+			continue
+		}
+		var ops []*pb.SCMOperation
+		switch step {
+		case pb.Course_ORGANIZATION:
+			ops, err = s.planOrganization(ctx, sc, org, course)
+		case pb.Course_REPOSITORIES:
+			ops, err = s.planCourseRepositories(ctx, sc, org, course)
+		case pb.Course_TEAMS:
+			ops, err = planCourseTeams(ctx, sc, org, course, courseCreator.GetLoginFor(course.GetProvider()))
+		case pb.Course_CREATOR_REPOSITORY:
+			ops, err = s.planCreatorRepository(ctx, sc, org, courseCreator.GetID(), courseCreator.GetLoginFor(course.GetProvider()))
+		}
+		if err != nil {
+			return nil, err
+		}
+		for _, op := range ops {
+			op.Step = step
+		}
+		plan.Operations = append(plan.Operations, ops...)
+	}
+	return plan, nil
+}
+
+// planOrganization returns the operations performed by setupOrganization.
+func (s *AutograderService) planOrganization(ctx context.Context, sc scm.SCM, org *pb.Organization, course *pb.Course) ([]*pb.SCMOperation, error) {
+	eventsURL := auth.GetEventsURL(s.bh.BaseURL, course.GetProvider())
+	hooks, err := sc.ListHooks(ctx, nil, org.GetPath())
+	if err != nil {
+		return nil, err
+	}
+	hookExists := false
+	for _, hook := range hooks {
+		if hook.URL == eventsURL {
+			hookExists = true
+		}
+	}
+	return []*pb.SCMOperation{
+		{
+			Kind:        pb.SCMOperation_UPDATE_ORGANIZATION,
+			Resource:    org.GetPath(),
+			Description: "set the default repository permission to none",
+		},
+		{
+			Kind:        pb.SCMOperation_CREATE_HOOK,
+			Resource:    eventsURL,
+			Description: "create the organization's push hook",
+			Skipped:     hookExists,
+		},
+	}, nil
+}
+
+// planCourseRepositories returns the operations performed by setupCourseRepositories.
+func (s *AutograderService) planCourseRepositories(ctx context.Context, sc scm.SCM, org *pb.Organization, course *pb.Course) ([]*pb.SCMOperation, error) {
+	repos, err := sc.GetRepositories(ctx, org)
+	if err != nil {
+		return nil, err
+	}
+	existing := make(map[string]bool)
+	for _, repo := range repos {
+		existing[repo.Path] = true
+	}
+	repoPaths := RepoPaths(course)
+	paths := make([]string, 0, len(repoPaths))
+	for path := range repoPaths {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	var ops []*pb.SCMOperation
+	for _, path := range paths {
+		dbRepos, err := s.db.GetRepositories(&pb.Repository{OrganizationID: org.GetID(), RepoType: course.RepoType(path)})
+		if err != nil {
+			return nil, err
+		}
+		visibility := "public"
+		if repoPaths[path] == private {
+			visibility = "private"
+		}
+		ops = append(ops, &pb.SCMOperation{
+			Kind:        pb.SCMOperation_CREATE_REPOSITORY,
+			Resource:    path,
+			Description: fmt.Sprintf("create the %s %s repository", visibility, path),
+			Skipped:     len(dbRepos) > 0 || existing[path],
+		})
+	}
+	return ops, nil
+}
+
+// planCourseTeams returns the operations performed by setupCourseTeams.
+func planCourseTeams(ctx context.Context, sc scm.SCM, org *pb.Organization, course *pb.Course, creatorLogin string) ([]*pb.SCMOperation, error) {
+	teams, err := sc.GetTeams(ctx, org)
+	if err != nil {
+		return nil, err
+	}
+	existing := make(map[string]bool)
+	for _, team := range teams {
+		existing[team.Name] = true
+	}
+	ops := []*pb.SCMOperation{
+		{
+			Kind:        pb.SCMOperation_CREATE_TEAM,
+			Resource:    scm.TeachersTeam,
+			Description: fmt.Sprintf("create the %s team with %s", scm.TeachersTeam, creatorLogin),
+			Skipped:     existing[scm.TeachersTeam],
+		},
+		{
+			Kind:        pb.SCMOperation_CREATE_TEAM,
+			Resource:    scm.StudentsTeam,
+			Description: fmt.Sprintf("create the %s team without members", scm.StudentsTeam),
+			Skipped:     existing[scm.StudentsTeam],
+		},
+	}
+	for _, repo := range courseRepos(course) {
+		ops = append(ops, &pb.SCMOperation{
+			Kind:        pb.SCMOperation_ADD_TEAM_REPOSITORY,
+			Resource:    repo,
+			Description: fmt.Sprintf("grant the %s team read access to the %s repository", scm.StudentsTeam, repo),
+		})
+	}
+	return ops, nil
+}
+
+// planCreatorRepository returns the operations performed by setupCreatorRepository.
+func (s *AutograderService) planCreatorRepository(ctx context.Context, sc scm.SCM, org *pb.Organization, creatorID uint64, creatorLogin string) ([]*pb.SCMOperation, error) {
+	dbRepos, err := s.db.GetRepositories(&pb.Repository{
+		OrganizationID: org.GetID(),
+		UserID:         creatorID,
+		RepoType:       pb.Repository_USER,
+	})
+	if err != nil {
+		return nil, err
+	}
+	path := pb.StudentRepoName(creatorLogin)
+	if len(dbRepos) > 0 {
+		return []*pb.SCMOperation{{
+			Kind:        pb.SCMOperation_CREATE_REPOSITORY,
+			Resource:    path,
+			Description: fmt.Sprintf("create the private %s repository of the course creator", path),
+			Skipped:     true,
+		}}, nil
+	}
+	repos, err := sc.GetRepositories(ctx, org)
+	if err != nil {
+		return nil, err
+	}
+	exists := false
+	for _, repo := range repos {
+		if repo.Path == path {
+			exists = true
+		}
+	}
+	return []*pb.SCMOperation{
+		{
+			Kind:        pb.SCMOperation_CREATE_REPOSITORY,
+			Resource:    path,
+			Description: fmt.Sprintf("create the private %s repository of the course creator", path),
+			Skipped:     exists,
+		},
+		{
+			Kind:        pb.SCMOperation_UPDATE_REPOSITORY_ACCESS,
+			Resource:    path,
+			Description: fmt.Sprintf("grant %s write access to the %s repository", creatorLogin, path),
+		},
+	}, nil
+}
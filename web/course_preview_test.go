@@ -0,0 +1,91 @@
+package web_test
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/ci"
+	"github.com/autograde/quickfeed/internal/qtest"
+	"github.com/autograde/quickfeed/scm"
+	"github.com/autograde/quickfeed/web"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestPreviewCourseCreation(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	fakeGothProvider()
+	admin := qtest.CreateFakeUser(t, db, 1)
+	ctx := withUserContext(context.Background(), admin)
+	fakeProvider, scms := qtest.FakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	client := accessControlled(t, ags)
+	org, err := fakeProvider.CreateOrganization(ctx, &scm.OrganizationOptions{Path: "path", Name: "name"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	course := &pb.Course{
+		Name:           "Distributed Systems",
+		Code:           "DAT520",
+		Year:           2018,
+		Provider:       "fake",
+		OrganizationID: 1,
+	}
+
+	plan, err := ags.PreviewCourseCreation(ctx, course)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if plan.GetProblem() != "" || plan.GetResume() {
+		t.Errorf("PreviewCourseCreation() = (problem: %q, resume: %t), want (problem: \"\", resume: false)", plan.GetProblem(), plan.GetResume())
+	}
+	steps := make(map[pb.Course_SetupStep]int)
+	for _, op := range plan.GetOperations() {
+		steps[op.GetStep()]++
+		if op.GetSkipped() {
+			t.Errorf("operation %v on %s is skipped for a new organization", op.GetKind(), op.GetResource())
+		}
+	}
+	for _, step := range []pb.Course_SetupStep{pb.Course_ORGANIZATION, pb.Course_REPOSITORIES, pb.Course_TEAMS, pb.Course_CREATOR_REPOSITORY} {
+		if steps[step] == 0 {
+			t.Errorf("plan has no operations for setup step %v", step)
+		}
+	}
+
+	// the preview must not perform any of the operations
+	repos, err := fakeProvider.GetRepositories(ctx, org)
+	if err != nil {
+		t.Fatal(err)
+	}
+	teams, err := fakeProvider.GetTeams(ctx, org)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(repos) != 0 || len(teams) != 0 {
+		t.Errorf("preview created %d repositories and %d teams, want none", len(repos), len(teams))
+	}
+	if _, err := db.GetCourseByOrganizationID(org.GetID()); err == nil {
+		t.Error("preview created the course in the database")
+	}
+
+	if _, err := ags.CreateCourse(ctx, course); err != nil {
+		t.Fatal(err)
+	}
+	plan, err = ags.PreviewCourseCreation(ctx, &pb.Course{Name: "Another", Code: "DAT521", Year: 2018, Provider: "fake", OrganizationID: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if plan.GetProblem() != web.ErrAlreadyExists.Error() {
+		t.Errorf("PreviewCourseCreation() problem = %q, want %q", plan.GetProblem(), web.ErrAlreadyExists.Error())
+	}
+
+	user := qtest.CreateFakeUser(t, db, 2)
+	_, err = client.PreviewCourseCreation(withUserContext(context.Background(), user), course)
+	if status.Code(err) != codes.PermissionDenied {
+		t.Errorf("PreviewCourseCreation() by non-admin: %v, want %v", err, codes.PermissionDenied)
+	}
+}
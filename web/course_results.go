@@ -0,0 +1,85 @@
+package web
+
+import (
+	"sort"
+
+	pb "github.com/autograde/quickfeed/ag"
+)
+
+// resultKey identifies the submissions of a student or group to an assignment.
+type resultKey struct {
+	assignmentID uint64
+	ownerID      uint64
+}
+
+// courseResults returns the results matrix of the given course, with a row per student followed
+// by a row per group, holding the latest submission of the student or group to each assignment.
+// The latest submissions are fetched in a single query, and graded according to the course's grading scale.
+func (s *AutograderService) courseResults(courseID uint64) (*pb.CourseResults, error) {
+	assignments, err := s.db.GetAssignmentsByCourse(courseID, false)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(assignments, func(i, j int) bool {
+		return assignments[i].GetOrder() < assignments[j].GetOrder()
+	})
+	enrollments, err := s.db.GetEnrollmentsByCourse(courseID, pb.Enrollment_STUDENT)
+	if err != nil {
+		return nil, err
+	}
+	groups, err := s.db.GetGroupsByCourse(courseID)
+	if err != nil {
+		return nil, err
+	}
+	submissions, err := s.db.GetLatestSubmissionsByCourse(courseID)
+	if err != nil {
+		return nil, err
+	}
+	scale, err := s.getGradingScale(courseID)
+	if err != nil {
+		return nil, err
+	}
+	scale.SetGrades(submissions...)
+
+	userSubmissions := make(map[resultKey]*pb.Submission)
+	groupSubmissions := make(map[resultKey]*pb.Submission)
+	for _, submission := range submissions {
+		if submission.GetGroupID() > 0 {
+			groupSubmissions[resultKey{submission.GetAssignmentID(), submission.GetGroupID()}] = submission
+		} else {
+			userSubmissions[resultKey{submission.GetAssignmentID(), submission.GetUserID()}] = submission
+		}
+	}
+	// row returns the submissions of the student or group, or an empty submission if there are none.
+	row := func(userID, groupID uint64) []*pb.Submission {
+		row := make([]*pb.Submission, len(assignments))
+		for i, a := range assignments {
+			var submission *pb.Submission
+			if a.GetIsGroupLab() {
+				submission = groupSubmissions[resultKey{a.GetID(), groupID}]
+			} else {
+				submission = userSubmissions[resultKey{a.GetID(), userID}]
+			}
+			if submission == nil {
+				submission = &pb.Submission{}
+			}
+			row[i] = submission
+		}
+		return row
+	}
+
+	results := &pb.CourseResults{CourseID: courseID, Assignments: assignments}
+	for _, enrollment := range enrollments {
+		results.Rows = append(results.Rows, &pb.ResultsRow{
+			Enrollment:  enrollment,
+			Submissions: row(enrollment.GetUserID(), enrollment.GetGroupID()),
+		})
+	}
+	for _, group := range groups {
+		results.Rows = append(results.Rows, &pb.ResultsRow{
+			Group:       group,
+			Submissions: row(0, group.GetID()),
+		})
+	}
+	return results, nil
+}
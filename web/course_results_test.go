@@ -0,0 +1,174 @@
+package web_test
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/ci"
+	"github.com/autograde/quickfeed/internal/qtest"
+	"github.com/autograde/quickfeed/web"
+	"github.com/google/go-cmp/cmp"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGetCourseResults(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	teacher := qtest.CreateFakeUser(t, db, 1)
+	course := &pb.Course{Code: "DAT101", Year: 2021, OrganizationID: 1}
+	qtest.CreateCourse(t, db, teacher, course)
+	student1 := qtest.CreateFakeUser(t, db, 2)
+	qtest.EnrollStudent(t, db, student1, course)
+	student2 := qtest.CreateFakeUser(t, db, 3)
+	qtest.EnrollStudent(t, db, student2, course)
+	group := &pb.Group{Name: "team", CourseID: course.GetID(), Users: []*pb.User{student1, student2}}
+	if err := db.CreateGroup(group); err != nil {
+		t.Fatal(err)
+	}
+
+	// created out of order to check that the matrix follows the assignment order
+	labs := []*pb.Assignment{
+		{CourseID: course.GetID(), Name: "lab2", Order: 2, IsGroupLab: true},
+		{CourseID: course.GetID(), Name: "lab1", Order: 1},
+	}
+	for _, lab := range labs {
+		if err := db.CreateAssignment(lab); err != nil {
+			t.Fatal(err)
+		}
+	}
+	submissions := []*pb.Submission{
+		{AssignmentID: labs[1].GetID(), UserID: student1.GetID(), Score: 40},
+		{AssignmentID: labs[1].GetID(), UserID: student1.GetID(), Score: 90},
+		{AssignmentID: labs[1].GetID(), UserID: student2.GetID(), Score: 50},
+		{AssignmentID: labs[0].GetID(), GroupID: group.GetID(), Score: 60},
+		{AssignmentID: labs[0].GetID(), GroupID: group.GetID(), Score: 70},
+	}
+	for _, submission := range submissions {
+		if err := db.CreateSubmission(submission); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	_, scms := qtest.FakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	client := accessControlled(t, ags)
+	request := &pb.CourseRequest{CourseID: course.GetID()}
+
+	if _, err := client.GetCourseResults(withUserContext(context.Background(), student1), request); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("GetCourseResults() by student: got %v, want %v", err, codes.PermissionDenied)
+	}
+	results, err := ags.GetCourseResults(withUserContext(context.Background(), teacher), request)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var names []string
+	for _, a := range results.GetAssignments() {
+		names = append(names, a.GetName())
+	}
+	if diff := cmp.Diff([]string{"lab1", "lab2"}, names); diff != "" {
+		t.Errorf("GetCourseResults() assignments mismatch (-want +got):\n%s", diff)
+	}
+
+	type row struct {
+		UserID, GroupID uint64
+		Scores          []uint32
+		Grades          []string
+	}
+	var got []row
+	for _, r := range results.GetRows() {
+		resultsRow := row{UserID: r.GetEnrollment().GetUserID(), GroupID: r.GetGroup().GetID()}
+		for _, submission := range r.GetSubmissions() {
+			resultsRow.Scores = append(resultsRow.Scores, submission.GetScore())
+			resultsRow.Grades = append(resultsRow.Grades, submission.GetGrade())
+		}
+		got = append(got, resultsRow)
+	}
+	want := []row{
+		{UserID: student1.GetID(), Scores: []uint32{90, 70}, Grades: []string{"A", "C"}},
+		{UserID: student2.GetID(), Scores: []uint32{50, 70}, Grades: []string{"D", "C"}},
+		{GroupID: group.GetID(), Scores: []uint32{0, 70}, Grades: []string{"", "C"}},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("GetCourseResults() rows mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestGetCourseStatistics(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	teacher := qtest.CreateFakeUser(t, db, 1)
+	course := &pb.Course{Code: "DAT101", Year: 2021, OrganizationID: 1}
+	qtest.CreateCourse(t, db, teacher, course)
+	student := qtest.CreateFakeUser(t, db, 2)
+	qtest.EnrollStudent(t, db, student, course)
+	lab := &pb.Assignment{CourseID: course.GetID(), Name: "lab1", Order: 1}
+	if err := db.CreateAssignment(lab); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.CreateSubmission(&pb.Submission{AssignmentID: lab.GetID(), UserID: student.GetID(), Score: 80}); err != nil {
+		t.Fatal(err)
+	}
+
+	_, scms := qtest.FakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	client := accessControlled(t, ags)
+	request := &pb.CourseRequest{CourseID: course.GetID()}
+
+	if _, err := client.GetCourseStatistics(withUserContext(context.Background(), student), request); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("GetCourseStatistics() by student: got %v, want %v", err, codes.PermissionDenied)
+	}
+	statistics, err := ags.GetCourseStatistics(withUserContext(context.Background(), teacher), request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(statistics.GetAssignments()) != 1 || statistics.GetAssignments()[0].GetSubmissions() != 1 || statistics.GetAssignments()[0].GetAverageScore() != 80 {
+		t.Errorf("GetCourseStatistics() = %v, want one assignment with one submission scoring 80", statistics)
+	}
+}
+
+func TestGetTestFailureStats(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	teacher := qtest.CreateFakeUser(t, db, 1)
+	course := &pb.Course{Code: "DAT101", Year: 2021, OrganizationID: 1}
+	qtest.CreateCourse(t, db, teacher, course)
+	student := qtest.CreateFakeUser(t, db, 2)
+	qtest.EnrollStudent(t, db, student, course)
+	lab := &pb.Assignment{CourseID: course.GetID(), Name: "lab1", Order: 1}
+	if err := db.CreateAssignment(lab); err != nil {
+		t.Fatal(err)
+	}
+	submission := &pb.Submission{AssignmentID: lab.GetID(), UserID: student.GetID(), Score: 50}
+	if err := db.CreateSubmission(submission); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.UpdateTestResults(submission.GetID(), []*pb.TestResult{
+		{Name: "TestAdd", Status: pb.TestResult_PASSED},
+		{Name: "TestSub", Status: pb.TestResult_FAILED},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	_, scms := qtest.FakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	client := accessControlled(t, ags)
+	request := &pb.AssignmentRequest{CourseID: course.GetID(), AssignmentID: lab.GetID()}
+
+	if _, err := client.GetTestFailureStats(withUserContext(context.Background(), student), request); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("GetTestFailureStats() by student: got %v, want %v", err, codes.PermissionDenied)
+	}
+	stats, err := ags.GetTestFailureStats(withUserContext(context.Background(), teacher), request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(stats.GetTests()) != 2 || stats.GetTests()[0].GetName() != "TestSub" || stats.GetTests()[0].GetFailureRate() != 100 {
+		t.Errorf("GetTestFailureStats() = %v, want TestSub failing first", stats)
+	}
+}
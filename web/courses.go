@@ -7,16 +7,22 @@ import (
 	"time"
 
 	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/database"
 	"github.com/autograde/quickfeed/scm"
 )
 
 // getCourses returns all courses.
 func (s *AutograderService) getCourses() (*pb.Courses, error) {
+	if courses, ok := s.cache.get("courses"); ok {
+		return courses.(*pb.Courses), nil
+	}
 	courses, err := s.db.GetCourses()
 	if err != nil {
 		return nil, err
 	}
-	return &pb.Courses{Courses: courses}, nil
+	result := &pb.Courses{Courses: courses}
+	s.cache.set("courses", result, coursesTables)
+	return result, nil
 }
 
 // getCoursesByUser returns all courses that match the provided enrollment status.
@@ -42,16 +48,44 @@ func (s *AutograderService) getEnrollmentsByUser(request *pb.EnrollmentStatusReq
 }
 
 // getEnrollmentsByCourse returns all enrollments for a course that match the given enrollment request.
-func (s *AutograderService) getEnrollmentsByCourse(request *pb.EnrollmentRequest) (*pb.Enrollments, error) {
-	enrollments, err := s.db.GetEnrollmentsByCourse(request.CourseID, request.Statuses...)
+func (s *AutograderService) getEnrollmentsByCourse(request *pb.EnrollmentRequest, page *database.Page) (*pb.Enrollments, error) {
+	// the activity depends on the course's submissions, which change too often to be cached
+	if request.WithActivity {
+		return s.loadEnrollmentsByCourse(request, page)
+	}
+	key := "enrollments/" + request.String()
+	if enrollments, ok := s.cache.get(key); ok {
+		return enrollments.(*pb.Enrollments), nil
+	}
+	enrollments, err := s.loadEnrollmentsByCourse(request, page)
 	if err != nil {
 		return nil, err
 	}
-	if request.WithActivity {
+	s.cache.set(key, enrollments, enrollmentsTables)
+	return enrollments, nil
+}
+
+// loadEnrollmentsByCourse reads the enrollments matching the given enrollment request from the database.
+func (s *AutograderService) loadEnrollmentsByCourse(request *pb.EnrollmentRequest, page *database.Page) (*pb.Enrollments, error) {
+	var enrollments []*pb.Enrollment
+	var err error
+	switch {
+	case request.WithActivity:
+		// the activity is computed from all the course's submissions; the page is selected afterwards
 		enrollments, err = s.getEnrollmentsWithActivity(request.CourseID)
 		if err != nil {
 			return nil, err
 		}
+		if page != nil {
+			enrollments = pageEnrollments(enrollments, page)
+		}
+	case page != nil:
+		enrollments, err = s.db.GetEnrollmentsByCoursePage(request.CourseID, page, request.Statuses...)
+	default:
+		enrollments, err = s.db.GetEnrollmentsByCourse(request.CourseID, request.Statuses...)
+	}
+	if err != nil {
+		return nil, err
 	}
 
 	// to populate response only with users who are not member of any group, we must filter the result
@@ -68,11 +102,23 @@ func (s *AutograderService) getEnrollmentsByCourse(request *pb.EnrollmentRequest
 	for _, enrollment := range enrollments {
 		enrollment.SetSlipDays(enrollment.Course)
 	}
-	return &pb.Enrollments{Enrollments: enrollments}, nil
+	return &pb.Enrollments{Enrollments: enrollments, NextPageToken: nextPageToken(page)}, nil
 }
 
 // createEnrollment creates a pending enrollment for the given user and course.
+// A user that does not belong to a tenant joins the tenant of the course, if any.
 func (s *AutograderService) createEnrollment(request *pb.Enrollment) error {
+	user, err := s.db.GetUser(request.GetUserID())
+	if err != nil {
+		return err
+	}
+	course, err := s.getCourse(request.GetCourseID())
+	if err != nil {
+		return err
+	}
+	if !canSeeCourse(user, course) {
+		return errOtherTenant
+	}
 	enrollment := pb.Enrollment{
 		UserID:   request.GetUserID(),
 		CourseID: request.GetCourseID(),
@@ -87,8 +133,8 @@ func (s *AutograderService) updateEnrollment(ctx context.Context, sc scm.SCM, cu
 	if err != nil {
 		return err
 	}
-	// log changes to teacher status
-	if enrollment.Status == pb.Enrollment_TEACHER || request.Status == pb.Enrollment_TEACHER {
+	// log changes to teacher and teaching assistant status
+	if enrollment.IsTeachingStaff() || request.Status == pb.Enrollment_TEACHER || request.Status == pb.Enrollment_TA {
 		s.logger.Debugf("User %s attempting to change enrollment status of user %d from %s to %s", curUser, enrollment.UserID, enrollment.Status, request.Status)
 	}
 
@@ -97,10 +143,19 @@ func (s *AutograderService) updateEnrollment(ctx context.Context, sc scm.SCM, cu
 		return s.rejectEnrollment(ctx, sc, enrollment)
 
 	case pb.Enrollment_STUDENT:
-		return s.enrollStudent(ctx, sc, enrollment)
+		if err := s.enrollStudent(ctx, sc, enrollment); err != nil {
+			return err
+		}
+		if enrollment.GetStatus() == pb.Enrollment_PENDING {
+			s.publishEnrollmentAccepted(enrollment)
+		}
+		return nil
 
 	case pb.Enrollment_TEACHER:
 		return s.enrollTeacher(ctx, sc, enrollment)
+
+	case pb.Enrollment_TA:
+		return s.enrollTeachingAssistant(ctx, sc, enrollment)
 	}
 	return fmt.Errorf("unknown enrollment")
 }
@@ -126,17 +181,45 @@ func (s *AutograderService) getCourse(courseID uint64) (*pb.Course, error) {
 }
 
 // getSubmissions returns all the latests submissions for a user of the given course.
-func (s *AutograderService) getSubmissions(request *pb.SubmissionRequest) (*pb.Submissions, error) {
+// If hideReviews is set, only the released reviews of the submissions are returned.
+func (s *AutograderService) getSubmissions(request *pb.SubmissionRequest, page *database.Page, hideReviews bool) (*pb.Submissions, error) {
 	// only one of user ID and group ID will be set; enforced by IsValid on pb.SubmissionRequest
 	query := &pb.Submission{
 		UserID:  request.GetUserID(),
 		GroupID: request.GetGroupID(),
 	}
-	submissions, err := s.db.GetLastSubmissions(request.GetCourseID(), query)
+	var submissions []*pb.Submission
+	var err error
+	if page != nil {
+		submissions, err = s.db.GetLastSubmissionsPage(request.GetCourseID(), query, page)
+	} else {
+		submissions, err = s.db.GetLastSubmissions(request.GetCourseID(), query)
+	}
 	if err != nil {
 		return nil, err
 	}
-	return &pb.Submissions{Submissions: submissions}, nil
+	scale, err := s.getGradingScale(request.GetCourseID())
+	if err != nil {
+		return nil, err
+	}
+	if hideReviews {
+		hideUnreleasedReviews(submissions)
+	}
+	scale.SetGrades(submissions...)
+	return &pb.Submissions{Submissions: submissions, NextPageToken: nextPageToken(page)}, nil
+}
+
+// getGradingScale returns the grading scale of the given course,
+// or the default grading scale if the course has no grading scale.
+func (s *AutograderService) getGradingScale(courseID uint64) (*pb.GradingScale, error) {
+	thresholds, err := s.db.GetGradingScale(courseID)
+	if err != nil {
+		return nil, err
+	}
+	if len(thresholds) == 0 {
+		thresholds = pb.DefaultGradingScale()
+	}
+	return &pb.GradingScale{CourseID: courseID, Thresholds: thresholds}, nil
 }
 
 // getAllCourseSubmissions returns all individual lab submissions by students enrolled in the specified course.
@@ -152,6 +235,13 @@ func (s *AutograderService) getAllCourseSubmissions(request *pb.SubmissionsForCo
 	}
 
 	course.SetSlipDays()
+	scale, err := s.getGradingScale(request.GetCourseID())
+	if err != nil {
+		return nil, err
+	}
+	for _, a := range assignments {
+		scale.SetGrades(a.Submissions...)
+	}
 
 	enrolLinks := make([]*pb.EnrollmentLink, 0)
 
@@ -233,7 +323,7 @@ func (s *AutograderService) makeGroupResults(course *pb.Course, assignments []*p
 }
 
 // updateSubmission updates submission status or sets a submission score based on a manual review.
-func (s *AutograderService) updateSubmission(courseID, submissionID uint64, status pb.Submission_Status, released bool, score uint32) error {
+func (s *AutograderService) updateSubmission(ctx context.Context, courseID, submissionID uint64, status pb.Submission_Status, released bool, score uint32) error {
 	submission, err := s.db.GetSubmission(&pb.Submission{ID: submissionID})
 	if err != nil {
 		return err
@@ -246,12 +336,41 @@ func (s *AutograderService) updateSubmission(courseID, submissionID uint64, stat
 			return err
 		}
 	}
+	newlyReleased := released && !submission.Released
+	if newlyReleased {
+		submission.ReleasedDate = time.Now().Format(pb.TimeLayout)
+	}
 	submission.Status = status
 	submission.Released = released
 	if score > 0 {
-		submission.Score = score
+		if err := s.setReviewScore(submission, score); err != nil {
+			return err
+		}
+	}
+	if err := s.db.UpdateSubmission(submission); err != nil {
+		return err
+	}
+	if err := s.publishFeedbackIssue(ctx, courseID, submission); err != nil {
+		s.logger.Errorf("Failed to publish feedback issue for submission %d: %v", submission.GetID(), err)
+	}
+	if newlyReleased {
+		if err := s.notifySubmissionReleased(ctx, courseID, submission); err != nil {
+			s.logger.Errorf("Failed to notify authors of submission %d about released review: %v", submission.GetID(), err)
+		}
+		if err := s.publishReviewPublished(courseID, submission); err != nil {
+			s.logger.Errorf("Failed to publish released review of submission %d: %v", submission.GetID(), err)
+		}
+	}
+	return nil
+}
+
+// getForcePushes returns all force pushes to student and group repositories in the given course.
+func (s *AutograderService) getForcePushes(courseID uint64) (*pb.ForcePushes, error) {
+	forcePushes, err := s.db.GetForcePushes(courseID)
+	if err != nil {
+		return nil, err
 	}
-	return s.db.UpdateSubmission(submission)
+	return &pb.ForcePushes{ForcePushes: forcePushes}, nil
 }
 
 // updateSubmissions updates status and release state of multiple submissions for the
@@ -291,10 +410,12 @@ func (s *AutograderService) getReviewers(submissionID uint64) ([]*pb.User, error
 	return names, nil
 }
 
-// updateCourse updates an existing course.
+// updateCourse updates the settings of an existing course from the request.
+// Only the settings that teachers may edit are taken from the request; the rest of the
+// course, such as its provider, repositories and Dockerfile, is kept as stored.
 func (s *AutograderService) updateCourse(ctx context.Context, sc scm.SCM, request *pb.Course) error {
 	// ensure the course exists
-	_, err := s.db.GetCourse(request.ID, false)
+	course, err := s.db.GetCourse(request.ID, false)
 	if err != nil {
 		return err
 	}
@@ -303,8 +424,29 @@ func (s *AutograderService) updateCourse(ctx context.Context, sc scm.SCM, reques
 	if err != nil {
 		return err
 	}
-	request.OrganizationPath = org.GetPath()
-	return s.db.UpdateCourse(request)
+	course.OrganizationID = request.GetOrganizationID()
+	course.OrganizationPath = org.GetPath()
+	course.Name = request.GetName()
+	course.Code = request.GetCode()
+	course.Year = request.GetYear()
+	course.Tag = request.GetTag()
+	course.SlipDays = request.GetSlipDays()
+	course.DropLowest = request.GetDropLowest()
+	course.FinalGradesReleased = request.GetFinalGradesReleased()
+	course.AppealWindow = request.GetAppealWindow()
+	course.AppealResponseDays = request.GetAppealResponseDays()
+	course.Certificates = request.GetCertificates()
+	course.RunnerPool = request.GetRunnerPool()
+	course.AssistantPermission = request.GetAssistantPermission()
+	course.AutoApproveDomains = request.GetAutoApproveDomains()
+	course.AutoApproveRoster = request.GetAutoApproveRoster()
+	course.MinGroupSize = request.GetMinGroupSize()
+	course.MaxGroupSize = request.GetMaxGroupSize()
+	course.AssignmentsTemplate = request.GetAssignmentsTemplate()
+	course.AssignmentsDir = request.GetAssignmentsDir()
+	course.Timezone = request.GetTimezone()
+	course.MinApproved = request.GetMinApproved()
+	return s.db.UpdateCourse(course)
 }
 
 func (s *AutograderService) changeCourseVisibility(enrollment *pb.Enrollment) error {
@@ -374,10 +516,15 @@ func (s *AutograderService) rejectEnrollment(ctx context.Context, sc scm.SCM, en
 	if err != nil {
 		return err
 	}
+	login, err := s.courseLogin(course, user)
+	if err != nil {
+		// the repositories were created with the user's login if the user has no identity for the provider
+		login = user.GetLogin()
+	}
 	for _, repo := range repos {
 		// we do not care about errors here, even if the github repo does not exists,
 		// log the error and go on with deleting database entries
-		if err := removeUserFromCourse(ctx, sc, user.GetLogin(), repo); err != nil {
+		if err := removeUserFromCourse(ctx, sc, login, repo); err != nil {
 			s.logger.Debug("updateEnrollment: rejectUserFromCourse failed (expected behavior): ", err)
 		}
 
@@ -388,6 +535,20 @@ func (s *AutograderService) rejectEnrollment(ctx context.Context, sc scm.SCM, en
 	return s.db.RejectEnrollment(user.ID, course.ID)
 }
 
+// courseLogin returns the given user's login on the provider of the given course.
+// An error is returned if the user has no remote identity for the course's provider.
+func (s *AutograderService) courseLogin(course *pb.Course, user *pb.User) (string, error) {
+	// enrollments are preloaded without the user's remote identities
+	user, err := s.db.GetUser(user.GetID())
+	if err != nil {
+		return "", err
+	}
+	if user.GetRemoteIDFor(course.GetProvider()) == nil {
+		return "", fmt.Errorf("user %s has no %s identity", user.GetLogin(), course.GetProvider())
+	}
+	return user.GetLoginFor(course.GetProvider()), nil
+}
+
 // enrollStudent enrolls the given user as a student into the given course.
 func (s *AutograderService) enrollStudent(ctx context.Context, sc scm.SCM, enrolled *pb.Enrollment) error {
 	// course and user are both preloaded, no need to query the database
@@ -409,9 +570,13 @@ func (s *AutograderService) enrollStudent(ctx context.Context, sc scm.SCM, enrol
 	if err != nil {
 		return err
 	}
+	login, err := s.courseLogin(course, user)
+	if err != nil {
+		return err
+	}
 
-	if enrolled.Status == pb.Enrollment_TEACHER {
-		err = revokeTeacherStatus(ctx, sc, course.GetOrganizationPath(), user.GetLogin())
+	if enrolled.IsTeachingStaff() {
+		err = revokeTeacherStatus(ctx, sc, course.GetOrganizationPath(), login)
 		if err != nil {
 			s.logger.Errorf("Failed to revoke teacher status for user %s and course %s: %v", user.Login, course.Name, err)
 		}
@@ -423,7 +588,7 @@ func (s *AutograderService) enrollStudent(ctx context.Context, sc scm.SCM, enrol
 			return s.db.UpdateEnrollment(userEnrolQuery)
 		}
 		// create user repo, user team, and add user to students team
-		repo, err := updateReposAndTeams(ctx, sc, course, user.GetLogin(), pb.Enrollment_STUDENT)
+		repo, err := updateReposAndTeams(ctx, sc, course, login, pb.Enrollment_STUDENT)
 		if err != nil {
 			s.logger.Errorf("Failed to update repos or team membership for student %s: %v", user.Login, err)
 			return err
@@ -451,9 +616,19 @@ func (s *AutograderService) enrollStudent(ctx context.Context, sc scm.SCM, enrol
 func (s *AutograderService) enrollTeacher(ctx context.Context, sc scm.SCM, enrolled *pb.Enrollment) error {
 	// course and user are both preloaded, no need to query the database
 	course, user := enrolled.GetCourse(), enrolled.GetUser()
+	login, err := s.courseLogin(course, user)
+	if err != nil {
+		return err
+	}
 
-	// make owner, remove from students, add to teachers
-	if _, err := updateReposAndTeams(ctx, sc, course, user.GetLogin(), pb.Enrollment_TEACHER); err != nil {
+	if enrolled.IsTA() {
+		// teaching assistants are already members of the teachers team; make owner and team maintainer
+		if err := promoteTeachingAssistant(ctx, sc, course.GetOrganizationPath(), login); err != nil {
+			s.logger.Errorf("Failed to promote teaching assistant %s to teacher: %v", user.Login, err)
+			return err
+		}
+	} else if _, err := updateReposAndTeams(ctx, sc, course, login, pb.Enrollment_TEACHER); err != nil {
+		// make owner, remove from students, add to teachers
 		s.logger.Errorf("Failed to update team membership for teacher %s: %v", user.Login, err)
 		return err
 	}
@@ -464,6 +639,38 @@ func (s *AutograderService) enrollTeacher(ctx context.Context, sc scm.SCM, enrol
 	})
 }
 
+// enrollTeachingAssistant makes the given user a teaching assistant of the given course.
+// Teaching assistants get access to the course's repositories through the teachers team,
+// but unlike teachers, they are not organization owners.
+func (s *AutograderService) enrollTeachingAssistant(ctx context.Context, sc scm.SCM, enrolled *pb.Enrollment) error {
+	// course and user are both preloaded, no need to query the database
+	course, user := enrolled.GetCourse(), enrolled.GetUser()
+	login, err := s.courseLogin(course, user)
+	if err != nil {
+		return err
+	}
+
+	if enrolled.IsTeacher() {
+		// teachers are already members of the teachers team; only the organization ownership is revoked
+		if err := sc.UpdateOrgMembership(ctx, &scm.OrgMembershipOptions{
+			Organization: course.GetOrganizationPath(),
+			Username:     login,
+			Role:         scm.OrgMember,
+		}); err != nil {
+			s.logger.Errorf("Failed to revoke organization ownership for teaching assistant %s: %v", user.Login, err)
+			return err
+		}
+	} else if _, err := updateReposAndTeams(ctx, sc, course, login, pb.Enrollment_TA); err != nil {
+		s.logger.Errorf("Failed to update team membership for teaching assistant %s: %v", user.Login, err)
+		return err
+	}
+	return s.db.UpdateEnrollment(&pb.Enrollment{
+		UserID:   user.ID,
+		CourseID: course.ID,
+		Status:   pb.Enrollment_TA,
+	})
+}
+
 // returns all enrollments for the course ID with last activity date and number of approved assignments
 func (s *AutograderService) getEnrollmentsWithActivity(courseID uint64) ([]*pb.Enrollment, error) {
 	allEnrollmentsWithSubmissions, err := s.getAllCourseSubmissions(
@@ -539,3 +746,50 @@ func sortSubmissionsByAssignmentOrder(unsorted []*pb.SubmissionLink) []*pb.Submi
 	})
 	return unsorted
 }
+
+// repairCourseRepoAccess restores the students' read access to the course's info and assignments
+// repositories. The "students" team is recreated if missing, its members are synchronized with
+// the course's student enrollments, and the team is given read access to the repositories.
+func (s *AutograderService) repairCourseRepoAccess(ctx context.Context, sc scm.SCM, course *pb.Course) error {
+	org := &pb.Organization{ID: course.GetOrganizationID(), Path: course.GetOrganizationPath()}
+	enrollments, err := s.db.GetEnrollmentsByCourse(course.GetID(), pb.Enrollment_STUDENT)
+	if err != nil {
+		return err
+	}
+	var students []string
+	for _, enrollment := range enrollments {
+		students = append(students, enrollment.GetUser().GetLogin())
+	}
+
+	teams, err := sc.GetTeams(ctx, org)
+	if err != nil {
+		return err
+	}
+	var studentsTeam *scm.Team
+	for _, team := range teams {
+		if team.Name == scm.StudentsTeam {
+			studentsTeam = team
+			break
+		}
+	}
+	if studentsTeam == nil {
+		s.logger.Warnf("Students team missing for course %s; recreating it", course.GetCode())
+		studentsTeam, err = sc.CreateTeam(ctx, &scm.NewTeamOptions{
+			Organization: org.GetPath(),
+			TeamName:     scm.StudentsTeam,
+			Users:        students,
+		})
+		if err != nil {
+			return err
+		}
+	} else {
+		if err := sc.UpdateTeamMembers(ctx, &scm.UpdateTeamOptions{
+			TeamID:         studentsTeam.ID,
+			OrganizationID: org.GetID(),
+			Users:          students,
+		}); err != nil {
+			return err
+		}
+	}
+	return grantStudentsTeamAccess(ctx, sc, org, course, studentsTeam.ID)
+}
@@ -15,17 +15,21 @@ const (
 	public  = !private
 )
 
-// RepoPaths maps from Autograder repository path names to a boolean indicating
-// whether or not the repository should be create as public or private.
-var RepoPaths = map[string]bool{
-	pb.InfoRepo:       public,
-	pb.AssignmentRepo: private,
-	pb.TestsRepo:      private,
+// RepoPaths maps from the path names of the course's repositories to a boolean
+// indicating whether or not the repository should be create as public or private.
+func RepoPaths(course *pb.Course) map[string]bool {
+	return map[string]bool{
+		course.InfoRepoName():        public,
+		course.AssignmentsRepoName(): private,
+		course.TestsRepoName():       private,
+	}
 }
 
 // createCourse creates a new course for the directory specified in the request
 // and creates the repositories for the course. Requires that the directory
-// does not contain the Autograder repositories that will be created.
+// does not contain the Autograder repositories that will be created, unless
+// the directory belongs to a partially created course, in which case the
+// course creation is resumed.
 func (s *AutograderService) createCourse(ctx context.Context, sc scm.SCM, request *pb.Course) (*pb.Course, error) {
 	org, err := sc.GetOrganization(ctx, &scm.GetOrgOptions{ID: request.OrganizationID})
 	if err != nil {
@@ -34,13 +38,100 @@ func (s *AutograderService) createCourse(ctx context.Context, sc scm.SCM, reques
 	if org.GetPaymentPlan() == FreeOrgPlan {
 		return nil, ErrFreePlan
 	}
+	if course, err := s.db.GetCourseByOrganizationID(org.GetID()); err == nil && course.GetPendingSetup() != pb.Course_COMPLETE {
+		s.logger.Debugf("createCourse: resuming creation of course %s at step %s", course.GetCode(), course.GetPendingSetup())
+		if err := s.setupCourse(ctx, sc, org, course); err != nil {
+			return nil, err
+		}
+		return course, nil
+	}
 	repos, err := sc.GetRepositories(ctx, org)
 	if err != nil {
 		return nil, err
 	}
-	if isDirty(repos) {
+	if isDirty(request, repos) {
 		return nil, ErrAlreadyExists
 	}
+
+	// the course record is created first to record the progress of the remaining steps
+	request.OrganizationPath = org.GetPath()
+	request.PendingSetup = pb.Course_ORGANIZATION
+	if err := s.db.CreateCourse(request.GetCourseCreatorID(), request); err != nil {
+		s.logger.Debugf("createCourse: failed to create database record for course %s: %s", request.Name, err)
+		return nil, err
+	}
+	if err := s.setupCourse(ctx, sc, org, request); err != nil {
+		return nil, err
+	}
+	return request, nil
+}
+
+// repairCourse finishes the creation of a partially created course.
+// Repairing a course that is completely created has no effect.
+func (s *AutograderService) repairCourse(ctx context.Context, sc scm.SCM, courseID uint64) (*pb.Course, error) {
+	course, err := s.db.GetCourse(courseID, false)
+	if err != nil {
+		return nil, err
+	}
+	if course.GetPendingSetup() == pb.Course_COMPLETE {
+		return course, nil
+	}
+	org, err := sc.GetOrganization(ctx, &scm.GetOrgOptions{ID: course.GetOrganizationID()})
+	if err != nil {
+		return nil, err
+	}
+	if err := s.setupCourse(ctx, sc, org, course); err != nil {
+		return nil, err
+	}
+	return course, nil
+}
+
+// courseSetupSteps are the course creation steps in the order they are performed.
+var courseSetupSteps = []pb.Course_SetupStep{
+	pb.Course_ORGANIZATION,
+	pb.Course_REPOSITORIES,
+	pb.Course_TEAMS,
+	pb.Course_CREATOR_REPOSITORY,
+}
+
+// setupCourse performs the course creation steps, starting with the course's pending step.
+// Each step skips the resources that already exist, and the next step to perform is
+// recorded after each step, so that a failed course creation can be resumed.
+func (s *AutograderService) setupCourse(ctx context.Context, sc scm.SCM, org *pb.Organization, course *pb.Course) error {
+	for i, step := range courseSetupSteps {
+		if course.GetPendingSetup() == pb.Course_COMPLETE || step < course.GetPendingSetup() {
+			continue
+		}
+		var err error
+		switch step {
+		case pb.Course_ORGANIZATION:
+			s.setupOrganization(ctx, sc, org, course)
+		case pb.Course_REPOSITORIES:
+			err = s.setupCourseRepositories(ctx, sc, org, course)
+		case pb.Course_TEAMS:
+			err = s.setupCourseTeams(ctx, sc, org, course)
+		case pb.Course_CREATOR_REPOSITORY:
+			err = s.setupCreatorRepository(ctx, sc, org, course)
+		}
+		if err != nil {
+			s.logger.Debugf("createCourse: course %s stopped at step %s: %s", course.GetCode(), step, err)
+			return err
+		}
+		next := pb.Course_COMPLETE
+		if i+1 < len(courseSetupSteps) {
+			next = courseSetupSteps[i+1]
+		}
+		if err := s.db.UpdateCourseSetup(course.GetID(), next); err != nil {
+			return err
+		}
+		course.PendingSetup = next
+	}
+	return nil
+}
+
+// setupOrganization sets the default repository permissions of the organization and
+// creates the organization's push hook. Failures are logged, but do not stop the course creation.
+func (s *AutograderService) setupOrganization(ctx context.Context, sc scm.SCM, org *pb.Organization, course *pb.Course) {
 	// set default repository access level for all students to "none"
 	// will not affect organization owners (teachers)
 	orgOptions := &scm.OrganizationOptions{
@@ -48,100 +139,159 @@ func (s *AutograderService) createCourse(ctx context.Context, sc scm.SCM, reques
 		DefaultPermission: scm.OrgNone,
 		RepoPermissions:   false,
 	}
-	if err = sc.UpdateOrganization(ctx, orgOptions); err != nil {
+	if err := sc.UpdateOrganization(ctx, orgOptions); err != nil {
 		s.logger.Debugf("createCourse: failed to update permissions for GitHub organization %s: %s", orgOptions.Path, err)
 	}
 
-	// create a push hook on organization level
+	// create a push hook on organization level, unless it already exists
+	eventsURL := auth.GetEventsURL(s.bh.BaseURL, course.GetProvider())
+	hooks, err := sc.ListHooks(ctx, nil, org.GetPath())
+	if err != nil {
+		s.logger.Debugf("createCourse: failed to list organization hooks for %s: %s", org.GetPath(), err)
+	}
+	for _, hook := range hooks {
+		if hook.URL == eventsURL {
+			return
+		}
+	}
 	hookOptions := &scm.CreateHookOptions{
-		URL:          auth.GetEventsURL(s.bh.BaseURL, request.Provider),
+		URL:          eventsURL,
 		Secret:       s.bh.Secret,
 		Organization: org.Path,
 	}
-
-	err = sc.CreateHook(ctx, hookOptions)
-	if err != nil {
+	if err := sc.CreateHook(ctx, hookOptions); err != nil {
 		s.logger.Debugf("createCourse: failed to create organization hook for %s: %s", org.GetPath(), err)
 	}
+}
 
-	// create course repos and webhooks for each repo
-	for path, private := range RepoPaths {
-		repoOptions := &scm.CreateRepositoryOptions{
-			Path:         path,
-			Organization: org,
-			Private:      private,
-		}
-		repo, err := sc.CreateRepository(ctx, repoOptions)
+// setupCourseRepositories creates the course repositories and their database records,
+// reusing the repositories that already exist.
+func (s *AutograderService) setupCourseRepositories(ctx context.Context, sc scm.SCM, org *pb.Organization, course *pb.Course) error {
+	repos, err := sc.GetRepositories(ctx, org)
+	if err != nil {
+		return err
+	}
+	existing := make(map[string]*scm.Repository)
+	for _, repo := range repos {
+		existing[repo.Path] = repo
+	}
+	for path, private := range RepoPaths(course) {
+		dbRepos, err := s.db.GetRepositories(&pb.Repository{OrganizationID: org.GetID(), RepoType: course.RepoType(path)})
 		if err != nil {
-			return nil, err
+			return err
+		}
+		if len(dbRepos) > 0 {
+			continue
+		}
+		repo, ok := existing[path]
+		if !ok {
+			repoOptions := &scm.CreateRepositoryOptions{
+				Path:         path,
+				Organization: org,
+				Private:      private,
+			}
+			if repo, err = sc.CreateRepository(ctx, repoOptions); err != nil {
+				return err
+			}
 		}
-
 		dbRepo := pb.Repository{
 			OrganizationID: org.ID,
 			RepositoryID:   repo.ID,
 			HTMLURL:        repo.WebURL,
-			RepoType:       pb.RepoType(path),
+			RepoType:       course.RepoType(path),
 		}
 		if err := s.db.CreateRepository(&dbRepo); err != nil {
 			s.logger.Debugf("createCourse: failed to create database record for repository %s: %s", path, err)
-			return nil, err
+			return err
 		}
 	}
+	return nil
+}
 
-	// add course creator to teacher team
-	courseCreator, err := s.db.GetUser(request.GetCourseCreatorID())
+// setupCourseTeams creates the teachers team with the course creator and the students team
+// without any members, reusing the teams that already exist. The students team is given
+// read access to the course's info and assignments repositories.
+func (s *AutograderService) setupCourseTeams(ctx context.Context, sc scm.SCM, org *pb.Organization, course *pb.Course) error {
+	courseCreator, err := s.db.GetUser(course.GetCourseCreatorID())
 	if err != nil {
-		return nil, fmt.Errorf("createCourse: failed to get course creator record from database: %w", err)
+		return fmt.Errorf("createCourse: failed to get course creator record from database: %w", err)
 	}
-	// create teacher team with course creator
-	opt := &scm.NewTeamOptions{
-		Organization: org.Path,
-		TeamName:     scm.TeachersTeam,
-		Users:        []string{courseCreator.GetLogin()},
+	teams, err := sc.GetTeams(ctx, org)
+	if err != nil {
+		return err
 	}
-	if _, err = sc.CreateTeam(ctx, opt); err != nil {
-		s.logger.Debugf("createCourse: failed to create teachers team: %s", err)
-		return nil, err
+	existing := make(map[string]*scm.Team)
+	for _, team := range teams {
+		existing[team.Name] = team
 	}
-	// create student team without any members
-	studOpt := &scm.NewTeamOptions{Organization: org.Path, TeamName: scm.StudentsTeam}
-	if _, err = sc.CreateTeam(ctx, studOpt); err != nil {
-		s.logger.Debugf("createCourse: failed to create students team: %s", err)
-		return nil, err
+
+	if _, ok := existing[scm.TeachersTeam]; !ok {
+		opt := &scm.NewTeamOptions{
+			Organization: org.Path,
+			TeamName:     scm.TeachersTeam,
+			Users:        []string{courseCreator.GetLoginFor(course.GetProvider())},
+		}
+		if _, err = sc.CreateTeam(ctx, opt); err != nil {
+			s.logger.Debugf("createCourse: failed to create teachers team: %s", err)
+			return err
+		}
+	}
+	studentsTeam, ok := existing[scm.StudentsTeam]
+	if !ok {
+		studOpt := &scm.NewTeamOptions{Organization: org.Path, TeamName: scm.StudentsTeam}
+		if studentsTeam, err = sc.CreateTeam(ctx, studOpt); err != nil {
+			s.logger.Debugf("createCourse: failed to create students team: %s", err)
+			return err
+		}
+	}
+	// give students read access to the course's info and assignments repositories
+	if err = grantStudentsTeamAccess(ctx, sc, org, course, studentsTeam.ID); err != nil {
+		s.logger.Debugf("createCourse: failed to grant students team access to course repositories: %s", err)
+		return err
 	}
+	return nil
+}
 
-	// add student repo for the course creator
-	scmRepo, err := createStudentRepo(ctx, sc, org, pb.StudentRepoName(courseCreator.GetLogin()), courseCreator.GetLogin())
+// setupCreatorRepository creates the course creator's student repository and its database record,
+// unless the record already exists.
+func (s *AutograderService) setupCreatorRepository(ctx context.Context, sc scm.SCM, org *pb.Organization, course *pb.Course) error {
+	courseCreator, err := s.db.GetUser(course.GetCourseCreatorID())
 	if err != nil {
-		return nil, err
+		return fmt.Errorf("createCourse: failed to get course creator record from database: %w", err)
 	}
 	repoQuery := &pb.Repository{
 		OrganizationID: org.GetID(),
-		RepositoryID:   scmRepo.ID,
-		UserID:         courseCreator.ID,
-		HTMLURL:        scmRepo.WebURL,
+		UserID:         courseCreator.GetID(),
 		RepoType:       pb.Repository_USER,
 	}
-	if err := s.db.CreateRepository(repoQuery); err != nil {
-		return nil, err
+	dbRepos, err := s.db.GetRepositories(repoQuery)
+	if err != nil {
+		return err
 	}
-
-	request.OrganizationPath = org.GetPath()
-	if err := s.db.CreateCourse(request.GetCourseCreatorID(), request); err != nil {
-		s.logger.Debugf("createCourse: failed to create database record for course %s: %s", request.Name, err)
-		return nil, err
+	if len(dbRepos) > 0 {
+		return nil
 	}
-	return request, nil
+	// createStudentRepo reuses the repository if it already exists;
+	// the repository is not created from the template, since the new assignments repository is empty
+	login := courseCreator.GetLoginFor(course.GetProvider())
+	scmRepo, err := createStudentRepo(ctx, sc, org, pb.StudentRepoName(login), login, nil)
+	if err != nil {
+		return err
+	}
+	repoQuery.RepositoryID = scmRepo.ID
+	repoQuery.HTMLURL = scmRepo.WebURL
+	return s.db.CreateRepository(repoQuery)
 }
 
 // isDirty returns true if the list of provided repositories contains
-// any of the repositories that Autograder wants to create.
-func isDirty(repos []*scm.Repository) bool {
+// any of the repositories that Autograder wants to create for the course.
+func isDirty(course *pb.Course, repos []*scm.Repository) bool {
 	if len(repos) == 0 {
 		return false
 	}
+	paths := RepoPaths(course)
 	for _, repo := range repos {
-		if _, exists := RepoPaths[repo.Path]; exists {
+		if _, exists := paths[repo.Path]; exists {
 			return true
 		}
 	}
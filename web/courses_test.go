@@ -2,9 +2,11 @@ package web_test
 
 import (
 	"context"
+	"path"
 	"reflect"
 	"strconv"
 	"testing"
+	"time"
 
 	pb "github.com/autograde/quickfeed/ag"
 	"github.com/autograde/quickfeed/internal/qtest"
@@ -78,7 +80,7 @@ func TestGetCourses(t *testing.T) {
 		testCourses = append(testCourses, course)
 	}
 
-	foundCourses, err := ags.GetCourses(context.Background(), &pb.Void{})
+	foundCourses, err := ags.GetCourses(withUserContext(context.Background(), admin), &pb.Void{})
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -92,10 +94,12 @@ func TestGetCourses(t *testing.T) {
 
 // withUserContext is a test helper function to create metadata for the
 // given user mimicking the context coming from the browser.
+// withUserContext returns a context with the given user as the current user, both when
+// calling the service's methods directly and through the client of accessControlled.
 func withUserContext(ctx context.Context, user *pb.User) context.Context {
 	userID := strconv.Itoa(int(user.GetID()))
 	meta := metadata.New(map[string]string{"user": userID})
-	return metadata.NewIncomingContext(ctx, meta)
+	return metadata.NewIncomingContext(metadata.NewOutgoingContext(ctx, meta), meta)
 }
 
 func fakeGothProvider() {
@@ -156,7 +160,7 @@ func TestNewCourseExistingRepos(t *testing.T) {
 	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
 
 	directory, _ := fakeProvider.CreateOrganization(ctx, &scm.OrganizationOptions{Path: "path", Name: "name"})
-	for path, private := range web.RepoPaths {
+	for path, private := range web.RepoPaths(allCourses[0]) {
 		repoOptions := &scm.CreateRepositoryOptions{Path: path, Organization: directory, Private: private}
 		_, err := fakeProvider.CreateRepository(ctx, repoOptions)
 		if err != nil {
@@ -173,6 +177,245 @@ func TestNewCourseExistingRepos(t *testing.T) {
 	}
 }
 
+func TestNewCourseCustomRepoNames(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	fakeGothProvider()
+	admin := qtest.CreateFakeUser(t, db, 10)
+	ctx := withUserContext(context.Background(), admin)
+	fakeProvider, scms := qtest.FakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	org, err := fakeProvider.CreateOrganization(ctx, &scm.OrganizationOptions{Path: "path", Name: "name"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	course, err := ags.CreateCourse(ctx, &pb.Course{
+		Name:            "Distributed Systems",
+		CourseCreatorID: admin.GetID(),
+		Code:            "DAT520",
+		Year:            2018,
+		Tag:             "Spring",
+		Provider:        "fake",
+		OrganizationID:  org.GetID(),
+		InfoRepo:        "dat520-info",
+		AssignmentsRepo: "labs",
+		TestsRepo:       "labs-tests",
+		AssignmentsDir:  "assignments",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	repos, err := fakeProvider.GetRepositories(ctx, org)
+	if err != nil {
+		t.Fatal(err)
+	}
+	gotRepos := make(map[string]bool)
+	for _, repo := range repos {
+		gotRepos[repo.Path] = true
+	}
+	wantRepos := map[string]bool{"dat520-info": true, "labs": true, "labs-tests": true, pb.StudentRepoName(admin.GetLogin()): true}
+	if diff := cmp.Diff(wantRepos, gotRepos); diff != "" {
+		t.Errorf("repositories after CreateCourse mismatch (-want +got):\n%s", diff)
+	}
+	for repoType, name := range map[pb.Repository_Type]string{
+		pb.Repository_COURSEINFO:  "dat520-info",
+		pb.Repository_ASSIGNMENTS: "labs",
+		pb.Repository_TESTS:       "labs-tests",
+	} {
+		dbRepos, err := db.GetRepositories(&pb.Repository{OrganizationID: org.GetID(), RepoType: repoType})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(dbRepos) != 1 || path.Base(dbRepos[0].GetHTMLURL()) != name {
+			t.Errorf("%s repositories = %v, want %s", repoType, dbRepos, name)
+		}
+	}
+
+	// the repositories keep their names, and the Dockerfile is only fetched from the tests repository,
+	// while the assignments folder can be changed
+	if err := db.UpdateCourseDockerfile(course.GetID(), "FROM golang"); err != nil {
+		t.Fatal(err)
+	}
+	course.TestsRepo = "tests"
+	course.AssignmentsDir = ""
+	course.Dockerfile = ""
+	if _, err := ags.UpdateCourse(ctx, course); err != nil {
+		t.Fatal(err)
+	}
+	updated, err := db.GetCourse(course.GetID(), false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if updated.GetTestsRepo() != "labs-tests" || updated.GetAssignmentsDir() != "" || updated.GetDockerfile() != "FROM golang" {
+		t.Errorf("UpdateCourse() = tests repository %q, assignments folder %q and Dockerfile %q, want %q, %q and %q",
+			updated.GetTestsRepo(), updated.GetAssignmentsDir(), updated.GetDockerfile(), "labs-tests", "", "FROM golang")
+	}
+}
+
+func TestStudentsTeamCourseRepoAccess(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	fakeGothProvider()
+	admin := qtest.CreateFakeUser(t, db, 10)
+	ctx := withUserContext(context.Background(), admin)
+	fakeProvider, scms := qtest.FakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	org, err := fakeProvider.CreateOrganization(ctx, &scm.OrganizationOptions{Path: "path", Name: "name"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ags.CreateCourse(ctx, allCourses[0]); err != nil {
+		t.Fatal(err)
+	}
+
+	fake := fakeProvider.(*scm.FakeSCM)
+	studentsTeamRepos := func() map[string]string {
+		teams, err := fake.GetTeams(ctx, org)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, team := range teams {
+			if team.Name == scm.StudentsTeam {
+				return fake.TeamRepos[team.ID]
+			}
+		}
+		return nil
+	}
+	wantRepos := map[string]string{pb.InfoRepo: scm.RepoPull, pb.AssignmentRepo: scm.RepoPull}
+	if diff := cmp.Diff(wantRepos, studentsTeamRepos()); diff != "" {
+		t.Errorf("students team repositories after CreateCourse mismatch (-want +got):\n%s", diff)
+	}
+
+	// remove the students team; the periodic check should recreate it with access to the course repositories
+	for _, team := range fake.Teams {
+		if team.Name == scm.StudentsTeam {
+			if err := fake.DeleteTeam(ctx, &scm.TeamOptions{TeamID: team.ID}); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	if repos := studentsTeamRepos(); repos != nil {
+		t.Fatalf("students team repositories after DeleteTeam: got %v, want none", repos)
+	}
+	canceledCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	ags.RunCourseChecks(canceledCtx, time.Hour)
+	if diff := cmp.Diff(wantRepos, studentsTeamRepos()); diff != "" {
+		t.Errorf("students team repositories after repair mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestRepairCourse(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	fakeGothProvider()
+	admin := qtest.CreateFakeUser(t, db, 10)
+	ctx := withUserContext(context.Background(), admin)
+	fakeProvider, scms := qtest.FakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	client := accessControlled(t, ags)
+	org, err := fakeProvider.CreateOrganization(ctx, &scm.OrganizationOptions{Path: "path", Name: "name"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	fake := fakeProvider.(*scm.FakeSCM)
+
+	// a course whose creation failed after creating the info repository on the SCM provider
+	course := &pb.Course{Code: "DAT520", Provider: "fake", OrganizationID: org.GetID(), OrganizationPath: org.GetPath(), PendingSetup: pb.Course_REPOSITORIES}
+	qtest.CreateCourse(t, db, admin, course)
+	if _, err := fake.CreateRepository(ctx, &scm.CreateRepositoryOptions{Path: pb.InfoRepo, Organization: org}); err != nil {
+		t.Fatal(err)
+	}
+
+	check := func(call string) {
+		t.Helper()
+		gotCourse, err := db.GetCourse(course.GetID(), false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if gotCourse.GetPendingSetup() != pb.Course_COMPLETE {
+			t.Errorf("%s: pending setup = %s, want %s", call, gotCourse.GetPendingSetup(), pb.Course_COMPLETE)
+		}
+		// the three course repositories and the course creator's repository
+		dbRepos, err := db.GetRepositories(&pb.Repository{OrganizationID: org.GetID()})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(fake.Repositories) != 4 || len(dbRepos) != 4 {
+			t.Errorf("%s: %d repositories and %d repository records, want 4", call, len(fake.Repositories), len(dbRepos))
+		}
+		if len(fake.Teams) != 2 {
+			t.Errorf("%s: %d teams, want 2", call, len(fake.Teams))
+		}
+	}
+
+	repaired, err := ags.RepairCourse(ctx, &pb.CourseRequest{CourseID: course.GetID()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if repaired.GetPendingSetup() != pb.Course_COMPLETE {
+		t.Errorf("RepairCourse() pending setup = %s, want %s", repaired.GetPendingSetup(), pb.Course_COMPLETE)
+	}
+	check("RepairCourse()")
+
+	// repairing a completely created course has no effect
+	if _, err := ags.RepairCourse(ctx, &pb.CourseRequest{CourseID: course.GetID()}); err != nil {
+		t.Fatal(err)
+	}
+	check("second RepairCourse()")
+
+	// creating the course again fails, since the course is completely created
+	if _, err := ags.CreateCourse(ctx, &pb.Course{Code: "DAT520", Provider: "fake", OrganizationID: org.GetID()}); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("CreateCourse() for existing course: got %v, want %v", err, codes.FailedPrecondition)
+	}
+
+	student := qtest.CreateFakeUser(t, db, 11)
+	qtest.EnrollStudent(t, db, student, course)
+	if _, err := client.RepairCourse(withUserContext(context.Background(), student), &pb.CourseRequest{CourseID: course.GetID()}); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("RepairCourse() by student: got %v, want %v", err, codes.PermissionDenied)
+	}
+}
+
+func TestCreateCourseResumesPartialCourse(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	fakeGothProvider()
+	admin := qtest.CreateFakeUser(t, db, 10)
+	ctx := withUserContext(context.Background(), admin)
+	fakeProvider, scms := qtest.FakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	org, err := fakeProvider.CreateOrganization(ctx, &scm.OrganizationOptions{Path: "path", Name: "name"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// a course whose creation failed after creating all the course repositories
+	partial := &pb.Course{Code: "DAT520", Provider: "fake", OrganizationID: org.GetID(), OrganizationPath: org.GetPath(), PendingSetup: pb.Course_ORGANIZATION}
+	qtest.CreateCourse(t, db, admin, partial)
+	for path, private := range web.RepoPaths(partial) {
+		if _, err := fakeProvider.CreateRepository(ctx, &scm.CreateRepositoryOptions{Path: path, Organization: org, Private: private}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	course, err := ags.CreateCourse(ctx, &pb.Course{Code: "DAT520", Provider: "fake", OrganizationID: org.GetID()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if course.GetID() != partial.GetID() || course.GetPendingSetup() != pb.Course_COMPLETE {
+		t.Errorf("CreateCourse() = course %d with pending setup %s, want course %d with pending setup %s",
+			course.GetID(), course.GetPendingSetup(), partial.GetID(), pb.Course_COMPLETE)
+	}
+	if repos := fakeProvider.(*scm.FakeSCM).Repositories; len(repos) != 4 {
+		t.Errorf("CreateCourse() created %d repositories, want 4 including the existing course repositories", len(repos))
+	}
+}
+
 func TestEnrollmentProcess(t *testing.T) {
 	db, cleanup := qtest.TestDB(t)
 	defer cleanup()
@@ -425,7 +668,7 @@ func TestGetCourse(t *testing.T) {
 	_, scms := qtest.FakeProviderMap(t)
 	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
 
-	foundCourse, err := ags.GetCourse(context.Background(), &pb.CourseRequest{CourseID: course.ID})
+	foundCourse, err := ags.GetCourse(withUserContext(context.Background(), admin), &pb.CourseRequest{CourseID: course.ID})
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -629,3 +872,80 @@ func TestPromoteDemoteRejectTeacher(t *testing.T) {
 		t.Error("expected error 'ta cannot be demoted course creator'")
 	}
 }
+
+func TestGradingScale(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	teacher := qtest.CreateFakeUser(t, db, 1)
+	course := &pb.Course{}
+	qtest.CreateCourse(t, db, teacher, course)
+	student := qtest.CreateFakeUser(t, db, 2)
+	qtest.EnrollStudent(t, db, student, course)
+
+	assignment := &pb.Assignment{CourseID: course.ID, Name: "lab1", Order: 1}
+	if err := db.CreateAssignment(assignment); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.CreateSubmission(&pb.Submission{AssignmentID: assignment.ID, UserID: student.ID, Score: 75}); err != nil {
+		t.Fatal(err)
+	}
+
+	_, scms := qtest.FakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	client := accessControlled(t, ags)
+	teacherCtx := withUserContext(context.Background(), teacher)
+	studentCtx := withUserContext(context.Background(), student)
+
+	scale, err := ags.GetGradingScale(studentCtx, &pb.CourseRequest{CourseID: course.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(pb.DefaultGradingScale(), scale.GetThresholds(), protocmp.Transform()); diff != "" {
+		t.Errorf("GetGradingScale() mismatch (-want +got):\n%s", diff)
+	}
+	submissions, err := ags.GetSubmissions(studentCtx, &pb.SubmissionRequest{CourseID: course.ID, UserID: student.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := submissions.GetSubmissions()[0].GetGrade(); got != "C" {
+		t.Errorf("GetSubmissions() grade = %q, want %q", got, "C")
+	}
+
+	passFail := &pb.GradingScale{
+		CourseID: course.ID,
+		Thresholds: []*pb.GradeThreshold{
+			{Grade: "Fail", MinScore: 0},
+			{Grade: "Pass", MinScore: 70, Passing: true},
+		},
+	}
+	if _, err := client.UpdateGradingScale(studentCtx, passFail); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("UpdateGradingScale() by student: got %v, want %v", err, codes.PermissionDenied)
+	}
+	if _, err := ags.UpdateGradingScale(teacherCtx, passFail); err != nil {
+		t.Fatal(err)
+	}
+	scale, err = ags.GetGradingScale(studentCtx, &pb.CourseRequest{CourseID: course.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantScale := []*pb.GradeThreshold{
+		{CourseID: course.ID, Grade: "Pass", MinScore: 70, Passing: true},
+		{CourseID: course.ID, Grade: "Fail", MinScore: 0},
+	}
+	if diff := cmp.Diff(wantScale, scale.GetThresholds(), protocmp.Transform(), protocmp.IgnoreFields(&pb.GradeThreshold{}, "ID")); diff != "" {
+		t.Errorf("GetGradingScale() mismatch (-want +got):\n%s", diff)
+	}
+	submissions, err = ags.GetSubmissions(studentCtx, &pb.SubmissionRequest{CourseID: course.ID, UserID: student.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := submissions.GetSubmissions()[0].GetGrade(); got != "Pass" {
+		t.Errorf("GetSubmissions() grade = %q, want %q", got, "Pass")
+	}
+
+	invalid := &pb.GradingScale{CourseID: course.ID, Thresholds: []*pb.GradeThreshold{{Grade: "Pass", MinScore: 70}}}
+	if invalid.IsValid() {
+		t.Error("expected grading scale without a grade for zero score to be invalid")
+	}
+}
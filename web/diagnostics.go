@@ -0,0 +1,104 @@
+package web
+
+import (
+	"net/http"
+	"net/http/pprof"
+	"runtime"
+	"runtime/debug"
+	rpprof "runtime/pprof"
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/web/auth"
+	"github.com/labstack/echo/v4"
+)
+
+// started is the time when the server was started.
+var started = time.Now()
+
+// RuntimeStats holds runtime diagnostics for the server process.
+type RuntimeStats struct {
+	GoVersion    string    `json:"goVersion"`
+	Uptime       string    `json:"uptime"`
+	NumCPU       int       `json:"numCPU"`
+	GOMAXPROCS   int       `json:"gomaxprocs"`
+	NumGoroutine int       `json:"numGoroutine"`
+	HeapAlloc    uint64    `json:"heapAlloc"`
+	HeapSys      uint64    `json:"heapSys"`
+	HeapObjects  uint64    `json:"heapObjects"`
+	NumGC        int64     `json:"numGC"`
+	LastGC       time.Time `json:"lastGC"`
+	PauseTotal   string    `json:"pauseTotal"`
+	RecentPauses []string  `json:"recentPauses"`
+	NextGC       uint64    `json:"nextGC"`
+}
+
+// runtimeStats returns the current runtime diagnostics.
+func runtimeStats() *RuntimeStats {
+	var mem runtime.MemStats
+	runtime.ReadMemStats(&mem)
+	var gc debug.GCStats
+	debug.ReadGCStats(&gc)
+
+	stats := &RuntimeStats{
+		GoVersion:    runtime.Version(),
+		Uptime:       time.Since(started).Round(time.Second).String(),
+		NumCPU:       runtime.NumCPU(),
+		GOMAXPROCS:   runtime.GOMAXPROCS(0),
+		NumGoroutine: runtime.NumGoroutine(),
+		HeapAlloc:    mem.HeapAlloc,
+		HeapSys:      mem.HeapSys,
+		HeapObjects:  mem.HeapObjects,
+		NumGC:        gc.NumGC,
+		LastGC:       gc.LastGC,
+		PauseTotal:   gc.PauseTotal.String(),
+		NextGC:       mem.NextGC,
+	}
+	// the most recent pauses come first
+	for i, pause := range gc.Pause {
+		if i == 10 {
+			break
+		}
+		stats.RecentPauses = append(stats.RecentPauses, pause.String())
+	}
+	return stats
+}
+
+// AdminOnly returns a middleware that only allows requests from admins that do not belong to a tenant.
+func (s *AutograderService) AdminOnly() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			user, ok := c.Get(auth.UserKey).(*pb.User)
+			if !ok || !user.IsGlobalAdmin() {
+				s.logger.Errorf("Diagnostics access denied for %s: user is not admin", c.Request().URL.Path)
+				return echo.ErrUnauthorized
+			}
+			return next(c)
+		}
+	}
+}
+
+// RegisterDiagnostics registers the admin-only profiling and runtime diagnostics
+// endpoints under /debug:
+//
+//	/debug/pprof/     net/http/pprof profiles, e.g., /debug/pprof/profile?seconds=30
+//	/debug/goroutines full stack dump of all goroutines
+//	/debug/runtime    runtime and garbage collector statistics as JSON
+func (s *AutograderService) RegisterDiagnostics(e *echo.Echo) {
+	debugGroup := e.Group("/debug", s.AdminOnly())
+	debugGroup.GET("/pprof/cmdline", echo.WrapHandler(http.HandlerFunc(pprof.Cmdline)))
+	debugGroup.GET("/pprof/profile", echo.WrapHandler(http.HandlerFunc(pprof.Profile)))
+	debugGroup.GET("/pprof/symbol", echo.WrapHandler(http.HandlerFunc(pprof.Symbol)))
+	debugGroup.POST("/pprof/symbol", echo.WrapHandler(http.HandlerFunc(pprof.Symbol)))
+	debugGroup.GET("/pprof/trace", echo.WrapHandler(http.HandlerFunc(pprof.Trace)))
+	// the index serves the named profiles, e.g., /debug/pprof/heap
+	debugGroup.GET("/pprof/*", echo.WrapHandler(http.HandlerFunc(pprof.Index)))
+	debugGroup.GET("/goroutines", func(c echo.Context) error {
+		c.Response().Header().Set(echo.HeaderContentType, echo.MIMETextPlainCharsetUTF8)
+		c.Response().WriteHeader(http.StatusOK)
+		return rpprof.Lookup("goroutine").WriteTo(c.Response(), 2)
+	})
+	debugGroup.GET("/runtime", func(c echo.Context) error {
+		return c.JSON(http.StatusOK, runtimeStats())
+	})
+}
@@ -0,0 +1,76 @@
+package web_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/ci"
+	"github.com/autograde/quickfeed/internal/qtest"
+	"github.com/autograde/quickfeed/web"
+	"github.com/autograde/quickfeed/web/auth"
+)
+
+func TestDiagnostics(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	admin := qtest.CreateFakeUser(t, db, 1)
+	if err := db.UpdateUser(&pb.User{ID: admin.ID, IsAdmin: true}); err != nil {
+		t.Fatal(err)
+	}
+	admin.IsAdmin = true
+	student := qtest.CreateFakeUser(t, db, 2)
+
+	_, scms := qtest.FakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+
+	// serve requests as the user given by the request's user header
+	users := map[string]*pb.User{"admin": admin, "student": student}
+	e := echo.New()
+	e.Use(func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if user, ok := users[c.Request().Header.Get("user")]; ok {
+				c.Set(auth.UserKey, user)
+			}
+			return next(c)
+		}
+	})
+	ags.RegisterDiagnostics(e)
+
+	get := func(user, path string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		req.Header.Set("user", user)
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		return rec
+	}
+
+	for _, path := range []string{"/debug/pprof/", "/debug/pprof/heap", "/debug/goroutines", "/debug/runtime"} {
+		for _, user := range []string{"", "student"} {
+			if rec := get(user, path); rec.Code != http.StatusUnauthorized {
+				t.Errorf("GET %s by %q: status = %d, want %d", path, user, rec.Code, http.StatusUnauthorized)
+			}
+		}
+		if rec := get("admin", path); rec.Code != http.StatusOK {
+			t.Errorf("GET %s by admin: status = %d, want %d", path, rec.Code, http.StatusOK)
+		}
+	}
+
+	if rec := get("admin", "/debug/goroutines"); !strings.Contains(rec.Body.String(), "goroutine") {
+		t.Errorf("GET /debug/goroutines: expected goroutine dump, got:\n%s", rec.Body.String())
+	}
+	var stats web.RuntimeStats
+	if err := json.Unmarshal(get("admin", "/debug/runtime").Body.Bytes(), &stats); err != nil {
+		t.Fatal(err)
+	}
+	if stats.NumGoroutine == 0 || stats.HeapAlloc == 0 || stats.GoVersion == "" {
+		t.Errorf("GET /debug/runtime = %+v, want runtime statistics", stats)
+	}
+}
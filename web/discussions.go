@@ -0,0 +1,51 @@
+package web
+
+import (
+	"context"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/scm"
+)
+
+// maxDiscussions is the number of recent discussions returned for an assignment.
+const maxDiscussions = 10
+
+// getAssignmentDiscussions returns the most recent discussions about the given assignment
+// from the discussion category named after the assignment in the course info repository.
+// The course creator's access token is used, since students may not have access to the
+// discussions API through their own tokens.
+func (s *AutograderService) getAssignmentDiscussions(ctx context.Context, request *pb.AssignmentRequest) (*pb.Discussions, error) {
+	assignment, err := s.getAssignmentInCourse(request.GetAssignmentID(), request.GetCourseID())
+	if err != nil {
+		return nil, err
+	}
+	course, err := s.db.GetCourse(request.GetCourseID(), false)
+	if err != nil {
+		return nil, err
+	}
+	sc, err := s.getCourseSCM(course)
+	if err != nil {
+		return nil, err
+	}
+	scmDiscussions, err := sc.GetDiscussions(ctx, &scm.DiscussionOptions{
+		Owner:      course.GetOrganizationPath(),
+		Repository: course.InfoRepoName(),
+		Category:   assignment.GetName(),
+		Limit:      maxDiscussions,
+	})
+	if err != nil {
+		return nil, err
+	}
+	discussions := &pb.Discussions{}
+	for _, d := range scmDiscussions {
+		discussions.Discussions = append(discussions.Discussions, &pb.Discussion{
+			Title:    d.Title,
+			URL:      d.URL,
+			Author:   d.Author,
+			Created:  d.Created.Format(pb.TimeLayout),
+			Comments: d.Comments,
+			Answered: d.Answered,
+		})
+	}
+	return discussions, nil
+}
@@ -0,0 +1,64 @@
+package web_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/ci"
+	"github.com/autograde/quickfeed/internal/qtest"
+	"github.com/autograde/quickfeed/scm"
+	"github.com/autograde/quickfeed/web"
+)
+
+func TestGetAssignmentDiscussions(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	teacher := qtest.CreateFakeUser(t, db, 1)
+	student := qtest.CreateFakeUser(t, db, 2)
+	outsider := qtest.CreateFakeUser(t, db, 3)
+	course := &pb.Course{Provider: "fake", OrganizationID: 1, OrganizationPath: "test"}
+	qtest.CreateCourse(t, db, teacher, course)
+	qtest.EnrollStudent(t, db, student, course)
+	assignment := &pb.Assignment{CourseID: course.ID, Name: "lab1", Order: 1}
+	if err := db.CreateAssignment(assignment); err != nil {
+		t.Fatal(err)
+	}
+
+	_, scms := qtest.FakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	client := accessControlled(t, ags)
+	sc, err := scms.GetOrCreateSCMEntry(zap.NewNop(), "fake", course.GetAccessToken())
+	if err != nil {
+		t.Fatal(err)
+	}
+	created := time.Date(2021, 9, 1, 12, 0, 0, 0, time.Local)
+	sc.(*scm.FakeSCM).Discussions["test/"+pb.InfoRepo+"/lab1"] = []*scm.Discussion{
+		{Title: "Task 2 hangs", URL: "https://github.com/test/info/discussions/1", Author: "student", Created: created, Comments: 3, Answered: true},
+	}
+
+	request := &pb.AssignmentRequest{CourseID: course.ID, AssignmentID: assignment.ID}
+	got, err := ags.GetAssignmentDiscussions(withUserContext(context.Background(), student), request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &pb.Discussions{Discussions: []*pb.Discussion{
+		{Title: "Task 2 hangs", URL: "https://github.com/test/info/discussions/1", Author: "student", Created: created.Format(pb.TimeLayout), Comments: 3, Answered: true},
+	}}
+	if diff := cmp.Diff(want, got, protocmp.Transform()); diff != "" {
+		t.Errorf("GetAssignmentDiscussions() mismatch (-want +got):\n%s", diff)
+	}
+
+	_, err = client.GetAssignmentDiscussions(withUserContext(context.Background(), outsider), request)
+	if status.Code(err) != codes.PermissionDenied {
+		t.Errorf("GetAssignmentDiscussions() for non-enrolled user: got %v, want %v", err, codes.PermissionDenied)
+	}
+}
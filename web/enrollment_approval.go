@@ -0,0 +1,57 @@
+package web
+
+import (
+	"context"
+
+	pb "github.com/autograde/quickfeed/ag"
+)
+
+// autoApproveEnrollments accepts the course's pending enrollments of students admitted by
+// the course's automatic approval rules: students with an email in one of the course's
+// auto-approved domains, and if enabled, students in the course's imported roster.
+// The students' repositories are created using the course creator's access token.
+// It returns the number of enrollments accepted.
+func (s *AutograderService) autoApproveEnrollments(ctx context.Context, course *pb.Course) (int, error) {
+	if course.GetAutoApproveDomains() == "" && !course.GetAutoApproveRoster() {
+		return 0, nil
+	}
+	pending, err := s.db.GetEnrollmentsByCourse(course.GetID(), pb.Enrollment_PENDING)
+	if err != nil {
+		return 0, err
+	}
+	approved := 0
+	for _, enrollment := range pending {
+		ok, err := s.autoApproves(course, enrollment.GetUser())
+		if err != nil {
+			return approved, err
+		}
+		// students must link an identity on the course's provider before their repositories can be created
+		if !ok || !s.hasCourseIdentity(enrollment.GetUserID(), course.GetID()) {
+			continue
+		}
+		sc, err := s.getCourseSCM(course)
+		if err != nil {
+			return approved, err
+		}
+		request := &pb.Enrollment{CourseID: course.GetID(), UserID: enrollment.GetUserID(), Status: pb.Enrollment_STUDENT}
+		if err := s.updateEnrollment(ctx, sc, "", request); err != nil {
+			return approved, err
+		}
+		after, _ := s.db.GetEnrollmentByCourseAndUser(course.GetID(), enrollment.GetUserID())
+		s.auditChange(nil, course.GetID(), "auto-approve enrollment", enrollmentRecord(enrollment), enrollmentRecord(after),
+			"user", enrollment.GetUser().GetLogin())
+		approved++
+	}
+	return approved, nil
+}
+
+// autoApproves returns true if the course's automatic approval rules admit the given user.
+func (s *AutograderService) autoApproves(course *pb.Course, user *pb.User) (bool, error) {
+	if course.AutoApprovesEmail(user.GetEmail()) {
+		return true, nil
+	}
+	if course.GetAutoApproveRoster() {
+		return s.db.IsPreEnrolled(course.GetID(), user)
+	}
+	return false, nil
+}
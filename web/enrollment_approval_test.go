@@ -0,0 +1,101 @@
+package web_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/ci"
+	"github.com/autograde/quickfeed/internal/qtest"
+	"github.com/autograde/quickfeed/scm"
+	"github.com/autograde/quickfeed/web"
+	"go.uber.org/zap"
+)
+
+func TestAutoApproveEnrollments(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	fakeGothProvider()
+	teacher := qtest.CreateFakeUser(t, db, 1)
+	ctx := withUserContext(context.Background(), teacher)
+	fakeProvider, scms := qtest.FakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	if _, err := fakeProvider.CreateOrganization(ctx, &scm.OrganizationOptions{Path: "path", Name: "name"}); err != nil {
+		t.Fatal(err)
+	}
+	course, err := ags.CreateCourse(ctx, &pb.Course{
+		Name:               "Distributed Systems",
+		Code:               "DAT520",
+		Year:               2018,
+		Provider:           "fake",
+		OrganizationID:     1,
+		AutoApproveDomains: "stud.uis.no",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	wantStatus := func(user *pb.User, want pb.Enrollment_UserStatus) {
+		t.Helper()
+		enrollment, err := db.GetEnrollmentByCourseAndUser(course.ID, user.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if enrollment.GetStatus() != want {
+			t.Errorf("enrollment of %s has status %v, want %v", user.GetLogin(), enrollment.GetStatus(), want)
+		}
+	}
+	runChecks := func() {
+		canceledCtx, cancel := context.WithCancel(context.Background())
+		cancel()
+		ags.RunCourseChecks(canceledCtx, time.Hour)
+	}
+
+	// students with an email in an auto-approved domain are accepted when enrolling
+	alice := qtest.CreateUser(t, db, 2, &pb.User{Login: "alice", Email: "alice@Stud.UIS.no"})
+	bob := qtest.CreateUser(t, db, 3, &pb.User{Login: "bob", Email: "bob@gmail.com"})
+	for _, user := range []*pb.User{alice, bob} {
+		if _, err := ags.CreateEnrollment(ctx, &pb.Enrollment{CourseID: course.ID, UserID: user.ID}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	wantStatus(alice, pb.Enrollment_STUDENT)
+	wantStatus(bob, pb.Enrollment_PENDING)
+	repos, err := db.GetRepositories(&pb.Repository{OrganizationID: course.OrganizationID, UserID: alice.ID, RepoType: pb.Repository_USER})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(repos) != 1 {
+		t.Errorf("expected repository for auto-approved student, got %d repositories", len(repos))
+	}
+
+	// students in the roster are accepted when imported, or when first logging in
+	course.AutoApproveDomains = ""
+	course.AutoApproveRoster = true
+	if err := db.UpdateCourse(course); err != nil {
+		t.Fatal(err)
+	}
+	carol := qtest.CreateUser(t, db, 4, &pb.User{Login: "carol"})
+	if _, err := ags.ImportEnrollments(ctx, &pb.ImportEnrollmentsRequest{CourseID: course.ID, Csv: "1,,carol\n2,dave@gmail.com,\n"}); err != nil {
+		t.Fatal(err)
+	}
+	wantStatus(carol, pb.Enrollment_STUDENT)
+
+	dave := qtest.CreateUser(t, db, 5, &pb.User{Login: "dave", Email: "dave@gmail.com"})
+	if _, err := db.MatchPreEnrollments(dave); err != nil {
+		t.Fatal(err)
+	}
+	wantStatus(dave, pb.Enrollment_PENDING)
+	runChecks()
+	wantStatus(dave, pb.Enrollment_STUDENT)
+	wantStatus(bob, pb.Enrollment_PENDING)
+
+	// clearing the auto-approved domains stops accepting students by email
+	erin := qtest.CreateUser(t, db, 6, &pb.User{Login: "erin", Email: "erin@stud.uis.no"})
+	if _, err := ags.CreateEnrollment(ctx, &pb.Enrollment{CourseID: course.ID, UserID: erin.ID}); err != nil {
+		t.Fatal(err)
+	}
+	wantStatus(erin, pb.Enrollment_PENDING)
+}
@@ -0,0 +1,108 @@
+package web
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"gorm.io/gorm"
+)
+
+// importEnrollments enrolls the students listed in the given CSV in the given course.
+// Each row holds a student ID, an email and a GitHub username; either the email or the
+// username must be given. All students are recorded in the course's roster. Students with
+// an existing user are enrolled as pending, while the remaining students are enrolled
+// when they first log in.
+// A header row is skipped, and invalid rows are reported in the returned report.
+func (s *AutograderService) importEnrollments(request *pb.ImportEnrollmentsRequest) (*pb.EnrollmentImportReport, error) {
+	courseID := request.GetCourseID()
+	if _, err := s.db.GetCourse(courseID, false); err != nil {
+		return nil, err
+	}
+	reader := csv.NewReader(strings.NewReader(request.GetCsv()))
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	report := &pb.EnrollmentImportReport{}
+	for line := 1; ; line++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse CSV: %w", err)
+		}
+		if line == 1 && isImportHeader(record) {
+			continue
+		}
+		preEnrollment := parseImportRecord(courseID, record)
+		if preEnrollment.GetEmail() == "" && preEnrollment.GetLogin() == "" {
+			report.Errors = append(report.Errors, fmt.Sprintf("line %d: missing email and GitHub username", line))
+			continue
+		}
+		user, err := s.db.GetUserByLoginOrEmail(preEnrollment.GetLogin(), preEnrollment.GetEmail())
+		switch {
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			if err := s.db.CreatePreEnrollment(preEnrollment); err != nil {
+				return nil, err
+			}
+			report.Unmatched = append(report.Unmatched, preEnrollment)
+			continue
+		case err != nil:
+			return nil, err
+		}
+		// record the student in the course's roster
+		preEnrollment.UserID = user.GetID()
+		if err := s.db.CreatePreEnrollment(preEnrollment); err != nil {
+			return nil, err
+		}
+		if user.GetStudentID() == "" && preEnrollment.GetStudentID() != "" {
+			user.StudentID = preEnrollment.GetStudentID()
+			if err := s.db.UpdateUser(user); err != nil {
+				return nil, err
+			}
+		}
+		if _, err := s.db.GetEnrollmentByCourseAndUser(courseID, user.GetID()); err == nil {
+			report.AlreadyEnrolled++
+			continue
+		} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, err
+		}
+		if err := s.db.CreateEnrollment(&pb.Enrollment{CourseID: courseID, UserID: user.GetID()}); err != nil {
+			return nil, err
+		}
+		report.Enrolled++
+	}
+	return report, nil
+}
+
+// isImportHeader returns true if the given CSV record is a header row.
+func isImportHeader(record []string) bool {
+	for _, field := range record {
+		field = strings.ToLower(field)
+		if strings.Contains(field, "email") || strings.Contains(field, "github") || strings.Contains(field, "username") {
+			return true
+		}
+	}
+	return false
+}
+
+// parseImportRecord returns the pre-enrollment in the given course for the given CSV record
+// with the columns student ID, email and GitHub username; missing columns are left empty.
+func parseImportRecord(courseID uint64, record []string) *pb.PreEnrollment {
+	field := func(i int) string {
+		if i < len(record) {
+			return strings.TrimSpace(record[i])
+		}
+		return ""
+	}
+	return &pb.PreEnrollment{
+		CourseID:  courseID,
+		StudentID: field(0),
+		Email:     field(1),
+		Login:     strings.TrimPrefix(field(2), "@"),
+	}
+}
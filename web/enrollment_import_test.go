@@ -0,0 +1,79 @@
+package web_test
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/ci"
+	"github.com/autograde/quickfeed/internal/qtest"
+	"github.com/autograde/quickfeed/web"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestImportEnrollments(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	teacher := qtest.CreateFakeUser(t, db, 1)
+	course := &pb.Course{Code: "DAT101"}
+	qtest.CreateCourse(t, db, teacher, course)
+	student := qtest.CreateUser(t, db, 2, &pb.User{Login: "alice", Email: "alice@example.com"})
+	enrolled := qtest.CreateUser(t, db, 3, &pb.User{Login: "bob"})
+	qtest.EnrollStudent(t, db, enrolled, course)
+
+	_, scms := qtest.FakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	client := accessControlled(t, ags)
+	teacherCtx := withUserContext(context.Background(), teacher)
+
+	csv := `Student ID,Email,GitHub username
+111111,ALICE@example.com,
+222222,,@bob
+333333,carol@example.com,carol
+444444,,
+`
+	if _, err := client.ImportEnrollments(withUserContext(context.Background(), student), &pb.ImportEnrollmentsRequest{CourseID: course.ID, Csv: csv}); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("ImportEnrollments() by student: got %v, want %v", err, codes.PermissionDenied)
+	}
+
+	report, err := ags.ImportEnrollments(teacherCtx, &pb.ImportEnrollmentsRequest{CourseID: course.ID, Csv: csv})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.GetEnrolled() != 1 || report.GetAlreadyEnrolled() != 1 {
+		t.Errorf("ImportEnrollments() enrolled %d and found %d already enrolled, want 1 and 1", report.GetEnrolled(), report.GetAlreadyEnrolled())
+	}
+	if len(report.GetUnmatched()) != 1 || report.GetUnmatched()[0].GetLogin() != "carol" {
+		t.Errorf("ImportEnrollments() unmatched = %v, want carol", report.GetUnmatched())
+	}
+	if len(report.GetErrors()) != 1 {
+		t.Errorf("ImportEnrollments() errors = %q, want 1 error", report.GetErrors())
+	}
+
+	enrollment, err := db.GetEnrollmentByCourseAndUser(course.ID, student.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if enrollment.GetStatus() != pb.Enrollment_PENDING {
+		t.Errorf("imported enrollment has status %v, want %v", enrollment.GetStatus(), pb.Enrollment_PENDING)
+	}
+	gotStudent, err := db.GetUser(student.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if gotStudent.GetStudentID() != "111111" {
+		t.Errorf("StudentID = %q, want %q", gotStudent.GetStudentID(), "111111")
+	}
+
+	// the unmatched student is enrolled on first login
+	carol := qtest.CreateUser(t, db, 4, &pb.User{Login: "carol"})
+	if n, err := db.MatchPreEnrollments(carol); err != nil || n != 1 {
+		t.Errorf("MatchPreEnrollments() = (%d, %v), want (1, nil)", n, err)
+	}
+	if _, err := db.GetEnrollmentByCourseAndUser(course.ID, carol.ID); err != nil {
+		t.Errorf("expected carol to be enrolled after login: %v", err)
+	}
+}
@@ -0,0 +1,267 @@
+package web
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/ci"
+	"github.com/autograde/quickfeed/web/auth"
+	"github.com/labstack/echo/v4"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const (
+	// eventsBuffer is the number of events buffered for each subscriber, and the number of
+	// recent events kept for each user. Events are dropped for subscribers that fall further behind.
+	eventsBuffer = 16
+	// eventsHistory is how long recent events are kept for clients reconnecting to the event stream.
+	eventsHistory = time.Minute
+	// eventsRetry is how long clients wait before reconnecting to a closed event stream, in milliseconds.
+	eventsRetry = 500
+)
+
+// eventsStreamDuration is how long an event stream is open before the server closes it,
+// and the client reconnects; it must be shorter than the web server's write timeout.
+var eventsStreamDuration = writeTimeout - time.Second
+
+// eventHub delivers events to the event streams of the users the events are addressed to.
+// The recent events of each user are kept, such that clients reconnecting to the event
+// stream receive the events published while they were disconnected.
+type eventHub struct {
+	mu          sync.Mutex
+	lastID      uint64
+	recent      map[uint64][]*sentEvent
+	subscribers map[*eventSubscriber]struct{}
+}
+
+// sentEvent is an event with the ID identifying it in event streams.
+type sentEvent struct {
+	id        uint64
+	published time.Time
+	event     *pb.Event
+}
+
+type eventSubscriber struct {
+	userID uint64
+	ch     chan *sentEvent
+}
+
+func newEventHub() *eventHub {
+	return &eventHub{
+		recent:      make(map[uint64][]*sentEvent),
+		subscribers: make(map[*eventSubscriber]struct{}),
+	}
+}
+
+// subscribe returns a channel receiving the events addressed to the given user,
+// starting with the recent events after the given event ID if the client is reconnecting,
+// the ID of the last event published before subscribing, and a function to cancel the subscription.
+func (h *eventHub) subscribe(userID uint64, reconnecting bool, lastEventID uint64) (<-chan *sentEvent, uint64, func()) {
+	sub := &eventSubscriber{userID: userID, ch: make(chan *sentEvent, eventsBuffer)}
+	h.mu.Lock()
+	currentID := h.lastID
+	if reconnecting {
+		for _, e := range h.recent[userID] {
+			if e.id > lastEventID {
+				sub.ch <- e
+			}
+		}
+	}
+	h.subscribers[sub] = struct{}{}
+	h.mu.Unlock()
+
+	var once sync.Once
+	return sub.ch, currentID, func() {
+		once.Do(func() {
+			h.mu.Lock()
+			delete(h.subscribers, sub)
+			h.mu.Unlock()
+			close(sub.ch)
+		})
+	}
+}
+
+// publish delivers the event to the subscribers of the given users.
+// publish does not block on subscribers that fall behind.
+func (h *eventHub) publish(event *pb.Event, userIDs ...uint64) {
+	now := time.Now()
+	event.Time = now.Format(pb.TimeLayout)
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for _, userID := range userIDs {
+		h.lastID++
+		e := &sentEvent{id: h.lastID, published: now, event: event}
+		h.remember(userID, e)
+		for sub := range h.subscribers {
+			if sub.userID != userID {
+				continue
+			}
+			select {
+			case sub.ch <- e:
+			default:
+			}
+		}
+	}
+}
+
+// remember adds the event to the user's recent events, and forgets the events that are no longer recent.
+func (h *eventHub) remember(userID uint64, e *sentEvent) {
+	recent := append(h.recent[userID], e)
+	for len(recent) > 0 && (len(recent) > eventsBuffer || e.published.Sub(recent[0].published) > eventsHistory) {
+		recent = recent[1:]
+	}
+	h.recent[userID] = recent
+}
+
+// queuedBuffer is the number of queued test jobs buffered for posting pending commit statuses.
+const queuedBuffer = 64
+
+// publishResults publishes an event to the authors of each submission as its results are received,
+// and posts the results as the status of the graded commit. The commits of queued test jobs get a
+// pending status. Statuses are posted one at a time, in the order the jobs are queued and graded.
+func (s *AutograderService) publishResults(results <-chan *pb.Submission, queued <-chan *ci.RunData) {
+	for {
+		select {
+		case rData := <-queued:
+			s.postPendingStatus(context.Background(), rData)
+		case submission, ok := <-results:
+			if !ok {
+				return
+			}
+			s.publishResult(submission)
+			if err := s.postSubmissionStatus(context.Background(), submission); err != nil {
+				s.logger.Errorf("Failed to post status of submission %d: %v", submission.GetID(), err)
+			}
+		}
+	}
+}
+
+// publishResult publishes an event to the authors of the given submission.
+func (s *AutograderService) publishResult(submission *pb.Submission) {
+	userIDs, err := s.submissionAuthors(submission)
+	if err != nil {
+		s.logger.Errorf("Failed to publish results of submission %d: %v", submission.GetID(), err)
+		return
+	}
+	event := &pb.Event{
+		Type:         pb.Event_SUBMISSION_GRADED,
+		AssignmentID: submission.GetAssignmentID(),
+		SubmissionID: submission.GetID(),
+		GroupID:      submission.GetGroupID(),
+	}
+	if assignment, err := s.db.GetAssignment(&pb.Assignment{ID: submission.GetAssignmentID()}); err == nil {
+		event.CourseID = assignment.GetCourseID()
+	}
+	s.events.publish(event, userIDs...)
+}
+
+// submissionAuthors returns the IDs of the user who made the given submission,
+// or of the members of the group that made it.
+func (s *AutograderService) submissionAuthors(submission *pb.Submission) ([]uint64, error) {
+	if submission.GetGroupID() == 0 {
+		return []uint64{submission.GetUserID()}, nil
+	}
+	group, err := s.db.GetGroup(submission.GetGroupID())
+	if err != nil {
+		return nil, err
+	}
+	return group.UserIDs(), nil
+}
+
+// publishEnrollmentAccepted notifies the user of the given enrollment that the enrollment has been accepted.
+func (s *AutograderService) publishEnrollmentAccepted(enrollment *pb.Enrollment) {
+	s.events.publish(&pb.Event{
+		Type:     pb.Event_ENROLLMENT_ACCEPTED,
+		CourseID: enrollment.GetCourseID(),
+	}, enrollment.GetUserID())
+}
+
+// publishGroupApproved notifies the members of the given group that the group has been approved.
+func (s *AutograderService) publishGroupApproved(group *pb.Group) {
+	s.events.publish(&pb.Event{
+		Type:     pb.Event_GROUP_APPROVED,
+		CourseID: group.GetCourseID(),
+		GroupID:  group.GetID(),
+	}, group.UserIDs()...)
+}
+
+// publishReviewPublished notifies the authors of the given submission that its review has been released.
+func (s *AutograderService) publishReviewPublished(courseID uint64, submission *pb.Submission) error {
+	userIDs, err := s.submissionAuthors(submission)
+	if err != nil {
+		return err
+	}
+	s.events.publish(&pb.Event{
+		Type:         pb.Event_REVIEW_PUBLISHED,
+		CourseID:     courseID,
+		AssignmentID: submission.GetAssignmentID(),
+		SubmissionID: submission.GetID(),
+		GroupID:      submission.GetGroupID(),
+	}, userIDs...)
+	return nil
+}
+
+// Events returns a handler streaming the events addressed to the signed in user as
+// server-sent events. The name of each event is its type in lower case, e.g.,
+// submission_graded, and its data is the event as JSON. The stream is closed before
+// the web server's write timeout; browsers then reconnect with the ID of the last
+// event received, and receive the events published in the meantime.
+// Access policy: Current User.
+func (s *AutograderService) Events() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		user, ok := c.Get(auth.UserKey).(*pb.User)
+		if !ok {
+			return echo.ErrUnauthorized
+		}
+		lastEventID, err := strconv.ParseUint(c.Request().Header.Get("Last-Event-ID"), 10, 64)
+		reconnecting := err == nil
+		events, currentID, cancel := s.events.subscribe(user.GetID(), reconnecting, lastEventID)
+		defer cancel()
+
+		w := c.Response()
+		w.Header().Set(echo.HeaderContentType, "text/event-stream")
+		w.Header().Set("Cache-Control", "no-cache")
+		// disable buffering of the stream by nginx
+		w.Header().Set("X-Accel-Buffering", "no")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, "retry: %d\n\n", eventsRetry)
+		if !reconnecting {
+			// sets the client's last event ID without an event, such that the
+			// client receives the events published while it is reconnecting
+			fmt.Fprintf(w, "id: %d\n\n", currentID)
+		}
+		w.Flush()
+
+		timeout := time.NewTimer(eventsStreamDuration)
+		defer timeout.Stop()
+		for {
+			select {
+			case <-c.Request().Context().Done():
+				return nil
+			case <-timeout.C:
+				return nil
+			case e := <-events:
+				if err := writeEvent(w, e); err != nil {
+					return nil
+				}
+				w.Flush()
+			}
+		}
+	}
+}
+
+// writeEvent writes the given event as a server-sent event.
+func writeEvent(w http.ResponseWriter, e *sentEvent) error {
+	data, err := protojson.Marshal(e.event)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", e.id, strings.ToLower(e.event.GetType().String()), data)
+	return err
+}
@@ -0,0 +1,151 @@
+package web_test
+
+import (
+	"bufio"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/internal/qtest"
+	"github.com/autograde/quickfeed/web"
+	"github.com/autograde/quickfeed/web/auth"
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// serverSentEvent is an event read from an event stream.
+type serverSentEvent struct {
+	id, name string
+	event    *pb.Event
+}
+
+// readEvent returns the next event of the event stream, skipping lines without events.
+func readEvent(t *testing.T, r *bufio.Reader) *serverSentEvent {
+	t.Helper()
+	e := &serverSentEvent{}
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			t.Fatalf("failed to read event: %v", err)
+		}
+		line = strings.TrimSuffix(line, "\n")
+		switch {
+		case strings.HasPrefix(line, "id: "):
+			e.id = strings.TrimPrefix(line, "id: ")
+		case strings.HasPrefix(line, "event: "):
+			e.name = strings.TrimPrefix(line, "event: ")
+		case strings.HasPrefix(line, "data: "):
+			e.event = &pb.Event{}
+			if err := protojson.Unmarshal([]byte(strings.TrimPrefix(line, "data: ")), e.event); err != nil {
+				t.Fatal(err)
+			}
+		case line == "" && e.event != nil:
+			return e
+		}
+	}
+}
+
+// openEvents opens the event stream of the given user, reconnecting with the given last event ID if not empty.
+// It returns the stream once the server has subscribed to the user's events, and the stream's last event ID.
+func openEvents(t *testing.T, ctx context.Context, url, user, lastEventID string) (*bufio.Reader, string) {
+	t.Helper()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url+"/events", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("user", user)
+	if lastEventID != "" {
+		req.Header.Set("Last-Event-ID", lastEventID)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { resp.Body.Close() })
+	if resp.StatusCode != http.StatusOK || resp.Header.Get("Content-Type") != "text/event-stream" {
+		t.Fatalf("GET /events = %d %s, want event stream", resp.StatusCode, resp.Header.Get("Content-Type"))
+	}
+	r := bufio.NewReader(resp.Body)
+	// the stream starts with the retry interval, and on the first connection the last event ID
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			t.Fatal(err)
+		}
+		if strings.HasPrefix(line, "id: ") {
+			lastEventID = strings.TrimSpace(strings.TrimPrefix(line, "id: "))
+		}
+		if line == "\n" && lastEventID != "" {
+			return r, lastEventID
+		}
+	}
+}
+
+func TestEvents(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	teacher := qtest.CreateFakeUser(t, db, 1)
+	course := &pb.Course{Code: "DAT101", OrganizationID: 1}
+	qtest.CreateCourse(t, db, teacher, course)
+	students := qtest.PopulateCourse(t, db, course, 2, 1)
+	_, scms := qtest.FakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, echoRunner{})
+
+	// serve requests as the user given by the request's user header
+	users := map[string]*pb.User{"student": students[0], "other": students[1]}
+	e := echo.New()
+	e.Use(func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if user, ok := users[c.Request().Header.Get("user")]; ok {
+				c.Set(auth.UserKey, user)
+			}
+			return next(c)
+		}
+	})
+	e.GET("/events", ags.Events())
+	server := httptest.NewServer(e)
+	defer server.Close()
+
+	if resp, err := http.Get(server.URL + "/events"); err != nil || resp.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("GET /events without user = %v, %v, want status %d", resp, err, http.StatusUnauthorized)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream, firstID := openEvents(t, ctx, server.URL, "student", "")
+	otherStream, _ := openEvents(t, ctx, server.URL, "other", "")
+
+	rebuild := func(student *pb.User) *pb.Submission {
+		submission, err := db.GetSubmission(&pb.Submission{AssignmentID: 1, UserID: student.GetID()})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := ags.RebuildSubmission(withUserContext(ctx, teacher), &pb.RebuildRequest{AssignmentID: 1, SubmissionID: submission.GetID()}); err != nil {
+			t.Fatal(err)
+		}
+		return submission
+	}
+	submission := rebuild(students[0])
+	got := readEvent(t, stream)
+	if got.name != "submission_graded" || got.event.GetType() != pb.Event_SUBMISSION_GRADED ||
+		got.event.GetSubmissionID() != submission.GetID() || got.event.GetCourseID() != course.GetID() {
+		t.Errorf("student received event %s %v, want submission_graded for submission %d", got.name, got.event, submission.GetID())
+	}
+
+	// another student only receives the events of their own submissions
+	otherSubmission := rebuild(students[1])
+	if got := readEvent(t, otherStream); got.event.GetSubmissionID() != otherSubmission.GetID() {
+		t.Errorf("other student received event %v, want event for submission %d", got.event, otherSubmission.GetID())
+	}
+
+	// a reconnecting client receives the events published since the last event it received
+	reconnected, _ := openEvents(t, ctx, server.URL, "student", firstID)
+	if got := readEvent(t, reconnected); got.event.GetSubmissionID() != submission.GetID() {
+		t.Errorf("reconnected student received event %v, want event for submission %d", got.event, submission.GetID())
+	}
+}
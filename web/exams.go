@@ -0,0 +1,125 @@
+package web
+
+import (
+	"context"
+	"path"
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/scm"
+)
+
+// courseExams returns the exam assignments of the course.
+func (s *AutograderService) courseExams(courseID uint64) ([]*pb.Assignment, error) {
+	assignments, err := s.db.GetAssignmentsByCourse(courseID, false)
+	if err != nil {
+		return nil, err
+	}
+	var exams []*pb.Assignment
+	for _, assignment := range assignments {
+		if assignment.GetExam() {
+			exams = append(exams, assignment)
+		}
+	}
+	return exams, nil
+}
+
+// examRepoPermission returns the permission of the owner of the given student or group repository
+// at the given time: write access during the exam window of one of the exams for the repository,
+// including any extension granted to the repository's owner, and read access outside the windows.
+// Repositories without exams, i.e., group repositories in courses with only individual exams and
+// vice versa, keep their write access.
+func (s *AutograderService) examRepoPermission(exams []*pb.Assignment, repo *pb.Repository, now time.Time) (string, error) {
+	permission := scm.RepoPush
+	for _, exam := range exams {
+		if exam.GetIsGroupLab() != repo.IsGroupRepo() {
+			continue
+		}
+		if extension, err := s.db.GetExtension(exam.GetID(), repo.GetUserID(), repo.GetGroupID()); err == nil {
+			exam = exam.WithExtension(extension)
+		}
+		open, err := exam.InExamWindow(now)
+		if err != nil {
+			return "", err
+		}
+		if open {
+			return scm.RepoPush, nil
+		}
+		permission = scm.RepoPull
+	}
+	return permission, nil
+}
+
+// enforceExamWindows opens the student and group repositories of the course for pushes when
+// their exam windows start, and freezes them by downgrading their owners to read access when
+// the windows end. Only repositories whose access differs from the exam permission are updated.
+// The number of updated repositories is returned.
+func (s *AutograderService) enforceExamWindows(ctx context.Context, sc scm.SCM, course *pb.Course, now time.Time) (int, error) {
+	exams, err := s.courseExams(course.GetID())
+	if err != nil || len(exams) == 0 {
+		return 0, err
+	}
+	repos, err := s.db.GetRepositories(&pb.Repository{OrganizationID: course.GetOrganizationID()})
+	if err != nil {
+		return 0, err
+	}
+	updated := 0
+	for _, repo := range repos {
+		if !repo.IsStudentRepo() {
+			continue
+		}
+		permission, err := s.examRepoPermission(exams, repo, now)
+		if err != nil {
+			return updated, err
+		}
+		changed, err := s.updateRepoOwnerAccess(ctx, sc, course, repo, permission)
+		if err != nil {
+			s.logger.Errorf("Failed to update exam access to %s in course %s: %v", repo.GetHTMLURL(), course.GetCode(), err)
+			continue
+		}
+		if changed {
+			s.audit(nil, course.GetID(), "update exam access", "repository", path.Base(repo.GetHTMLURL()), "permission", permission)
+			updated++
+		}
+	}
+	return updated, nil
+}
+
+// updateRepoOwnerAccess gives the owner of the given student or group repository the given permission,
+// as a collaborator on student repositories and as the group's team on group repositories.
+// It returns false without updating the access if the owner already has the permission.
+func (s *AutograderService) updateRepoOwnerAccess(ctx context.Context, sc scm.SCM, course *pb.Course, repo *pb.Repository, permission string) (bool, error) {
+	access, err := sc.GetRepositoryAccess(ctx, &scm.RepositoryOptions{ID: repo.GetRepositoryID()})
+	if err != nil {
+		return false, err
+	}
+	repoName := path.Base(repo.GetHTMLURL())
+	if repo.IsGroupRepo() {
+		group, err := s.db.GetGroup(repo.GetGroupID())
+		if err != nil {
+			return false, err
+		}
+		for _, team := range access.Teams {
+			if team.ID == group.GetTeamID() && team.Permission == permission {
+				return false, nil
+			}
+		}
+		return true, sc.AddTeamRepo(ctx, &scm.AddTeamRepoOptions{
+			TeamID:         group.GetTeamID(),
+			OrganizationID: course.GetOrganizationID(),
+			Owner:          course.GetOrganizationPath(),
+			Repo:           repoName,
+			Permission:     permission,
+		})
+	}
+	user, err := s.db.GetUser(repo.GetUserID())
+	if err != nil {
+		return false, err
+	}
+	for _, collaborator := range access.Collaborators {
+		if collaborator.Name == user.GetLogin() && collaborator.Permission == permission {
+			return false, nil
+		}
+	}
+	return true, sc.UpdateRepoAccess(ctx, &scm.Repository{Owner: course.GetOrganizationPath(), Path: repoName}, user.GetLogin(), permission)
+}
@@ -0,0 +1,117 @@
+package web_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/ci"
+	"github.com/autograde/quickfeed/internal/qtest"
+	"github.com/autograde/quickfeed/scm"
+	"github.com/autograde/quickfeed/web"
+	"go.uber.org/zap"
+)
+
+func TestExamRepoAccess(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	teacher := qtest.CreateFakeUser(t, db, 1)
+	student := qtest.CreateUser(t, db, 2, &pb.User{Login: "student"})
+	course := &pb.Course{Provider: "fake", OrganizationID: 1, OrganizationPath: "test"}
+	qtest.CreateCourse(t, db, teacher, course)
+	qtest.EnrollStudent(t, db, student, course)
+	fakeProvider, scms := qtest.FakeProviderMap(t)
+	fake := fakeProvider.(*scm.FakeSCM)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	ctx := context.Background()
+
+	org := &pb.Organization{ID: 1, Path: "test"}
+	studentRepo, err := fake.CreateRepository(ctx, &scm.CreateRepositoryOptions{Organization: org, Path: "student-labs"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	groupRepo, err := fake.CreateRepository(ctx, &scm.CreateRepositoryOptions{Organization: org, Path: "group1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	team, err := fake.CreateTeam(ctx, &scm.NewTeamOptions{Organization: org.Path, TeamName: "group1", Users: []string{"student"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	group := &pb.Group{Name: "group1", CourseID: course.ID, TeamID: team.ID, Users: []*pb.User{student}}
+	if err := db.CreateGroup(group); err != nil {
+		t.Fatal(err)
+	}
+	for _, repo := range []*pb.Repository{
+		{OrganizationID: 1, RepositoryID: studentRepo.ID, UserID: student.ID, HTMLURL: studentRepo.WebURL, RepoType: pb.Repository_USER},
+		{OrganizationID: 1, RepositoryID: groupRepo.ID, GroupID: group.ID, HTMLURL: groupRepo.WebURL, RepoType: pb.Repository_GROUP},
+	} {
+		if err := db.CreateRepository(repo); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := fake.UpdateRepoAccess(ctx, studentRepo, "student", scm.RepoPush); err != nil {
+		t.Fatal(err)
+	}
+	if err := fake.AddTeamRepo(ctx, &scm.AddTeamRepoOptions{TeamID: team.ID, OrganizationID: 1, Owner: org.Path, Repo: groupRepo.Path, Permission: scm.RepoPush}); err != nil {
+		t.Fatal(err)
+	}
+
+	// the individual exam is in progress, while the group exam has ended
+	now := time.Now().UTC()
+	for _, assignment := range []*pb.Assignment{
+		{CourseID: course.ID, Name: "exam1", Order: 1, Exam: true, ExamStart: now.Add(-time.Hour).Format(pb.TimeLayout), Deadline: now.Add(time.Hour).Format(pb.TimeLayout)},
+		{CourseID: course.ID, Name: "exam2", Order: 2, Exam: true, IsGroupLab: true, ExamStart: now.Add(-3 * time.Hour).Format(pb.TimeLayout), Deadline: now.Add(-time.Hour).Format(pb.TimeLayout)},
+	} {
+		if err := db.CreateAssignment(assignment); err != nil {
+			t.Fatal(err)
+		}
+	}
+	runChecks := func() {
+		canceledCtx, cancel := context.WithCancel(ctx)
+		cancel()
+		ags.RunCourseChecks(canceledCtx, time.Hour)
+	}
+	access := func() (string, string) {
+		return fake.Collaborators[studentRepo.Path]["student"], fake.TeamRepos[team.ID][groupRepo.Path]
+	}
+
+	runChecks()
+	if studentAccess, groupAccess := access(); studentAccess != scm.RepoPush || groupAccess != scm.RepoPull {
+		t.Errorf("access during exam1 and after exam2 = (%s, %s), want (%s, %s)", studentAccess, groupAccess, scm.RepoPush, scm.RepoPull)
+	}
+
+	// an extension reopens the group's repository until the extended deadline
+	exam2, err := db.GetAssignment(&pb.Assignment{CourseID: course.ID, Name: "exam2"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.UpdateExtension(&pb.Extension{
+		CourseID:     course.ID,
+		AssignmentID: exam2.ID,
+		GroupID:      group.ID,
+		Deadline:     now.Add(time.Hour).Format(pb.TimeLayout),
+	}); err != nil {
+		t.Fatal(err)
+	}
+	runChecks()
+	if _, groupAccess := access(); groupAccess != scm.RepoPush {
+		t.Errorf("group access with extended exam2 = %s, want %s", groupAccess, scm.RepoPush)
+	}
+
+	// the student's repository is frozen when exam1 ends
+	exam1, err := db.GetAssignment(&pb.Assignment{CourseID: course.ID, Name: "exam1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	exam1.Deadline = now.Add(-time.Minute).Format(pb.TimeLayout)
+	if err := db.UpdateAssignments([]*pb.Assignment{exam1}); err != nil {
+		t.Fatal(err)
+	}
+	runChecks()
+	if studentAccess, _ := access(); studentAccess != scm.RepoPull {
+		t.Errorf("student access after exam1 = %s, want %s", studentAccess, scm.RepoPull)
+	}
+}
@@ -0,0 +1,132 @@
+package web
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"strings"
+
+	"github.com/360EntSecGroup-Skylar/excelize"
+	pb "github.com/autograde/quickfeed/ag"
+)
+
+const (
+	csvContentType  = "text/csv"
+	xlsxContentType = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	// resultsSheet is the name of the spreadsheet holding the exported results.
+	resultsSheet = "Results"
+)
+
+// exportResults returns a file with the results of the given course in the requested format,
+// with one row per student and a score and status column per assignment.
+// Group rows, if requested, follow the student rows and only hold group assignment results.
+func (s *AutograderService) exportResults(request *pb.ResultsExportRequest) (*pb.ResultsExport, error) {
+	course, err := s.db.GetCourse(request.GetCourseID(), false)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := s.resultsTable(course, request.GetIncludeGroups())
+	if err != nil {
+		return nil, err
+	}
+	fileName := strings.ToLower(fmt.Sprintf("%s-%d-results", course.GetCode(), course.GetYear()))
+	switch request.GetFormat() {
+	case pb.ResultsExportRequest_XLSX:
+		content, err := writeXLSX(rows)
+		if err != nil {
+			return nil, err
+		}
+		return &pb.ResultsExport{FileName: fileName + ".xlsx", ContentType: xlsxContentType, Content: content}, nil
+	default:
+		content, err := writeCSV(rows)
+		if err != nil {
+			return nil, err
+		}
+		return &pb.ResultsExport{FileName: fileName + ".csv", ContentType: csvContentType, Content: content}, nil
+	}
+}
+
+// resultsTable returns the header row followed by a row per student in the course,
+// and a row per group if includeGroups is set. Scores are left as numbers, curved if a score
+// curve has been applied, and the cells of assignments without submissions are empty.
+// Each student row ends with the number of approved assignments and whether the student
+// completes the course according to the course's pass requirement.
+func (s *AutograderService) resultsTable(course *pb.Course, includeGroups bool) ([][]interface{}, error) {
+	results, err := s.courseResults(course.GetID())
+	if err != nil {
+		return nil, err
+	}
+
+	header := []interface{}{"Name", "Student ID", "Login", "Email", "Group"}
+	for _, a := range results.GetAssignments() {
+		header = append(header, a.GetName()+" score", a.GetName()+" status")
+	}
+	header = append(header, "Approved", "Completed")
+	rows := [][]interface{}{header}
+
+	for _, resultsRow := range results.GetRows() {
+		var row []interface{}
+		if enrollment := resultsRow.GetEnrollment(); enrollment != nil {
+			user := enrollment.GetUser()
+			row = []interface{}{user.GetName(), user.GetStudentID(), user.GetLogin(), user.GetEmail(), enrollment.GetGroup().GetName()}
+		} else if includeGroups {
+			group := resultsRow.GetGroup()
+			row = []interface{}{group.GetName(), "", strings.Join(group.UserNames(), " "), "", group.GetName()}
+		} else {
+			continue
+		}
+		for _, sub := range resultsRow.GetSubmissions() {
+			if sub.GetID() > 0 {
+				row = append(row, sub.CurvedScore(), sub.GetStatus().String())
+			} else {
+				row = append(row, "", "")
+			}
+		}
+		if resultsRow.GetEnrollment() != nil {
+			completion := studentCompletion(results.GetAssignments(), resultsRow, course.GetMinApproved())
+			completed := "no"
+			if completion.GetCompleted() {
+				completed = "yes"
+			}
+			row = append(row, completion.GetApproved(), completed)
+		} else {
+			row = append(row, "", "")
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+// writeCSV returns the rows encoded as CSV.
+func writeCSV(rows [][]interface{}) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	for _, row := range rows {
+		record := make([]string, len(row))
+		for i, cell := range row {
+			record[i] = fmt.Sprint(cell)
+		}
+		if err := w.Write(record); err != nil {
+			return nil, err
+		}
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// writeXLSX returns the rows encoded as an Excel workbook with a single sheet.
+func writeXLSX(rows [][]interface{}) ([]byte, error) {
+	f := excelize.NewFile()
+	f.SetSheetName("Sheet1", resultsSheet)
+	for i := range rows {
+		f.SetSheetRow(resultsSheet, fmt.Sprintf("A%d", i+1), &rows[i])
+	}
+	buf, err := f.WriteToBuffer()
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,102 @@
+package web_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"reflect"
+	"testing"
+
+	"github.com/360EntSecGroup-Skylar/excelize"
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/ci"
+	"github.com/autograde/quickfeed/internal/qtest"
+	"github.com/autograde/quickfeed/web"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestExportResults(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	teacher := qtest.CreateFakeUser(t, db, 1)
+	course := &pb.Course{Code: "DAT101", Year: 2021, OrganizationID: 1, MinApproved: 1}
+	qtest.CreateCourse(t, db, teacher, course)
+	student1 := qtest.CreateUser(t, db, 2, &pb.User{Name: "Ann", StudentID: "101", Login: "ann", Email: "ann@example.com"})
+	qtest.EnrollStudent(t, db, student1, course)
+	student2 := qtest.CreateUser(t, db, 3, &pb.User{Name: "Bob", StudentID: "102", Login: "bob", Email: "bob@example.com"})
+	qtest.EnrollStudent(t, db, student2, course)
+	group := &pb.Group{Name: "team", CourseID: course.GetID(), Users: []*pb.User{student1, student2}}
+	if err := db.CreateGroup(group); err != nil {
+		t.Fatal(err)
+	}
+
+	labs := []*pb.Assignment{
+		{CourseID: course.GetID(), Name: "lab1", Order: 1},
+		{CourseID: course.GetID(), Name: "lab2", Order: 2, IsGroupLab: true},
+	}
+	for _, lab := range labs {
+		if err := db.CreateAssignment(lab); err != nil {
+			t.Fatal(err)
+		}
+	}
+	submissions := []*pb.Submission{
+		{AssignmentID: labs[0].GetID(), UserID: student1.GetID(), Score: 40},
+		{AssignmentID: labs[0].GetID(), UserID: student1.GetID(), Score: 90, Status: pb.Submission_APPROVED},
+		{AssignmentID: labs[1].GetID(), GroupID: group.GetID(), Score: 70, Status: pb.Submission_REVISION},
+	}
+	for _, submission := range submissions {
+		if err := db.CreateSubmission(submission); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	_, scms := qtest.FakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	client := accessControlled(t, ags)
+	ctx := withUserContext(context.Background(), teacher)
+
+	if _, err := client.ExportResults(withUserContext(context.Background(), student1), &pb.ResultsExportRequest{CourseID: course.GetID()}); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("ExportResults() by student: got %v, want %v", err, codes.PermissionDenied)
+	}
+
+	want := [][]string{
+		{"Name", "Student ID", "Login", "Email", "Group", "lab1 score", "lab1 status", "lab2 score", "lab2 status", "Approved", "Completed"},
+		{"Ann", "101", "ann", "ann@example.com", "team", "90", "APPROVED", "70", "REVISION", "1", "yes"},
+		{"Bob", "102", "bob", "bob@example.com", "team", "", "", "70", "REVISION", "0", "no"},
+		{"team", "", "ann bob", "", "team", "", "", "70", "REVISION", "", ""},
+	}
+
+	export, err := ags.ExportResults(ctx, &pb.ResultsExportRequest{CourseID: course.GetID(), IncludeGroups: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if export.GetFileName() != "dat101-2021-results.csv" || export.GetContentType() != "text/csv" {
+		t.Errorf("ExportResults() = file %q of type %q, want dat101-2021-results.csv of type text/csv", export.GetFileName(), export.GetContentType())
+	}
+	rows, err := csv.NewReader(bytes.NewReader(export.GetContent())).ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(rows, want) {
+		t.Errorf("ExportResults() CSV rows = %q, want %q", rows, want)
+	}
+
+	// without group rows, exported as an Excel workbook
+	export, err = ags.ExportResults(ctx, &pb.ResultsExportRequest{CourseID: course.GetID(), Format: pb.ResultsExportRequest_XLSX})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if export.GetFileName() != "dat101-2021-results.xlsx" {
+		t.Errorf("ExportResults() file = %q, want dat101-2021-results.xlsx", export.GetFileName())
+	}
+	f, err := excelize.OpenReader(bytes.NewReader(export.GetContent()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rows := f.GetRows("Results"); !reflect.DeepEqual(rows, want[:3]) {
+		t.Errorf("ExportResults() XLSX rows = %q, want %q", rows, want[:3])
+	}
+}
@@ -0,0 +1,120 @@
+package web
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/notify"
+	"github.com/autograde/quickfeed/scm"
+)
+
+// SecretRepos returns the course repositories that students must not have access to.
+func SecretRepos(course *pb.Course) []string {
+	return []string{course.TestsRepoName()}
+}
+
+// auditTestsExposure checks that no team other than the teachers team, and no user other
+// than the course teachers and teaching assistants, has access to the course's secret repositories, such as the
+// tests repository. If revoke is true, the access of the violating teams and users is revoked.
+func (s *AutograderService) auditTestsExposure(ctx context.Context, sc scm.SCM, course *pb.Course, revoke bool) (*pb.ExposureReport, error) {
+	teachers, err := s.db.GetEnrollmentsByCourse(course.GetID(), pb.Enrollment_TEACHER, pb.Enrollment_TA)
+	if err != nil {
+		return nil, err
+	}
+	isTeacher := make(map[string]bool)
+	for _, teacher := range teachers {
+		isTeacher[teacher.GetUser().GetLogin()] = true
+	}
+
+	report := &pb.ExposureReport{
+		CourseID:  course.GetID(),
+		CheckedAt: time.Now().Format(pb.TimeLayout),
+	}
+	for _, path := range SecretRepos(course) {
+		access, err := sc.GetRepositoryAccess(ctx, &scm.RepositoryOptions{Owner: course.GetOrganizationPath(), Path: path})
+		if err != nil {
+			return nil, err
+		}
+		for _, team := range access.Teams {
+			if team.Name == scm.TeachersTeam {
+				continue
+			}
+			violation := &pb.AccessViolation{Repository: path, Team: team.Name, Permission: team.Permission}
+			if revoke {
+				if err := sc.RemoveTeamRepo(ctx, &scm.RemoveTeamRepoOptions{
+					OrganizationID: course.GetOrganizationID(),
+					TeamID:         team.ID,
+					Owner:          course.GetOrganizationPath(),
+					Repo:           path,
+				}); err != nil {
+					s.logger.Errorf("Failed to revoke team %s access to %s: %v", team.Name, path, err)
+				} else {
+					violation.Revoked = true
+				}
+			}
+			report.Violations = append(report.Violations, violation)
+		}
+		for _, user := range access.Collaborators {
+			if isTeacher[user.Name] {
+				continue
+			}
+			violation := &pb.AccessViolation{Repository: path, User: user.Name, Permission: user.Permission}
+			if revoke {
+				if err := sc.RemoveRepoCollaborator(ctx, &scm.Repository{Owner: course.GetOrganizationPath(), Path: path}, user.Name); err != nil {
+					s.logger.Errorf("Failed to revoke user %s access to %s: %v", user.Name, path, err)
+				} else {
+					violation.Revoked = true
+				}
+			}
+			report.Violations = append(report.Violations, violation)
+		}
+	}
+	sort.Slice(report.Violations, func(i, j int) bool {
+		vi, vj := report.Violations[i], report.Violations[j]
+		if vi.GetRepository() != vj.GetRepository() {
+			return vi.GetRepository() < vj.GetRepository()
+		}
+		if vi.GetTeam() != vj.GetTeam() {
+			return vi.GetTeam() > vj.GetTeam() // teams before users
+		}
+		return vi.GetUser() < vj.GetUser()
+	})
+	return report, nil
+}
+
+// reportTestsExposure logs the violations found by the tests exposure audit and notifies the course teachers.
+func (s *AutograderService) reportTestsExposure(ctx context.Context, course *pb.Course, report *pb.ExposureReport) {
+	var violations []string
+	for _, v := range report.GetViolations() {
+		who := "team " + v.GetTeam()
+		if v.GetUser() != "" {
+			who = "user " + v.GetUser()
+		}
+		violation := fmt.Sprintf("%s has %s access to %s", who, v.GetPermission(), v.GetRepository())
+		if v.GetRevoked() {
+			violation += " (revoked)"
+		}
+		violations = append(violations, violation)
+	}
+	s.logger.Warnf("Tests exposure audit found violations in course %s: %s", course.GetCode(), strings.Join(violations, "; "))
+
+	teachers, err := s.db.GetEnrollmentsByCourse(course.GetID(), pb.Enrollment_TEACHER)
+	if err != nil {
+		s.logger.Errorf("Failed to notify teachers about tests exposure in course %s: %v", course.GetCode(), err)
+		return
+	}
+	var teacherIDs []uint64
+	for _, teacher := range teachers {
+		teacherIDs = append(teacherIDs, teacher.GetUserID())
+	}
+	s.notifier.Dispatch(ctx, &notify.Notification{
+		CourseID: course.GetID(),
+		UserIDs:  teacherIDs,
+		Subject:  fmt.Sprintf("Tests repository exposed in %s", course.GetCode()),
+		Body:     strings.Join(violations, "\n"),
+	})
+}
@@ -0,0 +1,98 @@
+package web_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/ci"
+	"github.com/autograde/quickfeed/internal/qtest"
+	"github.com/autograde/quickfeed/scm"
+	"github.com/autograde/quickfeed/web"
+)
+
+func TestAuditTestsExposure(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	fakeGothProvider()
+	teacher := qtest.CreateUser(t, db, 1, &pb.User{Login: "teacher"})
+	ctx := withUserContext(context.Background(), teacher)
+	fakeProvider, scms := qtest.FakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	client := accessControlled(t, ags)
+	org, err := fakeProvider.CreateOrganization(ctx, &scm.OrganizationOptions{Path: "path", Name: "name"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	course, err := ags.CreateCourse(ctx, allCourses[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	student := qtest.CreateUser(t, db, 2, &pb.User{Login: "student"})
+	qtest.EnrollStudent(t, db, student, course)
+
+	// expose the tests repository to the students team and a student
+	fake := fakeProvider.(*scm.FakeSCM)
+	teams, err := fake.GetTeams(ctx, org)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, team := range teams {
+		if err := fake.AddTeamRepo(ctx, &scm.AddTeamRepoOptions{
+			TeamID: team.ID, OrganizationID: org.ID, Owner: org.Path, Repo: pb.TestsRepo, Permission: scm.RepoPull,
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	testsRepo := &scm.Repository{Owner: org.Path, Path: pb.TestsRepo}
+	if err := fake.UpdateRepoAccess(ctx, testsRepo, teacher.GetLogin(), scm.RepoFull); err != nil {
+		t.Fatal(err)
+	}
+	if err := fake.UpdateRepoAccess(ctx, testsRepo, student.GetLogin(), scm.RepoPush); err != nil {
+		t.Fatal(err)
+	}
+
+	report, err := ags.AuditTestsExposure(ctx, &pb.ExposureAuditRequest{CourseID: course.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*pb.AccessViolation{
+		{Repository: pb.TestsRepo, Team: scm.StudentsTeam, Permission: scm.RepoPull},
+		{Repository: pb.TestsRepo, User: student.GetLogin(), Permission: scm.RepoPush},
+	}
+	if diff := cmp.Diff(want, report.GetViolations(), protocmp.Transform()); diff != "" {
+		t.Errorf("AuditTestsExposure() mismatch (-want +got):\n%s", diff)
+	}
+
+	report, err = ags.AuditTestsExposure(ctx, &pb.ExposureAuditRequest{CourseID: course.ID, Revoke: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, violation := range want {
+		violation.Revoked = true
+	}
+	if diff := cmp.Diff(want, report.GetViolations(), protocmp.Transform()); diff != "" {
+		t.Errorf("AuditTestsExposure(revoke) mismatch (-want +got):\n%s", diff)
+	}
+
+	report, err = ags.AuditTestsExposure(ctx, &pb.ExposureAuditRequest{CourseID: course.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(report.GetViolations()) > 0 {
+		t.Errorf("AuditTestsExposure() after revoke: got %v, want no violations", report.GetViolations())
+	}
+
+	studentCtx := withUserContext(context.Background(), student)
+	_, err = client.AuditTestsExposure(studentCtx, &pb.ExposureAuditRequest{CourseID: course.ID})
+	if status.Code(err) != codes.PermissionDenied {
+		t.Errorf("AuditTestsExposure() for student: got %v, want %v", err, codes.PermissionDenied)
+	}
+}
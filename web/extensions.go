@@ -0,0 +1,99 @@
+package web
+
+import (
+	"fmt"
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+)
+
+// grantExtension grants the student or group in the request an extended deadline for the assignment.
+// The extended deadline is in the course's time zone, unless given in RFC3339 format, and is stored in UTC.
+// It must be later than the assignment's deadline. Group assignments can only be extended for groups,
+// and individual assignments only for students enrolled in the course.
+func (s *AutograderService) grantExtension(usr *pb.User, request *pb.Extension) (*pb.Extension, error) {
+	assignment, err := s.getAssignmentInCourse(request.GetAssignmentID(), request.GetCourseID())
+	if err != nil {
+		return nil, err
+	}
+	course, err := s.db.GetCourse(request.GetCourseID(), false)
+	if err != nil {
+		return nil, err
+	}
+	deadline, err := pb.ParseDeadline(request.GetDeadline(), course.Location())
+	if err != nil {
+		return nil, fmt.Errorf("invalid deadline %q: %w", request.GetDeadline(), err)
+	}
+	if sinceDeadline, err := assignment.SinceDeadline(deadline); err != nil || sinceDeadline <= 0 {
+		return nil, fmt.Errorf("extended deadline %s is not after the deadline of assignment %d", request.GetDeadline(), assignment.GetID())
+	}
+	if assignment.GetIsGroupLab() {
+		group, err := s.db.GetGroup(request.GetGroupID())
+		if err != nil {
+			return nil, err
+		}
+		if group.GetCourseID() != request.GetCourseID() {
+			return nil, fmt.Errorf("group %d does not belong to course %d", group.GetID(), request.GetCourseID())
+		}
+	} else if !s.hasCourseAccess(request.GetUserID(), request.GetCourseID(), func(e *pb.Enrollment) bool {
+		return e.Status == pb.Enrollment_STUDENT
+	}) {
+		return nil, fmt.Errorf("user %d is not student in course %d", request.GetUserID(), request.GetCourseID())
+	}
+
+	extension := &pb.Extension{
+		CourseID:     request.GetCourseID(),
+		AssignmentID: assignment.GetID(),
+		UserID:       request.GetUserID(),
+		GroupID:      request.GetGroupID(),
+		Deadline:     deadline.UTC().Format(pb.TimeLayout),
+		Reason:       request.GetReason(),
+		GrantedByID:  usr.GetID(),
+		Granted:      time.Now().Format(pb.TimeLayout),
+	}
+	if err := s.db.UpdateExtension(extension); err != nil {
+		return nil, err
+	}
+	s.audit(usr, extension.GetCourseID(), "grant extension",
+		"course", extension.GetCourseID(),
+		"assignment", assignment.GetName(),
+		"userID", extension.GetUserID(),
+		"groupID", extension.GetGroupID(),
+		"deadline", extension.GetDeadline(),
+	)
+	return extension, nil
+}
+
+// listExtensions returns the extensions granted in the given course, optionally only for the
+// given assignment. Teaching staff get all extensions, while students only get the extensions
+// granted to themselves and to their group.
+func (s *AutograderService) listExtensions(usr *pb.User, request *pb.ExtensionRequest) (*pb.Extensions, error) {
+	query := &pb.Extension{CourseID: request.GetCourseID(), AssignmentID: request.GetAssignmentID()}
+	if s.isTeachingStaff(usr.GetID(), request.GetCourseID()) {
+		extensions, err := s.db.GetExtensions(query)
+		if err != nil {
+			return nil, err
+		}
+		return &pb.Extensions{Extensions: extensions}, nil
+	}
+
+	enrollment, err := s.db.GetEnrollmentByCourseAndUser(request.GetCourseID(), usr.GetID())
+	if err != nil {
+		return nil, err
+	}
+	query.UserID = usr.GetID()
+	extensions, err := s.db.GetExtensions(query)
+	if err != nil {
+		return nil, err
+	}
+	if enrollment.GetGroupID() > 0 {
+		query.UserID = 0
+		query.GroupID = enrollment.GetGroupID()
+		groupExtensions, err := s.db.GetExtensions(query)
+		if err != nil {
+			return nil, err
+		}
+		extensions = append(extensions, groupExtensions...)
+	}
+	return &pb.Extensions{Extensions: extensions}, nil
+}
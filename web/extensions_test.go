@@ -0,0 +1,79 @@
+package web_test
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/ci"
+	"github.com/autograde/quickfeed/internal/qtest"
+	"github.com/autograde/quickfeed/web"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestExtensions(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	teacher := qtest.CreateFakeUser(t, db, 1)
+	course := &pb.Course{Code: "DAT101", OrganizationID: 1, Timezone: "UTC"}
+	qtest.CreateCourse(t, db, teacher, course)
+	students := qtest.PopulateCourse(t, db, course, 2, 1)
+	_, scms := qtest.FakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	client := accessControlled(t, ags)
+	teacherCtx := withUserContext(context.Background(), teacher)
+	studentCtx := withUserContext(context.Background(), students[0])
+
+	// the deadline of lab1 is 2021-01-01T00:00:00
+	extension := &pb.Extension{CourseID: course.GetID(), AssignmentID: 1, UserID: students[0].GetID(), Deadline: "2021-01-03T12:00:00"}
+	if _, err := client.GrantExtension(studentCtx, extension); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("GrantExtension() by student = %v, want code %v", err, codes.PermissionDenied)
+	}
+	earlier := &pb.Extension{CourseID: course.GetID(), AssignmentID: 1, UserID: students[0].GetID(), Deadline: "2020-12-31T00:00:00"}
+	if _, err := ags.GrantExtension(teacherCtx, earlier); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("GrantExtension() with earlier deadline = %v, want code %v", err, codes.InvalidArgument)
+	}
+	stranger := qtest.CreateFakeUser(t, db, 99)
+	notEnrolled := &pb.Extension{CourseID: course.GetID(), AssignmentID: 1, UserID: stranger.GetID(), Deadline: "2021-01-03T12:00:00"}
+	if _, err := ags.GrantExtension(teacherCtx, notEnrolled); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("GrantExtension() for user not enrolled = %v, want code %v", err, codes.InvalidArgument)
+	}
+
+	// deadlines with an offset from UTC are stored in UTC
+	for _, deadline := range []string{"2021-01-02T12:00:00", "2021-01-03 12:00", "2021-01-03T13:00:00+01:00"} {
+		extension.Deadline = deadline
+		granted, err := ags.GrantExtension(teacherCtx, extension)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if granted.GetGrantedByID() != teacher.GetID() {
+			t.Errorf("GrantExtension() granted by %d, want %d", granted.GetGrantedByID(), teacher.GetID())
+		}
+	}
+	other := &pb.Extension{CourseID: course.GetID(), AssignmentID: 1, UserID: students[1].GetID(), Deadline: "2021-01-02T00:00:00"}
+	if _, err := ags.GrantExtension(teacherCtx, other); err != nil {
+		t.Fatal(err)
+	}
+
+	// granting the first student a new deadline replaced the previous extension
+	all, err := ags.ListExtensions(teacherCtx, &pb.ExtensionRequest{CourseID: course.GetID()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(all.GetExtensions()) != 2 {
+		t.Errorf("ListExtensions() for teacher returned %d extensions, want 2", len(all.GetExtensions()))
+	}
+	own, err := ags.ListExtensions(studentCtx, &pb.ExtensionRequest{CourseID: course.GetID(), AssignmentID: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(own.GetExtensions()) != 1 || own.GetExtensions()[0].GetDeadline() != "2021-01-03T12:00:00" {
+		t.Errorf("ListExtensions() for student = %v, want the student's extension until 2021-01-03T12:00:00", own.GetExtensions())
+	}
+	if _, err := client.ListExtensions(withUserContext(context.Background(), stranger), &pb.ExtensionRequest{CourseID: course.GetID()}); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("ListExtensions() for user not enrolled = %v, want code %v", err, codes.PermissionDenied)
+	}
+}
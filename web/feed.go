@@ -0,0 +1,168 @@
+package web
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/web/auth"
+	"github.com/labstack/echo/v4"
+)
+
+// atomFeed is an Atom feed as defined by RFC 4287.
+type atomFeed struct {
+	XMLName xml.Name     `xml:"http://www.w3.org/2005/Atom feed"`
+	ID      string       `xml:"id"`
+	Title   string       `xml:"title"`
+	Updated string       `xml:"updated"`
+	Entries []*atomEntry `xml:"entry"`
+}
+
+type atomEntry struct {
+	ID      string      `xml:"id"`
+	Title   string      `xml:"title"`
+	Updated string      `xml:"updated"`
+	Author  *atomAuthor `xml:"author,omitempty"`
+	Content string      `xml:"content"`
+	updated time.Time
+}
+
+type atomAuthor struct {
+	Name string `xml:"name"`
+}
+
+// feedTime returns the given QuickFeed timestamp formatted for use in an Atom feed.
+func feedTime(timestamp string) (time.Time, string) {
+	t, err := time.ParseInLocation(pb.TimeLayout, timestamp, time.Local)
+	if err != nil {
+		// should not happen for timestamps recorded by QuickFeed
+		t = time.Time{}
+	}
+	return t, t.Format(time.RFC3339)
+}
+
+// feedToken returns the token granting the given user access to the course feed.
+// Feed readers cannot log in, so the token is included in the feed URL instead.
+func (s *AutograderService) feedToken(userID, courseID uint64) string {
+	mac := hmac.New(sha256.New, []byte(s.feedSecret))
+	fmt.Fprintf(mac, "%d/%d", userID, courseID)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// getCourseFeedURL returns the URL of the course feed for the given user.
+func (s *AutograderService) getCourseFeedURL(userID, courseID uint64) *pb.FeedURL {
+	query := url.Values{}
+	query.Set("user", strconv.FormatUint(userID, 10))
+	query.Set("token", s.feedToken(userID, courseID))
+	return &pb.FeedURL{
+		URL: fmt.Sprintf("https://%s/feed/%d?%s", s.bh.BaseURL, courseID, query.Encode()),
+	}
+}
+
+// courseFeed returns an Atom feed with the course's announcements and events,
+// such as assignment releases and deadline changes, newest first.
+func (s *AutograderService) courseFeed(courseID uint64) (*atomFeed, error) {
+	course, err := s.db.GetCourse(courseID, false)
+	if err != nil {
+		return nil, err
+	}
+	announcements, err := s.db.GetAnnouncements(courseID, 0)
+	if err != nil {
+		return nil, err
+	}
+	events, err := s.db.GetCourseEvents(courseID)
+	if err != nil {
+		return nil, err
+	}
+
+	feedID := fmt.Sprintf("https://%s/feed/%d", s.bh.BaseURL, courseID)
+	var entries []*atomEntry
+	for _, announcement := range announcements {
+		timestamp := announcement.GetCreated()
+		if announcement.GetUpdated() != "" {
+			timestamp = announcement.GetUpdated()
+		}
+		t, updated := feedTime(timestamp)
+		entry := &atomEntry{
+			ID:      fmt.Sprintf("%s/announcements/%d", feedID, announcement.GetID()),
+			Title:   announcement.GetTitle(),
+			Updated: updated,
+			Content: announcement.GetBody(),
+			updated: t,
+		}
+		if author, err := s.db.GetUser(announcement.GetAuthorID()); err == nil {
+			entry.Author = &atomAuthor{Name: author.GetName()}
+		}
+		entries = append(entries, entry)
+	}
+	for _, event := range events {
+		t, updated := feedTime(event.GetCreated())
+		entries = append(entries, &atomEntry{
+			ID:      fmt.Sprintf("%s/events/%d", feedID, event.GetID()),
+			Title:   event.GetTitle(),
+			Updated: updated,
+			Content: event.GetDescription(),
+			updated: t,
+		})
+	}
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].updated.After(entries[j].updated)
+	})
+
+	feed := &atomFeed{
+		ID:      feedID,
+		Title:   fmt.Sprintf("%s %s (%d)", course.GetCode(), course.GetName(), course.GetYear()),
+		Updated: time.Now().Format(time.RFC3339),
+		Entries: entries,
+	}
+	if len(entries) > 0 {
+		feed.Updated = entries[0].Updated
+	}
+	return feed, nil
+}
+
+// feedUserID returns the ID of the user requesting the course feed. The user is
+// authenticated either by the user session or by the feed token in the request.
+func (s *AutograderService) feedUserID(c echo.Context, courseID uint64) (uint64, bool) {
+	if user, ok := c.Get(auth.UserKey).(*pb.User); ok {
+		return user.GetID(), true
+	}
+	userID, err := strconv.ParseUint(c.QueryParam("user"), 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	token := c.QueryParam("token")
+	return userID, hmac.Equal([]byte(token), []byte(s.feedToken(userID, courseID)))
+}
+
+// CourseFeed returns a handler serving the course's Atom feed to enrolled users.
+func (s *AutograderService) CourseFeed() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		courseID, err := strconv.ParseUint(c.Param("course"), 10, 64)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, "invalid course ID")
+		}
+		userID, ok := s.feedUserID(c, courseID)
+		if !ok || !s.isEnrolled(userID, courseID) {
+			return echo.ErrUnauthorized
+		}
+		feed, err := s.courseFeed(courseID)
+		if err != nil {
+			s.logger.Errorf("CourseFeed failed for course %d: %v", courseID, err)
+			return echo.ErrInternalServerError
+		}
+		out, err := xml.MarshalIndent(feed, "", "  ")
+		if err != nil {
+			return err
+		}
+		return c.Blob(http.StatusOK, "application/atom+xml; charset=utf-8", append([]byte(xml.Header), out...))
+	}
+}
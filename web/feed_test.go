@@ -0,0 +1,76 @@
+package web_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/ci"
+	"github.com/autograde/quickfeed/internal/qtest"
+	"github.com/autograde/quickfeed/web"
+)
+
+func TestCourseFeed(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	teacher := qtest.CreateFakeUser(t, db, 1)
+	course := &pb.Course{Code: "DAT320", Name: "Operating Systems"}
+	qtest.CreateCourse(t, db, teacher, course)
+	student := qtest.CreateFakeUser(t, db, 2)
+	qtest.EnrollStudent(t, db, student, course)
+
+	_, scms := qtest.FakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{BaseURL: "example.com"}, &ci.Local{})
+
+	ctx := withUserContext(context.Background(), teacher)
+	if _, err := ags.CreateAnnouncement(ctx, &pb.Announcement{CourseID: course.ID, Title: "Welcome", Body: "Welcome to the course"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.CreateCourseEvent(&pb.CourseEvent{
+		CourseID: course.ID,
+		Type:     pb.CourseEvent_ASSIGNMENT_RELEASED,
+		Title:    "Assignment lab1 released",
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	feedURL, err := ags.GetCourseFeedURL(withUserContext(context.Background(), student), &pb.CourseRequest{CourseID: course.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	u, err := url.Parse(feedURL.GetURL())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	e := echo.New()
+	e.GET("/feed/:course", ags.CourseFeed())
+
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, u.RequestURI(), nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("CourseFeed() status = %d, want %d", rec.Code, http.StatusOK)
+	}
+	for _, want := range []string{"Welcome to the course", "Assignment lab1 released", "DAT320"} {
+		if !strings.Contains(rec.Body.String(), want) {
+			t.Errorf("CourseFeed() missing %q in feed:\n%s", want, rec.Body.String())
+		}
+	}
+
+	query := u.Query()
+	query.Set("token", "invalid")
+	u.RawQuery = query.Encode()
+	rec = httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, u.RequestURI(), nil))
+	if rec.Code != http.StatusUnauthorized {
+		t.Errorf("CourseFeed() with invalid token status = %d, want %d", rec.Code, http.StatusUnauthorized)
+	}
+}
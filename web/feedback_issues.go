@@ -0,0 +1,112 @@
+package web
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/scm"
+)
+
+// publishFeedbackIssue publishes the released review of the given submission as an issue in the
+// repository of the submission's student or group, if the assignment publishes feedback issues and
+// the submission has been approved or rejected. The review is only published once for each submission.
+func (s *AutograderService) publishFeedbackIssue(ctx context.Context, courseID uint64, submission *pb.Submission) error {
+	if !submission.GetReleased() || submission.GetFeedbackIssueURL() != "" {
+		return nil
+	}
+	if status := submission.GetStatus(); status != pb.Submission_APPROVED && status != pb.Submission_REJECTED {
+		return nil
+	}
+	assignment, err := s.db.GetAssignment(&pb.Assignment{ID: submission.GetAssignmentID()})
+	if err != nil || !assignment.GetFeedbackIssue() {
+		return err
+	}
+	course, err := s.db.GetCourse(courseID, false)
+	if err != nil {
+		return err
+	}
+	repo, err := s.submissionRepository(course, submission)
+	if err != nil {
+		return err
+	}
+	sc, err := s.getCourseSCM(course)
+	if err != nil {
+		return err
+	}
+	url, err := sc.CreateIssue(ctx, &scm.IssueOptions{
+		Owner:      course.GetOrganizationPath(),
+		Repository: path.Base(repo.GetHTMLURL()),
+		Title:      fmt.Sprintf("Feedback on %s: %s", assignment.GetName(), statusName(submission.GetStatus())),
+		Body:       feedbackIssueBody(submission),
+	})
+	if err != nil {
+		return err
+	}
+	submission.FeedbackIssueURL = url
+	return s.db.UpdateSubmission(submission)
+}
+
+// feedbackIssueBody returns the markdown body of the feedback issue of the given submission,
+// with the feedback and rubric breakdown of each of its ready reviews. The reviewers are not named.
+func feedbackIssueBody(submission *pb.Submission) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "**Status:** %s\n", statusName(submission.GetStatus()))
+	fmt.Fprintf(&b, "**Score:** %d\n", submission.GetScore())
+	n := 0
+	for _, review := range submission.GetReviews() {
+		if !review.GetReady() {
+			continue
+		}
+		n++
+		fmt.Fprintf(&b, "\n## Review %d\n\n", n)
+		fmt.Fprintf(&b, "**Score:** %d\n", review.GetScore())
+		if feedback := review.GetFeedback(); feedback != "" {
+			fmt.Fprintf(&b, "\n%s\n", feedback)
+		}
+		for _, bm := range review.GetGradingBenchmarks() {
+			fmt.Fprintf(&b, "\n### %s\n\n", bm.GetHeading())
+			b.WriteString("| Criterion | Grade | Points | Comment |\n")
+			b.WriteString("|-----------|-------|--------|---------|\n")
+			for _, c := range bm.GetCriteria() {
+				fmt.Fprintf(&b, "| %s | %s | %d | %s |\n", tableCell(c.GetDescription()), gradeName(c.GetGrade()), c.GetPoints(), tableCell(c.GetComment()))
+			}
+			if comment := bm.GetComment(); comment != "" {
+				fmt.Fprintf(&b, "\n%s\n", comment)
+			}
+		}
+	}
+	return b.String()
+}
+
+// statusName returns the name of the given submission status as shown to students.
+func statusName(status pb.Submission_Status) string {
+	switch status {
+	case pb.Submission_APPROVED:
+		return "Approved"
+	case pb.Submission_REJECTED:
+		return "Rejected"
+	case pb.Submission_REVISION:
+		return "Revision"
+	}
+	return "None"
+}
+
+// gradeName returns the name of the given criterion grade as shown to students.
+func gradeName(grade pb.GradingCriterion_Grade) string {
+	switch grade {
+	case pb.GradingCriterion_PASSED:
+		return "Passed"
+	case pb.GradingCriterion_FAILED:
+		return "Failed"
+	}
+	return "-"
+}
+
+// tableCell returns the given text escaped for a cell of a markdown table.
+func tableCell(text string) string {
+	text = strings.ReplaceAll(text, "|", "\\|")
+	return strings.Join(strings.Fields(text), " ")
+}
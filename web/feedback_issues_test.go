@@ -0,0 +1,112 @@
+package web_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"go.uber.org/zap"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/ci"
+	"github.com/autograde/quickfeed/internal/qtest"
+	"github.com/autograde/quickfeed/scm"
+	"github.com/autograde/quickfeed/web"
+)
+
+func TestPublishFeedbackIssue(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	teacher := qtest.CreateFakeUser(t, db, 1)
+	course := &pb.Course{Provider: "fake", OrganizationID: 1, OrganizationPath: "test"}
+	qtest.CreateCourse(t, db, teacher, course)
+	student := qtest.CreateFakeUser(t, db, 2)
+	qtest.EnrollStudent(t, db, student, course)
+	if err := db.CreateRepository(&pb.Repository{
+		OrganizationID: course.OrganizationID,
+		RepositoryID:   10,
+		UserID:         student.ID,
+		RepoType:       pb.Repository_USER,
+		HTMLURL:        "https://example.com/test/student-labs",
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	lab1 := &pb.Assignment{CourseID: course.ID, Name: "lab1", Order: 1, Reviewers: 1, FeedbackIssue: true}
+	lab2 := &pb.Assignment{CourseID: course.ID, Name: "lab2", Order: 2, Reviewers: 1}
+	var submissions []*pb.Submission
+	for _, assignment := range []*pb.Assignment{lab1, lab2} {
+		if err := db.CreateAssignment(assignment); err != nil {
+			t.Fatal(err)
+		}
+		submission := &pb.Submission{AssignmentID: assignment.ID, UserID: student.ID, Score: 75}
+		if err := db.CreateSubmission(submission); err != nil {
+			t.Fatal(err)
+		}
+		if err := db.CreateReview(&pb.Review{
+			SubmissionID: submission.ID,
+			ReviewerID:   teacher.ID,
+			Ready:        true,
+			Score:        75,
+			Feedback:     "Good work overall.",
+			GradingBenchmarks: []*pb.GradingBenchmark{{
+				Heading: "Code quality",
+				Criteria: []*pb.GradingCriterion{
+					{Description: "Functions are documented", Points: 5, Grade: pb.GradingCriterion_PASSED},
+					{Description: "No global | state", Points: 5, Grade: pb.GradingCriterion_FAILED, Comment: "counter is global"},
+				},
+			}},
+		}); err != nil {
+			t.Fatal(err)
+		}
+		submissions = append(submissions, submission)
+	}
+
+	_, scms := qtest.FakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	sc, err := scms.GetOrCreateSCMEntry(zap.NewNop(), "fake", course.GetAccessToken())
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := withUserContext(context.Background(), teacher)
+
+	// the review is not published before the submission is both released and approved or rejected
+	updates := []*pb.UpdateSubmissionRequest{
+		{SubmissionID: submissions[0].ID, CourseID: course.ID, Status: pb.Submission_APPROVED},
+		{SubmissionID: submissions[0].ID, CourseID: course.ID, Status: pb.Submission_REVISION, Released: true},
+		{SubmissionID: submissions[0].ID, CourseID: course.ID, Status: pb.Submission_APPROVED, Released: true},
+		{SubmissionID: submissions[0].ID, CourseID: course.ID, Status: pb.Submission_APPROVED, Released: true},
+		{SubmissionID: submissions[1].ID, CourseID: course.ID, Status: pb.Submission_REJECTED, Released: true},
+	}
+	for _, update := range updates {
+		if _, err := ags.UpdateSubmission(ctx, update); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	issues := sc.(*scm.FakeSCM).Issues["test/student-labs"]
+	if len(issues) != 1 {
+		t.Fatalf("got %d feedback issues, want 1", len(issues))
+	}
+	if want := "Feedback on lab1: Approved"; issues[0].Title != want {
+		t.Errorf("feedback issue title = %q, want %q", issues[0].Title, want)
+	}
+	for _, want := range []string{
+		"Good work overall.",
+		"### Code quality",
+		"| Functions are documented | Passed | 5 |  |",
+		`| No global \| state | Failed | 5 | counter is global |`,
+	} {
+		if !strings.Contains(issues[0].Body, want) {
+			t.Errorf("feedback issue body missing %q:\n%s", want, issues[0].Body)
+		}
+	}
+	submission, err := db.GetSubmission(&pb.Submission{ID: submissions[0].ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if submission.GetFeedbackIssueURL() == "" {
+		t.Error("submission has no feedback issue URL, want the published issue's URL")
+	}
+}
@@ -0,0 +1,55 @@
+package web
+
+import (
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+)
+
+// computeFinalGrades computes the final grades for all students in the given course
+// according to the course's assignment weights, drop-lowest rule, pass requirement
+// and grading scale. The final grades are not stored; they are meant to be reviewed
+// by teachers before export.
+func (s *AutograderService) computeFinalGrades(courseID uint64) (*pb.FinalGrades, error) {
+	course, err := s.db.GetCourse(courseID, false)
+	if err != nil {
+		return nil, err
+	}
+	assignments, err := s.db.GetAssignmentsWithSubmissions(courseID, pb.SubmissionsForCourseRequest_ALL, false)
+	if err != nil {
+		return nil, err
+	}
+	scale, err := s.getGradingScale(courseID)
+	if err != nil {
+		return nil, err
+	}
+	enrollments, err := s.db.GetEnrollmentsByCourse(courseID, pb.Enrollment_STUDENT)
+	if err != nil {
+		return nil, err
+	}
+
+	finalGrades := &pb.FinalGrades{
+		CourseID:   courseID,
+		ComputedAt: time.Now().Format(pb.TimeLayout),
+	}
+	for _, enrollment := range enrollments {
+		submissions := make(map[uint64]*pb.Submission)
+		for _, a := range assignments {
+			for _, sub := range a.GetSubmissions() {
+				owned := sub.GetUserID() == enrollment.GetUserID()
+				if a.GetIsGroupLab() {
+					owned = enrollment.GetGroupID() > 0 && sub.GetGroupID() == enrollment.GetGroupID()
+				}
+				// keep the most recent submission
+				if owned && sub.GetID() > submissions[a.GetID()].GetID() {
+					submissions[a.GetID()] = sub
+				}
+			}
+		}
+		finalGrade := pb.ComputeFinalGrade(assignments, submissions, course.GetDropLowest(), course.GetMinApproved(), scale)
+		finalGrade.UserID = enrollment.GetUserID()
+		finalGrade.User = enrollment.GetUser()
+		finalGrades.Grades = append(finalGrades.Grades, finalGrade)
+	}
+	return finalGrades, nil
+}
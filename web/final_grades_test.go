@@ -0,0 +1,82 @@
+package web_test
+
+import (
+	"context"
+	"testing"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/ci"
+	"github.com/autograde/quickfeed/internal/qtest"
+	"github.com/autograde/quickfeed/web"
+)
+
+func TestComputeFinalGrades(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	teacher := qtest.CreateFakeUser(t, db, 1)
+	course := &pb.Course{DropLowest: 1}
+	qtest.CreateCourse(t, db, teacher, course)
+	student1 := qtest.CreateFakeUser(t, db, 2)
+	qtest.EnrollStudent(t, db, student1, course)
+	student2 := qtest.CreateFakeUser(t, db, 3)
+	qtest.EnrollStudent(t, db, student2, course)
+
+	labs := []*pb.Assignment{
+		{CourseID: course.ID, Name: "lab1", Order: 1},
+		{CourseID: course.ID, Name: "lab2", Order: 2},
+		{CourseID: course.ID, Name: "lab3", Order: 3, Mandatory: true},
+	}
+	for _, lab := range labs {
+		if err := db.CreateAssignment(lab); err != nil {
+			t.Fatal(err)
+		}
+	}
+	submissions := []*pb.Submission{
+		{AssignmentID: labs[0].ID, UserID: student1.ID, Score: 20},
+		{AssignmentID: labs[1].ID, UserID: student1.ID, Score: 90},
+		{AssignmentID: labs[2].ID, UserID: student1.ID, Score: 100, Status: pb.Submission_APPROVED},
+		{AssignmentID: labs[0].ID, UserID: student2.ID, Score: 100},
+		{AssignmentID: labs[2].ID, UserID: student2.ID, Score: 50},
+	}
+	for _, submission := range submissions {
+		if err := db.CreateSubmission(submission); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	_, scms := qtest.FakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	client := accessControlled(t, ags)
+
+	if _, err := client.ComputeFinalGrades(withUserContext(context.Background(), student1), &pb.CourseRequest{CourseID: course.ID}); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("ComputeFinalGrades() by student: got %v, want %v", err, codes.PermissionDenied)
+	}
+	finalGrades, err := ags.ComputeFinalGrades(withUserContext(context.Background(), teacher), &pb.CourseRequest{CourseID: course.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(finalGrades.GetGrades()) != 2 {
+		t.Fatalf("expected 2 final grades, got %d", len(finalGrades.GetGrades()))
+	}
+	for _, finalGrade := range finalGrades.GetGrades() {
+		switch finalGrade.GetUserID() {
+		case student1.ID:
+			// lab1 is dropped
+			if finalGrade.GetScore() != 95 || finalGrade.GetGrade() != "A" || !finalGrade.GetPassed() {
+				t.Errorf("student 1: got %v, want score 95, grade A and passed", finalGrade)
+			}
+		case student2.ID:
+			// lab2 is dropped, and the mandatory lab3 is not approved
+			if finalGrade.GetScore() != 75 || finalGrade.GetGrade() != "C" || finalGrade.GetPassed() {
+				t.Errorf("student 2: got %v, want score 75, grade C and not passed", finalGrade)
+			}
+		default:
+			t.Errorf("unexpected final grade for user %d", finalGrade.GetUserID())
+		}
+	}
+}
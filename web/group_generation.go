@@ -0,0 +1,106 @@
+package web
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"sort"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/scm"
+)
+
+// defaultGroupNamePrefix is the name prefix of generated groups if none is given.
+const defaultGroupNamePrefix = "group"
+
+// generateGroups partitions the course's accepted students that are not in a group into groups
+// of at most the requested size, with sizes differing by at most one. Students are assigned at random,
+// or, if balanced, by their scores so far, such that each group gets a mix of high and low scoring students.
+// Each group is created and approved, which creates its repository and team on the SCM provider.
+// Groups that cannot be created or approved are reported, and do not prevent creating the other groups.
+func (s *AutograderService) generateGroups(ctx context.Context, sc scm.SCM, request *pb.GenerateGroupsRequest) (*pb.GenerateGroupsReport, error) {
+	courseID := request.GetCourseID()
+	enrollments, err := s.db.GetEnrollmentsByCourse(courseID, pb.Enrollment_STUDENT)
+	if err != nil {
+		return nil, err
+	}
+	var students []*pb.User
+	for _, enrollment := range enrollments {
+		if enrollment.GetGroupID() == 0 {
+			students = append(students, enrollment.GetUser())
+		}
+	}
+	if request.GetBalanced() {
+		if err := s.sortByScore(courseID, students); err != nil {
+			return nil, err
+		}
+	} else {
+		rand.Shuffle(len(students), func(i, j int) {
+			students[i], students[j] = students[j], students[i]
+		})
+	}
+	prefix := request.GetNamePrefix()
+	if prefix == "" {
+		prefix = defaultGroupNamePrefix
+	}
+
+	report := &pb.GenerateGroupsReport{}
+	number := 0
+	for _, users := range partitionStudents(students, int(request.GetGroupSize())) {
+		name := ""
+		for name == "" || !s.isValidGroupName(courseID, name) {
+			number++
+			name = fmt.Sprintf("%s-%d", prefix, number)
+		}
+		group, err := s.createGroup(&pb.Group{Name: name, CourseID: courseID, Users: users})
+		if err != nil {
+			report.Errors = append(report.Errors, fmt.Sprintf("failed to create group %s: %v", name, err))
+			continue
+		}
+		if err := s.updateGroup(ctx, sc, group); err != nil {
+			// the group remains pending, and can be approved by the teacher later
+			report.Errors = append(report.Errors, fmt.Sprintf("failed to approve group %s: %v", name, err))
+			continue
+		}
+		if group, err = s.db.GetGroup(group.GetID()); err != nil {
+			return nil, err
+		}
+		report.Groups = append(report.Groups, group)
+	}
+	return report, nil
+}
+
+// sortByScore sorts the given students of the course by their final scores so far, highest first.
+func (s *AutograderService) sortByScore(courseID uint64, students []*pb.User) error {
+	finalGrades, err := s.computeFinalGrades(courseID)
+	if err != nil {
+		return err
+	}
+	scores := make(map[uint64]uint32)
+	for _, grade := range finalGrades.GetGrades() {
+		scores[grade.GetUserID()] = grade.GetScore()
+	}
+	sort.SliceStable(students, func(i, j int) bool {
+		return scores[students[i].GetID()] > scores[students[j].GetID()]
+	})
+	return nil
+}
+
+// partitionStudents partitions the given students into the fewest groups of at most the given size.
+// The students are dealt to the groups in a snake order, first to last and then last to first,
+// such that groups get a mix of students from the front and back of the list.
+func partitionStudents(students []*pb.User, size int) [][]*pb.User {
+	if len(students) == 0 || size < 1 {
+		return nil
+	}
+	numGroups := (len(students) + size - 1) / size
+	groups := make([][]*pb.User, numGroups)
+	for i, student := range students {
+		g := i % numGroups
+		if (i/numGroups)%2 == 1 {
+			g = numGroups - 1 - g
+		}
+		groups[g] = append(groups[g], student)
+	}
+	return groups
+}
@@ -0,0 +1,108 @@
+package web_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/ci"
+	"github.com/autograde/quickfeed/internal/qtest"
+	"github.com/autograde/quickfeed/scm"
+	"github.com/autograde/quickfeed/web"
+)
+
+func TestGenerateGroups(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	fakeGothProvider()
+	teacher := qtest.CreateFakeUser(t, db, 1)
+	course := &pb.Course{Code: "DAT101", Provider: "fake", OrganizationID: 1, OrganizationPath: "DAT101"}
+	qtest.CreateCourse(t, db, teacher, course)
+	assignment := &pb.Assignment{CourseID: course.ID, Name: "lab1", Order: 1}
+	if err := db.CreateAssignment(assignment); err != nil {
+		t.Fatal(err)
+	}
+	// students[i] has score 10*(i+1); the last student is already in a group
+	var students []*pb.User
+	for i := 0; i < 8; i++ {
+		student := qtest.CreateFakeUser(t, db, uint64(i+2))
+		qtest.EnrollStudent(t, db, student, course)
+		if err := db.CreateSubmission(&pb.Submission{AssignmentID: assignment.ID, UserID: student.ID, Score: uint32(10 * (i + 1))}); err != nil {
+			t.Fatal(err)
+		}
+		students = append(students, student)
+	}
+
+	fakeProvider, scms := qtest.FakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	client := accessControlled(t, ags)
+	ctx := withUserContext(context.Background(), teacher)
+	if _, err := fakeProvider.CreateOrganization(ctx, &scm.OrganizationOptions{Name: course.Code, Path: course.Code}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ags.CreateGroup(ctx, &pb.Group{Name: "group-1", CourseID: course.ID, Users: students[7:]}); err != nil {
+		t.Fatal(err)
+	}
+
+	request := &pb.GenerateGroupsRequest{CourseID: course.ID, GroupSize: 3, Balanced: true}
+	if _, err := client.GenerateGroups(withUserContext(context.Background(), students[0]), request); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("GenerateGroups() by student: err = %v, want %v", err, codes.PermissionDenied)
+	}
+	report, err := ags.GenerateGroups(ctx, request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(report.GetErrors()) > 0 {
+		t.Errorf("GenerateGroups() errors: %v", report.GetErrors())
+	}
+
+	// the students are dealt to the groups in snake order by descending score
+	wantGroups := map[string][]uint64{
+		"group-2": {students[6].ID, students[1].ID, students[0].ID},
+		"group-3": {students[5].ID, students[2].ID},
+		"group-4": {students[4].ID, students[3].ID},
+	}
+	gotGroups := make(map[string][]uint64)
+	for _, group := range report.GetGroups() {
+		if group.GetStatus() != pb.Group_APPROVED {
+			t.Errorf("group %s is %s, want %s", group.GetName(), group.GetStatus(), pb.Group_APPROVED)
+		}
+		repos, err := db.GetRepositories(&pb.Repository{GroupID: group.GetID()})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(repos) != 1 {
+			t.Errorf("group %s has %d repositories, want 1", group.GetName(), len(repos))
+		}
+		var userIDs []uint64
+		for _, user := range group.GetUsers() {
+			userIDs = append(userIDs, user.GetID())
+		}
+		gotGroups[group.GetName()] = userIDs
+	}
+	asSet := cmp.Transformer("set", func(ids []uint64) map[uint64]bool {
+		set := make(map[uint64]bool)
+		for _, id := range ids {
+			set[id] = true
+		}
+		return set
+	})
+	if diff := cmp.Diff(wantGroups, gotGroups, asSet); diff != "" {
+		t.Errorf("GenerateGroups() mismatch (-want +got):\n%s", diff)
+	}
+
+	// all students are now in a group
+	report, err = ags.GenerateGroups(ctx, &pb.GenerateGroupsRequest{CourseID: course.ID, GroupSize: 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(report.GetGroups()) != 0 {
+		t.Errorf("GenerateGroups() created %d groups, want 0", len(report.GetGroups()))
+	}
+}
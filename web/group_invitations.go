@@ -0,0 +1,311 @@
+package web
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/notify"
+)
+
+// groupInvitationExpiry is the time an invitation to join a group can be answered before it expires.
+const groupInvitationExpiry = 7 * 24 * time.Hour
+
+// createInvitingGroup creates a new group for the given student, with the student as its only member,
+// and invites the other requested users to join the group. The group is submitted for approval when
+// none of its invitations are pending; a group without invitations is submitted right away.
+func (s *AutograderService) createInvitingGroup(ctx context.Context, usr *pb.User, request *pb.Group) (*pb.Group, error) {
+	var invitees []*pb.User
+	for _, user := range request.GetUsers() {
+		if user.GetID() != usr.GetID() {
+			invitees = append(invitees, user)
+		}
+	}
+	group := &pb.Group{
+		Name:     request.GetName(),
+		CourseID: request.GetCourseID(),
+		Users:    []*pb.User{usr},
+	}
+	if len(invitees) > 0 {
+		group.Status = pb.Group_INVITING
+	}
+	// the invited users are checked along with the group's creator, to fail before the group is created
+	if _, err := s.getGroupUsers(&pb.Group{CourseID: request.GetCourseID(), Users: request.GetUsers()}); err != nil {
+		return nil, err
+	}
+	if err := s.checkGroupSize(request.GetCourseID(), len(invitees)+1); err != nil {
+		return nil, err
+	}
+	group, err := s.createGroup(group)
+	if err != nil {
+		return nil, err
+	}
+	for _, invitee := range invitees {
+		if _, err := s.inviteToGroup(ctx, usr, group, invitee.GetID()); err != nil {
+			return nil, err
+		}
+	}
+	return group, nil
+}
+
+// inviteToGroup invites the given user to join the given group, and notifies the invited user.
+// Users can only be invited to groups that are not approved, and only if their enrollment
+// in the course has been accepted and they are not in a group. The group's members and pending
+// invitations cannot exceed the course's maximum group size. A group submitted for approval
+// is withdrawn from approval until the invitation has been answered.
+func (s *AutograderService) inviteToGroup(ctx context.Context, inviter *pb.User, group *pb.Group, userID uint64) (*pb.GroupInvitation, error) {
+	if group.GetStatus() == pb.Group_APPROVED {
+		return nil, fmt.Errorf("group %d is already approved", group.GetID())
+	}
+	enrollment, err := s.db.GetEnrollmentByCourseAndUser(group.GetCourseID(), userID)
+	if err != nil {
+		return nil, err
+	}
+	if enrollment.GetStatus() < pb.Enrollment_STUDENT {
+		return nil, fmt.Errorf("user %d is not yet accepted for course %d", userID, group.GetCourseID())
+	}
+	if enrollment.GetGroupID() > 0 {
+		return nil, fmt.Errorf("user %d is already in group %d", userID, enrollment.GetGroupID())
+	}
+	pending, err := s.db.GetGroupInvitations(&pb.GroupInvitation{GroupID: group.GetID()}, pb.GroupInvitation_PENDING)
+	if err != nil {
+		return nil, err
+	}
+	for _, invitation := range pending {
+		if invitation.GetUserID() == userID {
+			return nil, fmt.Errorf("user %d is already invited to group %d", userID, group.GetID())
+		}
+	}
+	course, err := s.db.GetCourse(group.GetCourseID(), false)
+	if err != nil {
+		return nil, err
+	}
+	if err := maxGroupSizeError(course, len(group.GetUsers())+len(pending)+1); err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	invitation := &pb.GroupInvitation{
+		CourseID:  group.GetCourseID(),
+		GroupID:   group.GetID(),
+		UserID:    userID,
+		InviterID: inviter.GetID(),
+		Status:    pb.GroupInvitation_PENDING,
+		Created:   now.Format(pb.TimeLayout),
+		Expires:   now.Add(groupInvitationExpiry).Format(pb.TimeLayout),
+		GroupName: group.GetName(),
+	}
+	if err := s.db.CreateGroupInvitation(invitation); err != nil {
+		return nil, err
+	}
+	if group.GetStatus() == pb.Group_PENDING {
+		group.Status = pb.Group_INVITING
+		if err := s.db.UpdateGroupStatus(group); err != nil {
+			return nil, err
+		}
+	}
+	s.notifier.Dispatch(ctx, &notify.Notification{
+		CourseID: group.GetCourseID(),
+		UserIDs:  []uint64{userID},
+		Subject:  fmt.Sprintf("Invitation to join group %s", group.GetName()),
+		Body:     fmt.Sprintf("%s has invited you to join the group %s. The invitation expires %s.", inviter.GetName(), group.GetName(), invitation.GetExpires()),
+	})
+	return invitation, nil
+}
+
+// respondToGroupInvitation accepts or declines the given pending invitation. Accepting an invitation
+// adds the invited user to the group, and declines the user's other pending invitations in the course.
+// Expired invitations cannot be answered.
+func (s *AutograderService) respondToGroupInvitation(invitation *pb.GroupInvitation, accept bool) error {
+	if invitation.GetStatus() != pb.GroupInvitation_PENDING {
+		return fmt.Errorf("invitation %d is already %s", invitation.GetID(), invitation.GetStatus())
+	}
+	expires, err := time.ParseInLocation(pb.TimeLayout, invitation.GetExpires(), time.Local)
+	if err != nil {
+		return err
+	}
+	if time.Now().After(expires) {
+		if err := s.expireGroupInvitation(invitation); err != nil {
+			return err
+		}
+		return fmt.Errorf("invitation %d expired %s", invitation.GetID(), invitation.GetExpires())
+	}
+	if !accept {
+		invitation.Status = pb.GroupInvitation_DECLINED
+		if err := s.db.UpdateGroupInvitationStatus(invitation); err != nil {
+			return err
+		}
+		return s.submitCompleteGroup(invitation.GetGroupID())
+	}
+
+	group, err := s.db.GetGroup(invitation.GetGroupID())
+	if err != nil {
+		return err
+	}
+	user, err := s.db.GetUser(invitation.GetUserID())
+	if err != nil {
+		return err
+	}
+	// checks that the invited user is still enrolled and not in another group
+	users, err := s.getGroupUsers(&pb.Group{ID: group.GetID(), CourseID: group.GetCourseID(), Users: append(group.GetUsers(), user)})
+	if err != nil {
+		return err
+	}
+	// the course's maximum group size may have been lowered since the invitation was sent
+	course, err := s.db.GetCourse(group.GetCourseID(), false)
+	if err != nil {
+		return err
+	}
+	if err := maxGroupSizeError(course, len(users)); err != nil {
+		return err
+	}
+	if err := s.db.UpdateGroup(&pb.Group{
+		ID:       group.GetID(),
+		Name:     group.GetName(),
+		CourseID: group.GetCourseID(),
+		TeamID:   group.GetTeamID(),
+		Status:   group.GetStatus(),
+		Users:    users,
+	}); err != nil {
+		return err
+	}
+	invitation.Status = pb.GroupInvitation_ACCEPTED
+	if err := s.db.UpdateGroupInvitationStatus(invitation); err != nil {
+		return err
+	}
+	others, err := s.db.GetGroupInvitations(&pb.GroupInvitation{CourseID: group.GetCourseID(), UserID: user.GetID()}, pb.GroupInvitation_PENDING)
+	if err != nil {
+		return err
+	}
+	for _, other := range others {
+		other.Status = pb.GroupInvitation_DECLINED
+		if err := s.db.UpdateGroupInvitationStatus(other); err != nil {
+			return err
+		}
+		if err := s.submitCompleteGroup(other.GetGroupID()); err != nil {
+			return err
+		}
+	}
+	return s.submitCompleteGroup(group.GetID())
+}
+
+// submitCompleteGroup submits the given group for approval if none of its invitations are pending.
+func (s *AutograderService) submitCompleteGroup(groupID uint64) error {
+	group, err := s.db.GetGroup(groupID)
+	if err != nil {
+		return err
+	}
+	if group.GetStatus() != pb.Group_INVITING {
+		return nil
+	}
+	pending, err := s.db.GetGroupInvitations(&pb.GroupInvitation{GroupID: groupID}, pb.GroupInvitation_PENDING)
+	if err != nil || len(pending) > 0 {
+		return err
+	}
+	group.Status = pb.Group_PENDING
+	return s.db.UpdateGroupStatus(group)
+}
+
+// expireGroupInvitation marks the given invitation as expired,
+// and submits the group for approval if it was the last pending invitation.
+func (s *AutograderService) expireGroupInvitation(invitation *pb.GroupInvitation) error {
+	invitation.Status = pb.GroupInvitation_EXPIRED
+	if err := s.db.UpdateGroupInvitationStatus(invitation); err != nil {
+		return err
+	}
+	return s.submitCompleteGroup(invitation.GetGroupID())
+}
+
+// expireGroupInvitations expires the pending group invitations of the given course that have
+// not been answered before their expiry time. It returns the number of expired invitations.
+func (s *AutograderService) expireGroupInvitations(course *pb.Course, now time.Time) (int, error) {
+	pending, err := s.db.GetGroupInvitations(&pb.GroupInvitation{CourseID: course.GetID()}, pb.GroupInvitation_PENDING)
+	if err != nil {
+		return 0, err
+	}
+	expired := 0
+	for _, invitation := range pending {
+		expires, err := time.ParseInLocation(pb.TimeLayout, invitation.GetExpires(), time.Local)
+		if err != nil {
+			s.logger.Errorf("Invalid expiry time of group invitation %d: %v", invitation.GetID(), err)
+			continue
+		}
+		if now.Before(expires) {
+			continue
+		}
+		if err := s.expireGroupInvitation(invitation); err != nil {
+			return expired, err
+		}
+		expired++
+	}
+	return expired, nil
+}
+
+// cancelGroupInvitations cancels the pending invitations to the given group,
+// e.g., when the group is approved or deleted by a teacher.
+func (s *AutograderService) cancelGroupInvitations(groupID uint64) error {
+	pending, err := s.db.GetGroupInvitations(&pb.GroupInvitation{GroupID: groupID}, pb.GroupInvitation_PENDING)
+	if err != nil {
+		return err
+	}
+	for _, invitation := range pending {
+		invitation.Status = pb.GroupInvitation_CANCELED
+		if err := s.db.UpdateGroupInvitationStatus(invitation); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getGroupInvitations returns the group invitations of the given course visible to the given user.
+// The teaching staff get all invitations, while students get the invitations they have received,
+// and the invitations to their own group.
+func (s *AutograderService) getGroupInvitations(usr *pb.User, courseID uint64) (*pb.GroupInvitations, error) {
+	var invitations []*pb.GroupInvitation
+	if s.isTeachingStaff(usr.GetID(), courseID) {
+		all, err := s.db.GetGroupInvitations(&pb.GroupInvitation{CourseID: courseID})
+		if err != nil {
+			return nil, err
+		}
+		invitations = all
+	} else {
+		received, err := s.db.GetGroupInvitations(&pb.GroupInvitation{CourseID: courseID, UserID: usr.GetID()})
+		if err != nil {
+			return nil, err
+		}
+		invitations = received
+		enrollment, err := s.db.GetEnrollmentByCourseAndUser(courseID, usr.GetID())
+		if err != nil {
+			return nil, err
+		}
+		if enrollment.GetGroupID() > 0 {
+			sent, err := s.db.GetGroupInvitations(&pb.GroupInvitation{CourseID: courseID, GroupID: enrollment.GetGroupID()})
+			if err != nil {
+				return nil, err
+			}
+			for _, invitation := range sent {
+				// the user's accepted invitation to the group has already been received
+				if invitation.GetUserID() != usr.GetID() {
+					invitations = append(invitations, invitation)
+				}
+			}
+		}
+	}
+	groupNames := make(map[uint64]string)
+	visible := make([]*pb.GroupInvitation, 0, len(invitations))
+	for _, invitation := range invitations {
+		name, ok := groupNames[invitation.GetGroupID()]
+		if !ok {
+			group, err := s.db.GetGroup(invitation.GetGroupID())
+			if err != nil {
+				// the group has been deleted
+				continue
+			}
+			name = group.GetName()
+			groupNames[invitation.GetGroupID()] = name
+		}
+		invitation.GroupName = name
+		visible = append(visible, invitation)
+	}
+	return &pb.GroupInvitations{Invitations: visible}, nil
+}
@@ -0,0 +1,133 @@
+package web_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/ci"
+	"github.com/autograde/quickfeed/internal/qtest"
+	"github.com/autograde/quickfeed/web"
+)
+
+func TestGroupInvitations(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	teacher := qtest.CreateFakeUser(t, db, 1)
+	course := &pb.Course{Provider: "fake", OrganizationID: 1}
+	qtest.CreateCourse(t, db, teacher, course)
+	var students []*pb.User
+	for i := 2; i <= 6; i++ {
+		student := qtest.CreateFakeUser(t, db, uint64(i))
+		qtest.EnrollStudent(t, db, student, course)
+		students = append(students, student)
+	}
+	creator, invitee, decliner, other, rival := students[0], students[1], students[2], students[3], students[4]
+
+	_, scms := qtest.FakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	ctx := context.Background()
+
+	group, err := ags.CreateGroup(withUserContext(ctx, creator), &pb.Group{Name: "Group 1", CourseID: course.ID, Users: []*pb.User{creator, invitee, decliner}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if group.GetStatus() != pb.Group_INVITING || len(group.GetUsers()) != 1 {
+		t.Errorf("CreateGroup() = %s with %d members, want %s with 1 member", group.GetStatus(), len(group.GetUsers()), pb.Group_INVITING)
+	}
+	// the invitee is also invited to a rival group, which is submitted for approval when the invitation is declined
+	rivalGroup, err := ags.CreateGroup(withUserContext(ctx, rival), &pb.Group{Name: "Group 2", CourseID: course.ID, Users: []*pb.User{rival, invitee}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	invitations, err := ags.GetGroupInvitations(withUserContext(ctx, invitee), &pb.CourseRequest{CourseID: course.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(invitations.GetInvitations()) != 2 || invitations.GetInvitations()[0].GetGroupName() != "Group 1" {
+		t.Fatalf("GetGroupInvitations() = %v, want invitations to Group 1 and Group 2", invitations.GetInvitations())
+	}
+	invitation := invitations.GetInvitations()[0]
+	if invitation.GetInviterID() != creator.ID || invitation.GetStatus() != pb.GroupInvitation_PENDING {
+		t.Errorf("GetGroupInvitations() = %v, want pending invitation from user %d", invitation, creator.ID)
+	}
+
+	accept := &pb.GroupInvitation{ID: invitation.GetID(), CourseID: course.ID, Status: pb.GroupInvitation_ACCEPTED}
+	if _, err := ags.RespondToGroupInvitation(withUserContext(ctx, other), accept); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("RespondToGroupInvitation() by other student: err = %v, want %v", err, codes.PermissionDenied)
+	}
+	if _, err := ags.RespondToGroupInvitation(withUserContext(ctx, invitee), accept); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ags.RespondToGroupInvitation(withUserContext(ctx, invitee), accept); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("RespondToGroupInvitation() answered twice: err = %v, want %v", err, codes.FailedPrecondition)
+	}
+	assertGroup(t, db, group.GetID(), pb.Group_INVITING, 2)
+	assertGroup(t, db, rivalGroup.GetID(), pb.Group_PENDING, 1)
+
+	received, err := db.GetGroupInvitations(&pb.GroupInvitation{GroupID: group.GetID(), UserID: decliner.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	decline := &pb.GroupInvitation{ID: received[0].GetID(), CourseID: course.ID, Status: pb.GroupInvitation_DECLINED}
+	if _, err := ags.RespondToGroupInvitation(withUserContext(ctx, decliner), decline); err != nil {
+		t.Fatal(err)
+	}
+	// the group is complete, and submitted for approval
+	assertGroup(t, db, group.GetID(), pb.Group_PENDING, 2)
+
+	// inviting another student withdraws the group from approval
+	if _, err := ags.InviteToGroup(withUserContext(ctx, other), &pb.GroupInvitation{CourseID: course.ID, GroupID: group.GetID(), UserID: other.ID}); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("InviteToGroup() by non-member: err = %v, want %v", err, codes.PermissionDenied)
+	}
+	if _, err := ags.InviteToGroup(withUserContext(ctx, invitee), &pb.GroupInvitation{CourseID: course.ID, GroupID: group.GetID(), UserID: rival.ID}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("InviteToGroup() of student in another group: err = %v, want %v", err, codes.InvalidArgument)
+	}
+	if _, err := ags.InviteToGroup(withUserContext(ctx, invitee), &pb.GroupInvitation{CourseID: course.ID, GroupID: group.GetID(), UserID: other.ID}); err != nil {
+		t.Fatal(err)
+	}
+	assertGroup(t, db, group.GetID(), pb.Group_INVITING, 2)
+
+	// invitations that have not been answered in time expire
+	expired := &pb.GroupInvitation{
+		CourseID: course.ID,
+		GroupID:  rivalGroup.GetID(),
+		UserID:   decliner.ID,
+		Created:  time.Now().Add(-14 * 24 * time.Hour).Format(pb.TimeLayout),
+		Expires:  time.Now().Add(-7 * 24 * time.Hour).Format(pb.TimeLayout),
+	}
+	if err := db.CreateGroupInvitation(expired); err != nil {
+		t.Fatal(err)
+	}
+	accept = &pb.GroupInvitation{ID: expired.GetID(), CourseID: course.ID, Status: pb.GroupInvitation_ACCEPTED}
+	if _, err := ags.RespondToGroupInvitation(withUserContext(ctx, decliner), accept); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("RespondToGroupInvitation() of expired invitation: err = %v, want %v", err, codes.FailedPrecondition)
+	}
+	got, err := db.GetGroupInvitation(expired.GetID())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.GetStatus() != pb.GroupInvitation_EXPIRED {
+		t.Errorf("invitation status = %s, want %s", got.GetStatus(), pb.GroupInvitation_EXPIRED)
+	}
+}
+
+func assertGroup(t *testing.T, db interface {
+	GetGroup(uint64) (*pb.Group, error)
+}, groupID uint64, wantStatus pb.Group_GroupStatus, wantMembers int) {
+	t.Helper()
+	group, err := db.GetGroup(groupID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if group.GetStatus() != wantStatus || len(group.GetUsers()) != wantMembers {
+		t.Errorf("group %s is %s with %d members, want %s with %d members", group.GetName(), group.GetStatus(), len(group.GetUsers()), wantStatus, wantMembers)
+	}
+}
@@ -2,6 +2,7 @@ package web
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	pb "github.com/autograde/quickfeed/ag"
@@ -18,6 +19,30 @@ var (
 	ErrUserNotInGroup     = status.Errorf(codes.NotFound, "user is not in group")
 )
 
+// errGroupSize indicates that a group has too few or too many members for its course.
+var errGroupSize = errors.New("invalid group size")
+
+// GroupCleanup determines what happens to the repository and team
+// of a group on the SCM provider when the group is deleted.
+type GroupCleanup string
+
+const (
+	// GroupCleanupDelete deletes the group's repository and team.
+	GroupCleanupDelete GroupCleanup = "delete"
+	// GroupCleanupArchive archives the group's repository and deletes its team.
+	// The archived repository remains read-only in the course organization.
+	GroupCleanupArchive GroupCleanup = "archive"
+)
+
+// ParseGroupCleanup returns the group cleanup policy for the given name.
+func ParseGroupCleanup(policy string) (GroupCleanup, error) {
+	switch p := GroupCleanup(policy); p {
+	case GroupCleanupDelete, GroupCleanupArchive:
+		return p, nil
+	}
+	return "", fmt.Errorf("unknown group cleanup policy: %q", policy)
+}
+
 // getGroup returns the group for the given group ID.
 func (s *AutograderService) getGroup(request *pb.GetGroupRequest) (*pb.Group, error) {
 	group, err := s.db.GetGroup(request.GetGroupID())
@@ -50,8 +75,9 @@ func (s *AutograderService) getGroupByUserAndCourse(request *pb.GroupRequest) (*
 	return grp, err
 }
 
-// DeleteGroup deletes group with the provided ID.
-func (s *AutograderService) deleteGroup(ctx context.Context, sc scm.SCM, request *pb.GroupRequest) error {
+// deleteGroup deletes group with the provided ID. The group's repository and team
+// on the SCM provider are deleted or archived according to the group cleanup policy.
+func (s *AutograderService) deleteGroup(ctx context.Context, sc scm.SCM, usr *pb.User, request *pb.GroupRequest) error {
 	group, repos, _, err := s.getCourseGroupRepos(request)
 	if err != nil {
 		return err
@@ -65,27 +91,60 @@ func (s *AutograderService) deleteGroup(ctx context.Context, sc scm.SCM, request
 				return err
 			}
 		}
-		if err = deleteGroupRepoAndTeam(ctx, sc, repo.GetRepositoryID(), group.GetTeamID(), repo.GetOrganizationID()); err != nil {
+		if s.groupCleanupDryRun {
+			s.logger.Infof("deleteGroup: dry run: would %s repository %s and delete team %d of group %s",
+				s.groupCleanup, repo.GetHTMLURL(), group.GetTeamID(), group.GetName())
+			continue
+		}
+		switch s.groupCleanup {
+		case GroupCleanupArchive:
+			err = archiveGroupRepoAndTeam(ctx, sc, repo.GetRepositoryID(), group.GetTeamID(), repo.GetOrganizationID())
+		default:
+			err = deleteGroupRepoAndTeam(ctx, sc, repo.GetRepositoryID(), group.GetTeamID(), repo.GetOrganizationID())
+		}
+		if err != nil {
 			return err
 		}
+		s.logger.Infof("deleteGroup: %s repository %s and delete team %d of group %s",
+			s.groupCleanup, repo.GetHTMLURL(), group.GetTeamID(), group.GetName())
+		s.audit(usr, group.GetCourseID(), string(s.groupCleanup)+" group repository and team",
+			"course", group.GetCourseID(),
+			"group", group.GetName(),
+			"repository", repo.GetHTMLURL(),
+			"team", group.GetTeamID(),
+		)
 	}
 
-	return s.db.DeleteGroup(request.GetGroupID())
+	if err := s.db.DeleteGroup(request.GetGroupID()); err != nil {
+		return err
+	}
+	if err := s.cancelGroupInvitations(request.GetGroupID()); err != nil {
+		return err
+	}
+	s.audit(usr, group.GetCourseID(), "delete group", "group", group.GetName(), "groupID", group.GetID())
+	return nil
 }
 
 // createGroup creates a new group for the given course and users.
-// This function is typically called by a student when creating
-// a group, which will later be (optionally) edited and approved
-// by a teacher of the course using the updateGroup function below.
+// This function is called by a teacher creating a group, and by
+// createInvitingGroup for students. The group will later be (optionally)
+// edited and approved by a teacher of the course using the updateGroup function below.
 func (s *AutograderService) createGroup(request *pb.Group) (*pb.Group, error) {
 	if !s.isValidGroupName(request.GetCourseID(), request.GetName()) {
 		return nil, ErrGroupNameDuplicate
 	}
 	// get users of group, check consistency of group request
-	if _, err := s.getGroupUsers(request); err != nil {
+	users, err := s.getGroupUsers(request)
+	if err != nil {
 		s.logger.Errorf("CreateGroup: failed to retrieve users for group %s: %v", request.GetName(), err)
 		return nil, err
 	}
+	// the size of a group with pending invitations is checked by createInvitingGroup
+	if request.GetStatus() != pb.Group_INVITING {
+		if err := s.checkGroupSize(request.GetCourseID(), len(users)); err != nil {
+			return nil, err
+		}
+	}
 	// create new group and update groupID in enrollment table
 	if err := s.db.CreateGroup(request); err != nil {
 		return nil, err
@@ -111,6 +170,9 @@ func (s *AutograderService) updateGroup(ctx context.Context, sc scm.SCM, request
 	if err != nil {
 		return err
 	}
+	if err := groupSizeError(course, len(users)); err != nil {
+		return err
+	}
 
 	// allow changing the name of the group only if the group
 	// is not already approved and the new name is valid
@@ -157,14 +219,48 @@ func (s *AutograderService) updateGroup(ctx context.Context, sc scm.SCM, request
 
 	// if there are changes in group membership, update SCM team
 	if !group.ContainsAll(newGroup) {
-		if err := updateGroupTeam(ctx, sc, newGroup, course.GetOrganizationID()); err != nil {
+		teamGroup := newGroup
+		if removed := removedUsers(group, newGroup); len(removed) > 0 {
+			logins := (&pb.Group{Users: removed}).UserNames()
+			if s.groupCleanupDryRun {
+				s.logger.Infof("updateGroup: dry run: would remove %v from team %d of group %s", logins, newGroup.GetTeamID(), newGroup.GetName())
+				// keep the removed members in the SCM team
+				users := append(append([]*pb.User{}, newGroup.GetUsers()...), removed...)
+				teamGroup = &pb.Group{TeamID: newGroup.GetTeamID(), Users: users}
+			} else {
+				s.logger.Infof("updateGroup: removing %v from team %d of group %s", logins, newGroup.GetTeamID(), newGroup.GetName())
+			}
+		}
+		if err := updateGroupTeam(ctx, sc, teamGroup, course.GetOrganizationID()); err != nil {
 			return err
 		}
 	}
 
 	// approve and update the group in the database
 	newGroup.Status = pb.Group_APPROVED
-	return s.db.UpdateGroup(newGroup)
+	if err := s.db.UpdateGroup(newGroup); err != nil {
+		return err
+	}
+	// the teacher's approval overrides the group's unanswered invitations
+	if err := s.cancelGroupInvitations(newGroup.GetID()); err != nil {
+		return err
+	}
+	if group.GetStatus() != pb.Group_APPROVED {
+		s.notifyGroupApproved(ctx, newGroup)
+		s.publishGroupApproved(newGroup)
+	}
+	return nil
+}
+
+// removedUsers returns the members of group that are not members of newGroup.
+func removedUsers(group, newGroup *pb.Group) []*pb.User {
+	var removed []*pb.User
+	for _, user := range group.GetUsers() {
+		if !newGroup.Contains(user) {
+			removed = append(removed, user)
+		}
+	}
+	return removed
 }
 
 // getGroupUsers returns the users of the specified group request, and checks
@@ -206,6 +302,34 @@ func (s *AutograderService) getGroupUsers(request *pb.Group) ([]*pb.User, error)
 	return users, nil
 }
 
+// checkGroupSize returns an error if a group with the given number of members
+// is too small or too large for the given course.
+func (s *AutograderService) checkGroupSize(courseID uint64, size int) error {
+	course, err := s.db.GetCourse(courseID, false)
+	if err != nil {
+		return err
+	}
+	return groupSizeError(course, size)
+}
+
+// groupSizeError returns an error wrapping errGroupSize if a group with
+// the given number of members is too small or too large for the course.
+func groupSizeError(course *pb.Course, size int) error {
+	if min := int(course.GetMinGroupSize()); min > 0 && size < min {
+		return fmt.Errorf("%w: groups in course %s must have at least %d members", errGroupSize, course.GetCode(), min)
+	}
+	return maxGroupSizeError(course, size)
+}
+
+// maxGroupSizeError returns an error wrapping errGroupSize if a group with
+// the given number of members is too large for the course.
+func maxGroupSizeError(course *pb.Course, size int) error {
+	if max := int(course.GetMaxGroupSize()); max > 0 && size > max {
+		return fmt.Errorf("%w: groups in course %s can have at most %d members", errGroupSize, course.GetCode(), max)
+	}
+	return nil
+}
+
 // isValidGroupName ensures that SCM team and repository names for the given group
 // will not coincide with one of the existing approved groups
 func (s *AutograderService) isValidGroupName(courseID uint64, groupName string) bool {
@@ -2,10 +2,13 @@ package web_test
 
 import (
 	"context"
+	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
 	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/testing/protocmp"
 
 	pb "github.com/autograde/quickfeed/ag"
@@ -871,6 +874,83 @@ func TestDeleteApprovedGroup(t *testing.T) {
 	}
 }
 
+func TestDeleteApprovedGroupArchive(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	admin := qtest.CreateFakeUser(t, db, 1)
+	course := allCourses[0]
+	if err := db.CreateCourse(admin.ID, course); err != nil {
+		t.Fatal(err)
+	}
+
+	fakeProvider, scms := qtest.FakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	ags.SetGroupCleanup(web.GroupCleanupArchive)
+	ctx := withUserContext(context.Background(), admin)
+
+	if _, err := fakeProvider.CreateOrganization(ctx, &scm.OrganizationOptions{
+		Name: course.Code,
+		Path: course.Code,
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	user := qtest.CreateFakeUser(t, db, 2)
+	if err := db.CreateEnrollment(&pb.Enrollment{UserID: user.ID, CourseID: course.ID}); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.UpdateEnrollment(&pb.Enrollment{
+		UserID:   user.ID,
+		CourseID: course.ID,
+		Status:   pb.Enrollment_STUDENT,
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx = withUserContext(context.Background(), user)
+	createdGroup, err := ags.CreateGroup(ctx, &pb.Group{
+		CourseID: course.ID,
+		Name:     "Test Group",
+		Users:    []*pb.User{user},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	createdGroup.Status = pb.Group_APPROVED
+	ctx = withUserContext(context.Background(), admin)
+	if _, err = ags.UpdateGroup(ctx, createdGroup); err != nil {
+		t.Fatal(err)
+	}
+	approvedGroup, err := db.GetGroup(createdGroup.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	repos, err := db.GetRepositories(&pb.Repository{GroupID: createdGroup.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(repos) != 1 {
+		t.Fatalf("expected 1 group repository, got %d", len(repos))
+	}
+
+	if _, err = ags.DeleteGroup(ctx, &pb.GroupRequest{CourseID: course.ID, GroupID: createdGroup.ID}); err != nil {
+		t.Fatal(err)
+	}
+
+	fake := fakeProvider.(*scm.FakeSCM)
+	repo, ok := fake.Repositories[repos[0].GetRepositoryID()]
+	if !ok {
+		t.Fatalf("expected group repository %d to be archived, but it was deleted", repos[0].GetRepositoryID())
+	}
+	if !repo.Archived {
+		t.Errorf("expected group repository %s to be archived", repo.Path)
+	}
+	if _, ok := fake.Teams[approvedGroup.GetTeamID()]; ok {
+		t.Errorf("expected group team %d to be deleted", approvedGroup.GetTeamID())
+	}
+}
+
 func TestGetGroups(t *testing.T) {
 	db, cleanup := qtest.TestDB(t)
 	defer cleanup()
@@ -884,6 +964,7 @@ func TestGetGroups(t *testing.T) {
 
 	_, scms := qtest.FakeProviderMap(t)
 	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	client := accessControlled(t, ags)
 	// admin will be enrolled as teacher because of course creation below
 	withUserContext(context.Background(), admin)
 
@@ -928,7 +1009,7 @@ func TestGetGroups(t *testing.T) {
 	}
 
 	// check that request on non-existent course returns error
-	_, err = ags.GetGroupsByCourse(ctx, &pb.CourseRequest{CourseID: 15})
+	_, err = client.GetGroupsByCourse(ctx, &pb.CourseRequest{CourseID: 15})
 	if err == nil {
 		t.Error("expected error; no groups should be returned")
 	}
@@ -945,3 +1026,145 @@ func TestGetGroups(t *testing.T) {
 		t.Errorf("mismatch (-wantGroups +gotGroups):\n%s", diff)
 	}
 }
+
+func TestGroupCleanupDryRun(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	fakeGothProvider()
+	admin := qtest.CreateFakeUser(t, db, 1)
+	course := &pb.Course{Code: "DAT101", Provider: "fake", OrganizationID: 1, OrganizationPath: "DAT101"}
+	qtest.CreateCourse(t, db, admin, course)
+	user1 := qtest.CreateFakeUser(t, db, 2)
+	user2 := qtest.CreateFakeUser(t, db, 3)
+	qtest.EnrollStudent(t, db, user1, course)
+	qtest.EnrollStudent(t, db, user2, course)
+
+	fakeProvider, scms := qtest.FakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	ags.SetGroupCleanupDryRun(true)
+	ctx := withUserContext(context.Background(), admin)
+	if _, err := fakeProvider.CreateOrganization(ctx, &scm.OrganizationOptions{Name: course.Code, Path: course.Code}); err != nil {
+		t.Fatal(err)
+	}
+
+	// the teacher creates the group, since the members of groups created by students must accept their invitations
+	group, err := ags.CreateGroup(ctx, &pb.Group{
+		CourseID: course.ID,
+		Name:     "Test Group",
+		Users:    []*pb.User{user1, user2},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = ags.UpdateGroup(ctx, group); err != nil {
+		t.Fatal(err)
+	}
+	approvedGroup, err := db.GetGroup(group.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	repos, err := db.GetRepositories(&pb.Repository{GroupID: group.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(repos) != 1 {
+		t.Fatalf("expected 1 group repository, got %d", len(repos))
+	}
+
+	// removing a member in dry run mode updates the database, but keeps the member in the SCM team
+	approvedGroup.Users = []*pb.User{user1}
+	if _, err = ags.UpdateGroup(ctx, approvedGroup); err != nil {
+		t.Fatal(err)
+	}
+	updatedGroup, err := db.GetGroup(group.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(updatedGroup.GetUsers()) != 1 {
+		t.Errorf("expected 1 group member after update, got %d", len(updatedGroup.GetUsers()))
+	}
+	fake := fakeProvider.(*scm.FakeSCM)
+	wantMembers := []string{user1.GetLogin(), user2.GetLogin()}
+	if diff := cmp.Diff(wantMembers, fake.TeamMembers[approvedGroup.GetTeamID()]); diff != "" {
+		t.Errorf("team members after dry run update mismatch (-want +got):\n%s", diff)
+	}
+
+	// deleting the group in dry run mode deletes the database records, but keeps the repository and team
+	if _, err = ags.DeleteGroup(ctx, &pb.GroupRequest{CourseID: course.ID, GroupID: group.ID}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.GetGroup(group.ID); err == nil {
+		t.Errorf("expected group %d to be deleted", group.ID)
+	}
+	if _, ok := fake.Repositories[repos[0].GetRepositoryID()]; !ok {
+		t.Errorf("expected group repository %d to be kept in dry run mode", repos[0].GetRepositoryID())
+	}
+	if _, ok := fake.Teams[approvedGroup.GetTeamID()]; !ok {
+		t.Errorf("expected group team %d to be kept in dry run mode", approvedGroup.GetTeamID())
+	}
+}
+
+func TestGroupSize(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	admin := qtest.CreateFakeUser(t, db, 1)
+	course := &pb.Course{Code: "DAT101", Provider: "fake", OrganizationID: 1, OrganizationPath: "DAT101", MinGroupSize: 2, MaxGroupSize: 3}
+	qtest.CreateCourse(t, db, admin, course)
+	var students []*pb.User
+	for i := 2; i <= 8; i++ {
+		student := qtest.CreateFakeUser(t, db, uint64(i))
+		qtest.EnrollStudent(t, db, student, course)
+		students = append(students, student)
+	}
+
+	fakeProvider, scms := qtest.FakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	ctx := withUserContext(context.Background(), admin)
+	if _, err := fakeProvider.CreateOrganization(ctx, &scm.OrganizationOptions{Name: course.Code, Path: course.Code}); err != nil {
+		t.Fatal(err)
+	}
+	studentCtx := withUserContext(context.Background(), students[0])
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		users   []*pb.User
+		wantErr string
+	}{
+		{"student alone", studentCtx, students[:1], "must have at least 2 members"},
+		{"student with too many invitees", studentCtx, students[:4], "can have at most 3 members"},
+		{"teacher with too few members", ctx, students[4:5], "must have at least 2 members"},
+	}
+	for _, tt := range tests {
+		_, err := ags.CreateGroup(tt.ctx, &pb.Group{Name: tt.name, CourseID: course.ID, Users: tt.users})
+		if status.Code(err) != codes.InvalidArgument || !strings.Contains(status.Convert(err).Message(), tt.wantErr) {
+			t.Errorf("CreateGroup(%s): err = %v, want %v with %q", tt.name, err, codes.InvalidArgument, tt.wantErr)
+		}
+	}
+
+	// the group's pending invitations count towards its size
+	group, err := ags.CreateGroup(studentCtx, &pb.Group{Name: "Full Group", CourseID: course.ID, Users: students[:3]})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = ags.InviteToGroup(studentCtx, &pb.GroupInvitation{CourseID: course.ID, GroupID: group.ID, UserID: students[3].ID})
+	if status.Code(err) != codes.InvalidArgument || !strings.Contains(status.Convert(err).Message(), "can have at most 3 members") {
+		t.Errorf("InviteToGroup(): err = %v, want %v with group size error", err, codes.InvalidArgument)
+	}
+
+	group, err = ags.CreateGroup(ctx, &pb.Group{Name: "Teacher Group", CourseID: course.ID, Users: students[4:6]})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, users := range [][]*pb.User{students[4:5], students[3:7]} {
+		_, err := ags.UpdateGroup(ctx, &pb.Group{ID: group.ID, Name: group.Name, CourseID: course.ID, Users: users})
+		if status.Code(err) != codes.InvalidArgument || !strings.Contains(status.Convert(err).Message(), "members") {
+			t.Errorf("UpdateGroup() with %d members: err = %v, want %v with group size error", len(users), err, codes.InvalidArgument)
+		}
+	}
+	if _, err := ags.UpdateGroup(ctx, &pb.Group{ID: group.ID, Name: group.Name, CourseID: course.ID, Users: students[4:7]}); err != nil {
+		t.Errorf("UpdateGroup() with 3 members: err = %v, want nil", err)
+	}
+}
@@ -102,7 +102,8 @@ func startGrpcAuthServer(t *testing.T, db database.Database) {
 	check(t, err)
 
 	opt := grpc.ChainUnaryInterceptor(
-		auth.UserVerifier(),
+		auth.UserVerifier(db),
+		agService.AccessControl(),
 	)
 	grpcServer := grpc.NewServer(opt)
 
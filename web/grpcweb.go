@@ -0,0 +1,181 @@
+package web
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
+	"golang.org/x/net/http2"
+	"google.golang.org/grpc"
+)
+
+const (
+	grpcWebContentType     = "application/grpc-web"
+	grpcWebTextContentType = "application/grpc-web-text"
+	grpcContentType        = "application/grpc"
+	// grpcWebTrailerFlag marks the frame holding the trailers at the end of a gRPC-web response.
+	grpcWebTrailerFlag = 0x80
+)
+
+// grpcWebHandler translates gRPC-web requests from browsers to gRPC requests served
+// by the gRPC server, and the gRPC responses back to gRPC-web responses. This replaces
+// the gRPC-web translation of a separate proxy, such as Envoy.
+type grpcWebHandler struct {
+	server *grpc.Server
+}
+
+// NewGRPCWebHandler returns a handler serving gRPC-web requests, in both binary and
+// text (base64) format, using the given gRPC server.
+func NewGRPCWebHandler(server *grpc.Server) http.Handler {
+	return &grpcWebHandler{server: server}
+}
+
+// isGRPCWebRequest returns true if the request is a gRPC-web request.
+func isGRPCWebRequest(r *http.Request) bool {
+	return r.Method == http.MethodPost && strings.HasPrefix(r.Header.Get(echo.HeaderContentType), grpcWebContentType)
+}
+
+func (h *grpcWebHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !isGRPCWebRequest(r) {
+		http.Error(w, "not a gRPC-web request", http.StatusUnsupportedMediaType)
+		return
+	}
+	contentType := r.Header.Get(echo.HeaderContentType)
+	text := strings.HasPrefix(contentType, grpcWebTextContentType)
+	// the content subtype, e.g., +proto, follows the gRPC-web content type
+	subtype := strings.TrimPrefix(strings.TrimPrefix(contentType, grpcWebTextContentType), grpcWebContentType)
+
+	req := r.Clone(r.Context())
+	req.ProtoMajor, req.ProtoMinor, req.Proto = 2, 0, "HTTP/2.0"
+	req.Header.Set(echo.HeaderContentType, grpcContentType+subtype)
+	req.Header.Set("Te", "trailers")
+	req.Header.Del(echo.HeaderContentLength)
+	req.ContentLength = -1
+	if text {
+		req.Body = ioutil.NopCloser(base64.NewDecoder(base64.StdEncoding, r.Body))
+	}
+
+	resp := &grpcWebResponse{
+		w:           w,
+		header:      make(http.Header),
+		text:        text,
+		contentType: strings.TrimSuffix(contentType, ";"),
+	}
+	h.server.ServeHTTP(resp, req)
+	resp.writeTrailers()
+}
+
+// grpcWebResponse is a response writer for the gRPC server that writes a gRPC-web
+// response, where the gRPC trailers are written as a frame at the end of the body.
+type grpcWebResponse struct {
+	w           http.ResponseWriter
+	header      http.Header
+	text        bool
+	contentType string
+	// sent holds the headers sent before the body; the remaining headers are trailers.
+	sent http.Header
+}
+
+func (r *grpcWebResponse) Header() http.Header {
+	return r.header
+}
+
+func (r *grpcWebResponse) WriteHeader(statusCode int) {
+	if r.sent != nil {
+		return
+	}
+	r.sent = make(http.Header)
+	for key, values := range r.header {
+		if key == "Trailer" || strings.HasPrefix(key, http2.TrailerPrefix) {
+			continue
+		}
+		r.sent[key] = values
+		r.w.Header()[key] = values
+	}
+	r.w.Header().Set(echo.HeaderContentType, r.contentType)
+	r.w.Header().Del(echo.HeaderContentLength)
+	r.w.WriteHeader(statusCode)
+}
+
+func (r *grpcWebResponse) Write(b []byte) (int, error) {
+	r.WriteHeader(http.StatusOK)
+	if r.text {
+		if _, err := io.WriteString(r.w, base64.StdEncoding.EncodeToString(b)); err != nil {
+			return 0, err
+		}
+		return len(b), nil
+	}
+	return r.w.Write(b)
+}
+
+func (r *grpcWebResponse) Flush() {
+	r.WriteHeader(http.StatusOK)
+	if f, ok := r.w.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// writeTrailers writes the headers set after the body was started as the trailer frame.
+func (r *grpcWebResponse) writeTrailers() {
+	r.WriteHeader(http.StatusOK)
+	var keys []string
+	trailers := make(map[string][]string)
+	for key, values := range r.header {
+		if key == "Trailer" {
+			continue
+		}
+		name := strings.ToLower(strings.TrimPrefix(key, http2.TrailerPrefix))
+		if _, ok := r.sent[key]; ok && !strings.HasPrefix(key, http2.TrailerPrefix) {
+			continue
+		}
+		if _, ok := trailers[name]; !ok {
+			keys = append(keys, name)
+		}
+		trailers[name] = append(trailers[name], values...)
+	}
+	sort.Strings(keys)
+	var buf bytes.Buffer
+	for _, key := range keys {
+		for _, value := range trailers[key] {
+			fmt.Fprintf(&buf, "%s: %s\r\n", key, value)
+		}
+	}
+	frame := make([]byte, 5, 5+buf.Len())
+	frame[0] = grpcWebTrailerFlag
+	binary.BigEndian.PutUint32(frame[1:], uint32(buf.Len()))
+	frame = append(frame, buf.Bytes()...)
+	r.Write(frame)
+	r.Flush()
+}
+
+// grpcWebCORS returns a middleware allowing gRPC-web requests from the given origins.
+func grpcWebCORS(allowedOrigins []string) echo.MiddlewareFunc {
+	return middleware.CORSWithConfig(middleware.CORSConfig{
+		AllowOrigins:     allowedOrigins,
+		AllowMethods:     []string{http.MethodPost, http.MethodOptions},
+		AllowHeaders:     []string{echo.HeaderContentType, "X-Grpc-Web", "X-User-Agent", "Grpc-Timeout"},
+		ExposeHeaders:    []string{"Grpc-Status", "Grpc-Message", "Grpc-Status-Details-Bin"},
+		AllowCredentials: true,
+	})
+}
+
+// registerGRPCWeb serves the gRPC-web requests for the autograder service.
+func registerGRPCWeb(ags *AutograderService, e *echo.Echo) {
+	if ags.grpcServer == nil {
+		return
+	}
+	var middlewares []echo.MiddlewareFunc
+	if len(ags.grpcWebOrigins) > 0 {
+		middlewares = append(middlewares, grpcWebCORS(ags.grpcWebOrigins))
+	}
+	grpcWeb := e.Group("/ag.AutograderService", middlewares...)
+	grpcWeb.Any("/*", echo.WrapHandler(NewGRPCWebHandler(ags.grpcServer)))
+}
@@ -0,0 +1,157 @@
+package web_test
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/ci"
+	"github.com/autograde/quickfeed/internal/qtest"
+	"github.com/autograde/quickfeed/web"
+)
+
+// grpcWebFrame returns a gRPC-web frame with the given flag and payload.
+func grpcWebFrame(flag byte, payload []byte) []byte {
+	frame := make([]byte, 5, 5+len(payload))
+	frame[0] = flag
+	binary.BigEndian.PutUint32(frame[1:], uint32(len(payload)))
+	return append(frame, payload...)
+}
+
+// parseGRPCWebFrames returns the message and the trailers of a gRPC-web response body.
+func parseGRPCWebFrames(t *testing.T, body []byte) (message []byte, trailers map[string]string) {
+	t.Helper()
+	trailers = make(map[string]string)
+	for len(body) > 0 {
+		if len(body) < 5 {
+			t.Fatalf("truncated frame header: %v", body)
+		}
+		flag, length := body[0], binary.BigEndian.Uint32(body[1:5])
+		if len(body) < 5+int(length) {
+			t.Fatalf("truncated frame: got %d bytes, want %d", len(body)-5, length)
+		}
+		payload := body[5 : 5+length]
+		body = body[5+length:]
+		if flag&0x80 == 0 {
+			message = payload
+			continue
+		}
+		for _, line := range strings.Split(strings.TrimSpace(string(payload)), "\r\n") {
+			kv := strings.SplitN(line, ": ", 2)
+			if len(kv) == 2 {
+				trailers[kv[0]] = kv[1]
+			}
+		}
+	}
+	return message, trailers
+}
+
+// decodeGRPCWebText decodes a gRPC-web text response body,
+// which may hold several separately base64 encoded and padded chunks.
+func decodeGRPCWebText(t *testing.T, body string) []byte {
+	t.Helper()
+	var decoded []byte
+	for len(body) > 0 {
+		end := len(body)
+		if i := strings.Index(body, "="); i >= 0 {
+			end = i + len(body[i:]) - len(strings.TrimLeft(body[i:], "="))
+		}
+		chunk, err := base64.StdEncoding.DecodeString(body[:end])
+		if err != nil {
+			t.Fatalf("invalid base64 chunk %q: %v", body[:end], err)
+		}
+		decoded = append(decoded, chunk...)
+		body = body[end:]
+	}
+	return decoded
+}
+
+func TestGRPCWeb(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	user := qtest.CreateFakeUser(t, db, 1)
+	_, scms := qtest.FakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	grpcServer := grpc.NewServer()
+	pb.RegisterAutograderServiceServer(grpcServer, ags)
+	handler := web.NewGRPCWebHandler(grpcServer)
+
+	request, err := proto.Marshal(&pb.Void{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name        string
+		contentType string
+		userID      uint64
+		wantStatus  string
+	}{
+		{name: "binary", contentType: "application/grpc-web+proto", userID: user.GetID(), wantStatus: "0"},
+		{name: "text", contentType: "application/grpc-web-text", userID: user.GetID(), wantStatus: "0"},
+		// unknown users are denied access
+		{name: "unknown user", contentType: "application/grpc-web+proto", userID: 99, wantStatus: "7"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			text := strings.HasPrefix(test.contentType, "application/grpc-web-text")
+			body := grpcWebFrame(0, request)
+			if text {
+				body = []byte(base64.StdEncoding.EncodeToString(body))
+			}
+			r := httptest.NewRequest(http.MethodPost, "/ag.AutograderService/GetUser", bytes.NewReader(body))
+			r.Header.Set("Content-Type", test.contentType)
+			r.Header.Set("X-Grpc-Web", "1")
+			r.Header.Set("user", strconv.FormatUint(test.userID, 10))
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, r)
+
+			if w.Code != http.StatusOK {
+				t.Fatalf("ServeHTTP() status = %d, want %d", w.Code, http.StatusOK)
+			}
+			if got := w.Header().Get("Content-Type"); got != test.contentType {
+				t.Errorf("ServeHTTP() content type = %q, want %q", got, test.contentType)
+			}
+			respBody, err := ioutil.ReadAll(w.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if text {
+				respBody = decodeGRPCWebText(t, string(respBody))
+			}
+			message, trailers := parseGRPCWebFrames(t, respBody)
+			if trailers["grpc-status"] != test.wantStatus {
+				t.Fatalf("grpc-status = %q (%s), want %q", trailers["grpc-status"], trailers["grpc-message"], test.wantStatus)
+			}
+			if test.wantStatus != "0" {
+				return
+			}
+			got := &pb.User{}
+			if err := proto.Unmarshal(message, got); err != nil {
+				t.Fatal(err)
+			}
+			if got.GetID() != user.GetID() {
+				t.Errorf("GetUser() over gRPC-web = user %d, want %d", got.GetID(), user.GetID())
+			}
+		})
+	}
+
+	r := httptest.NewRequest(http.MethodPost, "/ag.AutograderService/GetUser", bytes.NewReader(request))
+	r.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, r)
+	if w.Code != http.StatusUnsupportedMediaType {
+		t.Errorf("ServeHTTP() with content type application/json: status = %d, want %d", w.Code, http.StatusUnsupportedMediaType)
+	}
+}
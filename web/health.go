@@ -0,0 +1,121 @@
+package web
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/autograde/quickfeed/scm"
+	"github.com/autograde/quickfeed/web/auth"
+	"github.com/labstack/echo/v4"
+)
+
+// healthCheckTimeout limits the time spent on the checks of each health request.
+const healthCheckTimeout = 5 * time.Second
+
+const (
+	healthOK          = "ok"
+	healthUnavailable = "unavailable"
+)
+
+var errShuttingDown = errors.New("shutting down")
+
+// HealthStatus reports the outcome of the server's health checks.
+type HealthStatus struct {
+	// Status is "ok" if all checks passed; otherwise "unavailable".
+	Status string `json:"status"`
+	// Checks holds the outcome of each check; "ok" or "unavailable".
+	Checks map[string]string `json:"checks"`
+	// errs holds the errors of the failed checks, which are logged, but not
+	// exposed by the unauthenticated health endpoints.
+	errs map[string]error
+}
+
+// newHealthStatus returns the health status given the errors of the named checks.
+func newHealthStatus(errs map[string]error) *HealthStatus {
+	health := &HealthStatus{Status: healthOK, Checks: make(map[string]string), errs: make(map[string]error)}
+	for name, err := range errs {
+		if err != nil {
+			health.Status = healthUnavailable
+			health.Checks[name] = healthUnavailable
+			health.errs[name] = err
+		} else {
+			health.Checks[name] = healthOK
+		}
+	}
+	return health
+}
+
+// checkLiveness checks that the server can serve requests, that is, that the database can be reached.
+func (s *AutograderService) checkLiveness(ctx context.Context) *HealthStatus {
+	return newHealthStatus(map[string]error{"database": s.db.Ping(ctx)})
+}
+
+// checkReadiness checks that the server is not shutting down, that the database can be reached, that
+// the runner of each runner pool is available, and that the API of each enabled SCM provider is reachable.
+func (s *AutograderService) checkReadiness(ctx context.Context) *HealthStatus {
+	errs := map[string]error{"database": s.db.Ping(ctx), "server": nil}
+	if s.isShuttingDown() {
+		errs["server"] = errShuttingDown
+	}
+	for pool, err := range s.runners.Ping(ctx) {
+		errs["runner/"+pool] = err
+	}
+	for _, provider := range auth.GetProviders().GetProviders() {
+		errs["scm/"+provider] = pingProvider(ctx, provider)
+	}
+	return newHealthStatus(errs)
+}
+
+// pingProvider returns an error if the API of the given SCM provider cannot be reached.
+// Any response, even one rejecting the unauthenticated request, shows that the API is reachable,
+// unless the provider reports a server error.
+func pingProvider(ctx context.Context, provider string) error {
+	url, err := scm.APIURL(provider)
+	if err != nil || url == "" {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	if resp.StatusCode >= http.StatusInternalServerError {
+		return fmt.Errorf("%s responded %s", url, resp.Status)
+	}
+	return nil
+}
+
+// RegisterHealthChecks registers the unauthenticated health check endpoints,
+// e.g., for Kubernetes probes and load balancers:
+//
+//	/healthz  liveness: the database can be reached
+//	/readyz   readiness: the server is not shutting down, and the database, test runners and SCM providers are available
+//
+// Both respond with the outcome of each check as JSON, with status 200 if all checks
+// passed, and 503 otherwise; the errors of failed checks are only logged. The liveness check does not depend on external services,
+// such that an SCM provider outage does not get the server restarted.
+func (s *AutograderService) RegisterHealthChecks(e *echo.Echo) {
+	respond := func(c echo.Context, check func(context.Context) *HealthStatus) error {
+		ctx, cancel := context.WithTimeout(c.Request().Context(), healthCheckTimeout)
+		defer cancel()
+		health := check(ctx)
+		if health.Status != healthOK {
+			s.logger.Errorf("Health check %s failed: %v", c.Path(), health.errs)
+			return c.JSON(http.StatusServiceUnavailable, health)
+		}
+		return c.JSON(http.StatusOK, health)
+	}
+	e.GET("/healthz", func(c echo.Context) error {
+		return respond(c, s.checkLiveness)
+	})
+	e.GET("/readyz", func(c echo.Context) error {
+		return respond(c, s.checkReadiness)
+	})
+}
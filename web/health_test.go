@@ -0,0 +1,81 @@
+package web_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+
+	"github.com/autograde/quickfeed/ci"
+	"github.com/autograde/quickfeed/internal/qtest"
+	"github.com/autograde/quickfeed/web"
+)
+
+// unavailableRunner is a runner whose daemon is unavailable.
+type unavailableRunner struct {
+	ci.Local
+}
+
+func (unavailableRunner) Ping(context.Context) error {
+	return errors.New("cannot connect to the Docker daemon")
+}
+
+func TestHealthChecks(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	_, scms := qtest.FakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	ags.AddRunnerPool("campus", &unavailableRunner{})
+	e := echo.New()
+	ags.RegisterHealthChecks(e)
+
+	get := func(path string) (int, *web.HealthStatus) {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		var health web.HealthStatus
+		if err := json.Unmarshal(rec.Body.Bytes(), &health); err != nil {
+			t.Fatalf("GET %s: %v", path, err)
+		}
+		return rec.Code, &health
+	}
+
+	// liveness does not depend on the runners
+	code, health := get("/healthz")
+	if code != http.StatusOK || health.Status != "ok" || health.Checks["database"] != "ok" {
+		t.Errorf("GET /healthz = %d %+v, want %d with database ok", code, health, http.StatusOK)
+	}
+
+	code, health = get("/readyz")
+	if code != http.StatusServiceUnavailable || health.Status != "unavailable" {
+		t.Errorf("GET /readyz = %d %+v, want %d", code, health, http.StatusServiceUnavailable)
+	}
+	for check, want := range map[string]string{
+		"database":       "ok",
+		"server":         "ok",
+		"runner/default": "ok",
+		"runner/campus":  "unavailable",
+	} {
+		if got := health.Checks[check]; got != want {
+			t.Errorf("GET /readyz: %s = %q, want %q", check, got, want)
+		}
+	}
+
+	// the server is not ready once it is shutting down, but remains live while draining test jobs
+	if err := ags.Shutdown(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	code, health = get("/readyz")
+	if code != http.StatusServiceUnavailable || health.Checks["server"] != "unavailable" {
+		t.Errorf("GET /readyz after Shutdown() = %d %+v, want %d with server unavailable", code, health, http.StatusServiceUnavailable)
+	}
+	if code, _ = get("/healthz"); code != http.StatusOK {
+		t.Errorf("GET /healthz after Shutdown() = %d, want %d", code, http.StatusOK)
+	}
+}
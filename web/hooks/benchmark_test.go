@@ -0,0 +1,48 @@
+package hooks
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/ci"
+	"github.com/autograde/quickfeed/internal/qtest"
+	"github.com/autograde/quickfeed/notify"
+	"github.com/google/go-github/v35/github"
+	"go.uber.org/zap"
+)
+
+// echoRunner outputs the job's commands instead of running them.
+type echoRunner struct{}
+
+func (echoRunner) Run(_ context.Context, job *ci.Job) (string, error) {
+	return strings.Join(job.Commands, "\n"), nil
+}
+
+// BenchmarkHandlePush measures handling of student push events in a course with
+// 500 students and 10 assignments, including running the tests and recording the results.
+func BenchmarkHandlePush(b *testing.B) {
+	db, cleanup := qtest.TestDB(b)
+	defer cleanup()
+
+	teacher := qtest.CreateFakeUser(b, db, 1)
+	course := &pb.Course{OrganizationID: 1}
+	qtest.CreateCourse(b, db, teacher, course)
+	students := qtest.PopulateCourse(b, db, course, 500, 10)
+
+	logger := zap.NewNop().Sugar()
+	webhook := NewGitHubWebHook(logger, db, ci.NewPools(logger, ci.NewQueue(logger, db, echoRunner{}, 1)), "secret", notify.NewDispatcher(zap.NewNop()))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		student := i % len(students)
+		webhook.handlePush(&github.PushEvent{
+			Ref:        github.String("refs/heads/main"),
+			Repo:       &github.PushEventRepository{ID: github.Int64(int64(1001 + student)), Name: github.String("student-labs"), DefaultBranch: github.String("main")},
+			Sender:     &github.User{Login: github.String(fmt.Sprintf("student%d", student))},
+			HeadCommit: &github.HeadCommit{ID: github.String(fmt.Sprintf("commit-%d", i))},
+			Commits:    []*github.HeadCommit{{Modified: []string{fmt.Sprintf("lab%d/lab.go", i%10+1)}}},
+		})
+	}
+}
@@ -1,7 +1,11 @@
 package hooks
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"net/http"
+	"path"
 	"strings"
 	"time"
 
@@ -11,26 +15,32 @@ import (
 	"github.com/autograde/quickfeed/database"
 	"github.com/autograde/quickfeed/kit/score"
 	"github.com/autograde/quickfeed/log"
+	"github.com/autograde/quickfeed/notify"
+	"github.com/autograde/quickfeed/scm"
 	"github.com/google/go-github/v35/github"
 	"go.uber.org/zap"
+	"gorm.io/gorm"
 )
 
 // GitHubWebHook holds references and data for handling webhook events.
 type GitHubWebHook struct {
-	logger *zap.SugaredLogger
-	db     database.Database
-	runner ci.Runner
-	secret string
+	logger   *zap.SugaredLogger
+	db       database.Database
+	runners  *ci.Pools
+	secret   string
+	notifier *notify.Dispatcher
 }
 
 // NewGitHubWebHook creates a new webhook to handle POST requests from GitHub to the QuickFeed server.
-func NewGitHubWebHook(logger *zap.SugaredLogger, db database.Database, runner ci.Runner, secret string) *GitHubWebHook {
-	return &GitHubWebHook{logger: logger, db: db, runner: runner, secret: secret}
+// The notifier is used to notify course teachers about force pushes to student and group repositories.
+func NewGitHubWebHook(logger *zap.SugaredLogger, db database.Database, runners *ci.Pools, secret string, notifier *notify.Dispatcher) *GitHubWebHook {
+	return &GitHubWebHook{logger: logger, db: db, runners: runners, secret: secret, notifier: notifier}
 }
 
-// Handle take POST requests from GitHub, representing Push events
+// Handle take POST requests from GitHub, representing Push and PullRequest events
 // associated with course repositories, which then triggers various
-// actions on the QuickFeed backend.
+// actions on the QuickFeed backend. Each delivery is recorded, such that
+// redelivered webhooks are processed only once, and failed deliveries can be replayed.
 func (wh GitHubWebHook) Handle(w http.ResponseWriter, r *http.Request) {
 	payload, err := github.ValidatePayload(r, []byte(wh.secret))
 	if err != nil {
@@ -44,36 +54,177 @@ func (wh GitHubWebHook) Handle(w http.ResponseWriter, r *http.Request) {
 		wh.logger.Errorf("Could not parse github webhook: %v", err)
 		return
 	}
+	delivery := &pb.WebhookDelivery{
+		DeliveryID: github.DeliveryID(r),
+		Event:      github.WebHookType(r),
+		Repository: eventRepository(event),
+		Payload:    payload,
+	}
+	if !wh.startDelivery(delivery) {
+		wh.logger.Debugf("Ignoring duplicate delivery %s of %s event", delivery.GetDeliveryID(), delivery.GetEvent())
+		return
+	}
+	wh.finishDelivery(delivery, wh.handleEvent(event))
+}
+
+// Replay processes the given recorded webhook delivery again, e.g., after a failure,
+// and records the outcome in the delivery. Returns the error processing the delivery, if any.
+func (wh GitHubWebHook) Replay(delivery *pb.WebhookDelivery) error {
+	event, err := github.ParseWebHook(delivery.GetEvent(), delivery.GetPayload())
+	if err != nil {
+		err = fmt.Errorf("could not parse github webhook: %w", err)
+		wh.finishDelivery(delivery, err)
+		return err
+	}
+	delivery.Status = pb.WebhookDelivery_PROCESSING
+	delivery.Deliveries++
+	err = wh.handleEvent(event)
+	wh.finishDelivery(delivery, err)
+	return err
+}
+
+// startDelivery records the given delivery, and returns true if it should be processed.
+// A redelivery is only processed if the previous delivery failed. Deliveries without
+// a delivery ID, and deliveries that cannot be recorded, are processed without being recorded.
+func (wh GitHubWebHook) startDelivery(delivery *pb.WebhookDelivery) bool {
+	if delivery.GetDeliveryID() == "" {
+		return true
+	}
+	now := time.Now().Format(pb.TimeLayout)
+	previous, err := wh.db.GetWebhookDelivery(delivery.GetDeliveryID())
+	if err == nil {
+		if previous.GetStatus() != pb.WebhookDelivery_FAILED {
+			return false
+		}
+		delivery.ID = previous.GetID()
+		delivery.Deliveries = previous.GetDeliveries() + 1
+		delivery.Received = previous.GetReceived()
+		delivery.Processed = now
+		if err := wh.db.UpdateWebhookDelivery(delivery); err != nil {
+			wh.logger.Errorf("Failed to record redelivery %s: %v", delivery.GetDeliveryID(), err)
+		}
+		return true
+	}
+	delivery.Deliveries = 1
+	delivery.Received = now
+	delivery.Processed = now
+	if err := wh.db.CreateWebhookDelivery(delivery); err != nil {
+		if _, getErr := wh.db.GetWebhookDelivery(delivery.GetDeliveryID()); getErr == nil {
+			// the same delivery was recorded concurrently
+			return false
+		}
+		wh.logger.Errorf("Failed to record delivery %s: %v", delivery.GetDeliveryID(), err)
+		delivery.ID = 0
+	}
+	return true
+}
+
+// finishDelivery records the outcome of processing the given delivery, if the delivery was recorded.
+func (wh GitHubWebHook) finishDelivery(delivery *pb.WebhookDelivery, err error) {
+	if err != nil {
+		wh.logger.Errorf("Failed to process delivery %s of %s event for %s: %v", delivery.GetDeliveryID(), delivery.GetEvent(), delivery.GetRepository(), err)
+		delivery.Status = pb.WebhookDelivery_FAILED
+		delivery.Error = err.Error()
+	} else {
+		delivery.Status = pb.WebhookDelivery_PROCESSED
+		delivery.Error = ""
+	}
+	if delivery.GetID() == 0 {
+		return
+	}
+	delivery.Processed = time.Now().Format(pb.TimeLayout)
+	if err := wh.db.UpdateWebhookDelivery(delivery); err != nil {
+		wh.logger.Errorf("Failed to record outcome of delivery %s: %v", delivery.GetDeliveryID(), err)
+	}
+}
+
+// handleEvent processes the given webhook event.
+func (wh GitHubWebHook) handleEvent(event interface{}) error {
 	switch e := event.(type) {
 	case *github.PushEvent:
 		wh.logger.Debug(log.IndentJson(e))
-		wh.handlePush(e)
+		return wh.handlePush(e)
+	case *github.PullRequestEvent:
+		wh.logger.Debug(log.IndentJson(e))
+		return wh.handlePullRequest(e)
+	default:
+		wh.logger.Debugf("Ignored event type %T", event)
+		return nil
+	}
+}
+
+// eventRepository returns the full name of the repository the given event is for, if known.
+func eventRepository(event interface{}) string {
+	switch e := event.(type) {
+	case *github.PushEvent:
+		return e.GetRepo().GetFullName()
+	case *github.PullRequestEvent:
+		return e.GetRepo().GetFullName()
 	default:
-		wh.logger.Debugf("Ignored event type %s", github.WebHookType(r))
+		return ""
 	}
 }
 
-func (wh GitHubWebHook) handlePush(payload *github.PushEvent) {
+func (wh GitHubWebHook) handlePush(payload *github.PushEvent) error {
 	wh.logger.Debugf("Received push event for branch reference: %s (user's default branch: %s)",
 		payload.GetRef(), payload.GetRepo().GetDefaultBranch())
 	if !strings.HasSuffix(payload.GetRef(), payload.GetRepo().GetDefaultBranch()) {
 		wh.logger.Debugf("Ignoring push event for non-default branch: %s", payload.GetRef())
-		return
+		return nil
+	}
+	return wh.processPush(payload)
+}
+
+// ProcessMissedPush processes the given commits, newest first, as if they had been pushed to the
+// default branch of the given repository by the given sender, e.g., when their push event was missed
+// during a webhook outage. Commits that have already been submitted are not tested again.
+func (wh GitHubWebHook) ProcessMissedPush(repo *pb.Repository, sender string, commits []*scm.Commit) error {
+	if len(commits) == 0 {
+		return nil
 	}
+	payload := &github.PushEvent{
+		Repo:   &github.PushEventRepository{ID: github.Int64(int64(repo.GetRepositoryID())), Name: github.String(path.Base(repo.GetHTMLURL()))},
+		Sender: &github.User{Login: github.String(sender)},
+	}
+	for _, commit := range commits {
+		payload.Commits = append(payload.Commits, &github.HeadCommit{
+			ID:       github.String(commit.SHA),
+			Message:  github.String(commit.Message),
+			Modified: commit.Files,
+		})
+	}
+	payload.HeadCommit = payload.Commits[0]
+	return wh.processPush(payload)
+}
 
+// processPush processes a push event to the default branch of a repository.
+func (wh GitHubWebHook) processPush(payload *github.PushEvent) error {
 	repo, err := wh.db.GetRepositoryByRemoteID(uint64(payload.GetRepo().GetID()))
 	if err != nil {
-		wh.logger.Errorf("Failed to get repository by remote ID %d from database: %v", payload.GetRepo().GetID(), err)
-		return
+		return fmt.Errorf("failed to get repository by remote ID %d from database: %w", payload.GetRepo().GetID(), err)
 	}
 	wh.logger.Debugf("Received push event for repository %v", repo)
 
 	course, err := wh.db.GetCourseByOrganizationID(repo.OrganizationID)
 	if err != nil {
-		wh.logger.Errorf("Failed to get course from database: %v", err)
-		return
+		return fmt.Errorf("failed to get course from database: %w", err)
 	}
 	wh.logger.Debugf("For course(%d)=%v", course.GetID(), course.GetName())
+	if err := wh.db.UpdateCourseLastWebhook(course.GetID(), time.Now().Format(pb.TimeLayout)); err != nil {
+		wh.logger.Errorf("Failed to record push event for course(%d): %v", course.GetID(), err)
+	}
+	if course.GetArchived() {
+		wh.logger.Debugf("Ignoring push event for archived course(%d)", course.GetID())
+		return nil
+	}
+
+	if payload.GetForced() && (repo.IsUserRepo() || repo.IsGroupRepo()) {
+		wh.recordForcePush(payload, repo, course)
+	}
+	if repo.IsStudentRepo() && strings.HasPrefix(payload.GetHeadCommit().GetMessage(), assignments.ReleaseCommitPrefix) {
+		wh.logger.Debugf("Ignoring push event for assignment release to %s", payload.GetRepo().GetName())
+		return nil
+	}
 
 	switch {
 	case repo.IsTestsRepo():
@@ -88,7 +239,9 @@ func (wh GitHubWebHook) handlePush(payload *github.PushEvent) {
 		for _, assignment := range assignments {
 			if !assignment.IsGroupLab {
 				// only run non-group assignments
-				wh.runAssignmentTests(assignment, repo, course, payload)
+				if err := wh.runAssignmentTests(assignment, repo, course, payload); err != nil {
+					return err
+				}
 			} else {
 				wh.logger.Debugf("Ignoring assignment: %s, pushed to user repo: %s", assignment.GetName(), payload.GetRepo().GetName())
 			}
@@ -98,15 +251,16 @@ func (wh GitHubWebHook) handlePush(payload *github.PushEvent) {
 		wh.logger.Debugf("Processing push event for group repo %s", payload.GetRepo().GetName())
 		jobOwner, _, err := wh.db.GetUserByCourse(course, payload.GetSender().GetLogin())
 		if err != nil {
-			wh.logger.Errorf("Failed to find user %s in course %s: %v", payload.GetSender().GetLogin(), course.GetName(), err)
-			return
+			return fmt.Errorf("failed to find user %s in course %s: %w", payload.GetSender().GetLogin(), course.GetName(), err)
 		}
 		wh.updateLastActivityDate(jobOwner.ID, course.ID)
 		assignments := wh.extractAssignments(payload, course)
 		for _, assignment := range assignments {
 			if assignment.IsGroupLab {
 				// only run group assignments
-				wh.runAssignmentTests(assignment, repo, course, payload)
+				if err := wh.runAssignmentTests(assignment, repo, course, payload); err != nil {
+					return err
+				}
 			} else {
 				wh.logger.Debugf("Ignoring assignment: %s, pushed to group repo: %s", assignment.GetName(), payload.GetRepo().GetName())
 			}
@@ -115,11 +269,142 @@ func (wh GitHubWebHook) handlePush(payload *github.PushEvent) {
 	default:
 		wh.logger.Debug("Nothing to do for this push event")
 	}
+	return nil
+}
+
+// handlePullRequest runs the tests on the head commit of a pull request that is opened, reopened
+// or updated, for each assignment submitted by pull request to the pull request's base branch.
+func (wh GitHubWebHook) handlePullRequest(payload *github.PullRequestEvent) error {
+	switch payload.GetAction() {
+	case "opened", "reopened", "synchronize":
+	default:
+		wh.logger.Debugf("Ignoring pull request event with action: %s", payload.GetAction())
+		return nil
+	}
+	repo, err := wh.db.GetRepositoryByRemoteID(uint64(payload.GetRepo().GetID()))
+	if err != nil {
+		return fmt.Errorf("failed to get repository by remote ID %d from database: %w", payload.GetRepo().GetID(), err)
+	}
+	if !repo.IsUserRepo() && !repo.IsGroupRepo() {
+		wh.logger.Debugf("Ignoring pull request to repository %s", payload.GetRepo().GetName())
+		return nil
+	}
+	course, err := wh.db.GetCourseByOrganizationID(repo.OrganizationID)
+	if err != nil {
+		return fmt.Errorf("failed to get course from database: %w", err)
+	}
+	if course.GetArchived() {
+		wh.logger.Debugf("Ignoring pull request event for archived course(%d)", course.GetID())
+		return nil
+	}
+
+	pullRequest := payload.GetPullRequest()
+	if repo.IsGroupRepo() {
+		jobOwner, _, err := wh.db.GetUserByCourse(course, payload.GetSender().GetLogin())
+		if err != nil {
+			return fmt.Errorf("failed to find user %s in course %s: %w", payload.GetSender().GetLogin(), course.GetName(), err)
+		}
+		wh.updateLastActivityDate(jobOwner.ID, course.ID)
+	} else {
+		wh.updateLastActivityDate(repo.UserID, course.ID)
+	}
+	assignments, err := wh.db.GetAssignmentsByCourse(course.GetID(), false)
+	if err != nil {
+		return fmt.Errorf("failed to get assignments for course %s: %w", course.GetCode(), err)
+	}
+	for _, assignment := range assignments {
+		if assignment.GetSubmissionBranch() != pullRequest.GetBase().GetRef() || assignment.GetIsGroupLab() != repo.IsGroupRepo() {
+			continue
+		}
+		runData := &ci.RunData{
+			Course:      course,
+			Assignment:  assignment,
+			Repo:        repo,
+			CommitID:    pullRequest.GetHead().GetSHA(),
+			JobOwner:    payload.GetSender().GetLogin(),
+			PullRequest: uint64(pullRequest.GetNumber()),
+		}
+		if err := wh.submit(runData); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// recordForcePush records a force push to a student or group repository against
+// the repository owner's latest submission for each assignment, and notifies the
+// course teachers, since the force push has rewritten the history of these submissions.
+func (wh GitHubWebHook) recordForcePush(payload *github.PushEvent, repo *pb.Repository, course *pb.Course) {
+	wh.logger.Warnf("Force push to %s by %s: %s -> %s", payload.GetRepo().GetFullName(),
+		payload.GetSender().GetLogin(), payload.GetBefore(), payload.GetAfter())
+	submissions, err := wh.db.GetLastSubmissions(course.GetID(), &pb.Submission{UserID: repo.GetUserID(), GroupID: repo.GetGroupID()})
+	if err != nil {
+		wh.logger.Errorf("Failed to get submissions affected by force push to %s: %v", payload.GetRepo().GetName(), err)
+		return
+	}
+	courseAssignments, err := wh.db.GetAssignmentsByCourse(course.GetID(), false)
+	if err != nil {
+		wh.logger.Errorf("Failed to get assignments for course %s: %v", course.GetCode(), err)
+	}
+	assignments := make(map[uint64]*pb.Assignment)
+	for _, assignment := range courseAssignments {
+		assignments[assignment.GetID()] = assignment
+	}
+
+	now := time.Now()
+	var afterDeadline []string
+	for _, submission := range submissions {
+		forcePush := &pb.ForcePush{
+			CourseID:     course.GetID(),
+			SubmissionID: submission.GetID(),
+			BeforeCommit: payload.GetBefore(),
+			AfterCommit:  payload.GetAfter(),
+			Pusher:       payload.GetSender().GetLogin(),
+			Pushed:       now.Format(pb.TimeLayout),
+		}
+		if assignment, ok := assignments[submission.GetAssignmentID()]; ok {
+			if extension, err := wh.db.GetExtension(assignment.GetID(), submission.GetUserID(), submission.GetGroupID()); err == nil {
+				assignment = assignment.WithExtension(extension)
+			}
+			if sinceDeadline, err := assignment.SinceDeadline(now); err == nil && sinceDeadline > 0 {
+				forcePush.AfterDeadline = true
+				afterDeadline = append(afterDeadline, assignment.GetName())
+			}
+		}
+		if err := wh.db.CreateForcePush(forcePush); err != nil {
+			wh.logger.Errorf("Failed to record force push for submission %d: %v", submission.GetID(), err)
+		}
+	}
+	if len(submissions) == 0 {
+		return
+	}
+
+	teachers, err := wh.db.GetEnrollmentsByCourse(course.GetID(), pb.Enrollment_TEACHER)
+	if err != nil {
+		wh.logger.Errorf("Failed to notify teachers about force push to %s: %v", payload.GetRepo().GetName(), err)
+		return
+	}
+	var teacherIDs []uint64
+	for _, teacher := range teachers {
+		teacherIDs = append(teacherIDs, teacher.GetUserID())
+	}
+	body := fmt.Sprintf("%s force pushed to %s, rewriting the history of %d submission(s): %s -> %s.",
+		payload.GetSender().GetLogin(), payload.GetRepo().GetName(), len(submissions), payload.GetBefore(), payload.GetAfter())
+	if len(afterDeadline) > 0 {
+		body += fmt.Sprintf(" The deadline has passed for: %s.", strings.Join(afterDeadline, ", "))
+	}
+	wh.notifier.Dispatch(context.Background(), &notify.Notification{
+		CourseID: course.GetID(),
+		UserIDs:  teacherIDs,
+		Subject:  fmt.Sprintf("Force push to %s", payload.GetRepo().GetName()),
+		Body:     body,
+	})
 }
 
 // extractAssignments extracts information from the push payload from github
 // and determines the assignments that have been changed in this commit by
-// querying the database based on the lab name.
+// querying the database based on the lab name. Assignments submitted by
+// pull request are graded when their pull requests are updated, not on push.
 func (wh GitHubWebHook) extractAssignments(payload *github.PushEvent, course *pb.Course) []*pb.Assignment {
 	modifiedAssignments := make(map[string]bool)
 	for _, commit := range payload.Commits {
@@ -136,6 +421,10 @@ func (wh GitHubWebHook) extractAssignments(payload *github.PushEvent, course *pb
 			wh.logger.Errorf("Could not find assignment '%s' for course %d in database: %v", name, course.GetID(), err)
 			continue
 		}
+		if assignment.GetSubmissionBranch() != "" {
+			wh.logger.Debugf("Ignoring push for assignment %s submitted by pull request", name)
+			continue
+		}
 		assignments = append(assignments, assignment)
 	}
 	return assignments
@@ -158,42 +447,130 @@ func extractChanges(changes []string, modifiedAssignments map[string]bool) {
 	}
 }
 
-// runAssignmentTests runs the tests for the given assignment pushed to repo.
-func (wh GitHubWebHook) runAssignmentTests(assignment *pb.Assignment, repo *pb.Repository, course *pb.Course, payload *github.PushEvent) {
-	runData := &ci.RunData{
+// runAssignmentTests runs the tests for the given assignment pushed to repo,
+// unless the pushed commit has already been submitted for the assignment.
+func (wh GitHubWebHook) runAssignmentTests(assignment *pb.Assignment, repo *pb.Repository, course *pb.Course, payload *github.PushEvent) error {
+	return wh.submit(&ci.RunData{
 		Course:     course,
 		Assignment: assignment,
 		Repo:       repo,
 		CommitID:   payload.GetHeadCommit().GetID(),
 		JobOwner:   payload.GetSender().GetLogin(),
+	})
+}
+
+// submit runs the tests specified by the run data, unless its commit has already been
+// submitted for the assignment, or the commit of an exam is pushed outside the exam window.
+// Submissions of manually reviewed assignments are recorded without tests.
+func (wh GitHubWebHook) submit(runData *ci.RunData) error {
+	assignment, course := runData.Assignment, runData.Course
+	if assignment.GetExam() {
+		open, err := wh.inExamWindow(assignment, runData.Repo, time.Now())
+		if err != nil {
+			return err
+		}
+		if !open {
+			wh.logger.Debugf("Ignoring commit %s for exam %s pushed by %s outside the exam window", runData.CommitID, assignment.GetName(), runData.JobOwner)
+			return nil
+		}
+	}
+	commit, ok, err := wh.markSubmitted(runData)
+	if err != nil {
+		return err
 	}
-	if assignment.GradedManually() {
+	if !ok {
+		wh.logger.Debugf("Commit %s for assignment %s is already submitted by %s", runData.CommitID, assignment.GetName(), runData.JobOwner)
+		return nil
+	}
+	if assignment.SkipTests() {
 		wh.logger.Debugf("Assignment %s for course %s is manually reviewed", assignment.Name, course.Name)
-		wh.recordSubmissionWithoutTests(runData)
-		return
+		if err := wh.recordSubmissionWithoutTests(runData); err != nil {
+			// the commit is submitted again when the delivery is redelivered or replayed
+			if commit != nil {
+				if err := wh.db.DeleteSubmittedCommit(commit.GetID()); err != nil {
+					wh.logger.Errorf("Failed to delete submitted commit %s: %v", runData.CommitID, err)
+				}
+			}
+			return err
+		}
+		return nil
+	}
+	// the tests run in the background, so that bursts of pushes do not hold up webhook deliveries
+	wh.runners.Submit(runData)
+	return nil
+}
+
+// inExamWindow returns true if the given time is within the exam window of the repository's owner,
+// which ends at the deadline of any extension granted to the owner.
+func (wh GitHubWebHook) inExamWindow(assignment *pb.Assignment, repo *pb.Repository, now time.Time) (bool, error) {
+	if extension, err := wh.db.GetExtension(assignment.GetID(), repo.GetUserID(), repo.GetGroupID()); err == nil {
+		assignment = assignment.WithExtension(extension)
 	}
-	ci.RunTests(wh.logger, wh.db, wh.runner, runData)
+	open, err := assignment.InExamWindow(now)
+	if err != nil {
+		return false, fmt.Errorf("invalid exam window of assignment %s: %w", assignment.GetName(), err)
+	}
+	return open, nil
+}
+
+// markSubmitted records the run data's commit as submitted for the assignment by the repository's
+// owner, and returns false if the commit was already submitted, or is the commit of the owner's
+// most recent submission, e.g., recorded before submitted commits were. This makes submissions
+// idempotent per commit, such that push events that are delivered more than once, e.g., redelivered
+// or replayed, do not test the same commit twice, or overwrite the results of newer commits.
+// The returned record is nil if the run data has no commit.
+func (wh GitHubWebHook) markSubmitted(data *ci.RunData) (*pb.SubmittedCommit, bool, error) {
+	if data.CommitID == "" {
+		return nil, true, nil
+	}
+	submission, err := wh.db.GetSubmission(&pb.Submission{
+		AssignmentID: data.Assignment.GetID(),
+		UserID:       data.Repo.GetUserID(),
+		GroupID:      data.Repo.GetGroupID(),
+	})
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, false, fmt.Errorf("failed to get submission for assignment %d: %w", data.Assignment.GetID(), err)
+	}
+	if submission.GetCommitHash() == data.CommitID {
+		return nil, false, nil
+	}
+	commit := &pb.SubmittedCommit{
+		AssignmentID: data.Assignment.GetID(),
+		UserID:       data.Repo.GetUserID(),
+		GroupID:      data.Repo.GetGroupID(),
+		CommitID:     data.CommitID,
+		Submitted:    time.Now().UTC().Format(pb.TimeLayout),
+	}
+	if err := wh.db.CreateSubmittedCommit(commit); err != nil {
+		if errors.Is(err, database.ErrDuplicateSubmittedCommit) {
+			return nil, false, nil
+		}
+		return nil, false, fmt.Errorf("failed to record commit %s for assignment %d: %w", data.CommitID, data.Assignment.GetID(), err)
+	}
+	return commit, true, nil
 }
 
 // recordSubmissionWithoutTests saves a new submission without running any tests
 // for a manually graded assignment.
-func (wh GitHubWebHook) recordSubmissionWithoutTests(data *ci.RunData) {
+func (wh GitHubWebHook) recordSubmissionWithoutTests(data *ci.RunData) error {
 	newSubmission := &pb.Submission{
 		AssignmentID: data.Assignment.ID,
 		BuildInfo: &score.BuildInfo{
-			BuildDate: time.Now().Format(pb.TimeLayout),
+			BuildDate: time.Now().UTC().Format(pb.TimeLayout),
 			BuildLog:  "No automated tests for this assignment",
 			ExecTime:  1,
 		},
-		CommitHash: data.CommitID,
-		UserID:     data.Repo.UserID,
-		GroupID:    data.Repo.GroupID,
+		CommitHash:  data.CommitID,
+		UserID:      data.Repo.UserID,
+		GroupID:     data.Repo.GroupID,
+		PullRequest: data.PullRequest,
 	}
 	if err := wh.db.CreateSubmission(newSubmission); err != nil {
-		wh.logger.Errorf("Failed to save submission for user %s, assignment %d: %v", data.JobOwner, data.Assignment.ID, err)
-		return
+		return fmt.Errorf("failed to save submission for user %s, assignment %d: %w", data.JobOwner, data.Assignment.ID, err)
 	}
+	wh.runners.Results().Publish(data.Course.GetID(), newSubmission)
 	wh.logger.Debugf("Saved manual review submission for user %s for assignment %d", data.JobOwner, data.Assignment.ID)
+	return nil
 }
 
 // updateLastActivityDate sets a current date as a last activity date of the student
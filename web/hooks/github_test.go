@@ -1,16 +1,29 @@
 package hooks
 
 import (
+	"bytes"
 	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"log"
 	"net/http"
+	"net/http/httptest"
 	"testing"
+	"time"
 
+	pb "github.com/autograde/quickfeed/ag"
 	"github.com/autograde/quickfeed/ci"
 	"github.com/autograde/quickfeed/database"
+	"github.com/autograde/quickfeed/internal/qtest"
 	logq "github.com/autograde/quickfeed/log"
+	"github.com/autograde/quickfeed/notify"
 	"github.com/autograde/quickfeed/scm"
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-github/v35/github"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/testing/protocmp"
 )
 
 const (
@@ -69,7 +82,7 @@ func TestGitHubWebHook(t *testing.T) {
 	// TODO(meling) db is nil; will cause handling of push event to panic; will need a database with content for this to work fully.
 	var db database.Database
 	var runner ci.Runner
-	webhook := NewGitHubWebHook(logger, db, runner, secret)
+	webhook := NewGitHubWebHook(logger, db, ci.NewPools(logger, ci.NewQueue(logger, db, runner, 1)), secret, notify.NewDispatcher(logger.Desugar()))
 
 	log.Println("starting webhook server")
 	http.HandleFunc("/webhook", webhook.Handle)
@@ -99,3 +112,356 @@ func TestExtractChanges(t *testing.T) {
 		t.Fatalf("content mismatch (-want +got):\n%s", diff)
 	}
 }
+
+type testNotifier struct {
+	notifications []*notify.Notification
+}
+
+func (n *testNotifier) Notify(_ context.Context, notification *notify.Notification) error {
+	n.notifications = append(n.notifications, notification)
+	return nil
+}
+
+func TestForcePush(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	teacher := qtest.CreateFakeUser(t, db, 1)
+	student := qtest.CreateFakeUser(t, db, 2)
+	course := &pb.Course{OrganizationID: 1}
+	qtest.CreateCourse(t, db, teacher, course)
+	qtest.EnrollStudent(t, db, student, course)
+	repo := &pb.Repository{OrganizationID: 1, RepositoryID: 42, UserID: student.ID, RepoType: pb.Repository_USER}
+	if err := db.CreateRepository(repo); err != nil {
+		t.Fatal(err)
+	}
+	assignment := &pb.Assignment{CourseID: course.ID, Name: "lab1", Order: 1, Deadline: "2021-01-01T00:00:00"}
+	if err := db.CreateAssignment(assignment); err != nil {
+		t.Fatal(err)
+	}
+	submission := &pb.Submission{AssignmentID: assignment.ID, UserID: student.ID, CommitHash: "abc"}
+	if err := db.CreateSubmission(submission); err != nil {
+		t.Fatal(err)
+	}
+
+	notifier := &testNotifier{}
+	webhook := NewGitHubWebHook(zap.NewNop().Sugar(), db, nil, secret, notify.NewDispatcher(zap.NewNop(), notifier))
+	payload := &github.PushEvent{
+		Ref:    github.String("refs/heads/main"),
+		Forced: github.Bool(true),
+		Before: github.String("abc"),
+		After:  github.String("def"),
+		Repo:   &github.PushEventRepository{ID: github.Int64(42), Name: github.String("student-labs"), DefaultBranch: github.String("main")},
+		Sender: &github.User{Login: github.String("student")},
+	}
+	webhook.handlePush(payload)
+
+	forcePushes, err := db.GetForcePushes(course.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*pb.ForcePush{{
+		ID:            1,
+		CourseID:      course.ID,
+		SubmissionID:  submission.ID,
+		BeforeCommit:  "abc",
+		AfterCommit:   "def",
+		Pusher:        "student",
+		AfterDeadline: true,
+	}}
+	if diff := cmp.Diff(want, forcePushes, protocmp.Transform(), protocmp.IgnoreFields(&pb.ForcePush{}, "pushed")); diff != "" {
+		t.Errorf("GetForcePushes() mismatch (-want +got):\n%s", diff)
+	}
+	if len(notifier.notifications) != 1 {
+		t.Fatalf("got %d notifications, want 1", len(notifier.notifications))
+	}
+	if diff := cmp.Diff([]uint64{teacher.ID}, notifier.notifications[0].UserIDs); diff != "" {
+		t.Errorf("notified users mismatch (-want +got):\n%s", diff)
+	}
+
+	// regular pushes are not recorded
+	payload.Forced = github.Bool(false)
+	webhook.handlePush(payload)
+	if forcePushes, err = db.GetForcePushes(course.ID); err != nil {
+		t.Fatal(err)
+	}
+	if len(forcePushes) != 1 {
+		t.Errorf("got %d force pushes after regular push, want 1", len(forcePushes))
+	}
+}
+
+// deliver delivers the given push event to the webhook with the given delivery ID.
+func deliver(t *testing.T, webhook *GitHubWebHook, deliveryID string, event *github.PushEvent) {
+	t.Helper()
+	payload, err := json.Marshal(event)
+	if err != nil {
+		t.Fatal(err)
+	}
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	req := httptest.NewRequest(http.MethodPost, "/hook/github/events", bytes.NewReader(payload))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-GitHub-Event", "push")
+	req.Header.Set("X-GitHub-Delivery", deliveryID)
+	req.Header.Set("X-Hub-Signature-256", "sha256="+hex.EncodeToString(mac.Sum(nil)))
+	webhook.Handle(httptest.NewRecorder(), req)
+}
+
+func TestWebhookDeliveries(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	teacher := qtest.CreateFakeUser(t, db, 1)
+	student := qtest.CreateFakeUser(t, db, 2)
+	course := &pb.Course{OrganizationID: 1}
+	qtest.CreateCourse(t, db, teacher, course)
+	qtest.EnrollStudent(t, db, student, course)
+	repo := &pb.Repository{OrganizationID: 1, RepositoryID: 42, UserID: student.ID, RepoType: pb.Repository_USER}
+	if err := db.CreateRepository(repo); err != nil {
+		t.Fatal(err)
+	}
+	// manually reviewed, such that submissions are recorded without running tests
+	assignment := &pb.Assignment{CourseID: course.ID, Name: "lab1", Order: 1, Reviewers: 1}
+	if err := db.CreateAssignment(assignment); err != nil {
+		t.Fatal(err)
+	}
+
+	logger := zap.NewNop().Sugar()
+	runners := ci.NewPools(logger, ci.NewQueue(logger, db, &ci.Local{}, 1))
+	webhook := NewGitHubWebHook(logger, db, runners, secret, notify.NewDispatcher(zap.NewNop()))
+	push := func(repoID int64, commitID string) *github.PushEvent {
+		return &github.PushEvent{
+			Ref:        github.String("refs/heads/main"),
+			Repo:       &github.PushEventRepository{ID: github.Int64(repoID), Name: github.String("student-labs"), FullName: github.String("course/student-labs"), DefaultBranch: github.String("main")},
+			Sender:     &github.User{Login: github.String("student")},
+			HeadCommit: &github.HeadCommit{ID: github.String(commitID)},
+			Commits:    []*github.HeadCommit{{ID: github.String(commitID), Modified: []string{"lab1/main.go"}}},
+		}
+	}
+	submissions := func(user *pb.User) []*pb.Submission {
+		t.Helper()
+		submissions, err := db.GetSubmissions(&pb.Submission{AssignmentID: assignment.ID, UserID: user.ID})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return submissions
+	}
+	delivery := func(deliveryID string) *pb.WebhookDelivery {
+		t.Helper()
+		delivery, err := db.GetWebhookDelivery(deliveryID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return delivery
+	}
+
+	deliver(t, webhook, "d1", push(42, "c1"))
+	if got := submissions(student); len(got) != 1 || got[0].GetCommitHash() != "c1" {
+		t.Fatalf("submissions after first delivery = %v, want one submission of c1", got)
+	}
+	if got := delivery("d1"); got.GetStatus() != pb.WebhookDelivery_PROCESSED || got.GetDeliveries() != 1 || got.GetRepository() != "course/student-labs" {
+		t.Errorf("delivery d1 = %v, want processed once", got)
+	}
+
+	// redeliveries of processed deliveries are ignored
+	deliver(t, webhook, "d1", push(42, "c1"))
+	if got := delivery("d1"); got.GetDeliveries() != 1 {
+		t.Errorf("delivery d1 processed %d times, want 1", got.GetDeliveries())
+	}
+	// other deliveries of the same commit do not create new submissions
+	deliver(t, webhook, "d2", push(42, "c1"))
+	if got := submissions(student); len(got) != 1 {
+		t.Errorf("got %d submissions after delivering the same commit again, want 1", len(got))
+	}
+	if got := delivery("d2"); got.GetStatus() != pb.WebhookDelivery_PROCESSED {
+		t.Errorf("delivery d2 = %v, want processed", got)
+	}
+
+	// deliveries of older commits are not submitted again after newer commits
+	deliver(t, webhook, "d4", push(42, "c3"))
+	deliver(t, webhook, "d5", push(42, "c1"))
+	if got := submissions(student); len(got) != 2 || got[1].GetCommitHash() != "c3" {
+		t.Errorf("submissions after delivering an older commit = %v, want submissions of c1 and c3", got)
+	}
+
+	// deliveries for unknown repositories fail, and are processed again when redelivered
+	deliver(t, webhook, "d3", push(43, "c2"))
+	if got := delivery("d3"); got.GetStatus() != pb.WebhookDelivery_FAILED || got.GetError() == "" {
+		t.Errorf("delivery d3 = %v, want failed", got)
+	}
+	other := qtest.CreateFakeUser(t, db, 3)
+	qtest.EnrollStudent(t, db, other, course)
+	if err := db.CreateRepository(&pb.Repository{OrganizationID: 1, RepositoryID: 43, UserID: other.ID, RepoType: pb.Repository_USER}); err != nil {
+		t.Fatal(err)
+	}
+	deliver(t, webhook, "d3", push(43, "c2"))
+	if got := delivery("d3"); got.GetStatus() != pb.WebhookDelivery_PROCESSED || got.GetDeliveries() != 2 || got.GetError() != "" {
+		t.Errorf("delivery d3 = %v, want processed on second delivery", got)
+	}
+	if got := submissions(other); len(got) != 1 || got[0].GetCommitHash() != "c2" {
+		t.Errorf("submissions after redelivery = %v, want one submission of c2", got)
+	}
+
+	failed, err := db.GetWebhookDeliveries(&pb.WebhookDelivery{Status: pb.WebhookDelivery_FAILED})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(failed) != 0 {
+		t.Errorf("got %d failed deliveries, want 0", len(failed))
+	}
+}
+
+func TestPullRequest(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	teacher := qtest.CreateFakeUser(t, db, 1)
+	student := qtest.CreateFakeUser(t, db, 2)
+	course := &pb.Course{OrganizationID: 1}
+	qtest.CreateCourse(t, db, teacher, course)
+	qtest.EnrollStudent(t, db, student, course)
+	repo := &pb.Repository{OrganizationID: 1, RepositoryID: 42, UserID: student.ID, RepoType: pb.Repository_USER}
+	if err := db.CreateRepository(repo); err != nil {
+		t.Fatal(err)
+	}
+	// manually reviewed, such that submissions are recorded without running tests
+	lab1 := &pb.Assignment{CourseID: course.ID, Name: "lab1", Order: 1, Reviewers: 1, SubmissionBranch: "submit"}
+	lab2 := &pb.Assignment{CourseID: course.ID, Name: "lab2", Order: 2, Reviewers: 1}
+	for _, assignment := range []*pb.Assignment{lab1, lab2} {
+		if err := db.CreateAssignment(assignment); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	logger := zap.NewNop().Sugar()
+	runners := ci.NewPools(logger, ci.NewQueue(logger, db, &ci.Local{}, 1))
+	webhook := NewGitHubWebHook(logger, db, runners, secret, notify.NewDispatcher(zap.NewNop()))
+	pullRequest := func(action, base, head string) *github.PullRequestEvent {
+		return &github.PullRequestEvent{
+			Action: github.String(action),
+			Repo:   &github.Repository{ID: github.Int64(42), Name: github.String("student-labs")},
+			Sender: &github.User{Login: github.String("student")},
+			PullRequest: &github.PullRequest{
+				Number: github.Int(7),
+				Base:   &github.PullRequestBranch{Ref: github.String(base)},
+				Head:   &github.PullRequestBranch{Ref: github.String("lab1"), SHA: github.String(head)},
+			},
+		}
+	}
+	submissions := func(assignment *pb.Assignment) []*pb.Submission {
+		t.Helper()
+		submissions, err := db.GetSubmissions(&pb.Submission{AssignmentID: assignment.ID, UserID: student.ID})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return submissions
+	}
+
+	for _, event := range []*github.PullRequestEvent{
+		pullRequest("opened", "main", "c1"),
+		pullRequest("closed", "submit", "c1"),
+		pullRequest("opened", "submit", "c1"),
+		pullRequest("synchronize", "submit", "c2"),
+	} {
+		if err := webhook.handlePullRequest(event); err != nil {
+			t.Fatal(err)
+		}
+	}
+	got := submissions(lab1)
+	if len(got) != 2 || got[0].GetCommitHash() != "c1" || got[1].GetCommitHash() != "c2" || got[1].GetPullRequest() != 7 {
+		t.Errorf("lab1 submissions = %v, want submissions of c1 and c2 by pull request 7", got)
+	}
+	if got := submissions(lab2); len(got) != 0 {
+		t.Errorf("lab2 submissions = %v, want none", got)
+	}
+
+	// pushes are not graded for assignments submitted by pull request
+	if err := webhook.handlePush(&github.PushEvent{
+		Ref:        github.String("refs/heads/main"),
+		Repo:       &github.PushEventRepository{ID: github.Int64(42), Name: github.String("student-labs"), DefaultBranch: github.String("main")},
+		Sender:     &github.User{Login: github.String("student")},
+		HeadCommit: &github.HeadCommit{ID: github.String("c3")},
+		Commits:    []*github.HeadCommit{{ID: github.String("c3"), Modified: []string{"lab1/main.go", "lab2/main.go"}}},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if got := submissions(lab1); len(got) != 2 {
+		t.Errorf("lab1 submissions after push = %v, want submissions of c1 and c2", got)
+	}
+	if got := submissions(lab2); len(got) != 1 || got[0].GetCommitHash() != "c3" || got[0].GetPullRequest() != 0 {
+		t.Errorf("lab2 submissions after push = %v, want one submission of c3", got)
+	}
+}
+
+func TestExamPush(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	teacher := qtest.CreateFakeUser(t, db, 1)
+	student := qtest.CreateFakeUser(t, db, 2)
+	course := &pb.Course{OrganizationID: 1}
+	qtest.CreateCourse(t, db, teacher, course)
+	qtest.EnrollStudent(t, db, student, course)
+	repo := &pb.Repository{OrganizationID: 1, RepositoryID: 42, UserID: student.ID, RepoType: pb.Repository_USER}
+	if err := db.CreateRepository(repo); err != nil {
+		t.Fatal(err)
+	}
+	// manually reviewed, such that submissions are recorded without running tests
+	now := time.Now().UTC()
+	exam := &pb.Assignment{
+		CourseID:  course.ID,
+		Name:      "exam",
+		Order:     1,
+		Reviewers: 1,
+		Exam:      true,
+		ExamStart: now.Add(-2 * time.Hour).Format(pb.TimeLayout),
+		Deadline:  now.Add(-time.Hour).Format(pb.TimeLayout),
+	}
+	if err := db.CreateAssignment(exam); err != nil {
+		t.Fatal(err)
+	}
+
+	logger := zap.NewNop().Sugar()
+	runners := ci.NewPools(logger, ci.NewQueue(logger, db, &ci.Local{}, 1))
+	webhook := NewGitHubWebHook(logger, db, runners, secret, notify.NewDispatcher(zap.NewNop()))
+	push := func(commit string) {
+		t.Helper()
+		if err := webhook.handlePush(&github.PushEvent{
+			Ref:        github.String("refs/heads/main"),
+			Repo:       &github.PushEventRepository{ID: github.Int64(42), Name: github.String("student-labs"), DefaultBranch: github.String("main")},
+			Sender:     &github.User{Login: github.String("student")},
+			HeadCommit: &github.HeadCommit{ID: github.String(commit)},
+			Commits:    []*github.HeadCommit{{ID: github.String(commit), Modified: []string{"exam/main.go"}}},
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	submissions := func() []*pb.Submission {
+		t.Helper()
+		submissions, err := db.GetSubmissions(&pb.Submission{AssignmentID: exam.ID, UserID: student.ID})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return submissions
+	}
+
+	// pushes after the exam window are not submitted
+	push("c1")
+	if got := submissions(); len(got) != 0 {
+		t.Errorf("submissions after the exam window = %v, want none", got)
+	}
+
+	// the exam window of students with an extension ends at the extension's deadline
+	if err := db.UpdateExtension(&pb.Extension{
+		CourseID:     course.ID,
+		AssignmentID: exam.ID,
+		UserID:       student.ID,
+		Deadline:     now.Add(time.Hour).Format(pb.TimeLayout),
+	}); err != nil {
+		t.Fatal(err)
+	}
+	push("c2")
+	if got := submissions(); len(got) != 1 || got[0].GetCommitHash() != "c2" {
+		t.Errorf("submissions within the extended exam window = %v, want one submission of c2", got)
+	}
+}
@@ -0,0 +1,106 @@
+package hooks
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/ci"
+	"github.com/autograde/quickfeed/database"
+	"github.com/autograde/quickfeed/notify"
+	"github.com/google/go-github/v35/github"
+	gitlab "github.com/xanzy/go-gitlab"
+	"go.uber.org/zap"
+)
+
+// GitLabWebHook handles webhook events from GitLab group hooks and project hooks.
+// Events are converted to the corresponding GitHub events, such that they are
+// processed, recorded and replayed the same way as GitHub's events.
+type GitLabWebHook struct {
+	GitHubWebHook
+}
+
+// NewGitLabWebHook creates a new webhook to handle POST requests from GitLab to the QuickFeed server.
+func NewGitLabWebHook(logger *zap.SugaredLogger, db database.Database, runners *ci.Pools, secret string, notifier *notify.Dispatcher) *GitLabWebHook {
+	return &GitLabWebHook{GitHubWebHook: *NewGitHubWebHook(logger, db, runners, secret, notifier)}
+}
+
+// Handle take POST requests from GitLab, representing Push events associated with course
+// repositories. A single group hook delivers the events of all projects in the course's group,
+// and each event is routed to the repository record of the project it was pushed to.
+func (wh GitLabWebHook) Handle(w http.ResponseWriter, r *http.Request) {
+	if subtle.ConstantTimeCompare([]byte(r.Header.Get("X-Gitlab-Token")), []byte(wh.secret)) != 1 {
+		wh.logger.Error("Error in request: invalid GitLab webhook token")
+		return
+	}
+	payload, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		wh.logger.Errorf("Error in request body: %v", err)
+		return
+	}
+	defer r.Body.Close()
+
+	event, err := gitlab.ParseWebhook(gitlab.HookEventType(r), payload)
+	if err != nil {
+		wh.logger.Errorf("Could not parse gitlab webhook: %v", err)
+		return
+	}
+	pushEvent, ok := event.(*gitlab.PushEvent)
+	if !ok {
+		wh.logger.Debugf("Ignored event type %T", event)
+		return
+	}
+	push := gitlabPushEvent(pushEvent)
+	// the delivery is recorded as a GitHub push event, such that it can be replayed
+	githubPayload, err := json.Marshal(push)
+	if err != nil {
+		wh.logger.Errorf("Could not convert gitlab webhook: %v", err)
+		return
+	}
+	delivery := &pb.WebhookDelivery{
+		DeliveryID: r.Header.Get("X-Gitlab-Event-UUID"),
+		Event:      "push",
+		Repository: eventRepository(push),
+		Payload:    githubPayload,
+	}
+	if !wh.startDelivery(delivery) {
+		wh.logger.Debugf("Ignoring duplicate delivery %s of %s event", delivery.GetDeliveryID(), delivery.GetEvent())
+		return
+	}
+	wh.finishDelivery(delivery, wh.handleEvent(push))
+}
+
+// gitlabPushEvent converts the given GitLab push event to the corresponding GitHub push event.
+// The project ID of the GitLab event identifies the repository record, like GitHub's repository ID.
+func gitlabPushEvent(event *gitlab.PushEvent) *github.PushEvent {
+	push := &github.PushEvent{
+		Ref:    github.String(event.Ref),
+		Before: github.String(event.Before),
+		After:  github.String(event.After),
+		Repo: &github.PushEventRepository{
+			ID:            github.Int64(int64(event.ProjectID)),
+			Name:          github.String(event.Project.Name),
+			FullName:      github.String(event.Project.PathWithNamespace),
+			DefaultBranch: github.String(event.Project.DefaultBranch),
+			HTMLURL:       github.String(event.Project.WebURL),
+		},
+		Sender: &github.User{Login: github.String(event.UserUsername)},
+	}
+	for _, c := range event.Commits {
+		commit := &github.HeadCommit{
+			ID:       github.String(c.ID),
+			Message:  github.String(c.Message),
+			URL:      github.String(c.URL),
+			Added:    c.Added,
+			Removed:  c.Removed,
+			Modified: c.Modified,
+		}
+		push.Commits = append(push.Commits, commit)
+		if c.ID == event.After {
+			push.HeadCommit = commit
+		}
+	}
+	return push
+}
@@ -0,0 +1,117 @@
+package hooks
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/ci"
+	"github.com/autograde/quickfeed/internal/qtest"
+	"github.com/autograde/quickfeed/notify"
+	"go.uber.org/zap"
+)
+
+// deliverGitLab delivers the given GitLab push event to the webhook with the given token and event UUID.
+func deliverGitLab(t *testing.T, webhook *GitLabWebHook, token, uuid string, event map[string]interface{}) {
+	t.Helper()
+	payload, err := json.Marshal(event)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req := httptest.NewRequest(http.MethodPost, "/hook/gitlab/events", bytes.NewReader(payload))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Gitlab-Event", "Push Hook")
+	req.Header.Set("X-Gitlab-Event-UUID", uuid)
+	req.Header.Set("X-Gitlab-Token", token)
+	webhook.Handle(httptest.NewRecorder(), req)
+}
+
+func TestGitLabWebHook(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	teacher := qtest.CreateFakeUser(t, db, 1)
+	student := qtest.CreateFakeUser(t, db, 2)
+	course := &pb.Course{OrganizationID: 1}
+	qtest.CreateCourse(t, db, teacher, course)
+	qtest.EnrollStudent(t, db, student, course)
+	// manually reviewed, such that submissions are recorded without running tests
+	assignment := &pb.Assignment{CourseID: course.ID, Name: "lab1", Order: 1, Reviewers: 1}
+	if err := db.CreateAssignment(assignment); err != nil {
+		t.Fatal(err)
+	}
+	other := qtest.CreateFakeUser(t, db, 3)
+	qtest.EnrollStudent(t, db, other, course)
+	for _, repo := range []*pb.Repository{
+		{OrganizationID: 1, RepositoryID: 42, UserID: student.ID, RepoType: pb.Repository_USER},
+		{OrganizationID: 1, RepositoryID: 43, UserID: other.ID, RepoType: pb.Repository_USER},
+	} {
+		if err := db.CreateRepository(repo); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	logger := zap.NewNop().Sugar()
+	runners := ci.NewPools(logger, ci.NewQueue(logger, db, &ci.Local{}, 1))
+	webhook := NewGitLabWebHook(logger, db, runners, secret, notify.NewDispatcher(zap.NewNop()))
+	push := func(projectID int, commitID string) map[string]interface{} {
+		return map[string]interface{}{
+			"object_kind":   "push",
+			"ref":           "refs/heads/main",
+			"before":        "0000000",
+			"after":         commitID,
+			"user_username": "student",
+			"project_id":    projectID,
+			"project": map[string]interface{}{
+				"name":                "student-labs",
+				"path_with_namespace": "course/student-labs",
+				"default_branch":      "main",
+			},
+			"commits": []map[string]interface{}{
+				{"id": commitID, "message": "lab1", "modified": []string{"lab1/main.go"}},
+			},
+		}
+	}
+	submissions := func(user *pb.User) []*pb.Submission {
+		t.Helper()
+		submissions, err := db.GetSubmissions(&pb.Submission{AssignmentID: assignment.ID, UserID: user.ID})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return submissions
+	}
+
+	// events with an invalid token are ignored
+	deliverGitLab(t, webhook, "wrong-secret", "u0", push(42, "c0"))
+	if got := submissions(student); len(got) != 0 {
+		t.Errorf("submissions after delivery with invalid token = %v, want none", got)
+	}
+
+	// the group hook's events are routed to the repository of the project pushed to
+	deliverGitLab(t, webhook, secret, "u1", push(42, "c1"))
+	deliverGitLab(t, webhook, secret, "u2", push(43, "c2"))
+	if got := submissions(student); len(got) != 1 || got[0].GetCommitHash() != "c1" {
+		t.Errorf("submissions of student = %v, want one submission of c1", got)
+	}
+	if got := submissions(other); len(got) != 1 || got[0].GetCommitHash() != "c2" {
+		t.Errorf("submissions of other student = %v, want one submission of c2", got)
+	}
+
+	// deliveries are recorded as GitHub push events, such that they can be replayed
+	delivery, err := db.GetWebhookDelivery("u1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if delivery.GetStatus() != pb.WebhookDelivery_PROCESSED || delivery.GetEvent() != "push" || delivery.GetRepository() != "course/student-labs" {
+		t.Errorf("delivery u1 = %v, want processed push event for course/student-labs", delivery)
+	}
+	if err := webhook.Replay(delivery); err != nil {
+		t.Errorf("Replay() = %v", err)
+	}
+	if got := submissions(student); len(got) != 1 {
+		t.Errorf("got %d submissions after replaying the delivery, want 1", len(got))
+	}
+}
@@ -0,0 +1,45 @@
+package web
+
+import (
+	"errors"
+	"fmt"
+
+	pb "github.com/autograde/quickfeed/ag"
+)
+
+// errIdentityInUse is returned when removing a remote identity needed by one of the user's courses.
+var errIdentityInUse = errors.New("remote identity is in use")
+
+// getRemoteIdentities returns the user's remote identities without their access tokens.
+func getRemoteIdentities(user *pb.User) *pb.RemoteIdentities {
+	identities := make([]*pb.RemoteIdentity, 0, len(user.GetRemoteIdentities()))
+	for _, remoteID := range user.GetRemoteIdentities() {
+		identities = append(identities, &pb.RemoteIdentity{
+			ID:       remoteID.GetID(),
+			Provider: remoteID.GetProvider(),
+			RemoteID: remoteID.GetRemoteID(),
+			UserID:   remoteID.GetUserID(),
+			Login:    user.GetLoginFor(remoteID.GetProvider()),
+		})
+	}
+	return &pb.RemoteIdentities{Identities: identities}
+}
+
+// unlinkRemoteIdentity removes the user's remote identity for the given provider.
+// The identity cannot be removed while the user is enrolled in a course on the provider,
+// since the identity is needed to access the course's repositories.
+func (s *AutograderService) unlinkRemoteIdentity(user *pb.User, provider string) error {
+	if user.GetRemoteIDFor(provider) == nil {
+		return fmt.Errorf("user %s has no %s identity", user.GetLogin(), provider)
+	}
+	enrollments, err := s.db.GetEnrollmentsByUser(user.GetID())
+	if err != nil {
+		return err
+	}
+	for _, enrollment := range enrollments {
+		if enrollment.GetCourse().GetProvider() == provider {
+			return fmt.Errorf("%w: user %s is enrolled in course %s", errIdentityInUse, user.GetLogin(), enrollment.GetCourse().GetCode())
+		}
+	}
+	return s.db.UnlinkRemoteIdentity(user.GetID(), provider)
+}
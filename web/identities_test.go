@@ -0,0 +1,117 @@
+package web_test
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/ci"
+	"github.com/autograde/quickfeed/internal/qtest"
+	"github.com/autograde/quickfeed/scm"
+	"github.com/autograde/quickfeed/web"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestRemoteIdentities(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	fakeGothProvider()
+	admin := qtest.CreateFakeUser(t, db, 1)
+	ctx := withUserContext(context.Background(), admin)
+	fakeProvider, scms := qtest.FakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	org, err := fakeProvider.CreateOrganization(ctx, &scm.OrganizationOptions{Path: "path", Name: "name"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	course, err := ags.CreateCourse(ctx, &pb.Course{Name: "Distributed Systems", Code: "DAT520", Year: 2018, Provider: "fake", OrganizationID: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.AssociateUserWithRemoteIdentity(admin.ID, "gitlab", 100, "", "token"); err != nil {
+		t.Fatal(err)
+	}
+	admin, err = db.GetUser(admin.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	gitlabCourse := &pb.Course{Name: "Operating Systems", Code: "DAT320", Year: 2018, Provider: "gitlab", OrganizationID: 2}
+	qtest.CreateCourse(t, db, admin, gitlabCourse)
+
+	// alice signed up with GitLab, and has linked her account on the fake provider with another login
+	alice := &pb.User{Login: "alice"}
+	if err := db.CreateUserFromRemoteIdentity(alice, &pb.RemoteIdentity{Provider: "gitlab", RemoteID: 2, AccessToken: "secret"}); err != nil {
+		t.Fatal(err)
+	}
+	aliceCtx := withUserContext(context.Background(), alice)
+
+	// alice cannot enroll in a course on a provider without a linked identity
+	_, err = ags.CreateEnrollment(aliceCtx, &pb.Enrollment{CourseID: course.ID, UserID: alice.ID})
+	if err != web.ErrMissingIdentity {
+		t.Errorf("CreateEnrollment() without identity: %v, want %v", err, web.ErrMissingIdentity)
+	}
+	if err := db.AssociateUserWithRemoteIdentity(alice.ID, "fake", 3, "alice-fake", "token"); err != nil {
+		t.Fatal(err)
+	}
+	identities, err := ags.GetRemoteIdentities(aliceCtx, &pb.Void{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	logins := make(map[string]string)
+	for _, remoteID := range identities.GetIdentities() {
+		if remoteID.GetAccessToken() != "" {
+			t.Errorf("GetRemoteIdentities() returned the access token of the %s identity", remoteID.GetProvider())
+		}
+		logins[remoteID.GetProvider()] = remoteID.GetLogin()
+	}
+	if len(logins) != 2 || logins["gitlab"] != "alice" || logins["fake"] != "alice-fake" {
+		t.Errorf("GetRemoteIdentities() logins = %v, want gitlab: alice, fake: alice-fake", logins)
+	}
+
+	// the repositories of the course are created with the login of the course's provider
+	if _, err := ags.CreateEnrollment(aliceCtx, &pb.Enrollment{CourseID: course.ID, UserID: alice.ID}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ags.UpdateEnrollment(ctx, &pb.Enrollment{CourseID: course.ID, UserID: alice.ID, Status: pb.Enrollment_STUDENT}); err != nil {
+		t.Fatal(err)
+	}
+	repos, err := fakeProvider.GetRepositories(ctx, org)
+	if err != nil {
+		t.Fatal(err)
+	}
+	found := false
+	for _, repo := range repos {
+		if repo.Path == pb.StudentRepoName("alice-fake") {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("no repository %s among the course's repositories", pb.StudentRepoName("alice-fake"))
+	}
+
+	// the identity of an enrolled course cannot be unlinked, nor can the last identity
+	_, err = ags.UnlinkRemoteIdentity(aliceCtx, &pb.UnlinkIdentityRequest{Provider: "fake"})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("UnlinkRemoteIdentity(fake) while enrolled: %v, want %v", err, codes.FailedPrecondition)
+	}
+	bob := qtest.CreateFakeUser(t, db, 4)
+	bobCtx := withUserContext(context.Background(), bob)
+	_, err = ags.UnlinkRemoteIdentity(bobCtx, &pb.UnlinkIdentityRequest{Provider: "fake"})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("UnlinkRemoteIdentity(fake) of last identity: %v, want %v", err, codes.FailedPrecondition)
+	}
+	_, err = ags.UnlinkRemoteIdentity(bobCtx, &pb.UnlinkIdentityRequest{Provider: "gitlab"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("UnlinkRemoteIdentity(gitlab) without identity: %v, want %v", err, codes.InvalidArgument)
+	}
+	if _, err := ags.UnlinkRemoteIdentity(aliceCtx, &pb.UnlinkIdentityRequest{Provider: "gitlab"}); err != nil {
+		t.Fatal(err)
+	}
+	_, err = ags.CreateEnrollment(aliceCtx, &pb.Enrollment{CourseID: gitlabCourse.ID, UserID: alice.ID})
+	if err != web.ErrMissingIdentity {
+		t.Errorf("CreateEnrollment() after unlinking: %v, want %v", err, web.ErrMissingIdentity)
+	}
+}
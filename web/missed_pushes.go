@@ -0,0 +1,90 @@
+package web
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/scm"
+	"github.com/autograde/quickfeed/web/hooks"
+)
+
+// processMissedPushes lists the commits pushed to the course's student and group repositories since
+// the given time, and processes them as if their push events had been received, e.g., after a webhook
+// outage. This runs the tests for the newest commit of each repository, unless the commit has already
+// been submitted. Repositories that cannot be checked or processed are reported, and do not prevent
+// processing the other repositories.
+func (s *AutograderService) processMissedPushes(ctx context.Context, sc scm.SCM, request *pb.MissedPushesRequest) (*pb.MissedPushesReport, error) {
+	since, err := time.ParseInLocation(pb.TimeLayout, request.GetSince(), time.Local)
+	if err != nil {
+		return nil, err
+	}
+	course, err := s.db.GetCourse(request.GetCourseID(), false)
+	if err != nil {
+		return nil, err
+	}
+	repos, err := s.db.GetRepositories(&pb.Repository{OrganizationID: course.GetOrganizationID()})
+	if err != nil {
+		return nil, err
+	}
+	webhook := hooks.NewGitHubWebHook(s.logger, s.db, s.runners, s.bh.Secret, s.notifier)
+	report := &pb.MissedPushesReport{}
+	for _, repo := range repos {
+		if !repo.IsUserRepo() && !repo.IsGroupRepo() {
+			continue
+		}
+		report.Repositories++
+		name := path.Base(repo.GetHTMLURL())
+		commits, err := sc.GetCommits(ctx, &scm.CommitOptions{
+			Owner:      course.GetOrganizationPath(),
+			Repository: name,
+			Since:      since,
+		})
+		if err != nil {
+			report.Errors = append(report.Errors, fmt.Sprintf("failed to get commits of %s: %v", name, err))
+			continue
+		}
+		if len(commits) == 0 {
+			continue
+		}
+		report.Pushes++
+		report.Commits += uint32(len(commits))
+		sender, err := s.missedPushSender(repo, commits[0])
+		if err != nil {
+			report.Errors = append(report.Errors, fmt.Sprintf("failed to find the pusher of %s: %v", name, err))
+			continue
+		}
+		if err := webhook.ProcessMissedPush(repo, sender, commits); err != nil {
+			report.Errors = append(report.Errors, fmt.Sprintf("failed to process commits of %s: %v", name, err))
+		}
+	}
+	return report, nil
+}
+
+// missedPushSender returns the login name of the user the tests of the given commit are run for:
+// the owner of a student repository, or the commit's author if they are a member of the group
+// owning a group repository, and otherwise the group's first member.
+func (s *AutograderService) missedPushSender(repo *pb.Repository, commit *scm.Commit) (string, error) {
+	if repo.IsUserRepo() {
+		user, err := s.db.GetUser(repo.GetUserID())
+		if err != nil {
+			return "", err
+		}
+		return user.GetLogin(), nil
+	}
+	group, err := s.db.GetGroup(repo.GetGroupID())
+	if err != nil {
+		return "", err
+	}
+	if len(group.GetUsers()) == 0 {
+		return "", fmt.Errorf("group %s has no members", group.GetName())
+	}
+	for _, user := range group.GetUsers() {
+		if user.GetLogin() == commit.Author {
+			return commit.Author, nil
+		}
+	}
+	return group.GetUsers()[0].GetLogin(), nil
+}
@@ -0,0 +1,100 @@
+package web_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/ci"
+	"github.com/autograde/quickfeed/internal/qtest"
+	"github.com/autograde/quickfeed/scm"
+	"github.com/autograde/quickfeed/web"
+)
+
+func TestProcessMissedPushes(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	fakeGothProvider()
+	teacher := qtest.CreateFakeUser(t, db, 1)
+	course := &pb.Course{Code: "DAT101", Provider: "fake", OrganizationID: 1, OrganizationPath: "DAT101"}
+	qtest.CreateCourse(t, db, teacher, course)
+	// manually reviewed, such that submissions are recorded without running tests
+	assignment := &pb.Assignment{CourseID: course.ID, Name: "lab1", Order: 1, Reviewers: 1}
+	if err := db.CreateAssignment(assignment); err != nil {
+		t.Fatal(err)
+	}
+	var students []*pb.User
+	repoNames := []string{"alice-labs", "bob-labs", "carol-labs"}
+	for i := 0; i < 3; i++ {
+		student := qtest.CreateFakeUser(t, db, uint64(i+2))
+		qtest.EnrollStudent(t, db, student, course)
+		repo := &pb.Repository{
+			OrganizationID: course.OrganizationID,
+			RepositoryID:   uint64(100 + i),
+			UserID:         student.ID,
+			HTMLURL:        "https://example.com/DAT101/" + repoNames[i],
+			RepoType:       pb.Repository_USER,
+		}
+		if err := db.CreateRepository(repo); err != nil {
+			t.Fatal(err)
+		}
+		students = append(students, student)
+	}
+	// the newest commit of the last student has already been submitted
+	if err := db.CreateSubmission(&pb.Submission{AssignmentID: assignment.ID, UserID: students[2].ID, CommitHash: "c4"}); err != nil {
+		t.Fatal(err)
+	}
+
+	fakeProvider, scms := qtest.FakeProviderMap(t)
+	now := time.Now()
+	commits := fakeProvider.(*scm.FakeSCM).Commits
+	commits["DAT101/"+repoNames[0]] = []*scm.Commit{
+		{SHA: "c2", Date: now.Add(-time.Hour), Files: []string{"lab1/main.go"}},
+		{SHA: "c1", Date: now.Add(-2 * time.Hour), Files: []string{"lab1/main.go"}},
+	}
+	// pushed before the outage
+	commits["DAT101/"+repoNames[1]] = []*scm.Commit{
+		{SHA: "c3", Date: now.Add(-48 * time.Hour), Files: []string{"lab1/main.go"}},
+	}
+	commits["DAT101/"+repoNames[2]] = []*scm.Commit{
+		{SHA: "c4", Date: now.Add(-time.Hour), Files: []string{"lab1/main.go"}},
+	}
+
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	client := accessControlled(t, ags)
+	ctx := withUserContext(context.Background(), teacher)
+	request := &pb.MissedPushesRequest{CourseID: course.ID, Since: now.Add(-24 * time.Hour).Format(pb.TimeLayout)}
+	if _, err := client.ProcessMissedPushes(withUserContext(context.Background(), students[0]), request); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("ProcessMissedPushes() by student: err = %v, want %v", err, codes.PermissionDenied)
+	}
+	report, err := ags.ProcessMissedPushes(ctx, request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(report.GetErrors()) > 0 {
+		t.Fatalf("ProcessMissedPushes() errors: %v", report.GetErrors())
+	}
+	if report.GetRepositories() != 3 || report.GetPushes() != 2 || report.GetCommits() != 3 {
+		t.Errorf("ProcessMissedPushes() = %v, want 3 repositories, 2 pushes and 3 commits", report)
+	}
+
+	for i, want := range []string{"c2", "", "c4"} {
+		submissions, err := db.GetSubmissions(&pb.Submission{AssignmentID: assignment.ID, UserID: students[i].ID})
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got []string
+		for _, submission := range submissions {
+			got = append(got, submission.GetCommitHash())
+		}
+		if (want == "" && len(got) != 0) || (want != "" && (len(got) != 1 || got[0] != want)) {
+			t.Errorf("submissions of %s = %v, want %q", repoNames[i], got, want)
+		}
+	}
+}
@@ -0,0 +1,104 @@
+package web
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/notify"
+)
+
+const (
+	// maxNotifications is the largest number of notifications returned to a user.
+	maxNotifications = 100
+	// deadlineReminder is how long before an assignment's deadline students are reminded of it.
+	deadlineReminder = 24 * time.Hour
+)
+
+// getNotifications returns the most recent notifications of the given user, newest first.
+func (s *AutograderService) getNotifications(userID uint64, request *pb.NotificationsRequest) (*pb.Notifications, error) {
+	notifications, err := s.db.GetNotifications(userID, request.GetUnreadOnly(), maxNotifications)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.Notifications{Notifications: notifications}, nil
+}
+
+// markNotificationRead marks the given user's notification as read,
+// or all the user's notifications if no notification is given.
+func (s *AutograderService) markNotificationRead(userID uint64, request *pb.NotificationRequest) error {
+	return s.db.MarkNotificationsRead(userID, request.GetNotificationID())
+}
+
+// notifyGroupApproved notifies the members of the given group that the group has been approved.
+func (s *AutograderService) notifyGroupApproved(ctx context.Context, group *pb.Group) {
+	s.notifier.Dispatch(ctx, &notify.Notification{
+		CourseID: group.GetCourseID(),
+		UserIDs:  group.UserIDs(),
+		Subject:  fmt.Sprintf("Group %s approved", group.GetName()),
+		Body:     "Your group has been approved, and the group repository has been created.",
+	})
+}
+
+// notifySubmissionReleased notifies the authors of the given submission that
+// the submission's review has been released.
+func (s *AutograderService) notifySubmissionReleased(ctx context.Context, courseID uint64, submission *pb.Submission) error {
+	assignment, err := s.db.GetAssignment(&pb.Assignment{ID: submission.GetAssignmentID()})
+	if err != nil {
+		return err
+	}
+	userIDs, err := s.submissionAuthors(submission)
+	if err != nil {
+		return err
+	}
+	s.notifier.Dispatch(ctx, &notify.Notification{
+		CourseID: courseID,
+		UserIDs:  userIDs,
+		Subject:  fmt.Sprintf("New review of %s", assignment.GetName()),
+		Body:     fmt.Sprintf("The review of your submission for %s has been released.", assignment.GetName()),
+	})
+	return nil
+}
+
+// notifyDeadlines reminds the students of the given course about the assignments
+// with a deadline within the next day. Each deadline is only reminded of once;
+// if a deadline is changed, the students are reminded of the new deadline.
+func (s *AutograderService) notifyDeadlines(ctx context.Context, course *pb.Course, now time.Time) error {
+	assignments, err := s.db.GetAssignmentsByCourse(course.GetID(), false)
+	if err != nil {
+		return err
+	}
+	var userIDs []uint64
+	for _, assignment := range assignments {
+		sinceDeadline, err := assignment.SinceDeadline(now)
+		if err != nil || sinceDeadline >= 0 || sinceDeadline < -deadlineReminder || assignment.IsHidden() {
+			continue
+		}
+		subject := fmt.Sprintf("%s is due %s", assignment.GetName(), assignment.LocalDeadlineIn(course.Location()))
+		reminded, err := s.db.HasNotification(course.GetID(), subject)
+		if err != nil {
+			return err
+		}
+		if reminded {
+			continue
+		}
+		if userIDs == nil {
+			students, err := s.db.GetEnrollmentsByCourse(course.GetID(), pb.Enrollment_STUDENT)
+			if err != nil {
+				return err
+			}
+			userIDs = make([]uint64, 0, len(students))
+			for _, student := range students {
+				userIDs = append(userIDs, student.GetUserID())
+			}
+		}
+		s.notifier.Dispatch(ctx, &notify.Notification{
+			CourseID: course.GetID(),
+			UserIDs:  userIDs,
+			Subject:  subject,
+			Body:     fmt.Sprintf("The deadline for %s in %s is approaching.", assignment.GetName(), course.GetCode()),
+		})
+	}
+	return nil
+}
@@ -0,0 +1,123 @@
+package web_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/ci"
+	"github.com/autograde/quickfeed/internal/qtest"
+	"github.com/autograde/quickfeed/scm"
+	"github.com/autograde/quickfeed/web"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestNotifications(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	fakeGothProvider()
+	teacher := qtest.CreateFakeUser(t, db, 1)
+	course := &pb.Course{Code: "DAT101", Provider: "fake", OrganizationID: 1, OrganizationPath: "DAT101"}
+	qtest.CreateCourse(t, db, teacher, course)
+	student := qtest.CreateFakeUser(t, db, 2)
+	qtest.EnrollStudent(t, db, student, course)
+
+	fakeProvider, scms := qtest.FakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	teacherCtx := withUserContext(context.Background(), teacher)
+	studentCtx := withUserContext(context.Background(), student)
+	if _, err := fakeProvider.CreateOrganization(teacherCtx, &scm.OrganizationOptions{Name: course.Code, Path: course.Code}); err != nil {
+		t.Fatal(err)
+	}
+
+	subjects := func(request *pb.NotificationsRequest) []string {
+		t.Helper()
+		notifications, err := ags.GetNotifications(studentCtx, request)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var subjects []string
+		for _, notification := range notifications.GetNotifications() {
+			subjects = append(subjects, notification.GetSubject())
+		}
+		return subjects
+	}
+
+	if _, err := ags.CreateAnnouncement(teacherCtx, &pb.Announcement{CourseID: course.ID, Title: "Lab 1 released"}); err != nil {
+		t.Fatal(err)
+	}
+
+	// group approval
+	group, err := ags.CreateGroup(studentCtx, &pb.Group{CourseID: course.ID, Name: "group1", Users: []*pb.User{student}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ags.UpdateGroup(teacherCtx, group); err != nil {
+		t.Fatal(err)
+	}
+
+	// released review
+	lab := &pb.Assignment{CourseID: course.ID, Name: "lab1", Order: 1, Deadline: time.Now().UTC().Add(2 * time.Hour).Format(pb.TimeLayout)}
+	if err := db.CreateAssignment(lab); err != nil {
+		t.Fatal(err)
+	}
+	submission := &pb.Submission{AssignmentID: lab.ID, UserID: student.ID, Score: 80}
+	if err := db.CreateSubmission(submission); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ags.UpdateSubmission(teacherCtx, &pb.UpdateSubmissionRequest{
+		CourseID:     course.ID,
+		SubmissionID: submission.ID,
+		Status:       pb.Submission_APPROVED,
+		Released:     true,
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	// approaching deadline; the students are only reminded once
+	canceledCtx, cancel := context.WithCancel(context.Background())
+	cancel()
+	ags.RunCourseChecks(canceledCtx, time.Hour)
+	ags.RunCourseChecks(canceledCtx, time.Hour)
+
+	want := []string{
+		"lab1 is due " + lab.LocalDeadlineIn(course.Location()),
+		"New review of lab1",
+		"Group group1 approved",
+		"Lab 1 released",
+	}
+	got := subjects(&pb.NotificationsRequest{})
+	if len(got) != len(want) {
+		t.Fatalf("GetNotifications() = %q, want %q", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("GetNotifications()[%d] = %q, want %q", i, got[i], want[i])
+		}
+	}
+
+	notifications, err := ags.GetNotifications(studentCtx, &pb.NotificationsRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	latest := notifications.GetNotifications()[0]
+	if _, err := ags.MarkNotificationRead(teacherCtx, &pb.NotificationRequest{NotificationID: latest.GetID()}); status.Code(err) != codes.NotFound {
+		t.Errorf("MarkNotificationRead() for another user's notification: got %v, want %v", err, codes.NotFound)
+	}
+	if _, err := ags.MarkNotificationRead(studentCtx, &pb.NotificationRequest{NotificationID: latest.GetID()}); err != nil {
+		t.Fatal(err)
+	}
+	if got := subjects(&pb.NotificationsRequest{UnreadOnly: true}); len(got) != len(want)-1 {
+		t.Errorf("GetNotifications(unread) = %q, want %d notifications", got, len(want)-1)
+	}
+	if _, err := ags.MarkNotificationRead(studentCtx, &pb.NotificationRequest{}); err != nil {
+		t.Fatal(err)
+	}
+	if got := subjects(&pb.NotificationsRequest{UnreadOnly: true}); len(got) != 0 {
+		t.Errorf("GetNotifications(unread) after marking all read = %q, want none", got)
+	}
+}
@@ -0,0 +1,133 @@
+package web
+
+import (
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// openAPIVersion is the version of the OpenAPI specification of the REST API.
+const openAPIVersion = "3.0.3"
+
+// openAPISpec returns the OpenAPI specification of the REST API of the given service,
+// generated from the service's protobuf descriptors. Messages are encoded as JSON
+// according to the protobuf JSON mapping, e.g., 64-bit integers are encoded as strings.
+func openAPISpec(service protoreflect.ServiceDescriptor) map[string]interface{} {
+	schemas := make(map[string]interface{})
+	paths := make(map[string]interface{})
+	methods := service.Methods()
+	for i := 0; i < methods.Len(); i++ {
+		method := methods.Get(i)
+		if method.IsStreamingClient() || method.IsStreamingServer() {
+			continue
+		}
+		paths[restPrefix+string(method.Name())] = map[string]interface{}{
+			"post": map[string]interface{}{
+				"operationId": string(method.Name()),
+				"tags":        []string{string(service.Name())},
+				"requestBody": map[string]interface{}{
+					"required": true,
+					"content": map[string]interface{}{
+						"application/json": map[string]interface{}{"schema": messageRef(method.Input(), schemas)},
+					},
+				},
+				"responses": map[string]interface{}{
+					"200": map[string]interface{}{
+						"description": "A successful response.",
+						"content": map[string]interface{}{
+							"application/json": map[string]interface{}{"schema": messageRef(method.Output(), schemas)},
+						},
+					},
+					"default": map[string]interface{}{
+						"description": "An error response with the gRPC status code and message.",
+						"content": map[string]interface{}{
+							"application/json": map[string]interface{}{"schema": map[string]interface{}{"$ref": "#/components/schemas/Status"}},
+						},
+					},
+				},
+			},
+		}
+	}
+	schemas["Status"] = map[string]interface{}{
+		"type": "object",
+		"properties": map[string]interface{}{
+			"code":    map[string]interface{}{"type": "integer", "format": "int32"},
+			"message": map[string]interface{}{"type": "string"},
+		},
+	}
+	return map[string]interface{}{
+		"openapi": openAPIVersion,
+		"info": map[string]interface{}{
+			"title":   "QuickFeed API",
+			"version": "v1",
+		},
+		"paths": paths,
+		"components": map[string]interface{}{
+			"schemas": schemas,
+			"securitySchemes": map[string]interface{}{
+				"accessToken": map[string]interface{}{"type": "http", "scheme": "bearer"},
+			},
+		},
+		"security": []interface{}{map[string]interface{}{"accessToken": []string{}}},
+	}
+}
+
+// messageRef returns a reference to the schema of the given message,
+// adding the schemas of the message and the messages it refers to, to the given schemas.
+func messageRef(message protoreflect.MessageDescriptor, schemas map[string]interface{}) map[string]interface{} {
+	name := string(message.FullName())
+	ref := map[string]interface{}{"$ref": "#/components/schemas/" + name}
+	if _, ok := schemas[name]; ok {
+		return ref
+	}
+	properties := make(map[string]interface{})
+	// the schema is added before its fields, since messages may refer to themselves
+	schemas[name] = map[string]interface{}{"type": "object", "properties": properties}
+	fields := message.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		field := fields.Get(i)
+		properties[field.JSONName()] = fieldSchema(field, schemas)
+	}
+	return ref
+}
+
+// fieldSchema returns the schema of the given field.
+func fieldSchema(field protoreflect.FieldDescriptor, schemas map[string]interface{}) map[string]interface{} {
+	switch {
+	case field.IsMap():
+		return map[string]interface{}{"type": "object", "additionalProperties": valueSchema(field.MapValue(), schemas)}
+	case field.IsList():
+		return map[string]interface{}{"type": "array", "items": valueSchema(field, schemas)}
+	}
+	return valueSchema(field, schemas)
+}
+
+// valueSchema returns the schema of a single value of the given field.
+func valueSchema(field protoreflect.FieldDescriptor, schemas map[string]interface{}) map[string]interface{} {
+	switch field.Kind() {
+	case protoreflect.BoolKind:
+		return map[string]interface{}{"type": "boolean"}
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return map[string]interface{}{"type": "integer", "format": "int32"}
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return map[string]interface{}{"type": "integer", "format": "int64", "minimum": 0}
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return map[string]interface{}{"type": "string", "format": "int64"}
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return map[string]interface{}{"type": "string", "format": "uint64"}
+	case protoreflect.FloatKind:
+		return map[string]interface{}{"type": "number", "format": "float"}
+	case protoreflect.DoubleKind:
+		return map[string]interface{}{"type": "number", "format": "double"}
+	case protoreflect.BytesKind:
+		return map[string]interface{}{"type": "string", "format": "byte"}
+	case protoreflect.EnumKind:
+		values := field.Enum().Values()
+		names := make([]string, values.Len())
+		for i := range names {
+			names[i] = string(values.Get(i).Name())
+		}
+		return map[string]interface{}{"type": "string", "enum": names}
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return messageRef(field.Message(), schemas)
+	}
+	return map[string]interface{}{"type": "string"}
+}
@@ -16,12 +16,12 @@ func (s *AutograderService) getOrganization(ctx context.Context, sc scm.SCM, org
 	if gitOrg.GetPaymentPlan() == FreeOrgPlan {
 		return nil, ErrFreePlan
 	}
-	// check course repos
+	// check course repos with the default names
 	repos, err := sc.GetRepositories(ctx, gitOrg)
 	if err != nil {
 		return nil, err
 	}
-	if isDirty(repos) {
+	if isDirty(&pb.Course{}, repos) {
 		return nil, ErrAlreadyExists
 	}
 	return gitOrg, nil
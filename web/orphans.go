@@ -0,0 +1,117 @@
+package web
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/scm"
+)
+
+// orphanReports holds the most recent orphaned resources report for each course.
+type orphanReports struct {
+	mu      sync.RWMutex
+	reports map[uint64]*pb.OrphanedResources
+}
+
+func newOrphanReports() *orphanReports {
+	return &orphanReports{reports: make(map[uint64]*pb.OrphanedResources)}
+}
+
+func (o *orphanReports) get(courseID uint64) (*pb.OrphanedResources, bool) {
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+	report, ok := o.reports[courseID]
+	return report, ok
+}
+
+func (o *orphanReports) set(report *pb.OrphanedResources) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.reports[report.GetCourseID()] = report
+}
+
+// findOrphanedResources compares the repositories, teams and members of the course's
+// organization with the course records in the database, and returns the resources
+// that have no corresponding records. The report is also stored for later retrieval.
+func (s *AutograderService) findOrphanedResources(ctx context.Context, sc scm.SCM, course *pb.Course) (*pb.OrphanedResources, error) {
+	org := &pb.Organization{ID: course.GetOrganizationID(), Path: course.GetOrganizationPath()}
+	report := &pb.OrphanedResources{
+		CourseID:  course.GetID(),
+		CheckedAt: time.Now().Format(pb.TimeLayout),
+	}
+
+	repos, err := s.db.GetRepositories(&pb.Repository{OrganizationID: course.GetOrganizationID()})
+	if err != nil {
+		return nil, err
+	}
+	knownRepos := make(map[uint64]bool)
+	for _, repo := range repos {
+		knownRepos[repo.GetRepositoryID()] = true
+	}
+	scmRepos, err := sc.GetRepositories(ctx, org)
+	if err != nil {
+		return nil, err
+	}
+	for _, repo := range scmRepos {
+		if !knownRepos[repo.ID] {
+			report.Repositories = append(report.Repositories, repo.Path)
+		}
+	}
+
+	groups, err := s.db.GetGroupsByCourse(course.GetID())
+	if err != nil {
+		return nil, err
+	}
+	knownTeams := make(map[uint64]bool)
+	for _, group := range groups {
+		knownTeams[group.GetTeamID()] = true
+	}
+	scmTeams, err := sc.GetTeams(ctx, org)
+	if err != nil {
+		return nil, err
+	}
+	for _, team := range scmTeams {
+		if team.Name == scm.TeachersTeam || team.Name == scm.StudentsTeam {
+			continue
+		}
+		if !knownTeams[team.ID] {
+			report.Teams = append(report.Teams, team.Name)
+		}
+	}
+
+	enrollments, err := s.db.GetEnrollmentsByCourse(course.GetID(), pb.Enrollment_STUDENT, pb.Enrollment_TEACHER, pb.Enrollment_TA)
+	if err != nil {
+		return nil, err
+	}
+	enrolled := make(map[string]bool)
+	for _, enrollment := range enrollments {
+		enrolled[enrollment.GetUser().GetLogin()] = true
+	}
+	members, err := sc.GetOrgMembers(ctx, org)
+	if err != nil {
+		return nil, err
+	}
+	for _, member := range members {
+		if !enrolled[member] {
+			report.Users = append(report.Users, member)
+		}
+	}
+
+	s.orphans.set(report)
+	return report, nil
+}
+
+// getOrphanedResources returns the most recent orphaned resources report for the course.
+// If the course has not yet been checked, the course is checked immediately.
+func (s *AutograderService) getOrphanedResources(ctx context.Context, sc scm.SCM, courseID uint64) (*pb.OrphanedResources, error) {
+	if report, ok := s.orphans.get(courseID); ok {
+		return report, nil
+	}
+	course, err := s.db.GetCourse(courseID, false)
+	if err != nil {
+		return nil, err
+	}
+	return s.findOrphanedResources(ctx, sc, course)
+}
@@ -0,0 +1,85 @@
+package web_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/ci"
+	"github.com/autograde/quickfeed/internal/qtest"
+	"github.com/autograde/quickfeed/scm"
+	"github.com/autograde/quickfeed/web"
+)
+
+func TestGetOrphanedResources(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	fakeGothProvider()
+	admin := qtest.CreateFakeUser(t, db, 1)
+	ctx := withUserContext(context.Background(), admin)
+	fakeProvider, scms := qtest.FakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	client := accessControlled(t, ags)
+	org, err := fakeProvider.CreateOrganization(ctx, &scm.OrganizationOptions{Path: "path", Name: "name"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	course, err := ags.CreateCourse(ctx, allCourses[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// resources created directly on the SCM have no records in QuickFeed
+	if _, err := fakeProvider.CreateRepository(ctx, &scm.CreateRepositoryOptions{Path: "stray-labs", Organization: org}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := fakeProvider.CreateTeam(ctx, &scm.NewTeamOptions{TeamName: "stray-team", Organization: org.Path}); err != nil {
+		t.Fatal(err)
+	}
+	// members of the organization that are not enrolled in the course
+	admin.Login = "admin"
+	if err := db.UpdateUser(admin); err != nil {
+		t.Fatal(err)
+	}
+	student := qtest.CreateFakeUser(t, db, 2)
+	student.Login = "student"
+	if err := db.UpdateUser(student); err != nil {
+		t.Fatal(err)
+	}
+	qtest.EnrollStudent(t, db, student, course)
+	pending := qtest.CreateFakeUser(t, db, 3)
+	pending.Login = "pending"
+	if err := db.UpdateUser(pending); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.CreateEnrollment(&pb.Enrollment{CourseID: course.ID, UserID: pending.ID}); err != nil {
+		t.Fatal(err)
+	}
+	fakeProvider.(*scm.FakeSCM).OrgMembers[course.GetOrganizationPath()] = []string{"admin", "stray-member", "student", "pending"}
+
+	report, err := ags.GetOrphanedResources(ctx, &pb.CourseRequest{CourseID: course.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]string{"stray-labs"}, report.GetRepositories()); diff != "" {
+		t.Errorf("GetOrphanedResources() repositories mismatch (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{"stray-team"}, report.GetTeams()); diff != "" {
+		t.Errorf("GetOrphanedResources() teams mismatch (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{"stray-member", "pending"}, report.GetUsers()); diff != "" {
+		t.Errorf("GetOrphanedResources() users mismatch (-want +got):\n%s", diff)
+	}
+
+	studentCtx := withUserContext(context.Background(), student)
+	_, err = client.GetOrphanedResources(studentCtx, &pb.CourseRequest{CourseID: course.ID})
+	if status.Code(err) != codes.PermissionDenied {
+		t.Errorf("GetOrphanedResources() for non-admin: got %v, want %v", err, codes.PermissionDenied)
+	}
+}
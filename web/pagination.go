@@ -0,0 +1,74 @@
+package web
+
+import (
+	"encoding/base64"
+	"errors"
+	"sort"
+	"strconv"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/database"
+)
+
+const (
+	// defaultPageSize is the page size used when a page token is given without a page size.
+	defaultPageSize = 100
+	// maxPageSize is the largest number of records returned in a single page.
+	maxPageSize = 1000
+)
+
+var errInvalidPageToken = errors.New("invalid page token")
+
+// newPage returns the database page for the given page size and token,
+// or nil if neither is given, in which case all records should be returned.
+func newPage(pageSize uint32, pageToken string) (*database.Page, error) {
+	if pageSize == 0 && pageToken == "" {
+		return nil, nil
+	}
+	page := &database.Page{Size: int(pageSize)}
+	switch {
+	case page.Size == 0:
+		page.Size = defaultPageSize
+	case page.Size > maxPageSize:
+		page.Size = maxPageSize
+	}
+	if pageToken != "" {
+		b, err := base64.RawURLEncoding.DecodeString(pageToken)
+		if err != nil {
+			return nil, errInvalidPageToken
+		}
+		page.After, err = strconv.ParseUint(string(b), 10, 64)
+		if err != nil {
+			return nil, errInvalidPageToken
+		}
+	}
+	return page, nil
+}
+
+// pageEnrollments returns the given page of the enrollments, ordered by ID.
+func pageEnrollments(enrollments []*pb.Enrollment, page *database.Page) []*pb.Enrollment {
+	sort.Slice(enrollments, func(i, j int) bool {
+		return enrollments[i].GetID() < enrollments[j].GetID()
+	})
+	var paged []*pb.Enrollment
+	for _, enrollment := range enrollments {
+		if enrollment.GetID() > page.After {
+			paged = append(paged, enrollment)
+		}
+	}
+	page.Next = 0
+	if len(paged) > page.Size {
+		paged = paged[:page.Size]
+		page.Next = paged[len(paged)-1].GetID()
+	}
+	return paged
+}
+
+// nextPageToken returns the page token of the page following the given page,
+// or the empty string if there are no more pages.
+func nextPageToken(page *database.Page) string {
+	if page == nil || page.Next == 0 {
+		return ""
+	}
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.FormatUint(page.Next, 10)))
+}
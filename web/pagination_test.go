@@ -0,0 +1,112 @@
+package web_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/ci"
+	"github.com/autograde/quickfeed/internal/qtest"
+	"github.com/autograde/quickfeed/web"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGetUsersPaginated(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	_, scms := qtest.FakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	admin := qtest.CreateFakeUser(t, db, 1)
+	for i := 2; i <= 5; i++ {
+		qtest.CreateFakeUser(t, db, uint64(i))
+	}
+	ctx := withUserContext(context.Background(), admin)
+
+	var pages [][]uint64
+	request := &pb.UsersRequest{PageSize: 2}
+	for {
+		users, err := ags.GetUsers(ctx, request)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var ids []uint64
+		for _, user := range users.GetUsers() {
+			ids = append(ids, user.GetID())
+		}
+		pages = append(pages, ids)
+		if users.GetNextPageToken() == "" {
+			break
+		}
+		request.PageToken = users.GetNextPageToken()
+	}
+	wantPages := [][]uint64{{1, 2}, {3, 4}, {5}}
+	if !reflect.DeepEqual(pages, wantPages) {
+		t.Errorf("GetUsers() pages = %v, want %v", pages, wantPages)
+	}
+
+	_, err := ags.GetUsers(ctx, &pb.UsersRequest{PageSize: 2, PageToken: "not a token"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("GetUsers() with invalid page token: got error %v, want code %v", err, codes.InvalidArgument)
+	}
+}
+
+func TestGetEnrollmentsAndSubmissionsPaginated(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	_, scms := qtest.FakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	teacher := qtest.CreateFakeUser(t, db, 1)
+	course := &pb.Course{Code: "DAT101", OrganizationID: 1}
+	qtest.CreateCourse(t, db, teacher, course)
+	students := qtest.PopulateCourse(t, db, course, 4, 3)
+	ctx := withUserContext(context.Background(), teacher)
+
+	// the teacher and the four students are enrolled
+	var enrollments []*pb.Enrollment
+	request := &pb.EnrollmentRequest{CourseID: course.GetID(), PageSize: 2}
+	for pages := 1; ; pages++ {
+		page, err := ags.GetEnrollmentsByCourse(ctx, request)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(page.GetEnrollments()) > 2 {
+			t.Errorf("GetEnrollmentsByCourse() page %d has %d enrollments, want at most 2", pages, len(page.GetEnrollments()))
+		}
+		enrollments = append(enrollments, page.GetEnrollments()...)
+		if page.GetNextPageToken() == "" {
+			if pages != 3 {
+				t.Errorf("GetEnrollmentsByCourse() returned %d pages, want 3", pages)
+			}
+			break
+		}
+		request.PageToken = page.GetNextPageToken()
+	}
+	all, err := ags.GetEnrollmentsByCourse(ctx, &pb.EnrollmentRequest{CourseID: course.GetID()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(enrollments) != 5 || len(all.GetEnrollments()) != 5 || all.GetNextPageToken() != "" {
+		t.Errorf("GetEnrollmentsByCourse() returned %d paged and %d unpaged enrollments, want 5", len(enrollments), len(all.GetEnrollments()))
+	}
+
+	// the submissions of the three assignments are returned two assignments at a time
+	submissions, err := ags.GetSubmissions(ctx, &pb.SubmissionRequest{CourseID: course.GetID(), UserID: students[1].GetID(), PageSize: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(submissions.GetSubmissions()) != 2 || submissions.GetNextPageToken() == "" {
+		t.Fatalf("GetSubmissions() = %d submissions, next page %q; want 2 submissions and a next page", len(submissions.GetSubmissions()), submissions.GetNextPageToken())
+	}
+	submissions, err = ags.GetSubmissions(ctx, &pb.SubmissionRequest{CourseID: course.GetID(), UserID: students[1].GetID(), PageSize: 2, PageToken: submissions.GetNextPageToken()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(submissions.GetSubmissions()) != 1 || submissions.GetNextPageToken() != "" {
+		t.Errorf("GetSubmissions() = %d submissions, next page %q; want the last submission", len(submissions.GetSubmissions()), submissions.GetNextPageToken())
+	}
+}
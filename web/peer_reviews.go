@@ -0,0 +1,208 @@
+package web
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+
+	pb "github.com/autograde/quickfeed/ag"
+)
+
+var errPeerReviewsAssigned = errors.New("peer reviews already assigned")
+
+// assignPeerReviews assigns the peer reviewers of the latest submission of each student or group
+// to the assignment, such that each submission gets the assignment's number of peer reviews.
+// Reviewers are the authors of other submissions; for group assignments, each group member reviews.
+// Peer reviews can only be assigned once for each assignment.
+func (s *AutograderService) assignPeerReviews(usr *pb.User, request *pb.AssignmentRequest) (*pb.PeerReviews, error) {
+	assignment, err := s.getAssignmentInCourse(request.GetAssignmentID(), request.GetCourseID())
+	if err != nil {
+		return nil, err
+	}
+	if assignment.GetPeerReviews() == 0 {
+		return nil, fmt.Errorf("assignment %d is not peer reviewed", assignment.GetID())
+	}
+	existing, err := s.db.GetPeerReviews(&pb.PeerReview{AssignmentID: assignment.GetID()})
+	if err != nil {
+		return nil, err
+	}
+	if len(existing) > 0 {
+		return nil, errPeerReviewsAssigned
+	}
+
+	submissions, err := s.db.GetSubmissions(&pb.Submission{AssignmentID: assignment.GetID()})
+	if err != nil {
+		return nil, err
+	}
+	// keep the latest submission of each student or group
+	latest := make(map[string]*pb.Submission)
+	for _, submission := range submissions {
+		owner := fmt.Sprintf("%d/%d", submission.GetUserID(), submission.GetGroupID())
+		if submission.GetID() > latest[owner].GetID() {
+			latest[owner] = submission
+		}
+	}
+	var reviewed []*pb.Submission
+	var authors [][]uint64
+	for _, submission := range latest {
+		submissionAuthors := []uint64{submission.GetUserID()}
+		if submission.GetGroupID() > 0 {
+			group, err := s.db.GetGroup(submission.GetGroupID())
+			if err != nil {
+				return nil, err
+			}
+			submissionAuthors = nil
+			for _, user := range group.GetUsers() {
+				submissionAuthors = append(submissionAuthors, user.GetID())
+			}
+		}
+		reviewed = append(reviewed, submission)
+		authors = append(authors, submissionAuthors)
+	}
+	rand.Shuffle(len(reviewed), func(i, j int) {
+		reviewed[i], reviewed[j] = reviewed[j], reviewed[i]
+		authors[i], authors[j] = authors[j], authors[i]
+	})
+
+	var reviews []*pb.PeerReview
+	for i, reviewers := range pb.AssignPeerReviewers(authors, int(assignment.GetPeerReviews())) {
+		for _, reviewer := range reviewers {
+			reviews = append(reviews, &pb.PeerReview{
+				CourseID:     request.GetCourseID(),
+				AssignmentID: assignment.GetID(),
+				SubmissionID: reviewed[i].GetID(),
+				ReviewerID:   reviewer,
+			})
+		}
+	}
+	if err := s.db.CreatePeerReviews(reviews); err != nil {
+		return nil, err
+	}
+	s.audit(usr, request.GetCourseID(), "assign peer reviews", "course", request.GetCourseID(), "assignment", assignment.GetName(), "reviews", len(reviews))
+	return &pb.PeerReviews{PeerReviews: reviews}, nil
+}
+
+// getPeerReviewTasks returns the user's peer reviews of the given assignment,
+// with the reviewed submissions anonymized.
+func (s *AutograderService) getPeerReviewTasks(usr *pb.User, request *pb.AssignmentRequest) (*pb.PeerReviews, error) {
+	reviews, err := s.db.GetPeerReviews(&pb.PeerReview{
+		CourseID:     request.GetCourseID(),
+		AssignmentID: request.GetAssignmentID(),
+		ReviewerID:   usr.GetID(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, review := range reviews {
+		submission, err := s.db.GetSubmission(&pb.Submission{ID: review.GetSubmissionID()})
+		if err != nil {
+			return nil, err
+		}
+		review.Submission = submission.Anonymized()
+	}
+	return &pb.PeerReviews{PeerReviews: reviews}, nil
+}
+
+// submitPeerReview records the user's score and feedback for a peer review assigned to the user.
+// Released peer reviews can no longer be changed by the reviewer.
+func (s *AutograderService) submitPeerReview(usr *pb.User, request *pb.PeerReview) (*pb.PeerReview, error) {
+	review, err := s.db.GetPeerReview(&pb.PeerReview{ID: request.GetID(), CourseID: request.GetCourseID()})
+	if err != nil {
+		return nil, err
+	}
+	if review.GetReviewerID() != usr.GetID() {
+		return nil, fmt.Errorf("peer review %d is not assigned to user %d", review.GetID(), usr.GetID())
+	}
+	if review.GetReleased() {
+		return nil, fmt.Errorf("peer review %d is already released", review.GetID())
+	}
+	if request.GetScore() > 100 {
+		return nil, fmt.Errorf("invalid peer review score %d: must be at most 100", request.GetScore())
+	}
+	review.Score = request.GetScore()
+	review.Feedback = request.GetFeedback()
+	review.Submitted = true
+	if err := s.db.UpdatePeerReview(review); err != nil {
+		return nil, err
+	}
+	return review, nil
+}
+
+// getPeerReviews returns the peer reviews of the given assignment. Teaching staff get all peer reviews,
+// while students only get the released peer reviews of their own or their group's submissions,
+// without the identity of the reviewers.
+func (s *AutograderService) getPeerReviews(usr *pb.User, request *pb.AssignmentRequest) (*pb.PeerReviews, error) {
+	query := &pb.PeerReview{CourseID: request.GetCourseID(), AssignmentID: request.GetAssignmentID()}
+	if s.isTeachingStaff(usr.GetID(), request.GetCourseID()) {
+		reviews, err := s.db.GetPeerReviews(query)
+		if err != nil {
+			return nil, err
+		}
+		return &pb.PeerReviews{PeerReviews: reviews}, nil
+	}
+
+	enrollment, err := s.db.GetEnrollmentByCourseAndUser(request.GetCourseID(), usr.GetID())
+	if err != nil {
+		return nil, err
+	}
+	submissions, err := s.db.GetSubmissions(&pb.Submission{AssignmentID: request.GetAssignmentID(), UserID: usr.GetID()})
+	if err != nil {
+		return nil, err
+	}
+	if enrollment.GetGroupID() > 0 {
+		groupSubmissions, err := s.db.GetSubmissions(&pb.Submission{AssignmentID: request.GetAssignmentID(), GroupID: enrollment.GetGroupID()})
+		if err != nil {
+			return nil, err
+		}
+		submissions = append(submissions, groupSubmissions...)
+	}
+	var reviews []*pb.PeerReview
+	query.Released = true
+	for _, submission := range submissions {
+		query.SubmissionID = submission.GetID()
+		released, err := s.db.GetPeerReviews(query)
+		if err != nil {
+			return nil, err
+		}
+		for _, review := range released {
+			review.ReviewerID = 0
+			review.ModeratorID = 0
+		}
+		reviews = append(reviews, released...)
+	}
+	return &pb.PeerReviews{PeerReviews: reviews}, nil
+}
+
+// moderatePeerReview overrides the score and feedback of a peer review,
+// and releases or withholds the peer review as requested.
+func (s *AutograderService) moderatePeerReview(usr *pb.User, request *pb.PeerReview) (*pb.PeerReview, error) {
+	review, err := s.db.GetPeerReview(&pb.PeerReview{ID: request.GetID(), CourseID: request.GetCourseID()})
+	if err != nil {
+		return nil, err
+	}
+	if request.GetScore() > 100 {
+		return nil, fmt.Errorf("invalid peer review score %d: must be at most 100", request.GetScore())
+	}
+	review.Score = request.GetScore()
+	review.Feedback = request.GetFeedback()
+	review.Released = request.GetReleased()
+	review.ModeratorID = usr.GetID()
+	if err := s.db.UpdatePeerReview(review); err != nil {
+		return nil, err
+	}
+	s.audit(usr, review.GetCourseID(), "moderate peer review", "course", review.GetCourseID(), "peerReviewID", review.GetID(), "score", review.GetScore(), "released", review.GetReleased())
+	return review, nil
+}
+
+// releasePeerReviews makes the submitted peer reviews of the given assignment visible to the submissions' authors.
+func (s *AutograderService) releasePeerReviews(usr *pb.User, request *pb.AssignmentRequest) error {
+	assignment, err := s.getAssignmentInCourse(request.GetAssignmentID(), request.GetCourseID())
+	if err != nil {
+		return err
+	}
+	if err := s.db.ReleasePeerReviews(assignment.GetID()); err != nil {
+		return err
+	}
+	s.audit(usr, request.GetCourseID(), "release peer reviews", "course", request.GetCourseID(), "assignment", assignment.GetName())
+	return nil
+}
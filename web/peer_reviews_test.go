@@ -0,0 +1,160 @@
+package web_test
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/ci"
+	"github.com/autograde/quickfeed/internal/qtest"
+	"github.com/autograde/quickfeed/web"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestPeerReviews(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	teacher := qtest.CreateFakeUser(t, db, 1)
+	course := &pb.Course{Code: "DAT101", Year: 2021, OrganizationID: 1}
+	qtest.CreateCourse(t, db, teacher, course)
+	students := qtest.PopulateCourse(t, db, course, 3, 0)
+	lab := &pb.Assignment{CourseID: course.GetID(), Name: "lab1", Order: 1, PeerReviews: 2}
+	if err := db.CreateAssignment(lab); err != nil {
+		t.Fatal(err)
+	}
+	for i, student := range students {
+		if err := db.CreateSubmission(&pb.Submission{AssignmentID: lab.GetID(), UserID: student.GetID(), Score: uint32(50 + i), CommitHash: "abc"}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	_, scms := qtest.FakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	client := accessControlled(t, ags)
+	teacherCtx := withUserContext(context.Background(), teacher)
+	request := &pb.AssignmentRequest{CourseID: course.GetID(), AssignmentID: lab.GetID()}
+
+	if _, err := client.AssignPeerReviews(withUserContext(context.Background(), students[0]), request); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("AssignPeerReviews() by student: got %v, want %v", err, codes.PermissionDenied)
+	}
+	assigned, err := ags.AssignPeerReviews(teacherCtx, request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(assigned.GetPeerReviews()) != 6 {
+		t.Fatalf("AssignPeerReviews() assigned %d peer reviews, want 6", len(assigned.GetPeerReviews()))
+	}
+	if _, err := ags.AssignPeerReviews(teacherCtx, request); status.Code(err) != codes.AlreadyExists {
+		t.Errorf("AssignPeerReviews() again: got %v, want %v", err, codes.AlreadyExists)
+	}
+
+	for _, student := range students {
+		ctx := withUserContext(context.Background(), student)
+		tasks, err := ags.GetPeerReviewTasks(ctx, request)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(tasks.GetPeerReviews()) != 2 {
+			t.Fatalf("GetPeerReviewTasks() for %s returned %d peer reviews, want 2", student.GetLogin(), len(tasks.GetPeerReviews()))
+		}
+		for _, task := range tasks.GetPeerReviews() {
+			submission := task.GetSubmission()
+			if submission.GetID() != task.GetSubmissionID() || submission.GetCommitHash() != "abc" {
+				t.Errorf("GetPeerReviewTasks() submission = %v, want submission %d", submission, task.GetSubmissionID())
+			}
+			if submission.GetUserID() != 0 || submission.GetGroupID() != 0 {
+				t.Errorf("GetPeerReviewTasks() submission not anonymized: %v", submission)
+			}
+			if reviewed, err := db.GetSubmission(&pb.Submission{ID: task.GetSubmissionID()}); err != nil || reviewed.GetUserID() == student.GetID() {
+				t.Errorf("GetPeerReviewTasks() assigned %s to review own submission", student.GetLogin())
+			}
+			task.Score = 80
+			task.Feedback = "good"
+			if _, err := ags.SubmitPeerReview(ctx, task); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	// students cannot submit peer reviews assigned to others
+	other := assigned.GetPeerReviews()[0]
+	for _, student := range students {
+		if student.GetID() != other.GetReviewerID() {
+			ctx := withUserContext(context.Background(), student)
+			if _, err := ags.SubmitPeerReview(ctx, &pb.PeerReview{ID: other.GetID(), CourseID: course.GetID(), Score: 10}); status.Code(err) != codes.InvalidArgument {
+				t.Errorf("SubmitPeerReview() by %s of other's review: got %v, want %v", student.GetLogin(), err, codes.InvalidArgument)
+			}
+			break
+		}
+	}
+
+	// peer reviews are hidden from the authors until released
+	authorCtx := withUserContext(context.Background(), students[0])
+	reviews, err := ags.GetPeerReviews(authorCtx, request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(reviews.GetPeerReviews()) != 0 {
+		t.Errorf("GetPeerReviews() before release returned %d peer reviews, want 0", len(reviews.GetPeerReviews()))
+	}
+	if _, err := client.ReleasePeerReviews(authorCtx, request); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("ReleasePeerReviews() by student: got %v, want %v", err, codes.PermissionDenied)
+	}
+	if _, err := ags.ReleasePeerReviews(teacherCtx, request); err != nil {
+		t.Fatal(err)
+	}
+	reviews, err = ags.GetPeerReviews(authorCtx, request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(reviews.GetPeerReviews()) != 2 {
+		t.Fatalf("GetPeerReviews() after release returned %d peer reviews, want 2", len(reviews.GetPeerReviews()))
+	}
+	for _, review := range reviews.GetPeerReviews() {
+		if review.GetReviewerID() != 0 || review.GetScore() != 80 || review.GetFeedback() != "good" {
+			t.Errorf("GetPeerReviews() = %v, want anonymous review with score 80 and feedback %q", review, "good")
+		}
+	}
+	// released peer reviews can no longer be changed by the reviewer
+	released := reviews.GetPeerReviews()[0]
+	all, err := ags.GetPeerReviews(teacherCtx, request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(all.GetPeerReviews()) != 6 {
+		t.Fatalf("GetPeerReviews() by teacher returned %d peer reviews, want 6", len(all.GetPeerReviews()))
+	}
+	var reviewer *pb.User
+	for _, review := range all.GetPeerReviews() {
+		if review.GetID() == released.GetID() {
+			for _, student := range students {
+				if student.GetID() == review.GetReviewerID() {
+					reviewer = student
+				}
+			}
+		}
+	}
+	reviewerCtx := withUserContext(context.Background(), reviewer)
+	if _, err := ags.SubmitPeerReview(reviewerCtx, &pb.PeerReview{ID: released.GetID(), CourseID: course.GetID(), Score: 10}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("SubmitPeerReview() of released review: got %v, want %v", err, codes.InvalidArgument)
+	}
+
+	// teachers can moderate and withhold peer reviews
+	moderated, err := ags.ModeratePeerReview(teacherCtx, &pb.PeerReview{ID: released.GetID(), CourseID: course.GetID(), Score: 60, Feedback: "moderated"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if moderated.GetModeratorID() != teacher.GetID() || moderated.GetScore() != 60 || moderated.GetReleased() {
+		t.Errorf("ModeratePeerReview() = %v, want withheld review with score 60 moderated by %d", moderated, teacher.GetID())
+	}
+	reviews, err = ags.GetPeerReviews(authorCtx, request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(reviews.GetPeerReviews()) != 1 {
+		t.Errorf("GetPeerReviews() after withholding returned %d peer reviews, want 1", len(reviews.GetPeerReviews()))
+	}
+}
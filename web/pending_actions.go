@@ -0,0 +1,124 @@
+package web
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ErrPendingAction is returned when a destructive action has been recorded and awaits confirmation by another admin.
+var ErrPendingAction = status.Errorf(codes.FailedPrecondition, "action must be confirmed by another admin")
+
+var (
+	errConfirmOwnAction  = errors.New("actions must be confirmed by another admin than the one requesting them")
+	errConfirmTenant     = errors.New("actions must be confirmed by an admin of the course's tenant")
+	errCancelOtherAction = errors.New("only admins and the requester can cancel pending actions")
+)
+
+// requestAction records the given destructive action, requested by the given user,
+// to be executed when confirmed by another admin.
+func (s *AutograderService) requestAction(usr *pb.User, action *pb.PendingAction) error {
+	action.RequestedByID = usr.GetID()
+	action.RequestedBy = usr.GetLogin()
+	action.Requested = time.Now().Format(pb.TimeLayout)
+	if err := s.db.CreatePendingAction(action); err != nil {
+		return err
+	}
+	s.audit(usr, action.GetCourseID(), "request pending action", "action", action.GetAction().String(), "actionID", action.GetID())
+	return nil
+}
+
+// getPendingActions returns the pending actions that the given admin can confirm, that is,
+// the actions for the courses of the admin's tenant, or all actions if the admin does not belong to a tenant.
+func (s *AutograderService) getPendingActions(usr *pb.User) ([]*pb.PendingAction, error) {
+	actions, err := s.db.GetPendingActions()
+	if err != nil || usr.IsGlobalAdmin() {
+		return actions, err
+	}
+	var tenantActions []*pb.PendingAction
+	for _, action := range actions {
+		if s.isCourseAdmin(usr, action.GetCourseID()) {
+			tenantActions = append(tenantActions, action)
+		}
+	}
+	return tenantActions, nil
+}
+
+// hasGroupSubmissions returns true if the given group has any submissions.
+func (s *AutograderService) hasGroupSubmissions(groupID uint64) bool {
+	submissions, err := s.db.GetSubmissions(&pb.Submission{GroupID: groupID})
+	return err == nil && len(submissions) > 0
+}
+
+// isLastTeacher returns true if the given user is the only teacher of the given course.
+func (s *AutograderService) isLastTeacher(courseID, userID uint64) bool {
+	teachers, err := s.db.GetEnrollmentsByCourse(courseID, pb.Enrollment_TEACHER)
+	return err == nil && len(teachers) == 1 && teachers[0].GetUserID() == userID
+}
+
+// confirmPendingAction executes the given pending action on behalf of the given admin,
+// who must not be the admin that requested the action.
+func (s *AutograderService) confirmPendingAction(ctx context.Context, usr *pb.User, actionID uint64) error {
+	action, err := s.db.GetPendingAction(actionID)
+	if err != nil {
+		return err
+	}
+	if action.GetRequestedByID() == usr.GetID() {
+		return errConfirmOwnAction
+	}
+	if !s.isCourseAdmin(usr, action.GetCourseID()) {
+		return errConfirmTenant
+	}
+	course, err := s.getCourse(action.GetCourseID())
+	if err != nil {
+		return err
+	}
+	sc, err := s.getSCM(ctx, usr, course.GetProvider())
+	if err != nil {
+		return err
+	}
+	switch action.GetAction() {
+	case pb.PendingAction_DELETE_COURSE:
+		err = s.deleteCourse(ctx, sc, usr, &pb.CourseCleanupRequest{CourseID: action.GetCourseID(), Repositories: action.GetRepositories()})
+	case pb.PendingAction_DELETE_GROUP:
+		err = s.deleteGroup(ctx, sc, usr, &pb.GroupRequest{CourseID: action.GetCourseID(), GroupID: action.GetGroupID()})
+	case pb.PendingAction_DEMOTE_TEACHER:
+		err = s.updateEnrollment(ctx, sc, usr.GetLogin(), &pb.Enrollment{CourseID: action.GetCourseID(), UserID: action.GetEnrolledUserID(), Status: action.GetStatus()})
+	default:
+		err = fmt.Errorf("unknown pending action %s", action.GetAction())
+	}
+	if err != nil {
+		return err
+	}
+	if err := s.db.DeletePendingAction(actionID); err != nil {
+		return err
+	}
+	s.audit(usr, action.GetCourseID(), "confirm pending action",
+		"action", action.GetAction().String(),
+		"actionID", actionID,
+		"requestedBy", action.GetRequestedBy(),
+	)
+	return nil
+}
+
+// cancelPendingAction deletes the given pending action without executing it.
+// Admins can cancel the pending actions of their tenant's courses, while other users can only cancel their own.
+func (s *AutograderService) cancelPendingAction(usr *pb.User, actionID uint64) error {
+	action, err := s.db.GetPendingAction(actionID)
+	if err != nil {
+		return err
+	}
+	if !s.isCourseAdmin(usr, action.GetCourseID()) && action.GetRequestedByID() != usr.GetID() {
+		return errCancelOtherAction
+	}
+	if err := s.db.DeletePendingAction(actionID); err != nil {
+		return err
+	}
+	s.audit(usr, action.GetCourseID(), "cancel pending action", "action", action.GetAction().String(), "actionID", actionID)
+	return nil
+}
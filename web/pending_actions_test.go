@@ -0,0 +1,135 @@
+package web_test
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/ci"
+	"github.com/autograde/quickfeed/database"
+	"github.com/autograde/quickfeed/internal/qtest"
+	"github.com/autograde/quickfeed/web"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestPendingActions(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	admin := qtest.CreateFakeUser(t, db, 1)
+	course := &pb.Course{Code: "DAT101", OrganizationID: 1, Provider: "fake"}
+	qtest.CreateCourse(t, db, admin, course)
+	teacher := qtest.CreateFakeUser(t, db, 2)
+	qtest.EnrollStudent(t, db, teacher, course)
+	if err := db.UpdateEnrollment(&pb.Enrollment{UserID: teacher.GetID(), CourseID: course.GetID(), Status: pb.Enrollment_TEACHER}); err != nil {
+		t.Fatal(err)
+	}
+	student := qtest.CreateFakeUser(t, db, 3)
+	qtest.EnrollStudent(t, db, student, course)
+
+	group := &pb.Group{Name: "group", CourseID: course.GetID(), Users: []*pb.User{student}}
+	if err := db.CreateGroup(group); err != nil {
+		t.Fatal(err)
+	}
+	lab := &pb.Assignment{CourseID: course.GetID(), Name: "lab1", Order: 1, IsGroupLab: true}
+	if err := db.CreateAssignment(lab); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.CreateSubmission(&pb.Submission{AssignmentID: lab.GetID(), GroupID: group.GetID()}); err != nil {
+		t.Fatal(err)
+	}
+
+	_, scms := qtest.FakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	client := accessControlled(t, ags)
+	adminCtx := withUserContext(context.Background(), admin)
+	teacherCtx := withUserContext(context.Background(), teacher)
+	studentCtx := withUserContext(context.Background(), student)
+
+	// deleting a group with submissions must be confirmed
+	request := &pb.GroupRequest{CourseID: course.GetID(), GroupID: group.GetID()}
+	if _, err := ags.DeleteGroup(teacherCtx, request); err != web.ErrPendingAction {
+		t.Fatalf("DeleteGroup() = %v, want %v", err, web.ErrPendingAction)
+	}
+	if _, err := db.GetGroup(group.GetID()); err != nil {
+		t.Fatalf("DeleteGroup() deleted the group before confirmation: %v", err)
+	}
+	if _, err := client.GetPendingActions(teacherCtx, &pb.Void{}); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("GetPendingActions() by teacher = %v, want code %v", err, codes.PermissionDenied)
+	}
+	actions, err := ags.GetPendingActions(adminCtx, &pb.Void{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(actions.GetActions()) != 1 {
+		t.Fatalf("GetPendingActions() = %d actions, want 1", len(actions.GetActions()))
+	}
+	action := actions.GetActions()[0]
+	if action.GetAction() != pb.PendingAction_DELETE_GROUP || action.GetRequestedBy() != teacher.GetLogin() {
+		t.Errorf("GetPendingActions() = %v, want group deletion requested by %s", action, teacher.GetLogin())
+	}
+
+	// only admins and the requester can cancel the action
+	if _, err := ags.CancelPendingAction(studentCtx, action); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("CancelPendingAction() by student = %v, want code %v", err, codes.PermissionDenied)
+	}
+	if _, err := ags.CancelPendingAction(teacherCtx, action); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ags.ConfirmPendingAction(adminCtx, action); status.Code(err) != codes.NotFound {
+		t.Errorf("ConfirmPendingAction() for canceled action = %v, want code %v", err, codes.NotFound)
+	}
+
+	// the requesting admin cannot confirm their own action
+	if _, err := ags.DeleteGroup(adminCtx, request); err != web.ErrPendingAction {
+		t.Fatalf("DeleteGroup() = %v, want %v", err, web.ErrPendingAction)
+	}
+	actions, err = ags.GetPendingActions(adminCtx, &pb.Void{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	action = actions.GetActions()[0]
+	if _, err := ags.ConfirmPendingAction(adminCtx, action); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("ConfirmPendingAction() by requester = %v, want code %v", err, codes.PermissionDenied)
+	}
+	if _, err := db.GetGroup(group.GetID()); err != nil {
+		t.Fatalf("ConfirmPendingAction() by requester deleted the group: %v", err)
+	}
+
+	// demoting the last teacher must be confirmed
+	if err := db.UpdateEnrollment(&pb.Enrollment{UserID: admin.GetID(), CourseID: course.GetID(), Status: pb.Enrollment_STUDENT}); err != nil {
+		t.Fatal(err)
+	}
+	demote := &pb.Enrollment{UserID: teacher.GetID(), CourseID: course.GetID(), Status: pb.Enrollment_STUDENT}
+	if _, err := ags.UpdateEnrollment(teacherCtx, demote); err != web.ErrPendingAction {
+		t.Fatalf("UpdateEnrollment() = %v, want %v", err, web.ErrPendingAction)
+	}
+	if !isTeacherOf(t, db, teacher, course) {
+		t.Fatal("UpdateEnrollment() demoted the last teacher before confirmation")
+	}
+	actions, err = ags.GetPendingActions(adminCtx, &pb.Void{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(actions.GetActions()) != 2 {
+		t.Fatalf("GetPendingActions() = %d actions, want 2", len(actions.GetActions()))
+	}
+	if _, err := ags.ConfirmPendingAction(adminCtx, actions.GetActions()[1]); err != nil {
+		t.Fatal(err)
+	}
+	if isTeacherOf(t, db, teacher, course) {
+		t.Error("ConfirmPendingAction() did not demote the teacher")
+	}
+}
+
+// isTeacherOf returns true if the given user is enrolled as teacher in the given course.
+func isTeacherOf(t *testing.T, db database.Database, user *pb.User, course *pb.Course) bool {
+	t.Helper()
+	enrollment, err := db.GetEnrollmentByCourseAndUser(course.GetID(), user.GetID())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return enrollment.GetStatus() == pb.Enrollment_TEACHER
+}
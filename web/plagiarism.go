@@ -0,0 +1,110 @@
+package web
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/ci"
+	"github.com/autograde/quickfeed/plagiarism"
+	"github.com/autograde/quickfeed/scm"
+)
+
+// runPlagiarismCheck fetches the code of the latest submission of each student or group for
+// the assignment, and compares the code using the requested plagiarism checker. The results
+// replace the assignment's previous plagiarism results.
+func (s *AutograderService) runPlagiarismCheck(ctx context.Context, sc scm.SCM, usr *pb.User, request *pb.PlagiarismRequest) (*pb.PlagiarismResults, error) {
+	checker, ok := s.plagiarismCheckers[request.GetTool()]
+	if !ok {
+		return nil, fmt.Errorf("unknown plagiarism checker %q", request.GetTool())
+	}
+	assignment, course, err := s.getAssignmentWithCourse(&pb.Assignment{ID: request.GetAssignmentID()}, false)
+	if err != nil {
+		return nil, err
+	}
+	if assignment.GetCourseID() != request.GetCourseID() {
+		return nil, fmt.Errorf("assignment %d does not belong to course %d", assignment.GetID(), request.GetCourseID())
+	}
+	submissions, err := s.db.GetSubmissions(&pb.Submission{AssignmentID: assignment.GetID()})
+	if err != nil {
+		return nil, err
+	}
+
+	var batch []*plagiarism.Submission
+	for _, submission := range submissions {
+		var repo *pb.Repository
+		if assignment.GetIsGroupLab() {
+			repo, err = s.getGroupRepo(course, submission.GetGroupID())
+		} else {
+			repo, err = s.getUserRepo(course, submission.GetUserID())
+		}
+		if err != nil {
+			s.logger.Debugf("Skipping submission %d in plagiarism check: %v", submission.GetID(), err)
+			continue
+		}
+		batch = append(batch, &plagiarism.Submission{
+			ID:   submission.GetID(),
+			Name: s.lookupName(submission),
+			CloneURL: sc.CreateCloneURL(&scm.URLPathOptions{
+				Organization: course.GetOrganizationPath(),
+				Repository:   path.Base(repo.GetHTMLURL()),
+			}),
+			Commit: submission.GetCommitHash(),
+		})
+	}
+
+	dir, err := ioutil.TempDir("", "plagiarism")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dir)
+	if err := plagiarism.Fetch(ctx, &ci.Local{}, dir, assignment.GetName(), batch); err != nil {
+		return nil, err
+	}
+	matches, err := checker.Check(ctx, request.GetLanguage(), batch)
+	if err != nil {
+		return nil, err
+	}
+
+	checked := time.Now().Format(pb.TimeLayout)
+	results := make([]*pb.PlagiarismResult, 0, len(matches))
+	for _, match := range matches {
+		results = append(results, &pb.PlagiarismResult{
+			CourseID:          course.GetID(),
+			AssignmentID:      assignment.GetID(),
+			SubmissionID:      match.FirstID,
+			OtherSubmissionID: match.SecondID,
+			Similarity:        match.Similarity,
+			URL:               match.URL,
+			Tool:              request.GetTool(),
+			Checked:           checked,
+		})
+	}
+	if err := s.db.UpdatePlagiarismResults(assignment.GetID(), results); err != nil {
+		return nil, err
+	}
+	s.audit(usr, course.GetID(), "run plagiarism check",
+		"course", course.GetCode(),
+		"assignment", assignment.GetName(),
+		"tool", request.GetTool(),
+		"submissions", len(batch),
+		"matches", len(results),
+	)
+	return &pb.PlagiarismResults{Results: results}, nil
+}
+
+// getPlagiarismResults returns the results of the latest plagiarism check of the assignment.
+func (s *AutograderService) getPlagiarismResults(request *pb.PlagiarismRequest) (*pb.PlagiarismResults, error) {
+	results, err := s.db.GetPlagiarismResults(&pb.PlagiarismResult{
+		CourseID:     request.GetCourseID(),
+		AssignmentID: request.GetAssignmentID(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.PlagiarismResults{Results: results}, nil
+}
@@ -0,0 +1,79 @@
+package web_test
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/ci"
+	"github.com/autograde/quickfeed/internal/qtest"
+	"github.com/autograde/quickfeed/plagiarism"
+	"github.com/autograde/quickfeed/web"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeChecker reports the given matches for any submissions.
+type fakeChecker []*plagiarism.Match
+
+func (c fakeChecker) Check(context.Context, string, []*plagiarism.Submission) ([]*plagiarism.Match, error) {
+	return c, nil
+}
+
+func TestPlagiarismCheck(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	teacher := qtest.CreateFakeUser(t, db, 1)
+	course := &pb.Course{Code: "DAT101", OrganizationID: 1}
+	qtest.CreateCourse(t, db, teacher, course)
+	students := qtest.PopulateCourse(t, db, course, 2, 0)
+	// no code is fetched for an assignment without submissions
+	assignment := &pb.Assignment{CourseID: course.GetID(), Name: "lab1", Order: 1}
+	if err := db.CreateAssignment(assignment); err != nil {
+		t.Fatal(err)
+	}
+	_, scms := qtest.FakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	client := accessControlled(t, ags)
+	fakeGothProvider()
+	ags.AddPlagiarismChecker("fake", fakeChecker{
+		{FirstID: 1, SecondID: 2, Similarity: 40},
+		{FirstID: 1, SecondID: 3, Similarity: 90, URL: "https://example.com/match"},
+	})
+	teacherCtx := withUserContext(context.Background(), teacher)
+	request := &pb.PlagiarismRequest{CourseID: course.GetID(), AssignmentID: assignment.GetID(), Tool: "fake"}
+
+	if _, err := client.RunPlagiarismCheck(withUserContext(context.Background(), students[0]), request); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("RunPlagiarismCheck() by student = %v, want code %v", err, codes.PermissionDenied)
+	}
+	unknown := &pb.PlagiarismRequest{CourseID: course.GetID(), AssignmentID: assignment.GetID(), Tool: "moss"}
+	if _, err := ags.RunPlagiarismCheck(teacherCtx, unknown); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("RunPlagiarismCheck() with unknown checker = %v, want code %v", err, codes.InvalidArgument)
+	}
+
+	// running the check twice replaces the previous results
+	for i := 0; i < 2; i++ {
+		results, err := ags.RunPlagiarismCheck(teacherCtx, request)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(results.GetResults()) != 2 {
+			t.Errorf("RunPlagiarismCheck() returned %d results, want 2", len(results.GetResults()))
+		}
+	}
+	results, err := ags.GetPlagiarismResults(teacherCtx, request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results.GetResults()) != 2 {
+		t.Fatalf("GetPlagiarismResults() returned %d results, want 2", len(results.GetResults()))
+	}
+	if first := results.GetResults()[0]; first.GetOtherSubmissionID() != 3 || first.GetSimilarity() != 90 || first.GetTool() != "fake" || first.GetChecked() == "" {
+		t.Errorf("GetPlagiarismResults() most similar result = %+v, want submissions 1 and 3 with similarity 90", first)
+	}
+	if _, err := client.GetPlagiarismResults(withUserContext(context.Background(), students[0]), request); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("GetPlagiarismResults() by student = %v, want code %v", err, codes.PermissionDenied)
+	}
+}
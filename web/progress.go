@@ -0,0 +1,88 @@
+package web
+
+import (
+	"sort"
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"gorm.io/gorm"
+)
+
+// userProgress returns the user's progress in each course the user is enrolled in as a student.
+func (s *AutograderService) userProgress(userID uint64, now time.Time) (*pb.UserProgress, error) {
+	enrollments, err := s.db.GetEnrollmentsByUser(userID, pb.Enrollment_STUDENT)
+	if err != nil {
+		return nil, err
+	}
+	progress := &pb.UserProgress{}
+	for _, enrollment := range enrollments {
+		courseProgress, err := s.courseProgress(enrollment, now)
+		if err != nil {
+			return nil, err
+		}
+		progress.Courses = append(progress.Courses, courseProgress)
+	}
+	return progress, nil
+}
+
+// courseProgress returns the progress of the enrolled student in the enrollment's course.
+// Group assignments are counted with the submissions and extensions of the student's group.
+func (s *AutograderService) courseProgress(enrollment *pb.Enrollment, now time.Time) (*pb.CourseProgress, error) {
+	course := enrollment.GetCourse()
+	enrollment.SetSlipDays(course)
+	progress := &pb.CourseProgress{
+		CourseID:          course.GetID(),
+		Code:              course.GetCode(),
+		Name:              course.GetName(),
+		UsedSlipDays:      enrollment.TotalSlipDays(),
+		RemainingSlipDays: enrollment.GetSlipDaysRemaining(),
+	}
+	assignments, err := s.getAssignments(course.GetID())
+	if err != nil {
+		return nil, err
+	}
+	for _, assignment := range visibleAssignments(assignments.GetAssignments()) {
+		owner := &pb.Submission{AssignmentID: assignment.GetID(), UserID: enrollment.GetUserID()}
+		if assignment.GetIsGroupLab() {
+			if enrollment.GetGroupID() == 0 {
+				// the student cannot submit group assignments without a group
+				owner = nil
+			} else {
+				owner = &pb.Submission{AssignmentID: assignment.GetID(), GroupID: enrollment.GetGroupID()}
+			}
+		}
+		progress.Assignments++
+		approved := false
+		if owner != nil {
+			submission, err := s.db.GetSubmission(owner)
+			if err != nil && err != gorm.ErrRecordNotFound {
+				return nil, err
+			}
+			if err == nil {
+				hideUnreleasedReviews([]*pb.Submission{submission})
+			}
+			approved = submission.GetStatus() == pb.Submission_APPROVED
+			progress.TotalScore += submission.GetScore()
+			if extension, err := s.db.GetExtension(assignment.GetID(), owner.GetUserID(), owner.GetGroupID()); err == nil {
+				assignment = assignment.WithExtension(extension)
+			}
+		}
+		if approved {
+			progress.ApprovedAssignments++
+		}
+		if sinceDeadline, err := assignment.SinceDeadline(now); err == nil && sinceDeadline < 0 {
+			progress.UpcomingDeadlines = append(progress.UpcomingDeadlines, &pb.UpcomingDeadline{
+				AssignmentID:  assignment.GetID(),
+				Name:          assignment.GetName(),
+				Deadline:      assignment.GetDeadline(),
+				LocalDeadline: assignment.LocalDeadlineIn(course.Location()),
+				IsGroupLab:    assignment.GetIsGroupLab(),
+				Approved:      approved,
+			})
+		}
+	}
+	sort.SliceStable(progress.UpcomingDeadlines, func(i, j int) bool {
+		return progress.UpcomingDeadlines[i].GetDeadline() < progress.UpcomingDeadlines[j].GetDeadline()
+	})
+	return progress, nil
+}
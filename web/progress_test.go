@@ -0,0 +1,98 @@
+package web_test
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/ci"
+	"github.com/autograde/quickfeed/internal/qtest"
+	"github.com/autograde/quickfeed/web"
+	"github.com/google/go-cmp/cmp"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func TestGetUserProgress(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	teacher := qtest.CreateFakeUser(t, db, 1)
+	course := &pb.Course{Code: "DAT101", Name: "Operating Systems", OrganizationID: 1, SlipDays: 5, Timezone: "Europe/Oslo"}
+	qtest.CreateCourse(t, db, teacher, course)
+	student := qtest.CreateFakeUser(t, db, 2)
+	qtest.EnrollStudent(t, db, student, course)
+	partner := qtest.CreateFakeUser(t, db, 3)
+	qtest.EnrollStudent(t, db, partner, course)
+	group := &pb.Group{Name: "team", CourseID: course.GetID(), Users: []*pb.User{student, partner}}
+	if err := db.CreateGroup(group); err != nil {
+		t.Fatal(err)
+	}
+	// courses the student is not yet accepted to are not reported
+	otherCourse := &pb.Course{Code: "DAT320", OrganizationID: 2}
+	qtest.CreateCourse(t, db, teacher, otherCourse)
+	if err := db.CreateEnrollment(&pb.Enrollment{UserID: student.GetID(), CourseID: otherCourse.GetID()}); err != nil {
+		t.Fatal(err)
+	}
+
+	labs := []*pb.Assignment{
+		{CourseID: course.GetID(), Name: "lab1", Order: 1, Deadline: "2020-09-01T23:59:00"},
+		{CourseID: course.GetID(), Name: "lab2", Order: 2, Deadline: "2099-10-01T23:59:00"},
+		{CourseID: course.GetID(), Name: "lab3", Order: 3, Deadline: "2099-09-01T23:59:00", IsGroupLab: true},
+		{CourseID: course.GetID(), Name: "lab4", Order: 4, Deadline: "2099-11-01T23:59:00", Release: "2099-10-02T08:00:00"},
+	}
+	for _, lab := range labs {
+		if err := db.CreateAssignment(lab); err != nil {
+			t.Fatal(err)
+		}
+	}
+	submissions := []*pb.Submission{
+		{AssignmentID: labs[0].GetID(), UserID: student.GetID(), Score: 90, Status: pb.Submission_APPROVED},
+		{AssignmentID: labs[0].GetID(), UserID: partner.GetID(), Score: 40},
+		{AssignmentID: labs[2].GetID(), GroupID: group.GetID(), Score: 50},
+	}
+	for _, submission := range submissions {
+		if err := db.CreateSubmission(submission); err != nil {
+			t.Fatal(err)
+		}
+	}
+	enrollment, err := db.GetEnrollmentByCourseAndUser(course.GetID(), student.GetID())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.UpdateSlipDays([]*pb.UsedSlipDays{{EnrollmentID: enrollment.GetID(), AssignmentID: labs[0].GetID(), UsedSlipDays: 2}}); err != nil {
+		t.Fatal(err)
+	}
+	// the group's extension applies to the group assignment
+	if err := db.UpdateExtension(&pb.Extension{CourseID: course.GetID(), AssignmentID: labs[2].GetID(), GroupID: group.GetID(), Deadline: "2099-09-03T23:59:00"}); err != nil {
+		t.Fatal(err)
+	}
+
+	_, scms := qtest.FakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	got, err := ags.GetUserProgress(withUserContext(context.Background(), student), &pb.Void{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &pb.UserProgress{
+		Courses: []*pb.CourseProgress{
+			{
+				CourseID:            course.GetID(),
+				Code:                "DAT101",
+				Name:                "Operating Systems",
+				Assignments:         3,
+				ApprovedAssignments: 1,
+				TotalScore:          140,
+				UsedSlipDays:        2,
+				RemainingSlipDays:   3,
+				UpcomingDeadlines: []*pb.UpcomingDeadline{
+					{AssignmentID: labs[2].GetID(), Name: "lab3", Deadline: "2099-09-03T23:59:00", LocalDeadline: "2099-09-04T01:59:00+02:00", IsGroupLab: true},
+					{AssignmentID: labs[1].GetID(), Name: "lab2", Deadline: "2099-10-01T23:59:00", LocalDeadline: "2099-10-02T01:59:00+02:00"},
+				},
+			},
+		},
+	}
+	if diff := cmp.Diff(want, got, protocmp.Transform()); diff != "" {
+		t.Errorf("GetUserProgress() mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,164 @@
+package web
+
+import (
+	"context"
+	"fmt"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/notify"
+)
+
+// isAuthorOrTeacher returns true if the given user is the author, or a teacher of the given course.
+func (s *AutograderService) isAuthorOrTeacher(usr *pb.User, authorID, courseID uint64) bool {
+	return usr.IsOwner(authorID) || s.isTeacher(usr.GetID(), courseID)
+}
+
+// getAssignmentInCourse returns the assignment with the given ID if it belongs to the given course.
+func (s *AutograderService) getAssignmentInCourse(assignmentID, courseID uint64) (*pb.Assignment, error) {
+	assignment, err := s.db.GetAssignment(&pb.Assignment{ID: assignmentID})
+	if err != nil {
+		return nil, err
+	}
+	if assignment.GetCourseID() != courseID {
+		return nil, fmt.Errorf("assignment %d does not belong to course %d", assignmentID, courseID)
+	}
+	return assignment, nil
+}
+
+// getQuestionInCourse returns the question with the given ID if it belongs to the given course.
+func (s *AutograderService) getQuestionInCourse(questionID, courseID uint64) (*pb.Question, error) {
+	question, err := s.db.GetQuestion(questionID)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.getAssignmentInCourse(question.GetAssignmentID(), courseID); err != nil {
+		return nil, err
+	}
+	return question, nil
+}
+
+// getAnswerInCourse returns the answer with the given ID and the answered question,
+// if the question belongs to the given course.
+func (s *AutograderService) getAnswerInCourse(answerID, courseID uint64) (*pb.Answer, *pb.Question, error) {
+	answer, err := s.db.GetAnswer(answerID)
+	if err != nil {
+		return nil, nil, err
+	}
+	question, err := s.getQuestionInCourse(answer.GetQuestionID(), courseID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return answer, question, nil
+}
+
+// getQuestions returns all questions and answers for the given assignment.
+func (s *AutograderService) getQuestions(request *pb.AssignmentRequest) (*pb.Questions, error) {
+	if _, err := s.getAssignmentInCourse(request.GetAssignmentID(), request.GetCourseID()); err != nil {
+		return nil, err
+	}
+	questions, err := s.db.GetQuestions(request.GetAssignmentID())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.Questions{Questions: questions}, nil
+}
+
+// createQuestion creates a new question asked by the given user and notifies the course teachers.
+func (s *AutograderService) createQuestion(ctx context.Context, author *pb.User, request *pb.QuestionRequest) (*pb.Question, error) {
+	assignment, err := s.getAssignmentInCourse(request.GetQuestion().GetAssignmentID(), request.GetCourseID())
+	if err != nil {
+		return nil, err
+	}
+	question := &pb.Question{
+		AssignmentID: assignment.GetID(),
+		AuthorID:     author.GetID(),
+		Title:        request.GetQuestion().GetTitle(),
+		Body:         request.GetQuestion().GetBody(),
+	}
+	if err := s.db.CreateQuestion(question); err != nil {
+		return nil, err
+	}
+	teachers, err := s.db.GetEnrollmentsByCourse(request.GetCourseID(), pb.Enrollment_TEACHER)
+	if err != nil {
+		s.logger.Errorf("Failed to notify teachers about question %d: %v", question.GetID(), err)
+		return question, nil
+	}
+	var teacherIDs []uint64
+	for _, teacher := range teachers {
+		teacherIDs = append(teacherIDs, teacher.GetUserID())
+	}
+	s.notifier.Dispatch(ctx, &notify.Notification{
+		CourseID: request.GetCourseID(),
+		UserIDs:  teacherIDs,
+		Subject:  fmt.Sprintf("New question about %s: %s", assignment.GetName(), question.GetTitle()),
+		Body:     question.GetBody(),
+	})
+	return question, nil
+}
+
+// updateQuestion updates the given question, including its accepted answer.
+func (s *AutograderService) updateQuestion(question, update *pb.Question) error {
+	if acceptedID := update.GetAcceptedAnswerID(); acceptedID > 0 {
+		answer, err := s.db.GetAnswer(acceptedID)
+		if err != nil {
+			return err
+		}
+		if answer.GetQuestionID() != question.GetID() {
+			return fmt.Errorf("answer %d does not belong to question %d", acceptedID, question.GetID())
+		}
+	}
+	question.Title = update.GetTitle()
+	question.Body = update.GetBody()
+	question.AcceptedAnswerID = update.GetAcceptedAnswerID()
+	return s.db.UpdateQuestion(question)
+}
+
+// createAnswer creates a new answer by the given user and notifies the question's author.
+// Only teachers can endorse answers.
+func (s *AutograderService) createAnswer(ctx context.Context, author *pb.User, request *pb.AnswerRequest) (*pb.Answer, error) {
+	question, err := s.getQuestionInCourse(request.GetAnswer().GetQuestionID(), request.GetCourseID())
+	if err != nil {
+		return nil, err
+	}
+	answer := &pb.Answer{
+		QuestionID: question.GetID(),
+		AuthorID:   author.GetID(),
+		Body:       request.GetAnswer().GetBody(),
+		Endorsed:   request.GetAnswer().GetEndorsed() && s.isTeacher(author.GetID(), request.GetCourseID()),
+	}
+	if err := s.db.CreateAnswer(answer); err != nil {
+		return nil, err
+	}
+	if !author.IsOwner(question.GetAuthorID()) {
+		s.notifier.Dispatch(ctx, &notify.Notification{
+			CourseID: request.GetCourseID(),
+			UserIDs:  []uint64{question.GetAuthorID()},
+			Subject:  fmt.Sprintf("New answer to your question: %s", question.GetTitle()),
+			Body:     answer.GetBody(),
+		})
+	}
+	return answer, nil
+}
+
+// updateAnswer updates the given answer. Only the author can change the answer's body,
+// and only teachers can change the answer's endorsement.
+func (s *AutograderService) updateAnswer(usr *pb.User, courseID uint64, answer, update *pb.Answer) error {
+	if usr.IsOwner(answer.GetAuthorID()) {
+		answer.Body = update.GetBody()
+	}
+	if s.isTeacher(usr.GetID(), courseID) {
+		answer.Endorsed = update.GetEndorsed()
+	}
+	return s.db.UpdateAnswer(answer)
+}
+
+// deleteAnswer deletes the given answer, and unsets it as the question's accepted answer.
+func (s *AutograderService) deleteAnswer(answer *pb.Answer, question *pb.Question) error {
+	if question.GetAcceptedAnswerID() == answer.GetID() {
+		question.AcceptedAnswerID = 0
+		if err := s.db.UpdateQuestion(question); err != nil {
+			return err
+		}
+	}
+	return s.db.DeleteAnswer(answer.GetID())
+}
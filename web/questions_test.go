@@ -0,0 +1,109 @@
+package web_test
+
+import (
+	"context"
+	"testing"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/ci"
+	"github.com/autograde/quickfeed/internal/qtest"
+	"github.com/autograde/quickfeed/web"
+)
+
+func TestQuestionsAndAnswers(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	teacher := qtest.CreateFakeUser(t, db, 1)
+	course := &pb.Course{}
+	qtest.CreateCourse(t, db, teacher, course)
+	student1 := qtest.CreateFakeUser(t, db, 2)
+	qtest.EnrollStudent(t, db, student1, course)
+	student2 := qtest.CreateFakeUser(t, db, 3)
+	qtest.EnrollStudent(t, db, student2, course)
+	assignment := &pb.Assignment{CourseID: course.ID, Name: "lab1", Order: 1}
+	if err := db.CreateAssignment(assignment); err != nil {
+		t.Fatal(err)
+	}
+
+	_, scms := qtest.FakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	notifier := &testNotifier{}
+	ags.RegisterNotifier(notifier)
+
+	teacherCtx := withUserContext(context.Background(), teacher)
+	student1Ctx := withUserContext(context.Background(), student1)
+	student2Ctx := withUserContext(context.Background(), student2)
+
+	question, err := ags.CreateQuestion(student1Ctx, &pb.QuestionRequest{
+		CourseID: course.ID,
+		Question: &pb.Question{AssignmentID: assignment.ID, Title: "Task 2", Body: "What does task 2 mean?"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(notifier.notifications) != 1 || notifier.notifications[0].UserIDs[0] != teacher.ID {
+		t.Errorf("expected teacher to be notified about new question, got %+v", notifier.notifications)
+	}
+
+	// students cannot endorse their own answers
+	answer, err := ags.CreateAnswer(student2Ctx, &pb.AnswerRequest{
+		CourseID: course.ID,
+		Answer:   &pb.Answer{QuestionID: question.ID, Body: "Read the README", Endorsed: true},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if answer.GetEndorsed() {
+		t.Error("expected student answer not to be endorsed")
+	}
+	if len(notifier.notifications) != 2 || notifier.notifications[1].UserIDs[0] != student1.ID {
+		t.Errorf("expected question author to be notified about new answer, got %+v", notifier.notifications)
+	}
+
+	// only the author or teachers can update questions
+	question.AcceptedAnswerID = answer.ID
+	if _, err := ags.UpdateQuestion(student2Ctx, &pb.QuestionRequest{CourseID: course.ID, Question: question}); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("UpdateQuestion() by other student: got %v, want %v", err, codes.PermissionDenied)
+	}
+	if _, err := ags.UpdateQuestion(student1Ctx, &pb.QuestionRequest{CourseID: course.ID, Question: question}); err != nil {
+		t.Fatal(err)
+	}
+	answer.Endorsed = true
+	if _, err := ags.UpdateAnswer(teacherCtx, &pb.AnswerRequest{CourseID: course.ID, Answer: answer}); err != nil {
+		t.Fatal(err)
+	}
+
+	questions, err := ags.GetQuestions(student2Ctx, &pb.AssignmentRequest{CourseID: course.ID, AssignmentID: assignment.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(questions.GetQuestions()) != 1 {
+		t.Fatalf("expected 1 question, got %d", len(questions.GetQuestions()))
+	}
+	got := questions.GetQuestions()[0]
+	if got.GetAcceptedAnswerID() != answer.ID {
+		t.Errorf("expected accepted answer %d, got %d", answer.ID, got.GetAcceptedAnswerID())
+	}
+	if len(got.GetAnswers()) != 1 || !got.GetAnswers()[0].GetEndorsed() {
+		t.Errorf("expected 1 endorsed answer, got %+v", got.GetAnswers())
+	}
+
+	if _, err := ags.DeleteAnswer(student2Ctx, &pb.AnswerRequest{CourseID: course.ID, Answer: answer}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ags.DeleteQuestion(teacherCtx, &pb.QuestionRequest{CourseID: course.ID, Question: question}); err != nil {
+		t.Fatal(err)
+	}
+	questions, err = ags.GetQuestions(student1Ctx, &pb.AssignmentRequest{CourseID: course.ID, AssignmentID: assignment.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(questions.GetQuestions()) != 0 {
+		t.Errorf("expected no questions after deletion, got %d", len(questions.GetQuestions()))
+	}
+}
@@ -1,6 +1,9 @@
 package web
 
 import (
+	"errors"
+	"fmt"
+	"math"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -12,13 +15,51 @@ import (
 
 const maxContainers = 10
 
-// rebuildSubmission rebuilds the given assignment and submission.
-func (s *AutograderService) rebuildSubmission(request *pb.RebuildRequest) (*pb.Submission, error) {
+var (
+	errRebuildAccess = errors.New("only teaching staff and the submission's authors can rebuild the submission")
+	errRebuildQueued = errors.New("tests of the submission are already queued")
+)
+
+// getRebuildSubmission returns the submission to rebuild for the given request.
+// If the request specifies an assignment, the submission must belong to it.
+func (s *AutograderService) getRebuildSubmission(request *pb.RebuildRequest) (*pb.Submission, error) {
 	submission, err := s.db.GetSubmission(&pb.Submission{ID: request.GetSubmissionID()})
 	if err != nil {
 		return nil, err
 	}
-	assignment, course, err := s.getAssignmentWithCourse(&pb.Assignment{ID: request.AssignmentID}, false)
+	if request.GetAssignmentID() > 0 && request.GetAssignmentID() != submission.GetAssignmentID() {
+		return nil, fmt.Errorf("submission %d does not belong to assignment %d", submission.GetID(), request.GetAssignmentID())
+	}
+	return submission, nil
+}
+
+// rebuildPriority returns the priority of the given user's rebuild of the submission.
+// Teachers and teaching assistants allowed to approve submissions rebuild with high priority,
+// whereas students rebuild their own submissions with the same priority as pushed submissions,
+// and only if the tests of their submissions for the assignment are not already queued.
+func (s *AutograderService) rebuildPriority(usr *pb.User, submission *pb.Submission, assignment *pb.Assignment) (pb.TestJob_Priority, error) {
+	if s.canApprove(usr.GetID(), assignment.GetCourseID()) {
+		return pb.TestJob_HIGH, nil
+	}
+	if !s.isSubmissionAuthor(usr, submission) || !s.hasCourseAccess(usr.GetID(), assignment.GetCourseID(), func(e *pb.Enrollment) bool {
+		return e.IsStudent()
+	}) {
+		return 0, errRebuildAccess
+	}
+	if _, queued := s.runners.Status(assignment.GetID(), submission.GetUserID(), submission.GetGroupID()); queued {
+		return 0, errRebuildQueued
+	}
+	return pb.TestJob_NORMAL, nil
+}
+
+// rebuildSubmission rebuilds the requested submission,
+// queuing the tests with the given priority.
+func (s *AutograderService) rebuildSubmission(request *pb.RebuildRequest, priority pb.TestJob_Priority) (*pb.Submission, error) {
+	submission, err := s.getRebuildSubmission(request)
+	if err != nil {
+		return nil, err
+	}
+	assignment, course, err := s.getAssignmentWithCourse(&pb.Assignment{ID: submission.GetAssignmentID()}, false)
 	if err != nil {
 		return nil, err
 	}
@@ -45,13 +86,18 @@ func (s *AutograderService) rebuildSubmission(request *pb.RebuildRequest) (*pb.S
 		CommitID:   submission.GetCommitHash(),
 		JobOwner:   slug.Make(name),
 		Rebuild:    true,
+		Priority:   priority,
+
+		PullRequest: submission.GetPullRequest(),
 	}
-	ci.RunTests(s.logger, s.db, s.runner, runData)
+	s.runners.RunTests(runData)
 	return s.db.GetSubmission(&pb.Submission{ID: request.GetSubmissionID()})
 }
 
+// rebuildSubmissions rebuilds all submissions for the requested assignment,
+// queuing the tests with low priority.
 func (s *AutograderService) rebuildSubmissions(request *pb.AssignmentRequest) error {
-	if _, err := s.db.GetAssignment(&pb.Assignment{ID: request.AssignmentID}); err != nil {
+	if _, err := s.getAssignmentInCourse(request.GetAssignmentID(), request.GetCourseID()); err != nil {
 		return err
 	}
 	submissions, err := s.db.GetSubmissions(&pb.Submission{AssignmentID: request.AssignmentID})
@@ -74,7 +120,7 @@ func (s *AutograderService) rebuildSubmissions(request *pb.AssignmentRequest) er
 		// the counting semaphore limits concurrency to maxContainers
 		go func() {
 			sem <- struct{}{} // acquire semaphore
-			_, err := s.rebuildSubmission(rebuildReq)
+			_, err := s.rebuildSubmission(rebuildReq, pb.TestJob_LOW)
 			if err != nil {
 				atomic.AddInt32(&errCnt, 1)
 				s.logger.Errorf("Failed to rebuild submission %d: %v\n", rebuildReq.GetSubmissionID(), err)
@@ -92,6 +138,28 @@ func (s *AutograderService) rebuildSubmissions(request *pb.AssignmentRequest) er
 	return nil
 }
 
+// getSubmissionStatus returns the status of the tests for the requested
+// user's or group's submission in the test queue.
+func (s *AutograderService) getSubmissionStatus(request *pb.SubmissionStatusRequest) (*pb.SubmissionStatus, error) {
+	if _, err := s.getAssignmentInCourse(request.GetAssignmentID(), request.GetCourseID()); err != nil {
+		return nil, err
+	}
+	queueStatus, ok := s.runners.Status(request.GetAssignmentID(), request.GetUserID(), request.GetGroupID())
+	if !ok {
+		return &pb.SubmissionStatus{State: pb.SubmissionStatus_NONE}, nil
+	}
+	submissionStatus := &pb.SubmissionStatus{
+		State:         pb.SubmissionStatus_QUEUED,
+		Position:      uint32(queueStatus.Position),
+		EstimatedWait: uint32(math.Ceil(queueStatus.EstimatedWait.Seconds())),
+		Enqueued:      queueStatus.Enqueued.Format(pb.TimeLayout),
+	}
+	if queueStatus.Running {
+		submissionStatus.State = pb.SubmissionStatus_RUNNING
+	}
+	return submissionStatus, nil
+}
+
 func (s *AutograderService) lookupName(submission *pb.Submission) string {
 	if submission.GetGroupID() > 0 {
 		group, _ := s.db.GetGroup(submission.GetGroupID())
@@ -14,6 +14,8 @@ import (
 	"github.com/autograde/quickfeed/scm"
 	"github.com/autograde/quickfeed/web"
 	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func TestSimulatedRebuildWorkpoolWithErrCount(t *testing.T) {
@@ -115,6 +117,7 @@ func TestRebuildSubmissions(t *testing.T) {
 	}
 	fakeProvider, scms := qtest.FakeProviderMap(t)
 	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	client := accessControlled(t, ags)
 	ctx := withUserContext(context.Background(), teacher)
 
 	_, err = fakeProvider.CreateOrganization(context.Background(), &scm.OrganizationOptions{Path: "path", Name: "name"})
@@ -193,7 +196,74 @@ func TestRebuildSubmissions(t *testing.T) {
 
 	// check access control
 	ctx = withUserContext(ctx, student1)
-	if _, err = ags.RebuildSubmissions(ctx, &request); err == nil {
+	if _, err = client.RebuildSubmissions(ctx, &request); err == nil {
 		t.Fatal("Expected error: authentication failed")
 	}
 }
+
+func TestRebuildSubmissionAccess(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	teacher := qtest.CreateFakeUser(t, db, 1)
+	course := &pb.Course{Code: "DAT101", Provider: "fake", OrganizationID: 1}
+	qtest.CreateCourse(t, db, teacher, course)
+	otherCourse := &pb.Course{Code: "DAT102", Provider: "fake", OrganizationID: 2}
+	qtest.CreateCourse(t, db, teacher, otherCourse)
+	student1 := qtest.CreateFakeUser(t, db, 2)
+	student2 := qtest.CreateFakeUser(t, db, 3)
+	for i, student := range []*pb.User{student1, student2} {
+		qtest.EnrollStudent(t, db, student, course)
+		if err := db.CreateRepository(&pb.Repository{
+			OrganizationID: course.GetOrganizationID(),
+			RepositoryID:   uint64(i + 1),
+			UserID:         student.GetID(),
+			RepoType:       pb.Repository_USER,
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	lab1 := &pb.Assignment{CourseID: course.GetID(), Name: "lab1", ScriptFile: "go.sh", Order: 1, ContainerTimeout: 1}
+	lab2 := &pb.Assignment{CourseID: course.GetID(), Name: "lab2", ScriptFile: "go.sh", Order: 2, ContainerTimeout: 1}
+	otherLab := &pb.Assignment{CourseID: otherCourse.GetID(), Name: "lab1", ScriptFile: "go.sh", Order: 1}
+	for _, assignment := range []*pb.Assignment{lab1, lab2, otherLab} {
+		if err := db.CreateAssignment(assignment); err != nil {
+			t.Fatal(err)
+		}
+	}
+	submission1 := &pb.Submission{AssignmentID: lab1.GetID(), UserID: student1.GetID()}
+	submission2 := &pb.Submission{AssignmentID: lab1.GetID(), UserID: student2.GetID()}
+	for _, submission := range []*pb.Submission{submission1, submission2} {
+		if err := db.CreateSubmission(submission); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	_, scms := qtest.FakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	client := accessControlled(t, ags)
+	teacherCtx := withUserContext(context.Background(), teacher)
+	studentCtx := withUserContext(context.Background(), student1)
+
+	if _, err := ags.RebuildSubmission(studentCtx, &pb.RebuildRequest{SubmissionID: submission2.GetID()}); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("RebuildSubmission() of another student's submission: got %v, want %v", err, codes.PermissionDenied)
+	}
+	if _, err := ags.RebuildSubmission(studentCtx, &pb.RebuildRequest{AssignmentID: lab2.GetID(), SubmissionID: submission1.GetID()}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("RebuildSubmission() for another assignment: got %v, want %v", err, codes.InvalidArgument)
+	}
+	if _, err := ags.RebuildSubmission(studentCtx, &pb.RebuildRequest{SubmissionID: submission1.GetID()}); err != nil {
+		t.Errorf("RebuildSubmission() of own submission: %v", err)
+	}
+	if _, err := ags.RebuildSubmission(teacherCtx, &pb.RebuildRequest{AssignmentID: lab1.GetID(), SubmissionID: submission2.GetID()}); err != nil {
+		t.Errorf("RebuildSubmission() by teacher: %v", err)
+	}
+
+	// bulk rebuilds are restricted to assignments of the teacher's course
+	if _, err := ags.RebuildSubmissions(teacherCtx, &pb.AssignmentRequest{CourseID: course.GetID(), AssignmentID: otherLab.GetID()}); err == nil {
+		t.Error("RebuildSubmissions() for assignment in another course: expected error")
+	}
+	if _, err := client.RebuildSubmissions(studentCtx, &pb.AssignmentRequest{CourseID: course.GetID(), AssignmentID: lab1.GetID()}); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("RebuildSubmissions() by student: got %v, want %v", err, codes.PermissionDenied)
+	}
+}
@@ -0,0 +1,105 @@
+package web
+
+import (
+	"sort"
+	"sync"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/ci"
+	"github.com/autograde/quickfeed/kit/score"
+	"github.com/gosimple/slug"
+)
+
+// replaySubmissions replays the tests for a sample of the course's most recent submissions
+// on the requested runner pool, and compares the replayed test scores with the recorded scores.
+// The replayed results are not recorded, such that upgraded runners or test images can be
+// validated on a staging pool before they are used for grading students' submissions.
+func (s *AutograderService) replaySubmissions(request *pb.ReplayRequest) (*pb.ReplayReport, error) {
+	course, err := s.db.GetCourse(request.GetCourseID(), false)
+	if err != nil {
+		return nil, err
+	}
+	assignments, err := s.db.GetAssignmentsWithSubmissions(request.GetCourseID(), pb.SubmissionsForCourseRequest_ALL, false)
+	if err != nil {
+		return nil, err
+	}
+	assignmentMap := make(map[uint64]*pb.Assignment)
+	var submissions []*pb.Submission
+	for _, assignment := range assignments {
+		if assignment.SkipTests() || (request.GetAssignmentID() > 0 && assignment.GetID() != request.GetAssignmentID()) {
+			continue
+		}
+		assignmentMap[assignment.GetID()] = assignment
+		submissions = append(submissions, assignment.GetSubmissions()...)
+	}
+	// the most recent submissions have the highest IDs
+	sort.Slice(submissions, func(i, j int) bool {
+		return submissions[i].GetID() > submissions[j].GetID()
+	})
+	if len(submissions) > int(request.GetSample()) {
+		submissions = submissions[:request.GetSample()]
+	}
+
+	report := &pb.ReplayReport{
+		CourseID:   request.GetCourseID(),
+		RunnerPool: request.GetRunnerPool(),
+		Results:    make([]*pb.ReplayResult, len(submissions)),
+	}
+	var wg sync.WaitGroup
+	for i, submission := range submissions {
+		result := &pb.ReplayResult{
+			SubmissionID:  submission.GetID(),
+			AssignmentID:  submission.GetAssignmentID(),
+			UserID:        submission.GetUserID(),
+			GroupID:       submission.GetGroupID(),
+			CommitHash:    submission.GetCommitHash(),
+			RecordedScore: score.NewResults(submission.GetScores()...).Sum(),
+		}
+		report.Results[i] = result
+		wg.Add(1)
+		go func(submission *pb.Submission) {
+			defer wg.Done()
+			replayed, err := s.replaySubmission(course, assignmentMap[submission.GetAssignmentID()], submission, request.GetRunnerPool())
+			if err != nil {
+				result.Error = err.Error()
+				return
+			}
+			result.ReplayedScore = replayed
+		}(submission)
+	}
+	wg.Wait()
+
+	for _, result := range report.GetResults() {
+		if result.GetError() != "" || result.GetRecordedScore() != result.GetReplayedScore() {
+			report.Mismatches++
+		}
+	}
+	return report, nil
+}
+
+// replaySubmission replays the tests for the given submission on the given runner pool,
+// and returns the replayed test score.
+func (s *AutograderService) replaySubmission(course *pb.Course, assignment *pb.Assignment, submission *pb.Submission, pool string) (uint32, error) {
+	var repo *pb.Repository
+	var err error
+	if assignment.GetIsGroupLab() {
+		repo, err = s.getGroupRepo(course, submission.GetGroupID())
+	} else {
+		repo, err = s.getUserRepo(course, submission.GetUserID())
+	}
+	if err != nil {
+		return 0, err
+	}
+	results, err := s.runners.Replay(pool, &ci.RunData{
+		Course:     course,
+		Assignment: assignment,
+		Repo:       repo,
+		CommitID:   submission.GetCommitHash(),
+		JobOwner:   slug.Make(s.lookupName(submission)),
+		Rebuild:    true,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return results.Sum(), nil
+}
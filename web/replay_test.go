@@ -0,0 +1,103 @@
+package web_test
+
+import (
+	"context"
+	"testing"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/ci"
+	"github.com/autograde/quickfeed/internal/qtest"
+	"github.com/autograde/quickfeed/kit/score"
+	"github.com/autograde/quickfeed/web"
+)
+
+func TestReplaySubmissions(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	admin := qtest.CreateFakeUser(t, db, 1)
+	if err := db.UpdateUser(&pb.User{ID: admin.ID, IsAdmin: true}); err != nil {
+		t.Fatal(err)
+	}
+	course := &pb.Course{OrganizationID: 1}
+	qtest.CreateCourse(t, db, admin, course)
+	students := []*pb.User{qtest.CreateFakeUser(t, db, 2), qtest.CreateFakeUser(t, db, 3)}
+	for i, student := range students {
+		qtest.EnrollStudent(t, db, student, course)
+		if err := db.CreateRepository(&pb.Repository{
+			OrganizationID: 1,
+			RepositoryID:   uint64(i + 1),
+			UserID:         student.ID,
+			RepoType:       pb.Repository_USER,
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// the replayed tests always give a score of 80
+	assignment := &pb.Assignment{
+		CourseID:   course.ID,
+		Name:       "lab1",
+		Order:      1,
+		ScriptFile: `#image/qf101` + "\n" + `echo '{"Secret":"{{ .RandomSecret }}","TestName":"TestLab1","Score":8,"MaxScore":10,"Weight":1}'`,
+	}
+	if err := db.CreateAssignment(assignment); err != nil {
+		t.Fatal(err)
+	}
+	recorded := []int32{8, 5}
+	for i, student := range students {
+		if err := db.CreateSubmission(&pb.Submission{
+			AssignmentID: assignment.ID,
+			UserID:       student.ID,
+			Scores:       []*score.Score{{TestName: "TestLab1", Score: recorded[i], MaxScore: 10, Weight: 1}},
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	_, scms := qtest.FakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	client := accessControlled(t, ags)
+	ags.AddRunnerPool("staging", &ci.Local{})
+
+	ctx := withUserContext(context.Background(), students[0])
+	if _, err := client.ReplaySubmissions(ctx, &pb.ReplayRequest{CourseID: course.ID, Sample: 2, RunnerPool: "staging"}); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("ReplaySubmissions() by student: got error %v, want PermissionDenied", err)
+	}
+
+	ctx = withUserContext(context.Background(), admin)
+	report, err := ags.ReplaySubmissions(ctx, &pb.ReplayRequest{CourseID: course.ID, Sample: 2, RunnerPool: "staging"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(report.GetResults()) != 2 {
+		t.Fatalf("ReplaySubmissions() returned %d results, want 2", len(report.GetResults()))
+	}
+	// the most recent submission is replayed first
+	for i, want := range []struct {
+		userID   uint64
+		recorded uint32
+	}{{students[1].ID, 50}, {students[0].ID, 80}} {
+		result := report.GetResults()[i]
+		if result.GetError() != "" {
+			t.Fatalf("ReplaySubmissions() result %d: unexpected error: %s", i, result.GetError())
+		}
+		if result.GetUserID() != want.userID || result.GetRecordedScore() != want.recorded || result.GetReplayedScore() != 80 {
+			t.Errorf("ReplaySubmissions() result %d = %v, want user %d with recorded score %d and replayed score 80", i, result, want.userID, want.recorded)
+		}
+	}
+	if report.GetMismatches() != 1 {
+		t.Errorf("ReplaySubmissions() mismatches = %d, want 1", report.GetMismatches())
+	}
+
+	report, err = ags.ReplaySubmissions(ctx, &pb.ReplayRequest{CourseID: course.ID, Sample: 1, RunnerPool: "unknown"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(report.GetResults()) != 1 || report.GetResults()[0].GetError() == "" || report.GetMismatches() != 1 {
+		t.Errorf("ReplaySubmissions() on unknown pool = %v, want one failed replay", report)
+	}
+}
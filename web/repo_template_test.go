@@ -0,0 +1,71 @@
+package web_test
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/ci"
+	"github.com/autograde/quickfeed/internal/qtest"
+	"github.com/autograde/quickfeed/scm"
+	"github.com/autograde/quickfeed/web"
+	"go.uber.org/zap"
+)
+
+func TestStudentRepoFromTemplate(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	admin := qtest.CreateFakeUser(t, db, 1)
+	fakeGothProvider()
+	ctx := withUserContext(context.Background(), admin)
+	fakeProvider, scms := qtest.FakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	org, err := fakeProvider.CreateOrganization(ctx, &scm.OrganizationOptions{Path: "path", Name: "name"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	course, err := ags.CreateCourse(ctx, &pb.Course{
+		Name:                "Distributed Systems",
+		CourseCreatorID:     admin.GetID(),
+		Code:                "DAT520",
+		Year:                2018,
+		Tag:                 "Spring",
+		Provider:            "fake",
+		OrganizationID:      org.GetID(),
+		AssignmentsTemplate: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	fake := fakeProvider.(*scm.FakeSCM)
+	if len(fake.RepoTemplates) != 0 {
+		t.Errorf("CreateCourse() created %d repositories from template, want none", len(fake.RepoTemplates))
+	}
+
+	student := qtest.CreateFakeUser(t, db, 2)
+	enrollment := &pb.Enrollment{CourseID: course.GetID(), UserID: student.GetID()}
+	if _, err = ags.CreateEnrollment(ctx, enrollment); err != nil {
+		t.Fatal(err)
+	}
+	enrollment.Status = pb.Enrollment_STUDENT
+	if _, err = ags.UpdateEnrollment(ctx, enrollment); err != nil {
+		t.Fatal(err)
+	}
+
+	repos, err := db.GetRepositories(&pb.Repository{OrganizationID: org.GetID(), UserID: student.GetID(), RepoType: pb.Repository_USER})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(repos) != 1 {
+		t.Fatalf("UpdateEnrollment() created %d student repositories, want 1", len(repos))
+	}
+	template, ok := fake.RepoTemplates[repos[0].GetRepositoryID()]
+	if !ok {
+		t.Fatal("UpdateEnrollment() did not create the student repository from template")
+	}
+	if template.Owner != org.GetPath() || template.Path != pb.AssignmentRepo {
+		t.Errorf("student repository template = %s/%s, want %s/%s", template.Owner, template.Path, org.GetPath(), pb.AssignmentRepo)
+	}
+}
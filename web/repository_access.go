@@ -0,0 +1,45 @@
+package web
+
+import (
+	"context"
+	"path"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/scm"
+)
+
+// setCourseRepositoryAccess gives the owners of all student and group repositories of the course
+// read access if the request is for read-only repositories, and write access otherwise.
+// A repository whose access cannot be updated is reported with the error, and the remaining
+// repositories are still updated.
+func (s *AutograderService) setCourseRepositoryAccess(ctx context.Context, sc scm.SCM, usr *pb.User, request *pb.RepositoryAccessRequest) (*pb.RepositoryAccessReport, error) {
+	course, err := s.db.GetCourse(request.GetCourseID(), false)
+	if err != nil {
+		return nil, err
+	}
+	repos, err := s.db.GetRepositories(&pb.Repository{OrganizationID: course.GetOrganizationID()})
+	if err != nil {
+		return nil, err
+	}
+	permission := scm.RepoPush
+	if request.GetReadOnly() {
+		permission = scm.RepoPull
+	}
+	report := &pb.RepositoryAccessReport{CourseID: course.GetID()}
+	for _, repo := range repos {
+		if !repo.IsStudentRepo() {
+			continue
+		}
+		result := &pb.RepositoryAccessResult{Repository: path.Base(repo.GetHTMLURL()), Permission: permission}
+		changed, err := s.updateRepoOwnerAccess(ctx, sc, course, repo, permission)
+		if err != nil {
+			s.logger.Errorf("Failed to update access to %s in course %s: %v", repo.GetHTMLURL(), course.GetCode(), err)
+			result.Error = err.Error()
+		} else if changed {
+			s.audit(usr, course.GetID(), "update repository access", "repository", result.GetRepository(), "permission", permission)
+			result.Updated = true
+		}
+		report.Results = append(report.Results, result)
+	}
+	return report, nil
+}
@@ -0,0 +1,125 @@
+package web_test
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/ci"
+	"github.com/autograde/quickfeed/internal/qtest"
+	"github.com/autograde/quickfeed/scm"
+	"github.com/autograde/quickfeed/web"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestSetCourseRepositoryAccess(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	teacher := qtest.CreateFakeUser(t, db, 1)
+	student := qtest.CreateUser(t, db, 2, &pb.User{Login: "student"})
+	course := &pb.Course{Provider: "fake", OrganizationID: 1, OrganizationPath: "test"}
+	qtest.CreateCourse(t, db, teacher, course)
+	qtest.EnrollStudent(t, db, student, course)
+	fakeProvider, scms := qtest.FakeProviderMap(t)
+	fake := fakeProvider.(*scm.FakeSCM)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	client := accessControlled(t, ags)
+	fakeGothProvider()
+	ctx := context.Background()
+
+	org := &pb.Organization{ID: 1, Path: "test"}
+	studentRepo, err := fake.CreateRepository(ctx, &scm.CreateRepositoryOptions{Organization: org, Path: "student-labs"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	groupRepo, err := fake.CreateRepository(ctx, &scm.CreateRepositoryOptions{Organization: org, Path: "group1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	team, err := fake.CreateTeam(ctx, &scm.NewTeamOptions{Organization: org.Path, TeamName: "group1", Users: []string{"student"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	group := &pb.Group{Name: "group1", CourseID: course.ID, TeamID: team.ID, Users: []*pb.User{student}}
+	if err := db.CreateGroup(group); err != nil {
+		t.Fatal(err)
+	}
+	// the second group's team is missing on the SCM, such that its access cannot be updated
+	brokenGroup := &pb.Group{Name: "group2", CourseID: course.ID, TeamID: 99, Users: []*pb.User{student}}
+	if err := db.CreateGroup(brokenGroup); err != nil {
+		t.Fatal(err)
+	}
+	brokenRepo, err := fake.CreateRepository(ctx, &scm.CreateRepositoryOptions{Organization: org, Path: "group2"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, repo := range []*pb.Repository{
+		{OrganizationID: 1, RepositoryID: studentRepo.ID, UserID: student.ID, HTMLURL: studentRepo.WebURL, RepoType: pb.Repository_USER},
+		{OrganizationID: 1, RepositoryID: groupRepo.ID, GroupID: group.ID, HTMLURL: groupRepo.WebURL, RepoType: pb.Repository_GROUP},
+		{OrganizationID: 1, RepositoryID: brokenRepo.ID, GroupID: brokenGroup.ID, HTMLURL: brokenRepo.WebURL, RepoType: pb.Repository_GROUP},
+	} {
+		if err := db.CreateRepository(repo); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := fake.UpdateRepoAccess(ctx, studentRepo, "student", scm.RepoPush); err != nil {
+		t.Fatal(err)
+	}
+	if err := fake.AddTeamRepo(ctx, &scm.AddTeamRepoOptions{TeamID: team.ID, OrganizationID: 1, Owner: org.Path, Repo: groupRepo.Path, Permission: scm.RepoPush}); err != nil {
+		t.Fatal(err)
+	}
+	access := func() (string, string) {
+		return fake.Collaborators[studentRepo.Path]["student"], fake.TeamRepos[team.ID][groupRepo.Path]
+	}
+
+	request := &pb.RepositoryAccessRequest{CourseID: course.ID, ReadOnly: true}
+	if _, err := client.SetCourseRepositoryAccess(withUserContext(ctx, student), request); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("SetCourseRepositoryAccess() by student = %v, want code %v", err, codes.PermissionDenied)
+	}
+
+	teacherCtx := withUserContext(ctx, teacher)
+	report, err := ags.SetCourseRepositoryAccess(teacherCtx, request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if studentAccess, groupAccess := access(); studentAccess != scm.RepoPull || groupAccess != scm.RepoPull {
+		t.Errorf("access after freezing = (%s, %s), want (%s, %s)", studentAccess, groupAccess, scm.RepoPull, scm.RepoPull)
+	}
+	results := make(map[string]*pb.RepositoryAccessResult)
+	for _, result := range report.GetResults() {
+		results[result.GetRepository()] = result
+	}
+	if len(results) != 3 {
+		t.Fatalf("SetCourseRepositoryAccess() reported %d repositories, want 3", len(results))
+	}
+	for _, repo := range []string{"student-labs", "group1"} {
+		if result := results[repo]; !result.GetUpdated() || result.GetError() != "" || result.GetPermission() != scm.RepoPull {
+			t.Errorf("SetCourseRepositoryAccess() result for %s = %v, want updated to %s", repo, result, scm.RepoPull)
+		}
+	}
+	if result := results["group2"]; result.GetUpdated() || result.GetError() == "" {
+		t.Errorf("SetCourseRepositoryAccess() result for group2 = %v, want error", result)
+	}
+
+	// repositories that already have the permission are not updated again
+	report, err = ags.SetCourseRepositoryAccess(teacherCtx, request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, result := range report.GetResults() {
+		if result.GetUpdated() {
+			t.Errorf("SetCourseRepositoryAccess() updated %s again", result.GetRepository())
+		}
+	}
+
+	request.ReadOnly = false
+	if _, err = ags.SetCourseRepositoryAccess(teacherCtx, request); err != nil {
+		t.Fatal(err)
+	}
+	if studentAccess, groupAccess := access(); studentAccess != scm.RepoPush || groupAccess != scm.RepoPush {
+		t.Errorf("access after unfreezing = (%s, %s), want (%s, %s)", studentAccess, groupAccess, scm.RepoPush, scm.RepoPush)
+	}
+}
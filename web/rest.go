@@ -0,0 +1,242 @@
+package web
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/labstack/echo/v4"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+const (
+	// restPrefix is the path prefix of the REST API; the method name follows the prefix.
+	restPrefix = "/api/v1/"
+	// openAPIPath is the path of the OpenAPI specification of the REST API.
+	openAPIPath = restPrefix + "openapi.json"
+	// maxRESTRequestSize is the maximum size of the JSON body of a REST request.
+	maxRESTRequestSize = 4 << 20
+)
+
+// restHandler translates JSON requests to the REST API to gRPC requests served by the
+// gRPC server, and the gRPC responses back to JSON. Each unary RPC of the autograder
+// service is served by POST requests to /api/v1/<method>, with the JSON encoding of the
+// request message as body. The requests pass through the same interceptors as gRPC
+// requests, and are authenticated by session cookies or access tokens.
+type restHandler struct {
+	server  *grpc.Server
+	service protoreflect.ServiceDescriptor
+}
+
+// NewRESTHandler returns a handler serving the REST API of the autograder service using the given gRPC server.
+func NewRESTHandler(server *grpc.Server) http.Handler {
+	return &restHandler{
+		server:  server,
+		service: pb.File_ag_ag_proto.Services().ByName("AutograderService"),
+	}
+}
+
+func (h *restHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path == openAPIPath {
+		if r.Method != http.MethodGet {
+			writeRESTError(w, http.StatusMethodNotAllowed, status.New(codes.Unimplemented, "method not allowed"))
+			return
+		}
+		w.Header().Set(echo.HeaderContentType, echo.MIMEApplicationJSONCharsetUTF8)
+		json.NewEncoder(w).Encode(openAPISpec(h.service))
+		return
+	}
+	method := h.service.Methods().ByName(protoreflect.Name(strings.TrimPrefix(r.URL.Path, restPrefix)))
+	if method == nil || method.IsStreamingClient() || method.IsStreamingServer() {
+		writeRESTError(w, http.StatusNotFound, status.New(codes.NotFound, "no such method"))
+		return
+	}
+	if r.Method != http.MethodPost {
+		writeRESTError(w, http.StatusMethodNotAllowed, status.New(codes.Unimplemented, "method not allowed"))
+		return
+	}
+	// requiring JSON prevents cross-site form posts with the session cookies of browsers
+	if !strings.HasPrefix(r.Header.Get(echo.HeaderContentType), echo.MIMEApplicationJSON) {
+		writeRESTError(w, http.StatusUnsupportedMediaType, status.New(codes.InvalidArgument, "content type must be "+echo.MIMEApplicationJSON))
+		return
+	}
+	in, err := newMessage(method.Input())
+	if err != nil {
+		writeRESTError(w, http.StatusInternalServerError, status.New(codes.Internal, "unknown request type"))
+		return
+	}
+	body, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, maxRESTRequestSize))
+	if err != nil {
+		writeRESTError(w, http.StatusRequestEntityTooLarge, status.New(codes.InvalidArgument, "request too large"))
+		return
+	}
+	if len(bytes.TrimSpace(body)) > 0 {
+		if err := protojson.Unmarshal(body, in); err != nil {
+			writeRESTError(w, http.StatusBadRequest, status.Newf(codes.InvalidArgument, "malformed request: %v", err))
+			return
+		}
+	}
+	payload, err := proto.Marshal(in)
+	if err != nil {
+		writeRESTError(w, http.StatusBadRequest, status.New(codes.InvalidArgument, "malformed request"))
+		return
+	}
+	frame := make([]byte, 5, 5+len(payload))
+	binary.BigEndian.PutUint32(frame[1:], uint32(len(payload)))
+	frame = append(frame, payload...)
+
+	req := r.Clone(r.Context())
+	req.URL.Path = "/" + string(h.service.FullName()) + "/" + string(method.Name())
+	req.ProtoMajor, req.ProtoMinor, req.Proto = 2, 0, "HTTP/2.0"
+	req.Header.Set(echo.HeaderContentType, grpcContentType+"+proto")
+	req.Header.Set("Te", "trailers")
+	req.Header.Del(echo.HeaderContentLength)
+	req.ContentLength = -1
+	req.Body = ioutil.NopCloser(bytes.NewReader(frame))
+
+	resp := &restResponse{header: make(http.Header)}
+	h.server.ServeHTTP(resp, req)
+	if st := resp.status(); st.Code() != codes.OK {
+		writeRESTError(w, httpStatus(st.Code()), st)
+		return
+	}
+	out, err := newMessage(method.Output())
+	if err != nil {
+		writeRESTError(w, http.StatusInternalServerError, status.New(codes.Internal, "unknown response type"))
+		return
+	}
+	if err := proto.Unmarshal(resp.message(), out); err != nil {
+		writeRESTError(w, http.StatusInternalServerError, status.New(codes.Internal, "malformed response"))
+		return
+	}
+	writeRESTMessage(w, http.StatusOK, out)
+}
+
+// newMessage returns a new message of the given type.
+func newMessage(desc protoreflect.MessageDescriptor) (proto.Message, error) {
+	messageType, err := protoregistry.GlobalTypes.FindMessageByName(desc.FullName())
+	if err != nil {
+		return nil, err
+	}
+	return messageType.New().Interface(), nil
+}
+
+// writeRESTMessage writes the JSON encoding of the given message with the given HTTP status code.
+func writeRESTMessage(w http.ResponseWriter, code int, message proto.Message) {
+	b, err := protojson.Marshal(message)
+	if err != nil {
+		code, b = http.StatusInternalServerError, []byte(`{"code":13,"message":"malformed response"}`)
+	}
+	w.Header().Set(echo.HeaderContentType, echo.MIMEApplicationJSONCharsetUTF8)
+	w.WriteHeader(code)
+	w.Write(b)
+}
+
+// writeRESTError writes the given gRPC status as a JSON error with the given HTTP status code.
+func writeRESTError(w http.ResponseWriter, code int, st *status.Status) {
+	writeRESTMessage(w, code, st.Proto())
+}
+
+// httpStatus returns the HTTP status code corresponding to the given gRPC status code.
+func httpStatus(code codes.Code) int {
+	switch code {
+	case codes.OK:
+		return http.StatusOK
+	case codes.Canceled:
+		return 499
+	case codes.InvalidArgument, codes.OutOfRange:
+		return http.StatusBadRequest
+	case codes.DeadlineExceeded:
+		return http.StatusGatewayTimeout
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists, codes.Aborted:
+		return http.StatusConflict
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests
+	case codes.FailedPrecondition:
+		return http.StatusPreconditionFailed
+	case codes.Unimplemented:
+		return http.StatusNotImplemented
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	}
+	return http.StatusInternalServerError
+}
+
+// restResponse is a response writer for the gRPC server that buffers the gRPC response,
+// such that the response message and the status in the trailers can be translated to JSON.
+type restResponse struct {
+	header http.Header
+	body   bytes.Buffer
+}
+
+func (r *restResponse) Header() http.Header {
+	return r.header
+}
+
+func (r *restResponse) WriteHeader(int) {}
+
+func (r *restResponse) Write(b []byte) (int, error) {
+	return r.body.Write(b)
+}
+
+// Flush is required by the gRPC server; the response is written when the RPC completes.
+func (r *restResponse) Flush() {}
+
+// status returns the gRPC status of the response, given in its headers or trailers.
+func (r *restResponse) status() *status.Status {
+	value := r.header.Get("Grpc-Status")
+	if value == "" {
+		value = r.header.Get("Trailer:Grpc-Status")
+	}
+	code, err := strconv.Atoi(value)
+	if err != nil {
+		return status.New(codes.Internal, "missing status in response")
+	}
+	message := r.header.Get("Grpc-Message")
+	if message == "" {
+		message = r.header.Get("Trailer:Grpc-Message")
+	}
+	if unescaped, err := url.PathUnescape(message); err == nil {
+		message = unescaped
+	}
+	return status.New(codes.Code(code), message)
+}
+
+// message returns the response message of the first frame of the response body.
+func (r *restResponse) message() []byte {
+	body := r.body.Bytes()
+	if len(body) < 5 {
+		return nil
+	}
+	length := binary.BigEndian.Uint32(body[1:5])
+	if uint32(len(body)-5) < length {
+		return nil
+	}
+	return body[5 : 5+length]
+}
+
+// registerREST serves the REST API for the autograder service.
+func registerREST(ags *AutograderService, e *echo.Echo) {
+	if ags.grpcServer == nil {
+		return
+	}
+	e.Any(restPrefix+"*", echo.WrapHandler(NewRESTHandler(ags.grpcServer)))
+}
@@ -0,0 +1,124 @@
+package web_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/ci"
+	"github.com/autograde/quickfeed/internal/qtest"
+	"github.com/autograde/quickfeed/web"
+)
+
+func TestREST(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	admin := qtest.CreateFakeUser(t, db, 1)
+	course := &pb.Course{Code: "DAT101", Name: "Operating Systems", OrganizationID: 1}
+	qtest.CreateCourse(t, db, admin, course)
+	_, scms := qtest.FakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	grpcServer := grpc.NewServer()
+	pb.RegisterAutograderServiceServer(grpcServer, ags)
+	handler := web.NewRESTHandler(grpcServer)
+
+	tests := []struct {
+		name        string
+		method      string
+		path        string
+		contentType string
+		body        string
+		userID      uint64
+		wantCode    int
+		wantBody    string
+	}{
+		{name: "empty request", method: http.MethodPost, path: "/api/v1/GetUser", contentType: "application/json", userID: admin.GetID(), wantCode: http.StatusOK, wantBody: `"isAdmin":true`},
+		{name: "request", method: http.MethodPost, path: "/api/v1/GetCourse", contentType: "application/json", body: `{"courseID": "1"}`, userID: admin.GetID(), wantCode: http.StatusOK, wantBody: `"code":"DAT101"`},
+		{name: "unknown user", method: http.MethodPost, path: "/api/v1/GetUser", contentType: "application/json", userID: 99, wantCode: http.StatusForbidden, wantBody: `"code":7`},
+		{name: "malformed request", method: http.MethodPost, path: "/api/v1/GetCourse", contentType: "application/json", body: `{"courseID": true}`, userID: admin.GetID(), wantCode: http.StatusBadRequest, wantBody: `"code":3`},
+		{name: "form post", method: http.MethodPost, path: "/api/v1/GetUser", contentType: "text/plain", body: `{}`, userID: admin.GetID(), wantCode: http.StatusUnsupportedMediaType},
+		{name: "unknown method", method: http.MethodPost, path: "/api/v1/DeleteEverything", contentType: "application/json", userID: admin.GetID(), wantCode: http.StatusNotFound},
+		{name: "streaming method", method: http.MethodPost, path: "/api/v1/SubmissionStream", contentType: "application/json", userID: admin.GetID(), wantCode: http.StatusNotFound},
+		{name: "get", method: http.MethodGet, path: "/api/v1/GetUser", userID: admin.GetID(), wantCode: http.StatusMethodNotAllowed},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			r := httptest.NewRequest(test.method, test.path, strings.NewReader(test.body))
+			if test.contentType != "" {
+				r.Header.Set("Content-Type", test.contentType)
+			}
+			r.Header.Set("user", strconv.FormatUint(test.userID, 10))
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, r)
+
+			if w.Code != test.wantCode {
+				t.Fatalf("ServeHTTP() status = %d (%s), want %d", w.Code, w.Body.String(), test.wantCode)
+			}
+			if got := strings.Join(strings.Fields(w.Body.String()), ""); !strings.Contains(got, test.wantBody) {
+				t.Errorf("ServeHTTP() body = %s, want %s", got, test.wantBody)
+			}
+		})
+	}
+
+	r := httptest.NewRequest(http.MethodPost, "/api/v1/GetUser", nil)
+	r.Header.Set("Content-Type", "application/json")
+	r.Header.Set("user", strconv.FormatUint(admin.GetID(), 10))
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, r)
+	got := &pb.User{}
+	if err := protojson.Unmarshal(w.Body.Bytes(), got); err != nil {
+		t.Fatal(err)
+	}
+	if got.GetID() != admin.GetID() {
+		t.Errorf("GetUser() = %v, want user %d", got, admin.GetID())
+	}
+}
+
+func TestOpenAPISpec(t *testing.T) {
+	handler := web.NewRESTHandler(grpc.NewServer())
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v1/openapi.json", nil))
+	if w.Code != http.StatusOK {
+		t.Fatalf("ServeHTTP() status = %d, want %d", w.Code, http.StatusOK)
+	}
+	var spec struct {
+		OpenAPI    string                     `json:"openapi"`
+		Paths      map[string]json.RawMessage `json:"paths"`
+		Components struct {
+			Schemas map[string]struct {
+				Properties map[string]struct {
+					Type   string `json:"type"`
+					Format string `json:"format"`
+				} `json:"properties"`
+			} `json:"schemas"`
+		} `json:"components"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &spec); err != nil {
+		t.Fatal(err)
+	}
+	if spec.OpenAPI == "" {
+		t.Error("openapi version missing")
+	}
+	if _, ok := spec.Paths["/api/v1/GetSubmissions"]; !ok {
+		t.Error("path /api/v1/GetSubmissions missing")
+	}
+	if _, ok := spec.Paths["/api/v1/SubmissionStream"]; ok {
+		t.Error("streaming method /api/v1/SubmissionStream included")
+	}
+	id := spec.Components.Schemas["ag.User"].Properties["ID"]
+	if id.Type != "string" || id.Format != "uint64" {
+		t.Errorf("ag.User.ID schema = %+v, want uint64 string", id)
+	}
+	if _, ok := spec.Components.Schemas["score.Score"]; !ok {
+		t.Error("schema score.Score referred to by submissions missing")
+	}
+}
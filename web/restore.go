@@ -0,0 +1,47 @@
+package web
+
+import (
+	"context"
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+)
+
+// restoreGroup restores the deleted group of the given request. The group is restored as pending,
+// without its repository and team; approving the group again creates a new repository and team.
+func (s *AutograderService) restoreGroup(usr *pb.User, request *pb.GroupRequest) (*pb.Group, error) {
+	group, err := s.db.RestoreGroup(request.GetGroupID())
+	if err != nil {
+		return nil, err
+	}
+	s.audit(usr, group.GetCourseID(), "restore group", "group", group.GetName(), "groupID", group.GetID())
+	return group, nil
+}
+
+// restoreEnrollment restores the rejected enrollment of the given user and course. The enrollment is
+// restored as pending; accepting the enrollment again creates a new repository for the user.
+func (s *AutograderService) restoreEnrollment(usr *pb.User, request *pb.Enrollment) (*pb.Enrollment, error) {
+	enrollment, err := s.db.RestoreEnrollment(request.GetCourseID(), request.GetUserID())
+	if err != nil {
+		return nil, err
+	}
+	s.audit(usr, enrollment.GetCourseID(), "restore enrollment", "user", enrollment.GetUser().GetLogin())
+	return enrollment, nil
+}
+
+// RunRetentionPolicy permanently deletes the users, groups and enrollments deleted longer
+// than the retention period ago, once a day until the context is canceled.
+func (s *AutograderService) RunRetentionPolicy(ctx context.Context, retention time.Duration) {
+	ticker := time.NewTicker(24 * time.Hour)
+	defer ticker.Stop()
+	for {
+		if err := s.db.PurgeDeleted(time.Now().Add(-retention)); err != nil {
+			s.logger.Errorf("Failed to purge deleted records: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
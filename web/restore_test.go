@@ -0,0 +1,82 @@
+package web_test
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/ci"
+	"github.com/autograde/quickfeed/internal/qtest"
+	"github.com/autograde/quickfeed/web"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestRestoreGroupAndEnrollment(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	admin := qtest.CreateFakeUser(t, db, 1)
+	teacher := qtest.CreateFakeUser(t, db, 2)
+	course := &pb.Course{OrganizationID: 1}
+	qtest.CreateCourse(t, db, admin, course)
+	qtest.EnrollStudent(t, db, teacher, course)
+	if err := db.UpdateEnrollment(&pb.Enrollment{UserID: teacher.GetID(), CourseID: course.GetID(), Status: pb.Enrollment_TEACHER}); err != nil {
+		t.Fatal(err)
+	}
+	student1 := qtest.CreateFakeUser(t, db, 3)
+	qtest.EnrollStudent(t, db, student1, course)
+	student2 := qtest.CreateFakeUser(t, db, 4)
+	qtest.EnrollStudent(t, db, student2, course)
+	group := &pb.Group{Name: "team", CourseID: course.GetID(), Users: []*pb.User{student1, student2}}
+	if err := db.CreateGroup(group); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.DeleteGroup(group.GetID()); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.RejectEnrollment(student1.GetID(), course.GetID()); err != nil {
+		t.Fatal(err)
+	}
+
+	_, scms := qtest.FakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	client := accessControlled(t, ags)
+	adminCtx := withUserContext(context.Background(), admin)
+	teacherCtx := withUserContext(context.Background(), teacher)
+	groupRequest := &pb.GroupRequest{CourseID: course.GetID(), GroupID: group.GetID()}
+	enrollmentRequest := &pb.Enrollment{CourseID: course.GetID(), UserID: student1.GetID()}
+
+	if _, err := client.RestoreGroup(teacherCtx, groupRequest); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("RestoreGroup() by teacher: got %v, want %v", err, codes.PermissionDenied)
+	}
+	if _, err := client.RestoreEnrollment(teacherCtx, enrollmentRequest); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("RestoreEnrollment() by teacher: got %v, want %v", err, codes.PermissionDenied)
+	}
+
+	// the group cannot be restored while one of its members is not enrolled
+	if _, err := ags.RestoreGroup(adminCtx, groupRequest); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("RestoreGroup() with rejected member: got %v, want %v", err, codes.InvalidArgument)
+	}
+	enrollment, err := ags.RestoreEnrollment(adminCtx, enrollmentRequest)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if enrollment.GetStatus() != pb.Enrollment_PENDING {
+		t.Errorf("RestoreEnrollment() = %v, want pending enrollment", enrollment)
+	}
+	if err := db.UpdateEnrollment(&pb.Enrollment{UserID: student1.GetID(), CourseID: course.GetID(), Status: pb.Enrollment_STUDENT}); err != nil {
+		t.Fatal(err)
+	}
+	restored, err := ags.RestoreGroup(adminCtx, groupRequest)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if restored.GetName() != "team" || len(restored.GetUsers()) != 2 {
+		t.Errorf("RestoreGroup() = %v, want group team with 2 members", restored)
+	}
+	if _, err := ags.RestoreGroup(adminCtx, groupRequest); status.Code(err) != codes.NotFound {
+		t.Errorf("RestoreGroup() of restored group: got %v, want %v", err, codes.NotFound)
+	}
+}
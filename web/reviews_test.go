@@ -0,0 +1,145 @@
+package web_test
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/ci"
+	"github.com/autograde/quickfeed/internal/qtest"
+	"github.com/autograde/quickfeed/kit/score"
+	"github.com/autograde/quickfeed/web"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestReleaseReviews(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	teacher := qtest.CreateFakeUser(t, db, 1)
+	course := &pb.Course{Code: "DAT101", OrganizationID: 1}
+	qtest.CreateCourse(t, db, teacher, course)
+	student := qtest.CreateFakeUser(t, db, 2)
+	qtest.EnrollStudent(t, db, student, course)
+
+	lab := &pb.Assignment{CourseID: course.ID, Name: "lab1", Order: 1, Reviewers: 1}
+	if err := db.CreateAssignment(lab); err != nil {
+		t.Fatal(err)
+	}
+	submission := &pb.Submission{AssignmentID: lab.ID, UserID: student.ID, Score: 80}
+	if err := db.CreateSubmission(submission); err != nil {
+		t.Fatal(err)
+	}
+
+	_, scms := qtest.FakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	client := accessControlled(t, ags)
+	teacherCtx := withUserContext(context.Background(), teacher)
+	studentCtx := withUserContext(context.Background(), student)
+
+	// a review cannot be released by its author when created
+	if _, err := ags.CreateReview(teacherCtx, &pb.ReviewRequest{
+		CourseID: course.ID,
+		Review:   &pb.Review{SubmissionID: submission.ID, ReviewerID: teacher.ID, Ready: true, Released: true, Feedback: "Well done"},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	reviews := func(ctx context.Context) []*pb.Review {
+		t.Helper()
+		submissions, err := ags.GetSubmissions(ctx, &pb.SubmissionRequest{CourseID: course.ID, UserID: student.ID})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(submissions.GetSubmissions()) != 1 {
+			t.Fatalf("GetSubmissions() = %v, want one submission", submissions.GetSubmissions())
+		}
+		return submissions.GetSubmissions()[0].GetReviews()
+	}
+	if got := reviews(studentCtx); len(got) != 0 {
+		t.Errorf("student's reviews before release = %v, want none", got)
+	}
+	if got := reviews(teacherCtx); len(got) != 1 {
+		t.Errorf("teacher's reviews before release = %v, want one review", got)
+	}
+
+	request := &pb.AssignmentRequest{CourseID: course.ID, AssignmentID: lab.ID}
+	if _, err := client.ReleaseReviews(studentCtx, request); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("ReleaseReviews() by student: got %v, want %v", err, codes.PermissionDenied)
+	}
+	if _, err := ags.ReleaseReviews(teacherCtx, request); err != nil {
+		t.Fatal(err)
+	}
+	if got := reviews(studentCtx); len(got) != 1 || got[0].GetFeedback() != "Well done" {
+		t.Errorf("student's reviews after release = %v, want the released review", got)
+	}
+}
+
+func TestUnreleasedReviewsHidden(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	teacher := qtest.CreateFakeUser(t, db, 1)
+	course := &pb.Course{Code: "DAT101", OrganizationID: 1}
+	qtest.CreateCourse(t, db, teacher, course)
+	student := qtest.CreateFakeUser(t, db, 2)
+	qtest.EnrollStudent(t, db, student, course)
+
+	lab := &pb.Assignment{CourseID: course.ID, Name: "lab1", Order: 1, Reviewers: 1}
+	if err := db.CreateAssignment(lab); err != nil {
+		t.Fatal(err)
+	}
+	// the submission's score of 90 is the review score, while its tests score 40
+	submission := &pb.Submission{
+		AssignmentID: lab.ID,
+		UserID:       student.ID,
+		Score:        90,
+		ReviewScore:  90,
+		Scores:       []*score.Score{{TestName: "TestLab1", Score: 4, MaxScore: 10, Weight: 1}},
+	}
+	if err := db.CreateSubmission(submission); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.CreateReview(&pb.Review{SubmissionID: submission.ID, ReviewerID: teacher.ID, Ready: true, Score: 90, Feedback: "Well done"}); err != nil {
+		t.Fatal(err)
+	}
+
+	_, scms := qtest.FakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	teacherCtx := withUserContext(context.Background(), teacher)
+	studentCtx := withUserContext(context.Background(), student)
+
+	check := func(released bool, wantReviews int, wantScore, wantReviewScore uint32) {
+		t.Helper()
+		submissions, err := ags.GetSubmissions(studentCtx, &pb.SubmissionRequest{CourseID: course.ID, UserID: student.ID})
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, err := ags.ExportUserData(studentCtx, &pb.UserRequest{UserID: student.ID})
+		if err != nil {
+			t.Fatal(err)
+		}
+		for name, got := range map[string][]*pb.Submission{"GetSubmissions": submissions.GetSubmissions(), "ExportUserData": data.GetSubmissions()} {
+			if len(got) != 1 {
+				t.Fatalf("%s() = %v, want one submission", name, got)
+			}
+			if len(got[0].GetReviews()) != wantReviews || got[0].GetScore() != wantScore || got[0].GetReviewScore() != wantReviewScore {
+				t.Errorf("%s() with released=%t = %d reviews, score %d and review score %d, want %d reviews, score %d and review score %d",
+					name, released, len(got[0].GetReviews()), got[0].GetScore(), got[0].GetReviewScore(), wantReviews, wantScore, wantReviewScore)
+			}
+		}
+		progress, err := ags.GetUserProgress(studentCtx, &pb.Void{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(progress.GetCourses()) != 1 || progress.GetCourses()[0].GetTotalScore() != wantScore {
+			t.Errorf("GetUserProgress() with released=%t = %v, want total score %d", released, progress.GetCourses(), wantScore)
+		}
+	}
+	check(false, 0, 40, 0)
+	if _, err := ags.ReleaseReviews(teacherCtx, &pb.AssignmentRequest{CourseID: course.ID, AssignmentID: lab.ID}); err != nil {
+		t.Fatal(err)
+	}
+	check(true, 1, 90, 90)
+}
@@ -46,6 +46,7 @@ func createRepoAndTeam(ctx context.Context, sc scm.SCM, course *pb.Course, group
 		Organization: org,
 		Path:         group.GetName(),
 		Private:      true,
+		Template:     repoTemplate(course, org),
 	})
 	if err != nil {
 		return nil, nil, fmt.Errorf("createRepoAndTeam: failed to create repo: %w", err)
@@ -93,14 +94,38 @@ func deleteGroupRepoAndTeam(ctx context.Context, sc scm.SCM, repositoryID uint64
 	return nil
 }
 
-// creates {username}-labs repository and provides pull/push access to it for the given student
-func createStudentRepo(ctx context.Context, sc scm.SCM, org *pb.Organization, path string, student string) (*scm.Repository, error) {
+// archiveGroupRepoAndTeam archives the group's repository and deletes the group's team,
+// revoking the group members' access to the repository.
+func archiveGroupRepoAndTeam(ctx context.Context, sc scm.SCM, repositoryID uint64, teamID, orgID uint64) error {
+	if err := sc.ArchiveRepository(ctx, &scm.RepositoryOptions{ID: repositoryID}); err != nil {
+		return fmt.Errorf("archiveGroupRepoAndTeam: failed to archive repository: %w", err)
+	}
+
+	if err := sc.DeleteTeam(ctx, &scm.TeamOptions{TeamID: teamID, OrganizationID: orgID}); err != nil {
+		return fmt.Errorf("archiveGroupRepoAndTeam: failed to delete team: %w", err)
+	}
+	return nil
+}
+
+// repoTemplate returns the course's assignments repository if the course's student and group
+// repositories are created from it, such that they contain the starter code; otherwise nil.
+func repoTemplate(course *pb.Course, org *pb.Organization) *scm.Repository {
+	if !course.GetAssignmentsTemplate() {
+		return nil
+	}
+	return &scm.Repository{Owner: org.GetPath(), Path: course.AssignmentsRepoName()}
+}
+
+// creates {username}-labs repository and provides pull/push access to it for the given student.
+// If template is not nil, the repository is created with the contents of the template repository.
+func createStudentRepo(ctx context.Context, sc scm.SCM, org *pb.Organization, path string, student string, template *scm.Repository) (*scm.Repository, error) {
 	// create repo, or return existing repo if it already exists
 	// if repo is found, it is safe to reuse it
 	repo, err := sc.CreateRepository(ctx, &scm.CreateRepositoryOptions{
 		Organization: org,
 		Path:         path,
 		Private:      true,
+		Template:     template,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("createStudentRepo: failed to create repo: %w", err)
@@ -127,8 +152,8 @@ func addUserToStudentsTeam(ctx context.Context, sc scm.SCM, organizationPath str
 	return nil
 }
 
-// add user to the organization's "teachers" team, and remove user from "students" team.
-func promoteUserToTeachersTeam(ctx context.Context, sc scm.SCM, organizationPath string, userName string) error {
+// add user to the organization's "teachers" team with the given role, and remove user from "students" team.
+func promoteUserToTeachersTeam(ctx context.Context, sc scm.SCM, organizationPath string, userName string, role string) error {
 	studentsTeam := &scm.TeamMembershipOptions{
 		Organization: organizationPath,
 		Username:     userName,
@@ -142,7 +167,7 @@ func promoteUserToTeachersTeam(ctx context.Context, sc scm.SCM, organizationPath
 		Organization: organizationPath,
 		Username:     userName,
 		TeamName:     scm.TeachersTeam,
-		Role:         scm.TeamMaintainer,
+		Role:         role,
 	}
 	if err := sc.AddTeamMember(ctx, teachersTeam); err != nil {
 		return err
@@ -159,7 +184,7 @@ func updateReposAndTeams(ctx context.Context, sc scm.SCM, course *pb.Course, log
 	switch state {
 	case pb.Enrollment_STUDENT:
 		// give access to the course's info and assignments repositories
-		if err := grantAccessToCourseRepos(ctx, sc, org.GetPath(), login); err != nil {
+		if err := grantAccessToCourseRepos(ctx, sc, org.GetPath(), course, login); err != nil {
 			return nil, err
 		}
 
@@ -168,7 +193,7 @@ func updateReposAndTeams(ctx context.Context, sc scm.SCM, course *pb.Course, log
 			return nil, err
 		}
 
-		return createStudentRepo(ctx, sc, org, pb.StudentRepoName(login), login)
+		return createStudentRepo(ctx, sc, org, pb.StudentRepoName(login), login, repoTemplate(course, org))
 
 	case pb.Enrollment_TEACHER:
 		// if teacher, promote to owner, remove from students team, add to teachers team
@@ -181,15 +206,23 @@ func updateReposAndTeams(ctx context.Context, sc scm.SCM, course *pb.Course, log
 		if err = sc.UpdateOrgMembership(ctx, orgUpdate); err != nil {
 			return nil, fmt.Errorf("UpdateReposAndTeams: failed to update org membership for %s: %w", login, err)
 		}
-		err = promoteUserToTeachersTeam(ctx, sc, org.GetPath(), login)
+		err = promoteUserToTeachersTeam(ctx, sc, org.GetPath(), login, scm.TeamMaintainer)
+
+	case pb.Enrollment_TA:
+		// teaching assistants are added to the teachers team to access the course repositories,
+		// but remain organization members, and are thus not allowed to change the organization
+		err = promoteUserToTeachersTeam(ctx, sc, org.GetPath(), login, scm.TeamMember)
 	}
 	return nil, err
 }
 
-func grantAccessToCourseRepos(ctx context.Context, sc scm.SCM, org, login string) error {
-	commonRepos := []string{pb.InfoRepo, pb.AssignmentRepo}
+// courseRepos returns the course repositories that students have read access to.
+func courseRepos(course *pb.Course) []string {
+	return []string{course.InfoRepoName(), course.AssignmentsRepoName()}
+}
 
-	for _, repoType := range commonRepos {
+func grantAccessToCourseRepos(ctx context.Context, sc scm.SCM, org string, course *pb.Course, login string) error {
+	for _, repoType := range courseRepos(course) {
 		if err := sc.UpdateRepoAccess(ctx, &scm.Repository{Owner: org, Path: repoType}, login, scm.RepoPull); err != nil {
 			return fmt.Errorf("updateReposAndTeams: failed to update repo access to repo %s for user %s: %w ", repoType, login, err)
 		}
@@ -197,6 +230,24 @@ func grantAccessToCourseRepos(ctx context.Context, sc scm.SCM, org, login string
 	return nil
 }
 
+// grantStudentsTeamAccess gives the organization's "students" team read access
+// to the course's info and assignments repositories.
+func grantStudentsTeamAccess(ctx context.Context, sc scm.SCM, org *pb.Organization, course *pb.Course, teamID uint64) error {
+	for _, repoType := range courseRepos(course) {
+		opt := &scm.AddTeamRepoOptions{
+			TeamID:         teamID,
+			OrganizationID: org.GetID(),
+			Owner:          org.GetPath(),
+			Repo:           repoType,
+			Permission:     scm.RepoPull,
+		}
+		if err := sc.AddTeamRepo(ctx, opt); err != nil {
+			return fmt.Errorf("grantStudentsTeamAccess: failed to grant access to repo %s: %w", repoType, err)
+		}
+	}
+	return nil
+}
+
 func updateGroupTeam(ctx context.Context, sc scm.SCM, group *pb.Group, orgID uint64) error {
 	opt := &scm.UpdateTeamOptions{
 		TeamID:         group.TeamID,
@@ -226,6 +277,23 @@ func removeUserFromCourse(ctx context.Context, sc scm.SCM, login string, repo *p
 }
 
 // remove user from teachers team, set organization status from owner to regular member
+// promoteTeachingAssistant makes the teaching assistant an organization owner and a maintainer of the "teachers" team.
+func promoteTeachingAssistant(ctx context.Context, sc scm.SCM, org, userName string) error {
+	if err := sc.UpdateOrgMembership(ctx, &scm.OrgMembershipOptions{
+		Organization: org,
+		Username:     userName,
+		Role:         scm.OrgOwner,
+	}); err != nil {
+		return err
+	}
+	return sc.AddTeamMember(ctx, &scm.TeamMembershipOptions{
+		Organization: org,
+		Username:     userName,
+		TeamName:     scm.TeachersTeam,
+		Role:         scm.TeamMaintainer,
+	})
+}
+
 func revokeTeacherStatus(ctx context.Context, sc scm.SCM, org, userName string) error {
 	teamOpts := &scm.TeamMembershipOptions{
 		Organization: org,
@@ -0,0 +1,52 @@
+package web
+
+import (
+	"errors"
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"gorm.io/gorm"
+)
+
+// getScoreCurve returns the score curve of the given assignment, or a curve without
+// a method if no curve has been applied to the assignment.
+func (s *AutograderService) getScoreCurve(request *pb.AssignmentRequest) (*pb.ScoreCurve, error) {
+	assignment, err := s.getAssignmentInCourse(request.GetAssignmentID(), request.GetCourseID())
+	if err != nil {
+		return nil, err
+	}
+	curve, err := s.db.GetScoreCurve(assignment.GetID())
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return &pb.ScoreCurve{CourseID: request.GetCourseID(), AssignmentID: assignment.GetID()}, nil
+	}
+	return curve, err
+}
+
+// applyScoreCurve adjusts the scores of all submissions to the curve's assignment according to the curve,
+// replacing any previous curve of the assignment. The submissions' raw scores are not changed.
+// A curve without a method removes the assignment's curve.
+func (s *AutograderService) applyScoreCurve(usr *pb.User, curve *pb.ScoreCurve) (*pb.ScoreCurve, error) {
+	assignment, err := s.getAssignmentInCourse(curve.GetAssignmentID(), curve.GetCourseID())
+	if err != nil {
+		return nil, err
+	}
+	submissions, err := s.db.GetSubmissions(&pb.Submission{AssignmentID: assignment.GetID()})
+	if err != nil {
+		return nil, err
+	}
+	scores := make([]uint32, len(submissions))
+	for i, submission := range submissions {
+		scores[i] = submission.GetScore()
+	}
+	adjustedScores := make(map[uint64]uint32)
+	for i, score := range curve.AdjustedScores(scores) {
+		adjustedScores[submissions[i].GetID()] = score
+	}
+	curve.Applied = time.Now().Format(pb.TimeLayout)
+	if err := s.db.ApplyScoreCurve(curve, adjustedScores); err != nil {
+		return nil, err
+	}
+	s.audit(usr, curve.GetCourseID(), "apply score curve", "course", curve.GetCourseID(), "assignment", assignment.GetName(),
+		"method", curve.GetMethod().String(), "submissions", len(submissions))
+	return curve, nil
+}
@@ -0,0 +1,93 @@
+package web_test
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/ci"
+	"github.com/autograde/quickfeed/internal/qtest"
+	"github.com/autograde/quickfeed/web"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestApplyScoreCurve(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	teacher := qtest.CreateFakeUser(t, db, 1)
+	course := &pb.Course{Code: "DAT101", OrganizationID: 1}
+	qtest.CreateCourse(t, db, teacher, course)
+	student1 := qtest.CreateFakeUser(t, db, 2)
+	qtest.EnrollStudent(t, db, student1, course)
+	student2 := qtest.CreateFakeUser(t, db, 3)
+	qtest.EnrollStudent(t, db, student2, course)
+
+	lab := &pb.Assignment{CourseID: course.ID, Name: "lab1", Order: 1}
+	if err := db.CreateAssignment(lab); err != nil {
+		t.Fatal(err)
+	}
+	for _, submission := range []*pb.Submission{
+		{AssignmentID: lab.ID, UserID: student1.ID, Score: 40},
+		{AssignmentID: lab.ID, UserID: student2.ID, Score: 70},
+	} {
+		if err := db.CreateSubmission(submission); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	_, scms := qtest.FakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	client := accessControlled(t, ags)
+	teacherCtx := withUserContext(context.Background(), teacher)
+
+	curve := &pb.ScoreCurve{CourseID: course.ID, AssignmentID: lab.ID, Method: pb.ScoreCurve_LINEAR, Slope: 1, Offset: 20}
+	if _, err := client.ApplyScoreCurve(withUserContext(context.Background(), student1), curve); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("ApplyScoreCurve() by student: got %v, want %v", err, codes.PermissionDenied)
+	}
+	if _, err := ags.ApplyScoreCurve(teacherCtx, curve); err != nil {
+		t.Fatal(err)
+	}
+	got, err := ags.GetScoreCurve(teacherCtx, &pb.AssignmentRequest{CourseID: course.ID, AssignmentID: lab.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.GetMethod() != pb.ScoreCurve_LINEAR || got.GetOffset() != 20 || got.GetApplied() == "" {
+		t.Errorf("GetScoreCurve() = %v, want the applied linear curve", got)
+	}
+
+	finalScores := func() map[uint64]uint32 {
+		t.Helper()
+		finalGrades, err := ags.ComputeFinalGrades(teacherCtx, &pb.CourseRequest{CourseID: course.ID})
+		if err != nil {
+			t.Fatal(err)
+		}
+		scores := make(map[uint64]uint32)
+		for _, grade := range finalGrades.GetGrades() {
+			scores[grade.GetUserID()] = grade.GetScore()
+		}
+		return scores
+	}
+	if scores := finalScores(); scores[student1.ID] != 60 || scores[student2.ID] != 90 {
+		t.Errorf("final scores with linear curve = %v, want %d: 60, %d: 90", scores, student1.ID, student2.ID)
+	}
+
+	// the raw scores are kept, such that the curve can be replaced
+	curve.Method = pb.ScoreCurve_PERCENTILE
+	curve.MinScore, curve.MaxScore = 50, 90
+	if _, err := ags.ApplyScoreCurve(teacherCtx, curve); err != nil {
+		t.Fatal(err)
+	}
+	if scores := finalScores(); scores[student1.ID] != 60 || scores[student2.ID] != 80 {
+		t.Errorf("final scores with percentile curve = %v, want %d: 60, %d: 80", scores, student1.ID, student2.ID)
+	}
+
+	if _, err := ags.ApplyScoreCurve(teacherCtx, &pb.ScoreCurve{CourseID: course.ID, AssignmentID: lab.ID}); err != nil {
+		t.Fatal(err)
+	}
+	if scores := finalScores(); scores[student1.ID] != 40 || scores[student2.ID] != 70 {
+		t.Errorf("final scores without curve = %v, want %d: 40, %d: 70", scores, student1.ID, student2.ID)
+	}
+}
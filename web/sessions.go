@@ -0,0 +1,47 @@
+package web
+
+import (
+	pb "github.com/autograde/quickfeed/ag"
+)
+
+// getSessions returns the sessions of the given user, without their hashes.
+func (s *AutograderService) getSessions(usr *pb.User) (*pb.Sessions, error) {
+	sessions, err := s.db.GetSessions(usr.GetID())
+	if err != nil {
+		return nil, err
+	}
+	for _, session := range sessions {
+		session.Hash = ""
+	}
+	return &pb.Sessions{Sessions: sessions}, nil
+}
+
+// revokeSession deletes the given session of the given user; the user is signed out of that session.
+func (s *AutograderService) revokeSession(usr *pb.User, sessionID uint64) error {
+	if err := s.db.DeleteSession(usr.GetID(), sessionID); err != nil {
+		return err
+	}
+	s.audit(usr, 0, "revoke session", "sessionID", sessionID)
+	return nil
+}
+
+// logoutUser signs the given user out of all sessions and revokes the user's access tokens.
+func (s *AutograderService) logoutUser(usr *pb.User, userID uint64) error {
+	if _, err := s.db.GetUser(userID); err != nil {
+		return err
+	}
+	if err := s.db.DeleteSessions(userID); err != nil {
+		return err
+	}
+	tokens, err := s.db.GetAccessTokens(userID)
+	if err != nil {
+		return err
+	}
+	for _, token := range tokens {
+		if err := s.db.DeleteAccessToken(userID, token.GetID()); err != nil {
+			return err
+		}
+	}
+	s.audit(usr, 0, "logout user", "user", userID, "accessTokens", len(tokens))
+	return nil
+}
@@ -0,0 +1,122 @@
+package web_test
+
+import (
+	"context"
+	"log"
+	"net"
+	"testing"
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/ci"
+	"github.com/autograde/quickfeed/internal/qtest"
+	"github.com/autograde/quickfeed/web"
+	"github.com/autograde/quickfeed/web/auth"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+func TestSessions(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	admin := qtest.CreateFakeUser(t, db, 1)
+	user := qtest.CreateFakeUser(t, db, 2)
+
+	now := time.Now()
+	for _, session := range []*pb.Session{
+		{UserID: user.GetID(), Hash: auth.HashAccessToken("session=laptop"), UserAgent: "laptop", IP: "10.0.0.1", Created: now.Format(pb.TimeLayout), LastSeen: now.Format(pb.TimeLayout)},
+		{UserID: user.GetID(), Hash: auth.HashAccessToken("session=phone"), UserAgent: "phone", IP: "10.0.0.2", Created: now.Format(pb.TimeLayout), LastSeen: now.Format(pb.TimeLayout)},
+		{UserID: user.GetID(), Hash: auth.HashAccessToken("session=expired"), UserAgent: "old", IP: "10.0.0.3", Created: now.AddDate(0, -2, 0).Format(pb.TimeLayout), LastSeen: now.AddDate(0, -2, 0).Format(pb.TimeLayout)},
+	} {
+		if err := db.CreateSession(session); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := db.CreateAccessToken(&pb.AccessToken{UserID: user.GetID(), Name: "script", Hash: auth.HashAccessToken("qf_script")}); err != nil {
+		t.Fatal(err)
+	}
+
+	_, scms := qtest.FakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	lis := bufconn.Listen(1024 * 1024)
+	s := grpc.NewServer(grpc.ChainUnaryInterceptor(auth.UserVerifier(db)))
+	pb.RegisterAutograderServiceServer(s, ags)
+	go func() {
+		if err := s.Serve(lis); err != nil {
+			log.Fatalf("Server exited with error: %v", err)
+		}
+	}()
+	defer s.Stop()
+
+	conn, err := grpc.DialContext(context.Background(), "bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) { return lis.Dial() }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	client := pb.NewAutograderServiceClient(conn)
+	withCookie := func(cookie string) context.Context {
+		return metadata.NewOutgoingContext(context.Background(), metadata.Pairs(auth.Cookie, "theme=dark; "+cookie))
+	}
+
+	for _, tt := range []struct {
+		cookie string
+		want   codes.Code
+	}{
+		{cookie: "session=laptop", want: codes.OK},
+		{cookie: "session=phone", want: codes.OK},
+		{cookie: "session=expired", want: codes.Unauthenticated},
+		{cookie: "session=unknown", want: codes.Unauthenticated},
+	} {
+		if _, err := client.GetUser(withCookie(tt.cookie), &pb.Void{}); status.Code(err) != tt.want {
+			t.Errorf("GetUser() with %s: got %v, want %v", tt.cookie, err, tt.want)
+		}
+	}
+
+	userCtx := withUserContext(context.Background(), user)
+	sessions, err := ags.GetSessions(userCtx, &pb.Void{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the expired session was deleted when it was used
+	if len(sessions.GetSessions()) != 2 {
+		t.Fatalf("GetSessions() = %v, want 2 sessions", sessions.GetSessions())
+	}
+	phone := sessions.GetSessions()[1]
+	if phone.GetUserAgent() != "phone" || phone.GetIP() != "10.0.0.2" || phone.GetHash() != "" {
+		t.Errorf("GetSessions()[1] = %v, want phone session without hash", phone)
+	}
+
+	if _, err := ags.RevokeSession(withUserContext(context.Background(), admin), phone); status.Code(err) != codes.NotFound {
+		t.Errorf("RevokeSession() of other user's session: got %v, want %v", err, codes.NotFound)
+	}
+	if _, err := ags.RevokeSession(userCtx, phone); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.GetUser(withCookie("session=phone"), &pb.Void{}); status.Code(err) != codes.Unauthenticated {
+		t.Errorf("GetUser() with revoked session: got %v, want %v", err, codes.Unauthenticated)
+	}
+	if _, err := client.GetUser(withCookie("session=laptop"), &pb.Void{}); err != nil {
+		t.Errorf("GetUser() with remaining session: %v", err)
+	}
+
+	if _, err := accessControlled(t, ags).LogoutUser(userCtx, &pb.UserRequest{UserID: admin.GetID()}); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("LogoutUser() by non-admin: got %v, want %v", err, codes.PermissionDenied)
+	}
+	if _, err := ags.LogoutUser(withUserContext(context.Background(), admin), &pb.UserRequest{UserID: user.GetID()}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.GetUser(withCookie("session=laptop"), &pb.Void{}); status.Code(err) != codes.Unauthenticated {
+		t.Errorf("GetUser() after LogoutUser: got %v, want %v", err, codes.Unauthenticated)
+	}
+	if tokens, err := db.GetAccessTokens(user.GetID()); err != nil || len(tokens) != 0 {
+		t.Errorf("GetAccessTokens() after LogoutUser = %v, %v, want no tokens", tokens, err)
+	}
+}
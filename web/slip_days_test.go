@@ -0,0 +1,59 @@
+package web_test
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/ci"
+	"github.com/autograde/quickfeed/internal/qtest"
+	"github.com/autograde/quickfeed/web"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGetSlipDays(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	teacher := qtest.CreateFakeUser(t, db, 1)
+	course := &pb.Course{Code: "DAT101", OrganizationID: 1, SlipDays: 5}
+	qtest.CreateCourse(t, db, teacher, course)
+	student := qtest.CreateFakeUser(t, db, 2)
+	qtest.EnrollStudent(t, db, student, course)
+	other := qtest.CreateFakeUser(t, db, 3)
+	qtest.EnrollStudent(t, db, other, course)
+	enrollment, err := db.GetEnrollmentByCourseAndUser(course.GetID(), student.GetID())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.UpdateSlipDays([]*pb.UsedSlipDays{
+		{EnrollmentID: enrollment.GetID(), AssignmentID: 1, UsedSlipDays: 2},
+		{EnrollmentID: enrollment.GetID(), AssignmentID: 2, UsedSlipDays: 1},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	_, scms := qtest.FakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	client := accessControlled(t, ags)
+	request := &pb.SlipDaysRequest{CourseID: course.GetID(), UserID: student.GetID()}
+
+	for _, user := range []*pb.User{student, teacher} {
+		slipDays, err := ags.GetSlipDays(withUserContext(context.Background(), user), request)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if slipDays.GetBudget() != 5 || slipDays.GetUsed() != 3 || slipDays.GetRemaining() != 2 || len(slipDays.GetUsedSlipDays()) != 2 {
+			t.Errorf("GetSlipDays() by %s = %v, want 3 of 5 slip days used for 2 assignments", user.GetLogin(), slipDays)
+		}
+	}
+	if _, err := client.GetSlipDays(withUserContext(context.Background(), other), request); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("GetSlipDays() by other student = %v, want code %v", err, codes.PermissionDenied)
+	}
+	notEnrolled := &pb.SlipDaysRequest{CourseID: course.GetID(), UserID: 99}
+	if _, err := ags.GetSlipDays(withUserContext(context.Background(), teacher), notEnrolled); status.Code(err) != codes.NotFound {
+		t.Errorf("GetSlipDays() for user not enrolled = %v, want code %v", err, codes.NotFound)
+	}
+}
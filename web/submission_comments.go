@@ -0,0 +1,170 @@
+package web
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/scm"
+)
+
+// getSubmissionInCourse returns the submission with the given ID if it belongs to the given course.
+func (s *AutograderService) getSubmissionInCourse(submissionID, courseID uint64) (*pb.Submission, error) {
+	submission, err := s.db.GetSubmission(&pb.Submission{ID: submissionID})
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.getAssignmentInCourse(submission.GetAssignmentID(), courseID); err != nil {
+		return nil, err
+	}
+	return submission, nil
+}
+
+// submissionRepository returns the repository of the given submission's student or group.
+func (s *AutograderService) submissionRepository(course *pb.Course, submission *pb.Submission) (*pb.Repository, error) {
+	query := &pb.Repository{
+		OrganizationID: course.GetOrganizationID(),
+		UserID:         submission.GetUserID(),
+		RepoType:       pb.Repository_USER,
+	}
+	if submission.GetGroupID() > 0 {
+		query = &pb.Repository{
+			OrganizationID: course.GetOrganizationID(),
+			GroupID:        submission.GetGroupID(),
+			RepoType:       pb.Repository_GROUP,
+		}
+	}
+	repos, err := s.db.GetRepositories(query)
+	if err != nil {
+		return nil, err
+	}
+	if len(repos) < 1 {
+		return nil, fmt.Errorf("no repository found for submission %d", submission.GetID())
+	}
+	return repos[0], nil
+}
+
+// getSubmissionCommentInCourse returns the submission comment with the given ID if it belongs to the given course.
+func (s *AutograderService) getSubmissionCommentInCourse(commentID, courseID uint64) (*pb.SubmissionComment, error) {
+	comment, err := s.db.GetSubmissionComment(commentID)
+	if err != nil {
+		return nil, err
+	}
+	if comment.GetCourseID() != courseID {
+		return nil, fmt.Errorf("submission comment %d does not belong to course %d", commentID, courseID)
+	}
+	return comment, nil
+}
+
+// isPeerReviewer returns true if the given user is assigned to peer review the given submission.
+func (s *AutograderService) isPeerReviewer(usr *pb.User, submissionID uint64) bool {
+	_, err := s.db.GetPeerReview(&pb.PeerReview{SubmissionID: submissionID, ReviewerID: usr.GetID()})
+	return err == nil
+}
+
+// createSubmissionComment creates a new comment by the given user, anchored to a line of a file
+// in the submission's graded commit. Comments by peer reviewers are marked as peer comments.
+// Comments by the teaching staff are mirrored to the SCM as commit comments if requested,
+// and supported by the course's SCM provider.
+func (s *AutograderService) createSubmissionComment(ctx context.Context, usr *pb.User, submission *pb.Submission, request *pb.SubmissionComment) (*pb.SubmissionComment, error) {
+	if submission.GetCommitHash() == "" {
+		return nil, fmt.Errorf("submission %d has no graded commit", submission.GetID())
+	}
+	peer := !s.canReview(usr.GetID(), request.GetCourseID())
+	if peer && request.GetMirror() {
+		return nil, errors.New("peer reviewers cannot mirror comments to the repository")
+	}
+	now := time.Now().Format(pb.TimeLayout)
+	comment := &pb.SubmissionComment{
+		CourseID:     request.GetCourseID(),
+		SubmissionID: submission.GetID(),
+		AuthorID:     usr.GetID(),
+		CommitHash:   submission.GetCommitHash(),
+		Path:         request.GetPath(),
+		Line:         request.GetLine(),
+		Body:         request.GetBody(),
+		Created:      now,
+		Updated:      now,
+		Peer:         peer,
+	}
+	if request.GetMirror() {
+		url, err := s.mirrorSubmissionComment(ctx, submission, comment)
+		switch {
+		case errors.As(err, &scm.ErrNotSupported{}):
+			// the comment is only shown in QuickFeed for providers without commit comments
+			s.logger.Debugf("Commit comments are not supported for course %d: %v", comment.GetCourseID(), err)
+		case err != nil:
+			return nil, fmt.Errorf("failed to mirror comment: %w", err)
+		}
+		comment.CommitCommentURL = url
+	}
+	if err := s.db.CreateSubmissionComment(comment); err != nil {
+		return nil, err
+	}
+	return comment, nil
+}
+
+// mirrorSubmissionComment creates a commit comment with the given comment in the repository
+// of the submission's student or group, and returns the commit comment's URL.
+func (s *AutograderService) mirrorSubmissionComment(ctx context.Context, submission *pb.Submission, comment *pb.SubmissionComment) (string, error) {
+	course, err := s.db.GetCourse(comment.GetCourseID(), false)
+	if err != nil {
+		return "", err
+	}
+	repo, err := s.submissionRepository(course, submission)
+	if err != nil {
+		return "", err
+	}
+	sc, err := s.getCourseSCM(course)
+	if err != nil {
+		return "", err
+	}
+	return sc.CreateCommitComment(ctx, &scm.CommitCommentOptions{
+		Owner:      course.GetOrganizationPath(),
+		Repository: path.Base(repo.GetHTMLURL()),
+		CommitSHA:  comment.GetCommitHash(),
+		Path:       comment.GetPath(),
+		Line:       comment.GetLine(),
+		Body:       comment.GetBody(),
+	})
+}
+
+// updateSubmissionComment updates the body of the given comment.
+// Comments mirrored to the SCM are not updated there.
+func (s *AutograderService) updateSubmissionComment(comment, update *pb.SubmissionComment) (*pb.SubmissionComment, error) {
+	comment.Body = update.GetBody()
+	comment.Updated = time.Now().Format(pb.TimeLayout)
+	if err := s.db.UpdateSubmissionComment(comment); err != nil {
+		return nil, err
+	}
+	return comment, nil
+}
+
+// getSubmissionComments returns the comments on the given submission visible to the given user.
+// The teaching staff and the submission's authors get all comments, but the authors of peer comments
+// are hidden from the submission's authors. Peer reviewers only get their own comments.
+func (s *AutograderService) getSubmissionComments(usr *pb.User, submission *pb.Submission, courseID uint64) (*pb.SubmissionComments, error) {
+	comments, err := s.db.GetSubmissionComments(submission.GetID())
+	if err != nil {
+		return nil, err
+	}
+	if s.isTeachingStaff(usr.GetID(), courseID) {
+		return &pb.SubmissionComments{Comments: comments}, nil
+	}
+	isAuthor := s.isSubmissionAuthor(usr, submission)
+	visible := make([]*pb.SubmissionComment, 0, len(comments))
+	for _, comment := range comments {
+		switch {
+		case usr.IsOwner(comment.GetAuthorID()):
+		case !isAuthor:
+			continue
+		case comment.GetPeer():
+			comment.AuthorID = 0
+		}
+		visible = append(visible, comment)
+	}
+	return &pb.SubmissionComments{Comments: visible}, nil
+}
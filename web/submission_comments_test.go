@@ -0,0 +1,156 @@
+package web_test
+
+import (
+	"context"
+	"testing"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/ci"
+	"github.com/autograde/quickfeed/internal/qtest"
+	"github.com/autograde/quickfeed/scm"
+	"github.com/autograde/quickfeed/web"
+)
+
+func TestSubmissionComments(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	teacher := qtest.CreateFakeUser(t, db, 1)
+	course := &pb.Course{Provider: "fake", OrganizationID: 1, OrganizationPath: "test"}
+	qtest.CreateCourse(t, db, teacher, course)
+	student := qtest.CreateFakeUser(t, db, 2)
+	qtest.EnrollStudent(t, db, student, course)
+	reviewer := qtest.CreateFakeUser(t, db, 3)
+	qtest.EnrollStudent(t, db, reviewer, course)
+	other := qtest.CreateFakeUser(t, db, 4)
+	qtest.EnrollStudent(t, db, other, course)
+
+	assignment := &pb.Assignment{CourseID: course.ID, Name: "lab1", Order: 1}
+	if err := db.CreateAssignment(assignment); err != nil {
+		t.Fatal(err)
+	}
+	submission := &pb.Submission{AssignmentID: assignment.ID, UserID: student.ID, CommitHash: "abc123"}
+	if err := db.CreateSubmission(submission); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.CreateRepository(&pb.Repository{
+		OrganizationID: course.OrganizationID,
+		RepositoryID:   10,
+		UserID:         student.ID,
+		RepoType:       pb.Repository_USER,
+		HTMLURL:        "https://example.com/test/student-labs",
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.CreatePeerReviews([]*pb.PeerReview{
+		{CourseID: course.ID, AssignmentID: assignment.ID, SubmissionID: submission.ID, ReviewerID: reviewer.ID},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	_, scms := qtest.FakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	sc, err := scms.GetOrCreateSCMEntry(zap.NewNop(), "fake", course.GetAccessToken())
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	staffComment, err := ags.CreateSubmissionComment(withUserContext(ctx, teacher), &pb.SubmissionComment{
+		CourseID:     course.ID,
+		SubmissionID: submission.ID,
+		Path:         "lab1/fib.go",
+		Line:         12,
+		Body:         "This loop never terminates for n < 0.",
+		Mirror:       true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if staffComment.GetCommitHash() != "abc123" || staffComment.GetPeer() || staffComment.GetCommitCommentURL() == "" {
+		t.Errorf("CreateSubmissionComment() = %v, want mirrored staff comment on commit abc123", staffComment)
+	}
+	mirrored := sc.(*scm.FakeSCM).CommitComments["test/student-labs/abc123"]
+	if len(mirrored) != 1 || mirrored[0].Path != "lab1/fib.go" || mirrored[0].Line != 12 {
+		t.Errorf("mirrored commit comments = %v, want one comment on lab1/fib.go:12", mirrored)
+	}
+
+	peerRequest := &pb.SubmissionComment{CourseID: course.ID, SubmissionID: submission.ID, Path: "lab1/fib.go", Line: 3, Body: "Nice naming."}
+	peerComment, err := ags.CreateSubmissionComment(withUserContext(ctx, reviewer), peerRequest)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !peerComment.GetPeer() {
+		t.Errorf("CreateSubmissionComment() by peer reviewer: Peer = false, want true")
+	}
+	peerRequest.Mirror = true
+	if _, err := ags.CreateSubmissionComment(withUserContext(ctx, reviewer), peerRequest); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("CreateSubmissionComment() mirrored by peer reviewer: err = %v, want %v", err, codes.InvalidArgument)
+	}
+	if _, err := ags.CreateSubmissionComment(withUserContext(ctx, other), peerRequest); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("CreateSubmissionComment() by other student: err = %v, want %v", err, codes.PermissionDenied)
+	}
+
+	request := &pb.SubmissionCommentRequest{CourseID: course.ID, SubmissionID: submission.ID}
+	tests := []struct {
+		user      *pb.User
+		authorIDs []uint64
+	}{
+		{user: teacher, authorIDs: []uint64{teacher.ID, reviewer.ID}},
+		// the author of the peer comment is hidden from the submission's author
+		{user: student, authorIDs: []uint64{teacher.ID, 0}},
+		{user: reviewer, authorIDs: []uint64{reviewer.ID}},
+	}
+	for _, tt := range tests {
+		got, err := ags.GetSubmissionComments(withUserContext(ctx, tt.user), request)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var authorIDs []uint64
+		for _, c := range got.GetComments() {
+			authorIDs = append(authorIDs, c.GetAuthorID())
+		}
+		if len(authorIDs) != len(tt.authorIDs) {
+			t.Errorf("GetSubmissionComments() by %s: authors = %v, want %v", tt.user.GetLogin(), authorIDs, tt.authorIDs)
+			continue
+		}
+		for i := range authorIDs {
+			if authorIDs[i] != tt.authorIDs[i] {
+				t.Errorf("GetSubmissionComments() by %s: authors = %v, want %v", tt.user.GetLogin(), authorIDs, tt.authorIDs)
+				break
+			}
+		}
+	}
+	if _, err := ags.GetSubmissionComments(withUserContext(ctx, other), request); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("GetSubmissionComments() by other student: err = %v, want %v", err, codes.PermissionDenied)
+	}
+
+	update := &pb.SubmissionComment{ID: peerComment.ID, CourseID: course.ID, Body: "Nice naming, but see line 12."}
+	if _, err := ags.UpdateSubmissionComment(withUserContext(ctx, teacher), update); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("UpdateSubmissionComment() by non-author: err = %v, want %v", err, codes.PermissionDenied)
+	}
+	updated, err := ags.UpdateSubmissionComment(withUserContext(ctx, reviewer), update)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if updated.GetBody() != update.GetBody() {
+		t.Errorf("UpdateSubmissionComment() body = %q, want %q", updated.GetBody(), update.GetBody())
+	}
+	if _, err := ags.DeleteSubmissionComment(withUserContext(ctx, student), update); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("DeleteSubmissionComment() by non-author: err = %v, want %v", err, codes.PermissionDenied)
+	}
+	if _, err := ags.DeleteSubmissionComment(withUserContext(ctx, teacher), update); err != nil {
+		t.Fatal(err)
+	}
+	comments, err := db.GetSubmissionComments(submission.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(comments) != 1 {
+		t.Errorf("GetSubmissionComments() returned %d comments after delete, want 1", len(comments))
+	}
+}
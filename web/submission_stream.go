@@ -0,0 +1,63 @@
+package web
+
+import (
+	"errors"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"google.golang.org/protobuf/proto"
+)
+
+// submissionStreamFilter returns a filter selecting the submissions of the given course
+// that the user may receive: all submissions for teaching staff, and the user's own and
+// group's submissions for students.
+func (s *AutograderService) submissionStreamFilter(user *pb.User, courseID uint64) (func(*pb.Submission) bool, error) {
+	enrollment, err := s.db.GetEnrollmentByCourseAndUser(courseID, user.GetID())
+	if err != nil {
+		return nil, err
+	}
+	switch enrollment.GetStatus() {
+	case pb.Enrollment_TEACHER, pb.Enrollment_TA:
+		return func(*pb.Submission) bool { return true }, nil
+	case pb.Enrollment_STUDENT:
+		groupID := enrollment.GetGroupID()
+		return func(submission *pb.Submission) bool {
+			return submission.GetUserID() == user.GetID() ||
+				groupID > 0 && submission.GetGroupID() == groupID
+		}, nil
+	}
+	return nil, errors.New("enrollment is pending")
+}
+
+// streamSubmissions sends the course's submissions selected by the filter to the stream
+// as their results are recorded, until the stream's context is done. If hideReviews is set,
+// the submissions are sent with their released reviews only, as for GetSubmissions.
+func (s *AutograderService) streamSubmissions(stream pb.AutograderService_SubmissionStreamServer, courseID uint64, filter func(*pb.Submission) bool, hideReviews bool) error {
+	scale, err := s.getGradingScale(courseID)
+	if err != nil {
+		return err
+	}
+	results, cancel := s.runners.Results().Subscribe(courseID, filter)
+	defer cancel()
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case submission := <-results:
+			// the submission is shared with other subscribers
+			submission = proto.Clone(submission).(*pb.Submission)
+			if hideReviews {
+				// the recorded results do not include the submission's reviews
+				stored, err := s.db.GetSubmission(&pb.Submission{ID: submission.GetID()})
+				if err != nil {
+					return err
+				}
+				submission.Reviews = stored.GetReviews()
+				hideUnreleasedReviews([]*pb.Submission{submission})
+			}
+			scale.SetGrades(submission)
+			if err := stream.Send(submission); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,127 @@
+package web_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/ci"
+	"github.com/autograde/quickfeed/internal/qtest"
+	"github.com/autograde/quickfeed/web"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// echoRunner outputs the job's commands instead of running them.
+type echoRunner struct{}
+
+func (echoRunner) Run(_ context.Context, job *ci.Job) (string, error) {
+	return strings.Join(job.Commands, "\n"), nil
+}
+
+// submissionStream is a server stream passing the sent submissions to a channel.
+type submissionStream struct {
+	grpc.ServerStream
+	ctx         context.Context
+	submissions chan *pb.Submission
+}
+
+func newSubmissionStream(ctx context.Context) *submissionStream {
+	return &submissionStream{ctx: ctx, submissions: make(chan *pb.Submission, 10)}
+}
+
+func (s *submissionStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *submissionStream) Send(submission *pb.Submission) error {
+	s.submissions <- submission
+	return nil
+}
+
+func TestSubmissionStream(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	teacher := qtest.CreateFakeUser(t, db, 1)
+	course := &pb.Course{Code: "DAT101", OrganizationID: 1}
+	qtest.CreateCourse(t, db, teacher, course)
+	students := qtest.PopulateCourse(t, db, course, 2, 1)
+	_, scms := qtest.FakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, echoRunner{})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	streams := make(map[*pb.User]*submissionStream)
+	errs := make(chan error, 3)
+	for _, user := range []*pb.User{teacher, students[0], students[1]} {
+		stream := newSubmissionStream(withUserContext(ctx, user))
+		streams[user] = stream
+		go func() {
+			errs <- ags.SubmissionStream(&pb.SubmissionStreamRequest{CourseID: course.GetID()}, stream)
+		}()
+	}
+
+	// rebuild the first student's submission until the teacher and student receive its results;
+	// the streams may not have subscribed to the results when the first rebuild finishes
+	submission, err := db.GetSubmission(&pb.Submission{AssignmentID: 1, UserID: students[0].GetID()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the submission's review is not yet released, and is only sent to the teacher
+	if err := db.CreateReview(&pb.Review{SubmissionID: submission.GetID(), ReviewerID: teacher.GetID(), Ready: true, Score: 95}); err != nil {
+		t.Fatal(err)
+	}
+	submission.ReviewScore = 95
+	if err := db.UpdateSubmission(submission); err != nil {
+		t.Fatal(err)
+	}
+	wantReviewScore := map[*pb.User]uint32{teacher: 95, students[0]: 0}
+	rebuild := &pb.RebuildRequest{AssignmentID: 1, SubmissionID: submission.GetID()}
+	timeout := time.After(5 * time.Second)
+	for _, user := range []*pb.User{teacher, students[0]} {
+		for received := false; !received; {
+			if _, err := ags.RebuildSubmission(withUserContext(ctx, teacher), rebuild); err != nil {
+				t.Fatal(err)
+			}
+			select {
+			case got := <-streams[user].submissions:
+				if got.GetID() != submission.GetID() || got.GetScore() != 80 || got.GetGrade() == "" {
+					t.Errorf("SubmissionStream() for %s sent %+v, want graded submission %d", user.GetName(), got, submission.GetID())
+				}
+				if got.GetReviewScore() != wantReviewScore[user] || user != teacher && len(got.GetReviews()) != 0 {
+					t.Errorf("SubmissionStream() for %s sent review score %d and reviews %v, want review score %d",
+						user.GetName(), got.GetReviewScore(), got.GetReviews(), wantReviewScore[user])
+				}
+				received = true
+			case <-timeout:
+				t.Fatalf("SubmissionStream() for %s: no submission received", user.GetName())
+			case <-time.After(50 * time.Millisecond):
+			}
+		}
+	}
+	select {
+	case got := <-streams[students[1]].submissions:
+		t.Errorf("SubmissionStream() sent another student's submission %+v", got)
+	default:
+	}
+
+	// streams end when the client cancels them
+	cancel()
+	for range streams {
+		if err := <-errs; err != nil {
+			t.Errorf("SubmissionStream() = %v, want nil", err)
+		}
+	}
+
+	// users not enrolled in the course cannot stream its submissions
+	stranger := qtest.CreateFakeUser(t, db, 99)
+	err = ags.SubmissionStream(&pb.SubmissionStreamRequest{CourseID: course.GetID()}, newSubmissionStream(withUserContext(context.Background(), stranger)))
+	if status.Code(err) != codes.PermissionDenied {
+		t.Errorf("SubmissionStream() for stranger = %v, want code %v", err, codes.PermissionDenied)
+	}
+}
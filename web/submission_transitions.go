@@ -0,0 +1,66 @@
+package web
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+)
+
+// errAlreadySignedOff is returned when a reviewer signs off on the approval of a submission more than once.
+var errAlreadySignedOff = errors.New("reviewer has already signed off")
+
+// submissionTransition returns the transition of the given submission to the status requested by the
+// given grader, with the resulting status. Approvals of assignments with more than one reviewer are
+// sign-offs: the submission stays in review until the assignment's number of reviewers have signed off,
+// and each reviewer can only sign off once. The transition must be recorded by the caller once the
+// submission has been updated with the resulting status.
+func (s *AutograderService) submissionTransition(usr *pb.User, submission *pb.Submission, requested pb.Submission_Status, comment string) (*pb.SubmissionTransition, error) {
+	transitions, err := s.db.GetSubmissionTransitions(submission.GetID())
+	if err != nil {
+		return nil, err
+	}
+	transition := &pb.SubmissionTransition{
+		SubmissionID:    submission.GetID(),
+		UserID:          usr.GetID(),
+		PreviousStatus:  submission.GetStatus(),
+		RequestedStatus: requested,
+		Status:          requested,
+		Comment:         comment,
+		Created:         time.Now().Format(pb.TimeLayout),
+	}
+	if requested == pb.Submission_APPROVED {
+		assignment, err := s.db.GetAssignment(&pb.Assignment{ID: submission.GetAssignmentID()})
+		if err != nil {
+			return nil, err
+		}
+		signOffs := pb.ApprovalSignOffs(transitions)
+		if signOffs[usr.GetID()] {
+			return nil, fmt.Errorf("user %s on submission %d: %w", usr.GetLogin(), submission.GetID(), errAlreadySignedOff)
+		}
+		if required := int(assignment.GetReviewers()); len(signOffs)+1 < required {
+			transition.Status = pb.Submission_IN_REVIEW
+		}
+	}
+	return transition, nil
+}
+
+// applySubmissionTransition updates the submission with the resulting status of the given transition,
+// keeping the submission's release state, and records the transition in the submission's approval trail.
+func (s *AutograderService) applySubmissionTransition(ctx context.Context, courseID uint64, submission *pb.Submission, transition *pb.SubmissionTransition) error {
+	if err := s.updateSubmission(ctx, courseID, submission.GetID(), transition.GetStatus(), submission.GetReleased(), 0); err != nil {
+		return err
+	}
+	return s.db.CreateSubmissionTransition(transition)
+}
+
+// getSubmissionTransitions returns the transitions of the given submission, oldest first.
+func (s *AutograderService) getSubmissionTransitions(submissionID uint64) (*pb.SubmissionTransitions, error) {
+	transitions, err := s.db.GetSubmissionTransitions(submissionID)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.SubmissionTransitions{Transitions: transitions}, nil
+}
@@ -0,0 +1,129 @@
+package web_test
+
+import (
+	"context"
+	"testing"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/ci"
+	"github.com/autograde/quickfeed/internal/qtest"
+	"github.com/autograde/quickfeed/web"
+)
+
+func TestTransitionSubmission(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	teacher := qtest.CreateFakeUser(t, db, 1)
+	course := &pb.Course{Code: "DAT101", OrganizationID: 1}
+	qtest.CreateCourse(t, db, teacher, course)
+	reviewer := qtest.CreateFakeUser(t, db, 2)
+	if err := db.CreateEnrollment(&pb.Enrollment{UserID: reviewer.ID, CourseID: course.ID}); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.UpdateEnrollment(&pb.Enrollment{UserID: reviewer.ID, CourseID: course.ID, Status: pb.Enrollment_TEACHER}); err != nil {
+		t.Fatal(err)
+	}
+	student := qtest.CreateFakeUser(t, db, 3)
+	qtest.EnrollStudent(t, db, student, course)
+
+	lab := &pb.Assignment{CourseID: course.ID, Name: "lab1", Order: 1, Reviewers: 2}
+	if err := db.CreateAssignment(lab); err != nil {
+		t.Fatal(err)
+	}
+	submission := &pb.Submission{AssignmentID: lab.ID, UserID: student.ID, Score: 80}
+	if err := db.CreateSubmission(submission); err != nil {
+		t.Fatal(err)
+	}
+
+	_, scms := qtest.FakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	client := accessControlled(t, ags)
+	teacherCtx := withUserContext(context.Background(), teacher)
+	reviewerCtx := withUserContext(context.Background(), reviewer)
+	transition := func(ctx context.Context, status pb.Submission_Status, comment string) (*pb.Submission, error) {
+		return ags.TransitionSubmission(ctx, &pb.SubmissionTransitionRequest{
+			CourseID:     course.ID,
+			SubmissionID: submission.ID,
+			Status:       status,
+			Comment:      comment,
+		})
+	}
+
+	if got, err := transition(teacherCtx, pb.Submission_IN_REVIEW, "Reviewing"); err != nil || got.GetStatus() != pb.Submission_IN_REVIEW {
+		t.Fatalf("TransitionSubmission(IN_REVIEW) = (%v, %v), want IN_REVIEW", got.GetStatus(), err)
+	}
+	// the assignment requires two sign-offs for approval
+	if got, err := transition(teacherCtx, pb.Submission_APPROVED, "Looks good"); err != nil || got.GetStatus() != pb.Submission_IN_REVIEW {
+		t.Fatalf("TransitionSubmission(APPROVED) by first reviewer = (%v, %v), want IN_REVIEW", got.GetStatus(), err)
+	}
+	if _, err := transition(teacherCtx, pb.Submission_APPROVED, "Still good"); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("TransitionSubmission(APPROVED) signed off twice: got %v, want %v", err, codes.FailedPrecondition)
+	}
+	got, err := transition(reviewerCtx, pb.Submission_APPROVED, "Agreed")
+	if err != nil || got.GetStatus() != pb.Submission_APPROVED || got.GetApprovedDate() == "" {
+		t.Fatalf("TransitionSubmission(APPROVED) by second reviewer = (%v, %v), want APPROVED with approval date", got, err)
+	}
+	if _, err := transition(reviewerCtx, pb.Submission_APPROVED, ""); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("TransitionSubmission(APPROVED) of approved submission: got %v, want %v", err, codes.FailedPrecondition)
+	}
+
+	// requesting changes discards the sign-offs, also through UpdateSubmission
+	if _, err := transition(reviewerCtx, pb.Submission_REVISION, "Missing tests"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ags.UpdateSubmission(teacherCtx, &pb.UpdateSubmissionRequest{
+		SubmissionID: submission.ID,
+		CourseID:     course.ID,
+		Status:       pb.Submission_APPROVED,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	updated, err := db.GetSubmission(&pb.Submission{ID: submission.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if updated.GetStatus() != pb.Submission_IN_REVIEW {
+		t.Errorf("UpdateSubmission(APPROVED) after changes requested: status = %v, want IN_REVIEW", updated.GetStatus())
+	}
+
+	trail, err := ags.GetSubmissionTransitions(reviewerCtx, &pb.SubmissionTransitionRequest{CourseID: course.ID, SubmissionID: submission.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []struct {
+		userID    uint64
+		requested pb.Submission_Status
+		status    pb.Submission_Status
+	}{
+		{teacher.ID, pb.Submission_IN_REVIEW, pb.Submission_IN_REVIEW},
+		{teacher.ID, pb.Submission_APPROVED, pb.Submission_IN_REVIEW},
+		{reviewer.ID, pb.Submission_APPROVED, pb.Submission_APPROVED},
+		{reviewer.ID, pb.Submission_REVISION, pb.Submission_REVISION},
+		{teacher.ID, pb.Submission_APPROVED, pb.Submission_IN_REVIEW},
+	}
+	if len(trail.GetTransitions()) != len(want) {
+		t.Fatalf("GetSubmissionTransitions() = %v, want %d transitions", trail.GetTransitions(), len(want))
+	}
+	for i, transition := range trail.GetTransitions() {
+		if transition.GetUserID() != want[i].userID || transition.GetRequestedStatus() != want[i].requested || transition.GetStatus() != want[i].status {
+			t.Errorf("transition %d = (%d, %v, %v), want (%d, %v, %v)", i, transition.GetUserID(), transition.GetRequestedStatus(), transition.GetStatus(),
+				want[i].userID, want[i].requested, want[i].status)
+		}
+	}
+	if comment := trail.GetTransitions()[3].GetComment(); comment != "Missing tests" {
+		t.Errorf("transition comment = %q, want %q", comment, "Missing tests")
+	}
+
+	studentCtx := withUserContext(context.Background(), student)
+	if _, err := transition(studentCtx, pb.Submission_APPROVED, ""); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("TransitionSubmission() by student: got %v, want %v", err, codes.PermissionDenied)
+	}
+	if _, err := client.GetSubmissionTransitions(studentCtx, &pb.SubmissionTransitionRequest{CourseID: course.ID, SubmissionID: submission.ID}); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("GetSubmissionTransitions() by student: got %v, want %v", err, codes.PermissionDenied)
+	}
+}
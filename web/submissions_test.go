@@ -4,6 +4,7 @@ import (
 	"context"
 	"reflect"
 	"testing"
+	"time"
 
 	pb "github.com/autograde/quickfeed/ag"
 	"github.com/autograde/quickfeed/ci"
@@ -68,6 +69,7 @@ func TestSubmissionsAccess(t *testing.T) {
 
 	fakeProvider, scms := qtest.FakeProviderMap(t)
 	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	client := accessControlled(t, ags)
 	ctx := withUserContext(context.Background(), teacher)
 
 	_, err = fakeProvider.CreateOrganization(context.Background(), &scm.OrganizationOptions{Path: "path", Name: "name"})
@@ -155,6 +157,10 @@ func TestSubmissionsAccess(t *testing.T) {
 	}
 
 	allSubmissions := []*pb.Submission{submission1, submission2, submission3}
+	// submissions are returned with letter grades according to the default grading scale
+	for _, submission := range allSubmissions {
+		submission.Grade = "F"
+	}
 	latestSubmissions := []*pb.Submission{submission2, submission3}
 
 	// there must be exactly three submissions for given course and assignment in the database
@@ -173,7 +179,7 @@ func TestSubmissionsAccess(t *testing.T) {
 
 	// admin not enrolled in the course must not be able to access any course submissions
 	ctx = withUserContext(context.Background(), admin)
-	haveSubmissions, err = ags.GetSubmissions(ctx, &pb.SubmissionRequest{CourseID: course.ID})
+	haveSubmissions, err = client.GetSubmissions(ctx, &pb.SubmissionRequest{CourseID: course.ID})
 	if err == nil {
 		t.Error("Expected error: user not enrolled")
 	}
@@ -228,7 +234,7 @@ func TestSubmissionsAccess(t *testing.T) {
 
 	// the second student should not be able to access the submission by student1
 	ctx = withUserContext(context.Background(), student2)
-	personalSubmission, err = ags.GetSubmissions(ctx, &pb.SubmissionRequest{CourseID: course.ID, UserID: student1.ID})
+	personalSubmission, err = client.GetSubmissions(ctx, &pb.SubmissionRequest{CourseID: course.ID, UserID: student1.ID})
 	if err == nil || personalSubmission != nil {
 		t.Error("Expected error: only owner and teachers can get submissions")
 	}
@@ -243,14 +249,14 @@ func TestSubmissionsAccess(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	groupSubmission, err = ags.GetSubmissions(ctx, &pb.SubmissionRequest{CourseID: course.ID, GroupID: 1})
+	groupSubmission, err = client.GetSubmissions(ctx, &pb.SubmissionRequest{CourseID: course.ID, GroupID: 1})
 	if err == nil || groupSubmission != nil {
 		t.Error("Expected error: only owner and teachers can get submissions")
 	}
 
 	// the third student (not enrolled in the course) should not be able to access submission even if it belongs to that student
 	ctx = withUserContext(context.Background(), student3)
-	personalSubmission, err = ags.GetSubmissions(ctx, &pb.SubmissionRequest{CourseID: course.ID, UserID: student3.ID})
+	personalSubmission, err = client.GetSubmissions(ctx, &pb.SubmissionRequest{CourseID: course.ID, UserID: student3.ID})
 	if err == nil || personalSubmission != nil {
 		t.Error("Expected error: only owner and teachers can get submissions")
 	}
@@ -345,6 +351,50 @@ func TestApproveSubmission(t *testing.T) {
 	}
 }
 
+func TestUpdateSubmissionReviewScore(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	teacher := qtest.CreateFakeUser(t, db, 1)
+	course := &pb.Course{Code: "DAT101", OrganizationID: 1}
+	qtest.CreateCourse(t, db, teacher, course)
+	student := qtest.CreateFakeUser(t, db, 2)
+	qtest.EnrollStudent(t, db, student, course)
+
+	lab := &pb.Assignment{CourseID: course.GetID(), Name: "lab1", Order: 1, Reviewers: 1, TestScoreWeight: 40}
+	if err := db.CreateAssignment(lab); err != nil {
+		t.Fatal(err)
+	}
+	submission := &pb.Submission{
+		AssignmentID: lab.GetID(),
+		UserID:       student.GetID(),
+		Score:        80,
+		Scores:       []*score.Score{{TestName: "TestLab1", Score: 8, MaxScore: 10, Weight: 1}},
+	}
+	if err := db.CreateSubmission(submission); err != nil {
+		t.Fatal(err)
+	}
+
+	_, scms := qtest.FakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	if _, err := ags.UpdateSubmission(withUserContext(context.Background(), teacher), &pb.UpdateSubmissionRequest{
+		SubmissionID: submission.GetID(),
+		CourseID:     course.GetID(),
+		Score:        50,
+		Released:     true,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	got, err := db.GetSubmission(&pb.Submission{ID: submission.GetID()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the review score is stored alongside the score combining it with the test score of 80
+	if got.GetReviewScore() != 50 || got.GetScore() != 62 {
+		t.Errorf("UpdateSubmission() = review score %d and score %d, want review score 50 and score 62", got.GetReviewScore(), got.GetScore())
+	}
+}
+
 func TestGetCourseLabSubmissions(t *testing.T) {
 	db, cleanup := qtest.TestDB(t)
 	defer cleanup()
@@ -445,6 +495,7 @@ func TestGetCourseLabSubmissions(t *testing.T) {
 		UserID:       student.ID,
 		AssignmentID: lab1c1.ID,
 		Score:        44,
+		Grade:        "E",
 		Reviews:      []*pb.Review{},
 		Scores:       []*score.Score{},
 		BuildInfo:    buildInfo1,
@@ -453,6 +504,7 @@ func TestGetCourseLabSubmissions(t *testing.T) {
 		UserID:       student.ID,
 		AssignmentID: lab2c2.ID,
 		Score:        66,
+		Grade:        "C",
 		Reviews:      []*pb.Review{},
 		Scores:       []*score.Score{},
 		BuildInfo:    buildInfo2,
@@ -466,6 +518,7 @@ func TestGetCourseLabSubmissions(t *testing.T) {
 
 	fakeProvider, scms := qtest.FakeProviderMap(t)
 	ags := web.NewAutograderService(log.Zap(false), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	client := accessControlled(t, ags)
 	ctx := withUserContext(context.Background(), admin)
 
 	_, err := fakeProvider.CreateOrganization(context.Background(), &scm.OrganizationOptions{Path: "path", Name: "name"})
@@ -476,6 +529,10 @@ func TestGetCourseLabSubmissions(t *testing.T) {
 	// check that all assignments were saved for the correct courses
 	wantAssignments1 := []*pb.Assignment{lab1c1, lab2c1}
 	wantAssignments2 := []*pb.Assignment{lab1c2, lab2c2}
+	// the assignments are returned with their deadlines in the courses' time zone
+	for _, lab := range append(wantAssignments1, wantAssignments2...) {
+		lab.LocalDeadline = lab.LocalDeadlineIn(time.Local)
+	}
 
 	haveAssignments1, err := ags.GetAssignments(ctx, &pb.CourseRequest{CourseID: course1.ID})
 	if err != nil {
@@ -558,19 +615,19 @@ func TestGetCourseLabSubmissions(t *testing.T) {
 	}
 
 	// check that method fails with empty context
-	if _, err = ags.GetSubmissionsByCourse(context.Background(), &pb.SubmissionsForCourseRequest{CourseID: course1.ID}); err == nil {
+	if _, err = client.GetSubmissionsByCourse(context.Background(), &pb.SubmissionsForCourseRequest{CourseID: course1.ID}); err == nil {
 		t.Error("Expected 'authorization failed. please try to logout and sign in again'")
 	}
 
 	// check that method fails for unenrolled student user
 	unenrolledStudent := qtest.CreateFakeUser(t, db, 3)
 	ctx = withUserContext(ctx, unenrolledStudent)
-	if _, err := ags.GetSubmissionsByCourse(ctx, &pb.SubmissionsForCourseRequest{CourseID: course1.ID}); err == nil {
+	if _, err := client.GetSubmissionsByCourse(ctx, &pb.SubmissionsForCourseRequest{CourseID: course1.ID}); err == nil {
 		t.Error("Expected 'only teachers can get all lab submissions'")
 	}
 	// check that method fails for non-teacher user
 	ctx = withUserContext(ctx, student)
-	if _, err = ags.GetSubmissionsByCourse(ctx, &pb.SubmissionsForCourseRequest{CourseID: course1.ID}); err == nil {
+	if _, err = client.GetSubmissionsByCourse(ctx, &pb.SubmissionsForCourseRequest{CourseID: course1.ID}); err == nil {
 		t.Error("Expected 'only teachers can get all lab submissions'")
 	}
 }
@@ -0,0 +1,122 @@
+package web_test
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/ci"
+	"github.com/autograde/quickfeed/internal/qtest"
+	"github.com/autograde/quickfeed/scm"
+	"github.com/autograde/quickfeed/web"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestTeachingAssistantAccess(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	admin := qtest.CreateFakeUser(t, db, 1)
+	course := &pb.Course{Code: "DAT101", OrganizationID: 1}
+	qtest.CreateCourse(t, db, admin, course)
+	assistant := qtest.CreateFakeUser(t, db, 2)
+	qtest.EnrollStudent(t, db, assistant, course)
+	if err := db.UpdateEnrollment(&pb.Enrollment{UserID: assistant.GetID(), CourseID: course.GetID(), Status: pb.Enrollment_TA}); err != nil {
+		t.Fatal(err)
+	}
+	student := qtest.CreateFakeUser(t, db, 3)
+	qtest.EnrollStudent(t, db, student, course)
+
+	lab := &pb.Assignment{CourseID: course.GetID(), Name: "lab1", Order: 1, Reviewers: 1}
+	if err := db.CreateAssignment(lab); err != nil {
+		t.Fatal(err)
+	}
+	submission := &pb.Submission{AssignmentID: lab.GetID(), UserID: student.GetID(), Score: 80}
+	if err := db.CreateSubmission(submission); err != nil {
+		t.Fatal(err)
+	}
+
+	_, scms := qtest.FakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	client := accessControlled(t, ags)
+	ctx := withUserContext(context.Background(), assistant)
+
+	// teaching assistants can view all submissions for the course
+	if _, err := ags.GetSubmissionsByCourse(ctx, &pb.SubmissionsForCourseRequest{CourseID: course.GetID()}); err != nil {
+		t.Errorf("GetSubmissionsByCourse() for teaching assistant: %v", err)
+	}
+	// but cannot modify course settings or enrollments
+	if _, err := ags.UpdateCourse(ctx, &pb.Course{ID: course.GetID(), Code: "DAT101", OrganizationID: 1}); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("UpdateCourse() for teaching assistant: got %v, want %v", err, codes.PermissionDenied)
+	}
+	if _, err := client.UpdateEnrollment(ctx, &pb.Enrollment{UserID: student.GetID(), CourseID: course.GetID(), Status: pb.Enrollment_TEACHER}); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("UpdateEnrollment() for teaching assistant: got %v, want %v", err, codes.PermissionDenied)
+	}
+
+	approve := &pb.UpdateSubmissionRequest{CourseID: course.GetID(), SubmissionID: submission.GetID(), Status: pb.Submission_APPROVED}
+	review := &pb.ReviewRequest{CourseID: course.GetID(), Review: &pb.Review{SubmissionID: submission.GetID(), ReviewerID: assistant.GetID()}}
+
+	tests := []struct {
+		permission  pb.Course_AssistantPermission
+		wantApprove codes.Code
+		wantReview  codes.Code
+	}{
+		{permission: pb.Course_REVIEW_AND_APPROVE, wantApprove: codes.OK},
+		{permission: pb.Course_REVIEW_ONLY, wantApprove: codes.PermissionDenied},
+		{permission: pb.Course_VIEW_ONLY, wantApprove: codes.PermissionDenied, wantReview: codes.PermissionDenied},
+	}
+	for _, test := range tests {
+		course.AssistantPermission = test.permission
+		if err := db.UpdateCourse(course); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := ags.UpdateSubmission(ctx, approve); status.Code(err) != test.wantApprove {
+			t.Errorf("UpdateSubmission() with %v: got %v, want %v", test.permission, err, test.wantApprove)
+		}
+		// the review itself may be rejected for other reasons; only access is checked here
+		if _, err := ags.CreateReview(ctx, review); (status.Code(err) == codes.PermissionDenied) != (test.wantReview == codes.PermissionDenied) {
+			t.Errorf("CreateReview() with %v: got %v, want %v", test.permission, err, test.wantReview)
+		}
+	}
+}
+
+func TestUpdateCourseAssistantPermission(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	fakeGothProvider()
+	admin := qtest.CreateFakeUser(t, db, 1)
+	ctx := withUserContext(context.Background(), admin)
+	fakeProvider, scms := qtest.FakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	if _, err := fakeProvider.CreateOrganization(ctx, &scm.OrganizationOptions{Path: "path", Name: "name"}); err != nil {
+		t.Fatal(err)
+	}
+	course, err := ags.CreateCourse(ctx, &pb.Course{
+		Name:                "Distributed Systems",
+		Code:                "DAT520",
+		Year:                2021,
+		Tag:                 "Spring",
+		Provider:            "fake",
+		OrganizationID:      1,
+		AssistantPermission: pb.Course_VIEW_ONLY,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// resetting to the zero value must not be ignored
+	course.AssistantPermission = pb.Course_REVIEW_AND_APPROVE
+	if _, err := ags.UpdateCourse(ctx, course); err != nil {
+		t.Fatal(err)
+	}
+	got, err := db.GetCourse(course.GetID(), false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.GetAssistantPermission() != pb.Course_REVIEW_AND_APPROVE {
+		t.Errorf("UpdateCourse() assistant permission = %v, want %v", got.GetAssistantPermission(), pb.Course_REVIEW_AND_APPROVE)
+	}
+}
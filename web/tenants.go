@@ -0,0 +1,189 @@
+package web
+
+import (
+	"errors"
+	"fmt"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/scm"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	errOtherTenant      = errors.New("course belongs to another tenant")
+	errTenantProvider   = errors.New("provider is not used by the tenant")
+	errSuperAdminTenant = errors.New("the super admin cannot belong to a tenant")
+)
+
+// getTenants returns all tenants.
+func (s *AutograderService) getTenants() (*pb.Tenants, error) {
+	tenants, err := s.db.GetTenants()
+	if err != nil {
+		return nil, err
+	}
+	return &pb.Tenants{Tenants: tenants}, nil
+}
+
+// createTenant creates the given tenant.
+func (s *AutograderService) createTenant(usr *pb.User, tenant *pb.Tenant) (*pb.Tenant, error) {
+	tenant.ID = 0
+	if err := s.db.CreateTenant(tenant); err != nil {
+		return nil, err
+	}
+	s.audit(usr, 0, "create tenant", "tenant", tenant.GetName(), "provider", tenant.GetProvider())
+	return tenant, nil
+}
+
+// updateTenant updates the given tenant's name and SCM provider configuration.
+func (s *AutograderService) updateTenant(usr *pb.User, tenant *pb.Tenant) error {
+	if err := s.db.UpdateTenant(tenant); err != nil {
+		return err
+	}
+	s.audit(usr, 0, "update tenant", "tenant", tenant.GetName(), "provider", tenant.GetProvider())
+	return nil
+}
+
+// setUserTenant moves the given user to the given tenant, or out of its tenant if the tenant ID is zero.
+// The tenant of the super admin (user with ID 1) cannot be changed.
+func (s *AutograderService) setUserTenant(usr *pb.User, request *pb.TenantUserRequest) error {
+	if request.GetUserID() == 1 {
+		return errSuperAdminTenant
+	}
+	if _, err := s.getTenant(request.GetTenantID()); err != nil {
+		return err
+	}
+	user, err := s.db.GetUser(request.GetUserID())
+	if err != nil {
+		return err
+	}
+	user.TenantID = request.GetTenantID()
+	if err := s.db.UpdateUser(user); err != nil {
+		return err
+	}
+	s.audit(usr, 0, "set user tenant", "user", user.GetLogin(), "tenant", request.GetTenantID())
+	return nil
+}
+
+// getTenant returns the tenant with the given ID, or nil if the ID is zero,
+// that is, for users and courses that do not belong to a tenant.
+func (s *AutograderService) getTenant(tenantID uint64) (*pb.Tenant, error) {
+	if tenantID == 0 {
+		return nil, nil
+	}
+	return s.db.GetTenant(tenantID)
+}
+
+// canSeeCourse returns true if the given course is visible to the user.
+// Users that belong to a tenant only see the tenant's courses.
+func canSeeCourse(usr *pb.User, course *pb.Course) bool {
+	return usr.GetTenantID() == 0 || usr.GetTenantID() == course.GetTenantID()
+}
+
+// visibleCourses returns the courses visible to the given user.
+func visibleCourses(usr *pb.User, courses *pb.Courses) *pb.Courses {
+	if usr.GetTenantID() == 0 {
+		return courses
+	}
+	visible := &pb.Courses{}
+	for _, course := range courses.GetCourses() {
+		if canSeeCourse(usr, course) {
+			visible.Courses = append(visible.Courses, course)
+		}
+	}
+	return visible
+}
+
+// isCourseAdmin returns true if the user is admin of the given course's tenant,
+// or an admin that does not belong to a tenant. Only the latter administer
+// requests without a course, i.e., with a zero course ID.
+func (s *AutograderService) isCourseAdmin(usr *pb.User, courseID uint64) bool {
+	if usr.IsGlobalAdmin() {
+		return true
+	}
+	if !usr.GetIsAdmin() || courseID == 0 {
+		return false
+	}
+	course, err := s.getCourse(courseID)
+	return err == nil && course.GetTenantID() == usr.GetTenantID()
+}
+
+// isUserAdmin returns true if the current user is admin of the given user's tenant,
+// or an admin that does not belong to a tenant.
+func (s *AutograderService) isUserAdmin(usr *pb.User, userID uint64) bool {
+	if usr.IsGlobalAdmin() {
+		return true
+	}
+	if !usr.GetIsAdmin() {
+		return false
+	}
+	user, err := s.db.GetUser(userID)
+	return err == nil && user.GetTenantID() == usr.GetTenantID()
+}
+
+// inTenant returns true if the given course and the users of the request belong to the given tenant.
+func (s *AutograderService) inTenant(tenantID, courseID uint64, req interface{}) bool {
+	if courseID > 0 {
+		course, err := s.getCourse(courseID)
+		if err != nil || course.GetTenantID() != tenantID {
+			return false
+		}
+	}
+	var userID uint64
+	switch r := req.(type) {
+	case *pb.User:
+		userID = r.GetID()
+	case interface{ GetUserID() uint64 }:
+		userID = r.GetUserID()
+	}
+	if userID > 0 {
+		user, err := s.db.GetUser(userID)
+		if err != nil || user.GetTenantID() != tenantID {
+			return false
+		}
+	}
+	return true
+}
+
+// setCourseTenant sets the tenant of the given new course. Tenant admins create courses in their own
+// tenant, while admins that do not belong to a tenant create courses in the requested tenant, if any.
+// The course must use the tenant's SCM provider.
+func (s *AutograderService) setCourseTenant(usr *pb.User, course *pb.Course) error {
+	if usr.GetTenantID() > 0 {
+		course.TenantID = usr.GetTenantID()
+	}
+	tenant, err := s.getTenant(course.GetTenantID())
+	if err != nil {
+		return err
+	}
+	if tenant != nil && !tenant.HasProvider(course.GetProvider()) {
+		return fmt.Errorf("%w: tenant %s uses %s", errTenantProvider, tenant.GetName(), tenant.GetProvider())
+	}
+	return nil
+}
+
+// providerURL returns the base URL of the SCM provider's server for the given tenant,
+// or the empty string if the server's default is used.
+func (s *AutograderService) providerURL(tenantID uint64, provider string) string {
+	tenant, err := s.getTenant(tenantID)
+	if err != nil {
+		s.logger.Errorf("Failed to get tenant %d: %v", tenantID, err)
+		return ""
+	}
+	return tenant.BaseURLFor(provider)
+}
+
+// getCourseSCM returns an scm client for the course's provider, authenticated with the course's
+// access token, on the server configured for the course's tenant.
+func (s *AutograderService) getCourseSCM(course *pb.Course) (scm.SCM, error) {
+	baseURL := s.providerURL(course.GetTenantID(), course.GetProvider())
+	return s.scms.GetOrCreateSCMEntryForURL(s.logger.Desugar(), course.GetProvider(), baseURL, course.GetAccessToken())
+}
+
+// tenantError returns the status error for a failure to set a course's tenant.
+func tenantError(err error) error {
+	if errors.Is(err, errTenantProvider) {
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return status.Error(codes.NotFound, "tenant not found")
+}
@@ -0,0 +1,206 @@
+package web_test
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/ci"
+	"github.com/autograde/quickfeed/database"
+	"github.com/autograde/quickfeed/internal/qtest"
+	"github.com/autograde/quickfeed/web"
+	"github.com/google/go-cmp/cmp"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// createTenantUser creates a user belonging to the given tenant.
+func createTenantUser(t *testing.T, db database.Database, remoteID uint64, tenant *pb.Tenant, isAdmin bool) *pb.User {
+	t.Helper()
+	user := qtest.CreateFakeUser(t, db, remoteID)
+	user.TenantID = tenant.GetID()
+	user.IsAdmin = isAdmin
+	if err := db.UpdateUser(user); err != nil {
+		t.Fatal(err)
+	}
+	return user
+}
+
+func TestTenantIsolation(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	admin := qtest.CreateFakeUser(t, db, 1)
+	_, scms := qtest.FakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	ctx := withUserContext(context.Background(), admin)
+
+	physics, err := ags.CreateTenant(ctx, &pb.Tenant{Name: "Physics", Provider: "fake"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	chemistry, err := ags.CreateTenant(ctx, &pb.Tenant{Name: "Chemistry"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	physicsAdmin := createTenantUser(t, db, 2, physics, true)
+	physicsStudent := createTenantUser(t, db, 3, physics, false)
+	chemistryStudent := createTenantUser(t, db, 4, chemistry, false)
+	newStudent := qtest.CreateFakeUser(t, db, 5)
+
+	physicsCourse := &pb.Course{Code: "FYS101", OrganizationID: 1, TenantID: physics.GetID()}
+	qtest.CreateCourse(t, db, physicsAdmin, physicsCourse)
+	chemistryCourse := &pb.Course{Code: "KJE101", OrganizationID: 2, TenantID: chemistry.GetID()}
+	qtest.CreateCourse(t, db, admin, chemistryCourse)
+	serverCourse := &pb.Course{Code: "DAT101", OrganizationID: 3}
+	qtest.CreateCourse(t, db, admin, serverCourse)
+
+	// users of a tenant only see the tenant's courses
+	for _, tt := range []struct {
+		user *pb.User
+		want []string
+	}{
+		{admin, []string{"FYS101", "KJE101", "DAT101"}},
+		{physicsAdmin, []string{"FYS101"}},
+		{physicsStudent, []string{"FYS101"}},
+		{chemistryStudent, []string{"KJE101"}},
+		{newStudent, []string{"FYS101", "KJE101", "DAT101"}},
+	} {
+		courses, err := ags.GetCourses(withUserContext(context.Background(), tt.user), &pb.Void{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got []string
+		for _, course := range courses.GetCourses() {
+			got = append(got, course.GetCode())
+		}
+		if diff := cmp.Diff(tt.want, got); diff != "" {
+			t.Errorf("GetCourses() for user %d mismatch (-want +got):\n%s", tt.user.GetID(), diff)
+		}
+	}
+	if _, err := ags.GetCourse(withUserContext(context.Background(), chemistryStudent), &pb.CourseRequest{CourseID: physicsCourse.GetID()}); status.Code(err) != codes.NotFound {
+		t.Errorf("GetCourse() of other tenant = %v, want code %v", err, codes.NotFound)
+	}
+
+	// admins of a tenant only get the tenant's users
+	users, err := ags.GetUsers(withUserContext(context.Background(), physicsAdmin), &pb.UsersRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var gotUsers []uint64
+	for _, user := range users.GetUsers() {
+		gotUsers = append(gotUsers, user.GetID())
+	}
+	if diff := cmp.Diff([]uint64{physicsAdmin.GetID(), physicsStudent.GetID()}, gotUsers); diff != "" {
+		t.Errorf("GetUsers() for tenant admin mismatch (-want +got):\n%s", diff)
+	}
+	users, err = ags.GetUsers(ctx, &pb.UsersRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(users.GetUsers()) != 5 {
+		t.Errorf("GetUsers() for admin = %d users, want 5", len(users.GetUsers()))
+	}
+
+	// users that do not belong to a tenant remain outside the tenants when enrolling in a tenant's course
+	for _, course := range []*pb.Course{physicsCourse, chemistryCourse, serverCourse} {
+		if _, err := ags.CreateEnrollment(withUserContext(context.Background(), newStudent), &pb.Enrollment{UserID: newStudent.GetID(), CourseID: course.GetID()}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	enrolled, err := db.GetUser(newStudent.GetID())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if enrolled.GetTenantID() != 0 {
+		t.Errorf("tenant of enrolled user = %d, want 0", enrolled.GetTenantID())
+	}
+	if _, err := ags.CreateEnrollment(withUserContext(context.Background(), physicsStudent), &pb.Enrollment{UserID: physicsStudent.GetID(), CourseID: chemistryCourse.GetID()}); status.Code(err) != codes.NotFound {
+		t.Errorf("CreateEnrollment() in other tenant's course = %v, want code %v", err, codes.NotFound)
+	}
+}
+
+func TestTenantAccessControl(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	admin := qtest.CreateFakeUser(t, db, 1)
+	physics := &pb.Tenant{Name: "Physics"}
+	if err := db.CreateTenant(physics); err != nil {
+		t.Fatal(err)
+	}
+	physicsAdmin := createTenantUser(t, db, 2, physics, true)
+	physicsStudent := createTenantUser(t, db, 3, physics, false)
+	serverStudent := qtest.CreateFakeUser(t, db, 4)
+	physicsCourse := &pb.Course{Code: "FYS101", OrganizationID: 1, TenantID: physics.GetID()}
+	qtest.CreateCourse(t, db, admin, physicsCourse)
+	serverCourse := &pb.Course{Code: "DAT101", OrganizationID: 2}
+	qtest.CreateCourse(t, db, admin, serverCourse)
+
+	_, scms := qtest.FakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	interceptor := ags.AccessControl()
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) { return &pb.Void{}, nil }
+
+	tests := []struct {
+		name    string
+		method  string
+		user    *pb.User
+		request interface{}
+		allowed bool
+	}{
+		{"tenant admin gets users", "GetUsers", physicsAdmin, &pb.UsersRequest{}, true},
+		{"tenant admin restores enrollment in tenant course", "RestoreEnrollment", physicsAdmin, &pb.Enrollment{CourseID: physicsCourse.GetID(), UserID: physicsStudent.GetID()}, true},
+		{"tenant admin restores enrollment in other course", "RestoreEnrollment", physicsAdmin, &pb.Enrollment{CourseID: serverCourse.GetID(), UserID: physicsStudent.GetID()}, false},
+		{"tenant admin exports data of tenant user", "ExportUserData", physicsAdmin, &pb.UserRequest{UserID: physicsStudent.GetID()}, true},
+		{"tenant admin exports data of other user", "ExportUserData", physicsAdmin, &pb.UserRequest{UserID: serverStudent.GetID()}, false},
+		{"tenant admin gets admin overview", "GetAdminOverview", physicsAdmin, &pb.Void{}, false},
+		{"tenant admin creates tenant", "CreateTenant", physicsAdmin, &pb.Tenant{Name: "Chemistry"}, false},
+		{"admin creates tenant", "CreateTenant", admin, &pb.Tenant{Name: "Chemistry"}, true},
+		{"admin restores enrollment in tenant course", "RestoreEnrollment", admin, &pb.Enrollment{CourseID: physicsCourse.GetID(), UserID: physicsStudent.GetID()}, true},
+	}
+	for _, tt := range tests {
+		info := &grpc.UnaryServerInfo{FullMethod: "/ag.AutograderService/" + tt.method}
+		_, err := interceptor(withUserContext(context.Background(), tt.user), tt.request, info, handler)
+		if tt.allowed && err != nil {
+			t.Errorf("%s: %s() = %v, want access", tt.name, tt.method, err)
+		}
+		if !tt.allowed && status.Code(err) != codes.PermissionDenied {
+			t.Errorf("%s: %s() = %v, want code %v", tt.name, tt.method, err, codes.PermissionDenied)
+		}
+	}
+}
+
+func TestSetUserTenant(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	admin := qtest.CreateFakeUser(t, db, 1)
+	user := qtest.CreateFakeUser(t, db, 2)
+	_, scms := qtest.FakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	ctx := withUserContext(context.Background(), admin)
+
+	tenant, err := ags.CreateTenant(ctx, &pb.Tenant{Name: "Physics"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ags.SetUserTenant(ctx, &pb.TenantUserRequest{TenantID: tenant.GetID(), UserID: user.GetID()}); err != nil {
+		t.Fatal(err)
+	}
+	got, err := db.GetUser(user.GetID())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.GetTenantID() != tenant.GetID() {
+		t.Errorf("tenant of user = %d, want %d", got.GetTenantID(), tenant.GetID())
+	}
+	if _, err := ags.SetUserTenant(ctx, &pb.TenantUserRequest{TenantID: 99, UserID: user.GetID()}); status.Code(err) != codes.NotFound {
+		t.Errorf("SetUserTenant() with unknown tenant = %v, want code %v", err, codes.NotFound)
+	}
+	if _, err := ags.SetUserTenant(ctx, &pb.TenantUserRequest{TenantID: tenant.GetID(), UserID: admin.GetID()}); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("SetUserTenant() for super admin = %v, want code %v", err, codes.PermissionDenied)
+	}
+}
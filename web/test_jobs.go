@@ -0,0 +1,44 @@
+package web
+
+import (
+	"errors"
+
+	pb "github.com/autograde/quickfeed/ag"
+)
+
+var errTestJobNotFound = errors.New("test job not found")
+
+// getTestJobs returns the queued and running test jobs in all runner pools.
+func (s *AutograderService) getTestJobs() (*pb.TestJobs, error) {
+	jobs, err := s.db.GetTestJobs()
+	if err != nil {
+		return nil, err
+	}
+	return &pb.TestJobs{TestJobs: jobs}, nil
+}
+
+// cancelTestJob cancels the queued or running test job with the given ID.
+// A stored job that is not in any runner pool's queue, e.g., because it could
+// not be resumed, is deleted.
+func (s *AutograderService) cancelTestJob(usr *pb.User, jobID uint64) error {
+	jobs, err := s.db.GetTestJobs()
+	if err != nil {
+		return err
+	}
+	var job *pb.TestJob
+	for _, j := range jobs {
+		if j.GetID() == jobID {
+			job = j
+		}
+	}
+	if !s.runners.Cancel(jobID) {
+		if job == nil {
+			return errTestJobNotFound
+		}
+		if err := s.db.DeleteTestJob(jobID); err != nil {
+			return err
+		}
+	}
+	s.audit(usr, job.GetCourseID(), "cancel test job", "testJobID", jobID)
+	return nil
+}
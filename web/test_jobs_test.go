@@ -0,0 +1,61 @@
+package web_test
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/ci"
+	"github.com/autograde/quickfeed/internal/qtest"
+	"github.com/autograde/quickfeed/web"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestTestJobs(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	admin := qtest.CreateFakeUser(t, db, 1)
+	user := qtest.CreateFakeUser(t, db, 2)
+	job := &pb.TestJob{CourseID: 1, AssignmentID: 1, RepositoryID: 1, JobOwner: "user"}
+	if err := db.CreateTestJob(job); err != nil {
+		t.Fatal(err)
+	}
+
+	_, scms := qtest.FakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	client := accessControlled(t, ags)
+	adminCtx := withUserContext(context.Background(), admin)
+	userCtx := withUserContext(context.Background(), user)
+
+	if _, err := client.GetTestJobs(userCtx, &pb.Void{}); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("GetTestJobs() by user: got %v, want %v", err, codes.PermissionDenied)
+	}
+	jobs, err := ags.GetTestJobs(adminCtx, &pb.Void{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(jobs.GetTestJobs()) != 1 || jobs.GetTestJobs()[0].GetID() != job.GetID() {
+		t.Errorf("GetTestJobs() = %v, want job %d", jobs.GetTestJobs(), job.GetID())
+	}
+
+	if _, err := client.CancelTestJob(userCtx, job); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("CancelTestJob() by user: got %v, want %v", err, codes.PermissionDenied)
+	}
+	// a stored job that is not queued is deleted when canceled
+	if _, err := ags.CancelTestJob(adminCtx, job); err != nil {
+		t.Fatal(err)
+	}
+	jobs, err = ags.GetTestJobs(adminCtx, &pb.Void{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(jobs.GetTestJobs()) != 0 {
+		t.Errorf("GetTestJobs() = %v after cancel, want no jobs", jobs.GetTestJobs())
+	}
+	if _, err := ags.CancelTestJob(adminCtx, job); status.Code(err) != codes.NotFound {
+		t.Errorf("CancelTestJob() of canceled job: got %v, want %v", err, codes.NotFound)
+	}
+}
@@ -0,0 +1,64 @@
+package web_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/ci"
+	"github.com/autograde/quickfeed/internal/qtest"
+	"github.com/autograde/quickfeed/web"
+)
+
+func TestGetTestResults(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	teacher := qtest.CreateFakeUser(t, db, 1)
+	course := &pb.Course{Name: "Distributed Systems", Provider: "fake", OrganizationID: 1}
+	qtest.CreateCourse(t, db, teacher, course)
+	student := qtest.CreateFakeUser(t, db, 2)
+	qtest.EnrollStudent(t, db, student, course)
+	other := qtest.CreateFakeUser(t, db, 3)
+	qtest.EnrollStudent(t, db, other, course)
+
+	assignment := &pb.Assignment{CourseID: course.ID, Name: "lab1", Order: 1}
+	if err := db.CreateAssignment(assignment); err != nil {
+		t.Fatal(err)
+	}
+	submission := &pb.Submission{AssignmentID: assignment.ID, UserID: student.ID}
+	if err := db.CreateSubmission(submission); err != nil {
+		t.Fatal(err)
+	}
+	results := []*pb.TestResult{
+		{Name: "TestA", Points: 1, MaxPoints: 1, Duration: 10, Status: pb.TestResult_PASSED},
+		{Name: "TestB", Points: 0, MaxPoints: 2, Duration: 20, Output: "    b_test.go:5: wrong", Status: pb.TestResult_FAILED},
+	}
+	if err := db.UpdateTestResults(submission.ID, results); err != nil {
+		t.Fatal(err)
+	}
+
+	_, scms := qtest.FakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	ctx := context.Background()
+
+	request := &pb.TestResultRequest{CourseID: course.ID, SubmissionID: submission.ID}
+	for _, user := range []*pb.User{student, teacher} {
+		got, err := ags.GetTestResults(withUserContext(ctx, user), request)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(&pb.TestResults{TestResults: results}, got, protocmp.Transform()); diff != "" {
+			t.Errorf("GetTestResults() by %s mismatch (-want +got):\n%s", user.GetLogin(), diff)
+		}
+	}
+	if _, err := ags.GetTestResults(withUserContext(ctx, other), request); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("GetTestResults() by other student: err = %v, want %v", err, codes.PermissionDenied)
+	}
+}
@@ -0,0 +1,76 @@
+package web
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net/http"
+
+	"go.uber.org/zap"
+	"golang.org/x/crypto/acme"
+	"golang.org/x/crypto/acme/autocert"
+)
+
+// TLSOptions describes how the web server obtains its TLS certificate.
+// If both Hosts and the certificate files are given, the certificate files
+// are used when a certificate cannot be obtained from the ACME provider.
+type TLSOptions struct {
+	// CertFile and KeyFile are the certificate and key files to serve.
+	CertFile, KeyFile string
+	// Hosts are the host names to obtain and renew certificates for
+	// from Let's Encrypt; certificates are only requested for these hosts.
+	Hosts []string
+	// CacheDir is the directory for caching the obtained certificates and the account key.
+	CacheDir string
+	// ChallengeAddr is the listen address for the HTTP-01 challenge server, e.g., ":80",
+	// which also redirects plain HTTP requests to HTTPS. If empty, only the TLS-ALPN-01
+	// challenge is used, which requires that the web server listens on port 443.
+	ChallengeAddr string
+}
+
+// Enabled returns true if the web server should serve HTTPS.
+func (o TLSOptions) Enabled() bool {
+	return len(o.Hosts) > 0 || (o.CertFile != "" && o.KeyFile != "")
+}
+
+// Config returns the TLS configuration for the web server, and the handler for the
+// HTTP-01 challenge server, which is nil unless certificates are obtained automatically.
+// It returns a nil configuration if TLS is not enabled.
+func (o TLSOptions) Config(logger *zap.SugaredLogger) (*tls.Config, http.Handler, error) {
+	if !o.Enabled() {
+		return nil, nil, nil
+	}
+	var fallback *tls.Certificate
+	if o.CertFile != "" && o.KeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(o.CertFile, o.KeyFile)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to load certificate: %w", err)
+		}
+		fallback = &cert
+	}
+	cfg := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		NextProtos: []string{"h2", "http/1.1"},
+	}
+	if len(o.Hosts) == 0 {
+		cfg.Certificates = []tls.Certificate{*fallback}
+		return cfg, nil, nil
+	}
+
+	manager := &autocert.Manager{
+		Prompt:     autocert.AcceptTOS,
+		HostPolicy: autocert.HostWhitelist(o.Hosts...),
+	}
+	if o.CacheDir != "" {
+		manager.Cache = autocert.DirCache(o.CacheDir)
+	}
+	cfg.NextProtos = append(cfg.NextProtos, acme.ALPNProto)
+	cfg.GetCertificate = func(hello *tls.ClientHelloInfo) (*tls.Certificate, error) {
+		cert, err := manager.GetCertificate(hello)
+		if err != nil && fallback != nil {
+			logger.Warnf("Failed to obtain certificate for %q, using certificate file: %v", hello.ServerName, err)
+			return fallback, nil
+		}
+		return cert, err
+	}
+	return cfg, manager.HTTPHandler(nil), nil
+}
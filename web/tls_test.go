@@ -0,0 +1,106 @@
+package web_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/autograde/quickfeed/web"
+)
+
+// writeCertificate writes a self-signed certificate for the given host and its key to
+// files in a temporary directory, and returns the certificate and key file names.
+func writeCertificate(t *testing.T, host string) (certFile, keyFile string) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: host},
+		DNSNames:     []string{host},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dir := t.TempDir()
+	certFile, keyFile = filepath.Join(dir, "cert.pem"), filepath.Join(dir, "key.pem")
+	if err := os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return certFile, keyFile
+}
+
+func TestTLSOptions(t *testing.T) {
+	logger := zap.NewNop().Sugar()
+	certFile, keyFile := writeCertificate(t, "quickfeed.example.com")
+
+	cfg, challenge, err := web.TLSOptions{}.Config(logger)
+	if cfg != nil || challenge != nil || err != nil {
+		t.Errorf("Config() without certificates = (%v, %v, %v), want TLS disabled", cfg, challenge, err)
+	}
+
+	cfg, challenge, err = web.TLSOptions{CertFile: certFile, KeyFile: keyFile}.Config(logger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(cfg.Certificates) != 1 || cfg.GetCertificate != nil || challenge != nil {
+		t.Errorf("Config() with certificate files: got %d certificates and challenge handler %v, want only the certificate file", len(cfg.Certificates), challenge)
+	}
+
+	if _, _, err = (web.TLSOptions{CertFile: certFile, KeyFile: certFile}).Config(logger); err == nil {
+		t.Error("Config() with invalid key file: expected error")
+	}
+
+	// certificates are only requested for the configured hosts; other hosts get the
+	// fallback certificate without contacting Let's Encrypt
+	hello := &tls.ClientHelloInfo{ServerName: "other.example.com"}
+	cfg, challenge, err = web.TLSOptions{Hosts: []string{"quickfeed.example.com"}, CacheDir: t.TempDir()}.Config(logger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if challenge == nil {
+		t.Error("Config() with hosts: expected challenge handler")
+	}
+	if _, err := cfg.GetCertificate(hello); err == nil {
+		t.Error("GetCertificate() for unknown host without fallback: expected error")
+	}
+
+	cfg, _, err = web.TLSOptions{Hosts: []string{"quickfeed.example.com"}, CertFile: certFile, KeyFile: keyFile, CacheDir: t.TempDir()}.Config(logger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := cfg.GetCertificate(hello)
+	if err != nil {
+		t.Fatal(err)
+	}
+	leaf, err := x509.ParseCertificate(cert.Certificate[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if leaf.Subject.CommonName != "quickfeed.example.com" {
+		t.Errorf("GetCertificate() for unknown host = certificate for %q, want fallback certificate", leaf.Subject.CommonName)
+	}
+}
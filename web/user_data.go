@@ -0,0 +1,40 @@
+package web
+
+import (
+	"errors"
+	"fmt"
+
+	pb "github.com/autograde/quickfeed/ag"
+)
+
+// errAdminUserData is returned when deleting the personal data of an admin.
+var errAdminUserData = errors.New("cannot delete the data of an admin")
+
+// exportUserData returns the data stored about the given user, without the unreleased reviews of the user's submissions.
+func (s *AutograderService) exportUserData(usr *pb.User, userID uint64) (*pb.UserData, error) {
+	data, err := s.db.GetUserData(userID)
+	if err != nil {
+		return nil, err
+	}
+	// the user's grades are not exported before the teachers release them
+	hideUnreleasedReviews(data.GetSubmissions())
+	s.audit(usr, 0, "export user data", "user", userID)
+	return data, nil
+}
+
+// deleteUserData anonymizes the given user's personal data, keeping the user's course results.
+// Admins must be demoted before their data can be deleted.
+func (s *AutograderService) deleteUserData(usr *pb.User, userID uint64) error {
+	user, err := s.db.GetUser(userID)
+	if err != nil {
+		return err
+	}
+	if user.GetIsAdmin() {
+		return fmt.Errorf("user %d: %w", userID, errAdminUserData)
+	}
+	if err := s.db.AnonymizeUser(userID); err != nil {
+		return err
+	}
+	s.audit(usr, 0, "delete user data", "user", userID)
+	return nil
+}
@@ -0,0 +1,58 @@
+package web_test
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/ci"
+	"github.com/autograde/quickfeed/internal/qtest"
+	"github.com/autograde/quickfeed/web"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestUserData(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	admin := qtest.CreateFakeUser(t, db, 1)
+	student := qtest.CreateUser(t, db, 2, &pb.User{Name: "Ola Nordmann", Login: "ola", Email: "ola@example.com"})
+	other := qtest.CreateFakeUser(t, db, 3)
+
+	_, scms := qtest.FakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	client := accessControlled(t, ags)
+	adminCtx := withUserContext(context.Background(), admin)
+	studentCtx := withUserContext(context.Background(), student)
+	request := &pb.UserRequest{UserID: student.ID}
+
+	data, err := ags.ExportUserData(studentCtx, request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if data.GetUser().GetEmail() != "ola@example.com" {
+		t.Errorf("ExportUserData() user = %v, want the student's own data", data.GetUser())
+	}
+	if _, err := client.ExportUserData(withUserContext(context.Background(), other), request); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("ExportUserData() by other user: got %v, want %v", err, codes.PermissionDenied)
+	}
+
+	if _, err := client.DeleteUserData(studentCtx, request); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("DeleteUserData() by student: got %v, want %v", err, codes.PermissionDenied)
+	}
+	if _, err := ags.DeleteUserData(adminCtx, &pb.UserRequest{UserID: admin.ID}); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("DeleteUserData() of admin: got %v, want %v", err, codes.FailedPrecondition)
+	}
+	if _, err := ags.DeleteUserData(adminCtx, request); err != nil {
+		t.Fatal(err)
+	}
+	data, err = ags.ExportUserData(adminCtx, request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if user := data.GetUser(); user.GetName() == "Ola Nordmann" || user.GetLogin() == "ola" || user.GetEmail() != "" {
+		t.Errorf("ExportUserData() after DeleteUserData() = %v, want anonymized user", user)
+	}
+}
@@ -2,15 +2,25 @@ package web
 
 import (
 	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/database"
 )
 
-// getUsers returns all the users in the database.
-func (s *AutograderService) getUsers() (*pb.Users, error) {
-	users, err := s.db.GetUsers()
+// getUsers returns all the users in the database, or the tenant's users if the current user belongs to a tenant.
+func (s *AutograderService) getUsers(curUser *pb.User, page *database.Page) (*pb.Users, error) {
+	var users []*pb.User
+	var err error
+	switch {
+	case curUser.GetTenantID() > 0:
+		users, err = s.db.GetTenantUsers(curUser.GetTenantID(), page)
+	case page != nil:
+		users, err = s.db.GetUsersPage(page)
+	default:
+		users, err = s.db.GetUsers()
+	}
 	if err != nil {
 		return nil, err
 	}
-	return &pb.Users{Users: users}, nil
+	return &pb.Users{Users: users, NextPageToken: nextPageToken(page)}, nil
 }
 
 // getUserByCourse returns the user matching the given GitHub login if
@@ -21,7 +31,7 @@ func (s *AutograderService) getUserByCourse(request *pb.CourseUserRequest, curre
 	if err != nil {
 		return nil, err
 	}
-	if !(currentUser.IsAdmin || s.isTeacher(currentUser.ID, course.ID)) {
+	if !(s.isCourseAdmin(currentUser, course.ID) || s.isTeachingStaff(currentUser.ID, course.ID)) {
 		return nil, ErrInvalidUserInfo
 	}
 	return user, nil
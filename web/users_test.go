@@ -51,7 +51,7 @@ func TestGetSelf(t *testing.T) {
 	}
 
 	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
-	opt := grpc.ChainUnaryInterceptor(auth.UserVerifier())
+	opt := grpc.ChainUnaryInterceptor(auth.UserVerifier(db))
 	s := grpc.NewServer(opt)
 	pb.RegisterAutograderServiceServer(s, ags)
 
@@ -130,7 +130,8 @@ func TestGetUsers(t *testing.T) {
 
 	_, scms := qtest.FakeProviderMap(t)
 	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
-	unexpectedUsers, err := ags.GetUsers(context.Background(), &pb.Void{})
+	client := accessControlled(t, ags)
+	unexpectedUsers, err := ags.GetUsers(context.Background(), &pb.UsersRequest{})
 	if err == nil && unexpectedUsers != nil && len(unexpectedUsers.GetUsers()) > 0 {
 		t.Fatalf("found unexpected users %+v", unexpectedUsers)
 	}
@@ -138,13 +139,13 @@ func TestGetUsers(t *testing.T) {
 	admin := qtest.CreateFakeUser(t, db, 1)
 	user2 := qtest.CreateFakeUser(t, db, 2)
 	ctx := withUserContext(context.Background(), user2)
-	_, err = ags.GetUsers(ctx, &pb.Void{})
+	_, err = client.GetUsers(ctx, &pb.UsersRequest{})
 	if err == nil {
 		t.Fatal("expected 'rpc error: code = PermissionDenied desc = only admin can access other users'")
 	}
 	// now switch to use admin as the user; this should pass
 	ctx = withUserContext(context.Background(), admin)
-	foundUsers, err := ags.GetUsers(ctx, &pb.Void{})
+	foundUsers, err := ags.GetUsers(ctx, &pb.UsersRequest{})
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -0,0 +1,70 @@
+package web
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/web/hooks"
+	"gorm.io/gorm"
+)
+
+var (
+	errWebhookDeliveryNotFound = errors.New("webhook delivery not found")
+	errWebhookPayloadPurged    = errors.New("webhook delivery payload has been purged")
+)
+
+// getWebhookDeliveries returns the recorded webhook deliveries, newest first;
+// only the failed deliveries if failed is true.
+func (s *AutograderService) getWebhookDeliveries(failed bool) (*pb.WebhookDeliveries, error) {
+	query := &pb.WebhookDelivery{}
+	if failed {
+		query.Status = pb.WebhookDelivery_FAILED
+	}
+	deliveries, err := s.db.GetWebhookDeliveries(query)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.WebhookDeliveries{Deliveries: deliveries}, nil
+}
+
+// replayWebhookDelivery processes the recorded webhook delivery with the given delivery ID again,
+// and returns the delivery with the outcome of the replay. Commits that have already been
+// submitted are not tested again.
+func (s *AutograderService) replayWebhookDelivery(usr *pb.User, deliveryID string) (*pb.WebhookDelivery, error) {
+	delivery, err := s.db.GetWebhookDelivery(deliveryID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errWebhookDeliveryNotFound
+		}
+		return nil, err
+	}
+	if len(delivery.GetPayload()) == 0 {
+		return nil, errWebhookPayloadPurged
+	}
+	webhook := hooks.NewGitHubWebHook(s.logger, s.db, s.runners, s.bh.Secret, s.notifier)
+	if err := webhook.Replay(delivery); err != nil {
+		s.logger.Debugf("Replayed webhook delivery %s failed: %v", deliveryID, err)
+	}
+	s.audit(usr, 0, "replay webhook delivery", "deliveryID", deliveryID)
+	delivery.Payload = nil
+	return delivery, nil
+}
+
+// RunWebhookRetention deletes the payloads of the webhook deliveries received longer than
+// the retention period ago, once a day until the context is canceled.
+func (s *AutograderService) RunWebhookRetention(ctx context.Context, retention time.Duration) {
+	ticker := time.NewTicker(24 * time.Hour)
+	defer ticker.Stop()
+	for {
+		if err := s.db.PurgeWebhookPayloads(time.Now().Add(-retention)); err != nil {
+			s.logger.Errorf("Failed to purge webhook delivery payloads: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
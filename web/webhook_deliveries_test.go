@@ -0,0 +1,84 @@
+package web_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/autograde/quickfeed/ag"
+	"github.com/autograde/quickfeed/ci"
+	"github.com/autograde/quickfeed/internal/qtest"
+	"github.com/autograde/quickfeed/web"
+)
+
+func TestWebhookDeliveries(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	admin := qtest.CreateFakeUser(t, db, 1)
+	user := qtest.CreateFakeUser(t, db, 2)
+	// a push event for a repository unknown to QuickFeed
+	payload := []byte(`{"ref":"refs/heads/main","repository":{"id":42,"full_name":"course/labs","default_branch":"main"}}`)
+	for _, delivery := range []*pb.WebhookDelivery{
+		{DeliveryID: "d1", Event: "push", Status: pb.WebhookDelivery_PROCESSED, Deliveries: 1},
+		{DeliveryID: "d2", Event: "push", Status: pb.WebhookDelivery_FAILED, Error: "failed", Deliveries: 1, Received: "2022-07-01T12:00:00", Payload: payload},
+		{DeliveryID: "d4", Event: "push", Status: pb.WebhookDelivery_FAILED, Error: "failed", Deliveries: 1, Received: "2022-01-01T12:00:00", Payload: payload},
+	} {
+		if err := db.CreateWebhookDelivery(delivery); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	_, scms := qtest.FakeProviderMap(t)
+	ags := web.NewAutograderService(zap.NewNop(), db, scms, web.BaseHookOptions{}, &ci.Local{})
+	client := accessControlled(t, ags)
+	ctx := withUserContext(context.Background(), admin)
+
+	if _, err := client.GetWebhookDeliveries(withUserContext(context.Background(), user), &pb.WebhookDeliveryRequest{}); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("GetWebhookDeliveries() by user: err = %v, want %v", err, codes.PermissionDenied)
+	}
+	deliveries, err := ags.GetWebhookDeliveries(ctx, &pb.WebhookDeliveryRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := deliveries.GetDeliveries(); len(got) != 3 || got[0].GetDeliveryID() != "d4" || got[0].GetPayload() != nil {
+		t.Errorf("GetWebhookDeliveries() = %v, want d4, d2 and d1 without payloads", got)
+	}
+	deliveries, err = ags.GetWebhookDeliveries(ctx, &pb.WebhookDeliveryRequest{Failed: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := deliveries.GetDeliveries(); len(got) != 2 || got[1].GetDeliveryID() != "d2" {
+		t.Errorf("GetWebhookDeliveries(failed) = %v, want d4 and d2", got)
+	}
+
+	if _, err := client.ReplayWebhookDelivery(withUserContext(context.Background(), user), &pb.WebhookDeliveryRequest{DeliveryID: "d2"}); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("ReplayWebhookDelivery() by user: err = %v, want %v", err, codes.PermissionDenied)
+	}
+	if _, err := ags.ReplayWebhookDelivery(ctx, &pb.WebhookDeliveryRequest{DeliveryID: "d3"}); status.Code(err) != codes.NotFound {
+		t.Errorf("ReplayWebhookDelivery(d3) err = %v, want %v", err, codes.NotFound)
+	}
+	// the repository is still unknown, so the replay fails again
+	delivery, err := ags.ReplayWebhookDelivery(ctx, &pb.WebhookDeliveryRequest{DeliveryID: "d2"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if delivery.GetStatus() != pb.WebhookDelivery_FAILED || delivery.GetDeliveries() != 2 || delivery.GetError() == "failed" {
+		t.Errorf("ReplayWebhookDelivery(d2) = %v, want failed with a new error after 2 deliveries", delivery)
+	}
+
+	// deliveries received before the retention period cannot be replayed once their payloads are purged
+	if err := db.PurgeWebhookPayloads(time.Date(2022, 6, 1, 0, 0, 0, 0, time.Local)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ags.ReplayWebhookDelivery(ctx, &pb.WebhookDeliveryRequest{DeliveryID: "d4"}); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("ReplayWebhookDelivery(d4) err = %v, want %v", err, codes.FailedPrecondition)
+	}
+	if _, err := ags.ReplayWebhookDelivery(ctx, &pb.WebhookDeliveryRequest{DeliveryID: "d2"}); err != nil {
+		t.Errorf("ReplayWebhookDelivery(d2) after purge err = %v, want nil", err)
+	}
+}
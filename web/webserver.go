@@ -1,14 +1,13 @@
 package web
 
 import (
-	"context"
 	"net/http"
 	"os"
-	"os/signal"
 	"path/filepath"
 	"time"
 
 	"github.com/autograde/quickfeed/internal/rand"
+	"github.com/autograde/quickfeed/scm"
 	"github.com/autograde/quickfeed/web/auth"
 	"github.com/autograde/quickfeed/web/hooks"
 	"github.com/gorilla/sessions"
@@ -17,6 +16,7 @@ import (
 	"github.com/labstack/echo/v4/middleware"
 	"github.com/markbates/goth"
 	"github.com/markbates/goth/gothic"
+	"github.com/markbates/goth/providers/gitea"
 	"github.com/markbates/goth/providers/github"
 	"github.com/markbates/goth/providers/gitlab"
 	"go.uber.org/zap"
@@ -29,8 +29,8 @@ var (
 	idleTimeout  = 5 * time.Minute
 )
 
-// New starts a new web server
-func New(ags *AutograderService, public, httpAddr string) {
+// New starts a new web server, and returns it, such that it can be shut down.
+func New(ags *AutograderService, public, httpAddr string) *echo.Echo {
 	entryPoint := filepath.Join(public, "index.html")
 	if _, err := os.Stat(entryPoint); os.IsNotExist(err) {
 		ags.logger.Fatalf("file not found %s", entryPoint)
@@ -42,11 +42,29 @@ func New(ags *AutograderService, public, httpAddr string) {
 	e := newServer(ags, store)
 
 	enabled := enableProviders(ags.logger, ags.bh.BaseURL)
+	ags.RegisterHealthChecks(e)
 	registerWebhooks(ags, e, enabled)
 	registerAuth(ags, e)
+	e.GET("/feed/:course", ags.CourseFeed())
+	e.GET("/reports/access/:course", ags.AccessReport())
+	e.GET("/certificates/:code", ags.VerifyCertificate())
+	e.GET("/artifacts/:submission/*", ags.DownloadArtifact())
+	e.GET("/events", ags.Events())
+	if ags.diagnosticsAddr == "" {
+		ags.RegisterDiagnostics(e)
+	} else {
+		// the separate server shares the session store, such that admins' sessions are valid for both
+		diag := newServer(ags, store)
+		ags.RegisterDiagnostics(diag)
+		go runDiagnosticsServer(ags.logger, diag, ags.diagnosticsAddr)
+	}
+
+	registerGRPCWeb(ags, e)
+	registerREST(ags, e)
 
 	registerFrontend(e, entryPoint, public)
-	runWebServer(ags.logger, e, httpAddr)
+	go runWebServer(ags.logger, e, httpAddr, ags.tls)
+	return e
 }
 
 func newServer(ags *AutograderService, store sessions.Store) *echo.Echo {
@@ -102,24 +120,55 @@ func enableProviders(l *zap.SugaredLogger, baseURL string) map[string]bool {
 		l.Debug("environment variable not set for gitlab")
 	}
 
+	giteaURL := scm.GiteaURL()
+	if ok := auth.EnableProvider(&auth.Provider{
+		Name:          "gitea",
+		KeyEnv:        "GITEA_KEY",
+		SecretEnv:     "GITEA_SECRET",
+		CallbackURL:   auth.GetCallbackURL(baseURL, "gitea"),
+		StudentScopes: []string{},
+		TeacherScopes: []string{},
+	}, func(key, secret, callback string, scopes ...string) goth.Provider {
+		return gitea.NewCustomisedURL(key, secret, callback,
+			giteaURL+"/login/oauth/authorize",
+			giteaURL+"/login/oauth/access_token",
+			giteaURL+"/api/v1/user",
+			scopes...)
+	}); ok {
+		enabled["gitea"] = true
+	} else {
+		l.Debug("environment variable not set for gitea")
+	}
+
 	return enabled
 }
 
 func registerWebhooks(ags *AutograderService, e *echo.Echo, enabled map[string]bool) {
-	if enabled["github"] {
-		ghHook := hooks.NewGitHubWebHook(ags.logger, ags.db, ags.runner, ags.bh.Secret)
-		e.POST("/hook/github/events", func(c echo.Context) error {
-			ghHook.Handle(c.Response(), c.Request())
+	// rejects webhook deliveries while shutting down, such that the SCM provider reports them as failed
+	handle := func(hook interface {
+		Handle(http.ResponseWriter, *http.Request)
+	}) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if ags.isShuttingDown() {
+				return c.NoContent(http.StatusServiceUnavailable)
+			}
+			hook.Handle(c.Response(), c.Request())
 			return nil
-		})
+		}
+	}
+	if enabled["github"] {
+		ghHook := hooks.NewGitHubWebHook(ags.logger, ags.db, ags.runners, ags.bh.Secret, ags.notifier)
+		e.POST("/hook/github/events", handle(ghHook))
 	}
 	if enabled["gitlab"] {
-		// TODO(meling) fix gitlab
-		glHook := hooks.NewGitHubWebHook(ags.logger, ags.db, ags.runner, ags.bh.Secret)
-		e.POST("/hook/gitlab/events", func(c echo.Context) error {
-			glHook.Handle(c.Response(), c.Request())
-			return nil
-		})
+		// GitLab push events are converted to GitHub's push events
+		glHook := hooks.NewGitLabWebHook(ags.logger, ags.db, ags.runners, ags.bh.Secret, ags.notifier)
+		e.POST("/hook/gitlab/events", handle(glHook))
+	}
+	if enabled["gitea"] {
+		// Gitea push events are compatible with GitHub's push events
+		gtHook := hooks.NewGitHubWebHook(ags.logger, ags.db, ags.runners, ags.bh.Secret, ags.notifier)
+		e.POST("/hook/gitea/events", handle(gtHook))
 	}
 }
 
@@ -138,7 +187,7 @@ func registerAuth(ags *AutograderService, e *echo.Echo) {
 	oauth2 := e.Group("/auth/:provider", withProvider, auth.PreAuth(ags.logger, ags.db))
 	oauth2.GET("", auth.OAuth2Login(ags.logger, ags.db))
 	oauth2.GET("/callback", auth.OAuth2Callback(ags.logger, ags.db, ags.scms))
-	e.GET("/logout", auth.OAuth2Logout(ags.logger))
+	e.GET("/logout", auth.OAuth2Logout(ags.logger, ags.db))
 }
 
 func registerFrontend(e *echo.Echo, entryPoint, public string) {
@@ -161,7 +210,34 @@ func registerFrontend(e *echo.Echo, entryPoint, public string) {
 	e.Static("/static", public)
 }
 
-func runWebServer(l *zap.SugaredLogger, e *echo.Echo, httpAddr string) {
+// runDiagnosticsServer runs the diagnostics server. Unlike the main web server,
+// it has no write timeout, to allow long-running CPU profiles and execution traces.
+func runDiagnosticsServer(l *zap.SugaredLogger, e *echo.Echo, addr string) {
+	e.Server.ReadTimeout = readTimeout
+	e.Server.IdleTimeout = idleTimeout
+	if err := e.Start(addr); err != http.ErrServerClosed {
+		l.Errorf("Failed to start diagnostics server: %v", err)
+	}
+}
+
+// runChallengeServer runs the server answering the ACME HTTP-01 challenges,
+// which redirects all other requests to HTTPS.
+func runChallengeServer(l *zap.SugaredLogger, handler http.Handler, addr string) {
+	srv := &http.Server{
+		Addr:         addr,
+		Handler:      handler,
+		ReadTimeout:  readTimeout,
+		WriteTimeout: writeTimeout,
+		IdleTimeout:  idleTimeout,
+	}
+	if err := srv.ListenAndServe(); err != http.ErrServerClosed {
+		l.Errorf("Failed to start ACME challenge server: %v", err)
+	}
+}
+
+// runWebServer runs the web server. If TLS is enabled, the server serves HTTPS,
+// which also enables HTTP/2.
+func runWebServer(l *zap.SugaredLogger, e *echo.Echo, httpAddr string, tlsOpts TLSOptions) {
 	e.Server.WriteTimeout = writeTimeout
 	e.Server.ReadTimeout = readTimeout
 	e.Server.IdleTimeout = idleTimeout
@@ -170,21 +246,24 @@ func runWebServer(l *zap.SugaredLogger, e *echo.Echo, httpAddr string) {
 	e.TLSServer.WriteTimeout = writeTimeout
 	e.TLSServer.IdleTimeout = idleTimeout
 
-	srvErr := e.Start(httpAddr)
+	tlsConfig, challengeHandler, err := tlsOpts.Config(l)
+	if err != nil {
+		l.Fatal("failed to configure TLS", zap.Error(err))
+	}
+	if challengeHandler != nil && tlsOpts.ChallengeAddr != "" {
+		go runChallengeServer(l, challengeHandler, tlsOpts.ChallengeAddr)
+	}
+	var srvErr error
+	if tlsConfig != nil {
+		e.TLSServer.Addr = httpAddr
+		e.TLSServer.TLSConfig = tlsConfig
+		srvErr = e.StartServer(e.TLSServer)
+	} else {
+		srvErr = e.Start(httpAddr)
+	}
 	if srvErr == http.ErrServerClosed {
 		l.Warn("shutting down the server")
 		return
 	}
 	l.Fatal("failed to start server", zap.Error(srvErr))
-	// TODO(meling) pretty sure the following lines are unreachable; check
-
-	quit := make(chan os.Signal, 1)
-	signal.Notify(quit, os.Interrupt)
-	<-quit
-
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-	defer cancel()
-	if err := e.Shutdown(ctx); err != nil {
-		l.Fatal("failure during server shutdown", zap.Error(err))
-	}
 }